@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// AppFileNotFoundError is returned when a path does not exist in an app
+// instance.
+type AppFileNotFoundError struct {
+	Path string
+}
+
+func (e AppFileNotFoundError) Error() string {
+	return fmt.Sprintf("file or directory '%s' not found", e.Path)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// AppPathIsDirectoryError is returned when a file is expected in an app
+// instance but the path is a directory.
+type AppPathIsDirectoryError struct {
+	Path string
+}
+
+func (e AppPathIsDirectoryError) Error() string {
+	return fmt.Sprintf("'%s' is a directory", e.Path)
+}
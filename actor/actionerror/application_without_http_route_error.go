@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationWithoutHTTPRouteError is returned when an operation needs to
+// reach an application through the Gorouter but none of its routes is an
+// HTTP route.
+type ApplicationWithoutHTTPRouteError struct {
+	AppName string
+}
+
+func (e ApplicationWithoutHTTPRouteError) Error() string {
+	return fmt.Sprintf("Application '%s' has no HTTP route", e.AppName)
+}
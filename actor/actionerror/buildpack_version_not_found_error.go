@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// BuildpackVersionNotFoundError is returned when the installed version of a
+// buildpack cannot be determined from its filename.
+type BuildpackVersionNotFoundError struct {
+	BuildpackName string
+}
+
+func (e BuildpackVersionNotFoundError) Error() string {
+	return fmt.Sprintf("Cannot determine the installed version of buildpack '%s'", e.BuildpackName)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// DiagnosticDumpFailedError is returned when a diagnostic dump could not be
+// created in an app instance.
+type DiagnosticDumpFailedError struct {
+	Type   string
+	Reason string
+}
+
+func (e DiagnosticDumpFailedError) Error() string {
+	return fmt.Sprintf("creating %s dump failed: %s", e.Type, e.Reason)
+}
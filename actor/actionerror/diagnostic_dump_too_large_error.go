@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// DiagnosticDumpTooLargeError is returned when a diagnostic dump is larger
+// than the maximum size that may be downloaded.
+type DiagnosticDumpTooLargeError struct {
+	Type    string
+	Size    int64
+	MaxSize int64
+}
+
+func (e DiagnosticDumpTooLargeError) Error() string {
+	return fmt.Sprintf("%s dump of %d bytes exceeds the maximum size of %d bytes", e.Type, e.Size, e.MaxSize)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// GitCloneError is returned when a git repository cannot be cloned or the
+// requested ref cannot be checked out.
+type GitCloneError struct {
+	URL    string
+	Ref    string
+	Output string
+}
+
+func (e GitCloneError) Error() string {
+	return fmt.Sprintf("cloning %s at ref '%s' failed: %s", e.URL, e.Ref, e.Output)
+}
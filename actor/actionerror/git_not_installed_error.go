@@ -0,0 +1,9 @@
+package actionerror
+
+// GitNotInstalledError is returned when pushing from a git URL and the git
+// executable cannot be found.
+type GitNotInstalledError struct{}
+
+func (GitNotInstalledError) Error() string {
+	return "git is not installed"
+}
@@ -0,0 +1,19 @@
+package actionerror
+
+import "fmt"
+
+// HealthCheckEndpointFailedError is returned when a health check endpoint
+// does not respond successfully through the application's route. StatusCode
+// is 0 when the router never sent the request to the application.
+type HealthCheckEndpointFailedError struct {
+	AppName    string
+	URL        string
+	StatusCode int
+}
+
+func (e HealthCheckEndpointFailedError) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("health check endpoint %s of application '%s' could not be reached", e.URL, e.AppName)
+	}
+	return fmt.Sprintf("health check endpoint %s of application '%s' responded with status %d", e.URL, e.AppName, e.StatusCode)
+}
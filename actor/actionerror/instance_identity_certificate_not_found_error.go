@@ -0,0 +1,9 @@
+package actionerror
+
+// InstanceIdentityCertificateNotFoundError is returned when an app instance
+// does not expose an instance identity certificate through CF_INSTANCE_CERT.
+type InstanceIdentityCertificateNotFoundError struct{}
+
+func (InstanceIdentityCertificateNotFoundError) Error() string {
+	return "instance identity certificate not found"
+}
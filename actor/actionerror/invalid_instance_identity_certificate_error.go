@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidInstanceIdentityCertificateError is returned when the contents of
+// CF_INSTANCE_CERT cannot be parsed as a PEM encoded x509 certificate.
+type InvalidInstanceIdentityCertificateError struct {
+	Reason string
+}
+
+func (e InvalidInstanceIdentityCertificateError) Error() string {
+	return fmt.Sprintf("invalid instance identity certificate: %s", e.Reason)
+}
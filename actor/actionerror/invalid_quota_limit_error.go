@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// InvalidQuotaLimitError is returned when a limit of a quota in a quotas file
+// cannot be parsed.
+type InvalidQuotaLimitError struct {
+	QuotaName string
+	Limit     string
+	Value     string
+}
+
+func (e InvalidQuotaLimitError) Error() string {
+	return fmt.Sprintf("Invalid value '%s' for %s of quota '%s'.", e.Value, e.Limit, e.QuotaName)
+}
@@ -0,0 +1,23 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/jsonschema"
+)
+
+// InvalidServiceParametersError is returned when the parameters given for a
+// service instance do not match the schema the broker publishes for the plan.
+type InvalidServiceParametersError struct {
+	PlanName string
+	Errors   []jsonschema.ValidationError
+}
+
+func (e InvalidServiceParametersError) Error() string {
+	lines := []string{}
+	for _, validationError := range e.Errors {
+		lines = append(lines, validationError.Error())
+	}
+	return fmt.Sprintf("The parameters do not match the schema of service plan '%s':\n%s", e.PlanName, strings.Join(lines, "\n"))
+}
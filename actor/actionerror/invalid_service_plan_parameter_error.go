@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// InvalidServicePlanParameterError is returned when a value does not match
+// the schema of a service plan parameter.
+type InvalidServicePlanParameterError struct {
+	Name     string
+	Value    string
+	Expected string
+}
+
+func (e InvalidServicePlanParameterError) Error() string {
+	return fmt.Sprintf("Invalid value '%s' for parameter '%s': expected %s.", e.Value, e.Name, e.Expected)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidServicePlanSchemaError is returned when the parameters schema of a
+// service plan cannot be read.
+type InvalidServicePlanSchemaError struct {
+	PlanName string
+	Err      error
+}
+
+func (e InvalidServicePlanSchemaError) Error() string {
+	return fmt.Sprintf("The parameters schema of service plan '%s' is invalid: %s", e.PlanName, e.Err)
+}
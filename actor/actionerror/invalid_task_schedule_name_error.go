@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidTaskScheduleNameError is returned when a task schedule name cannot
+// be used as an annotation key.
+type InvalidTaskScheduleNameError struct {
+	Name string
+}
+
+func (e InvalidTaskScheduleNameError) Error() string {
+	return fmt.Sprintf("Task schedule name %s must be at most 63 characters of letters, numbers, '-', '_' and '.', starting and ending with a letter or number.", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// JobNotFoundError is returned when the Cloud Controller has no job with the
+// given GUID, either because it never existed or because it has expired.
+type JobNotFoundError struct {
+	GUID string
+}
+
+func (e JobNotFoundError) Error() string {
+	return fmt.Sprintf("Job '%s' not found.", e.GUID)
+}
@@ -0,0 +1,8 @@
+package actionerror
+
+// PackageNotFoundError is returned when an application has no ready package.
+type PackageNotFoundError struct{}
+
+func (PackageNotFoundError) Error() string {
+	return "Package not found"
+}
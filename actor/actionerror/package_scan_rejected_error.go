@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// PackageScanRejectedError is returned when the pre-upload scan hook does not
+// approve the files of an app.
+type PackageScanRejectedError struct {
+	AppName string
+	Hook    string
+	Reason  string
+}
+
+func (e PackageScanRejectedError) Error() string {
+	return fmt.Sprintf("scan hook '%s' rejected app '%s': %s", e.Hook, e.AppName, e.Reason)
+}
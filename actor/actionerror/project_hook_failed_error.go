@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ProjectHookFailedError is returned when a hook declared in a project file
+// exits unsuccessfully.
+type ProjectHookFailedError struct {
+	Command string
+	Err     error
+}
+
+func (e ProjectHookFailedError) Error() string {
+	return fmt.Sprintf("hook '%s' failed: %s", e.Command, e.Err)
+}
@@ -0,0 +1,22 @@
+package actionerror
+
+import "fmt"
+
+// QuotaReservationExceededError is returned when an operation would use more
+// of a resource than a space or organization quota has remaining.
+type QuotaReservationExceededError struct {
+	QuotaName string
+	Resource  string
+	Needed    string
+	Available string
+	// PerInstance is set when the quota limits each app instance rather than
+	// the total usage.
+	PerInstance bool
+}
+
+func (e QuotaReservationExceededError) Error() string {
+	if e.PerInstance {
+		return fmt.Sprintf("%s needs %s per instance, only %s allowed by quota %s", e.Resource, e.Needed, e.Available, e.QuotaName)
+	}
+	return fmt.Sprintf("%s needs %s, only %s remaining in quota %s", e.Resource, e.Needed, e.Available, e.QuotaName)
+}
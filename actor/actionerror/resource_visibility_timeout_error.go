@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ResourceVisibilityTimeoutError is returned when the overall polling timeout
+// is reached waiting for a newly created resource to be returned by the API.
+type ResourceVisibilityTimeoutError struct {
+	Type string
+	Name string
+}
+
+func (e ResourceVisibilityTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for %s '%s' to be visible", e.Type, e.Name)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// RevisionNotFoundError is returned when an application has no revision with
+// the requested version.
+type RevisionNotFoundError struct {
+	AppName string
+	Version int
+}
+
+func (e RevisionNotFoundError) Error() string {
+	return fmt.Sprintf("Revision %d of app '%s' not found.", e.Version, e.AppName)
+}
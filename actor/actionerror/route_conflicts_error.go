@@ -0,0 +1,29 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RouteConflict is a route an app cannot be mapped to because the route
+// exists in another space.
+type RouteConflict struct {
+	AppName string
+	Route   string
+	// Alternatives are similar routes that are not in use.
+	Alternatives []string
+}
+
+// RouteConflictsError is returned when push finds that some of the routes of
+// the apps being pushed exist in other spaces.
+type RouteConflictsError struct {
+	Conflicts []RouteConflict
+}
+
+func (e RouteConflictsError) Error() string {
+	var routes []string
+	for _, conflict := range e.Conflicts {
+		routes = append(routes, conflict.Route)
+	}
+	return fmt.Sprintf("routes registered to another space: %s", strings.Join(routes, ", "))
+}
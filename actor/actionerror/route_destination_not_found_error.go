@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// RouteDestinationNotFoundError is returned when an app is not one of the
+// destinations of a route.
+type RouteDestinationNotFoundError struct {
+	AppName string
+	Route   string
+}
+
+func (e RouteDestinationNotFoundError) Error() string {
+	return fmt.Sprintf("App %s is not a destination of route %s", e.AppName, e.Route)
+}
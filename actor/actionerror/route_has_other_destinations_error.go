@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RouteHasOtherDestinationsError is returned when traffic cannot be shifted
+// between two apps because the route also sends traffic elsewhere.
+type RouteHasOtherDestinationsError struct {
+	Route string
+}
+
+func (e RouteHasOtherDestinationsError) Error() string {
+	return fmt.Sprintf("Route %s has destinations other than the apps being cut over", e.Route)
+}
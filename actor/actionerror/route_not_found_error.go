@@ -6,6 +6,7 @@ import "fmt"
 type RouteNotFoundError struct {
 	Host       string
 	DomainGUID string
+	DomainName string
 	Path       string
 	Port       int
 }
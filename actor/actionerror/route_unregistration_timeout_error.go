@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RouteUnregistrationTimeoutError is returned when the router still sends
+// traffic to an application after its routes have been unmapped.
+type RouteUnregistrationTimeoutError struct {
+	AppName string
+	Routes  []string
+}
+
+func (e RouteUnregistrationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for the router to unregister routes %s from application '%s'", strings.Join(e.Routes, ", "), e.AppName)
+}
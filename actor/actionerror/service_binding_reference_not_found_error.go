@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceBindingReferenceNotFoundError is returned when an environment
+// variable template refers to a service binding credential that the
+// application does not have.
+type ServiceBindingReferenceNotFoundError struct {
+	Reference string
+}
+
+func (e ServiceBindingReferenceNotFoundError) Error() string {
+	return fmt.Sprintf("service binding reference '%s' not found", e.Reference)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceDashboardUnavailableError is returned when a service
+// instance has no dashboard URL that can be opened in a browser.
+type ServiceInstanceDashboardUnavailableError struct {
+	Name string
+}
+
+func (e ServiceInstanceDashboardUnavailableError) Error() string {
+	return fmt.Sprintf("Service instance '%s' does not provide a dashboard.", e.Name)
+}
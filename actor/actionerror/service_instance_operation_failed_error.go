@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationFailedError is returned when the last operation of a
+// service instance failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("operation on service instance '%s' failed: %s", e.Name, e.Description)
+}
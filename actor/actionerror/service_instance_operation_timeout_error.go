@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationTimeoutError is returned when the overall polling
+// timeout is reached waiting for a service instance operation to finish.
+type ServiceInstanceOperationTimeoutError struct {
+	Name string
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for the operation on service instance '%s' to finish", e.Name)
+}
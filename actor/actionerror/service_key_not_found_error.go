@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceKeyNotFoundError is returned when a service instance has no service
+// key of the given name.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Service key '%s' of service instance '%s' not found.", e.Name, e.ServiceInstanceName)
+}
@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// TaskFailedError is returned when a task that was waited for fails.
+type TaskFailedError struct {
+	Name string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %s failed.", e.Name)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// TaskInputFileTooLargeError is returned when a file uploaded to a task is
+// larger than a task command can carry.
+type TaskInputFileTooLargeError struct {
+	Path    string
+	Size    int
+	MaxSize int
+}
+
+func (e TaskInputFileTooLargeError) Error() string {
+	return fmt.Sprintf("Input file %s is %d bytes; files uploaded to a task can be at most %d bytes.", e.Path, e.Size, e.MaxSize)
+}
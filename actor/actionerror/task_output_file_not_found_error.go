@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// TaskOutputFileNotFoundError is returned when a task finishes without
+// creating the output file it was expected to create.
+type TaskOutputFileNotFoundError struct {
+	Path string
+}
+
+func (e TaskOutputFileNotFoundError) Error() string {
+	return fmt.Sprintf("Task did not create output file %s.", e.Path)
+}
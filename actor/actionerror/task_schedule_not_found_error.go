@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// TaskScheduleNotFoundError is returned when an application has no task
+// schedule with the provided name.
+type TaskScheduleNotFoundError struct {
+	AppName string
+	Name    string
+}
+
+func (e TaskScheduleNotFoundError) Error() string {
+	return fmt.Sprintf("Task schedule %s not found for app %s.", e.Name, e.AppName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// UnsupportedAppArchiveError is returned when a downloaded app source is not
+// a zip, tar or gzipped tar archive.
+type UnsupportedAppArchiveError struct {
+	URL string
+}
+
+func (e UnsupportedAppArchiveError) Error() string {
+	return fmt.Sprintf("%s is not a zip, tar or tar.gz archive", e.URL)
+}
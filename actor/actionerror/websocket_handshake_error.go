@@ -0,0 +1,18 @@
+package actionerror
+
+import "fmt"
+
+// WebSocketHandshakeError is returned when the server refuses to upgrade a
+// request to a websocket connection.
+type WebSocketHandshakeError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e WebSocketHandshakeError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("websocket handshake with %s failed with status %d", e.URL, e.StatusCode)
+	}
+	return fmt.Sprintf("websocket handshake with %s failed with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
@@ -0,0 +1,79 @@
+package pluginaction
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . CommandList
+
+type CommandList interface {
+	HasCommand(string) bool
+	HasAlias(string) bool
+}
+
+// CommandConflict is a command name or alias that several installed plugins,
+// or an installed plugin and a built-in command, have.
+type CommandConflict struct {
+	Command string
+
+	// BuiltIn is true when a built-in command has the name or alias.
+	BuiltIn bool
+
+	// Plugins are the plugins that have the name or alias, sorted by name.
+	Plugins []string
+
+	// RunBy is the plugin whose command runs. It is empty when none of the
+	// plugins is in the plugin-precedence setting, in which case the built-in
+	// command runs, or the plugin commands have to be run as PLUGIN:COMMAND.
+	RunBy string
+}
+
+// GetCommandConflicts returns the command names and aliases of installed
+// plugins that other plugins, or built-in commands, also have, sorted by
+// command.
+func (actor Actor) GetCommandConflicts(commandList CommandList) []CommandConflict {
+	pluginsByCommand := map[string][]string{}
+	for _, plugin := range actor.config.Plugins() {
+		for _, command := range plugin.Commands {
+			for _, name := range []string{command.Name, command.Alias} {
+				if name != "" && !containsString(pluginsByCommand[name], plugin.Name) {
+					pluginsByCommand[name] = append(pluginsByCommand[name], plugin.Name)
+				}
+			}
+		}
+	}
+
+	conflicts := []CommandConflict{}
+	for name, pluginNames := range pluginsByCommand {
+		builtIn := commandList.HasCommand(name) || commandList.HasAlias(name)
+		if !builtIn && len(pluginNames) == 1 {
+			continue
+		}
+
+		runBy, _ := configv3.PreferredPlugin(pluginNames, actor.config.PluginPrecedence())
+		conflicts = append(conflicts, CommandConflict{
+			Command: name,
+			BuiltIn: builtIn,
+			Plugins: pluginNames,
+			RunBy:   runBy,
+		})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return strings.ToLower(conflicts[i].Command) < strings.ToLower(conflicts[j].Command)
+	})
+
+	return conflicts
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
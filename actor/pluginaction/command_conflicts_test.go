@@ -0,0 +1,80 @@
+package pluginaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("command conflict actions", func() {
+	var (
+		actor           *Actor
+		fakeConfig      *pluginactionfakes.FakeConfig
+		fakeCommandList *pluginactionfakes.FakeCommandList
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeCommandList = new(pluginactionfakes.FakeCommandList)
+		actor = NewActor(fakeConfig, nil)
+	})
+
+	Describe("GetCommandConflicts", func() {
+		var conflicts []CommandConflict
+
+		BeforeEach(func() {
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{
+					Name: "plugin-a",
+					Commands: []configv3.PluginCommand{
+						{Name: "deploy", Alias: "d"},
+						{Name: "version"},
+						{Name: "unique-a"},
+					},
+				},
+				{
+					Name: "plugin-b",
+					Commands: []configv3.PluginCommand{
+						{Name: "deploy-all", Alias: "deploy"},
+						{Name: "some-command", Alias: "cups"},
+					},
+				},
+			})
+
+			fakeCommandList.HasCommandStub = func(commandName string) bool {
+				return commandName == "version"
+			}
+			fakeCommandList.HasAliasStub = func(commandAlias string) bool {
+				return commandAlias == "cups"
+			}
+		})
+
+		JustBeforeEach(func() {
+			conflicts = actor.GetCommandConflicts(fakeCommandList)
+		})
+
+		It("returns the names and aliases that several plugins, or a plugin and a built-in command, have", func() {
+			Expect(conflicts).To(Equal([]CommandConflict{
+				{Command: "cups", BuiltIn: true, Plugins: []string{"plugin-b"}},
+				{Command: "deploy", Plugins: []string{"plugin-a", "plugin-b"}},
+				{Command: "version", BuiltIn: true, Plugins: []string{"plugin-a"}},
+			}))
+		})
+
+		When("plugins are in the plugin-precedence setting", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginPrecedenceReturns([]string{"plugin-b", "plugin-a"})
+			})
+
+			It("returns which plugin runs each command", func() {
+				Expect(conflicts).To(Equal([]CommandConflict{
+					{Command: "cups", BuiltIn: true, Plugins: []string{"plugin-b"}, RunBy: "plugin-b"},
+					{Command: "deploy", Plugins: []string{"plugin-a", "plugin-b"}, RunBy: "plugin-b"},
+					{Command: "version", BuiltIn: true, Plugins: []string{"plugin-a"}, RunBy: "plugin-a"},
+				}))
+			})
+		})
+	})
+})
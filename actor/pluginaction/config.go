@@ -10,6 +10,7 @@ type Config interface {
 	AddPluginRepository(repoName string, repoURL string)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
+	PluginPrecedence() []string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
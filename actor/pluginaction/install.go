@@ -4,8 +4,6 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"sort"
-	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/plugin"
@@ -20,13 +18,6 @@ type PluginMetadata interface {
 	GetMetadata(pluginPath string) (configv3.Plugin, error)
 }
 
-//go:generate counterfeiter . CommandList
-
-type CommandList interface {
-	HasCommand(string) bool
-	HasAlias(string) bool
-}
-
 // CreateExecutableCopy makes a temporary copy of a plugin binary and makes it
 // executable.
 //
@@ -81,63 +72,12 @@ func (actor Actor) FileExists(path string) bool {
 	return err == nil
 }
 
-func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandList CommandList, path string) (configv3.Plugin, error) {
+func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, path string) (configv3.Plugin, error) {
 	plugin, err := pluginMetadata.GetMetadata(path)
 	if err != nil || plugin.Name == "" || len(plugin.Commands) == 0 {
 		return configv3.Plugin{}, actionerror.PluginInvalidError{Err: err}
 	}
 
-	installedPlugins := actor.config.Plugins()
-
-	conflictingNames := []string{}
-	conflictingAliases := []string{}
-
-	for _, command := range plugin.Commands {
-		if commandList.HasCommand(command.Name) || commandList.HasAlias(command.Name) {
-			conflictingNames = append(conflictingNames, command.Name)
-		}
-
-		if commandList.HasAlias(command.Alias) || commandList.HasCommand(command.Alias) {
-			conflictingAliases = append(conflictingAliases, command.Alias)
-		}
-
-		for _, installedPlugin := range installedPlugins {
-			// we do not error if a plugins commands conflict with previous
-			// versions of the same plugin
-			if plugin.Name == installedPlugin.Name {
-				continue
-			}
-
-			for _, installedCommand := range installedPlugin.Commands {
-				if command.Name == installedCommand.Name || command.Name == installedCommand.Alias {
-					conflictingNames = append(conflictingNames, command.Name)
-				}
-
-				if command.Alias != "" &&
-					(command.Alias == installedCommand.Alias || command.Alias == installedCommand.Name) {
-					conflictingAliases = append(conflictingAliases, command.Alias)
-				}
-			}
-		}
-	}
-
-	if len(conflictingNames) > 0 || len(conflictingAliases) > 0 {
-		sort.Slice(conflictingNames, func(i, j int) bool {
-			return strings.ToLower(conflictingNames[i]) < strings.ToLower(conflictingNames[j])
-		})
-
-		sort.Slice(conflictingAliases, func(i, j int) bool {
-			return strings.ToLower(conflictingAliases[i]) < strings.ToLower(conflictingAliases[j])
-		})
-
-		return configv3.Plugin{}, actionerror.PluginCommandsConflictError{
-			PluginName:     plugin.Name,
-			PluginVersion:  plugin.Version.String(),
-			CommandNames:   conflictingNames,
-			CommandAliases: conflictingAliases,
-		}
-	}
-
 	return plugin, nil
 }
 
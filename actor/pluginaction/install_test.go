@@ -166,18 +166,16 @@ var _ = Describe("install actions", func() {
 	Describe("GetAndValidatePlugin", func() {
 		var (
 			fakePluginMetadata *pluginactionfakes.FakePluginMetadata
-			fakeCommandList    *pluginactionfakes.FakeCommandList
 			plugin             configv3.Plugin
 			validateErr        error
 		)
 
 		BeforeEach(func() {
 			fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
-			fakeCommandList = new(pluginactionfakes.FakeCommandList)
 		})
 
 		JustBeforeEach(func() {
-			plugin, validateErr = actor.GetAndValidatePlugin(fakePluginMetadata, fakeCommandList, "some-plugin-path")
+			plugin, validateErr = actor.GetAndValidatePlugin(fakePluginMetadata, "some-plugin-path")
 		})
 
 		When("getting the plugin metadata returns an error", func() {
@@ -213,249 +211,6 @@ var _ = Describe("install actions", func() {
 			})
 		})
 
-		When("there are command conflicts", func() {
-			BeforeEach(func() {
-				fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
-					Name: "some-plugin",
-					Version: configv3.PluginVersion{
-						Major: 1,
-						Minor: 1,
-						Build: 1,
-					},
-					Commands: []configv3.PluginCommand{
-						{Name: "some-other-command", Alias: "soc"},
-						{Name: "some-command", Alias: "sc"},
-						{Name: "version", Alias: "v"},
-						{Name: "p", Alias: "push"},
-					},
-				}, nil)
-			})
-
-			When("the plugin has command names that conflict with native command names", func() {
-				BeforeEach(func() {
-					fakeCommandList.HasCommandStub = func(commandName string) bool {
-						switch commandName {
-						case "version":
-							return true
-						default:
-							return false
-						}
-					}
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command names", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{"version"},
-						CommandAliases: []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command names that conflict with native command aliases", func() {
-				BeforeEach(func() {
-					fakeCommandList.HasAliasStub = func(commandAlias string) bool {
-						switch commandAlias {
-						case "p":
-							return true
-						default:
-							return false
-						}
-					}
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command names", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{"p"},
-						CommandAliases: []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command aliases that conflict with native command names", func() {
-				BeforeEach(func() {
-					fakeCommandList.HasCommandStub = func(commandName string) bool {
-						switch commandName {
-						case "push":
-							return true
-						default:
-							return false
-						}
-					}
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command aliases", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandAliases: []string{"push"},
-						CommandNames:   []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command aliases that conflict with native command aliases", func() {
-				BeforeEach(func() {
-					fakeCommandList.HasAliasStub = func(commandAlias string) bool {
-						switch commandAlias {
-						case "v":
-							return true
-						default:
-							return false
-						}
-					}
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command aliases", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandAliases: []string{"v"},
-						CommandNames:   []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command names that conflict with existing plugin command names", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{{
-						Name:     "installed-plugin-2",
-						Commands: []configv3.PluginCommand{{Name: "some-command"}},
-					}})
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command names", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{"some-command"},
-						CommandAliases: []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command names that conflict with existing plugin command aliases", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{{
-						Name:     "installed-plugin-2",
-						Commands: []configv3.PluginCommand{{Alias: "some-command"}}},
-					})
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command names", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{"some-command"},
-						CommandAliases: []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command aliases that conflict with existing plugin command names", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{{
-						Name:     "installed-plugin-2",
-						Commands: []configv3.PluginCommand{{Name: "sc"}}},
-					})
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command aliases", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{},
-						CommandAliases: []string{"sc"},
-					}))
-				})
-			})
-
-			When("the plugin has command aliases that conflict with existing plugin command aliases", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{{
-						Name:     "installed-plugin-2",
-						Commands: []configv3.PluginCommand{{Alias: "sc"}}},
-					})
-				})
-
-				It("returns a PluginCommandsConflictError containing all conflicting command aliases", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandAliases: []string{"sc"},
-						CommandNames:   []string{},
-					}))
-				})
-			})
-
-			When("the plugin has command names and aliases that conflict with existing native and plugin command names and aliases", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{
-						{
-							Name: "installed-plugin-1",
-							Commands: []configv3.PluginCommand{
-								{Name: "some-command"},
-								{Alias: "some-other-command"},
-							},
-						},
-						{
-							Name: "installed-plugin-2",
-							Commands: []configv3.PluginCommand{
-								{Name: "sc"},
-								{Alias: "soc"},
-							},
-						},
-					})
-
-					fakeCommandList.HasCommandStub = func(commandName string) bool {
-						switch commandName {
-						case "version", "p":
-							return true
-						default:
-							return false
-						}
-					}
-
-					fakeCommandList.HasAliasStub = func(commandAlias string) bool {
-						switch commandAlias {
-						case "v", "push":
-							return true
-						default:
-							return false
-						}
-					}
-				})
-
-				It("returns a PluginCommandsConflictError with all conflicting command names and aliases", func() {
-					Expect(validateErr).To(MatchError(actionerror.PluginCommandsConflictError{
-						PluginName:     "some-plugin",
-						PluginVersion:  "1.1.1",
-						CommandNames:   []string{"p", "some-command", "some-other-command", "version"},
-						CommandAliases: []string{"push", "sc", "soc", "v"},
-					}))
-				})
-			})
-
-			When("the plugin is already installed", func() {
-				BeforeEach(func() {
-					fakeConfig.PluginsReturns([]configv3.Plugin{{
-						Name: "some-plugin",
-						Commands: []configv3.PluginCommand{
-							{Name: "some-command", Alias: "sc"},
-							{Name: "some-other-command", Alias: "soc"},
-						},
-					}})
-				})
-
-				It("does not return any errors due to command name or alias conflict", func() {
-					Expect(validateErr).ToNot(HaveOccurred())
-				})
-			})
-		})
-
 		When("the plugin is valid", func() {
 			var pluginToBeInstalled configv3.Plugin
 
@@ -479,30 +234,6 @@ var _ = Describe("install actions", func() {
 					},
 				}
 				fakePluginMetadata.GetMetadataReturns(pluginToBeInstalled, nil)
-				fakeConfig.PluginsReturns([]configv3.Plugin{
-					{
-						Name: "installed-plugin-1",
-						Commands: []configv3.PluginCommand{
-							{
-								Name:  "unique-command-1",
-								Alias: "uc1",
-							},
-						},
-					},
-					{
-						Name: "installed-plugin-2",
-						Commands: []configv3.PluginCommand{
-							{
-								Name:  "unique-command-2",
-								Alias: "uc2",
-							},
-							{
-								Name:  "unique-command-3",
-								Alias: "uc3",
-							},
-						},
-					},
-				})
 			})
 
 			It("returns the plugin and no errors", func() {
@@ -511,20 +242,6 @@ var _ = Describe("install actions", func() {
 
 				Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(1))
 				Expect(fakePluginMetadata.GetMetadataArgsForCall(0)).To(Equal("some-plugin-path"))
-
-				Expect(fakeCommandList.HasCommandCallCount()).To(Equal(4))
-				Expect(fakeCommandList.HasCommandArgsForCall(0)).To(Equal("some-command"))
-				Expect(fakeCommandList.HasCommandArgsForCall(1)).To(Equal("sc"))
-				Expect(fakeCommandList.HasCommandArgsForCall(2)).To(Equal("some-other-command"))
-				Expect(fakeCommandList.HasCommandArgsForCall(3)).To(Equal("soc"))
-
-				Expect(fakeCommandList.HasAliasCallCount()).To(Equal(4))
-				Expect(fakeCommandList.HasAliasArgsForCall(0)).To(Equal("some-command"))
-				Expect(fakeCommandList.HasAliasArgsForCall(1)).To(Equal("sc"))
-				Expect(fakeCommandList.HasAliasArgsForCall(2)).To(Equal("some-other-command"))
-				Expect(fakeCommandList.HasAliasArgsForCall(3)).To(Equal("soc"))
-
-				Expect(fakeConfig.PluginsCallCount()).To(Equal(1))
 			})
 		})
 	})
@@ -43,6 +43,16 @@ type FakeConfig struct {
 	pluginHomeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PluginPrecedenceStub        func() []string
+	pluginPrecedenceMutex       sync.RWMutex
+	pluginPrecedenceArgsForCall []struct {
+	}
+	pluginPrecedenceReturns struct {
+		result1 []string
+	}
+	pluginPrecedenceReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	PluginRepositoriesStub        func() []configv3.PluginRepository
 	pluginRepositoriesMutex       sync.RWMutex
 	pluginRepositoriesArgsForCall []struct {
@@ -260,6 +270,58 @@ func (fake *FakeConfig) PluginHomeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginPrecedence() []string {
+	fake.pluginPrecedenceMutex.Lock()
+	ret, specificReturn := fake.pluginPrecedenceReturnsOnCall[len(fake.pluginPrecedenceArgsForCall)]
+	fake.pluginPrecedenceArgsForCall = append(fake.pluginPrecedenceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PluginPrecedence", []interface{}{})
+	fake.pluginPrecedenceMutex.Unlock()
+	if fake.PluginPrecedenceStub != nil {
+		return fake.PluginPrecedenceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pluginPrecedenceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginPrecedenceCallCount() int {
+	fake.pluginPrecedenceMutex.RLock()
+	defer fake.pluginPrecedenceMutex.RUnlock()
+	return len(fake.pluginPrecedenceArgsForCall)
+}
+
+func (fake *FakeConfig) PluginPrecedenceCalls(stub func() []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = stub
+}
+
+func (fake *FakeConfig) PluginPrecedenceReturns(result1 []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = nil
+	fake.pluginPrecedenceReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginPrecedenceReturnsOnCall(i int, result1 []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = nil
+	if fake.pluginPrecedenceReturnsOnCall == nil {
+		fake.pluginPrecedenceReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.pluginPrecedenceReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginRepositories() []configv3.PluginRepository {
 	fake.pluginRepositoriesMutex.Lock()
 	ret, specificReturn := fake.pluginRepositoriesReturnsOnCall[len(fake.pluginRepositoriesArgsForCall)]
@@ -458,6 +520,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
+	fake.pluginPrecedenceMutex.RLock()
+	defer fake.pluginPrecedenceMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
 	fake.pluginsMutex.RLock()
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -28,6 +29,11 @@ type ApplicationConfig struct {
 	Archive            bool
 	Path               string
 	DropletPath        string
+
+	// ScanHook, when set, must approve the package or droplet before it is
+	// uploaded. The manifest at ManifestPath is passed to it along with it.
+	ScanHook     string
+	ManifestPath string
 }
 
 func (config ApplicationConfig) CreatingApplication() bool {
@@ -50,9 +56,13 @@ func (config ApplicationConfig) HasMultipleBuildpacks() bool {
 // The V2 Actor is primarily used to determine all but multiple buildpack
 // information. Only then is the V3 Actor used to gather the multiple
 // buildpacks.
+//
+// Routes that are in use in other spaces are collected across all the apps
+// and returned together as a RouteConflictsError.
 func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
+	var routeConflicts []actionerror.RouteConflict
 
 	log.Infof("iterating through %d app configuration(s)", len(apps))
 	for _, app := range apps {
@@ -116,7 +126,13 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 			var routeWarnings Warnings
 			config, routeWarnings, err = actor.configureRoutes(app, orgGUID, spaceGUID, config)
 			warnings = append(warnings, routeWarnings...)
-			if err != nil {
+			if conflictsErr, ok := err.(actionerror.RouteConflictsError); ok {
+				for _, conflict := range conflictsErr.Conflicts {
+					conflict.AppName = app.Name
+					routeConflicts = append(routeConflicts, conflict)
+				}
+				continue
+			} else if err != nil {
 				log.Errorln("determining routes:", err)
 				return nil, warnings, err
 			}
@@ -133,6 +149,11 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		configs = append(configs, config)
 	}
 
+	if len(routeConflicts) > 0 {
+		log.Errorln("routes in other spaces:", routeConflicts)
+		return nil, warnings, actionerror.RouteConflictsError{Conflicts: routeConflicts}
+	}
+
 	return configs, warnings, nil
 }
 
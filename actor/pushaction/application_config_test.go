@@ -920,6 +920,32 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 
+			When("routes of several apps are in other spaces", func() {
+				BeforeEach(func() {
+					manifestApps = append(manifestApps, manifest.Application{
+						Name:   "some-other-app",
+						Path:   filesPath,
+						Routes: []string{"route-3.private-domain.com"},
+					})
+
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{})
+					fakeV2Actor.GetDomainsByNameAndOrganizationReturns([]v2action.Domain{domain}, nil, nil)
+					fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, actionerror.RouteNotFoundError{})
+					fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturnsOnCall(1, v2action.Route{}, nil, actionerror.RouteInDifferentSpaceError{Route: "route-2.private-domain.com"})
+					fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturnsOnCall(2, v2action.Route{}, nil, actionerror.RouteInDifferentSpaceError{Route: "route-3.private-domain.com"})
+					fakeV2Actor.CheckRouteReturns(true, v2action.Warnings{"check-route-warning"}, nil)
+				})
+
+				It("returns the conflicts of all the apps", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{
+						{AppName: appName, Route: "route-2.private-domain.com"},
+						{AppName: "some-other-app", Route: "route-3.private-domain.com"},
+					}}))
+					Expect(configs).To(BeNil())
+					Expect(warnings).To(ContainElement("check-route-warning"))
+				})
+			})
+
 			When("retrieving the routes fails", func() {
 				var expectedErr error
 				BeforeEach(func() {
@@ -100,6 +100,12 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 		}
 
 		if config.DropletPath != "" {
+			err = actor.scanPackage(config, config.DropletPath, eventStream)
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
 			for count := 0; count < PushRetries; count++ {
 				warnings, err = actor.UploadDroplet(config, config.DropletPath, progressBar, eventStream)
 				warningsStream <- warnings
@@ -134,6 +140,12 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 				eventStream <- CreatingArchive
 				defer os.RemoveAll(archivePath)
 
+				err = actor.scanPackage(config, archivePath, eventStream)
+				if err != nil {
+					errorStream <- err
+					return
+				}
+
 				for count := 0; count < PushRetries; count++ {
 					warnings, err = actor.UploadPackageWithArchive(config, archivePath, progressBar, eventStream)
 					warningsStream <- warnings
@@ -173,3 +185,15 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 
 	return configStream, eventStream, warningsStream, errorStream
 }
+
+// scanPackage has the configured scan hook approve the package or droplet at
+// packagePath before it is uploaded.
+func (actor Actor) scanPackage(config ApplicationConfig, packagePath string, eventStream chan<- Event) error {
+	if config.ScanHook == "" {
+		return nil
+	}
+
+	eventStream <- ScanningPackage
+	log.WithField("hook", config.ScanHook).Info("scanning package")
+	return actor.SharedActor.ScanPackage(config.ScanHook, config.DesiredApplication.Name, packagePath, config.ManifestPath)
+}
@@ -565,6 +565,40 @@ var _ = Describe("Apply", func() {
 				Expect(os.RemoveAll(dropletPath)).ToNot(HaveOccurred())
 			})
 
+			When("a scan hook is configured", func() {
+				BeforeEach(func() {
+					config.ScanHook = "some-scan-hook"
+					config.ManifestPath = "some-manifest-path"
+					fakeV2Actor.CreateApplicationReturns(v2action.Application{Name: "some-app-name"}, nil, nil)
+					fakeV2Actor.UploadDropletReturns(v2action.Job{}, nil, nil)
+				})
+
+				It("scans the droplet before uploading it", func() {
+					Eventually(nextEvent).Should(Equal(ScanningPackage))
+					Expect(nextEvent()).To(Equal(UploadingDroplet))
+
+					Expect(fakeSharedActor.ScanPackageCallCount()).To(Equal(1))
+					hook, appName, packagePath, manifestPath := fakeSharedActor.ScanPackageArgsForCall(0)
+					Expect(hook).To(Equal("some-scan-hook"))
+					Expect(appName).To(Equal("some-app-name"))
+					Expect(packagePath).To(Equal(dropletPath))
+					Expect(manifestPath).To(Equal("some-manifest-path"))
+				})
+
+				When("the scan rejects the droplet", func() {
+					BeforeEach(func() {
+						fakeSharedActor.ScanPackageReturns(actionerror.PackageScanRejectedError{AppName: "some-app-name"})
+					})
+
+					It("returns the error without uploading the droplet", func() {
+						Eventually(nextEvent).Should(Equal(ScanningPackage))
+						Eventually(errorStream).Should(Receive(MatchError(actionerror.PackageScanRejectedError{AppName: "some-app-name"})))
+						Consistently(nextEvent).ShouldNot(EqualEither(UploadingDroplet, Complete))
+						Expect(fakeV2Actor.UploadDropletCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("uploading the droplet fails", func() {
 				When("the error is a retryable error", func() {
 					var someErr error
@@ -649,6 +683,36 @@ var _ = Describe("Apply", func() {
 						Eventually(nextEvent).Should(Equal(CreatingArchive))
 					})
 
+					When("a scan hook is configured", func() {
+						BeforeEach(func() {
+							config.ScanHook = "some-scan-hook"
+							fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, nil, nil)
+						})
+
+						It("scans the archive before uploading it", func() {
+							Eventually(nextEvent).Should(Equal(CreatingArchive))
+							Expect(nextEvent()).To(Equal(ScanningPackage))
+							Expect(nextEvent()).To(Equal(UploadingApplicationWithArchive))
+
+							Expect(fakeSharedActor.ScanPackageCallCount()).To(Equal(1))
+							_, _, packagePath, _ := fakeSharedActor.ScanPackageArgsForCall(0)
+							Expect(packagePath).To(Equal(archivePath))
+						})
+
+						When("the scan rejects the archive", func() {
+							BeforeEach(func() {
+								fakeSharedActor.ScanPackageReturns(actionerror.PackageScanRejectedError{AppName: "some-app-name"})
+							})
+
+							It("returns the error without uploading the archive", func() {
+								Eventually(nextEvent).Should(Equal(ScanningPackage))
+								Eventually(errorStream).Should(Receive(MatchError(actionerror.PackageScanRejectedError{AppName: "some-app-name"})))
+								Consistently(nextEvent).ShouldNot(EqualEither(UploadingApplicationWithArchive, Complete))
+								Expect(fakeV2Actor.UploadApplicationPackageCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					When("the upload is successful", func() {
 						BeforeEach(func() {
 							fakeV2Actor.UploadApplicationPackageReturns(v2action.Job{}, v2action.Warnings{"upload-warnings-1", "upload-warnings-2"}, nil)
@@ -15,6 +15,7 @@ const (
 	ReadingArchive                  Event = "reading archive"
 	ResourceMatching                Event = "resource matching"
 	RetryUpload                     Event = "retry upload"
+	ScanningPackage                 Event = "scanning package"
 	SettingDroplet                  Event = "setting droplet"
 	SetDropletComplete              Event = "set droplet complete"
 	SettingUpApplication            Event = "setting up application"
@@ -0,0 +1,105 @@
+package pushaction
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	SpringBootActuatorFramework = "Spring Boot Actuator"
+	RailsFramework              = "Rails"
+	NodeFramework               = "Node.js"
+)
+
+var railsGemRegexp = regexp.MustCompile(`(?m)^\s*gem\s+['"]rails['"]`)
+
+// DetectedHealthCheck is the HTTP health check endpoint conventionally
+// served by the framework an application is built with.
+type DetectedHealthCheck struct {
+	Framework string
+	Endpoint  string
+}
+
+// DetectHealthCheck inspects the application directory or archive at appPath
+// for a framework with a conventional health endpoint. Spring Boot apps
+// including the Actuator serve /actuator/health, Rails apps serve /up and
+// Node.js apps are expected to serve /health.
+func (Actor) DetectHealthCheck(appPath string) (DetectedHealthCheck, bool) {
+	info, err := os.Stat(appPath)
+	if err != nil {
+		log.WithField("path", appPath).Debugln("unable to detect health check:", err)
+		return DetectedHealthCheck{}, false
+	}
+
+	if !info.IsDir() {
+		if archiveHasActuator(appPath) {
+			return DetectedHealthCheck{Framework: SpringBootActuatorFramework, Endpoint: "/actuator/health"}, true
+		}
+		return DetectedHealthCheck{}, false
+	}
+
+	switch {
+	case directoryHasActuator(appPath):
+		return DetectedHealthCheck{Framework: SpringBootActuatorFramework, Endpoint: "/actuator/health"}, true
+	case fileMatches(filepath.Join(appPath, "Gemfile"), railsGemRegexp.MatchString):
+		return DetectedHealthCheck{Framework: RailsFramework, Endpoint: "/up"}, true
+	case fileExists(filepath.Join(appPath, "package.json")):
+		return DetectedHealthCheck{Framework: NodeFramework, Endpoint: "/health"}, true
+	}
+
+	return DetectedHealthCheck{}, false
+}
+
+func isActuatorLibrary(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), "spring-boot-actuator-") && strings.HasSuffix(path, ".jar")
+}
+
+func archiveHasActuator(archivePath string) bool {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return false
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		if strings.HasPrefix(file.Name, "BOOT-INF/lib/") && isActuatorLibrary(file.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func directoryHasActuator(dir string) bool {
+	libraries, _ := filepath.Glob(filepath.Join(dir, "BOOT-INF", "lib", "spring-boot-actuator-*.jar"))
+	if len(libraries) > 0 {
+		return true
+	}
+
+	for _, buildFile := range []string{"pom.xml", "build.gradle", "build.gradle.kts"} {
+		if fileMatches(filepath.Join(dir, buildFile), func(contents string) bool {
+			return strings.Contains(contents, "spring-boot-starter-actuator")
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func fileMatches(path string, match func(string) bool) bool {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return match(string(contents))
+}
@@ -0,0 +1,130 @@
+package pushaction_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Health Check Detection", func() {
+	var (
+		actor  *Actor
+		appDir string
+
+		appPath     string
+		healthCheck DetectedHealthCheck
+		detected    bool
+	)
+
+	writeFile := func(name string, contents string) {
+		path := filepath.Join(appDir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		actor, _, _, _ = getTestPushActor()
+
+		var err error
+		appDir, err = ioutil.TempDir("", "health-check-detection")
+		Expect(err).ToNot(HaveOccurred())
+		appPath = appDir
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(appDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		healthCheck, detected = actor.DetectHealthCheck(appPath)
+	})
+
+	When("the app declares the Spring Boot Actuator in its build file", func() {
+		BeforeEach(func() {
+			writeFile("pom.xml", "<artifactId>spring-boot-starter-actuator</artifactId>")
+			writeFile("package.json", "{}")
+		})
+
+		It("detects the actuator health endpoint", func() {
+			Expect(detected).To(BeTrue())
+			Expect(healthCheck).To(Equal(DetectedHealthCheck{Framework: SpringBootActuatorFramework, Endpoint: "/actuator/health"}))
+		})
+	})
+
+	When("the app is an exploded Spring Boot jar including the actuator", func() {
+		BeforeEach(func() {
+			writeFile("BOOT-INF/lib/spring-boot-actuator-2.1.0.jar", "")
+		})
+
+		It("detects the actuator health endpoint", func() {
+			Expect(detected).To(BeTrue())
+			Expect(healthCheck.Endpoint).To(Equal("/actuator/health"))
+		})
+	})
+
+	When("the app is a Spring Boot jar including the actuator", func() {
+		BeforeEach(func() {
+			appPath = filepath.Join(appDir, "app.jar")
+			jarFile, err := os.Create(appPath)
+			Expect(err).ToNot(HaveOccurred())
+			writer := zip.NewWriter(jarFile)
+			_, err = writer.Create("BOOT-INF/lib/spring-boot-actuator-2.1.0.jar")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writer.Close()).To(Succeed())
+			Expect(jarFile.Close()).To(Succeed())
+		})
+
+		It("detects the actuator health endpoint", func() {
+			Expect(detected).To(BeTrue())
+			Expect(healthCheck.Framework).To(Equal(SpringBootActuatorFramework))
+		})
+	})
+
+	When("the app is a Rails app", func() {
+		BeforeEach(func() {
+			writeFile("Gemfile", "source 'https://rubygems.org'\ngem \"rails\", \"~> 7.1\"\n")
+		})
+
+		It("detects the Rails health endpoint", func() {
+			Expect(detected).To(BeTrue())
+			Expect(healthCheck).To(Equal(DetectedHealthCheck{Framework: RailsFramework, Endpoint: "/up"}))
+		})
+	})
+
+	When("the app is a Node.js app", func() {
+		BeforeEach(func() {
+			writeFile("package.json", "{}")
+		})
+
+		It("suggests the /health endpoint", func() {
+			Expect(detected).To(BeTrue())
+			Expect(healthCheck).To(Equal(DetectedHealthCheck{Framework: NodeFramework, Endpoint: "/health"}))
+		})
+	})
+
+	When("the app uses no known framework", func() {
+		BeforeEach(func() {
+			writeFile("Gemfile", "gem 'sinatra'\n")
+		})
+
+		It("detects nothing", func() {
+			Expect(detected).To(BeFalse())
+		})
+	})
+
+	When("the app path does not exist", func() {
+		BeforeEach(func() {
+			appPath = filepath.Join(appDir, "missing")
+		})
+
+		It("detects nothing", func() {
+			Expect(detected).To(BeFalse())
+		})
+	})
+})
@@ -51,6 +51,20 @@ type FakeSharedActor struct {
 		result2 int64
 		result3 error
 	}
+	ScanPackageStub        func(string, string, string, string) error
+	scanPackageMutex       sync.RWMutex
+	scanPackageArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	scanPackageReturns struct {
+		result1 error
+	}
+	scanPackageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ZipArchiveResourcesStub        func(string, []sharedaction.Resource) (string, error)
 	zipArchiveResourcesMutex       sync.RWMutex
 	zipArchiveResourcesArgsForCall []struct {
@@ -275,6 +289,69 @@ func (fake *FakeSharedActor) ReadArchiveReturnsOnCall(i int, result1 io.ReadClos
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSharedActor) ScanPackage(arg1 string, arg2 string, arg3 string, arg4 string) error {
+	fake.scanPackageMutex.Lock()
+	ret, specificReturn := fake.scanPackageReturnsOnCall[len(fake.scanPackageArgsForCall)]
+	fake.scanPackageArgsForCall = append(fake.scanPackageArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ScanPackage", []interface{}{arg1, arg2, arg3, arg4})
+	fake.scanPackageMutex.Unlock()
+	if fake.ScanPackageStub != nil {
+		return fake.ScanPackageStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scanPackageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedActor) ScanPackageCallCount() int {
+	fake.scanPackageMutex.RLock()
+	defer fake.scanPackageMutex.RUnlock()
+	return len(fake.scanPackageArgsForCall)
+}
+
+func (fake *FakeSharedActor) ScanPackageCalls(stub func(string, string, string, string) error) {
+	fake.scanPackageMutex.Lock()
+	defer fake.scanPackageMutex.Unlock()
+	fake.ScanPackageStub = stub
+}
+
+func (fake *FakeSharedActor) ScanPackageArgsForCall(i int) (string, string, string, string) {
+	fake.scanPackageMutex.RLock()
+	defer fake.scanPackageMutex.RUnlock()
+	argsForCall := fake.scanPackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSharedActor) ScanPackageReturns(result1 error) {
+	fake.scanPackageMutex.Lock()
+	defer fake.scanPackageMutex.Unlock()
+	fake.ScanPackageStub = nil
+	fake.scanPackageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedActor) ScanPackageReturnsOnCall(i int, result1 error) {
+	fake.scanPackageMutex.Lock()
+	defer fake.scanPackageMutex.Unlock()
+	fake.ScanPackageStub = nil
+	if fake.scanPackageReturnsOnCall == nil {
+		fake.scanPackageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scanPackageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedActor) ZipArchiveResources(arg1 string, arg2 []sharedaction.Resource) (string, error) {
 	var arg2Copy []sharedaction.Resource
 	if arg2 != nil {
@@ -422,6 +499,8 @@ func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	defer fake.gatherDirectoryResourcesMutex.RUnlock()
 	fake.readArchiveMutex.RLock()
 	defer fake.readArchiveMutex.RUnlock()
+	fake.scanPackageMutex.RLock()
+	defer fake.scanPackageMutex.RUnlock()
 	fake.zipArchiveResourcesMutex.RLock()
 	defer fake.zipArchiveResourcesMutex.RUnlock()
 	fake.zipDirectoryResourcesMutex.RLock()
@@ -24,6 +24,21 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	CheckRouteStub        func(v2action.Route) (bool, v2action.Warnings, error)
+	checkRouteMutex       sync.RWMutex
+	checkRouteArgsForCall []struct {
+		arg1 v2action.Route
+	}
+	checkRouteReturns struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
+	checkRouteReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct {
@@ -379,6 +394,72 @@ func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) CheckRoute(arg1 v2action.Route) (bool, v2action.Warnings, error) {
+	fake.checkRouteMutex.Lock()
+	ret, specificReturn := fake.checkRouteReturnsOnCall[len(fake.checkRouteArgsForCall)]
+	fake.checkRouteArgsForCall = append(fake.checkRouteArgsForCall, struct {
+		arg1 v2action.Route
+	}{arg1})
+	fake.recordInvocation("CheckRoute", []interface{}{arg1})
+	fake.checkRouteMutex.Unlock()
+	if fake.CheckRouteStub != nil {
+		return fake.CheckRouteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV2Actor) CheckRouteCallCount() int {
+	fake.checkRouteMutex.RLock()
+	defer fake.checkRouteMutex.RUnlock()
+	return len(fake.checkRouteArgsForCall)
+}
+
+func (fake *FakeV2Actor) CheckRouteCalls(stub func(v2action.Route) (bool, v2action.Warnings, error)) {
+	fake.checkRouteMutex.Lock()
+	defer fake.checkRouteMutex.Unlock()
+	fake.CheckRouteStub = stub
+}
+
+func (fake *FakeV2Actor) CheckRouteArgsForCall(i int) v2action.Route {
+	fake.checkRouteMutex.RLock()
+	defer fake.checkRouteMutex.RUnlock()
+	argsForCall := fake.checkRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV2Actor) CheckRouteReturns(result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.checkRouteMutex.Lock()
+	defer fake.checkRouteMutex.Unlock()
+	fake.CheckRouteStub = nil
+	fake.checkRouteReturns = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CheckRouteReturnsOnCall(i int, result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.checkRouteMutex.Lock()
+	defer fake.checkRouteMutex.Unlock()
+	fake.CheckRouteStub = nil
+	if fake.checkRouteReturnsOnCall == nil {
+		fake.checkRouteReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.checkRouteReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -1644,6 +1725,8 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
 	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	fake.checkRouteMutex.RLock()
+	defer fake.checkRouteMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
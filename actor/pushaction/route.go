@@ -13,6 +13,14 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// routeAlternatives is the number of unused routes suggested for a route
+	// that is taken by another space.
+	routeAlternatives = 2
+
+	routeAlternativeAttempts = 5
+)
+
 func (actor Actor) MapRoutes(config ApplicationConfig) (ApplicationConfig, bool, Warnings, error) {
 	log.Info("mapping routes")
 
@@ -76,6 +84,7 @@ func (actor Actor) CalculateRoutes(routes []string, orgGUID string, spaceGUID st
 		nameToFoundDomain[foundDomain.Name] = foundDomain
 	}
 
+	var conflicts []actionerror.RouteConflict
 	for _, route := range unknownRoutes {
 		log.WithField("route", route).Debug("generating route")
 
@@ -109,7 +118,12 @@ func (actor Actor) CalculateRoutes(routes []string, orgGUID string, spaceGUID st
 
 		calculatedRoute, routeWarnings, routeErr := actor.findOrReturnPartialRouteWithSettings(potentialRoute)
 		allWarnings = append(allWarnings, routeWarnings...)
-		if routeErr != nil {
+		if _, ok := routeErr.(actionerror.RouteInDifferentSpaceError); ok {
+			conflict, conflictWarnings := actor.routeConflict(potentialRoute)
+			allWarnings = append(allWarnings, conflictWarnings...)
+			conflicts = append(conflicts, conflict)
+			continue
+		} else if routeErr != nil {
 			log.Errorln("route lookup:", routeErr)
 			return nil, allWarnings, routeErr
 		}
@@ -117,6 +131,10 @@ func (actor Actor) CalculateRoutes(routes []string, orgGUID string, spaceGUID st
 		calculatedRoutes = append(calculatedRoutes, calculatedRoute)
 	}
 
+	if len(conflicts) > 0 {
+		return nil, allWarnings, actionerror.RouteConflictsError{Conflicts: conflicts}
+	}
+
 	return calculatedRoutes, allWarnings, nil
 }
 
@@ -244,10 +262,16 @@ func (actor Actor) GetGeneratedRoute(manifestApp manifest.Application, orgGUID s
 	cachedRoute, found := actor.routeInListBySettings(defaultRoute, knownRoutes)
 	if !found {
 		route, routeWarnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
-		if _, ok := err.(actionerror.RouteNotFoundError); ok {
-			return defaultRoute, append(warnings, routeWarnings...), nil
+		warnings = append(warnings, routeWarnings...)
+		switch err.(type) {
+		case actionerror.RouteNotFoundError:
+			return defaultRoute, warnings, nil
+		case actionerror.RouteInDifferentSpaceError:
+			conflict, conflictWarnings := actor.routeConflict(defaultRoute)
+			warnings = append(warnings, conflictWarnings...)
+			return v2action.Route{}, warnings, actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{conflict}}
 		}
-		return route, append(warnings, routeWarnings...), err
+		return route, warnings, err
 	}
 	return cachedRoute, warnings, nil
 }
@@ -345,6 +369,38 @@ func (actor Actor) findOrReturnPartialRouteWithSettings(route v2action.Route) (v
 	return cachedRoute, Warnings(warnings), err
 }
 
+// routeConflict describes a route that exists in another space, suggesting
+// alternatives with random words appended to the host that are not in use.
+func (actor Actor) routeConflict(route v2action.Route) (actionerror.RouteConflict, Warnings) {
+	conflict := actionerror.RouteConflict{Route: route.String()}
+	if !route.Domain.IsHTTP() || route.Host == "" {
+		return conflict, nil
+	}
+
+	var warnings Warnings
+	tried := map[string]bool{}
+	for attempt := 0; attempt < routeAlternativeAttempts && len(conflict.Alternatives) < routeAlternatives; attempt++ {
+		alternative := route
+		alternative.Host = fmt.Sprintf("%s-%s-%s", route.Host, actor.WordGenerator.RandomAdjective(), actor.WordGenerator.RandomNoun())
+		if tried[alternative.Host] {
+			continue
+		}
+		tried[alternative.Host] = true
+
+		exists, checkWarnings, err := actor.V2Actor.CheckRoute(alternative)
+		warnings = append(warnings, checkWarnings...)
+		if err != nil {
+			log.Errorln("checking alternative route:", err)
+			break
+		}
+		if !exists {
+			conflict.Alternatives = append(conflict.Alternatives, alternative.String())
+		}
+	}
+
+	return conflict, warnings
+}
+
 func (actor Actor) generatePossibleDomains(routes []string) ([]string, error) {
 	var hostnames []string
 	for _, route := range routes {
@@ -357,6 +357,56 @@ var _ = Describe("Routes", func() {
 					})
 				})
 
+				When("some of the routes are in other spaces", func() {
+					BeforeEach(func() {
+						routes = []string{"a.com", "c.b.a.com", "d.c.b.a.com"}
+						fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"find-route-warning"}, actionerror.RouteNotFoundError{})
+						fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturnsOnCall(1, v2action.Route{}, v2action.Warnings{"find-route-warning"}, actionerror.RouteInDifferentSpaceError{Route: "c.b.a.com"})
+						fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturnsOnCall(2, v2action.Route{}, v2action.Warnings{"find-route-warning"}, actionerror.RouteInDifferentSpaceError{Route: "d.c.b.a.com"})
+
+						fakeRandomWordGenerator.RandomAdjectiveReturns("striped")
+						fakeRandomWordGenerator.RandomNounReturns("apple")
+						fakeRandomWordGenerator.RandomNounReturnsOnCall(1, "banana")
+						fakeRandomWordGenerator.RandomNounReturnsOnCall(2, "cherry")
+						fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"check-route-warning"}, nil)
+						fakeV2Actor.CheckRouteReturnsOnCall(0, true, v2action.Warnings{"check-route-warning"}, nil)
+					})
+
+					It("returns all the conflicts with unused alternatives and warnings", func() {
+						Expect(executeErr).To(MatchError(actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{
+							{Route: "c.b.a.com", Alternatives: []string{"c-striped-banana.b.a.com", "c-striped-cherry.b.a.com"}},
+							{Route: "d.c.b.a.com", Alternatives: []string{"d.c-striped-apple.b.a.com"}},
+						}}))
+						Expect(calculatedRoutes).To(BeNil())
+						Expect(warnings).To(ConsistOf("domain-warnings-1", "domains-warnings-2",
+							"find-route-warning", "find-route-warning", "find-route-warning",
+							"check-route-warning", "check-route-warning", "check-route-warning", "check-route-warning"))
+
+						Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(4))
+						Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(v2action.Route{
+							Host: "c-striped-apple",
+							Domain: v2action.Domain{
+								GUID: "domain-guid-2",
+								Name: "b.a.com",
+							},
+							SpaceGUID: spaceGUID,
+						}))
+					})
+
+					When("checking an alternative fails", func() {
+						BeforeEach(func() {
+							fakeV2Actor.CheckRouteReturnsOnCall(0, false, v2action.Warnings{"check-route-warning"}, errors.New("check failed"))
+						})
+
+						It("returns the conflict without alternatives", func() {
+							Expect(executeErr).To(MatchError(actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{
+								{Route: "c.b.a.com"},
+								{Route: "d.c.b.a.com", Alternatives: []string{"d.c-striped-banana.b.a.com", "d.c-striped-cherry.b.a.com"}},
+							}}))
+						})
+					})
+				})
+
 				When("the route existance check fails", func() {
 					var expectedErr error
 
@@ -1400,6 +1450,23 @@ var _ = Describe("Routes", func() {
 							})
 						})
 
+						When("the route is in another space", func() {
+							BeforeEach(func() {
+								fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, actionerror.RouteInDifferentSpaceError{Route: "some-app.shared-domain.com"})
+								fakeRandomWordGenerator.RandomAdjectiveReturns("striped")
+								fakeRandomWordGenerator.RandomNounReturns("apple")
+								fakeRandomWordGenerator.RandomNounReturnsOnCall(1, "banana")
+								fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"check-route-warning"}, nil)
+							})
+
+							It("returns a conflict with alternatives and warnings", func() {
+								Expect(executeErr).To(MatchError(actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{
+									{Route: "some-app.shared-domain.com", Alternatives: []string{"some-app-striped-apple.shared-domain.com", "some-app-striped-banana.shared-domain.com"}},
+								}}))
+								Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings", "check-route-warning", "check-route-warning"))
+							})
+						})
+
 						When("retrieving the routes errors", func() {
 							var expectedErr error
 
@@ -13,6 +13,7 @@ type SharedActor interface {
 	GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error)
 	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
 	ReadArchive(archivePath string) (io.ReadCloser, int64, error)
+	ScanPackage(hook string, appName string, packagePath string, manifestPath string) error
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
 }
@@ -13,6 +13,7 @@ type V2Actor interface {
 	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	CheckRoute(route v2action.Route) (bool, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
 	FindRouteBoundToSpaceWithSettings(route v2action.Route) (v2action.Route, v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
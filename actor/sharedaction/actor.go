@@ -5,6 +5,10 @@ package sharedaction
 // Actor handles all shared actions
 type Actor struct {
 	Config Config
+
+	// FingerprintCacheDirectory is where file fingerprints are cached between
+	// pushes. Fingerprints are not cached when it is empty.
+	FingerprintCacheDirectory string
 }
 
 // NewActor returns an Actor with default settings
@@ -0,0 +1,190 @@
+package sharedaction
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/shellquote"
+)
+
+const (
+	appPathDirectory = "directory"
+	appPathFile      = "file"
+	appPathMissing   = "missing"
+
+	// appPathTypeCommand prints the type of the path given as its argument.
+	appPathTypeCommand = `if [ -d %[1]s ]; then echo directory; elif [ -e %[1]s ]; then echo file; else echo missing; fi`
+
+	// appDirectoryListCommand prints the type, size, modification time and
+	// name of every entry of the directory given as its argument.
+	appDirectoryListCommand = `find %s/ -mindepth 1 -maxdepth 1 -printf '%%y %%s %%T@ %%f\n'`
+)
+
+// AppFile is an entry of a directory in an app instance.
+type AppFile struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+	IsDir   bool
+}
+
+// AppFileListing is the result of browsing a path in an app instance. When
+// the path is a directory, Entries lists its contents sorted by name.
+// Otherwise IsFile is set and the file's contents were written out.
+type AppFileListing struct {
+	Path    string
+	IsFile  bool
+	Entries []AppFile
+}
+
+// BrowseAppFiles connects to an app instance over SSH and lists the
+// directory at path, or writes the contents of the file at path to
+// fileContents. Relative paths are relative to the home directory of the
+// instance, /home/vcap.
+func (actor Actor) BrowseAppFiles(sshClient SecureShellClient, sshOptions SSHOptions, path string, fileContents io.Writer) (AppFileListing, error) {
+	if path == "" {
+		path = "."
+	}
+
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return AppFileListing{}, err
+	}
+	defer sshClient.Close()
+
+	listing := AppFileListing{Path: path}
+
+	pathType, err := appPathType(sshClient, path)
+	if err != nil {
+		return AppFileListing{}, err
+	}
+
+	switch pathType {
+	case appPathMissing:
+		return AppFileListing{}, actionerror.AppFileNotFoundError{Path: path}
+	case appPathFile:
+		listing.IsFile = true
+		err = streamAppFile(sshClient, path, fileContents)
+		return listing, err
+	}
+
+	stdout, stderr, err := sshClient.RunCommand(fmt.Sprintf(appDirectoryListCommand, shellquote.Quote(strings.TrimSuffix(path, "/"))))
+	if err != nil {
+		return AppFileListing{}, remoteCommandError(err, stderr)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(stdout)), "\n") {
+		if line == "" {
+			continue
+		}
+		entry, parseErr := parseAppFile(line)
+		if parseErr != nil {
+			return AppFileListing{}, parseErr
+		}
+		listing.Entries = append(listing.Entries, entry)
+	}
+
+	sort.Slice(listing.Entries, func(i int, j int) bool {
+		return listing.Entries[i].Name < listing.Entries[j].Name
+	})
+
+	return listing, nil
+}
+
+// CatAppFile connects to an app instance over SSH and writes the contents of
+// the file at path to out.
+func (actor Actor) CatAppFile(sshClient SecureShellClient, sshOptions SSHOptions, path string, out io.Writer) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	pathType, err := appPathType(sshClient, path)
+	if err != nil {
+		return err
+	}
+
+	switch pathType {
+	case appPathMissing:
+		return actionerror.AppFileNotFoundError{Path: path}
+	case appPathDirectory:
+		return actionerror.AppPathIsDirectoryError{Path: path}
+	}
+
+	return streamAppFile(sshClient, path, out)
+}
+
+func appPathType(sshClient SecureShellClient, path string) (string, error) {
+	stdout, stderr, err := sshClient.RunCommand(fmt.Sprintf(appPathTypeCommand, shellquote.Quote(path)))
+	if err != nil {
+		return "", remoteCommandError(err, stderr)
+	}
+	return strings.TrimSpace(string(stdout)), nil
+}
+
+func streamAppFile(sshClient SecureShellClient, path string, out io.Writer) error {
+	stderr, err := sshClient.StreamCommand("cat "+shellquote.Quote(path), out)
+	if err != nil {
+		return remoteCommandError(err, stderr)
+	}
+	return nil
+}
+
+// parseAppFile parses a line printed by appDirectoryListCommand.
+func parseAppFile(line string) (AppFile, error) {
+	fields := strings.SplitN(line, " ", 4)
+	if len(fields) != 4 {
+		return AppFile{}, fmt.Errorf("unexpected directory listing line: %q", line)
+	}
+
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return AppFile{}, err
+	}
+
+	modTime, err := parseFindTimestamp(fields[2])
+	if err != nil {
+		return AppFile{}, err
+	}
+
+	return AppFile{
+		Name:    fields[3],
+		Size:    size,
+		ModTime: modTime,
+		IsDir:   fields[0] == "d",
+	}, nil
+}
+
+// parseFindTimestamp parses a %T@ timestamp, seconds since the epoch with a
+// fractional part, without losing precision to floating point.
+func parseFindTimestamp(timestamp string) (time.Time, error) {
+	parts := strings.SplitN(timestamp, ".", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var nanoseconds int64
+	if len(parts) == 2 {
+		fraction := (parts[1] + "000000000")[:9]
+		nanoseconds, err = strconv.ParseInt(fraction, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return time.Unix(seconds, nanoseconds), nil
+}
+
+func remoteCommandError(err error, stderr []byte) error {
+	if message := strings.TrimSpace(string(stderr)); message != "" {
+		return fmt.Errorf("%s: %s", err, message)
+	}
+	return err
+}
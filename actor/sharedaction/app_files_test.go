@@ -0,0 +1,211 @@
+package sharedaction_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Files Actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+		sshOptions            SSHOptions
+
+		pathType string
+		listing  string
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		sshOptions = SSHOptions{
+			Username:           "some-user",
+			Passcode:           "some-passcode",
+			Endpoint:           "some-endpoint",
+			HostKeyFingerprint: "some-fingerprint",
+		}
+
+		pathType = "directory"
+		listing = ""
+		fakeSecureShellClient.RunCommandStub = func(command string) ([]byte, []byte, error) {
+			if strings.HasPrefix(command, "find ") {
+				return []byte(listing), nil, nil
+			}
+			return []byte(pathType + "\n"), nil, nil
+		}
+		fakeSecureShellClient.StreamCommandStub = func(command string, stdout io.Writer) ([]byte, error) {
+			_, err := stdout.Write([]byte("some-contents"))
+			return nil, err
+		}
+	})
+
+	Describe("BrowseAppFiles", func() {
+		var (
+			path     string
+			contents *bytes.Buffer
+
+			result     AppFileListing
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			path = "app"
+			contents = new(bytes.Buffer)
+		})
+
+		JustBeforeEach(func() {
+			result, executeErr = actor.BrowseAppFiles(fakeSecureShellClient, sshOptions, path, contents)
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("connect error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("connect error"))
+				Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the path is a directory", func() {
+			BeforeEach(func() {
+				listing = "f 220 1571234567.5000000000 .bash_logout\nd 4096 1571234000.0000000000 app dir\n"
+			})
+
+			It("lists the entries sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				username, passcode, endpoint, fingerprint, skipHostValidation := fakeSecureShellClient.ConnectArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(passcode).To(Equal("some-passcode"))
+				Expect(endpoint).To(Equal("some-endpoint"))
+				Expect(fingerprint).To(Equal("some-fingerprint"))
+				Expect(skipHostValidation).To(BeFalse())
+
+				Expect(fakeSecureShellClient.RunCommandArgsForCall(0)).To(ContainSubstring("[ -d 'app' ]"))
+				Expect(fakeSecureShellClient.RunCommandArgsForCall(1)).To(HavePrefix("find 'app'/ -mindepth 1 -maxdepth 1"))
+
+				Expect(result).To(Equal(AppFileListing{
+					Path: "app",
+					Entries: []AppFile{
+						{Name: ".bash_logout", Size: 220, ModTime: time.Unix(1571234567, 500000000)},
+						{Name: "app dir", Size: 4096, ModTime: time.Unix(1571234000, 0), IsDir: true},
+					},
+				}))
+				Expect(contents.Len()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("no path is given", func() {
+			BeforeEach(func() {
+				path = ""
+			})
+
+			It("lists the home directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.Path).To(Equal("."))
+				Expect(fakeSecureShellClient.RunCommandArgsForCall(1)).To(HavePrefix("find '.'/ "))
+			})
+		})
+
+		When("the path is a file", func() {
+			BeforeEach(func() {
+				pathType = "file"
+			})
+
+			It("writes the contents of the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result).To(Equal(AppFileListing{Path: "app", IsFile: true}))
+				Expect(contents.String()).To(Equal("some-contents"))
+
+				command, _ := fakeSecureShellClient.StreamCommandArgsForCall(0)
+				Expect(command).To(Equal("cat 'app'"))
+			})
+		})
+
+		When("the path does not exist", func() {
+			BeforeEach(func() {
+				pathType = "missing"
+			})
+
+			It("returns an AppFileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppFileNotFoundError{Path: "app"}))
+			})
+		})
+
+		When("listing the directory fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandStub = func(command string) ([]byte, []byte, error) {
+					if strings.HasPrefix(command, "find ") {
+						return nil, []byte("Permission denied\n"), errors.New("exit status 1")
+					}
+					return []byte("directory\n"), nil, nil
+				}
+			})
+
+			It("returns the error along with the remote output", func() {
+				Expect(executeErr).To(MatchError("exit status 1: Permission denied"))
+			})
+		})
+	})
+
+	Describe("CatAppFile", func() {
+		var (
+			path       string
+			out        *bytes.Buffer
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			path = "logs/it's.log"
+			out = new(bytes.Buffer)
+			pathType = "file"
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.CatAppFile(fakeSecureShellClient, sshOptions, path, out)
+		})
+
+		It("writes the contents of the file, quoting the path", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(out.String()).To(Equal("some-contents"))
+
+			command, _ := fakeSecureShellClient.StreamCommandArgsForCall(0)
+			Expect(command).To(Equal(`cat 'logs/it'"'"'s.log'`))
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the path is a directory", func() {
+			BeforeEach(func() {
+				pathType = "directory"
+			})
+
+			It("returns an AppPathIsDirectoryError", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppPathIsDirectoryError{Path: path}))
+				Expect(fakeSecureShellClient.StreamCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the path does not exist", func() {
+			BeforeEach(func() {
+				pathType = "missing"
+			})
+
+			It("returns an AppFileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.AppFileNotFoundError{Path: path}))
+			})
+		})
+	})
+})
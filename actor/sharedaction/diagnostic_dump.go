@@ -0,0 +1,199 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+)
+
+// DiagnosticDumpType is the kind of diagnostic dump taken of an app
+// instance.
+type DiagnosticDumpType string
+
+const (
+	// DiagnosticDumpDefault selects the dump type from the buildpack that
+	// staged the app.
+	DiagnosticDumpDefault DiagnosticDumpType = ""
+	JVMHeapDump           DiagnosticDumpType = "jvm-heap"
+	GoroutineDump         DiagnosticDumpType = "goroutine"
+	CoreDump              DiagnosticDumpType = "core"
+)
+
+const (
+	diagnosticDumpRemotePath = "/home/vcap/tmp/cf-diagnostic-dump"
+	stagingInfoCommand       = "cat /home/vcap/staging_info.yml"
+)
+
+// diagnosticDumpCommands create a dump at the path given as their first
+// argument. Tool output goes to stderr so that it can be reported when the
+// dump fails.
+var diagnosticDumpCommands = map[DiagnosticDumpType]string{
+	JVMHeapDump: `PID=$(pidof java) && ` +
+		`TOOL=$(find /home/vcap -executable -name jcmd 2>/dev/null | head -1) && ` +
+		`if [ -n "$TOOL" ]; then "$TOOL" "$PID" GC.heap_dump %[1]s >&2; ` +
+		`else TOOL=$(find /home/vcap -executable -name jmap 2>/dev/null | head -1) && ` +
+		`[ -n "$TOOL" ] && "$TOOL" -dump:format=b,file=%[1]s "$PID" >&2; fi`,
+	GoroutineDump: `curl -sSf -o %[1]s "http://localhost:${PORT}/debug/pprof/goroutine?debug=2"`,
+	CoreDump: `PID=$(ps -u vcap -o pid= --sort=-rss | head -1 | tr -d ' ') && ` +
+		`gcore -o %[1]s "$PID" >&2 && mv %[1]s."$PID" %[1]s`,
+}
+
+var diagnosticDumpExtensions = map[DiagnosticDumpType]string{
+	JVMHeapDump:   ".hprof",
+	GoroutineDump: ".txt",
+	CoreDump:      ".core",
+}
+
+// DiagnosticDump is a dump downloaded from an app instance.
+type DiagnosticDump struct {
+	Type DiagnosticDumpType
+	Path string
+	Size int64
+}
+
+// DiagnosticDumpOptions configure DownloadDiagnosticDump. Path is the local
+// file to write the dump to; when it is empty or a directory, the dump is
+// written there as BaseName-TYPE with an extension matching the dump type.
+// Dumps larger than MaxSize bytes are not downloaded.
+type DiagnosticDumpOptions struct {
+	Type     DiagnosticDumpType
+	Path     string
+	BaseName string
+	MaxSize  int64
+}
+
+// DownloadDiagnosticDump connects to an app instance over SSH, creates a
+// dump of the requested type in the container, downloads it and removes it
+// from the container again.
+func (actor Actor) DownloadDiagnosticDump(sshClient SecureShellClient, sshOptions SSHOptions, dumpOptions DiagnosticDumpOptions) (DiagnosticDump, error) {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return DiagnosticDump{}, err
+	}
+	defer sshClient.Close()
+
+	dump := DiagnosticDump{Type: dumpOptions.Type}
+	if dump.Type == DiagnosticDumpDefault {
+		dump.Type = defaultDiagnosticDumpType(sshClient)
+	}
+
+	remotePath := diagnosticDumpRemotePath + diagnosticDumpExtensions[dump.Type]
+	defer removeRemoteDump(sshClient, remotePath)
+
+	_, stderr, err := sshClient.RunCommand(fmt.Sprintf(diagnosticDumpCommands[dump.Type], remotePath))
+	if err != nil {
+		if _, ok := err.(*ssh.ExitError); ok {
+			return DiagnosticDump{}, actionerror.DiagnosticDumpFailedError{Type: string(dump.Type), Reason: strings.TrimSpace(string(stderr))}
+		}
+		return DiagnosticDump{}, err
+	}
+
+	stdout, _, err := sshClient.RunCommand("stat -c %s " + remotePath)
+	if err != nil {
+		return DiagnosticDump{}, err
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(string(stdout)), 10, 64)
+	if err != nil {
+		return DiagnosticDump{}, err
+	}
+	if size > dumpOptions.MaxSize {
+		return DiagnosticDump{}, actionerror.DiagnosticDumpTooLargeError{Type: string(dump.Type), Size: size, MaxSize: dumpOptions.MaxSize}
+	}
+
+	dump.Path = diagnosticDumpLocalPath(dumpOptions, dump.Type)
+	file, err := os.OpenFile(dump.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return DiagnosticDump{}, err
+	}
+
+	writer := &limitedWriter{writer: file, remaining: dumpOptions.MaxSize}
+	_, err = sshClient.StreamCommand("cat "+remotePath, writer)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dump.Path)
+		if writer.exceeded {
+			return DiagnosticDump{}, actionerror.DiagnosticDumpTooLargeError{Type: string(dump.Type), Size: dumpOptions.MaxSize + 1, MaxSize: dumpOptions.MaxSize}
+		}
+		return DiagnosticDump{}, err
+	}
+
+	dump.Size = dumpOptions.MaxSize - writer.remaining
+	return dump, nil
+}
+
+// defaultDiagnosticDumpType picks a heap dump for apps staged by the Java
+// buildpack, a goroutine dump for apps staged by the Go buildpack and a core
+// dump otherwise.
+func defaultDiagnosticDumpType(sshClient SecureShellClient) DiagnosticDumpType {
+	stdout, _, err := sshClient.RunCommand(stagingInfoCommand)
+	if err != nil {
+		log.Debugln("reading staging info:", err)
+		return CoreDump
+	}
+
+	var stagingInfo struct {
+		DetectedBuildpack string `json:"detected_buildpack"`
+	}
+	err = json.Unmarshal(stdout, &stagingInfo)
+	if err != nil {
+		log.Debugln("parsing staging info:", err)
+		return CoreDump
+	}
+
+	buildpack := strings.ToLower(stagingInfo.DetectedBuildpack)
+	switch {
+	case strings.Contains(buildpack, "java"):
+		return JVMHeapDump
+	case buildpack == "go" || strings.HasPrefix(buildpack, "go_") || strings.HasPrefix(buildpack, "go-"):
+		return GoroutineDump
+	default:
+		return CoreDump
+	}
+}
+
+func diagnosticDumpLocalPath(dumpOptions DiagnosticDumpOptions, dumpType DiagnosticDumpType) string {
+	fileName := fmt.Sprintf("%s-%s%s", dumpOptions.BaseName, dumpType, diagnosticDumpExtensions[dumpType])
+	if dumpOptions.Path == "" {
+		return fileName
+	}
+	if info, err := os.Stat(dumpOptions.Path); err == nil && info.IsDir() {
+		return filepath.Join(dumpOptions.Path, fileName)
+	}
+	return dumpOptions.Path
+}
+
+func removeRemoteDump(sshClient SecureShellClient, remotePath string) {
+	_, _, err := sshClient.RunCommand("rm -f " + remotePath)
+	if err != nil {
+		log.Debugln("removing remote dump:", err)
+	}
+}
+
+// limitedWriter fails writes once more than remaining bytes have been
+// written, guarding against dumps that grow after their size was checked.
+type limitedWriter struct {
+	writer    io.Writer
+	remaining int64
+	exceeded  bool
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > w.remaining {
+		w.exceeded = true
+		return 0, fmt.Errorf("dump exceeds %d bytes", w.remaining)
+	}
+	n, err := w.writer.Write(p)
+	w.remaining -= int64(n)
+	return n, err
+}
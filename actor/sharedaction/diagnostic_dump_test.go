@@ -0,0 +1,259 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("Diagnostic Dump Actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+	})
+
+	Describe("DownloadDiagnosticDump", func() {
+		var (
+			sshOptions  SSHOptions
+			dumpOptions DiagnosticDumpOptions
+			localDir    string
+
+			stagingInfo   string
+			dumpCommand   string
+			dumpErr       error
+			dumpSize      string
+			dumpContents  string
+			remoteCommand []string
+
+			dump       DiagnosticDump
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+			}
+
+			var err error
+			localDir, err = ioutil.TempDir("", "diagnostic-dump")
+			Expect(err).ToNot(HaveOccurred())
+
+			dumpOptions = DiagnosticDumpOptions{
+				Path:     localDir,
+				BaseName: "some-app-0",
+				MaxSize:  1024,
+			}
+
+			stagingInfo = `{"detected_buildpack":"java","start_command":"some-command"}`
+			dumpCommand = ""
+			dumpErr = nil
+			dumpSize = "12\n"
+			dumpContents = "some-dump-\n"
+			remoteCommand = nil
+
+			fakeSecureShellClient.RunCommandStub = func(command string) ([]byte, []byte, error) {
+				remoteCommand = append(remoteCommand, command)
+				switch {
+				case command == "cat /home/vcap/staging_info.yml":
+					return []byte(stagingInfo), nil, nil
+				case strings.HasPrefix(command, "stat "):
+					return []byte(dumpSize), nil, nil
+				case strings.HasPrefix(command, "rm "):
+					return nil, nil, nil
+				default:
+					dumpCommand = command
+					return nil, []byte("some-stderr\n"), dumpErr
+				}
+			}
+			fakeSecureShellClient.StreamCommandStub = func(command string, stdout io.Writer) ([]byte, error) {
+				_, err := io.WriteString(stdout, dumpContents)
+				return nil, err
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(localDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			dump, executeErr = actor.DownloadDiagnosticDump(fakeSecureShellClient, sshOptions, dumpOptions)
+		})
+
+		It("connects once with the provided options and closes the connection", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			username, passcode, endpoint, fingerprint, skipHostValidation := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(passcode).To(Equal("some-passcode"))
+			Expect(endpoint).To(Equal("some-endpoint"))
+			Expect(fingerprint).To(Equal("some-fingerprint"))
+			Expect(skipHostValidation).To(BeTrue())
+
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("no dump type is given", func() {
+			When("the app was staged by the java buildpack", func() {
+				It("downloads a heap dump", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(dump.Type).To(Equal(JVMHeapDump))
+					Expect(dumpCommand).To(ContainSubstring("GC.heap_dump /home/vcap/tmp/cf-diagnostic-dump.hprof"))
+					Expect(dump.Path).To(Equal(filepath.Join(localDir, "some-app-0-jvm-heap.hprof")))
+				})
+			})
+
+			When("the app was staged by the go buildpack", func() {
+				BeforeEach(func() {
+					stagingInfo = `{"detected_buildpack":"go","start_command":"some-command"}`
+				})
+
+				It("downloads a goroutine dump", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(dump.Type).To(Equal(GoroutineDump))
+					Expect(dumpCommand).To(ContainSubstring("/debug/pprof/goroutine?debug=2"))
+					Expect(dump.Path).To(Equal(filepath.Join(localDir, "some-app-0-goroutine.txt")))
+				})
+			})
+
+			When("the app was staged by another buildpack", func() {
+				BeforeEach(func() {
+					stagingInfo = `{"detected_buildpack":"ruby","start_command":"some-command"}`
+				})
+
+				It("downloads a core dump", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(dump.Type).To(Equal(CoreDump))
+					Expect(dumpCommand).To(ContainSubstring("gcore -o /home/vcap/tmp/cf-diagnostic-dump.core"))
+				})
+			})
+
+			When("the staging info cannot be parsed", func() {
+				BeforeEach(func() {
+					stagingInfo = "not-json"
+				})
+
+				It("downloads a core dump", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(dump.Type).To(Equal(CoreDump))
+				})
+			})
+		})
+
+		When("a dump type is given", func() {
+			BeforeEach(func() {
+				dumpOptions.Type = GoroutineDump
+			})
+
+			It("does not read the staging info", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(dump.Type).To(Equal(GoroutineDump))
+				Expect(remoteCommand).ToNot(ContainElement("cat /home/vcap/staging_info.yml"))
+			})
+		})
+
+		When("the dump is created and downloaded", func() {
+			It("writes the dump to the local path and removes the remote dump", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(dump.Size).To(BeEquivalentTo(len(dumpContents)))
+
+				contents, err := ioutil.ReadFile(dump.Path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal(dumpContents))
+
+				Expect(fakeSecureShellClient.StreamCommandCallCount()).To(Equal(1))
+				command, _ := fakeSecureShellClient.StreamCommandArgsForCall(0)
+				Expect(command).To(Equal("cat /home/vcap/tmp/cf-diagnostic-dump.hprof"))
+				Expect(remoteCommand[len(remoteCommand)-1]).To(Equal("rm -f /home/vcap/tmp/cf-diagnostic-dump.hprof"))
+			})
+
+			When("the path is a file", func() {
+				BeforeEach(func() {
+					dumpOptions.Path = filepath.Join(localDir, "some-dump")
+				})
+
+				It("writes the dump to the file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(dump.Path).To(Equal(filepath.Join(localDir, "some-dump")))
+					Expect(filepath.Join(localDir, "some-dump")).To(BeAnExistingFile())
+				})
+			})
+		})
+
+		When("creating the dump exits with an error", func() {
+			BeforeEach(func() {
+				dumpErr = &ssh.ExitError{}
+			})
+
+			It("returns a DiagnosticDumpFailedError with the command's error output", func() {
+				Expect(executeErr).To(MatchError(actionerror.DiagnosticDumpFailedError{Type: "jvm-heap", Reason: "some-stderr"}))
+				Expect(fakeSecureShellClient.StreamCommandCallCount()).To(Equal(0))
+				Expect(remoteCommand[len(remoteCommand)-1]).To(HavePrefix("rm -f "))
+			})
+		})
+
+		When("the dump is larger than the maximum size", func() {
+			BeforeEach(func() {
+				dumpSize = "2048\n"
+			})
+
+			It("returns a DiagnosticDumpTooLargeError without downloading the dump", func() {
+				Expect(executeErr).To(MatchError(actionerror.DiagnosticDumpTooLargeError{Type: "jvm-heap", Size: 2048, MaxSize: 1024}))
+				Expect(fakeSecureShellClient.StreamCommandCallCount()).To(Equal(0))
+				Expect(remoteCommand[len(remoteCommand)-1]).To(HavePrefix("rm -f "))
+			})
+		})
+
+		When("the download grows past the maximum size", func() {
+			BeforeEach(func() {
+				dumpOptions.MaxSize = 4
+				dumpSize = "4\n"
+			})
+
+			It("returns a DiagnosticDumpTooLargeError and removes the partial download", func() {
+				Expect(executeErr).To(MatchError(actionerror.DiagnosticDumpTooLargeError{Type: "jvm-heap", Size: 5, MaxSize: 4}))
+				Expect(filepath.Join(localDir, "some-app-0-jvm-heap.hprof")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the download fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.StreamCommandStub = nil
+				fakeSecureShellClient.StreamCommandReturns(nil, errors.New("some-stream-error"))
+			})
+
+			It("returns the error and removes the partial download", func() {
+				Expect(executeErr).To(MatchError("some-stream-error"))
+				Expect(filepath.Join(localDir, "some-app-0-jvm-heap.hprof")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,135 @@
+package sharedaction
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	fingerprintCacheVersion = 1
+
+	// fingerprintRacyWindow is how recently a file can have been modified and
+	// still be cached. Files modified within this window may be modified again
+	// without their modification time changing, so their fingerprint is always
+	// recalculated.
+	fingerprintRacyWindow = 2 * time.Second
+)
+
+type fingerprint struct {
+	ModTime int64  `json:"mtime"`
+	Size    int64  `json:"size"`
+	SHA1    string `json:"sha1"`
+}
+
+type fingerprintCacheFile struct {
+	Version      int                    `json:"version"`
+	Fingerprints map[string]fingerprint `json:"fingerprints"`
+}
+
+// fingerprintCache remembers the SHA1 of the files in a directory, keyed by
+// relative path, modification time and size, so unchanged files are not
+// re-hashed on subsequent pushes.
+type fingerprintCache struct {
+	path     string
+	previous map[string]fingerprint
+	current  map[string]fingerprint
+}
+
+// loadFingerprintCache loads the cache for sourceDir from cacheDir. A missing
+// or unreadable cache results in an empty cache.
+func loadFingerprintCache(cacheDir string, sourceDir string) *fingerprintCache {
+	cache := &fingerprintCache{
+		path:     filepath.Join(cacheDir, fmt.Sprintf("%x.json", sha1.Sum([]byte(sourceDir)))),
+		previous: map[string]fingerprint{},
+		current:  map[string]fingerprint{},
+	}
+
+	raw, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", cache.path).Warnln("reading fingerprint cache:", err)
+		}
+		return cache
+	}
+
+	var cacheFile fingerprintCacheFile
+	if err := json.Unmarshal(raw, &cacheFile); err != nil || cacheFile.Version != fingerprintCacheVersion {
+		log.WithField("path", cache.path).Warnln("ignoring invalid fingerprint cache:", err)
+		return cache
+	}
+	if cacheFile.Fingerprints != nil {
+		cache.previous = cacheFile.Fingerprints
+	}
+
+	return cache
+}
+
+// Lookup returns the cached SHA1 for the file if it has not changed since it
+// was cached. A nil cache never contains the file.
+func (cache *fingerprintCache) Lookup(relPath string, info os.FileInfo) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+
+	cached, ok := cache.previous[relPath]
+	if !ok || cached.ModTime != info.ModTime().UnixNano() || cached.Size != info.Size() {
+		return "", false
+	}
+
+	cache.current[relPath] = cached
+	return cached.SHA1, true
+}
+
+// Store records the SHA1 of the file. Storing in a nil cache does nothing.
+func (cache *fingerprintCache) Store(relPath string, info os.FileInfo, sha string) {
+	if cache == nil || time.Since(info.ModTime()) < fingerprintRacyWindow {
+		return
+	}
+
+	cache.current[relPath] = fingerprint{
+		ModTime: info.ModTime().UnixNano(),
+		Size:    info.Size(),
+		SHA1:    sha,
+	}
+}
+
+// Save writes the fingerprints looked up or stored since the cache was
+// loaded, dropping entries for files that no longer exist.
+func (cache *fingerprintCache) Save() error {
+	raw, err := json.Marshal(fingerprintCacheFile{
+		Version:      fingerprintCacheVersion,
+		Fingerprints: cache.current,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(cache.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(cache.path), "temp-fingerprints")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), cache.path)
+}
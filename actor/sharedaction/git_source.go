@@ -0,0 +1,49 @@
+package sharedaction
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitSource identifies the git commit an app was pushed from.
+type GitSource struct {
+	// Commit is the full SHA of the checked out commit.
+	Commit string
+	// RemoteURL is the URL of the origin remote without any credentials, or
+	// empty when the repository has no origin.
+	RemoteURL string
+}
+
+// GetGitSource returns the commit checked out in the git repository
+// containing appPath. It returns false when appPath is not inside a git
+// repository or git is not installed.
+func (Actor) GetGitSource(appPath string) (GitSource, bool) {
+	dir := appPath
+	if info, err := os.Stat(appPath); err == nil && !info.IsDir() {
+		dir = filepath.Dir(appPath)
+	}
+
+	commit, err := runGit("-C", dir, "rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return GitSource{}, false
+	}
+
+	source := GitSource{Commit: strings.TrimSpace(commit)}
+	if remoteURL, err := runGit("-C", dir, "config", "--get", "remote.origin.url"); err == nil {
+		source.RemoteURL = withoutCredentials(strings.TrimSpace(remoteURL))
+	}
+	return source, true
+}
+
+// withoutCredentials strips a user and password, such as an access token,
+// from a remote URL so that it can be recorded safely.
+func withoutCredentials(remoteURL string) string {
+	parsed, err := url.Parse(remoteURL)
+	if err != nil || parsed.User == nil || parsed.Scheme == "" {
+		return remoteURL
+	}
+	parsed.User = nil
+	return parsed.String()
+}
@@ -31,6 +31,9 @@ type CommandInfo struct {
 
 	// Environment is a list of environment variables specific for this command
 	Environment []EnvironmentVariable
+
+	// Examples is a list of runnable examples for this command
+	Examples []CommandExample
 }
 
 // CommandFlag contains the help details of a command's flag
@@ -55,6 +58,15 @@ type EnvironmentVariable struct {
 	DefaultValue string
 }
 
+// CommandExample is a runnable example of a command
+type CommandExample struct {
+	// Command is the example command line, starting with CF_NAME
+	Command string
+
+	// Description explains what the example does, may be empty
+	Description string
+}
+
 // CommandInfoByName returns the help information for a particular commandName in
 // the commandList.
 func (Actor) CommandInfoByName(commandList interface{}, commandName string) (CommandInfo, error) {
@@ -91,6 +103,11 @@ func (Actor) CommandInfoByName(commandList interface{}, commandName string) (Com
 			continue
 		}
 
+		if fieldTag.Get("examples") != "" {
+			cmd.Examples = parseExamples(strings.Split(fieldTag.Get("examples"), "\n"))
+			continue
+		}
+
 		if fieldTag.Get("related_commands") != "" {
 			relatedCommands := strings.Split(fieldTag.Get("related_commands"), ", ")
 			sort.Slice(relatedCommands, sorting.SortAlphabeticFunc(relatedCommands))
@@ -116,6 +133,10 @@ func (Actor) CommandInfoByName(commandList interface{}, commandName string) (Com
 		}
 	}
 
+	if len(cmd.Examples) == 0 {
+		cmd.Examples = examplesFromUsage(cmd.Usage)
+	}
+
 	return cmd, nil
 }
 
@@ -137,3 +158,82 @@ func (Actor) CommandInfos(commandList interface{}) map[string]CommandInfo {
 
 	return infos
 }
+
+// SearchCommandInfos returns the help information for all the commands in
+// commandList whose name, alias, description or flags contain keyword,
+// ignoring case. Only the flags that match keyword are included.
+func (actor Actor) SearchCommandInfos(commandList interface{}, keyword string) []CommandInfo {
+	keyword = strings.ToLower(keyword)
+	contains := func(s string) bool {
+		return strings.Contains(strings.ToLower(s), keyword)
+	}
+
+	var matches []CommandInfo
+	handler := reflect.TypeOf(commandList)
+	for i := 0; i < handler.NumField(); i++ {
+		commandName := handler.Field(i).Tag.Get("command")
+		if commandName == "" {
+			continue
+		}
+
+		cmd, err := actor.CommandInfoByName(commandList, commandName)
+		if err != nil {
+			continue
+		}
+
+		var matchingFlags []CommandFlag
+		for _, flag := range cmd.Flags {
+			if contains(flag.Long) || contains(flag.Short) || contains(flag.Description) {
+				matchingFlags = append(matchingFlags, flag)
+			}
+		}
+
+		if len(matchingFlags) > 0 || contains(cmd.Name) || contains(cmd.Alias) || contains(cmd.Description) {
+			cmd.Flags = matchingFlags
+			matches = append(matches, cmd)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return sorting.LessIgnoreCase(matches[i].Name, matches[j].Name)
+	})
+
+	return matches
+}
+
+// examplesFromUsage extracts the commands listed in the EXAMPLE(S) section of
+// a usage string.
+func examplesFromUsage(usage string) []CommandExample {
+	index := strings.Index(usage, "EXAMPLE")
+	if index == -1 {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range strings.Split(usage[index:], "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "CF_NAME ") {
+			lines = append(lines, line)
+		}
+	}
+	return parseExamples(lines)
+}
+
+// parseExamples converts lines of the form 'CF_NAME command # description'
+// into examples.
+func parseExamples(lines []string) []CommandExample {
+	var examples []CommandExample
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		example := CommandExample{Command: line}
+		if index := strings.Index(line, " # "); index != -1 {
+			example.Command = strings.TrimSpace(line[:index])
+			example.Description = strings.TrimSpace(line[index+3:])
+		}
+		examples = append(examples, example)
+	}
+	return examples
+}
@@ -13,6 +13,7 @@ type commandList struct {
 	App     appCommand     `command:"app" description:"Display health and status for an app"`
 	Restage restageCommand `command:"restage" alias:"rg" description:"Restage an app"`
 	Help    helpCommand    `command:"help" alias:"h" description:"Show help"`
+	Route   routeCommand   `command:"create-route" description:"Create a url route in a space for later use"`
 }
 
 type appCommand struct {
@@ -29,6 +30,12 @@ type restageCommand struct {
 type helpCommand struct {
 	AllCommands bool        `short:"a" description:"All available CLI commands"`
 	usage       interface{} `usage:"CF_NAME help [COMMAND]"`
+	examples    interface{} `examples:"CF_NAME help push # Show help for push\nCF_NAME help -a"`
+}
+
+type routeCommand struct {
+	Hostname string      `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	usage    interface{} `usage:"CF_NAME create-route SPACE DOMAIN [--hostname HOSTNAME]\n\nEXAMPLES:\n   CF_NAME create-route my-space example.com                  # example.com\n   CF_NAME create-route my-space example.com --hostname myapp # myapp.example.com"`
 }
 
 var _ = Describe("Help Actions", func() {
@@ -110,6 +117,39 @@ var _ = Describe("Help Actions", func() {
 			})
 		})
 
+		When("the command has curated examples", func() {
+			It("returns the examples", func() {
+				commandInfo, err := actor.CommandInfoByName(commandList{}, "help")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(commandInfo.Examples).To(Equal([]CommandExample{
+					{Command: "CF_NAME help push", Description: "Show help for push"},
+					{Command: "CF_NAME help -a"},
+				}))
+			})
+		})
+
+		When("the command's usage has an examples section", func() {
+			It("returns the examples from the usage", func() {
+				commandInfo, err := actor.CommandInfoByName(commandList{}, "create-route")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(commandInfo.Examples).To(Equal([]CommandExample{
+					{Command: "CF_NAME create-route my-space example.com", Description: "example.com"},
+					{Command: "CF_NAME create-route my-space example.com --hostname myapp", Description: "myapp.example.com"},
+				}))
+			})
+		})
+
+		When("the command has no examples", func() {
+			It("returns no examples", func() {
+				commandInfo, err := actor.CommandInfoByName(commandList{}, "app")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(commandInfo.Examples).To(BeEmpty())
+			})
+		})
+
 		When("the command does not exist", func() {
 			It("returns err", func() {
 				_, err := actor.CommandInfoByName(commandList{}, "does-not-exist")
@@ -120,6 +160,35 @@ var _ = Describe("Help Actions", func() {
 		})
 	})
 
+	Describe("SearchCommandInfos", func() {
+		It("returns the commands whose name, alias or description match, ignoring case", func() {
+			commands := actor.SearchCommandInfos(commandList{}, "APP")
+
+			Expect(commands).To(HaveLen(2))
+			Expect(commands[0].Name).To(Equal("app"))
+			Expect(commands[1].Name).To(Equal("restage"))
+			Expect(commands[1].Flags).To(BeEmpty())
+		})
+
+		It("returns the commands with matching flags and only the flags that match", func() {
+			commands := actor.SearchCommandInfos(commandList{}, "hostname")
+
+			Expect(commands).To(HaveLen(1))
+			Expect(commands[0].Name).To(Equal("create-route"))
+			Expect(commands[0].Flags).To(ConsistOf(CommandFlag{
+				Short:       "n",
+				Long:        "hostname",
+				Description: "Hostname for the HTTP route (required for shared domains)",
+			}))
+		})
+
+		When("nothing matches", func() {
+			It("returns no commands", func() {
+				Expect(actor.SearchCommandInfos(commandList{}, "does-not-match")).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("CommandInfos", func() {
 		It("returns back all the command's names and descriptions", func() {
 			commands := actor.CommandInfos(commandList{})
@@ -0,0 +1,118 @@
+package sharedaction
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"golang.org/x/crypto/ssh"
+)
+
+const instanceIdentityCertificateCommand = `cat "$CF_INSTANCE_CERT"`
+
+// InstanceIdentityCertificate represents the identity certificate Diego
+// issues to an app instance for container to container and service mTLS.
+type InstanceIdentityCertificate struct {
+	// CommonName is the subject common name, which is the instance GUID.
+	CommonName string
+	// Issuer is the common name of the issuing certificate authority.
+	Issuer string
+
+	// OrganizationGUID, SpaceGUID and AppGUID are parsed out of the subject
+	// organizational units.
+	OrganizationGUID string
+	SpaceGUID        string
+	AppGUID          string
+
+	// OrganizationalUnits is the raw list of subject organizational units.
+	OrganizationalUnits []string
+
+	// DNSNames and IPAddresses are the subject alternative names.
+	DNSNames    []string
+	IPAddresses []string
+
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Expired returns true if the certificate is no longer valid at the given
+// time.
+func (cert InstanceIdentityCertificate) Expired(now time.Time) bool {
+	return now.After(cert.NotAfter)
+}
+
+// GetInstanceIdentityCertificate connects to an app instance over SSH, reads
+// the certificate referenced by CF_INSTANCE_CERT and parses the leaf
+// certificate out of it.
+func (actor Actor) GetInstanceIdentityCertificate(sshClient SecureShellClient, sshOptions SSHOptions) (InstanceIdentityCertificate, error) {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return InstanceIdentityCertificate{}, err
+	}
+	defer sshClient.Close()
+
+	stdout, _, err := sshClient.RunCommand(instanceIdentityCertificateCommand)
+	if err != nil {
+		if _, ok := err.(*ssh.ExitError); ok {
+			return InstanceIdentityCertificate{}, actionerror.InstanceIdentityCertificateNotFoundError{}
+		}
+		return InstanceIdentityCertificate{}, err
+	}
+
+	if len(strings.TrimSpace(string(stdout))) == 0 {
+		return InstanceIdentityCertificate{}, actionerror.InstanceIdentityCertificateNotFoundError{}
+	}
+
+	return parseInstanceIdentityCertificate(stdout)
+}
+
+func parseInstanceIdentityCertificate(raw []byte) (InstanceIdentityCertificate, error) {
+	var block *pem.Block
+	for {
+		block, raw = pem.Decode(raw)
+		if block == nil {
+			return InstanceIdentityCertificate{}, actionerror.InvalidInstanceIdentityCertificateError{Reason: "no PEM encoded certificate found"}
+		}
+		if block.Type == "CERTIFICATE" {
+			break
+		}
+	}
+
+	x509Cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return InstanceIdentityCertificate{}, actionerror.InvalidInstanceIdentityCertificateError{Reason: err.Error()}
+	}
+
+	cert := InstanceIdentityCertificate{
+		CommonName:          x509Cert.Subject.CommonName,
+		Issuer:              x509Cert.Issuer.CommonName,
+		OrganizationalUnits: x509Cert.Subject.OrganizationalUnit,
+		DNSNames:            x509Cert.DNSNames,
+		NotBefore:           x509Cert.NotBefore,
+		NotAfter:            x509Cert.NotAfter,
+	}
+
+	for _, ip := range x509Cert.IPAddresses {
+		cert.IPAddresses = append(cert.IPAddresses, ip.String())
+	}
+
+	for _, unit := range x509Cert.Subject.OrganizationalUnit {
+		parts := strings.SplitN(unit, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "organization":
+			cert.OrganizationGUID = parts[1]
+		case "space":
+			cert.SpaceGUID = parts[1]
+		case "app":
+			cert.AppGUID = parts[1]
+		}
+	}
+
+	return cert, nil
+}
@@ -0,0 +1,199 @@
+package sharedaction_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+func generateInstanceIdentityCertificatePEM(notBefore time.Time, notAfter time.Time) []byte {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "instanceIdentityCA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject: pkix.Name{
+			CommonName: "some-instance-guid",
+			OrganizationalUnit: []string{
+				"organization:some-org-guid",
+				"space:some-space-guid",
+				"app:some-app-guid",
+			},
+		},
+		DNSNames:    []string{"some-instance-guid"},
+		IPAddresses: []net.IP{net.ParseIP("10.255.0.4")},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &leafKey.PublicKey, caKey)
+	Expect(err).ToNot(HaveOccurred())
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	Expect(err).ToNot(HaveOccurred())
+
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})...)
+}
+
+var _ = Describe("Instance Identity Certificate Actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+	})
+
+	Describe("GetInstanceIdentityCertificate", func() {
+		var (
+			sshOptions SSHOptions
+			notBefore  time.Time
+			notAfter   time.Time
+
+			cert       InstanceIdentityCertificate
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+			}
+
+			notBefore = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+			notAfter = time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+			fakeSecureShellClient.RunCommandReturns(generateInstanceIdentityCertificatePEM(notBefore, notAfter), nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			cert, executeErr = actor.GetInstanceIdentityCertificate(fakeSecureShellClient, sshOptions)
+		})
+
+		It("connects, reads CF_INSTANCE_CERT and closes the connection", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			username, passcode, endpoint, fingerprint, skipHostValidation := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(passcode).To(Equal("some-passcode"))
+			Expect(endpoint).To(Equal("some-endpoint"))
+			Expect(fingerprint).To(Equal("some-fingerprint"))
+			Expect(skipHostValidation).To(BeFalse())
+
+			Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(1))
+			Expect(fakeSecureShellClient.RunCommandArgsForCall(0)).To(Equal(`cat "$CF_INSTANCE_CERT"`))
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		It("returns the parsed leaf certificate", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(cert.CommonName).To(Equal("some-instance-guid"))
+			Expect(cert.Issuer).To(Equal("instanceIdentityCA"))
+			Expect(cert.OrganizationGUID).To(Equal("some-org-guid"))
+			Expect(cert.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(cert.AppGUID).To(Equal("some-app-guid"))
+			Expect(cert.OrganizationalUnits).To(ConsistOf(
+				"organization:some-org-guid",
+				"space:some-space-guid",
+				"app:some-app-guid",
+			))
+			Expect(cert.DNSNames).To(ConsistOf("some-instance-guid"))
+			Expect(cert.IPAddresses).To(ConsistOf("10.255.0.4"))
+			Expect(cert.NotBefore).To(Equal(notBefore))
+			Expect(cert.NotAfter).To(Equal(notAfter))
+			Expect(cert.Expired(notAfter.Add(-time.Minute))).To(BeFalse())
+			Expect(cert.Expired(notAfter.Add(time.Minute))).To(BeTrue())
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without running anything", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the remote command exits with a non-zero status", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns(nil, []byte("cat: can't open ''"), &ssh.ExitError{})
+			})
+
+			It("returns an InstanceIdentityCertificateNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InstanceIdentityCertificateNotFoundError{}))
+			})
+		})
+
+		When("running the remote command fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns(nil, nil, errors.New("some-session-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-session-error"))
+			})
+		})
+
+		When("the remote command prints nothing", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns([]byte("\n"), nil, nil)
+			})
+
+			It("returns an InstanceIdentityCertificateNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InstanceIdentityCertificateNotFoundError{}))
+			})
+		})
+
+		When("the output is not a PEM encoded certificate", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns([]byte("not a certificate"), nil, nil)
+			})
+
+			It("returns an InvalidInstanceIdentityCertificateError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidInstanceIdentityCertificateError{Reason: "no PEM encoded certificate found"}))
+			})
+		})
+
+		When("the PEM block does not contain a valid certificate", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandReturns(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}), nil, nil)
+			})
+
+			It("returns an InvalidInstanceIdentityCertificateError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.InvalidInstanceIdentityCertificateError{}))
+			})
+		})
+	})
+})
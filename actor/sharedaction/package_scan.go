@@ -0,0 +1,114 @@
+package sharedaction
+
+import (
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// ScanPackage asks the scan hook to approve the package or droplet at
+// packagePath before it is uploaded. A hook starting with http:// or https://
+// receives the files in a multipart POST and approves them with a 2xx
+// response. Any other hook is run through the system shell, with the paths in
+// the CF_SCAN_* environment variables, and approves them by exiting with 0.
+func (Actor) ScanPackage(hook string, appName string, packagePath string, manifestPath string) error {
+	var reason string
+	if strings.HasPrefix(hook, "http://") || strings.HasPrefix(hook, "https://") {
+		reason = postPackageScan(hook, appName, packagePath, manifestPath)
+	} else {
+		reason = runPackageScan(hook, appName, packagePath, manifestPath)
+	}
+
+	if reason != "" {
+		return actionerror.PackageScanRejectedError{AppName: appName, Hook: hook, Reason: reason}
+	}
+	return nil
+}
+
+// runPackageScan runs the scan command and returns why it did not approve the
+// files, or an empty string when it did.
+func runPackageScan(hook string, appName string, packagePath string, manifestPath string) string {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", hook)
+	} else {
+		cmd = exec.Command("sh", "-c", hook)
+	}
+	cmd.Env = append(os.Environ(),
+		"CF_SCAN_APP_NAME="+appName,
+		"CF_SCAN_PACKAGE_PATH="+packagePath,
+		"CF_SCAN_MANIFEST_PATH="+manifestPath,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return ""
+	}
+	if trimmed := strings.TrimSpace(string(output)); trimmed != "" {
+		return trimmed
+	}
+	return err.Error()
+}
+
+// postPackageScan sends the files to the scan endpoint and returns why it did
+// not approve them, or an empty string when it did.
+func postPackageScan(hook string, appName string, packagePath string, manifestPath string) string {
+	// The package is streamed, since it can be too large to hold in memory.
+	bodyReader, bodyWriter := io.Pipe()
+	form := multipart.NewWriter(bodyWriter)
+	go func() {
+		err := form.WriteField("app_name", appName)
+		if err == nil {
+			err = addFormFile(form, "package", packagePath)
+		}
+		if err == nil && manifestPath != "" {
+			err = addFormFile(form, "manifest", manifestPath)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		bodyWriter.CloseWithError(err)
+	}()
+
+	defer bodyReader.Close()
+
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}}
+	response, err := client.Post(hook, form.FormDataContentType(), bodyReader)
+	if err != nil {
+		return err.Error()
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return ""
+	}
+
+	responseBody, _ := ioutil.ReadAll(response.Body)
+	if trimmed := strings.TrimSpace(string(responseBody)); trimmed != "" {
+		return trimmed
+	}
+	return response.Status
+}
+
+func addFormFile(form *multipart.Writer, field string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	part, err := form.CreateFormFile(field, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, file)
+	return err
+}
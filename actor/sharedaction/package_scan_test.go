@@ -0,0 +1,96 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ScanPackage with an HTTP endpoint", func() {
+	var (
+		actor        *Actor
+		server       *Server
+		dir          string
+		packagePath  string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		server = NewServer()
+
+		var err error
+		dir, err = ioutil.TempDir("", "package-scan")
+		Expect(err).ToNot(HaveOccurred())
+		packagePath = filepath.Join(dir, "package.zip")
+		Expect(ioutil.WriteFile(packagePath, []byte("some-package"), 0600)).To(Succeed())
+		manifestPath = filepath.Join(dir, "manifest.yml")
+		Expect(ioutil.WriteFile(manifestPath, []byte("some-manifest"), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("posts the files and approves them on a 2xx response", func() {
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodPost, "/scan"),
+				func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.FormValue("app_name")).To(Equal("some-app"))
+
+					packageFile, header, err := r.FormFile("package")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(header.Filename).To(Equal("package.zip"))
+					Expect(ioutil.ReadAll(packageFile)).To(Equal([]byte("some-package")))
+
+					manifestFile, _, err := r.FormFile("manifest")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ioutil.ReadAll(manifestFile)).To(Equal([]byte("some-manifest")))
+				},
+				RespondWith(http.StatusNoContent, nil),
+			),
+		)
+
+		err := actor.ScanPackage(server.URL()+"/scan", "some-app", packagePath, manifestPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	When("the endpoint rejects the files", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(RespondWith(http.StatusForbidden, "found a virus\n"))
+		})
+
+		It("rejects the files with the response body", func() {
+			err := actor.ScanPackage(server.URL(), "some-app", packagePath, "")
+			Expect(err).To(MatchError(actionerror.PackageScanRejectedError{
+				AppName: "some-app",
+				Hook:    server.URL(),
+				Reason:  "found a virus",
+			}))
+		})
+	})
+
+	When("the package cannot be read", func() {
+		BeforeEach(func() {
+			server.AllowUnhandledRequests = true
+		})
+
+		It("rejects the files", func() {
+			err := actor.ScanPackage(server.URL(), "some-app", filepath.Join(dir, "missing.zip"), "")
+			Expect(err).To(BeAssignableToTypeOf(actionerror.PackageScanRejectedError{}))
+			Expect(err.Error()).To(ContainSubstring("missing.zip"))
+		})
+	})
+})
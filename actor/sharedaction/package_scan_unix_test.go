@@ -0,0 +1,47 @@
+// +build !windows
+
+package sharedaction_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScanPackage with a command", func() {
+	var actor *Actor
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+	})
+
+	It("approves the files when the command exits with 0", func() {
+		err := actor.ScanPackage(`test "$CF_SCAN_APP_NAME:$CF_SCAN_PACKAGE_PATH:$CF_SCAN_MANIFEST_PATH" = "some-app:/some/package.zip:/some/manifest.yml"`, "some-app", "/some/package.zip", "/some/manifest.yml")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("the command fails", func() {
+		It("rejects the files with the command's output", func() {
+			err := actor.ScanPackage("echo found a virus; exit 1", "some-app", "/some/package.zip", "")
+			Expect(err).To(MatchError(actionerror.PackageScanRejectedError{
+				AppName: "some-app",
+				Hook:    "echo found a virus; exit 1",
+				Reason:  "found a virus",
+			}))
+		})
+	})
+
+	When("the command fails without output", func() {
+		It("rejects the files with the exit status", func() {
+			err := actor.ScanPackage("exit 3", "some-app", "/some/package.zip", "")
+			Expect(err).To(MatchError(actionerror.PackageScanRejectedError{
+				AppName: "some-app",
+				Hook:    "exit 3",
+				Reason:  "exit status 3",
+			}))
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package sharedaction
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// RunProjectHook runs a hook declared in a project file through the system
+// shell from dir, streaming its output to the provided writers.
+func (Actor) RunProjectHook(command string, dir string, stdout io.Writer, stderr io.Writer) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return actionerror.ProjectHookFailedError{Command: command, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// +build !windows
+
+package sharedaction_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunProjectHook", func() {
+	var (
+		actor          *Actor
+		dir            string
+		stdout, stderr *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		var err error
+		dir, err = ioutil.TempDir("", "project-hook")
+		Expect(err).ToNot(HaveOccurred())
+		dir, err = filepath.EvalSymlinks(dir)
+		Expect(err).ToNot(HaveOccurred())
+
+		stdout = new(bytes.Buffer)
+		stderr = new(bytes.Buffer)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("runs the command through the shell from the provided directory", func() {
+		err := actor.RunProjectHook("pwd && echo oops >&2", dir, stdout, stderr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal(dir + "\n"))
+		Expect(stderr.String()).To(Equal("oops\n"))
+	})
+
+	When("the command fails", func() {
+		It("returns a ProjectHookFailedError", func() {
+			err := actor.RunProjectHook("exit 3", dir, stdout, stderr)
+			Expect(err).To(BeAssignableToTypeOf(actionerror.ProjectHookFailedError{}))
+			Expect(err.(actionerror.ProjectHookFailedError).Command).To(Equal("exit 3"))
+			Expect(err.Error()).To(ContainSubstring("exit status 3"))
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package sharedaction
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . Downloader
+
+// Downloader downloads a URL into a directory and returns the path of the
+// downloaded file.
+type Downloader interface {
+	Download(url string, tmpDirPath string) (string, error)
+}
+
+// IsRemoteAppSource returns true when the provided app path is a URL of an
+// archive or git repository instead of a local path.
+func IsRemoteAppSource(path string) bool {
+	return util.IsHTTPScheme(path) || util.IsGitURL(path)
+}
+
+// FetchRemoteAppSource clones the git repository, or downloads and extracts
+// the archive, at source into destDir and returns the path to push. Git URLs
+// may select a branch, tag or commit by appending #REF. Zip archives are
+// returned as is; tar archives containing a single top-level directory, as
+// produced by most source hosting services, resolve to that directory.
+func (Actor) FetchRemoteAppSource(source string, destDir string, downloader Downloader) (string, error) {
+	if util.IsGitURL(source) {
+		url, ref := util.SplitGitURLRef(source)
+		return cloneGitRepository(url, ref, filepath.Join(destDir, "app"))
+	}
+
+	url, _ := util.SplitGitURLRef(source)
+	log.WithField("url", url).Info("downloading app source")
+	archivePath, err := downloader.Download(url, destDir)
+	if err != nil {
+		return "", err
+	}
+
+	return prepareDownloadedAppArchive(url, archivePath, filepath.Join(destDir, "app"))
+}
+
+func cloneGitRepository(url string, ref string, dir string) (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", actionerror.GitNotInstalledError{}
+	}
+
+	log.WithFields(log.Fields{"url": url, "ref": ref}).Info("cloning app source")
+
+	shallowArgs := []string{"clone", "--quiet", "--depth", "1"}
+	if ref != "" {
+		shallowArgs = append(shallowArgs, "--branch", ref)
+	}
+	_, err := runGit(append(shallowArgs, url, dir)...)
+	if err == nil {
+		return dir, nil
+	}
+	if ref == "" {
+		return "", actionerror.GitCloneError{URL: url, Output: err.Error()}
+	}
+
+	// Refs that are commits cannot be shallow cloned, fall back to a full
+	// clone followed by a checkout.
+	log.WithField("ref", ref).Debug("shallow clone failed, retrying with full clone")
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = runGit("clone", "--quiet", url, dir); err == nil {
+		_, err = runGit("-C", dir, "checkout", "--quiet", ref)
+	}
+	if err != nil {
+		return "", actionerror.GitCloneError{URL: url, Ref: ref, Output: err.Error()}
+	}
+	return dir, nil
+}
+
+func runGit(args ...string) (string, error) {
+	var output bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s", strings.TrimSpace(output.String()))
+	}
+	return output.String(), nil
+}
+
+func prepareDownloadedAppArchive(url string, archivePath string, dir string) (string, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+
+	reader := bufio.NewReader(archive)
+	header, err := reader.Peek(512)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	var tarReader *tar.Reader
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		return archivePath, nil
+	case bytes.HasPrefix(header, []byte("\x1f\x8b")):
+		gzipReader, gzipErr := gzip.NewReader(reader)
+		if gzipErr != nil {
+			return "", gzipErr
+		}
+		defer gzipReader.Close()
+		tarReader = tar.NewReader(gzipReader)
+	case len(header) > 262 && string(header[257:262]) == "ustar":
+		tarReader = tar.NewReader(reader)
+	default:
+		return "", actionerror.UnsupportedAppArchiveError{URL: url}
+	}
+
+	err = extractTar(tarReader, dir)
+	if err != nil {
+		return "", err
+	}
+
+	return singleTopLevelDirectory(dir)
+}
+
+func extractTar(reader *tar.Reader, dir string) error {
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !isWithinDirectory(dir, target) {
+			return fmt.Errorf("archive entry %s is outside of the archive", header.Name)
+		}
+
+		// The checks above are lexical, so writing through a symlink that an
+		// earlier entry created could still escape dir, for example through a
+		// chain of links to parent directories.
+		err = refuseWritingThroughSymlink(dir, target, header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, DefaultFolderPermissions)
+		case tar.TypeReg, tar.TypeRegA:
+			err = writeTarEntry(reader, target, os.FileMode(header.Mode).Perm())
+		case tar.TypeSymlink:
+			linkTarget := filepath.Join(filepath.Dir(target), filepath.FromSlash(header.Linkname))
+			if filepath.IsAbs(header.Linkname) || !isWithinDirectory(dir, linkTarget) {
+				return fmt.Errorf("archive entry %s links outside of the archive", header.Name)
+			}
+			err = os.MkdirAll(filepath.Dir(target), DefaultFolderPermissions)
+			if err == nil {
+				err = os.Symlink(header.Linkname, target)
+			}
+		default:
+			log.WithField("entry", header.Name).Debug("skipping unsupported archive entry")
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func isWithinDirectory(dir string, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
+
+// refuseWritingThroughSymlink returns an error when target, or one of its
+// parent directories below dir, is a symlink.
+func refuseWritingThroughSymlink(dir string, target string, entryName string) error {
+	relativePath, err := filepath.Rel(dir, target)
+	if err != nil || relativePath == "." {
+		return err
+	}
+
+	path := dir
+	for _, part := range strings.Split(relativePath, string(os.PathSeparator)) {
+		path = filepath.Join(path, part)
+		info, err := os.Lstat(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry %s is written through a link", entryName)
+		}
+	}
+	return nil
+}
+
+func writeTarEntry(reader io.Reader, target string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), DefaultFolderPermissions)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+func singleTopLevelDirectory(dir string) (string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if len(entries) == 1 && entries[0].IsDir() {
+		return filepath.Join(dir, entries[0].Name()), nil
+	}
+	return dir, nil
+}
@@ -0,0 +1,302 @@
+package sharedaction_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Remote App Source Actions", func() {
+	var (
+		actor          *Actor
+		fakeDownloader *sharedactionfakes.FakeDownloader
+		destDir        string
+		archiveDir     string
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		fakeDownloader = new(sharedactionfakes.FakeDownloader)
+
+		var err error
+		destDir, err = ioutil.TempDir("", "remote-app-source-dest")
+		Expect(err).ToNot(HaveOccurred())
+		archiveDir, err = ioutil.TempDir("", "remote-app-source-archive")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(destDir)).To(Succeed())
+		Expect(os.RemoveAll(archiveDir)).To(Succeed())
+	})
+
+	Describe("IsRemoteAppSource", func() {
+		It("returns true for archive and git URLs", func() {
+			Expect(IsRemoteAppSource("https://example.com/org/repo/archive/main.tar.gz")).To(BeTrue())
+			Expect(IsRemoteAppSource("git@example.com:org/repo.git")).To(BeTrue())
+			Expect(IsRemoteAppSource("some/local/path")).To(BeFalse())
+		})
+	})
+
+	Describe("FetchRemoteAppSource", func() {
+		var (
+			source     string
+			path       string
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			path, executeErr = actor.FetchRemoteAppSource(source, destDir, fakeDownloader)
+		})
+
+		When("the source is an archive URL", func() {
+			var archivePath string
+
+			BeforeEach(func() {
+				source = "https://example.com/org/repo/archive/main.tar.gz#ignored"
+				archivePath = filepath.Join(archiveDir, "main.tar.gz")
+				fakeDownloader.DownloadReturns(archivePath, nil)
+			})
+
+			When("the archive is a gzipped tarball with a single top-level directory", func() {
+				BeforeEach(func() {
+					writeTarGz(archivePath, map[string]string{
+						"repo-main/":             "",
+						"repo-main/index.html":   "hello",
+						"repo-main/lib/app.rb":   "puts 'hi'",
+						"repo-main/lib/.hidden/": "",
+					})
+				})
+
+				It("downloads the archive without the fragment and returns the top-level directory", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeDownloader.DownloadCallCount()).To(Equal(1))
+					url, tmpDir := fakeDownloader.DownloadArgsForCall(0)
+					Expect(url).To(Equal("https://example.com/org/repo/archive/main.tar.gz"))
+					Expect(tmpDir).To(Equal(destDir))
+
+					Expect(path).To(Equal(filepath.Join(destDir, "app", "repo-main")))
+					contents, err := ioutil.ReadFile(filepath.Join(path, "lib", "app.rb"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("puts 'hi'"))
+				})
+			})
+
+			When("the archive has several top-level entries", func() {
+				BeforeEach(func() {
+					writeTarGz(archivePath, map[string]string{
+						"index.html": "hello",
+						"app.rb":     "puts 'hi'",
+					})
+				})
+
+				It("returns the extraction directory", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(path).To(Equal(filepath.Join(destDir, "app")))
+					Expect(filepath.Join(path, "index.html")).To(BeARegularFile())
+				})
+			})
+
+			When("an archive entry escapes the extraction directory", func() {
+				BeforeEach(func() {
+					writeTarGz(archivePath, map[string]string{
+						"../escaped": "nope",
+					})
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("archive entry ../escaped is outside of the archive"))
+				})
+			})
+
+			When("an archive entry is written through a chain of links", func() {
+				BeforeEach(func() {
+					writeTarGzHeaders(archivePath, []tar.Header{
+						{Name: "l", Linkname: ".", Typeflag: tar.TypeSymlink},
+						{Name: "l/u1", Linkname: "..", Typeflag: tar.TypeSymlink},
+						{Name: "u1/u2", Linkname: "..", Typeflag: tar.TypeSymlink},
+						{Name: "u1/u2/pwned.txt", Mode: 0644, Typeflag: tar.TypeReg},
+					})
+				})
+
+				It("returns an error without writing outside of the extraction directory", func() {
+					Expect(executeErr).To(MatchError("archive entry l/u1 is written through a link"))
+					Expect(filepath.Join(destDir, "..", "pwned.txt")).ToNot(BeAnExistingFile())
+					Expect(filepath.Join(destDir, "pwned.txt")).ToNot(BeAnExistingFile())
+				})
+			})
+
+			When("the archive is a zip file", func() {
+				BeforeEach(func() {
+					archivePath = filepath.Join(archiveDir, "main.zip")
+					fakeDownloader.DownloadReturns(archivePath, nil)
+
+					file, err := os.Create(archivePath)
+					Expect(err).ToNot(HaveOccurred())
+					writer := zip.NewWriter(file)
+					entry, err := writer.Create("index.html")
+					Expect(err).ToNot(HaveOccurred())
+					_, err = entry.Write([]byte("hello"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(writer.Close()).To(Succeed())
+					Expect(file.Close()).To(Succeed())
+				})
+
+				It("returns the downloaded zip", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(path).To(Equal(archivePath))
+				})
+			})
+
+			When("the download is not an archive", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(archivePath, []byte("<html>not found</html>"), 0600)).To(Succeed())
+				})
+
+				It("returns an UnsupportedAppArchiveError", func() {
+					Expect(executeErr).To(MatchError(actionerror.UnsupportedAppArchiveError{URL: "https://example.com/org/repo/archive/main.tar.gz"}))
+				})
+			})
+
+			When("the download fails", func() {
+				BeforeEach(func() {
+					fakeDownloader.DownloadReturns("", errors.New("some-download-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-download-error"))
+				})
+			})
+		})
+
+		When("the source is a git URL", func() {
+			var repoDir string
+
+			BeforeEach(func() {
+				if _, err := exec.LookPath("git"); err != nil {
+					Skip("git is not installed")
+				}
+
+				repoDir = filepath.Join(archiveDir, "repo.git")
+				Expect(os.MkdirAll(repoDir, 0700)).To(Succeed())
+				runGitIn(repoDir, "init", "--quiet")
+				Expect(ioutil.WriteFile(filepath.Join(repoDir, "app.rb"), []byte("v1"), 0600)).To(Succeed())
+				runGitIn(repoDir, "add", "app.rb")
+				runGitIn(repoDir, "commit", "--quiet", "-m", "v1")
+				runGitIn(repoDir, "tag", "v1")
+				Expect(ioutil.WriteFile(filepath.Join(repoDir, "app.rb"), []byte("v2"), 0600)).To(Succeed())
+				runGitIn(repoDir, "commit", "--quiet", "-am", "v2")
+
+				source = "file://" + filepath.ToSlash(repoDir)
+			})
+
+			It("clones the default branch without using the downloader", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeDownloader.DownloadCallCount()).To(Equal(0))
+
+				Expect(path).To(Equal(filepath.Join(destDir, "app")))
+				contents, err := ioutil.ReadFile(filepath.Join(path, "app.rb"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("v2"))
+			})
+
+			When("a tag is provided", func() {
+				BeforeEach(func() {
+					source += "#v1"
+				})
+
+				It("clones the tag", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					contents, err := ioutil.ReadFile(filepath.Join(path, "app.rb"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("v1"))
+				})
+			})
+
+			When("a commit is provided", func() {
+				BeforeEach(func() {
+					output, err := exec.Command("git", "-C", repoDir, "rev-parse", "v1").Output()
+					Expect(err).ToNot(HaveOccurred())
+					source += "#" + string(output[:40])
+				})
+
+				It("checks out the commit", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					contents, err := ioutil.ReadFile(filepath.Join(path, "app.rb"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("v1"))
+				})
+			})
+
+			When("the ref does not exist", func() {
+				BeforeEach(func() {
+					source += "#does-not-exist"
+				})
+
+				It("returns a GitCloneError", func() {
+					Expect(executeErr).To(HaveOccurred())
+					cloneErr, ok := executeErr.(actionerror.GitCloneError)
+					Expect(ok).To(BeTrue())
+					Expect(cloneErr.URL).To(Equal("file://" + filepath.ToSlash(repoDir)))
+					Expect(cloneErr.Ref).To(Equal("does-not-exist"))
+				})
+			})
+		})
+	})
+})
+
+func writeTarGz(path string, entries map[string]string) {
+	file, err := os.Create(path)
+	Expect(err).ToNot(HaveOccurred())
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	defer gzipWriter.Close()
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer tarWriter.Close()
+
+	for name, contents := range entries {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg}
+		if name[len(name)-1] == '/' {
+			header.Mode = 0755
+			header.Typeflag = tar.TypeDir
+		}
+		Expect(tarWriter.WriteHeader(header)).To(Succeed())
+		_, err = tarWriter.Write([]byte(contents))
+		Expect(err).ToNot(HaveOccurred())
+	}
+}
+
+func writeTarGzHeaders(path string, headers []tar.Header) {
+	file, err := os.Create(path)
+	Expect(err).ToNot(HaveOccurred())
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	defer gzipWriter.Close()
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer tarWriter.Close()
+
+	for i := range headers {
+		Expect(tarWriter.WriteHeader(&headers[i])).To(Succeed())
+	}
+}
+
+func runGitIn(dir string, args ...string) {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=some-user", "-c", "user.email=some-user@example.com"}, args...)...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	Expect(err).ToNot(HaveOccurred(), string(output))
+}
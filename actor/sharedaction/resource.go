@@ -13,8 +13,9 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/cfignore"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/ykk"
-	ignore "github.com/sabhiram/go-gitignore"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -45,6 +46,13 @@ type Resource struct {
 
 type V3Resource ccv3.Resource
 
+// IgnoredResource is a file or directory excluded from an upload, along with
+// the ignore rule responsible for excluding it.
+type IgnoredResource struct {
+	Filename string
+	Rule     cfignore.Rule
+}
+
 // Translate shared action Resource to V3 Resource format
 func (r Resource) ToV3Resource() V3Resource {
 	return V3Resource{
@@ -88,12 +96,12 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 
 	for _, archivedFile := range reader.File {
 		filename := filepath.ToSlash(archivedFile.Name)
-		if gitIgnore.MatchesPath(filename) {
+		info := archivedFile.FileInfo()
+		if gitIgnore.Ignored(filename, info.IsDir()) {
 			continue
 		}
 
 		resource := Resource{Filename: filename}
-		info := archivedFile.FileInfo()
 
 		switch {
 		case info.IsDir():
@@ -127,41 +135,16 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
-// GatherDirectoryResources returns a list of resources for a directory.
+// GatherDirectoryResources returns a list of resources for a directory. When
+// the actor has a FingerprintCacheDirectory, the SHA1s of unchanged files are
+// read from the cache instead of being recalculated.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
-	var (
-		resources []Resource
-		gitIgnore *ignore.GitIgnore
-	)
-
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
-	if err != nil {
-		log.Errorln("reading .cfignore file:", err)
-		return nil, err
-	}
-
-	evalDir, err := filepath.EvalSymlinks(sourceDir)
-	if err != nil {
-		log.Errorln("evaluating symlink:", err)
-		return nil, err
-	}
-
-	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		relPath, err := filepath.Rel(evalDir, fullPath)
-		if err != nil {
-			return err
-		}
+	var resources []Resource
 
-		// if file ignored continue to the next file
-		if gitIgnore.MatchesPath(relPath) {
-			return nil
-		}
+	cache := actor.loadFingerprintCache(sourceDir)
 
-		if relPath == "." {
+	walkErr := actor.walkDirectoryResources(sourceDir, func(fullPath string, relPath string, info os.FileInfo, rule cfignore.Rule, ignored bool) error {
+		if ignored {
 			return nil
 		}
 
@@ -180,7 +163,16 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			resource.Mode = fixMode(info.Mode())
 		default:
 			// If the file is regular we want to open
-			// and calculate the sha of the file
+			// and calculate the sha of the file, unless
+			// it has not changed since the last push
+			resource.Mode = fixMode(info.Mode())
+			resource.Size = info.Size()
+
+			if sha, ok := cache.Lookup(resource.Filename, info); ok {
+				resource.SHA1 = sha
+				break
+			}
+
 			file, err := os.Open(fullPath)
 			if err != nil {
 				return err
@@ -193,9 +185,8 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 				return err
 			}
 
-			resource.Mode = fixMode(info.Mode())
 			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
-			resource.Size = info.Size()
+			cache.Store(resource.Filename, info, resource.SHA1)
 		}
 
 		resources = append(resources, resource)
@@ -206,9 +197,122 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if walkErr == nil && cache != nil {
+		if err := cache.Save(); err != nil {
+			log.WithField("sourceDir", sourceDir).Warnln("saving fingerprint cache:", err)
+		}
+	}
+
 	return resources, walkErr
 }
 
+func (actor Actor) loadFingerprintCache(sourceDir string) *fingerprintCache {
+	if actor.FingerprintCacheDirectory == "" {
+		return nil
+	}
+
+	absDir, err := filepath.Abs(sourceDir)
+	if err != nil {
+		log.WithField("sourceDir", sourceDir).Warnln("not using fingerprint cache:", err)
+		return nil
+	}
+
+	return loadFingerprintCache(actor.FingerprintCacheDirectory, absDir)
+}
+
+// GatherIgnoredArchiveResources returns the files in an archive that are
+// excluded by the default ignore patterns, the user's global .cfignore and
+// the .cfignore in the archive.
+func (actor Actor) GatherIgnoredArchiveResources(archivePath string) ([]IgnoredResource, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	reader, err := actor.newArchiveReader(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	gitIgnore, err := actor.generateArchiveCFIgnoreMatcher(reader.File)
+	if err != nil {
+		log.Errorln("reading .cfignore file:", err)
+		return nil, err
+	}
+
+	var ignoredResources []IgnoredResource
+	for _, archivedFile := range reader.File {
+		filename := filepath.ToSlash(archivedFile.Name)
+		if rule, ignored := gitIgnore.Match(filename, archivedFile.FileInfo().IsDir()); ignored {
+			ignoredResources = append(ignoredResources, IgnoredResource{Filename: filename, Rule: rule})
+		}
+	}
+	return ignoredResources, nil
+}
+
+// GatherIgnoredDirectoryResources returns the files and directories in a
+// directory that are excluded by the default ignore patterns, the user's
+// global .cfignore and the directory's .cfignore. The contents of an ignored
+// directory are not listed.
+func (actor Actor) GatherIgnoredDirectoryResources(sourceDir string) ([]IgnoredResource, error) {
+	var ignoredResources []IgnoredResource
+
+	err := actor.walkDirectoryResources(sourceDir, func(fullPath string, relPath string, info os.FileInfo, rule cfignore.Rule, ignored bool) error {
+		if ignored {
+			filename := filepath.ToSlash(relPath)
+			if info.IsDir() {
+				filename += "/"
+			}
+			ignoredResources = append(ignoredResources, IgnoredResource{Filename: filename, Rule: rule})
+		}
+		return nil
+	})
+
+	return ignoredResources, err
+}
+
+type walkResourceFunc func(fullPath string, relPath string, info os.FileInfo, rule cfignore.Rule, ignored bool) error
+
+// walkDirectoryResources walks sourceDir, resolving it if it is a symlink, and
+// calls walkFunc for every file and directory in it. Ignored directories are
+// passed to walkFunc but not descended into.
+func (actor Actor) walkDirectoryResources(sourceDir string, walkFunc walkResourceFunc) error {
+	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	if err != nil {
+		log.Errorln("reading .cfignore file:", err)
+		return err
+	}
+
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		log.Errorln("evaluating symlink:", err)
+		return err
+	}
+
+	return filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." {
+			return nil
+		}
+
+		rule, ignored := gitIgnore.Match(filepath.ToSlash(relPath), info.IsDir())
+		err = walkFunc(fullPath, relPath, info, rule, ignored)
+		if err == nil && ignored && info.IsDir() {
+			return filepath.SkipDir
+		}
+		return err
+	})
+}
+
 // ZipArchiveResources zips an archive and a sorted (based on full
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
@@ -425,7 +529,12 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	return nil
 }
 
-func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
+func (actor Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*cfignore.Matcher, error) {
+	gitIgnore, err := actor.newCFIgnoreMatcher()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, item := range files {
 		if strings.HasSuffix(item.Name, ".cfignore") {
 			fileReader, err := item.Open()
@@ -438,36 +547,61 @@ func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnor
 			if err != nil {
 				return nil, err
 			}
-			s := append(DefaultIgnoreLines, strings.Split(string(raw), "\n")...)
-			return ignore.CompileIgnoreLines(s...)
+			gitIgnore.AddLines(item.Name, strings.Split(string(raw), "\n")...)
+			break
 		}
 	}
-	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
+	return gitIgnore, nil
 }
 
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
+func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*cfignore.Matcher, error) {
 	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
 	log.WithFields(log.Fields{
 		"pathToCFIgnore": pathToCFIgnore,
 		"sourceDir":      sourceDir,
 	}).Debug("using ignore file")
 
-	additionalIgnoreLines := DefaultIgnoreLines
+	gitIgnore, err := actor.newCFIgnoreMatcher()
+	if err != nil {
+		return nil, err
+	}
 
 	// If verbose logging has files in the current dir, ignore them
+	var traceFileLines []string
 	_, traceFiles := actor.Config.Verbose()
 	for _, traceFilePath := range traceFiles {
 		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
-			additionalIgnoreLines = append(additionalIgnoreLines, relPath)
+			traceFileLines = append(traceFileLines, "/"+filepath.ToSlash(relPath))
 		}
 	}
+	gitIgnore.AddLines("trace files", traceFileLines...)
 
-	log.Debugf("ignore rules: %v", additionalIgnoreLines)
+	log.Debugf("ignore rules: %v", gitIgnore.Rules())
 
 	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
+		err = gitIgnore.AddFile(pathToCFIgnore)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gitIgnore, nil
+}
+
+// newCFIgnoreMatcher returns a matcher containing the default ignore patterns
+// followed by the patterns in the user's global .cfignore, if it exists.
+func (Actor) newCFIgnoreMatcher() (*cfignore.Matcher, error) {
+	gitIgnore := cfignore.NewMatcher()
+	gitIgnore.AddLines("default ignore rules", DefaultIgnoreLines...)
+
+	globalCFIgnore := configv3.GlobalCFIgnoreFilePath()
+	if _, err := os.Stat(globalCFIgnore); !os.IsNotExist(err) {
+		log.WithField("pathToGlobalCFIgnore", globalCFIgnore).Debug("using global ignore file")
+		err = gitIgnore.AddFile(globalCFIgnore)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return ignore.CompileIgnoreLines(additionalIgnoreLines...)
+	return gitIgnore, nil
 }
 
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
@@ -3,9 +3,12 @@
 package sharedaction_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
@@ -320,6 +323,89 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 
+		When("a fingerprint cache directory is set", func() {
+			var (
+				cacheDir  string
+				cachePath string
+			)
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "fingerprint-cache")
+				Expect(err).ToNot(HaveOccurred())
+				actor.FingerprintCacheDirectory = cacheDir
+
+				anHourAgo := time.Now().Add(-time.Hour)
+				for _, path := range []string{"level1/level2/tmpFile1", "tmpFile2", "tmpFile3"} {
+					Expect(os.Chtimes(filepath.Join(srcDir, path), anHourAgo, anHourAgo)).To(Succeed())
+				}
+
+				_, err = actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				cacheFiles, err := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cacheFiles).To(HaveLen(1))
+				cachePath = cacheFiles[0]
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cacheDir)).To(Succeed())
+			})
+
+			replaceCachedSHA := func(filename string, sha string) {
+				raw, err := ioutil.ReadFile(cachePath)
+				Expect(err).ToNot(HaveOccurred())
+
+				var cache map[string]interface{}
+				decoder := json.NewDecoder(bytes.NewReader(raw))
+				decoder.UseNumber()
+				Expect(decoder.Decode(&cache)).To(Succeed())
+				fingerprints := cache["fingerprints"].(map[string]interface{})
+				Expect(fingerprints).To(HaveLen(3))
+				fingerprints[filename].(map[string]interface{})["sha1"] = sha
+
+				raw, err = json.Marshal(cache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(cachePath, raw, 0600)).To(Succeed())
+			}
+
+			It("reuses the cached fingerprints of unchanged files", func() {
+				replaceCachedSHA("tmpFile2", "cached-sha")
+
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources).To(ContainElement(
+					Resource{Filename: "tmpFile2", SHA1: "cached-sha", Size: 12, Mode: 0751},
+				))
+			})
+
+			It("recalculates the fingerprints of changed files", func() {
+				replaceCachedSHA("tmpFile2", "cached-sha")
+				Expect(ioutil.WriteFile(filepath.Join(srcDir, "tmpFile2"), []byte("Goodbye, Binky"), 0751)).To(Succeed())
+
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources).To(ContainElement(
+					Resource{Filename: "tmpFile2", SHA1: "97c2ec942e7edee8c7905bd317c0d0860005db5f", Size: 14, Mode: 0751},
+				))
+
+				raw, err := ioutil.ReadFile(cachePath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).ToNot(ContainSubstring("tmpFile2"))
+			})
+
+			It("ignores an invalid cache", func() {
+				Expect(ioutil.WriteFile(cachePath, []byte("not json"), 0600)).To(Succeed())
+
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources).To(ContainElement(
+					Resource{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+				))
+			})
+		})
+
 		When("the directory is empty", func() {
 			var emptyDir string
 
@@ -340,6 +426,107 @@ var _ = Describe("Resource Actions", func() {
 		})
 	})
 
+	Describe("GatherIgnoredDirectoryResources", func() {
+		var (
+			ignoredResources []IgnoredResource
+			executeErr       error
+		)
+
+		JustBeforeEach(func() {
+			ignoredResources, executeErr = actor.GatherIgnoredDirectoryResources(srcDir)
+		})
+
+		When("a .cfignore file with directory and negated patterns exists", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("level2/\ntmpFile*\n!tmpFile3\n"), 0655)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("lists the ignored files with the rule that ignored them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(ignoredResources).To(HaveLen(3))
+				Expect(ignoredResources[0].Filename).To(Equal(".cfignore"))
+				Expect(ignoredResources[0].Rule.Source).To(Equal("default ignore rules"))
+				Expect(ignoredResources[1].Filename).To(Equal("level1/level2/"))
+				Expect(ignoredResources[1].Rule.Source).To(Equal(filepath.Join(srcDir, ".cfignore")))
+				Expect(ignoredResources[1].Rule.Line).To(Equal(1))
+				Expect(ignoredResources[2].Filename).To(Equal("tmpFile2"))
+				Expect(ignoredResources[2].Rule.Pattern).To(Equal("tmpFile*"))
+			})
+
+			It("excludes the same files when gathering resources", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resources).To(Equal(
+					[]Resource{
+						{Filename: "level1", Mode: DefaultFolderPermissions},
+						{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+					}))
+			})
+		})
+
+		When("a global .cfignore file exists", func() {
+			var homeDir string
+
+			BeforeEach(func() {
+				var err error
+				homeDir, err = ioutil.TempDir("", "cf-home")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+
+				err = ioutil.WriteFile(filepath.Join(homeDir, ".cfignore"), []byte("tmpFile2\ntmpFile3\n"), 0655)
+				Expect(err).ToNot(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("!tmpFile3"), 0655)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+				Expect(os.RemoveAll(homeDir)).To(Succeed())
+			})
+
+			It("applies the global patterns before the app's .cfignore", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(ignoredResources).To(HaveLen(2))
+				Expect(ignoredResources[1].Filename).To(Equal("tmpFile2"))
+				Expect(ignoredResources[1].Rule.Source).To(Equal(filepath.Join(homeDir, ".cfignore")))
+			})
+		})
+	})
+
+	Describe("GatherIgnoredArchiveResources", func() {
+		var archive string
+
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("level2/"), 0655)
+			Expect(err).ToNot(HaveOccurred())
+
+			tmpfile, err := ioutil.TempFile("", "example")
+			Expect(err).ToNot(HaveOccurred())
+			archive = tmpfile.Name()
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+			Expect(zipit(srcDir, archive, "")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(archive)).ToNot(HaveOccurred())
+		})
+
+		It("lists the ignored archive entries", func() {
+			ignoredResources, err := actor.GatherIgnoredArchiveResources(archive)
+			Expect(err).ToNot(HaveOccurred())
+
+			var filenames []string
+			for _, ignoredResource := range ignoredResources {
+				filenames = append(filenames, ignoredResource.Filename)
+			}
+			Expect(filenames).To(Equal([]string{"/.cfignore", "/level1/level2/", "/level1/level2/tmpFile1"}))
+		})
+	})
+
 	Describe("ZipDirectoryResources", func() {
 		var (
 			resultZip  string
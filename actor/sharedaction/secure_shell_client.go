@@ -1,13 +1,21 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
 type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
+	ConnectWithKey(username string, identityFile string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RecordSession(writer io.Writer)
+	RunCommand(command string) ([]byte, []byte, error)
+	StreamCommand(command string, stdout io.Writer) ([]byte, error)
 	Wait() error
 }
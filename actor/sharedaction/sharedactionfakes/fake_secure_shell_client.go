@@ -2,6 +2,7 @@
 package sharedactionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -34,6 +35,21 @@ type FakeSecureShellClient struct {
 	connectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ConnectWithKeyStub        func(string, string, string, string, bool) error
+	connectWithKeyMutex       sync.RWMutex
+	connectWithKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}
+	connectWithKeyReturns struct {
+		result1 error
+	}
+	connectWithKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func([]string, clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -57,6 +73,40 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RecordSessionStub        func(io.Writer)
+	recordSessionMutex       sync.RWMutex
+	recordSessionArgsForCall []struct {
+		arg1 io.Writer
+	}
+	RunCommandStub        func(string) ([]byte, []byte, error)
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		arg1 string
+	}
+	runCommandReturns struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}
+	StreamCommandStub        func(string, io.Writer) ([]byte, error)
+	streamCommandMutex       sync.RWMutex
+	streamCommandArgsForCall []struct {
+		arg1 string
+		arg2 io.Writer
+	}
+	streamCommandReturns struct {
+		result1 []byte
+		result2 error
+	}
+	streamCommandReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct {
@@ -187,6 +237,70 @@ func (fake *FakeSecureShellClient) ConnectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) ConnectWithKey(arg1 string, arg2 string, arg3 string, arg4 string, arg5 bool) error {
+	fake.connectWithKeyMutex.Lock()
+	ret, specificReturn := fake.connectWithKeyReturnsOnCall[len(fake.connectWithKeyArgsForCall)]
+	fake.connectWithKeyArgsForCall = append(fake.connectWithKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ConnectWithKey", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.connectWithKeyMutex.Unlock()
+	if fake.ConnectWithKeyStub != nil {
+		return fake.ConnectWithKeyStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.connectWithKeyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) ConnectWithKeyCallCount() int {
+	fake.connectWithKeyMutex.RLock()
+	defer fake.connectWithKeyMutex.RUnlock()
+	return len(fake.connectWithKeyArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) ConnectWithKeyCalls(stub func(string, string, string, string, bool) error) {
+	fake.connectWithKeyMutex.Lock()
+	defer fake.connectWithKeyMutex.Unlock()
+	fake.ConnectWithKeyStub = stub
+}
+
+func (fake *FakeSecureShellClient) ConnectWithKeyArgsForCall(i int) (string, string, string, string, bool) {
+	fake.connectWithKeyMutex.RLock()
+	defer fake.connectWithKeyMutex.RUnlock()
+	argsForCall := fake.connectWithKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeSecureShellClient) ConnectWithKeyReturns(result1 error) {
+	fake.connectWithKeyMutex.Lock()
+	defer fake.connectWithKeyMutex.Unlock()
+	fake.ConnectWithKeyStub = nil
+	fake.connectWithKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) ConnectWithKeyReturnsOnCall(i int, result1 error) {
+	fake.connectWithKeyMutex.Lock()
+	defer fake.connectWithKeyMutex.Unlock()
+	fake.ConnectWithKeyStub = nil
+	if fake.connectWithKeyReturnsOnCall == nil {
+		fake.connectWithKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.connectWithKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh.TTYRequest) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -318,6 +432,167 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RecordSession(arg1 io.Writer) {
+	fake.recordSessionMutex.Lock()
+	fake.recordSessionArgsForCall = append(fake.recordSessionArgsForCall, struct {
+		arg1 io.Writer
+	}{arg1})
+	fake.recordInvocation("RecordSession", []interface{}{arg1})
+	fake.recordSessionMutex.Unlock()
+	if fake.RecordSessionStub != nil {
+		fake.RecordSessionStub(arg1)
+	}
+}
+
+func (fake *FakeSecureShellClient) RecordSessionCallCount() int {
+	fake.recordSessionMutex.RLock()
+	defer fake.recordSessionMutex.RUnlock()
+	return len(fake.recordSessionArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RecordSessionCalls(stub func(io.Writer)) {
+	fake.recordSessionMutex.Lock()
+	defer fake.recordSessionMutex.Unlock()
+	fake.RecordSessionStub = stub
+}
+
+func (fake *FakeSecureShellClient) RecordSessionArgsForCall(i int) io.Writer {
+	fake.recordSessionMutex.RLock()
+	defer fake.recordSessionMutex.RUnlock()
+	argsForCall := fake.recordSessionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) RunCommand(arg1 string) ([]byte, []byte, error) {
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RunCommand", []interface{}{arg1})
+	fake.runCommandMutex.Unlock()
+	if fake.RunCommandStub != nil {
+		return fake.RunCommandStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.runCommandReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSecureShellClient) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RunCommandCalls(stub func(string) ([]byte, []byte, error)) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = stub
+}
+
+func (fake *FakeSecureShellClient) RunCommandArgsForCall(i int) string {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	argsForCall := fake.runCommandArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturns(result1 []byte, result2 []byte, result3 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturnsOnCall(i int, result1 []byte, result2 []byte, result3 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecureShellClient) StreamCommand(arg1 string, arg2 io.Writer) ([]byte, error) {
+	fake.streamCommandMutex.Lock()
+	ret, specificReturn := fake.streamCommandReturnsOnCall[len(fake.streamCommandArgsForCall)]
+	fake.streamCommandArgsForCall = append(fake.streamCommandArgsForCall, struct {
+		arg1 string
+		arg2 io.Writer
+	}{arg1, arg2})
+	fake.recordInvocation("StreamCommand", []interface{}{arg1, arg2})
+	fake.streamCommandMutex.Unlock()
+	if fake.StreamCommandStub != nil {
+		return fake.StreamCommandStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamCommandReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecureShellClient) StreamCommandCallCount() int {
+	fake.streamCommandMutex.RLock()
+	defer fake.streamCommandMutex.RUnlock()
+	return len(fake.streamCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) StreamCommandCalls(stub func(string, io.Writer) ([]byte, error)) {
+	fake.streamCommandMutex.Lock()
+	defer fake.streamCommandMutex.Unlock()
+	fake.StreamCommandStub = stub
+}
+
+func (fake *FakeSecureShellClient) StreamCommandArgsForCall(i int) (string, io.Writer) {
+	fake.streamCommandMutex.RLock()
+	defer fake.streamCommandMutex.RUnlock()
+	argsForCall := fake.streamCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecureShellClient) StreamCommandReturns(result1 []byte, result2 error) {
+	fake.streamCommandMutex.Lock()
+	defer fake.streamCommandMutex.Unlock()
+	fake.StreamCommandStub = nil
+	fake.streamCommandReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureShellClient) StreamCommandReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.streamCommandMutex.Lock()
+	defer fake.streamCommandMutex.Unlock()
+	fake.StreamCommandStub = nil
+	if fake.streamCommandReturnsOnCall == nil {
+		fake.streamCommandReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.streamCommandReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -377,10 +652,18 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.connectWithKeyMutex.RLock()
+	defer fake.connectWithKeyMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.recordSessionMutex.RLock()
+	defer fake.recordSessionMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	fake.streamCommandMutex.RLock()
+	defer fake.streamCommandMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
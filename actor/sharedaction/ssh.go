@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -17,6 +21,8 @@ type SSHOptions struct {
 	Commands              []string
 	Username              string
 	Passcode              string
+	IdentityFile          string
+	RecordFile            string
 	Endpoint              string
 	HostKeyFingerprint    string
 	SkipHostValidation    bool
@@ -25,8 +31,28 @@ type SSHOptions struct {
 	LocalPortForwardSpecs []LocalPortForward
 }
 
+// ExecuteSecureShell connects to the instance and runs the session described
+// by sshOptions. The connection is authenticated with the private key in
+// IdentityFile when it is set, and with the one-time Passcode otherwise. When
+// RecordFile is set, the interactive session is recorded to that file in
+// asciicast format.
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
-	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if sshOptions.RecordFile != "" && !sshOptions.SkipRemoteExecution {
+		recording, err := os.Create(sshOptions.RecordFile)
+		if err != nil {
+			return err
+		}
+		defer recording.Close()
+
+		sshClient.RecordSession(recording)
+	}
+
+	var err error
+	if sshOptions.IdentityFile != "" {
+		err = sshClient.ConnectWithKey(sshOptions.Username, sshOptions.IdentityFile, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	} else {
+		err = sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	}
 	if err != nil {
 		return err
 	}
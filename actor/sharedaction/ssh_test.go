@@ -2,6 +2,9 @@ package sharedaction_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
@@ -53,6 +56,81 @@ var _ = Describe("SSH Actions", func() {
 			Expect(skipHostValidationArg).To(BeTrue())
 		})
 
+		When("an identity file is provided", func() {
+			BeforeEach(func() {
+				sshOptions.Passcode = ""
+				sshOptions.IdentityFile = "some-identity-file"
+			})
+
+			It("connects with the private key instead of a passcode", func() {
+				Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.ConnectWithKeyCallCount()).To(Equal(1))
+				usernameArg, identityFileArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectWithKeyArgsForCall(0)
+				Expect(usernameArg).To(Equal("some-user"))
+				Expect(identityFileArg).To(Equal("some-identity-file"))
+				Expect(endpointArg).To(Equal("some-endpoint"))
+				Expect(fingerprintArg).To(Equal("some-fingerprint"))
+				Expect(skipHostValidationArg).To(BeTrue())
+			})
+
+			When("connecting fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.ConnectWithKeyReturns(errors.New("some-connect-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-connect-error"))
+					Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a record file is provided", func() {
+			var tempDir string
+
+			BeforeEach(func() {
+				var err error
+				tempDir, err = ioutil.TempDir("", "ssh-record")
+				Expect(err).ToNot(HaveOccurred())
+				sshOptions.RecordFile = filepath.Join(tempDir, "session.cast")
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tempDir)).To(Succeed())
+			})
+
+			It("records the session to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.RecordSessionCallCount()).To(Equal(1))
+				recording, ok := fakeSecureShellClient.RecordSessionArgsForCall(0).(*os.File)
+				Expect(ok).To(BeTrue())
+				Expect(recording.Name()).To(Equal(sshOptions.RecordFile))
+				Expect(sshOptions.RecordFile).To(BeAnExistingFile())
+			})
+
+			When("the file cannot be created", func() {
+				BeforeEach(func() {
+					sshOptions.RecordFile = filepath.Join(tempDir, "missing-dir", "session.cast")
+				})
+
+				It("returns the error without connecting", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(0))
+				})
+			})
+
+			When("skipping remote execution", func() {
+				BeforeEach(func() {
+					sshOptions.SkipRemoteExecution = true
+				})
+
+				It("does not record", func() {
+					Expect(fakeSecureShellClient.RecordSessionCallCount()).To(Equal(0))
+					Expect(sshOptions.RecordFile).ToNot(BeAnExistingFile())
+				})
+			})
+		})
+
 		When("connecting fails", func() {
 			BeforeEach(func() {
 				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
@@ -117,6 +117,13 @@ func (actor Actor) CreateApplication(application Application) (Application, Warn
 	return Application(app), Warnings(warnings), err
 }
 
+// DeleteApplication deletes the application along with its service bindings
+// and route mappings.
+func (actor Actor) DeleteApplication(appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplication(appGUID)
+	return Warnings(warnings), err
+}
+
 // GetApplication returns the application.
 func (actor Actor) GetApplication(guid string) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.GetApplication(guid)
@@ -0,0 +1,104 @@
+package v2action
+
+// ApplicationDeletion is an application along with the resources that would
+// be left unused if it were deleted.
+type ApplicationDeletion struct {
+	Application Application
+
+	// OrphanedRoutes are the routes that are mapped to no other application.
+	OrphanedRoutes []Route
+
+	// OrphanedServiceInstances are the managed service instances that are
+	// bound to no other application and have no service keys. User provided
+	// service instances are never included.
+	OrphanedServiceInstances []ServiceInstance
+}
+
+// GetApplicationDeletion returns the named application along with the routes
+// and service instances that deleting it would leave orphaned.
+func (actor Actor) GetApplicationDeletion(appName string, spaceGUID string) (ApplicationDeletion, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationDeletion{}, allWarnings, err
+	}
+
+	deletion := ApplicationDeletion{Application: app}
+
+	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationDeletion{}, allWarnings, err
+	}
+
+	for _, route := range routes {
+		routeApps, warnings, err := actor.GetRouteApplications(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationDeletion{}, allWarnings, err
+		}
+
+		if onlyApplication(routeApps, app.GUID) {
+			deletion.OrphanedRoutes = append(deletion.OrphanedRoutes, route)
+		}
+	}
+
+	instances, warnings, err := actor.GetServiceInstancesByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationDeletion{}, allWarnings, err
+	}
+
+	for _, instance := range instances {
+		if instance.IsUserProvided() {
+			continue
+		}
+
+		orphaned, warnings, err := actor.serviceInstanceOnlyUsedBy(instance.GUID, app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationDeletion{}, allWarnings, err
+		}
+
+		if orphaned {
+			deletion.OrphanedServiceInstances = append(deletion.OrphanedServiceInstances, instance)
+		}
+	}
+
+	return deletion, allWarnings, nil
+}
+
+func (actor Actor) serviceInstanceOnlyUsedBy(serviceInstanceGUID string, appGUID string) (bool, Warnings, error) {
+	var allWarnings Warnings
+
+	bindings, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceBindings(serviceInstanceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	for _, binding := range bindings {
+		if binding.AppGUID != appGUID {
+			return false, allWarnings, nil
+		}
+	}
+
+	keys, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(serviceInstanceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	return len(keys) == 0, allWarnings, nil
+}
+
+func onlyApplication(apps []Application, appGUID string) bool {
+	for _, app := range apps {
+		if app.GUID != appGUID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Deletion Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationDeletion", func() {
+		var (
+			deletion   ApplicationDeletion
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			deletion, warnings, executeErr = actor.GetApplicationDeletion("some-app", "some-space-guid")
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("app-warning"))
+			})
+		})
+
+		When("the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv2.Warnings{"app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSharedDomainReturns(
+					ccv2.Domain{GUID: "some-domain-guid", Name: "example.com"},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationRoutesReturns(
+					[]ccv2.Route{
+						{GUID: "orphaned-route-guid", Host: "orphaned", DomainGUID: "some-domain-guid"},
+						{GUID: "shared-route-guid", Host: "shared", DomainGUID: "some-domain-guid"},
+					},
+					ccv2.Warnings{"routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, _ ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+					if routeGUID == "shared-route-guid" {
+						return []ccv2.Application{{GUID: "some-app-guid"}, {GUID: "other-app-guid"}}, ccv2.Warnings{"route-apps-warning"}, nil
+					}
+					return []ccv2.Application{{GUID: "some-app-guid"}}, ccv2.Warnings{"route-apps-warning"}, nil
+				}
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{ServiceInstanceGUID: "orphaned-instance-guid"},
+						{ServiceInstanceGUID: "shared-instance-guid"},
+						{ServiceInstanceGUID: "keyed-instance-guid"},
+						{ServiceInstanceGUID: "user-provided-instance-guid"},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceStub = func(guid string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+					instanceType := constant.ServiceInstanceTypeManagedService
+					if guid == "user-provided-instance-guid" {
+						instanceType = constant.ServiceInstanceTypeUserProvidedService
+					}
+					return ccv2.ServiceInstance{GUID: guid, Name: guid, Type: instanceType}, nil, nil
+				}
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsStub = func(guid string) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+					if guid == "shared-instance-guid" {
+						return []ccv2.ServiceBinding{{AppGUID: "some-app-guid"}, {AppGUID: "other-app-guid"}}, ccv2.Warnings{"bindings-warning"}, nil
+					}
+					return []ccv2.ServiceBinding{{AppGUID: "some-app-guid"}}, ccv2.Warnings{"bindings-warning"}, nil
+				}
+				fakeCloudControllerClient.GetServiceInstanceServiceKeysStub = func(guid string) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+					if guid == "keyed-instance-guid" {
+						return []ccv2.ServiceKey{{GUID: "some-key-guid"}}, ccv2.Warnings{"keys-warning"}, nil
+					}
+					return nil, ccv2.Warnings{"keys-warning"}, nil
+				}
+			})
+
+			It("returns the routes and managed service instances only the app uses", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("app-warning"))
+				Expect(warnings).To(ContainElement("routes-warning"))
+				Expect(warnings).To(ContainElement("route-apps-warning"))
+				Expect(warnings).To(ContainElement("bindings-warning"))
+				Expect(warnings).To(ContainElement("keys-warning"))
+
+				Expect(deletion.Application.GUID).To(Equal("some-app-guid"))
+
+				Expect(deletion.OrphanedRoutes).To(HaveLen(1))
+				Expect(deletion.OrphanedRoutes[0].GUID).To(Equal("orphaned-route-guid"))
+
+				Expect(deletion.OrphanedServiceInstances).To(HaveLen(1))
+				Expect(deletion.OrphanedServiceInstances[0].GUID).To(Equal("orphaned-instance-guid"))
+
+				Expect(fakeCloudControllerClient.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+
+			When("getting the service instance bindings fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceServiceBindingsStub = nil
+					fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, errors.New("bindings error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("bindings error"))
+					Expect(warnings).To(ContainElement("bindings-warning"))
+				})
+			})
+
+			When("getting the route applications fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouteApplicationsStub = nil
+					fakeCloudControllerClient.GetRouteApplicationsReturns(nil, ccv2.Warnings{"route-apps-warning"}, errors.New("route apps error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("route apps error"))
+					Expect(warnings).To(ContainElement("route-apps-warning"))
+					Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("DeleteApplication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete error"))
+		})
+
+		It("deletes the application and returns the warnings and error", func() {
+			warnings, err := actor.DeleteApplication("some-app-guid")
+			Expect(err).To(MatchError("delete error"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the service instance without purging it", func() {
+			warnings, err := actor.DeleteServiceInstance("some-instance-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(1))
+			guid, purge := fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)
+			Expect(guid).To(Equal("some-instance-guid"))
+			Expect(purge).To(BeFalse())
+		})
+	})
+})
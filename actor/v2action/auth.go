@@ -14,11 +14,6 @@ import (
 // It unsets the currently targeted org and space whether authentication
 // succeeds or not.
 func (actor Actor) Authenticate(ID string, secret string, origin string, grantType constant.GrantType) error {
-	if grantType == constant.GrantTypePassword && actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
-		return actionerror.PasswordGrantTypeLogoutRequiredError{}
-	}
-
-	actor.Config.UnsetOrganizationAndSpaceInformation()
 	credentials := make(map[string]string)
 
 	if grantType == constant.GrantTypePassword {
@@ -29,6 +24,22 @@ func (actor Actor) Authenticate(ID string, secret string, origin string, grantTy
 		credentials["client_secret"] = secret
 	}
 
+	return actor.AuthenticateWithCredentials(credentials, origin, grantType)
+}
+
+// AuthenticateWithCredentials authenticates the user in UAA with the given
+// credentials, such as a passcode or the values of custom login prompts, and
+// sets the returned tokens in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithCredentials(credentials map[string]string, origin string, grantType constant.GrantType) error {
+	if grantType == constant.GrantTypePassword && actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
 	accessToken, refreshToken, err := actor.UAAClient.Authenticate(credentials, origin, grantType)
 	if err != nil {
 		actor.Config.SetTokenInformation("", "", "")
@@ -41,7 +52,7 @@ func (actor Actor) Authenticate(ID string, secret string, origin string, grantTy
 	actor.Config.SetUAAGrantType(string(grantType))
 
 	if grantType == constant.GrantTypeClientCredentials {
-		actor.Config.SetUAAClientCredentials(ID, secret)
+		actor.Config.SetUAAClientCredentials(credentials["client_id"], credentials["client_secret"])
 	}
 
 	return nil
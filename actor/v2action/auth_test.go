@@ -147,6 +147,55 @@ var _ = Describe("Auth Actions", func() {
 		})
 	})
 
+	Describe("AuthenticateWithCredentials", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithCredentials(map[string]string{
+				"passcode": "some-passcode",
+				"pin":      "1234",
+			}, "", constant.GrantTypePassword)
+		})
+
+		When("no API errors occur", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			It("authenticates with the given credentials and stores the tokens", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+				creds, origin, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(creds).To(Equal(map[string]string{
+					"passcode": "some-passcode",
+					"pin":      "1234",
+				}))
+				Expect(origin).To(BeEmpty())
+				Expect(passedGrantType).To(Equal(constant.GrantTypePassword))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a previous user authenticated with a client grant type", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetLoginPrompts", func() {
 		When("getting login prompts info from UAA", func() {
 			var (
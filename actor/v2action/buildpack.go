@@ -39,6 +39,9 @@ type SimpleProgressBar interface {
 }
 
 type ProgressBar struct {
+	// Hidden reads the file without drawing the bar, for accessible mode.
+	Hidden bool
+
 	bar *pb.ProgressBar
 }
 
@@ -57,6 +60,10 @@ func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 		return nil, 0, err
 	}
 
+	if p.Hidden {
+		return file, fileInfo.Size(), nil
+	}
+
 	p.bar = pb.New(int(fileInfo.Size())).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
 	p.bar.Start()
@@ -65,6 +72,10 @@ func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 }
 
 func (p *ProgressBar) Terminate() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
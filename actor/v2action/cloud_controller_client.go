@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 //go:generate counterfeiter . CloudControllerClient
@@ -23,13 +24,16 @@ type CloudControllerClient interface {
 	CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	CreateSharedDomain(domainName string, routerGroupGUID string, isInternal bool) (ccv2.Warnings, error)
+	DeleteApplication(guid string) (ccv2.Warnings, error)
 	DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteRouteApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	DeleteSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	DeleteSecurityGroupStagingSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	DeleteService(serviceGUID string, purge bool) (ccv2.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string, purge bool) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string, acceptsIncomplete bool) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error)
 	DeleteServicePlanVisibility(servicePlanVisibilityGUID string) (ccv2.Warnings, error)
 	DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteSpaceUnmappedRoutes(spaceGUID string) (ccv2.Warnings, error)
@@ -39,7 +43,9 @@ type CloudControllerClient interface {
 	GetApplicationRoutes(appGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error)
 	GetBuildpacks(filters ...ccv2.Filter) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	GetConfigEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
 	GetConfigFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
+	GetEvents(filters ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, filters ...ccv2.Filter) ([]ccv2.Domain, ccv2.Warnings, error)
@@ -57,6 +63,7 @@ type CloudControllerClient interface {
 	GetServiceBrokers(filters ...ccv2.Filter) ([]ccv2.ServiceBroker, ccv2.Warnings, error)
 	GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 	GetServiceInstanceServiceBindings(serviceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	GetServiceInstanceServiceKeys(serviceInstanceGUID string) ([]ccv2.ServiceKey, ccv2.Warnings, error)
 	GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
 	GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
 	GetServiceInstances(filters ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
@@ -78,6 +85,7 @@ type CloudControllerClient interface {
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	GetStacks(filters ...ccv2.Filter) ([]ccv2.Stack, ccv2.Warnings, error)
 	GetUserProvidedServiceInstanceServiceBindings(userProvidedServiceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	GetUserSpaces(userGUID string, role constant.SpaceRole, filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	SetSpaceQuota(spaceGUID string, quotaGUID string) (ccv2.Warnings, error)
@@ -106,6 +114,7 @@ type CloudControllerClient interface {
 	AuthorizationEndpoint() string
 	DopplerEndpoint() string
 	MinCLIVersion() string
+	MinCLIVersionEnforcement() constant.MinCLIVersionEnforcement
 	RoutingEndpoint() string
 	TokenEndpoint() string
 }
@@ -8,6 +8,7 @@ type Config interface {
 	AccessToken() string
 	BinaryName() string
 	DialTimeout() time.Duration
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 	RefreshToken() string
 	SetAccessToken(accessToken string)
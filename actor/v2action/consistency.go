@@ -0,0 +1,76 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// PollOrganizationVisible waits until the organization is returned both when
+// getting it by GUID and when looking it up by name, so that commands run
+// right after creating it find it. It returns a
+// ResourceVisibilityTimeoutError if it is still not returned when the overall
+// polling timeout is reached.
+func (actor Actor) PollOrganizationVisible(org Organization) (Warnings, error) {
+	return actor.pollVisible("org", org.Name, func() (Warnings, error) {
+		_, warnings, err := actor.GetOrganization(org.GUID)
+		if err != nil {
+			return warnings, err
+		}
+
+		_, nameWarnings, err := actor.GetOrganizationByName(org.Name)
+		return append(warnings, nameWarnings...), err
+	})
+}
+
+// PollSpaceVisible waits until the space is returned when looking it up by
+// name in its organization. It returns a ResourceVisibilityTimeoutError if it
+// is still not returned when the overall polling timeout is reached.
+func (actor Actor) PollSpaceVisible(space Space) (Warnings, error) {
+	return actor.pollVisible("space", space.Name, func() (Warnings, error) {
+		_, warnings, err := actor.GetSpaceByOrganizationAndName(space.OrganizationGUID, space.Name)
+		return warnings, err
+	})
+}
+
+// PollRouteVisible waits until the route is returned when looking it up by
+// its host, domain, path and port. It returns a
+// ResourceVisibilityTimeoutError if it is still not returned when the overall
+// polling timeout is reached.
+func (actor Actor) PollRouteVisible(route Route) (Warnings, error) {
+	return actor.pollVisible("route", route.String(), func() (Warnings, error) {
+		_, warnings, err := actor.GetRouteByComponents(route)
+		return warnings, err
+	})
+}
+
+// pollVisible calls lookup until it stops returning a not found error. Any
+// other error is returned straight away.
+func (actor Actor) pollVisible(resourceType string, name string, lookup func() (Warnings, error)) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.OverallPollingTimeout())
+	for {
+		warnings, err := lookup()
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			return allWarnings, nil
+		}
+		if !isNotFoundError(err) {
+			return allWarnings, err
+		}
+
+		if !time.Now().Before(timeout) {
+			return allWarnings, actionerror.ResourceVisibilityTimeoutError{Type: resourceType, Name: name}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
+func isNotFoundError(err error) bool {
+	switch err.(type) {
+	case actionerror.OrganizationNotFoundError, actionerror.SpaceNotFoundError, actionerror.RouteNotFoundError:
+		return true
+	}
+	return false
+}
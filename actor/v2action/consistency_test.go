@@ -0,0 +1,178 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Consistency Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("PollOrganizationVisible", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollOrganizationVisible(Organization{GUID: "some-org-guid", Name: "some-org"})
+		})
+
+		When("the org becomes visible", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationReturnsOnCall(0, ccv2.Organization{}, ccv2.Warnings{"get-warning-1"}, ccerror.ResourceNotFoundError{})
+				fakeCloudControllerClient.GetOrganizationReturnsOnCall(1, ccv2.Organization{GUID: "some-org-guid"}, ccv2.Warnings{"get-warning-2"}, nil)
+				fakeCloudControllerClient.GetOrganizationReturnsOnCall(2, ccv2.Organization{GUID: "some-org-guid"}, ccv2.Warnings{"get-warning-3"}, nil)
+				fakeCloudControllerClient.GetOrganizationsReturnsOnCall(0, nil, ccv2.Warnings{"list-warning-1"}, nil)
+				fakeCloudControllerClient.GetOrganizationsReturnsOnCall(1, []ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}}, ccv2.Warnings{"list-warning-2"}, nil)
+			})
+
+			It("polls until the org is returned by GUID and by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning-1", "get-warning-2", "list-warning-1", "get-warning-3", "list-warning-2"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.NameFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org"},
+				}))
+			})
+		})
+
+		When("getting the org fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"get-warning"}, errors.New("get error"))
+			})
+
+			It("returns the error without polling", func() {
+				Expect(executeErr).To(MatchError("get error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the org is still not visible at the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+				fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{}, nil, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a ResourceVisibilityTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "org", Name: "some-org"}))
+			})
+		})
+	})
+
+	Describe("PollSpaceVisible", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollSpaceVisible(Space{GUID: "some-space-guid", Name: "some-space", OrganizationGUID: "some-org-guid"})
+		})
+
+		When("the space becomes visible", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturnsOnCall(0, nil, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetSpacesReturnsOnCall(1, []ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}}, ccv2.Warnings{"warning-2"}, nil)
+			})
+
+			It("polls until the space is returned by name in its org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{
+						Type:     constant.NameFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-space"},
+					},
+					ccv2.Filter{
+						Type:     constant.OrganizationGUIDFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-org-guid"},
+					},
+				))
+			})
+		})
+
+		When("the space is still not visible at the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a ResourceVisibilityTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "space", Name: "some-space"}))
+			})
+		})
+	})
+
+	Describe("PollRouteVisible", func() {
+		var (
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				GUID:   "some-route-guid",
+				Host:   "some-host",
+				Domain: Domain{GUID: "some-domain-guid", Name: "some-domain.com"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollRouteVisible(route)
+		})
+
+		When("the route becomes visible", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturnsOnCall(0, nil, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetRoutesReturnsOnCall(1, []ccv2.Route{{GUID: "some-route-guid", Host: "some-host", DomainGUID: "some-domain-guid"}}, ccv2.Warnings{"warning-2"}, nil)
+			})
+
+			It("polls until the route is returned by its components", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the route is still not visible at the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a ResourceVisibilityTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "route", Name: "some-host.some-domain.com"}))
+			})
+		})
+	})
+})
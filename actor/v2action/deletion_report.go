@@ -0,0 +1,194 @@
+package v2action
+
+import "sort"
+
+// DeletionReport lists what is destroyed along with an org or space.
+type DeletionReport struct {
+	Spaces []SpaceDeletionReport
+}
+
+// SpaceDeletionReport lists the resources of a space destroyed along with it.
+type SpaceDeletionReport struct {
+	Name             string
+	Applications     []string
+	ServiceInstances []ServiceInstanceDeletionReport
+	Routes           []string
+}
+
+// ServiceInstanceDeletionReport describes a service instance destroyed along
+// with its space: the apps it is bound to and its service keys.
+type ServiceInstanceDeletionReport struct {
+	Name              string
+	BoundApplications []string
+	ServiceKeys       []string
+}
+
+// GetOrganizationDeletionReport returns what deleting the org destroys,
+// space by space.
+func (actor Actor) GetOrganizationDeletionReport(orgName string) (DeletionReport, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return DeletionReport{}, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DeletionReport{}, allWarnings, err
+	}
+
+	var report DeletionReport
+	for _, space := range spaces {
+		spaceReport, warnings, err := actor.getSpaceDeletionReport(space)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DeletionReport{}, allWarnings, err
+		}
+		report.Spaces = append(report.Spaces, spaceReport)
+	}
+
+	return report, allWarnings, nil
+}
+
+// GetSpaceDeletionReport returns what deleting the space destroys.
+func (actor Actor) GetSpaceDeletionReport(spaceName string, orgName string) (DeletionReport, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return DeletionReport{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DeletionReport{}, allWarnings, err
+	}
+
+	spaceReport, warnings, err := actor.getSpaceDeletionReport(space)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DeletionReport{}, allWarnings, err
+	}
+
+	return DeletionReport{Spaces: []SpaceDeletionReport{spaceReport}}, allWarnings, nil
+}
+
+// StartOrganizationDeletion requests the deletion of the org and returns the
+// deletion job without waiting for it to finish.
+func (actor Actor) StartOrganizationDeletion(orgName string) (Job, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	job, warnings, err := actor.CloudControllerClient.DeleteOrganizationJob(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return Job(job), allWarnings, err
+}
+
+// StartSpaceDeletion requests the deletion of the space and returns the
+// deletion job without waiting for it to finish.
+func (actor Actor) StartSpaceDeletion(spaceName string, orgName string) (Job, Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Job{}, allWarnings, err
+	}
+
+	job, ccWarnings, err := actor.CloudControllerClient.DeleteSpaceJob(space.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	return Job(job), allWarnings, err
+}
+
+func (actor Actor) getSpaceDeletionReport(space Space) (SpaceDeletionReport, Warnings, error) {
+	report := SpaceDeletionReport{Name: space.Name}
+
+	apps, allWarnings, err := actor.GetApplicationsBySpace(space.GUID)
+	if err != nil {
+		return SpaceDeletionReport{}, allWarnings, err
+	}
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+		report.Applications = append(report.Applications, app.Name)
+	}
+	sort.Strings(report.Applications)
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceDeletionReport{}, allWarnings, err
+	}
+	for _, serviceInstance := range serviceInstances {
+		instanceReport, warnings, err := actor.getServiceInstanceDeletionReport(serviceInstance, appNames)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceDeletionReport{}, allWarnings, err
+		}
+		report.ServiceInstances = append(report.ServiceInstances, instanceReport)
+	}
+	sort.Slice(report.ServiceInstances, func(i, j int) bool {
+		return report.ServiceInstances[i].Name < report.ServiceInstances[j].Name
+	})
+
+	routes, warnings, err := actor.GetSpaceRoutes(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceDeletionReport{}, allWarnings, err
+	}
+	for _, route := range routes {
+		report.Routes = append(report.Routes, route.String())
+	}
+	sort.Strings(report.Routes)
+
+	return report, allWarnings, nil
+}
+
+// getServiceInstanceDeletionReport names the bound apps using appNames, the
+// apps of the space by GUID. Apps bound from other spaces through service
+// instance sharing are reported by GUID.
+func (actor Actor) getServiceInstanceDeletionReport(serviceInstance ServiceInstance, appNames map[string]string) (ServiceInstanceDeletionReport, Warnings, error) {
+	report := ServiceInstanceDeletionReport{Name: serviceInstance.Name}
+
+	var (
+		bindings    []ServiceBinding
+		allWarnings Warnings
+		err         error
+	)
+	if serviceInstance.IsUserProvided() {
+		bindings, allWarnings, err = actor.GetServiceBindingsByUserProvidedServiceInstance(serviceInstance.GUID)
+	} else {
+		bindings, allWarnings, err = actor.GetServiceBindingsByServiceInstance(serviceInstance.GUID)
+	}
+	if err != nil {
+		return ServiceInstanceDeletionReport{}, allWarnings, err
+	}
+	for _, binding := range bindings {
+		appName, ok := appNames[binding.AppGUID]
+		if !ok {
+			appName = binding.AppGUID
+		}
+		report.BoundApplications = append(report.BoundApplications, appName)
+	}
+	sort.Strings(report.BoundApplications)
+
+	if serviceInstance.IsUserProvided() {
+		return report, allWarnings, nil
+	}
+
+	serviceKeys, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstanceDeletionReport{}, allWarnings, err
+	}
+	for _, serviceKey := range serviceKeys {
+		report.ServiceKeys = append(report.ServiceKeys, serviceKey.Name)
+	}
+	sort.Strings(report.ServiceKeys)
+
+	return report, allWarnings, nil
+}
@@ -0,0 +1,158 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deletion Report Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetOrganizationsReturns(
+			[]ccv2.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+			ccv2.Warnings{"get-org-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSpacesReturns(
+			[]ccv2.Space{{Name: "some-space", GUID: "some-space-guid"}},
+			ccv2.Warnings{"get-spaces-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv2.Application{
+				{Name: "app-2", GUID: "app-guid-2"},
+				{Name: "app-1", GUID: "app-guid-1"},
+			},
+			ccv2.Warnings{"get-apps-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+			[]ccv2.ServiceInstance{
+				{Name: "managed-instance", GUID: "managed-instance-guid", Type: constant.ServiceInstanceTypeManagedService},
+				{Name: "user-provided-instance", GUID: "user-provided-instance-guid", Type: constant.ServiceInstanceTypeUserProvidedService},
+			},
+			ccv2.Warnings{"get-instances-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+			[]ccv2.ServiceBinding{{AppGUID: "app-guid-2"}, {AppGUID: "shared-app-guid"}},
+			ccv2.Warnings{"get-bindings-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(
+			[]ccv2.ServiceBinding{{AppGUID: "app-guid-1"}},
+			nil,
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+			[]ccv2.ServiceKey{{Name: "some-key"}},
+			ccv2.Warnings{"get-keys-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSpaceRoutesReturns(
+			[]ccv2.Route{{Host: "some-host", DomainGUID: "some-domain-guid"}},
+			ccv2.Warnings{"get-routes-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "some-domain-guid", Name: "example.com"}, nil, nil)
+	})
+
+	expectedSpaceReport := SpaceDeletionReport{
+		Name:         "some-space",
+		Applications: []string{"app-1", "app-2"},
+		ServiceInstances: []ServiceInstanceDeletionReport{
+			{Name: "managed-instance", BoundApplications: []string{"app-2", "shared-app-guid"}, ServiceKeys: []string{"some-key"}},
+			{Name: "user-provided-instance", BoundApplications: []string{"app-1"}},
+		},
+		Routes: []string{"some-host.example.com"},
+	}
+
+	Describe("GetOrganizationDeletionReport", func() {
+		It("reports the resources of every space in the org", func() {
+			report, warnings, err := actor.GetOrganizationDeletionReport("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report).To(Equal(DeletionReport{Spaces: []SpaceDeletionReport{expectedSpaceReport}}))
+			Expect(warnings).To(ConsistOf(
+				"get-org-warning", "get-spaces-warning", "get-apps-warning", "get-instances-warning",
+				"get-bindings-warning", "get-keys-warning", "get-routes-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceKeysCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceKeysArgsForCall(0)).To(Equal("managed-instance-guid"))
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, nil, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				_, _, err := actor.GetOrganizationDeletionReport("some-org")
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+			})
+		})
+
+		When("getting the service keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationDeletionReport("some-org")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ContainElement("get-keys-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceDeletionReport", func() {
+		It("reports the resources of the space", func() {
+			report, _, err := actor.GetSpaceDeletionReport("some-space", "some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report).To(Equal(DeletionReport{Spaces: []SpaceDeletionReport{expectedSpaceReport}}))
+		})
+	})
+
+	Describe("StartOrganizationDeletion", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteOrganizationJobReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("requests the deletion and returns the job", func() {
+			job, warnings, err := actor.StartOrganizationDeletion("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job).To(Equal(Job{GUID: "some-job-guid"}))
+			Expect(warnings).To(ConsistOf("get-org-warning", "delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteOrganizationJobArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("StartSpaceDeletion", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteSpaceJobReturns(ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("requests the deletion and returns the job", func() {
+			job, warnings, err := actor.StartSpaceDeletion("some-space", "some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job).To(Equal(Job{GUID: "some-job-guid"}))
+			Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning", "delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteSpaceJobArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Authorization Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient, fakeConfig)
+	})
+
+	Describe("StartDeviceAuthorization", func() {
+		It("returns the device authorization from UAA", func() {
+			fakeUAAClient.StartDeviceAuthorizationReturns(uaa.DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "ABCD-EFGH",
+			}, nil)
+
+			deviceAuthorization, err := actor.StartDeviceAuthorization()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deviceAuthorization).To(Equal(DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "ABCD-EFGH",
+			}))
+		})
+	})
+
+	Describe("AuthenticateWithDeviceAuthorization", func() {
+		var executeErr error
+
+		JustBeforeEach(func() {
+			executeErr = actor.AuthenticateWithDeviceAuthorization(DeviceAuthorization{DeviceCode: "some-device-code"}, time.Minute)
+		})
+
+		When("the user approves the request", func() {
+			BeforeEach(func() {
+				fakeUAAClient.PollDeviceAuthorizationReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			It("stores the tokens and the grant type", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeUAAClient.PollDeviceAuthorizationCallCount()).To(Equal(1))
+				deviceAuthorization, timeout := fakeUAAClient.PollDeviceAuthorizationArgsForCall(0)
+				Expect(deviceAuthorization).To(Equal(uaa.DeviceAuthorization{DeviceCode: "some-device-code"}))
+				Expect(timeout).To(Equal(time.Minute))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeDeviceCode)))
+			})
+		})
+
+		When("polling fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.PollDeviceAuthorizationReturns("", "", errors.New("some error"))
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(executeErr).To(MatchError("some error"))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a previous user authenticated with a client grant type", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.PollDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package v2action
+
+import (
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// EnvironmentVariableGroupVariable is a variable of the staging or running
+// environment variable group, or both.
+type EnvironmentVariableGroupVariable struct {
+	Name string
+
+	StagingValue interface{}
+	InStaging    bool
+
+	RunningValue interface{}
+	InRunning    bool
+}
+
+// Differs returns true when the variable is only set in one of the groups,
+// or is set to different values in each.
+func (variable EnvironmentVariableGroupVariable) Differs() bool {
+	if variable.InStaging != variable.InRunning {
+		return true
+	}
+	return !reflect.DeepEqual(variable.StagingValue, variable.RunningValue)
+}
+
+// GetEnvironmentVariableGroups returns the variables of the staging and
+// running environment variable groups side by side, sorted by name.
+func (actor Actor) GetEnvironmentVariableGroups() ([]EnvironmentVariableGroupVariable, Warnings, error) {
+	var allWarnings Warnings
+
+	staging, warnings, err := actor.CloudControllerClient.GetConfigEnvironmentVariableGroup(constant.StagingEnvironmentVariableGroup)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	running, warnings, err := actor.CloudControllerClient.GetConfigEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	variablesByName := map[string]*EnvironmentVariableGroupVariable{}
+	variable := func(name string) *EnvironmentVariableGroupVariable {
+		if _, ok := variablesByName[name]; !ok {
+			variablesByName[name] = &EnvironmentVariableGroupVariable{Name: name}
+		}
+		return variablesByName[name]
+	}
+
+	for name, value := range staging {
+		v := variable(name)
+		v.StagingValue = value
+		v.InStaging = true
+	}
+	for name, value := range running {
+		v := variable(name)
+		v.RunningValue = value
+		v.InRunning = true
+	}
+
+	variables := make([]EnvironmentVariableGroupVariable, 0, len(variablesByName))
+	for _, v := range variablesByName {
+		variables = append(variables, *v)
+	}
+	sort.Slice(variables, func(i int, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	return variables, allWarnings, nil
+}
@@ -0,0 +1,84 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Group Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetEnvironmentVariableGroups", func() {
+		var (
+			variables []EnvironmentVariableGroupVariable
+			warnings  Warnings
+			err       error
+		)
+
+		JustBeforeEach(func() {
+			variables, warnings, err = actor.GetEnvironmentVariableGroups()
+		})
+
+		When("both groups are retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturnsOnCall(0,
+					ccv2.EnvironmentVariableGroup{"SHARED": "same", "DB_POOL": float64(5), "STAGING_ONLY": "a"},
+					ccv2.Warnings{"staging-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturnsOnCall(1,
+					ccv2.EnvironmentVariableGroup{"SHARED": "same", "DB_POOL": float64(10), "RUNNING_ONLY": "b"},
+					ccv2.Warnings{"running-warning"},
+					nil,
+				)
+			})
+
+			It("returns the variables of both groups side by side, sorted by name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("staging-warning", "running-warning"))
+
+				Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupArgsForCall(0)).To(Equal(constant.StagingEnvironmentVariableGroup))
+				Expect(fakeCloudControllerClient.GetConfigEnvironmentVariableGroupArgsForCall(1)).To(Equal(constant.RunningEnvironmentVariableGroup))
+
+				Expect(variables).To(Equal([]EnvironmentVariableGroupVariable{
+					{Name: "DB_POOL", StagingValue: float64(5), InStaging: true, RunningValue: float64(10), InRunning: true},
+					{Name: "RUNNING_ONLY", RunningValue: "b", InRunning: true},
+					{Name: "SHARED", StagingValue: "same", InStaging: true, RunningValue: "same", InRunning: true},
+					{Name: "STAGING_ONLY", StagingValue: "a", InStaging: true},
+				}))
+
+				Expect(variables[0].Differs()).To(BeTrue())
+				Expect(variables[1].Differs()).To(BeTrue())
+				Expect(variables[2].Differs()).To(BeFalse())
+				Expect(variables[3].Differs()).To(BeTrue())
+			})
+		})
+
+		When("getting the running group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturnsOnCall(0, nil, ccv2.Warnings{"staging-warning"}, nil)
+				fakeCloudControllerClient.GetConfigEnvironmentVariableGroupReturnsOnCall(1, nil, ccv2.Warnings{"running-warning"}, errors.New("running error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError("running error"))
+				Expect(warnings).To(ConsistOf("staging-warning", "running-warning"))
+			})
+		})
+	})
+})
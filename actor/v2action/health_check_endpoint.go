@@ -0,0 +1,79 @@
+package v2action
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+//go:generate counterfeiter . HealthCheckEndpointProber
+
+// HealthCheckEndpointProber requests a URL through the router and reports
+// the status code of the response, and whether the router sent the request
+// to an application at all.
+type HealthCheckEndpointProber interface {
+	EndpointStatus(url string) (int, bool, error)
+}
+
+// VerifyHealthCheckEndpoint requests the endpoint through one of the
+// application's HTTP routes until the application answers it successfully.
+// A 404 from the application fails immediately; any other unsuccessful
+// response is retried until the timeout.
+func (actor Actor) VerifyHealthCheckEndpoint(app Application, endpoint string, prober HealthCheckEndpointProber, timeout time.Duration) (Warnings, error) {
+	routes, warnings, err := actor.GetApplicationRoutes(app.GUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	route, found := healthCheckRoute(routes)
+	if !found {
+		return warnings, actionerror.ApplicationWithoutHTTPRouteError{AppName: app.Name}
+	}
+	url := fmt.Sprintf("https://%s%s", route, endpoint)
+
+	var lastStatusCode int
+	deadline := time.Now().Add(timeout)
+	for {
+		statusCode, routed, err := prober.EndpointStatus(url)
+		if err != nil {
+			return warnings, err
+		}
+
+		if routed {
+			lastStatusCode = statusCode
+			switch {
+			case statusCode < http.StatusBadRequest:
+				return warnings, nil
+			case statusCode == http.StatusNotFound:
+				return warnings, actionerror.HealthCheckEndpointFailedError{AppName: app.Name, URL: url, StatusCode: statusCode}
+			}
+		}
+
+		if !time.Now().Before(deadline) {
+			return warnings, actionerror.HealthCheckEndpointFailedError{AppName: app.Name, URL: url, StatusCode: lastStatusCode}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
+// healthCheckRoute returns the HTTP route to verify a health check endpoint
+// through, preferring routes without a path since the platform health check
+// requests the endpoint without one.
+func healthCheckRoute(routes Routes) (Route, bool) {
+	var candidate Route
+	var found bool
+	for _, route := range routes {
+		if route.Domain.IsTCP() || route.Domain.Internal {
+			continue
+		}
+		if route.Path == "" {
+			return route, true
+		}
+		if !found {
+			candidate, found = route, true
+		}
+	}
+	return candidate, found
+}
@@ -0,0 +1,161 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Health Check Endpoint Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("VerifyHealthCheckEndpoint", func() {
+		var (
+			fakeProber *v2actionfakes.FakeHealthCheckEndpointProber
+			timeout    time.Duration
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeProber = new(v2actionfakes.FakeHealthCheckEndpointProber)
+			timeout = time.Second
+
+			fakeCloudControllerClient.GetApplicationRoutesReturns([]ccv2.Route{
+				{GUID: "tcp-route-guid", Port: types.NullInt{IsSet: true, Value: 1024}, DomainGUID: "tcp-domain-guid"},
+				{GUID: "path-route-guid", Host: "some-host", Path: "/some-path", DomainGUID: "http-domain-guid"},
+				{GUID: "http-route-guid", Host: "some-host", DomainGUID: "http-domain-guid"},
+			}, ccv2.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+				if domainGUID == "tcp-domain-guid" {
+					return ccv2.Domain{GUID: domainGUID, Name: "tcp.com", RouterGroupType: constant.TCPRouterGroup}, nil, nil
+				}
+				return ccv2.Domain{GUID: domainGUID, Name: "example.com"}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.VerifyHealthCheckEndpoint(
+				Application{GUID: "some-app-guid", Name: "some-app"},
+				"/actuator/health",
+				fakeProber,
+				timeout,
+			)
+		})
+
+		When("the endpoint responds successfully once the route is registered", func() {
+			BeforeEach(func() {
+				fakeProber.EndpointStatusReturnsOnCall(0, 404, false, nil)
+				fakeProber.EndpointStatusReturnsOnCall(1, 503, true, nil)
+				fakeProber.EndpointStatusReturns(200, true, nil)
+			})
+
+			It("probes the endpoint on the HTTP route without a path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+
+				Expect(fakeProber.EndpointStatusCallCount()).To(Equal(3))
+				Expect(fakeProber.EndpointStatusArgsForCall(0)).To(Equal("https://some-host.example.com/actuator/health"))
+			})
+		})
+
+		When("the app responds with a 404", func() {
+			BeforeEach(func() {
+				fakeProber.EndpointStatusReturns(404, true, nil)
+			})
+
+			It("fails without retrying", func() {
+				Expect(executeErr).To(MatchError(actionerror.HealthCheckEndpointFailedError{
+					AppName:    "some-app",
+					URL:        "https://some-host.example.com/actuator/health",
+					StatusCode: 404,
+				}))
+				Expect(fakeProber.EndpointStatusCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the endpoint keeps failing until the timeout", func() {
+			BeforeEach(func() {
+				timeout = 0
+				fakeProber.EndpointStatusReturns(503, true, nil)
+			})
+
+			It("returns the last status code", func() {
+				Expect(executeErr).To(MatchError(actionerror.HealthCheckEndpointFailedError{
+					AppName:    "some-app",
+					URL:        "https://some-host.example.com/actuator/health",
+					StatusCode: 503,
+				}))
+			})
+		})
+
+		When("the router never routes to the app", func() {
+			BeforeEach(func() {
+				timeout = 0
+				fakeProber.EndpointStatusReturns(404, false, nil)
+			})
+
+			It("returns an error without a status code", func() {
+				Expect(executeErr).To(MatchError(actionerror.HealthCheckEndpointFailedError{
+					AppName: "some-app",
+					URL:     "https://some-host.example.com/actuator/health",
+				}))
+			})
+		})
+
+		When("probing fails", func() {
+			BeforeEach(func() {
+				fakeProber.EndpointStatusReturns(0, false, errors.New("dial-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("dial-error"))
+			})
+		})
+
+		When("the app has no HTTP route", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns([]ccv2.Route{
+					{GUID: "tcp-route-guid", Port: types.NullInt{IsSet: true, Value: 1024}, DomainGUID: "tcp-domain-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns an ApplicationWithoutHTTPRouteError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationWithoutHTTPRouteError{AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(fakeProber.EndpointStatusCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+})
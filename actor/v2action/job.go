@@ -2,17 +2,75 @@ package v2action
 
 import (
 	"io"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type Job ccv2.Job
 
+// JobProgress is the state of a job reported by PollJobWithProgress.
+type JobProgress struct {
+	Status  constant.JobStatus
+	Elapsed time.Duration
+}
+
+// GetJob returns the job with the given GUID.
+func (actor Actor) GetJob(jobGUID string) (Job, Warnings, error) {
+	job, warnings, err := actor.CloudControllerClient.GetJob(jobGUID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Job{}, Warnings(warnings), actionerror.JobNotFoundError{GUID: jobGUID}
+	}
+	return Job(job), Warnings(warnings), err
+}
+
 func (actor Actor) PollJob(job Job) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.PollJob(ccv2.Job(job))
 	return Warnings(warnings), err
 }
 
+// PollJobWithProgress polls the job until it finishes, calling progress after
+// every poll that finds it still queued or running. Like PollJob, it returns
+// a JobTimeoutError when the job has not finished within the overall polling
+// timeout.
+func (actor Actor) PollJobWithProgress(job Job, progress func(JobProgress)) (Warnings, error) {
+	var allWarnings Warnings
+	startTime := time.Now()
+	timeout := actor.Config.OverallPollingTimeout()
+
+	for {
+		ccJob, warnings, err := actor.CloudControllerClient.GetJob(job.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if ccJob.Failed() {
+			return allWarnings, ccerror.V2JobFailedError{
+				JobGUID: job.GUID,
+				Message: ccJob.ErrorDetails.Description,
+			}
+		}
+		if ccJob.Finished() {
+			return allWarnings, nil
+		}
+
+		elapsed := time.Since(startTime)
+		if elapsed >= timeout {
+			return allWarnings, ccerror.JobTimeoutError{
+				JobGUID: job.GUID,
+				Timeout: timeout,
+			}
+		}
+		progress(JobProgress{Status: ccJob.Status, Elapsed: elapsed})
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
 func (actor Actor) UploadApplicationPackage(appGUID string, existingResources []Resource, newResources io.Reader, newResourcesLength int64) (Job, Warnings, error) {
 	job, warnings, err := actor.CloudControllerClient.UploadApplicationPackage(appGUID, actor.actorToCCResources(existingResources), newResources, newResourcesLength)
 	return Job(job), Warnings(warnings), err
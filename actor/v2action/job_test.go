@@ -4,10 +4,14 @@ import (
 	"errors"
 	"io"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -23,6 +27,129 @@ var _ = Describe("Job Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil, nil)
 	})
 
+	Describe("GetJob", func() {
+		var (
+			job        Job
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			job, warnings, executeErr = actor.GetJob("some-job-guid")
+		})
+
+		When("the job exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{GUID: "some-job-guid", Status: constant.JobStatusRunning}, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns the job and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: constant.JobStatusRunning}))
+
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		When("the job does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a JobNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.JobNotFoundError{GUID: "some-job-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("getting the job returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, errors.New("get job error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get job error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("PollJobWithProgress", func() {
+		var (
+			fakeConfig *v2actionfakes.FakeConfig
+			progress   []JobProgress
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+			progress = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PollJobWithProgress(Job{GUID: "some-job-guid"}, func(jobProgress JobProgress) {
+				progress = append(progress, jobProgress)
+			})
+		})
+
+		When("the job finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturnsOnCall(0, ccv2.Job{Status: constant.JobStatusQueued}, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetJobReturnsOnCall(1, ccv2.Job{Status: constant.JobStatusRunning}, ccv2.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetJobReturnsOnCall(2, ccv2.Job{Status: constant.JobStatusFinished}, ccv2.Warnings{"warning-3"}, nil)
+			})
+
+			It("reports progress until the job finishes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+				Expect(progress).To(HaveLen(2))
+				Expect(progress[0].Status).To(Equal(constant.JobStatusQueued))
+				Expect(progress[1].Status).To(Equal(constant.JobStatusRunning))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			})
+		})
+
+		When("the job fails", func() {
+			BeforeEach(func() {
+				failedJob := ccv2.Job{Status: constant.JobStatusFailed}
+				failedJob.ErrorDetails.Description = "some-description"
+				fakeCloudControllerClient.GetJobReturns(failedJob, nil, nil)
+			})
+
+			It("returns a V2JobFailedError", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "some-description"}))
+			})
+		})
+
+		When("the job does not finish in time", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{Status: constant.JobStatusRunning}, nil, nil)
+			})
+
+			It("returns a JobTimeoutError", func() {
+				Expect(executeErr).To(MatchError(ccerror.JobTimeoutError{JobGUID: "some-job-guid", Timeout: 0}))
+			})
+		})
+
+		When("getting the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv2.Job{}, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("UploadApplicationPackage", func() {
 		var (
 			appGUID           string
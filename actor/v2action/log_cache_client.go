@@ -0,0 +1,14 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+)
+
+//go:generate counterfeiter . LogCacheClient
+
+// LogCacheClient is a Log Cache API client.
+type LogCacheClient interface {
+	ReadLogs(sourceID string, start time.Time) ([]logcache.Envelope, error)
+}
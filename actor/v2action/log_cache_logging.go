@@ -0,0 +1,106 @@
+package v2action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// recentLogCacheWindow is how far back recent logs are read from Log Cache.
+const recentLogCacheWindow = time.Hour
+
+// recentLogCacheLimit is the maximum number of recent logs returned from Log
+// Cache.
+const recentLogCacheLimit = 1000
+
+// logCachePollInterval is how often Log Cache is polled for new logs.
+const logCachePollInterval = time.Second
+
+// GetRecentLogsFromLogCacheForApplicationByNameAndSpace returns the logs
+// emitted by the application in the last hour, up to the most recent 1000,
+// as read from Log Cache, oldest first.
+func (actor Actor) GetRecentLogsFromLogCacheForApplicationByNameAndSpace(appName string, spaceGUID string, client LogCacheClient) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	envelopes, err := client.ReadLogs(app.GUID, time.Now().Add(-recentLogCacheWindow))
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sortEnvelopes(envelopes)
+	if len(envelopes) > recentLogCacheLimit {
+		envelopes = envelopes[len(envelopes)-recentLogCacheLimit:]
+	}
+
+	var logMessages []LogMessage
+	for _, envelope := range envelopes {
+		logMessages = append(logMessages, logMessageFromEnvelope(envelope))
+	}
+
+	return logMessages, allWarnings, nil
+}
+
+// GetStreamingLogsFromLogCacheForApplicationByNameAndSpace polls Log Cache
+// for the logs the application emits from now on. Polling stops after the
+// first error, which is sent on the error channel before both channels are
+// closed.
+func (actor Actor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpace(appName string, spaceGUID string, client LogCacheClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	outgoingLogStream := make(chan *LogMessage)
+	outgoingErrStream := make(chan error, 1)
+
+	go func() {
+		defer close(outgoingLogStream)
+		defer close(outgoingErrStream)
+
+		start := time.Now()
+		for {
+			envelopes, readErr := client.ReadLogs(app.GUID, start)
+			if readErr != nil {
+				outgoingErrStream <- readErr
+				return
+			}
+
+			sortEnvelopes(envelopes)
+			for _, envelope := range envelopes {
+				message := logMessageFromEnvelope(envelope)
+				outgoingLogStream <- &message
+				start = envelope.Timestamp.Add(time.Nanosecond)
+			}
+
+			time.Sleep(logCachePollInterval)
+		}
+	}()
+
+	return outgoingLogStream, outgoingErrStream, allWarnings, nil
+}
+
+func logMessageFromEnvelope(envelope logcache.Envelope) LogMessage {
+	messageType := events.LogMessage_OUT
+	if envelope.LogType == "ERR" {
+		messageType = events.LogMessage_ERR
+	}
+
+	return LogMessage{
+		message:        envelope.Payload,
+		messageType:    messageType,
+		timestamp:      envelope.Timestamp,
+		sourceType:     envelope.Tags["source_type"],
+		sourceInstance: envelope.InstanceID,
+	}
+}
+
+func sortEnvelopes(envelopes []logcache.Envelope) {
+	sort.SliceStable(envelopes, func(i int, j int) bool {
+		return envelopes[i].Timestamp.Before(envelopes[j].Timestamp)
+	})
+}
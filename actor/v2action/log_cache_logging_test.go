@@ -0,0 +1,174 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/logcache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log Cache Logging Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *v2actionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _ = NewTestActor()
+		fakeLogCacheClient = new(v2actionfakes.FakeLogCacheClient)
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv2.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv2.Warnings{"some-app-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetRecentLogsFromLogCacheForApplicationByNameAndSpace", func() {
+		var (
+			messages   []LogMessage
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetRecentLogsFromLogCacheForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+		})
+
+		When("Log Cache returns logs", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturns([]logcache.Envelope{
+					{
+						Timestamp:  time.Unix(102, 0),
+						InstanceID: "1",
+						LogType:    "ERR",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Payload:    "second message",
+					},
+					{
+						Timestamp:  time.Unix(101, 0),
+						InstanceID: "0",
+						LogType:    "OUT",
+						Tags:       map[string]string{"source_type": "STG"},
+						Payload:    "first message",
+					},
+				}, nil)
+			})
+
+			It("returns the logs from the last hour, oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(messages).To(HaveLen(2))
+
+				Expect(messages[0].Message()).To(Equal("first message"))
+				Expect(messages[0].Type()).To(Equal("OUT"))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(101, 0)))
+				Expect(messages[0].SourceType()).To(Equal("STG"))
+				Expect(messages[0].SourceInstance()).To(Equal("0"))
+
+				Expect(messages[1].Message()).To(Equal("second message"))
+				Expect(messages[1].Type()).To(Equal("ERR"))
+				Expect(messages[1].SourceInstance()).To(Equal("1"))
+
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(1))
+				sourceID, start := fakeLogCacheClient.ReadLogsArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+			})
+		})
+
+		When("Log Cache returns more than 1000 logs", func() {
+			BeforeEach(func() {
+				var envelopes []logcache.Envelope
+				for i := 0; i < 1001; i++ {
+					envelopes = append(envelopes, logcache.Envelope{Timestamp: time.Unix(int64(i), 0)})
+				}
+				fakeLogCacheClient.ReadLogsReturns(envelopes, nil)
+			})
+
+			It("returns the most recent 1000", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(1000))
+				Expect(messages[0].Timestamp()).To(Equal(time.Unix(1, 0)))
+			})
+		})
+
+		When("Log Cache returns an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturns(nil, errors.New("log-cache-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("log-cache-error"))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError without reading logs", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsFromLogCacheForApplicationByNameAndSpace", func() {
+		var (
+			messages   <-chan *LogMessage
+			errs       <-chan error
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			messages, errs, warnings, executeErr = actor.GetStreamingLogsFromLogCacheForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+		})
+
+		When("Log Cache returns logs and then an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturnsOnCall(0, []logcache.Envelope{
+					{Timestamp: time.Unix(101, 0), Payload: "some message"},
+				}, nil)
+				fakeLogCacheClient.ReadLogsReturnsOnCall(1, nil, errors.New("log-cache-error"))
+			})
+
+			It("streams the logs, then the error, and closes both channels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+
+				var message *LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("some message"))
+
+				Eventually(errs, 3*time.Second).Should(Receive(MatchError("log-cache-error")))
+				Eventually(messages).Should(BeClosed())
+				Eventually(errs).Should(BeClosed())
+
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(2))
+				_, start := fakeLogCacheClient.ReadLogsArgsForCall(1)
+				Expect(start).To(Equal(time.Unix(101, 1)))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package v2action
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/jws"
+)
+
+const (
+	LogConnectionLayerDNS   = "DNS"
+	LogConnectionLayerTCP   = "TCP"
+	LogConnectionLayerTLS   = "TLS"
+	LogConnectionLayerToken = "auth token"
+)
+
+// logTokenAudiences are the token audiences accepted by the logging endpoint.
+var logTokenAudiences = []string{"cloud_controller", "doppler"}
+
+// LogConnectionCheck is the outcome of checking one layer of the connection
+// to the logging endpoint.
+type LogConnectionCheck struct {
+	Layer  string
+	Passed bool
+	Detail string
+}
+
+// LogConnectionDiagnosis lists the checks run against the logging endpoint,
+// in the order they were run. Checking stops at the first layer that fails.
+type LogConnectionDiagnosis struct {
+	Endpoint string
+	Checks   []LogConnectionCheck
+}
+
+// FailedCheck returns the check that failed, if any.
+func (diagnosis LogConnectionDiagnosis) FailedCheck() (LogConnectionCheck, bool) {
+	for _, check := range diagnosis.Checks {
+		if !check.Passed {
+			return check, true
+		}
+	}
+	return LogConnectionCheck{}, false
+}
+
+func (diagnosis *LogConnectionDiagnosis) record(layer string, err error, detail string) bool {
+	check := LogConnectionCheck{Layer: layer, Passed: err == nil, Detail: detail}
+	if err != nil {
+		check.Detail = err.Error()
+	}
+	diagnosis.Checks = append(diagnosis.Checks, check)
+	return check.Passed
+}
+
+// DiagnoseLogConnection checks, layer by layer, that the logging endpoint of
+// the targeted Cloud Controller can be reached: that its host name resolves,
+// that a TCP connection can be opened, that the TLS handshake succeeds and
+// that the access token is issued for the logging endpoint.
+func (actor Actor) DiagnoseLogConnection() LogConnectionDiagnosis {
+	endpoint := actor.CloudControllerClient.DopplerEndpoint()
+	diagnosis := LogConnectionDiagnosis{Endpoint: endpoint}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err == nil && endpointURL.Hostname() == "" {
+		err = fmt.Errorf("no host in logging endpoint %q", endpoint)
+	}
+	if err != nil {
+		diagnosis.record(LogConnectionLayerDNS, err, "")
+		return diagnosis
+	}
+
+	host := endpointURL.Hostname()
+	addresses, err := net.LookupHost(host)
+	if !diagnosis.record(LogConnectionLayerDNS, err, fmt.Sprintf("%s resolves to %s", host, strings.Join(addresses, ", "))) {
+		return diagnosis
+	}
+
+	secure := endpointURL.Scheme == "wss" || endpointURL.Scheme == "https"
+	port := endpointURL.Port()
+	if port == "" {
+		port = "80"
+		if secure {
+			port = "443"
+		}
+	}
+	address := net.JoinHostPort(host, port)
+
+	conn, err := net.DialTimeout("tcp", address, actor.Config.DialTimeout())
+	if !diagnosis.record(LogConnectionLayerTCP, err, fmt.Sprintf("connected to %s", address)) {
+		return diagnosis
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(actor.Config.DialTimeout()))
+
+	if secure {
+		tlsConn := tls.Client(conn, &tls.Config{
+			ServerName:         host,
+			InsecureSkipVerify: actor.Config.SkipSSLValidation(),
+		})
+		err = tlsConn.Handshake()
+		if !diagnosis.record(LogConnectionLayerTLS, err, "handshake succeeded") {
+			return diagnosis
+		}
+	}
+
+	detail, err := actor.checkLogTokenAudience()
+	diagnosis.record(LogConnectionLayerToken, err, detail)
+	return diagnosis
+}
+
+func (actor Actor) checkLogTokenAudience() (string, error) {
+	accessToken := actor.Config.AccessToken()
+	if accessToken == "" {
+		return "", errors.New("not logged in")
+	}
+
+	token, err := jws.ParseJWT([]byte(strings.TrimPrefix(accessToken, "bearer ")))
+	if err != nil {
+		return "", fmt.Errorf("access token cannot be parsed: %s", err)
+	}
+
+	audiences, _ := token.Claims().Audience()
+	for _, audience := range audiences {
+		for _, accepted := range logTokenAudiences {
+			if audience == accepted {
+				return fmt.Sprintf("issued for %s", audience), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("access token audience %q does not include %s", strings.Join(audiences, ", "), strings.Join(logTokenAudiences, " or "))
+}
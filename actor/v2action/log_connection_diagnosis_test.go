@@ -0,0 +1,166 @@
+package v2action_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log Connection Diagnosis Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	buildToken := func(audiences ...string) string {
+		claims := jws.Claims{}
+		claims.SetAudience(audiences...)
+		token, err := jws.NewJWT(claims, crypto.Unsecured).Serialize(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return "bearer " + string(token)
+	}
+
+	layers := func(diagnosis LogConnectionDiagnosis) []string {
+		var names []string
+		for _, check := range diagnosis.Checks {
+			names = append(names, check.Layer)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, fakeConfig = NewTestActor()
+		fakeConfig.DialTimeoutReturns(5 * time.Second)
+		fakeConfig.AccessTokenReturns(buildToken("cloud_controller", "openid"))
+	})
+
+	Describe("DiagnoseLogConnection", func() {
+		var (
+			server    *httptest.Server
+			diagnosis LogConnectionDiagnosis
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+			fakeCloudControllerClient.DopplerEndpointReturns(strings.Replace(server.URL, "https://", "wss://", 1))
+			fakeConfig.SkipSSLValidationReturns(true)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		JustBeforeEach(func() {
+			diagnosis = actor.DiagnoseLogConnection()
+		})
+
+		When("every layer checks out", func() {
+			It("passes each check", func() {
+				Expect(diagnosis.Endpoint).To(HavePrefix("wss://127.0.0.1:"))
+				Expect(layers(diagnosis)).To(Equal([]string{
+					LogConnectionLayerDNS,
+					LogConnectionLayerTCP,
+					LogConnectionLayerTLS,
+					LogConnectionLayerToken,
+				}))
+				_, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeFalse())
+				Expect(diagnosis.Checks[3].Detail).To(Equal("issued for cloud_controller"))
+			})
+		})
+
+		When("the endpoint has no host", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DopplerEndpointReturns("")
+			})
+
+			It("fails the DNS check", func() {
+				failedCheck, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeTrue())
+				Expect(failedCheck.Layer).To(Equal(LogConnectionLayerDNS))
+				Expect(diagnosis.Checks).To(HaveLen(1))
+			})
+		})
+
+		When("nothing is listening on the endpoint", func() {
+			BeforeEach(func() {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).ToNot(HaveOccurred())
+				address := listener.Addr().String()
+				Expect(listener.Close()).To(Succeed())
+
+				fakeCloudControllerClient.DopplerEndpointReturns("wss://" + address)
+			})
+
+			It("fails the TCP check", func() {
+				failedCheck, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeTrue())
+				Expect(failedCheck.Layer).To(Equal(LogConnectionLayerTCP))
+				Expect(layers(diagnosis)).To(Equal([]string{LogConnectionLayerDNS, LogConnectionLayerTCP}))
+			})
+		})
+
+		When("the certificate cannot be verified", func() {
+			BeforeEach(func() {
+				fakeConfig.SkipSSLValidationReturns(false)
+			})
+
+			It("fails the TLS check", func() {
+				failedCheck, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeTrue())
+				Expect(failedCheck.Layer).To(Equal(LogConnectionLayerTLS))
+				Expect(failedCheck.Detail).To(ContainSubstring("certificate"))
+			})
+		})
+
+		When("the endpoint is not secure", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DopplerEndpointReturns(strings.Replace(server.URL, "https://", "ws://", 1))
+			})
+
+			It("skips the TLS check", func() {
+				Expect(layers(diagnosis)).To(Equal([]string{
+					LogConnectionLayerDNS,
+					LogConnectionLayerTCP,
+					LogConnectionLayerToken,
+				}))
+			})
+		})
+
+		When("the access token is not issued for the logging endpoint", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns(buildToken("uaa"))
+			})
+
+			It("fails the auth token check", func() {
+				failedCheck, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeTrue())
+				Expect(failedCheck.Layer).To(Equal(LogConnectionLayerToken))
+				Expect(failedCheck.Detail).To(Equal(`access token audience "uaa" does not include cloud_controller or doppler`))
+			})
+		})
+
+		When("there is no access token", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns("")
+			})
+
+			It("fails the auth token check", func() {
+				failedCheck, failed := diagnosis.FailedCheck()
+				Expect(failed).To(BeTrue())
+				Expect(failedCheck.Layer).To(Equal(LogConnectionLayerToken))
+				Expect(failedCheck.Detail).To(Equal("not logged in"))
+			})
+		})
+	})
+})
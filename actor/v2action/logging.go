@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,10 @@ import (
 
 const StagingLog = "STG"
 
+// logRateLimitExceededMessage prefixes the message Diego emits when an app
+// instance drops log lines for exceeding its log rate limit.
+const logRateLimitExceededMessage = "app instance exceeded log rate limit"
+
 var flushInterval = 300 * time.Millisecond
 
 type LogMessage struct {
@@ -39,6 +44,12 @@ func (log LogMessage) Staging() bool {
 	return log.sourceType == StagingLog
 }
 
+// LogRateLimitExceeded returns true when the message reports that an app
+// instance is dropping log lines because it exceeded its log rate limit.
+func (log LogMessage) LogRateLimitExceeded() bool {
+	return strings.HasPrefix(log.message, logRateLimitExceededMessage)
+}
+
 func (log LogMessage) Timestamp() time.Time {
 	return log.timestamp
 }
@@ -43,6 +43,22 @@ var _ = Describe("Logging Actions", func() {
 				})
 			})
 		})
+
+		Describe("LogRateLimitExceeded", func() {
+			When("the log reports that the log rate limit was exceeded", func() {
+				It("returns true", func() {
+					message := NewLogMessage("app instance exceeded log rate limit (16384 bytes/sec)", 1, time.Now(), "APP/PROC/WEB", "0")
+					Expect(message.LogRateLimitExceeded()).To(BeTrue())
+				})
+			})
+
+			When("the log is any other kind of log", func() {
+				It("returns false", func() {
+					message := NewLogMessage("exceeded my expectations", 1, time.Now(), "APP/PROC/WEB", "0")
+					Expect(message.LogRateLimitExceeded()).To(BeFalse())
+				})
+			})
+		})
 	})
 
 	Describe("GetStreamingLogs", func() {
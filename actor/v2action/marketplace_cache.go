@@ -0,0 +1,134 @@
+package v2action
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const marketplaceCacheVersion = 1
+
+// MarketplaceCacheTTL is how long a cached marketplace catalog is used before
+// it is fetched again.
+const MarketplaceCacheTTL = 5 * time.Minute
+
+type cachedServicePlan struct {
+	GUID        string `json:"guid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Free        bool   `json:"free"`
+}
+
+type cachedService struct {
+	GUID              string              `json:"guid"`
+	Label             string              `json:"label"`
+	Description       string              `json:"description"`
+	ServiceBrokerName string              `json:"service_broker_name"`
+	Plans             []cachedServicePlan `json:"plans"`
+}
+
+type marketplaceCacheFile struct {
+	Version  int             `json:"version"`
+	CachedAt time.Time       `json:"cached_at"`
+	Services []cachedService `json:"services"`
+}
+
+// MarketplaceCache caches the service summaries of the marketplace on disk,
+// one catalog per key, so that repeatedly inspecting the marketplace does not
+// refetch every service and plan.
+type MarketplaceCache struct {
+	Directory string
+	TTL       time.Duration
+	Now       func() time.Time
+}
+
+// NewMarketplaceCache returns a cache in directory that keeps catalogs for
+// MarketplaceCacheTTL.
+func NewMarketplaceCache(directory string) MarketplaceCache {
+	return MarketplaceCache{
+		Directory: directory,
+		TTL:       MarketplaceCacheTTL,
+		Now:       time.Now,
+	}
+}
+
+// Load returns the catalog cached under key and when it was cached. The
+// returned bool is false when there is no catalog younger than the TTL.
+func (cache MarketplaceCache) Load(key string) ([]ServiceSummary, time.Time, bool) {
+	raw, err := ioutil.ReadFile(cache.path(key))
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	var cacheFile marketplaceCacheFile
+	err = json.Unmarshal(raw, &cacheFile)
+	if err != nil || cacheFile.Version != marketplaceCacheVersion || cache.Now().Sub(cacheFile.CachedAt) >= cache.TTL {
+		return nil, time.Time{}, false
+	}
+
+	summaries := []ServiceSummary{}
+	for _, service := range cacheFile.Services {
+		summary := ServiceSummary{Service: Service{
+			GUID:              service.GUID,
+			Label:             service.Label,
+			Description:       service.Description,
+			ServiceBrokerName: service.ServiceBrokerName,
+		}}
+		for _, plan := range service.Plans {
+			summary.Plans = append(summary.Plans, ServicePlanSummary{ServicePlan: ServicePlan{
+				GUID:        plan.GUID,
+				Name:        plan.Name,
+				ServiceGUID: service.GUID,
+				Description: plan.Description,
+				Free:        plan.Free,
+			}})
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, cacheFile.CachedAt, true
+}
+
+// Save caches the catalog under key.
+func (cache MarketplaceCache) Save(key string, summaries []ServiceSummary) error {
+	cacheFile := marketplaceCacheFile{
+		Version:  marketplaceCacheVersion,
+		CachedAt: cache.Now(),
+		Services: []cachedService{},
+	}
+	for _, summary := range summaries {
+		service := cachedService{
+			GUID:              summary.GUID,
+			Label:             summary.Label,
+			Description:       summary.Description,
+			ServiceBrokerName: summary.ServiceBrokerName,
+		}
+		for _, plan := range summary.Plans {
+			service.Plans = append(service.Plans, cachedServicePlan{
+				GUID:        plan.GUID,
+				Name:        plan.Name,
+				Description: plan.Description,
+				Free:        plan.Free,
+			})
+		}
+		cacheFile.Services = append(cacheFile.Services, service)
+	}
+
+	raw, err := json.Marshal(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(cache.Directory, 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cache.path(key), raw, 0600)
+}
+
+func (cache MarketplaceCache) path(key string) string {
+	return filepath.Join(cache.Directory, fmt.Sprintf("%x.json", sha1.Sum([]byte(key))))
+}
@@ -0,0 +1,77 @@
+package v2action_test
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MarketplaceCache", func() {
+	var (
+		directory string
+		now       time.Time
+		cache     MarketplaceCache
+		summaries []ServiceSummary
+	)
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "marketplace-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		now = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		cache = NewMarketplaceCache(directory)
+		cache.Now = func() time.Time { return now }
+
+		summaries = []ServiceSummary{
+			{
+				Service: Service{GUID: "service-guid", Label: "postgres", Description: "a database", ServiceBrokerName: "some-broker"},
+				Plans: []ServicePlanSummary{
+					{ServicePlan: ServicePlan{GUID: "plan-guid", Name: "small", ServiceGUID: "service-guid", Description: "a small database", Free: true}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(directory)).To(Succeed())
+	})
+
+	It("returns the catalog saved under the key until the TTL passes", func() {
+		Expect(cache.Save("some-key", summaries)).To(Succeed())
+
+		now = now.Add(MarketplaceCacheTTL - time.Second)
+		loaded, cachedAt, ok := cache.Load("some-key")
+		Expect(ok).To(BeTrue())
+		Expect(cachedAt).To(BeTemporally("==", time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(loaded).To(Equal(summaries))
+
+		now = now.Add(time.Second)
+		_, _, ok = cache.Load("some-key")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("keeps catalogs for different keys apart", func() {
+		Expect(cache.Save("some-key", summaries)).To(Succeed())
+
+		_, _, ok := cache.Load("other-key")
+		Expect(ok).To(BeFalse())
+	})
+
+	When("the cache file is invalid", func() {
+		It("is a cache miss", func() {
+			Expect(cache.Save("some-key", summaries)).To(Succeed())
+			files, err := ioutil.ReadDir(directory)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(ioutil.WriteFile(directory+"/"+files[0].Name(), []byte("not json"), 0600)).To(Succeed())
+
+			_, _, ok := cache.Load("some-key")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package v2action
+
+import "strings"
+
+// SearchServiceSummaries returns the summaries that match every word of the
+// search, ignoring case. A word matches when it is part of the service's name,
+// description or broker, or of the name or description of one of its plans.
+func SearchServiceSummaries(summaries []ServiceSummary, search string) []ServiceSummary {
+	words := strings.Fields(strings.ToLower(search))
+
+	matches := []ServiceSummary{}
+	for _, summary := range summaries {
+		text := []string{summary.Label, summary.Description, summary.ServiceBrokerName}
+		for _, plan := range summary.Plans {
+			text = append(text, plan.Name, plan.Description)
+		}
+		searchable := strings.ToLower(strings.Join(text, "\n"))
+
+		matched := true
+		for _, word := range words {
+			if !strings.Contains(searchable, word) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, summary)
+		}
+	}
+	return matches
+}
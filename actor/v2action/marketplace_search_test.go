@@ -0,0 +1,43 @@
+package v2action_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SearchServiceSummaries", func() {
+	var summaries []ServiceSummary
+
+	BeforeEach(func() {
+		summaries = []ServiceSummary{
+			{
+				Service: Service{Label: "elephantsql", Description: "Hosted PostgreSQL", ServiceBrokerName: "cloud-broker"},
+				Plans:   []ServicePlanSummary{{ServicePlan: ServicePlan{Name: "turtle", Description: "Shared server"}}},
+			},
+			{
+				Service: Service{Label: "redis", Description: "In-memory store", ServiceBrokerName: "cache-broker"},
+				Plans:   []ServicePlanSummary{{ServicePlan: ServicePlan{Name: "dedicated", Description: "Dedicated VM"}}},
+			},
+		}
+	})
+
+	It("matches the service name, description and broker, ignoring case", func() {
+		Expect(SearchServiceSummaries(summaries, "postgres")).To(Equal(summaries[:1]))
+		Expect(SearchServiceSummaries(summaries, "REDIS")).To(Equal(summaries[1:]))
+		Expect(SearchServiceSummaries(summaries, "cache-broker")).To(Equal(summaries[1:]))
+	})
+
+	It("matches plan names and descriptions", func() {
+		Expect(SearchServiceSummaries(summaries, "turtle")).To(Equal(summaries[:1]))
+		Expect(SearchServiceSummaries(summaries, "dedicated vm")).To(Equal(summaries[1:]))
+	})
+
+	It("requires every word to match", func() {
+		Expect(SearchServiceSummaries(summaries, "postgres dedicated")).To(BeEmpty())
+	})
+
+	It("returns every summary for an empty search", func() {
+		Expect(SearchServiceSummaries(summaries, "")).To(Equal(summaries))
+	})
+})
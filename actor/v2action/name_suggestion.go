@@ -0,0 +1,80 @@
+package v2action
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sajari/fuzzy"
+)
+
+// MaxNameSuggestions is the maximum number of close matches suggested for a
+// mistyped org or space name.
+const MaxNameSuggestions = 3
+
+// GetOrganizationNameSuggestions returns the names of the orgs closest to
+// orgName, closest first.
+func (actor Actor) GetOrganizationNameSuggestions(orgName string) ([]string, Warnings, error) {
+	orgs, warnings, err := actor.GetOrganizations()
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var names []string
+	for _, org := range orgs {
+		names = append(names, org.Name)
+	}
+	return closestNames(orgName, names), warnings, nil
+}
+
+// GetSpaceNameSuggestions returns the names of the spaces in the org closest
+// to spaceName, closest first.
+func (actor Actor) GetSpaceNameSuggestions(orgGUID string, spaceName string) ([]string, Warnings, error) {
+	spaces, warnings, err := actor.GetOrganizationSpaces(orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var names []string
+	for _, space := range spaces {
+		names = append(names, space.Name)
+	}
+	return closestNames(spaceName, names), warnings, nil
+}
+
+// closestNames returns up to MaxNameSuggestions candidates within a
+// Levenshtein distance of a third of the name's length, and at least 2, of
+// name. Case is ignored.
+func closestNames(name string, candidates []string) []string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type match struct {
+		name     string
+		distance int
+	}
+
+	lowerName := strings.ToLower(name)
+	var matches []match
+	for _, candidate := range candidates {
+		lowerCandidate := strings.ToLower(candidate)
+		distance := fuzzy.Levenshtein(&lowerName, &lowerCandidate)
+		if distance <= maxDistance {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(matches) && i < MaxNameSuggestions; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
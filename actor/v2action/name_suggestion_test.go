@@ -0,0 +1,90 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Name Suggestion Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationNameSuggestions", func() {
+		When("getting the orgs succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{
+						{Name: "production"},
+						{Name: "Staging"},
+						{Name: "stage"},
+						{Name: "sandbox"},
+						{Name: "stagi"},
+						{Name: "stagin"},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the closest names, closest first, ignoring case", func() {
+				suggestions, warnings, err := actor.GetOrganizationNameSuggestions("stagign")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(suggestions).To(Equal([]string{"stagin", "Staging", "stagi"}))
+			})
+
+			It("allows at least two edits for short names", func() {
+				suggestions, _, err := actor.GetOrganizationNameSuggestions("stg")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(suggestions).To(Equal([]string{"stage", "stagi"}))
+			})
+
+			It("returns nothing when no name is close", func() {
+				suggestions, _, err := actor.GetOrganizationNameSuggestions("completely-different")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(suggestions).To(BeEmpty())
+			})
+		})
+
+		When("getting the orgs fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("get orgs error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetOrganizationNameSuggestions("some-org")
+				Expect(err).To(MatchError("get orgs error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceNameSuggestions", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{Name: "dev"}, {Name: "devs"}, {Name: "production"}},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("returns the closest space names in the org", func() {
+			suggestions, warnings, err := actor.GetSpaceNameSuggestions("some-org-guid", "dve")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(suggestions).To(Equal([]string{"dev", "devs"}))
+
+			Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+const (
+	cloudControllerAdminScope         = "cloud_controller.admin"
+	cloudControllerAdminReadOnlyScope = "cloud_controller.admin_read_only"
+	cloudControllerGlobalAuditorScope = "cloud_controller.global_auditor"
+	cloudControllerReadScope          = "cloud_controller.read"
+	cloudControllerWriteScope         = "cloud_controller.write"
+
+	orgManagerRole = "OrgManager"
+)
+
+var spaceRoleNames = map[constant.SpaceRole]string{
+	constant.SpaceDeveloperRole: "SpaceDeveloper",
+	constant.SpaceManagerRole:   "SpaceManager",
+	constant.SpaceAuditorRole:   "SpaceAuditor",
+}
+
+// PermissionOperation describes the token scope and roles the Cloud
+// Controller requires before it authorizes an operation.
+type PermissionOperation struct {
+	// Name is the name of the operation, usually the matching command.
+	Name string
+	// Scope is the token scope the operation requires.
+	Scope string
+	// SpaceRoles are the space roles that permit the operation. Operations
+	// without space roles apply to the org.
+	SpaceRoles []constant.SpaceRole
+	// OrgManager is true when org managers may perform the operation.
+	OrgManager bool
+}
+
+// RequiresSpace returns true when the operation is performed in a space.
+func (operation PermissionOperation) RequiresSpace() bool {
+	return len(operation.SpaceRoles) > 0
+}
+
+func (operation PermissionOperation) readOnly() bool {
+	return operation.Scope == cloudControllerReadScope
+}
+
+var spaceDeveloperRoles = []constant.SpaceRole{constant.SpaceDeveloperRole}
+
+var permissionOperations = []PermissionOperation{
+	{Name: "bind-service", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "create-route", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "create-service", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "create-space", Scope: cloudControllerWriteScope, OrgManager: true},
+	{Name: "delete", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "map-route", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "push", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "read", Scope: cloudControllerReadScope, SpaceRoles: []constant.SpaceRole{constant.SpaceDeveloperRole, constant.SpaceManagerRole, constant.SpaceAuditorRole}, OrgManager: true},
+	{Name: "restage", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "restart", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "scale", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "set-env", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "set-space-role", Scope: cloudControllerWriteScope, SpaceRoles: []constant.SpaceRole{constant.SpaceManagerRole}, OrgManager: true},
+	{Name: "start", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+	{Name: "stop", Scope: cloudControllerWriteScope, SpaceRoles: spaceDeveloperRoles},
+}
+
+// PermissionOperationNames returns the names of the operations whose
+// permissions can be checked, in alphabetical order.
+func PermissionOperationNames() []string {
+	var names []string
+	for _, operation := range permissionOperations {
+		names = append(names, operation.Name)
+	}
+	return names
+}
+
+// GetPermissionOperation returns the operation with the given name, and false
+// when there is none.
+func GetPermissionOperation(name string) (PermissionOperation, bool) {
+	for _, operation := range permissionOperations {
+		if operation.Name == name {
+			return operation, true
+		}
+	}
+	return PermissionOperation{}, false
+}
+
+// PermissionCheck is the answer to whether the user is authorized to perform
+// an operation.
+type PermissionCheck struct {
+	Allowed bool
+	// GrantedByScope is the admin token scope that permits the operation.
+	GrantedByScope string
+	// GrantedByRole is the role that permits the operation.
+	GrantedByRole string
+	// MissingScope is the scope the operation requires but the token lacks.
+	MissingScope string
+	// RequiredRoles are the roles, any of which would permit the operation.
+	RequiredRoles []string
+}
+
+// CheckPermission determines, without changing anything, whether the user
+// with the given token scopes is authorized to perform the operation in the
+// org, or in the space for operations that require one.
+func (actor Actor) CheckPermission(operation PermissionOperation, userGUID string, scopes []string, orgGUID string, spaceGUID string) (PermissionCheck, Warnings, error) {
+	hasScope := map[string]bool{}
+	for _, scope := range scopes {
+		hasScope[scope] = true
+	}
+
+	adminScopes := []string{cloudControllerAdminScope}
+	if operation.readOnly() {
+		adminScopes = append(adminScopes, cloudControllerAdminReadOnlyScope, cloudControllerGlobalAuditorScope)
+	}
+	for _, scope := range adminScopes {
+		if hasScope[scope] {
+			return PermissionCheck{Allowed: true, GrantedByScope: scope}, nil, nil
+		}
+	}
+
+	if !hasScope[operation.Scope] {
+		return PermissionCheck{MissingScope: operation.Scope}, nil, nil
+	}
+
+	var (
+		allWarnings   Warnings
+		requiredRoles []string
+	)
+	for _, role := range operation.SpaceRoles {
+		requiredRoles = append(requiredRoles, spaceRoleNames[role])
+
+		spaces, warnings, err := actor.CloudControllerClient.GetUserSpaces(userGUID, role, ccv2.Filter{
+			Type:     constant.OrganizationGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{orgGUID},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PermissionCheck{}, allWarnings, err
+		}
+
+		for _, space := range spaces {
+			if space.GUID == spaceGUID {
+				return PermissionCheck{Allowed: true, GrantedByRole: spaceRoleNames[role]}, allWarnings, nil
+			}
+		}
+	}
+
+	if operation.OrgManager {
+		requiredRoles = append(requiredRoles, orgManagerRole)
+
+		orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv2.Filter{
+			Type:     constant.ManagerGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{userGUID},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PermissionCheck{}, allWarnings, err
+		}
+
+		for _, org := range orgs {
+			if org.GUID == orgGUID {
+				return PermissionCheck{Allowed: true, GrantedByRole: orgManagerRole}, allWarnings, nil
+			}
+		}
+	}
+
+	return PermissionCheck{RequiredRoles: requiredRoles}, allWarnings, nil
+}
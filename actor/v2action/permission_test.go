@@ -0,0 +1,210 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Permission Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetPermissionOperation", func() {
+		It("returns known operations", func() {
+			operation, found := GetPermissionOperation("push")
+			Expect(found).To(BeTrue())
+			Expect(operation.Scope).To(Equal("cloud_controller.write"))
+			Expect(operation.RequiresSpace()).To(BeTrue())
+
+			operation, found = GetPermissionOperation("create-space")
+			Expect(found).To(BeTrue())
+			Expect(operation.RequiresSpace()).To(BeFalse())
+		})
+
+		It("returns false for unknown operations", func() {
+			_, found := GetPermissionOperation("launch-rockets")
+			Expect(found).To(BeFalse())
+		})
+
+		It("lists the operation names alphabetically", func() {
+			names := PermissionOperationNames()
+			Expect(names).To(ContainElement("push"))
+			for i := 1; i < len(names); i++ {
+				Expect(names[i-1] < names[i]).To(BeTrue(), names[i])
+			}
+		})
+	})
+
+	Describe("CheckPermission", func() {
+		var (
+			operationName string
+			scopes        []string
+
+			check      PermissionCheck
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			operationName = "push"
+			scopes = []string{"cloud_controller.read", "cloud_controller.write"}
+		})
+
+		JustBeforeEach(func() {
+			operation, found := GetPermissionOperation(operationName)
+			Expect(found).To(BeTrue())
+			check, warnings, executeErr = actor.CheckPermission(operation, "some-user-guid", scopes, "some-org-guid", "some-space-guid")
+		})
+
+		When("the token has the admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"cloud_controller.admin"}
+			})
+
+			It("allows the operation without looking up roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(check).To(Equal(PermissionCheck{Allowed: true, GrantedByScope: "cloud_controller.admin"}))
+				Expect(fakeCloudControllerClient.GetUserSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the token has a read only admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"cloud_controller.global_auditor"}
+			})
+
+			It("does not allow writes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(check).To(Equal(PermissionCheck{MissingScope: "cloud_controller.write"}))
+			})
+
+			When("the operation only reads", func() {
+				BeforeEach(func() {
+					operationName = "read"
+				})
+
+				It("allows the operation", func() {
+					Expect(check).To(Equal(PermissionCheck{Allowed: true, GrantedByScope: "cloud_controller.global_auditor"}))
+				})
+			})
+		})
+
+		When("the token lacks the required scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"cloud_controller.read"}
+			})
+
+			It("denies the operation without looking up roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(check).To(Equal(PermissionCheck{MissingScope: "cloud_controller.write"}))
+				Expect(fakeCloudControllerClient.GetUserSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user is a developer of the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns(
+					[]ccv2.Space{{GUID: "other-space-guid"}, {GUID: "some-space-guid"}},
+					ccv2.Warnings{"spaces-warning"},
+					nil)
+			})
+
+			It("allows the operation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("spaces-warning"))
+				Expect(check).To(Equal(PermissionCheck{Allowed: true, GrantedByRole: "SpaceDeveloper"}))
+
+				Expect(fakeCloudControllerClient.GetUserSpacesCallCount()).To(Equal(1))
+				userGUID, role, filters := fakeCloudControllerClient.GetUserSpacesArgsForCall(0)
+				Expect(userGUID).To(Equal("some-user-guid"))
+				Expect(role).To(Equal(constant.SpaceDeveloperRole))
+				Expect(filters).To(ConsistOf(ccv2.Filter{
+					Type:     constant.OrganizationGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org-guid"},
+				}))
+			})
+		})
+
+		When("the user has no role that permits the operation", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns([]ccv2.Space{{GUID: "other-space-guid"}}, ccv2.Warnings{"spaces-warning"}, nil)
+			})
+
+			It("denies the operation and lists the roles that would permit it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(check).To(Equal(PermissionCheck{RequiredRoles: []string{"SpaceDeveloper"}}))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("org managers may perform the operation", func() {
+			BeforeEach(func() {
+				operationName = "set-space-role"
+				fakeCloudControllerClient.GetUserSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, nil)
+			})
+
+			When("the user manages the org", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "some-org-guid"}}, ccv2.Warnings{"orgs-warning"}, nil)
+				})
+
+				It("allows the operation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("spaces-warning", "orgs-warning"))
+					Expect(check).To(Equal(PermissionCheck{Allowed: true, GrantedByRole: "OrgManager"}))
+
+					_, role, _ := fakeCloudControllerClient.GetUserSpacesArgsForCall(0)
+					Expect(role).To(Equal(constant.SpaceManagerRole))
+					Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+						Type:     constant.ManagerGUIDFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-user-guid"},
+					}))
+				})
+			})
+
+			When("the user does not manage the org", func() {
+				It("denies the operation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(check).To(Equal(PermissionCheck{RequiredRoles: []string{"SpaceManager", "OrgManager"}}))
+				})
+			})
+
+			When("getting the orgs fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"orgs-warning"}, errors.New("orgs-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("orgs-error"))
+					Expect(warnings).To(ConsistOf("spaces-warning", "orgs-warning"))
+				})
+			})
+		})
+
+		When("getting the user's spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, errors.New("spaces-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("spaces-error"))
+				Expect(warnings).To(ConsistOf("spaces-warning"))
+			})
+		})
+	})
+})
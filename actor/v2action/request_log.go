@@ -0,0 +1,166 @@
+package v2action
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RouterSourceType is the source type of the access logs emitted by the
+// router.
+const RouterSourceType = "RTR"
+
+// routerAccessLogRegexp matches the request line, status code and response
+// time (in seconds) of a router access log.
+var routerAccessLogRegexp = regexp.MustCompile(`"(\S+) (\S+) [^"]*" (\d{3}) .* response_time:([\d.]+)`)
+
+// RequestLog is a request to an app, as logged by the router.
+type RequestLog struct {
+	Timestamp     time.Time
+	InstanceIndex string
+	Method        string
+	Path          string
+	StatusCode    int
+	ResponseTime  time.Duration
+}
+
+// RequestPathSummary is the number of requests to a path and their 90th
+// percentile response time.
+type RequestPathSummary struct {
+	Path  string
+	Count int
+	P90   time.Duration
+}
+
+// RequestLogSummary summarizes the response times of a set of requests and
+// the paths most requested.
+type RequestLogSummary struct {
+	Count    int
+	P50      time.Duration
+	P90      time.Duration
+	P99      time.Duration
+	TopPaths []RequestPathSummary
+}
+
+// GetRequestLogsForApplicationByNameAndSpace returns the router access logs
+// of the app emitted since the given time, oldest first. Logs that are not
+// access logs are skipped.
+func (actor Actor) GetRequestLogsForApplicationByNameAndSpace(appName string, spaceGUID string, since time.Time, client LogCacheClient) ([]RequestLog, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	envelopes, err := client.ReadLogs(app.GUID, since)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var requestLogs []RequestLog
+	for _, envelope := range envelopes {
+		if envelope.Tags["source_type"] != RouterSourceType {
+			continue
+		}
+
+		requestLog, ok := parseRouterAccessLog(envelope.Payload)
+		if !ok {
+			continue
+		}
+		requestLog.Timestamp = envelope.Timestamp
+		requestLog.InstanceIndex = envelope.InstanceID
+		requestLogs = append(requestLogs, requestLog)
+	}
+
+	sort.SliceStable(requestLogs, func(i int, j int) bool {
+		return requestLogs[i].Timestamp.Before(requestLogs[j].Timestamp)
+	})
+
+	return requestLogs, allWarnings, nil
+}
+
+// SummarizeRequestLogs returns the response time percentiles of requestLogs
+// and up to topPaths of the most requested paths.
+func SummarizeRequestLogs(requestLogs []RequestLog, topPaths int) RequestLogSummary {
+	var (
+		all    []time.Duration
+		byPath = map[string][]time.Duration{}
+	)
+	for _, requestLog := range requestLogs {
+		all = append(all, requestLog.ResponseTime)
+		byPath[requestLog.Path] = append(byPath[requestLog.Path], requestLog.ResponseTime)
+	}
+
+	summary := RequestLogSummary{
+		Count: len(all),
+		P50:   percentile(all, 50),
+		P90:   percentile(all, 90),
+		P99:   percentile(all, 99),
+	}
+
+	for path, responseTimes := range byPath {
+		summary.TopPaths = append(summary.TopPaths, RequestPathSummary{
+			Path:  path,
+			Count: len(responseTimes),
+			P90:   percentile(responseTimes, 90),
+		})
+	}
+	sort.Slice(summary.TopPaths, func(i int, j int) bool {
+		if summary.TopPaths[i].Count != summary.TopPaths[j].Count {
+			return summary.TopPaths[i].Count > summary.TopPaths[j].Count
+		}
+		return summary.TopPaths[i].Path < summary.TopPaths[j].Path
+	})
+	if len(summary.TopPaths) > topPaths {
+		summary.TopPaths = summary.TopPaths[:topPaths]
+	}
+
+	return summary
+}
+
+func parseRouterAccessLog(payload string) (RequestLog, bool) {
+	matches := routerAccessLogRegexp.FindStringSubmatch(payload)
+	if matches == nil {
+		return RequestLog{}, false
+	}
+
+	statusCode, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return RequestLog{}, false
+	}
+	seconds, err := strconv.ParseFloat(matches[4], 64)
+	if err != nil {
+		return RequestLog{}, false
+	}
+
+	path := matches[2]
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	return RequestLog{
+		Method:       matches[1],
+		Path:         path,
+		StatusCode:   statusCode,
+		ResponseTime: time.Duration(seconds * float64(time.Second)),
+	}, true
+}
+
+// percentile returns the nearest-rank percentile of durations.
+func percentile(durations []time.Duration, p int) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i int, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
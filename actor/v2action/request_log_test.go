@@ -0,0 +1,157 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/logcache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Log Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *v2actionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _ = NewTestActor()
+		fakeLogCacheClient = new(v2actionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetRequestLogsForApplicationByNameAndSpace", func() {
+		var (
+			since       time.Time
+			requestLogs []RequestLog
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			since = time.Unix(100, 0)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv2.Warnings{"some-app-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			requestLogs, warnings, executeErr = actor.GetRequestLogsForApplicationByNameAndSpace("some-app", "some-space-guid", since, fakeLogCacheClient)
+		})
+
+		When("Log Cache returns logs", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturns([]logcache.Envelope{
+					{
+						Timestamp:  time.Unix(102, 0),
+						InstanceID: "1",
+						Tags:       map[string]string{"source_type": "RTR"},
+						Payload:    `app.example.com - [2019-01-01T00:00:02.000+0000] "POST /orders?page=2 HTTP/1.1" 503 12 67 "-" "curl/7.54.0" "10.0.0.1:5000" "10.0.0.2:61000" x_forwarded_for:"-" x_forwarded_proto:"https" vcap_request_id:"some-id" response_time:1.25 app_id:"some-app-guid" app_index:"1"`,
+					},
+					{
+						Timestamp:  time.Unix(101, 0),
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "RTR"},
+						Payload:    `app.example.com - [2019-01-01T00:00:01.000+0000] "GET / HTTP/1.1" 200 0 5 "-" "curl/7.54.0" "10.0.0.1:5000" "10.0.0.2:61000" x_forwarded_for:"-" x_forwarded_proto:"https" vcap_request_id:"some-id" response_time:0.005 app_id:"some-app-guid" app_index:"0"`,
+					},
+					{
+						Timestamp: time.Unix(103, 0),
+						Tags:      map[string]string{"source_type": "APP/PROC/WEB"},
+						Payload:   `"GET / HTTP/1.1" 200 response_time:1`,
+					},
+					{
+						Timestamp: time.Unix(104, 0),
+						Tags:      map[string]string{"source_type": "RTR"},
+						Payload:   "not an access log",
+					},
+				}, nil)
+			})
+
+			It("returns the parsed access logs, oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(requestLogs).To(Equal([]RequestLog{
+					{
+						Timestamp:     time.Unix(101, 0),
+						InstanceIndex: "0",
+						Method:        "GET",
+						Path:          "/",
+						StatusCode:    200,
+						ResponseTime:  5 * time.Millisecond,
+					},
+					{
+						Timestamp:     time.Unix(102, 0),
+						InstanceIndex: "1",
+						Method:        "POST",
+						Path:          "/orders",
+						StatusCode:    503,
+						ResponseTime:  1250 * time.Millisecond,
+					},
+				}))
+
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(1))
+				sourceID, start := fakeLogCacheClient.ReadLogsArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(since))
+			})
+		})
+
+		When("Log Cache returns an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadLogsReturns(nil, errors.New("log-cache-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("log-cache-error"))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(fakeLogCacheClient.ReadLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("SummarizeRequestLogs", func() {
+		It("returns the percentiles and the most requested paths", func() {
+			var requestLogs []RequestLog
+			for i := 1; i <= 10; i++ {
+				requestLogs = append(requestLogs, RequestLog{Path: "/a", ResponseTime: time.Duration(i) * time.Millisecond})
+			}
+			requestLogs = append(requestLogs,
+				RequestLog{Path: "/b", ResponseTime: time.Second},
+				RequestLog{Path: "/b", ResponseTime: 2 * time.Second},
+				RequestLog{Path: "/c", ResponseTime: time.Millisecond},
+			)
+
+			summary := SummarizeRequestLogs(requestLogs, 2)
+			Expect(summary.Count).To(Equal(13))
+			Expect(summary.P50).To(Equal(6 * time.Millisecond))
+			Expect(summary.P90).To(Equal(time.Second))
+			Expect(summary.P99).To(Equal(2 * time.Second))
+			Expect(summary.TopPaths).To(Equal([]RequestPathSummary{
+				{Path: "/a", Count: 10, P90: 9 * time.Millisecond},
+				{Path: "/b", Count: 2, P90: 2 * time.Second},
+			}))
+		})
+
+		It("returns an empty summary when there are no requests", func() {
+			Expect(SummarizeRequestLogs(nil, 5)).To(Equal(RequestLogSummary{}))
+		})
+	})
+})
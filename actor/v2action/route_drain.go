@@ -0,0 +1,118 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+//go:generate counterfeiter . RouteProber
+
+// RouteProber reports whether the router still sends traffic for a route to
+// a specific app instance.
+type RouteProber interface {
+	IsRegistered(routeURL string, appGUID string, index int) (bool, error)
+}
+
+type routeInstance struct {
+	route Route
+	index int
+}
+
+// DrainApplicationRoutes unmaps all routes from the application, waits until
+// the router stops sending traffic for them to any of the application's
+// instances and then waits drainPeriod for in-flight requests to complete.
+// The unmapped routes are returned, even on error, so that they can be mapped
+// again with MapRoutesToApplication.
+func (actor Actor) DrainApplicationRoutes(app Application, prober RouteProber, unregistrationTimeout time.Duration, drainPeriod time.Duration) (Routes, Warnings, error) {
+	routes, allWarnings, err := actor.GetApplicationRoutes(app.GUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var unmappedRoutes Routes
+	for _, route := range routes {
+		warnings, err := actor.UnmapRouteFromApplication(route.GUID, app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return unmappedRoutes, allWarnings, err
+		}
+		unmappedRoutes = append(unmappedRoutes, route)
+	}
+
+	err = actor.waitForRouteUnregistration(app, unmappedRoutes, prober, unregistrationTimeout)
+	if err != nil {
+		return unmappedRoutes, allWarnings, err
+	}
+
+	time.Sleep(drainPeriod)
+	return unmappedRoutes, allWarnings, nil
+}
+
+// MapRoutesToApplication maps each of the provided routes to the
+// application.
+func (actor Actor) MapRoutesToApplication(routes Routes, appGUID string) (Warnings, error) {
+	var allWarnings Warnings
+	for _, route := range routes {
+		warnings, err := actor.MapRouteToApplication(route.GUID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+// waitForRouteUnregistration probes every instance of the application on
+// each of its HTTP routes. TCP and internal routes do not go through the
+// Gorouter and are not checked.
+func (actor Actor) waitForRouteUnregistration(app Application, routes Routes, prober RouteProber, timeout time.Duration) error {
+	var pending []routeInstance
+	for _, route := range routes {
+		if route.Domain.IsTCP() || route.Domain.Internal {
+			continue
+		}
+		for index := 0; index < app.Instances.Value; index++ {
+			pending = append(pending, routeInstance{route: route, index: index})
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for len(pending) > 0 {
+		var stillRegistered []routeInstance
+		for _, instance := range pending {
+			registered, err := prober.IsRegistered(fmt.Sprintf("https://%s", instance.route), app.GUID, instance.index)
+			if err != nil {
+				return err
+			}
+			if registered {
+				stillRegistered = append(stillRegistered, instance)
+			}
+		}
+		pending = stillRegistered
+
+		if len(pending) == 0 {
+			break
+		}
+		if !time.Now().Before(deadline) {
+			return actionerror.RouteUnregistrationTimeoutError{AppName: app.Name, Routes: routeInstanceNames(pending)}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return nil
+}
+
+func routeInstanceNames(instances []routeInstance) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, instance := range instances {
+		name := instance.route.String()
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,195 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Drain Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("DrainApplicationRoutes", func() {
+		var (
+			app                   Application
+			fakeProber            *v2actionfakes.FakeRouteProber
+			unregistrationTimeout time.Duration
+
+			routes     Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				Instances: types.NullInt{IsSet: true, Value: 2},
+			}
+			fakeProber = new(v2actionfakes.FakeRouteProber)
+			unregistrationTimeout = time.Second
+
+			fakeCloudControllerClient.GetApplicationRoutesReturns([]ccv2.Route{
+				{GUID: "http-route-guid", Host: "some-host", DomainGUID: "http-domain-guid"},
+				{GUID: "tcp-route-guid", Port: types.NullInt{IsSet: true, Value: 1024}, DomainGUID: "tcp-domain-guid"},
+			}, ccv2.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+				if domainGUID == "tcp-domain-guid" {
+					return ccv2.Domain{GUID: domainGUID, Name: "tcp.com", RouterGroupType: constant.TCPRouterGroup}, nil, nil
+				}
+				return ccv2.Domain{GUID: domainGUID, Name: "example.com"}, nil, nil
+			}
+			fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unmap-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = actor.DrainApplicationRoutes(app, fakeProber, unregistrationTimeout, 0)
+		})
+
+		When("the router stops routing to the app", func() {
+			BeforeEach(func() {
+				fakeProber.IsRegisteredReturnsOnCall(0, true, nil)
+				fakeProber.IsRegisteredReturns(false, nil)
+			})
+
+			It("unmaps every route and returns them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "unmap-warning", "unmap-warning"))
+
+				Expect(routes).To(HaveLen(2))
+				Expect(routes[0].GUID).To(Equal("http-route-guid"))
+				Expect(routes[1].GUID).To(Equal("tcp-route-guid"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("http-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+
+			It("probes each instance on the HTTP routes until they are unregistered", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeProber.IsRegisteredCallCount()).To(Equal(3))
+				url, appGUID, index := fakeProber.IsRegisteredArgsForCall(0)
+				Expect(url).To(Equal("https://some-host.example.com"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(index).To(Equal(0))
+
+				_, _, index = fakeProber.IsRegisteredArgsForCall(1)
+				Expect(index).To(Equal(1))
+
+				_, _, index = fakeProber.IsRegisteredArgsForCall(2)
+				Expect(index).To(Equal(0))
+			})
+		})
+
+		When("the router keeps routing to the app", func() {
+			BeforeEach(func() {
+				unregistrationTimeout = 0
+				fakeProber.IsRegisteredReturns(true, nil)
+			})
+
+			It("returns a RouteUnregistrationTimeoutError along with the unmapped routes", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteUnregistrationTimeoutError{
+					AppName: "some-app",
+					Routes:  []string{"some-host.example.com"},
+				}))
+				Expect(routes).To(HaveLen(2))
+			})
+		})
+
+		When("probing the route fails", func() {
+			BeforeEach(func() {
+				fakeProber.IsRegisteredReturns(false, errors.New("probe-error"))
+			})
+
+			It("returns the error along with the unmapped routes", func() {
+				Expect(executeErr).To(MatchError("probe-error"))
+				Expect(routes).To(HaveLen(2))
+			})
+		})
+
+		When("unmapping a route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteApplicationReturnsOnCall(1, ccv2.Warnings{"unmap-warning"}, errors.New("unmap-error"))
+			})
+
+			It("returns the error and the routes unmapped so far", func() {
+				Expect(executeErr).To(MatchError("unmap-error"))
+				Expect(routes).To(HaveLen(1))
+				Expect(routes[0].GUID).To(Equal("http-route-guid"))
+				Expect(fakeProber.IsRegisteredCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(routes).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MapRoutesToApplication", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRoutesToApplication(Routes{{GUID: "route-guid-1"}, {GUID: "route-guid-2"}}, "some-app-guid")
+		})
+
+		When("mapping succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-warning"}, nil)
+			})
+
+			It("maps each route to the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("map-warning", "map-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("route-guid-2"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("mapping a route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-warning"}, errors.New("map-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("map-error"))
+				Expect(warnings).To(ConsistOf("map-warning"))
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/actor/actionerror"
+
+// CreateRenamedApplicationRoutes maps a route with the hostname newName to
+// the application for each of its HTTP routes with the hostname oldName,
+// keeping the domain and path. Missing routes are created in the route's
+// space. It returns the routes with the old hostname and the routes mapped
+// in their place.
+func (actor Actor) CreateRenamedApplicationRoutes(appGUID string, oldName string, newName string) (Routes, Routes, Warnings, error) {
+	routes, allWarnings, err := actor.GetApplicationRoutes(appGUID)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	var oldRoutes, newRoutes Routes
+	for _, route := range routes {
+		if route.Host != oldName || route.Domain.IsTCP() {
+			continue
+		}
+
+		newRoute := Route{
+			Domain:    route.Domain,
+			Host:      newName,
+			Path:      route.Path,
+			SpaceGUID: route.SpaceGUID,
+		}
+
+		existingRoute, warnings, err := actor.FindRouteBoundToSpaceWithSettings(newRoute)
+		allWarnings = append(allWarnings, warnings...)
+		switch err.(type) {
+		case nil:
+			newRoute = existingRoute
+		case actionerror.RouteNotFoundError:
+			newRoute, warnings, err = actor.CreateRoute(newRoute, false)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return oldRoutes, newRoutes, allWarnings, err
+			}
+		default:
+			return oldRoutes, newRoutes, allWarnings, err
+		}
+
+		warnings, err = actor.MapRouteToApplication(newRoute.GUID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return oldRoutes, newRoutes, allWarnings, err
+		}
+
+		oldRoutes = append(oldRoutes, route)
+		newRoutes = append(newRoutes, newRoute)
+	}
+
+	return oldRoutes, newRoutes, allWarnings, nil
+}
+
+// RemoveApplicationRoutes unmaps the routes from the application and deletes
+// the ones no other application is mapped to.
+func (actor Actor) RemoveApplicationRoutes(routes Routes, appGUID string) (Warnings, error) {
+	var allWarnings Warnings
+	for _, route := range routes {
+		warnings, err := actor.UnmapRouteFromApplication(route.GUID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		apps, warnings, err := actor.GetRouteApplications(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		if len(apps) > 0 {
+			continue
+		}
+
+		warnings, err = actor.DeleteRoute(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
@@ -0,0 +1,168 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Migration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateRenamedApplicationRoutes", func() {
+		var (
+			oldRoutes  Routes
+			newRoutes  Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationRoutesReturns([]ccv2.Route{
+				{GUID: "old-route-guid", Host: "old-name", Path: "/some-path", DomainGUID: "http-domain-guid", SpaceGUID: "some-space-guid"},
+				{GUID: "other-route-guid", Host: "other-host", DomainGUID: "http-domain-guid", SpaceGUID: "some-space-guid"},
+				{GUID: "tcp-route-guid", Port: types.NullInt{IsSet: true, Value: 1024}, DomainGUID: "tcp-domain-guid", SpaceGUID: "some-space-guid"},
+			}, ccv2.Warnings{"get-routes-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainStub = func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+				if domainGUID == "tcp-domain-guid" {
+					return ccv2.Domain{GUID: domainGUID, Name: "tcp.com", RouterGroupType: constant.TCPRouterGroup}, nil, nil
+				}
+				return ccv2.Domain{GUID: domainGUID, Name: "example.com"}, nil, nil
+			}
+			fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			oldRoutes, newRoutes, warnings, executeErr = actor.CreateRenamedApplicationRoutes("some-app-guid", "old-name", "new-name")
+		})
+
+		When("the route with the new hostname does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"find-route-warning"}, nil)
+				fakeCloudControllerClient.CheckRouteReturns(false, nil, nil)
+				fakeCloudControllerClient.CreateRouteReturns(ccv2.Route{GUID: "new-route-guid", Host: "new-name", Path: "/some-path", DomainGUID: "http-domain-guid", SpaceGUID: "some-space-guid"}, ccv2.Warnings{"create-route-warning"}, nil)
+			})
+
+			It("creates the route and maps it to the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "find-route-warning", "create-route-warning", "map-warning"))
+
+				Expect(oldRoutes).To(HaveLen(1))
+				Expect(oldRoutes[0].GUID).To(Equal("old-route-guid"))
+				Expect(newRoutes).To(HaveLen(1))
+				Expect(newRoutes[0].GUID).To(Equal("new-route-guid"))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				route, generatePort := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+				Expect(route).To(Equal(ccv2.Route{Host: "new-name", Path: "/some-path", DomainGUID: "http-domain-guid", SpaceGUID: "some-space-guid"}))
+				Expect(generatePort).To(BeFalse())
+
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("new-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the route with the new hostname already exists in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "existing-route-guid", Host: "new-name", Path: "/some-path", DomainGUID: "http-domain-guid", SpaceGUID: "some-space-guid"},
+				}, nil, nil)
+			})
+
+			It("maps the existing route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+				Expect(newRoutes[0].GUID).To(Equal("existing-route-guid"))
+
+				routeGUID, _ := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("existing-route-guid"))
+			})
+		})
+
+		When("the route with the new hostname belongs to another space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, nil, nil)
+				fakeCloudControllerClient.CheckRouteReturns(true, nil, nil)
+			})
+
+			It("returns a RouteInDifferentSpaceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "new-name.example.com/some-path"}))
+				Expect(newRoutes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the app routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("RemoveApplicationRoutes", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unmap-warning"}, nil)
+			fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, _ ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+				if routeGUID == "shared-route-guid" {
+					return []ccv2.Application{{GUID: "other-app-guid"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.DeleteRouteReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RemoveApplicationRoutes(Routes{
+				{GUID: "some-route-guid"},
+				{GUID: "shared-route-guid"},
+			}, "some-app-guid")
+		})
+
+		It("unmaps the routes and deletes the ones no other app uses", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("unmap-warning", "delete-warning", "unmap-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+		})
+
+		When("unmapping a route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unmap-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
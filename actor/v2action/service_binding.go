@@ -1,9 +1,12 @@
 package v2action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/sorting"
 )
 
 // ServiceBinding represents the link between a service instance and an
@@ -101,6 +104,50 @@ func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName stri
 	return ServiceBinding(deletedBinding), allWarnings, err
 }
 
+// RebindServiceBySpace replaces the service binding between an application and
+// service instance for a given space so that the application receives freshly
+// issued credentials. An existing binding is deleted synchronously before the
+// new binding is created; if no binding exists, the service is simply bound.
+// When bindingName is empty, the name of the existing binding is kept.
+func (actor Actor) RebindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBinding{}, allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBinding{}, allWarnings, err
+	}
+
+	existingBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if bindingName == "" {
+			bindingName = existingBinding.Name
+		}
+
+		_, ccWarnings, deleteErr := actor.CloudControllerClient.DeleteServiceBinding(existingBinding.GUID, false)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if deleteErr != nil {
+			return ServiceBinding{}, allWarnings, deleteErr
+		}
+	case actionerror.ServiceBindingNotFoundError:
+	default:
+		return ServiceBinding{}, allWarnings, err
+	}
+
+	serviceBinding, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID, bindingName, true, parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+
+	return ServiceBinding(serviceBinding), allWarnings, err
+}
+
 func (actor Actor) GetServiceBindingsByServiceInstance(serviceInstanceGUID string) ([]ServiceBinding, Warnings, error) {
 	serviceBindings, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceBindings(serviceInstanceGUID)
 	if err != nil {
@@ -127,3 +174,66 @@ func (actor Actor) GetServiceBindingsByUserProvidedServiceInstance(userProvidedS
 
 	return allServiceBindings, Warnings(warnings), nil
 }
+
+// ServiceBindingSummary is a service binding along with the names of the
+// application and service instance it links.
+type ServiceBindingSummary struct {
+	ServiceBinding
+	AppName             string
+	ServiceInstanceName string
+}
+
+// GetServiceBindingSummariesBySpace returns every service binding between the
+// applications and service instances of a space, sorted by application and
+// then service instance name.
+func (actor Actor) GetServiceBindingSummariesBySpace(spaceGUID string) ([]ServiceBindingSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []ServiceBindingSummary
+	for _, serviceInstance := range serviceInstances {
+		var serviceBindings []ServiceBinding
+		if serviceInstance.IsUserProvided() {
+			serviceBindings, warnings, err = actor.GetServiceBindingsByUserProvidedServiceInstance(serviceInstance.GUID)
+		} else {
+			serviceBindings, warnings, err = actor.GetServiceBindingsByServiceInstance(serviceInstance.GUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, serviceBinding := range serviceBindings {
+			summaries = append(summaries, ServiceBindingSummary{
+				ServiceBinding:      serviceBinding,
+				AppName:             appNames[serviceBinding.AppGUID],
+				ServiceInstanceName: serviceInstance.Name,
+			})
+		}
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].AppName != summaries[j].AppName {
+			return sorting.LessIgnoreCase(summaries[i].AppName, summaries[j].AppName)
+		}
+		return sorting.LessIgnoreCase(summaries[i].ServiceInstanceName, summaries[j].ServiceInstanceName)
+	})
+
+	return summaries, allWarnings, nil
+}
@@ -341,6 +341,130 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("RebindServiceBySpace", func() {
+		var (
+			executeErr     error
+			warnings       Warnings
+			serviceBinding ServiceBinding
+			bindingName    string
+		)
+
+		BeforeEach(func() {
+			bindingName = ""
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"foo-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"foo-2"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceBindingReturns(
+				ccv2.ServiceBinding{GUID: "new-service-binding-guid"},
+				ccv2.Warnings{"foo-5"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			parameters := map[string]interface{}{"some-parameter": "some-value"}
+			serviceBinding, warnings, executeErr = actor.RebindServiceBySpace("some-app", "some-service-instance", "some-space-guid", bindingName, parameters)
+		})
+
+		When("the service binding exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{{GUID: "some-service-binding-guid", Name: "some-binding-name"}},
+					ccv2.Warnings{"foo-3"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteServiceBindingReturns(
+					ccv2.ServiceBinding{},
+					ccv2.Warnings{"foo-4"},
+					nil,
+				)
+			})
+
+			It("deletes the binding synchronously and creates a new one with the same name", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3", "foo-4", "foo-5"))
+				Expect(serviceBinding).To(Equal(ServiceBinding{GUID: "new-service-binding-guid"}))
+
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(1))
+				passedGUID, acceptsIncomplete := fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)
+				Expect(passedGUID).To(Equal("some-service-binding-guid"))
+				Expect(acceptsIncomplete).To(BeFalse())
+
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID, passedBindingName, acceptsIncomplete, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(passedBindingName).To(Equal("some-binding-name"))
+				Expect(acceptsIncomplete).To(BeTrue())
+				Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+			})
+
+			When("a binding name is provided", func() {
+				BeforeEach(func() {
+					bindingName = "some-other-binding-name"
+				})
+
+				It("creates the new binding with the provided name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					_, _, passedBindingName, _, _ := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+					Expect(passedBindingName).To(Equal("some-other-binding-name"))
+				})
+			})
+
+			When("deleting the binding fails", func() {
+				var expectedError error
+
+				BeforeEach(func() {
+					expectedError = errors.New("I am a CC error")
+					fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"foo-4"}, expectedError)
+				})
+
+				It("returns the warnings and the error without creating a binding", func() {
+					Expect(executeErr).To(MatchError(expectedError))
+					Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3", "foo-4"))
+					Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the service binding does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"foo-3"}, nil)
+			})
+
+			It("binds the service without deleting anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3", "foo-5"))
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			})
+		})
+
+		When("getting the service bindings fails", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("get bindings error")
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"foo-3"}, expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				Expect(executeErr).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3"))
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetServiceBindingsByServiceInstance", func() {
 		var (
 			serviceBindings         []ServiceBinding
@@ -456,4 +580,94 @@ var _ = Describe("Service Binding Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceBindingSummariesBySpace", func() {
+		var (
+			summaries  []ServiceBindingSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetServiceBindingSummariesBySpace("some-space-guid")
+		})
+
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{GUID: "app-guid-1", Name: "zeta-app"},
+						{GUID: "app-guid-2", Name: "alpha-app"},
+					},
+					ccv2.Warnings{"apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "managed-guid", Name: "managed-instance", Type: constant.ServiceInstanceTypeManagedService},
+						{GUID: "ups-guid", Name: "ups-instance", Type: constant.ServiceInstanceTypeUserProvidedService},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "binding-guid-1", AppGUID: "app-guid-1"},
+						{GUID: "binding-guid-2", AppGUID: "app-guid-2"},
+					},
+					ccv2.Warnings{"managed-bindings-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "binding-guid-3", AppGUID: "app-guid-2"},
+					},
+					ccv2.Warnings{"ups-bindings-warning"},
+					nil,
+				)
+			})
+
+			It("returns the bindings sorted by app and service instance name with all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apps-warning", "instances-warning", "managed-bindings-warning", "ups-bindings-warning"))
+				Expect(summaries).To(Equal([]ServiceBindingSummary{
+					{
+						ServiceBinding:      ServiceBinding{GUID: "binding-guid-2", AppGUID: "app-guid-2"},
+						AppName:             "alpha-app",
+						ServiceInstanceName: "managed-instance",
+					},
+					{
+						ServiceBinding:      ServiceBinding{GUID: "binding-guid-3", AppGUID: "app-guid-2"},
+						AppName:             "alpha-app",
+						ServiceInstanceName: "ups-instance",
+					},
+					{
+						ServiceBinding:      ServiceBinding{GUID: "binding-guid-1", AppGUID: "app-guid-1"},
+						AppName:             "zeta-app",
+						ServiceInstanceName: "managed-instance",
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceServiceBindingsArgsForCall(0)).To(Equal("managed-guid"))
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsArgsForCall(0)).To(Equal("ups-guid"))
+			})
+		})
+
+		When("getting the bindings of a service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, nil)
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "managed-guid", Type: constant.ServiceInstanceTypeManagedService}},
+					ccv2.Warnings{"instances-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, errors.New("bindings error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("bindings error"))
+				Expect(warnings).To(ConsistOf("apps-warning", "instances-warning", "bindings-warning"))
+			})
+		})
+	})
 })
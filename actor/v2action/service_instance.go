@@ -1,6 +1,9 @@
 package v2action
 
 import (
+	"net/url"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -19,6 +22,11 @@ func (actor Actor) CreateServiceInstance(spaceGUID, serviceName, servicePlanName
 		return ServiceInstance{}, allWarnings, err
 	}
 
+	err = plan.validateParameters(plan.CreateParametersSchema, params)
+	if err != nil {
+		return ServiceInstance{}, allWarnings, err
+	}
+
 	instance, warnings, err := actor.CloudControllerClient.CreateServiceInstance(spaceGUID, plan.GUID, serviceInstanceName, params, tags)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
@@ -28,6 +36,13 @@ func (actor Actor) CreateServiceInstance(spaceGUID, serviceName, servicePlanName
 	return ServiceInstance(instance), allWarnings, nil
 }
 
+// DeleteServiceInstance deletes the service instance, asking its broker to
+// deprovision it.
+func (actor Actor) DeleteServiceInstance(serviceInstanceGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstanceGUID, false)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, error) {
 	instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(guid)
 	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
@@ -37,6 +52,42 @@ func (actor Actor) GetServiceInstance(guid string) (ServiceInstance, Warnings, e
 	return ServiceInstance(instance), Warnings(warnings), err
 }
 
+// PollServiceInstanceOperation waits for the last operation of the provided
+// service instance to finish. It returns the service instance once the
+// operation succeeds, a ServiceInstanceOperationFailedError if it fails, and a
+// ServiceInstanceOperationTimeoutError if it is still in progress when the
+// overall polling timeout is reached.
+func (actor Actor) PollServiceInstanceOperation(instance ServiceInstance) (ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.OverallPollingTimeout())
+	for instance.LastOperation.State == constant.LastOperationInProgress {
+		if !time.Now().Before(timeout) {
+			return instance, allWarnings, actionerror.ServiceInstanceOperationTimeoutError{Name: instance.Name}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+
+		var (
+			warnings Warnings
+			err      error
+		)
+		instance, warnings, err = actor.GetServiceInstance(instance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstance{}, allWarnings, err
+		}
+	}
+
+	if instance.LastOperation.State == constant.LastOperationFailed {
+		return instance, allWarnings, actionerror.ServiceInstanceOperationFailedError{
+			Name:        instance.Name,
+			Description: instance.LastOperation.Description,
+		}
+	}
+
+	return instance, allWarnings, nil
+}
+
 func (actor Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (ServiceInstance, Warnings, error) {
 	serviceInstances, warnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(
 		spaceGUID,
@@ -60,6 +111,23 @@ func (actor Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID strin
 	return ServiceInstance(serviceInstances[0]), Warnings(warnings), nil
 }
 
+// GetServiceInstanceDashboardURLByNameAndSpace returns the dashboard URL the
+// service broker provided for the service instance. Only http and https URLs
+// are returned so they can be safely handed to a browser.
+func (actor Actor) GetServiceInstanceDashboardURLByNameAndSpace(name string, spaceGUID string) (string, Warnings, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(name, spaceGUID)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	dashboardURL, err := url.Parse(serviceInstance.DashboardURL)
+	if serviceInstance.DashboardURL == "" || err != nil || (dashboardURL.Scheme != "http" && dashboardURL.Scheme != "https") {
+		return "", warnings, actionerror.ServiceInstanceDashboardUnavailableError{Name: name}
+	}
+
+	return serviceInstance.DashboardURL, warnings, nil
+}
+
 func (actor Actor) GetServiceInstancesByApplication(appGUID string) ([]ServiceInstance, Warnings, error) {
 	var allWarnings Warnings
 	bindings, apiWarnings, err := actor.CloudControllerClient.GetServiceBindings(ccv2.Filter{
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/jsonschema"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -151,6 +153,57 @@ var _ = Describe("Service Instance Actions", func() {
 				})
 			})
 
+			When("the plan has a schema the parameters do not match", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceServicesReturns(
+						[]ccv2.Service{{
+							GUID: "a-service-guid",
+						}},
+						ccv2.Warnings{"service-warnings"},
+						nil,
+					)
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]ccv2.ServicePlan{{
+							GUID:                   "the-service-plan-guid",
+							Name:                   "service-plan",
+							CreateParametersSchema: []byte(`{"properties": {"some": {"type": "integer"}}, "required": ["size"]}`),
+						}},
+						nil,
+						nil)
+				})
+
+				It("returns an InvalidServiceParametersError without creating the service instance", func() {
+					Expect(createServiceErr).To(MatchError(actionerror.InvalidServiceParametersError{
+						PlanName: "service-plan",
+						Errors: []jsonschema.ValidationError{
+							{Pointer: "/size", Message: "is required"},
+							{Pointer: "/some", Message: "must be of type integer, not string"},
+						},
+					}))
+					Expect(createServiceWarnings).To(ConsistOf("service-warnings"))
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the plan's schema cannot be read", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceServicesReturns([]ccv2.Service{{GUID: "a-service-guid"}}, nil, nil)
+					fakeCloudControllerClient.GetServicePlansReturns(
+						[]ccv2.ServicePlan{{
+							GUID:                   "the-service-plan-guid",
+							Name:                   "service-plan",
+							CreateParametersSchema: []byte(`"not a schema"`),
+						}},
+						nil,
+						nil)
+				})
+
+				It("leaves validation to the broker", func() {
+					Expect(createServiceErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				})
+			})
+
 			When("there are no matching services", func() {
 				BeforeEach(func() {
 					fakeCloudControllerClient.GetSpaceServicesReturns(
@@ -490,6 +543,105 @@ var _ = Describe("Service Instance Actions", func() {
 		)
 	})
 
+	Describe("PollServiceInstanceOperation", func() {
+		var (
+			fakeConfig *v2actionfakes.FakeConfig
+			instance   ServiceInstance
+
+			polledInstance ServiceInstance
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+			actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+
+			instance = ServiceInstance{
+				GUID:          "some-service-instance-guid",
+				Name:          "some-service-instance",
+				LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress},
+			}
+		})
+
+		JustBeforeEach(func() {
+			polledInstance, warnings, executeErr = actor.PollServiceInstanceOperation(instance)
+		})
+
+		When("the operation succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0,
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress}},
+					ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1,
+					ccv2.ServiceInstance{GUID: "some-service-instance-guid", LastOperation: ccv2.LastOperation{State: constant.LastOperationSucceeded}},
+					ccv2.Warnings{"warning-2"}, nil)
+			})
+
+			It("polls until the operation is no longer in progress", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(polledInstance.LastOperation.State).To(Equal(constant.LastOperationSucceeded))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		When("the operation is not in progress", func() {
+			BeforeEach(func() {
+				instance.LastOperation.State = constant.LastOperationSucceeded
+			})
+
+			It("returns without polling", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{
+						GUID:          "some-service-instance-guid",
+						Name:          "some-service-instance",
+						LastOperation: ccv2.LastOperation{State: constant.LastOperationFailed, Description: "broker exploded"},
+					},
+					nil, nil)
+			})
+
+			It("returns a ServiceInstanceOperationFailedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{
+					Name:        "some-service-instance",
+					Description: "broker exploded",
+				}))
+			})
+		})
+
+		When("the operation is still in progress at the timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a ServiceInstanceOperationTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationTimeoutError{Name: "some-service-instance"}))
+				Expect(polledInstance).To(Equal(instance))
+			})
+		})
+
+		When("getting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		var (
 			serviceInstanceGUID string
@@ -616,6 +768,75 @@ var _ = Describe("Service Instance Actions", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceDashboardURLByNameAndSpace", func() {
+		var (
+			dashboardURL string
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			dashboardURL, warnings, executeErr = actor.GetServiceInstanceDashboardURLByNameAndSpace("some-service-instance", "some-space-guid")
+		})
+
+		When("the service instance has an https dashboard URL", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{Name: "some-service-instance", DashboardURL: "https://dashboard.example.com/some-guid"}},
+					ccv2.Warnings{"foo"},
+					nil,
+				)
+			})
+
+			It("returns the dashboard URL and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(dashboardURL).To(Equal("https://dashboard.example.com/some-guid"))
+				Expect(warnings).To(ConsistOf("foo"))
+			})
+		})
+
+		When("the service instance has no dashboard URL", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{Name: "some-service-instance"}},
+					ccv2.Warnings{"foo"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceDashboardUnavailableError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("foo"))
+			})
+		})
+
+		When("the dashboard URL is not an http or https URL", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{Name: "some-service-instance", DashboardURL: "file:///etc/passwd"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceDashboardUnavailableError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"}))
+				Expect(dashboardURL).To(BeEmpty())
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"foo"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf("foo"))
+			})
+		})
+	})
+
 	Describe("GetServiceInstancesByApplication", func() {
 		var (
 			appGUID string
@@ -1,6 +1,7 @@
 package v2action
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
@@ -22,3 +23,64 @@ func (actor Actor) CreateServiceKey(serviceInstanceName, keyName, spaceGUID stri
 
 	return ServiceKey(serviceKey), allWarnings, err
 }
+
+// GetServiceInstanceCredentials returns the credentials of the service key
+// keyName of the service instance, creating the key when it does not exist.
+// The key is kept, since brokers may revoke its credentials once it is
+// deleted, and is reused until DeleteServiceInstanceKey deletes it.
+func (actor Actor) GetServiceInstanceCredentials(serviceInstanceName, keyName, spaceGUID string) (map[string]interface{}, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	serviceKey, found, warnings, err := actor.getServiceInstanceKey(serviceInstance.GUID, keyName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil || found {
+		return serviceKey.Credentials, allWarnings, err
+	}
+
+	serviceKey, ccv2Warnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstance.GUID, keyName, nil)
+	allWarnings = append(allWarnings, ccv2Warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return serviceKey.Credentials, allWarnings, nil
+}
+
+// DeleteServiceInstanceKey deletes the service key keyName of the service
+// instance, revoking the credentials GetServiceInstanceCredentials returned.
+func (actor Actor) DeleteServiceInstanceKey(serviceInstanceName, keyName, spaceGUID string) (Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	serviceKey, found, warnings, err := actor.getServiceInstanceKey(serviceInstance.GUID, keyName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	if !found {
+		return allWarnings, actionerror.ServiceKeyNotFoundError{Name: keyName, ServiceInstanceName: serviceInstanceName}
+	}
+
+	ccv2Warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKey.GUID)
+	allWarnings = append(allWarnings, ccv2Warnings...)
+	return allWarnings, err
+}
+
+func (actor Actor) getServiceInstanceKey(serviceInstanceGUID, keyName string) (ccv2.ServiceKey, bool, Warnings, error) {
+	serviceKeys, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(serviceInstanceGUID)
+	if err != nil {
+		return ccv2.ServiceKey{}, false, Warnings(warnings), err
+	}
+
+	for _, serviceKey := range serviceKeys {
+		if serviceKey.Name == keyName {
+			return serviceKey, true, Warnings(warnings), nil
+		}
+	}
+	return ccv2.ServiceKey{}, false, Warnings(warnings), nil
+}
@@ -127,3 +127,159 @@ var _ = Describe("Service Key", func() {
 		})
 	})
 })
+
+var _ = Describe("GetServiceInstanceCredentials", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+		credentials map[string]interface{}
+		warnings    Warnings
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+			[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+			ccv2.Warnings{"get-instance-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+			[]ccv2.ServiceKey{{GUID: "other-key-guid", Name: "other-key-name"}},
+			ccv2.Warnings{"get-keys-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		credentials, warnings, executeErr = actor.GetServiceInstanceCredentials("some-service-instance-name", "some-key-name", "some-space-guid")
+	})
+
+	When("the service instance does not have the key", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateServiceKeyReturns(
+				ccv2.ServiceKey{
+					GUID:        "some-key-guid",
+					Credentials: map[string]interface{}{"uri": "postgres://db"},
+				},
+				ccv2.Warnings{"create-key-warning"},
+				nil,
+			)
+		})
+
+		It("creates the key and returns its credentials without deleting it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(map[string]interface{}{"uri": "postgres://db"}))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "create-key-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceKeysArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			serviceInstanceGUID, keyName, parameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(keyName).To(Equal("some-key-name"))
+			Expect(parameters).To(BeNil())
+
+			Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+
+		When("creating the key fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceKeyReturns(ccv2.ServiceKey{}, ccv2.Warnings{"create-key-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "create-key-warning"))
+			})
+		})
+	})
+
+	When("the service instance already has the key", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+				[]ccv2.ServiceKey{
+					{GUID: "other-key-guid", Name: "other-key-name"},
+					{GUID: "some-key-guid", Name: "some-key-name", Credentials: map[string]interface{}{"uri": "postgres://db"}},
+				},
+				ccv2.Warnings{"get-keys-warning"},
+				nil,
+			)
+		})
+
+		It("returns the credentials of the existing key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(map[string]interface{}{"uri": "postgres://db"}))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+			Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the keys fails", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, errors.New("get-keys-error"))
+		})
+
+		It("returns the error without creating a key", func() {
+			Expect(executeErr).To(MatchError("get-keys-error"))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+			Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+})
+
+var _ = Describe("DeleteServiceInstanceKey", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+			[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid"}},
+			ccv2.Warnings{"get-instance-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = actor.DeleteServiceInstanceKey("some-service-instance-name", "some-key-name", "some-space-guid")
+	})
+
+	When("the service instance has the key", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+				[]ccv2.ServiceKey{{GUID: "some-key-guid", Name: "some-key-name"}},
+				ccv2.Warnings{"get-keys-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-key-warning"}, nil)
+		})
+
+		It("deletes the key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning", "delete-key-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+
+	When("the service instance does not have the key", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(nil, ccv2.Warnings{"get-keys-warning"}, nil)
+		})
+
+		It("returns a ServiceKeyNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{Name: "some-key-name", ServiceInstanceName: "some-service-instance-name"}))
+			Expect(warnings).To(ConsistOf("get-instance-warning", "get-keys-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package v2action
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/jsonschema"
+)
+
+// ServicePlanParameter is a top-level parameter accepted when creating a
+// service instance from a plan, as described by the plan's JSON schema.
+type ServicePlanParameter struct {
+	Name        string
+	Type        string
+	Description string
+	Enum        []interface{}
+	Default     interface{}
+	HasDefault  bool
+	Required    bool
+}
+
+type servicePlanParametersSchema struct {
+	Properties map[string]struct {
+		Type        interface{}     `json:"type"`
+		Description string          `json:"description"`
+		Title       string          `json:"title"`
+		Enum        []interface{}   `json:"enum"`
+		Default     json.RawMessage `json:"default"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+// CreateParameters returns the parameters described by the plan's create
+// schema, required parameters first and otherwise sorted by name. A plan
+// without a schema has no parameters.
+func (plan ServicePlan) CreateParameters() ([]ServicePlanParameter, error) {
+	if len(plan.CreateParametersSchema) == 0 {
+		return nil, nil
+	}
+
+	var schema servicePlanParametersSchema
+	err := json.Unmarshal(plan.CreateParametersSchema, &schema)
+	if err != nil {
+		return nil, actionerror.InvalidServicePlanSchemaError{PlanName: plan.Name, Err: err}
+	}
+
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	parameters := []ServicePlanParameter{}
+	for name, property := range schema.Properties {
+		parameter := ServicePlanParameter{
+			Name:        name,
+			Type:        schemaType(property.Type),
+			Description: property.Description,
+			Enum:        property.Enum,
+			Required:    required[name],
+		}
+		if parameter.Description == "" {
+			parameter.Description = property.Title
+		}
+		if len(property.Default) > 0 {
+			err = json.Unmarshal(property.Default, &parameter.Default)
+			if err != nil {
+				return nil, actionerror.InvalidServicePlanSchemaError{PlanName: plan.Name, Err: err}
+			}
+			parameter.HasDefault = true
+		}
+		parameters = append(parameters, parameter)
+	}
+
+	sort.Slice(parameters, func(i int, j int) bool {
+		if parameters[i].Required != parameters[j].Required {
+			return parameters[i].Required
+		}
+		return parameters[i].Name < parameters[j].Name
+	})
+	return parameters, nil
+}
+
+// schemaType returns the type of a schema property. When a list of types is
+// given, the first one other than null is used.
+func schemaType(rawType interface{}) string {
+	switch typ := rawType.(type) {
+	case string:
+		return typ
+	case []interface{}:
+		for _, entry := range typ {
+			if name, ok := entry.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// Parse converts input to a value of the parameter's type and checks it
+// against the parameter's enum. Objects and arrays, and parameters without a
+// type, are entered as JSON; untyped input that is not JSON is a string.
+func (parameter ServicePlanParameter) Parse(input string) (interface{}, error) {
+	var (
+		value    interface{}
+		err      error
+		expected string
+	)
+
+	switch parameter.Type {
+	case "string":
+		value = input
+	case "integer":
+		value, err = strconv.ParseInt(strings.TrimSpace(input), 10, 64)
+		expected = "an integer"
+	case "number":
+		value, err = strconv.ParseFloat(strings.TrimSpace(input), 64)
+		expected = "a number"
+	case "boolean":
+		value, err = strconv.ParseBool(strings.TrimSpace(input))
+		expected = "true or false"
+	case "object":
+		var object map[string]interface{}
+		err = json.Unmarshal([]byte(input), &object)
+		if err == nil && object == nil {
+			err = fmt.Errorf("not an object")
+		}
+		value = object
+		expected = "a JSON object"
+	case "array":
+		var array []interface{}
+		err = json.Unmarshal([]byte(input), &array)
+		if err == nil && array == nil {
+			err = fmt.Errorf("not an array")
+		}
+		value = array
+		expected = "a JSON array"
+	default:
+		if json.Unmarshal([]byte(input), &value) != nil {
+			value = input
+		}
+	}
+
+	if err != nil {
+		return nil, actionerror.InvalidServicePlanParameterError{Name: parameter.Name, Value: input, Expected: expected}
+	}
+
+	if len(parameter.Enum) > 0 && !parameter.allows(value) {
+		return nil, actionerror.InvalidServicePlanParameterError{
+			Name:     parameter.Name,
+			Value:    input,
+			Expected: "one of " + strings.Join(parameter.EnumValues(), ", "),
+		}
+	}
+	return value, nil
+}
+
+// EnumValues returns the allowed values of the parameter as text.
+func (parameter ServicePlanParameter) EnumValues() []string {
+	values := []string{}
+	for _, allowed := range parameter.Enum {
+		values = append(values, FormatServicePlanParameterValue(allowed))
+	}
+	return values
+}
+
+func (parameter ServicePlanParameter) allows(value interface{}) bool {
+	formatted := FormatServicePlanParameterValue(value)
+	for _, allowed := range parameter.EnumValues() {
+		if allowed == formatted {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatServicePlanParameterValue returns value as it would be entered for a
+// parameter: strings as they are and anything else as JSON.
+func FormatServicePlanParameterValue(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(raw)
+}
+
+// validateParameters checks params against schema, one of the plan's
+// parameters schemas. A missing or unreadable schema is not checked, leaving
+// validation to the broker.
+func (plan ServicePlan) validateParameters(schema json.RawMessage, params map[string]interface{}) error {
+	if len(schema) == 0 || params == nil {
+		return nil
+	}
+
+	parsed, err := jsonschema.Parse(schema)
+	if err != nil {
+		return nil
+	}
+	validationErrors, err := parsed.Validate(params)
+	if err != nil || len(validationErrors) == 0 {
+		return nil
+	}
+	return actionerror.InvalidServiceParametersError{PlanName: plan.Name, Errors: validationErrors}
+}
@@ -0,0 +1,99 @@
+package v2action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Plan Parameters", func() {
+	Describe("CreateParameters", func() {
+		var plan ServicePlan
+
+		BeforeEach(func() {
+			plan = ServicePlan{Name: "some-plan"}
+		})
+
+		When("the plan has no schema", func() {
+			It("returns no parameters", func() {
+				parameters, err := plan.CreateParameters()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parameters).To(BeEmpty())
+			})
+		})
+
+		When("the plan has a schema", func() {
+			BeforeEach(func() {
+				plan.CreateParametersSchema = []byte(`{
+					"type": "object",
+					"properties": {
+						"version": {"type": "string", "enum": ["10", "11"], "default": "11"},
+						"storage_gb": {"type": "integer", "description": "Disk size"},
+						"backups": {"type": ["boolean", "null"], "title": "Enable backups"}
+					},
+					"required": ["storage_gb"]
+				}`)
+			})
+
+			It("returns the required parameters first, then the others by name", func() {
+				parameters, err := plan.CreateParameters()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parameters).To(Equal([]ServicePlanParameter{
+					{Name: "storage_gb", Type: "integer", Description: "Disk size", Required: true},
+					{Name: "backups", Type: "boolean", Description: "Enable backups"},
+					{Name: "version", Type: "string", Enum: []interface{}{"10", "11"}, Default: "11", HasDefault: true},
+				}))
+			})
+		})
+
+		When("the schema is invalid", func() {
+			BeforeEach(func() {
+				plan.CreateParametersSchema = []byte(`{"properties": []}`)
+			})
+
+			It("returns an InvalidServicePlanSchemaError", func() {
+				_, err := plan.CreateParameters()
+				Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidServicePlanSchemaError{}))
+			})
+		})
+	})
+
+	Describe("Parse", func() {
+		DescribeTable("valid input",
+			func(parameter ServicePlanParameter, input string, expected interface{}) {
+				value, err := parameter.Parse(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal(expected))
+			},
+			Entry("string", ServicePlanParameter{Type: "string"}, "some text", "some text"),
+			Entry("integer", ServicePlanParameter{Type: "integer"}, " 42 ", int64(42)),
+			Entry("number", ServicePlanParameter{Type: "number"}, "1.5", 1.5),
+			Entry("boolean", ServicePlanParameter{Type: "boolean"}, "true", true),
+			Entry("object", ServicePlanParameter{Type: "object"}, `{"a": 1}`, map[string]interface{}{"a": float64(1)}),
+			Entry("array", ServicePlanParameter{Type: "array"}, `["a"]`, []interface{}{"a"}),
+			Entry("untyped JSON", ServicePlanParameter{}, `3`, float64(3)),
+			Entry("untyped text", ServicePlanParameter{}, `some text`, "some text"),
+			Entry("integer in the enum", ServicePlanParameter{Type: "integer", Enum: []interface{}{float64(1), float64(2)}}, "2", int64(2)),
+		)
+
+		DescribeTable("invalid input",
+			func(parameter ServicePlanParameter, input string, expected string) {
+				parameter.Name = "some-parameter"
+				_, err := parameter.Parse(input)
+				Expect(err).To(MatchError(actionerror.InvalidServicePlanParameterError{
+					Name:     "some-parameter",
+					Value:    input,
+					Expected: expected,
+				}))
+			},
+			Entry("integer", ServicePlanParameter{Type: "integer"}, "1.5", "an integer"),
+			Entry("number", ServicePlanParameter{Type: "number"}, "many", "a number"),
+			Entry("boolean", ServicePlanParameter{Type: "boolean"}, "maybe", "true or false"),
+			Entry("object", ServicePlanParameter{Type: "object"}, `["a"]`, "a JSON object"),
+			Entry("array", ServicePlanParameter{Type: "array"}, `null`, "a JSON array"),
+			Entry("value outside the enum", ServicePlanParameter{Type: "string", Enum: []interface{}{"10", "11"}}, "9", "one of 10, 11"),
+		)
+	})
+})
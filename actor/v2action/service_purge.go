@@ -0,0 +1,131 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ServiceInstancePurgeImpact lists the records that purging a service
+// instance removes from Cloud Foundry without telling its broker.
+type ServiceInstancePurgeImpact struct {
+	ServiceInstance ServiceInstance
+	// BoundApps are the names of the apps bound to the service instance,
+	// including apps in spaces the instance is shared to.
+	BoundApps   []string
+	ServiceKeys []string
+	SharedTo    []ServiceInstanceSharedTo
+}
+
+// Orphans returns how many bindings, keys and shares purging the service
+// instance leaves without a broker-side cleanup.
+func (impact ServiceInstancePurgeImpact) Orphans() int {
+	return len(impact.BoundApps) + len(impact.ServiceKeys) + len(impact.SharedTo)
+}
+
+// GetServiceInstancePurgeImpact returns the bindings, keys and shares that
+// purging the service instance removes.
+func (actor Actor) GetServiceInstancePurgeImpact(serviceInstance ServiceInstance) (ServiceInstancePurgeImpact, Warnings, error) {
+	impact := ServiceInstancePurgeImpact{
+		ServiceInstance: serviceInstance,
+		BoundApps:       []string{},
+		ServiceKeys:     []string{},
+		SharedTo:        []ServiceInstanceSharedTo{},
+	}
+
+	bindings, allWarnings, err := actor.CloudControllerClient.GetServiceInstanceServiceBindings(serviceInstance.GUID)
+	if err != nil {
+		return ServiceInstancePurgeImpact{}, Warnings(allWarnings), err
+	}
+
+	for _, binding := range bindings {
+		app, warnings, err := actor.CloudControllerClient.GetApplication(binding.AppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceInstancePurgeImpact{}, Warnings(allWarnings), err
+		}
+		impact.BoundApps = append(impact.BoundApps, app.Name)
+	}
+	sort.Strings(impact.BoundApps)
+
+	keys, warnings, err := actor.CloudControllerClient.GetServiceInstanceServiceKeys(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceInstancePurgeImpact{}, Warnings(allWarnings), err
+	}
+	for _, key := range keys {
+		impact.ServiceKeys = append(impact.ServiceKeys, key.Name)
+	}
+	sort.Strings(impact.ServiceKeys)
+
+	sharedTos, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedTos(serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		// Cloud Controllers without service instance sharing have no shares
+		// to report.
+		if _, ok := err.(ccerror.ResourceNotFoundError); !ok {
+			return ServiceInstancePurgeImpact{}, Warnings(allWarnings), err
+		}
+	}
+	for _, sharedTo := range sharedTos {
+		impact.SharedTo = append(impact.SharedTo, ServiceInstanceSharedTo(sharedTo))
+	}
+
+	return impact, Warnings(allWarnings), nil
+}
+
+// GetServiceOfferingPurgeImpact returns the purge impact of every service
+// instance of the service offering, in every space.
+func (actor Actor) GetServiceOfferingPurgeImpact(service Service) ([]ServiceInstancePurgeImpact, Warnings, error) {
+	plans, allWarnings, err := actor.CloudControllerClient.GetServicePlans(ccv2.Filter{
+		Type:     constant.ServiceGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{service.GUID},
+	})
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	impacts := []ServiceInstancePurgeImpact{}
+	if len(plans) == 0 {
+		return impacts, Warnings(allWarnings), nil
+	}
+
+	planGUIDs := []string{}
+	for _, plan := range plans {
+		planGUIDs = append(planGUIDs, plan.GUID)
+	}
+
+	instances, warnings, err := actor.CloudControllerClient.GetServiceInstances(ccv2.Filter{
+		Type:     constant.ServicePlanGUIDFilter,
+		Operator: constant.InOperator,
+		Values:   planGUIDs,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, Warnings(allWarnings), err
+	}
+
+	for _, instance := range instances {
+		impact, impactWarnings, err := actor.GetServiceInstancePurgeImpact(ServiceInstance(instance))
+		allWarnings = append(allWarnings, impactWarnings...)
+		if err != nil {
+			return nil, Warnings(allWarnings), err
+		}
+		impacts = append(impacts, impact)
+	}
+
+	sort.Slice(impacts, func(i int, j int) bool {
+		return impacts[i].ServiceInstance.Name < impacts[j].ServiceInstance.Name
+	})
+	return impacts, Warnings(allWarnings), nil
+}
+
+// PurgeServiceInstance removes the service instance and its bindings, keys
+// and shares from Cloud Foundry without contacting its broker.
+func (actor Actor) PurgeServiceInstance(serviceInstance ServiceInstance) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID, true)
+	return Warnings(warnings), err
+}
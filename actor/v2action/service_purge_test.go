@@ -0,0 +1,177 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Purge Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstancePurgeImpact", func() {
+		var (
+			serviceInstance ServiceInstance
+			impact          ServiceInstancePurgeImpact
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			serviceInstance = ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"}
+
+			fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{AppGUID: "app-guid-1"}, {AppGUID: "app-guid-2"}},
+				ccv2.Warnings{"bindings-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationStub = func(guid string) (ccv2.Application, ccv2.Warnings, error) {
+				if guid == "app-guid-1" {
+					return ccv2.Application{Name: "zed-app"}, ccv2.Warnings{"app-warning-1"}, nil
+				}
+				return ccv2.Application{Name: "alpha-app"}, ccv2.Warnings{"app-warning-2"}, nil
+			}
+			fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+				[]ccv2.ServiceKey{{Name: "key-b"}, {Name: "key-a"}},
+				ccv2.Warnings{"keys-warning"},
+				nil)
+			fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+				[]ccv2.ServiceInstanceSharedTo{{SpaceName: "other-space", OrganizationName: "other-org"}},
+				ccv2.Warnings{"shares-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			impact, warnings, executeErr = actor.GetServiceInstancePurgeImpact(serviceInstance)
+		})
+
+		It("returns the bound apps, service keys and shares, sorted by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("bindings-warning", "app-warning-1", "app-warning-2", "keys-warning", "shares-warning"))
+			Expect(impact).To(Equal(ServiceInstancePurgeImpact{
+				ServiceInstance: serviceInstance,
+				BoundApps:       []string{"alpha-app", "zed-app"},
+				ServiceKeys:     []string{"key-a", "key-b"},
+				SharedTo:        []ServiceInstanceSharedTo{{SpaceName: "other-space", OrganizationName: "other-org"}},
+			}))
+			Expect(impact.Orphans()).To(Equal(5))
+
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceBindingsArgsForCall(0)).To(Equal("some-instance-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceServiceKeysArgsForCall(0)).To(Equal("some-instance-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("some-instance-guid"))
+		})
+
+		When("the Cloud Controller does not support sharing", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(nil, ccv2.Warnings{"shares-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("reports no shares", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(impact.SharedTo).To(BeEmpty())
+				Expect(warnings).To(ContainElement("shares-warning"))
+			})
+		})
+
+		When("getting the service keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, errors.New("keys-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("keys-error"))
+				Expect(warnings).To(ConsistOf("bindings-warning", "app-warning-1", "app-warning-2", "keys-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetServiceOfferingPurgeImpact", func() {
+		var (
+			impacts    []ServiceInstancePurgeImpact
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			impacts, warnings, executeErr = actor.GetServiceOfferingPurgeImpact(Service{GUID: "some-service-guid"})
+		})
+
+		When("the offering has no plans", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, nil)
+			})
+
+			It("returns no impacts without looking up instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(impacts).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("plans-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the offering has instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(
+					[]ccv2.ServicePlan{{GUID: "plan-guid-1"}, {GUID: "plan-guid-2"}},
+					ccv2.Warnings{"plans-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "instance-guid-2", Name: "instance-2"}, {GUID: "instance-guid-1", Name: "instance-1"}},
+					ccv2.Warnings{"instances-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceServiceKeysReturns(
+					[]ccv2.ServiceKey{{Name: "some-key"}},
+					nil,
+					nil)
+			})
+
+			It("returns the impact of purging each instance, sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("plans-warning", "instances-warning"))
+				Expect(impacts).To(HaveLen(2))
+				Expect(impacts[0].ServiceInstance.Name).To(Equal("instance-1"))
+				Expect(impacts[0].ServiceKeys).To(Equal([]string{"some-key"}))
+				Expect(impacts[1].ServiceInstance.Name).To(Equal("instance-2"))
+
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.ServiceGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-service-guid"},
+				}))
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.ServicePlanGUIDFilter,
+					Operator: constant.InOperator,
+					Values:   []string{"plan-guid-1", "plan-guid-2"},
+				}))
+			})
+		})
+	})
+
+	Describe("PurgeServiceInstance", func() {
+		It("purges the service instance", func() {
+			fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete-error"))
+
+			warnings, err := actor.PurgeServiceInstance(ServiceInstance{GUID: "some-instance-guid"})
+			Expect(err).To(MatchError("delete-error"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			guid, purge := fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)
+			Expect(guid).To(Equal("some-instance-guid"))
+			Expect(purge).To(BeTrue())
+		})
+	})
+})
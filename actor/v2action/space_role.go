@@ -0,0 +1,48 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// UserHasSpaceRole returns true when the user is a developer, manager or
+// auditor of the space, or a manager of the org it belongs to.
+func (actor Actor) UserHasSpaceRole(userGUID string, orgGUID string, spaceGUID string) (bool, Warnings, error) {
+	var allWarnings Warnings
+
+	for _, role := range []constant.SpaceRole{constant.SpaceDeveloperRole, constant.SpaceManagerRole, constant.SpaceAuditorRole} {
+		spaces, warnings, err := actor.CloudControllerClient.GetUserSpaces(userGUID, role, ccv2.Filter{
+			Type:     constant.OrganizationGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{orgGUID},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return false, allWarnings, err
+		}
+
+		for _, space := range spaces {
+			if space.GUID == spaceGUID {
+				return true, allWarnings, nil
+			}
+		}
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv2.Filter{
+		Type:     constant.ManagerGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{userGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	for _, org := range orgs {
+		if org.GUID == orgGUID {
+			return true, allWarnings, nil
+		}
+	}
+
+	return false, allWarnings, nil
+}
@@ -0,0 +1,123 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("UserHasSpaceRole", func() {
+		var (
+			hasRole    bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			hasRole, warnings, executeErr = actor.UserHasSpaceRole("some-user-guid", "some-org-guid", "some-space-guid")
+		})
+
+		When("the user is a developer of the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturnsOnCall(0,
+					[]ccv2.Space{{GUID: "other-space-guid"}, {GUID: "some-space-guid"}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns true without checking other roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(hasRole).To(BeTrue())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetUserSpacesCallCount()).To(Equal(1))
+				userGUID, role, filters := fakeCloudControllerClient.GetUserSpacesArgsForCall(0)
+				Expect(userGUID).To(Equal("some-user-guid"))
+				Expect(role).To(Equal(constant.SpaceDeveloperRole))
+				Expect(filters).To(ConsistOf(ccv2.Filter{
+					Type:     constant.OrganizationGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-org-guid"},
+				}))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user is an auditor of the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturnsOnCall(0, nil, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetUserSpacesReturnsOnCall(1, nil, ccv2.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetUserSpacesReturnsOnCall(2, []ccv2.Space{{GUID: "some-space-guid"}}, ccv2.Warnings{"warning-3"}, nil)
+			})
+
+			It("returns true", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(hasRole).To(BeTrue())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+
+				_, role, _ := fakeCloudControllerClient.GetUserSpacesArgsForCall(1)
+				Expect(role).To(Equal(constant.SpaceManagerRole))
+				_, role, _ = fakeCloudControllerClient.GetUserSpacesArgsForCall(2)
+				Expect(role).To(Equal(constant.SpaceAuditorRole))
+			})
+		})
+
+		When("the user has no space role", func() {
+			When("the user manages the org", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "some-org-guid"}}, ccv2.Warnings{"org-warning"}, nil)
+				})
+
+				It("returns true", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(hasRole).To(BeTrue())
+					Expect(warnings).To(ConsistOf("org-warning"))
+
+					Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+						Type:     constant.ManagerGUIDFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-user-guid"},
+					}))
+				})
+			})
+
+			When("the user does not manage the org", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "other-org-guid"}}, nil, nil)
+				})
+
+				It("returns false", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(hasRole).To(BeFalse())
+				})
+			})
+		})
+
+		When("getting the user's spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("get spaces error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get spaces error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
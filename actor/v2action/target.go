@@ -1,6 +1,9 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 type TargetSettings ccv2.TargetSettings
 
@@ -16,6 +19,12 @@ func (actor Actor) MinCLIVersion() string {
 	return actor.CloudControllerClient.MinCLIVersion()
 }
 
+// MinCLIVersionEnforcement returns whether the Cloud Controller wants a CLI
+// below its minimum version to warn or to be blocked.
+func (actor Actor) MinCLIVersionEnforcement() constant.MinCLIVersionEnforcement {
+	return actor.CloudControllerClient.MinCLIVersionEnforcement()
+}
+
 // SetTarget targets the Cloud Controller using the client and sets target
 // information in the actor based on the response.
 func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
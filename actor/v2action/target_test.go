@@ -3,6 +3,7 @@ package v2action_test
 import (
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -71,6 +72,16 @@ var _ = Describe("Targeting", func() {
 		})
 	})
 
+	Describe("MinCLIVersionEnforcement", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.MinCLIVersionEnforcementReturns(constant.MinCLIVersionBlock)
+		})
+
+		It("returns the enforcement that the API reports", func() {
+			Expect(actor.MinCLIVersionEnforcement()).To(Equal(constant.MinCLIVersionBlock))
+		})
+	})
+
 	Describe("SetTarget", func() {
 		var expectedAPI, expectedAPIVersion, expectedAuth, expectedMinCLIVersion, expectedDoppler, expectedRouting string
 
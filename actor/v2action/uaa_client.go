@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
@@ -13,5 +15,7 @@ type UAAClient interface {
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	LoginPrompts() map[string][]string
+	PollDeviceAuthorization(deviceAuthorization uaa.DeviceAuthorization, timeout time.Duration) (string, string, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	StartDeviceAuthorization() (uaa.DeviceAuthorization, error)
 }
@@ -0,0 +1,223 @@
+package v2action
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+const (
+	// unhealthyApplicationWorkers is the number of applications whose
+	// instances and crash events are fetched at the same time.
+	unhealthyApplicationWorkers = 10
+
+	// crashEventLookback is how far back crash events are searched for the
+	// last crash reason.
+	crashEventLookback = 24 * time.Hour
+)
+
+// UnhealthyApplication is a started application that is running fewer
+// instances than desired.
+type UnhealthyApplication struct {
+	Application
+	OrganizationName string
+	SpaceName        string
+	RunningInstances int
+	DesiredInstances int
+	CrashedInstances int
+	// LastCrashReason is the exit description of the most recent crash in the
+	// last 24 hours. It is empty if the application did not crash in that
+	// time.
+	LastCrashReason string
+	LastCrashTime   time.Time
+}
+
+type unhealthyApplicationResult struct {
+	app       UnhealthyApplication
+	unhealthy bool
+	warnings  Warnings
+}
+
+// GetUnhealthyApplicationsByOrganization returns the started applications in
+// the organization that are running fewer instances than desired, sorted by
+// space and name.
+func (actor Actor) GetUnhealthyApplicationsByOrganization(orgGUID string) ([]UnhealthyApplication, Warnings, error) {
+	org, warnings, err := actor.CloudControllerClient.GetOrganization(orgGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgFilter := ccv2.Filter{
+		Type:     constant.OrganizationGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{orgGUID},
+	}
+	apps, unhealthyWarnings, err := actor.getUnhealthyApplications([]ccv2.Organization{org}, orgFilter)
+	allWarnings = append(allWarnings, unhealthyWarnings...)
+	return apps, allWarnings, err
+}
+
+// GetUnhealthyApplications returns the started applications in every
+// organization visible to the user that are running fewer instances than
+// desired, sorted by organization, space and name.
+func (actor Actor) GetUnhealthyApplications() ([]UnhealthyApplication, Warnings, error) {
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	apps, unhealthyWarnings, err := actor.getUnhealthyApplications(orgs)
+	allWarnings = append(allWarnings, unhealthyWarnings...)
+	return apps, allWarnings, err
+}
+
+func (actor Actor) getUnhealthyApplications(orgs []ccv2.Organization, filters ...ccv2.Filter) ([]UnhealthyApplication, Warnings, error) {
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(filters...)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(filters...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+	}
+	spacesByGUID := map[string]ccv2.Space{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = space
+	}
+
+	var started []UnhealthyApplication
+	for _, ccApp := range ccApps {
+		if ccApp.State != constant.ApplicationStarted {
+			continue
+		}
+		space := spacesByGUID[ccApp.SpaceGUID]
+		started = append(started, UnhealthyApplication{
+			Application:      Application(ccApp),
+			OrganizationName: orgNames[space.OrganizationGUID],
+			SpaceName:        space.Name,
+			DesiredInstances: ccApp.Instances.Value,
+		})
+	}
+
+	results := make([]unhealthyApplicationResult, len(started))
+	workers := make(chan struct{}, unhealthyApplicationWorkers)
+
+	var wg sync.WaitGroup
+	for i, app := range started {
+		wg.Add(1)
+		go func(i int, app UnhealthyApplication) {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+			results[i] = actor.checkApplicationHealth(app)
+		}(i, app)
+	}
+	wg.Wait()
+
+	unhealthyApps := []UnhealthyApplication{}
+	for _, result := range results {
+		allWarnings = append(allWarnings, result.warnings...)
+		if result.unhealthy {
+			unhealthyApps = append(unhealthyApps, result.app)
+		}
+	}
+
+	sort.Slice(unhealthyApps, func(i, j int) bool {
+		if unhealthyApps[i].OrganizationName != unhealthyApps[j].OrganizationName {
+			return sorting.LessIgnoreCase(unhealthyApps[i].OrganizationName, unhealthyApps[j].OrganizationName)
+		}
+		if unhealthyApps[i].SpaceName != unhealthyApps[j].SpaceName {
+			return sorting.LessIgnoreCase(unhealthyApps[i].SpaceName, unhealthyApps[j].SpaceName)
+		}
+		return sorting.LessIgnoreCase(unhealthyApps[i].Name, unhealthyApps[j].Name)
+	})
+
+	return unhealthyApps, allWarnings, nil
+}
+
+// checkApplicationHealth counts the running and crashed instances of the
+// application and, if it is unhealthy, looks up its last crash. Errors are
+// returned as warnings so that one application cannot hide the others.
+func (actor Actor) checkApplicationHealth(app UnhealthyApplication) unhealthyApplicationResult {
+	result := unhealthyApplicationResult{app: app}
+
+	instances, warnings, err := actor.CloudControllerClient.GetApplicationApplicationInstances(app.GUID)
+	result.warnings = append(result.warnings, warnings...)
+	switch err.(type) {
+	case nil, ccerror.ResourceNotFoundError, ccerror.NotStagedError, ccerror.InstancesError:
+	default:
+		result.warnings = append(result.warnings, err.Error())
+		return result
+	}
+
+	for _, instance := range instances {
+		switch instance.State {
+		case constant.ApplicationInstanceRunning:
+			result.app.RunningInstances++
+		case constant.ApplicationInstanceCrashed, constant.ApplicationInstanceFlapping:
+			result.app.CrashedInstances++
+		}
+	}
+
+	if result.app.RunningInstances >= result.app.DesiredInstances {
+		return result
+	}
+	result.unhealthy = true
+
+	events, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv2.Filter{
+			Type:     constant.TypeFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{string(constant.EventTypeApplicationCrash)},
+		},
+		ccv2.Filter{
+			Type:     constant.ActeeFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{app.GUID},
+		},
+		ccv2.Filter{
+			Type:     constant.TimestampFilter,
+			Operator: constant.GreaterThanOperator,
+			Values:   []string{time.Now().Add(-crashEventLookback).UTC().Format(time.RFC3339)},
+		},
+	)
+	result.warnings = append(result.warnings, warnings...)
+	if err != nil {
+		result.warnings = append(result.warnings, err.Error())
+		return result
+	}
+
+	for _, event := range events {
+		if event.Timestamp.Before(result.app.LastCrashTime) {
+			continue
+		}
+		result.app.LastCrashTime = event.Timestamp
+		result.app.LastCrashReason = crashReason(event)
+	}
+
+	return result
+}
+
+func crashReason(event ccv2.Event) string {
+	for _, key := range []string{"exit_description", "reason"} {
+		if reason, ok := event.Metadata[key].(string); ok && reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
@@ -0,0 +1,195 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unhealthy Application Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		crashTime                 time.Time
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+		crashTime = time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		fakeCloudControllerClient.GetSpacesReturns(
+			[]ccv2.Space{
+				{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+				{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-1"},
+			},
+			ccv2.Warnings{"spaces-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv2.Application{
+				{GUID: "healthy-guid", Name: "healthy", SpaceGUID: "space-guid-1", State: constant.ApplicationStarted, Instances: types.NullInt{IsSet: true, Value: 1}},
+				{GUID: "crashing-guid", Name: "crashing", SpaceGUID: "space-guid-2", State: constant.ApplicationStarted, Instances: types.NullInt{IsSet: true, Value: 3}},
+				{GUID: "unstaged-guid", Name: "unstaged", SpaceGUID: "space-guid-1", State: constant.ApplicationStarted, Instances: types.NullInt{IsSet: true, Value: 1}},
+				{GUID: "stopped-guid", Name: "stopped", SpaceGUID: "space-guid-1", State: constant.ApplicationStopped, Instances: types.NullInt{IsSet: true, Value: 1}},
+			},
+			ccv2.Warnings{"apps-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationApplicationInstancesStub = func(appGUID string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+			switch appGUID {
+			case "healthy-guid":
+				return map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: constant.ApplicationInstanceRunning},
+				}, nil, nil
+			case "crashing-guid":
+				return map[int]ccv2.ApplicationInstance{
+					0: {ID: 0, State: constant.ApplicationInstanceRunning},
+					1: {ID: 1, State: constant.ApplicationInstanceCrashed},
+					2: {ID: 2, State: constant.ApplicationInstanceDown},
+				}, ccv2.Warnings{"instances-warning"}, nil
+			case "unstaged-guid":
+				return nil, nil, ccerror.NotStagedError{}
+			}
+			panic("unexpected app " + appGUID)
+		}
+		fakeCloudControllerClient.GetEventsStub = func(filters ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error) {
+			if filters[1].Values[0] != "crashing-guid" {
+				return nil, nil, nil
+			}
+			return []ccv2.Event{
+				{Timestamp: crashTime.Add(-time.Hour), Metadata: map[string]interface{}{"exit_description": "out of memory"}},
+				{Timestamp: crashTime, Metadata: map[string]interface{}{"exit_description": "", "reason": "CRASHED"}},
+			}, ccv2.Warnings{"events-warning"}, nil
+		}
+	})
+
+	Describe("GetUnhealthyApplicationsByOrganization", func() {
+		var (
+			apps       []UnhealthyApplication
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{GUID: "org-guid-1", Name: "org-1"}, ccv2.Warnings{"org-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetUnhealthyApplicationsByOrganization("org-guid-1")
+		})
+
+		It("returns the started apps running fewer instances than desired", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "spaces-warning", "apps-warning", "instances-warning", "events-warning"))
+
+			Expect(apps).To(HaveLen(2))
+			Expect(apps[0].Name).To(Equal("unstaged"))
+			Expect(apps[0].OrganizationName).To(Equal("org-1"))
+			Expect(apps[0].SpaceName).To(Equal("space-1"))
+			Expect(apps[0].RunningInstances).To(Equal(0))
+			Expect(apps[0].DesiredInstances).To(Equal(1))
+			Expect(apps[0].LastCrashReason).To(BeEmpty())
+
+			Expect(apps[1].Name).To(Equal("crashing"))
+			Expect(apps[1].SpaceName).To(Equal("space-2"))
+			Expect(apps[1].RunningInstances).To(Equal(1))
+			Expect(apps[1].DesiredInstances).To(Equal(3))
+			Expect(apps[1].CrashedInstances).To(Equal(1))
+			Expect(apps[1].LastCrashReason).To(Equal("CRASHED"))
+			Expect(apps[1].LastCrashTime).To(Equal(crashTime))
+		})
+
+		It("filters spaces and apps by the org and only checks started apps", func() {
+			orgFilter := ccv2.Filter{
+				Type:     constant.OrganizationGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"org-guid-1"},
+			}
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(orgFilter))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(orgFilter))
+			Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(3))
+		})
+
+		It("looks up the recent crash events of unhealthy apps", func() {
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+
+			var crashingFilters []ccv2.Filter
+			for i := 0; i < fakeCloudControllerClient.GetEventsCallCount(); i++ {
+				filters := fakeCloudControllerClient.GetEventsArgsForCall(i)
+				if filters[1].Values[0] == "crashing-guid" {
+					crashingFilters = filters
+				}
+			}
+			Expect(crashingFilters).To(HaveLen(3))
+			Expect(crashingFilters[0]).To(Equal(ccv2.Filter{
+				Type:     constant.TypeFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"app.crash"},
+			}))
+			Expect(crashingFilters[2].Type).To(Equal(constant.TimestampFilter))
+			Expect(crashingFilters[2].Operator).To(Equal(constant.GreaterThanOperator))
+		})
+
+		When("getting an app's instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationApplicationInstancesStub = func(appGUID string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+					return nil, nil, errors.New("instances-error")
+				}
+			})
+
+			It("reports the error as a warning and skips the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("instances-error"))
+				Expect(apps).To(BeEmpty())
+			})
+		})
+
+		When("getting the org fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"org-warning"}, errors.New("org-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("org-error"))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("org-warning", "spaces-warning", "apps-warning"))
+			})
+		})
+	})
+
+	Describe("GetUnhealthyApplications", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "org-guid-1", Name: "org-1"}}, ccv2.Warnings{"orgs-warning"}, nil)
+		})
+
+		It("checks the apps in every org", func() {
+			apps, warnings, err := actor.GetUnhealthyApplications()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("orgs-warning"))
+			Expect(apps).To(HaveLen(2))
+			Expect(apps[1].OrganizationName).To(Equal("org-1"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(BeEmpty())
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(BeEmpty())
+		})
+	})
+})
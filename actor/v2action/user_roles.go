@@ -0,0 +1,129 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// UserRoles maps an org or space GUID to the names of the roles a user has in
+// it, in the order the roles are listed.
+type UserRoles map[string][]string
+
+var organizationRoleFilters = []struct {
+	Name   string
+	Filter constant.FilterType
+}{
+	{Name: "manager", Filter: constant.ManagerGUIDFilter},
+	{Name: "billing manager", Filter: constant.BillingManagerGUIDFilter},
+	{Name: "auditor", Filter: constant.AuditorGUIDFilter},
+}
+
+var spaceRoles = []struct {
+	Name string
+	Role constant.SpaceRole
+}{
+	{Name: "manager", Role: constant.SpaceManagerRole},
+	{Name: "developer", Role: constant.SpaceDeveloperRole},
+	{Name: "auditor", Role: constant.SpaceAuditorRole},
+}
+
+type roleLookup struct {
+	guids    []string
+	warnings Warnings
+	err      error
+}
+
+// GetUserOrganizationRoles returns the manager, billing manager and auditor
+// roles the user has in each org. The roles are fetched concurrently.
+func (actor Actor) GetUserOrganizationRoles(userGUID string) (UserRoles, Warnings, error) {
+	lookups := make([]func() roleLookup, len(organizationRoleFilters))
+	for i, role := range organizationRoleFilters {
+		filter := role.Filter
+		lookups[i] = func() roleLookup {
+			orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv2.Filter{
+				Type:     filter,
+				Operator: constant.EqualOperator,
+				Values:   []string{userGUID},
+			})
+			lookup := roleLookup{warnings: Warnings(warnings), err: err}
+			for _, org := range orgs {
+				lookup.guids = append(lookup.guids, org.GUID)
+			}
+			return lookup
+		}
+	}
+
+	names := make([]string, len(organizationRoleFilters))
+	for i, role := range organizationRoleFilters {
+		names[i] = role.Name
+	}
+	return collectUserRoles(names, lookups)
+}
+
+// GetUserSpaceRoles returns the manager, developer and auditor roles the user
+// has in each space of the org. The roles are fetched concurrently. A user
+// unknown to the Cloud Controller, such as a client, has no space roles.
+func (actor Actor) GetUserSpaceRoles(userGUID string, orgGUID string) (UserRoles, Warnings, error) {
+	lookups := make([]func() roleLookup, len(spaceRoles))
+	for i, role := range spaceRoles {
+		spaceRole := role.Role
+		lookups[i] = func() roleLookup {
+			spaces, warnings, err := actor.CloudControllerClient.GetUserSpaces(userGUID, spaceRole, ccv2.Filter{
+				Type:     constant.OrganizationGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{orgGUID},
+			})
+			if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+				err = nil
+			}
+			lookup := roleLookup{warnings: Warnings(warnings), err: err}
+			for _, space := range spaces {
+				lookup.guids = append(lookup.guids, space.GUID)
+			}
+			return lookup
+		}
+	}
+
+	names := make([]string, len(spaceRoles))
+	for i, role := range spaceRoles {
+		names[i] = role.Name
+	}
+	return collectUserRoles(names, lookups)
+}
+
+// collectUserRoles runs the lookups concurrently and merges their results in
+// the order of the role names, so warnings and roles are deterministic.
+func collectUserRoles(names []string, lookups []func() roleLookup) (UserRoles, Warnings, error) {
+	results := make([]chan roleLookup, len(lookups))
+	for i, lookup := range lookups {
+		results[i] = make(chan roleLookup, 1)
+		go func(lookup func() roleLookup, result chan<- roleLookup) {
+			result <- lookup()
+		}(lookup, results[i])
+	}
+
+	var (
+		allWarnings Warnings
+		firstErr    error
+	)
+	roles := UserRoles{}
+	for i, result := range results {
+		lookup := <-result
+		allWarnings = append(allWarnings, lookup.warnings...)
+		if lookup.err != nil {
+			if firstErr == nil {
+				firstErr = lookup.err
+			}
+			continue
+		}
+		for _, guid := range lookup.guids {
+			roles[guid] = append(roles[guid], names[i])
+		}
+	}
+
+	if firstErr != nil {
+		return nil, allWarnings, firstErr
+	}
+	return roles, allWarnings, nil
+}
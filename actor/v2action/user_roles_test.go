@@ -0,0 +1,157 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetUserOrganizationRoles", func() {
+		var (
+			roles      UserRoles
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			roles, warnings, executeErr = actor.GetUserOrganizationRoles("some-user-guid")
+		})
+
+		When("the user has roles in orgs", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsStub = func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
+					switch filters[0].Type {
+					case constant.ManagerGUIDFilter:
+						return []ccv2.Organization{{GUID: "org-1"}}, ccv2.Warnings{"manager-warning"}, nil
+					case constant.BillingManagerGUIDFilter:
+						return nil, ccv2.Warnings{"billing-manager-warning"}, nil
+					default:
+						return []ccv2.Organization{{GUID: "org-1"}, {GUID: "org-2"}}, ccv2.Warnings{"auditor-warning"}, nil
+					}
+				}
+			})
+
+			It("returns the roles in each org and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(roles).To(Equal(UserRoles{
+					"org-1": {"manager", "auditor"},
+					"org-2": {"auditor"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"manager-warning", "billing-manager-warning", "auditor-warning"}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(3))
+				var filterTypes []constant.FilterType
+				for i := 0; i < 3; i++ {
+					filters := fakeCloudControllerClient.GetOrganizationsArgsForCall(i)
+					Expect(filters).To(HaveLen(1))
+					Expect(filters[0].Values).To(Equal([]string{"some-user-guid"}))
+					filterTypes = append(filterTypes, filters[0].Type)
+				}
+				Expect(filterTypes).To(ConsistOf(constant.ManagerGUIDFilter, constant.BillingManagerGUIDFilter, constant.AuditorGUIDFilter))
+			})
+		})
+
+		When("getting the orgs for a role fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsStub = func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
+					if filters[0].Type == constant.BillingManagerGUIDFilter {
+						return nil, ccv2.Warnings{"billing-manager-warning"}, errors.New("get orgs error")
+					}
+					return nil, ccv2.Warnings{"other-warning"}, nil
+				}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get orgs error"))
+				Expect(warnings).To(Equal(Warnings{"other-warning", "billing-manager-warning", "other-warning"}))
+			})
+		})
+	})
+
+	Describe("GetUserSpaceRoles", func() {
+		var (
+			roles      UserRoles
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			roles, warnings, executeErr = actor.GetUserSpaceRoles("some-user-guid", "some-org-guid")
+		})
+
+		When("the user has roles in spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesStub = func(userGUID string, role constant.SpaceRole, filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
+					switch role {
+					case constant.SpaceManagerRole:
+						return nil, ccv2.Warnings{"manager-warning"}, nil
+					case constant.SpaceDeveloperRole:
+						return []ccv2.Space{{GUID: "space-1"}, {GUID: "space-2"}}, ccv2.Warnings{"developer-warning"}, nil
+					default:
+						return []ccv2.Space{{GUID: "space-2"}}, ccv2.Warnings{"auditor-warning"}, nil
+					}
+				}
+			})
+
+			It("returns the roles in each space of the org and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(roles).To(Equal(UserRoles{
+					"space-1": {"developer"},
+					"space-2": {"developer", "auditor"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"manager-warning", "developer-warning", "auditor-warning"}))
+
+				Expect(fakeCloudControllerClient.GetUserSpacesCallCount()).To(Equal(3))
+				for i := 0; i < 3; i++ {
+					userGUID, _, filters := fakeCloudControllerClient.GetUserSpacesArgsForCall(i)
+					Expect(userGUID).To(Equal("some-user-guid"))
+					Expect(filters).To(Equal([]ccv2.Filter{{
+						Type:     constant.OrganizationGUIDFilter,
+						Operator: constant.EqualOperator,
+						Values:   []string{"some-org-guid"},
+					}}))
+				}
+			})
+		})
+
+		When("the user is not known to the cloud controller", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns(nil, ccv2.Warnings{"warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns no roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(roles).To(BeEmpty())
+				Expect(warnings).To(Equal(Warnings{"warning", "warning", "warning"}))
+			})
+		})
+
+		When("getting the spaces for a role fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetUserSpacesReturns(nil, ccv2.Warnings{"warning"}, errors.New("get spaces error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get spaces error"))
+				Expect(warnings).To(Equal(Warnings{"warning", "warning", "warning"}))
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -253,6 +254,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		arg1 string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationJobStub        func(string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationJobMutex       sync.RWMutex
 	deleteOrganizationJobArgsForCall []struct {
@@ -353,6 +367,33 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteServiceInstanceStub        func(string, bool) (ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteServiceKeyStub        func(string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteServicePlanVisibilityStub        func(string) (ccv2.Warnings, error)
 	deleteServicePlanVisibilityMutex       sync.RWMutex
 	deleteServicePlanVisibilityArgsForCall []struct {
@@ -495,6 +536,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetConfigEnvironmentVariableGroupStub        func(constant.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)
+	getConfigEnvironmentVariableGroupMutex       sync.RWMutex
+	getConfigEnvironmentVariableGroupArgsForCall []struct {
+		arg1 constant.EnvironmentVariableGroupName
+	}
+	getConfigEnvironmentVariableGroupReturns struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getConfigEnvironmentVariableGroupReturnsOnCall map[int]struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetConfigFeatureFlagsStub        func() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
 	getConfigFeatureFlagsMutex       sync.RWMutex
 	getConfigFeatureFlagsArgsForCall []struct {
@@ -509,6 +565,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetEventsStub        func(...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 []ccv2.Filter
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -766,6 +837,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceInstanceServiceKeysStub        func(string) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceInstanceServiceKeysMutex       sync.RWMutex
+	getServiceInstanceServiceKeysArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceInstanceSharedFromStub        func(string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
 	getServiceInstanceSharedFromMutex       sync.RWMutex
 	getServiceInstanceSharedFromArgsForCall []struct {
@@ -1087,6 +1173,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetUserSpacesStub        func(string, constant.SpaceRole, ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
+	getUserSpacesMutex       sync.RWMutex
+	getUserSpacesArgsForCall []struct {
+		arg1 string
+		arg2 constant.SpaceRole
+		arg3 []ccv2.Filter
+	}
+	getUserSpacesReturns struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getUserSpacesReturnsOnCall map[int]struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct {
@@ -1097,6 +1200,16 @@ type FakeCloudControllerClient struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MinCLIVersionEnforcementStub        func() constant.MinCLIVersionEnforcement
+	minCLIVersionEnforcementMutex       sync.RWMutex
+	minCLIVersionEnforcementArgsForCall []struct {
+	}
+	minCLIVersionEnforcementReturns struct {
+		result1 constant.MinCLIVersionEnforcement
+	}
+	minCLIVersionEnforcementReturnsOnCall map[int]struct {
+		result1 constant.MinCLIVersionEnforcement
+	}
 	PollJobStub        func(ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -2478,6 +2591,69 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplication(arg1 string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteApplication", []interface{}{arg1})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCalls(stub func(string) (ccv2.Warnings, error)) {
+	fake.deleteApplicationMutex.Lock()
+	defer fake.deleteApplicationMutex.Unlock()
+	fake.DeleteApplicationStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	argsForCall := fake.deleteApplicationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.deleteApplicationMutex.Lock()
+	defer fake.deleteApplicationMutex.Unlock()
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.deleteApplicationMutex.Lock()
+	defer fake.deleteApplicationMutex.Unlock()
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganizationJob(arg1 string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationJobMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationJobReturnsOnCall[len(fake.deleteOrganizationJobArgsForCall)]
@@ -2930,6 +3106,133 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(arg1 string, arg2 bool) (ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{arg1, arg2})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCalls(stub func(string, bool) (ccv2.Warnings, error)) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) (string, bool) {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	argsForCall := fake.deleteServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	defer fake.deleteServiceInstanceMutex.Unlock()
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(arg1 string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{arg1})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteServiceKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCalls(stub func(string) (ccv2.Warnings, error)) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	argsForCall := fake.deleteServiceKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServicePlanVisibility(arg1 string) (ccv2.Warnings, error) {
 	fake.deleteServicePlanVisibilityMutex.Lock()
 	ret, specificReturn := fake.deleteServicePlanVisibilityReturnsOnCall[len(fake.deleteServicePlanVisibilityArgsForCall)]
@@ -3571,6 +3874,72 @@ func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroup(arg1 constant.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error) {
+	fake.getConfigEnvironmentVariableGroupMutex.Lock()
+	ret, specificReturn := fake.getConfigEnvironmentVariableGroupReturnsOnCall[len(fake.getConfigEnvironmentVariableGroupArgsForCall)]
+	fake.getConfigEnvironmentVariableGroupArgsForCall = append(fake.getConfigEnvironmentVariableGroupArgsForCall, struct {
+		arg1 constant.EnvironmentVariableGroupName
+	}{arg1})
+	fake.recordInvocation("GetConfigEnvironmentVariableGroup", []interface{}{arg1})
+	fake.getConfigEnvironmentVariableGroupMutex.Unlock()
+	if fake.GetConfigEnvironmentVariableGroupStub != nil {
+		return fake.GetConfigEnvironmentVariableGroupStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getConfigEnvironmentVariableGroupReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroupCallCount() int {
+	fake.getConfigEnvironmentVariableGroupMutex.RLock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.RUnlock()
+	return len(fake.getConfigEnvironmentVariableGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroupCalls(stub func(constant.EnvironmentVariableGroupName) (ccv2.EnvironmentVariableGroup, ccv2.Warnings, error)) {
+	fake.getConfigEnvironmentVariableGroupMutex.Lock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.Unlock()
+	fake.GetConfigEnvironmentVariableGroupStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroupArgsForCall(i int) constant.EnvironmentVariableGroupName {
+	fake.getConfigEnvironmentVariableGroupMutex.RLock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.RUnlock()
+	argsForCall := fake.getConfigEnvironmentVariableGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroupReturns(result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.getConfigEnvironmentVariableGroupMutex.Lock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.Unlock()
+	fake.GetConfigEnvironmentVariableGroupStub = nil
+	fake.getConfigEnvironmentVariableGroupReturns = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetConfigEnvironmentVariableGroupReturnsOnCall(i int, result1 ccv2.EnvironmentVariableGroup, result2 ccv2.Warnings, result3 error) {
+	fake.getConfigEnvironmentVariableGroupMutex.Lock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.Unlock()
+	fake.GetConfigEnvironmentVariableGroupStub = nil
+	if fake.getConfigEnvironmentVariableGroupReturnsOnCall == nil {
+		fake.getConfigEnvironmentVariableGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.EnvironmentVariableGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getConfigEnvironmentVariableGroupReturnsOnCall[i] = struct {
+		result1 ccv2.EnvironmentVariableGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetConfigFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error) {
 	fake.getConfigFeatureFlagsMutex.Lock()
 	ret, specificReturn := fake.getConfigFeatureFlagsReturnsOnCall[len(fake.getConfigFeatureFlagsArgsForCall)]
@@ -3629,6 +3998,72 @@ func (fake *FakeCloudControllerClient) GetConfigFeatureFlagsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetEvents(arg1 ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 []ccv2.Filter
+	}{arg1})
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCalls(stub func(...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) []ccv2.Filter {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(arg1 string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
@@ -4753,6 +5188,72 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceServiceBindingsReturnsO
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeys(arg1 string) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceInstanceServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceServiceKeysReturnsOnCall[len(fake.getServiceInstanceServiceKeysArgsForCall)]
+	fake.getServiceInstanceServiceKeysArgsForCall = append(fake.getServiceInstanceServiceKeysArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceInstanceServiceKeys", []interface{}{arg1})
+	fake.getServiceInstanceServiceKeysMutex.Unlock()
+	if fake.GetServiceInstanceServiceKeysStub != nil {
+		return fake.GetServiceInstanceServiceKeysStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceServiceKeysReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysCallCount() int {
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
+	return len(fake.getServiceInstanceServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysCalls(stub func(string) ([]ccv2.ServiceKey, ccv2.Warnings, error)) {
+	fake.getServiceInstanceServiceKeysMutex.Lock()
+	defer fake.getServiceInstanceServiceKeysMutex.Unlock()
+	fake.GetServiceInstanceServiceKeysStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysArgsForCall(i int) string {
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceServiceKeysArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceInstanceServiceKeysMutex.Lock()
+	defer fake.getServiceInstanceServiceKeysMutex.Unlock()
+	fake.GetServiceInstanceServiceKeysStub = nil
+	fake.getServiceInstanceServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceInstanceServiceKeysMutex.Lock()
+	defer fake.getServiceInstanceServiceKeysMutex.Unlock()
+	fake.GetServiceInstanceServiceKeysStub = nil
+	if fake.getServiceInstanceServiceKeysReturnsOnCall == nil {
+		fake.getServiceInstanceServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstanceSharedFrom(arg1 string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error) {
 	fake.getServiceInstanceSharedFromMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceSharedFromReturnsOnCall[len(fake.getServiceInstanceSharedFromArgsForCall)]
@@ -6145,6 +6646,74 @@ func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceServiceBind
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetUserSpaces(arg1 string, arg2 constant.SpaceRole, arg3 ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
+	fake.getUserSpacesMutex.Lock()
+	ret, specificReturn := fake.getUserSpacesReturnsOnCall[len(fake.getUserSpacesArgsForCall)]
+	fake.getUserSpacesArgsForCall = append(fake.getUserSpacesArgsForCall, struct {
+		arg1 string
+		arg2 constant.SpaceRole
+		arg3 []ccv2.Filter
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetUserSpaces", []interface{}{arg1, arg2, arg3})
+	fake.getUserSpacesMutex.Unlock()
+	if fake.GetUserSpacesStub != nil {
+		return fake.GetUserSpacesStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getUserSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesCallCount() int {
+	fake.getUserSpacesMutex.RLock()
+	defer fake.getUserSpacesMutex.RUnlock()
+	return len(fake.getUserSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesCalls(stub func(string, constant.SpaceRole, ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)) {
+	fake.getUserSpacesMutex.Lock()
+	defer fake.getUserSpacesMutex.Unlock()
+	fake.GetUserSpacesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesArgsForCall(i int) (string, constant.SpaceRole, []ccv2.Filter) {
+	fake.getUserSpacesMutex.RLock()
+	defer fake.getUserSpacesMutex.RUnlock()
+	argsForCall := fake.getUserSpacesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesReturns(result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.getUserSpacesMutex.Lock()
+	defer fake.getUserSpacesMutex.Unlock()
+	fake.GetUserSpacesStub = nil
+	fake.getUserSpacesReturns = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetUserSpacesReturnsOnCall(i int, result1 []ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.getUserSpacesMutex.Lock()
+	defer fake.getUserSpacesMutex.Unlock()
+	fake.GetUserSpacesStub = nil
+	if fake.getUserSpacesReturnsOnCall == nil {
+		fake.getUserSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getUserSpacesReturnsOnCall[i] = struct {
+		result1 []ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
@@ -6197,6 +6766,58 @@ func (fake *FakeCloudControllerClient) MinCLIVersionReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) MinCLIVersionEnforcement() constant.MinCLIVersionEnforcement {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionEnforcementReturnsOnCall[len(fake.minCLIVersionEnforcementArgsForCall)]
+	fake.minCLIVersionEnforcementArgsForCall = append(fake.minCLIVersionEnforcementArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MinCLIVersionEnforcement", []interface{}{})
+	fake.minCLIVersionEnforcementMutex.Unlock()
+	if fake.MinCLIVersionEnforcementStub != nil {
+		return fake.MinCLIVersionEnforcementStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.minCLIVersionEnforcementReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCloudControllerClient) MinCLIVersionEnforcementCallCount() int {
+	fake.minCLIVersionEnforcementMutex.RLock()
+	defer fake.minCLIVersionEnforcementMutex.RUnlock()
+	return len(fake.minCLIVersionEnforcementArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MinCLIVersionEnforcementCalls(stub func() constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = stub
+}
+
+func (fake *FakeCloudControllerClient) MinCLIVersionEnforcementReturns(result1 constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = nil
+	fake.minCLIVersionEnforcementReturns = struct {
+		result1 constant.MinCLIVersionEnforcement
+	}{result1}
+}
+
+func (fake *FakeCloudControllerClient) MinCLIVersionEnforcementReturnsOnCall(i int, result1 constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = nil
+	if fake.minCLIVersionEnforcementReturnsOnCall == nil {
+		fake.minCLIVersionEnforcementReturnsOnCall = make(map[int]struct {
+			result1 constant.MinCLIVersionEnforcement
+		})
+	}
+	fake.minCLIVersionEnforcementReturnsOnCall[i] = struct {
+		result1 constant.MinCLIVersionEnforcement
+	}{result1}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(arg1 ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -7774,6 +8395,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteOrganizationJobMutex.RLock()
 	defer fake.deleteOrganizationJobMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -7788,6 +8411,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteServicePlanVisibilityMutex.RLock()
 	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
 	fake.deleteSpaceJobMutex.RLock()
@@ -7808,8 +8435,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getConfigEnvironmentVariableGroupMutex.RLock()
+	defer fake.getConfigEnvironmentVariableGroupMutex.RUnlock()
 	fake.getConfigFeatureFlagsMutex.RLock()
 	defer fake.getConfigFeatureFlagsMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -7844,6 +8475,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceInstanceMutex.RUnlock()
 	fake.getServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.getServiceInstanceServiceKeysMutex.RLock()
+	defer fake.getServiceInstanceServiceKeysMutex.RUnlock()
 	fake.getServiceInstanceSharedFromMutex.RLock()
 	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
 	fake.getServiceInstanceSharedTosMutex.RLock()
@@ -7886,8 +8519,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getStacksMutex.RUnlock()
 	fake.getUserProvidedServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getUserProvidedServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.getUserSpacesMutex.RLock()
+	defer fake.getUserSpacesMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.minCLIVersionEnforcementMutex.RLock()
+	defer fake.minCLIVersionEnforcementMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
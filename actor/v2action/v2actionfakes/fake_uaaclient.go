@@ -3,6 +3,7 @@ package v2actionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -76,6 +77,22 @@ type FakeUAAClient struct {
 	loginPromptsReturnsOnCall map[int]struct {
 		result1 map[string][]string
 	}
+	PollDeviceAuthorizationStub        func(uaa.DeviceAuthorization, time.Duration) (string, string, error)
+	pollDeviceAuthorizationMutex       sync.RWMutex
+	pollDeviceAuthorizationArgsForCall []struct {
+		arg1 uaa.DeviceAuthorization
+		arg2 time.Duration
+	}
+	pollDeviceAuthorizationReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	pollDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
 	RefreshAccessTokenStub        func(string) (uaa.RefreshedTokens, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -89,6 +106,18 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
+	StartDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -394,6 +423,73 @@ func (fake *FakeUAAClient) LoginPromptsReturnsOnCall(i int, result1 map[string][
 	}{result1}
 }
 
+func (fake *FakeUAAClient) PollDeviceAuthorization(arg1 uaa.DeviceAuthorization, arg2 time.Duration) (string, string, error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.pollDeviceAuthorizationReturnsOnCall[len(fake.pollDeviceAuthorizationArgsForCall)]
+	fake.pollDeviceAuthorizationArgsForCall = append(fake.pollDeviceAuthorizationArgsForCall, struct {
+		arg1 uaa.DeviceAuthorization
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeviceAuthorization", []interface{}{arg1, arg2})
+	fake.pollDeviceAuthorizationMutex.Unlock()
+	if fake.PollDeviceAuthorizationStub != nil {
+		return fake.PollDeviceAuthorizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCallCount() int {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	return len(fake.pollDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCalls(stub func(uaa.DeviceAuthorization, time.Duration) (string, string, error)) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationArgsForCall(i int) (uaa.DeviceAuthorization, time.Duration) {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.pollDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturns(result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	fake.pollDeviceAuthorizationReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	if fake.pollDeviceAuthorizationReturnsOnCall == nil {
+		fake.pollDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.pollDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUAAClient) RefreshAccessToken(arg1 string) (uaa.RefreshedTokens, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -457,6 +553,61 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) StartDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if fake.StartDeviceAuthorizationStub != nil {
+		return fake.StartDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -470,8 +621,12 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.loginPromptsMutex.RLock()
 	defer fake.loginPromptsMutex.RUnlock()
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,140 @@
+package v2action
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"github.com/gorilla/websocket"
+)
+
+// WebSocketFrame is a data frame received over a websocket connection.
+type WebSocketFrame struct {
+	Binary bool
+	Data   []byte
+}
+
+// WebSocketURL returns the websocket URL of the given target. A target
+// starting with "/" is a path on the targeted API endpoint. http and https
+// URLs are converted to ws and wss URLs.
+func (actor Actor) WebSocketURL(target string) (string, error) {
+	if strings.HasPrefix(target, "/") {
+		target = strings.TrimSuffix(actor.Config.Target(), "/") + target
+	}
+
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+
+	switch targetURL.Scheme {
+	case "http":
+		targetURL.Scheme = "ws"
+	case "https":
+		targetURL.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("unsupported websocket URL %q: expected a path or a ws, wss, http or https URL", target)
+	}
+
+	return targetURL.String(), nil
+}
+
+// StreamWebSocket opens a websocket connection to the URL and writes each
+// message to it as a text frame. Unless headers contain an Authorization
+// header, the connection is authenticated with the access token of the
+// current session when the URL is on the targeted API or Doppler endpoint;
+// the token is not sent to other hosts. The frames received are sent on the returned frame
+// channel until the connection is closed. Both channels are closed when the
+// connection is closed; a normal closure by the server is not an error.
+func (actor Actor) StreamWebSocket(wsURL string, headers http.Header, messages []string) (<-chan WebSocketFrame, <-chan error, error) {
+	requestHeaders := http.Header{}
+	for name, values := range headers {
+		requestHeaders[name] = values
+	}
+	if requestHeaders.Get("Authorization") == "" && actor.Config.AccessToken() != "" && actor.isSessionHost(wsURL) {
+		requestHeaders.Set("Authorization", actor.Config.AccessToken())
+	}
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: actor.Config.DialTimeout(),
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: actor.Config.SkipSSLValidation(),
+		},
+	}
+
+	conn, response, err := dialer.Dial(wsURL, requestHeaders)
+	if err != nil {
+		if err == websocket.ErrBadHandshake && response != nil {
+			body, _ := ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			return nil, nil, actionerror.WebSocketHandshakeError{
+				URL:        wsURL,
+				StatusCode: response.StatusCode,
+				Body:       strings.TrimSpace(string(body)),
+			}
+		}
+		return nil, nil, err
+	}
+
+	for _, message := range messages {
+		err = conn.WriteMessage(websocket.TextMessage, []byte(message))
+		if err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+	}
+
+	frames := make(chan WebSocketFrame)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(frames)
+		defer conn.Close()
+
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					errs <- err
+				}
+				return
+			}
+			frames <- WebSocketFrame{
+				Binary: messageType == websocket.BinaryMessage,
+				Data:   data,
+			}
+		}
+	}()
+
+	return frames, errs, nil
+}
+
+// isSessionHost returns whether the URL is on the host of the targeted API or
+// Doppler endpoint.
+func (actor Actor) isSessionHost(wsURL string) bool {
+	host := urlHostname(wsURL)
+	if host == "" {
+		return false
+	}
+	for _, endpoint := range []string{actor.Config.Target(), actor.CloudControllerClient.DopplerEndpoint()} {
+		if strings.EqualFold(urlHostname(endpoint), host) {
+			return true
+		}
+	}
+	return false
+}
+
+func urlHostname(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return parsedURL.Hostname()
+}
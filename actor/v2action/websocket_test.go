@@ -0,0 +1,161 @@
+package v2action_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebSocket Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeCloudControllerClient.DopplerEndpointReturns("wss://doppler.some-domain.com:443")
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		fakeConfig.TargetReturns("https://api.some-domain.com/")
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+	})
+
+	Describe("WebSocketURL", func() {
+		It("appends a path to the targeted API endpoint", func() {
+			wsURL, err := actor.WebSocketURL("/v3/some-stream")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wsURL).To(Equal("wss://api.some-domain.com/v3/some-stream"))
+		})
+
+		It("converts http URLs to ws URLs", func() {
+			wsURL, err := actor.WebSocketURL("http://doppler.some-domain.com:4443/apps/some-guid/stream")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wsURL).To(Equal("ws://doppler.some-domain.com:4443/apps/some-guid/stream"))
+		})
+
+		It("keeps wss URLs", func() {
+			wsURL, err := actor.WebSocketURL("wss://doppler.some-domain.com/firehose/some-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(wsURL).To(Equal("wss://doppler.some-domain.com/firehose/some-id"))
+		})
+
+		It("errors on other schemes", func() {
+			_, err := actor.WebSocketURL("ftp://some-domain.com/some-file")
+			Expect(err).To(MatchError(ContainSubstring("unsupported websocket URL")))
+		})
+	})
+
+	Describe("StreamWebSocket", func() {
+		var (
+			server          *httptest.Server
+			receivedHeaders http.Header
+			receivedMessage string
+		)
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		When("the server accepts the connection", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns("bearer some-token")
+
+				upgrader := websocket.Upgrader{}
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					receivedHeaders = r.Header
+					conn, err := upgrader.Upgrade(w, r, nil)
+					Expect(err).ToNot(HaveOccurred())
+					defer conn.Close()
+
+					_, message, err := conn.ReadMessage()
+					Expect(err).ToNot(HaveOccurred())
+					receivedMessage = string(message)
+
+					Expect(conn.WriteMessage(websocket.TextMessage, []byte("frame-1"))).To(Succeed())
+					Expect(conn.WriteMessage(websocket.BinaryMessage, []byte{0x01, 0x02})).To(Succeed())
+					Expect(conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))).To(Succeed())
+				}))
+			})
+
+			It("authenticates on the API endpoint, sends the messages and streams the frames", func() {
+				fakeConfig.TargetReturns(server.URL)
+				wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+				headers := http.Header{}
+				headers.Set("X-Some-Header", "some-value")
+
+				frames, errs, err := actor.StreamWebSocket(wsURL, headers, []string{"hello"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(frames).Should(Receive(Equal(WebSocketFrame{Data: []byte("frame-1")})))
+				Eventually(frames).Should(Receive(Equal(WebSocketFrame{Binary: true, Data: []byte{0x01, 0x02}})))
+				Eventually(frames).Should(BeClosed())
+				Eventually(errs).Should(BeClosed())
+
+				Expect(receivedHeaders.Get("Authorization")).To(Equal("bearer some-token"))
+				Expect(receivedHeaders.Get("X-Some-Header")).To(Equal("some-value"))
+				Expect(receivedMessage).To(Equal("hello"))
+			})
+
+			It("authenticates on the Doppler endpoint", func() {
+				fakeCloudControllerClient.DopplerEndpointReturns("ws" + strings.TrimPrefix(server.URL, "http"))
+				wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/apps/some-app-guid/stream"
+
+				frames, _, err := actor.StreamWebSocket(wsURL, nil, []string{"hello"})
+				Expect(err).ToNot(HaveOccurred())
+				Eventually(frames).Should(BeClosed())
+
+				Expect(receivedHeaders.Get("Authorization")).To(Equal("bearer some-token"))
+			})
+
+			It("does not send the access token to other hosts", func() {
+				wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+				frames, _, err := actor.StreamWebSocket(wsURL, nil, []string{"hello"})
+				Expect(err).ToNot(HaveOccurred())
+				Eventually(frames).Should(BeClosed())
+
+				Expect(receivedHeaders).ToNot(HaveKey("Authorization"))
+			})
+
+			It("sends an Authorization header given explicitly to other hosts", func() {
+				wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+				headers := http.Header{}
+				headers.Set("Authorization", "bearer other-token")
+
+				frames, _, err := actor.StreamWebSocket(wsURL, headers, []string{"hello"})
+				Expect(err).ToNot(HaveOccurred())
+				Eventually(frames).Should(BeClosed())
+
+				Expect(receivedHeaders.Get("Authorization")).To(Equal("bearer other-token"))
+			})
+		})
+
+		When("the server refuses the upgrade", func() {
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+					_, _ = w.Write([]byte("not authorized\n"))
+				}))
+			})
+
+			It("returns a WebSocketHandshakeError", func() {
+				wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+				_, _, err := actor.StreamWebSocket(wsURL, nil, nil)
+				Expect(err).To(MatchError(actionerror.WebSocketHandshakeError{
+					URL:        wsURL,
+					StatusCode: http.StatusUnauthorized,
+					Body:       "not authorized",
+				}))
+			})
+		})
+	})
+})
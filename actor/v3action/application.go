@@ -124,6 +124,19 @@ func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (
 	return actor.convertCCToActorApplication(createdApp), Warnings(warnings), nil
 }
 
+// ClearApplicationBuildCacheByNameAndSpace deletes the build cache of the
+// application with the given name in the given space.
+func (actor Actor) ClearApplicationBuildCacheByNameAndSpace(appName string, spaceGUID string) (Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.UpdateApplicationClearBuildpackCache(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
 // StopApplication stops an application.
 func (actor Actor) StopApplication(appGUID string) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
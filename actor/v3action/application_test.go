@@ -748,6 +748,68 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("ClearApplicationBuildCacheByNameAndSpace", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ClearApplicationBuildCacheByNameAndSpace("some-app-name", "some-space-guid")
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			When("clearing the build cache succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheReturns(ccv3.Warnings{"clear-cache-warning"}, nil)
+				})
+
+				It("clears the build cache of the app and returns all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "clear-cache-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					))
+					Expect(fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheArgsForCall(0)).To(Equal("some-app-guid"))
+				})
+			})
+
+			When("clearing the build cache fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheReturns(ccv3.Warnings{"clear-cache-warning"}, errors.New("clear-cache-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("clear-cache-error"))
+					Expect(warnings).To(ConsistOf("get-app-warning", "clear-cache-warning"))
+				})
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and does not clear the build cache", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("StopApplication", func() {
 		var (
 			warnings   Warnings
@@ -32,6 +32,35 @@ func (actor Actor) Authenticate(credentials map[string]string, origin string, gr
 	return nil
 }
 
+// AuthenticateWithRefreshToken exchanges an existing refresh token for an
+// access token and sets both in the config, starting a session without a
+// username and password.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithRefreshToken(refreshToken string) error {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	tokens, err := actor.UAAClient.RefreshAccessToken(refreshToken)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	// UAA only returns a new refresh token when it rotates them.
+	if tokens.RefreshToken != "" {
+		refreshToken = tokens.RefreshToken
+	}
+	actor.Config.SetTokenInformation(tokens.AuthorizationToken(), refreshToken, "")
+	actor.Config.SetUAAGrantType(string(constant.GrantTypePassword))
+
+	return nil
+}
+
 func (actor Actor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	rawPrompts := actor.UAAClient.LoginPrompts()
 	prompts := make(map[string]coreconfig.AuthPrompt)
@@ -44,6 +73,31 @@ func (actor Actor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	return prompts
 }
 
+// AuthorizationCodeURL returns the URL at which the user logs in through a
+// browser, after which UAA redirects to redirectURI with an authorization code
+// to authenticate with. codeChallenge is the PKCE challenge for the code
+// verifier to authenticate with.
+func (actor Actor) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	return actor.UAAClient.AuthorizationCodeURL(redirectURI, state, codeChallenge)
+}
+
+// VerifyAuthorizationCodeRedirect returns an error when UAA will not redirect
+// the browser back to redirectURI after the user logs in.
+func (actor Actor) VerifyAuthorizationCodeRedirect(redirectURI string) error {
+	return actor.UAAClient.VerifyAuthorizationCodeRedirect(redirectURI)
+}
+
+// RefreshLoginPrompts fetches the login prompts from UAA again, for when the
+// prompts may have changed since the client was set up.
+func (actor Actor) RefreshLoginPrompts() (map[string]coreconfig.AuthPrompt, error) {
+	err := actor.UAAClient.RefreshLoginPrompts()
+	if err != nil {
+		return nil, err
+	}
+
+	return actor.GetLoginPrompts(), nil
+}
+
 var knownAuthPromptTypes = map[string]coreconfig.AuthPromptType{
 	"text":     coreconfig.AuthPromptTypeText,
 	"password": coreconfig.AuthPromptTypePassword,
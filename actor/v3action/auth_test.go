@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 )
@@ -149,6 +150,82 @@ var _ = Describe("Auth Actions", func() {
 		})
 	})
 
+	Describe("AuthenticateWithRefreshToken", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithRefreshToken("some-refresh-token")
+		})
+
+		When("UAA issues an access token", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{
+					AccessToken: "some-access-token",
+					Type:        "bearer",
+				}, nil)
+			})
+
+			It("stores the access token with the given refresh token", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypePassword)))
+			})
+
+			When("UAA also rotates the refresh token", func() {
+				BeforeEach(func() {
+					fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{
+						AccessToken:  "some-access-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					}, nil)
+				})
+
+				It("stores the new refresh token", func() {
+					_, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(refreshToken).To(Equal("new-refresh-token"))
+				})
+			})
+		})
+
+		When("UAA rejects the refresh token", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{}, uaa.InvalidAuthTokenError{Message: "invalid"})
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(actualErr).To(MatchError(uaa.InvalidAuthTokenError{Message: "invalid"}))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a previous user authenticated with a client grant type", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetLoginPrompts", func() {
 		When("getting login prompts info from UAA", func() {
 			var (
@@ -177,4 +254,79 @@ var _ = Describe("Auth Actions", func() {
 			})
 		})
 	})
+
+	Describe("RefreshLoginPrompts", func() {
+		var (
+			prompts    map[string]coreconfig.AuthPrompt
+			refreshErr error
+		)
+
+		JustBeforeEach(func() {
+			prompts, refreshErr = actor.RefreshLoginPrompts()
+		})
+
+		When("fetching the prompts from UAA succeeds", func() {
+			BeforeEach(func() {
+				fakeUAAClient.LoginPromptsReturns(map[string][]string{
+					"username": {"text", "Email"},
+					"mfaCode":  {"password", "MFA Code"},
+				})
+			})
+
+			It("returns the refreshed prompts", func() {
+				Expect(refreshErr).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.RefreshLoginPromptsCallCount()).To(Equal(1))
+				Expect(prompts).To(Equal(map[string]coreconfig.AuthPrompt{
+					"username": {
+						DisplayName: "Email",
+						Type:        coreconfig.AuthPromptTypeText,
+					},
+					"mfaCode": {
+						DisplayName: "MFA Code",
+						Type:        coreconfig.AuthPromptTypePassword,
+					},
+				}))
+			})
+		})
+
+		When("fetching the prompts from UAA fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RefreshLoginPromptsReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(refreshErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Describe("AuthorizationCodeURL", func() {
+		BeforeEach(func() {
+			fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?state=some-state", nil)
+		})
+
+		It("returns the URL from UAA", func() {
+			authorizeURL, err := actor.AuthorizationCodeURL("http://127.0.0.1:1234/callback", "some-state", "some-challenge")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorizeURL).To(Equal("https://login.example.com/oauth/authorize?state=some-state"))
+
+			redirectURI, state, codeChallenge := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(Equal("http://127.0.0.1:1234/callback"))
+			Expect(state).To(Equal("some-state"))
+			Expect(codeChallenge).To(Equal("some-challenge"))
+		})
+	})
+
+	Describe("VerifyAuthorizationCodeRedirect", func() {
+		BeforeEach(func() {
+			fakeUAAClient.VerifyAuthorizationCodeRedirectReturns(uaa.RedirectURINotRegisteredError{ClientID: "cf"})
+		})
+
+		It("returns the error from UAA", func() {
+			err := actor.VerifyAuthorizationCodeRedirect("http://127.0.0.1:1234/callback")
+			Expect(err).To(MatchError(uaa.RedirectURINotRegisteredError{ClientID: "cf"}))
+
+			Expect(fakeUAAClient.VerifyAuthorizationCodeRedirectArgsForCall(0)).To(Equal("http://127.0.0.1:1234/callback"))
+		})
+	})
 })
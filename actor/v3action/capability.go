@@ -0,0 +1,64 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/versioncheck"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+)
+
+const (
+	CapabilityRoutingAPI             = "routing-api"
+	CapabilityNetworkPolicy          = "network-policy"
+	CapabilityLogCache               = "log-cache"
+	CapabilityRevisions              = "revisions"
+	CapabilitySidecars               = "sidecars"
+	CapabilityCNBLifecycle           = "cnb-lifecycle"
+	CapabilityServiceInstanceSharing = "service-instance-sharing"
+)
+
+// Capability reports whether the targeted foundation supports an optional
+// API, and why.
+type Capability struct {
+	Name      string `json:"name"`
+	Supported bool   `json:"supported"`
+	Reason    string `json:"reason"`
+}
+
+// GetCapabilities returns, in a fixed order, whether each optional API is
+// supported by the targeted foundation. Separately deployed APIs are detected
+// from the links advertised by the API root; features of the Cloud Controller
+// itself are detected from its V3 API version.
+func (actor Actor) GetCapabilities() ([]Capability, Warnings, error) {
+	info, _, warnings, err := actor.CloudControllerClient.GetInfo()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	apiVersion := info.CloudControllerAPIVersion()
+	return []Capability{
+		linkCapability(CapabilityRoutingAPI, "routing", info.Routing()),
+		linkCapability(CapabilityNetworkPolicy, "network_policy_v1", info.NetworkPolicyV1()),
+		linkCapability(CapabilityLogCache, "log_cache", info.LogCache()),
+		versionCapability(CapabilityRevisions, apiVersion, ccversion.MinVersionRevisionsV3),
+		versionCapability(CapabilitySidecars, apiVersion, ccversion.MinVersionSidecarsV3),
+		versionCapability(CapabilityCNBLifecycle, apiVersion, ccversion.MinVersionCNBLifecycleV3),
+		versionCapability(CapabilityServiceInstanceSharing, apiVersion, ccversion.MinVersionShareServiceV3),
+	}, Warnings(warnings), nil
+}
+
+func linkCapability(name string, link string, href string) Capability {
+	if href == "" {
+		return Capability{Name: name, Reason: link + " link not advertised"}
+	}
+	return Capability{Name: name, Supported: true, Reason: link + " link advertised"}
+}
+
+func versionCapability(name string, apiVersion string, minimum string) Capability {
+	supported, err := versioncheck.IsMinimumAPIVersionMet(apiVersion, minimum)
+	if err != nil {
+		return Capability{Name: name, Reason: "API version unknown"}
+	}
+	if !supported {
+		return Capability{Name: name, Reason: "requires API " + minimum + ", target is " + apiVersion}
+	}
+	return Capability{Name: name, Supported: true, Reason: "API " + apiVersion + " meets " + minimum}
+}
@@ -0,0 +1,80 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Capability Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetCapabilities", func() {
+		var (
+			info         ccv3.Info
+			capabilities []Capability
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			info = ccv3.Info{}
+			info.Links.Routing.HREF = "https://api.example.com/routing"
+			info.Links.LogCache.HREF = "https://log-cache.example.com"
+			info.Links.CCV3.Meta.Version = "3.70.0"
+			fakeCloudControllerClient.GetInfoReturns(info, nil, ccv3.Warnings{"info-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			capabilities, warnings, executeErr = actor.GetCapabilities()
+		})
+
+		It("reports each capability from the root links and the API version", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("info-warning"))
+			Expect(capabilities).To(Equal([]Capability{
+				{Name: CapabilityRoutingAPI, Supported: true, Reason: "routing link advertised"},
+				{Name: CapabilityNetworkPolicy, Supported: false, Reason: "network_policy_v1 link not advertised"},
+				{Name: CapabilityLogCache, Supported: true, Reason: "log_cache link advertised"},
+				{Name: CapabilityRevisions, Supported: true, Reason: "API 3.70.0 meets 3.64.0"},
+				{Name: CapabilitySidecars, Supported: false, Reason: "requires API 3.77.0, target is 3.70.0"},
+				{Name: CapabilityCNBLifecycle, Supported: false, Reason: "requires API 3.168.0, target is 3.70.0"},
+				{Name: CapabilityServiceInstanceSharing, Supported: true, Reason: "API 3.70.0 meets 3.36.0"},
+			}))
+		})
+
+		When("the API version cannot be determined", func() {
+			BeforeEach(func() {
+				info.Links.CCV3.Meta.Version = ""
+				fakeCloudControllerClient.GetInfoReturns(info, nil, nil, nil)
+			})
+
+			It("reports the version based capabilities as unsupported", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(capabilities[3]).To(Equal(Capability{Name: CapabilityRevisions, Reason: "API version unknown"}))
+			})
+		})
+
+		When("getting the root links fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetInfoReturns(ccv3.Info{}, nil, ccv3.Warnings{"info-warning"}, errors.New("info-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("info-error"))
+				Expect(warnings).To(ConsistOf("info-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package v3action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// DeviceAuthorization represents a device authorization request that the user
+// approves from another device.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// StartDeviceAuthorization requests a user code which the user enters on
+// another device to approve logging in on this one.
+func (actor Actor) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	deviceAuthorization, err := actor.UAAClient.StartDeviceAuthorization()
+	return DeviceAuthorization(deviceAuthorization), err
+}
+
+// AuthenticateWithDeviceAuthorization waits for the user to approve the device
+// authorization request, for no longer than timeout when it is not zero, and
+// sets the returned tokens in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithDeviceAuthorization(deviceAuthorization DeviceAuthorization, timeout time.Duration) error {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	accessToken, refreshToken, err := actor.UAAClient.PollDeviceAuthorization(uaa.DeviceAuthorization(deviceAuthorization), timeout)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	actor.Config.SetTokenInformation(fmt.Sprintf("bearer %s", accessToken), refreshToken, "")
+	actor.Config.SetUAAGrantType(string(constant.GrantTypeDeviceCode))
+
+	return nil
+}
@@ -15,6 +15,7 @@ type Droplet struct {
 	Stack      string
 	Image      string
 	Buildpacks []Buildpack
+	Checksum   Checksum
 }
 
 type Buildpack ccv3.DropletBuildpack
@@ -98,5 +99,6 @@ func (actor Actor) convertCCToActorDroplet(ccDroplet ccv3.Droplet) Droplet {
 		Stack:      ccDroplet.Stack,
 		Buildpacks: buildpacks,
 		Image:      ccDroplet.Image,
+		Checksum:   Checksum(ccDroplet.Checksum),
 	}
 }
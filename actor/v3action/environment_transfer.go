@@ -0,0 +1,147 @@
+package v3action
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// AppEnvironment is the user provided environment of one application, as
+// exported and imported. Redacted variables are listed by name only.
+type AppEnvironment struct {
+	Name                 string            `json:"name"`
+	EnvironmentVariables map[string]string `json:"environment_variables"`
+	RedactedVariables    []string          `json:"redacted_variables,omitempty"`
+}
+
+// AppFilter selects applications by name. Names may be glob patterns such as
+// "worker-*". An application is selected when it matches an Include pattern,
+// or Include is empty, and matches no Exclude pattern.
+type AppFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// Selects returns whether the filter selects the application named appName.
+func (filter AppFilter) Selects(appName string) bool {
+	if len(filter.Include) > 0 && !matchesAny(filter.Include, appName) {
+		return false
+	}
+	return !matchesAny(filter.Exclude, appName)
+}
+
+// EnvironmentImport is the outcome of importing environments into a space.
+type EnvironmentImport struct {
+	// Imported are the names of the applications whose environment was set.
+	Imported []string
+	// NotFound are the names of the applications missing from the space.
+	NotFound []string
+}
+
+// ExportEnvironmentVariablesBySpace returns the user provided environment
+// variables of the applications in the space selected by filter, sorted by
+// name. The values of variables whose names match a redact pattern, compared
+// case insensitively, are left out.
+func (actor Actor) ExportEnvironmentVariablesBySpace(spaceGUID string, filter AppFilter, redact []string) ([]AppEnvironment, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var environments []AppEnvironment
+	for _, app := range apps {
+		if !filter.Selects(app.Name) {
+			continue
+		}
+
+		ccEnv, warnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		environment := AppEnvironment{Name: app.Name, EnvironmentVariables: map[string]string{}}
+		for name, value := range ccEnv.EnvironmentVariables {
+			if isRedacted(redact, name) {
+				environment.RedactedVariables = append(environment.RedactedVariables, name)
+				continue
+			}
+			environment.EnvironmentVariables[name] = fmt.Sprint(value)
+		}
+		sort.Strings(environment.RedactedVariables)
+		environments = append(environments, environment)
+	}
+
+	sort.Slice(environments, func(i int, j int) bool {
+		return environments[i].Name < environments[j].Name
+	})
+	return environments, allWarnings, nil
+}
+
+// ImportEnvironmentVariablesBySpace sets the environment variables of each
+// environment selected by filter on the application of the same name in the
+// space. Variables not in the environment, including redacted ones, are left
+// unchanged. Applications missing from the space are skipped and reported.
+// Applications must be restarted for changes to take effect.
+func (actor Actor) ImportEnvironmentVariablesBySpace(spaceGUID string, environments []AppEnvironment, filter AppFilter) (EnvironmentImport, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return EnvironmentImport{}, allWarnings, err
+	}
+
+	appGUIDs := map[string]string{}
+	for _, app := range apps {
+		appGUIDs[app.Name] = app.GUID
+	}
+
+	var result EnvironmentImport
+	for _, environment := range environments {
+		if !filter.Selects(environment.Name) {
+			continue
+		}
+
+		appGUID, found := appGUIDs[environment.Name]
+		if !found {
+			result.NotFound = append(result.NotFound, environment.Name)
+			continue
+		}
+
+		if len(environment.EnvironmentVariables) > 0 {
+			variables := ccv3.EnvironmentVariables{}
+			for name, value := range environment.EnvironmentVariables {
+				variables[name] = types.FilteredString{Value: value, IsSet: true}
+			}
+
+			_, warnings, err := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(appGUID, variables)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return result, allWarnings, err
+			}
+		}
+		result.Imported = append(result.Imported, environment.Name)
+	}
+
+	return result, allWarnings, nil
+}
+
+func isRedacted(redact []string, variableName string) bool {
+	for _, pattern := range redact {
+		if matched, _ := path.Match(strings.ToUpper(pattern), strings.ToUpper(variableName)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
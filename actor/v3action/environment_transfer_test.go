@@ -0,0 +1,157 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Transfer Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _ = NewTestActor()
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{
+				{Name: "web", GUID: "web-guid"},
+				{Name: "worker-1", GUID: "worker-1-guid"},
+				{Name: "worker-2", GUID: "worker-2-guid"},
+			},
+			ccv3.Warnings{"get-apps-warning"},
+			nil,
+		)
+	})
+
+	Describe("AppFilter", func() {
+		It("selects every app when empty", func() {
+			Expect(AppFilter{}.Selects("web")).To(BeTrue())
+		})
+
+		It("selects only included apps, less excluded ones", func() {
+			filter := AppFilter{Include: []string{"worker-*"}, Exclude: []string{"worker-2"}}
+			Expect(filter.Selects("web")).To(BeFalse())
+			Expect(filter.Selects("worker-1")).To(BeTrue())
+			Expect(filter.Selects("worker-2")).To(BeFalse())
+		})
+	})
+
+	Describe("ExportEnvironmentVariablesBySpace", func() {
+		var (
+			filter       AppFilter
+			redact       []string
+			environments []AppEnvironment
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			filter = AppFilter{Exclude: []string{"worker-2"}}
+			redact = []string{"*password*"}
+			fakeCloudControllerClient.GetApplicationEnvironmentStub = func(appGUID string) (ccv3.Environment, ccv3.Warnings, error) {
+				if appGUID == "web-guid" {
+					return ccv3.Environment{
+						EnvironmentVariables: map[string]interface{}{"DB_PASSWORD": "secret", "PORT": "8080"},
+						System:               map[string]interface{}{"VCAP_SERVICES": "{}"},
+					}, ccv3.Warnings{"get-env-warning"}, nil
+				}
+				return ccv3.Environment{EnvironmentVariables: map[string]interface{}{"QUEUE": "jobs"}}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			environments, warnings, executeErr = actor.ExportEnvironmentVariablesBySpace("some-space-guid", filter, redact)
+		})
+
+		It("returns the user provided variables of the selected apps, redacting matching names", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-env-warning"))
+			Expect(environments).To(Equal([]AppEnvironment{
+				{
+					Name:                 "web",
+					EnvironmentVariables: map[string]string{"PORT": "8080"},
+					RedactedVariables:    []string{"DB_PASSWORD"},
+				},
+				{
+					Name:                 "worker-1",
+					EnvironmentVariables: map[string]string{"QUEUE": "jobs"},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationEnvironmentCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+
+		When("getting an app environment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentStub = nil
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, errors.New("get-env-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-env-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-env-warning"))
+			})
+		})
+	})
+
+	Describe("ImportEnvironmentVariablesBySpace", func() {
+		var (
+			environments []AppEnvironment
+			filter       AppFilter
+			result       EnvironmentImport
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			environments = []AppEnvironment{
+				{Name: "web", EnvironmentVariables: map[string]string{"PORT": "8080"}, RedactedVariables: []string{"DB_PASSWORD"}},
+				{Name: "worker-1", EnvironmentVariables: map[string]string{"QUEUE": "jobs"}},
+				{Name: "missing", EnvironmentVariables: map[string]string{"A": "b"}},
+			}
+			filter = AppFilter{Exclude: []string{"worker-*"}}
+			fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			result, warnings, executeErr = actor.ImportEnvironmentVariablesBySpace("some-space-guid", environments, filter)
+		})
+
+		It("sets the variables of the selected apps found in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning", "update-warning"))
+			Expect(result).To(Equal(EnvironmentImport{
+				Imported: []string{"web"},
+				NotFound: []string{"missing"},
+			}))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+			appGUID, variables := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+			Expect(appGUID).To(Equal("web-guid"))
+			Expect(variables).To(Equal(ccv3.EnvironmentVariables{
+				"PORT": types.FilteredString{Value: "8080", IsSet: true},
+			}))
+		})
+
+		When("setting the variables fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "update-warning"))
+			})
+		})
+	})
+})
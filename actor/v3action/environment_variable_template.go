@@ -0,0 +1,138 @@
+package v3action
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// EnvironmentVariableTemplateAnnotationPrefix prefixes the application
+// annotations that record the template an environment variable was resolved
+// from.
+const EnvironmentVariableTemplateAnnotationPrefix = "env-template.cli.cloudfoundry.org/"
+
+var serviceReferenceRegexp = regexp.MustCompile(`{{\s*(services\.[^{}\s]+)\s*}}`)
+
+// IsEnvironmentVariableTemplate returns true when the value refers to at
+// least one service binding credential, e.g.
+// '{{services.mydb.credentials.uri}}'.
+func IsEnvironmentVariableTemplate(value string) bool {
+	return serviceReferenceRegexp.MatchString(value)
+}
+
+// ResolveEnvironmentVariableTemplate replaces every
+// '{{services.INSTANCE_NAME.PATH}}' reference in the template with the
+// matching value of the application's VCAP_SERVICES.
+func (actor *Actor) ResolveEnvironmentVariableTemplate(appName string, spaceGUID string, template string) (string, Warnings, error) {
+	envGroups, warnings, err := actor.GetEnvironmentVariablesByApplicationNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	var resolveErr error
+	resolved := serviceReferenceRegexp.ReplaceAllStringFunc(template, func(match string) string {
+		reference := serviceReferenceRegexp.FindStringSubmatch(match)[1]
+		value, found := lookupServiceReference(envGroups.System["VCAP_SERVICES"], reference)
+		if !found && resolveErr == nil {
+			resolveErr = actionerror.ServiceBindingReferenceNotFoundError{Reference: reference}
+		}
+		return value
+	})
+	if resolveErr != nil {
+		return "", warnings, resolveErr
+	}
+
+	return resolved, warnings, nil
+}
+
+// SetEnvironmentVariableTemplateByApplicationNameAndSpace records the
+// template of an environment variable in an annotation on the application,
+// so that its value can be resolved again when the service bindings change.
+func (actor *Actor) SetEnvironmentVariableTemplateByApplicationNameAndSpace(appName string, spaceGUID string, envPair EnvironmentVariablePair) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	ccApp := ccv3.Application{GUID: app.GUID}
+	ccApp.Metadata.Annotations = map[string]types.NullString{
+		EnvironmentVariableTemplateAnnotationPrefix + envPair.Key: types.NewNullString(envPair.Value),
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
+	warnings = append(warnings, updateWarnings...)
+	return warnings, err
+}
+
+// lookupServiceReference finds the value of a
+// 'services.INSTANCE_NAME.PATH' reference in VCAP_SERVICES. PATH is a dot
+// separated list of keys and list indexes within the binding.
+func lookupServiceReference(vcapServices interface{}, reference string) (string, bool) {
+	parts := strings.Split(reference, ".")
+	if len(parts) < 3 {
+		return "", false
+	}
+
+	binding, found := findServiceBinding(vcapServices, parts[1])
+	if !found {
+		return "", false
+	}
+
+	var current interface{} = binding
+	for _, part := range parts[2:] {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[part]
+			if !ok {
+				return "", false
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			current = node[index]
+		default:
+			return "", false
+		}
+	}
+
+	if value, ok := current.(string); ok {
+		return value, true
+	}
+
+	raw, err := json.Marshal(current)
+	if err != nil {
+		return "", false
+	}
+	return string(raw), true
+}
+
+func findServiceBinding(vcapServices interface{}, instanceName string) (map[string]interface{}, bool) {
+	offerings, ok := vcapServices.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	for _, instances := range offerings {
+		bindings, ok := instances.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, binding := range bindings {
+			fields, ok := binding.(map[string]interface{})
+			if ok && fields["name"] == instanceName {
+				return fields, true
+			}
+		}
+	}
+
+	return nil, false
+}
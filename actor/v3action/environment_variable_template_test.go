@@ -0,0 +1,174 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment Variable Template Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	DescribeTable("IsEnvironmentVariableTemplate",
+		func(value string, expected bool) {
+			Expect(IsEnvironmentVariableTemplate(value)).To(Equal(expected))
+		},
+		Entry("a plain value", "some-value", false),
+		Entry("a service reference", "{{services.mydb.credentials.uri}}", true),
+		Entry("a service reference with spaces", "{{ services.mydb.credentials.uri }}", true),
+		Entry("an embedded service reference", "jdbc:{{services.mydb.credentials.uri}}?ssl=true", true),
+		Entry("a reference to something else", "{{app.name}}", false),
+	)
+
+	Describe("ResolveEnvironmentVariableTemplate", func() {
+		var (
+			template   string
+			resolved   string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			template = "{{services.mydb.credentials.uri}}"
+		})
+
+		JustBeforeEach(func() {
+			resolved, warnings, executeErr = actor.ResolveEnvironmentVariableTemplate("some-app", "some-space-guid", template)
+		})
+
+		When("getting the app environment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, errors.New("get-application-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-application-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+			})
+		})
+
+		When("getting the app environment succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-application-warning"}, nil)
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv3.Environment{
+						System: map[string]interface{}{
+							"VCAP_SERVICES": map[string]interface{}{
+								"p-mysql": []interface{}{
+									map[string]interface{}{
+										"name": "mydb",
+										"credentials": map[string]interface{}{
+											"uri":   "mysql://db:3306/app",
+											"port":  float64(3306),
+											"hosts": []interface{}{"db-1", "db-2"},
+										},
+									},
+								},
+							},
+						},
+					},
+					ccv3.Warnings{"get-env-warning"},
+					nil,
+				)
+			})
+
+			It("resolves the reference from the service binding", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resolved).To(Equal("mysql://db:3306/app"))
+				Expect(warnings).To(ConsistOf("get-application-warning", "get-env-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+
+			When("the template has several references and surrounding text", func() {
+				BeforeEach(func() {
+					template = "{{services.mydb.credentials.hosts.1}}:{{ services.mydb.credentials.port }}/app"
+				})
+
+				It("resolves each reference, encoding non-string values as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(resolved).To(Equal("db-2:3306/app"))
+				})
+			})
+
+			When("the service instance is not bound to the app", func() {
+				BeforeEach(func() {
+					template = "{{services.otherdb.credentials.uri}}"
+				})
+
+				It("returns a ServiceBindingReferenceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceBindingReferenceNotFoundError{Reference: "services.otherdb.credentials.uri"}))
+				})
+			})
+
+			When("the credential does not exist", func() {
+				BeforeEach(func() {
+					template = "{{services.mydb.credentials.password}}"
+				})
+
+				It("returns a ServiceBindingReferenceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServiceBindingReferenceNotFoundError{Reference: "services.mydb.credentials.password"}))
+				})
+			})
+		})
+	})
+
+	Describe("SetEnvironmentVariableTemplateByApplicationNameAndSpace", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetEnvironmentVariableTemplateByApplicationNameAndSpace("some-app", "some-space-guid", EnvironmentVariablePair{
+				Key:   "DB_URL",
+				Value: "{{services.mydb.credentials.uri}}",
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, errors.New("get-application-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-application-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the app succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-application-warning"}, nil)
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("annotates the app with the template", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				app := fakeCloudControllerClient.UpdateApplicationArgsForCall(0)
+				Expect(app.GUID).To(Equal("some-app-guid"))
+				Expect(app.Metadata.Annotations).To(Equal(map[string]types.NullString{
+					"env-template.cli.cloudfoundry.org/DB_URL": types.NewNullString("{{services.mydb.credentials.uri}}"),
+				}))
+			})
+		})
+	})
+})
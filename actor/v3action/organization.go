@@ -26,6 +26,19 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
 
+// GetOrganizations returns all the organizations the user can see, ordered by
+// name.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	)
+	if err != nil {
+		return []Organization{}, Warnings(warnings), err
+	}
+
+	return actor.convertCCToActorOrganizations(orgs), Warnings(warnings), nil
+}
+
 func (actor Actor) GetOrganizationsByGUIDs(guids ...string) ([]Organization, Warnings, error) {
 	currentV3Ver := actor.CloudControllerClient.CloudControllerAPIVersion()
 
@@ -0,0 +1,106 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// OrganizationQuota represents a V3 actor organization quota.
+type OrganizationQuota ccv3.OrganizationQuota
+
+// QuotaLimits represents the limits of an organization or space quota. Nil
+// limits are left unchanged when updating a quota, and limits whose values
+// are not set are unlimited.
+type QuotaLimits ccv3.QuotaLimits
+
+// ApplyOrganizationQuotaByName applies the organization quota with the given
+// name to the organization.
+func (actor Actor) ApplyOrganizationQuotaByName(quotaName string, orgGUID string) (Warnings, error) {
+	quota, allWarnings, err := actor.GetOrganizationQuotaByName(quotaName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.ApplyOrganizationQuota(quota.GUID, orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// CreateOrganizationQuota creates an organization quota with the given name
+// and limits.
+func (actor Actor) CreateOrganizationQuota(name string, limits QuotaLimits) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateOrganizationQuota(ccv3.OrganizationQuota{
+		Name:        name,
+		QuotaLimits: ccv3.QuotaLimits(limits),
+	})
+	return Warnings(warnings), err
+}
+
+// DeleteOrganizationQuota deletes the organization quota with the given name
+// and waits for the deletion to complete.
+func (actor Actor) DeleteOrganizationQuota(name string) (Warnings, error) {
+	quota, allWarnings, err := actor.GetOrganizationQuotaByName(name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteOrganizationQuota(quota.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// GetOrganizationQuotaByName returns the organization quota with the given
+// name.
+func (actor Actor) GetOrganizationQuotaByName(name string) (OrganizationQuota, Warnings, error) {
+	quotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(ccv3.Query{
+		Key:    ccv3.NameFilter,
+		Values: []string{name},
+	})
+	if err != nil {
+		return OrganizationQuota{}, Warnings(warnings), err
+	}
+
+	if len(quotas) == 0 {
+		return OrganizationQuota{}, Warnings(warnings), actionerror.OrganizationQuotaNotFoundForNameError{Name: name}
+	}
+
+	return OrganizationQuota(quotas[0]), Warnings(warnings), nil
+}
+
+// GetOrganizationQuotas returns all organization quotas.
+func (actor Actor) GetOrganizationQuotas() ([]OrganizationQuota, Warnings, error) {
+	ccQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var quotas []OrganizationQuota
+	for _, quota := range ccQuotas {
+		quotas = append(quotas, OrganizationQuota(quota))
+	}
+
+	return quotas, Warnings(warnings), nil
+}
+
+// UpdateOrganizationQuota updates the limits of the organization quota with
+// the given name, and renames it when newName is provided.
+func (actor Actor) UpdateOrganizationQuota(name string, newName string, limits QuotaLimits) (Warnings, error) {
+	quota, allWarnings, err := actor.GetOrganizationQuotaByName(name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(ccv3.OrganizationQuota{
+		GUID:        quota.GUID,
+		Name:        newName,
+		QuotaLimits: ccv3.QuotaLimits(limits),
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
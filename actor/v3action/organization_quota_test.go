@@ -0,0 +1,180 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization Quota Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationQuotaByName", func() {
+		var (
+			quota      OrganizationQuota
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			quota, warnings, executeErr = actor.GetOrganizationQuotaByName("some-quota")
+		})
+
+		When("the quota exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv3.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("filters by name and returns the quota with all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(quota).To(Equal(OrganizationQuota{GUID: "some-quota-guid", Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(ConsistOf(ccv3.Query{
+					Key:    ccv3.NameFilter,
+					Values: []string{"some-quota"},
+				}))
+			})
+		})
+
+		When("the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundForNameError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationQuotaNotFoundForNameError{Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		It("creates the quota with the given limits", func() {
+			fakeCloudControllerClient.CreateOrganizationQuotaReturns(ccv3.OrganizationQuota{}, ccv3.Warnings{"create-warning"}, nil)
+
+			limits := QuotaLimits{Apps: ccv3.AppLimits{PerAppTasks: &types.NullInt{IsSet: true, Value: 4}}}
+			warnings, err := actor.CreateOrganizationQuota("some-quota", limits)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0)).To(Equal(ccv3.OrganizationQuota{
+				Name:        "some-quota",
+				QuotaLimits: ccv3.QuotaLimits(limits),
+			}))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]ccv3.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateOrganizationQuota("some-quota", "new-name", QuotaLimits{
+				Routes: ccv3.RouteLimits{TotalRoutes: &types.NullInt{}},
+			})
+		})
+
+		When("the update succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv3.OrganizationQuota{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the quota by GUID and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)).To(Equal(ccv3.OrganizationQuota{
+					GUID: "some-quota-guid",
+					Name: "new-name",
+					QuotaLimits: ccv3.QuotaLimits{
+						Routes: ccv3.RouteLimits{TotalRoutes: &types.NullInt{}},
+					},
+				}))
+			})
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv3.OrganizationQuota{}, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("get-warning", "update-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]ccv3.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteOrganizationQuotaReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		It("deletes the quota and waits for the job", func() {
+			warnings, err := actor.DeleteOrganizationQuota("some-quota")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "delete-warning", "poll-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteOrganizationQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+	})
+
+	Describe("ApplyOrganizationQuotaByName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]ccv3.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.ApplyOrganizationQuotaReturns(ccv3.RelationshipList{}, ccv3.Warnings{"apply-warning"}, nil)
+		})
+
+		It("applies the quota to the organization", func() {
+			warnings, err := actor.ApplyOrganizationQuotaByName("some-quota", "some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "apply-warning"))
+
+			quotaGUID, orgGUID := fakeCloudControllerClient.ApplyOrganizationQuotaArgsForCall(0)
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
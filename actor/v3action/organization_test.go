@@ -95,6 +95,56 @@ var _ = Describe("Organization Actions", func() {
 		})
 	})
 
+	Describe("GetOrganizations", func() {
+		var (
+			executeErr error
+			warnings   Warnings
+			orgs       []Organization
+		)
+
+		JustBeforeEach(func() {
+			orgs, warnings, executeErr = actor.GetOrganizations()
+		})
+
+		When("the cloud controller returns organizations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{
+						{Name: "org-1", GUID: "org-guid-1"},
+						{Name: "org-2", GUID: "org-guid-2"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the organizations ordered by name and the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{
+					{Name: "org-1", GUID: "org-guid-1"},
+					{Name: "org-2", GUID: "org-guid-2"},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the warnings and the error", func() {
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
 	Describe("GetOrganizationsByGUIDs", func() {
 		Context("when organizations endpoint supports the 'guids' param", func() {
 			When("the orgs exists", func() {
@@ -0,0 +1,239 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// The annotation keys used to record who owns an organization, space or
+// application.
+const (
+	OwnerAnnotation   = "owner"
+	TeamAnnotation    = "team"
+	ContactAnnotation = "contact"
+)
+
+// OwnedResourceType is the kind of resource an ownership record belongs to.
+type OwnedResourceType string
+
+const (
+	OwnedOrganization OwnedResourceType = "org"
+	OwnedSpace        OwnedResourceType = "space"
+	OwnedApplication  OwnedResourceType = "app"
+)
+
+// Owner is the ownership recorded in a resource's owner, team and contact
+// annotations.
+type Owner struct {
+	Owner   string
+	Team    string
+	Contact string
+}
+
+// IsSet returns true if any of the ownership annotations are present.
+func (owner Owner) IsSet() bool {
+	return owner.Owner != "" || owner.Team != "" || owner.Contact != ""
+}
+
+// ResourceOwner is the ownership of a single organization, space or
+// application.
+type ResourceOwner struct {
+	Owner
+	Type OwnedResourceType
+	Name string
+	// SpaceName is the name of the application's space. It is empty for
+	// organizations and spaces.
+	SpaceName string
+}
+
+// UpdateOrganizationOwnershipByName sets the given ownership annotations on
+// the organization. Annotations with a null value are removed.
+func (actor Actor) UpdateOrganizationOwnershipByName(orgName string, annotations map[string]types.NullString) (Warnings, error) {
+	org, allWarnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganization(ccv3.Organization{
+		GUID:     org.GUID,
+		Metadata: &ccv3.Metadata{Annotations: annotations},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UpdateSpaceOwnershipByName sets the given ownership annotations on the
+// space. Annotations with a null value are removed.
+func (actor Actor) UpdateSpaceOwnershipByName(spaceName string, orgGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	space, allWarnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateSpace(ccv3.Space{
+		GUID:     space.GUID,
+		Metadata: &ccv3.Metadata{Annotations: annotations},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UpdateApplicationOwnershipByName sets the given ownership annotations on
+// the application. Annotations with a null value are removed.
+func (actor Actor) UpdateApplicationOwnershipByName(appName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccApp := ccv3.Application{GUID: app.GUID}
+	ccApp.Metadata.Annotations = annotations
+
+	_, warnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// GetOrganizationOwners returns the ownership of the organization, its spaces
+// and their applications. Resources without any ownership annotations are
+// left out. The organization comes first, followed by the spaces and then
+// the applications, each sorted by name.
+func (actor Actor) GetOrganizationOwners(orgName string) ([]ResourceOwner, Warnings, error) {
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{orgName}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	if len(orgs) == 0 {
+		return nil, allWarnings, actionerror.OrganizationNotFoundError{Name: orgName}
+	}
+
+	var owners []ResourceOwner
+	if owner := ownerFromMetadata(orgs[0].Metadata); owner.IsSet() {
+		owners = append(owners, ResourceOwner{Owner: owner, Type: OwnedOrganization, Name: orgs[0].Name})
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgs[0].GUID}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return owners, allWarnings, nil
+	}
+
+	var (
+		spaceOwners []ResourceOwner
+		spaceGUIDs  []string
+	)
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceGUIDs = append(spaceGUIDs, space.GUID)
+		spaceNames[space.GUID] = space.Name
+		if owner := ownerFromMetadata(space.Metadata); owner.IsSet() {
+			spaceOwners = append(spaceOwners, ResourceOwner{Owner: owner, Type: OwnedSpace, Name: space.Name})
+		}
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: spaceGUIDs},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var appOwners []ResourceOwner
+	for _, app := range apps {
+		owner := ownerFromAnnotations(app.Metadata.Annotations)
+		if !owner.IsSet() {
+			continue
+		}
+		appOwners = append(appOwners, ResourceOwner{
+			Owner:     owner,
+			Type:      OwnedApplication,
+			Name:      app.Name,
+			SpaceName: spaceNames[app.Relationships[constant.RelationshipTypeSpace].GUID],
+		})
+	}
+
+	sort.Slice(spaceOwners, func(i, j int) bool {
+		return sorting.LessIgnoreCase(spaceOwners[i].Name, spaceOwners[j].Name)
+	})
+	sort.Slice(appOwners, func(i, j int) bool {
+		if appOwners[i].SpaceName != appOwners[j].SpaceName {
+			return sorting.LessIgnoreCase(appOwners[i].SpaceName, appOwners[j].SpaceName)
+		}
+		return sorting.LessIgnoreCase(appOwners[i].Name, appOwners[j].Name)
+	})
+
+	owners = append(owners, spaceOwners...)
+	owners = append(owners, appOwners...)
+	return owners, allWarnings, nil
+}
+
+// GetSpaceOwnersByOrganization returns the ownership of the spaces in the
+// organization, keyed by space GUID. Spaces without any ownership
+// annotations are left out.
+func (actor Actor) GetSpaceOwnersByOrganization(orgGUID string) (map[string]Owner, Warnings, error) {
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	owners := map[string]Owner{}
+	for _, space := range spaces {
+		if owner := ownerFromMetadata(space.Metadata); owner.IsSet() {
+			owners[space.GUID] = owner
+		}
+	}
+
+	return owners, Warnings(warnings), nil
+}
+
+// GetApplicationOwnersBySpace returns the ownership of the applications in
+// the space, keyed by application GUID. Applications without any ownership
+// annotations are left out.
+func (actor Actor) GetApplicationOwnersBySpace(spaceGUID string) (map[string]Owner, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	owners := map[string]Owner{}
+	for _, app := range apps {
+		if owner := ownerFromAnnotations(app.Metadata.Annotations); owner.IsSet() {
+			owners[app.GUID] = owner
+		}
+	}
+
+	return owners, Warnings(warnings), nil
+}
+
+func ownerFromMetadata(metadata *ccv3.Metadata) Owner {
+	if metadata == nil {
+		return Owner{}
+	}
+	return ownerFromAnnotations(metadata.Annotations)
+}
+
+func ownerFromAnnotations(annotations map[string]types.NullString) Owner {
+	return Owner{
+		Owner:   annotations[OwnerAnnotation].Value,
+		Team:    annotations[TeamAnnotation].Value,
+		Contact: annotations[ContactAnnotation].Value,
+	}
+}
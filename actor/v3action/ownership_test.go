@@ -0,0 +1,334 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func ccApplicationWithOwner(name string, guid string, spaceGUID string, owner string) ccv3.Application {
+	app := ccv3.Application{
+		Name: name,
+		GUID: guid,
+		Relationships: ccv3.Relationships{
+			constant.RelationshipTypeSpace: ccv3.Relationship{GUID: spaceGUID},
+		},
+	}
+	if owner != "" {
+		app.Metadata.Annotations = map[string]types.NullString{
+			OwnerAnnotation: types.NewNullString(owner),
+		}
+	}
+	return app
+}
+
+var _ = Describe("Ownership Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		annotations               map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+		annotations = map[string]types.NullString{
+			OwnerAnnotation:   types.NewNullString("jane"),
+			ContactAnnotation: types.NewNullString(),
+		}
+	})
+
+	Describe("UpdateOrganizationOwnershipByName", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateOrganizationOwnershipByName("some-org", annotations)
+		})
+
+		When("the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid"}}, ccv3.Warnings{"get-org-warning"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationReturns(ccv3.Organization{}, ccv3.Warnings{"update-org-warning"}, nil)
+			})
+
+			It("updates the org's annotations and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "update-org-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateOrganizationArgsForCall(0)).To(Equal(ccv3.Organization{
+					GUID:     "some-org-guid",
+					Metadata: &ccv3.Metadata{Annotations: annotations},
+				}))
+			})
+
+			When("updating the org fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateOrganizationReturns(ccv3.Organization{}, ccv3.Warnings{"update-org-warning"}, errors.New("update-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("update-error"))
+					Expect(warnings).To(ConsistOf("get-org-warning", "update-org-warning"))
+				})
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceOwnershipByName", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateSpaceOwnershipByName("some-space", "some-org-guid", annotations)
+		})
+
+		When("the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{GUID: "some-space-guid"}}, ccv3.Warnings{"get-space-warning"}, nil)
+				fakeCloudControllerClient.UpdateSpaceReturns(ccv3.Space{}, ccv3.Warnings{"update-space-warning"}, nil)
+			})
+
+			It("updates the space's annotations and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-warning", "update-space-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+				Expect(fakeCloudControllerClient.UpdateSpaceArgsForCall(0)).To(Equal(ccv3.Space{
+					GUID:     "some-space-guid",
+					Metadata: &ccv3.Metadata{Annotations: annotations},
+				}))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("get-space-warning"))
+				Expect(fakeCloudControllerClient.UpdateSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationOwnershipByName", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationOwnershipByName("some-app", "some-space-guid", annotations)
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-app-warning"}, nil)
+			})
+
+			It("only updates the app's annotations and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-app-warning"))
+
+				updatedApp := fakeCloudControllerClient.UpdateApplicationArgsForCall(0)
+				Expect(updatedApp.GUID).To(Equal("some-app-guid"))
+				Expect(updatedApp.Name).To(BeEmpty())
+				Expect(updatedApp.Metadata.Annotations).To(Equal(annotations))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetOrganizationOwners", func() {
+		var (
+			owners     []ResourceOwner
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			owners, warnings, executeErr = actor.GetOrganizationOwners("some-org")
+		})
+
+		When("the org, its spaces and apps have owners", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{
+						GUID: "some-org-guid",
+						Name: "some-org",
+						Metadata: &ccv3.Metadata{Annotations: map[string]types.NullString{
+							OwnerAnnotation:   types.NewNullString("platform"),
+							TeamAnnotation:    types.NewNullString("core"),
+							ContactAnnotation: types.NewNullString("#core-oncall"),
+						}},
+					}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{GUID: "space-guid-2", Name: "zeta", Metadata: &ccv3.Metadata{Annotations: map[string]types.NullString{
+							TeamAnnotation: types.NewNullString("payments"),
+						}}},
+						{GUID: "space-guid-1", Name: "alpha"},
+					},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						ccApplicationWithOwner("web", "app-guid-1", "space-guid-2", "alice"),
+						ccApplicationWithOwner("api", "app-guid-2", "space-guid-2", "bob"),
+						ccApplicationWithOwner("worker", "app-guid-3", "space-guid-1", "carol"),
+						ccApplicationWithOwner("unowned", "app-guid-4", "space-guid-1", ""),
+					},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the owned resources in order and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning", "get-apps-warning"))
+
+				Expect(owners).To(Equal([]ResourceOwner{
+					{Type: OwnedOrganization, Name: "some-org", Owner: Owner{Owner: "platform", Team: "core", Contact: "#core-oncall"}},
+					{Type: OwnedSpace, Name: "zeta", Owner: Owner{Team: "payments"}},
+					{Type: OwnedApplication, Name: "worker", SpaceName: "alpha", Owner: Owner{Owner: "carol"}},
+					{Type: OwnedApplication, Name: "api", SpaceName: "zeta", Owner: Owner{Owner: "bob"}},
+					{Type: OwnedApplication, Name: "web", SpaceName: "zeta", Owner: Owner{Owner: "alice"}},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid-2", "space-guid-1"}},
+				))
+			})
+		})
+
+		When("the org has no spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid", Name: "some-org"}}, nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns(nil, nil, nil)
+			})
+
+			It("returns no owners without listing apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(owners).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+
+		When("getting the spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid"}}, ccv3.Warnings{"get-org-warning"}, nil)
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-spaces-warning"}, errors.New("spaces-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("spaces-error"))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceOwnersByOrganization", func() {
+		It("returns the owned spaces keyed by GUID", func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{
+					{GUID: "space-guid-1", Metadata: &ccv3.Metadata{Annotations: map[string]types.NullString{
+						OwnerAnnotation: types.NewNullString("jane"),
+					}}},
+					{GUID: "space-guid-2"},
+				},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+
+			owners, warnings, err := actor.GetSpaceOwnersByOrganization("some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning"))
+			Expect(owners).To(Equal(map[string]Owner{"space-guid-1": {Owner: "jane"}}))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+		})
+	})
+
+	Describe("GetApplicationOwnersBySpace", func() {
+		It("returns the owned apps keyed by GUID", func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					ccApplicationWithOwner("web", "app-guid-1", "some-space-guid", "alice"),
+					ccApplicationWithOwner("worker", "app-guid-2", "some-space-guid", ""),
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+
+			owners, warnings, err := actor.GetApplicationOwnersBySpace("some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning"))
+			Expect(owners).To(Equal(map[string]Owner{"app-guid-1": {Owner: "alice"}}))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+	})
+})
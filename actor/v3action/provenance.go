@@ -0,0 +1,221 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ProvenanceAnnotationPrefix prefixes the application annotations that
+// record what was pushed, from where and by whom.
+const ProvenanceAnnotationPrefix = "provenance.cli.cloudfoundry.org/"
+
+const (
+	inTotoStatementType    = "https://in-toto.io/Statement/v0.1"
+	slsaProvenanceType     = "https://slsa.dev/provenance/v0.2"
+	provenanceBuildType    = "https://code.cloudfoundry.org/cli/push@v1"
+	gitCommitDigestType    = "sha1"
+	provenanceSubjectScope = "droplet/"
+)
+
+// Checksum is the digest of a package or droplet.
+type Checksum ccv3.Checksum
+
+// String returns the checksum as TYPE:VALUE, or an empty string when the
+// Cloud Controller did not compute one.
+func (checksum Checksum) String() string {
+	if checksum.Value == "" {
+		return ""
+	}
+	return checksum.Type + ":" + checksum.Value
+}
+
+// Provenance describes the artifacts of a push: the droplet that is
+// running, the package it was staged from, the source commit and the user
+// who pushed it.
+type Provenance struct {
+	AppName string
+	AppGUID string
+
+	DropletGUID     string
+	DropletChecksum Checksum
+	PackageGUID     string
+	PackageChecksum Checksum
+
+	GitCommit    string
+	GitRemoteURL string
+
+	PushedBy string
+	PushedAt time.Time
+}
+
+// GetApplicationArtifactProvenance returns the checksums of the current
+// droplet of the application and of its most recent bits package. Either
+// may be missing, for example for docker apps or apps that have not been
+// staged yet.
+func (actor Actor) GetApplicationArtifactProvenance(appGUID string) (Provenance, Warnings, error) {
+	provenance := Provenance{AppGUID: appGUID}
+
+	droplet, allWarnings, err := actor.GetCurrentDropletByApplication(appGUID)
+	switch err.(type) {
+	case nil:
+		provenance.DropletGUID = droplet.GUID
+		provenance.DropletChecksum = droplet.Checksum
+	case actionerror.DropletNotFoundError:
+	default:
+		return Provenance{}, allWarnings, err
+	}
+
+	packages, warnings, err := actor.CloudControllerClient.GetPackages(ccv3.Query{
+		Key:    ccv3.AppGUIDFilter,
+		Values: []string{appGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Provenance{}, allWarnings, err
+	}
+
+	var latest ccv3.Package
+	for _, pkg := range packages {
+		if pkg.Type != constant.PackageTypeBits || pkg.State != constant.PackageReady {
+			continue
+		}
+		if pkg.CreatedAt >= latest.CreatedAt {
+			latest = pkg
+		}
+	}
+	provenance.PackageGUID = latest.GUID
+	provenance.PackageChecksum = Checksum(latest.Checksum)
+
+	return provenance, allWarnings, nil
+}
+
+// SetApplicationProvenance records the provenance in annotations on the
+// application. Annotations for missing values are removed so that they do
+// not describe an earlier push.
+func (actor Actor) SetApplicationProvenance(provenance Provenance) (Warnings, error) {
+	values := map[string]string{
+		"droplet-checksum": provenance.DropletChecksum.String(),
+		"package-checksum": provenance.PackageChecksum.String(),
+		"git-commit":       provenance.GitCommit,
+		"pushed-by":        provenance.PushedBy,
+		"pushed-at":        "",
+	}
+	if !provenance.PushedAt.IsZero() {
+		values["pushed-at"] = provenance.PushedAt.UTC().Format(time.RFC3339)
+	}
+
+	ccApp := ccv3.Application{GUID: provenance.AppGUID}
+	ccApp.Metadata.Annotations = map[string]types.NullString{}
+	for key, value := range values {
+		annotation := types.NullString{}
+		if value != "" {
+			annotation = types.NewNullString(value)
+		}
+		ccApp.Metadata.Annotations[ProvenanceAnnotationPrefix+key] = annotation
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
+	return Warnings(warnings), err
+}
+
+// ProvenanceStatement is an in-toto statement carrying a SLSA provenance
+// predicate for the droplets of one or more pushed apps.
+type ProvenanceStatement struct {
+	Type          string                  `json:"_type"`
+	Subject       []ProvenanceSubject     `json:"subject"`
+	PredicateType string                  `json:"predicateType"`
+	Predicate     SLSAProvenancePredicate `json:"predicate"`
+}
+
+// ProvenanceSubject is an artifact described by a ProvenanceStatement.
+type ProvenanceSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// SLSAProvenancePredicate describes how the subjects of a
+// ProvenanceStatement were built.
+type SLSAProvenancePredicate struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	BuildType  string `json:"buildType"`
+	Invocation struct {
+		Parameters map[string]string `json:"parameters,omitempty"`
+	} `json:"invocation"`
+	Metadata struct {
+		BuildFinishedOn string `json:"buildFinishedOn,omitempty"`
+	} `json:"metadata"`
+	Materials []ProvenanceMaterial `json:"materials"`
+}
+
+// ProvenanceMaterial is an input to the build described by a
+// ProvenanceStatement.
+type ProvenanceMaterial struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+// NewProvenanceStatement builds the provenance statement for the given
+// pushes. builderID identifies the Cloud Controller that staged the
+// droplets. Droplets without a checksum are not included as subjects.
+func NewProvenanceStatement(builderID string, provenances []Provenance) ProvenanceStatement {
+	statement := ProvenanceStatement{
+		Type:          inTotoStatementType,
+		Subject:       []ProvenanceSubject{},
+		PredicateType: slsaProvenanceType,
+	}
+	statement.Predicate.Builder.ID = builderID
+	statement.Predicate.BuildType = provenanceBuildType
+	statement.Predicate.Materials = []ProvenanceMaterial{}
+
+	seenMaterials := map[string]bool{}
+	addMaterial := func(uri string, digestType string, digest string) {
+		if digest == "" || seenMaterials[uri+digest] {
+			return
+		}
+		seenMaterials[uri+digest] = true
+		statement.Predicate.Materials = append(statement.Predicate.Materials, ProvenanceMaterial{
+			URI:    uri,
+			Digest: map[string]string{digestType: digest},
+		})
+	}
+
+	var finishedOn time.Time
+	for _, provenance := range provenances {
+		if provenance.DropletChecksum.Value != "" {
+			statement.Subject = append(statement.Subject, ProvenanceSubject{
+				Name:   provenanceSubjectScope + provenance.AppName,
+				Digest: map[string]string{provenance.DropletChecksum.Type: provenance.DropletChecksum.Value},
+			})
+		}
+
+		if provenance.GitCommit != "" {
+			source := provenance.GitRemoteURL
+			if source == "" {
+				source = "git"
+			}
+			addMaterial("git+"+source, gitCommitDigestType, provenance.GitCommit)
+		}
+		addMaterial("package/"+provenance.PackageGUID, provenance.PackageChecksum.Type, provenance.PackageChecksum.Value)
+
+		if provenance.PushedBy != "" {
+			if statement.Predicate.Invocation.Parameters == nil {
+				statement.Predicate.Invocation.Parameters = map[string]string{}
+			}
+			statement.Predicate.Invocation.Parameters["pushedBy"] = provenance.PushedBy
+		}
+		if provenance.PushedAt.After(finishedOn) {
+			finishedOn = provenance.PushedAt
+		}
+	}
+
+	if !finishedOn.IsZero() {
+		statement.Predicate.Metadata.BuildFinishedOn = finishedOn.UTC().Format(time.RFC3339)
+	}
+	return statement
+}
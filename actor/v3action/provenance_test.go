@@ -0,0 +1,193 @@
+package v3action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Provenance Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationArtifactProvenance", func() {
+		var (
+			provenance Provenance
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			provenance, warnings, executeErr = actor.GetApplicationArtifactProvenance("some-app-guid")
+		})
+
+		When("the app has a droplet and packages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", Checksum: ccv3.Checksum{Type: "sha256", Value: "some-droplet-sha"}},
+					ccv3.Warnings{"droplet-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{GUID: "old-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady, CreatedAt: "2019-01-01T00:00:00Z", Checksum: ccv3.Checksum{Type: "sha256", Value: "old-package-sha"}},
+						{GUID: "new-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady, CreatedAt: "2019-01-02T00:00:00Z", Checksum: ccv3.Checksum{Type: "sha256", Value: "new-package-sha"}},
+						{GUID: "pending-package-guid", Type: constant.PackageTypeBits, State: constant.PackageProcessingUpload, CreatedAt: "2019-01-03T00:00:00Z"},
+					},
+					ccv3.Warnings{"packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns the droplet checksum and the checksum of the latest ready package", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("droplet-warning", "packages-warning"))
+				Expect(provenance).To(Equal(Provenance{
+					AppGUID:         "some-app-guid",
+					DropletGUID:     "some-droplet-guid",
+					DropletChecksum: Checksum{Type: "sha256", Value: "some-droplet-sha"},
+					PackageGUID:     "new-package-guid",
+					PackageChecksum: Checksum{Type: "sha256", Value: "new-package-sha"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		When("the app has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"droplet-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns the package only", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("droplet-warning"))
+				Expect(provenance.DropletGUID).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetPackagesCallCount()).To(Equal(1))
+			})
+		})
+
+		When("getting the droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"droplet-warning"}, errors.New("droplet-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("droplet-error"))
+				Expect(warnings).To(ConsistOf("droplet-warning"))
+			})
+		})
+
+		When("getting the packages fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, errors.New("packages-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("packages-error"))
+				Expect(warnings).To(ConsistOf("packages-warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationProvenance", func() {
+		var (
+			provenance Provenance
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			provenance = Provenance{
+				AppGUID:         "some-app-guid",
+				DropletChecksum: Checksum{Type: "sha256", Value: "some-droplet-sha"},
+				PackageChecksum: Checksum{Type: "sha256", Value: "some-package-sha"},
+				PushedBy:        "some-user",
+				PushedAt:        time.Date(2019, 2, 3, 4, 5, 6, 0, time.UTC),
+			}
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetApplicationProvenance(provenance)
+		})
+
+		It("annotates the app and removes annotations for missing values", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			app := fakeCloudControllerClient.UpdateApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(app.Metadata.Annotations).To(Equal(map[string]types.NullString{
+				"provenance.cli.cloudfoundry.org/droplet-checksum": types.NewNullString("sha256:some-droplet-sha"),
+				"provenance.cli.cloudfoundry.org/package-checksum": types.NewNullString("sha256:some-package-sha"),
+				"provenance.cli.cloudfoundry.org/git-commit":       {},
+				"provenance.cli.cloudfoundry.org/pushed-by":        types.NewNullString("some-user"),
+				"provenance.cli.cloudfoundry.org/pushed-at":        types.NewNullString("2019-02-03T04:05:06Z"),
+			}))
+		})
+	})
+
+	Describe("NewProvenanceStatement", func() {
+		It("describes the droplets as subjects and the sources as materials", func() {
+			statement := NewProvenanceStatement("https://api.example.com", []Provenance{
+				{
+					AppName:         "app-1",
+					DropletChecksum: Checksum{Type: "sha256", Value: "droplet-1-sha"},
+					PackageGUID:     "package-1-guid",
+					PackageChecksum: Checksum{Type: "sha256", Value: "package-1-sha"},
+					GitCommit:       "some-commit",
+					GitRemoteURL:    "https://example.com/org/repo.git",
+					PushedBy:        "some-user",
+					PushedAt:        time.Date(2019, 2, 3, 4, 5, 6, 0, time.UTC),
+				},
+				{
+					AppName:      "docker-app",
+					GitCommit:    "some-commit",
+					GitRemoteURL: "https://example.com/org/repo.git",
+					PushedBy:     "some-user",
+					PushedAt:     time.Date(2019, 2, 3, 4, 6, 0, 0, time.UTC),
+				},
+			})
+
+			output, err := json.Marshal(statement)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(MatchJSON(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"subject": [
+					{"name": "droplet/app-1", "digest": {"sha256": "droplet-1-sha"}}
+				],
+				"predicateType": "https://slsa.dev/provenance/v0.2",
+				"predicate": {
+					"builder": {"id": "https://api.example.com"},
+					"buildType": "https://code.cloudfoundry.org/cli/push@v1",
+					"invocation": {"parameters": {"pushedBy": "some-user"}},
+					"metadata": {"buildFinishedOn": "2019-02-03T04:06:00Z"},
+					"materials": [
+						{"uri": "git+https://example.com/org/repo.git", "digest": {"sha1": "some-commit"}},
+						{"uri": "package/package-1-guid", "digest": {"sha256": "package-1-sha"}}
+					]
+				}
+			}`))
+		})
+	})
+})
@@ -0,0 +1,375 @@
+package v3action
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/types"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// QuotasManifest declares organization and space quotas, and where they are
+// applied.
+type QuotasManifest struct {
+	OrganizationQuotas []QuotaManifest `yaml:"organization_quotas"`
+	SpaceQuotas        []QuotaManifest `yaml:"space_quotas"`
+}
+
+// QuotaManifest declares the limits of a single quota. Limits that are
+// omitted are left unchanged; "unlimited" or -1 removes the limit.
+type QuotaManifest struct {
+	Name string `yaml:"name"`
+	// Org is the organization that owns a space quota.
+	Org string `yaml:"org"`
+
+	TotalMemory        string `yaml:"total_memory"`
+	InstanceMemory     string `yaml:"instance_memory"`
+	AppInstances       string `yaml:"app_instances"`
+	PerAppTasks        string `yaml:"per_app_tasks"`
+	LogRateLimit       string `yaml:"log_rate_limit"`
+	PaidServicePlans   *bool  `yaml:"paid_service_plans"`
+	ServiceInstances   string `yaml:"service_instances"`
+	Routes             string `yaml:"routes"`
+	ReservedRoutePorts string `yaml:"reserved_route_ports"`
+
+	// Orgs are the organizations an organization quota is applied to.
+	Orgs []string `yaml:"orgs"`
+	// Spaces are the spaces, in Org, a space quota is applied to.
+	Spaces []string `yaml:"spaces"`
+}
+
+// QuotaChangeType describes what applying a quotas manifest did to a quota.
+type QuotaChangeType string
+
+const (
+	QuotaCreated        QuotaChangeType = "created"
+	QuotaUpdated        QuotaChangeType = "updated"
+	QuotaUnchanged      QuotaChangeType = "unchanged"
+	QuotaAppliedToOrg   QuotaChangeType = "applied to org"
+	QuotaAppliedToSpace QuotaChangeType = "applied to space"
+)
+
+// QuotaChange records a single step taken while applying a quotas manifest.
+type QuotaChange struct {
+	QuotaName    string
+	IsSpaceQuota bool
+	// Org is the organization that owns a space quota.
+	Org  string
+	Type QuotaChangeType
+	// Target is the organization or space the quota was applied to.
+	Target string
+}
+
+// ApplyQuotasManifest creates or updates every quota declared in the raw
+// quotas manifest so its limits match the declared ones, then applies each
+// quota to its declared organizations or spaces. Quotas that are not declared
+// are left untouched. All limits are validated before any change is made.
+func (actor Actor) ApplyQuotasManifest(rawManifest []byte) ([]QuotaChange, Warnings, error) {
+	var manifest QuotasManifest
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orgQuotaLimits, err := quotaManifestLimits(manifest.OrganizationQuotas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spaceQuotaLimits, err := quotaManifestLimits(manifest.SpaceQuotas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, spaceQuota := range manifest.SpaceQuotas {
+		if spaceQuota.Org == "" {
+			return nil, nil, actionerror.InvalidQuotaLimitError{QuotaName: spaceQuota.Name, Limit: "org"}
+		}
+	}
+
+	var (
+		changes     []QuotaChange
+		allWarnings Warnings
+	)
+
+	if len(manifest.OrganizationQuotas) > 0 {
+		orgQuotaChanges, warnings, err := actor.applyOrganizationQuotasManifest(manifest.OrganizationQuotas, orgQuotaLimits)
+		changes = append(changes, orgQuotaChanges...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+	}
+
+	spaceQuotaChanges, warnings, err := actor.applySpaceQuotasManifest(manifest.SpaceQuotas, spaceQuotaLimits)
+	changes = append(changes, spaceQuotaChanges...)
+	allWarnings = append(allWarnings, warnings...)
+	return changes, allWarnings, err
+}
+
+func (actor Actor) applyOrganizationQuotasManifest(quotaManifests []QuotaManifest, limits []QuotaLimits) ([]QuotaChange, Warnings, error) {
+	existingQuotas, allWarnings, err := actor.GetOrganizationQuotas()
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	quotasByName := map[string]OrganizationQuota{}
+	for _, quota := range existingQuotas {
+		quotasByName[quota.Name] = quota
+	}
+
+	var changes []QuotaChange
+	for i, quotaManifest := range quotaManifests {
+		change := QuotaChange{QuotaName: quotaManifest.Name, Type: QuotaUnchanged}
+
+		quota, exists := quotasByName[quotaManifest.Name]
+		var warnings Warnings
+		switch {
+		case !exists:
+			change.Type = QuotaCreated
+			warnings, err = actor.CreateOrganizationQuota(quotaManifest.Name, limits[i])
+		case !quotaLimitsMatch(QuotaLimits(quota.QuotaLimits), limits[i]):
+			change.Type = QuotaUpdated
+			warnings, err = actor.UpdateOrganizationQuota(quotaManifest.Name, "", limits[i])
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+		changes = append(changes, change)
+
+		for _, orgName := range quotaManifest.Orgs {
+			org, warnings, err := actor.GetOrganizationByName(orgName)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+
+			if containsGUID(quota.OrganizationGUIDs, org.GUID) {
+				continue
+			}
+
+			warnings, err = actor.ApplyOrganizationQuotaByName(quotaManifest.Name, org.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+			changes = append(changes, QuotaChange{QuotaName: quotaManifest.Name, Type: QuotaAppliedToOrg, Target: orgName})
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) applySpaceQuotasManifest(quotaManifests []QuotaManifest, limits []QuotaLimits) ([]QuotaChange, Warnings, error) {
+	var (
+		changes     []QuotaChange
+		allWarnings Warnings
+	)
+
+	orgGUIDs := map[string]string{}
+	quotasByOrg := map[string]map[string]SpaceQuota{}
+	for i, quotaManifest := range quotaManifests {
+		orgGUID, ok := orgGUIDs[quotaManifest.Org]
+		if !ok {
+			org, warnings, err := actor.GetOrganizationByName(quotaManifest.Org)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+			orgGUID = org.GUID
+			orgGUIDs[quotaManifest.Org] = orgGUID
+
+			existingQuotas, warnings, err := actor.GetSpaceQuotasByOrganization(orgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+
+			quotasByOrg[orgGUID] = map[string]SpaceQuota{}
+			for _, quota := range existingQuotas {
+				quotasByOrg[orgGUID][quota.Name] = quota
+			}
+		}
+
+		change := QuotaChange{QuotaName: quotaManifest.Name, IsSpaceQuota: true, Org: quotaManifest.Org, Type: QuotaUnchanged}
+
+		quota, exists := quotasByOrg[orgGUID][quotaManifest.Name]
+		var (
+			warnings Warnings
+			err      error
+		)
+		switch {
+		case !exists:
+			change.Type = QuotaCreated
+			warnings, err = actor.CreateSpaceQuota(quotaManifest.Name, orgGUID, limits[i])
+		case !quotaLimitsMatch(QuotaLimits(quota.QuotaLimits), limits[i]):
+			change.Type = QuotaUpdated
+			warnings, err = actor.UpdateSpaceQuota(quotaManifest.Name, orgGUID, "", limits[i])
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return changes, allWarnings, err
+		}
+		changes = append(changes, change)
+
+		for _, spaceName := range quotaManifest.Spaces {
+			space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+
+			if containsGUID(quota.SpaceGUIDs, space.GUID) {
+				continue
+			}
+
+			warnings, err = actor.ApplySpaceQuotaByName(quotaManifest.Name, space.GUID, orgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return changes, allWarnings, err
+			}
+			changes = append(changes, QuotaChange{QuotaName: quotaManifest.Name, IsSpaceQuota: true, Org: quotaManifest.Org, Type: QuotaAppliedToSpace, Target: spaceName})
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func quotaManifestLimits(quotaManifests []QuotaManifest) ([]QuotaLimits, error) {
+	var allLimits []QuotaLimits
+	for _, quotaManifest := range quotaManifests {
+		var (
+			limits QuotaLimits
+			err    error
+		)
+
+		parsers := []struct {
+			name   string
+			value  string
+			parse  func(string) (*types.NullInt, error)
+			target **types.NullInt
+		}{
+			{"total_memory", quotaManifest.TotalMemory, parseMegabytesLimit, &limits.Apps.TotalMemory},
+			{"instance_memory", quotaManifest.InstanceMemory, parseMegabytesLimit, &limits.Apps.InstanceMemory},
+			{"app_instances", quotaManifest.AppInstances, parseCountLimit, &limits.Apps.TotalInstances},
+			{"per_app_tasks", quotaManifest.PerAppTasks, parseCountLimit, &limits.Apps.PerAppTasks},
+			{"log_rate_limit", quotaManifest.LogRateLimit, parseBytesLimit, &limits.Apps.LogRateLimit},
+			{"service_instances", quotaManifest.ServiceInstances, parseCountLimit, &limits.Services.TotalServiceInstances},
+			{"routes", quotaManifest.Routes, parseCountLimit, &limits.Routes.TotalRoutes},
+			{"reserved_route_ports", quotaManifest.ReservedRoutePorts, parseCountLimit, &limits.Routes.TotalReservedPorts},
+		}
+		for _, parser := range parsers {
+			if parser.value == "" {
+				continue
+			}
+
+			*parser.target, err = parser.parse(parser.value)
+			if err != nil {
+				return nil, actionerror.InvalidQuotaLimitError{
+					QuotaName: quotaManifest.Name,
+					Limit:     parser.name,
+					Value:     parser.value,
+				}
+			}
+		}
+		limits.Services.PaidServicePlans = quotaManifest.PaidServicePlans
+
+		allLimits = append(allLimits, limits)
+	}
+
+	return allLimits, nil
+}
+
+// quotaLimitsMatch returns true if every limit set in desired matches the
+// corresponding limit in current.
+func quotaLimitsMatch(current QuotaLimits, desired QuotaLimits) bool {
+	pairs := [][2]*types.NullInt{
+		{current.Apps.TotalMemory, desired.Apps.TotalMemory},
+		{current.Apps.InstanceMemory, desired.Apps.InstanceMemory},
+		{current.Apps.TotalInstances, desired.Apps.TotalInstances},
+		{current.Apps.PerAppTasks, desired.Apps.PerAppTasks},
+		{current.Apps.LogRateLimit, desired.Apps.LogRateLimit},
+		{current.Services.TotalServiceInstances, desired.Services.TotalServiceInstances},
+		{current.Routes.TotalRoutes, desired.Routes.TotalRoutes},
+		{current.Routes.TotalReservedPorts, desired.Routes.TotalReservedPorts},
+	}
+	for _, pair := range pairs {
+		currentLimit, desiredLimit := pair[0], pair[1]
+		if desiredLimit == nil {
+			continue
+		}
+		if currentLimit == nil {
+			currentLimit = &types.NullInt{}
+		}
+		if *currentLimit != *desiredLimit {
+			return false
+		}
+	}
+
+	if desired.Services.PaidServicePlans != nil {
+		currentPaidServicePlans := current.Services.PaidServicePlans != nil && *current.Services.PaidServicePlans
+		if currentPaidServicePlans != *desired.Services.PaidServicePlans {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUnlimited(value string) bool {
+	return value == "-1" || strings.ToLower(value) == "unlimited"
+}
+
+func parseCountLimit(value string) (*types.NullInt, error) {
+	if isUnlimited(value) {
+		return &types.NullInt{}, nil
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil || count < 0 {
+		return nil, actionerror.InvalidQuotaLimitError{Value: value}
+	}
+	return &types.NullInt{IsSet: true, Value: count}, nil
+}
+
+func parseMegabytesLimit(value string) (*types.NullInt, error) {
+	if isUnlimited(value) {
+		return &types.NullInt{}, nil
+	}
+	if value == "0" {
+		return &types.NullInt{IsSet: true}, nil
+	}
+
+	megabytes, err := bytefmt.ToMegabytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.NullInt{IsSet: true, Value: int(megabytes)}, nil
+}
+
+func parseBytesLimit(value string) (*types.NullInt, error) {
+	if isUnlimited(value) {
+		return &types.NullInt{}, nil
+	}
+	if value == "0" {
+		return &types.NullInt{IsSet: true}, nil
+	}
+
+	bytes, err := bytefmt.ToBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return &types.NullInt{IsSet: true, Value: int(bytes)}, nil
+}
+
+func containsGUID(guids []string, guid string) bool {
+	for _, candidate := range guids {
+		if candidate == guid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package v3action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Manifest Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+
+		rawManifest string
+
+		changes    []QuotaChange
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	JustBeforeEach(func() {
+		changes, warnings, executeErr = actor.ApplyQuotasManifest([]byte(rawManifest))
+	})
+
+	Describe("ApplyQuotasManifest", func() {
+		When("a limit cannot be parsed", func() {
+			BeforeEach(func() {
+				rawManifest = `
+organization_quotas:
+- name: small
+  total_memory: lots
+`
+			})
+
+			It("returns an InvalidQuotaLimitError without changing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidQuotaLimitError{
+					QuotaName: "small",
+					Limit:     "total_memory",
+					Value:     "lots",
+				}))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a space quota has no org", func() {
+			BeforeEach(func() {
+				rawManifest = `
+space_quotas:
+- name: dev
+`
+			})
+
+			It("returns an InvalidQuotaLimitError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidQuotaLimitError{QuotaName: "dev", Limit: "org"}))
+			})
+		})
+
+		When("an organization quota does not exist", func() {
+			BeforeEach(func() {
+				rawManifest = `
+organization_quotas:
+- name: small
+  total_memory: 10G
+  instance_memory: unlimited
+  app_instances: 25
+  per_app_tasks: 5
+  log_rate_limit: 1K
+  paid_service_plans: true
+  orgs:
+  - some-org
+`
+				fakeCloudControllerClient.GetOrganizationQuotasReturnsOnCall(0, nil, ccv3.Warnings{"list-warning"}, nil)
+				fakeCloudControllerClient.CreateOrganizationQuotaReturns(ccv3.OrganizationQuota{}, ccv3.Warnings{"create-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid", Name: "some-org"}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationQuotasReturnsOnCall(1, []ccv3.OrganizationQuota{{GUID: "small-guid", Name: "small"}}, nil, nil)
+				fakeCloudControllerClient.ApplyOrganizationQuotaReturns(ccv3.RelationshipList{}, ccv3.Warnings{"apply-warning"}, nil)
+			})
+
+			It("creates the quota with the declared limits and applies it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("list-warning", "create-warning", "apply-warning"))
+				Expect(changes).To(Equal([]QuotaChange{
+					{QuotaName: "small", Type: QuotaCreated},
+					{QuotaName: "small", Type: QuotaAppliedToOrg, Target: "some-org"},
+				}))
+
+				paidServicePlans := true
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0)).To(Equal(ccv3.OrganizationQuota{
+					Name: "small",
+					QuotaLimits: ccv3.QuotaLimits{
+						Apps: ccv3.AppLimits{
+							TotalMemory:    &types.NullInt{IsSet: true, Value: 10240},
+							InstanceMemory: &types.NullInt{},
+							TotalInstances: &types.NullInt{IsSet: true, Value: 25},
+							PerAppTasks:    &types.NullInt{IsSet: true, Value: 5},
+							LogRateLimit:   &types.NullInt{IsSet: true, Value: 1024},
+						},
+						Services: ccv3.ServiceLimits{
+							PaidServicePlans: &paidServicePlans,
+						},
+					},
+				}))
+
+				quotaGUID, orgGUID := fakeCloudControllerClient.ApplyOrganizationQuotaArgsForCall(0)
+				Expect(quotaGUID).To(Equal("small-guid"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("an organization quota exists and is applied", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns([]ccv3.OrganizationQuota{{
+					GUID: "small-guid",
+					Name: "small",
+					QuotaLimits: ccv3.QuotaLimits{
+						Routes: ccv3.RouteLimits{TotalRoutes: &types.NullInt{IsSet: true, Value: 10}},
+					},
+					OrganizationGUIDs: []string{"some-org-guid"},
+				}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid", Name: "some-org"}}, nil, nil)
+			})
+
+			When("its limits match", func() {
+				BeforeEach(func() {
+					rawManifest = `
+organization_quotas:
+- name: small
+  routes: 10
+  service_instances: unlimited
+  orgs: [some-org]
+`
+				})
+
+				It("leaves the quota unchanged", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(changes).To(Equal([]QuotaChange{{QuotaName: "small", Type: QuotaUnchanged}}))
+					Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.ApplyOrganizationQuotaCallCount()).To(Equal(0))
+				})
+			})
+
+			When("its limits differ", func() {
+				BeforeEach(func() {
+					rawManifest = `
+organization_quotas:
+- name: small
+  routes: 20
+`
+					fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv3.OrganizationQuota{}, ccv3.Warnings{"update-warning"}, nil)
+				})
+
+				It("updates only the declared limits", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("update-warning"))
+					Expect(changes).To(Equal([]QuotaChange{{QuotaName: "small", Type: QuotaUpdated}}))
+					Expect(fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)).To(Equal(ccv3.OrganizationQuota{
+						GUID: "small-guid",
+						QuotaLimits: ccv3.QuotaLimits{
+							Routes: ccv3.RouteLimits{TotalRoutes: &types.NullInt{IsSet: true, Value: 20}},
+						},
+					}))
+				})
+			})
+		})
+
+		When("a space quota does not exist", func() {
+			BeforeEach(func() {
+				rawManifest = `
+space_quotas:
+- name: dev
+  org: some-org
+  per_app_tasks: 2
+  spaces: [some-space]
+`
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid", Name: "some-org"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturnsOnCall(0, nil, nil, nil)
+				fakeCloudControllerClient.CreateSpaceQuotaReturns(ccv3.SpaceQuota{}, ccv3.Warnings{"create-warning"}, nil)
+				fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{GUID: "some-space-guid", Name: "some-space"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturnsOnCall(1, []ccv3.SpaceQuota{{GUID: "dev-guid", Name: "dev"}}, nil, nil)
+				fakeCloudControllerClient.ApplySpaceQuotaReturns(ccv3.RelationshipList{}, ccv3.Warnings{"apply-warning"}, nil)
+			})
+
+			It("creates the quota in the organization and applies it to the spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "apply-warning"))
+				Expect(changes).To(Equal([]QuotaChange{
+					{QuotaName: "dev", IsSpaceQuota: true, Org: "some-org", Type: QuotaCreated},
+					{QuotaName: "dev", IsSpaceQuota: true, Org: "some-org", Type: QuotaAppliedToSpace, Target: "some-space"},
+				}))
+
+				Expect(fakeCloudControllerClient.CreateSpaceQuotaArgsForCall(0)).To(Equal(ccv3.SpaceQuota{
+					Name:             "dev",
+					OrganizationGUID: "some-org-guid",
+					QuotaLimits: ccv3.QuotaLimits{
+						Apps: ccv3.AppLimits{PerAppTasks: &types.NullInt{IsSet: true, Value: 2}},
+					},
+				}))
+
+				quotaGUID, spaceGUID := fakeCloudControllerClient.ApplySpaceQuotaArgsForCall(0)
+				Expect(quotaGUID).To(Equal("dev-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+	})
+})
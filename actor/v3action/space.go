@@ -63,6 +63,25 @@ func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID strin
 	return actor.convertCCToActorSpace(spaces[0]), Warnings(warnings), nil
 }
 
+// GetOrganizationSpaces returns the spaces in the given organization that the
+// user can see, ordered by name.
+func (actor Actor) GetOrganizationSpaces(orgGUID string) ([]Space, Warnings, error) {
+	ccSpaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	)
+	if err != nil {
+		return []Space{}, Warnings(warnings), err
+	}
+
+	spaces := make([]Space, len(ccSpaces))
+	for i, ccSpace := range ccSpaces {
+		spaces[i] = actor.convertCCToActorSpace(ccSpace)
+	}
+
+	return spaces, Warnings(warnings), nil
+}
+
 func (actor Actor) GetSpacesByGUIDs(guids ...string) ([]Space, Warnings, error) {
 	currentV3Ver := actor.CloudControllerClient.CloudControllerAPIVersion()
 
@@ -0,0 +1,308 @@
+package v3action
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"gopkg.in/yaml.v2"
+)
+
+// UserProvidedServiceInstance is a user provided service instance together
+// with its credentials.
+type UserProvidedServiceInstance struct {
+	Name            string
+	Credentials     map[string]interface{}
+	RouteServiceURL string
+	SyslogDrainURL  string
+	Tags            []string
+}
+
+// AppMigration describes how an application is recreated from its manifest in
+// another space.
+type AppMigration struct {
+	// Name is the name of the application.
+	Name string
+	// Manifest is the application's manifest without the routes and service
+	// bindings that cannot be recreated.
+	Manifest []byte
+	// Routes are the routes whose domain exists.
+	Routes []string
+	// SkippedRoutes are the routes whose domain does not exist.
+	SkippedRoutes []string
+	// Services are the bound service instances that exist.
+	Services []string
+	// SkippedServices are the bound service instances that do not exist.
+	SkippedServices []string
+	// EnvironmentVariables is the number of user provided environment
+	// variables.
+	EnvironmentVariables int
+	// DockerImage is the image of a docker application.
+	DockerImage string
+}
+
+// PlanAppMigration plans recreating the single application described by
+// rawManifest in a space where only the given domains and service instances
+// exist. Routes on other domains and bindings to other service instances are
+// removed from the manifest.
+func PlanAppMigration(rawManifest []byte, domains []string, serviceInstances []string) (AppMigration, error) {
+	var manifest yaml.MapSlice
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return AppMigration{}, err
+	}
+
+	var migration AppMigration
+	for i, item := range manifest {
+		if item.Key != "applications" {
+			continue
+		}
+		apps, _ := item.Value.([]interface{})
+		for j, rawApp := range apps {
+			app, ok := rawApp.(yaml.MapSlice)
+			if !ok {
+				continue
+			}
+			apps[j] = migration.filterApp(app, domains, serviceInstances)
+		}
+		manifest[i].Value = apps
+	}
+
+	migration.Manifest, err = yaml.Marshal(manifest)
+	return migration, err
+}
+
+func (migration *AppMigration) filterApp(app yaml.MapSlice, domains []string, serviceInstances []string) yaml.MapSlice {
+	var filtered yaml.MapSlice
+	for _, item := range app {
+		switch item.Key {
+		case "name":
+			migration.Name, _ = item.Value.(string)
+		case "env":
+			env, _ := item.Value.(yaml.MapSlice)
+			migration.EnvironmentVariables = len(env)
+		case "docker":
+			docker, _ := item.Value.(yaml.MapSlice)
+			for _, setting := range docker {
+				if setting.Key == "image" {
+					migration.DockerImage, _ = setting.Value.(string)
+				}
+			}
+		case "routes":
+			routes, _ := item.Value.([]interface{})
+			var kept []interface{}
+			for _, rawRoute := range routes {
+				route := manifestEntryValue(rawRoute, "route")
+				if routeDomainExists(route, domains) {
+					migration.Routes = append(migration.Routes, route)
+					kept = append(kept, rawRoute)
+				} else {
+					migration.SkippedRoutes = append(migration.SkippedRoutes, route)
+				}
+			}
+			if len(kept) == 0 {
+				filtered = append(filtered, yaml.MapItem{Key: "no-route", Value: true})
+				continue
+			}
+			item.Value = kept
+		case "services":
+			services, _ := item.Value.([]interface{})
+			var kept []interface{}
+			for _, rawService := range services {
+				service := manifestEntryValue(rawService, "name")
+				if containsString(serviceInstances, service) {
+					migration.Services = append(migration.Services, service)
+					kept = append(kept, rawService)
+				} else {
+					migration.SkippedServices = append(migration.SkippedServices, service)
+				}
+			}
+			if len(kept) == 0 {
+				continue
+			}
+			item.Value = kept
+		}
+		filtered = append(filtered, item)
+	}
+	return filtered
+}
+
+// GetApplicationManifest returns the YAML manifest of the application.
+func (actor Actor) GetApplicationManifest(appGUID string) ([]byte, Warnings, error) {
+	rawManifest, warnings, err := actor.CloudControllerClient.GetApplicationManifest(appGUID)
+	return rawManifest, Warnings(warnings), err
+}
+
+// DownloadApplicationPackage writes the bits of the application's newest ready
+// bits package to path as a zip archive.
+func (actor Actor) DownloadApplicationPackage(appGUID string, path string) (Warnings, error) {
+	packages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.PackageReady)}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NewestFirstOrder}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, pkg := range packages {
+		if pkg.Type != constant.PackageTypeBits {
+			continue
+		}
+
+		bits, warnings, err := actor.CloudControllerClient.DownloadPackage(pkg.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		return allWarnings, ioutil.WriteFile(path, bits, DefaultArchiveFilePermissions)
+	}
+
+	return allWarnings, actionerror.PackageNotFoundError{}
+}
+
+// GetDomainNames returns the names of the domains visible to the current
+// user.
+func (actor Actor) GetDomainNames() ([]string, Warnings, error) {
+	domains, warnings, err := actor.CloudControllerClient.GetDomains()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.Name)
+	}
+	sort.Strings(names)
+	return names, Warnings(warnings), nil
+}
+
+// GetServiceInstanceNamesBySpace returns the names of the service instances
+// in the space.
+func (actor Actor) GetServiceInstanceNamesBySpace(spaceGUID string) ([]string, Warnings, error) {
+	instances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var names []string
+	for _, instance := range instances {
+		names = append(names, instance.Name)
+	}
+	sort.Strings(names)
+	return names, Warnings(warnings), nil
+}
+
+// GetUserProvidedServiceInstancesBySpace returns the user provided service
+// instances in the space, sorted by name, with their credentials.
+func (actor Actor) GetUserProvidedServiceInstancesBySpace(spaceGUID string) ([]UserProvidedServiceInstance, Warnings, error) {
+	ccInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(constant.UserProvidedServiceInstance)}},
+	)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var instances []UserProvidedServiceInstance
+	for _, ccInstance := range ccInstances {
+		credentials, warnings, err := actor.CloudControllerClient.GetServiceInstanceCredentials(ccInstance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		instances = append(instances, UserProvidedServiceInstance{
+			Name:            ccInstance.Name,
+			Credentials:     credentials,
+			RouteServiceURL: ccInstance.RouteServiceURL,
+			SyslogDrainURL:  ccInstance.SyslogDrainURL,
+			Tags:            ccInstance.Tags,
+		})
+	}
+
+	sort.Slice(instances, func(i int, j int) bool {
+		return instances[i].Name < instances[j].Name
+	})
+	return instances, allWarnings, nil
+}
+
+// CreateUserProvidedServiceInstance creates the user provided service
+// instance in the space.
+func (actor Actor) CreateUserProvidedServiceInstance(spaceGUID string, instance UserProvidedServiceInstance) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateServiceInstance(ccv3.ServiceInstance{
+		Name:            instance.Name,
+		Type:            constant.UserProvidedServiceInstance,
+		Credentials:     instance.Credentials,
+		RouteServiceURL: instance.RouteServiceURL,
+		SyslogDrainURL:  instance.SyslogDrainURL,
+		Tags:            instance.Tags,
+		Relationships: ccv3.Relationships{
+			constant.RelationshipTypeSpace: ccv3.Relationship{GUID: spaceGUID},
+		},
+	})
+	return Warnings(warnings), err
+}
+
+// ApplySpaceManifest applies the manifest to the space, creating or updating
+// the applications it describes, and waits for it to be applied.
+func (actor Actor) ApplySpaceManifest(spaceGUID string, rawManifest []byte) (Warnings, error) {
+	jobURL, warnings, err := actor.CloudControllerClient.UpdateSpaceApplyManifest(spaceGUID, rawManifest)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if jobErr, ok := err.(ccerror.V3JobFailedError); ok {
+			return allWarnings, actionerror.ApplicationManifestError{Message: jobErr.Detail}
+		}
+		return allWarnings, err
+	}
+	return allWarnings, nil
+}
+
+func manifestEntryValue(entry interface{}, key string) string {
+	switch value := entry.(type) {
+	case string:
+		return value
+	case yaml.MapSlice:
+		for _, item := range value {
+			if item.Key == key {
+				name, _ := item.Value.(string)
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func routeDomainExists(route string, domains []string) bool {
+	host := strings.SplitN(route, "/", 2)[0]
+	host = strings.SplitN(host, ":", 2)[0]
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,281 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Migration Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _ = NewTestActor()
+	})
+
+	Describe("PlanAppMigration", func() {
+		var (
+			rawManifest []byte
+			migration   AppMigration
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			rawManifest = []byte(`---
+applications:
+- name: web
+  instances: 2
+  env:
+    PORT: "8080"
+    MODE: production
+  routes:
+  - route: web.apps.old.com/api
+  - route: web.internal.old.com
+  - route: tcp.old.com:1234
+  services:
+  - my-db
+  - name: my-cache
+`)
+		})
+
+		JustBeforeEach(func() {
+			migration, executeErr = PlanAppMigration(rawManifest, []string{"apps.old.com", "tcp.old.com"}, []string{"my-db"})
+		})
+
+		It("keeps the routes and services that exist", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(migration.Name).To(Equal("web"))
+			Expect(migration.Routes).To(Equal([]string{"web.apps.old.com/api", "tcp.old.com:1234"}))
+			Expect(migration.SkippedRoutes).To(Equal([]string{"web.internal.old.com"}))
+			Expect(migration.Services).To(Equal([]string{"my-db"}))
+			Expect(migration.SkippedServices).To(Equal([]string{"my-cache"}))
+			Expect(migration.EnvironmentVariables).To(Equal(2))
+
+			Expect(string(migration.Manifest)).To(ContainSubstring("instances: 2"))
+			Expect(string(migration.Manifest)).To(ContainSubstring("web.apps.old.com/api"))
+			Expect(string(migration.Manifest)).ToNot(ContainSubstring("web.internal.old.com"))
+			Expect(string(migration.Manifest)).ToNot(ContainSubstring("my-cache"))
+		})
+
+		When("no route or service can be kept", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`---
+applications:
+- name: worker
+  docker:
+    image: some/image:latest
+  routes:
+  - route: worker.internal.old.com
+  services:
+  - my-cache
+`)
+			})
+
+			It("disables routing and drops the services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(migration.DockerImage).To(Equal("some/image:latest"))
+				Expect(string(migration.Manifest)).To(ContainSubstring("no-route: true"))
+				Expect(string(migration.Manifest)).ToNot(ContainSubstring("routes:"))
+				Expect(string(migration.Manifest)).ToNot(ContainSubstring("services:"))
+			})
+		})
+
+		When("the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("applications: [")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DownloadApplicationPackage", func() {
+		var (
+			dir        string
+			path       string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "space-migration")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(dir, "web.zip")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DownloadApplicationPackage("web-guid", path)
+		})
+
+		When("the application has a ready bits package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{GUID: "docker-package-guid", Type: constant.PackageTypeDocker},
+						{GUID: "bits-package-guid", Type: constant.PackageTypeBits},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DownloadPackageReturns([]byte("some-zip"), ccv3.Warnings{"download-warning"}, nil)
+			})
+
+			It("writes the newest bits package to path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning", "download-warning"))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"web-guid"}},
+					ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"READY"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{"-created_at"}},
+				))
+				Expect(fakeCloudControllerClient.DownloadPackageArgsForCall(0)).To(Equal("bits-package-guid"))
+
+				bits, err := ioutil.ReadFile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(bits)).To(Equal("some-zip"))
+			})
+		})
+
+		When("the application has no ready bits package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a PackageNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+	})
+
+	Describe("GetUserProvidedServiceInstancesBySpace", func() {
+		var (
+			instances  []UserProvidedServiceInstance
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv3.ServiceInstance{
+					{GUID: "syslog-guid", Name: "syslog", SyslogDrainURL: "syslog://logs.example.com"},
+					{GUID: "db-guid", Name: "db", Tags: []string{"postgres"}},
+				},
+				ccv3.Warnings{"get-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceCredentialsStub = func(guid string) (map[string]interface{}, ccv3.Warnings, error) {
+				return map[string]interface{}{"guid": guid}, ccv3.Warnings{"credentials-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			instances, warnings, executeErr = actor.GetUserProvidedServiceInstancesBySpace("some-space-guid")
+		})
+
+		It("returns the instances sorted by name with their credentials", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-instances-warning", "credentials-warning", "credentials-warning"))
+			Expect(instances).To(Equal([]UserProvidedServiceInstance{
+				{Name: "db", Credentials: map[string]interface{}{"guid": "db-guid"}, Tags: []string{"postgres"}},
+				{Name: "syslog", Credentials: map[string]interface{}{"guid": "syslog-guid"}, SyslogDrainURL: "syslog://logs.example.com"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"user-provided"}},
+			))
+		})
+
+		When("getting credentials fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceCredentialsReturns(nil, ccv3.Warnings{"credentials-warning"}, errors.New("credentials-error"))
+				fakeCloudControllerClient.GetServiceInstanceCredentialsStub = nil
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("credentials-error"))
+				Expect(warnings).To(ConsistOf("get-instances-warning", "credentials-warning"))
+			})
+		})
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		It("creates the instance in the space", func() {
+			fakeCloudControllerClient.CreateServiceInstanceReturns(ccv3.ServiceInstance{}, ccv3.Warnings{"create-warning"}, nil)
+
+			warnings, err := actor.CreateUserProvidedServiceInstance("some-space-guid", UserProvidedServiceInstance{
+				Name:        "db",
+				Credentials: map[string]interface{}{"user": "admin"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+			Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(ccv3.ServiceInstance{
+				Name:        "db",
+				Type:        constant.UserProvidedServiceInstance,
+				Credentials: map[string]interface{}{"user": "admin"},
+				Relationships: ccv3.Relationships{
+					constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid"},
+				},
+			}))
+		})
+	})
+
+	Describe("ApplySpaceManifest", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("some-job-url", ccv3.Warnings{"apply-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySpaceManifest("some-space-guid", []byte("some-manifest"))
+		})
+
+		It("applies the manifest and waits for the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+
+			spaceGUID, rawManifest := fakeCloudControllerClient.UpdateSpaceApplyManifestArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(rawManifest).To(Equal([]byte("some-manifest")))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+		})
+
+		When("the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, ccerror.V3JobFailedError{Detail: "route is taken"})
+			})
+
+			It("returns an ApplicationManifestError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationManifestError{Message: "route is taken"}))
+				Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+			})
+		})
+	})
+})
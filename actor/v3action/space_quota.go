@@ -0,0 +1,124 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// SpaceQuota represents a V3 actor space quota.
+type SpaceQuota ccv3.SpaceQuota
+
+// ApplySpaceQuotaByName applies the space quota with the given name, in the
+// given organization, to the space.
+func (actor Actor) ApplySpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (Warnings, error) {
+	quota, allWarnings, err := actor.GetSpaceQuotaByName(quotaName, orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.ApplySpaceQuota(quota.GUID, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// CreateSpaceQuota creates a space quota with the given name and limits in
+// the organization.
+func (actor Actor) CreateSpaceQuota(name string, orgGUID string, limits QuotaLimits) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateSpaceQuota(ccv3.SpaceQuota{
+		Name:             name,
+		QuotaLimits:      ccv3.QuotaLimits(limits),
+		OrganizationGUID: orgGUID,
+	})
+	return Warnings(warnings), err
+}
+
+// DeleteSpaceQuota deletes the space quota with the given name in the
+// organization and waits for the deletion to complete.
+func (actor Actor) DeleteSpaceQuota(name string, orgGUID string) (Warnings, error) {
+	quota, allWarnings, err := actor.GetSpaceQuotaByName(name, orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteSpaceQuota(quota.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// GetSpaceQuotaByName returns the space quota with the given name in the
+// organization.
+func (actor Actor) GetSpaceQuotaByName(name string, orgGUID string) (SpaceQuota, Warnings, error) {
+	quotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(
+		ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{name},
+		},
+		ccv3.Query{
+			Key:    ccv3.OrganizationGUIDFilter,
+			Values: []string{orgGUID},
+		},
+	)
+	if err != nil {
+		return SpaceQuota{}, Warnings(warnings), err
+	}
+
+	if len(quotas) == 0 {
+		return SpaceQuota{}, Warnings(warnings), actionerror.SpaceQuotaNotFoundByNameError{Name: name}
+	}
+
+	return SpaceQuota(quotas[0]), Warnings(warnings), nil
+}
+
+// GetSpaceQuotasByOrganization returns all space quotas in the organization.
+func (actor Actor) GetSpaceQuotasByOrganization(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	ccQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas(ccv3.Query{
+		Key:    ccv3.OrganizationGUIDFilter,
+		Values: []string{orgGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var quotas []SpaceQuota
+	for _, quota := range ccQuotas {
+		quotas = append(quotas, SpaceQuota(quota))
+	}
+
+	return quotas, Warnings(warnings), nil
+}
+
+// UnsetSpaceQuotaByName removes the space quota with the given name, in the
+// given organization, from the space.
+func (actor Actor) UnsetSpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (Warnings, error) {
+	quota, allWarnings, err := actor.GetSpaceQuotaByName(quotaName, orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.UnsetSpaceQuota(quota.GUID, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UpdateSpaceQuota updates the limits of the space quota with the given name
+// in the organization, and renames it when newName is provided.
+func (actor Actor) UpdateSpaceQuota(name string, orgGUID string, newName string, limits QuotaLimits) (Warnings, error) {
+	quota, allWarnings, err := actor.GetSpaceQuotaByName(name, orgGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateSpaceQuota(ccv3.SpaceQuota{
+		GUID:        quota.GUID,
+		Name:        newName,
+		QuotaLimits: ccv3.QuotaLimits(limits),
+	})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,113 @@
+package v3action_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Quota Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetSpaceQuotaByName", func() {
+		When("the quota exists in the organization", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv3.SpaceQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("filters by name and organization", func() {
+				quota, warnings, err := actor.GetSpaceQuotaByName("some-quota", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(quota.GUID).To(Equal("some-quota-guid"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-quota"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+			})
+		})
+
+		When("the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a SpaceQuotaNotFoundByNameError", func() {
+				_, warnings, err := actor.GetSpaceQuotaByName("some-quota", "some-org-guid")
+				Expect(err).To(MatchError(actionerror.SpaceQuotaNotFoundByNameError{Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		It("creates the quota in the organization", func() {
+			fakeCloudControllerClient.CreateSpaceQuotaReturns(ccv3.SpaceQuota{}, ccv3.Warnings{"create-warning"}, nil)
+
+			warnings, err := actor.CreateSpaceQuota("some-quota", "some-org-guid", QuotaLimits{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+
+			Expect(fakeCloudControllerClient.CreateSpaceQuotaArgsForCall(0)).To(Equal(ccv3.SpaceQuota{
+				Name:             "some-quota",
+				OrganizationGUID: "some-org-guid",
+			}))
+		})
+	})
+
+	Describe("UnsetSpaceQuotaByName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]ccv3.SpaceQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UnsetSpaceQuotaReturns(ccv3.Warnings{"unset-warning"}, nil)
+		})
+
+		It("removes the quota from the space", func() {
+			warnings, err := actor.UnsetSpaceQuotaByName("some-quota", "some-space-guid", "some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "unset-warning"))
+
+			quotaGUID, spaceGUID := fakeCloudControllerClient.UnsetSpaceQuotaArgsForCall(0)
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Describe("DeleteSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]ccv3.SpaceQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+				ccv3.Warnings{"get-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteSpaceQuotaReturns("some-job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		It("deletes the quota and waits for the job", func() {
+			warnings, err := actor.DeleteSpaceQuota("some-quota", "some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning", "delete-warning", "poll-warning"))
+			Expect(fakeCloudControllerClient.DeleteSpaceQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+		})
+	})
+})
@@ -222,6 +222,55 @@ var _ = Describe("Space", func() {
 
 	})
 
+	Describe("GetOrganizationSpaces", func() {
+		var (
+			spaces     []Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			spaces, warnings, executeErr = actor.GetOrganizationSpaces("some-org-guid")
+		})
+
+		When("the cloud controller returns spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{GUID: "space-guid-1", Name: "space-1"},
+						{GUID: "space-guid-2", Name: "space-2"},
+					},
+					ccv3.Warnings{"some-space-warning"}, nil)
+			})
+
+			It("returns the spaces in the org ordered by name and the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(spaces).To(Equal([]Space{
+					{GUID: "space-guid-1", Name: "space-1"},
+					{GUID: "space-guid-2", Name: "space-2"},
+				}))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-space-warning"}, errors.New("cannot get spaces"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("cannot get spaces"))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+			})
+		})
+	})
+
 	Describe("GetSpacesByGUIDs", func() {
 		Context("when the api returns a bogus semver", func() {
 			BeforeEach(func() {
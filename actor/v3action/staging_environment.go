@@ -0,0 +1,98 @@
+package v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// StagingEnvironmentSource identifies where a variable of the staging
+// environment is set.
+type StagingEnvironmentSource string
+
+const (
+	// StagingEnvironmentGroupSource is the staging environment variable group
+	// shared by all apps.
+	StagingEnvironmentGroupSource StagingEnvironmentSource = "staging group"
+	// StagingEnvironmentAppSource is the user provided environment of the app.
+	StagingEnvironmentAppSource StagingEnvironmentSource = "app"
+	// StagingEnvironmentLifecycleSource is the platform, which sets the
+	// variables describing the app and its lifecycle.
+	StagingEnvironmentLifecycleSource StagingEnvironmentSource = "lifecycle"
+)
+
+// StagingEnvironmentVariable is a variable of the environment an app is
+// staged with.
+type StagingEnvironmentVariable struct {
+	Name   string
+	Value  interface{}
+	Source StagingEnvironmentSource
+	// Overrides lists the sources that also set the variable and whose value
+	// is replaced by Value.
+	Overrides []StagingEnvironmentSource
+}
+
+// GetStagingEnvironmentByApplicationNameAndSpace resolves the environment
+// the next staging of the app will see. The variables of the app override
+// those of the staging group, and the lifecycle variables override both.
+// The variables are sorted by name.
+func (actor *Actor) GetStagingEnvironmentByApplicationNameAndSpace(appName string, spaceGUID string) ([]StagingEnvironmentVariable, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	envGroups, envWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+	warnings = append(warnings, envWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	resolved := map[string]*StagingEnvironmentVariable{}
+	set := func(name string, value interface{}, source StagingEnvironmentSource) {
+		variable, ok := resolved[name]
+		if !ok {
+			resolved[name] = &StagingEnvironmentVariable{Name: name, Value: value, Source: source}
+			return
+		}
+		variable.Overrides = append(variable.Overrides, variable.Source)
+		variable.Value = value
+		variable.Source = source
+	}
+
+	for name, value := range envGroups.Staging {
+		set(name, value, StagingEnvironmentGroupSource)
+	}
+	for name, value := range envGroups.EnvironmentVariables {
+		set(name, value, StagingEnvironmentAppSource)
+	}
+	for name, value := range lifecycleEnvironment(app, EnvironmentVariableGroups(envGroups)) {
+		set(name, value, StagingEnvironmentLifecycleSource)
+	}
+
+	variables := make([]StagingEnvironmentVariable, 0, len(resolved))
+	for _, variable := range resolved {
+		variables = append(variables, *variable)
+	}
+	sort.Slice(variables, func(i int, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	return variables, warnings, nil
+}
+
+// lifecycleEnvironment returns the variables the platform sets while staging
+// the app.
+func lifecycleEnvironment(app Application, envGroups EnvironmentVariableGroups) map[string]interface{} {
+	variables := map[string]interface{}{}
+	if value, ok := envGroups.Application["VCAP_APPLICATION"]; ok {
+		variables["VCAP_APPLICATION"] = value
+	}
+	if value, ok := envGroups.System["VCAP_SERVICES"]; ok {
+		variables["VCAP_SERVICES"] = value
+	}
+	if app.LifecycleType != constant.AppLifecycleTypeDocker && app.StackName != "" {
+		variables["CF_STACK"] = app.StackName
+	}
+	return variables
+}
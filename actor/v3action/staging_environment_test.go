@@ -0,0 +1,146 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Staging Environment Actions", func() {
+	Describe("GetStagingEnvironmentByApplicationNameAndSpace", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+			variables                 []StagingEnvironmentVariable
+			warnings                  Warnings
+			executeErr                error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			variables, warnings, executeErr = actor.GetStagingEnvironmentByApplicationNameAndSpace("some-app", "some-space-guid")
+		})
+
+		When("finding the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, errors.New("get-application-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-application-error"))
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+			})
+		})
+
+		When("the app exists", func() {
+			var app ccv3.Application
+
+			BeforeEach(func() {
+				app = ccv3.Application{
+					GUID:          "some-app-guid",
+					Name:          "some-app",
+					StackName:     "cflinuxfs3",
+					LifecycleType: constant.AppLifecycleTypeBuildpack,
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			})
+
+			When("getting the environment fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{app}, ccv3.Warnings{"get-application-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, errors.New("get-env-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("get-env-error"))
+					Expect(warnings).To(ConsistOf("get-application-warning", "get-env-warning"))
+				})
+			})
+
+			When("getting the environment succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{app}, ccv3.Warnings{"get-application-warning"}, nil)
+					fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+						ccv3.Environment{
+							Staging: map[string]interface{}{
+								"GROUP_ONLY": "group-value",
+								"SHADOWED":   "group-value",
+								"CF_STACK":   "group-stack",
+							},
+							Running: map[string]interface{}{
+								"RUNNING_ONLY": "running-value",
+							},
+							EnvironmentVariables: map[string]interface{}{
+								"APP_ONLY": "app-value",
+								"SHADOWED": "app-value",
+							},
+							Application: map[string]interface{}{
+								"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+							},
+							System: map[string]interface{}{
+								"VCAP_SERVICES": map[string]interface{}{},
+							},
+						},
+						ccv3.Warnings{"get-env-warning"},
+						nil,
+					)
+				})
+
+				It("resolves the staging environment in order of precedence", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-application-warning", "get-env-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(variables).To(Equal([]StagingEnvironmentVariable{
+						{Name: "APP_ONLY", Value: "app-value", Source: StagingEnvironmentAppSource},
+						{Name: "CF_STACK", Value: "cflinuxfs3", Source: StagingEnvironmentLifecycleSource, Overrides: []StagingEnvironmentSource{StagingEnvironmentGroupSource}},
+						{Name: "GROUP_ONLY", Value: "group-value", Source: StagingEnvironmentGroupSource},
+						{Name: "SHADOWED", Value: "app-value", Source: StagingEnvironmentAppSource, Overrides: []StagingEnvironmentSource{StagingEnvironmentGroupSource}},
+						{Name: "VCAP_APPLICATION", Value: map[string]interface{}{"application_name": "some-app"}, Source: StagingEnvironmentLifecycleSource},
+						{Name: "VCAP_SERVICES", Value: map[string]interface{}{}, Source: StagingEnvironmentLifecycleSource},
+					}))
+				})
+
+				When("the app is a docker app", func() {
+					BeforeEach(func() {
+						app.LifecycleType = constant.AppLifecycleTypeDocker
+						fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{app}, nil, nil)
+					})
+
+					It("does not set the stack", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(variables).To(ContainElement(StagingEnvironmentVariable{
+							Name:   "CF_STACK",
+							Value:  "group-stack",
+							Source: StagingEnvironmentGroupSource,
+						}))
+					})
+				})
+			})
+		})
+	})
+})
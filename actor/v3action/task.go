@@ -2,12 +2,14 @@ package v3action
 
 import (
 	"strconv"
+	"time"
 
 	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 // Task represents a V3 actor Task.
@@ -65,6 +67,26 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// PollTask waits for the task with the provided sequence ID to succeed or
+// fail, and returns it.
+func (actor Actor) PollTask(appGUID string, sequenceID int) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	for {
+		task, warnings, err := actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+
+		if task.State == constant.TaskSucceeded || task.State == constant.TaskFailed {
+			return task, allWarnings, nil
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return Task(task), Warnings(warnings), err
@@ -0,0 +1,170 @@
+package v3action
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/shellquote"
+)
+
+const (
+	// MaxTaskInputFileSize is the size in bytes of the largest file that can
+	// be uploaded to a task. The file is carried in the task command.
+	MaxTaskInputFileSize = 64 * 1024
+
+	// TaskInputDirectory is where the input file is written in the task
+	// container.
+	TaskInputDirectory = "/home/vcap/tmp/task-input"
+
+	// TaskInputFileVariable is the environment variable holding the path of
+	// the input file in the task container.
+	TaskInputFileVariable = "TASK_INPUT_FILE"
+)
+
+// TaskFiles describes the files transferred to and from a task. Task
+// containers cannot be reached over SSH, so the input file is carried in the
+// task command and the output file is written to the task's logs, between
+// two markers, once the command exits.
+type TaskFiles struct {
+	InputName    string
+	InputContent []byte
+	OutputPath   string
+
+	// Marker delimits the output file in the task's logs. It must not occur
+	// anywhere else in the task's output.
+	Marker string
+}
+
+// NewTaskFiles returns TaskFiles with a randomly generated marker. Either the
+// input or the output may be omitted by leaving its name or path empty.
+func NewTaskFiles(inputName string, inputContent []byte, outputPath string) (TaskFiles, error) {
+	nonce := make([]byte, 16)
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return TaskFiles{}, err
+	}
+
+	return TaskFiles{
+		InputName:    inputName,
+		InputContent: inputContent,
+		OutputPath:   outputPath,
+		Marker:       "cf-task-output-" + hex.EncodeToString(nonce),
+	}, nil
+}
+
+// InputPath returns where the input file is written in the task container.
+func (files TaskFiles) InputPath() string {
+	return path.Join(TaskInputDirectory, path.Base(files.InputName))
+}
+
+// WrapCommand returns the task command extended to create the input file
+// before command runs and to write the output file to the logs after it
+// exits. The exit status of command is preserved.
+func (files TaskFiles) WrapCommand(command string) (string, error) {
+	wrapped := "(\n" + command + "\n)"
+
+	if files.InputName != "" {
+		if len(files.InputContent) > MaxTaskInputFileSize {
+			return "", actionerror.TaskInputFileTooLargeError{
+				Path:    files.InputName,
+				Size:    len(files.InputContent),
+				MaxSize: MaxTaskInputFileSize,
+			}
+		}
+
+		wrapped = fmt.Sprintf("mkdir -p %s && echo %s | base64 -d > %s && export %s=%s && %s",
+			shellquote.Quote(TaskInputDirectory),
+			shellquote.Quote(base64.StdEncoding.EncodeToString(files.InputContent)),
+			shellquote.Quote(files.InputPath()),
+			TaskInputFileVariable,
+			shellquote.Quote(files.InputPath()),
+			wrapped,
+		)
+	}
+
+	if files.OutputPath != "" {
+		output := shellquote.Quote(files.OutputPath)
+		wrapped = fmt.Sprintf("%s; status=$?; if [ -f %s ]; then echo %s; base64 %s; echo %s; else echo %s; fi; exit $status",
+			wrapped,
+			output,
+			files.beginMarker(),
+			output,
+			files.endMarker(),
+			files.missingMarker(),
+		)
+	}
+
+	return wrapped, nil
+}
+
+// NewOutputFile returns a TaskOutputFile that reassembles the output file
+// from the task's logs.
+func (files TaskFiles) NewOutputFile() *TaskOutputFile {
+	return &TaskOutputFile{files: files}
+}
+
+func (files TaskFiles) beginMarker() string {
+	return files.Marker + "-begin"
+}
+
+func (files TaskFiles) endMarker() string {
+	return files.Marker + "-end"
+}
+
+func (files TaskFiles) missingMarker() string {
+	return files.Marker + "-missing"
+}
+
+// TaskOutputFile reassembles the output file a task writes to its logs.
+type TaskOutputFile struct {
+	files      TaskFiles
+	collecting bool
+	complete   bool
+	missing    bool
+	encoded    strings.Builder
+}
+
+// Consume processes a line of the task's logs. It returns true when the line
+// is part of the transferred output file rather than output of the task.
+func (file *TaskOutputFile) Consume(line string) bool {
+	line = strings.TrimSpace(line)
+
+	switch {
+	case file.complete:
+		return false
+	case line == file.files.beginMarker():
+		file.collecting = true
+	case line == file.files.endMarker():
+		file.collecting = false
+		file.complete = true
+	case line == file.files.missingMarker():
+		file.complete = true
+		file.missing = true
+	case file.collecting:
+		file.encoded.WriteString(line)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Complete returns whether the whole output file, or the notice that the
+// task did not create it, has been read from the logs.
+func (file *TaskOutputFile) Complete() bool {
+	return file.complete
+}
+
+// Content returns the output file once it is complete.
+func (file *TaskOutputFile) Content() ([]byte, error) {
+	if !file.complete || file.missing {
+		return nil, actionerror.TaskOutputFileNotFoundError{Path: file.files.OutputPath}
+	}
+
+	return base64.StdEncoding.DecodeString(file.encoded.String())
+}
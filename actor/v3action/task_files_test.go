@@ -0,0 +1,135 @@
+package v3action_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Files", func() {
+	Describe("NewTaskFiles", func() {
+		It("generates a different marker every time", func() {
+			files, err := NewTaskFiles("input.sql", []byte("select 1;"), "report.csv")
+			Expect(err).ToNot(HaveOccurred())
+			otherFiles, err := NewTaskFiles("input.sql", []byte("select 1;"), "report.csv")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(files.Marker).To(HavePrefix("cf-task-output-"))
+			Expect(files.Marker).ToNot(Equal(otherFiles.Marker))
+			Expect(files.InputPath()).To(Equal("/home/vcap/tmp/task-input/input.sql"))
+		})
+	})
+
+	Describe("WrapCommand", func() {
+		var files TaskFiles
+
+		BeforeEach(func() {
+			files = TaskFiles{Marker: "some-marker"}
+		})
+
+		When("an input file is provided", func() {
+			BeforeEach(func() {
+				files.InputName = "/local/dir/input.sql"
+				files.InputContent = []byte("select 'it''s';")
+			})
+
+			It("creates the input file and exports its path before running the command", func() {
+				wrapped, err := files.WrapCommand("bin/migrate")
+				Expect(err).ToNot(HaveOccurred())
+
+				encoded := base64.StdEncoding.EncodeToString([]byte("select 'it''s';"))
+				Expect(wrapped).To(Equal(
+					"mkdir -p '/home/vcap/tmp/task-input' && " +
+						"echo '" + encoded + "' | base64 -d > '/home/vcap/tmp/task-input/input.sql' && " +
+						"export TASK_INPUT_FILE='/home/vcap/tmp/task-input/input.sql' && " +
+						"(\nbin/migrate\n)",
+				))
+			})
+
+			When("the input file is too large", func() {
+				BeforeEach(func() {
+					files.InputContent = make([]byte, MaxTaskInputFileSize+1)
+				})
+
+				It("returns a TaskInputFileTooLargeError", func() {
+					_, err := files.WrapCommand("bin/migrate")
+					Expect(err).To(MatchError(actionerror.TaskInputFileTooLargeError{
+						Path:    "/local/dir/input.sql",
+						Size:    MaxTaskInputFileSize + 1,
+						MaxSize: MaxTaskInputFileSize,
+					}))
+				})
+			})
+		})
+
+		When("an output file is declared", func() {
+			BeforeEach(func() {
+				files.OutputPath = "/home/vcap/tmp/owner's report.csv"
+			})
+
+			It("writes the output file to the logs after the command exits and keeps its exit status", func() {
+				wrapped, err := files.WrapCommand("bin/report")
+				Expect(err).ToNot(HaveOccurred())
+
+				quoted := `'/home/vcap/tmp/owner'"'"'s report.csv'`
+				Expect(wrapped).To(Equal(
+					"(\nbin/report\n); status=$?; " +
+						"if [ -f " + quoted + " ]; then echo some-marker-begin; base64 " + quoted + "; echo some-marker-end; " +
+						"else echo some-marker-missing; fi; exit $status",
+				))
+			})
+		})
+	})
+
+	Describe("TaskOutputFile", func() {
+		var (
+			files  TaskFiles
+			output *TaskOutputFile
+		)
+
+		BeforeEach(func() {
+			files = TaskFiles{OutputPath: "report.csv", Marker: "some-marker"}
+			output = files.NewOutputFile()
+		})
+
+		It("reassembles the output file from the logs and ignores the task's own output", func() {
+			encoded := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("id,name\n1,some-name\n", 10)))
+
+			Expect(output.Consume("migrating...")).To(BeFalse())
+			Expect(output.Consume("some-marker-begin")).To(BeTrue())
+			Expect(output.Complete()).To(BeFalse())
+			Expect(output.Consume(encoded[:76] + "\n")).To(BeTrue())
+			Expect(output.Consume(encoded[76:])).To(BeTrue())
+			Expect(output.Consume("some-marker-end")).To(BeTrue())
+			Expect(output.Complete()).To(BeTrue())
+			Expect(output.Consume("some-marker-begin")).To(BeFalse())
+
+			content, err := output.Content()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal(strings.Repeat("id,name\n1,some-name\n", 10)))
+		})
+
+		When("the task did not create the output file", func() {
+			It("returns a TaskOutputFileNotFoundError", func() {
+				Expect(output.Consume("some-marker-missing")).To(BeTrue())
+				Expect(output.Complete()).To(BeTrue())
+
+				_, err := output.Content()
+				Expect(err).To(MatchError(actionerror.TaskOutputFileNotFoundError{Path: "report.csv"}))
+			})
+		})
+
+		When("the output file has not been read completely", func() {
+			It("returns a TaskOutputFileNotFoundError", func() {
+				output.Consume("some-marker-begin")
+
+				_, err := output.Content()
+				Expect(err).To(MatchError(actionerror.TaskOutputFileNotFoundError{Path: "report.csv"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package v3action
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+// TaskScheduleAnnotationPrefix prefixes the application annotations that
+// store task schedules, one annotation per schedule.
+const TaskScheduleAnnotationPrefix = "schedule.cli.cloudfoundry.org/"
+
+var taskScheduleNameRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+
+// TaskSchedule is a task that is run on an application whenever its cron
+// expression, evaluated in UTC, is due. Schedules are only run when
+// RunTaskSchedule is called, for example by a CI job running
+// `cf run-due-tasks`.
+type TaskSchedule struct {
+	Name    string
+	AppName string
+	AppGUID string
+
+	Cron    string
+	Command string
+
+	CreatedAt time.Time
+	LastRunAt time.Time
+}
+
+type taskScheduleAnnotation struct {
+	Cron      string    `json:"cron"`
+	Command   string    `json:"command"`
+	CreatedAt time.Time `json:"created_at"`
+	LastRunAt time.Time `json:"last_run_at"`
+}
+
+// NextRun returns the first time the schedule is due after it was created or
+// last run. It returns the zero time if the cron expression never matches.
+func (schedule TaskSchedule) NextRun() (time.Time, error) {
+	parsed, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	since := schedule.CreatedAt
+	if schedule.LastRunAt.After(since) {
+		since = schedule.LastRunAt
+	}
+	return parsed.Next(since.UTC()), nil
+}
+
+// Due returns whether the schedule has been due since it was created or last
+// run. Runs missed in between are not made up; a due schedule runs once.
+func (schedule TaskSchedule) Due(now time.Time) bool {
+	next, err := schedule.NextRun()
+	return err == nil && !next.IsZero() && !next.After(now)
+}
+
+// SetTaskSchedule creates or replaces the task schedule with the provided
+// name on the application. When no name is provided, one is generated from
+// the cron expression and the command.
+func (actor Actor) SetTaskSchedule(appName string, spaceGUID string, schedule TaskSchedule) (TaskSchedule, Warnings, error) {
+	if schedule.Name == "" {
+		digest := sha1.Sum([]byte(schedule.Cron + "\n" + schedule.Command))
+		schedule.Name = hex.EncodeToString(digest[:])[:8]
+	}
+	if !taskScheduleNameRegexp.MatchString(schedule.Name) {
+		return TaskSchedule{}, nil, actionerror.InvalidTaskScheduleNameError{Name: schedule.Name}
+	}
+
+	_, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return TaskSchedule{}, nil, err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return TaskSchedule{}, warnings, err
+	}
+
+	schedule.AppName = app.Name
+	schedule.AppGUID = app.GUID
+	schedule.CreatedAt = time.Now().UTC().Truncate(time.Second)
+	schedule.LastRunAt = time.Time{}
+
+	updateWarnings, err := actor.updateTaskSchedule(schedule)
+	return schedule, append(warnings, updateWarnings...), err
+}
+
+// GetTaskSchedulesByApplicationNameAndSpace returns the task schedules of
+// the application, sorted by name.
+func (actor Actor) GetTaskSchedulesByApplicationNameAndSpace(appName string, spaceGUID string) ([]TaskSchedule, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{appName}},
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	if len(apps) == 0 {
+		return nil, Warnings(warnings), actionerror.ApplicationNotFoundError{Name: appName}
+	}
+
+	return taskSchedulesFromApplications(apps), Warnings(warnings), nil
+}
+
+// GetTaskSchedulesBySpace returns the task schedules of every application in
+// the space, sorted by application name and schedule name.
+func (actor Actor) GetTaskSchedulesBySpace(spaceGUID string) ([]TaskSchedule, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	return taskSchedulesFromApplications(apps), Warnings(warnings), nil
+}
+
+// DeleteTaskSchedule removes the task schedule with the provided name from
+// the application.
+func (actor Actor) DeleteTaskSchedule(appName string, spaceGUID string, name string) (Warnings, error) {
+	schedules, warnings, err := actor.GetTaskSchedulesByApplicationNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Name != name {
+			continue
+		}
+
+		ccApp := ccv3.Application{GUID: schedule.AppGUID}
+		ccApp.Metadata.Annotations = map[string]types.NullString{
+			TaskScheduleAnnotationPrefix + name: {},
+		}
+		_, updateWarnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
+		return append(warnings, updateWarnings...), err
+	}
+
+	return warnings, actionerror.TaskScheduleNotFoundError{AppName: appName, Name: name}
+}
+
+// RunTaskSchedule runs the scheduled task and records when it ran, so that
+// it is not due again until the next time its cron expression matches.
+func (actor Actor) RunTaskSchedule(schedule TaskSchedule, now time.Time) (Task, Warnings, error) {
+	task, warnings, err := actor.RunTask(schedule.AppGUID, Task{
+		Name:    schedule.Name,
+		Command: schedule.Command,
+	})
+	if err != nil {
+		return Task{}, warnings, err
+	}
+
+	schedule.LastRunAt = now.UTC().Truncate(time.Second)
+	updateWarnings, err := actor.updateTaskSchedule(schedule)
+	return task, append(warnings, updateWarnings...), err
+}
+
+func (actor Actor) updateTaskSchedule(schedule TaskSchedule) (Warnings, error) {
+	value, err := json.Marshal(taskScheduleAnnotation{
+		Cron:      schedule.Cron,
+		Command:   schedule.Command,
+		CreatedAt: schedule.CreatedAt,
+		LastRunAt: schedule.LastRunAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ccApp := ccv3.Application{GUID: schedule.AppGUID}
+	ccApp.Metadata.Annotations = map[string]types.NullString{
+		TaskScheduleAnnotationPrefix + schedule.Name: types.NewNullString(string(value)),
+	}
+	_, warnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
+	return Warnings(warnings), err
+}
+
+// taskSchedulesFromApplications reads the task schedules from the
+// annotations of the applications. Annotations that do not hold a schedule
+// are ignored.
+func taskSchedulesFromApplications(apps []ccv3.Application) []TaskSchedule {
+	var schedules []TaskSchedule
+	for _, app := range apps {
+		for key, value := range app.Metadata.Annotations {
+			if !strings.HasPrefix(key, TaskScheduleAnnotationPrefix) || !value.IsSet {
+				continue
+			}
+
+			var annotation taskScheduleAnnotation
+			if json.Unmarshal([]byte(value.Value), &annotation) != nil {
+				continue
+			}
+
+			schedules = append(schedules, TaskSchedule{
+				Name:      strings.TrimPrefix(key, TaskScheduleAnnotationPrefix),
+				AppName:   app.Name,
+				AppGUID:   app.GUID,
+				Cron:      annotation.Cron,
+				Command:   annotation.Command,
+				CreatedAt: annotation.CreatedAt,
+				LastRunAt: annotation.LastRunAt,
+			})
+		}
+	}
+
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].AppName != schedules[j].AppName {
+			return schedules[i].AppName < schedules[j].AppName
+		}
+		return schedules[i].Name < schedules[j].Name
+	})
+
+	return schedules
+}
@@ -0,0 +1,335 @@
+package v3action_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Schedule Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	scheduleAnnotation := func(cronExpression string, command string, createdAt string, lastRunAt string) types.NullString {
+		value := map[string]string{"cron": cronExpression, "command": command, "created_at": createdAt, "last_run_at": lastRunAt}
+		raw, err := json.Marshal(value)
+		Expect(err).ToNot(HaveOccurred())
+		return types.NewNullString(string(raw))
+	}
+
+	updatedAnnotation := func(call int, key string) map[string]interface{} {
+		app := fakeCloudControllerClient.UpdateApplicationArgsForCall(call)
+		Expect(app.Metadata.Annotations).To(HaveLen(1))
+		Expect(app.Metadata.Annotations).To(HaveKey(key))
+
+		var value map[string]interface{}
+		Expect(json.Unmarshal([]byte(app.Metadata.Annotations[key].Value), &value)).To(Succeed())
+		return value
+	}
+
+	Describe("TaskSchedule", func() {
+		var schedule TaskSchedule
+
+		BeforeEach(func() {
+			schedule = TaskSchedule{
+				Cron:      "0 3 * * *",
+				CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+			}
+		})
+
+		It("is due once its cron expression has matched since it was created", func() {
+			Expect(schedule.NextRun()).To(Equal(time.Date(2019, 3, 5, 3, 0, 0, 0, time.UTC)))
+			Expect(schedule.Due(time.Date(2019, 3, 5, 2, 59, 0, 0, time.UTC))).To(BeFalse())
+			Expect(schedule.Due(time.Date(2019, 3, 5, 3, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(schedule.Due(time.Date(2019, 3, 9, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("is not due again until its cron expression matches after the last run", func() {
+			schedule.LastRunAt = time.Date(2019, 3, 5, 3, 2, 0, 0, time.UTC)
+
+			Expect(schedule.NextRun()).To(Equal(time.Date(2019, 3, 6, 3, 0, 0, 0, time.UTC)))
+			Expect(schedule.Due(time.Date(2019, 3, 5, 23, 0, 0, 0, time.UTC))).To(BeFalse())
+		})
+
+		It("is never due when the cron expression is invalid", func() {
+			schedule.Cron = "every day"
+
+			_, err := schedule.NextRun()
+			Expect(err).To(BeAssignableToTypeOf(cron.ParseError{}))
+			Expect(schedule.Due(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+		})
+	})
+
+	Describe("SetTaskSchedule", func() {
+		var (
+			schedule   TaskSchedule
+			result     TaskSchedule
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			schedule = TaskSchedule{Name: "cleanup", Cron: "0 3 * * *", Command: "rake cleanup"}
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-app-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			result, warnings, executeErr = actor.SetTaskSchedule("some-app", "some-space-guid", schedule)
+		})
+
+		It("stores the schedule in an annotation on the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "update-app-warning"))
+
+			Expect(result.Name).To(Equal("cleanup"))
+			Expect(result.AppName).To(Equal("some-app"))
+			Expect(result.AppGUID).To(Equal("some-app-guid"))
+			Expect(result.CreatedAt).To(BeTemporally("~", time.Now(), 2*time.Second))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0).GUID).To(Equal("some-app-guid"))
+			value := updatedAnnotation(0, "schedule.cli.cloudfoundry.org/cleanup")
+			Expect(value).To(HaveKeyWithValue("cron", "0 3 * * *"))
+			Expect(value).To(HaveKeyWithValue("command", "rake cleanup"))
+			Expect(value).To(HaveKeyWithValue("created_at", result.CreatedAt.Format(time.RFC3339)))
+		})
+
+		When("no name is provided", func() {
+			BeforeEach(func() {
+				schedule.Name = ""
+			})
+
+			It("generates a name from the cron expression and the command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(result.Name).To(MatchRegexp(`^[0-9a-f]{8}$`))
+
+				other, _, err := actor.SetTaskSchedule("some-app", "some-space-guid", schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(other.Name).To(Equal(result.Name))
+			})
+		})
+
+		When("the name cannot be used in an annotation", func() {
+			BeforeEach(func() {
+				schedule.Name = "clean up!"
+			})
+
+			It("returns an InvalidTaskScheduleNameError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidTaskScheduleNameError{Name: "clean up!"}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cron expression is invalid", func() {
+			BeforeEach(func() {
+				schedule.Cron = "0 25 * * *"
+			})
+
+			It("returns the parse error", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(cron.ParseError{}))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("GetTaskSchedulesBySpace", func() {
+		var (
+			schedules  []TaskSchedule
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			schedules, warnings, executeErr = actor.GetTaskSchedulesBySpace("some-space-guid")
+		})
+
+		When("the apps have schedules", func() {
+			BeforeEach(func() {
+				app1 := ccv3.Application{Name: "app-b", GUID: "app-b-guid"}
+				app1.Metadata.Annotations = map[string]types.NullString{
+					"schedule.cli.cloudfoundry.org/report": scheduleAnnotation("@daily", "bin/report", "2019-03-04T10:00:00Z", "2019-03-05T00:01:00Z"),
+					"schedule.cli.cloudfoundry.org/broken": types.NewNullString("not json"),
+					"some-other-annotation":                types.NewNullString("some-value"),
+				}
+				app2 := ccv3.Application{Name: "app-a", GUID: "app-a-guid"}
+				app2.Metadata.Annotations = map[string]types.NullString{
+					"schedule.cli.cloudfoundry.org/cleanup": scheduleAnnotation("0 3 * * *", "rake cleanup", "2019-03-04T10:00:00Z", "0001-01-01T00:00:00Z"),
+				}
+
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{app1, app2}, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns the schedules sorted by app name and schedule name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+
+				Expect(schedules).To(Equal([]TaskSchedule{
+					{
+						Name:      "cleanup",
+						AppName:   "app-a",
+						AppGUID:   "app-a-guid",
+						Cron:      "0 3 * * *",
+						Command:   "rake cleanup",
+						CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+					},
+					{
+						Name:      "report",
+						AppName:   "app-b",
+						AppGUID:   "app-b-guid",
+						Cron:      "@daily",
+						Command:   "bin/report",
+						CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+						LastRunAt: time.Date(2019, 3, 5, 0, 1, 0, 0, time.UTC),
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteTaskSchedule", func() {
+		var (
+			name       string
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			name = "cleanup"
+			app := ccv3.Application{Name: "some-app", GUID: "some-app-guid"}
+			app.Metadata.Annotations = map[string]types.NullString{
+				"schedule.cli.cloudfoundry.org/cleanup": scheduleAnnotation("0 3 * * *", "rake cleanup", "2019-03-04T10:00:00Z", "0001-01-01T00:00:00Z"),
+			}
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{app}, ccv3.Warnings{"get-app-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-app-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteTaskSchedule("some-app", "some-space-guid", name)
+		})
+
+		It("removes the schedule's annotation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "update-app-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			app := fakeCloudControllerClient.UpdateApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(app.Metadata.Annotations).To(Equal(map[string]types.NullString{
+				"schedule.cli.cloudfoundry.org/cleanup": {},
+			}))
+		})
+
+		When("the schedule does not exist", func() {
+			BeforeEach(func() {
+				name = "report"
+			})
+
+			It("returns a TaskScheduleNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskScheduleNotFoundError{AppName: "some-app", Name: "report"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RunTaskSchedule", func() {
+		var (
+			schedule   TaskSchedule
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			schedule = TaskSchedule{
+				Name:      "cleanup",
+				AppName:   "some-app",
+				AppGUID:   "some-app-guid",
+				Cron:      "0 3 * * *",
+				Command:   "rake cleanup",
+				CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+			}
+			fakeCloudControllerClient.CreateApplicationTaskReturns(ccv3.Task{Name: "cleanup", SequenceID: 7}, ccv3.Warnings{"run-task-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv3.Application{}, ccv3.Warnings{"update-app-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.RunTaskSchedule(schedule, time.Date(2019, 3, 5, 3, 1, 30, 0, time.UTC))
+		})
+
+		It("runs the task and records when it ran", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("run-task-warning", "update-app-warning"))
+			Expect(task).To(Equal(Task{Name: "cleanup", SequenceID: 7}))
+
+			Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(1))
+			appGUID, ccTask := fakeCloudControllerClient.CreateApplicationTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(ccTask).To(Equal(ccv3.Task{Name: "cleanup", Command: "rake cleanup"}))
+
+			value := updatedAnnotation(0, "schedule.cli.cloudfoundry.org/cleanup")
+			Expect(value).To(HaveKeyWithValue("command", "rake cleanup"))
+			Expect(value).To(HaveKeyWithValue("created_at", "2019-03-04T10:00:00Z"))
+			Expect(value).To(HaveKeyWithValue("last_run_at", "2019-03-05T03:01:30Z"))
+		})
+
+		When("running the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationTaskReturns(ccv3.Task{}, ccv3.Warnings{"run-task-warning"}, errors.New("run-task-error"))
+			})
+
+			It("does not record a run", func() {
+				Expect(executeErr).To(MatchError("run-task-error"))
+				Expect(warnings).To(ConsistOf("run-task-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
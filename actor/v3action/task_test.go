@@ -263,6 +263,54 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("PollTask", func() {
+		var (
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig := new(v3actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.PollTask("some-app-guid", 3)
+		})
+
+		When("the task finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0, []ccv3.Task{{SequenceID: 3, State: constant.TaskPending}}, ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1, []ccv3.Task{{SequenceID: 3, State: constant.TaskRunning}}, ccv3.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(2, []ccv3.Task{{SequenceID: 3, State: constant.TaskFailed}}, ccv3.Warnings{"warning-3"}, nil)
+			})
+
+			It("polls until the task has finished and returns it with all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task{SequenceID: 3, State: constant.TaskFailed}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(3))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.SequenceIDFilter, Values: []string{"3"}}))
+			})
+		})
+
+		When("getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("get-task-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-task-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		When("the task exists", func() {
 			var returnedTask ccv3.Task
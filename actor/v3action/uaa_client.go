@@ -1,11 +1,22 @@
 package v3action
 
-import "code.cloudfoundry.org/cli/api/uaa/constant"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
 
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) (string, string, error)
+	AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	LoginPrompts() map[string][]string
+	PollDeviceAuthorization(deviceAuthorization uaa.DeviceAuthorization, timeout time.Duration) (string, string, error)
+	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	RefreshLoginPrompts() error
+	StartDeviceAuthorization() (uaa.DeviceAuthorization, error)
+	VerifyAuthorizationCodeRedirect(redirectURI string) error
 }
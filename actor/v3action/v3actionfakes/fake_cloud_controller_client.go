@@ -30,6 +30,38 @@ type FakeCloudControllerClient struct {
 	appSSHHostKeyFingerprintReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ApplyOrganizationQuotaStub        func(string, string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	applyOrganizationQuotaMutex       sync.RWMutex
+	applyOrganizationQuotaArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	applyOrganizationQuotaReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	applyOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	ApplySpaceQuotaStub        func(string, string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	applySpaceQuotaMutex       sync.RWMutex
+	applySpaceQuotaArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	applySpaceQuotaReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	applySpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CancelDeploymentStub        func(string) (ccv3.Warnings, error)
 	cancelDeploymentMutex       sync.RWMutex
 	cancelDeploymentArgsForCall []struct {
@@ -146,6 +178,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateOrganizationQuotaStub        func(ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error)
+	createOrganizationQuotaMutex       sync.RWMutex
+	createOrganizationQuotaArgsForCall []struct {
+		arg1 ccv3.OrganizationQuota
+	}
+	createOrganizationQuotaReturns struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreatePackageStub        func(ccv3.Package) (ccv3.Package, ccv3.Warnings, error)
 	createPackageMutex       sync.RWMutex
 	createPackageArgsForCall []struct {
@@ -161,6 +208,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(ccv3.ServiceInstance) (ccv3.ServiceInstance, ccv3.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		arg1 ccv3.ServiceInstance
+	}
+	createServiceInstanceReturns struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateSpaceQuotaStub        func(ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error)
+	createSpaceQuotaMutex       sync.RWMutex
+	createSpaceQuotaArgsForCall []struct {
+		arg1 ccv3.SpaceQuota
+	}
+	createSpaceQuotaReturns struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteApplicationStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteApplicationMutex       sync.RWMutex
 	deleteApplicationArgsForCall []struct {
@@ -218,6 +295,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteOrganizationQuotaStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteOrganizationQuotaMutex       sync.RWMutex
+	deleteOrganizationQuotaArgsForCall []struct {
+		arg1 string
+	}
+	deleteOrganizationQuotaReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(string, string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -232,6 +324,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteSpaceQuotaStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteSpaceQuotaMutex       sync.RWMutex
+	deleteSpaceQuotaArgsForCall []struct {
+		arg1 string
+	}
+	deleteSpaceQuotaReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DownloadPackageStub        func(string) ([]byte, ccv3.Warnings, error)
+	downloadPackageMutex       sync.RWMutex
+	downloadPackageArgsForCall []struct {
+		arg1 string
+	}
+	downloadPackageReturns struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
+	downloadPackageReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(string, []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -278,6 +400,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationManifestStub        func(string) ([]byte, ccv3.Warnings, error)
+	getApplicationManifestMutex       sync.RWMutex
+	getApplicationManifestArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationManifestReturns struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationManifestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationProcessByTypeStub        func(string, string) (ccv3.Process, ccv3.Warnings, error)
 	getApplicationProcessByTypeMutex       sync.RWMutex
 	getApplicationProcessByTypeArgsForCall []struct {
@@ -385,6 +522,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDomainsStub        func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getDomainsReturns struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDomainsReturnsOnCall map[int]struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -491,6 +643,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -551,6 +718,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceInstanceCredentialsStub        func(string) (map[string]interface{}, ccv3.Warnings, error)
+	getServiceInstanceCredentialsMutex       sync.RWMutex
+	getServiceInstanceCredentialsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceCredentialsReturns struct {
+		result1 map[string]interface{}
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstanceCredentialsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -581,6 +763,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(...ccv3.Query) ([]ccv3.Space, ccv3.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -654,6 +851,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	UnsetSpaceQuotaStub        func(string, string) (ccv3.Warnings, error)
+	unsetSpaceQuotaMutex       sync.RWMutex
+	unsetSpaceQuotaArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	unsetSpaceQuotaReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unsetSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -685,6 +896,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateApplicationClearBuildpackCacheStub        func(string) (ccv3.Warnings, error)
+	updateApplicationClearBuildpackCacheMutex       sync.RWMutex
+	updateApplicationClearBuildpackCacheArgsForCall []struct {
+		arg1 string
+	}
+	updateApplicationClearBuildpackCacheReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	updateApplicationClearBuildpackCacheReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationEnvironmentVariablesStub        func(string, ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
 	updateApplicationEnvironmentVariablesMutex       sync.RWMutex
 	updateApplicationEnvironmentVariablesArgsForCall []struct {
@@ -746,6 +970,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateOrganizationStub        func(ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error)
+	updateOrganizationMutex       sync.RWMutex
+	updateOrganizationArgsForCall []struct {
+		arg1 ccv3.Organization
+	}
+	updateOrganizationReturns struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateOrganizationReturnsOnCall map[int]struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateOrganizationDefaultIsolationSegmentRelationshipStub        func(string, string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateOrganizationDefaultIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall []struct {
@@ -762,6 +1001,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateOrganizationQuotaStub        func(ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		arg1 ccv3.OrganizationQuota
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateProcessStub        func(ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	updateProcessMutex       sync.RWMutex
 	updateProcessArgsForCall []struct {
@@ -777,6 +1031,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSpaceStub        func(ccv3.Space) (ccv3.Space, ccv3.Warnings, error)
+	updateSpaceMutex       sync.RWMutex
+	updateSpaceArgsForCall []struct {
+		arg1 ccv3.Space
+	}
+	updateSpaceReturns struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceApplyManifestStub        func(string, []byte) (ccv3.JobURL, ccv3.Warnings, error)
+	updateSpaceApplyManifestMutex       sync.RWMutex
+	updateSpaceApplyManifestArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	updateSpaceApplyManifestReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceApplyManifestReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceIsolationSegmentRelationshipStub        func(string, string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateSpaceIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateSpaceIsolationSegmentRelationshipArgsForCall []struct {
@@ -793,6 +1078,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSpaceQuotaStub        func(ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error)
+	updateSpaceQuotaMutex       sync.RWMutex
+	updateSpaceQuotaArgsForCall []struct {
+		arg1 ccv3.SpaceQuota
+	}
+	updateSpaceQuotaReturns struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceQuotaReturnsOnCall map[int]struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskCancelStub        func(string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskCancelMutex       sync.RWMutex
 	updateTaskCancelArgsForCall []struct {
@@ -950,6 +1250,140 @@ func (fake *FakeCloudControllerClient) AppSSHHostKeyFingerprintReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuota(arg1 string, arg2 string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	fake.applyOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.applyOrganizationQuotaReturnsOnCall[len(fake.applyOrganizationQuotaArgsForCall)]
+	fake.applyOrganizationQuotaArgsForCall = append(fake.applyOrganizationQuotaArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ApplyOrganizationQuota", []interface{}{arg1, arg2})
+	fake.applyOrganizationQuotaMutex.Unlock()
+	if fake.ApplyOrganizationQuotaStub != nil {
+		return fake.ApplyOrganizationQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.applyOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuotaCallCount() int {
+	fake.applyOrganizationQuotaMutex.RLock()
+	defer fake.applyOrganizationQuotaMutex.RUnlock()
+	return len(fake.applyOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuotaCalls(stub func(string, string) (ccv3.RelationshipList, ccv3.Warnings, error)) {
+	fake.applyOrganizationQuotaMutex.Lock()
+	defer fake.applyOrganizationQuotaMutex.Unlock()
+	fake.ApplyOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.applyOrganizationQuotaMutex.RLock()
+	defer fake.applyOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.applyOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuotaReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.applyOrganizationQuotaMutex.Lock()
+	defer fake.applyOrganizationQuotaMutex.Unlock()
+	fake.ApplyOrganizationQuotaStub = nil
+	fake.applyOrganizationQuotaReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ApplyOrganizationQuotaReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.applyOrganizationQuotaMutex.Lock()
+	defer fake.applyOrganizationQuotaMutex.Unlock()
+	fake.ApplyOrganizationQuotaStub = nil
+	if fake.applyOrganizationQuotaReturnsOnCall == nil {
+		fake.applyOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.applyOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuota(arg1 string, arg2 string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	fake.applySpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.applySpaceQuotaReturnsOnCall[len(fake.applySpaceQuotaArgsForCall)]
+	fake.applySpaceQuotaArgsForCall = append(fake.applySpaceQuotaArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ApplySpaceQuota", []interface{}{arg1, arg2})
+	fake.applySpaceQuotaMutex.Unlock()
+	if fake.ApplySpaceQuotaStub != nil {
+		return fake.ApplySpaceQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.applySpaceQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuotaCallCount() int {
+	fake.applySpaceQuotaMutex.RLock()
+	defer fake.applySpaceQuotaMutex.RUnlock()
+	return len(fake.applySpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuotaCalls(stub func(string, string) (ccv3.RelationshipList, ccv3.Warnings, error)) {
+	fake.applySpaceQuotaMutex.Lock()
+	defer fake.applySpaceQuotaMutex.Unlock()
+	fake.ApplySpaceQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuotaArgsForCall(i int) (string, string) {
+	fake.applySpaceQuotaMutex.RLock()
+	defer fake.applySpaceQuotaMutex.RUnlock()
+	argsForCall := fake.applySpaceQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuotaReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.applySpaceQuotaMutex.Lock()
+	defer fake.applySpaceQuotaMutex.Unlock()
+	fake.ApplySpaceQuotaStub = nil
+	fake.applySpaceQuotaReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ApplySpaceQuotaReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.applySpaceQuotaMutex.Lock()
+	defer fake.applySpaceQuotaMutex.Unlock()
+	fake.ApplySpaceQuotaStub = nil
+	if fake.applySpaceQuotaReturnsOnCall == nil {
+		fake.applySpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.applySpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CancelDeployment(arg1 string) (ccv3.Warnings, error) {
 	fake.cancelDeploymentMutex.Lock()
 	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
@@ -1464,39 +1898,105 @@ func (fake *FakeCloudControllerClient) CreateIsolationSegmentReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreatePackage(arg1 ccv3.Package) (ccv3.Package, ccv3.Warnings, error) {
-	fake.createPackageMutex.Lock()
-	ret, specificReturn := fake.createPackageReturnsOnCall[len(fake.createPackageArgsForCall)]
-	fake.createPackageArgsForCall = append(fake.createPackageArgsForCall, struct {
-		arg1 ccv3.Package
+func (fake *FakeCloudControllerClient) CreateOrganizationQuota(arg1 ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.createOrganizationQuotaReturnsOnCall[len(fake.createOrganizationQuotaArgsForCall)]
+	fake.createOrganizationQuotaArgsForCall = append(fake.createOrganizationQuotaArgsForCall, struct {
+		arg1 ccv3.OrganizationQuota
 	}{arg1})
-	fake.recordInvocation("CreatePackage", []interface{}{arg1})
-	fake.createPackageMutex.Unlock()
-	if fake.CreatePackageStub != nil {
-		return fake.CreatePackageStub(arg1)
+	fake.recordInvocation("CreateOrganizationQuota", []interface{}{arg1})
+	fake.createOrganizationQuotaMutex.Unlock()
+	if fake.CreateOrganizationQuotaStub != nil {
+		return fake.CreateOrganizationQuotaStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.createPackageReturns
+	fakeReturns := fake.createOrganizationQuotaReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreatePackageCallCount() int {
-	fake.createPackageMutex.RLock()
-	defer fake.createPackageMutex.RUnlock()
-	return len(fake.createPackageArgsForCall)
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCallCount() int {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return len(fake.createOrganizationQuotaArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreatePackageCalls(stub func(ccv3.Package) (ccv3.Package, ccv3.Warnings, error)) {
-	fake.createPackageMutex.Lock()
-	defer fake.createPackageMutex.Unlock()
-	fake.CreatePackageStub = stub
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCalls(stub func(ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error)) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = stub
 }
 
-func (fake *FakeCloudControllerClient) CreatePackageArgsForCall(i int) ccv3.Package {
-	fake.createPackageMutex.RLock()
-	defer fake.createPackageMutex.RUnlock()
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaArgsForCall(i int) ccv3.OrganizationQuota {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.createOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturns(result1 ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = nil
+	fake.createOrganizationQuotaReturns = struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturnsOnCall(i int, result1 ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = nil
+	if fake.createOrganizationQuotaReturnsOnCall == nil {
+		fake.createOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.OrganizationQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreatePackage(arg1 ccv3.Package) (ccv3.Package, ccv3.Warnings, error) {
+	fake.createPackageMutex.Lock()
+	ret, specificReturn := fake.createPackageReturnsOnCall[len(fake.createPackageArgsForCall)]
+	fake.createPackageArgsForCall = append(fake.createPackageArgsForCall, struct {
+		arg1 ccv3.Package
+	}{arg1})
+	fake.recordInvocation("CreatePackage", []interface{}{arg1})
+	fake.createPackageMutex.Unlock()
+	if fake.CreatePackageStub != nil {
+		return fake.CreatePackageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createPackageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreatePackageCallCount() int {
+	fake.createPackageMutex.RLock()
+	defer fake.createPackageMutex.RUnlock()
+	return len(fake.createPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreatePackageCalls(stub func(ccv3.Package) (ccv3.Package, ccv3.Warnings, error)) {
+	fake.createPackageMutex.Lock()
+	defer fake.createPackageMutex.Unlock()
+	fake.CreatePackageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreatePackageArgsForCall(i int) ccv3.Package {
+	fake.createPackageMutex.RLock()
+	defer fake.createPackageMutex.RUnlock()
 	argsForCall := fake.createPackageArgsForCall[i]
 	return argsForCall.arg1
 }
@@ -1530,6 +2030,138 @@ func (fake *FakeCloudControllerClient) CreatePackageReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceInstance(arg1 ccv3.ServiceInstance) (ccv3.ServiceInstance, ccv3.Warnings, error) {
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		arg1 ccv3.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{arg1})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createServiceInstanceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceCalls(stub func(ccv3.ServiceInstance) (ccv3.ServiceInstance, ccv3.Warnings, error)) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceArgsForCall(i int) ccv3.ServiceInstance {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	argsForCall := fake.createServiceInstanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturns(result1 ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceInstanceReturnsOnCall(i int, result1 ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.createServiceInstanceMutex.Lock()
+	defer fake.createServiceInstanceMutex.Unlock()
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServiceInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv3.ServiceInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuota(arg1 ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error) {
+	fake.createSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaReturnsOnCall[len(fake.createSpaceQuotaArgsForCall)]
+	fake.createSpaceQuotaArgsForCall = append(fake.createSpaceQuotaArgsForCall, struct {
+		arg1 ccv3.SpaceQuota
+	}{arg1})
+	fake.recordInvocation("CreateSpaceQuota", []interface{}{arg1})
+	fake.createSpaceQuotaMutex.Unlock()
+	if fake.CreateSpaceQuotaStub != nil {
+		return fake.CreateSpaceQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createSpaceQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaCallCount() int {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return len(fake.createSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaCalls(stub func(ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error)) {
+	fake.createSpaceQuotaMutex.Lock()
+	defer fake.createSpaceQuotaMutex.Unlock()
+	fake.CreateSpaceQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaArgsForCall(i int) ccv3.SpaceQuota {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	argsForCall := fake.createSpaceQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturns(result1 ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.createSpaceQuotaMutex.Lock()
+	defer fake.createSpaceQuotaMutex.Unlock()
+	fake.CreateSpaceQuotaStub = nil
+	fake.createSpaceQuotaReturns = struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaReturnsOnCall(i int, result1 ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.createSpaceQuotaMutex.Lock()
+	defer fake.createSpaceQuotaMutex.Unlock()
+	fake.CreateSpaceQuotaStub = nil
+	if fake.createSpaceQuotaReturnsOnCall == nil {
+		fake.createSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.SpaceQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteApplication(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteApplicationMutex.Lock()
 	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
@@ -1788,6 +2420,72 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentOrganizationReturns
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuota(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationQuotaReturnsOnCall[len(fake.deleteOrganizationQuotaArgsForCall)]
+	fake.deleteOrganizationQuotaArgsForCall = append(fake.deleteOrganizationQuotaArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteOrganizationQuota", []interface{}{arg1})
+	fake.deleteOrganizationQuotaMutex.Unlock()
+	if fake.DeleteOrganizationQuotaStub != nil {
+		return fake.DeleteOrganizationQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuotaCallCount() int {
+	fake.deleteOrganizationQuotaMutex.RLock()
+	defer fake.deleteOrganizationQuotaMutex.RUnlock()
+	return len(fake.deleteOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuotaCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteOrganizationQuotaMutex.Lock()
+	defer fake.deleteOrganizationQuotaMutex.Unlock()
+	fake.DeleteOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuotaArgsForCall(i int) string {
+	fake.deleteOrganizationQuotaMutex.RLock()
+	defer fake.deleteOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.deleteOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuotaReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteOrganizationQuotaMutex.Lock()
+	defer fake.deleteOrganizationQuotaMutex.Unlock()
+	fake.DeleteOrganizationQuotaStub = nil
+	fake.deleteOrganizationQuotaReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationQuotaReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteOrganizationQuotaMutex.Lock()
+	defer fake.deleteOrganizationQuotaMutex.Unlock()
+	fake.DeleteOrganizationQuotaStub = nil
+	if fake.deleteOrganizationQuotaReturnsOnCall == nil {
+		fake.deleteOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -1852,6 +2550,138 @@ func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedS
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSpaceQuota(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceQuotaReturnsOnCall[len(fake.deleteSpaceQuotaArgsForCall)]
+	fake.deleteSpaceQuotaArgsForCall = append(fake.deleteSpaceQuotaArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteSpaceQuota", []interface{}{arg1})
+	fake.deleteSpaceQuotaMutex.Unlock()
+	if fake.DeleteSpaceQuotaStub != nil {
+		return fake.DeleteSpaceQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteSpaceQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceQuotaCallCount() int {
+	fake.deleteSpaceQuotaMutex.RLock()
+	defer fake.deleteSpaceQuotaMutex.RUnlock()
+	return len(fake.deleteSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceQuotaCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteSpaceQuotaMutex.Lock()
+	defer fake.deleteSpaceQuotaMutex.Unlock()
+	fake.DeleteSpaceQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceQuotaArgsForCall(i int) string {
+	fake.deleteSpaceQuotaMutex.RLock()
+	defer fake.deleteSpaceQuotaMutex.RUnlock()
+	argsForCall := fake.deleteSpaceQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceQuotaReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteSpaceQuotaMutex.Lock()
+	defer fake.deleteSpaceQuotaMutex.Unlock()
+	fake.DeleteSpaceQuotaStub = nil
+	fake.deleteSpaceQuotaReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceQuotaReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteSpaceQuotaMutex.Lock()
+	defer fake.deleteSpaceQuotaMutex.Unlock()
+	fake.DeleteSpaceQuotaStub = nil
+	if fake.deleteSpaceQuotaReturnsOnCall == nil {
+		fake.deleteSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackage(arg1 string) ([]byte, ccv3.Warnings, error) {
+	fake.downloadPackageMutex.Lock()
+	ret, specificReturn := fake.downloadPackageReturnsOnCall[len(fake.downloadPackageArgsForCall)]
+	fake.downloadPackageArgsForCall = append(fake.downloadPackageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DownloadPackage", []interface{}{arg1})
+	fake.downloadPackageMutex.Unlock()
+	if fake.DownloadPackageStub != nil {
+		return fake.DownloadPackageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.downloadPackageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageCallCount() int {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	return len(fake.downloadPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageCalls(stub func(string) ([]byte, ccv3.Warnings, error)) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageArgsForCall(i int) string {
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
+	argsForCall := fake.downloadPackageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturns(result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	fake.downloadPackageReturns = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DownloadPackageReturnsOnCall(i int, result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.downloadPackageMutex.Lock()
+	defer fake.downloadPackageMutex.Unlock()
+	fake.DownloadPackageStub = nil
+	if fake.downloadPackageReturnsOnCall == nil {
+		fake.downloadPackageReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.downloadPackageReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(arg1 string, arg2 []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -2056,6 +2886,72 @@ func (fake *FakeCloudControllerClient) GetApplicationEnvironmentReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationManifest(arg1 string) ([]byte, ccv3.Warnings, error) {
+	fake.getApplicationManifestMutex.Lock()
+	ret, specificReturn := fake.getApplicationManifestReturnsOnCall[len(fake.getApplicationManifestArgsForCall)]
+	fake.getApplicationManifestArgsForCall = append(fake.getApplicationManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationManifest", []interface{}{arg1})
+	fake.getApplicationManifestMutex.Unlock()
+	if fake.GetApplicationManifestStub != nil {
+		return fake.GetApplicationManifestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationManifestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationManifestCallCount() int {
+	fake.getApplicationManifestMutex.RLock()
+	defer fake.getApplicationManifestMutex.RUnlock()
+	return len(fake.getApplicationManifestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationManifestCalls(stub func(string) ([]byte, ccv3.Warnings, error)) {
+	fake.getApplicationManifestMutex.Lock()
+	defer fake.getApplicationManifestMutex.Unlock()
+	fake.GetApplicationManifestStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationManifestArgsForCall(i int) string {
+	fake.getApplicationManifestMutex.RLock()
+	defer fake.getApplicationManifestMutex.RUnlock()
+	argsForCall := fake.getApplicationManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationManifestReturns(result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationManifestMutex.Lock()
+	defer fake.getApplicationManifestMutex.Unlock()
+	fake.GetApplicationManifestStub = nil
+	fake.getApplicationManifestReturns = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationManifestReturnsOnCall(i int, result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationManifestMutex.Lock()
+	defer fake.getApplicationManifestMutex.Unlock()
+	fake.GetApplicationManifestStub = nil
+	if fake.getApplicationManifestReturnsOnCall == nil {
+		fake.getApplicationManifestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationManifestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationProcessByType(arg1 string, arg2 string) (ccv3.Process, ccv3.Warnings, error) {
 	fake.getApplicationProcessByTypeMutex.Lock()
 	ret, specificReturn := fake.getApplicationProcessByTypeReturnsOnCall[len(fake.getApplicationProcessByTypeArgsForCall)]
@@ -2520,6 +3416,72 @@ func (fake *FakeCloudControllerClient) GetDeploymentsReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDomains(arg1 ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error) {
+	fake.getDomainsMutex.Lock()
+	ret, specificReturn := fake.getDomainsReturnsOnCall[len(fake.getDomainsArgsForCall)]
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetDomains", []interface{}{arg1})
+	fake.getDomainsMutex.Unlock()
+	if fake.GetDomainsStub != nil {
+		return fake.GetDomainsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDomainsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCalls(stub func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsArgsForCall(i int) []ccv3.Query {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	argsForCall := fake.getDomainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturns(result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturnsOnCall(i int, result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	if fake.getDomainsReturnsOnCall == nil {
+		fake.getDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainsReturnsOnCall[i] = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(arg1 string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -2977,6 +3939,72 @@ func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(arg1 ...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{arg1})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCalls(stub func(...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error)) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv3.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	argsForCall := fake.getOrganizationQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.OrganizationQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -3223,19 +4251,85 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturns(result1 []ccv3
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, result1 []ccv3.ProcessInstance, result2 ccv3.Warnings, result3 error) {
-	fake.getProcessInstancesMutex.Lock()
-	defer fake.getProcessInstancesMutex.Unlock()
-	fake.GetProcessInstancesStub = nil
-	if fake.getProcessInstancesReturnsOnCall == nil {
-		fake.getProcessInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv3.ProcessInstance
+func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, result1 []ccv3.ProcessInstance, result2 ccv3.Warnings, result3 error) {
+	fake.getProcessInstancesMutex.Lock()
+	defer fake.getProcessInstancesMutex.Unlock()
+	fake.GetProcessInstancesStub = nil
+	if fake.getProcessInstancesReturnsOnCall == nil {
+		fake.getProcessInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ProcessInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesReturnsOnCall[i] = struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentials(arg1 string) (map[string]interface{}, ccv3.Warnings, error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceCredentialsReturnsOnCall[len(fake.getServiceInstanceCredentialsArgsForCall)]
+	fake.getServiceInstanceCredentialsArgsForCall = append(fake.getServiceInstanceCredentialsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceInstanceCredentials", []interface{}{arg1})
+	fake.getServiceInstanceCredentialsMutex.Unlock()
+	if fake.GetServiceInstanceCredentialsStub != nil {
+		return fake.GetServiceInstanceCredentialsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstanceCredentialsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCallCount() int {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	return len(fake.getServiceInstanceCredentialsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCalls(stub func(string) (map[string]interface{}, ccv3.Warnings, error)) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsArgsForCall(i int) string {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturns(result1 map[string]interface{}, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	fake.getServiceInstanceCredentialsReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	if fake.getServiceInstanceCredentialsReturnsOnCall == nil {
+		fake.getServiceInstanceCredentialsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getProcessInstancesReturnsOnCall[i] = struct {
-		result1 []ccv3.ProcessInstance
+	fake.getServiceInstanceCredentialsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -3373,6 +4467,72 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(arg1 ...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{arg1})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCalls(stub func(...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error)) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) []ccv3.Query {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	argsForCall := fake.getSpaceQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.SpaceQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv3.Query) ([]ccv3.Space, ccv3.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -3704,6 +4864,70 @@ func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv3
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UnsetSpaceQuota(arg1 string, arg2 string) (ccv3.Warnings, error) {
+	fake.unsetSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.unsetSpaceQuotaReturnsOnCall[len(fake.unsetSpaceQuotaArgsForCall)]
+	fake.unsetSpaceQuotaArgsForCall = append(fake.unsetSpaceQuotaArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("UnsetSpaceQuota", []interface{}{arg1, arg2})
+	fake.unsetSpaceQuotaMutex.Unlock()
+	if fake.UnsetSpaceQuotaStub != nil {
+		return fake.UnsetSpaceQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unsetSpaceQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnsetSpaceQuotaCallCount() int {
+	fake.unsetSpaceQuotaMutex.RLock()
+	defer fake.unsetSpaceQuotaMutex.RUnlock()
+	return len(fake.unsetSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnsetSpaceQuotaCalls(stub func(string, string) (ccv3.Warnings, error)) {
+	fake.unsetSpaceQuotaMutex.Lock()
+	defer fake.unsetSpaceQuotaMutex.Unlock()
+	fake.UnsetSpaceQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UnsetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.unsetSpaceQuotaMutex.RLock()
+	defer fake.unsetSpaceQuotaMutex.RUnlock()
+	argsForCall := fake.unsetSpaceQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UnsetSpaceQuotaReturns(result1 ccv3.Warnings, result2 error) {
+	fake.unsetSpaceQuotaMutex.Lock()
+	defer fake.unsetSpaceQuotaMutex.Unlock()
+	fake.UnsetSpaceQuotaStub = nil
+	fake.unsetSpaceQuotaReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnsetSpaceQuotaReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.unsetSpaceQuotaMutex.Lock()
+	defer fake.unsetSpaceQuotaMutex.Unlock()
+	fake.UnsetSpaceQuotaStub = nil
+	if fake.unsetSpaceQuotaReturnsOnCall == nil {
+		fake.unsetSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unsetSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplication(arg1 ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -3842,6 +5066,69 @@ func (fake *FakeCloudControllerClient) UpdateApplicationApplyManifestReturnsOnCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCache(arg1 string) (ccv3.Warnings, error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	ret, specificReturn := fake.updateApplicationClearBuildpackCacheReturnsOnCall[len(fake.updateApplicationClearBuildpackCacheArgsForCall)]
+	fake.updateApplicationClearBuildpackCacheArgsForCall = append(fake.updateApplicationClearBuildpackCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UpdateApplicationClearBuildpackCache", []interface{}{arg1})
+	fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	if fake.UpdateApplicationClearBuildpackCacheStub != nil {
+		return fake.UpdateApplicationClearBuildpackCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateApplicationClearBuildpackCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheCallCount() int {
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
+	return len(fake.updateApplicationClearBuildpackCacheArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheArgsForCall(i int) string {
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
+	argsForCall := fake.updateApplicationClearBuildpackCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheReturns(result1 ccv3.Warnings, result2 error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = nil
+	fake.updateApplicationClearBuildpackCacheReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = nil
+	if fake.updateApplicationClearBuildpackCacheReturnsOnCall == nil {
+		fake.updateApplicationClearBuildpackCacheReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationClearBuildpackCacheReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariables(arg1 string, arg2 ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
 	fake.updateApplicationEnvironmentVariablesMutex.Lock()
 	ret, specificReturn := fake.updateApplicationEnvironmentVariablesReturnsOnCall[len(fake.updateApplicationEnvironmentVariablesArgsForCall)]
@@ -4107,6 +5394,72 @@ func (fake *FakeCloudControllerClient) UpdateApplicationStopReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganization(arg1 ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error) {
+	fake.updateOrganizationMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationReturnsOnCall[len(fake.updateOrganizationArgsForCall)]
+	fake.updateOrganizationArgsForCall = append(fake.updateOrganizationArgsForCall, struct {
+		arg1 ccv3.Organization
+	}{arg1})
+	fake.recordInvocation("UpdateOrganization", []interface{}{arg1})
+	fake.updateOrganizationMutex.Unlock()
+	if fake.UpdateOrganizationStub != nil {
+		return fake.UpdateOrganizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationCallCount() int {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	return len(fake.updateOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationCalls(stub func(ccv3.Organization) (ccv3.Organization, ccv3.Warnings, error)) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationArgsForCall(i int) ccv3.Organization {
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
+	argsForCall := fake.updateOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturns(result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = nil
+	fake.updateOrganizationReturns = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationReturnsOnCall(i int, result1 ccv3.Organization, result2 ccv3.Warnings, result3 error) {
+	fake.updateOrganizationMutex.Lock()
+	defer fake.updateOrganizationMutex.Unlock()
+	fake.UpdateOrganizationStub = nil
+	if fake.updateOrganizationReturnsOnCall == nil {
+		fake.updateOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Organization
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationReturnsOnCall[i] = struct {
+		result1 ccv3.Organization
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegmentRelationship(arg1 string, arg2 string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationDefaultIsolationSegmentRelationshipReturnsOnCall[len(fake.updateOrganizationDefaultIsolationSegmentRelationshipArgsForCall)]
@@ -4174,6 +5527,72 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegment
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(arg1 ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		arg1 ccv3.OrganizationQuota
+	}{arg1})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{arg1})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCalls(stub func(ccv3.OrganizationQuota) (ccv3.OrganizationQuota, ccv3.Warnings, error)) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) ccv3.OrganizationQuota {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.updateOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.OrganizationQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateProcess(arg1 ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.updateProcessMutex.Lock()
 	ret, specificReturn := fake.updateProcessReturnsOnCall[len(fake.updateProcessArgsForCall)]
@@ -4240,6 +5659,144 @@ func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpace(arg1 ccv3.Space) (ccv3.Space, ccv3.Warnings, error) {
+	fake.updateSpaceMutex.Lock()
+	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
+	fake.updateSpaceArgsForCall = append(fake.updateSpaceArgsForCall, struct {
+		arg1 ccv3.Space
+	}{arg1})
+	fake.recordInvocation("UpdateSpace", []interface{}{arg1})
+	fake.updateSpaceMutex.Unlock()
+	if fake.UpdateSpaceStub != nil {
+		return fake.UpdateSpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceCallCount() int {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	return len(fake.updateSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceCalls(stub func(ccv3.Space) (ccv3.Space, ccv3.Warnings, error)) {
+	fake.updateSpaceMutex.Lock()
+	defer fake.updateSpaceMutex.Unlock()
+	fake.UpdateSpaceStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceArgsForCall(i int) ccv3.Space {
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	argsForCall := fake.updateSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturns(result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceMutex.Lock()
+	defer fake.updateSpaceMutex.Unlock()
+	fake.UpdateSpaceStub = nil
+	fake.updateSpaceReturns = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceReturnsOnCall(i int, result1 ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceMutex.Lock()
+	defer fake.updateSpaceMutex.Unlock()
+	fake.UpdateSpaceStub = nil
+	if fake.updateSpaceReturnsOnCall == nil {
+		fake.updateSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifest(arg1 string, arg2 []byte) (ccv3.JobURL, ccv3.Warnings, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.updateSpaceApplyManifestMutex.Lock()
+	ret, specificReturn := fake.updateSpaceApplyManifestReturnsOnCall[len(fake.updateSpaceApplyManifestArgsForCall)]
+	fake.updateSpaceApplyManifestArgsForCall = append(fake.updateSpaceApplyManifestArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UpdateSpaceApplyManifest", []interface{}{arg1, arg2Copy})
+	fake.updateSpaceApplyManifestMutex.Unlock()
+	if fake.UpdateSpaceApplyManifestStub != nil {
+		return fake.UpdateSpaceApplyManifestStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateSpaceApplyManifestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestCallCount() int {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return len(fake.updateSpaceApplyManifestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestCalls(stub func(string, []byte) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.updateSpaceApplyManifestMutex.Lock()
+	defer fake.updateSpaceApplyManifestMutex.Unlock()
+	fake.UpdateSpaceApplyManifestStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestArgsForCall(i int) (string, []byte) {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	argsForCall := fake.updateSpaceApplyManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceApplyManifestMutex.Lock()
+	defer fake.updateSpaceApplyManifestMutex.Unlock()
+	fake.UpdateSpaceApplyManifestStub = nil
+	fake.updateSpaceApplyManifestReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceApplyManifestMutex.Lock()
+	defer fake.updateSpaceApplyManifestMutex.Unlock()
+	fake.UpdateSpaceApplyManifestStub = nil
+	if fake.updateSpaceApplyManifestReturnsOnCall == nil {
+		fake.updateSpaceApplyManifestReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceApplyManifestReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpaceIsolationSegmentRelationship(arg1 string, arg2 string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateSpaceIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateSpaceIsolationSegmentRelationshipReturnsOnCall[len(fake.updateSpaceIsolationSegmentRelationshipArgsForCall)]
@@ -4307,6 +5864,72 @@ func (fake *FakeCloudControllerClient) UpdateSpaceIsolationSegmentRelationshipRe
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceQuota(arg1 ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaReturnsOnCall[len(fake.updateSpaceQuotaArgsForCall)]
+	fake.updateSpaceQuotaArgsForCall = append(fake.updateSpaceQuotaArgsForCall, struct {
+		arg1 ccv3.SpaceQuota
+	}{arg1})
+	fake.recordInvocation("UpdateSpaceQuota", []interface{}{arg1})
+	fake.updateSpaceQuotaMutex.Unlock()
+	if fake.UpdateSpaceQuotaStub != nil {
+		return fake.UpdateSpaceQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateSpaceQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaCallCount() int {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return len(fake.updateSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaCalls(stub func(ccv3.SpaceQuota) (ccv3.SpaceQuota, ccv3.Warnings, error)) {
+	fake.updateSpaceQuotaMutex.Lock()
+	defer fake.updateSpaceQuotaMutex.Unlock()
+	fake.UpdateSpaceQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaArgsForCall(i int) ccv3.SpaceQuota {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	argsForCall := fake.updateSpaceQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturns(result1 ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	defer fake.updateSpaceQuotaMutex.Unlock()
+	fake.UpdateSpaceQuotaStub = nil
+	fake.updateSpaceQuotaReturns = struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaReturnsOnCall(i int, result1 ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	defer fake.updateSpaceQuotaMutex.Unlock()
+	fake.UpdateSpaceQuotaStub = nil
+	if fake.updateSpaceQuotaReturnsOnCall == nil {
+		fake.updateSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv3.SpaceQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceQuotaReturnsOnCall[i] = struct {
+		result1 ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTaskCancel(arg1 string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskCancelMutex.Lock()
 	ret, specificReturn := fake.updateTaskCancelReturnsOnCall[len(fake.updateTaskCancelArgsForCall)]
@@ -4521,6 +6144,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.appSSHEndpointMutex.RUnlock()
 	fake.appSSHHostKeyFingerprintMutex.RLock()
 	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
+	fake.applyOrganizationQuotaMutex.RLock()
+	defer fake.applyOrganizationQuotaMutex.RUnlock()
+	fake.applySpaceQuotaMutex.RLock()
+	defer fake.applySpaceQuotaMutex.RUnlock()
 	fake.cancelDeploymentMutex.RLock()
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
@@ -4537,8 +6164,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createBuildMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
 	fake.createPackageMutex.RLock()
 	defer fake.createPackageMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
 	fake.deleteApplicationMutex.RLock()
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
@@ -4547,14 +6180,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
 	defer fake.deleteIsolationSegmentOrganizationMutex.RUnlock()
+	fake.deleteOrganizationQuotaMutex.RLock()
+	defer fake.deleteOrganizationQuotaMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
+	fake.deleteSpaceQuotaMutex.RLock()
+	defer fake.deleteSpaceQuotaMutex.RUnlock()
+	fake.downloadPackageMutex.RLock()
+	defer fake.downloadPackageMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
 	defer fake.getApplicationDropletCurrentMutex.RUnlock()
 	fake.getApplicationEnvironmentMutex.RLock()
 	defer fake.getApplicationEnvironmentMutex.RUnlock()
+	fake.getApplicationManifestMutex.RLock()
+	defer fake.getApplicationManifestMutex.RUnlock()
 	fake.getApplicationProcessByTypeMutex.RLock()
 	defer fake.getApplicationProcessByTypeMutex.RUnlock()
 	fake.getApplicationProcessesMutex.RLock()
@@ -4569,6 +6210,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getDeploymentMutex.RUnlock()
 	fake.getDeploymentsMutex.RLock()
 	defer fake.getDeploymentsMutex.RUnlock()
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
@@ -4583,6 +6226,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getIsolationSegmentsMutex.RUnlock()
 	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
@@ -4591,10 +6236,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackagesMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -4605,10 +6254,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
+	fake.unsetSpaceQuotaMutex.RLock()
+	defer fake.unsetSpaceQuotaMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationApplyManifestMutex.RLock()
 	defer fake.updateApplicationApplyManifestMutex.RUnlock()
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
 	fake.updateApplicationEnvironmentVariablesMutex.RLock()
 	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
 	fake.updateApplicationRestartMutex.RLock()
@@ -4617,12 +6270,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStartMutex.RUnlock()
 	fake.updateApplicationStopMutex.RLock()
 	defer fake.updateApplicationStopMutex.RUnlock()
+	fake.updateOrganizationMutex.RLock()
+	defer fake.updateOrganizationMutex.RUnlock()
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
 	fake.updateProcessMutex.RLock()
 	defer fake.updateProcessMutex.RUnlock()
+	fake.updateSpaceMutex.RLock()
+	defer fake.updateSpaceMutex.RUnlock()
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
 	fake.updateSpaceIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateSpaceIsolationSegmentRelationshipMutex.RUnlock()
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
 	fake.updateTaskCancelMutex.RLock()
 	defer fake.updateTaskCancelMutex.RUnlock()
 	fake.uploadBitsPackageMutex.RLock()
@@ -3,8 +3,10 @@ package v3actionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
@@ -26,6 +28,21 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthorizationCodeURLStub        func(string, string, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetSSHPasscodeStub        func(string, string) (string, error)
 	getSSHPasscodeMutex       sync.RWMutex
 	getSSHPasscodeArgsForCall []struct {
@@ -50,6 +67,68 @@ type FakeUAAClient struct {
 	loginPromptsReturnsOnCall map[int]struct {
 		result1 map[string][]string
 	}
+	PollDeviceAuthorizationStub        func(uaa.DeviceAuthorization, time.Duration) (string, string, error)
+	pollDeviceAuthorizationMutex       sync.RWMutex
+	pollDeviceAuthorizationArgsForCall []struct {
+		arg1 uaa.DeviceAuthorization
+		arg2 time.Duration
+	}
+	pollDeviceAuthorizationReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	pollDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	RefreshAccessTokenStub        func(string) (uaa.RefreshedTokens, error)
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct {
+		arg1 string
+	}
+	refreshAccessTokenReturns struct {
+		result1 uaa.RefreshedTokens
+		result2 error
+	}
+	refreshAccessTokenReturnsOnCall map[int]struct {
+		result1 uaa.RefreshedTokens
+		result2 error
+	}
+	RefreshLoginPromptsStub        func() error
+	refreshLoginPromptsMutex       sync.RWMutex
+	refreshLoginPromptsArgsForCall []struct {
+	}
+	refreshLoginPromptsReturns struct {
+		result1 error
+	}
+	refreshLoginPromptsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	VerifyAuthorizationCodeRedirectStub        func(string) error
+	verifyAuthorizationCodeRedirectMutex       sync.RWMutex
+	verifyAuthorizationCodeRedirectArgsForCall []struct {
+		arg1 string
+	}
+	verifyAuthorizationCodeRedirectReturns struct {
+		result1 error
+	}
+	verifyAuthorizationCodeRedirectReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -122,6 +201,71 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.authorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) GetSSHPasscode(arg1 string, arg2 string) (string, error) {
 	fake.getSSHPasscodeMutex.Lock()
 	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
@@ -238,15 +382,324 @@ func (fake *FakeUAAClient) LoginPromptsReturnsOnCall(i int, result1 map[string][
 	}{result1}
 }
 
+func (fake *FakeUAAClient) PollDeviceAuthorization(arg1 uaa.DeviceAuthorization, arg2 time.Duration) (string, string, error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.pollDeviceAuthorizationReturnsOnCall[len(fake.pollDeviceAuthorizationArgsForCall)]
+	fake.pollDeviceAuthorizationArgsForCall = append(fake.pollDeviceAuthorizationArgsForCall, struct {
+		arg1 uaa.DeviceAuthorization
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeviceAuthorization", []interface{}{arg1, arg2})
+	fake.pollDeviceAuthorizationMutex.Unlock()
+	if fake.PollDeviceAuthorizationStub != nil {
+		return fake.PollDeviceAuthorizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCallCount() int {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	return len(fake.pollDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationCalls(stub func(uaa.DeviceAuthorization, time.Duration) (string, string, error)) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationArgsForCall(i int) (uaa.DeviceAuthorization, time.Duration) {
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.pollDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturns(result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	fake.pollDeviceAuthorizationReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) PollDeviceAuthorizationReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.pollDeviceAuthorizationMutex.Lock()
+	defer fake.pollDeviceAuthorizationMutex.Unlock()
+	fake.PollDeviceAuthorizationStub = nil
+	if fake.pollDeviceAuthorizationReturnsOnCall == nil {
+		fake.pollDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.pollDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) RefreshAccessToken(arg1 string) (uaa.RefreshedTokens, error) {
+	fake.refreshAccessTokenMutex.Lock()
+	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{arg1})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.refreshAccessTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenCalls(stub func(string) (uaa.RefreshedTokens, error)) {
+	fake.refreshAccessTokenMutex.Lock()
+	defer fake.refreshAccessTokenMutex.Unlock()
+	fake.RefreshAccessTokenStub = stub
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenArgsForCall(i int) string {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	argsForCall := fake.refreshAccessTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenReturns(result1 uaa.RefreshedTokens, result2 error) {
+	fake.refreshAccessTokenMutex.Lock()
+	defer fake.refreshAccessTokenMutex.Unlock()
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 uaa.RefreshedTokens
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.RefreshedTokens, result2 error) {
+	fake.refreshAccessTokenMutex.Lock()
+	defer fake.refreshAccessTokenMutex.Unlock()
+	fake.RefreshAccessTokenStub = nil
+	if fake.refreshAccessTokenReturnsOnCall == nil {
+		fake.refreshAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 uaa.RefreshedTokens
+			result2 error
+		})
+	}
+	fake.refreshAccessTokenReturnsOnCall[i] = struct {
+		result1 uaa.RefreshedTokens
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RefreshLoginPrompts() error {
+	fake.refreshLoginPromptsMutex.Lock()
+	ret, specificReturn := fake.refreshLoginPromptsReturnsOnCall[len(fake.refreshLoginPromptsArgsForCall)]
+	fake.refreshLoginPromptsArgsForCall = append(fake.refreshLoginPromptsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RefreshLoginPrompts", []interface{}{})
+	fake.refreshLoginPromptsMutex.Unlock()
+	if fake.RefreshLoginPromptsStub != nil {
+		return fake.RefreshLoginPromptsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.refreshLoginPromptsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUAAClient) RefreshLoginPromptsCallCount() int {
+	fake.refreshLoginPromptsMutex.RLock()
+	defer fake.refreshLoginPromptsMutex.RUnlock()
+	return len(fake.refreshLoginPromptsArgsForCall)
+}
+
+func (fake *FakeUAAClient) RefreshLoginPromptsCalls(stub func() error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = stub
+}
+
+func (fake *FakeUAAClient) RefreshLoginPromptsReturns(result1 error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = nil
+	fake.refreshLoginPromptsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) RefreshLoginPromptsReturnsOnCall(i int, result1 error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = nil
+	if fake.refreshLoginPromptsReturnsOnCall == nil {
+		fake.refreshLoginPromptsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshLoginPromptsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if fake.StartDeviceAuthorizationStub != nil {
+		return fake.StartDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirect(arg1 string) error {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	ret, specificReturn := fake.verifyAuthorizationCodeRedirectReturnsOnCall[len(fake.verifyAuthorizationCodeRedirectArgsForCall)]
+	fake.verifyAuthorizationCodeRedirectArgsForCall = append(fake.verifyAuthorizationCodeRedirectArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("VerifyAuthorizationCodeRedirect", []interface{}{arg1})
+	fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	if fake.VerifyAuthorizationCodeRedirectStub != nil {
+		return fake.VerifyAuthorizationCodeRedirectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.verifyAuthorizationCodeRedirectReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirectCallCount() int {
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
+	return len(fake.verifyAuthorizationCodeRedirectArgsForCall)
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirectCalls(stub func(string) error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = stub
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirectArgsForCall(i int) string {
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
+	argsForCall := fake.verifyAuthorizationCodeRedirectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirectReturns(result1 error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = nil
+	fake.verifyAuthorizationCodeRedirectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) VerifyAuthorizationCodeRedirectReturnsOnCall(i int, result1 error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = nil
+	if fake.verifyAuthorizationCodeRedirectReturnsOnCall == nil {
+		fake.verifyAuthorizationCodeRedirectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyAuthorizationCodeRedirectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.loginPromptsMutex.RLock()
 	defer fake.loginPromptsMutex.RUnlock()
+	fake.pollDeviceAuthorizationMutex.RLock()
+	defer fake.pollDeviceAuthorizationMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.refreshLoginPromptsMutex.RLock()
+	defer fake.refreshLoginPromptsMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -19,7 +19,8 @@ type Application struct {
 	LifecycleType       constant.AppLifecycleType
 	LifecycleBuildpacks []string
 	Metadata            struct {
-		Labels map[string]types.NullString `json:"labels,omitempty"`
+		Labels      map[string]types.NullString `json:"labels,omitempty"`
+		Annotations map[string]types.NullString `json:"annotations,omitempty"`
 	}
 }
 
@@ -156,6 +157,14 @@ func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(
 	return app, append(getWarnings, setWarnings...), err
 }
 
+// ClearApplicationBuildCache deletes the build cache of an application, so
+// that its next staging does not reuse artifacts cached by earlier builds.
+func (actor Actor) ClearApplicationBuildCache(appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateApplicationClearBuildpackCache(appGUID)
+
+	return Warnings(warnings), err
+}
+
 // StopApplication stops an application.
 func (actor Actor) StopApplication(appGUID string) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
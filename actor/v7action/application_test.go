@@ -188,7 +188,8 @@ var _ = Describe("Application Actions", func() {
 							Name: "some-app-name",
 							GUID: "some-app-guid",
 							Metadata: struct {
-								Labels map[string]types.NullString `json:"labels,omitempty"`
+								Labels      map[string]types.NullString `json:"labels,omitempty"`
+								Annotations map[string]types.NullString `json:"annotations,omitempty"`
 							}{
 								Labels: map[string]types.NullString{
 									"some-key": types.NewNullString("some-value"),
@@ -208,7 +209,8 @@ var _ = Describe("Application Actions", func() {
 					Name: "some-app-name",
 					GUID: "some-app-guid",
 					Metadata: struct {
-						Labels map[string]types.NullString `json:"labels,omitempty"`
+						Labels      map[string]types.NullString `json:"labels,omitempty"`
+						Annotations map[string]types.NullString `json:"annotations,omitempty"`
 					}{
 						Labels: map[string]types.NullString{"some-key": types.NewNullString("some-value")},
 					},
@@ -843,6 +845,45 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("ClearApplicationBuildCache", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ClearApplicationBuildCache("some-app-guid")
+		})
+
+		When("there are no client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheReturns(ccv3.Warnings{"clear-cache-warning"}, nil)
+			})
+
+			It("clears the build cache of the application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("clear-cache-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("clearing the build cache fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some clear cache error")
+				fakeCloudControllerClient.UpdateApplicationClearBuildpackCacheReturns(ccv3.Warnings{"clear-cache-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("clear-cache-warning"))
+			})
+		})
+	})
+
 	Describe("StopApplication", func() {
 		var (
 			warnings   Warnings
@@ -0,0 +1,110 @@
+package v7action
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// GetMissingManifestReferences returns the provided manifest references that
+// do not exist on the targeted foundation. Service instances are looked up in
+// the provided space and routes are checked against the available domains.
+func (actor Actor) GetMissingManifestReferences(spaceGUID string, references []manifestparser.Reference) ([]manifestparser.Reference, Warnings, error) {
+	namesByType := map[manifestparser.ReferenceType][]string{}
+	for _, reference := range references {
+		namesByType[reference.Type] = append(namesByType[reference.Type], reference.Name)
+	}
+
+	var allWarnings Warnings
+	found := map[manifestparser.ReferenceType]map[string]bool{}
+
+	if names := namesByType[manifestparser.BuildpackReference]; len(names) > 0 {
+		buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(
+			ccv3.Query{Key: ccv3.NameFilter, Values: names},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		found[manifestparser.BuildpackReference] = map[string]bool{}
+		for _, buildpack := range buildpacks {
+			found[manifestparser.BuildpackReference][buildpack.Name] = true
+		}
+	}
+
+	if names := namesByType[manifestparser.StackReference]; len(names) > 0 {
+		stacks, warnings, err := actor.CloudControllerClient.GetStacks(
+			ccv3.Query{Key: ccv3.NameFilter, Values: names},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		found[manifestparser.StackReference] = map[string]bool{}
+		for _, stack := range stacks {
+			found[manifestparser.StackReference][stack.Name] = true
+		}
+	}
+
+	if names := namesByType[manifestparser.ServiceInstanceReference]; len(names) > 0 {
+		serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+			ccv3.Query{Key: ccv3.NameFilter, Values: names},
+			ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		found[manifestparser.ServiceInstanceReference] = map[string]bool{}
+		for _, serviceInstance := range serviceInstances {
+			found[manifestparser.ServiceInstanceReference][serviceInstance.Name] = true
+		}
+	}
+
+	var domains []ccv3.Domain
+	if len(namesByType[manifestparser.RouteReference]) > 0 {
+		var warnings ccv3.Warnings
+		var err error
+		domains, warnings, err = actor.CloudControllerClient.GetDomains()
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	var missing []manifestparser.Reference
+	for _, reference := range references {
+		if reference.Type == manifestparser.RouteReference {
+			if !routeHasDomain(reference.Name, domains) {
+				missing = append(missing, reference)
+			}
+			continue
+		}
+
+		if !found[reference.Type][reference.Name] {
+			missing = append(missing, reference)
+		}
+	}
+
+	return missing, allWarnings, nil
+}
+
+func routeHasDomain(route string, domains []ccv3.Domain) bool {
+	hostname := route
+	if index := strings.IndexAny(hostname, ":/"); index >= 0 {
+		hostname = hostname[:index]
+	}
+	hostname = strings.ToLower(hostname)
+
+	for _, domain := range domains {
+		domainName := strings.ToLower(domain.Name)
+		if hostname == domainName || strings.HasSuffix(hostname, "."+domainName) {
+			return true
+		}
+	}
+	return false
+}
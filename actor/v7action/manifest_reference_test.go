@@ -0,0 +1,113 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Reference Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetMissingManifestReferences", func() {
+		var (
+			references []manifestparser.Reference
+
+			missing    []manifestparser.Reference
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			references = []manifestparser.Reference{
+				{Type: manifestparser.BuildpackReference, Name: "some-buildpack"},
+				{Type: manifestparser.BuildpackReference, Name: "missing-buildpack"},
+				{Type: manifestparser.StackReference, Name: "some-stack"},
+				{Type: manifestparser.ServiceInstanceReference, Name: "some-service"},
+				{Type: manifestparser.ServiceInstanceReference, Name: "missing-service"},
+				{Type: manifestparser.RouteReference, Name: "app.example.com/path"},
+				{Type: manifestparser.RouteReference, Name: "example.com:8080"},
+				{Type: manifestparser.RouteReference, Name: "app.missing.com"},
+			}
+
+			fakeCloudControllerClient.GetBuildpacksReturns([]ccv3.Buildpack{{Name: "some-buildpack"}}, ccv3.Warnings{"buildpack-warning"}, nil)
+			fakeCloudControllerClient.GetStacksReturns([]ccv3.Stack{{Name: "some-stack"}}, ccv3.Warnings{"stack-warning"}, nil)
+			fakeCloudControllerClient.GetServiceInstancesReturns([]ccv3.ServiceInstance{{Name: "some-service"}}, ccv3.Warnings{"service-warning"}, nil)
+			fakeCloudControllerClient.GetDomainsReturns([]ccv3.Domain{{Name: "Example.com"}}, ccv3.Warnings{"domain-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			missing, warnings, executeErr = actor.GetMissingManifestReferences("some-space-guid", references)
+		})
+
+		It("returns the references that do not exist and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("buildpack-warning", "stack-warning", "service-warning", "domain-warning"))
+			Expect(missing).To(ConsistOf(
+				manifestparser.Reference{Type: manifestparser.BuildpackReference, Name: "missing-buildpack"},
+				manifestparser.Reference{Type: manifestparser.ServiceInstanceReference, Name: "missing-service"},
+				manifestparser.Reference{Type: manifestparser.RouteReference, Name: "app.missing.com"},
+			))
+		})
+
+		It("looks the resources up by name", func() {
+			Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-buildpack", "missing-buildpack"}},
+			))
+
+			Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-stack"}},
+			))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-service", "missing-service"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+
+			Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(1))
+		})
+
+		When("there are no references", func() {
+			BeforeEach(func() {
+				references = nil
+			})
+
+			It("does not call the API", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(missing).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the domains fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"domain-warning"}, errors.New("domain-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("domain-error"))
+				Expect(warnings).To(ConsistOf("buildpack-warning", "stack-warning", "service-warning", "domain-warning"))
+			})
+		})
+	})
+})
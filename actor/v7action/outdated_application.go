@@ -0,0 +1,244 @@
+package v7action
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"github.com/blang/semver"
+)
+
+// buildpackFilenameVersion matches the version in a buildpack filename such
+// as java-buildpack-cflinuxfs3-v4.19.1.zip.
+var buildpackFilenameVersion = regexp.MustCompile(`v?\d+(\.\d+)+`)
+
+// OutdatedApplication is an application whose current droplet was staged
+// with an older version of a buildpack than the one installed.
+type OutdatedApplication struct {
+	Application
+	DropletGUID      string
+	Stack            string
+	StagedVersion    string
+	InstalledVersion string
+}
+
+// GetOutdatedApplicationsByBuildpack returns the applications in the space
+// whose current droplet was staged with an older version of the named
+// buildpack than the one installed for the droplet's stack. Applications
+// whose droplet does not record a buildpack version are skipped.
+func (actor Actor) GetOutdatedApplicationsByBuildpack(buildpackName string, spaceGUID string) ([]OutdatedApplication, Warnings, error) {
+	installedVersions, allWarnings, err := actor.getInstalledBuildpackVersions(buildpackName)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	outdatedApps := []OutdatedApplication{}
+	for _, app := range apps {
+		droplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			continue
+		}
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		installedVersion, ok := installedVersions[droplet.Stack]
+		if !ok {
+			installedVersion, ok = installedVersions[""]
+		}
+		if !ok {
+			continue
+		}
+
+		for _, buildpack := range droplet.Buildpacks {
+			if buildpack.Name != buildpackName {
+				continue
+			}
+
+			stagedVersion, err := semver.ParseTolerant(buildpack.Version)
+			if err != nil || !stagedVersion.LT(installedVersion) {
+				break
+			}
+
+			outdatedApps = append(outdatedApps, OutdatedApplication{
+				Application:      app,
+				DropletGUID:      droplet.GUID,
+				Stack:            droplet.Stack,
+				StagedVersion:    buildpack.Version,
+				InstalledVersion: installedVersion.String(),
+			})
+			break
+		}
+	}
+
+	return outdatedApps, allWarnings, nil
+}
+
+// RestageApplication stages the newest ready package of the application and
+// runs the resulting droplet. With rolling, the droplet is rolled out with a
+// deployment that replaces instances without downtime; otherwise the
+// application is stopped and started again. A stopped application only has
+// its droplet replaced.
+func (actor Actor) RestageApplication(app Application, rolling bool) (Warnings, error) {
+	packages, apiWarnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.StatesFilter, Values: []string{string(constant.PackageReady)}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NewestFirstOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+	)
+	allWarnings := Warnings(apiWarnings)
+	if err != nil {
+		return allWarnings, err
+	}
+	if len(packages) == 0 {
+		return allWarnings, actionerror.PackageNotFoundError{}
+	}
+
+	droplet, warnings, err := actor.stagePackageAndWait(packages[0].GUID, app.Name)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch {
+	case app.Stopped():
+		warnings, err = actor.SetApplicationDroplet(app.GUID, droplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		return allWarnings, err
+	case rolling:
+		deploymentGUID, deploymentWarnings, err := actor.CloudControllerClient.CreateApplicationDeployment(app.GUID, droplet.GUID)
+		allWarnings = append(allWarnings, deploymentWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.pollDeployment(deploymentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		return allWarnings, err
+	}
+
+	warnings, err = actor.StopApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.SetApplicationDroplet(app.GUID, droplet.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, warnings, err = actor.StartApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.PollStart(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// getInstalledBuildpackVersions returns the installed versions of the named
+// buildpack by stack, as found in the buildpack filenames. Buildpacks without
+// a stack are keyed by the empty string.
+func (actor Actor) getInstalledBuildpackVersions(buildpackName string) (map[string]semver.Version, Warnings, error) {
+	buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{buildpackName}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+	if len(buildpacks) == 0 {
+		return nil, Warnings(warnings), actionerror.BuildpackNotFoundError{BuildpackName: buildpackName}
+	}
+
+	versions := map[string]semver.Version{}
+	for _, buildpack := range buildpacks {
+		matches := buildpackFilenameVersion.FindAllString(buildpack.Filename, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		version, err := semver.ParseTolerant(matches[len(matches)-1])
+		if err != nil {
+			continue
+		}
+		versions[buildpack.Stack] = version
+	}
+
+	if len(versions) == 0 {
+		return nil, Warnings(warnings), actionerror.BuildpackVersionNotFoundError{BuildpackName: buildpackName}
+	}
+
+	return versions, Warnings(warnings), nil
+}
+
+func (actor Actor) stagePackageAndWait(packageGUID string, appName string) (Droplet, Warnings, error) {
+	var (
+		allWarnings Warnings
+		droplet     Droplet
+		stageErr    error
+	)
+
+	dropletStream, warningsStream, errorStream := actor.StagePackage(packageGUID, appName)
+	for dropletStream != nil || warningsStream != nil || errorStream != nil {
+		select {
+		case stagedDroplet, ok := <-dropletStream:
+			if !ok {
+				dropletStream = nil
+				continue
+			}
+			droplet = stagedDroplet
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				warningsStream = nil
+				continue
+			}
+			allWarnings = append(allWarnings, warnings...)
+		case err, ok := <-errorStream:
+			if !ok {
+				errorStream = nil
+				continue
+			}
+			stageErr = err
+		}
+	}
+
+	return droplet, allWarnings, stageErr
+}
+
+func (actor Actor) pollDeployment(deploymentGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		switch deployment.State {
+		case constant.DeploymentDeployed:
+			return allWarnings, nil
+		case constant.DeploymentCanceled:
+			return allWarnings, errors.New("Deployment has been canceled")
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return allWarnings, actionerror.StartupTimeoutError{}
+}
@@ -0,0 +1,265 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Outdated Application Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		fakeConfig.StartupTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+	})
+
+	Describe("GetOutdatedApplicationsByBuildpack", func() {
+		var (
+			outdatedApps []OutdatedApplication
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetBuildpacksReturns(
+				[]ccv3.Buildpack{
+					{Name: "java_buildpack", Stack: "cflinuxfs3", Filename: "java-buildpack-cflinuxfs3-v4.19.1.zip"},
+					{Name: "java_buildpack", Stack: "windows", Filename: "java-buildpack-windows-v4.18.zip"},
+				},
+				ccv3.Warnings{"get-buildpacks-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{Name: "old-app", GUID: "old-app-guid"},
+					{Name: "current-app", GUID: "current-app-guid"},
+					{Name: "windows-app", GUID: "windows-app-guid"},
+					{Name: "go-app", GUID: "go-app-guid"},
+					{Name: "unstaged-app", GUID: "unstaged-app-guid"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentStub = func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+				switch appGUID {
+				case "old-app-guid":
+					return ccv3.Droplet{
+						GUID:       "old-droplet-guid",
+						Stack:      "cflinuxfs3",
+						Buildpacks: []ccv3.DropletBuildpack{{Name: "java_buildpack", Version: "v4.17.2"}},
+					}, ccv3.Warnings{"droplet-warning"}, nil
+				case "current-app-guid":
+					return ccv3.Droplet{
+						Stack:      "cflinuxfs3",
+						Buildpacks: []ccv3.DropletBuildpack{{Name: "java_buildpack", Version: "v4.19.1"}},
+					}, nil, nil
+				case "windows-app-guid":
+					return ccv3.Droplet{
+						Stack:      "windows",
+						Buildpacks: []ccv3.DropletBuildpack{{Name: "java_buildpack", Version: "4.18"}},
+					}, nil, nil
+				case "go-app-guid":
+					return ccv3.Droplet{
+						Stack:      "cflinuxfs3",
+						Buildpacks: []ccv3.DropletBuildpack{{Name: "go_buildpack", Version: "1.0.0"}},
+					}, nil, nil
+				}
+				return ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{}
+			}
+		})
+
+		JustBeforeEach(func() {
+			outdatedApps, warnings, executeErr = actor.GetOutdatedApplicationsByBuildpack("java_buildpack", "some-space-guid")
+		})
+
+		It("returns the apps staged with an older version of the buildpack for their stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-buildpacks-warning", "get-apps-warning", "droplet-warning"))
+			Expect(outdatedApps).To(Equal([]OutdatedApplication{
+				{
+					Application:      Application{Name: "old-app", GUID: "old-app-guid"},
+					DropletGUID:      "old-droplet-guid",
+					Stack:            "cflinuxfs3",
+					StagedVersion:    "v4.17.2",
+					InstalledVersion: "4.19.1",
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"java_buildpack"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+
+		When("the buildpack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv3.Warnings{"get-buildpacks-warning"}, nil)
+			})
+
+			It("returns a BuildpackNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{BuildpackName: "java_buildpack"}))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no buildpack filename contains a version", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv3.Buildpack{{Name: "java_buildpack", Filename: "java.zip"}}, nil, nil)
+			})
+
+			It("returns a BuildpackVersionNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.BuildpackVersionNotFoundError{BuildpackName: "java_buildpack"}))
+			})
+		})
+
+		When("getting a droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentStub = nil
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"droplet-warning"}, errors.New("droplet-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("droplet-error"))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning", "get-apps-warning", "droplet-warning"))
+			})
+		})
+	})
+
+	Describe("RestageApplication", func() {
+		var (
+			app        Application
+			rolling    bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = Application{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStarted}
+			rolling = false
+
+			fakeCloudControllerClient.GetPackagesReturns([]ccv3.Package{{GUID: "package-guid"}}, ccv3.Warnings{"get-packages-warning"}, nil)
+			fakeCloudControllerClient.CreateBuildReturns(ccv3.Build{GUID: "build-guid"}, ccv3.Warnings{"create-build-warning"}, nil)
+			fakeCloudControllerClient.GetBuildReturns(ccv3.Build{GUID: "build-guid", State: constant.BuildStaged, DropletGUID: "droplet-guid"}, ccv3.Warnings{"get-build-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationStopReturns(ccv3.Application{}, ccv3.Warnings{"stop-warning"}, nil)
+			fakeCloudControllerClient.SetApplicationDropletReturns(ccv3.Relationship{}, ccv3.Warnings{"set-droplet-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationStartReturns(ccv3.Application{}, ccv3.Warnings{"start-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RestageApplication(app, rolling)
+		})
+
+		It("stages the newest ready package and restarts the app on the new droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-packages-warning", "create-build-warning", "get-build-warning",
+				"stop-warning", "set-droplet-warning", "start-warning", "get-processes-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"READY"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NewestFirstOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+			))
+			Expect(fakeCloudControllerClient.CreateBuildArgsForCall(0)).To(Equal(ccv3.Build{PackageGUID: "package-guid"}))
+
+			appGUID, dropletGUID := fakeCloudControllerClient.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(Equal("droplet-guid"))
+			Expect(fakeCloudControllerClient.UpdateApplicationStopCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationStartCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(0))
+		})
+
+		When("the app is stopped", func() {
+			BeforeEach(func() {
+				app.State = constant.ApplicationStopped
+			})
+
+			It("only replaces the droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationStopCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateApplicationStartCallCount()).To(Equal(0))
+			})
+		})
+
+		When("rolling is requested", func() {
+			BeforeEach(func() {
+				rolling = true
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns("deployment-guid", ccv3.Warnings{"create-deployment-warning"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: constant.DeploymentDeploying}, ccv3.Warnings{"deploying-warning"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: constant.DeploymentDeployed}, ccv3.Warnings{"deployed-warning"}, nil)
+			})
+
+			It("rolls out the new droplet with a deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("create-deployment-warning"))
+				Expect(warnings).To(ContainElement("deployed-warning"))
+
+				appGUID, dropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("droplet-guid"))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("deployment-guid"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.UpdateApplicationStopCallCount()).To(Equal(0))
+			})
+
+			When("the deployment is canceled", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: constant.DeploymentCanceled}, nil, nil)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Deployment has been canceled"))
+				})
+			})
+		})
+
+		When("the app has no ready package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a PackageNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PackageNotFoundError{}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(0))
+			})
+		})
+
+		When("staging fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturns(ccv3.Build{State: constant.BuildFailed, Error: "staging-error"}, ccv3.Warnings{"get-build-warning"}, nil)
+			})
+
+			It("returns the error without touching the app", func() {
+				Expect(executeErr).To(MatchError("staging-error"))
+				Expect(warnings).To(ConsistOf("get-packages-warning", "create-build-warning", "get-build-warning"))
+				Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
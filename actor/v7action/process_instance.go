@@ -75,3 +75,28 @@ func (actor Actor) DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appNa
 
 	return allWarnings, nil
 }
+
+// ProcessInstancesRunning returns true when the process of the given type of
+// the app has instances and all of them are running.
+func (actor Actor) ProcessInstancesRunning(appGUID string, processType string) (bool, Warnings, error) {
+	process, allWarnings, err := actor.GetProcessByTypeAndApplication(processType, appGUID)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	if len(instances) == 0 {
+		return false, allWarnings, nil
+	}
+	for _, instance := range instances {
+		if !ProcessInstance(instance).Running() {
+			return false, allWarnings, nil
+		}
+	}
+	return true, allWarnings, nil
+}
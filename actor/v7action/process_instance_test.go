@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -119,4 +120,72 @@ var _ = Describe("instance actions", func() {
 			})
 		})
 	})
+
+	Describe("ProcessInstancesRunning", func() {
+		var (
+			running    bool
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{GUID: "some-process-guid"}, ccv3.Warnings{"get-process-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			running, warnings, executeErr = actor.ProcessInstancesRunning("some-app-guid", "web")
+		})
+
+		When("all the instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+					{State: constant.ProcessInstanceRunning},
+				}, ccv3.Warnings{"get-instances-warning"}, nil)
+			})
+
+			It("returns true and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(running).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+			})
+		})
+
+		When("an instance is not running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+					{State: constant.ProcessInstanceCrashed},
+				}, nil, nil)
+			})
+
+			It("returns false", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(running).To(BeFalse())
+			})
+		})
+
+		When("the process has no instances", func() {
+			It("returns false", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(running).To(BeFalse())
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+			})
+		})
+	})
 })
@@ -15,6 +15,9 @@ type SimpleProgressBar interface {
 }
 
 type ProgressBar struct {
+	// Hidden reads the file without drawing the bar, for accessible mode.
+	Hidden bool
+
 	bar *pb.ProgressBar
 }
 
@@ -33,6 +36,10 @@ func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 		return nil, 0, err
 	}
 
+	if p.Hidden {
+		return file, fileInfo.Size(), nil
+	}
+
 	p.bar = pb.New(int(fileInfo.Size())).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
 	p.bar.Start()
@@ -41,6 +48,10 @@ func (p *ProgressBar) Initialize(path string) (io.Reader, int64, error) {
 }
 
 func (p *ProgressBar) Terminate() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
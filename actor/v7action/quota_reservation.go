@@ -0,0 +1,200 @@
+package v7action
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// QuotaReservation describes the resources an operation is about to use in a
+// space on top of what the space is using already. Resources that are zero
+// are not checked.
+type QuotaReservation struct {
+	// MemoryInMB is the additional memory of started app instances.
+	MemoryInMB int64
+	// Instances is the number of additional started app instances.
+	Instances int64
+	// Routes is the number of routes that will be created.
+	Routes int64
+	// InstanceMemoryInMB is the memory of each app instance.
+	InstanceMemoryInMB int64
+	// InstanceLogRateLimitInBPS is the log rate limit of each app instance,
+	// where -1 is unlimited.
+	InstanceLogRateLimitInBPS int64
+}
+
+// NewScaleQuotaReservation returns the resources scaling the process of the
+// app will use. A stopped app uses no memory or instances until it is
+// started, so only its per instance limits are reserved.
+func NewScaleQuotaReservation(app Application, current Process, scaled Process) QuotaReservation {
+	var reservation QuotaReservation
+
+	instances, memory := int64(current.Instances.Value), int64(current.MemoryInMB.Value)
+	if scaled.Instances.IsSet {
+		instances = int64(scaled.Instances.Value)
+	}
+	if scaled.MemoryInMB.IsSet {
+		memory = int64(scaled.MemoryInMB.Value)
+		reservation.InstanceMemoryInMB = memory
+	}
+	if scaled.LogRateLimitInBPS.IsSet {
+		reservation.InstanceLogRateLimitInBPS = int64(scaled.LogRateLimitInBPS.Value)
+	}
+
+	if app.State == constant.ApplicationStarted {
+		reservation.MemoryInMB = instances*memory - int64(current.Instances.Value)*int64(current.MemoryInMB.Value)
+		reservation.Instances = instances - int64(current.Instances.Value)
+	}
+
+	return reservation
+}
+
+// CheckQuotaReservation returns a QuotaReservationExceededError when the
+// reservation does not fit in the remaining space quota or organization
+// quota. Totals are not checked against Cloud Controllers that do not
+// report usage summaries.
+func (actor Actor) CheckQuotaReservation(orgGUID string, spaceGUID string, reservation QuotaReservation) (Warnings, error) {
+	spaceQuotas, allWarnings, err := actor.CloudControllerClient.GetSpaceQuotas(ccv3.Query{
+		Key:    ccv3.SpaceGUIDFilter,
+		Values: []string{spaceGUID},
+	})
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	if len(spaceQuotas) > 0 {
+		usage, warnings, err := actor.getUsageSummary(reservation, func() (ccv3.UsageSummary, ccv3.Warnings, error) {
+			return actor.CloudControllerClient.GetSpaceUsageSummary(spaceGUID)
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+
+		err = checkQuotaReservation(spaceQuotas[0].Name, spaceQuotas[0].QuotaLimits, usage, reservation)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+	}
+
+	orgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas(ccv3.Query{
+		Key:    ccv3.OrganizationGUIDFilter,
+		Values: []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil || len(orgQuotas) == 0 {
+		return Warnings(allWarnings), err
+	}
+
+	usage, warnings, err := actor.getUsageSummary(reservation, func() (ccv3.UsageSummary, ccv3.Warnings, error) {
+		return actor.CloudControllerClient.GetOrganizationUsageSummary(orgGUID)
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	return Warnings(allWarnings), checkQuotaReservation(orgQuotas[0].Name, orgQuotas[0].QuotaLimits, usage, reservation)
+}
+
+// getUsageSummary returns nil when the reservation has no totals to check or
+// the Cloud Controller does not report usage summaries.
+func (Actor) getUsageSummary(reservation QuotaReservation, get func() (ccv3.UsageSummary, ccv3.Warnings, error)) (*ccv3.UsageSummary, ccv3.Warnings, error) {
+	if reservation.MemoryInMB <= 0 && reservation.Instances <= 0 && reservation.Routes <= 0 {
+		return nil, nil, nil
+	}
+
+	usage, warnings, err := get()
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return nil, warnings, nil
+	}
+	if err != nil {
+		return nil, warnings, err
+	}
+	return &usage, warnings, nil
+}
+
+func checkQuotaReservation(quotaName string, limits ccv3.QuotaLimits, usage *ccv3.UsageSummary, reservation QuotaReservation) error {
+	if limit, ok := quotaLimit(limits.Apps.InstanceMemory); ok && reservation.InstanceMemoryInMB > limit {
+		return actionerror.QuotaReservationExceededError{
+			QuotaName:   quotaName,
+			Resource:    "Memory",
+			Needed:      formatMegabytes(reservation.InstanceMemoryInMB),
+			Available:   formatMegabytes(limit),
+			PerInstance: true,
+		}
+	}
+
+	if limit, ok := quotaLimit(limits.Apps.LogRateLimit); ok && reservation.InstanceLogRateLimitInBPS != 0 && (reservation.InstanceLogRateLimitInBPS < 0 || reservation.InstanceLogRateLimitInBPS > limit) {
+		needed := "unlimited"
+		if reservation.InstanceLogRateLimitInBPS > 0 {
+			needed = bytefmt.ByteSize(uint64(reservation.InstanceLogRateLimitInBPS)) + "/s"
+		}
+		return actionerror.QuotaReservationExceededError{
+			QuotaName:   quotaName,
+			Resource:    "Log rate",
+			Needed:      needed,
+			Available:   bytefmt.ByteSize(uint64(limit)) + "/s",
+			PerInstance: true,
+		}
+	}
+
+	if usage == nil {
+		return nil
+	}
+
+	totals := []struct {
+		resource string
+		needed   int64
+		limit    *types.NullInt
+		used     int64
+		format   func(int64) string
+	}{
+		{"Memory", reservation.MemoryInMB, limits.Apps.TotalMemory, usage.MemoryInMB, formatMegabytes},
+		{"Instances", reservation.Instances, limits.Apps.TotalInstances, usage.StartedInstances, formatCount},
+		{"Routes", reservation.Routes, limits.Routes.TotalRoutes, usage.Routes, formatCount},
+	}
+	for _, total := range totals {
+		limit, ok := quotaLimit(total.limit)
+		if !ok || total.needed <= 0 {
+			continue
+		}
+
+		remaining := limit - total.used
+		if remaining < 0 {
+			remaining = 0
+		}
+		if total.needed > remaining {
+			return actionerror.QuotaReservationExceededError{
+				QuotaName: quotaName,
+				Resource:  total.resource,
+				Needed:    total.format(total.needed),
+				Available: total.format(remaining),
+			}
+		}
+	}
+
+	return nil
+}
+
+// quotaLimit returns the value of a limit and whether the quota limits the
+// resource at all. The Cloud Controller reports unlimited limits as null.
+func quotaLimit(limit *types.NullInt) (int64, bool) {
+	if limit == nil || !limit.IsSet || limit.Value < 0 {
+		return 0, false
+	}
+	return int64(limit.Value), true
+}
+
+func formatMegabytes(megabytes int64) string {
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
+
+func formatCount(count int64) string {
+	return strconv.FormatInt(count, 10)
+}
@@ -0,0 +1,224 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Reservation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	limit := func(value int) *types.NullInt {
+		return &types.NullInt{IsSet: true, Value: value}
+	}
+
+	Describe("NewScaleQuotaReservation", func() {
+		var current Process
+
+		BeforeEach(func() {
+			current = Process{
+				Instances:  types.NullInt{IsSet: true, Value: 2},
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 512},
+			}
+		})
+
+		It("reserves the additional memory and instances of a started app", func() {
+			reservation := NewScaleQuotaReservation(
+				Application{State: constant.ApplicationStarted},
+				current,
+				Process{
+					Instances:         types.NullInt{IsSet: true, Value: 3},
+					MemoryInMB:        types.NullUint64{IsSet: true, Value: 1024},
+					LogRateLimitInBPS: types.NullInt{IsSet: true, Value: -1},
+				},
+			)
+
+			Expect(reservation).To(Equal(QuotaReservation{
+				MemoryInMB:                2048,
+				Instances:                 1,
+				InstanceMemoryInMB:        1024,
+				InstanceLogRateLimitInBPS: -1,
+			}))
+		})
+
+		It("only reserves the per instance limits of a stopped app", func() {
+			reservation := NewScaleQuotaReservation(
+				Application{State: constant.ApplicationStopped},
+				current,
+				Process{
+					Instances:  types.NullInt{IsSet: true, Value: 10},
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 1024},
+				},
+			)
+
+			Expect(reservation).To(Equal(QuotaReservation{InstanceMemoryInMB: 1024}))
+		})
+	})
+
+	Describe("CheckQuotaReservation", func() {
+		var (
+			reservation QuotaReservation
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			reservation = QuotaReservation{MemoryInMB: 2048, Instances: 2, InstanceMemoryInMB: 1024}
+
+			spaceQuota := ccv3.SpaceQuota{Name: "space-quota"}
+			spaceQuota.Apps.TotalMemory = limit(4096)
+			spaceQuota.Apps.InstanceMemory = limit(2048)
+			fakeCloudControllerClient.GetSpaceQuotasReturns([]ccv3.SpaceQuota{spaceQuota}, ccv3.Warnings{"space-quota-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceUsageSummaryReturns(ccv3.UsageSummary{MemoryInMB: 1024}, ccv3.Warnings{"space-usage-warning"}, nil)
+
+			orgQuota := ccv3.OrganizationQuota{Name: "org-quota"}
+			orgQuota.Apps.TotalMemory = limit(10240)
+			orgQuota.Apps.TotalInstances = &types.NullInt{}
+			fakeCloudControllerClient.GetOrganizationQuotasReturns([]ccv3.OrganizationQuota{orgQuota}, ccv3.Warnings{"org-quota-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(ccv3.UsageSummary{MemoryInMB: 2048, StartedInstances: 50}, ccv3.Warnings{"org-usage-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CheckQuotaReservation("some-org-guid", "some-space-guid", reservation)
+		})
+
+		When("the reservation fits in both quotas", func() {
+			It("returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-quota-warning", "space-usage-warning", "org-quota-warning", "org-usage-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetSpaceUsageSummaryArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetOrganizationUsageSummaryArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("the memory does not fit in the space quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsageSummaryReturns(ccv3.UsageSummary{MemoryInMB: 2560}, nil, nil)
+			})
+
+			It("returns a QuotaReservationExceededError", func() {
+				Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{
+					QuotaName: "space-quota",
+					Resource:  "Memory",
+					Needed:    "2G",
+					Available: "1.5G",
+				}))
+				Expect(executeErr).To(MatchError("Memory needs 2G, only 1.5G remaining in quota space-quota"))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the instance memory exceeds the space quota", func() {
+			BeforeEach(func() {
+				reservation.InstanceMemoryInMB = 3072
+			})
+
+			It("returns a per instance QuotaReservationExceededError", func() {
+				Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{
+					QuotaName:   "space-quota",
+					Resource:    "Memory",
+					Needed:      "3G",
+					Available:   "2G",
+					PerInstance: true,
+				}))
+			})
+		})
+
+		When("the instances do not fit in the org quota", func() {
+			BeforeEach(func() {
+				orgQuota := ccv3.OrganizationQuota{Name: "org-quota"}
+				orgQuota.Apps.TotalInstances = limit(51)
+				fakeCloudControllerClient.GetOrganizationQuotasReturns([]ccv3.OrganizationQuota{orgQuota}, nil, nil)
+			})
+
+			It("returns a QuotaReservationExceededError", func() {
+				Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{
+					QuotaName: "org-quota",
+					Resource:  "Instances",
+					Needed:    "2",
+					Available: "1",
+				}))
+			})
+		})
+
+		When("an unlimited log rate is requested and the org quota limits it", func() {
+			BeforeEach(func() {
+				reservation = QuotaReservation{InstanceLogRateLimitInBPS: -1}
+				orgQuota := ccv3.OrganizationQuota{Name: "org-quota"}
+				orgQuota.Apps.LogRateLimit = limit(16384)
+				fakeCloudControllerClient.GetOrganizationQuotasReturns([]ccv3.OrganizationQuota{orgQuota}, nil, nil)
+			})
+
+			It("returns a per instance QuotaReservationExceededError without getting usage", func() {
+				Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{
+					QuotaName:   "org-quota",
+					Resource:    "Log rate",
+					Needed:      "unlimited",
+					Available:   "16K/s",
+					PerInstance: true,
+				}))
+				Expect(fakeCloudControllerClient.GetSpaceUsageSummaryCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetOrganizationUsageSummaryCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the space has no quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceQuotasReturns(nil, nil, nil)
+			})
+
+			It("only checks the org quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceUsageSummaryCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetOrganizationUsageSummaryCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the Cloud Controller does not report usage summaries", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsageSummaryReturns(ccv3.UsageSummary{}, nil, ccerror.ResourceNotFoundError{})
+				fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(ccv3.UsageSummary{}, nil, ccerror.ResourceNotFoundError{})
+				reservation.MemoryInMB = 1000000
+			})
+
+			It("skips checking the totals", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+
+		When("getting the usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsageSummaryReturns(ccv3.UsageSummary{}, ccv3.Warnings{"space-usage-warning"}, errors.New("usage-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("usage-error"))
+				Expect(warnings).To(ConsistOf("space-quota-warning", "space-usage-warning"))
+			})
+		})
+	})
+})
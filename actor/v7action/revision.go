@@ -0,0 +1,208 @@
+package v7action
+
+import (
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Revision represents a Cloud Controller revision of an application.
+type Revision struct {
+	GUID        string
+	Version     int
+	Description string
+	Deployable  bool
+	DropletGUID string
+	// ProcessCommands maps each process type to the command it runs with.
+	ProcessCommands map[string]string
+	CreatedAt       string
+	// EnvironmentVariableNames are the sorted names of the revision's
+	// environment variables. Values are never exposed.
+	EnvironmentVariableNames []string
+}
+
+// ProcessCommandChange is the command of a process type before and after a
+// revision change. From is empty for process types the later revision adds,
+// and To is empty for the ones it removes.
+type ProcessCommandChange struct {
+	ProcessType string
+	From        string
+	To          string
+}
+
+// RevisionDiff is what changes when an application moves from one revision
+// to another.
+type RevisionDiff struct {
+	From Revision
+	To   Revision
+
+	AddedEnvironmentVariables   []string
+	RemovedEnvironmentVariables []string
+	// ChangedEnvironmentVariables are the names of the environment variables
+	// set in both revisions with different values.
+	ChangedEnvironmentVariables []string
+
+	ProcessCommandChanges []ProcessCommandChange
+}
+
+// DropletChanged returns whether the revisions run different droplets.
+func (diff RevisionDiff) DropletChanged() bool {
+	return diff.From.DropletGUID != diff.To.DropletGUID
+}
+
+// HasChanges returns whether the revisions differ in droplet, environment
+// variables or process commands.
+func (diff RevisionDiff) HasChanges() bool {
+	return diff.DropletChanged() ||
+		len(diff.AddedEnvironmentVariables) > 0 ||
+		len(diff.RemovedEnvironmentVariables) > 0 ||
+		len(diff.ChangedEnvironmentVariables) > 0 ||
+		len(diff.ProcessCommandChanges) > 0
+}
+
+// GetRevisionByApplicationNameAndVersion returns the revision of the
+// application with the given version.
+func (actor Actor) GetRevisionByApplicationNameAndVersion(appName string, spaceGUID string, version int) (Revision, Warnings, error) {
+	revisions, _, warnings, err := actor.getRevisions(appName, spaceGUID, version)
+	if err != nil {
+		return Revision{}, warnings, err
+	}
+
+	return revisions[0], warnings, nil
+}
+
+// GetRevisionDiffByApplicationNameAndVersions returns what changes when the
+// application moves from revision version to revision otherVersion.
+func (actor Actor) GetRevisionDiffByApplicationNameAndVersions(appName string, spaceGUID string, version int, otherVersion int) (RevisionDiff, Warnings, error) {
+	revisions, envVars, warnings, err := actor.getRevisions(appName, spaceGUID, version, otherVersion)
+	if err != nil {
+		return RevisionDiff{}, warnings, err
+	}
+
+	diff := RevisionDiff{
+		From: revisions[0],
+		To:   revisions[1],
+	}
+	diff.AddedEnvironmentVariables, diff.RemovedEnvironmentVariables, diff.ChangedEnvironmentVariables = diffEnvironmentVariables(envVars[0], envVars[1])
+	diff.ProcessCommandChanges = diffProcessCommands(diff.From.ProcessCommands, diff.To.ProcessCommands)
+
+	return diff, warnings, nil
+}
+
+// getRevisions returns the application's revisions and their environment
+// variables in the order of versions.
+func (actor Actor) getRevisions(appName string, spaceGUID string, versions ...int) ([]Revision, []ccv3.EnvironmentVariables, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	versionValues := make([]string, 0, len(versions))
+	for _, version := range versions {
+		versionValues = append(versionValues, strconv.Itoa(version))
+	}
+
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(app.GUID,
+		ccv3.Query{Key: ccv3.VersionsFilter, Values: versionValues},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	byVersion := map[int]ccv3.Revision{}
+	for _, ccRevision := range ccRevisions {
+		byVersion[ccRevision.Version] = ccRevision
+	}
+
+	revisions := make([]Revision, 0, len(versions))
+	envVars := make([]ccv3.EnvironmentVariables, 0, len(versions))
+	for _, version := range versions {
+		ccRevision, ok := byVersion[version]
+		if !ok {
+			return nil, nil, allWarnings, actionerror.RevisionNotFoundError{AppName: appName, Version: version}
+		}
+
+		revisionEnvVars, warnings, err := actor.getRevisionEnvironmentVariables(ccRevision)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+
+		revision := Revision{
+			GUID:                     ccRevision.GUID,
+			Version:                  ccRevision.Version,
+			Description:              ccRevision.Description,
+			Deployable:               ccRevision.Deployable,
+			DropletGUID:              ccRevision.DropletGUID,
+			ProcessCommands:          ccRevision.ProcessCommands,
+			CreatedAt:                ccRevision.CreatedAt,
+			EnvironmentVariableNames: []string{},
+		}
+		for name := range revisionEnvVars {
+			revision.EnvironmentVariableNames = append(revision.EnvironmentVariableNames, name)
+		}
+		sort.Strings(revision.EnvironmentVariableNames)
+
+		revisions = append(revisions, revision)
+		envVars = append(envVars, revisionEnvVars)
+	}
+
+	return revisions, envVars, allWarnings, nil
+}
+
+func (actor Actor) getRevisionEnvironmentVariables(revision ccv3.Revision) (ccv3.EnvironmentVariables, Warnings, error) {
+	link, ok := revision.Links["environment_variables"]
+	if !ok {
+		return ccv3.EnvironmentVariables{}, nil, nil
+	}
+
+	envVars, warnings, err := actor.CloudControllerClient.GetEnvironmentVariablesByURL(link.HREF)
+	return envVars, Warnings(warnings), err
+}
+
+func diffEnvironmentVariables(from ccv3.EnvironmentVariables, to ccv3.EnvironmentVariables) ([]string, []string, []string) {
+	added, removed, changed := []string{}, []string{}, []string{}
+
+	for name, value := range to {
+		fromValue, ok := from[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case fromValue != value:
+			changed = append(changed, name)
+		}
+	}
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed
+}
+
+func diffProcessCommands(from map[string]string, to map[string]string) []ProcessCommandChange {
+	changes := []ProcessCommandChange{}
+
+	for processType, command := range to {
+		if fromCommand, ok := from[processType]; !ok || fromCommand != command {
+			changes = append(changes, ProcessCommandChange{ProcessType: processType, From: from[processType], To: command})
+		}
+	}
+	for processType, command := range from {
+		if _, ok := to[processType]; !ok {
+			changes = append(changes, ProcessCommandChange{ProcessType: processType, From: command})
+		}
+	}
+
+	sort.Slice(changes, func(i int, j int) bool {
+		return changes[i].ProcessType < changes[j].ProcessType
+	})
+	return changes
+}
@@ -0,0 +1,172 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+			ccv3.Warnings{"get-applications-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationRevisionsReturns(
+			[]ccv3.Revision{
+				{
+					GUID:            "revision-guid-3",
+					Version:         3,
+					DropletGUID:     "droplet-guid-2",
+					ProcessCommands: map[string]string{"web": "new-command", "clock": "clock-command"},
+					Links:           ccv3.APILinks{"environment_variables": ccv3.APILink{HREF: "revision-3-env-url"}},
+				},
+				{
+					GUID:            "revision-guid-1",
+					Version:         1,
+					DropletGUID:     "droplet-guid-1",
+					ProcessCommands: map[string]string{"web": "old-command", "worker": "worker-command"},
+					Links:           ccv3.APILinks{"environment_variables": ccv3.APILink{HREF: "revision-1-env-url"}},
+				},
+			},
+			ccv3.Warnings{"get-revisions-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetEnvironmentVariablesByURLStub = func(url string) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+			if url == "revision-1-env-url" {
+				return ccv3.EnvironmentVariables{
+					"KEPT":    {Value: "same", IsSet: true},
+					"CHANGED": {Value: "before", IsSet: true},
+					"REMOVED": {Value: "gone", IsSet: true},
+				}, ccv3.Warnings{"env-warning-1"}, nil
+			}
+			return ccv3.EnvironmentVariables{
+				"KEPT":    {Value: "same", IsSet: true},
+				"CHANGED": {Value: "after", IsSet: true},
+				"ADDED":   {Value: "new", IsSet: true},
+			}, ccv3.Warnings{"env-warning-3"}, nil
+		}
+	})
+
+	Describe("GetRevisionByApplicationNameAndVersion", func() {
+		It("returns the revision with its environment variable names", func() {
+			revision, warnings, err := actor.GetRevisionByApplicationNameAndVersion("some-app", "some-space-guid", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-applications-warning", "get-revisions-warning", "env-warning-1"))
+			Expect(revision).To(Equal(Revision{
+				GUID:                     "revision-guid-1",
+				Version:                  1,
+				DropletGUID:              "droplet-guid-1",
+				ProcessCommands:          map[string]string{"web": "old-command", "worker": "worker-command"},
+				EnvironmentVariableNames: []string{"CHANGED", "KEPT", "REMOVED"},
+			}))
+
+			appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.VersionsFilter, Values: []string{"1"}}))
+		})
+
+		When("the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError", func() {
+				_, warnings, err := actor.GetRevisionByApplicationNameAndVersion("some-app", "some-space-guid", 7)
+				Expect(err).To(MatchError(actionerror.RevisionNotFoundError{AppName: "some-app", Version: 7}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-revisions-warning"))
+			})
+		})
+
+		When("the revision has no environment variables link", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns([]ccv3.Revision{{Version: 1}}, nil, nil)
+			})
+
+			It("reports no environment variables", func() {
+				revision, _, err := actor.GetRevisionByApplicationNameAndVersion("some-app", "some-space-guid", 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(revision.EnvironmentVariableNames).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetEnvironmentVariablesByURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the environment variables fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEnvironmentVariablesByURLStub = nil
+				fakeCloudControllerClient.GetEnvironmentVariablesByURLReturns(nil, ccv3.Warnings{"env-warning"}, errors.New("env-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetRevisionByApplicationNameAndVersion("some-app", "some-space-guid", 1)
+				Expect(err).To(MatchError("env-error"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-revisions-warning", "env-warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionDiffByApplicationNameAndVersions", func() {
+		It("returns the droplet, environment variable and process command changes", func() {
+			diff, warnings, err := actor.GetRevisionDiffByApplicationNameAndVersions("some-app", "some-space-guid", 1, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-applications-warning", "get-revisions-warning", "env-warning-1", "env-warning-3"))
+
+			Expect(diff.From.Version).To(Equal(1))
+			Expect(diff.To.Version).To(Equal(3))
+			Expect(diff.DropletChanged()).To(BeTrue())
+			Expect(diff.HasChanges()).To(BeTrue())
+			Expect(diff.AddedEnvironmentVariables).To(Equal([]string{"ADDED"}))
+			Expect(diff.RemovedEnvironmentVariables).To(Equal([]string{"REMOVED"}))
+			Expect(diff.ChangedEnvironmentVariables).To(Equal([]string{"CHANGED"}))
+			Expect(diff.ProcessCommandChanges).To(Equal([]ProcessCommandChange{
+				{ProcessType: "clock", To: "clock-command"},
+				{ProcessType: "web", From: "old-command", To: "new-command"},
+				{ProcessType: "worker", From: "worker-command"},
+			}))
+
+			_, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+			Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.VersionsFilter, Values: []string{"1", "3"}}))
+		})
+
+		It("reports no changes between a revision and itself", func() {
+			diff, _, err := actor.GetRevisionDiffByApplicationNameAndVersions("some-app", "some-space-guid", 3, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.HasChanges()).To(BeFalse())
+		})
+
+		When("one of the revisions does not exist", func() {
+			It("returns a RevisionNotFoundError", func() {
+				_, _, err := actor.GetRevisionDiffByApplicationNameAndVersions("some-app", "some-space-guid", 1, 2)
+				Expect(err).To(MatchError(actionerror.RevisionNotFoundError{AppName: "some-app", Version: 2}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-applications-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				_, warnings, err := actor.GetRevisionDiffByApplicationNameAndVersions("some-app", "some-space-guid", 1, 3)
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,145 @@
+package v7action
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Route represents a V3 actor route.
+type Route ccv3.Route
+
+// RouteDestination represents an app process that a route sends traffic to.
+type RouteDestination ccv3.RouteDestination
+
+// GetRouteByURL returns the route with the given URL, which is the route's
+// host and domain followed by an optional path, for example
+// myapp.example.com/some-path.
+func (actor Actor) GetRouteByURL(routeURL string) (Route, Warnings, error) {
+	hostAndDomain, path := routeURL, ""
+	if i := strings.Index(routeURL, "/"); i != -1 {
+		hostAndDomain, path = routeURL[:i], routeURL[i:]
+	}
+
+	labels := strings.Split(hostAndDomain, ".")
+	var domainNames []string
+	for i := range labels {
+		domainNames = append(domainNames, strings.Join(labels[i:], "."))
+	}
+
+	domains, warnings, err := actor.CloudControllerClient.GetDomains(ccv3.Query{
+		Key:    ccv3.NameFilter,
+		Values: domainNames,
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	// The longest matching domain name is the most specific domain.
+	var domain ccv3.Domain
+	for _, candidate := range domains {
+		if len(candidate.Name) > len(domain.Name) {
+			domain = candidate
+		}
+	}
+	if domain.GUID == "" {
+		return Route{}, allWarnings, actionerror.DomainNotFoundError{Name: hostAndDomain}
+	}
+	host := strings.TrimSuffix(strings.TrimSuffix(hostAndDomain, domain.Name), ".")
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{domain.GUID}},
+		ccv3.Query{Key: ccv3.HostsFilter, Values: []string{host}},
+		ccv3.Query{Key: ccv3.PathsFilter, Values: []string{path}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	if len(routes) == 0 {
+		return Route{}, allWarnings, actionerror.RouteNotFoundError{
+			Host:       host,
+			DomainGUID: domain.GUID,
+			DomainName: domain.Name,
+			Path:       path,
+		}
+	}
+
+	return Route(routes[0]), allWarnings, nil
+}
+
+// GetRouteDestinations returns the app processes the route sends traffic to.
+func (actor Actor) GetRouteDestinations(routeGUID string) ([]RouteDestination, Warnings, error) {
+	ccDestinations, warnings, err := actor.CloudControllerClient.GetRouteDestinations(routeGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var destinations []RouteDestination
+	for _, destination := range ccDestinations {
+		destinations = append(destinations, RouteDestination(destination))
+	}
+	return destinations, Warnings(warnings), nil
+}
+
+// UpdateRouteDestinations replaces the destinations of the route.
+func (actor Actor) UpdateRouteDestinations(routeGUID string, destinations []RouteDestination) (Warnings, error) {
+	ccDestinations := []ccv3.RouteDestination{}
+	for _, destination := range destinations {
+		ccDestinations = append(ccDestinations, ccv3.RouteDestination{
+			AppGUID:     destination.AppGUID,
+			ProcessType: destination.ProcessType,
+			Weight:      destination.Weight,
+		})
+	}
+
+	_, warnings, err := actor.CloudControllerClient.UpdateRouteDestinations(routeGUID, ccDestinations)
+	return Warnings(warnings), err
+}
+
+// CutoverRouteDestinations returns the destinations that send toWeight
+// percent of the route's traffic to the web process of toApp and the rest to
+// fromApp. The route's current destinations must be fromApp and, if a cutover
+// was interrupted, toApp. At 100 percent the route only sends traffic to
+// toApp, without a weight.
+func (actor Actor) CutoverRouteDestinations(route Route, current []RouteDestination, fromApp Application, toApp Application, toWeight int) ([]RouteDestination, error) {
+	var fromDestination *RouteDestination
+	for i, destination := range current {
+		switch destination.AppGUID {
+		case fromApp.GUID:
+			if fromDestination != nil {
+				return nil, actionerror.RouteHasOtherDestinationsError{Route: route.URL}
+			}
+			fromDestination = &current[i]
+		case toApp.GUID:
+		default:
+			return nil, actionerror.RouteHasOtherDestinationsError{Route: route.URL}
+		}
+	}
+	if fromDestination == nil {
+		return nil, actionerror.RouteDestinationNotFoundError{AppName: fromApp.Name, Route: route.URL}
+	}
+
+	toDestination := RouteDestination{
+		AppGUID:     toApp.GUID,
+		ProcessType: constant.ProcessTypeWeb,
+	}
+	if toWeight >= 100 {
+		return []RouteDestination{toDestination}, nil
+	}
+	toDestination.Weight = types.NullInt{IsSet: true, Value: toWeight}
+
+	return []RouteDestination{
+		{
+			AppGUID:     fromApp.GUID,
+			ProcessType: fromDestination.ProcessType,
+			Weight:      types.NullInt{IsSet: true, Value: 100 - toWeight},
+		},
+		toDestination,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetRouteByURL", func() {
+		var (
+			routeURL   string
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			routeURL = "some-host.apps.example.com/some-path"
+			fakeCloudControllerClient.GetDomainsReturns([]ccv3.Domain{
+				{GUID: "example-domain-guid", Name: "example.com"},
+				{GUID: "apps-domain-guid", Name: "apps.example.com"},
+			}, ccv3.Warnings{"get-domains-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.GetRouteByURL(routeURL)
+		})
+
+		When("the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv3.Route{
+					{GUID: "route-guid", Host: "some-host", Path: "/some-path", URL: "some-host.apps.example.com/some-path"},
+				}, ccv3.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("looks the route up on the most specific domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+				Expect(route).To(Equal(Route{GUID: "route-guid", Host: "some-host", Path: "/some-path", URL: "some-host.apps.example.com/some-path"}))
+
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(ccv3.Query{
+					Key:    ccv3.NameFilter,
+					Values: []string{"some-host.apps.example.com", "apps.example.com", "example.com", "com"},
+				}))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"apps-domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{"some-host"}},
+					ccv3.Query{Key: ccv3.PathsFilter, Values: []string{"/some-path"}},
+				))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				routeURL = "apps.example.com"
+			})
+
+			It("returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{
+					DomainGUID: "apps-domain-guid",
+					DomainName: "apps.example.com",
+				}))
+			})
+		})
+
+		When("no domain matches", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "some-host.apps.example.com"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateRouteDestinations", func() {
+		It("replaces the destinations of the route", func() {
+			fakeCloudControllerClient.UpdateRouteDestinationsReturns(nil, ccv3.Warnings{"update-warning"}, errors.New("update-error"))
+
+			warnings, executeErr := actor.UpdateRouteDestinations("route-guid", []RouteDestination{
+				{GUID: "destination-guid", AppGUID: "app-guid", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 100}},
+			})
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			routeGUID, destinations := fakeCloudControllerClient.UpdateRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinations).To(Equal([]ccv3.RouteDestination{
+				{AppGUID: "app-guid", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 100}},
+			}))
+		})
+	})
+
+	Describe("CutoverRouteDestinations", func() {
+		var (
+			route        Route
+			current      []RouteDestination
+			fromApp      Application
+			toApp        Application
+			toWeight     int
+			destinations []RouteDestination
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			route = Route{GUID: "route-guid", URL: "app.example.com"}
+			fromApp = Application{GUID: "blue-guid", Name: "blue"}
+			toApp = Application{GUID: "green-guid", Name: "green"}
+			current = []RouteDestination{{GUID: "destination-guid", AppGUID: "blue-guid", ProcessType: "web"}}
+			toWeight = 25
+		})
+
+		JustBeforeEach(func() {
+			destinations, executeErr = actor.CutoverRouteDestinations(route, current, fromApp, toApp, toWeight)
+		})
+
+		It("splits the traffic between the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(destinations).To(Equal([]RouteDestination{
+				{AppGUID: "blue-guid", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 75}},
+				{AppGUID: "green-guid", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 25}},
+			}))
+		})
+
+		When("all the traffic goes to the new app", func() {
+			BeforeEach(func() {
+				toWeight = 100
+				current = append(current, RouteDestination{AppGUID: "green-guid", ProcessType: "web"})
+			})
+
+			It("only sends traffic to the new app, without a weight", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(destinations).To(Equal([]RouteDestination{
+					{AppGUID: "green-guid", ProcessType: "web"},
+				}))
+			})
+		})
+
+		When("the app being cut over from is not a destination", func() {
+			BeforeEach(func() {
+				current = nil
+			})
+
+			It("returns a RouteDestinationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationNotFoundError{AppName: "blue", Route: "app.example.com"}))
+			})
+		})
+
+		When("the route has other destinations", func() {
+			BeforeEach(func() {
+				current = append(current, RouteDestination{AppGUID: "other-guid", ProcessType: "web"})
+			})
+
+			It("returns a RouteHasOtherDestinationsError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteHasOtherDestinationsError{Route: "app.example.com"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,264 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// ServicePlanAccess is who a service plan is visible to.
+type ServicePlanAccess struct {
+	BrokerName  string `json:"broker"`
+	ServiceName string `json:"service"`
+	PlanName    string `json:"plan"`
+	PlanGUID    string `json:"plan_guid"`
+	// VisibilityType is who the plan is visible to.
+	VisibilityType constant.ServicePlanVisibilityType `json:"visibility_type"`
+	// Organizations are the names of the orgs the plan is visible to when the
+	// visibility type is organization.
+	Organizations []string `json:"organizations"`
+}
+
+// ServiceAccessChange is how enabling or disabling service access changes
+// who a plan is visible to.
+type ServiceAccessChange struct {
+	ServicePlanAccess
+	// NewVisibilityType is who the plan will be visible to.
+	NewVisibilityType constant.ServicePlanVisibilityType
+	// GainedOrganizations are the orgs that gain access to the plan. It is
+	// empty when the plan becomes public.
+	GainedOrganizations []string
+	// LostOrganizations are the orgs that lose access to the plan. It is empty
+	// when a public plan is made admin only.
+	LostOrganizations []string
+	// OrganizationGUID is the org of an org level change.
+	OrganizationGUID string
+}
+
+// GetServiceAccess returns the access to every plan, optionally limited to
+// the plans of a broker or service, or to those visible to an org.
+func (actor Actor) GetServiceAccess(brokerName string, serviceName string, orgName string) ([]ServicePlanAccess, Warnings, error) {
+	plans, allWarnings, err := actor.getServicePlanAccess(brokerName, serviceName, "", false)
+	if err != nil || orgName == "" {
+		return plans, allWarnings, err
+	}
+
+	_, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var visiblePlans []ServicePlanAccess
+	for _, plan := range plans {
+		if plan.VisibilityType == constant.ServicePlanVisibilityPublic || containsString(plan.Organizations, orgName) {
+			visiblePlans = append(visiblePlans, plan)
+		}
+	}
+	return visiblePlans, allWarnings, nil
+}
+
+// GetEnableServiceAccessChanges returns, without changing anything, how
+// enabling access to the service, or one of its plans, for one or all orgs
+// changes who each plan is visible to. Plans that are unchanged are left out.
+func (actor Actor) GetEnableServiceAccessChanges(serviceName string, planName string, orgName string, brokerName string) ([]ServiceAccessChange, Warnings, error) {
+	plans, org, allWarnings, err := actor.getServiceAccessTargets(serviceName, planName, orgName, brokerName)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var changes []ServiceAccessChange
+	for _, plan := range plans {
+		switch {
+		case plan.VisibilityType == constant.ServicePlanVisibilityPublic:
+		case orgName == "":
+			changes = append(changes, ServiceAccessChange{
+				ServicePlanAccess: plan,
+				NewVisibilityType: constant.ServicePlanVisibilityPublic,
+			})
+		case !containsString(plan.Organizations, org.Name):
+			changes = append(changes, ServiceAccessChange{
+				ServicePlanAccess:   plan,
+				NewVisibilityType:   constant.ServicePlanVisibilityOrganization,
+				GainedOrganizations: []string{org.Name},
+				OrganizationGUID:    org.GUID,
+			})
+		}
+	}
+	return changes, allWarnings, nil
+}
+
+// GetDisableServiceAccessChanges returns, without changing anything, how
+// disabling access to the service, or one of its plans, for one or all orgs
+// changes who each plan is visible to. Public plans cannot lose access for a
+// single org, so they are unchanged then, as are plans the org cannot see.
+func (actor Actor) GetDisableServiceAccessChanges(serviceName string, planName string, orgName string, brokerName string) ([]ServiceAccessChange, Warnings, error) {
+	plans, org, allWarnings, err := actor.getServiceAccessTargets(serviceName, planName, orgName, brokerName)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var changes []ServiceAccessChange
+	for _, plan := range plans {
+		switch {
+		case plan.VisibilityType == constant.ServicePlanVisibilityAdmin:
+		case orgName == "":
+			changes = append(changes, ServiceAccessChange{
+				ServicePlanAccess: plan,
+				NewVisibilityType: constant.ServicePlanVisibilityAdmin,
+				LostOrganizations: plan.Organizations,
+			})
+		case containsString(plan.Organizations, org.Name):
+			changes = append(changes, ServiceAccessChange{
+				ServicePlanAccess: plan,
+				NewVisibilityType: constant.ServicePlanVisibilityOrganization,
+				LostOrganizations: []string{org.Name},
+				OrganizationGUID:  org.GUID,
+			})
+		}
+	}
+	return changes, allWarnings, nil
+}
+
+// ApplyServiceAccessChanges updates who each plan is visible to.
+func (actor Actor) ApplyServiceAccessChanges(changes []ServiceAccessChange) (Warnings, error) {
+	var allWarnings Warnings
+	for _, change := range changes {
+		var (
+			warnings ccv3.Warnings
+			err      error
+		)
+		switch {
+		case change.NewVisibilityType != constant.ServicePlanVisibilityOrganization:
+			_, warnings, err = actor.CloudControllerClient.UpdateServicePlanVisibility(change.PlanGUID, ccv3.ServicePlanVisibility{Type: change.NewVisibilityType})
+		case len(change.GainedOrganizations) > 0:
+			_, warnings, err = actor.CloudControllerClient.AddServicePlanVisibilityOrganizations(change.PlanGUID, []string{change.OrganizationGUID})
+		default:
+			warnings, err = actor.CloudControllerClient.DeleteServicePlanVisibilityOrganization(change.PlanGUID, change.OrganizationGUID)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+func (actor Actor) getServiceAccessTargets(serviceName string, planName string, orgName string, brokerName string) ([]ServicePlanAccess, Organization, Warnings, error) {
+	plans, allWarnings, err := actor.getServicePlanAccess(brokerName, serviceName, planName, true)
+	if err != nil || orgName == "" {
+		return plans, Organization{}, allWarnings, err
+	}
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	return plans, org, allWarnings, err
+}
+
+// getServicePlanAccess looks up the plans, their service and broker, and the
+// orgs they are visible to. Space scoped plans are left out, since service
+// access does not apply to them. When requireService is true the service must
+// exist and be provided by a single broker.
+func (actor Actor) getServicePlanAccess(brokerName string, serviceName string, planName string, requireService bool) ([]ServicePlanAccess, Warnings, error) {
+	var (
+		allWarnings Warnings
+		queries     []ccv3.Query
+	)
+	if serviceName != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.NameFilter, Values: []string{serviceName}})
+	}
+	if brokerName != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{brokerName}})
+	}
+
+	offerings, warnings, err := actor.CloudControllerClient.GetServiceOfferings(queries...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if requireService {
+		switch {
+		case len(offerings) == 0 && brokerName != "":
+			return nil, allWarnings, actionerror.ServiceAndBrokerCombinationNotFoundError{ServiceName: serviceName, BrokerName: brokerName}
+		case len(offerings) == 0:
+			return nil, allWarnings, actionerror.ServiceNotFoundError{Name: serviceName}
+		case len(offerings) > 1:
+			return nil, allWarnings, actionerror.DuplicateServiceError{Name: serviceName}
+		}
+	}
+	if len(offerings) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	brokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	brokerNames := map[string]string{}
+	for _, broker := range brokers {
+		brokerNames[broker.GUID] = broker.Name
+	}
+
+	offeringsByGUID := map[string]ccv3.ServiceOffering{}
+	var offeringGUIDs []string
+	for _, offering := range offerings {
+		offeringsByGUID[offering.GUID] = offering
+		offeringGUIDs = append(offeringGUIDs, offering.GUID)
+	}
+
+	queries = []ccv3.Query{{Key: ccv3.ServiceOfferingGUIDsFilter, Values: offeringGUIDs}}
+	if planName != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.NameFilter, Values: []string{planName}})
+	}
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans(queries...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if planName != "" && len(plans) == 0 {
+		return nil, allWarnings, actionerror.ServicePlanNotFoundError{PlanName: planName, ServiceName: serviceName}
+	}
+
+	var planAccess []ServicePlanAccess
+	for _, plan := range plans {
+		if plan.VisibilityType == constant.ServicePlanVisibilitySpace {
+			continue
+		}
+
+		offering := offeringsByGUID[plan.Relationships[constant.RelationshipTypeServiceOffering].GUID]
+		access := ServicePlanAccess{
+			BrokerName:     brokerNames[offering.Relationships[constant.RelationshipTypeServiceBroker].GUID],
+			ServiceName:    offering.Name,
+			PlanName:       plan.Name,
+			PlanGUID:       plan.GUID,
+			VisibilityType: plan.VisibilityType,
+		}
+
+		if plan.VisibilityType == constant.ServicePlanVisibilityOrganization {
+			visibility, warnings, err := actor.CloudControllerClient.GetServicePlanVisibility(plan.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			for _, org := range visibility.Organizations {
+				access.Organizations = append(access.Organizations, org.Name)
+			}
+		}
+
+		planAccess = append(planAccess, access)
+	}
+
+	return planAccess, allWarnings, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
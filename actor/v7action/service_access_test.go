@@ -0,0 +1,338 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Access Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+
+		fakeCloudControllerClient.GetServiceOfferingsReturns(
+			[]ccv3.ServiceOffering{{
+				GUID:          "service-guid",
+				Name:          "some-service",
+				Relationships: ccv3.Relationships{constant.RelationshipTypeServiceBroker: {GUID: "broker-guid"}},
+			}},
+			ccv3.Warnings{"offerings-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceBrokersReturns(
+			[]ccv3.ServiceBroker{{GUID: "broker-guid", Name: "some-broker"}},
+			ccv3.Warnings{"brokers-warning"},
+			nil,
+		)
+		offering := ccv3.Relationships{constant.RelationshipTypeServiceOffering: {GUID: "service-guid"}}
+		fakeCloudControllerClient.GetServicePlansReturns(
+			[]ccv3.ServicePlan{
+				{GUID: "public-guid", Name: "public-plan", VisibilityType: constant.ServicePlanVisibilityPublic, Relationships: offering},
+				{GUID: "limited-guid", Name: "limited-plan", VisibilityType: constant.ServicePlanVisibilityOrganization, Relationships: offering},
+				{GUID: "admin-guid", Name: "admin-plan", VisibilityType: constant.ServicePlanVisibilityAdmin, Relationships: offering},
+				{GUID: "space-guid", Name: "space-plan", VisibilityType: constant.ServicePlanVisibilitySpace, Relationships: offering},
+			},
+			ccv3.Warnings{"plans-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlanVisibilityReturns(
+			ccv3.ServicePlanVisibility{
+				Type:          constant.ServicePlanVisibilityOrganization,
+				Organizations: []ccv3.VisibilityDetail{{GUID: "org-1-guid", Name: "org-1"}},
+			},
+			ccv3.Warnings{"visibility-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetOrganizationsReturns(
+			[]ccv3.Organization{{GUID: "org-1-guid", Name: "org-1"}},
+			ccv3.Warnings{"org-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetServiceAccess", func() {
+		var (
+			orgName    string
+			plans      []ServicePlanAccess
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			orgName = ""
+		})
+
+		JustBeforeEach(func() {
+			plans, warnings, executeErr = actor.GetServiceAccess("some-broker", "some-service", orgName)
+		})
+
+		It("returns the access to every plan that is not space scoped", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("offerings-warning", "brokers-warning", "plans-warning", "visibility-warning"))
+			Expect(plans).To(Equal([]ServicePlanAccess{
+				{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "public-plan", PlanGUID: "public-guid", VisibilityType: constant.ServicePlanVisibilityPublic},
+				{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "limited-plan", PlanGUID: "limited-guid", VisibilityType: constant.ServicePlanVisibilityOrganization, Organizations: []string{"org-1"}},
+				{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "admin-plan", PlanGUID: "admin-guid", VisibilityType: constant.ServicePlanVisibilityAdmin},
+			}))
+
+			Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-service"}},
+				ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"some-broker"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceOfferingGUIDsFilter, Values: []string{"service-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlanVisibilityCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServicePlanVisibilityArgsForCall(0)).To(Equal("limited-guid"))
+		})
+
+		When("an org is given", func() {
+			BeforeEach(func() {
+				orgName = "org-2"
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "org-2-guid", Name: "org-2"}}, nil, nil)
+			})
+
+			It("returns only the plans visible to the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plans).To(HaveLen(1))
+				Expect(plans[0].PlanName).To(Equal("public-plan"))
+			})
+		})
+
+		When("there are no matching services", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingsReturns(nil, ccv3.Warnings{"offerings-warning"}, nil)
+			})
+
+			It("returns no plans", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plans).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServicePlansCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans-warning"}, errors.New("plans-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("plans-error"))
+				Expect(warnings).To(ConsistOf("offerings-warning", "brokers-warning", "plans-warning"))
+			})
+		})
+	})
+
+	Describe("GetEnableServiceAccessChanges", func() {
+		var (
+			planName   string
+			orgName    string
+			changes    []ServiceAccessChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			planName = ""
+			orgName = ""
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetEnableServiceAccessChanges("some-service", planName, orgName, "")
+		})
+
+		When("enabling for all orgs", func() {
+			It("makes the plans that are not public, public", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("offerings-warning", "brokers-warning", "plans-warning", "visibility-warning"))
+				Expect(changes).To(HaveLen(2))
+				Expect(changes[0].PlanName).To(Equal("limited-plan"))
+				Expect(changes[0].NewVisibilityType).To(Equal(constant.ServicePlanVisibilityPublic))
+				Expect(changes[1].PlanName).To(Equal("admin-plan"))
+				Expect(changes[1].NewVisibilityType).To(Equal(constant.ServicePlanVisibilityPublic))
+			})
+		})
+
+		When("enabling for an org", func() {
+			BeforeEach(func() {
+				orgName = "org-2"
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "org-2-guid", Name: "org-2"}}, ccv3.Warnings{"org-warning"}, nil)
+			})
+
+			It("adds the org to the plans it cannot see", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("org-warning"))
+				Expect(changes).To(HaveLen(2))
+				for _, change := range changes {
+					Expect(change.NewVisibilityType).To(Equal(constant.ServicePlanVisibilityOrganization))
+					Expect(change.GainedOrganizations).To(Equal([]string{"org-2"}))
+					Expect(change.OrganizationGUID).To(Equal("org-2-guid"))
+				}
+			})
+		})
+
+		When("the plan is given", func() {
+			BeforeEach(func() {
+				planName = "admin-plan"
+			})
+
+			It("filters the plans by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"admin-plan"}},
+				))
+			})
+
+			When("the plan does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServicePlansReturns(nil, nil, nil)
+				})
+
+				It("returns a ServicePlanNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "admin-plan", ServiceName: "some-service"}))
+				})
+			})
+		})
+
+		When("the service does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingsReturns(nil, ccv3.Warnings{"offerings-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("offerings-warning"))
+			})
+		})
+
+		When("the service is provided by multiple brokers", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingsReturns([]ccv3.ServiceOffering{{GUID: "a"}, {GUID: "b"}}, nil, nil)
+			})
+
+			It("returns a DuplicateServiceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DuplicateServiceError{Name: "some-service"}))
+			})
+		})
+	})
+
+	Describe("GetDisableServiceAccessChanges", func() {
+		var (
+			orgName    string
+			changes    []ServiceAccessChange
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			orgName = ""
+		})
+
+		JustBeforeEach(func() {
+			changes, _, executeErr = actor.GetDisableServiceAccessChanges("some-service", "", orgName, "")
+		})
+
+		When("disabling for all orgs", func() {
+			It("makes the plans that are not admin only, admin only", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(2))
+				Expect(changes[0].PlanName).To(Equal("public-plan"))
+				Expect(changes[0].NewVisibilityType).To(Equal(constant.ServicePlanVisibilityAdmin))
+				Expect(changes[0].LostOrganizations).To(BeEmpty())
+				Expect(changes[1].PlanName).To(Equal("limited-plan"))
+				Expect(changes[1].NewVisibilityType).To(Equal(constant.ServicePlanVisibilityAdmin))
+				Expect(changes[1].LostOrganizations).To(Equal([]string{"org-1"}))
+			})
+		})
+
+		When("disabling for an org", func() {
+			BeforeEach(func() {
+				orgName = "org-1"
+			})
+
+			It("removes the org from the plans it can see through org access", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(1))
+				Expect(changes[0].PlanName).To(Equal("limited-plan"))
+				Expect(changes[0].NewVisibilityType).To(Equal(constant.ServicePlanVisibilityOrganization))
+				Expect(changes[0].LostOrganizations).To(Equal([]string{"org-1"}))
+				Expect(changes[0].OrganizationGUID).To(Equal("org-1-guid"))
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				orgName = "missing-org"
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, nil, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "missing-org"}))
+			})
+		})
+	})
+
+	Describe("ApplyServiceAccessChanges", func() {
+		var (
+			changes    []ServiceAccessChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			changes = []ServiceAccessChange{
+				{ServicePlanAccess: ServicePlanAccess{PlanGUID: "plan-1"}, NewVisibilityType: constant.ServicePlanVisibilityPublic},
+				{ServicePlanAccess: ServicePlanAccess{PlanGUID: "plan-2"}, NewVisibilityType: constant.ServicePlanVisibilityOrganization, GainedOrganizations: []string{"org"}, OrganizationGUID: "org-guid"},
+				{ServicePlanAccess: ServicePlanAccess{PlanGUID: "plan-3"}, NewVisibilityType: constant.ServicePlanVisibilityOrganization, LostOrganizations: []string{"org"}, OrganizationGUID: "org-guid"},
+			}
+			fakeCloudControllerClient.UpdateServicePlanVisibilityReturns(ccv3.ServicePlanVisibility{}, ccv3.Warnings{"update-warning"}, nil)
+			fakeCloudControllerClient.AddServicePlanVisibilityOrganizationsReturns(ccv3.ServicePlanVisibility{}, ccv3.Warnings{"add-warning"}, nil)
+			fakeCloudControllerClient.DeleteServicePlanVisibilityOrganizationReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyServiceAccessChanges(changes)
+		})
+
+		It("updates the visibility of each plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"update-warning", "add-warning", "delete-warning"}))
+
+			planGUID, visibility := fakeCloudControllerClient.UpdateServicePlanVisibilityArgsForCall(0)
+			Expect(planGUID).To(Equal("plan-1"))
+			Expect(visibility).To(Equal(ccv3.ServicePlanVisibility{Type: constant.ServicePlanVisibilityPublic}))
+
+			planGUID, orgGUIDs := fakeCloudControllerClient.AddServicePlanVisibilityOrganizationsArgsForCall(0)
+			Expect(planGUID).To(Equal("plan-2"))
+			Expect(orgGUIDs).To(Equal([]string{"org-guid"}))
+
+			planGUID, orgGUID := fakeCloudControllerClient.DeleteServicePlanVisibilityOrganizationArgsForCall(0)
+			Expect(planGUID).To(Equal("plan-3"))
+			Expect(orgGUID).To(Equal("org-guid"))
+		})
+
+		When("a change fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AddServicePlanVisibilityOrganizationsReturns(ccv3.ServicePlanVisibility{}, ccv3.Warnings{"add-warning"}, errors.New("add-error"))
+			})
+
+			It("stops and returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("add-error"))
+				Expect(warnings).To(Equal(Warnings{"update-warning", "add-warning"}))
+				Expect(fakeCloudControllerClient.DeleteServicePlanVisibilityOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -17,6 +17,22 @@ type SSHAuthentication struct {
 // back the SSH authentication information for the SSH session.
 func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 	appName string, spaceGUID string, processType string, processIndex uint,
+) (SSHAuthentication, Warnings, error) {
+	return actor.getSecureShellConfiguration(appName, spaceGUID, processType, processIndex, true)
+}
+
+// GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex
+// returns back the SSH endpoint and username for an SSH session that
+// authenticates with a key registered with the platform, without fetching a
+// one-time passcode.
+func (actor Actor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+	appName string, spaceGUID string, processType string, processIndex uint,
+) (SSHAuthentication, Warnings, error) {
+	return actor.getSecureShellConfiguration(appName, spaceGUID, processType, processIndex, false)
+}
+
+func (actor Actor) getSecureShellConfiguration(
+	appName string, spaceGUID string, processType string, processIndex uint, withPasscode bool,
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
@@ -30,9 +46,13 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		return SSHAuthentication{}, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
 	}
 
-	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
-	if err != nil {
-		return SSHAuthentication{}, Warnings{}, err
+	var passcode string
+	if withPasscode {
+		var err error
+		passcode, err = actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+		if err != nil {
+			return SSHAuthentication{}, Warnings{}, err
+		}
 	}
 
 	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
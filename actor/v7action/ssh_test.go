@@ -205,4 +205,33 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex", func() {
+		var sshAuth SSHAuthentication
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning, Index: 0}}, ccv3.Warnings{"some-instance-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuth, warnings, executeErr = actor.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex("some-app", "some-space-guid", "some-process-type", 0)
+		})
+
+		It("returns the endpoint and username without fetching a passcode", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+			Expect(sshAuth).To(Equal(SSHAuthentication{
+				Endpoint:           "some-app-ssh-endpoint",
+				HostKeyFingerprint: "some-app-ssh-fingerprint",
+				Username:           "cf:some-process-guid/0",
+			}))
+
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+		})
+	})
 })
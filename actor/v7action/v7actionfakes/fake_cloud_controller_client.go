@@ -10,6 +10,22 @@ import (
 )
 
 type FakeCloudControllerClient struct {
+	AddServicePlanVisibilityOrganizationsStub        func(string, []string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)
+	addServicePlanVisibilityOrganizationsMutex       sync.RWMutex
+	addServicePlanVisibilityOrganizationsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	addServicePlanVisibilityOrganizationsReturns struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
+	addServicePlanVisibilityOrganizationsReturnsOnCall map[int]struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
 	AppSSHEndpointStub        func() string
 	appSSHEndpointMutex       sync.RWMutex
 	appSSHEndpointArgsForCall []struct {
@@ -55,6 +71,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(string, string) (string, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(string, ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -248,6 +280,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteServicePlanVisibilityOrganizationStub        func(string, string) (ccv3.Warnings, error)
+	deleteServicePlanVisibilityOrganizationMutex       sync.RWMutex
+	deleteServicePlanVisibilityOrganizationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteServicePlanVisibilityOrganizationReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteServicePlanVisibilityOrganizationReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(string, []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -340,6 +386,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationRevisionsStub        func(string, ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationRevisionsMutex       sync.RWMutex
+	getApplicationRevisionsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getApplicationRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -401,6 +463,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDomainsStub        func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getDomainsReturns struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDomainsReturnsOnCall map[int]struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -431,6 +523,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetEnvironmentVariablesByURLStub        func(string) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	getEnvironmentVariablesByURLMutex       sync.RWMutex
+	getEnvironmentVariablesByURLArgsForCall []struct {
+		arg1 string
+	}
+	getEnvironmentVariablesByURLReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getEnvironmentVariablesByURLReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetFeatureFlagStub        func(string) (ccv3.FeatureFlag, ccv3.Warnings, error)
 	getFeatureFlagMutex       sync.RWMutex
 	getFeatureFlagArgsForCall []struct {
@@ -520,6 +627,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetOrganizationUsageSummaryStub        func(string) (ccv3.UsageSummary, ccv3.Warnings, error)
+	getOrganizationUsageSummaryMutex       sync.RWMutex
+	getOrganizationUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationUsageSummaryReturns struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationUsageSummaryReturnsOnCall map[int]struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -580,6 +717,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRouteDestinationsStub        func(string) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	getRouteDestinationsMutex       sync.RWMutex
+	getRouteDestinationsArgsForCall []struct {
+		arg1 string
+	}
+	getRouteDestinationsReturns struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRouteDestinationsReturnsOnCall map[int]struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetRoutesStub        func(...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error)
+	getRoutesMutex       sync.RWMutex
+	getRoutesArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getRoutesReturns struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRoutesReturnsOnCall map[int]struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServiceBrokersStub        func(...ccv3.Query) ([]ccv3.ServiceBroker, ccv3.Warnings, error)
+	getServiceBrokersMutex       sync.RWMutex
+	getServiceBrokersArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceBrokersReturns struct {
+		result1 []ccv3.ServiceBroker
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceBrokersReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceBroker
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -595,6 +777,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceOfferingsStub        func(...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error)
+	getServiceOfferingsMutex       sync.RWMutex
+	getServiceOfferingsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceOfferingsReturns struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceOfferingsReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServicePlanVisibilityStub        func(string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)
+	getServicePlanVisibilityMutex       sync.RWMutex
+	getServicePlanVisibilityArgsForCall []struct {
+		arg1 string
+	}
+	getServicePlanVisibilityReturns struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServicePlanVisibilityReturnsOnCall map[int]struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServicePlansStub        func(...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error)
+	getServicePlansMutex       sync.RWMutex
+	getServicePlansArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServicePlansReturns struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServicePlansReturnsOnCall map[int]struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -610,6 +837,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpaceQuotasStub        func(...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error)
+	getSpaceQuotasMutex       sync.RWMutex
+	getSpaceQuotasArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getSpaceQuotasReturns struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetSpaceUsageSummaryStub        func(string) (ccv3.UsageSummary, ccv3.Warnings, error)
+	getSpaceUsageSummaryMutex       sync.RWMutex
+	getSpaceUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceUsageSummaryReturns struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceUsageSummaryReturnsOnCall map[int]struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(...ccv3.Query) ([]ccv3.Space, ccv3.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -731,6 +988,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateApplicationClearBuildpackCacheStub        func(string) (ccv3.Warnings, error)
+	updateApplicationClearBuildpackCacheMutex       sync.RWMutex
+	updateApplicationClearBuildpackCacheArgsForCall []struct {
+		arg1 string
+	}
+	updateApplicationClearBuildpackCacheReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	updateApplicationClearBuildpackCacheReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationEnvironmentVariablesStub        func(string, ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
 	updateApplicationEnvironmentVariablesMutex       sync.RWMutex
 	updateApplicationEnvironmentVariablesArgsForCall []struct {
@@ -853,6 +1123,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateRouteDestinationsStub        func(string, []ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	updateRouteDestinationsMutex       sync.RWMutex
+	updateRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.RouteDestination
+	}
+	updateRouteDestinationsReturns struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateRouteDestinationsReturnsOnCall map[int]struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateServicePlanVisibilityStub        func(string, ccv3.ServicePlanVisibility) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)
+	updateServicePlanVisibilityMutex       sync.RWMutex
+	updateServicePlanVisibilityArgsForCall []struct {
+		arg1 string
+		arg2 ccv3.ServicePlanVisibility
+	}
+	updateServicePlanVisibilityReturns struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateServicePlanVisibilityReturnsOnCall map[int]struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceApplyManifestStub        func(string, []byte) (ccv3.JobURL, ccv3.Warnings, error)
 	updateSpaceApplyManifestMutex       sync.RWMutex
 	updateSpaceApplyManifestArgsForCall []struct {
@@ -956,6 +1258,78 @@ type FakeCloudControllerClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizations(arg1 string, arg2 []string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addServicePlanVisibilityOrganizationsMutex.Lock()
+	ret, specificReturn := fake.addServicePlanVisibilityOrganizationsReturnsOnCall[len(fake.addServicePlanVisibilityOrganizationsArgsForCall)]
+	fake.addServicePlanVisibilityOrganizationsArgsForCall = append(fake.addServicePlanVisibilityOrganizationsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddServicePlanVisibilityOrganizations", []interface{}{arg1, arg2Copy})
+	fake.addServicePlanVisibilityOrganizationsMutex.Unlock()
+	if fake.AddServicePlanVisibilityOrganizationsStub != nil {
+		return fake.AddServicePlanVisibilityOrganizationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.addServicePlanVisibilityOrganizationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizationsCallCount() int {
+	fake.addServicePlanVisibilityOrganizationsMutex.RLock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.RUnlock()
+	return len(fake.addServicePlanVisibilityOrganizationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizationsCalls(stub func(string, []string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)) {
+	fake.addServicePlanVisibilityOrganizationsMutex.Lock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.Unlock()
+	fake.AddServicePlanVisibilityOrganizationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizationsArgsForCall(i int) (string, []string) {
+	fake.addServicePlanVisibilityOrganizationsMutex.RLock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.RUnlock()
+	argsForCall := fake.addServicePlanVisibilityOrganizationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizationsReturns(result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.addServicePlanVisibilityOrganizationsMutex.Lock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.Unlock()
+	fake.AddServicePlanVisibilityOrganizationsStub = nil
+	fake.addServicePlanVisibilityOrganizationsReturns = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) AddServicePlanVisibilityOrganizationsReturnsOnCall(i int, result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.addServicePlanVisibilityOrganizationsMutex.Lock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.Unlock()
+	fake.AddServicePlanVisibilityOrganizationsStub = nil
+	if fake.addServicePlanVisibilityOrganizationsReturnsOnCall == nil {
+		fake.addServicePlanVisibilityOrganizationsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServicePlanVisibility
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.addServicePlanVisibilityOrganizationsReturnsOnCall[i] = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) AppSSHEndpoint() string {
 	fake.appSSHEndpointMutex.Lock()
 	ret, specificReturn := fake.appSSHEndpointReturnsOnCall[len(fake.appSSHEndpointArgsForCall)]
@@ -1178,6 +1552,73 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{arg1, arg2})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
+	fake.createApplicationDeploymentMutex.Lock()
+	defer fake.createApplicationDeploymentMutex.Unlock()
+	fake.CreateApplicationDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	argsForCall := fake.createApplicationDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	defer fake.createApplicationDeploymentMutex.Unlock()
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	defer fake.createApplicationDeploymentMutex.Unlock()
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(arg1 string, arg2 ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -2030,25 +2471,89 @@ func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedS
 	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(arg1 string, arg2 []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
-	var arg2Copy []string
-	if arg2 != nil {
-		arg2Copy = make([]string, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.entitleIsolationSegmentToOrganizationsMutex.Lock()
-	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationsReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationsArgsForCall)]
-	fake.entitleIsolationSegmentToOrganizationsArgsForCall = append(fake.entitleIsolationSegmentToOrganizationsArgsForCall, struct {
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganization(arg1 string, arg2 string) (ccv3.Warnings, error) {
+	fake.deleteServicePlanVisibilityOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteServicePlanVisibilityOrganizationReturnsOnCall[len(fake.deleteServicePlanVisibilityOrganizationArgsForCall)]
+	fake.deleteServicePlanVisibilityOrganizationArgsForCall = append(fake.deleteServicePlanVisibilityOrganizationArgsForCall, struct {
 		arg1 string
-		arg2 []string
-	}{arg1, arg2Copy})
-	fake.recordInvocation("EntitleIsolationSegmentToOrganizations", []interface{}{arg1, arg2Copy})
-	fake.entitleIsolationSegmentToOrganizationsMutex.Unlock()
-	if fake.EntitleIsolationSegmentToOrganizationsStub != nil {
-		return fake.EntitleIsolationSegmentToOrganizationsStub(arg1, arg2)
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteServicePlanVisibilityOrganization", []interface{}{arg1, arg2})
+	fake.deleteServicePlanVisibilityOrganizationMutex.Unlock()
+	if fake.DeleteServicePlanVisibilityOrganizationStub != nil {
+		return fake.DeleteServicePlanVisibilityOrganizationStub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteServicePlanVisibilityOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganizationCallCount() int {
+	fake.deleteServicePlanVisibilityOrganizationMutex.RLock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.RUnlock()
+	return len(fake.deleteServicePlanVisibilityOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganizationCalls(stub func(string, string) (ccv3.Warnings, error)) {
+	fake.deleteServicePlanVisibilityOrganizationMutex.Lock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.Unlock()
+	fake.DeleteServicePlanVisibilityOrganizationStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganizationArgsForCall(i int) (string, string) {
+	fake.deleteServicePlanVisibilityOrganizationMutex.RLock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.RUnlock()
+	argsForCall := fake.deleteServicePlanVisibilityOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganizationReturns(result1 ccv3.Warnings, result2 error) {
+	fake.deleteServicePlanVisibilityOrganizationMutex.Lock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.Unlock()
+	fake.DeleteServicePlanVisibilityOrganizationStub = nil
+	fake.deleteServicePlanVisibilityOrganizationReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityOrganizationReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.deleteServicePlanVisibilityOrganizationMutex.Lock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.Unlock()
+	fake.DeleteServicePlanVisibilityOrganizationStub = nil
+	if fake.deleteServicePlanVisibilityOrganizationReturnsOnCall == nil {
+		fake.deleteServicePlanVisibilityOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServicePlanVisibilityOrganizationReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(arg1 string, arg2 []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.entitleIsolationSegmentToOrganizationsMutex.Lock()
+	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationsReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationsArgsForCall)]
+	fake.entitleIsolationSegmentToOrganizationsArgsForCall = append(fake.entitleIsolationSegmentToOrganizationsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("EntitleIsolationSegmentToOrganizations", []interface{}{arg1, arg2Copy})
+	fake.entitleIsolationSegmentToOrganizationsMutex.Unlock()
+	if fake.EntitleIsolationSegmentToOrganizationsStub != nil {
+		return fake.EntitleIsolationSegmentToOrganizationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
 	}
 	fakeReturns := fake.entitleIsolationSegmentToOrganizationsReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
@@ -2433,6 +2938,73 @@ func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationRevisions(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsReturnsOnCall[len(fake.getApplicationRevisionsArgsForCall)]
+	fake.getApplicationRevisionsArgsForCall = append(fake.getApplicationRevisionsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationRevisions", []interface{}{arg1, arg2})
+	fake.getApplicationRevisionsMutex.Unlock()
+	if fake.GetApplicationRevisionsStub != nil {
+		return fake.GetApplicationRevisionsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRevisionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCallCount() int {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return len(fake.getApplicationRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCalls(stub func(string, ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error)) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	argsForCall := fake.getApplicationRevisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = nil
+	fake.getApplicationRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = nil
+	if fake.getApplicationRevisionsReturnsOnCall == nil {
+		fake.getApplicationRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -2698,6 +3270,138 @@ func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(arg1 string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDeployment", []interface{}{arg1})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCalls(stub func(string) (ccv3.Deployment, ccv3.Warnings, error)) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	argsForCall := fake.getDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomains(arg1 ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error) {
+	fake.getDomainsMutex.Lock()
+	ret, specificReturn := fake.getDomainsReturnsOnCall[len(fake.getDomainsArgsForCall)]
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetDomains", []interface{}{arg1})
+	fake.getDomainsMutex.Unlock()
+	if fake.GetDomainsStub != nil {
+		return fake.GetDomainsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDomainsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCalls(stub func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsArgsForCall(i int) []ccv3.Query {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	argsForCall := fake.getDomainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturns(result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturnsOnCall(i int, result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	if fake.getDomainsReturnsOnCall == nil {
+		fake.getDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainsReturnsOnCall[i] = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(arg1 string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -2830,6 +3534,72 @@ func (fake *FakeCloudControllerClient) GetDropletsReturnsOnCall(i int, result1 [
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURL(arg1 string) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getEnvironmentVariablesByURLMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentVariablesByURLReturnsOnCall[len(fake.getEnvironmentVariablesByURLArgsForCall)]
+	fake.getEnvironmentVariablesByURLArgsForCall = append(fake.getEnvironmentVariablesByURLArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetEnvironmentVariablesByURL", []interface{}{arg1})
+	fake.getEnvironmentVariablesByURLMutex.Unlock()
+	if fake.GetEnvironmentVariablesByURLStub != nil {
+		return fake.GetEnvironmentVariablesByURLStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEnvironmentVariablesByURLReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURLCallCount() int {
+	fake.getEnvironmentVariablesByURLMutex.RLock()
+	defer fake.getEnvironmentVariablesByURLMutex.RUnlock()
+	return len(fake.getEnvironmentVariablesByURLArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURLCalls(stub func(string) (ccv3.EnvironmentVariables, ccv3.Warnings, error)) {
+	fake.getEnvironmentVariablesByURLMutex.Lock()
+	defer fake.getEnvironmentVariablesByURLMutex.Unlock()
+	fake.GetEnvironmentVariablesByURLStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURLArgsForCall(i int) string {
+	fake.getEnvironmentVariablesByURLMutex.RLock()
+	defer fake.getEnvironmentVariablesByURLMutex.RUnlock()
+	argsForCall := fake.getEnvironmentVariablesByURLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURLReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByURLMutex.Lock()
+	defer fake.getEnvironmentVariablesByURLMutex.Unlock()
+	fake.GetEnvironmentVariablesByURLStub = nil
+	fake.getEnvironmentVariablesByURLReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEnvironmentVariablesByURLReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByURLMutex.Lock()
+	defer fake.getEnvironmentVariablesByURLMutex.Unlock()
+	fake.GetEnvironmentVariablesByURLStub = nil
+	if fake.getEnvironmentVariablesByURLReturnsOnCall == nil {
+		fake.getEnvironmentVariablesByURLReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariablesByURLReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetFeatureFlag(arg1 string) (ccv3.FeatureFlag, ccv3.Warnings, error) {
 	fake.getFeatureFlagMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagReturnsOnCall[len(fake.getFeatureFlagArgsForCall)]
@@ -3218,6 +3988,138 @@ func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(arg1 ...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{arg1})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCalls(stub func(...ccv3.Query) ([]ccv3.OrganizationQuota, ccv3.Warnings, error)) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv3.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	argsForCall := fake.getOrganizationQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv3.OrganizationQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationQuotasMutex.Lock()
+	defer fake.getOrganizationQuotasMutex.Unlock()
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.OrganizationQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv3.OrganizationQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummary(arg1 string) (ccv3.UsageSummary, ccv3.Warnings, error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsageSummaryReturnsOnCall[len(fake.getOrganizationUsageSummaryArgsForCall)]
+	fake.getOrganizationUsageSummaryArgsForCall = append(fake.getOrganizationUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationUsageSummary", []interface{}{arg1})
+	fake.getOrganizationUsageSummaryMutex.Unlock()
+	if fake.GetOrganizationUsageSummaryStub != nil {
+		return fake.GetOrganizationUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCallCount() int {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	return len(fake.getOrganizationUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCalls(stub func(string) (ccv3.UsageSummary, ccv3.Warnings, error)) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryArgsForCall(i int) string {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getOrganizationUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturns(result1 ccv3.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	fake.getOrganizationUsageSummaryReturns = struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturnsOnCall(i int, result1 ccv3.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	if fake.getOrganizationUsageSummaryReturnsOnCall == nil {
+		fake.getOrganizationUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 ccv3.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsageSummaryReturnsOnCall[i] = struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -3482,47 +4384,245 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstances(arg1 ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
-	fake.getServiceInstancesMutex.Lock()
-	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
-	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
-		arg1 []ccv3.Query
+func (fake *FakeCloudControllerClient) GetRouteDestinations(arg1 string) ([]ccv3.RouteDestination, ccv3.Warnings, error) {
+	fake.getRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsReturnsOnCall[len(fake.getRouteDestinationsArgsForCall)]
+	fake.getRouteDestinationsArgsForCall = append(fake.getRouteDestinationsArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	fake.recordInvocation("GetServiceInstances", []interface{}{arg1})
-	fake.getServiceInstancesMutex.Unlock()
-	if fake.GetServiceInstancesStub != nil {
-		return fake.GetServiceInstancesStub(arg1...)
+	fake.recordInvocation("GetRouteDestinations", []interface{}{arg1})
+	fake.getRouteDestinationsMutex.Unlock()
+	if fake.GetRouteDestinationsStub != nil {
+		return fake.GetRouteDestinationsStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.getServiceInstancesReturns
+	fakeReturns := fake.getRouteDestinationsReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return len(fake.getServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) GetRouteDestinationsCallCount() int {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	return len(fake.getRouteDestinationsArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesCalls(stub func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)) {
-	fake.getServiceInstancesMutex.Lock()
-	defer fake.getServiceInstancesMutex.Unlock()
-	fake.GetServiceInstancesStub = stub
+func (fake *FakeCloudControllerClient) GetRouteDestinationsCalls(stub func(string) ([]ccv3.RouteDestination, ccv3.Warnings, error)) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = stub
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv3.Query {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	argsForCall := fake.getServiceInstancesArgsForCall[i]
+func (fake *FakeCloudControllerClient) GetRouteDestinationsArgsForCall(i int) string {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.getRouteDestinationsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
-	fake.getServiceInstancesMutex.Lock()
-	defer fake.getServiceInstancesMutex.Unlock()
-	fake.GetServiceInstancesStub = nil
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturns(result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = nil
+	fake.getRouteDestinationsReturns = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturnsOnCall(i int, result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = nil
+	if fake.getRouteDestinationsReturnsOnCall == nil {
+		fake.getRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.RouteDestination
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsReturnsOnCall[i] = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutes(arg1 ...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error) {
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetRoutes", []interface{}{arg1})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCalls(stub func(...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error)) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv3.Query {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	argsForCall := fake.getRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokers(arg1 ...ccv3.Query) ([]ccv3.ServiceBroker, ccv3.Warnings, error) {
+	fake.getServiceBrokersMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokersReturnsOnCall[len(fake.getServiceBrokersArgsForCall)]
+	fake.getServiceBrokersArgsForCall = append(fake.getServiceBrokersArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceBrokers", []interface{}{arg1})
+	fake.getServiceBrokersMutex.Unlock()
+	if fake.GetServiceBrokersStub != nil {
+		return fake.GetServiceBrokersStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceBrokersReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersCallCount() int {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	return len(fake.getServiceBrokersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersCalls(stub func(...ccv3.Query) ([]ccv3.ServiceBroker, ccv3.Warnings, error)) {
+	fake.getServiceBrokersMutex.Lock()
+	defer fake.getServiceBrokersMutex.Unlock()
+	fake.GetServiceBrokersStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersArgsForCall(i int) []ccv3.Query {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	argsForCall := fake.getServiceBrokersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturns(result1 []ccv3.ServiceBroker, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceBrokersMutex.Lock()
+	defer fake.getServiceBrokersMutex.Unlock()
+	fake.GetServiceBrokersStub = nil
+	fake.getServiceBrokersReturns = struct {
+		result1 []ccv3.ServiceBroker
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBrokersReturnsOnCall(i int, result1 []ccv3.ServiceBroker, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceBrokersMutex.Lock()
+	defer fake.getServiceBrokersMutex.Unlock()
+	fake.GetServiceBrokersStub = nil
+	if fake.getServiceBrokersReturnsOnCall == nil {
+		fake.getServiceBrokersReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceBroker
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokersReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceBroker
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstances(arg1 ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
+	fake.getServiceInstancesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
+	fake.getServiceInstancesArgsForCall = append(fake.getServiceInstancesArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceInstances", []interface{}{arg1})
+	fake.getServiceInstancesMutex.Unlock()
+	if fake.GetServiceInstancesStub != nil {
+		return fake.GetServiceInstancesStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceInstancesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCalls(stub func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)) {
+	fake.getServiceInstancesMutex.Lock()
+	defer fake.getServiceInstancesMutex.Unlock()
+	fake.GetServiceInstancesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv3.Query {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	argsForCall := fake.getServiceInstancesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv3.ServiceInstance, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstancesMutex.Lock()
+	defer fake.getServiceInstancesMutex.Unlock()
+	fake.GetServiceInstancesStub = nil
 	fake.getServiceInstancesReturns = struct {
 		result1 []ccv3.ServiceInstance
 		result2 ccv3.Warnings
@@ -3548,6 +4648,204 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceOfferings(arg1 ...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error) {
+	fake.getServiceOfferingsMutex.Lock()
+	ret, specificReturn := fake.getServiceOfferingsReturnsOnCall[len(fake.getServiceOfferingsArgsForCall)]
+	fake.getServiceOfferingsArgsForCall = append(fake.getServiceOfferingsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceOfferings", []interface{}{arg1})
+	fake.getServiceOfferingsMutex.Unlock()
+	if fake.GetServiceOfferingsStub != nil {
+		return fake.GetServiceOfferingsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceOfferingsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsCallCount() int {
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	return len(fake.getServiceOfferingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsCalls(stub func(...ccv3.Query) ([]ccv3.ServiceOffering, ccv3.Warnings, error)) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsArgsForCall(i int) []ccv3.Query {
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	argsForCall := fake.getServiceOfferingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsReturns(result1 []ccv3.ServiceOffering, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = nil
+	fake.getServiceOfferingsReturns = struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceOfferingsReturnsOnCall(i int, result1 []ccv3.ServiceOffering, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceOfferingsMutex.Lock()
+	defer fake.getServiceOfferingsMutex.Unlock()
+	fake.GetServiceOfferingsStub = nil
+	if fake.getServiceOfferingsReturnsOnCall == nil {
+		fake.getServiceOfferingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceOffering
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceOfferingsReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceOffering
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibility(arg1 string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error) {
+	fake.getServicePlanVisibilityMutex.Lock()
+	ret, specificReturn := fake.getServicePlanVisibilityReturnsOnCall[len(fake.getServicePlanVisibilityArgsForCall)]
+	fake.getServicePlanVisibilityArgsForCall = append(fake.getServicePlanVisibilityArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServicePlanVisibility", []interface{}{arg1})
+	fake.getServicePlanVisibilityMutex.Unlock()
+	if fake.GetServicePlanVisibilityStub != nil {
+		return fake.GetServicePlanVisibilityStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServicePlanVisibilityReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilityCallCount() int {
+	fake.getServicePlanVisibilityMutex.RLock()
+	defer fake.getServicePlanVisibilityMutex.RUnlock()
+	return len(fake.getServicePlanVisibilityArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilityCalls(stub func(string) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)) {
+	fake.getServicePlanVisibilityMutex.Lock()
+	defer fake.getServicePlanVisibilityMutex.Unlock()
+	fake.GetServicePlanVisibilityStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilityArgsForCall(i int) string {
+	fake.getServicePlanVisibilityMutex.RLock()
+	defer fake.getServicePlanVisibilityMutex.RUnlock()
+	argsForCall := fake.getServicePlanVisibilityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilityReturns(result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlanVisibilityMutex.Lock()
+	defer fake.getServicePlanVisibilityMutex.Unlock()
+	fake.GetServicePlanVisibilityStub = nil
+	fake.getServicePlanVisibilityReturns = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlanVisibilityReturnsOnCall(i int, result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlanVisibilityMutex.Lock()
+	defer fake.getServicePlanVisibilityMutex.Unlock()
+	fake.GetServicePlanVisibilityStub = nil
+	if fake.getServicePlanVisibilityReturnsOnCall == nil {
+		fake.getServicePlanVisibilityReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServicePlanVisibility
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanVisibilityReturnsOnCall[i] = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlans(arg1 ...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error) {
+	fake.getServicePlansMutex.Lock()
+	ret, specificReturn := fake.getServicePlansReturnsOnCall[len(fake.getServicePlansArgsForCall)]
+	fake.getServicePlansArgsForCall = append(fake.getServicePlansArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServicePlans", []interface{}{arg1})
+	fake.getServicePlansMutex.Unlock()
+	if fake.GetServicePlansStub != nil {
+		return fake.GetServicePlansStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServicePlansReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCallCount() int {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	return len(fake.getServicePlansArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansCalls(stub func(...ccv3.Query) ([]ccv3.ServicePlan, ccv3.Warnings, error)) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansArgsForCall(i int) []ccv3.Query {
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
+	argsForCall := fake.getServicePlansArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturns(result1 []ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = nil
+	fake.getServicePlansReturns = struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlansReturnsOnCall(i int, result1 []ccv3.ServicePlan, result2 ccv3.Warnings, result3 error) {
+	fake.getServicePlansMutex.Lock()
+	defer fake.getServicePlansMutex.Unlock()
+	fake.GetServicePlansStub = nil
+	if fake.getServicePlansReturnsOnCall == nil {
+		fake.getServicePlansReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServicePlan
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlansReturnsOnCall[i] = struct {
+		result1 []ccv3.ServicePlan
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(arg1 string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.getSpaceIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
@@ -3614,6 +4912,138 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceQuotas(arg1 ...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error) {
+	fake.getSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotasReturnsOnCall[len(fake.getSpaceQuotasArgsForCall)]
+	fake.getSpaceQuotasArgsForCall = append(fake.getSpaceQuotasArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetSpaceQuotas", []interface{}{arg1})
+	fake.getSpaceQuotasMutex.Unlock()
+	if fake.GetSpaceQuotasStub != nil {
+		return fake.GetSpaceQuotasStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCallCount() int {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	return len(fake.getSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasCalls(stub func(...ccv3.Query) ([]ccv3.SpaceQuota, ccv3.Warnings, error)) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasArgsForCall(i int) []ccv3.Query {
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	argsForCall := fake.getSpaceQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturns(result1 []ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = nil
+	fake.getSpaceQuotasReturns = struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result1 []ccv3.SpaceQuota, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceQuotasMutex.Lock()
+	defer fake.getSpaceQuotasMutex.Unlock()
+	fake.GetSpaceQuotasStub = nil
+	if fake.getSpaceQuotasReturnsOnCall == nil {
+		fake.getSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.SpaceQuota
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []ccv3.SpaceQuota
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummary(arg1 string) (ccv3.UsageSummary, ccv3.Warnings, error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsageSummaryReturnsOnCall[len(fake.getSpaceUsageSummaryArgsForCall)]
+	fake.getSpaceUsageSummaryArgsForCall = append(fake.getSpaceUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpaceUsageSummary", []interface{}{arg1})
+	fake.getSpaceUsageSummaryMutex.Unlock()
+	if fake.GetSpaceUsageSummaryStub != nil {
+		return fake.GetSpaceUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCallCount() int {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	return len(fake.getSpaceUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCalls(stub func(string) (ccv3.UsageSummary, ccv3.Warnings, error)) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryArgsForCall(i int) string {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getSpaceUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturns(result1 ccv3.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	fake.getSpaceUsageSummaryReturns = struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturnsOnCall(i int, result1 ccv3.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	if fake.getSpaceUsageSummaryReturnsOnCall == nil {
+		fake.getSpaceUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 ccv3.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsageSummaryReturnsOnCall[i] = struct {
+		result1 ccv3.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv3.Query) ([]ccv3.Space, ccv3.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -4157,6 +5587,69 @@ func (fake *FakeCloudControllerClient) UpdateApplicationApplyManifestReturnsOnCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCache(arg1 string) (ccv3.Warnings, error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	ret, specificReturn := fake.updateApplicationClearBuildpackCacheReturnsOnCall[len(fake.updateApplicationClearBuildpackCacheArgsForCall)]
+	fake.updateApplicationClearBuildpackCacheArgsForCall = append(fake.updateApplicationClearBuildpackCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UpdateApplicationClearBuildpackCache", []interface{}{arg1})
+	fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	if fake.UpdateApplicationClearBuildpackCacheStub != nil {
+		return fake.UpdateApplicationClearBuildpackCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateApplicationClearBuildpackCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheCallCount() int {
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
+	return len(fake.updateApplicationClearBuildpackCacheArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheArgsForCall(i int) string {
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
+	argsForCall := fake.updateApplicationClearBuildpackCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheReturns(result1 ccv3.Warnings, result2 error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = nil
+	fake.updateApplicationClearBuildpackCacheReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationClearBuildpackCacheReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.updateApplicationClearBuildpackCacheMutex.Lock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.Unlock()
+	fake.UpdateApplicationClearBuildpackCacheStub = nil
+	if fake.updateApplicationClearBuildpackCacheReturnsOnCall == nil {
+		fake.updateApplicationClearBuildpackCacheReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationClearBuildpackCacheReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplicationEnvironmentVariables(arg1 string, arg2 ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
 	fake.updateApplicationEnvironmentVariablesMutex.Lock()
 	ret, specificReturn := fake.updateApplicationEnvironmentVariablesReturnsOnCall[len(fake.updateApplicationEnvironmentVariablesArgsForCall)]
@@ -4687,6 +6180,145 @@ func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateRouteDestinations(arg1 string, arg2 []ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error) {
+	var arg2Copy []ccv3.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]ccv3.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.updateRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteDestinationsReturnsOnCall[len(fake.updateRouteDestinationsArgsForCall)]
+	fake.updateRouteDestinationsArgsForCall = append(fake.updateRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UpdateRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.updateRouteDestinationsMutex.Unlock()
+	if fake.UpdateRouteDestinationsStub != nil {
+		return fake.UpdateRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsCallCount() int {
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
+	return len(fake.updateRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsCalls(stub func(string, []ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error)) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsArgsForCall(i int) (string, []ccv3.RouteDestination) {
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.updateRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsReturns(result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = nil
+	fake.updateRouteDestinationsReturns = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteDestinationsReturnsOnCall(i int, result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.updateRouteDestinationsMutex.Lock()
+	defer fake.updateRouteDestinationsMutex.Unlock()
+	fake.UpdateRouteDestinationsStub = nil
+	if fake.updateRouteDestinationsReturnsOnCall == nil {
+		fake.updateRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.RouteDestination
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouteDestinationsReturnsOnCall[i] = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibility(arg1 string, arg2 ccv3.ServicePlanVisibility) (ccv3.ServicePlanVisibility, ccv3.Warnings, error) {
+	fake.updateServicePlanVisibilityMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanVisibilityReturnsOnCall[len(fake.updateServicePlanVisibilityArgsForCall)]
+	fake.updateServicePlanVisibilityArgsForCall = append(fake.updateServicePlanVisibilityArgsForCall, struct {
+		arg1 string
+		arg2 ccv3.ServicePlanVisibility
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateServicePlanVisibility", []interface{}{arg1, arg2})
+	fake.updateServicePlanVisibilityMutex.Unlock()
+	if fake.UpdateServicePlanVisibilityStub != nil {
+		return fake.UpdateServicePlanVisibilityStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateServicePlanVisibilityReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityCallCount() int {
+	fake.updateServicePlanVisibilityMutex.RLock()
+	defer fake.updateServicePlanVisibilityMutex.RUnlock()
+	return len(fake.updateServicePlanVisibilityArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityCalls(stub func(string, ccv3.ServicePlanVisibility) (ccv3.ServicePlanVisibility, ccv3.Warnings, error)) {
+	fake.updateServicePlanVisibilityMutex.Lock()
+	defer fake.updateServicePlanVisibilityMutex.Unlock()
+	fake.UpdateServicePlanVisibilityStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityArgsForCall(i int) (string, ccv3.ServicePlanVisibility) {
+	fake.updateServicePlanVisibilityMutex.RLock()
+	defer fake.updateServicePlanVisibilityMutex.RUnlock()
+	argsForCall := fake.updateServicePlanVisibilityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturns(result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.updateServicePlanVisibilityMutex.Lock()
+	defer fake.updateServicePlanVisibilityMutex.Unlock()
+	fake.UpdateServicePlanVisibilityStub = nil
+	fake.updateServicePlanVisibilityReturns = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturnsOnCall(i int, result1 ccv3.ServicePlanVisibility, result2 ccv3.Warnings, result3 error) {
+	fake.updateServicePlanVisibilityMutex.Lock()
+	defer fake.updateServicePlanVisibilityMutex.Unlock()
+	fake.UpdateServicePlanVisibilityStub = nil
+	if fake.updateServicePlanVisibilityReturnsOnCall == nil {
+		fake.updateServicePlanVisibilityReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ServicePlanVisibility
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateServicePlanVisibilityReturnsOnCall[i] = struct {
+		result1 ccv3.ServicePlanVisibility
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifest(arg1 string, arg2 []byte) (ccv3.JobURL, ccv3.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -5105,6 +6737,8 @@ func (fake *FakeCloudControllerClient) UploadPackageReturnsOnCall(i int, result1
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addServicePlanVisibilityOrganizationsMutex.RLock()
+	defer fake.addServicePlanVisibilityOrganizationsMutex.RUnlock()
 	fake.appSSHEndpointMutex.RLock()
 	defer fake.appSSHEndpointMutex.RUnlock()
 	fake.appSSHHostKeyFingerprintMutex.RLock()
@@ -5113,6 +6747,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -5139,6 +6775,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteIsolationSegmentOrganizationMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
+	fake.deleteServicePlanVisibilityOrganizationMutex.RLock()
+	defer fake.deleteServicePlanVisibilityOrganizationMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
@@ -5151,6 +6789,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationProcessByTypeMutex.RUnlock()
 	fake.getApplicationProcessesMutex.RLock()
 	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
@@ -5159,10 +6799,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getBuildMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
 	defer fake.getDropletsMutex.RUnlock()
+	fake.getEnvironmentVariablesByURLMutex.RLock()
+	defer fake.getEnvironmentVariablesByURLMutex.RUnlock()
 	fake.getFeatureFlagMutex.RLock()
 	defer fake.getFeatureFlagMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
@@ -5175,6 +6821,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getIsolationSegmentsMutex.RUnlock()
 	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
@@ -5183,10 +6833,26 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackagesMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceOfferingsMutex.RLock()
+	defer fake.getServiceOfferingsMutex.RUnlock()
+	fake.getServicePlanVisibilityMutex.RLock()
+	defer fake.getServicePlanVisibilityMutex.RUnlock()
+	fake.getServicePlansMutex.RLock()
+	defer fake.getServicePlansMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getSpaceQuotasMutex.RLock()
+	defer fake.getSpaceQuotasMutex.RUnlock()
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStacksMutex.RLock()
@@ -5203,6 +6869,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationApplyManifestMutex.RLock()
 	defer fake.updateApplicationApplyManifestMutex.RUnlock()
+	fake.updateApplicationClearBuildpackCacheMutex.RLock()
+	defer fake.updateApplicationClearBuildpackCacheMutex.RUnlock()
 	fake.updateApplicationEnvironmentVariablesMutex.RLock()
 	defer fake.updateApplicationEnvironmentVariablesMutex.RUnlock()
 	fake.updateApplicationRestartMutex.RLock()
@@ -5219,6 +6887,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RUnlock()
 	fake.updateProcessMutex.RLock()
 	defer fake.updateProcessMutex.RUnlock()
+	fake.updateRouteDestinationsMutex.RLock()
+	defer fake.updateRouteDestinationsMutex.RUnlock()
+	fake.updateServicePlanVisibilityMutex.RLock()
+	defer fake.updateServicePlanVisibilityMutex.RUnlock()
 	fake.updateSpaceApplyManifestMutex.RLock()
 	defer fake.updateSpaceApplyManifestMutex.RUnlock()
 	fake.updateSpaceIsolationSegmentRelationshipMutex.RLock()
@@ -22,6 +22,11 @@ type Actor struct {
 	V7Actor       V7Actor
 	PushPlanFuncs []UpdatePushPlanFunc
 
+	// CheckpointDirectory is where the completed phases of each push are
+	// recorded so a failed push can be resumed. Checkpoints are not recorded
+	// when it is empty.
+	CheckpointDirectory string
+
 	startWithProtocol *regexp.Regexp
 	urlValidator      *regexp.Regexp
 }
@@ -46,6 +51,8 @@ func NewActor(v2Actor V2Actor, v3Actor V7Actor, sharedActor SharedActor) *Actor
 		SetupBitsPathForPushPlan,
 		actor.SetupAllResourcesForPushPlan,
 		SetupNoStartForPushPlan,
+		SetupNoBuildCacheForPushPlan,
+		SetupResumeForPushPlan,
 		SetupSkipRouteCreationForPushPlan,
 		SetupScaleWebProcessForPushPlan,
 		SetupUpdateWebProcessForPushPlan,
@@ -62,23 +62,37 @@ func (actor Actor) Actualize(plan PushPlan, progressBar ProgressBar) (
 			return
 		}
 
-		pkg, err := actor.CreatePackage(plan, progressBar, warningsStream, eventStream)
+		checkpoint := actor.loadPushCheckpoint(plan)
+
+		polledPackage, err := actor.resumePackage(checkpoint, warningsStream, eventStream)
 		if err != nil {
-			errorStream <- err
-			return
+			log.WithField("GUID", checkpoint.PackageGUID).Warnln("not resuming from package:", err)
+			checkpoint = PushCheckpoint{}
 		}
 
-		polledPackage, warnings, err := actor.V7Actor.PollPackage(pkg)
-		warningsStream <- Warnings(warnings)
-		if err != nil {
-			errorStream <- err
-			return
+		if polledPackage.GUID == "" {
+			pkg, err := actor.CreatePackage(plan, progressBar, warningsStream, eventStream)
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
+			var warnings v7action.Warnings
+			polledPackage, warnings, err = actor.V7Actor.PollPackage(pkg)
+			warningsStream <- Warnings(warnings)
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
+			checkpoint = PushCheckpoint{PackageGUID: polledPackage.GUID}
+			actor.savePushCheckpoint(plan, checkpoint)
 		}
 
 		if plan.NoStart {
 			if plan.Application.State == constant.ApplicationStarted {
 				eventStream <- StoppingApplication
-				warnings, err = actor.V7Actor.StopApplication(plan.Application.GUID)
+				warnings, err := actor.V7Actor.StopApplication(plan.Application.GUID)
 				warningsStream <- Warnings(warnings)
 				if err != nil {
 					errorStream <- err
@@ -89,28 +103,15 @@ func (actor Actor) Actualize(plan PushPlan, progressBar ProgressBar) (
 			return
 		}
 
-		eventStream <- StartingStaging
-
-		build, warnings, err := actor.V7Actor.StageApplicationPackage(polledPackage.GUID)
-		warningsStream <- Warnings(warnings)
+		droplet, err := actor.stagePackage(plan, polledPackage, checkpoint, warningsStream, eventStream)
 		if err != nil {
 			errorStream <- err
 			return
 		}
 
-		eventStream <- PollingBuild
-
-		droplet, warnings, err := actor.V7Actor.PollBuild(build.GUID, plan.Application.Name)
-		warningsStream <- Warnings(warnings)
-		if err != nil {
-			errorStream <- err
-			return
-		}
-
-		eventStream <- StagingComplete
 		eventStream <- SettingDroplet
 
-		warnings, err = actor.V7Actor.SetApplicationDroplet(plan.Application.GUID, droplet.GUID)
+		warnings, err := actor.V7Actor.SetApplicationDroplet(plan.Application.GUID, droplet.GUID)
 		warningsStream <- Warnings(warnings)
 		if err != nil {
 			errorStream <- err
@@ -125,6 +126,78 @@ func (actor Actor) Actualize(plan PushPlan, progressBar ProgressBar) (
 	return planStream, eventStream, warningsStream, errorStream
 }
 
+// resumePackage returns the package recorded by the checkpoint once it has
+// finished processing. An empty package is returned when there is nothing to
+// resume from.
+func (actor Actor) resumePackage(checkpoint PushCheckpoint, warningsStream chan Warnings, eventStream chan Event) (v7action.Package, error) {
+	if checkpoint.PackageGUID == "" {
+		return v7action.Package{}, nil
+	}
+
+	eventStream <- ResumingFromPackage
+	pkg, warnings, err := actor.V7Actor.PollPackage(v7action.Package{GUID: checkpoint.PackageGUID})
+	warningsStream <- Warnings(warnings)
+	return pkg, err
+}
+
+// stagePackage stages the package and waits for the resulting droplet,
+// reusing the build or droplet recorded by the checkpoint when possible.
+func (actor Actor) stagePackage(plan PushPlan, pkg v7action.Package, checkpoint PushCheckpoint, warningsStream chan Warnings, eventStream chan Event) (v7action.Droplet, error) {
+	if checkpoint.DropletGUID != "" {
+		eventStream <- ResumingFromDroplet
+		return v7action.Droplet{GUID: checkpoint.DropletGUID}, nil
+	}
+
+	eventStream <- StartingStaging
+
+	if checkpoint.BuildGUID != "" {
+		eventStream <- ResumingFromBuild
+		droplet, warnings, err := actor.V7Actor.PollBuild(checkpoint.BuildGUID, plan.Application.Name)
+		warningsStream <- Warnings(warnings)
+		if err == nil {
+			eventStream <- StagingComplete
+			checkpoint.DropletGUID = droplet.GUID
+			actor.savePushCheckpoint(plan, checkpoint)
+			return droplet, nil
+		}
+		log.WithField("GUID", checkpoint.BuildGUID).Warnln("not resuming from build:", err)
+	}
+
+	if plan.NoBuildCache && plan.Application.LifecycleType != constant.AppLifecycleTypeDocker {
+		eventStream <- ClearingBuildCache
+		warnings, err := actor.V7Actor.ClearApplicationBuildCache(plan.Application.GUID)
+		warningsStream <- Warnings(warnings)
+		if err != nil {
+			return v7action.Droplet{}, err
+		}
+		eventStream <- ClearingBuildCacheComplete
+	}
+
+	build, warnings, err := actor.V7Actor.StageApplicationPackage(pkg.GUID)
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		return v7action.Droplet{}, err
+	}
+
+	checkpoint.BuildGUID = build.GUID
+	actor.savePushCheckpoint(plan, checkpoint)
+
+	eventStream <- PollingBuild
+
+	droplet, warnings, err := actor.V7Actor.PollBuild(build.GUID, plan.Application.Name)
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		return v7action.Droplet{}, err
+	}
+
+	eventStream <- StagingComplete
+
+	checkpoint.DropletGUID = droplet.GUID
+	actor.savePushCheckpoint(plan, checkpoint)
+
+	return droplet, nil
+}
+
 func (actor Actor) CreateAndUploadApplicationBits(plan PushPlan, progressBar ProgressBar, warningsStream chan Warnings, eventStream chan Event) (v7action.Package, error) {
 	log.WithField("Path", plan.BitsPath).Info("creating archive")
 	var v7warnings v7action.Warnings
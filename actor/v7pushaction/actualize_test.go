@@ -3,6 +3,9 @@ package v7pushaction_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -889,6 +892,53 @@ var _ = Describe("Actualize", func() {
 				Eventually(errorStream).Should(Receive(MatchError("ahhh, i failed")))
 			})
 		})
+
+		When("the build cache should not be used", func() {
+			BeforeEach(func() {
+				plan.NoBuildCache = true
+			})
+
+			When("clearing the build cache is successful", func() {
+				BeforeEach(func() {
+					fakeV7Actor.ClearApplicationBuildCacheReturns(v7action.Warnings{"some-clear-cache-warning"}, nil)
+				})
+
+				It("clears the build cache of the app before staging", func() {
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ClearingBuildCache))
+					Eventually(warningsStream).Should(Receive(ConsistOf("some-clear-cache-warning")))
+					Eventually(eventStream).Should(Receive(Equal(ClearingBuildCacheComplete)))
+					Eventually(fakeV7Actor.StageApplicationPackageCallCount).Should(Equal(1))
+
+					Expect(fakeV7Actor.ClearApplicationBuildCacheCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.ClearApplicationBuildCacheArgsForCall(0)).To(Equal("some-app-guid"))
+				})
+			})
+
+			When("clearing the build cache errors", func() {
+				BeforeEach(func() {
+					fakeV7Actor.ClearApplicationBuildCacheReturns(v7action.Warnings{"some-clear-cache-warning"}, errors.New("cache is stuck"))
+				})
+
+				It("returns errors and warnings without staging", func() {
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ClearingBuildCache))
+					Eventually(warningsStream).Should(Receive(ConsistOf("some-clear-cache-warning")))
+					Eventually(errorStream).Should(Receive(MatchError("cache is stuck")))
+					Expect(fakeV7Actor.StageApplicationPackageCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the app is a docker app", func() {
+				BeforeEach(func() {
+					plan.Application.LifecycleType = constant.AppLifecycleTypeDocker
+				})
+
+				It("stages without clearing the build cache", func() {
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(StartingStaging))
+					Eventually(fakeV7Actor.StageApplicationPackageCallCount).Should(Equal(1))
+					Expect(fakeV7Actor.ClearApplicationBuildCacheCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("no start", func() {
@@ -1006,6 +1056,160 @@ var _ = Describe("Actualize", func() {
 		})
 	})
 
+	Describe("resuming", func() {
+		var checkpointDir string
+
+		previousPush := func() {
+			previousPlanStream, previousEventStream, previousWarningsStream, previousErrorStream := actor.Actualize(plan, fakeProgressBar)
+			Eventually(actualizedStreamsDrainedAndClosed(previousPlanStream, previousEventStream, previousWarningsStream, previousErrorStream)).Should(BeTrue())
+		}
+
+		BeforeEach(func() {
+			var err error
+			checkpointDir, err = ioutil.TempDir("", "push-checkpoints")
+			Expect(err).ToNot(HaveOccurred())
+			actor.CheckpointDirectory = checkpointDir
+
+			fakeV7Actor.CreateBitsPackageByApplicationReturns(v7action.Package{GUID: "some-new-package-guid"}, nil, nil)
+			fakeV7Actor.UploadBitsPackageStub = func(pkg v7action.Package, _ []sharedaction.V3Resource, _ io.Reader, _ int64) (v7action.Package, v7action.Warnings, error) {
+				return pkg, nil, nil
+			}
+			fakeV7Actor.PollPackageStub = func(pkg v7action.Package) (v7action.Package, v7action.Warnings, error) {
+				return v7action.Package{GUID: pkg.GUID, State: constant.PackageReady}, nil, nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(checkpointDir)).To(Succeed())
+		})
+
+		When("a previous push uploaded a package but failed to stage it", func() {
+			BeforeEach(func() {
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{}, nil, errors.New("staging failed"))
+				previousPush()
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{GUID: "some-build-guid"}, nil, nil)
+			})
+
+			When("the push is resumed", func() {
+				BeforeEach(func() {
+					plan.Resume = true
+				})
+
+				It("stages the previously uploaded package without uploading it again", func() {
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ResumingFromPackage))
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(StartingStaging))
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+					Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.StageApplicationPackageArgsForCall(1)).To(Equal("some-new-package-guid"))
+				})
+
+				When("the previously uploaded package can no longer be used", func() {
+					BeforeEach(func() {
+						fakeV7Actor.PollPackageStub = func(pkg v7action.Package) (v7action.Package, v7action.Warnings, error) {
+							if pkg.GUID == "some-new-package-guid" {
+								return v7action.Package{}, nil, actionerror.PackageProcessingExpiredError{}
+							}
+							return v7action.Package{GUID: pkg.GUID, State: constant.PackageReady}, nil, nil
+						}
+						fakeV7Actor.CreateBitsPackageByApplicationReturns(v7action.Package{GUID: "some-other-package-guid"}, nil, nil)
+					})
+
+					It("uploads a new package", func() {
+						Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ResumingFromPackage))
+						Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+						Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(2))
+						Expect(fakeV7Actor.StageApplicationPackageArgsForCall(1)).To(Equal("some-other-package-guid"))
+					})
+				})
+			})
+
+			When("the push is not resumed", func() {
+				It("uploads a new package", func() {
+					Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+					Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		When("a previous push started staging but did not finish", func() {
+			BeforeEach(func() {
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{GUID: "some-build-guid"}, nil, nil)
+				fakeV7Actor.PollBuildReturns(v7action.Droplet{}, nil, errors.New("connection lost"))
+				previousPush()
+				fakeV7Actor.PollBuildReturns(v7action.Droplet{GUID: "some-droplet-guid"}, nil, nil)
+				plan.Resume = true
+			})
+
+			It("waits for the previous build instead of staging again", func() {
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ResumingFromBuild))
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(StagingComplete))
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+				Expect(fakeV7Actor.StageApplicationPackageCallCount()).To(Equal(1))
+				buildGUID, _ := fakeV7Actor.PollBuildArgsForCall(1)
+				Expect(buildGUID).To(Equal("some-build-guid"))
+				_, dropletGUID := fakeV7Actor.SetApplicationDropletArgsForCall(0)
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		When("a previous push staged a droplet but failed to set it", func() {
+			BeforeEach(func() {
+				fakeV7Actor.PollBuildReturns(v7action.Droplet{GUID: "some-droplet-guid"}, nil, nil)
+				fakeV7Actor.SetApplicationDropletReturnsOnCall(0, nil, errors.New("the climate is arid"))
+				previousPush()
+				plan.Resume = true
+			})
+
+			It("sets the previously staged droplet without staging again", func() {
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ResumingFromPackage))
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(ResumingFromDroplet))
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(SettingDroplet))
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+				Expect(fakeV7Actor.StageApplicationPackageCallCount()).To(Equal(1))
+				_, dropletGUID := fakeV7Actor.SetApplicationDropletArgsForCall(1)
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		When("the checkpoint of a previous push has been deleted", func() {
+			BeforeEach(func() {
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{}, nil, errors.New("staging failed"))
+				previousPush()
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{GUID: "some-build-guid"}, nil, nil)
+				actor.DeletePushCheckpoint(plan)
+				plan.Resume = true
+			})
+
+			It("starts the push from scratch", func() {
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+				Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the bits being pushed have changed since the previous push", func() {
+			BeforeEach(func() {
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{}, nil, errors.New("staging failed"))
+				previousPush()
+				fakeV7Actor.StageApplicationPackageReturns(v7action.Build{GUID: "some-build-guid"}, nil, nil)
+				plan.AllResources = []sharedaction.V3Resource{buildV3Resource("some-changed-filename")}
+				plan.Resume = true
+			})
+
+			It("starts the push from scratch", func() {
+				Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
+
+				Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(2))
+				Expect(fakeV7Actor.PollPackageCallCount()).To(Equal(2))
+			})
+		})
+	})
+
 	When("all operations are finished", func() {
 		It("returns a complete event", func() {
 			Eventually(getNextEvent(planStream, eventStream, warningsStream)).Should(Equal(Complete))
@@ -0,0 +1,131 @@
+package v7pushaction
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PushCheckpoint records the phases of a push that completed, so that a
+// failed push can be resumed without re-uploading or re-staging the app.
+type PushCheckpoint struct {
+	PackageGUID string `json:"package_guid,omitempty"`
+	BuildGUID   string `json:"build_guid,omitempty"`
+	DropletGUID string `json:"droplet_guid,omitempty"`
+}
+
+// DeletePushCheckpoint removes the checkpoint recorded for the plan. It is
+// called once the push has finished so the next push starts from scratch.
+func (actor Actor) DeletePushCheckpoint(plan PushPlan) {
+	path := actor.pushCheckpointPath(plan)
+	if path == "" {
+		return
+	}
+
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		log.WithField("path", path).Warnln("deleting push checkpoint:", err)
+	}
+}
+
+// loadPushCheckpoint returns the checkpoint recorded for the plan when the
+// plan is being resumed. A missing or unreadable checkpoint results in an
+// empty checkpoint.
+func (actor Actor) loadPushCheckpoint(plan PushPlan) PushCheckpoint {
+	path := actor.pushCheckpointPath(plan)
+	if path == "" || !plan.Resume {
+		return PushCheckpoint{}
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", path).Warnln("reading push checkpoint:", err)
+		}
+		return PushCheckpoint{}
+	}
+
+	var checkpoint PushCheckpoint
+	if err := json.Unmarshal(raw, &checkpoint); err != nil {
+		log.WithField("path", path).Warnln("ignoring invalid push checkpoint:", err)
+		return PushCheckpoint{}
+	}
+
+	return checkpoint
+}
+
+// savePushCheckpoint records the checkpoint for the plan. Failing to record
+// a checkpoint does not fail the push.
+func (actor Actor) savePushCheckpoint(plan PushPlan, checkpoint PushCheckpoint) {
+	path := actor.pushCheckpointPath(plan)
+	if path == "" {
+		return
+	}
+
+	err := writePushCheckpoint(path, checkpoint)
+	if err != nil {
+		log.WithField("path", path).Warnln("saving push checkpoint:", err)
+	}
+}
+
+// pushCheckpointPath returns where the checkpoint for the plan is stored. The
+// path is keyed by the app and a digest of the bits being pushed, so a
+// checkpoint is never resumed for different bits.
+func (actor Actor) pushCheckpointPath(plan PushPlan) string {
+	if actor.CheckpointDirectory == "" || plan.Application.GUID == "" {
+		return ""
+	}
+
+	return filepath.Join(actor.CheckpointDirectory, fmt.Sprintf("%s-%s.json", plan.Application.GUID, pushDigest(plan)))
+}
+
+func pushDigest(plan PushPlan) string {
+	resources := make([]string, 0, len(plan.AllResources))
+	for _, resource := range plan.AllResources {
+		resources = append(resources, fmt.Sprintf("%s:%s:%o", resource.FilePath, resource.Checksum.Value, resource.Mode))
+	}
+	sort.Strings(resources)
+
+	hash := sha1.New()
+	_, _ = io.WriteString(hash, plan.DockerImageCredentials.Path+"\n")
+	for _, resource := range resources {
+		_, _ = io.WriteString(hash, resource+"\n")
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+func writePushCheckpoint(path string, checkpoint PushCheckpoint) error {
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "temp-checkpoint")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
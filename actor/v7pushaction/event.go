@@ -8,6 +8,8 @@ const (
 	ApplyManifestComplete           Event = "Applying manifest Complete"
 	BoundRoutes                     Event = "bound routes"
 	BoundServices                   Event = "bound services"
+	ClearingBuildCache              Event = "clearing build cache"
+	ClearingBuildCacheComplete      Event = "clearing build cache complete"
 	ConfiguringServices             Event = "configuring services"
 	CreatedApplication              Event = "created application"
 	CreatedRoutes                   Event = "created routes"
@@ -18,6 +20,9 @@ const (
 	PollingBuild                    Event = "polling build"
 	ReadingArchive                  Event = "reading archive"
 	ResourceMatching                Event = "resource matching"
+	ResumingFromBuild               Event = "resuming from build"
+	ResumingFromDroplet             Event = "resuming from droplet"
+	ResumingFromPackage             Event = "resuming from package"
 	RetryUpload                     Event = "retry upload"
 	ScaleWebProcess                 Event = "scaling the web process"
 	ScaleWebProcessComplete         Event = "scaling the web process complete"
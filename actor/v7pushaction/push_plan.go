@@ -16,8 +16,10 @@ type PushPlan struct {
 	Application            v7action.Application
 	ApplicationNeedsUpdate bool
 
+	NoBuildCache      bool
 	NoStart           bool
 	SkipRouteCreation bool
+	Resume            bool
 
 	DockerImageCredentials            v7action.DockerImageCredentials
 	DockerImageCredentialsNeedsUpdate bool
@@ -47,8 +49,10 @@ type FlagOverrides struct {
 	HealthCheckType     constant.HealthCheckType
 	Instances           types.NullInt
 	Memory              types.NullUint64
+	NoBuildCache        bool
 	NoStart             bool
 	ProvidedAppPath     string
+	Resume              bool
 	SkipRouteCreation   bool
 	StartCommand        types.FilteredString
 }
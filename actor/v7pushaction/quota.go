@@ -0,0 +1,116 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	log "github.com/sirupsen/logrus"
+)
+
+// CheckPushPlanQuotas returns a QuotaReservationExceededError when starting
+// the apps of the push plans, and creating their default routes, does not
+// fit in the space or org quota. It lets the push fail before anything is
+// uploaded or staged.
+func (actor Actor) CheckPushPlanQuotas(pushPlans []PushPlan) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		total       v7action.QuotaReservation
+	)
+
+	for _, plan := range pushPlans {
+		if plan.NoStart {
+			continue
+		}
+
+		reservation, warnings, err := actor.pushPlanQuotaReservation(plan)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		total.MemoryInMB += reservation.MemoryInMB
+		total.Instances += reservation.Instances
+		total.Routes += reservation.Routes
+		if reservation.InstanceMemoryInMB > total.InstanceMemoryInMB {
+			total.InstanceMemoryInMB = reservation.InstanceMemoryInMB
+		}
+	}
+
+	if total == (v7action.QuotaReservation{}) {
+		return allWarnings, nil
+	}
+
+	log.WithField("reservation", total).Debug("checking quotas")
+	warnings, err := actor.V7Actor.CheckQuotaReservation(pushPlans[0].OrgGUID, pushPlans[0].SpaceGUID, total)
+	return append(allWarnings, warnings...), err
+}
+
+// pushPlanQuotaReservation returns the resources the app uses once the push
+// has restarted it, less the resources it is using already.
+func (actor Actor) pushPlanQuotaReservation(plan PushPlan) (v7action.QuotaReservation, Warnings, error) {
+	current, getWarnings, err := actor.V7Actor.GetProcessByTypeAndApplication(constant.ProcessTypeWeb, plan.Application.GUID)
+	warnings := Warnings(getWarnings)
+	if err != nil {
+		return v7action.QuotaReservation{}, warnings, err
+	}
+
+	instances, memory := int64(current.Instances.Value), int64(current.MemoryInMB.Value)
+	if plan.ScaleWebProcessNeedsUpdate {
+		if plan.ScaleWebProcess.Instances.IsSet {
+			instances = int64(plan.ScaleWebProcess.Instances.Value)
+		}
+		if plan.ScaleWebProcess.MemoryInMB.IsSet {
+			memory = int64(plan.ScaleWebProcess.MemoryInMB.Value)
+		}
+	}
+
+	reservation := v7action.QuotaReservation{
+		MemoryInMB:         instances * memory,
+		Instances:          instances,
+		InstanceMemoryInMB: memory,
+	}
+	if plan.Application.State == constant.ApplicationStarted {
+		reservation.MemoryInMB -= int64(current.Instances.Value) * int64(current.MemoryInMB.Value)
+		reservation.Instances -= int64(current.Instances.Value)
+	}
+
+	if !plan.SkipRouteCreation {
+		routes, routeWarnings, err := actor.countDefaultRoutesToCreate(plan)
+		warnings = append(warnings, routeWarnings...)
+		if err != nil {
+			return v7action.QuotaReservation{}, warnings, err
+		}
+		reservation.Routes = routes
+	}
+
+	return reservation, warnings, nil
+}
+
+// countDefaultRoutesToCreate returns 1 when the push will create the default
+// route of the app, and 0 when the route is already mapped or exists.
+func (actor Actor) countDefaultRoutesToCreate(plan PushPlan) (int64, Warnings, error) {
+	defaultRoute, warnings, err := actor.getDefaultRoute(plan.OrgGUID, plan.SpaceGUID, plan.Application.Name)
+	if err != nil {
+		return 0, warnings, err
+	}
+
+	boundRoutes, routeWarnings, err := actor.V2Actor.GetApplicationRoutes(plan.Application.GUID)
+	warnings = append(warnings, routeWarnings...)
+	if err != nil {
+		return 0, warnings, err
+	}
+	if _, bound := actor.routeInListBySettings(defaultRoute, boundRoutes); bound {
+		return 0, warnings, nil
+	}
+
+	_, routeWarnings, err = actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
+	warnings = append(warnings, routeWarnings...)
+	switch err.(type) {
+	case nil:
+		return 0, warnings, nil
+	case actionerror.RouteNotFoundError:
+		return 1, warnings, nil
+	default:
+		return 0, warnings, err
+	}
+}
@@ -0,0 +1,163 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckPushPlanQuotas", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v7pushactionfakes.FakeV2Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		pushPlans  []PushPlan
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV2Actor, fakeV7Actor, _ = getTestPushActor()
+
+		pushPlans = []PushPlan{
+			{
+				OrgGUID:     "some-org-guid",
+				SpaceGUID:   "some-space-guid",
+				Application: v7action.Application{Name: "started-app", GUID: "started-app-guid", State: constant.ApplicationStarted},
+				ScaleWebProcess: v7action.Process{
+					Instances: types.NullInt{Value: 4, IsSet: true},
+				},
+				ScaleWebProcessNeedsUpdate: true,
+			},
+			{
+				OrgGUID:     "some-org-guid",
+				SpaceGUID:   "some-space-guid",
+				Application: v7action.Application{Name: "new-app", GUID: "new-app-guid", State: constant.ApplicationStopped},
+			},
+			{
+				OrgGUID:     "some-org-guid",
+				SpaceGUID:   "some-space-guid",
+				Application: v7action.Application{Name: "no-start-app", GUID: "no-start-app-guid"},
+				NoStart:     true,
+			},
+		}
+
+		fakeV7Actor.GetProcessByTypeAndApplicationStub = func(processType string, appGUID string) (v7action.Process, v7action.Warnings, error) {
+			if appGUID == "started-app-guid" {
+				return v7action.Process{
+					Instances:  types.NullInt{Value: 2, IsSet: true},
+					MemoryInMB: types.NullUint64{Value: 512, IsSet: true},
+				}, v7action.Warnings{"get-process-warning"}, nil
+			}
+			return v7action.Process{
+				Instances:  types.NullInt{Value: 1, IsSet: true},
+				MemoryInMB: types.NullUint64{Value: 1024, IsSet: true},
+			}, nil, nil
+		}
+
+		fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{Name: "example.com", GUID: "domain-guid"}}, nil, nil)
+		fakeV2Actor.GetApplicationRoutesStub = func(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+			if appGUID == "started-app-guid" {
+				return v2action.Routes{{Host: "started-app", SpaceGUID: "some-space-guid", Domain: v2action.Domain{GUID: "domain-guid"}}}, nil, nil
+			}
+			return nil, v2action.Warnings{"get-routes-warning"}, nil
+		}
+		fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, nil, actionerror.RouteNotFoundError{})
+
+		fakeV7Actor.CheckQuotaReservationReturns(v7action.Warnings{"quota-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = actor.CheckPushPlanQuotas(pushPlans)
+	})
+
+	It("checks the resources the started apps and their default routes need", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf("get-process-warning", "get-routes-warning", "quota-warning"))
+
+		Expect(fakeV7Actor.GetProcessByTypeAndApplicationCallCount()).To(Equal(2))
+		processType, appGUID := fakeV7Actor.GetProcessByTypeAndApplicationArgsForCall(0)
+		Expect(processType).To(Equal(constant.ProcessTypeWeb))
+		Expect(appGUID).To(Equal("started-app-guid"))
+
+		Expect(fakeV7Actor.CheckQuotaReservationCallCount()).To(Equal(1))
+		orgGUID, spaceGUID, reservation := fakeV7Actor.CheckQuotaReservationArgsForCall(0)
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(reservation).To(Equal(v7action.QuotaReservation{
+			MemoryInMB:         2*512 + 1024,
+			Instances:          2 + 1,
+			Routes:             1,
+			InstanceMemoryInMB: 1024,
+		}))
+	})
+
+	When("route creation is skipped", func() {
+		BeforeEach(func() {
+			pushPlans[1].SkipRouteCreation = true
+		})
+
+		It("does not reserve routes", func() {
+			_, _, reservation := fakeV7Actor.CheckQuotaReservationArgsForCall(0)
+			Expect(reservation.Routes).To(BeZero())
+		})
+	})
+
+	When("the default route already exists in the space", func() {
+		BeforeEach(func() {
+			fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{GUID: "route-guid"}, nil, nil)
+		})
+
+		It("does not reserve routes", func() {
+			_, _, reservation := fakeV7Actor.CheckQuotaReservationArgsForCall(0)
+			Expect(reservation.Routes).To(BeZero())
+		})
+	})
+
+	When("the push does not fit in the quota", func() {
+		BeforeEach(func() {
+			fakeV7Actor.CheckQuotaReservationReturns(
+				v7action.Warnings{"quota-warning"},
+				actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1.5G"})
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1.5G"}))
+			Expect(warnings).To(ContainElement("quota-warning"))
+		})
+	})
+
+	When("getting a process fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetProcessByTypeAndApplicationStub = nil
+			fakeV7Actor.GetProcessByTypeAndApplicationReturns(v7action.Process{}, v7action.Warnings{"get-process-warning"}, errors.New("process-error"))
+		})
+
+		It("returns the error without checking the quotas", func() {
+			Expect(executeErr).To(MatchError("process-error"))
+			Expect(warnings).To(ConsistOf("get-process-warning"))
+			Expect(fakeV7Actor.CheckQuotaReservationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no app is started", func() {
+		BeforeEach(func() {
+			pushPlans = pushPlans[2:]
+		})
+
+		It("does not check the quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.CheckQuotaReservationCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+func SetupNoBuildCacheForPushPlan(pushPlan PushPlan, overrides FlagOverrides, manifestApp manifestparser.Application) (PushPlan, error) {
+	pushPlan.NoBuildCache = overrides.NoBuildCache
+
+	return pushPlan, nil
+}
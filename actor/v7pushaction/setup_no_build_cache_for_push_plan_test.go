@@ -0,0 +1,42 @@
+package v7pushaction_test
+
+import (
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetupNoBuildCacheForPushPlan", func() {
+	var (
+		pushPlan    PushPlan
+		overrides   FlagOverrides
+		manifestApp manifestparser.Application
+
+		expectedPushPlan PushPlan
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		pushPlan = PushPlan{}
+		overrides = FlagOverrides{}
+		manifestApp = manifestparser.Application{}
+	})
+
+	JustBeforeEach(func() {
+		expectedPushPlan, executeErr = SetupNoBuildCacheForPushPlan(pushPlan, overrides, manifestApp)
+	})
+
+	When("flag overrides specifies no build cache", func() {
+		BeforeEach(func() {
+			overrides.NoBuildCache = true
+		})
+
+		It("sets no build cache on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.NoBuildCache).To(BeTrue())
+		})
+	})
+})
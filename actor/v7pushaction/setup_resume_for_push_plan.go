@@ -0,0 +1,11 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+func SetupResumeForPushPlan(pushPlan PushPlan, overrides FlagOverrides, manifestApp manifestparser.Application) (PushPlan, error) {
+	pushPlan.Resume = overrides.Resume
+
+	return pushPlan, nil
+}
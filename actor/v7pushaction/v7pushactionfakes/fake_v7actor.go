@@ -11,6 +11,34 @@ import (
 )
 
 type FakeV7Actor struct {
+	CheckQuotaReservationStub        func(string, string, v7action.QuotaReservation) (v7action.Warnings, error)
+	checkQuotaReservationMutex       sync.RWMutex
+	checkQuotaReservationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaReservation
+	}
+	checkQuotaReservationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	checkQuotaReservationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	ClearApplicationBuildCacheStub        func(string) (v7action.Warnings, error)
+	clearApplicationBuildCacheMutex       sync.RWMutex
+	clearApplicationBuildCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearApplicationBuildCacheReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	clearApplicationBuildCacheReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CreateApplicationInSpaceStub        func(v7action.Application, string) (v7action.Application, v7action.Warnings, error)
 	createApplicationInSpaceMutex       sync.RWMutex
 	createApplicationInSpaceArgsForCall []struct {
@@ -90,6 +118,22 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetProcessByTypeAndApplicationStub        func(string, string) (v7action.Process, v7action.Warnings, error)
+	getProcessByTypeAndApplicationMutex       sync.RWMutex
+	getProcessByTypeAndApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getProcessByTypeAndApplicationReturns struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
+	getProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
 	PollBuildStub        func(string, string) (v7action.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -272,6 +316,134 @@ type FakeV7Actor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV7Actor) CheckQuotaReservation(arg1 string, arg2 string, arg3 v7action.QuotaReservation) (v7action.Warnings, error) {
+	fake.checkQuotaReservationMutex.Lock()
+	ret, specificReturn := fake.checkQuotaReservationReturnsOnCall[len(fake.checkQuotaReservationArgsForCall)]
+	fake.checkQuotaReservationArgsForCall = append(fake.checkQuotaReservationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaReservation
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckQuotaReservation", []interface{}{arg1, arg2, arg3})
+	fake.checkQuotaReservationMutex.Unlock()
+	if fake.CheckQuotaReservationStub != nil {
+		return fake.CheckQuotaReservationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkQuotaReservationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) CheckQuotaReservationCallCount() int {
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	return len(fake.checkQuotaReservationArgsForCall)
+}
+
+func (fake *FakeV7Actor) CheckQuotaReservationCalls(stub func(string, string, v7action.QuotaReservation) (v7action.Warnings, error)) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = stub
+}
+
+func (fake *FakeV7Actor) CheckQuotaReservationArgsForCall(i int) (string, string, v7action.QuotaReservation) {
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	argsForCall := fake.checkQuotaReservationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) CheckQuotaReservationReturns(result1 v7action.Warnings, result2 error) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = nil
+	fake.checkQuotaReservationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) CheckQuotaReservationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = nil
+	if fake.checkQuotaReservationReturnsOnCall == nil {
+		fake.checkQuotaReservationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.checkQuotaReservationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCache(arg1 string) (v7action.Warnings, error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	ret, specificReturn := fake.clearApplicationBuildCacheReturnsOnCall[len(fake.clearApplicationBuildCacheArgsForCall)]
+	fake.clearApplicationBuildCacheArgsForCall = append(fake.clearApplicationBuildCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearApplicationBuildCache", []interface{}{arg1})
+	fake.clearApplicationBuildCacheMutex.Unlock()
+	if fake.ClearApplicationBuildCacheStub != nil {
+		return fake.ClearApplicationBuildCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clearApplicationBuildCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheCallCount() int {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	return len(fake.clearApplicationBuildCacheArgsForCall)
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = stub
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheArgsForCall(i int) string {
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
+	argsForCall := fake.clearApplicationBuildCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheReturns(result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	fake.clearApplicationBuildCacheReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) ClearApplicationBuildCacheReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.clearApplicationBuildCacheMutex.Lock()
+	defer fake.clearApplicationBuildCacheMutex.Unlock()
+	fake.ClearApplicationBuildCacheStub = nil
+	if fake.clearApplicationBuildCacheReturnsOnCall == nil {
+		fake.clearApplicationBuildCacheReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.clearApplicationBuildCacheReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) CreateApplicationInSpace(arg1 v7action.Application, arg2 string) (v7action.Application, v7action.Warnings, error) {
 	fake.createApplicationInSpaceMutex.Lock()
 	ret, specificReturn := fake.createApplicationInSpaceReturnsOnCall[len(fake.createApplicationInSpaceArgsForCall)]
@@ -611,6 +783,73 @@ func (fake *FakeV7Actor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (v7action.Process, v7action.Warnings, error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
+	fake.getProcessByTypeAndApplicationArgsForCall = append(fake.getProcessByTypeAndApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetProcessByTypeAndApplication", []interface{}{arg1, arg2})
+	fake.getProcessByTypeAndApplicationMutex.Unlock()
+	if fake.GetProcessByTypeAndApplicationStub != nil {
+		return fake.GetProcessByTypeAndApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProcessByTypeAndApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCallCount() int {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.getProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCalls(stub func(string, string) (v7action.Process, v7action.Warnings, error)) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationArgsForCall(i int) (string, string) {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	argsForCall := fake.getProcessByTypeAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturns(result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	fake.getProcessByTypeAndApplicationReturns = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	if fake.getProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.getProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Process
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) PollBuild(arg1 string, arg2 string) (v7action.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -1418,6 +1657,10 @@ func (fake *FakeV7Actor) UploadBitsPackageReturnsOnCall(i int, result1 v7action.
 func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	fake.clearApplicationBuildCacheMutex.RLock()
+	defer fake.clearApplicationBuildCacheMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
@@ -1428,6 +1671,8 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -4,6 +4,10 @@ package ccerror
 // request.
 type ForbiddenError struct {
 	Message string
+	// Method is the HTTP method of the forbidden request.
+	Method string
+	// Path is the URL path of the forbidden request.
+	Path string
 }
 
 func (e ForbiddenError) Error() string {
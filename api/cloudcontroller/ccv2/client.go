@@ -91,6 +91,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"github.com/tedsuo/rata"
 )
 
@@ -106,6 +107,7 @@ type Client struct {
 	cloudControllerURL        string
 	dopplerEndpoint           string
 	minCLIVersion             string
+	minCLIVersionEnforcement  constant.MinCLIVersionEnforcement
 	routingEndpoint           string
 	tokenEndpoint             string
 
@@ -126,6 +128,10 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// UserAgentSuffix is appended to the User-Agent of every request to
+	// identify the automation using the client. It is omitted when empty.
+	UserAgentSuffix string
+
 	// JobPollingTimeout is the maximum amount of time a job polls for.
 	JobPollingTimeout time.Duration
 
@@ -139,6 +145,9 @@ type Config struct {
 // NewClient returns a new Cloud Controller Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+	if config.UserAgentSuffix != "" {
+		userAgent += " " + config.UserAgentSuffix
+	}
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
@@ -56,4 +56,24 @@ var _ = Describe("Cloud Controller Client", func() {
 			Expect(server.ReceivedRequests()).To(HaveLen(2))
 		})
 	})
+
+	When("a user agent suffix is configured", func() {
+		BeforeEach(func() {
+			client = NewTestClient(Config{UserAgentSuffix: "team-payments/deploy"})
+
+			expectedUserAgent := fmt.Sprintf("CF CLI API V2 Test/Unknown (%s; %s %s) team-payments/deploy", runtime.Version(), runtime.GOARCH, runtime.GOOS)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/apps"),
+					VerifyHeaderKV("User-Agent", expectedUserAgent),
+					RespondWith(http.StatusOK, "{}"),
+				),
+			)
+		})
+
+		It("appends the suffix to the user agent header", func() {
+			client.GetApplications()
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
 })
@@ -0,0 +1,14 @@
+package constant
+
+// EnvironmentVariableGroupName is the name of an environment variable group
+// given by the Cloud Controller.
+type EnvironmentVariableGroupName string
+
+const (
+	// StagingEnvironmentVariableGroup is the group of variables set on every
+	// app while it is staged.
+	StagingEnvironmentVariableGroup EnvironmentVariableGroupName = "staging"
+	// RunningEnvironmentVariableGroup is the group of variables set on every
+	// running app.
+	RunningEnvironmentVariableGroup EnvironmentVariableGroupName = "running"
+)
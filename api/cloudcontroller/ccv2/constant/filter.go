@@ -4,10 +4,18 @@ package constant
 type FilterType string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter FilterType = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter FilterType = "app_guid"
+	// AuditorGUIDFilter is the name of the 'auditor_guid' filter.
+	AuditorGUIDFilter FilterType = "auditor_guid"
+	// BillingManagerGUIDFilter is the name of the 'billing_manager_guid' filter.
+	BillingManagerGUIDFilter FilterType = "billing_manager_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
 	DomainGUIDFilter FilterType = "domain_guid"
+	// ManagerGUIDFilter is the name of the 'manager_guid' filter.
+	ManagerGUIDFilter FilterType = "manager_guid"
 	// OrganizationGUIDFilter is the name of the 'organization_guid' filter.
 	OrganizationGUIDFilter FilterType = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
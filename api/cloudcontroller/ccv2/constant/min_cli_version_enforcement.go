@@ -0,0 +1,15 @@
+package constant
+
+// MinCLIVersionEnforcement is how the Cloud Controller asks the CLI to treat a
+// CLI older than its minimum CLI version.
+type MinCLIVersionEnforcement string
+
+const (
+	// MinCLIVersionWarn warns the user and carries on. This is the default
+	// when the Cloud Controller does not say.
+	MinCLIVersionWarn MinCLIVersionEnforcement = "warn"
+	// MinCLIVersionBlock refuses to log in until the CLI is upgraded.
+	MinCLIVersionBlock MinCLIVersionEnforcement = "block"
+	// MinCLIVersionOff suppresses the check altogether.
+	MinCLIVersionOff MinCLIVersionEnforcement = "off"
+)
@@ -0,0 +1,15 @@
+package constant
+
+// SpaceRole represents a role a user can have in a space.
+type SpaceRole string
+
+const (
+	// SpaceAuditorRole is the space auditor role.
+	SpaceAuditorRole SpaceRole = "auditor"
+
+	// SpaceDeveloperRole is the space developer role.
+	SpaceDeveloperRole SpaceRole = "developer"
+
+	// SpaceManagerRole is the space manager role.
+	SpaceManagerRole SpaceRole = "manager"
+)
@@ -83,7 +83,7 @@ var _ = Describe("Domain", func() {
 			It("should return the error and all warnings", func() {
 				warnings, err := client.CreateSharedDomain(domain, "", isInternal)
 				Expect(warnings).To(ConsistOf("this is your final warning"))
-				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action", Method: http.MethodPost, Path: "/v2/shared_domains"}))
 			})
 		})
 
@@ -0,0 +1,31 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// EnvironmentVariableGroup represents the variables of a Cloud Controller
+// environment variable group.
+type EnvironmentVariableGroup map[string]interface{}
+
+// GetConfigEnvironmentVariableGroup retrieves the variables of the given
+// environment variable group from the Cloud Controller.
+func (client Client) GetConfigEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (EnvironmentVariableGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetConfigEnvVarGroupRequest,
+		URIParams:   Params{"group_name": string(group)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var variables EnvironmentVariableGroup
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &variables,
+	}
+
+	err = client.connection.Make(request, &response)
+	return variables, response.Warnings, err
+}
@@ -0,0 +1,83 @@
+package ccv2_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Environment Variable Group", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetConfigEnvironmentVariableGroup", func() {
+		var (
+			variables EnvironmentVariableGroup
+			warnings  Warnings
+			err       error
+		)
+
+		JustBeforeEach(func() {
+			variables, warnings, err = client.GetConfigEnvironmentVariableGroup(constant.RunningEnvironmentVariableGroup)
+		})
+
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"LOG_LEVEL": "debug",
+					"MAX_CONNECTIONS": 10
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/running"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					))
+			})
+
+			It("returns the variables and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(variables).To(Equal(EnvironmentVariableGroup{
+					"LOG_LEVEL":       "debug",
+					"MAX_CONNECTIONS": json.Number("10"),
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/environment_variable_groups/running"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
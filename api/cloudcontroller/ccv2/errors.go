@@ -28,7 +28,7 @@ func (e *errorWrapper) Make(request *cloudcontroller.Request, passedResponse *cl
 			return convert500(rawHTTPStatusErr)
 		}
 
-		return convert400(rawHTTPStatusErr)
+		return convert400(rawHTTPStatusErr, request)
 	}
 	return err
 }
@@ -39,7 +39,7 @@ func (e *errorWrapper) Wrap(innerconnection cloudcontroller.Connection) cloudcon
 	return e
 }
 
-func convert400(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
+func convert400(rawHTTPStatusErr ccerror.RawHTTPStatusError, request *cloudcontroller.Request) error {
 	// Try to unmarshal the raw error into a CC error. If unmarshaling fails,
 	// either we're not talking to a CC, or the CC returned invalid json.
 	errorResponse, err := unmarshalRawHTTPErr(rawHTTPStatusErr)
@@ -53,7 +53,7 @@ func convert400(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	case http.StatusUnauthorized: // 401
 		return handleUnauthorized(errorResponse)
 	case http.StatusForbidden: // 403
-		return ccerror.ForbiddenError{Message: errorResponse.Description}
+		return ccerror.ForbiddenError{Message: errorResponse.Description, Method: request.Method, Path: request.URL.Path}
 	case http.StatusNotFound: // 404
 		return ccerror.ResourceNotFoundError{Message: errorResponse.Description}
 	case http.StatusUnprocessableEntity: // 422
@@ -292,7 +292,7 @@ var _ = Describe("Error Wrapper", func() {
 					})
 
 					It("returns a ForbiddenError", func() {
-						Expect(executeErr).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
+						Expect(executeErr).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message", Method: http.MethodGet, Path: "/v2/apps"}))
 					})
 				})
 
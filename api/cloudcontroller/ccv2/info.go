@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -25,6 +26,10 @@ type APIInformation struct {
 	// Cloud Controller.
 	MinCLIVersion string `json:"min_cli_version"`
 
+	// MinCLIVersionEnforcement is whether a CLI older than MinCLIVersion
+	// should only warn or be blocked from logging in.
+	MinCLIVersionEnforcement constant.MinCLIVersionEnforcement `json:"min_cli_version_enforcement"`
+
 	// MinimumRecommendedCLIVersion is the minimum CLI version number recommended
 	// for the targeted Cloud Controller.
 	MinimumRecommendedCLIVersion string `json:"min_recommended_cli_version"`
@@ -90,6 +95,15 @@ func (client *Client) MinCLIVersion() string {
 	return client.minCLIVersion
 }
 
+// MinCLIVersionEnforcement returns how the targeted Cloud Controller wants a
+// CLI below its minimum version to be treated, defaulting to warning.
+func (client *Client) MinCLIVersionEnforcement() constant.MinCLIVersionEnforcement {
+	if client.minCLIVersionEnforcement == "" {
+		return constant.MinCLIVersionWarn
+	}
+	return client.minCLIVersionEnforcement
+}
+
 // RoutingEndpoint returns the Routing endpoint for the targeted Cloud
 // Controller.
 func (client *Client) RoutingEndpoint() string {
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,6 +36,7 @@ var _ = Describe("Info", func() {
 					"authorization_endpoint":"https://login.APISERVER",
 					"token_endpoint":"https://uaa.APISERVER",
 					"min_cli_version":"6.22.1",
+					"min_cli_version_enforcement":"block",
 					"min_recommended_cli_version":null,
 					"api_version":"2.59.0",
 					"app_ssh_endpoint":"ssh.APISERVER",
@@ -61,6 +63,7 @@ var _ = Describe("Info", func() {
 			Expect(info.AuthorizationEndpoint).To(MatchRegexp("https://login.%s", serverAPIURL))
 			Expect(info.DopplerEndpoint).To(MatchRegexp("wss://doppler.%s", serverAPIURL))
 			Expect(info.MinCLIVersion).To(Equal("6.22.1"))
+			Expect(info.MinCLIVersionEnforcement).To(Equal(constant.MinCLIVersionBlock))
 			Expect(info.MinimumRecommendedCLIVersion).To(BeEmpty())
 			Expect(info.Name).To(Equal("faceman test server"))
 			Expect(info.RoutingEndpoint).To(MatchRegexp("https://%s/routing", serverAPIURL))
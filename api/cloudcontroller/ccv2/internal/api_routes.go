@@ -15,12 +15,15 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppRequest                                     = "DeleteApp"
 	DeleteOrganizationRequest                            = "DeleteOrganization"
 	DeleteRouteAppRequest                                = "DeleteRouteApp"
 	DeleteRouteRequest                                   = "DeleteRoute"
 	DeleteSecurityGroupSpaceRequest                      = "DeleteSecurityGroupSpace"
 	DeleteSecurityGroupStagingSpaceRequest               = "DeleteSecurityGroupStagingSpace"
 	DeleteServiceBindingRequest                          = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest                         = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                              = "DeleteServiceKey"
 	DeleteServicePlanVisibilityRequest                   = "DeleteServicePlanVisibility"
 	DeleteServiceRequest                                 = "DeleteService"
 	DeleteSpaceRequest                                   = "DeleteSpace"
@@ -31,6 +34,7 @@ const (
 	GetAppsRequest                                       = "GetApps"
 	GetAppStatsRequest                                   = "GetAppStats"
 	GetBuildpacksRequest                                 = "GetBuildpacks"
+	GetConfigEnvVarGroupRequest                          = "GetConfigEnvVarGroup"
 	GetConfigFeatureFlagsRequest                         = "GetConfigFeatureFlags"
 	GetEventsRequest                                     = "GetEvents"
 	GetInfoRequest                                       = "GetInfo"
@@ -58,6 +62,7 @@ const (
 	GetServiceBrokersRequest                             = "GetServiceBrokers"
 	GetServiceInstanceRequest                            = "GetServiceInstance"
 	GetServiceInstanceServiceBindingsRequest             = "GetServiceInstanceServiceBindings"
+	GetServiceInstanceServiceKeysRequest                 = "GetServiceInstanceServiceKeys"
 	GetServiceInstanceSharedFromRequest                  = "GetServiceInstanceSharedFrom"
 	GetServiceInstanceSharedToRequest                    = "GetServiceInstanceSharedTo"
 	GetServiceInstancesRequest                           = "GetServiceInstances"
@@ -81,6 +86,9 @@ const (
 	GetStacksRequest                                     = "GetStacks"
 	GetUserProvidedServiceInstanceServiceBindingsRequest = "GetUserProvidedServiceInstanceServiceBindings"
 	GetUserProvidedServiceInstancesRequest               = "GetUserProvidedServiceInstances"
+	GetUserAuditedSpacesRequest                          = "GetUserAuditedSpaces"
+	GetUserManagedSpacesRequest                          = "GetUserManagedSpaces"
+	GetUserSpacesRequest                                 = "GetUserSpaces"
 	GetUsersRequest                                      = "GetUsers"
 	PostAppRequest                                       = "PostApp"
 	PostAppRestageRequest                                = "PostAppRestage"
@@ -121,6 +129,7 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
@@ -133,6 +142,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackBitsRequest},
+	{Path: "/v2/config/environment_variable_groups/:group_name", Method: http.MethodGet, Name: GetConfigEnvVarGroupRequest},
 	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
 	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
@@ -179,10 +189,13 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
 	{Path: "/v2/service_instances", Method: http.MethodPost, Name: PostServiceInstancesRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/service_keys", Method: http.MethodGet, Name: GetServiceInstanceServiceKeysRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
 	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodPost, Name: PostServicePlanVisibilityRequest},
 	{Path: "/v2/service_plan_visibilities/:service_plan_visibility_guid", Method: http.MethodDelete, Name: DeleteServicePlanVisibilityRequest},
@@ -217,4 +230,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/user_provided_service_instances", Method: http.MethodGet, Name: GetUserProvidedServiceInstancesRequest},
 	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetUserProvidedServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
+	{Path: "/v2/users/:user_guid/audited_spaces", Method: http.MethodGet, Name: GetUserAuditedSpacesRequest},
+	{Path: "/v2/users/:user_guid/managed_spaces", Method: http.MethodGet, Name: GetUserManagedSpacesRequest},
+	{Path: "/v2/users/:user_guid/spaces", Method: http.MethodGet, Name: GetUserSpacesRequest},
 }
@@ -417,6 +417,25 @@ var _ = Describe("Job", func() {
 			})
 		})
 
+		When("running a dry run", func() {
+			BeforeEach(func() {
+				client = NewTestClient(Config{
+					JobPollingTimeout: time.Minute,
+					Wrappers:          []ConnectionWrapper{wrapper.NewDryRun(ioutil.Discard)},
+				})
+			})
+
+			It("sends neither the delete nor the job polling, and finds the job finished", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, err := client.PollJob(job)
+				Expect(err).NotTo(HaveOccurred())
+				for _, request := range server.ReceivedRequests() {
+					Expect(request.URL.Path).To(Equal("/v2/info"))
+				}
+			})
+		})
+
 		When("an error is encountered", func() {
 			BeforeEach(func() {
 				response := `{
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -15,6 +16,12 @@ import (
 type ServiceBinding struct {
 	// AppGUID is the associated application GUID.
 	AppGUID string
+	// CreatedAt is the time the service binding was created.
+	CreatedAt time.Time
+	// ExpiresAt is the time the binding's credentials expire, as reported by
+	// the service broker. It is zero when the broker does not report an
+	// expiry.
+	ExpiresAt time.Time
 	// GUID is the unique Service Binding identifier.
 	GUID string
 	// LastOperation
@@ -34,6 +41,9 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 			ServiceInstanceGUID string        `json:"service_instance_guid"`
 			Name                string        `json:"name"`
 			LastOperation       LastOperation `json:"last_operation"`
+			BindingMetadata     struct {
+				ExpiresAt *time.Time `json:"expires_at"`
+			} `json:"binding_metadata"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccServiceBinding)
@@ -42,6 +52,10 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.CreatedAt = ccServiceBinding.Metadata.CreatedAt
+	if ccServiceBinding.Entity.BindingMetadata.ExpiresAt != nil {
+		serviceBinding.ExpiresAt = *ccServiceBinding.Entity.BindingMetadata.ExpiresAt
+	}
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
 	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
 	serviceBinding.Name = ccServiceBinding.Entity.Name
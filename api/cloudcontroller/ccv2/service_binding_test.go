@@ -3,6 +3,7 @@ package ccv2_test
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -391,11 +392,15 @@ var _ = Describe("Service Binding", func() {
 				"resources": [
 					{
 						"metadata": {
-							"guid": "service-binding-guid-1"
+							"guid": "service-binding-guid-1",
+							"created_at": "2019-01-02T03:04:05Z"
 						},
 						"entity": {
 							"app_guid":"app-guid-1",
-							"service_instance_guid": "service-instance-guid-1"
+							"service_instance_guid": "service-instance-guid-1",
+							"binding_metadata": {
+								"expires_at": "2019-04-02T03:04:05Z"
+							}
 						}
 					},
 					{
@@ -455,7 +460,13 @@ var _ = Describe("Service Binding", func() {
 				})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
-					{GUID: "service-binding-guid-1", AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid-1"},
+					{
+						GUID:                "service-binding-guid-1",
+						AppGUID:             "app-guid-1",
+						ServiceInstanceGUID: "service-instance-guid-1",
+						CreatedAt:           time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+						ExpiresAt:           time.Date(2019, 4, 2, 3, 4, 5, 0, time.UTC),
+					},
 					{GUID: "service-binding-guid-2", AppGUID: "app-guid-2", ServiceInstanceGUID: "service-instance-guid-2"},
 					{GUID: "service-binding-guid-3", AppGUID: "app-guid-3", ServiceInstanceGUID: "service-instance-guid-3"},
 					{GUID: "service-binding-guid-4", AppGUID: "app-guid-4", ServiceInstanceGUID: "service-instance-guid-4"},
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/url"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -137,6 +138,25 @@ func (client *Client) CreateServiceInstance(spaceGUID, servicePlanGUID, serviceI
 	return instance, response.Warnings, err
 }
 
+// DeleteServiceInstance deletes the service instance with the given GUID.
+// When purge is true, the service instance and its bindings, keys and shares
+// are removed from the Cloud Controller without contacting the broker.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string, purge bool) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"purge": {strconv.FormatBool(purge)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
@@ -184,6 +184,8 @@ var _ = Describe("Service Instance", func() {
 				_, warnings, err := client.CreateServiceInstance("space-GUID", "service-plan-GUID", "service-instance", map[string]interface{}{}, []string{})
 				Expect(err).To(MatchError(ccerror.ForbiddenError{
 					Message: "You are not authorized to perform the requested action",
+					Method:  http.MethodPost,
+					Path:    "/v2/service_instances",
 				}))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 			})
@@ -224,6 +226,47 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("DeleteServiceInstance", func() {
+		When("purging the service instance succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "purge=true"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the warnings", func() {
+				warnings, err := client.DeleteServiceInstance("some-service-instance-guid", true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		When("the service instance does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-service-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "purge=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteServiceInstance("some-service-instance-guid", true)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service instance could not be found: some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
@@ -150,3 +150,138 @@ var _ = Describe("Service Key", func() {
 		})
 	})
 })
+
+var _ = Describe("DeleteServiceKey", func() {
+	var (
+		client     *Client
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = client.DeleteServiceKey("some-service-key-guid")
+	})
+
+	When("the delete is successful", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+					RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service key and returns warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	When("the delete returns an error", func() {
+		BeforeEach(func() {
+			response := `
+			{
+				"description": "The service key could not be found: some-service-key-guid",
+				"error_code": "CF-ServiceKeyNotFound",
+				"code": 360003
+			}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+					RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service key could not be found: some-service-key-guid"}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
+
+var _ = Describe("GetServiceInstanceServiceKeys", func() {
+	var (
+		client      *Client
+		serviceKeys []ServiceKey
+		warnings    Warnings
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	JustBeforeEach(func() {
+		serviceKeys, warnings, executeErr = client.GetServiceInstanceServiceKeys("some-service-instance-guid")
+	})
+
+	When("the service instance has service keys", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_instances/some-service-instance-guid/service_keys?page=2",
+				"resources": [
+					{
+						"metadata": {"guid": "service-key-guid-1"},
+						"entity": {"name": "key-1", "service_instance_guid": "some-service-instance-guid"}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "service-key-guid-2"},
+						"entity": {"name": "key-2", "service_instance_guid": "some-service-instance-guid"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/service_keys"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/service_keys", "page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the service keys and warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(serviceKeys).To(ConsistOf(
+				ServiceKey{GUID: "service-key-guid-1", Name: "key-1", ServiceInstanceGUID: "some-service-instance-guid"},
+				ServiceKey{GUID: "service-key-guid-2", Name: "key-2", ServiceInstanceGUID: "some-service-instance-guid"},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+
+	When("the cloud controller returns an error", func() {
+		BeforeEach(func() {
+			response := `{
+				"code": 60004,
+				"description": "The service instance could not be found: some-service-instance-guid",
+				"error_code": "CF-ServiceInstanceNotFound"
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_instances/some-service-instance-guid/service_keys"),
+					RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service instance could not be found: some-service-instance-guid"}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
@@ -30,6 +30,16 @@ type ServicePlan struct {
 
 	// Free is true if plan is free
 	Free bool
+
+	// CreateParametersSchema is the JSON schema of the parameters accepted
+	// when creating a service instance from the plan. It is nil when the
+	// broker does not provide one.
+	CreateParametersSchema json.RawMessage
+
+	// UpdateParametersSchema is the JSON schema of the parameters accepted
+	// when updating a service instance of the plan. It is nil when the broker
+	// does not provide one.
+	UpdateParametersSchema json.RawMessage
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
@@ -42,6 +52,16 @@ func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
 			Public      bool   `json:"public"`
 			Description string `json:"description"`
 			Free        bool   `json:"free"`
+			Schemas     struct {
+				ServiceInstance struct {
+					Create struct {
+						Parameters json.RawMessage `json:"parameters"`
+					} `json:"create"`
+					Update struct {
+						Parameters json.RawMessage `json:"parameters"`
+					} `json:"update"`
+				} `json:"service_instance"`
+			} `json:"schemas"`
 		}
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccServicePlan)
@@ -55,9 +75,20 @@ func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
 	servicePlan.Public = ccServicePlan.Entity.Public
 	servicePlan.Description = ccServicePlan.Entity.Description
 	servicePlan.Free = ccServicePlan.Entity.Free
+	servicePlan.CreateParametersSchema = parametersSchema(ccServicePlan.Entity.Schemas.ServiceInstance.Create.Parameters)
+	servicePlan.UpdateParametersSchema = parametersSchema(ccServicePlan.Entity.Schemas.ServiceInstance.Update.Parameters)
 	return nil
 }
 
+// parametersSchema returns nil for the empty schemas the Cloud Controller
+// reports when a broker does not provide one.
+func parametersSchema(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" || string(raw) == "{}" {
+		return nil
+	}
+	return raw
+}
+
 // GetServicePlan returns the service plan with the given GUID.
 func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -58,6 +58,43 @@ var _ = Describe("Service Plan", func() {
 			})
 		})
 
+		When("the service plan has schemas for the parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-plan-guid"
+					},
+					"entity": {
+						"name": "some-service-plan",
+						"schemas": {
+							"service_instance": {
+								"create": {
+									"parameters": {"type": "object", "properties": {"size": {"type": "integer"}}}
+								},
+								"update": {
+									"parameters": {"type": "object"}
+								}
+							}
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusOK, response, nil),
+					),
+				)
+			})
+
+			It("returns the schemas", func() {
+				servicePlan, _, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(servicePlan.CreateParametersSchema).To(MatchJSON(`{"type": "object", "properties": {"size": {"type": "integer"}}}`))
+				Expect(servicePlan.UpdateParametersSchema).To(MatchJSON(`{"type": "object"}`))
+			})
+		})
+
 		When("the service plan does not exist (testing general error case)", func() {
 			BeforeEach(func() {
 				response := `{
@@ -103,6 +103,8 @@ var _ = Describe("Space", func() {
 				Expect(warnings).To(ConsistOf("warning-1"))
 				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{
 					Message: "You are not authorized to perform the requested action",
+					Method:  http.MethodPost,
+					Path:    "/v2/spaces",
 				}))
 			})
 		})
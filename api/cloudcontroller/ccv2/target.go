@@ -31,6 +31,40 @@ type TargetSettings struct {
 // TargetCF sets the client to use the Cloud Controller specified in the
 // configuration. Any other configuration is also applied to the client.
 func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
+	client.connect(settings)
+
+	info, warnings, err := client.Info()
+	if err != nil {
+		return warnings, err
+	}
+
+	client.setTargetInfo(info)
+	return warnings, nil
+}
+
+// TargetCFWithInfo sets the client to use the Cloud Controller specified in
+// the configuration like TargetCF, with the info that another client
+// retrieved from it. No request is made.
+func (client *Client) TargetCFWithInfo(settings TargetSettings, info APIInformation) {
+	client.connect(settings)
+	client.setTargetInfo(info)
+}
+
+// TargetInfo returns the endpoint and API information of the targeted Cloud
+// Controller that the client uses.
+func (client *Client) TargetInfo() APIInformation {
+	return APIInformation{
+		APIVersion:               client.cloudControllerAPIVersion,
+		AuthorizationEndpoint:    client.authorizationEndpoint,
+		DopplerEndpoint:          client.dopplerEndpoint,
+		MinCLIVersion:            client.minCLIVersion,
+		MinCLIVersionEnforcement: client.minCLIVersionEnforcement,
+		RoutingEndpoint:          client.routingEndpoint,
+		TokenEndpoint:            client.tokenEndpoint,
+	}
+}
+
+func (client *Client) connect(settings TargetSettings) {
 	client.cloudControllerURL = settings.URL
 	client.router = rata.NewRequestGenerator(settings.URL, internal.APIRoutes)
 
@@ -42,18 +76,14 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	for _, wrapper := range client.wrappers {
 		client.connection = wrapper.Wrap(client.connection)
 	}
+}
 
-	info, warnings, err := client.Info()
-	if err != nil {
-		return warnings, err
-	}
-
+func (client *Client) setTargetInfo(info APIInformation) {
 	client.authorizationEndpoint = info.AuthorizationEndpoint
 	client.cloudControllerAPIVersion = info.APIVersion
 	client.dopplerEndpoint = info.DopplerEndpoint
 	client.minCLIVersion = info.MinCLIVersion
+	client.minCLIVersionEnforcement = info.MinCLIVersionEnforcement
 	client.routingEndpoint = info.RoutingEndpoint
 	client.tokenEndpoint = info.TokenEndpoint
-
-	return warnings, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/ccv2fakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -101,6 +102,7 @@ var _ = Describe("Target", func() {
 						Expect(client.AuthorizationEndpoint()).To(MatchRegexp("https://login.%s", serverAPIURL))
 						Expect(client.DopplerEndpoint()).To(MatchRegexp("wss://doppler.%s", serverAPIURL))
 						Expect(client.RoutingEndpoint()).To(MatchRegexp("https://%s/routing", serverAPIURL))
+						Expect(client.MinCLIVersionEnforcement()).To(Equal(constant.MinCLIVersionWarn))
 						Expect(client.TokenEndpoint()).To(MatchRegexp("https://uaa.%s", serverAPIURL))
 					})
 				})
@@ -116,4 +118,25 @@ var _ = Describe("Target", func() {
 			})
 		})
 	})
+
+	Describe("TargetCFWithInfo", func() {
+		BeforeEach(func() {
+			client = NewClient(Config{AppName: "CF CLI API Target Test", AppVersion: "Unknown"})
+		})
+
+		It("sets the endpoints of the info on the client without a request", func() {
+			info := APIInformation{
+				APIVersion:            "2.59.0",
+				AuthorizationEndpoint: "https://login.some-domain.com",
+				DopplerEndpoint:       "wss://doppler.some-domain.com",
+				RoutingEndpoint:       "https://api.some-domain.com/routing",
+				TokenEndpoint:         "https://uaa.some-domain.com",
+			}
+			client.TargetCFWithInfo(TargetSettings{URL: server.URL()}, info)
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(client.API()).To(Equal(server.URL()))
+			Expect(client.TargetInfo()).To(Equal(info))
+		})
+	})
 })
@@ -0,0 +1,45 @@
+package ccv2
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// GetUserSpaces returns the spaces in which the user with the provided GUID
+// has the given role, based off of the provided filters.
+func (client *Client) GetUserSpaces(userGUID string, role constant.SpaceRole, filters ...Filter) ([]Space, Warnings, error) {
+	var requestName string
+	switch role {
+	case constant.SpaceAuditorRole:
+		requestName = internal.GetUserAuditedSpacesRequest
+	case constant.SpaceManagerRole:
+		requestName = internal.GetUserManagedSpacesRequest
+	default:
+		requestName = internal.GetUserSpacesRequest
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   map[string]string{"user_guid": userGUID},
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			fullSpacesList = append(fullSpacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpacesList, warnings, err
+}
@@ -0,0 +1,87 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("User Space", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetUserSpaces", func() {
+		DescribeTable("requests the spaces for the role",
+			func(role constant.SpaceRole, path string) {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "space-guid-1"
+							},
+							"entity": {
+								"name": "space-1",
+								"organization_guid": "org-guid-1"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, path, "q=organization_guid:org-guid-1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+
+				spaces, warnings, err := client.GetUserSpaces("some-user-guid", role, Filter{
+					Type:     constant.OrganizationGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"org-guid-1"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(spaces).To(Equal([]Space{
+					{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+				}))
+			},
+
+			Entry("developer", constant.SpaceDeveloperRole, "/v2/users/some-user-guid/spaces"),
+			Entry("auditor", constant.SpaceAuditorRole, "/v2/users/some-user-guid/audited_spaces"),
+			Entry("manager", constant.SpaceManagerRole, "/v2/users/some-user-guid/managed_spaces"),
+		)
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/users/some-user-guid/spaces"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetUserSpaces("some-user-guid", constant.SpaceDeveloperRole)
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+					Method:  http.MethodGet,
+					Path:    "/v2/users/some-user-guid/spaces",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -23,7 +23,8 @@ type Application struct {
 	LifecycleType constant.AppLifecycleType
 	// Metadata is used for custom tagging of API resources
 	Metadata struct {
-		Labels map[string]types.NullString `json:"labels,omitempty"`
+		Labels      map[string]types.NullString `json:"labels,omitempty"`
+		Annotations map[string]types.NullString `json:"annotations,omitempty"`
 	}
 	// Name is the name given to the application.
 	Name string
@@ -40,7 +41,7 @@ func (a Application) MarshalJSON() ([]byte, error) {
 		Relationships: a.Relationships,
 	}
 
-	if len(a.Metadata.Labels) != 0 {
+	if len(a.Metadata.Labels) != 0 || len(a.Metadata.Annotations) != 0 {
 		ccApp.Metadata = &a.Metadata
 	}
 
@@ -107,7 +108,8 @@ type ccApplication struct {
 	GUID          string                    `json:"guid,omitempty"`
 	State         constant.ApplicationState `json:"state,omitempty"`
 	Metadata      *struct {
-		Labels map[string]types.NullString `json:"labels,omitempty"`
+		Labels      map[string]types.NullString `json:"labels,omitempty"`
+		Annotations map[string]types.NullString `json:"annotations,omitempty"`
 	} `json:"metadata,omitempty"`
 }
 
@@ -213,6 +215,23 @@ func (client *Client) UpdateApplication(app Application) (Application, Warnings,
 	return responseApp, response.Warnings, err
 }
 
+// UpdateApplicationClearBuildpackCache deletes the buildpack cache of the
+// given application, so that its next staging starts from a clean cache.
+func (client *Client) UpdateApplicationClearBuildpackCache(appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationActionClearBuildpackCacheRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
 // UpdateApplicationRestart restarts the given application.
 func (client *Client) UpdateApplicationRestart(appGUID string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -116,7 +116,8 @@ var _ = Describe("Application", func() {
 				BeforeEach(func() {
 					app = Application{
 						Metadata: struct {
-							Labels map[string]types.NullString `json:"labels,omitempty"`
+							Labels      map[string]types.NullString `json:"labels,omitempty"`
+							Annotations map[string]types.NullString `json:"annotations,omitempty"`
 						}{
 							Labels: map[string]types.NullString{
 								"some-key":  types.NewNullString("some-value"),
@@ -140,7 +141,8 @@ var _ = Describe("Application", func() {
 					BeforeEach(func() {
 						app = Application{
 							Metadata: struct {
-								Labels map[string]types.NullString `json:"labels,omitempty"`
+								Labels      map[string]types.NullString `json:"labels,omitempty"`
+								Annotations map[string]types.NullString `json:"annotations,omitempty"`
 							}{
 								Labels: map[string]types.NullString{
 									"some-key":      types.NewNullString("some-value"),
@@ -163,6 +165,31 @@ var _ = Describe("Application", func() {
 					}`))
 					})
 				})
+
+				When("only annotations are provided", func() {
+					BeforeEach(func() {
+						app = Application{
+							Metadata: struct {
+								Labels      map[string]types.NullString `json:"labels,omitempty"`
+								Annotations map[string]types.NullString `json:"annotations,omitempty"`
+							}{
+								Annotations: map[string]types.NullString{
+									"some-key": types.NewNullString("some-value"),
+								},
+							},
+						}
+					})
+
+					It("should include the annotations in the JSON", func() {
+						Expect(string(appBytes)).To(MatchJSON(`{
+						"metadata": {
+							"annotations": {
+								"some-key":"some-value"
+							}
+						}
+					}`))
+					})
+				})
 			})
 		})
 
@@ -228,7 +255,8 @@ var _ = Describe("Application", func() {
 				It("sets the labels", func() {
 					Expect(app).To(Equal(Application{
 						Metadata: struct {
-							Labels map[string]types.NullString `json:"labels,omitempty"`
+							Labels      map[string]types.NullString `json:"labels,omitempty"`
+							Annotations map[string]types.NullString `json:"annotations,omitempty"`
 						}{
 							Labels: map[string]types.NullString{
 								"some-key": types.NewNullString("some-value"),
@@ -822,6 +850,58 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("UpdateApplicationClearBuildpackCache", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.UpdateApplicationClearBuildpackCache("some-app-guid")
+		})
+
+		When("the response succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/clear_buildpack_cache"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns warnings and no error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the CC returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/clear_buildpack_cache"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplicationRestart", func() {
 		var (
 			responseApp Application
@@ -105,12 +105,27 @@ func SetupV3Response() {
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
+			"organization_quotas": {
+				"href": "SERVER_URL/v3/organization_quotas"
+			},
+			"service_brokers": {
+				"href": "SERVER_URL/v3/service_brokers"
+			},
 			"service_instances": {
 				"href": "SERVER_URL/v3/service_instances"
 			},
+			"service_offerings": {
+				"href": "SERVER_URL/v3/service_offerings"
+			},
+			"service_plans": {
+				"href": "SERVER_URL/v3/service_plans"
+			},
 			"spaces": {
 				"href": "SERVER_URL/v3/spaces"
 			},
+			"space_quotas": {
+				"href": "SERVER_URL/v3/space_quotas"
+			},
 			"packages": {
 				"href": "SERVER_URL/v3/packages"
 			},
@@ -137,6 +152,9 @@ func SetupV3Response() {
 			},
 			"resource_matches": {
 				"href": "SERVER_URL/v3/resource_matches"
+			},
+			"routes": {
+				"href": "SERVER_URL/v3/routes"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
@@ -91,6 +91,7 @@ type Client struct {
 	cloudControllerURL string
 
 	connection cloudcontroller.Connection
+	resources  map[string]string
 	router     *internal.Router
 	userAgent  string
 	wrappers   []ConnectionWrapper
@@ -109,6 +110,10 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// UserAgentSuffix is appended to the User-Agent of every request to
+	// identify the automation using the client. It is omitted when empty.
+	UserAgentSuffix string
+
 	// JobPollingTimeout is the maximum amount of time a job polls for.
 	JobPollingTimeout time.Duration
 
@@ -122,6 +127,9 @@ type Config struct {
 // NewClient returns a new Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+	if config.UserAgentSuffix != "" {
+		userAgent += " " + config.UserAgentSuffix
+	}
 	return &Client{
 		clock:              new(internal.RealTime),
 		userAgent:          userAgent,
@@ -9,6 +9,17 @@ const (
 	// application.
 	RelationshipTypeApplication RelationshipType = "app"
 
+	// RelationshipTypeDomain is a relationship with a Cloud Controller domain.
+	RelationshipTypeDomain RelationshipType = "domain"
+
+	// RelationshipTypeServiceBroker is a relationship with a Cloud Controller
+	// service broker.
+	RelationshipTypeServiceBroker RelationshipType = "service_broker"
+
+	// RelationshipTypeServiceOffering is a relationship with a Cloud
+	// Controller service offering.
+	RelationshipTypeServiceOffering RelationshipType = "service_offering"
+
 	// RelationshipTypeSpace is a relationship with a Cloud Controller space.
 	RelationshipTypeSpace RelationshipType = "space"
 
@@ -0,0 +1,13 @@
+package constant
+
+// ServiceInstanceType is the type of a service instance.
+type ServiceInstanceType string
+
+const (
+	// ManagedServiceInstance is a service instance provisioned by a service
+	// broker.
+	ManagedServiceInstance ServiceInstanceType = "managed"
+	// UserProvidedServiceInstance is a service instance whose credentials are
+	// provided by the user.
+	UserProvidedServiceInstance ServiceInstanceType = "user-provided"
+)
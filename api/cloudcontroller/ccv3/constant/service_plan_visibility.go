@@ -0,0 +1,17 @@
+package constant
+
+// ServicePlanVisibilityType is who a service plan is visible to.
+type ServicePlanVisibilityType string
+
+const (
+	// ServicePlanVisibilityPublic means the plan is visible to all orgs.
+	ServicePlanVisibilityPublic ServicePlanVisibilityType = "public"
+	// ServicePlanVisibilityAdmin means the plan is only visible to admins.
+	ServicePlanVisibilityAdmin ServicePlanVisibilityType = "admin"
+	// ServicePlanVisibilityOrganization means the plan is visible to the orgs
+	// it lists.
+	ServicePlanVisibilityOrganization ServicePlanVisibilityType = "organization"
+	// ServicePlanVisibilitySpace means the plan is only visible to the space of
+	// its space-scoped broker.
+	ServicePlanVisibilitySpace ServicePlanVisibilityType = "space"
+)
@@ -1,8 +1,10 @@
 package ccv3_test
 
 import (
+	"fmt"
 	"net/http"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -61,4 +63,126 @@ var _ = Describe("Domain", func() {
 			})
 		})
 	})
+
+	Describe("GetDomains", func() {
+		var (
+			query      Query
+			domains    []Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domains, warnings, executeErr = client.GetDomains(query)
+		})
+
+		When("domains exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/domains?names=domain-name-1,domain-name-2,domain-name-3&page=2&per_page=2"
+		}
+	},
+	"resources": [
+		{
+			"name": "domain-name-1",
+			"guid": "domain-guid-1",
+			"internal": false
+		},
+		{
+			"name": "domain-name-2",
+			"guid": "domain-guid-2",
+			"internal": true
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "domain-name-3",
+			"guid": "domain-guid-3",
+			"internal": false
+		}
+	]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/domains", "names=domain-name-1,domain-name-2,domain-name-3"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/domains", "names=domain-name-1,domain-name-2,domain-name-3&page=2&per_page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+
+				query = Query{
+					Key:    NameFilter,
+					Values: []string{"domain-name-1", "domain-name-2", "domain-name-3"},
+				}
+			})
+
+			It("returns the queried domains and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(domains).To(ConsistOf(
+					Domain{Name: "domain-name-1", GUID: "domain-guid-1", Internal: false},
+					Domain{Name: "domain-name-2", GUID: "domain-guid-2", Internal: true},
+					Domain{Name: "domain-name-3", GUID: "domain-guid-3", Internal: false},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "The request is semantically invalid: command presence",
+			"title": "CF-UnprocessableEntity"
+		},
+		{
+			"code": 10010,
+			"detail": "Domain not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/domains"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.MultiError{
+					ResponseCode: http.StatusTeapot,
+					Errors: []ccerror.V3Error{
+						{
+							Code:   10008,
+							Detail: "The request is semantically invalid: command presence",
+							Title:  "CF-UnprocessableEntity",
+						},
+						{
+							Code:   10010,
+							Detail: "Domain not found",
+							Title:  "CF-ResourceNotFound",
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -12,6 +12,8 @@ import (
 type Droplet struct {
 	//Buildpacks are the detected buildpacks from the staging process.
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+	// Checksum is the digest of the staged droplet.
+	Checksum Checksum `json:"checksum"`
 	// CreatedAt is the timestamp that the Cloud Controller created the droplet.
 	CreatedAt string `json:"created_at"`
 	// GUID is the unique droplet identifier.
@@ -31,6 +33,10 @@ type DropletBuildpack struct {
 	Name string `json:"name"`
 	//DetectOutput is the output during buildpack detect process.
 	DetectOutput string `json:"detect_output"`
+	// BuildpackName is the name the buildpack reports for itself.
+	BuildpackName string `json:"buildpack_name"`
+	// Version is the version of the buildpack the droplet was staged with.
+	Version string `json:"version"`
 }
 
 // GetApplicationDropletCurrent returns the current droplet for a given
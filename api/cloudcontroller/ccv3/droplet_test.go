@@ -43,9 +43,15 @@ var _ = Describe("Droplet", func() {
 					"buildpacks": [
 						{
 							"name": "some-buildpack",
-							"detect_output": "detected-buildpack"
+							"detect_output": "detected-buildpack",
+							"buildpack_name": "some-buildpack-name",
+							"version": "1.2.3"
 						}
 					],
+					"checksum": {
+						"type": "sha256",
+						"value": "some-droplet-sha"
+					},
 					"image": "docker/some-image",
 					"stack": "some-stack",
 					"created_at": "2016-03-28T23:39:34Z",
@@ -68,10 +74,13 @@ var _ = Describe("Droplet", func() {
 					State: constant.DropletStaged,
 					Buildpacks: []DropletBuildpack{
 						{
-							Name:         "some-buildpack",
-							DetectOutput: "detected-buildpack",
+							Name:          "some-buildpack",
+							DetectOutput:  "detected-buildpack",
+							BuildpackName: "some-buildpack-name",
+							Version:       "1.2.3",
 						},
 					},
+					Checksum:  Checksum{Type: "sha256", Value: "some-droplet-sha"},
 					Image:     "docker/some-image",
 					CreatedAt: "2016-03-28T23:39:34Z",
 				}))
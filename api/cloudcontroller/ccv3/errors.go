@@ -57,7 +57,7 @@ func convert400(rawHTTPStatusErr ccerror.RawHTTPStatusError, request *cloudcontr
 		}
 		return ccerror.UnauthorizedError{Message: firstErr.Detail}
 	case http.StatusForbidden: // 403
-		return ccerror.ForbiddenError{Message: firstErr.Detail}
+		return ccerror.ForbiddenError{Message: firstErr.Detail, Method: request.Method, Path: request.URL.Path}
 	case http.StatusNotFound: // 404
 		return handleNotFound(firstErr, request)
 	case http.StatusUnprocessableEntity: // 422
@@ -119,7 +119,7 @@ var _ = Describe("Error Wrapper", func() {
 					})
 
 					It("returns a ForbiddenError", func() {
-						Expect(makeError).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
+						Expect(makeError).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message", Method: http.MethodGet, Path: "/v3/apps"}))
 					})
 				})
 
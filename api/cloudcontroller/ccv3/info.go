@@ -17,6 +17,9 @@ type InfoLinks struct {
 	// Logging is the link to the Logging API.
 	Logging APILink `json:"logging"`
 
+	// LogCache is the link to the Log Cache API.
+	LogCache APILink `json:"log_cache"`
+
 	// NetworkPolicyV1 is the link to the Container to Container Networking
 	// API.
 	NetworkPolicyV1 APILink `json:"network_policy_v1"`
@@ -56,6 +59,12 @@ func (info Info) Logging() string {
 	return info.Links.Logging.HREF
 }
 
+// LogCache returns the HREF of the Log Cache API, or an empty string when the
+// Cloud Controller does not advertise one.
+func (info Info) LogCache() string {
+	return info.Links.LogCache.HREF
+}
+
 // NetworkPolicyV1 returns the HREF of the Container Networking v1 Policy API
 func (info Info) NetworkPolicyV1() string {
 	return info.Links.NetworkPolicyV1.HREF
@@ -2,20 +2,26 @@ package internal
 
 // When adding a resource, also add it to the api/cloudcontroller/ccv3/ccv3_suite_test.go resources response
 const (
-	AppsResource              = "apps"
-	BuildpacksResource        = "buildpacks"
-	BuildsResource            = "builds"
-	DeploymentsResource       = "deployments"
-	DomainsResource           = "domains"
-	DropletsResource          = "droplets"
-	FeatureFlagsResource      = "feature_flags"
-	IsolationSegmentsResource = "isolation_segments"
-	OrgsResource              = "organizations"
-	PackagesResource          = "packages"
-	ProcessesResource         = "processes"
-	ResourceMatches           = "resource_matches"
-	ServiceInstancesResource  = "service_instances"
-	SpacesResource            = "spaces"
-	StacksResource            = "stacks"
-	TasksResource             = "tasks"
+	AppsResource               = "apps"
+	BuildpacksResource         = "buildpacks"
+	BuildsResource             = "builds"
+	DeploymentsResource        = "deployments"
+	DomainsResource            = "domains"
+	DropletsResource           = "droplets"
+	FeatureFlagsResource       = "feature_flags"
+	IsolationSegmentsResource  = "isolation_segments"
+	OrganizationQuotasResource = "organization_quotas"
+	OrgsResource               = "organizations"
+	PackagesResource           = "packages"
+	ProcessesResource          = "processes"
+	ResourceMatches            = "resource_matches"
+	RoutesResource             = "routes"
+	ServiceBrokersResource     = "service_brokers"
+	ServiceInstancesResource   = "service_instances"
+	ServiceOfferingsResource   = "service_offerings"
+	ServicePlansResource       = "service_plans"
+	SpaceQuotasResource        = "space_quotas"
+	SpacesResource             = "spaces"
+	StacksResource             = "stacks"
+	TasksResource              = "tasks"
 )
@@ -14,18 +14,24 @@ const (
 	DeleteBuildpackRequest                                      = "DeleteBuildpack"
 	DeleteIsolationSegmentRelationshipOrganizationRequest       = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                               = "DeleteIsolationSegment"
+	DeleteOrganizationQuotaRequest                              = "DeleteOrganizationQuota"
 	DeleteServiceInstanceRelationshipsSharedSpaceRequest        = "DeleteServiceInstanceRelationshipsSharedSpace"
+	DeleteServicePlanVisibilityOrganizationRequest              = "DeleteServicePlanVisibilityOrganization"
+	DeleteSpaceQuotaRelationshipSpaceRequest                    = "DeleteSpaceQuotaRelationshipSpace"
+	DeleteSpaceQuotaRequest                                     = "DeleteSpaceQuota"
 	GetApplicationDropletCurrentRequest                         = "GetApplicationDropletCurrent"
 	GetApplicationEnvRequest                                    = "GetApplicationEnv"
 	GetApplicationManifestRequest                               = "GetApplicationManifest"
 	GetApplicationProcessesRequest                              = "GetApplicationProcesses"
 	GetApplicationProcessRequest                                = "GetApplicationProcess"
+	GetApplicationRevisionsRequest                              = "GetApplicationRevisions"
 	GetApplicationsRequest                                      = "GetApplications"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetBuildpacksRequest                                        = "GetBuildpacks"
 	GetBuildRequest                                             = "GetBuild"
 	GetDeploymentRequest                                        = "GetDeployment"
 	GetDeploymentsRequest                                       = "GetDeployments"
+	GetDomainsRequest                                           = "GetDomains"
 	GetDropletRequest                                           = "GetDroplet"
 	GetDropletsRequest                                          = "GetDroplets"
 	GetFeatureFlagRequest                                       = "GetFeatureFlag"
@@ -33,13 +39,25 @@ const (
 	GetIsolationSegmentOrganizationsRequest                     = "GetIsolationSegmentOrganizations"
 	GetIsolationSegmentRequest                                  = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                                 = "GetIsolationSegments"
+	GetOrganizationQuotasRequest                                = "GetOrganizationQuotas"
 	GetOrganizationRelationshipDefaultIsolationSegmentRequest   = "GetOrganizationRelationshipDefaultIsolationSegment"
+	GetOrganizationUsageSummaryRequest                          = "GetOrganizationUsageSummary"
 	GetOrganizationsRequest                                     = "GetOrganizations"
+	GetPackageDownloadRequest                                   = "GetPackageDownload"
 	GetPackageRequest                                           = "GetPackage"
 	GetPackagesRequest                                          = "GetPackages"
 	GetProcessStatsRequest                                      = "GetProcessStats"
+	GetRouteDestinationsRequest                                 = "GetRouteDestinations"
+	GetRoutesRequest                                            = "GetRoutes"
+	GetServiceBrokersRequest                                    = "GetServiceBrokers"
+	GetServiceInstanceCredentialsRequest                        = "GetServiceInstanceCredentials"
 	GetServiceInstancesRequest                                  = "GetServiceInstances"
+	GetServiceOfferingsRequest                                  = "GetServiceOfferings"
+	GetServicePlanVisibilityRequest                             = "GetServicePlanVisibility"
+	GetServicePlansRequest                                      = "GetServicePlans"
+	GetSpaceQuotasRequest                                       = "GetSpaceQuotas"
 	GetSpaceRelationshipIsolationSegmentRequest                 = "GetSpaceRelationshipIsolationSegment"
+	GetSpaceUsageSummaryRequest                                 = "GetSpaceUsageSummary"
 	GetSpacesRequest                                            = "GetSpaces"
 	GetStacksRequest                                            = "GetStacks"
 	PatchApplicationCurrentDropletRequest                       = "PatchApplicationCurrentDroplet"
@@ -47,10 +65,17 @@ const (
 	PatchApplicationRequest                                     = "PatchApplication"
 	PatchBuildpackRequest                                       = "PatchBuildpack"
 	PatchFeatureFlagRequest                                     = "PatchFeatureFlag"
+	PatchOrganizationQuotaRequest                               = "PatchOrganizationQuota"
 	PatchOrganizationRelationshipDefaultIsolationSegmentRequest = "PatchOrganizationRelationshipDefaultIsolationSegment"
+	PatchOrganizationRequest                                    = "PatchOrganization"
 	PatchProcessRequest                                         = "PatchProcess"
+	PatchRouteDestinationsRequest                               = "PatchRouteDestinations"
+	PatchServicePlanVisibilityRequest                           = "PatchServicePlanVisibility"
+	PatchSpaceQuotaRequest                                      = "PatchSpaceQuota"
 	PatchSpaceRelationshipIsolationSegmentRequest               = "PatchSpaceRelationshipIsolationSegment"
+	PatchSpaceRequest                                           = "PatchSpace"
 	PostApplicationActionApplyManifest                          = "PostApplicationActionApplyM"
+	PostApplicationActionClearBuildpackCacheRequest             = "PostApplicationActionClearBuildpackCache"
 	PostApplicationActionRestartRequest                         = "PostApplicationActionRestart"
 	PostApplicationActionStartRequest                           = "PostApplicationActionStart"
 	PostApplicationActionStopRequest                            = "PostApplicationActionStop"
@@ -65,10 +90,16 @@ const (
 	PostDomainRequest                                           = "PostDomain"
 	PostIsolationSegmentRelationshipOrganizationsRequest        = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                                = "PostIsolationSegments"
+	PostOrganizationQuotaRelationshipOrganizationsRequest       = "PostOrganizationQuotaRelationshipOrganizations"
+	PostOrganizationQuotasRequest                               = "PostOrganizationQuotas"
 	PostPackageRequest                                          = "PostPackage"
 	PostResourceMatchesRequest                                  = "PostResourceMatches"
 	PostServiceInstanceRelationshipsSharedSpacesRequest         = "PostServiceInstanceRelationshipsSharedSpaces"
+	PostServiceInstanceRequest                                  = "PostServiceInstance"
+	PostServicePlanVisibilityRequest                            = "PostServicePlanVisibility"
 	PostSpaceActionApplyManifestRequest                         = "PostSpaceActionApplyManifest"
+	PostSpaceQuotaRelationshipSpacesRequest                     = "PostSpaceQuotaRelationshipSpaces"
+	PostSpaceQuotasRequest                                      = "PostSpaceQuotas"
 	PutTaskCancelRequest                                        = "PutTaskCancel"
 )
 
@@ -79,6 +110,7 @@ var APIRoutes = []Route{
 	{Resource: AppsResource, Path: "/:app_guid", Method: http.MethodDelete, Name: DeleteApplicationRequest},
 	{Resource: AppsResource, Path: "/:app_guid", Method: http.MethodPatch, Name: PatchApplicationRequest},
 	{Resource: AppsResource, Path: "/:app_guid/actions/apply_manifest", Method: http.MethodPost, Name: PostApplicationActionApplyManifest},
+	{Resource: AppsResource, Path: "/:app_guid/actions/clear_buildpack_cache", Method: http.MethodPost, Name: PostApplicationActionClearBuildpackCacheRequest},
 	{Resource: AppsResource, Path: "/:app_guid/actions/restart", Method: http.MethodPost, Name: PostApplicationActionRestartRequest},
 	{Resource: AppsResource, Path: "/:app_guid/actions/start", Method: http.MethodPost, Name: PostApplicationActionStartRequest},
 	{Resource: AppsResource, Path: "/:app_guid/actions/stop", Method: http.MethodPost, Name: PostApplicationActionStopRequest},
@@ -91,6 +123,7 @@ var APIRoutes = []Route{
 	{Resource: AppsResource, Path: "/:app_guid/processes/:type/actions/scale", Method: http.MethodPost, Name: PostApplicationProcessActionScaleRequest},
 	{Resource: AppsResource, Path: "/:app_guid/processes/:type/instances/:index", Method: http.MethodDelete, Name: DeleteApplicationProcessInstanceRequest},
 	{Resource: AppsResource, Path: "/:app_guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchApplicationCurrentDropletRequest},
+	{Resource: AppsResource, Path: "/:app_guid/revisions", Method: http.MethodGet, Name: GetApplicationRevisionsRequest},
 	{Resource: AppsResource, Path: "/:app_guid/tasks", Method: http.MethodGet, Name: GetApplicationTasksRequest},
 	{Resource: AppsResource, Path: "/:app_guid/tasks", Method: http.MethodPost, Name: PostApplicationTasksRequest},
 	{Resource: BuildpacksResource, Path: "/", Method: http.MethodGet, Name: GetBuildpacksRequest},
@@ -104,6 +137,7 @@ var APIRoutes = []Route{
 	{Resource: DeploymentsResource, Path: "/", Method: http.MethodPost, Name: PostApplicationDeploymentRequest},
 	{Resource: DeploymentsResource, Path: "/:deployment_guid", Method: http.MethodGet, Name: GetDeploymentRequest},
 	{Resource: DeploymentsResource, Path: "/:deployment_guid/actions/cancel", Method: http.MethodPost, Name: PostApplicationDeploymentActionCancelRequest},
+	{Resource: DomainsResource, Path: "/", Method: http.MethodGet, Name: GetDomainsRequest},
 	{Resource: DomainsResource, Path: "/", Method: http.MethodPost, Name: PostDomainRequest},
 	{Resource: DropletsResource, Path: "/", Method: http.MethodGet, Name: GetDropletsRequest},
 	{Resource: DropletsResource, Path: "/:droplet_guid", Method: http.MethodGet, Name: GetDropletRequest},
@@ -117,22 +151,50 @@ var APIRoutes = []Route{
 	{Resource: IsolationSegmentsResource, Path: "/:isolation_segment_guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest},
 	{Resource: IsolationSegmentsResource, Path: "/:isolation_segment_guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest},
 	{Resource: IsolationSegmentsResource, Path: "/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest},
+	{Resource: OrganizationQuotasResource, Path: "/", Method: http.MethodGet, Name: GetOrganizationQuotasRequest},
+	{Resource: OrganizationQuotasResource, Path: "/", Method: http.MethodPost, Name: PostOrganizationQuotasRequest},
+	{Resource: OrganizationQuotasResource, Path: "/:quota_guid", Method: http.MethodDelete, Name: DeleteOrganizationQuotaRequest},
+	{Resource: OrganizationQuotasResource, Path: "/:quota_guid", Method: http.MethodPatch, Name: PatchOrganizationQuotaRequest},
+	{Resource: OrganizationQuotasResource, Path: "/:quota_guid/relationships/organizations", Method: http.MethodPost, Name: PostOrganizationQuotaRelationshipOrganizationsRequest},
 	{Resource: OrgsResource, Path: "/", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Resource: OrgsResource, Path: "/:organization_guid", Method: http.MethodPatch, Name: PatchOrganizationRequest},
 	{Resource: OrgsResource, Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationRelationshipDefaultIsolationSegmentRequest},
 	{Resource: OrgsResource, Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationRelationshipDefaultIsolationSegmentRequest},
+	{Resource: OrgsResource, Path: "/:organization_guid/usage_summary", Method: http.MethodGet, Name: GetOrganizationUsageSummaryRequest},
 	{Resource: PackagesResource, Path: "/", Method: http.MethodGet, Name: GetPackagesRequest},
 	{Resource: PackagesResource, Path: "/", Method: http.MethodPost, Name: PostPackageRequest},
 	{Resource: PackagesResource, Path: "/:package_guid", Method: http.MethodGet, Name: GetPackageRequest},
+	{Resource: PackagesResource, Path: "/:package_guid/download", Method: http.MethodGet, Name: GetPackageDownloadRequest},
 	{Resource: ProcessesResource, Path: "/:process_guid", Method: http.MethodPatch, Name: PatchProcessRequest},
 	{Resource: ProcessesResource, Path: "/:process_guid/stats", Method: http.MethodGet, Name: GetProcessStatsRequest},
 	{Resource: ResourceMatches, Path: "/", Method: http.MethodPost, Name: PostResourceMatchesRequest},
+	{Resource: RoutesResource, Path: "/", Method: http.MethodGet, Name: GetRoutesRequest},
+	{Resource: RoutesResource, Path: "/:route_guid/destinations", Method: http.MethodGet, Name: GetRouteDestinationsRequest},
+	{Resource: RoutesResource, Path: "/:route_guid/destinations", Method: http.MethodPatch, Name: PatchRouteDestinationsRequest},
+	{Resource: ServiceBrokersResource, Path: "/", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Resource: ServiceInstancesResource, Path: "/", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Resource: ServiceInstancesResource, Path: "/", Method: http.MethodPost, Name: PostServiceInstanceRequest},
+	{Resource: ServiceInstancesResource, Path: "/:service_instance_guid/credentials", Method: http.MethodGet, Name: GetServiceInstanceCredentialsRequest},
 	{Resource: ServiceInstancesResource, Path: "/:service_instance_guid/relationships/shared_spaces", Method: http.MethodPost, Name: PostServiceInstanceRelationshipsSharedSpacesRequest},
 	{Resource: ServiceInstancesResource, Path: "/:service_instance_guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRelationshipsSharedSpaceRequest},
+	{Resource: ServiceOfferingsResource, Path: "/", Method: http.MethodGet, Name: GetServiceOfferingsRequest},
+	{Resource: ServicePlansResource, Path: "/", Method: http.MethodGet, Name: GetServicePlansRequest},
+	{Resource: ServicePlansResource, Path: "/:service_plan_guid/visibility", Method: http.MethodGet, Name: GetServicePlanVisibilityRequest},
+	{Resource: ServicePlansResource, Path: "/:service_plan_guid/visibility", Method: http.MethodPatch, Name: PatchServicePlanVisibilityRequest},
+	{Resource: ServicePlansResource, Path: "/:service_plan_guid/visibility", Method: http.MethodPost, Name: PostServicePlanVisibilityRequest},
+	{Resource: ServicePlansResource, Path: "/:service_plan_guid/visibility/:organization_guid", Method: http.MethodDelete, Name: DeleteServicePlanVisibilityOrganizationRequest},
+	{Resource: SpaceQuotasResource, Path: "/", Method: http.MethodGet, Name: GetSpaceQuotasRequest},
+	{Resource: SpaceQuotasResource, Path: "/", Method: http.MethodPost, Name: PostSpaceQuotasRequest},
+	{Resource: SpaceQuotasResource, Path: "/:quota_guid", Method: http.MethodDelete, Name: DeleteSpaceQuotaRequest},
+	{Resource: SpaceQuotasResource, Path: "/:quota_guid", Method: http.MethodPatch, Name: PatchSpaceQuotaRequest},
+	{Resource: SpaceQuotasResource, Path: "/:quota_guid/relationships/spaces", Method: http.MethodPost, Name: PostSpaceQuotaRelationshipSpacesRequest},
+	{Resource: SpaceQuotasResource, Path: "/:quota_guid/relationships/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceQuotaRelationshipSpaceRequest},
 	{Resource: SpacesResource, Path: "/", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Resource: SpacesResource, Path: "/:space_guid", Method: http.MethodPatch, Name: PatchSpaceRequest},
 	{Resource: SpacesResource, Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest},
 	{Resource: SpacesResource, Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest},
 	{Resource: SpacesResource, Path: "/:space_guid/actions/apply_manifest", Method: http.MethodPost, Name: PostSpaceActionApplyManifestRequest},
+	{Resource: SpacesResource, Path: "/:space_guid/usage_summary", Method: http.MethodGet, Name: GetSpaceUsageSummaryRequest},
 	{Resource: StacksResource, Path: "/", Method: http.MethodGet, Name: GetStacksRequest},
 	{Resource: TasksResource, Path: "/:task_guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest},
 }
@@ -0,0 +1,10 @@
+package ccv3
+
+import "code.cloudfoundry.org/cli/types"
+
+// Metadata is used for custom tagging of API resources. A key set to a null
+// value is removed from the resource when it is updated.
+type Metadata struct {
+	Labels      map[string]types.NullString `json:"labels,omitempty"`
+	Annotations map[string]types.NullString `json:"annotations,omitempty"`
+}
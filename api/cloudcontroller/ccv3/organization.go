@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
@@ -11,6 +15,8 @@ type Organization struct {
 	GUID string `json:"guid"`
 	// Name is the name of the organization.
 	Name string `json:"name"`
+	// Metadata is the labels and annotations of the organization.
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 // GetIsolationSegmentOrganizations lists organizations
@@ -65,3 +71,35 @@ func (client *Client) GetOrganizations(query ...Query) ([]Organization, Warnings
 
 	return fullOrgsList, warnings, err
 }
+
+// UpdateOrganization updates the name and metadata of the organization with
+// the given GUID. Metadata keys that are not provided are left unchanged.
+func (client *Client) UpdateOrganization(org Organization) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}{
+		Name:     org.Name,
+		Metadata: org.Metadata,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchOrganizationRequest,
+		URIParams:   internal.Params{"organization_guid": org.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var responseOrg Organization
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseOrg,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseOrg, response.Warnings, err
+}
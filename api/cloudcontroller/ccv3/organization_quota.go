@@ -0,0 +1,201 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// OrganizationQuota represents a Cloud Controller organization quota.
+type OrganizationQuota struct {
+	// GUID is the unique organization quota identifier.
+	GUID string
+	// Name is the name of the organization quota.
+	Name string
+	// QuotaLimits are the limits the quota applies.
+	QuotaLimits
+	// OrganizationGUIDs are the organizations the quota is applied to.
+	OrganizationGUIDs []string
+}
+
+// MarshalJSON converts an OrganizationQuota into a Cloud Controller
+// organization quota. Applied organizations are only included when set.
+func (q OrganizationQuota) MarshalJSON() ([]byte, error) {
+	type ccRelationships struct {
+		Organizations RelationshipList `json:"organizations"`
+	}
+	var ccQuota struct {
+		Name          string           `json:"name,omitempty"`
+		Apps          AppLimits        `json:"apps"`
+		Services      ServiceLimits    `json:"services"`
+		Routes        RouteLimits      `json:"routes"`
+		Relationships *ccRelationships `json:"relationships,omitempty"`
+	}
+
+	ccQuota.Name = q.Name
+	ccQuota.Apps = q.Apps
+	ccQuota.Services = q.Services
+	ccQuota.Routes = q.Routes
+
+	if len(q.OrganizationGUIDs) > 0 {
+		ccQuota.Relationships = &ccRelationships{
+			Organizations: RelationshipList{GUIDs: q.OrganizationGUIDs},
+		}
+	}
+
+	return json.Marshal(ccQuota)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller organization quota
+// response.
+func (q *OrganizationQuota) UnmarshalJSON(data []byte) error {
+	var ccQuota struct {
+		GUID          string        `json:"guid"`
+		Name          string        `json:"name"`
+		Apps          AppLimits     `json:"apps"`
+		Services      ServiceLimits `json:"services"`
+		Routes        RouteLimits   `json:"routes"`
+		Relationships struct {
+			Organizations RelationshipList `json:"organizations"`
+		} `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccQuota)
+	if err != nil {
+		return err
+	}
+
+	q.GUID = ccQuota.GUID
+	q.Name = ccQuota.Name
+	q.Apps = ccQuota.Apps
+	q.Services = ccQuota.Services
+	q.Routes = ccQuota.Routes
+	q.OrganizationGUIDs = ccQuota.Relationships.Organizations.GUIDs
+
+	return nil
+}
+
+// ApplyOrganizationQuota applies the organization quota to the organization.
+func (client *Client) ApplyOrganizationQuota(quotaGUID string, orgGUID string) (RelationshipList, Warnings, error) {
+	body, err := json.Marshal(RelationshipList{GUIDs: []string{orgGUID}})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationQuotaRelationshipOrganizationsRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &relationships,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationships, response.Warnings, err
+}
+
+// CreateOrganizationQuota creates an organization quota with the provided
+// name and limits.
+func (client *Client) CreateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationQuotasRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var responseQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseQuota, response.Warnings, err
+}
+
+// DeleteOrganizationQuota deletes the organization quota with the given
+// GUID. Returns back a resulting job URL to poll.
+func (client *Client) DeleteOrganizationQuota(quotaGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteOrganizationQuotaRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// GetOrganizationQuotas lists organization quotas with optional filters.
+func (client *Client) GetOrganizationQuotas(query ...Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotasRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if quota, ok := item.(OrganizationQuota); ok {
+			fullQuotasList = append(fullQuotasList, quota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullQuotasList, warnings, err
+}
+
+// UpdateOrganizationQuota updates the name and limits of the organization
+// quota with the given GUID. Nil limits are left unchanged.
+func (client *Client) UpdateOrganizationQuota(quota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	quotaGUID := quota.GUID
+	quota.OrganizationGUIDs = nil
+
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchOrganizationQuotaRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var responseQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseQuota, response.Warnings, err
+}
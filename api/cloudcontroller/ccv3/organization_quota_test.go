@@ -0,0 +1,350 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Organization Quotas", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetOrganizationQuotas", func() {
+		var (
+			query Query
+
+			quotas     []OrganizationQuota
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			quotas, warnings, executeErr = client.GetOrganizationQuotas(query)
+		})
+
+		When("organization quotas exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/organization_quotas?names=some-quota&page=2&per_page=1"
+		}
+	},
+	"resources": [
+		{
+			"guid": "quota-guid-1",
+			"name": "quota-1",
+			"apps": {
+				"total_memory_in_mb": 10240,
+				"per_process_memory_in_mb": null,
+				"total_instances": 25,
+				"per_app_tasks": 5,
+				"log_rate_limit_in_bytes_per_second": 1024
+			},
+			"services": {
+				"paid_services_allowed": true,
+				"total_service_instances": 10
+			},
+			"routes": {
+				"total_routes": 100,
+				"total_reserved_ports": 0
+			},
+			"relationships": {
+				"organizations": {
+					"data": [{ "guid": "org-guid-1" }]
+				}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "quota-guid-2",
+			"name": "quota-2",
+			"apps": {},
+			"services": {
+				"paid_services_allowed": false
+			},
+			"routes": {},
+			"relationships": {
+				"organizations": {
+					"data": []
+				}
+			}
+		}
+	]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/organization_quotas", "names=some-quota"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/organization_quotas", "names=some-quota&page=2&per_page=1"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+
+				query = Query{
+					Key:    NameFilter,
+					Values: []string{"some-quota"},
+				}
+			})
+
+			It("returns the queried organization quotas and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				paidServicesAllowed := true
+				paidServicesNotAllowed := false
+				Expect(quotas).To(ConsistOf(
+					OrganizationQuota{
+						GUID: "quota-guid-1",
+						Name: "quota-1",
+						QuotaLimits: QuotaLimits{
+							Apps: AppLimits{
+								TotalMemory:    &types.NullInt{IsSet: true, Value: 10240},
+								TotalInstances: &types.NullInt{IsSet: true, Value: 25},
+								PerAppTasks:    &types.NullInt{IsSet: true, Value: 5},
+								LogRateLimit:   &types.NullInt{IsSet: true, Value: 1024},
+							},
+							Services: ServiceLimits{
+								PaidServicePlans:      &paidServicesAllowed,
+								TotalServiceInstances: &types.NullInt{IsSet: true, Value: 10},
+							},
+							Routes: RouteLimits{
+								TotalRoutes:        &types.NullInt{IsSet: true, Value: 100},
+								TotalReservedPorts: &types.NullInt{IsSet: true, Value: 0},
+							},
+						},
+						OrganizationGUIDs: []string{"org-guid-1"},
+					},
+					OrganizationQuota{
+						GUID: "quota-guid-2",
+						Name: "quota-2",
+						QuotaLimits: QuotaLimits{
+							Services: ServiceLimits{
+								PaidServicePlans: &paidServicesNotAllowed,
+							},
+						},
+					},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "The request is semantically invalid: command presence",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/organization_quotas"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				query = Query{}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateOrganizationQuota", func() {
+		var (
+			quota         OrganizationQuota
+			returnedQuota OrganizationQuota
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			quota = OrganizationQuota{
+				Name: "some-quota",
+				QuotaLimits: QuotaLimits{
+					Apps: AppLimits{
+						TotalMemory:    &types.NullInt{IsSet: true, Value: 2048},
+						InstanceMemory: &types.NullInt{},
+						PerAppTasks:    &types.NullInt{IsSet: true, Value: 3},
+					},
+				},
+			}
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/organization_quotas"),
+					VerifyJSON(`{
+						"name": "some-quota",
+						"apps": {
+							"total_memory_in_mb": 2048,
+							"per_process_memory_in_mb": null,
+							"per_app_tasks": 3
+						},
+						"services": {},
+						"routes": {}
+					}`),
+					RespondWith(http.StatusCreated, `{
+						"guid": "some-quota-guid",
+						"name": "some-quota",
+						"apps": {
+							"total_memory_in_mb": 2048,
+							"per_process_memory_in_mb": null,
+							"per_app_tasks": 3
+						}
+					}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			returnedQuota, warnings, executeErr = client.CreateOrganizationQuota(quota)
+		})
+
+		It("creates the quota and returns it with all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(returnedQuota.GUID).To(Equal("some-quota-guid"))
+			Expect(returnedQuota.Apps.TotalMemory).To(Equal(&types.NullInt{IsSet: true, Value: 2048}))
+			Expect(returnedQuota.Apps.InstanceMemory).To(BeNil())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/organization_quotas/some-quota-guid"),
+					VerifyJSON(`{
+						"name": "new-name",
+						"apps": {
+							"log_rate_limit_in_bytes_per_second": null
+						},
+						"services": {},
+						"routes": {
+							"total_routes": 5
+						}
+					}`),
+					RespondWith(http.StatusOK, `{"guid": "some-quota-guid", "name": "new-name"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.UpdateOrganizationQuota(OrganizationQuota{
+				GUID: "some-quota-guid",
+				Name: "new-name",
+				QuotaLimits: QuotaLimits{
+					Apps:   AppLimits{LogRateLimit: &types.NullInt{}},
+					Routes: RouteLimits{TotalRoutes: &types.NullInt{IsSet: true, Value: 5}},
+				},
+				OrganizationGUIDs: []string{"org-guid"},
+			})
+		})
+
+		It("only sends the name and limits", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("DeleteOrganizationQuota", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/organization_quotas/some-quota-guid"),
+					RespondWith(http.StatusAccepted, nil, http.Header{
+						"X-Cf-Warnings": {"this is a warning"},
+						"Location":      {"/v3/jobs/some-job"},
+					}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteOrganizationQuota("some-quota-guid")
+		})
+
+		It("returns the job URL and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job")))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("ApplyOrganizationQuota", func() {
+		var (
+			relationships RelationshipList
+			warnings      Warnings
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/organization_quotas/some-quota-guid/relationships/organizations"),
+					VerifyJSON(`{"data": [{"guid": "some-org-guid"}]}`),
+					RespondWith(http.StatusOK, `{"data": [{"guid": "some-org-guid"}]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			relationships, warnings, executeErr = client.ApplyOrganizationQuota("some-quota-guid", "some-org-guid")
+		})
+
+		It("applies the quota to the organization", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(relationships.GUIDs).To(ConsistOf("some-org-guid"))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
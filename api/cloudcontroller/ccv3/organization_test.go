@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -244,4 +245,80 @@ var _ = Describe("Organizations", func() {
 			})
 		})
 	})
+
+	Describe("UpdateOrganization", func() {
+		var (
+			org        Organization
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			org, warnings, executeErr = client.UpdateOrganization(Organization{
+				GUID: "org-guid",
+				Metadata: &Metadata{
+					Annotations: map[string]types.NullString{
+						"owner":   types.NewNullString("jane"),
+						"contact": types.NewNullString(),
+					},
+				},
+			})
+		})
+
+		When("the update succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := `{"metadata":{"annotations":{"contact":null,"owner":"jane"}}}`
+				response := `{
+  "name": "org-name",
+  "guid": "org-guid",
+  "metadata": {
+    "labels": {},
+    "annotations": {"owner": "jane"}
+  }
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/org-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the metadata and returns the updated org and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(org.GUID).To(Equal("org-guid"))
+				Expect(org.Name).To(Equal("org-name"))
+				Expect(org.Metadata.Annotations).To(Equal(map[string]types.NullString{
+					"owner": types.NewNullString("jane"),
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Organization not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/org-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Organization not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -18,6 +18,10 @@ import (
 
 // Package represents a Cloud Controller V3 Package.
 type Package struct {
+	// Checksum is the digest of the uploaded bits. It is only set for bits
+	// packages that have finished processing.
+	Checksum Checksum
+
 	// CreatedAt is the time with zone when the object was created.
 	CreatedAt string
 
@@ -90,9 +94,10 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 		State         constant.PackageState `json:"state,omitempty"`
 		Type          constant.PackageType  `json:"type,omitempty"`
 		Data          struct {
-			Image    string `json:"image"`
-			Username string `json:"username"`
-			Password string `json:"password"`
+			Checksum Checksum `json:"checksum"`
+			Image    string   `json:"image"`
+			Username string   `json:"username"`
+			Password string   `json:"password"`
 		} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccPackage)
@@ -106,6 +111,7 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	p.Relationships = ccPackage.Relationships
 	p.State = ccPackage.State
 	p.Type = ccPackage.Type
+	p.Checksum = ccPackage.Data.Checksum
 	p.DockerImage = ccPackage.Data.Image
 	p.DockerUsername = ccPackage.Data.Username
 	p.DockerPassword = ccPackage.Data.Password
@@ -157,6 +163,23 @@ func (client *Client) GetPackage(packageGUID string) (Package, Warnings, error)
 	return responsePackage, response.Warnings, err
 }
 
+// DownloadPackage returns the bits of the bits package with the given GUID
+// as a zip archive.
+func (client *Client) DownloadPackage(packageGUID string) ([]byte, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetPackageDownloadRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.RawResponse, response.Warnings, err
+}
+
 // GetPackages returns the list of packages.
 func (client *Client) GetPackages(query ...Query) ([]Package, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
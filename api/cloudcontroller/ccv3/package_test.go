@@ -310,6 +310,60 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("DownloadPackage", func() {
+		var (
+			bits       []byte
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			bits, warnings, executeErr = client.DownloadPackage("some-pkg-guid")
+		})
+
+		When("the package bits exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusOK, "some-zip-bits", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the bits and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(string(bits)).To(Equal("some-zip-bits"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the package does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("GetPackages", func() {
 		var (
 			pkgs       []Package
@@ -342,6 +396,13 @@ var _ = Describe("Package", func() {
 							"type": "bits",
 						  "state": "READY",
 							"created_at": "2017-08-14T21:20:13Z",
+							"data": {
+								"checksum": {
+									"type": "sha256",
+									"value": "some-package-sha"
+								},
+								"error": null
+							},
 							"links": {
 								"upload": {
 									"href": "some-pkg-upload-url-2",
@@ -377,6 +438,7 @@ var _ = Describe("Package", func() {
 						Type:      constant.PackageTypeBits,
 						State:     constant.PackageReady,
 						CreatedAt: "2017-08-14T21:20:13Z",
+						Checksum:  Checksum{Type: "sha256", Value: "some-package-sha"},
 						Links: map[string]APILink{
 							"upload": APILink{HREF: "some-pkg-upload-url-2", Method: http.MethodPost},
 						},
@@ -25,6 +25,9 @@ type Process struct {
 	Instances                    types.NullInt
 	MemoryInMB                   types.NullUint64
 	DiskInMB                     types.NullUint64
+	// LogRateLimitInBPS is the log rate limit of each instance in bytes per
+	// second. A value of -1 means the log rate is unlimited.
+	LogRateLimitInBPS types.NullInt
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -34,6 +37,7 @@ func (p Process) MarshalJSON() ([]byte, error) {
 	marshalInstances(p, &ccProcess)
 	marshalMemory(p, &ccProcess)
 	marshalDisk(p, &ccProcess)
+	marshalLogRateLimit(p, &ccProcess)
 	marshalHealthCheck(p, &ccProcess)
 
 	return json.Marshal(ccProcess)
@@ -48,6 +52,8 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 		MemoryInMB types.NullUint64     `json:"memory_in_mb"`
 		Type       string               `json:"type"`
 
+		LogRateLimitInBPS types.NullInt `json:"log_rate_limit_in_bytes_per_second"`
+
 		HealthCheck struct {
 			Type constant.HealthCheckType `json:"type"`
 			Data struct {
@@ -71,13 +77,15 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 	p.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
 	p.HealthCheckType = ccProcess.HealthCheck.Type
 	p.Instances = ccProcess.Instances
+	p.LogRateLimitInBPS = ccProcess.LogRateLimitInBPS
 	p.MemoryInMB = ccProcess.MemoryInMB
 	p.Type = ccProcess.Type
 
 	return nil
 }
 
-// CreateApplicationProcessScale updates process instances count, memory or disk
+// CreateApplicationProcessScale updates process instances count, memory, disk
+// or log rate limit
 func (client *Client) CreateApplicationProcessScale(appGUID string, process Process) (Process, Warnings, error) {
 	body, err := json.Marshal(process)
 	if err != nil {
@@ -196,6 +204,8 @@ type marshalProcess struct {
 	MemoryInMB json.Number `json:"memory_in_mb,omitempty"`
 	DiskInMB   json.Number `json:"disk_in_mb,omitempty"`
 
+	LogRateLimitInBPS json.Number `json:"log_rate_limit_in_bytes_per_second,omitempty"`
+
 	HealthCheck *healthCheck `json:"health_check,omitempty"`
 }
 
@@ -229,6 +239,12 @@ func marshalInstances(p Process, ccProcess *marshalProcess) {
 	}
 }
 
+func marshalLogRateLimit(p Process, ccProcess *marshalProcess) {
+	if p.LogRateLimitInBPS.IsSet {
+		ccProcess.LogRateLimitInBPS = json.Number(fmt.Sprint(p.LogRateLimitInBPS.Value))
+	}
+}
+
 func marshalMemory(p Process, ccProcess *marshalProcess) {
 	if p.MemoryInMB.IsSet {
 		ccProcess.MemoryInMB = json.Number(fmt.Sprint(p.MemoryInMB.Value))
@@ -22,6 +22,8 @@ type ProcessInstance struct {
 	DiskQuota uint64
 	// DiskUsage is the current disk usage of the instance.
 	DiskUsage uint64
+	// Host is the address of the Diego cell running the instance.
+	Host string
 	// Index is the index of the instance.
 	Index int64
 	// Isolation segment is the current isolation segment that the instance is
@@ -38,6 +40,9 @@ type ProcessInstance struct {
 	Type string
 	// Uptime is the duration that the instance has been running.
 	Uptime time.Duration
+	// Zone is the availability zone of the Diego cell running the instance.
+	// The value is empty when the Cloud Controller does not report it.
+	Zone string
 }
 
 // UnmarshalJSON helps unmarshal a V3 Cloud Controller Instance response.
@@ -45,6 +50,7 @@ func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
 	var inputInstance struct {
 		Details          string `json:"details"`
 		DiskQuota        uint64 `json:"disk_quota"`
+		Host             string `json:"host"`
 		Index            int64  `json:"index"`
 		IsolationSegment string `json:"isolation_segment"`
 		MemQuota         uint64 `json:"mem_quota"`
@@ -56,6 +62,7 @@ func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
 			Mem  uint64  `json:"mem"`
 			Disk uint64  `json:"disk"`
 		} `json:"usage"`
+		Zone string `json:"zone"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &inputInstance)
@@ -67,12 +74,14 @@ func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
 	instance.Details = inputInstance.Details
 	instance.DiskQuota = inputInstance.DiskQuota
 	instance.DiskUsage = inputInstance.Usage.Disk
+	instance.Host = inputInstance.Host
 	instance.Index = inputInstance.Index
 	instance.IsolationSegment = inputInstance.IsolationSegment
 	instance.MemoryQuota = inputInstance.MemQuota
 	instance.MemoryUsage = inputInstance.Usage.Mem
 	instance.State = constant.ProcessInstanceState(inputInstance.State)
 	instance.Type = inputInstance.Type
+	instance.Zone = inputInstance.Zone
 	instance.Uptime, err = time.ParseDuration(fmt.Sprintf("%ds", inputInstance.Uptime))
 	if err != nil {
 		return err
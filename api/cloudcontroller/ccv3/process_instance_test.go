@@ -100,7 +100,9 @@ var _ = Describe("ProcessInstance", func() {
 							"isolation_segment": "example_iso_segment",
 							"index": 0,
 							"uptime": 123,
-							"details": "some details"
+							"details": "some details",
+							"host": "10.0.16.5",
+							"zone": "z1"
 						},
 						{
 						  "type": "web",
@@ -135,6 +137,7 @@ var _ = Describe("ProcessInstance", func() {
 						Details:          "some details",
 						DiskQuota:        4000000,
 						DiskUsage:        2000000,
+						Host:             "10.0.16.5",
 						Index:            0,
 						IsolationSegment: "example_iso_segment",
 						MemoryQuota:      2000000,
@@ -142,6 +145,7 @@ var _ = Describe("ProcessInstance", func() {
 						State:            constant.ProcessInstanceRunning,
 						Type:             "web",
 						Uptime:           123 * time.Second,
+						Zone:             "z1",
 					},
 					ProcessInstance{
 						CPU:              0.02,
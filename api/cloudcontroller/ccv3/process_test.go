@@ -75,6 +75,18 @@ var _ = Describe("Process", func() {
 				})
 			})
 
+			When("log rate limit is provided", func() {
+				BeforeEach(func() {
+					process = Process{
+						LogRateLimitInBPS: types.NullInt{Value: -1, IsSet: true},
+					}
+				})
+
+				It("sets the log rate limit to be set", func() {
+					Expect(string(processBytes)).To(MatchJSON(`{"log_rate_limit_in_bytes_per_second": -1}`))
+				})
+			})
+
 			When("health check type http is provided", func() {
 				BeforeEach(func() {
 					process = Process{
@@ -173,6 +185,16 @@ var _ = Describe("Process", func() {
 					}))
 				})
 			})
+
+			When("log rate limit is provided", func() {
+				BeforeEach(func() {
+					processBytes = []byte(`{"log_rate_limit_in_bytes_per_second": 16384}`)
+				})
+
+				It("sets the log rate limit", func() {
+					Expect(process.LogRateLimitInBPS).To(Equal(types.NullInt{Value: 16384, IsSet: true}))
+				})
+			})
 		})
 	})
 
@@ -343,6 +365,7 @@ var _ = Describe("Process", func() {
 					"instances": 22,
 					"memory_in_mb": 32,
 					"disk_in_mb": 1024,
+					"log_rate_limit_in_bytes_per_second": 1024,
 					"health_check": {
 						"type": "http",
 						"data": {
@@ -370,6 +393,7 @@ var _ = Describe("Process", func() {
 					"Instances":                    Equal(types.NullInt{Value: 22, IsSet: true}),
 					"MemoryInMB":                   Equal(types.NullUint64{Value: 32, IsSet: true}),
 					"DiskInMB":                     Equal(types.NullUint64{Value: 1024, IsSet: true}),
+					"LogRateLimitInBPS":            Equal(types.NullInt{Value: 1024, IsSet: true}),
 					"HealthCheckType":              Equal(constant.HTTP),
 					"HealthCheckEndpoint":          Equal("/health"),
 					"HealthCheckInvocationTimeout": BeEquivalentTo(42),
@@ -11,18 +11,39 @@ type QueryKey string
 const (
 	// AppGUIDFilter is a query parameter for listing objects by app GUID.
 	AppGUIDFilter QueryKey = "app_guids"
+	// DomainGUIDFilter is a query parameter for listing objects by domain GUID.
+	DomainGUIDFilter QueryKey = "domain_guids"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
+	// HostsFilter is a query parameter for listing routes by host.
+	HostsFilter QueryKey = "hosts"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
 	OrganizationGUIDFilter QueryKey = "organization_guids"
+	// PathsFilter is a query parameter for listing routes by path.
+	PathsFilter QueryKey = "paths"
 	// SequenceIDFilter is a query parameter for listing objects by sequence ID.
 	SequenceIDFilter QueryKey = "sequence_ids"
+	// ServiceBrokerNamesFilter is a query parameter for listing service
+	// offerings and plans by service broker name.
+	ServiceBrokerNamesFilter QueryKey = "service_broker_names"
+	// ServiceOfferingGUIDsFilter is a query parameter for listing service plans
+	// by service offering GUID.
+	ServiceOfferingGUIDsFilter QueryKey = "service_offering_guids"
+	// ServiceOfferingNamesFilter is a query parameter for listing service plans
+	// by service offering name.
+	ServiceOfferingNamesFilter QueryKey = "service_offering_names"
 	// SpaceGUIDFilter is a query parameter for listing objects by Space GUID.
 	SpaceGUIDFilter QueryKey = "space_guids"
 	// StackFilter is a query parameter for listing objects by stack name
 	StackFilter QueryKey = "stacks"
+	// StatesFilter is a query parameter for listing objects by state.
+	StatesFilter QueryKey = "states"
+	// TypeFilter is a query parameter for listing objects by type.
+	TypeFilter QueryKey = "type"
+	// VersionsFilter is a query parameter for listing revisions by version.
+	VersionsFilter QueryKey = "versions"
 
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
@@ -36,6 +57,10 @@ const (
 	// PositionOrder is a query value for ordering by position. This value is
 	// used in conjunction with the OrderBy QueryKey.
 	PositionOrder = "position"
+
+	// NewestFirstOrder is a query value for ordering by creation time, newest
+	// first. This value is used in conjunction with the OrderBy QueryKey.
+	NewestFirstOrder = "-created_at"
 )
 
 // Query is additional settings that can be passed to some requests that can
@@ -0,0 +1,50 @@
+package ccv3
+
+import "code.cloudfoundry.org/cli/types"
+
+// QuotaLimits represents the limits shared by Cloud Controller organization
+// and space quotas.
+//
+// A nil limit is omitted from requests, leaving the current value untouched
+// on update. A limit whose value is not set is sent as null, which the Cloud
+// Controller treats as unlimited. Unlimited limits are returned as nil.
+type QuotaLimits struct {
+	// Apps are the application limits of the quota.
+	Apps AppLimits `json:"apps"`
+	// Services are the service limits of the quota.
+	Services ServiceLimits `json:"services"`
+	// Routes are the route limits of the quota.
+	Routes RouteLimits `json:"routes"`
+}
+
+// AppLimits represents the application limits of a quota.
+type AppLimits struct {
+	// TotalMemory is the total memory, in MB, of all started app instances.
+	TotalMemory *types.NullInt `json:"total_memory_in_mb,omitempty"`
+	// InstanceMemory is the maximum memory, in MB, of a single app instance.
+	InstanceMemory *types.NullInt `json:"per_process_memory_in_mb,omitempty"`
+	// TotalInstances is the total number of started app instances.
+	TotalInstances *types.NullInt `json:"total_instances,omitempty"`
+	// PerAppTasks is the maximum number of running tasks per app.
+	PerAppTasks *types.NullInt `json:"per_app_tasks,omitempty"`
+	// LogRateLimit is the maximum log rate, in bytes per second, of a single
+	// app instance.
+	LogRateLimit *types.NullInt `json:"log_rate_limit_in_bytes_per_second,omitempty"`
+}
+
+// ServiceLimits represents the service limits of a quota.
+type ServiceLimits struct {
+	// PaidServicePlans determines if instances of paid service plans can be
+	// provisioned.
+	PaidServicePlans *bool `json:"paid_services_allowed,omitempty"`
+	// TotalServiceInstances is the total number of service instances.
+	TotalServiceInstances *types.NullInt `json:"total_service_instances,omitempty"`
+}
+
+// RouteLimits represents the route limits of a quota.
+type RouteLimits struct {
+	// TotalRoutes is the total number of routes.
+	TotalRoutes *types.NullInt `json:"total_routes,omitempty"`
+	// TotalReservedPorts is the total number of routes with reserved ports.
+	TotalReservedPorts *types.NullInt `json:"total_reserved_ports,omitempty"`
+}
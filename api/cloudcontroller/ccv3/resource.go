@@ -10,7 +10,12 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+// Checksum is the digest of a resource, package or droplet.
 type Checksum struct {
+	// Type is the hash algorithm, such as sha256. It is only set on packages
+	// and droplets.
+	Type string `json:"type,omitempty"`
+	// Value is the hex encoded digest.
 	Value string `json:"value"`
 }
 
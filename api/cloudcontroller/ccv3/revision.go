@@ -0,0 +1,120 @@
+package ccv3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Revision represents a Cloud Controller revision: a snapshot of the droplet,
+// environment variables and process commands an application ran with.
+type Revision struct {
+	// GUID is the unique revision identifier.
+	GUID string
+	// Version is the application-scoped, incrementing revision number.
+	Version int
+	// Description is a summary of what changed in the revision.
+	Description string
+	// Deployable is whether the revision can be deployed.
+	Deployable bool
+	// DropletGUID is the GUID of the droplet of the revision.
+	DropletGUID string
+	// ProcessCommands maps each process type to the command it runs with.
+	ProcessCommands map[string]string
+	// CreatedAt is the timestamp that the Cloud Controller created the
+	// revision.
+	CreatedAt string
+	// Links are the links to the revision's environment variables and
+	// related resources.
+	Links APILinks
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Revision response.
+func (r *Revision) UnmarshalJSON(data []byte) error {
+	var ccRevision struct {
+		GUID        string `json:"guid"`
+		Version     int    `json:"version"`
+		Description string `json:"description"`
+		Deployable  bool   `json:"deployable"`
+		Droplet     struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Processes map[string]struct {
+			Command *string `json:"command"`
+		} `json:"processes"`
+		CreatedAt string   `json:"created_at"`
+		Links     APILinks `json:"links"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccRevision)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRevision.GUID
+	r.Version = ccRevision.Version
+	r.Description = ccRevision.Description
+	r.Deployable = ccRevision.Deployable
+	r.DropletGUID = ccRevision.Droplet.GUID
+	r.CreatedAt = ccRevision.CreatedAt
+	r.Links = ccRevision.Links
+
+	r.ProcessCommands = map[string]string{}
+	for processType, process := range ccRevision.Processes {
+		if process.Command != nil {
+			r.ProcessCommands[processType] = *process.Command
+		} else {
+			r.ProcessCommands[processType] = ""
+		}
+	}
+
+	return nil
+}
+
+// GetApplicationRevisions lists the revisions of an application with optional
+// filters.
+func (client *Client) GetApplicationRevisions(appGUID string, query ...Query) ([]Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationRevisionsRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseRevisions []Revision
+	warnings, err := client.paginate(request, Revision{}, func(item interface{}) error {
+		if revision, ok := item.(Revision); ok {
+			responseRevisions = append(responseRevisions, revision)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Revision{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return responseRevisions, warnings, err
+}
+
+// GetEnvironmentVariablesByURL returns the environment variables at the given
+// URL, such as the environment_variables link of a revision.
+func (client *Client) GetEnvironmentVariablesByURL(url string) (EnvironmentVariables, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    url,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return EnvironmentVariables{}, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+	return responseEnvVars, response.Warnings, err
+}
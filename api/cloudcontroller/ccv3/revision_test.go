@@ -0,0 +1,186 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Revision", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		var (
+			revisions  []Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revisions, warnings, executeErr = client.GetApplicationRevisions(
+				"some-app-guid",
+				Query{Key: VersionsFilter, Values: []string{"1", "2"}},
+			)
+		})
+
+		When("the CC returns back revisions", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/apps/some-app-guid/revisions?versions=1,2&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "revision-guid-1",
+							"version": 1,
+							"description": "Initial revision.",
+							"deployable": true,
+							"droplet": {"guid": "droplet-guid-1"},
+							"processes": {
+								"web": {"command": "bundle exec rackup"},
+								"worker": {"command": null}
+							},
+							"created_at": "2019-10-01T00:00:00Z",
+							"links": {
+								"environment_variables": {
+									"href": "https://api.example.com/v3/revisions/revision-guid-1/environment_variables"
+								}
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "revision-guid-2",
+							"version": 2,
+							"description": "New droplet deployed.",
+							"deployable": false,
+							"droplet": {"guid": "droplet-guid-2"},
+							"processes": {},
+							"created_at": "2019-10-02T00:00:00Z"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the revisions and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(revisions).To(Equal([]Revision{
+					{
+						GUID:            "revision-guid-1",
+						Version:         1,
+						Description:     "Initial revision.",
+						Deployable:      true,
+						DropletGUID:     "droplet-guid-1",
+						ProcessCommands: map[string]string{"web": "bundle exec rackup", "worker": ""},
+						CreatedAt:       "2019-10-01T00:00:00Z",
+						Links: APILinks{
+							"environment_variables": APILink{
+								HREF: "https://api.example.com/v3/revisions/revision-guid-1/environment_variables",
+							},
+						},
+					},
+					{
+						GUID:            "revision-guid-2",
+						Version:         2,
+						Description:     "New droplet deployed.",
+						DropletGUID:     "droplet-guid-2",
+						ProcessCommands: map[string]string{},
+						CreatedAt:       "2019-10-02T00:00:00Z",
+					},
+				}))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetEnvironmentVariablesByURL", func() {
+		var (
+			envVars    EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = client.GetEnvironmentVariablesByURL(fmt.Sprintf("%s/v3/revisions/some-revision-guid/environment_variables", server.URL()))
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"var": {
+						"DEBUG": "false",
+						"USER": "example"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/revisions/some-revision-guid/environment_variables"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(envVars).To(Equal(EnvironmentVariables{
+					"DEBUG": {Value: "false", IsSet: true},
+					"USER":  {Value: "example", IsSet: true},
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Route represents a Cloud Controller V3 Route.
+type Route struct {
+	// GUID is the unique route identifier.
+	GUID string
+	// Host is the hostname of the route.
+	Host string
+	// Path is the path of the route.
+	Path string
+	// URL is the host, domain and path of the route.
+	URL string
+	// DomainGUID is the GUID of the domain the route is on.
+	DomainGUID string
+	// SpaceGUID is the GUID of the space the route is in.
+	SpaceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Route response.
+func (r *Route) UnmarshalJSON(data []byte) error {
+	var ccRoute struct {
+		GUID          string        `json:"guid"`
+		Host          string        `json:"host"`
+		Path          string        `json:"path"`
+		URL           string        `json:"url"`
+		Relationships Relationships `json:"relationships"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccRoute)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRoute.GUID
+	r.Host = ccRoute.Host
+	r.Path = ccRoute.Path
+	r.URL = ccRoute.URL
+	r.DomainGUID = ccRoute.Relationships[constant.RelationshipTypeDomain].GUID
+	r.SpaceGUID = ccRoute.Relationships[constant.RelationshipTypeSpace].GUID
+
+	return nil
+}
+
+// GetRoutes lists routes with optional filters.
+func (client Client) GetRoutes(query ...Query) ([]Route, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRoutesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRoutesList []Route
+	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
+		if route, ok := item.(Route); ok {
+			fullRoutesList = append(fullRoutesList, route)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Route{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRoutesList, warnings, err
+}
@@ -0,0 +1,112 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// RouteDestination is an app process that a route sends traffic to.
+type RouteDestination struct {
+	// GUID is the unique destination identifier.
+	GUID string
+	// AppGUID is the GUID of the app receiving traffic.
+	AppGUID string
+	// ProcessType is the type of the app's process receiving traffic.
+	ProcessType string
+	// Weight is the percentage of the route's traffic sent to the
+	// destination. It is either set on all of a route's destinations or on
+	// none of them.
+	Weight types.NullInt
+}
+
+type ccRouteDestination struct {
+	GUID string `json:"guid,omitempty"`
+	App  struct {
+		GUID    string `json:"guid"`
+		Process struct {
+			Type string `json:"type"`
+		} `json:"process"`
+	} `json:"app"`
+	Weight types.NullInt `json:"weight"`
+}
+
+// MarshalJSON converts a RouteDestination into a Cloud Controller
+// destination.
+func (d RouteDestination) MarshalJSON() ([]byte, error) {
+	var ccDestination ccRouteDestination
+	ccDestination.GUID = d.GUID
+	ccDestination.App.GUID = d.AppGUID
+	ccDestination.App.Process.Type = d.ProcessType
+	ccDestination.Weight = d.Weight
+
+	return json.Marshal(ccDestination)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller destination.
+func (d *RouteDestination) UnmarshalJSON(data []byte) error {
+	var ccDestination ccRouteDestination
+	err := cloudcontroller.DecodeJSON(data, &ccDestination)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDestination.GUID
+	d.AppGUID = ccDestination.App.GUID
+	d.ProcessType = ccDestination.App.Process.Type
+	d.Weight = ccDestination.Weight
+
+	return nil
+}
+
+type routeDestinations struct {
+	Destinations []RouteDestination `json:"destinations"`
+}
+
+// GetRouteDestinations lists the destinations of the route.
+func (client Client) GetRouteDestinations(routeGUID string) ([]RouteDestination, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var destinations routeDestinations
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &destinations,
+	}
+	err = client.connection.Make(request, &response)
+
+	return destinations.Destinations, response.Warnings, err
+}
+
+// UpdateRouteDestinations replaces all the destinations of the route with
+// the given destinations.
+func (client Client) UpdateRouteDestinations(routeGUID string, destinations []RouteDestination) ([]RouteDestination, Warnings, error) {
+	bodyBytes, err := json.Marshal(routeDestinations{Destinations: destinations})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedDestinations routeDestinations
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &updatedDestinations,
+	}
+	err = client.connection.Make(request, &response)
+
+	return updatedDestinations.Destinations, response.Warnings, err
+}
@@ -0,0 +1,172 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("RouteDestination", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetRouteDestinations", func() {
+		var (
+			destinations []RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			destinations, warnings, executeErr = client.GetRouteDestinations("route-guid")
+		})
+
+		When("the route has destinations", func() {
+			BeforeEach(func() {
+				response := `{
+	"destinations": [
+		{
+			"guid": "destination-guid-1",
+			"app": {"guid": "app-guid-1", "process": {"type": "web"}},
+			"weight": null
+		},
+		{
+			"guid": "destination-guid-2",
+			"app": {"guid": "app-guid-2", "process": {"type": "worker"}},
+			"weight": null
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the destinations and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(destinations).To(Equal([]RouteDestination{
+					{GUID: "destination-guid-1", AppGUID: "app-guid-1", ProcessType: "web"},
+					{GUID: "destination-guid-2", AppGUID: "app-guid-2", ProcessType: "worker"},
+				}))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Route not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Route not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateRouteDestinations", func() {
+		var (
+			destinations []RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			destinations, warnings, executeErr = client.UpdateRouteDestinations("route-guid", []RouteDestination{
+				{AppGUID: "app-guid-1", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 75}},
+				{AppGUID: "app-guid-2", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 25}},
+			})
+		})
+
+		When("the update succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := `{
+	"destinations": [
+		{"app": {"guid": "app-guid-1", "process": {"type": "web"}}, "weight": 75},
+		{"app": {"guid": "app-guid-2", "process": {"type": "web"}}, "weight": 25}
+	]
+}`
+				response := `{
+	"destinations": [
+		{
+			"guid": "destination-guid-1",
+			"app": {"guid": "app-guid-1", "process": {"type": "web"}},
+			"weight": 75
+		},
+		{
+			"guid": "destination-guid-2",
+			"app": {"guid": "app-guid-2", "process": {"type": "web"}},
+			"weight": 25
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the updated destinations and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(destinations).To(Equal([]RouteDestination{
+					{GUID: "destination-guid-1", AppGUID: "app-guid-1", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 75}},
+					{GUID: "destination-guid-2", AppGUID: "app-guid-2", ProcessType: "web", Weight: types.NullInt{IsSet: true, Value: 25}},
+				}))
+			})
+		})
+
+		When("the weights are invalid", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Destinations weights must sum up to 100.",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Destinations weights must sum up to 100."}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,145 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Route", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetRoutes", func() {
+		var (
+			routes     []Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = client.GetRoutes(
+				Query{Key: DomainGUIDFilter, Values: []string{"domain-guid"}},
+				Query{Key: HostsFilter, Values: []string{"some-host"}},
+			)
+		})
+
+		When("routes exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/routes?domain_guids=domain-guid&hosts=some-host&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "route-guid-1",
+			"host": "some-host",
+			"path": "",
+			"url": "some-host.example.com",
+			"relationships": {
+				"domain": {"data": {"guid": "domain-guid"}},
+				"space": {"data": {"guid": "space-guid"}}
+			}
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "route-guid-2",
+			"host": "some-host",
+			"path": "/some-path",
+			"url": "some-host.example.com/some-path",
+			"relationships": {
+				"domain": {"data": {"guid": "domain-guid"}},
+				"space": {"data": {"guid": "space-guid"}}
+			}
+		}
+	]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes", "domain_guids=domain-guid&hosts=some-host"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes", "domain_guids=domain-guid&hosts=some-host&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the routes and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(routes).To(ConsistOf(
+					Route{
+						GUID:       "route-guid-1",
+						Host:       "some-host",
+						URL:        "some-host.example.com",
+						DomainGUID: "domain-guid",
+						SpaceGUID:  "space-guid",
+					},
+					Route{
+						GUID:       "route-guid-2",
+						Host:       "some-host",
+						Path:       "/some-path",
+						URL:        "some-host.example.com/some-path",
+						DomainGUID: "domain-guid",
+						SpaceGUID:  "space-guid",
+					},
+				))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "The request is semantically invalid: command presence",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
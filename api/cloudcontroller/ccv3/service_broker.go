@@ -0,0 +1,40 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceBroker represents a Cloud Controller V3 Service Broker.
+type ServiceBroker struct {
+	// GUID is a unique service broker identifier.
+	GUID string `json:"guid"`
+	// Name is the name of the service broker.
+	Name string `json:"name"`
+}
+
+// GetServiceBrokers lists service brokers with optional filters.
+func (client *Client) GetServiceBrokers(query ...Query) ([]ServiceBroker, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBrokersRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServiceBrokersList []ServiceBroker
+	warnings, err := client.paginate(request, ServiceBroker{}, func(item interface{}) error {
+		if serviceBroker, ok := item.(ServiceBroker); ok {
+			fullServiceBrokersList = append(fullServiceBrokersList, serviceBroker)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceBroker{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServiceBrokersList, warnings, err
+}
@@ -0,0 +1,53 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Broker", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServiceBrokers", func() {
+		var (
+			brokers    []ServiceBroker
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			brokers, warnings, executeErr = client.GetServiceBrokers(Query{Key: NameFilter, Values: []string{"some-broker"}})
+		})
+
+		When("service brokers exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{"guid": "some-broker-guid", "name": "some-broker"}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_brokers", "names=some-broker"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service brokers and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(brokers).To(ConsistOf(ServiceBroker{GUID: "some-broker-guid", Name: "some-broker"}))
+			})
+		})
+	})
+})
@@ -1,16 +1,79 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
 // ServiceInstance represents a Cloud Controller V3 Service Instance.
 type ServiceInstance struct {
 	// GUID is a unique service instance identifier.
-	GUID string `json:"guid"`
+	GUID string `json:"guid,omitempty"`
 	// Name is the name of the service instance.
 	Name string `json:"name"`
+	// Type is whether the service instance is managed or user provided.
+	Type constant.ServiceInstanceType `json:"type,omitempty"`
+	// Credentials are the credentials of a user provided service instance.
+	// They are only sent when creating the service instance.
+	Credentials map[string]interface{} `json:"credentials,omitempty"`
+	// RouteServiceURL is the URL of a user provided route service.
+	RouteServiceURL string `json:"route_service_url,omitempty"`
+	// SyslogDrainURL is the URL of a user provided syslog drain.
+	SyslogDrainURL string `json:"syslog_drain_url,omitempty"`
+	// Tags are the tags of the service instance.
+	Tags []string `json:"tags,omitempty"`
+	// Relationships list the relationships to the service instance.
+	Relationships Relationships `json:"relationships,omitempty"`
+}
+
+// CreateServiceInstance creates a service instance. Only user provided
+// service instances, with a space relationship, can be created.
+func (client *Client) CreateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(serviceInstance)
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var responseServiceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseServiceInstance,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseServiceInstance, response.Warnings, err
+}
+
+// GetServiceInstanceCredentials returns the credentials of a user provided
+// service instance.
+func (client *Client) GetServiceInstanceCredentials(serviceInstanceGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceCredentialsRequest,
+		URIParams:   internal.Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var credentials map[string]interface{}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &credentials,
+	}
+	err = client.connection.Make(request, &response)
+
+	return credentials, response.Warnings, err
 }
 
 // GetServiceInstances lists service instances with optional filters.
@@ -1,11 +1,13 @@
 package ccv3_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -150,4 +152,109 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("CreateServiceInstance", func() {
+		var (
+			instance   ServiceInstance
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			instance, warnings, executeErr = client.CreateServiceInstance(ServiceInstance{
+				Name:        "some-ups",
+				Type:        constant.UserProvidedServiceInstance,
+				Credentials: map[string]interface{}{"username": "admin"},
+				Tags:        []string{"db"},
+				Relationships: Relationships{
+					constant.RelationshipTypeSpace: Relationship{GUID: "some-space-guid"},
+				},
+			})
+		})
+
+		When("the service instance is created", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"name":        "some-ups",
+					"type":        "user-provided",
+					"credentials": map[string]interface{}{"username": "admin"},
+					"tags":        []string{"db"},
+					"relationships": map[string]interface{}{
+						"space": map[string]interface{}{
+							"data": map[string]string{"guid": "some-space-guid"},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "some-ups-guid", "name": "some-ups", "type": "user-provided"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created service instance and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(instance).To(Equal(ServiceInstance{
+					GUID: "some-ups-guid",
+					Name: "some-ups",
+					Type: constant.UserProvidedServiceInstance,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 60002,
+							"detail": "The service instance name is taken: some-ups",
+							"title": "CF-ServiceInstanceNameTaken"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "The service instance name is taken: some-ups"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceCredentials", func() {
+		var (
+			credentials map[string]interface{}
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			credentials, warnings, executeErr = client.GetServiceInstanceCredentials("some-ups-guid")
+		})
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/service_instances/some-ups-guid/credentials"),
+					RespondWith(http.StatusOK, `{"username": "admin", "port": 5432}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the credentials and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(map[string]interface{}{"username": "admin", "port": json.Number("5432")}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
 })
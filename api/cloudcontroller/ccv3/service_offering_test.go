@@ -0,0 +1,66 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Offering", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServiceOfferings", func() {
+		var (
+			offerings  []ServiceOffering
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			offerings, warnings, executeErr = client.GetServiceOfferings(Query{Key: NameFilter, Values: []string{"some-service"}})
+		})
+
+		When("service offerings exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{
+							"guid": "some-service-guid",
+							"name": "some-service",
+							"relationships": {
+								"service_broker": {"data": {"guid": "some-broker-guid"}}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_offerings", "names=some-service"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service offerings with their broker and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(offerings).To(ConsistOf(ServiceOffering{
+					GUID: "some-service-guid",
+					Name: "some-service",
+					Relationships: Relationships{
+						constant.RelationshipTypeServiceBroker: Relationship{GUID: "some-broker-guid"},
+					},
+				}))
+			})
+		})
+	})
+})
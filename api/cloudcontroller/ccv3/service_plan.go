@@ -0,0 +1,46 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServicePlan represents a Cloud Controller V3 Service Plan.
+type ServicePlan struct {
+	// GUID is a unique service plan identifier.
+	GUID string `json:"guid"`
+	// Name is the name of the service plan.
+	Name string `json:"name"`
+	// VisibilityType is who the service plan is visible to.
+	VisibilityType constant.ServicePlanVisibilityType `json:"visibility_type"`
+	// Relationships list the relationships to the service plan, including its
+	// service offering.
+	Relationships Relationships `json:"relationships"`
+}
+
+// GetServicePlans lists service plans with optional filters.
+func (client *Client) GetServicePlans(query ...Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if servicePlan, ok := item.(ServicePlan); ok {
+			fullServicePlansList = append(fullServicePlansList, servicePlan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlansList, warnings, err
+}
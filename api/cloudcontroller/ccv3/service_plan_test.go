@@ -0,0 +1,71 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServicePlans", func() {
+		var (
+			plans      []ServicePlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			plans, warnings, executeErr = client.GetServicePlans(
+				Query{Key: ServiceOfferingNamesFilter, Values: []string{"some-service"}},
+				Query{Key: ServiceBrokerNamesFilter, Values: []string{"some-broker"}},
+			)
+		})
+
+		When("service plans exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{
+							"guid": "some-plan-guid",
+							"name": "some-plan",
+							"visibility_type": "organization",
+							"relationships": {
+								"service_offering": {"data": {"guid": "some-service-guid"}}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans", "service_offering_names=some-service&service_broker_names=some-broker"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plans and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(plans).To(ConsistOf(ServicePlan{
+					GUID:           "some-plan-guid",
+					Name:           "some-plan",
+					VisibilityType: constant.ServicePlanVisibilityOrganization,
+					Relationships: Relationships{
+						constant.RelationshipTypeServiceOffering: Relationship{GUID: "some-service-guid"},
+					},
+				}))
+			})
+		})
+	})
+})
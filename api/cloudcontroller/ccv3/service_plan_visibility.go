@@ -0,0 +1,102 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServicePlanVisibility represents who a Cloud Controller V3 Service Plan is
+// visible to.
+type ServicePlanVisibility struct {
+	// Type is who the service plan is visible to.
+	Type constant.ServicePlanVisibilityType `json:"type"`
+	// Organizations are the orgs the plan is visible to, when the type is
+	// organization.
+	Organizations []VisibilityDetail `json:"organizations,omitempty"`
+}
+
+// VisibilityDetail is an org a service plan is visible to.
+type VisibilityDetail struct {
+	// GUID is the GUID of the org.
+	GUID string `json:"guid"`
+	// Name is the name of the org. It is only returned by the Cloud Controller.
+	Name string `json:"name,omitempty"`
+}
+
+// GetServicePlanVisibility returns who the service plan is visible to.
+func (client *Client) GetServicePlanVisibility(servicePlanGUID string) (ServicePlanVisibility, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanVisibilityRequest,
+		URIParams:   internal.Params{"service_plan_guid": servicePlanGUID},
+	})
+	if err != nil {
+		return ServicePlanVisibility{}, nil, err
+	}
+
+	var visibility ServicePlanVisibility
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &visibility,
+	}
+	err = client.connection.Make(request, &response)
+
+	return visibility, response.Warnings, err
+}
+
+// UpdateServicePlanVisibility replaces who the service plan is visible to.
+func (client *Client) UpdateServicePlanVisibility(servicePlanGUID string, visibility ServicePlanVisibility) (ServicePlanVisibility, Warnings, error) {
+	return client.writeServicePlanVisibility(internal.PatchServicePlanVisibilityRequest, servicePlanGUID, visibility)
+}
+
+// AddServicePlanVisibilityOrganizations makes the service plan visible to the
+// given orgs, in addition to those it is already visible to.
+func (client *Client) AddServicePlanVisibilityOrganizations(servicePlanGUID string, orgGUIDs []string) (ServicePlanVisibility, Warnings, error) {
+	visibility := ServicePlanVisibility{Type: constant.ServicePlanVisibilityOrganization}
+	for _, orgGUID := range orgGUIDs {
+		visibility.Organizations = append(visibility.Organizations, VisibilityDetail{GUID: orgGUID})
+	}
+	return client.writeServicePlanVisibility(internal.PostServicePlanVisibilityRequest, servicePlanGUID, visibility)
+}
+
+// DeleteServicePlanVisibilityOrganization stops the service plan being
+// visible to the org.
+func (client *Client) DeleteServicePlanVisibilityOrganization(servicePlanGUID string, orgGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServicePlanVisibilityOrganizationRequest,
+		URIParams:   internal.Params{"service_plan_guid": servicePlanGUID, "organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) writeServicePlanVisibility(requestName string, servicePlanGUID string, visibility ServicePlanVisibility) (ServicePlanVisibility, Warnings, error) {
+	bodyBytes, err := json.Marshal(visibility)
+	if err != nil {
+		return ServicePlanVisibility{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   internal.Params{"service_plan_guid": servicePlanGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ServicePlanVisibility{}, nil, err
+	}
+
+	var responseVisibility ServicePlanVisibility
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseVisibility,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseVisibility, response.Warnings, err
+}
@@ -0,0 +1,168 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan Visibility", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServicePlanVisibility", func() {
+		var (
+			visibility ServicePlanVisibility
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			visibility, warnings, executeErr = client.GetServicePlanVisibility("some-plan-guid")
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"type": "organization",
+					"organizations": [
+						{"guid": "org-guid-1", "name": "org-1"}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans/some-plan-guid/visibility"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the visibility and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(visibility).To(Equal(ServicePlanVisibility{
+					Type:          constant.ServicePlanVisibilityOrganization,
+					Organizations: []VisibilityDetail{{GUID: "org-guid-1", Name: "org-1"}},
+				}))
+			})
+		})
+
+		When("the plan does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Service plan not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_plans/some-plan-guid/visibility"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Service plan not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateServicePlanVisibility", func() {
+		var (
+			visibility ServicePlanVisibility
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			visibility, warnings, executeErr = client.UpdateServicePlanVisibility("some-plan-guid", ServicePlanVisibility{Type: constant.ServicePlanVisibilityPublic})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/service_plans/some-plan-guid/visibility"),
+						VerifyJSON(`{"type": "public"}`),
+						RespondWith(http.StatusOK, `{"type": "public"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("replaces the visibility and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(visibility).To(Equal(ServicePlanVisibility{Type: constant.ServicePlanVisibilityPublic}))
+			})
+		})
+	})
+
+	Describe("AddServicePlanVisibilityOrganizations", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.AddServicePlanVisibilityOrganizations("some-plan-guid", []string{"org-guid-1", "org-guid-2"})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_plans/some-plan-guid/visibility"),
+						VerifyJSON(`{"type": "organization", "organizations": [{"guid": "org-guid-1"}, {"guid": "org-guid-2"}]}`),
+						RespondWith(http.StatusOK, `{"type": "organization"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("appends the orgs and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServicePlanVisibilityOrganization", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.DeleteServicePlanVisibilityOrganization("some-plan-guid", "some-org-guid")
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_plans/some-plan-guid/visibility/some-org-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("removes the org and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -1,6 +1,10 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
@@ -13,6 +17,8 @@ type Space struct {
 	Name string `json:"name"`
 	// Relationships list the relationships to the space.
 	Relationships Relationships `json:"relationships"`
+	// Metadata is the labels and annotations of the space.
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 // GetSpaces lists spaces with optional filters.
@@ -40,3 +46,35 @@ func (client *Client) GetSpaces(query ...Query) ([]Space, Warnings, error) {
 
 	return fullSpacesList, warnings, err
 }
+
+// UpdateSpace updates the name and metadata of the space with the given GUID.
+// Metadata keys that are not provided are left unchanged.
+func (client *Client) UpdateSpace(space Space) (Space, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name     string    `json:"name,omitempty"`
+		Metadata *Metadata `json:"metadata,omitempty"`
+	}{
+		Name:     space.Name,
+		Metadata: space.Metadata,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchSpaceRequest,
+		URIParams:   internal.Params{"space_guid": space.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var responseSpace Space
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseSpace,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseSpace, response.Warnings, err
+}
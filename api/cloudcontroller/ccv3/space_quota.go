@@ -0,0 +1,225 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// SpaceQuota represents a Cloud Controller space quota.
+type SpaceQuota struct {
+	// GUID is the unique space quota identifier.
+	GUID string
+	// Name is the name of the space quota.
+	Name string
+	// QuotaLimits are the limits the quota applies.
+	QuotaLimits
+	// OrganizationGUID is the organization that owns the space quota.
+	OrganizationGUID string
+	// SpaceGUIDs are the spaces the quota is applied to.
+	SpaceGUIDs []string
+}
+
+// MarshalJSON converts a SpaceQuota into a Cloud Controller space quota. The
+// owning organization and applied spaces are only included when set.
+func (q SpaceQuota) MarshalJSON() ([]byte, error) {
+	type ccRelationships struct {
+		Organization Relationship      `json:"organization"`
+		Spaces       *RelationshipList `json:"spaces,omitempty"`
+	}
+	var ccQuota struct {
+		Name          string           `json:"name,omitempty"`
+		Apps          AppLimits        `json:"apps"`
+		Services      ServiceLimits    `json:"services"`
+		Routes        RouteLimits      `json:"routes"`
+		Relationships *ccRelationships `json:"relationships,omitempty"`
+	}
+
+	ccQuota.Name = q.Name
+	ccQuota.Apps = q.Apps
+	ccQuota.Services = q.Services
+	ccQuota.Routes = q.Routes
+
+	if q.OrganizationGUID != "" {
+		ccQuota.Relationships = &ccRelationships{
+			Organization: Relationship{GUID: q.OrganizationGUID},
+		}
+		if len(q.SpaceGUIDs) > 0 {
+			ccQuota.Relationships.Spaces = &RelationshipList{GUIDs: q.SpaceGUIDs}
+		}
+	}
+
+	return json.Marshal(ccQuota)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller space quota response.
+func (q *SpaceQuota) UnmarshalJSON(data []byte) error {
+	var ccQuota struct {
+		GUID          string        `json:"guid"`
+		Name          string        `json:"name"`
+		Apps          AppLimits     `json:"apps"`
+		Services      ServiceLimits `json:"services"`
+		Routes        RouteLimits   `json:"routes"`
+		Relationships struct {
+			Organization Relationship     `json:"organization"`
+			Spaces       RelationshipList `json:"spaces"`
+		} `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccQuota)
+	if err != nil {
+		return err
+	}
+
+	q.GUID = ccQuota.GUID
+	q.Name = ccQuota.Name
+	q.Apps = ccQuota.Apps
+	q.Services = ccQuota.Services
+	q.Routes = ccQuota.Routes
+	q.OrganizationGUID = ccQuota.Relationships.Organization.GUID
+	q.SpaceGUIDs = ccQuota.Relationships.Spaces.GUIDs
+
+	return nil
+}
+
+// ApplySpaceQuota applies the space quota to the space.
+func (client *Client) ApplySpaceQuota(quotaGUID string, spaceGUID string) (RelationshipList, Warnings, error) {
+	body, err := json.Marshal(RelationshipList{GUIDs: []string{spaceGUID}})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotaRelationshipSpacesRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &relationships,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationships, response.Warnings, err
+}
+
+// CreateSpaceQuota creates a space quota in the quota's organization with
+// the provided name and limits.
+func (client *Client) CreateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotasRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var responseQuota SpaceQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseQuota, response.Warnings, err
+}
+
+// DeleteSpaceQuota deletes the space quota with the given GUID. Returns back
+// a resulting job URL to poll.
+func (client *Client) DeleteSpaceQuota(quotaGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceQuotaRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// GetSpaceQuotas lists space quotas with optional filters.
+func (client *Client) GetSpaceQuotas(query ...Query) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceQuotasRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullQuotasList []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if quota, ok := item.(SpaceQuota); ok {
+			fullQuotasList = append(fullQuotasList, quota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullQuotasList, warnings, err
+}
+
+// UnsetSpaceQuota removes the space quota from the space.
+func (client *Client) UnsetSpaceQuota(quotaGUID string, spaceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceQuotaRelationshipSpaceRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID, "space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// UpdateSpaceQuota updates the name and limits of the space quota with the
+// given GUID. Nil limits are left unchanged.
+func (client *Client) UpdateSpaceQuota(quota SpaceQuota) (SpaceQuota, Warnings, error) {
+	quotaGUID := quota.GUID
+	quota.OrganizationGUID = ""
+	quota.SpaceGUIDs = nil
+
+	body, err := json.Marshal(quota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchSpaceQuotaRequest,
+		URIParams:   internal.Params{"quota_guid": quotaGUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var responseQuota SpaceQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseQuota, response.Warnings, err
+}
@@ -0,0 +1,261 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Space Quotas", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetSpaceQuotas", func() {
+		var (
+			quotas     []SpaceQuota
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			response := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "quota-guid",
+			"name": "quota",
+			"apps": {
+				"total_memory_in_mb": 1024,
+				"per_app_tasks": null
+			},
+			"services": {
+				"paid_services_allowed": false
+			},
+			"routes": {
+				"total_routes": 10
+			},
+			"relationships": {
+				"organization": {
+					"data": { "guid": "org-guid" }
+				},
+				"spaces": {
+					"data": [{ "guid": "space-guid-1" }, { "guid": "space-guid-2" }]
+				}
+			}
+		}
+	]
+}`
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/space_quotas", "organization_guids=org-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			quotas, warnings, executeErr = client.GetSpaceQuotas(Query{
+				Key:    OrganizationGUIDFilter,
+				Values: []string{"org-guid"},
+			})
+		})
+
+		It("returns the queried space quotas and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			paidServicesAllowed := false
+			Expect(quotas).To(ConsistOf(SpaceQuota{
+				GUID: "quota-guid",
+				Name: "quota",
+				QuotaLimits: QuotaLimits{
+					Apps: AppLimits{
+						TotalMemory: &types.NullInt{IsSet: true, Value: 1024},
+					},
+					Services: ServiceLimits{
+						PaidServicePlans: &paidServicesAllowed,
+					},
+					Routes: RouteLimits{
+						TotalRoutes: &types.NullInt{IsSet: true, Value: 10},
+					},
+				},
+				OrganizationGUID: "org-guid",
+				SpaceGUIDs:       []string{"space-guid-1", "space-guid-2"},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("CreateSpaceQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/space_quotas"),
+					VerifyJSON(`{
+						"name": "some-quota",
+						"apps": {
+							"total_instances": null
+						},
+						"services": {},
+						"routes": {},
+						"relationships": {
+							"organization": {
+								"data": { "guid": "org-guid" }
+							}
+						}
+					}`),
+					RespondWith(http.StatusCreated, `{"guid": "some-quota-guid", "name": "some-quota"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.CreateSpaceQuota(SpaceQuota{
+				Name: "some-quota",
+				QuotaLimits: QuotaLimits{
+					Apps: AppLimits{TotalInstances: &types.NullInt{}},
+				},
+				OrganizationGUID: "org-guid",
+			})
+		})
+
+		It("creates the quota in the organization", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/space_quotas/some-quota-guid"),
+					VerifyJSON(`{
+						"apps": {
+							"per_app_tasks": 2
+						},
+						"services": {},
+						"routes": {}
+					}`),
+					RespondWith(http.StatusOK, `{"guid": "some-quota-guid"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.UpdateSpaceQuota(SpaceQuota{
+				GUID: "some-quota-guid",
+				QuotaLimits: QuotaLimits{
+					Apps: AppLimits{PerAppTasks: &types.NullInt{IsSet: true, Value: 2}},
+				},
+				OrganizationGUID: "org-guid",
+				SpaceGUIDs:       []string{"space-guid"},
+			})
+		})
+
+		It("does not send the relationships", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("ApplySpaceQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/space_quotas/some-quota-guid/relationships/spaces"),
+					VerifyJSON(`{"data": [{"guid": "some-space-guid"}]}`),
+					RespondWith(http.StatusOK, `{"data": [{"guid": "some-space-guid"}]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.ApplySpaceQuota("some-quota-guid", "some-space-guid")
+		})
+
+		It("applies the quota to the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UnsetSpaceQuota", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/space_quotas/some-quota-guid/relationships/spaces/some-space-guid"),
+					RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.UnsetSpaceQuota("some-quota-guid", "some-space-guid")
+		})
+
+		It("removes the quota from the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("DeleteSpaceQuota", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/space_quotas/some-quota-guid"),
+					RespondWith(http.StatusAccepted, nil, http.Header{
+						"X-Cf-Warnings": {"this is a warning"},
+						"Location":      {"/v3/jobs/some-job"},
+					}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteSpaceQuota("some-quota-guid")
+		})
+
+		It("returns the job URL and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(jobURL).To(Equal(JobURL("/v3/jobs/some-job")))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
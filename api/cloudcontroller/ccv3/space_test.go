@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -159,4 +160,80 @@ var _ = Describe("Spaces", func() {
 			})
 		})
 	})
+
+	Describe("UpdateSpace", func() {
+		var (
+			space      Space
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			space, warnings, executeErr = client.UpdateSpace(Space{
+				GUID: "space-guid",
+				Metadata: &Metadata{
+					Annotations: map[string]types.NullString{
+						"owner":   types.NewNullString("jane"),
+						"contact": types.NewNullString(),
+					},
+				},
+			})
+		})
+
+		When("the update succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := `{"metadata":{"annotations":{"contact":null,"owner":"jane"}}}`
+				response := `{
+  "name": "space-name",
+  "guid": "space-guid",
+  "metadata": {
+    "labels": {},
+    "annotations": {"owner": "jane"}
+  }
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/spaces/space-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the metadata and returns the updated space and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(space.GUID).To(Equal("space-guid"))
+				Expect(space.Name).To(Equal("space-name"))
+				Expect(space.Metadata.Annotations).To(Equal(map[string]types.NullString{
+					"owner": types.NewNullString("jane"),
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Space not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/spaces/space-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Space not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -30,29 +30,51 @@ type TargetSettings struct {
 // TargetCF sets the client to use the Cloud Controller specified in the
 // configuration. Any other configuration is also applied to the client.
 func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
-	client.cloudControllerURL = settings.URL
-
-	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
-		DialTimeout:       settings.DialTimeout,
-		SkipSSLValidation: settings.SkipSSLValidation,
-	})
-
-	for _, wrapper := range client.wrappers {
-		client.connection = wrapper.Wrap(client.connection)
-	}
+	client.connect(settings)
 
 	apiInfo, resourceLinks, warnings, err := client.GetInfo()
 	if err != nil {
 		return warnings, err
 	}
 
-	client.Info = apiInfo
-
 	resources := map[string]string{}
 	for resource, link := range resourceLinks {
 		resources[resource] = link.HREF
 	}
-	client.router = internal.NewRouter(internal.APIRoutes, resources)
+	client.setTargetInfo(apiInfo, resources)
 
 	return warnings, nil
 }
+
+// TargetCFWithInfo sets the client to use the Cloud Controller specified in
+// the configuration like TargetCF, with the info and resource URLs that
+// another client retrieved from it. No request is made.
+func (client *Client) TargetCFWithInfo(settings TargetSettings, info Info, resources map[string]string) {
+	client.connect(settings)
+	client.setTargetInfo(info, resources)
+}
+
+// Resources returns the URLs of the V3 resources of the targeted Cloud
+// Controller, by resource name.
+func (client *Client) Resources() map[string]string {
+	return client.resources
+}
+
+func (client *Client) connect(settings TargetSettings) {
+	client.cloudControllerURL = settings.URL
+
+	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
+		DialTimeout:       settings.DialTimeout,
+		SkipSSLValidation: settings.SkipSSLValidation,
+	})
+
+	for _, wrapper := range client.wrappers {
+		client.connection = wrapper.Wrap(client.connection)
+	}
+}
+
+func (client *Client) setTargetInfo(info Info, resources map[string]string) {
+	client.Info = info
+	client.resources = resources
+	client.router = internal.NewRouter(internal.APIRoutes, resources)
+}
@@ -162,4 +162,22 @@ var _ = Describe("Target", func() {
 			})
 		})
 	})
+
+	Describe("TargetCFWithInfo", func() {
+		BeforeEach(func() {
+			server.Reset()
+		})
+
+		It("sets the info and resources on the client without a request", func() {
+			var info Info
+			info.Links.UAA.HREF = "https://uaa.bosh-lite.com"
+			resources := map[string]string{"tasks": server.URL() + "/v3/tasks"}
+
+			client.TargetCFWithInfo(TargetSettings{URL: server.URL()}, info, resources)
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(client.UAA()).To(Equal("https://uaa.bosh-lite.com"))
+			Expect(client.Resources()).To(Equal(resources))
+		})
+	})
 })
@@ -0,0 +1,52 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// UsageSummary represents the resources an organization or space is using
+// that count against its quota.
+type UsageSummary struct {
+	// StartedInstances is the number of started app instances.
+	StartedInstances int64 `json:"started_instances"`
+	// MemoryInMB is the memory of all started app instances and running
+	// tasks.
+	MemoryInMB int64 `json:"memory_in_mb"`
+	// Routes is the number of routes.
+	Routes int64 `json:"routes"`
+	// ServiceInstances is the number of service instances.
+	ServiceInstances int64 `json:"service_instances"`
+	// ReservedPorts is the number of routes with reserved ports.
+	ReservedPorts int64 `json:"reserved_ports"`
+}
+
+// GetOrganizationUsageSummary returns the usage summary of the organization.
+func (client *Client) GetOrganizationUsageSummary(orgGUID string) (UsageSummary, Warnings, error) {
+	return client.getUsageSummary(internal.GetOrganizationUsageSummaryRequest, internal.Params{"organization_guid": orgGUID})
+}
+
+// GetSpaceUsageSummary returns the usage summary of the space.
+func (client *Client) GetSpaceUsageSummary(spaceGUID string) (UsageSummary, Warnings, error) {
+	return client.getUsageSummary(internal.GetSpaceUsageSummaryRequest, internal.Params{"space_guid": spaceGUID})
+}
+
+func (client *Client) getUsageSummary(requestName string, params internal.Params) (UsageSummary, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   params,
+	})
+	if err != nil {
+		return UsageSummary{}, nil, err
+	}
+
+	var summary struct {
+		UsageSummary UsageSummary `json:"usage_summary"`
+	}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &summary,
+	}
+
+	err = client.connection.Make(request, &response)
+	return summary.UsageSummary, response.Warnings, err
+}
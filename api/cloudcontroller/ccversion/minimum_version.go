@@ -12,7 +12,15 @@ const (
 	MinVersionMultiServiceRegistrationV2            = "2.125.0"
 	MinVersionUpdateServiceNameWhenPlanNotVisibleV2 = "2.131.0"
 
-	MinVersionShareServiceV3     = "3.36.0"
-	MinVersionZeroDowntimePushV3 = "3.57.0"
-	MinVersionSpacesGUIDsParamV3 = "3.56.0"
+	MinVersionShareServiceV3         = "3.36.0"
+	MinVersionZeroDowntimePushV3     = "3.57.0"
+	MinVersionSpacesGUIDsParamV3     = "3.56.0"
+	MinVersionRevisionsV3            = "3.64.0"
+	MinVersionMetadataV3             = "3.66.0"
+	MinVersionSidecarsV3             = "3.77.0"
+	MinVersionQuotasV3               = "3.80.0"
+	MinVersionUserProvidedServicesV3 = "3.99.0"
+	MinVersionLogRateLimitV3         = "3.124.0"
+	MinVersionCNBLifecycleV3         = "3.168.0"
+	MinVersionClearBuildpackCacheV3  = "3.170.0"
 )
@@ -0,0 +1,45 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// ChangeReason is a wrapper that adds the change reason of the running command
+// as a header on every request that modifies resources, so that it is
+// recorded in the Cloud Controller audit events.
+type ChangeReason struct {
+	header     string
+	reason     func() (string, error)
+	connection cloudcontroller.Connection
+}
+
+// NewChangeReason returns a pointer to a ChangeReason wrapper. The reason
+// function is only called for requests that modify resources.
+func NewChangeReason(header string, reason func() (string, error)) *ChangeReason {
+	return &ChangeReason{
+		header: header,
+		reason: reason,
+	}
+}
+
+// Make adds the change reason header to POST, PUT, PATCH and DELETE requests.
+func (wrapper *ChangeReason) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	switch request.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		reason, err := wrapper.reason()
+		if err != nil {
+			return err
+		}
+		request.Header.Set(wrapper.header, reason)
+	}
+
+	return wrapper.connection.Make(request, passedResponse)
+}
+
+// Wrap sets the connection in the ChangeReason and returns itself.
+func (wrapper *ChangeReason) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
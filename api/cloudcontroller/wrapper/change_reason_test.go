@@ -0,0 +1,77 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Change Reason", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		reasonCalls    int
+		reasonErr      error
+		wrapper        cloudcontroller.Connection
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		reasonCalls = 0
+		reasonErr = nil
+
+		wrapper = NewChangeReason("X-Change-Ticket", func() (string, error) {
+			reasonCalls++
+			return "CHG12345", reasonErr
+		}).Wrap(fakeConnection)
+	})
+
+	DescribeTable("adding the change reason header",
+		func(method string, expectedHeader string) {
+			req, err := http.NewRequest(method, "https://foo.bar.com/v2/apps", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request := cloudcontroller.NewRequest(req, nil)
+
+			err = wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			passedRequest, _ := fakeConnection.MakeArgsForCall(0)
+			Expect(passedRequest.Header.Get("X-Change-Ticket")).To(Equal(expectedHeader))
+		},
+
+		Entry("POST", http.MethodPost, "CHG12345"),
+		Entry("PUT", http.MethodPut, "CHG12345"),
+		Entry("PATCH", http.MethodPatch, "CHG12345"),
+		Entry("DELETE", http.MethodDelete, "CHG12345"),
+		Entry("GET", http.MethodGet, ""),
+	)
+
+	It("does not ask for the reason of requests that do not modify resources", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/v2/apps", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})).To(Succeed())
+		Expect(reasonCalls).To(Equal(0))
+	})
+
+	When("getting the reason fails", func() {
+		BeforeEach(func() {
+			reasonErr = errors.New("no reason")
+		})
+
+		It("returns the error without making the request", func() {
+			req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/v2/apps", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})
+			Expect(err).To(MatchError("no reason"))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		})
+	})
+})
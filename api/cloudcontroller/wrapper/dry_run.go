@@ -0,0 +1,75 @@
+package wrapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/dryrun"
+)
+
+// DryRun is a wrapper that prints the requests that modify resources instead
+// of sending them. Requests that only read resources are sent, so that
+// commands can still look up what they would change.
+type DryRun struct {
+	output     io.Writer
+	connection cloudcontroller.Connection
+}
+
+// NewDryRun returns a pointer to a DryRun wrapper that prints the skipped
+// requests to output.
+func NewDryRun(output io.Writer) *DryRun {
+	return &DryRun{
+		output: output,
+	}
+}
+
+// dryRunJob is the V2 job that polling the job of a request that was not sent
+// finds. The empty response to an async request has no job GUID, so polling
+// its job asks for /v2/jobs/.
+const dryRunJob = `{"metadata":{"guid":""},"entity":{"guid":"","status":"finished"}}`
+
+// Make prints POST, PUT, PATCH and DELETE requests and returns an empty
+// response for them. Polling the job of such a request finds it finished.
+// Other requests are sent.
+func (wrapper *DryRun) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if !dryrun.ModifiesResources(request.Method) {
+		if strings.HasSuffix(request.URL.Path, "/v2/jobs/") {
+			return finishDryRunJob(passedResponse)
+		}
+		return wrapper.connection.Make(request, passedResponse)
+	}
+
+	_, err := fmt.Fprintf(wrapper.output, "Dry run: %s %s\n", request.Method, request.URL.String())
+	if err != nil {
+		return err
+	}
+
+	if request.Body == nil {
+		return nil
+	}
+
+	summary, err := dryrun.SummarizeBody(request.URL.Path, request.Header.Get("Content-Type"), request.Body)
+	defer request.ResetBody()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(wrapper.output, "  %s\n", summary)
+	return err
+}
+
+func finishDryRunJob(passedResponse *cloudcontroller.Response) error {
+	passedResponse.RawResponse = []byte(dryRunJob)
+	if passedResponse.DecodeJSONResponseInto == nil {
+		return nil
+	}
+	return json.Unmarshal(passedResponse.RawResponse, passedResponse.DecodeJSONResponseInto)
+}
+
+// Wrap sets the connection in the DryRun and returns itself.
+func (wrapper *DryRun) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
@@ -0,0 +1,108 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry Run", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		output         *bytes.Buffer
+		wrapper        cloudcontroller.Connection
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		output = new(bytes.Buffer)
+
+		wrapper = NewDryRun(output).Wrap(fakeConnection)
+	})
+
+	newRequest := func(method string, body string) *cloudcontroller.Request {
+		var reader *strings.Reader
+		req, err := http.NewRequest(method, "https://foo.bar.com/v2/apps/some-app-guid", nil)
+		Expect(err).ToNot(HaveOccurred())
+		if body == "" {
+			return cloudcontroller.NewRequest(req, nil)
+		}
+
+		reader = strings.NewReader(body)
+		req, err = http.NewRequest(method, "https://foo.bar.com/v2/apps/some-app-guid", reader)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		return cloudcontroller.NewRequest(req, reader)
+	}
+
+	DescribeTable("requests that modify resources",
+		func(method string) {
+			err := wrapper.Make(newRequest(method, ""), &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+			Expect(output.String()).To(Equal("Dry run: " + method + " https://foo.bar.com/v2/apps/some-app-guid\n"))
+		},
+
+		Entry("POST", http.MethodPost),
+		Entry("PUT", http.MethodPut),
+		Entry("PATCH", http.MethodPatch),
+		Entry("DELETE", http.MethodDelete),
+	)
+
+	It("sends requests that only read resources", func() {
+		err := wrapper.Make(newRequest(http.MethodGet, ""), &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(output.Len()).To(Equal(0))
+	})
+
+	It("finds the job of a request that was not sent finished", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/v2/jobs/", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		var job struct {
+			Entity struct {
+				Status string `json:"status"`
+			} `json:"entity"`
+		}
+		err = wrapper.Make(cloudcontroller.NewRequest(request, nil), &cloudcontroller.Response{DecodeJSONResponseInto: &job})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		Expect(job.Entity.Status).To(Equal("finished"))
+	})
+
+	It("prints a compact summary of JSON bodies", func() {
+		err := wrapper.Make(newRequest(http.MethodPut, "{\n  \"instances\": 3\n}"), &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.String()).To(Equal("Dry run: PUT https://foo.bar.com/v2/apps/some-app-guid\n  {\"instances\":3}\n"))
+	})
+
+	It("shortens long bodies", func() {
+		err := wrapper.Make(newRequest(http.MethodPut, `{"name":"`+strings.Repeat("a", 300)+`"}`), &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.String()).To(HaveSuffix(`{"name":"` + strings.Repeat("a", 191) + "...\n"))
+	})
+
+	It("describes bodies that are not JSON", func() {
+		req, err := http.NewRequest(http.MethodPut, "https://foo.bar.com/v2/apps/some-app-guid/bits", strings.NewReader("some-bits"))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "multipart/form-data")
+
+		err = wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.String()).To(HaveSuffix("  [multipart/form-data body]\n"))
+	})
+})
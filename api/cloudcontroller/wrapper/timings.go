@@ -0,0 +1,43 @@
+package wrapper
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+//go:generate counterfeiter . TimingsRecorder
+
+// TimingsRecorder records how long requests take.
+type TimingsRecorder interface {
+	RecordRequest(request *http.Request, duration time.Duration)
+}
+
+// Timings is a wrapper that records how long each request to the Cloud
+// Controller takes.
+type Timings struct {
+	recorder   TimingsRecorder
+	connection cloudcontroller.Connection
+}
+
+// NewTimings returns a pointer to a Timings wrapper.
+func NewTimings(recorder TimingsRecorder) *Timings {
+	return &Timings{
+		recorder: recorder,
+	}
+}
+
+// Make records the duration of the request, whether or not it succeeds.
+func (wrapper *Timings) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	start := time.Now()
+	err := wrapper.connection.Make(request, passedResponse)
+	wrapper.recorder.RecordRequest(request.Request, time.Since(start))
+	return err
+}
+
+// Wrap sets the connection in the Timings and returns itself.
+func (wrapper *Timings) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
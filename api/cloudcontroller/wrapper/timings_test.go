@@ -0,0 +1,61 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timings", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeRecorder   *wrapperfakes.FakeTimingsRecorder
+		wrapper        cloudcontroller.Connection
+		request        *cloudcontroller.Request
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeRecorder = new(wrapperfakes.FakeTimingsRecorder)
+
+		wrapper = NewTimings(fakeRecorder).Wrap(fakeConnection)
+
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/v3/apps", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+	})
+
+	It("records how long the request took", func() {
+		fakeConnection.MakeStub = func(*cloudcontroller.Request, *cloudcontroller.Response) error {
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}
+
+		err := wrapper.Make(request, &cloudcontroller.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeRecorder.RecordRequestCallCount()).To(Equal(1))
+		recordedRequest, duration := fakeRecorder.RecordRequestArgsForCall(0)
+		Expect(recordedRequest).To(Equal(request.Request))
+		Expect(duration).To(BeNumerically(">=", 10*time.Millisecond))
+	})
+
+	When("the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(errors.New("some-error"))
+		})
+
+		It("records the request and returns the error", func() {
+			err := wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(MatchError("some-error"))
+			Expect(fakeRecorder.RecordRequestCallCount()).To(Equal(1))
+		})
+	})
+})
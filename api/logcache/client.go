@@ -0,0 +1,99 @@
+// Package logcache is a GoLang library that reads app logs from the Log Cache
+// API.
+package logcache
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime"
+	"time"
+)
+
+//go:generate counterfeiter . TokenCache
+
+// TokenCache provides the access token sent with the Log Cache requests.
+type TokenCache interface {
+	AccessToken() string
+}
+
+//go:generate counterfeiter . TokenRefresher
+
+// TokenRefresher refreshes the access token when Log Cache rejects it.
+type TokenRefresher interface {
+	RefreshAuthToken() (string, error)
+}
+
+// ConnectionConfig is for configuring the connection to Log Cache.
+type ConnectionConfig struct {
+	DialTimeout       time.Duration
+	SkipSSLValidation bool
+}
+
+// Config allows the Client to be configured.
+type Config struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// UserAgentSuffix is appended to the User-Agent of every request to
+	// identify the automation using the client. It is omitted when empty.
+	UserAgentSuffix string
+
+	// ConnectionConfig is the configuration for the client connection.
+	ConnectionConfig
+
+	// Endpoint is the URL of the Log Cache API.
+	Endpoint string
+
+	// TokenCache provides the access token sent with the requests.
+	TokenCache TokenCache
+
+	// TokenRefresher refreshes the access token when it has expired.
+	TokenRefresher TokenRefresher
+}
+
+// Client is a client that can be used to talk to Log Cache.
+type Client struct {
+	endpoint       string
+	httpClient     *http.Client
+	tokenCache     TokenCache
+	tokenRefresher TokenRefresher
+	userAgent      string
+}
+
+// NewClient returns a new Log Cache Client.
+func NewClient(config Config) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
+		config.AppName,
+		config.AppVersion,
+		runtime.Version(),
+		runtime.GOARCH,
+		runtime.GOOS,
+	)
+	if config.UserAgentSuffix != "" {
+		userAgent += " " + config.UserAgentSuffix
+	}
+
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: config.SkipSSLValidation,
+		},
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   config.DialTimeout,
+		}).DialContext,
+	}
+
+	return &Client{
+		endpoint:       config.Endpoint,
+		httpClient:     &http.Client{Transport: transport},
+		tokenCache:     config.TokenCache,
+		tokenRefresher: config.TokenRefresher,
+		userAgent:      userAgent,
+	}
+}
@@ -0,0 +1,60 @@
+package logcache
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Envelope is a log emitted by an app or by a platform component on its
+// behalf, such as the router.
+type Envelope struct {
+	Timestamp  time.Time
+	SourceID   string
+	InstanceID string
+	Tags       map[string]string
+
+	// LogType is OUT or ERR.
+	LogType string
+	// Payload is the log line.
+	Payload string
+}
+
+// UnmarshalJSON helps unmarshal a Log Cache envelope.
+func (e *Envelope) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Timestamp  json.RawMessage   `json:"timestamp"`
+		SourceID   string            `json:"source_id"`
+		InstanceID string            `json:"instance_id"`
+		Tags       map[string]string `json:"tags"`
+		Log        struct {
+			Payload string `json:"payload"`
+			Type    string `json:"type"`
+		} `json:"log"`
+	}
+	err := json.Unmarshal(data, &envelope)
+	if err != nil {
+		return err
+	}
+
+	// Timestamps are nanoseconds since the epoch, encoded as strings.
+	nanoseconds, err := strconv.ParseInt(strings.Trim(string(envelope.Timestamp), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Log.Payload)
+	if err != nil {
+		return err
+	}
+
+	e.Timestamp = time.Unix(0, nanoseconds)
+	e.SourceID = envelope.SourceID
+	e.InstanceID = envelope.InstanceID
+	e.Tags = envelope.Tags
+	e.LogType = envelope.Log.Type
+	e.Payload = string(payload)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package logcache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+)
+
+// readLimit is the maximum number of envelopes Log Cache returns per request.
+const readLimit = 1000
+
+// ReadLogs returns the log envelopes of sourceID emitted since start, oldest
+// first.
+func (client *Client) ReadLogs(sourceID string, start time.Time) ([]Envelope, error) {
+	var envelopes []Envelope
+	startTime := start.UnixNano()
+
+	for {
+		batch, err := client.read(sourceID, startTime)
+		if err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, batch...)
+
+		if len(batch) < readLimit {
+			return envelopes, nil
+		}
+		startTime = batch[len(batch)-1].Timestamp.UnixNano() + 1
+	}
+}
+
+func (client *Client) read(sourceID string, startTime int64) ([]Envelope, error) {
+	query := url.Values{
+		"start_time":     {strconv.FormatInt(startTime, 10)},
+		"envelope_types": {"LOG"},
+		"limit":          {strconv.Itoa(readLimit)},
+	}
+	requestURL := fmt.Sprintf("%s/api/v1/read/%s?%s", strings.TrimRight(client.endpoint, "/"), url.PathEscape(sourceID), query.Encode())
+
+	body, err := client.get(requestURL, client.tokenCache.AccessToken(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Envelopes struct {
+			Batch []Envelope `json:"batch"`
+		} `json:"envelopes"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Envelopes.Batch, nil
+}
+
+// get sends a GET request, refreshing the access token and retrying once when
+// the token has expired.
+func (client *Client) get(requestURL string, token string, refreshed bool) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", token)
+	request.Header.Set("User-Agent", client.userAgent)
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusUnauthorized && !refreshed {
+		token, err = client.tokenRefresher.RefreshAuthToken()
+		if err != nil {
+			return nil, err
+		}
+		return client.get(requestURL, token, true)
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, logcacheerror.RawHTTPStatusError{
+			StatusCode:  response.StatusCode,
+			RawResponse: body,
+		}
+	}
+	return body, nil
+}
@@ -0,0 +1,139 @@
+package logcache_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Read", func() {
+	var (
+		client             *Client
+		fakeTokenCache     *logcachefakes.FakeTokenCache
+		fakeTokenRefresher *logcachefakes.FakeTokenRefresher
+		start              time.Time
+
+		envelopes  []Envelope
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeTokenCache = new(logcachefakes.FakeTokenCache)
+		fakeTokenCache.AccessTokenReturns("bearer some-token")
+		fakeTokenRefresher = new(logcachefakes.FakeTokenRefresher)
+		client = NewTestClient(fakeTokenCache, fakeTokenRefresher)
+		start = time.Unix(0, 1000)
+	})
+
+	JustBeforeEach(func() {
+		envelopes, executeErr = client.ReadLogs("some-app-guid", start)
+	})
+
+	When("Log Cache returns fewer envelopes than the limit", func() {
+		BeforeEach(func() {
+			response := `{
+				"envelopes": {
+					"batch": [
+						{
+							"timestamp": "1500",
+							"source_id": "some-app-guid",
+							"instance_id": "0",
+							"tags": {"source_type": "RTR"},
+							"log": {"payload": "c29tZSBsb2c=", "type": "OUT"}
+						}
+					]
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "envelope_types=LOG&limit=1000&start_time=1000"),
+					VerifyHeaderKV("Authorization", "bearer some-token"),
+					RespondWith(http.StatusOK, response),
+				),
+			)
+		})
+
+		It("returns the envelopes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(envelopes).To(Equal([]Envelope{{
+				Timestamp:  time.Unix(0, 1500),
+				SourceID:   "some-app-guid",
+				InstanceID: "0",
+				Tags:       map[string]string{"source_type": "RTR"},
+				LogType:    "OUT",
+				Payload:    "some log",
+			}}))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	When("Log Cache returns as many envelopes as the limit", func() {
+		BeforeEach(func() {
+			batch := make([]string, 1000)
+			for i := range batch {
+				batch[i] = fmt.Sprintf(`{"timestamp": "%d", "log": {"payload": "", "type": "OUT"}}`, 1000+i)
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "envelope_types=LOG&limit=1000&start_time=1000"),
+					RespondWith(http.StatusOK, `{"envelopes": {"batch": [`+strings.Join(batch, ",")+`]}}`),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "envelope_types=LOG&limit=1000&start_time=2000"),
+					RespondWith(http.StatusOK, `{"envelopes": {"batch": [{"timestamp": "2000", "log": {"payload": "", "type": "OUT"}}]}}`),
+				),
+			)
+		})
+
+		It("reads the following page", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(envelopes).To(HaveLen(1001))
+			Expect(envelopes[1000].Timestamp).To(Equal(time.Unix(0, 2000)))
+		})
+	})
+
+	When("the access token has expired", func() {
+		BeforeEach(func() {
+			fakeTokenRefresher.RefreshAuthTokenReturns("bearer new-token", nil)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyHeaderKV("Authorization", "bearer some-token"),
+					RespondWith(http.StatusUnauthorized, `{}`),
+				),
+				CombineHandlers(
+					VerifyHeaderKV("Authorization", "bearer new-token"),
+					RespondWith(http.StatusOK, `{"envelopes": {"batch": []}}`),
+				),
+			)
+		})
+
+		It("refreshes the token and retries the request", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(envelopes).To(BeEmpty())
+			Expect(fakeTokenRefresher.RefreshAuthTokenCallCount()).To(Equal(1))
+		})
+	})
+
+	When("Log Cache returns an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				RespondWith(http.StatusNotFound, `not found`),
+			)
+		})
+
+		It("returns the raw status error", func() {
+			Expect(executeErr).To(MatchError(logcacheerror.RawHTTPStatusError{
+				StatusCode:  http.StatusNotFound,
+				RawResponse: []byte("not found"),
+			}))
+		})
+	})
+})
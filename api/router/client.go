@@ -28,6 +28,10 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// UserAgentSuffix is appended to the User-Agent of every request to
+	// identify the automation using the client. It is omitted when empty.
+	UserAgentSuffix string
+
 	// ConnectionConfig is the configuration for the client connection.
 	ConnectionConfig
 
@@ -47,6 +51,9 @@ func NewClient(config Config) *Client {
 		runtime.GOARCH,
 		runtime.GOOS,
 	)
+	if config.UserAgentSuffix != "" {
+		userAgent += " " + config.UserAgentSuffix
+	}
 
 	client := Client{
 		userAgent:  userAgent,
@@ -0,0 +1,55 @@
+package wrapper
+
+import (
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/util/dryrun"
+)
+
+// DryRun is a wrapper that prints the requests that modify resources instead
+// of sending them. Requests that only read resources are sent.
+type DryRun struct {
+	output     io.Writer
+	connection router.Connection
+}
+
+// NewDryRun returns a pointer to a DryRun wrapper that prints the skipped
+// requests to output.
+func NewDryRun(output io.Writer) *DryRun {
+	return &DryRun{
+		output: output,
+	}
+}
+
+// Make prints POST, PUT, PATCH and DELETE requests and returns an empty
+// response for them. Other requests are sent.
+func (wrapper *DryRun) Make(request *router.Request, passedResponse *router.Response) error {
+	if !dryrun.ModifiesResources(request.Method) {
+		return wrapper.connection.Make(request, passedResponse)
+	}
+
+	_, err := fmt.Fprintf(wrapper.output, "Dry run: %s %s\n", request.Method, request.URL.String())
+	if err != nil {
+		return err
+	}
+
+	if request.Body == nil {
+		return nil
+	}
+
+	summary, err := dryrun.SummarizeBody(request.URL.Path, request.Header.Get("Content-Type"), request.Body)
+	defer request.ResetBody()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(wrapper.output, "  %s\n", summary)
+	return err
+}
+
+// Wrap sets the connection in the DryRun and returns itself.
+func (wrapper *DryRun) Wrap(innerconnection router.Connection) router.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
@@ -0,0 +1,52 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/api/router/routerfakes"
+	. "code.cloudfoundry.org/cli/api/router/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry Run", func() {
+	var (
+		fakeConnection *routerfakes.FakeConnection
+		output         *bytes.Buffer
+		wrapper        router.Connection
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(routerfakes.FakeConnection)
+		output = new(bytes.Buffer)
+
+		wrapper = NewDryRun(output).Wrap(fakeConnection)
+	})
+
+	It("prints requests that modify resources instead of sending them", func() {
+		body := strings.NewReader(`{"name": "some-router-group"}`)
+		req, err := http.NewRequest(http.MethodPut, "https://routing.bar.com/routing/v1/router_groups/some-guid", body)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+
+		err = wrapper.Make(router.NewRequest(req, body), &router.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		Expect(output.String()).To(Equal("Dry run: PUT https://routing.bar.com/routing/v1/router_groups/some-guid\n  {\"name\":\"some-router-group\"}\n"))
+	})
+
+	It("sends requests that only read resources", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://routing.bar.com/routing/v1/router_groups", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = wrapper.Make(router.NewRequest(req, nil), &router.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(output.Len()).To(Equal(0))
+	})
+})
@@ -58,7 +58,8 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	switch grantType {
+	case constant.GrantTypePassword, constant.GrantTypeAuthorizationCode, constant.GrantTypeDeviceCode:
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
@@ -68,5 +69,9 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 	}
 
 	err = client.connection.Make(request, &response)
+	if expiredErr, ok := err.(PasswordExpiredError); ok {
+		expiredErr.ResetURL = client.PasswordResetLink()
+		return "", "", expiredErr
+	}
 	return responseBody.AccessToken, responseBody.RefreshToken, err
 }
@@ -0,0 +1,70 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// AuthorizationCodeURL returns the login server URL at which the user logs in
+// through a browser. Once they have, UAA redirects the browser to redirectURI
+// with an authorization code and the given state. The code is then exchanged
+// for tokens by authenticating with the authorization_code grant type and the
+// PKCE code verifier that codeChallenge, its SHA-256 hash, was derived from.
+func (client *Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetAuthorizationCodeRequest,
+		Query: url.Values{
+			"response_type":         {"code"},
+			"client_id":             {client.config.UAAOAuthClient()},
+			"redirect_uri":          {redirectURI},
+			"state":                 {state},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// VerifyAuthorizationCodeRedirect asks the login server, without a session,
+// whether it will redirect the browser back to redirectURI after a browser
+// login. It returns a RedirectURINotRegisteredError when the login server
+// rejects the redirect URI, as the OAuth client is not registered for it.
+func (client *Client) VerifyAuthorizationCodeRedirect(redirectURI string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetAuthorizationCodeRequest,
+		Query: url.Values{
+			"response_type": {"code"},
+			"client_id":     {client.config.UAAOAuthClient()},
+			"redirect_uri":  {redirectURI},
+			"prompt":        {"none"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	response := Response{}
+	err = client.connection.Make(request, &response)
+	switch typedErr := err.(type) {
+	case nil:
+		return nil
+	case InvalidRequestError:
+	case RawHTTPStatusError:
+		if typedErr.StatusCode != http.StatusBadRequest {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return RedirectURINotRegisteredError{
+		ClientID:    client.config.UAAOAuthClient(),
+		RedirectURI: redirectURI,
+	}
+}
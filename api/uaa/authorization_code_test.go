@@ -0,0 +1,105 @@
+package uaa_test
+
+import (
+	"net/http"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("AuthorizationCode", func() {
+	var (
+		client *Client
+
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("AuthorizationCodeURL", func() {
+		It("returns the login server's authorize URL for the redirect URI, state and code challenge", func() {
+			authorizeURL, err := client.AuthorizationCodeURL("http://127.0.0.1:1234/callback", "some-state", "some-challenge")
+			Expect(err).ToNot(HaveOccurred())
+
+			parsedURL, err := url.Parse(authorizeURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedURL.Host).To(Equal(TestAuthorizationResource))
+			Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+			Expect(parsedURL.Query()).To(Equal(url.Values{
+				"response_type":         {"code"},
+				"client_id":             {"client-id"},
+				"redirect_uri":          {"http://127.0.0.1:1234/callback"},
+				"state":                 {"some-state"},
+				"code_challenge":        {"some-challenge"},
+				"code_challenge_method": {"S256"},
+			}))
+		})
+	})
+
+	Describe("VerifyAuthorizationCodeRedirect", func() {
+		var verifyErr error
+
+		JustBeforeEach(func() {
+			verifyErr = client.VerifyAuthorizationCodeRedirect("http://127.0.0.1:1234/callback")
+		})
+
+		When("the login server redirects the browser back", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodGet, "/oauth/authorize", "client_id=client-id&prompt=none&redirect_uri=http%3A%2F%2F127.0.0.1%3A1234%2Fcallback&response_type=code"),
+						RespondWith(http.StatusFound, nil, http.Header{
+							"Location": {"http://127.0.0.1:1234/callback?error=login_required"},
+						}),
+					))
+			})
+
+			It("does not return an error", func() {
+				Expect(verifyErr).ToNot(HaveOccurred())
+			})
+		})
+
+		When("the login server rejects the redirect URI", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusBadRequest, `<html>Invalid redirect http://127.0.0.1:1234/callback did not match one of the registered values.</html>`),
+					))
+			})
+
+			It("returns a RedirectURINotRegisteredError", func() {
+				Expect(verifyErr).To(MatchError(RedirectURINotRegisteredError{
+					ClientID:    "client-id",
+					RedirectURI: "http://127.0.0.1:1234/callback",
+				}))
+			})
+		})
+
+		When("the login server returns another error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/oauth/authorize"),
+						RespondWith(http.StatusInternalServerError, `<html>Internal Server Error</html>`),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(verifyErr).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusInternalServerError,
+					RawResponse: []byte(`<html>Internal Server Error</html>`),
+				}))
+			})
+		})
+	})
+})
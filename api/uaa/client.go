@@ -32,6 +32,9 @@ func NewClient(config Config) *Client {
 		runtime.GOARCH,
 		runtime.GOOS,
 	)
+	if suffix := config.UserAgentSuffix(); suffix != "" {
+		userAgent += " " + suffix
+	}
 
 	client := Client{
 		config: config,
@@ -51,6 +51,32 @@ var _ = Describe("UAA Client", func() {
 			})
 		})
 
+		When("a user agent suffix is configured", func() {
+			BeforeEach(func() {
+				fakeConfig.UserAgentSuffixReturns("team-payments/deploy")
+				client = NewTestUAAClientAndStore(fakeConfig)
+
+				userAgent := fmt.Sprintf("CF CLI UAA API Test/Unknown (%s; %s %s) team-payments/deploy",
+					runtime.Version(),
+					runtime.GOARCH,
+					runtime.GOOS,
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyHeaderKV("User-Agent", userAgent),
+						RespondWith(http.StatusOK, "{}"),
+					))
+			})
+
+			It("appends the suffix to the User-Agent header", func() {
+				_, err := client.RefreshAccessToken("")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
 		Describe("Conection", func() {
 			BeforeEach(func() {
 				server.AppendHandlers(
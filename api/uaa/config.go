@@ -43,4 +43,8 @@ type Config interface {
 
 	// UAAOAuthClientSecret is the UAA client secret the client will use.
 	UAAOAuthClientSecret() string
+
+	// UserAgentSuffix is appended to the User-Agent of every request to
+	// identify the automation using the client. It is omitted when empty.
+	UserAgentSuffix() string
 }
@@ -4,9 +4,15 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used to exchange an authorization code,
+	// granted after the user logged in through the browser, for tokens.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used to exchange a device code, once the user has
+	// approved it from another device, for tokens.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
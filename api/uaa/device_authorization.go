@@ -0,0 +1,152 @@
+package uaa
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+const (
+	// defaultDeviceAuthorizationInterval is how long to wait between polls for
+	// tokens when UAA does not say.
+	defaultDeviceAuthorizationInterval = 5 * time.Second
+
+	// deviceAuthorizationSlowDownIncrement is how much longer to wait between
+	// polls each time UAA asks the client to slow down.
+	deviceAuthorizationSlowDownIncrement = 5 * time.Second
+)
+
+// DeviceAuthorization represents a device authorization request that the user
+// approves by visiting VerificationURI on another device and entering
+// UserCode.
+type DeviceAuthorization struct {
+	DeviceCode string
+	UserCode   string
+
+	// VerificationURI is where the user enters UserCode.
+	VerificationURI string
+
+	// VerificationURIComplete is VerificationURI with UserCode already filled
+	// in. It is empty when UAA does not provide one.
+	VerificationURIComplete string
+
+	// ExpiresIn is how long the device code stays valid. It is zero when UAA
+	// does not say.
+	ExpiresIn time.Duration
+
+	// Interval is how long to wait between polls for tokens.
+	Interval time.Duration
+}
+
+// UnmarshalJSON helps unmarshal a UAA device authorization response.
+func (deviceAuthorization *DeviceAuthorization) UnmarshalJSON(data []byte) error {
+	var uaaDeviceAuthorization struct {
+		DeviceCode              string `json:"device_code"`
+		UserCode                string `json:"user_code"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete"`
+		ExpiresIn               int    `json:"expires_in"`
+		Interval                int    `json:"interval"`
+	}
+	err := json.Unmarshal(data, &uaaDeviceAuthorization)
+	if err != nil {
+		return err
+	}
+
+	deviceAuthorization.DeviceCode = uaaDeviceAuthorization.DeviceCode
+	deviceAuthorization.UserCode = uaaDeviceAuthorization.UserCode
+	deviceAuthorization.VerificationURI = uaaDeviceAuthorization.VerificationURI
+	deviceAuthorization.VerificationURIComplete = uaaDeviceAuthorization.VerificationURIComplete
+	deviceAuthorization.ExpiresIn = time.Duration(uaaDeviceAuthorization.ExpiresIn) * time.Second
+	deviceAuthorization.Interval = time.Duration(uaaDeviceAuthorization.Interval) * time.Second
+	if deviceAuthorization.Interval <= 0 {
+		deviceAuthorization.Interval = defaultDeviceAuthorizationInterval
+	}
+
+	return nil
+}
+
+// StartDeviceAuthorization requests a device code and a user code, which the
+// user enters on another device to approve logging in on this one. It returns
+// a DeviceAuthorizationNotSupportedError when UAA has no device authorization
+// endpoint.
+func (client *Client) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(url.Values{
+			"client_id": {client.config.UAAOAuthClient()},
+		}.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var deviceAuthorization DeviceAuthorization
+	response := Response{
+		Result: &deviceAuthorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	if rawErr, ok := err.(RawHTTPStatusError); ok && rawErr.StatusCode == http.StatusNotFound {
+		return DeviceAuthorization{}, DeviceAuthorizationNotSupportedError{}
+	}
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	return deviceAuthorization, nil
+}
+
+// PollDeviceAuthorization polls UAA until the user approves the device
+// authorization request, then returns an access token and a refresh token.
+//
+// It gives up with a DeviceAuthorizationExpiredError once the device code
+// expires or, when timeout is not zero and ends first, with a
+// DeviceAuthorizationTimeoutError once timeout has passed.
+func (client *Client) PollDeviceAuthorization(deviceAuthorization DeviceAuthorization, timeout time.Duration) (string, string, error) {
+	var (
+		deadline    time.Time
+		deadlineErr error
+	)
+	startTime := time.Now()
+	if deviceAuthorization.ExpiresIn > 0 {
+		deadline = startTime.Add(deviceAuthorization.ExpiresIn)
+		deadlineErr = DeviceAuthorizationExpiredError{Message: "The device code has expired."}
+	}
+	if timeout > 0 && (deadline.IsZero() || timeout < deviceAuthorization.ExpiresIn) {
+		deadline = startTime.Add(timeout)
+		deadlineErr = DeviceAuthorizationTimeoutError{Timeout: timeout}
+	}
+
+	credentials := map[string]string{
+		"device_code": deviceAuthorization.DeviceCode,
+	}
+	interval := deviceAuthorization.Interval
+
+	for {
+		accessToken, refreshToken, err := client.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return accessToken, refreshToken, nil
+		case DeviceAuthorizationPendingError:
+		case DeviceAuthorizationSlowDownError:
+			interval += deviceAuthorizationSlowDownIncrement
+		default:
+			return "", "", err
+		}
+
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			return "", "", deadlineErr
+		}
+		time.Sleep(interval)
+	}
+}
@@ -3,6 +3,9 @@ package uaa
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // errorWrapper is the wrapper that converts responses with 4xx and 5xx status
@@ -48,6 +51,21 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		if uaaErrorResponse.Type == "invalid_scim_resource" {
 			return InvalidSCIMResourceError{Message: uaaErrorResponse.Description}
 		}
+		if uaaErrorResponse.Type == "invalid_request" {
+			return InvalidRequestError{Message: uaaErrorResponse.Description}
+		}
+		if uaaErrorResponse.Type == "authorization_pending" {
+			return DeviceAuthorizationPendingError{Message: uaaErrorResponse.Description}
+		}
+		if uaaErrorResponse.Type == "slow_down" {
+			return DeviceAuthorizationSlowDownError{Message: uaaErrorResponse.Description}
+		}
+		if uaaErrorResponse.Type == "access_denied" {
+			return DeviceAuthorizationDeniedError{Message: uaaErrorResponse.Description}
+		}
+		if uaaErrorResponse.Type == "expired_token" {
+			return DeviceAuthorizationExpiredError{Message: uaaErrorResponse.Description}
+		}
 		return rawHTTPStatusErr
 	case http.StatusUnauthorized: // 401
 		if uaaErrorResponse.Type == "invalid_token" {
@@ -55,14 +73,23 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		}
 		if uaaErrorResponse.Type == "unauthorized" {
 			if uaaErrorResponse.Description == "Your account has been locked because of too many failed attempts to login." {
-				return AccountLockedError{Message: "Your account has been locked because of too many failed attempts to login."}
+				return AccountLockedError{
+					Message:       "Your account has been locked because of too many failed attempts to login.",
+					LockoutPeriod: retryAfterPeriod(rawHTTPStatusErr.RetryAfter),
+				}
+			}
+			if strings.Contains(strings.ToLower(uaaErrorResponse.Description), "password has expired") {
+				return PasswordExpiredError{Message: uaaErrorResponse.Description}
 			}
 			return UnauthorizedError{Message: uaaErrorResponse.Description}
 		}
 		return rawHTTPStatusErr
 	case http.StatusForbidden: // 403
 		if uaaErrorResponse.Type == "insufficient_scope" {
-			return InsufficientScopeError{Message: uaaErrorResponse.Description}
+			return InsufficientScopeError{
+				Message:        uaaErrorResponse.Description,
+				RequiredScopes: strings.Fields(uaaErrorResponse.Scope),
+			}
 		}
 		return rawHTTPStatusErr
 	case http.StatusConflict: // 409
@@ -71,3 +98,13 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		return rawHTTPStatusErr
 	}
 }
+
+// retryAfterPeriod parses a Retry-After header given in seconds. Any other
+// value, including an HTTP date, is treated as absent.
+func retryAfterPeriod(retryAfter string) time.Duration {
+	seconds, err := strconv.Atoi(retryAfter)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -2,6 +2,7 @@ package uaa_test
 
 import (
 	"net/http"
+	"time"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -83,6 +84,78 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("invalid request", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "invalid_request",
+  "error_description": "Missing mfa code"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an InvalidRequestError with the error description", func() {
+						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+						Expect(makeErr).To(MatchError(InvalidRequestError{Message: "Missing mfa code"}))
+					})
+				})
+
+				Context("authorization pending", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "authorization_pending",
+  "error_description": "Authorization pending"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a DeviceAuthorizationPendingError with the error description", func() {
+						Expect(makeErr).To(MatchError(DeviceAuthorizationPendingError{Message: "Authorization pending"}))
+					})
+				})
+
+				Context("slow down", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "slow_down",
+  "error_description": "Slow down"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a DeviceAuthorizationSlowDownError with the error description", func() {
+						Expect(makeErr).To(MatchError(DeviceAuthorizationSlowDownError{Message: "Slow down"}))
+					})
+				})
+
+				Context("access denied", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "access_denied",
+  "error_description": "The user denied the request"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a DeviceAuthorizationDeniedError with the error description", func() {
+						Expect(makeErr).To(MatchError(DeviceAuthorizationDeniedError{Message: "The user denied the request"}))
+					})
+				})
+
+				Context("expired token", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "expired_token",
+  "error_description": "The device code has expired"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a DeviceAuthorizationExpiredError with the error description", func() {
+						Expect(makeErr).To(MatchError(DeviceAuthorizationExpiredError{Message: "The device code has expired"}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
@@ -149,6 +222,34 @@ var _ = Describe("Error Wrapper", func() {
 
 						Expect(makeErr).To(MatchError(AccountLockedError{Message: "Your account has been locked because of too many failed attempts to login."}))
 					})
+
+					When("the response says when to retry", func() {
+						BeforeEach(func() {
+							fakeConnectionErr.RetryAfter = "300"
+							fakeConnection.MakeReturns(fakeConnectionErr)
+						})
+
+						It("returns the lockout period with the error", func() {
+							Expect(makeErr).To(MatchError(AccountLockedError{
+								Message:       "Your account has been locked because of too many failed attempts to login.",
+								LockoutPeriod: 5 * time.Minute,
+							}))
+						})
+					})
+				})
+
+				Context("unauthorized - password expired", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "unauthorized",
+  "error_description": "Your current password has expired. Please reset your password."
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a PasswordExpiredError", func() {
+						Expect(makeErr).To(MatchError(PasswordExpiredError{Message: "Your current password has expired. Please reset your password."}))
+					})
 				})
 			})
 
@@ -185,7 +286,10 @@ var _ = Describe("Error Wrapper", func() {
 					It("returns an InsufficientScopeError", func() {
 						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 
-						Expect(makeErr).To(MatchError(InsufficientScopeError{Message: "Insufficient scope for this resource"}))
+						Expect(makeErr).To(MatchError(InsufficientScopeError{
+							Message:        "Insufficient scope for this resource",
+							RequiredScopes: []string{"admin", "scim.write", "scim.create", "zones.admin"},
+						}))
 					})
 				})
 			})
@@ -1,11 +1,16 @@
 package uaa
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // RawHTTPStatusError represents any response with a 4xx or 5xx status code.
 type RawHTTPStatusError struct {
 	StatusCode  int
 	RawResponse []byte
+	// RetryAfter is the value of the response's Retry-After header, if any.
+	RetryAfter string
 }
 
 func (r RawHTTPStatusError) Error() string {
@@ -16,6 +21,9 @@ func (r RawHTTPStatusError) Error() string {
 type UAAErrorResponse struct {
 	Type        string `json:"error"`
 	Description string `json:"error_description"`
+	// Scope lists the scopes, separated by spaces, any of which would have
+	// authorized the request. It is only set for insufficient_scope errors.
+	Scope string `json:"scope"`
 }
 
 func (e UAAErrorResponse) Error() string {
@@ -74,6 +82,9 @@ func (e InvalidAuthTokenError) Error() string {
 // InsufficientScopeError is returned when the client has insufficient scope
 type InsufficientScopeError struct {
 	Message string
+	// RequiredScopes are the scopes, any of which would have authorized the
+	// request.
+	RequiredScopes []string
 }
 
 func (e InsufficientScopeError) Error() string {
@@ -89,10 +100,109 @@ func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
 
+// InvalidRequestError is returned when UAA rejects a request as malformed,
+// such as a token request that is missing a parameter the server requires.
+type InvalidRequestError struct {
+	Message string
+}
+
+func (e InvalidRequestError) Error() string {
+	return e.Message
+}
+
+// AccountLockedError is returned when the user's account has been locked
+// after too many failed attempts to log in.
 type AccountLockedError struct {
 	Message string
+	// LockoutPeriod is how long UAA asked the client to wait before trying
+	// again. It is zero when UAA did not say.
+	LockoutPeriod time.Duration
 }
 
 func (e AccountLockedError) Error() string {
 	return ""
 }
+
+// PasswordExpiredError is returned when the user's password has expired and
+// must be reset before they can log in.
+type PasswordExpiredError struct {
+	Message string
+	// ResetURL is the login server's password reset page, if it advertises
+	// one.
+	ResetURL string
+}
+
+func (e PasswordExpiredError) Error() string {
+	return e.Message
+}
+
+// RedirectURINotRegisteredError is returned when the login server refuses to
+// redirect the browser back to the CLI after a browser login, as the OAuth
+// client is not registered for the redirect URI.
+type RedirectURINotRegisteredError struct {
+	ClientID    string
+	RedirectURI string
+}
+
+func (e RedirectURINotRegisteredError) Error() string {
+	return "the OAuth client " + e.ClientID + " is not registered for the redirect URI " + e.RedirectURI
+}
+
+// DeviceAuthorizationNotSupportedError is returned when UAA does not support
+// the device authorization grant.
+type DeviceAuthorizationNotSupportedError struct{}
+
+func (e DeviceAuthorizationNotSupportedError) Error() string {
+	return "UAA does not support the device authorization grant"
+}
+
+// DeviceAuthorizationPendingError is returned when polling for tokens before
+// the user has approved the device authorization request.
+type DeviceAuthorizationPendingError struct {
+	Message string
+}
+
+func (e DeviceAuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// DeviceAuthorizationSlowDownError is returned when polling for tokens more
+// often than UAA allows.
+type DeviceAuthorizationSlowDownError struct {
+	Message string
+}
+
+func (e DeviceAuthorizationSlowDownError) Error() string {
+	return e.Message
+}
+
+// DeviceAuthorizationDeniedError is returned when the user denied the device
+// authorization request.
+type DeviceAuthorizationDeniedError struct {
+	Message string
+}
+
+func (e DeviceAuthorizationDeniedError) Error() string {
+	return e.Message
+}
+
+// DeviceAuthorizationExpiredError is returned when the device code expired
+// before the user approved the device authorization request.
+type DeviceAuthorizationExpiredError struct {
+	Message string
+}
+
+func (e DeviceAuthorizationExpiredError) Error() string {
+	return e.Message
+}
+
+// DeviceAuthorizationTimeoutError is returned when the user did not approve
+// the device authorization request within the time the client was willing to
+// wait.
+type DeviceAuthorizationTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e DeviceAuthorizationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for the device authorization request to be approved", e.Timeout)
+}
@@ -6,8 +6,9 @@ type Info struct {
 		Version string `json:"version"`
 	} `json:"app"`
 	Links struct {
-		UAA   string `json:"uaa"`
-		Login string `json:"login"`
+		UAA    string `json:"uaa"`
+		Login  string `json:"login"`
+		Passwd string `json:"passwd"`
 	} `json:"links"`
 	Prompts map[string][]string `json:"prompts"`
 }
@@ -22,6 +23,11 @@ func (info Info) LoginLink() string {
 	return info.Links.Login
 }
 
+// PasswordResetLink is the URL to the login server's password reset page.
+func (info Info) PasswordResetLink() string {
+	return info.Links.Passwd
+}
+
 func (info Info) LoginPrompts() map[string][]string {
 	return info.Prompts
 }
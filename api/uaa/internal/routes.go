@@ -5,14 +5,20 @@ import (
 )
 
 const (
-	GetSSHPasscodeRequest = "GetSSHPasscode"
-	PostOAuthTokenRequest = "PostOAuthToken"
-	PostUserRequest       = "PostUser"
+	GetAuthorizationCodeRequest    = "GetAuthorizationCode"
+	GetLoginPromptsRequest         = "GetLoginPrompts"
+	GetSSHPasscodeRequest          = "GetSSHPasscode"
+	PostDeviceAuthorizationRequest = "PostDeviceAuthorization"
+	PostOAuthTokenRequest          = "PostOAuthToken"
+	PostUserRequest                = "PostUser"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest, Resource: UAAResource},
+	{Path: "/login", Method: http.MethodGet, Name: GetLoginPromptsRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
+	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetAuthorizationCodeRequest, Resource: AuthorizationResource},
+	{Path: "/oauth/device_authorization", Method: http.MethodPost, Name: PostDeviceAuthorizationRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 }
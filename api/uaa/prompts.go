@@ -0,0 +1,27 @@
+package uaa
+
+import "code.cloudfoundry.org/cli/api/uaa/internal"
+
+// RefreshLoginPrompts fetches the login server's prompts again, replacing the
+// ones discovered when the client's resources were set up.
+func (client *Client) RefreshLoginPrompts() error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetLoginPromptsRequest,
+	})
+	if err != nil {
+		return err
+	}
+
+	var info Info
+	response := Response{
+		Result: &info,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return err
+	}
+
+	client.Info.Prompts = info.Prompts
+	return nil
+}
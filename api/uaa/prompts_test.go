@@ -0,0 +1,73 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Prompts", func() {
+	var (
+		client *Client
+
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("RefreshLoginPrompts", func() {
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodGet, "/login"),
+						RespondWith(http.StatusOK, `{
+							"prompts": {
+								"username": [ "text", "Email" ],
+								"password": [ "password", "Password" ],
+								"mfaCode": [ "password", "MFA Code" ]
+							}
+						}`),
+					))
+			})
+
+			It("replaces the client's login prompts", func() {
+				err := client.RefreshLoginPrompts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(client.LoginPrompts()).To(Equal(map[string][]string{
+					"username": {"text", "Email"},
+					"password": {"password", "Password"},
+					"mfaCode":  {"password", "MFA Code"},
+				}))
+			})
+		})
+
+		When("an error occurs", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodGet, "/login"),
+						RespondWith(http.StatusTeapot, `{}`),
+					))
+			})
+
+			It("returns the error", func() {
+				err := client.RefreshLoginPrompts()
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(`{}`),
+				}))
+			})
+		})
+	})
+})
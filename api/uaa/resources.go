@@ -28,6 +28,14 @@ func (client *Client) SetupResources(bootstrapURL string) error {
 		return err
 	}
 
+	client.SetupResourcesWithInfo(bootstrapURL, info)
+	return nil
+}
+
+// SetupResourcesWithInfo configures the client like SetupResources, with the
+// info that another client retrieved from the bootstrap URL. No request is
+// made.
+func (client *Client) SetupResourcesWithInfo(bootstrapURL string, info Info) {
 	resources := map[string]string{
 		"uaa":                    info.UAALink(),
 		"authorization_endpoint": bootstrapURL,
@@ -37,6 +45,4 @@ func (client *Client) SetupResources(bootstrapURL string) error {
 	client.Info = info
 
 	client.config.SetUAAEndpoint(info.UAALink())
-
-	return nil
 }
@@ -65,6 +65,7 @@ func (*UAAConnection) handleStatusCodes(response *http.Response, passedResponse
 		return RawHTTPStatusError{
 			StatusCode:  response.StatusCode,
 			RawResponse: passedResponse.RawResponse,
+			RetryAfter:  response.Header.Get("Retry-After"),
 		}
 	}
 
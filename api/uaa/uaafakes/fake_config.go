@@ -94,6 +94,16 @@ type FakeConfig struct {
 	uAAOAuthClientSecretReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UserAgentSuffixStub        func() string
+	userAgentSuffixMutex       sync.RWMutex
+	userAgentSuffixArgsForCall []struct {
+	}
+	userAgentSuffixReturns struct {
+		result1 string
+	}
+	userAgentSuffixReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -545,6 +555,58 @@ func (fake *FakeConfig) UAAOAuthClientSecretReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+func (fake *FakeConfig) UserAgentSuffix() string {
+	fake.userAgentSuffixMutex.Lock()
+	ret, specificReturn := fake.userAgentSuffixReturnsOnCall[len(fake.userAgentSuffixArgsForCall)]
+	fake.userAgentSuffixArgsForCall = append(fake.userAgentSuffixArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserAgentSuffix", []interface{}{})
+	fake.userAgentSuffixMutex.Unlock()
+	if fake.UserAgentSuffixStub != nil {
+		return fake.UserAgentSuffixStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.userAgentSuffixReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UserAgentSuffixCallCount() int {
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
+	return len(fake.userAgentSuffixArgsForCall)
+}
+
+func (fake *FakeConfig) UserAgentSuffixCalls(stub func() string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = stub
+}
+
+func (fake *FakeConfig) UserAgentSuffixReturns(result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	fake.userAgentSuffixReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UserAgentSuffixReturnsOnCall(i int, result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	if fake.userAgentSuffixReturnsOnCall == nil {
+		fake.userAgentSuffixReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.userAgentSuffixReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -566,6 +628,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,56 @@
+package wrapper
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/dryrun"
+)
+
+// DryRun is a wrapper that prints the requests that modify resources instead
+// of sending them. Token requests are sent, so that the session stays
+// authenticated, as are requests that only read resources.
+type DryRun struct {
+	output     io.Writer
+	connection uaa.Connection
+}
+
+// NewDryRun returns a pointer to a DryRun wrapper that prints the skipped
+// requests to output.
+func NewDryRun(output io.Writer) *DryRun {
+	return &DryRun{
+		output: output,
+	}
+}
+
+// Make prints POST, PUT, PATCH and DELETE requests other than token requests
+// and returns an empty response for them. Other requests are sent.
+func (wrapper *DryRun) Make(request *http.Request, passedResponse *uaa.Response) error {
+	if !dryrun.ModifiesResources(request.Method) || dryrun.IsTokenRequest(request.URL.Path) {
+		return wrapper.connection.Make(request, passedResponse)
+	}
+
+	_, err := fmt.Fprintf(wrapper.output, "Dry run: %s %s\n", request.Method, request.URL.String())
+	if err != nil {
+		return err
+	}
+
+	if request.Body == nil {
+		return nil
+	}
+
+	summary, err := dryrun.SummarizeBody(request.URL.Path, request.Header.Get("Content-Type"), request.Body)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(wrapper.output, "  %s\n", summary)
+	return err
+}
+
+// Wrap sets the connection in the DryRun and returns itself.
+func (wrapper *DryRun) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
@@ -0,0 +1,62 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry Run", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		output         *bytes.Buffer
+		wrapper        uaa.Connection
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		output = new(bytes.Buffer)
+
+		wrapper = NewDryRun(output).Wrap(fakeConnection)
+	})
+
+	It("prints requests that modify resources instead of sending them", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://uaa.bar.com/Users", strings.NewReader(`{"userName": "some-user"}`))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Content-Type", "application/json")
+
+		err = wrapper.Make(request, &uaa.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+		Expect(output.String()).To(Equal("Dry run: POST https://uaa.bar.com/Users\n  {\"userName\":\"some-user\"}\n"))
+	})
+
+	It("sends token requests", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://uaa.bar.com/oauth/token", strings.NewReader("grant_type=refresh_token"))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = wrapper.Make(request, &uaa.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(output.Len()).To(Equal(0))
+	})
+
+	It("sends requests that only read resources", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://uaa.bar.com/Users", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = wrapper.Make(request, &uaa.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(output.Len()).To(Equal(0))
+	})
+})
@@ -0,0 +1,43 @@
+package wrapper
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+//go:generate counterfeiter . TimingsRecorder
+
+// TimingsRecorder records how long requests take.
+type TimingsRecorder interface {
+	RecordRequest(request *http.Request, duration time.Duration)
+}
+
+// Timings is a wrapper that records how long each request to the UAA
+// server takes.
+type Timings struct {
+	recorder   TimingsRecorder
+	connection uaa.Connection
+}
+
+// NewTimings returns a pointer to a Timings wrapper.
+func NewTimings(recorder TimingsRecorder) *Timings {
+	return &Timings{
+		recorder: recorder,
+	}
+}
+
+// Make records the duration of the request, whether or not it succeeds.
+func (wrapper *Timings) Make(request *http.Request, passedResponse *uaa.Response) error {
+	start := time.Now()
+	err := wrapper.connection.Make(request, passedResponse)
+	wrapper.recorder.RecordRequest(request, time.Since(start))
+	return err
+}
+
+// Wrap sets the connection in the Timings and returns itself.
+func (wrapper *Timings) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	wrapper.connection = innerconnection
+	return wrapper
+}
@@ -0,0 +1,30 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timings", func() {
+	It("records each request made to the UAA server", func() {
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeRecorder := new(wrapperfakes.FakeTimingsRecorder)
+
+		request, err := http.NewRequest(http.MethodPost, "https://uaa.bar.com/oauth/token", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = NewTimings(fakeRecorder).Wrap(fakeConnection).Make(request, &uaa.Response{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(fakeRecorder.RecordRequestCallCount()).To(Equal(1))
+		recordedRequest, _ := fakeRecorder.RecordRequestArgsForCall(0)
+		Expect(recordedRequest).To(Equal(request))
+	})
+})
@@ -16,6 +16,15 @@ type FakeAppSummaryRepository struct {
 		result1 []models.Application
 		result2 error
 	}
+	GetSummariesInSpaceStub        func(spaceGUID string) (apps []models.Application, apiErr error)
+	getSummariesInSpaceMutex       sync.RWMutex
+	getSummariesInSpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getSummariesInSpaceReturns struct {
+		result1 []models.Application
+		result2 error
+	}
 	GetSummaryStub        func(appGUID string) (summary models.Application, apiErr error)
 	getSummaryMutex       sync.RWMutex
 	getSummaryArgsForCall []struct {
@@ -55,6 +64,40 @@ func (fake *FakeAppSummaryRepository) GetSummariesInCurrentSpaceReturns(result1
 	}{result1, result2}
 }
 
+func (fake *FakeAppSummaryRepository) GetSummariesInSpace(spaceGUID string) (apps []models.Application, apiErr error) {
+	fake.getSummariesInSpaceMutex.Lock()
+	fake.getSummariesInSpaceArgsForCall = append(fake.getSummariesInSpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSummariesInSpace", []interface{}{spaceGUID})
+	fake.getSummariesInSpaceMutex.Unlock()
+	if fake.GetSummariesInSpaceStub != nil {
+		return fake.GetSummariesInSpaceStub(spaceGUID)
+	} else {
+		return fake.getSummariesInSpaceReturns.result1, fake.getSummariesInSpaceReturns.result2
+	}
+}
+
+func (fake *FakeAppSummaryRepository) GetSummariesInSpaceCallCount() int {
+	fake.getSummariesInSpaceMutex.RLock()
+	defer fake.getSummariesInSpaceMutex.RUnlock()
+	return len(fake.getSummariesInSpaceArgsForCall)
+}
+
+func (fake *FakeAppSummaryRepository) GetSummariesInSpaceArgsForCall(i int) string {
+	fake.getSummariesInSpaceMutex.RLock()
+	defer fake.getSummariesInSpaceMutex.RUnlock()
+	return fake.getSummariesInSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeAppSummaryRepository) GetSummariesInSpaceReturns(result1 []models.Application, result2 error) {
+	fake.GetSummariesInSpaceStub = nil
+	fake.getSummariesInSpaceReturns = struct {
+		result1 []models.Application
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAppSummaryRepository) GetSummary(appGUID string) (summary models.Application, apiErr error) {
 	fake.getSummaryMutex.Lock()
 	fake.getSummaryArgsForCall = append(fake.getSummaryArgsForCall, struct {
@@ -94,6 +137,8 @@ func (fake *FakeAppSummaryRepository) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getSummariesInCurrentSpaceMutex.RLock()
 	defer fake.getSummariesInCurrentSpaceMutex.RUnlock()
+	fake.getSummariesInSpaceMutex.RLock()
+	defer fake.getSummariesInSpaceMutex.RUnlock()
 	fake.getSummaryMutex.RLock()
 	defer fake.getSummaryMutex.RUnlock()
 	return fake.invocations
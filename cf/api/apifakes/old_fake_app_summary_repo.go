@@ -8,6 +8,9 @@ import (
 type OldFakeAppSummaryRepo struct {
 	GetSummariesInCurrentSpaceApps []models.Application
 
+	GetSummariesInSpaceApps       map[string][]models.Application
+	GetSummariesInSpaceSpaceGUIDs []string
+
 	GetSummaryErrorCode string
 	GetSummaryAppGUID   string
 	GetSummarySummary   models.Application
@@ -18,6 +21,12 @@ func (repo *OldFakeAppSummaryRepo) GetSummariesInCurrentSpace() (apps []models.A
 	return
 }
 
+func (repo *OldFakeAppSummaryRepo) GetSummariesInSpace(spaceGUID string) (apps []models.Application, apiErr error) {
+	repo.GetSummariesInSpaceSpaceGUIDs = append(repo.GetSummariesInSpaceSpaceGUIDs, spaceGUID)
+	apps = repo.GetSummariesInSpaceApps[spaceGUID]
+	return
+}
+
 func (repo *OldFakeAppSummaryRepo) GetSummary(appGUID string) (summary models.Application, apiErr error) {
 	repo.GetSummaryAppGUID = appGUID
 	summary = repo.GetSummarySummary
@@ -129,6 +129,7 @@ type DomainSummary struct {
 
 type AppSummaryRepository interface {
 	GetSummariesInCurrentSpace() (apps []models.Application, apiErr error)
+	GetSummariesInSpace(spaceGUID string) (apps []models.Application, apiErr error)
 	GetSummary(appGUID string) (summary models.Application, apiErr error)
 }
 
@@ -144,9 +145,13 @@ func NewCloudControllerAppSummaryRepository(config coreconfig.Reader, gateway ne
 }
 
 func (repo CloudControllerAppSummaryRepository) GetSummariesInCurrentSpace() ([]models.Application, error) {
+	return repo.GetSummariesInSpace(repo.config.SpaceFields().GUID)
+}
+
+func (repo CloudControllerAppSummaryRepository) GetSummariesInSpace(spaceGUID string) ([]models.Application, error) {
 	resources := new(ApplicationSummaries)
 
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.APIEndpoint(), repo.config.SpaceFields().GUID)
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.APIEndpoint(), spaceGUID)
 	err := repo.gateway.GetResource(path, resources)
 	if err != nil {
 		return []models.Application{}, err
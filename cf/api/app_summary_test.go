@@ -86,6 +86,39 @@ var _ = Describe("AppSummaryRepository", func() {
 		})
 	})
 
+	Describe("GetSummariesInSpace()", func() {
+		BeforeEach(func() {
+			getAppSummariesRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/spaces/other-space-guid/summary",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   getAppSummariesResponseBody,
+				},
+			})
+
+			testServer, handler = testnet.NewServer([]testnet.TestRequest{getAppSummariesRequest})
+			configRepo := testconfig.NewRepositoryWithDefaults()
+			configRepo.SetAPIEndpoint(testServer.URL)
+			gateway := net.NewCloudControllerGateway(configRepo, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+			repo = NewCloudControllerAppSummaryRepository(configRepo, gateway)
+		})
+
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It("returns the app summaries of the given space", func() {
+			apps, apiErr := repo.GetSummariesInSpace("other-space-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(apps).To(HaveLen(3))
+			Expect(apps[0].Name).To(Equal("app1"))
+			Expect(apps[1].Name).To(Equal("app2"))
+		})
+	})
+
 	Describe("GetSummary()", func() {
 		BeforeEach(func() {
 			getAppSummaryRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
@@ -235,6 +235,19 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 
+			Context("when the user is authenticated with authorization code grant", func() {
+				BeforeEach(func() {
+					config.SetUAAGrantType("authorization_code")
+					config.SetAccessToken(testAccessToken)
+					setupTestServer(successfulPasswordRefreshTokenRequest)
+				})
+
+				It("uses the refresh token to refresh the access token", func() {
+					Expect(apiErr).ToNot(HaveOccurred())
+					Expect(accessToken).To(Equal(fmt.Sprintf("BEARER %s", testAccessToken)))
+				})
+			})
+
 			Context("when the refresh token has expired", func() {
 				BeforeEach(func() {
 					setupTestServer(refreshTokenExpiredRequestError)
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf/models"
@@ -19,6 +20,15 @@ type ServicePlanEntity struct {
 	Description         string                  `json:"description"`
 	ServiceOfferingGUID string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Schemas             ServicePlanSchemas      `json:"schemas"`
+}
+
+type ServicePlanSchemas struct {
+	ServiceInstance struct {
+		Update struct {
+			Parameters json.RawMessage `json:"parameters"`
+		} `json:"update"`
+	} `json:"service_instance"`
 }
 
 type ServicePlanDescription struct {
@@ -35,6 +45,9 @@ func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields)
 	fields.Public = resource.Entity.Public
 	fields.Active = resource.Entity.Active
 	fields.ServiceOfferingGUID = resource.Entity.ServiceOfferingGUID
+	if parameters := resource.Entity.Schemas.ServiceInstance.Update.Parameters; len(parameters) > 0 && string(parameters) != "null" && string(parameters) != "{}" {
+		fields.UpdateParametersSchema = parameters
+	}
 	return
 }
 
@@ -18,6 +18,7 @@ import (
 type SpaceRepository interface {
 	ListSpaces(func(models.Space) bool) error
 	ListSpacesFromOrg(orgGUID string, spaceFunc func(models.Space) bool) error
+	ListAllSpaces(spaceFunc func(models.Space) bool) error
 	FindByName(name string) (space models.Space, apiErr error)
 	FindByNameInOrg(name, orgGUID string) (space models.Space, apiErr error)
 	Create(name string, orgGUID string, spaceQuotaGUID string) (space models.Space, apiErr error)
@@ -57,6 +58,16 @@ func (repo CloudControllerSpaceRepository) ListSpacesFromOrg(orgGUID string, cal
 		})
 }
 
+func (repo CloudControllerSpaceRepository) ListAllSpaces(callback func(models.Space) bool) error {
+	return repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		"/v2/spaces?order-by=name&inline-relations-depth=1",
+		resources.SpaceResource{},
+		func(resource interface{}) bool {
+			return callback(resource.(resources.SpaceResource).ToModel())
+		})
+}
+
 func (repo CloudControllerSpaceRepository) FindByName(name string) (space models.Space, apiErr error) {
 	return repo.FindByNameInOrg(name, repo.config.OrganizationFields().GUID)
 }
@@ -212,6 +212,70 @@ var _ = Describe("Space Repository", func() {
 		})
 	})
 
+	Describe("ListAllSpaces", func() {
+		var (
+			ccServer *ghttp.Server
+			repo     CloudControllerSpaceRepository
+		)
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			configRepo := testconfig.NewRepositoryWithDefaults()
+			configRepo.SetAPIEndpoint(ccServer.URL())
+			gateway := net.NewCloudControllerGateway(configRepo, time.Now, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+			repo = NewCloudControllerSpaceRepository(configRepo, gateway)
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v2/spaces", "order-by=name&inline-relations-depth=1"),
+					ghttp.RespondWith(http.StatusOK, `{
+						"next_url": null,
+						"resources": [
+							{
+								"metadata": { "guid": "space1-guid" },
+								"entity": {
+									"name": "Alpha",
+									"organization": {
+										"metadata": { "guid": "org1-guid" },
+										"entity": { "name": "org1" }
+									}
+								}
+							},
+							{
+								"metadata": { "guid": "space2-guid" },
+								"entity": {
+									"name": "Beta",
+									"organization": {
+										"metadata": { "guid": "org2-guid" },
+										"entity": { "name": "org2" }
+									}
+								}
+							}
+						]
+					}`),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		It("lists the spaces across all orgs along with their org", func() {
+			spaces := []models.Space{}
+			apiErr := repo.ListAllSpaces(func(space models.Space) bool {
+				spaces = append(spaces, space)
+				return true
+			})
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(spaces).To(HaveLen(2))
+			Expect(spaces[0].GUID).To(Equal("space1-guid"))
+			Expect(spaces[0].Organization.GUID).To(Equal("org1-guid"))
+			Expect(spaces[1].GUID).To(Equal("space2-guid"))
+			Expect(spaces[1].Organization.Name).To(Equal("org2"))
+		})
+	})
+
 	Describe("finding spaces by name", func() {
 		It("returns the space", func() {
 			testSpacesFindByNameWithOrg("my-org-guid",
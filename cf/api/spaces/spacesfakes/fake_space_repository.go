@@ -9,6 +9,17 @@ import (
 )
 
 type FakeSpaceRepository struct {
+	ListAllSpacesStub        func(func(models.Space) bool) error
+	listAllSpacesMutex       sync.RWMutex
+	listAllSpacesArgsForCall []struct {
+		arg1 func(models.Space) bool
+	}
+	listAllSpacesReturns struct {
+		result1 error
+	}
+	listAllSpacesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ListSpacesStub        func(func(models.Space) bool) error
 	listSpacesMutex       sync.RWMutex
 	listSpacesArgsForCall []struct {
@@ -86,6 +97,66 @@ type FakeSpaceRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSpaceRepository) ListAllSpaces(arg1 func(models.Space) bool) error {
+	fake.listAllSpacesMutex.Lock()
+	ret, specificReturn := fake.listAllSpacesReturnsOnCall[len(fake.listAllSpacesArgsForCall)]
+	fake.listAllSpacesArgsForCall = append(fake.listAllSpacesArgsForCall, struct {
+		arg1 func(models.Space) bool
+	}{arg1})
+	fake.recordInvocation("ListAllSpaces", []interface{}{arg1})
+	fake.listAllSpacesMutex.Unlock()
+	if fake.ListAllSpacesStub != nil {
+		return fake.ListAllSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.listAllSpacesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSpaceRepository) ListAllSpacesCallCount() int {
+	fake.listAllSpacesMutex.RLock()
+	defer fake.listAllSpacesMutex.RUnlock()
+	return len(fake.listAllSpacesArgsForCall)
+}
+
+func (fake *FakeSpaceRepository) ListAllSpacesCalls(stub func(func(models.Space) bool) error) {
+	fake.listAllSpacesMutex.Lock()
+	defer fake.listAllSpacesMutex.Unlock()
+	fake.ListAllSpacesStub = stub
+}
+
+func (fake *FakeSpaceRepository) ListAllSpacesArgsForCall(i int) func(models.Space) bool {
+	fake.listAllSpacesMutex.RLock()
+	defer fake.listAllSpacesMutex.RUnlock()
+	argsForCall := fake.listAllSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSpaceRepository) ListAllSpacesReturns(result1 error) {
+	fake.listAllSpacesMutex.Lock()
+	defer fake.listAllSpacesMutex.Unlock()
+	fake.ListAllSpacesStub = nil
+	fake.listAllSpacesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSpaceRepository) ListAllSpacesReturnsOnCall(i int, result1 error) {
+	fake.listAllSpacesMutex.Lock()
+	defer fake.listAllSpacesMutex.Unlock()
+	fake.ListAllSpacesStub = nil
+	if fake.listAllSpacesReturnsOnCall == nil {
+		fake.listAllSpacesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listAllSpacesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSpaceRepository) ListSpaces(arg1 func(models.Space) bool) error {
 	fake.listSpacesMutex.Lock()
 	fake.listSpacesArgsForCall = append(fake.listSpacesArgsForCall, struct {
@@ -362,6 +433,8 @@ func (fake *FakeSpaceRepository) DeleteReturns(result1 error) {
 func (fake *FakeSpaceRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.listAllSpacesMutex.RLock()
+	defer fake.listAllSpacesMutex.RUnlock()
 	fake.listSpacesMutex.RLock()
 	defer fake.listSpacesMutex.RUnlock()
 	fake.listSpacesFromOrgMutex.RLock()
@@ -5,6 +5,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	"path/filepath"
 
@@ -23,6 +24,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/cf/util/spellcheck"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/usagestats"
 
 	netrpc "net/rpc"
 )
@@ -86,10 +89,16 @@ func Main(traceEnv string, args []string) {
 
 	commandsloader.Load()
 
+	pluginConfig := newPluginConfig(func(err error) {
+		deps.UI.Failed(fmt.Sprintf("Error read/writing plugin config: %s, ", err.Error()))
+	})
+	pluginList := pluginConfig.Plugins()
+	precedence, limits := pluginSettings()
+
 	//run core command
 	cmdName := args[1]
 	cmd := cmdRegistry.FindCommand(cmdName)
-	if cmd != nil {
+	if cmd != nil && !rpc.PluginOverridesCommand(cmdName, pluginList, precedence) {
 		meta := cmd.MetaData()
 		flagContext := flags.NewFlagContext(meta.Flags)
 		flagContext.SkipFlagParsing(meta.SkipFlagParsing)
@@ -105,21 +114,26 @@ func Main(traceEnv string, args []string) {
 		cmd = cmd.SetDependency(deps, false)
 		cmdRegistry.SetCommand(cmd)
 
+		startTime := time.Now()
+
 		requirementsFactory := requirements.NewFactory(deps.Config, deps.RepoLocator)
 		reqs, reqErr := cmd.Requirements(requirementsFactory, flagContext)
 		if reqErr != nil {
+			recordUsage(meta.Name, startTime, reqErr)
 			os.Exit(1)
 		}
 
 		for _, req := range reqs {
 			err = req.Execute()
 			if err != nil {
+				recordUsage(meta.Name, startTime, err)
 				deps.UI.Failed(err.Error())
 				os.Exit(1)
 			}
 		}
 
 		err = cmd.Execute(flagContext)
+		recordUsage(meta.Name, startTime, err)
 		if err != nil {
 			deps.UI.Failed(err.Error())
 			if _, ok := err.(*errors.CurlHTTPError); ok {
@@ -145,22 +159,71 @@ func Main(traceEnv string, args []string) {
 		os.Exit(1)
 	}
 
+	ran, exitCode := rpc.RunMethodIfExists(rpcService, args[1:], pluginList, precedence, limits)
+	if !ran {
+		if pluginNames := rpc.PluginsWithMethod(cmdName, pluginList); len(pluginNames) > 1 {
+			deps.UI.Say(T("'{{.Command}}' is a command of the plugins {{.Plugins}}. Run it as 'cf PLUGIN:{{.Command}}', or choose the plugin that runs it with 'cf config set plugin-precedence PLUGIN'.",
+				map[string]interface{}{
+					"Command": cmdName,
+					"Plugins": strings.Join(pluginNames, ", "),
+				}))
+			os.Exit(1)
+		}
+
+		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
+		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
+		os.Exit(1)
+	}
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// pluginSettings returns the plugins whose commands run when other plugins,
+// or core commands, have the same name, and the limits plugin commands run
+// within. The settings live in the newer config, so it is loaded here.
+func pluginSettings() ([]string, rpc.PluginLimits) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, rpc.PluginLimits{}
+	}
+	return config.PluginPrecedence(), rpc.PluginLimits{
+		Timeout:   config.PluginTimeout(),
+		MaxOutput: config.PluginMaxOutput(),
+	}
+}
+
+// PluginOverridesCommand returns whether a plugin in the plugin-precedence
+// setting has the command or alias name, so that Main runs the plugin command
+// instead of the core command. The plugin config is only loaded when the
+// setting is not empty.
+func PluginOverridesCommand(name string) bool {
+	precedence, _ := pluginSettings()
+	if len(precedence) == 0 {
+		return false
+	}
+
+	pluginConfig := newPluginConfig(func(error) {})
+	return rpc.PluginOverridesCommand(name, pluginConfig.Plugins(), precedence)
+}
+
+func newPluginConfig(onError func(error)) *pluginconfig.PluginConfig {
 	pluginPath := filepath.Join(confighelpers.PluginRepoDir(), ".cf", "plugins")
-	pluginConfig := pluginconfig.NewPluginConfig(
-		func(err error) {
-			deps.UI.Failed(fmt.Sprintf("Error read/writing plugin config: %s, ", err.Error()))
-		},
+	return pluginconfig.NewPluginConfig(
+		onError,
 		configuration.NewDiskPersistor(filepath.Join(pluginPath, "config.json")),
 		pluginPath,
 	)
-	pluginList := pluginConfig.Plugins()
+}
 
-	ran := rpc.RunMethodIfExists(rpcService, args[1:], pluginList)
-	if !ran {
-		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
-		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
-		os.Exit(1)
+// recordUsage records a core command run in the opt-in usage stats. The
+// usage stats settings live in the newer config, so it is loaded here.
+func recordUsage(cmdName string, startTime time.Time, cmdErr error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return
 	}
+	usagestats.RecordCommand(config, configv3.UsageStatsFilePath(), cmdName, startTime, cmdErr)
 }
 
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"errors"
+	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -10,6 +12,8 @@ import (
 	"code.cloudfoundry.org/cli/plugin/models"
 
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/spacequotas"
+	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/formatters"
 	"code.cloudfoundry.org/cli/cf/requirements"
@@ -17,10 +21,14 @@ import (
 	"code.cloudfoundry.org/cli/cf/uihelpers"
 )
 
+var appSortOrders = []string{"memory", "instances", "name", "state"}
+
 type ListApps struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
 	appSummaryRepo api.AppSummaryRepository
+	spaceRepo      spaces.SpaceRepository
+	spaceQuotaRepo spacequotas.SpaceQuotaRepository
 
 	pluginAppModels *[]plugin_models.GetAppsModel
 	pluginCall      bool
@@ -31,13 +39,18 @@ func init() {
 }
 
 func (cmd *ListApps) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["sort"] = &flags.StringFlag{Name: "sort", Usage: T("Sort apps by memory, instances, name or state")}
+	fs["all-spaces"] = &flags.BoolFlag{Name: "all-spaces", Usage: T("List the apps in all spaces of the targeted org")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "apps",
 		ShortName:   "a",
 		Description: T("List all apps in the target space"),
 		Usage: []string{
-			"CF_NAME apps",
+			"CF_NAME apps [--sort memory | instances | name | state] [--all-spaces]",
 		},
+		Flags: fs,
 	}
 }
 
@@ -49,10 +62,25 @@ func (cmd *ListApps) Requirements(requirementsFactory requirements.Factory, fc f
 		},
 	)
 
+	sortReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("--sort must be one of: {{.SortOrders}}", map[string]interface{}{
+			"SortOrders": strings.Join(appSortOrders, ", "),
+		}),
+		func() bool {
+			return fc.IsSet("sort") && !validAppSortOrder(fc.String("sort"))
+		},
+	)
+
 	reqs := []requirements.Requirement{
 		usageReq,
+		sortReq,
 		requirementsFactory.NewLoginRequirement(),
-		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	if fc.Bool("all-spaces") {
+		reqs = append(reqs, requirementsFactory.NewTargetedOrgRequirement())
+	} else {
+		reqs = append(reqs, requirementsFactory.NewTargetedSpaceRequirement())
 	}
 
 	return reqs, nil
@@ -62,24 +90,54 @@ func (cmd *ListApps) SetDependency(deps commandregistry.Dependency, pluginCall b
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
+	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
+	cmd.spaceQuotaRepo = deps.RepoLocator.GetSpaceQuotaRepository()
 	cmd.pluginAppModels = deps.PluginModels.AppsSummary
 	cmd.pluginCall = pluginCall
 	return cmd
 }
 
 func (cmd *ListApps) Execute(c flags.FlagContext) error {
-	cmd.ui.Say(T("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	allSpaces := c.Bool("all-spaces")
 
-	apps, err := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if allSpaces {
+		cmd.ui.Say(T("Getting apps in org {{.OrgName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"Username": terminal.EntityNameColor(cmd.config.Username())}))
+	} else {
+		cmd.ui.Say(T("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	}
 
+	spacesToList, err := cmd.spacesToList(allSpaces)
 	if err != nil {
 		return err
 	}
 
+	var (
+		apps        []models.Application
+		spaceApps   [][]models.Application
+		spacesFound []models.Space
+	)
+	for _, space := range spacesToList {
+		appsInSpace, err := cmd.appSummaryRepo.GetSummariesInSpace(space.GUID)
+		if err != nil {
+			return err
+		}
+		if len(appsInSpace) == 0 {
+			continue
+		}
+
+		sortApps(appsInSpace, c.String("sort"))
+		apps = append(apps, appsInSpace...)
+		spaceApps = append(spaceApps, appsInSpace)
+		spacesFound = append(spacesFound, space)
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -88,41 +146,191 @@ func (cmd *ListApps) Execute(c flags.FlagContext) error {
 		return nil
 	}
 
-	table := cmd.ui.Table([]string{
+	headers := []string{
 		T("name"),
 		T("requested state"),
 		T("instances"),
 		T("memory"),
 		T("disk"),
 		T("urls"),
-	})
+	}
+	if allSpaces {
+		headers = append([]string{T("space")}, headers...)
+	}
+	table := cmd.ui.Table(headers)
 
-	for _, application := range apps {
-		var urls []string
-		for _, route := range application.Routes {
-			urls = append(urls, route.URL())
-		}
+	for i, space := range spacesFound {
+		for _, application := range spaceApps[i] {
+			var urls []string
+			for _, route := range application.Routes {
+				urls = append(urls, route.URL())
+			}
 
-		table.Add(
-			application.Name,
-			uihelpers.ColoredAppState(application.ApplicationFields),
-			uihelpers.ColoredAppInstances(application.ApplicationFields),
-			formatters.ByteSize(application.Memory*formatters.MEGABYTE),
-			formatters.ByteSize(application.DiskQuota*formatters.MEGABYTE),
-			strings.Join(urls, ", "),
-		)
+			row := []string{
+				application.Name,
+				uihelpers.ColoredAppState(application.ApplicationFields),
+				uihelpers.ColoredAppInstances(application.ApplicationFields),
+				formatters.ByteSize(application.Memory * formatters.MEGABYTE),
+				formatters.ByteSize(application.DiskQuota * formatters.MEGABYTE),
+				strings.Join(urls, ", "),
+			}
+			if allSpaces {
+				row = append([]string{space.Name}, row...)
+			}
+			table.Add(row...)
+		}
 	}
 
 	err = table.Print()
 	if err != nil {
 		return err
 	}
+
+	err = cmd.printTotals(spacesFound, spaceApps)
+	if err != nil {
+		return err
+	}
+
 	if cmd.pluginCall {
 		cmd.populatePluginModel(apps)
 	}
 	return nil
 }
 
+// spacesToList returns the targeted space, or every space in the targeted org
+// when allSpaces is set.
+func (cmd *ListApps) spacesToList(allSpaces bool) ([]models.Space, error) {
+	if !allSpaces {
+		space, err := cmd.spaceRepo.FindByNameInOrg(cmd.config.SpaceFields().Name, cmd.config.OrganizationFields().GUID)
+		if err != nil {
+			return nil, err
+		}
+		return []models.Space{space}, nil
+	}
+
+	var spacesInOrg []models.Space
+	err := cmd.spaceRepo.ListSpacesFromOrg(cmd.config.OrganizationFields().GUID, func(space models.Space) bool {
+		spacesInOrg = append(spacesInOrg, space)
+		return true
+	})
+	if err != nil {
+		return nil, errors.New(T("Failed fetching spaces.\n{{.ErrorDescription}}",
+			map[string]interface{}{
+				"ErrorDescription": err.Error(),
+			}))
+	}
+	return spacesInOrg, nil
+}
+
+// printTotals prints, for each space, the memory and disk allocated to its
+// started apps and the space quota's memory limit.
+func (cmd *ListApps) printTotals(spacesFound []models.Space, spaceApps [][]models.Application) error {
+	quotas := map[string]models.SpaceQuota{}
+	for _, space := range spacesFound {
+		if space.SpaceQuotaGUID == "" {
+			continue
+		}
+
+		orgQuotas, err := cmd.spaceQuotaRepo.FindByOrg(cmd.config.OrganizationFields().GUID)
+		if err != nil {
+			return err
+		}
+		for _, quota := range orgQuotas {
+			quotas[quota.GUID] = quota
+		}
+		break
+	}
+
+	cmd.ui.Say("")
+	for i, space := range spacesFound {
+		var memory, disk int64
+		for _, application := range spaceApps[i] {
+			if application.State != models.ApplicationStateStarted {
+				continue
+			}
+			memory += application.Memory * int64(application.InstanceCount)
+			disk += application.DiskQuota * int64(application.InstanceCount)
+		}
+
+		quotaDescription := T("no space quota")
+		if quota, ok := quotas[space.SpaceQuotaGUID]; ok {
+			limit := T(models.UnlimitedDisplay)
+			if quota.MemoryLimit != -1 {
+				limit = quota.FormattedMemoryLimit()
+			}
+			quotaDescription = T("space quota {{.QuotaName}}: {{.MemoryLimit}}", map[string]interface{}{
+				"QuotaName":   quota.Name,
+				"MemoryLimit": limit,
+			})
+		}
+
+		cmd.ui.Say(T("Total for space {{.SpaceName}}: {{.AppCount}} apps, {{.Memory}} memory allocated ({{.Quota}}), {{.Disk}} disk allocated",
+			map[string]interface{}{
+				"SpaceName": terminal.EntityNameColor(space.Name),
+				"AppCount":  len(spaceApps[i]),
+				"Memory":    formatters.ByteSize(memory * formatters.MEGABYTE),
+				"Quota":     quotaDescription,
+				"Disk":      formatters.ByteSize(disk * formatters.MEGABYTE),
+			}))
+	}
+	return nil
+}
+
+func validAppSortOrder(order string) bool {
+	for _, valid := range appSortOrders {
+		if order == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// sortApps sorts apps by order, using the app name to break ties. Memory and
+// instances sort largest first. Apps are left in the order returned by the
+// API when no order is given.
+func sortApps(apps []models.Application, order string) {
+	var less func(a, b models.Application) bool
+	switch order {
+	case "memory":
+		less = func(a, b models.Application) bool {
+			return a.Memory*int64(a.InstanceCount) > b.Memory*int64(b.InstanceCount)
+		}
+	case "instances":
+		less = func(a, b models.Application) bool {
+			return a.InstanceCount > b.InstanceCount
+		}
+	case "state":
+		less = func(a, b models.Application) bool {
+			return a.State < b.State
+		}
+	case "name":
+		less = func(a, b models.Application) bool {
+			return false
+		}
+	default:
+		return
+	}
+
+	sort.Stable(appsByOrder{apps: apps, less: less})
+}
+
+type appsByOrder struct {
+	apps []models.Application
+	less func(a, b models.Application) bool
+}
+
+func (a appsByOrder) Len() int      { return len(a.apps) }
+func (a appsByOrder) Swap(i, j int) { a.apps[i], a.apps[j] = a.apps[j], a.apps[i] }
+func (a appsByOrder) Less(i, j int) bool {
+	if a.less(a.apps[i], a.apps[j]) {
+		return true
+	}
+	if a.less(a.apps[j], a.apps[i]) {
+		return false
+	}
+	return strings.ToLower(a.apps[i].Name) < strings.ToLower(a.apps[j].Name)
+}
+
 func (cmd *ListApps) populatePluginModel(apps []models.Application) {
 	for _, app := range apps {
 		appModel := plugin_models.GetAppsModel{}
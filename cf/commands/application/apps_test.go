@@ -1,7 +1,11 @@
 package application_test
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/spacequotas/spacequotasfakes"
+	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -27,6 +31,8 @@ var _ = Describe("list-apps command", func() {
 		ui                  *testterm.FakeUI
 		configRepo          coreconfig.Repository
 		appSummaryRepo      *apifakes.OldFakeAppSummaryRepo
+		spaceRepo           *spacesfakes.FakeSpaceRepository
+		spaceQuotaRepo      *spacequotasfakes.FakeSpaceQuotaRepository
 		requirementsFactory *requirementsfakes.FakeFactory
 		deps                commandregistry.Dependency
 	)
@@ -35,17 +41,27 @@ var _ = Describe("list-apps command", func() {
 		deps.UI = ui
 		deps.Config = configRepo
 		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSpaceRepository(spaceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSpaceQuotaRepository(spaceQuotaRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("apps").SetDependency(deps, pluginCall))
 	}
 
 	BeforeEach(func() {
 		ui = &testterm.FakeUI{}
 		appSummaryRepo = new(apifakes.OldFakeAppSummaryRepo)
+		spaceRepo = new(spacesfakes.FakeSpaceRepository)
+		spaceQuotaRepo = new(spacequotasfakes.FakeSpaceQuotaRepository)
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 
 		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
 		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedOrgRequirementReturns(new(requirementsfakes.FakeTargetedOrgRequirement))
+
+		mySpace := models.Space{}
+		mySpace.Name = "my-space"
+		mySpace.GUID = "my-space-guid"
+		spaceRepo.FindByNameInOrgReturns(mySpace, nil)
 
 		app1Routes := []models.RouteSummary{
 			{
@@ -90,7 +106,7 @@ var _ = Describe("list-apps command", func() {
 		app2.DiskQuota = 1024
 		app2.Routes = app2Routes
 
-		appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{app, app2}
+		appSummaryRepo.GetSummariesInSpaceApps = map[string][]models.Application{"my-space-guid": {app, app2}}
 
 		deps = commandregistry.NewDependency(os.Stdout, new(tracefakes.FakePrinter), "")
 	})
@@ -141,6 +157,32 @@ var _ = Describe("list-apps command", func() {
 			Expect(err.Error()).To(ContainSubstring("No argument required"))
 		})
 
+		It("should fail with usage when the sort order is not valid", func() {
+			flagContext.Parse("--sort", "size")
+
+			reqs, err := cmd.Requirements(requirementsFactory, flagContext)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = testcmd.RunRequirements(reqs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Incorrect Usage"))
+			Expect(err.Error()).To(ContainSubstring("--sort must be one of: memory, instances, name, state"))
+		})
+
+		Context("when --all-spaces is provided", func() {
+			BeforeEach(func() {
+				flagContext.Parse("--all-spaces")
+			})
+
+			It("requires an org to be targeted instead of a space", func() {
+				_, err := cmd.Requirements(requirementsFactory, flagContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(requirementsFactory.NewTargetedOrgRequirementCallCount()).To(Equal(1))
+				Expect(requirementsFactory.NewTargetedSpaceRequirementCallCount()).To(BeZero())
+			})
+		})
+
 		It("succeeds with all", func() {
 			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
 			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
@@ -196,6 +238,107 @@ var _ = Describe("list-apps command", func() {
 			))
 		})
 
+		It("displays the memory and disk allocated to started apps in the space", func() {
+			runCommand()
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Total for space my-space: 2 apps, 1G memory allocated (no space quota), 3G disk allocated"},
+			))
+		})
+
+		Context("when the space has a space quota", func() {
+			BeforeEach(func() {
+				mySpace := models.Space{}
+				mySpace.Name = "my-space"
+				mySpace.GUID = "my-space-guid"
+				mySpace.SpaceQuotaGUID = "my-quota-guid"
+				spaceRepo.FindByNameInOrgReturns(mySpace, nil)
+
+				spaceQuotaRepo.FindByOrgReturns([]models.SpaceQuota{
+					{GUID: "other-quota-guid", Name: "other-quota", MemoryLimit: 1024},
+					{GUID: "my-quota-guid", Name: "my-quota", MemoryLimit: 2048},
+				}, nil)
+			})
+
+			It("displays the memory allocated against the quota", func() {
+				runCommand()
+
+				Expect(spaceQuotaRepo.FindByOrgArgsForCall(0)).To(Equal("my-org-guid"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Total for space my-space: 2 apps, 1G memory allocated (space quota my-quota: 2G), 3G disk allocated"},
+				))
+			})
+		})
+
+		Context("when --sort is provided", func() {
+			indexOf := func(substring string) int {
+				for i, line := range ui.Outputs() {
+					if strings.Contains(line, substring) {
+						return i
+					}
+				}
+				return -1
+			}
+
+			It("sorts the apps by instances, largest first", func() {
+				runCommand("--sort", "instances")
+
+				Expect(indexOf("Application-2")).To(BeNumerically("<", indexOf("Application-1")))
+			})
+
+			It("sorts the apps by name when memory is equal", func() {
+				runCommand("--sort", "memory")
+
+				Expect(indexOf("Application-1")).To(BeNumerically("<", indexOf("Application-2")))
+			})
+		})
+
+		Context("when --all-spaces is provided", func() {
+			BeforeEach(func() {
+				otherSpace := models.Space{}
+				otherSpace.Name = "other-space"
+				otherSpace.GUID = "other-space-guid"
+				mySpace := models.Space{}
+				mySpace.Name = "my-space"
+				mySpace.GUID = "my-space-guid"
+				emptySpace := models.Space{}
+				emptySpace.Name = "empty-space"
+				emptySpace.GUID = "empty-space-guid"
+
+				spaceRepo.ListSpacesFromOrgStub = func(orgGUID string, callback func(models.Space) bool) error {
+					Expect(orgGUID).To(Equal("my-org-guid"))
+					callback(mySpace)
+					callback(emptySpace)
+					callback(otherSpace)
+					return nil
+				}
+
+				otherApp := models.Application{}
+				otherApp.Name = "other-app"
+				otherApp.State = "stopped"
+				otherApp.InstanceCount = 1
+				otherApp.Memory = 128
+				otherApp.DiskQuota = 256
+				appSummaryRepo.GetSummariesInSpaceApps["other-space-guid"] = []models.Application{otherApp}
+			})
+
+			It("lists the apps in every space of the org", func() {
+				runCommand("--all-spaces")
+
+				Expect(appSummaryRepo.GetSummariesInSpaceSpaceGUIDs).To(Equal([]string{"my-space-guid", "empty-space-guid", "other-space-guid"}))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting apps in org", "my-org", "my-user"},
+					[]string{"space", "name", "requested state", "instances", "memory", "disk", "urls"},
+					[]string{"my-space", "Application-1", "started", "1/1", "512M", "1G"},
+					[]string{"my-space", "Application-2", "started", "1/2", "256M", "1G"},
+					[]string{"other-space", "other-app", "stopped", "0/1", "128M", "256M"},
+					[]string{"Total for space my-space: 2 apps, 1G memory allocated"},
+					[]string{"Total for space other-space: 1 apps, 0 memory allocated"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"empty-space"}))
+			})
+		})
+
 		Context("when an app's running instances is unknown", func() {
 			It("dipslays a '?' for running instances", func() {
 				appRoutes := []models.RouteSummary{
@@ -213,7 +356,7 @@ var _ = Describe("list-apps command", func() {
 				app.DiskQuota = 1024
 				app.Routes = appRoutes
 
-				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{app}
+				appSummaryRepo.GetSummariesInSpaceApps = map[string][]models.Application{"my-space-guid": {app}}
 
 				runCommand()
 
@@ -227,7 +370,7 @@ var _ = Describe("list-apps command", func() {
 
 		Context("when there are no apps", func() {
 			It("tells the user that there are no apps", func() {
-				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{}
+				appSummaryRepo.GetSummariesInSpaceApps = map[string][]models.Application{"my-space-guid": {}}
 
 				runCommand()
 				Expect(ui.Outputs()).To(ContainSubstrings(
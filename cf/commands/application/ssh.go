@@ -19,6 +19,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type SSH struct {
@@ -50,19 +51,25 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs["request-pseudo-tty"] = &flags.BoolFlag{Name: "request-pseudo-tty", ShortName: "t", Usage: T("Request pseudo-tty allocation")}
 	fs["force-pseudo-tty"] = &flags.BoolFlag{Name: "force-pseudo-tty", ShortName: "tt", Usage: T("Force pseudo-tty allocation")}
 	fs["disable-pseudo-tty"] = &flags.BoolFlag{Name: "disable-pseudo-tty", ShortName: "T", Usage: T("Disable pseudo-tty allocation")}
+	fs["identity-file"] = &flags.StringFlag{Name: "identity-file", Usage: T("Authenticate with the private key in this file, registered with the platform, instead of a one-time passcode")}
+	fs["record"] = &flags.StringFlag{Name: "record", Usage: T("Record the terminal session to this file in asciicast format, with timing, for replay with asciinema")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty] [--identity-file path] [--record file]"),
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *SSH) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 1 {
+	args := fc.Args()
+	if len(args) == 0 {
+		args = projectAppName()
+	}
+	if len(args) != 1 {
 		cmd.ui.Failed(T("Incorrect Usage. Requires APP_NAME as argument") + "\n\n" + commandregistry.Commands.CommandUsage("ssh"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
@@ -74,6 +81,7 @@ func (cmd *SSH) Requirements(requirementsFactory requirements.Factory, fc flags.
 		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", err.Error(), commandregistry.Commands.CommandUsage("ssh")))
 		return nil, err
 	}
+	cmd.opts.AppName = args[0]
 
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.opts.AppName)
 
@@ -86,6 +94,21 @@ func (cmd *SSH) Requirements(requirementsFactory requirements.Factory, fc flags.
 	return reqs, nil
 }
 
+// projectAppName returns the app named in the working directory's project
+// file, if any, as the command's arguments.
+func projectAppName() []string {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	project, found, err := configv3.LoadProject(pwd)
+	if err != nil || !found || project.AppName == "" {
+		return nil
+	}
+	return []string{project.AppName}
+}
+
 func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
@@ -120,9 +143,12 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error getting SSH info:") + err.Error())
 	}
 
-	sshAuthCode, err := cmd.sshCodeGetter.Get()
-	if err != nil {
-		return errors.New(T("Error getting one time auth code: ") + err.Error())
+	var sshAuthCode string
+	if cmd.opts.IdentityFile == "" {
+		sshAuthCode, err = cmd.sshCodeGetter.Get()
+		if err != nil {
+			return errors.New(T("Error getting one time auth code: ") + err.Error())
+		}
 	}
 
 	//init secureShell if it is not already set by SetDependency() with fakes
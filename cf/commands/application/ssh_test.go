@@ -2,8 +2,11 @@ package application_test
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
@@ -89,6 +92,34 @@ var _ = Describe("SSH command", func() {
 
 		})
 
+		Context("when no app is provided and the working directory has a project file", func() {
+			var originalDir, projectDir string
+
+			BeforeEach(func() {
+				var err error
+				originalDir, err = os.Getwd()
+				Expect(err).NotTo(HaveOccurred())
+				projectDir, err = ioutil.TempDir("", "ssh-project")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ioutil.WriteFile(filepath.Join(projectDir, "cfproject.yml"), []byte("app: project-app\n"), 0644)).To(Succeed())
+				Expect(os.Chdir(projectDir)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(originalDir)).To(Succeed())
+				Expect(os.RemoveAll(projectDir)).To(Succeed())
+			})
+
+			It("uses the project's app", func() {
+				requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+				requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeting space"})
+
+				Expect(runCommand()).To(BeFalse())
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Incorrect Usage"}))
+				Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("project-app"))
+			})
+		})
+
 		It("fails requirements when not logged in", func() {
 			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
 			Expect(runCommand("my-app")).To(BeFalse())
@@ -292,6 +323,16 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("when --identity-file is provided", func() {
+				It("connects with the key without getting a one time auth code", func() {
+					runCommand("my-app", "--identity-file", "some-identity-file")
+
+					Expect(sshCodeGetter.GetCallCount()).To(Equal(0))
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.ConnectArgsForCall(0).IdentityFile).To(Equal("some-identity-file"))
+				})
+			})
+
 			Context("Error port forwarding when -L is provided", func() {
 				It("notifies users", func() {
 					fakeSecureShell.LocalPortForwardReturns(errors.New("listen error"))
@@ -10,6 +10,8 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
+	"code.cloudfoundry.org/cli/util/dnscheck"
 )
 
 type CreateDomain struct {
@@ -17,6 +19,7 @@ type CreateDomain struct {
 	config     coreconfig.Reader
 	domainRepo api.DomainRepository
 	orgReq     requirements.OrganizationRequirement
+	dnsChecker dnscheck.DNSChecker
 }
 
 func init() {
@@ -24,12 +27,16 @@ func init() {
 }
 
 func (cmd *CreateDomain) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["verify-dns"] = &flags.BoolFlag{Name: "verify-dns", Usage: T("Check that DNS resolves host names on the domain to the routers of the foundation")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "create-domain",
 		Description: T("Create a domain in an org for later use"),
 		Usage: []string{
-			T("CF_NAME create-domain ORG DOMAIN"),
+			T("CF_NAME create-domain ORG DOMAIN [--verify-dns]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -53,6 +60,12 @@ func (cmd *CreateDomain) SetDependency(deps commandregistry.Dependency, pluginCa
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
+
+	if deps.WildcardDependency != nil {
+		cmd.dnsChecker = deps.WildcardDependency.(dnscheck.DNSChecker)
+	} else {
+		cmd.dnsChecker = dnscheck.NewChecker()
+	}
 	return cmd
 }
 
@@ -72,5 +85,11 @@ func (cmd *CreateDomain) Execute(c flags.FlagContext) error {
 	}
 
 	cmd.ui.Ok()
+
+	if c.Bool("verify-dns") {
+		result := cmd.dnsChecker.CheckDomain(domainName, dnscheck.RouterExpectation(cmd.config))
+		uihelpers.ReportDNSCheck(cmd.ui, result)
+	}
+
 	return nil
 }
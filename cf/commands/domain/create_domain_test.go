@@ -10,6 +10,8 @@ import (
 	testcmd "code.cloudfoundry.org/cli/cf/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/cf/util/testhelpers/terminal"
+	"code.cloudfoundry.org/cli/util/dnscheck"
+	"code.cloudfoundry.org/cli/util/dnscheck/dnscheckfakes"
 
 	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
@@ -24,12 +26,14 @@ var _ = Describe("create domain command", func() {
 		domainRepo          *apifakes.FakeDomainRepository
 		configRepo          coreconfig.Repository
 		deps                commandregistry.Dependency
+		dnsChecker          *dnscheckfakes.FakeDNSChecker
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetDomainRepository(domainRepo)
 		deps.Config = configRepo
+		deps.WildcardDependency = dnsChecker
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("create-domain").SetDependency(deps, pluginCall))
 	}
 
@@ -37,6 +41,7 @@ var _ = Describe("create domain command", func() {
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
 		domainRepo = new(apifakes.FakeDomainRepository)
+		dnsChecker = new(dnscheckfakes.FakeDNSChecker)
 		configRepo = testconfig.NewRepositoryWithAccessToken(coreconfig.TokenInfo{Username: "my-user"})
 	})
 
@@ -95,4 +100,53 @@ var _ = Describe("create domain command", func() {
 			[]string{"OK"},
 		))
 	})
+
+	Context("when --verify-dns is passed", func() {
+		BeforeEach(func() {
+			fakeOrgRequirement := new(requirementsfakes.FakeOrganizationRequirement)
+			fakeOrgRequirement.GetOrganizationReturns(models.Organization{
+				OrganizationFields: models.OrganizationFields{Name: "my-org", GUID: "my-org-guid"},
+			})
+			requirementsFactory.NewOrganizationRequirementReturns(fakeOrgRequirement)
+		})
+
+		It("checks that host names on the domain resolve after creating it", func() {
+			dnsChecker.CheckDomainReturns(dnscheck.Result{
+				Host:      "cf-dns-check.example.com",
+				Addresses: []string{"10.0.0.1"},
+			})
+
+			Expect(runCommand("my-org", "example.com", "--verify-dns")).To(BeTrue())
+
+			Expect(domainRepo.CreateCallCount()).To(Equal(1))
+			Expect(dnsChecker.CheckDomainCallCount()).To(Equal(1))
+			domain, expected := dnsChecker.CheckDomainArgsForCall(0)
+			Expect(domain).To(Equal("example.com"))
+			Expect(expected).To(Equal(dnscheck.Expectation{}))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"OK"},
+				[]string{"DNS check passed", "cf-dns-check.example.com", "10.0.0.1"},
+			))
+		})
+
+		It("warns with the DNS record to create when the check fails", func() {
+			dnsChecker.CheckDomainReturns(dnscheck.Result{
+				Host:        "cf-dns-check.example.com",
+				Problem:     "cf-dns-check.example.com does not resolve (NXDOMAIN)",
+				Remediation: "Create a DNS record for *.example.com pointing to the routers of the foundation.",
+			})
+
+			Expect(runCommand("my-org", "example.com", "--verify-dns")).To(BeTrue())
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"DNS check failed: cf-dns-check.example.com does not resolve (NXDOMAIN)"},
+				[]string{"Create a DNS record for *.example.com pointing to the routers of the foundation."},
+			))
+		})
+
+		It("does not check DNS without the flag", func() {
+			Expect(runCommand("my-org", "example.com")).To(BeTrue())
+			Expect(dnsChecker.CheckDomainCallCount()).To(Equal(0))
+		})
+	})
 })
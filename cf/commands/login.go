@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -135,13 +136,13 @@ func (cmd *Login) Execute(c flags.FlagContext) error {
 		}
 	}
 
-	orgIsSet, err := cmd.setOrganization(c)
+	orgIsSet, orgSpaces, err := cmd.setOrganization(c)
 	if err != nil {
 		return err
 	}
 
 	if orgIsSet {
-		err = cmd.setSpace(c)
+		err = cmd.setSpace(c, orgSpaces)
 		if err != nil {
 			return err
 		}
@@ -277,39 +278,91 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 	return nil
 }
 
-func (cmd Login) setOrganization(c flags.FlagContext) (bool, error) {
+// setOrganization targets the org given by flag or config, or lets the user
+// pick one. When the user only has a single org, its spaces are returned as
+// well so that setSpace does not have to list them again.
+func (cmd Login) setOrganization(c flags.FlagContext) (bool, []models.Space, error) {
 	orgName := c.String("o")
+	if orgName == "" {
+		orgName = cmd.config.DefaultOrganization()
+	}
 
 	if orgName == "" {
-		orgs, err := cmd.orgRepo.ListOrgs(maxChoices)
+		prefetchSpaces := c.String("s") == "" && cmd.config.DefaultSpace() == ""
+		orgs, spaces, err := cmd.listOrgsAndSpaces(prefetchSpaces)
 		if err != nil {
-			return false, errors.New(T("Error finding available orgs\n{{.APIErr}}",
+			return false, nil, errors.New(T("Error finding available orgs\n{{.APIErr}}",
 				map[string]interface{}{"APIErr": err.Error()}))
 		}
 
 		switch len(orgs) {
 		case 0:
-			return false, nil
+			return false, nil, nil
 		case 1:
 			cmd.targetOrganization(orgs[0])
-			return true, nil
+			return true, spacesInOrg(spaces, orgs[0].GUID), nil
 		default:
 			orgName = cmd.promptForOrgName(orgs)
 			if orgName == "" {
 				cmd.ui.Say("")
-				return false, nil
+				return false, nil, nil
 			}
 		}
 	}
 
 	org, err := cmd.orgRepo.FindByName(orgName)
 	if err != nil {
-		return false, errors.New(T("Error finding org {{.OrgName}}\n{{.Err}}",
+		return false, nil, errors.New(T("Error finding org {{.OrgName}}\n{{.Err}}",
 			map[string]interface{}{"OrgName": terminal.EntityNameColor(orgName), "Err": err.Error()}))
 	}
 
 	cmd.targetOrganization(org)
-	return true, nil
+	return true, nil, nil
+}
+
+// listOrgsAndSpaces lists the user's orgs. When prefetchSpaces is set, the
+// spaces visible to the user are listed at the same time, with their org
+// inlined, so a single org can be targeted along with its spaces without
+// waiting on another round trip. Failing to list the spaces is not fatal;
+// they are listed again once the org has been targeted.
+func (cmd Login) listOrgsAndSpaces(prefetchSpaces bool) ([]models.Organization, []models.Space, error) {
+	var (
+		spaces   []models.Space
+		spaceErr error
+		wg       sync.WaitGroup
+	)
+
+	if prefetchSpaces {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			spaceErr = cmd.spaceRepo.ListAllSpaces(func(space models.Space) bool {
+				spaces = append(spaces, space)
+				return len(spaces) < maxChoices
+			})
+		}()
+	}
+
+	orgs, err := cmd.orgRepo.ListOrgs(maxChoices)
+	wg.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if spaceErr != nil {
+		return orgs, nil, nil
+	}
+	return orgs, spaces, nil
+}
+
+func spacesInOrg(spaces []models.Space, orgGUID string) []models.Space {
+	var orgSpaces []models.Space
+	for _, space := range spaces {
+		if space.Organization.GUID == orgGUID {
+			orgSpaces = append(orgSpaces, space)
+		}
+	}
+	return orgSpaces
 }
 
 func (cmd Login) promptForOrgName(orgs []models.Organization) string {
@@ -327,18 +380,25 @@ func (cmd Login) targetOrganization(org models.Organization) {
 		map[string]interface{}{"OrgName": terminal.EntityNameColor(org.Name)}))
 }
 
-func (cmd Login) setSpace(c flags.FlagContext) error {
+// setSpace targets the space given by flag or config, or lets the user pick
+// one from availableSpaces. The spaces in the targeted org are listed when
+// availableSpaces is empty.
+func (cmd Login) setSpace(c flags.FlagContext, availableSpaces []models.Space) error {
 	spaceName := c.String("s")
+	if spaceName == "" {
+		spaceName = cmd.config.DefaultSpace()
+	}
 
 	if spaceName == "" {
-		var availableSpaces []models.Space
-		err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
-			availableSpaces = append(availableSpaces, space)
-			return (len(availableSpaces) < maxChoices)
-		})
-		if err != nil {
-			return errors.New(T("Error finding available spaces\n{{.Err}}",
-				map[string]interface{}{"Err": err.Error()}))
+		if len(availableSpaces) == 0 {
+			err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
+				availableSpaces = append(availableSpaces, space)
+				return (len(availableSpaces) < maxChoices)
+			})
+			if err != nil {
+				return errors.New(T("Error finding available spaces\n{{.Err}}",
+					map[string]interface{}{"Err": err.Error()}))
+			}
 		}
 
 		if len(availableSpaces) == 0 {
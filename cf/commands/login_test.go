@@ -1,6 +1,9 @@
 package commands_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
@@ -239,6 +242,52 @@ var _ = Describe("Login Command", func() {
 				Expect(ui.ShowConfigurationCalled).To(BeTrue())
 			})
 
+			Describe("when a default org and space are configured", func() {
+				var configDir string
+
+				BeforeEach(func() {
+					var err error
+					configDir, err = ioutil.TempDir("", "login-default-target")
+					Expect(err).NotTo(HaveOccurred())
+
+					configPath := filepath.Join(configDir, "config.json")
+					err = ioutil.WriteFile(configPath, []byte(`{"ConfigVersion": 3, "DefaultOrganization": "my-new-org", "DefaultSpace": "some-space"}`), 0600)
+					Expect(err).NotTo(HaveOccurred())
+
+					Config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+						panic(err)
+					})
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(configDir)).To(Succeed())
+				})
+
+				It("targets them without prompting", func() {
+					Flags = []string{"-a", "api.example.com", "-u", "user@example.com", "-p", "password"}
+
+					orgRepo.FindByNameReturns(org2, nil)
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Select an org"}))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Select a space"}))
+
+					Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("my-new-org"))
+					Expect(spaceRepo.FindByNameArgsForCall(0)).To(Equal("some-space"))
+					Expect(Config.OrganizationFields().GUID).To(Equal("my-new-org-guid"))
+					Expect(Config.SpaceFields().GUID).To(Equal("some-space-guid"))
+				})
+
+				It("prefers the org and space flags", func() {
+					Flags = []string{"-a", "api.example.com", "-u", "user@example.com", "-p", "password", "-o", "some-org", "-s", "my-space"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("some-org"))
+					Expect(spaceRepo.FindByNameArgsForCall(0)).To(Equal("my-space"))
+				})
+			})
+
 			It("doesn't ask the user for the API url if they have it in their config", func() {
 				orgRepo.FindByNameReturns(org, nil)
 				Config.SetAPIEndpoint("http://api.example.com")
@@ -331,6 +380,61 @@ var _ = Describe("Login Command", func() {
 			})
 		})
 
+		Describe("when there is only a single org and its spaces were listed alongside the orgs", func() {
+			BeforeEach(func() {
+				space := models.Space{}
+				space.GUID = "my-prefetched-space-guid"
+				space.Name = "my-prefetched-space"
+				space.Organization = org.OrganizationFields
+
+				otherSpace := models.Space{}
+				otherSpace.GUID = "other-org-space-guid"
+				otherSpace.Name = "other-org-space"
+				otherSpace.Organization.GUID = "other-org-guid"
+
+				spaceRepo.ListAllSpacesStub = listSpacesStub([]models.Space{space, otherSpace})
+			})
+
+			It("targets the space without listing the org's spaces again", func() {
+				ui.Inputs = []string{"http://api.example.com", "user@example.com", "password"}
+
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+				Expect(Config.OrganizationFields().GUID).To(Equal("my-new-org-guid"))
+				Expect(Config.SpaceFields().GUID).To(Equal("my-prefetched-space-guid"))
+				Expect(spaceRepo.ListAllSpacesCallCount()).To(Equal(1))
+				Expect(spaceRepo.ListSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("when listing the spaces alongside the orgs fails", func() {
+			BeforeEach(func() {
+				spaceRepo.ListAllSpacesReturns(errors.New("list-all-spaces-err"))
+			})
+
+			It("lists the spaces in the targeted org instead", func() {
+				ui.Inputs = []string{"http://api.example.com", "user@example.com", "password"}
+
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+				Expect(Config.OrganizationFields().GUID).To(Equal("my-new-org-guid"))
+				Expect(Config.SpaceFields().GUID).To(Equal("my-space-guid"))
+				Expect(spaceRepo.ListSpacesCallCount()).To(Equal(1))
+			})
+		})
+
+		Describe("when a space is given on the command line", func() {
+			It("does not list the spaces alongside the orgs", func() {
+				Flags = []string{"-s", "my-space"}
+				ui.Inputs = []string{"http://api.example.com", "user@example.com", "password"}
+				spaceRepo.FindByNameReturns(models.Space{}, nil)
+
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+				Expect(spaceRepo.ListAllSpacesCallCount()).To(Equal(0))
+			})
+		})
+
 		Describe("where there are no available orgs", func() {
 			BeforeEach(func() {
 				orgRepo.ListOrgsReturns([]models.Organization{}, nil)
@@ -2,6 +2,7 @@ package organization
 
 import (
 	"fmt"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/api/organizations"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -10,6 +11,7 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type RenameOrg struct {
@@ -72,9 +74,42 @@ func (cmd *RenameOrg) Execute(c flags.FlagContext) error {
 	}
 	cmd.ui.Ok()
 
+	oldName := org.Name
 	if org.GUID == cmd.config.OrganizationFields().GUID {
 		org.Name = newName
 		cmd.config.SetOrganizationFields(org.OrganizationFields)
 	}
+
+	if cmd.config.DefaultOrganization() == oldName {
+		cmd.config.SetDefaultOrganization(newName)
+		cmd.ui.Say(T("Updated the default org to {{.NewName}}.",
+			map[string]interface{}{"NewName": terminal.EntityNameColor(newName)}))
+	}
+
+	cmd.renameLocalTarget(oldName, newName)
 	return nil
 }
+
+// renameLocalTarget rewrites the working directory's local target file when
+// it names the renamed org. The org has been renamed at this point, so
+// failures are only warned about.
+func (cmd *RenameOrg) renameLocalTarget(oldName string, newName string) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return
+	}
+
+	target, renamed, err := configv3.RenameLocalTargetOrganization(pwd, oldName, newName)
+	if err != nil {
+		cmd.ui.Warn(T("Unable to update local target file: {{.Error}}",
+			map[string]interface{}{"Error": err.Error()}))
+		return
+	}
+	if renamed {
+		cmd.ui.Say(T("Updated the org in {{.Path}} to {{.NewName}}.",
+			map[string]interface{}{
+				"Path":    target.Path,
+				"NewName": terminal.EntityNameColor(newName),
+			}))
+	}
+}
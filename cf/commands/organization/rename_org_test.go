@@ -1,6 +1,10 @@
 package organization_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -14,6 +18,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 var _ = Describe("rename-org command", func() {
@@ -90,6 +95,55 @@ var _ = Describe("rename-org command", func() {
 			Expect(configRepo.OrganizationFields().Name).To(Equal(targetedOrgName))
 		})
 
+		Describe("when the organization is the default org", func() {
+			It("updates the default org", func() {
+				configRepo.SetDefaultOrganization("the-old-org-name")
+				callRenameOrg([]string{"the-old-org-name", "the-new-org-name"})
+				Expect(configRepo.DefaultOrganization()).To(Equal("the-new-org-name"))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Updated the default org to", "the-new-org-name"}))
+			})
+		})
+
+		Describe("when the organization is named in the local target file", func() {
+			var (
+				workingDir string
+				projectDir string
+			)
+
+			BeforeEach(func() {
+				var err error
+				workingDir, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+				projectDir, err = ioutil.TempDir("", "rename-org")
+				Expect(err).ToNot(HaveOccurred())
+				projectDir, err = filepath.EvalSymlinks(projectDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				targetPath := filepath.Join(projectDir, configv3.LocalTargetFileName)
+				Expect(os.MkdirAll(filepath.Dir(targetPath), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(targetPath, []byte("org: the-old-org-name\nspace: my-space\n"), 0644)).To(Succeed())
+				Expect(os.Chdir(projectDir)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(workingDir)).To(Succeed())
+				Expect(os.RemoveAll(projectDir)).To(Succeed())
+			})
+
+			It("rewrites the local target file", func() {
+				callRenameOrg([]string{"the-old-org-name", "the-new-org-name"})
+
+				target, found, err := configv3.FindLocalTarget(projectDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(target).To(Equal(configv3.LocalTarget{
+					Path:         filepath.Join(projectDir, configv3.LocalTargetFileName),
+					Organization: "the-new-org-name",
+					Space:        "my-space",
+				}))
+			})
+		})
+
 		Describe("when the organization is currently targeted", func() {
 			It("updates the name of the org in the config", func() {
 				configRepo.SetOrganizationFields(models.OrganizationFields{
@@ -11,6 +11,8 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/uihelpers"
+	"code.cloudfoundry.org/cli/util/dnscheck"
 )
 
 type MapRoute struct {
@@ -20,6 +22,7 @@ type MapRoute struct {
 	appReq       requirements.ApplicationRequirement
 	domainReq    requirements.DomainRequirement
 	routeCreator Creator
+	dnsChecker   dnscheck.DNSChecker
 }
 
 func init() {
@@ -32,6 +35,7 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 	fs["path"] = &flags.StringFlag{Name: "path", Usage: T("Path for the HTTP route")}
 	fs["port"] = &flags.IntFlag{Name: "port", Usage: T("Port for the TCP route")}
 	fs["random-port"] = &flags.BoolFlag{Name: "random-port", Usage: T("Create a random port for the TCP route")}
+	fs["verify-dns"] = &flags.BoolFlag{Name: "verify-dns", Usage: T("Check that DNS resolves the route to the routers of the foundation")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "map-route",
@@ -42,12 +46,14 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 			fmt.Sprintf("%s ", T("APP_NAME")),
 			fmt.Sprintf("%s ", T("DOMAIN")),
 			fmt.Sprintf("[--hostname %s] ", T("HOSTNAME")),
-			fmt.Sprintf("[--path %s]\n\n", T("PATH")),
+			fmt.Sprintf("[--path %s] ", T("PATH")),
+			"[--verify-dns]\n\n",
 			fmt.Sprintf("   %s:\n", T("Map a TCP route")),
 			"      CF_NAME map-route ",
 			fmt.Sprintf("%s ", T("APP_NAME")),
 			fmt.Sprintf("%s ", T("DOMAIN")),
-			fmt.Sprintf("(--port %s | --random-port)", T("PORT")),
+			fmt.Sprintf("(--port %s | --random-port) ", T("PORT")),
+			"[--verify-dns]",
 		},
 		Examples: []string{
 			"CF_NAME map-route my-app example.com                              # example.com",
@@ -104,6 +110,12 @@ func (cmd *MapRoute) SetDependency(deps commandregistry.Dependency, pluginCall b
 	createRoute = createRoute.SetDependency(deps, false)
 	cmd.routeCreator = createRoute.(Creator)
 
+	if deps.WildcardDependency != nil {
+		cmd.dnsChecker = deps.WildcardDependency.(dnscheck.DNSChecker)
+	} else {
+		cmd.dnsChecker = dnscheck.NewChecker()
+	}
+
 	return cmd
 }
 
@@ -133,5 +145,20 @@ func (cmd *MapRoute) Execute(c flags.FlagContext) error {
 	}
 
 	cmd.ui.Ok()
+
+	if c.Bool("verify-dns") {
+		expected := dnscheck.RouterExpectation(cmd.config)
+		var result dnscheck.Result
+		switch route.Host {
+		case "":
+			result = cmd.dnsChecker.CheckHost(domain.Name, expected)
+		case "*":
+			result = cmd.dnsChecker.CheckDomain(domain.Name, expected)
+		default:
+			result = cmd.dnsChecker.CheckHost(route.Host+"."+domain.Name, expected)
+		}
+		uihelpers.ReportDNSCheck(cmd.ui, result)
+	}
+
 	return nil
 }
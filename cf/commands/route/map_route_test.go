@@ -16,6 +16,8 @@ import (
 
 	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/cf/util/testhelpers/terminal"
+	"code.cloudfoundry.org/cli/util/dnscheck"
+	"code.cloudfoundry.org/cli/util/dnscheck/dnscheckfakes"
 
 	"strings"
 
@@ -42,7 +44,8 @@ var _ = Describe("MapRoute", func() {
 		originalCreateRouteCmd commandregistry.Command
 		fakeCreateRouteCmd     commandregistry.Command
 
-		fakeDomain models.DomainFields
+		fakeDomain     models.DomainFields
+		fakeDNSChecker *dnscheckfakes.FakeDNSChecker
 	)
 
 	BeforeEach(func() {
@@ -51,10 +54,13 @@ var _ = Describe("MapRoute", func() {
 		routeRepo = new(apifakes.FakeRouteRepository)
 		repoLocator := deps.RepoLocator.SetRouteRepository(routeRepo)
 
+		fakeDNSChecker = new(dnscheckfakes.FakeDNSChecker)
+
 		deps = commandregistry.Dependency{
-			UI:          ui,
-			Config:      configRepo,
-			RepoLocator: repoLocator,
+			UI:                 ui,
+			Config:             configRepo,
+			RepoLocator:        repoLocator,
+			WildcardDependency: fakeDNSChecker,
 		}
 
 		originalCreateRouteCmd = commandregistry.Commands.FindCommand("create-route")
@@ -111,14 +117,15 @@ var _ = Describe("MapRoute", func() {
 			Expect(usage).To(ContainElement("   --path              Path for the HTTP route"))
 			Expect(usage).To(ContainElement("   --port              Port for the TCP route"))
 			Expect(usage).To(ContainElement("   --random-port       Create a random port for the TCP route"))
+			Expect(usage).To(ContainElement("   --verify-dns        Check that DNS resolves the route to the routers of the foundation"))
 		})
 
 		It("shows the usage", func() {
 			Expect(usage).To(ContainElement("   Map an HTTP route:"))
-			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]"))
+			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--verify-dns]"))
 
 			Expect(usage).To(ContainElement("   Map a TCP route:"))
-			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN (--port PORT | --random-port)"))
+			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN (--port PORT | --random-port) [--verify-dns]"))
 		})
 	})
 
@@ -375,6 +382,81 @@ var _ = Describe("MapRoute", func() {
 					Expect(err.Error()).To(Equal("bind-error"))
 				})
 			})
+
+			It("does not check DNS", func() {
+				Expect(fakeDNSChecker.CheckHostCallCount()).To(Equal(0))
+				Expect(fakeDNSChecker.CheckDomainCallCount()).To(Equal(0))
+			})
+
+			Context("when --verify-dns is passed", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--verify-dns")
+					Expect(err).NotTo(HaveOccurred())
+					cmd.Requirements(factory, flagContext)
+
+					fakeDNSChecker.CheckHostReturns(dnscheck.Result{
+						Host:      "fake-domain-name",
+						Addresses: []string{"10.0.0.1", "10.0.0.2"},
+					})
+				})
+
+				It("checks that the domain resolves to the routers", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeDNSChecker.CheckHostCallCount()).To(Equal(1))
+					host, expected := fakeDNSChecker.CheckHostArgsForCall(0)
+					Expect(host).To(Equal("fake-domain-name"))
+					Expect(expected).To(Equal(dnscheck.Expectation{}))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"OK"},
+						[]string{"DNS check passed", "fake-domain-name", "10.0.0.1, 10.0.0.2"},
+					))
+				})
+
+				Context("when the route has a hostname", func() {
+					BeforeEach(func() {
+						fakeRouteCreator := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
+						fakeRouteCreator.CreateRouteReturns(models.Route{GUID: "fake-route-guid", Host: "the-hostname"}, nil)
+					})
+
+					It("checks the host name of the route", func() {
+						host, _ := fakeDNSChecker.CheckHostArgsForCall(0)
+						Expect(host).To(Equal("the-hostname.fake-domain-name"))
+					})
+				})
+
+				Context("when the route is a wildcard route", func() {
+					BeforeEach(func() {
+						fakeRouteCreator := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
+						fakeRouteCreator.CreateRouteReturns(models.Route{GUID: "fake-route-guid", Host: "*"}, nil)
+						fakeDNSChecker.CheckDomainReturns(dnscheck.Result{Host: "cf-dns-check.fake-domain-name", Addresses: []string{"10.0.0.1"}})
+					})
+
+					It("checks the wildcard record of the domain", func() {
+						Expect(fakeDNSChecker.CheckDomainCallCount()).To(Equal(1))
+						domain, _ := fakeDNSChecker.CheckDomainArgsForCall(0)
+						Expect(domain).To(Equal("fake-domain-name"))
+					})
+				})
+
+				Context("when the check fails", func() {
+					BeforeEach(func() {
+						fakeDNSChecker.CheckHostReturns(dnscheck.Result{
+							Host:        "fake-domain-name",
+							Problem:     "fake-domain-name does not resolve (NXDOMAIN)",
+							Remediation: "Create a CNAME record for fake-domain-name pointing to router.example.com.",
+						})
+					})
+
+					It("warns with the remediation without failing", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"OK"},
+							[]string{"DNS check failed: fake-domain-name does not resolve (NXDOMAIN)"},
+							[]string{"Create a CNAME record for fake-domain-name pointing to router.example.com."},
+						))
+					})
+				})
+			})
 		})
 
 		Context("when a hostname is passed", func() {
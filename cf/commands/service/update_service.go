@@ -16,6 +16,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/uihelpers"
 	"code.cloudfoundry.org/cli/cf/util/json"
+	"code.cloudfoundry.org/cli/util/jsonschema"
 )
 
 type UpdateService struct {
@@ -128,6 +129,13 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 		}
 	}
 
+	if paramsMap != nil {
+		err = cmd.validateParams(serviceInstance, plan, paramsMap)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.printUpdatingServiceInstanceMessage(serviceInstanceName)
 
 	err = cmd.serviceRepo.UpdateServiceInstance(serviceInstance.GUID, plan.GUID, paramsMap, tags)
@@ -158,6 +166,44 @@ func (cmd *UpdateService) findPlan(serviceInstance models.ServiceInstance, planN
 	return
 }
 
+// validateParams checks the parameters against the update schema of the new
+// plan, or of the instance's current plan when the plan is not changing, so
+// that malformed parameters are rejected without a round trip to the broker.
+// A plan without a usable schema is left to the broker to validate.
+func (cmd *UpdateService) validateParams(serviceInstance models.ServiceInstance, plan models.ServicePlanFields, params map[string]interface{}) error {
+	if plan.GUID == "" {
+		plans, err := cmd.planBuilder.GetPlansForService(serviceInstance.ServiceOffering.GUID)
+		if err != nil {
+			return err
+		}
+		for _, p := range plans {
+			if p.GUID == serviceInstance.ServicePlan.GUID {
+				plan = p
+				break
+			}
+		}
+	}
+
+	if len(plan.UpdateParametersSchema) == 0 {
+		return nil
+	}
+	schema, err := jsonschema.Parse(plan.UpdateParametersSchema)
+	if err != nil {
+		return nil
+	}
+	validationErrors, err := schema.Validate(params)
+	if err != nil || len(validationErrors) == 0 {
+		return nil
+	}
+
+	lines := []string{}
+	for _, validationError := range validationErrors {
+		lines = append(lines, validationError.Error())
+	}
+	return errors.New(T("The parameters provided with -c do not match the schema of service plan {{.PlanName}}:",
+		map[string]interface{}{"PlanName": plan.Name}) + "\n   " + strings.Join(lines, "\n   "))
+}
+
 func (cmd *UpdateService) printUpdatingServiceInstanceMessage(serviceInstanceName string) {
 	cmd.ui.Say(T("Updating service instance {{.ServiceName}} as {{.UserName}}...",
 		map[string]interface{}{
@@ -163,6 +163,69 @@ var _ = Describe("update-service command", func() {
 			})
 		})
 
+		Context("when the plan publishes an update schema", func() {
+			BeforeEach(func() {
+				serviceInstance := models.ServiceInstance{
+					ServiceInstanceFields: models.ServiceInstanceFields{
+						Name: "my-service-instance",
+						GUID: "my-service-instance-guid",
+					},
+					ServicePlan: models.ServicePlanFields{
+						Name: "spark",
+						GUID: "murkydb-spark-guid",
+					},
+					ServiceOffering: models.ServiceOfferingFields{
+						Label: "murkydb",
+						GUID:  "murkydb-guid",
+					},
+				}
+				servicePlans := []models.ServicePlanFields{{
+					Name:                   "spark",
+					GUID:                   "murkydb-spark-guid",
+					UpdateParametersSchema: []byte(`{"properties": {"ram_gb": {"type": "integer", "maximum": 8}}}`),
+				}, {
+					Name:                   "flare",
+					GUID:                   "murkydb-flare-guid",
+					UpdateParametersSchema: []byte(`{"properties": {"ram_gb": {"type": "integer", "maximum": 16}}, "additionalProperties": false}`),
+				}}
+				serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+				planBuilder.GetPlansForServiceForOrgReturns(servicePlans, nil)
+				planBuilder.GetPlansForServiceReturns(servicePlans, nil)
+			})
+
+			It("validates the params against the schema of the new plan", func() {
+				callUpdateService([]string{"-p", "flare", "-c", `{"ram_gb": 12.5, "disk": 1}`, "my-service-instance"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The parameters provided with -c do not match the schema of service plan flare:"},
+					[]string{"/disk: is not allowed"},
+					[]string{"/ram_gb: must be of type integer, not number"},
+				))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			It("validates the params against the schema of the current plan when the plan is not changing", func() {
+				callUpdateService([]string{"-c", `{"ram_gb": 12}`, "my-service-instance"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The parameters provided with -c do not match the schema of service plan spark:"},
+					[]string{"/ram_gb: must be at most 8"},
+				))
+				Expect(planBuilder.GetPlansForServiceArgsForCall(0)).To(Equal("murkydb-guid"))
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			It("updates the service when the params match", func() {
+				callUpdateService([]string{"-p", "flare", "-c", `{"ram_gb": 12}`, "my-service-instance"})
+
+				Expect(serviceRepo.UpdateServiceInstanceCallCount()).To(Equal(1))
+				_, _, params, _ := serviceRepo.UpdateServiceInstanceArgsForCall(0)
+				Expect(params).To(Equal(map[string]interface{}{"ram_gb": float64(12)}))
+			})
+		})
+
 		Context("as a file that contains json", func() {
 			var jsonFile *os.File
 			var params string
@@ -2,6 +2,8 @@ package space
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -10,6 +12,8 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 type RenameSpace struct {
@@ -73,11 +77,66 @@ func (cmd *RenameSpace) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	oldName := space.Name
 	if cmd.config.SpaceFields().GUID == space.GUID {
 		space.Name = newName
 		cmd.config.SetSpaceFields(space.SpaceFields)
 	}
 
 	cmd.ui.Ok()
-	return err
+
+	orgName := cmd.config.OrganizationFields().Name
+	defaultOrg := cmd.config.DefaultOrganization()
+	if cmd.config.DefaultSpace() == oldName && (defaultOrg == "" || defaultOrg == orgName) {
+		cmd.config.SetDefaultSpace(newName)
+		cmd.ui.Say(T("Updated the default space to {{.NewName}}.",
+			map[string]interface{}{"NewName": terminal.EntityNameColor(newName)}))
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	cmd.renameLocalTarget(pwd, orgName, oldName, newName)
+	cmd.warnAboutManifestReferences(pwd, oldName)
+	return nil
+}
+
+// renameLocalTarget rewrites the working directory's local target file when
+// it names the renamed space. The space has been renamed at this point, so
+// failures are only warned about.
+func (cmd *RenameSpace) renameLocalTarget(pwd string, orgName string, oldName string, newName string) {
+	target, renamed, err := configv3.RenameLocalTargetSpace(pwd, orgName, oldName, newName)
+	if err != nil {
+		cmd.ui.Warn(T("Unable to update local target file: {{.Error}}",
+			map[string]interface{}{"Error": err.Error()}))
+		return
+	}
+	if renamed {
+		cmd.ui.Say(T("Updated the space in {{.Path}} to {{.NewName}}.",
+			map[string]interface{}{
+				"Path":    target.Path,
+				"NewName": terminal.EntityNameColor(newName),
+			}))
+	}
+}
+
+// warnAboutManifestReferences lists the manifest lines in the working
+// directory's repository that mention the old space name, since manifests
+// are not rewritten.
+func (cmd *RenameSpace) warnAboutManifestReferences(pwd string, oldName string) {
+	references, err := manifest.FindReferences(pwd, oldName)
+	if err != nil || len(references) == 0 {
+		return
+	}
+
+	cmd.ui.Warn(T("These manifests still reference the old space name {{.OldSpaceName}}:",
+		map[string]interface{}{"OldSpaceName": oldName}))
+	for _, reference := range references {
+		path := reference.Path
+		if relativePath, relErr := filepath.Rel(pwd, path); relErr == nil {
+			path = relativePath
+		}
+		cmd.ui.Warn("  %s:%d", path, reference.Line)
+	}
 }
@@ -2,6 +2,9 @@ package space_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -16,6 +19,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 var _ = Describe("rename-space command", func() {
@@ -74,8 +78,22 @@ var _ = Describe("rename-space command", func() {
 	})
 
 	Describe("when the user is logged in and has provided an old and new space name", func() {
-		var space models.Space
+		var (
+			space      models.Space
+			workingDir string
+			projectDir string
+		)
+
 		BeforeEach(func() {
+			var err error
+			workingDir, err = os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			projectDir, err = ioutil.TempDir("", "rename-space")
+			Expect(err).ToNot(HaveOccurred())
+			projectDir, err = filepath.EvalSymlinks(projectDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Chdir(projectDir)).To(Succeed())
+
 			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
 			requirementsFactory.NewTargetedOrgRequirementReturns(new(requirementsfakes.FakeTargetedOrgRequirement))
 			space = models.Space{}
@@ -101,6 +119,11 @@ var _ = Describe("rename-space command", func() {
 			Expect(configRepo.SpaceFields().Name).To(Equal(originalSpaceName))
 		})
 
+		AfterEach(func() {
+			Expect(os.Chdir(workingDir)).To(Succeed())
+			Expect(os.RemoveAll(projectDir)).To(Succeed())
+		})
+
 		Describe("renaming the space the user has targeted", func() {
 			BeforeEach(func() {
 				configRepo.SetSpaceFields(space.SpaceFields)
@@ -111,5 +134,63 @@ var _ = Describe("rename-space command", func() {
 				Expect(configRepo.SpaceFields().Name).To(Equal("my-new-space-name"))
 			})
 		})
+
+		Describe("renaming the default space", func() {
+			BeforeEach(func() {
+				configRepo.SetDefaultSpace("the-old-space-name")
+			})
+
+			It("updates the default space", func() {
+				callRenameSpace([]string{"the-old-space-name", "my-new-space-name"})
+				Expect(configRepo.DefaultSpace()).To(Equal("my-new-space-name"))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Updated the default space to", "my-new-space-name"}))
+			})
+
+			When("the default space belongs to another default org", func() {
+				BeforeEach(func() {
+					configRepo.SetDefaultOrganization("other-org")
+				})
+
+				It("leaves the default space alone", func() {
+					callRenameSpace([]string{"the-old-space-name", "my-new-space-name"})
+					Expect(configRepo.DefaultSpace()).To(Equal("the-old-space-name"))
+				})
+			})
+		})
+
+		Describe("renaming the space named in the local target file", func() {
+			var targetPath string
+
+			BeforeEach(func() {
+				targetPath = filepath.Join(projectDir, configv3.LocalTargetFileName)
+				Expect(os.MkdirAll(filepath.Dir(targetPath), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(targetPath, []byte("org: my-org\nspace: the-old-space-name\n"), 0644)).To(Succeed())
+			})
+
+			It("rewrites the local target file", func() {
+				callRenameSpace([]string{"the-old-space-name", "my-new-space-name"})
+
+				target, found, err := configv3.FindLocalTarget(projectDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(target.Space).To(Equal("my-new-space-name"))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Updated the space in", targetPath, "my-new-space-name"}))
+			})
+		})
+
+		Describe("when manifests reference the old space name", func() {
+			BeforeEach(func() {
+				manifest := "applications:\n- name: my-app\n  routes:\n  - route: my-app.the-old-space-name.example.com\n"
+				Expect(ioutil.WriteFile(filepath.Join(projectDir, "manifest.yml"), []byte(manifest), 0644)).To(Succeed())
+			})
+
+			It("warns about the references", func() {
+				callRenameSpace([]string{"the-old-space-name", "my-new-space-name"})
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"These manifests still reference the old space name the-old-space-name"},
+					[]string{"manifest.yml:4"},
+				))
+			})
+		})
 	})
 })
@@ -42,6 +42,22 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UsageStatsEnabled        bool     `json:",omitempty"`
+	UsageStatsEndpoint       string   `json:",omitempty"`
+	DefaultOrganization      string   `json:",omitempty"`
+	DefaultSpace             string   `json:",omitempty"`
+	PushScanHook             string   `json:",omitempty"`
+	PushScanSkipAllowed      bool     `json:",omitempty"`
+	InsecureForbidden        bool     `json:",omitempty"`
+	LogSource                string   `json:",omitempty"`
+	RouterCNAME              string   `json:",omitempty"`
+	RouterIPs                []string `json:",omitempty"`
+	ChangeHeader             string   `json:",omitempty"`
+	Accessible               bool     `json:",omitempty"`
+	UserAgentSuffix          string   `json:",omitempty"`
+	PluginPrecedence         []string `json:",omitempty"`
+	PluginTimeout            int      `json:",omitempty"`
+	PluginMaxOutput          uint64   `json:",omitempty"`
 }
 
 func NewData() *Data {
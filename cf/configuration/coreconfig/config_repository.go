@@ -1,11 +1,13 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -33,7 +35,17 @@ func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Reposi
 	if errorHandler == nil {
 		return nil
 	}
-	return NewRepositoryFromPersistor(configuration.NewDiskPersistor(filepath), errorHandler)
+
+	var persistor configuration.Persistor = configuration.NewDiskPersistor(filepath)
+	if localTarget := os.Getenv(LocalTargetEnvVar); localTarget != "" {
+		localTargetPersistor, err := NewLocalTargetPersistor(persistor, localTarget)
+		if err != nil {
+			errorHandler(err)
+		} else {
+			persistor = localTargetPersistor
+		}
+	}
+	return NewRepositoryFromPersistor(persistor, errorHandler)
 }
 
 func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler func(error)) Repository {
@@ -72,9 +84,11 @@ type Reader interface {
 
 	OrganizationFields() models.OrganizationFields
 	HasOrganization() bool
+	DefaultOrganization() string
 
 	SpaceFields() models.SpaceFields
 	HasSpace() bool
+	DefaultSpace() string
 
 	Username() string
 	UserGUID() string
@@ -95,6 +109,13 @@ type Reader interface {
 	Locale() string
 
 	PluginRepos() []models.PluginRepo
+
+	RouterCNAME() string
+	RouterIPs() []string
+
+	ChangeHeader() string
+
+	UserAgentSuffix() string
 }
 
 //go:generate counterfeiter . ReadWriter
@@ -109,6 +130,8 @@ type ReadWriter interface {
 	SetAuthenticationEndpoint(string)
 	SetCLIVersion(string)
 	SetColorEnabled(string)
+	SetDefaultOrganization(string)
+	SetDefaultSpace(string)
 	SetDopplerEndpoint(string)
 	SetLocale(string)
 	SetMinCLIVersion(string)
@@ -322,6 +345,20 @@ func (c *ConfigRepository) HasSpace() (hasSpace bool) {
 	return
 }
 
+func (c *ConfigRepository) DefaultOrganization() (name string) {
+	c.read(func() {
+		name = c.data.DefaultOrganization
+	})
+	return
+}
+
+func (c *ConfigRepository) DefaultSpace() (name string) {
+	c.read(func() {
+		name = c.data.DefaultSpace
+	})
+	return
+}
+
 func (c *ConfigRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	c.read(func() {
 		isSSLDisabled = c.data.SSLDisabled
@@ -427,6 +464,37 @@ func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 	return
 }
 
+func (c *ConfigRepository) RouterCNAME() (cname string) {
+	c.read(func() {
+		cname = c.data.RouterCNAME
+	})
+	return
+}
+
+func (c *ConfigRepository) RouterIPs() (ips []string) {
+	c.read(func() {
+		ips = c.data.RouterIPs
+	})
+	return
+}
+
+func (c *ConfigRepository) ChangeHeader() (header string) {
+	c.read(func() {
+		header = c.data.ChangeHeader
+	})
+	if configv3.IsReservedHeader(header) {
+		return ""
+	}
+	return
+}
+
+func (c *ConfigRepository) UserAgentSuffix() (suffix string) {
+	c.read(func() {
+		suffix = c.data.UserAgentSuffix
+	})
+	return
+}
+
 // SETTERS
 
 func (c *ConfigRepository) ClearSession() {
@@ -528,6 +596,18 @@ func (c *ConfigRepository) SetSpaceFields(space models.SpaceFields) {
 	})
 }
 
+func (c *ConfigRepository) SetDefaultOrganization(name string) {
+	c.write(func() {
+		c.data.DefaultOrganization = name
+	})
+}
+
+func (c *ConfigRepository) SetDefaultSpace(name string) {
+	c.write(func() {
+		c.data.DefaultSpace = name
+	})
+}
+
 func (c *ConfigRepository) SetSSLDisabled(disabled bool) {
 	c.write(func() {
 		c.data.SSLDisabled = disabled
@@ -120,6 +120,27 @@ var _ = Describe("Configuration Repository", func() {
 		Expect(config.MinRecommendedCLIVersion()).To(Equal("6.9.0"))
 	})
 
+	Describe("ChangeHeader", func() {
+		var changeHeader string
+
+		BeforeEach(func() {
+			persistor.LoadStub = func(data configuration.DataInterface) error {
+				data.(*coreconfig.Data).ChangeHeader = changeHeader
+				return nil
+			}
+		})
+
+		It("returns the change header", func() {
+			changeHeader = "X-Change-Ticket"
+			Expect(config.ChangeHeader()).To(Equal("X-Change-Ticket"))
+		})
+
+		It("does not return a reserved header name", func() {
+			changeHeader = "host"
+			Expect(config.ChangeHeader()).To(BeEmpty())
+		})
+	})
+
 	Describe("APIEndpoint", func() {
 		It("sanitizes the target URL", func() {
 			config.SetAPIEndpoint("http://api.the-endpoint/")
@@ -64,6 +64,24 @@ type FakeReadWriter struct {
 	uaaEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RouterCNAMEStub        func() string
+	routerCNAMEMutex       sync.RWMutex
+	routerCNAMEArgsForCall []struct{}
+	routerCNAMEReturns     struct {
+		result1 string
+	}
+	routerCNAMEReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RouterIPsStub        func() []string
+	routerIPsMutex       sync.RWMutex
+	routerIPsArgsForCall []struct{}
+	routerIPsReturns     struct {
+		result1 []string
+	}
+	routerIPsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	RoutingAPIEndpointStub        func() string
 	routingAPIEndpointMutex       sync.RWMutex
 	routingAPIEndpointArgsForCall []struct{}
@@ -136,6 +154,15 @@ type FakeReadWriter struct {
 	hasOrganizationReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	DefaultOrganizationStub        func() string
+	defaultOrganizationMutex       sync.RWMutex
+	defaultOrganizationArgsForCall []struct{}
+	defaultOrganizationReturns     struct {
+		result1 string
+	}
+	defaultOrganizationReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SpaceFieldsStub        func() models.SpaceFields
 	spaceFieldsMutex       sync.RWMutex
 	spaceFieldsArgsForCall []struct{}
@@ -154,6 +181,15 @@ type FakeReadWriter struct {
 	hasSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	DefaultSpaceStub        func() string
+	defaultSpaceMutex       sync.RWMutex
+	defaultSpaceArgsForCall []struct{}
+	defaultSpaceReturns     struct {
+		result1 string
+	}
+	defaultSpaceReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UsernameStub        func() string
 	usernameMutex       sync.RWMutex
 	usernameArgsForCall []struct{}
@@ -172,6 +208,16 @@ type FakeReadWriter struct {
 	userGUIDReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UserAgentSuffixStub        func() string
+	userAgentSuffixMutex       sync.RWMutex
+	userAgentSuffixArgsForCall []struct {
+	}
+	userAgentSuffixReturns struct {
+		result1 string
+	}
+	userAgentSuffixReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UserEmailStub        func() string
 	userEmailMutex       sync.RWMutex
 	userEmailArgsForCall []struct{}
@@ -293,6 +339,16 @@ type FakeReadWriter struct {
 	pluginReposReturnsOnCall map[int]struct {
 		result1 []models.PluginRepo
 	}
+	ChangeHeaderStub        func() string
+	changeHeaderMutex       sync.RWMutex
+	changeHeaderArgsForCall []struct {
+	}
+	changeHeaderReturns struct {
+		result1 string
+	}
+	changeHeaderReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ClearSessionStub          func()
 	clearSessionMutex         sync.RWMutex
 	clearSessionArgsForCall   []struct{}
@@ -391,6 +447,16 @@ type FakeReadWriter struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetDefaultOrganizationStub        func(string)
+	setDefaultOrganizationMutex       sync.RWMutex
+	setDefaultOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	SetDefaultSpaceStub        func(string)
+	setDefaultSpaceMutex       sync.RWMutex
+	setDefaultSpaceArgsForCall []struct {
+		arg1 string
+	}
 	SetLocaleStub        func(string)
 	setLocaleMutex       sync.RWMutex
 	setLocaleArgsForCall []struct {
@@ -669,6 +735,86 @@ func (fake *FakeReadWriter) UaaEndpointReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) RouterCNAME() string {
+	fake.routerCNAMEMutex.Lock()
+	ret, specificReturn := fake.routerCNAMEReturnsOnCall[len(fake.routerCNAMEArgsForCall)]
+	fake.routerCNAMEArgsForCall = append(fake.routerCNAMEArgsForCall, struct{}{})
+	fake.recordInvocation("RouterCNAME", []interface{}{})
+	fake.routerCNAMEMutex.Unlock()
+	if fake.RouterCNAMEStub != nil {
+		return fake.RouterCNAMEStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.routerCNAMEReturns.result1
+}
+
+func (fake *FakeReadWriter) RouterCNAMECallCount() int {
+	fake.routerCNAMEMutex.RLock()
+	defer fake.routerCNAMEMutex.RUnlock()
+	return len(fake.routerCNAMEArgsForCall)
+}
+
+func (fake *FakeReadWriter) RouterCNAMEReturns(result1 string) {
+	fake.RouterCNAMEStub = nil
+	fake.routerCNAMEReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) RouterCNAMEReturnsOnCall(i int, result1 string) {
+	fake.RouterCNAMEStub = nil
+	if fake.routerCNAMEReturnsOnCall == nil {
+		fake.routerCNAMEReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.routerCNAMEReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) RouterIPs() []string {
+	fake.routerIPsMutex.Lock()
+	ret, specificReturn := fake.routerIPsReturnsOnCall[len(fake.routerIPsArgsForCall)]
+	fake.routerIPsArgsForCall = append(fake.routerIPsArgsForCall, struct{}{})
+	fake.recordInvocation("RouterIPs", []interface{}{})
+	fake.routerIPsMutex.Unlock()
+	if fake.RouterIPsStub != nil {
+		return fake.RouterIPsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.routerIPsReturns.result1
+}
+
+func (fake *FakeReadWriter) RouterIPsCallCount() int {
+	fake.routerIPsMutex.RLock()
+	defer fake.routerIPsMutex.RUnlock()
+	return len(fake.routerIPsArgsForCall)
+}
+
+func (fake *FakeReadWriter) RouterIPsReturns(result1 []string) {
+	fake.RouterIPsStub = nil
+	fake.routerIPsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) RouterIPsReturnsOnCall(i int, result1 []string) {
+	fake.RouterIPsStub = nil
+	if fake.routerIPsReturnsOnCall == nil {
+		fake.routerIPsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.routerIPsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) RoutingAPIEndpoint() string {
 	fake.routingAPIEndpointMutex.Lock()
 	ret, specificReturn := fake.routingAPIEndpointReturnsOnCall[len(fake.routingAPIEndpointArgsForCall)]
@@ -967,6 +1113,8 @@ func (fake *FakeReadWriter) HasOrganization() bool {
 func (fake *FakeReadWriter) HasOrganizationCallCount() int {
 	fake.hasOrganizationMutex.RLock()
 	defer fake.hasOrganizationMutex.RUnlock()
+	fake.defaultOrganizationMutex.RLock()
+	defer fake.defaultOrganizationMutex.RUnlock()
 	return len(fake.hasOrganizationArgsForCall)
 }
 
@@ -989,6 +1137,46 @@ func (fake *FakeReadWriter) HasOrganizationReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) DefaultOrganization() string {
+	fake.defaultOrganizationMutex.Lock()
+	ret, specificReturn := fake.defaultOrganizationReturnsOnCall[len(fake.defaultOrganizationArgsForCall)]
+	fake.defaultOrganizationArgsForCall = append(fake.defaultOrganizationArgsForCall, struct{}{})
+	fake.recordInvocation("DefaultOrganization", []interface{}{})
+	fake.defaultOrganizationMutex.Unlock()
+	if fake.DefaultOrganizationStub != nil {
+		return fake.DefaultOrganizationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.defaultOrganizationReturns.result1
+}
+
+func (fake *FakeReadWriter) DefaultOrganizationCallCount() int {
+	fake.defaultOrganizationMutex.RLock()
+	defer fake.defaultOrganizationMutex.RUnlock()
+	return len(fake.defaultOrganizationArgsForCall)
+}
+
+func (fake *FakeReadWriter) DefaultOrganizationReturns(result1 string) {
+	fake.DefaultOrganizationStub = nil
+	fake.defaultOrganizationReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) DefaultOrganizationReturnsOnCall(i int, result1 string) {
+	fake.DefaultOrganizationStub = nil
+	if fake.defaultOrganizationReturnsOnCall == nil {
+		fake.defaultOrganizationReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.defaultOrganizationReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) SpaceFields() models.SpaceFields {
 	fake.spaceFieldsMutex.Lock()
 	ret, specificReturn := fake.spaceFieldsReturnsOnCall[len(fake.spaceFieldsArgsForCall)]
@@ -1047,6 +1235,8 @@ func (fake *FakeReadWriter) HasSpace() bool {
 func (fake *FakeReadWriter) HasSpaceCallCount() int {
 	fake.hasSpaceMutex.RLock()
 	defer fake.hasSpaceMutex.RUnlock()
+	fake.defaultSpaceMutex.RLock()
+	defer fake.defaultSpaceMutex.RUnlock()
 	return len(fake.hasSpaceArgsForCall)
 }
 
@@ -1069,6 +1259,46 @@ func (fake *FakeReadWriter) HasSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) DefaultSpace() string {
+	fake.defaultSpaceMutex.Lock()
+	ret, specificReturn := fake.defaultSpaceReturnsOnCall[len(fake.defaultSpaceArgsForCall)]
+	fake.defaultSpaceArgsForCall = append(fake.defaultSpaceArgsForCall, struct{}{})
+	fake.recordInvocation("DefaultSpace", []interface{}{})
+	fake.defaultSpaceMutex.Unlock()
+	if fake.DefaultSpaceStub != nil {
+		return fake.DefaultSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.defaultSpaceReturns.result1
+}
+
+func (fake *FakeReadWriter) DefaultSpaceCallCount() int {
+	fake.defaultSpaceMutex.RLock()
+	defer fake.defaultSpaceMutex.RUnlock()
+	return len(fake.defaultSpaceArgsForCall)
+}
+
+func (fake *FakeReadWriter) DefaultSpaceReturns(result1 string) {
+	fake.DefaultSpaceStub = nil
+	fake.defaultSpaceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) DefaultSpaceReturnsOnCall(i int, result1 string) {
+	fake.DefaultSpaceStub = nil
+	if fake.defaultSpaceReturnsOnCall == nil {
+		fake.defaultSpaceReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.defaultSpaceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) Username() string {
 	fake.usernameMutex.Lock()
 	ret, specificReturn := fake.usernameReturnsOnCall[len(fake.usernameArgsForCall)]
@@ -1149,6 +1379,58 @@ func (fake *FakeReadWriter) UserGUIDReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) UserAgentSuffix() string {
+	fake.userAgentSuffixMutex.Lock()
+	ret, specificReturn := fake.userAgentSuffixReturnsOnCall[len(fake.userAgentSuffixArgsForCall)]
+	fake.userAgentSuffixArgsForCall = append(fake.userAgentSuffixArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserAgentSuffix", []interface{}{})
+	fake.userAgentSuffixMutex.Unlock()
+	if fake.UserAgentSuffixStub != nil {
+		return fake.UserAgentSuffixStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.userAgentSuffixReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReadWriter) UserAgentSuffixCallCount() int {
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
+	return len(fake.userAgentSuffixArgsForCall)
+}
+
+func (fake *FakeReadWriter) UserAgentSuffixCalls(stub func() string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = stub
+}
+
+func (fake *FakeReadWriter) UserAgentSuffixReturns(result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	fake.userAgentSuffixReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) UserAgentSuffixReturnsOnCall(i int, result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	if fake.userAgentSuffixReturnsOnCall == nil {
+		fake.userAgentSuffixReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.userAgentSuffixReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) UserEmail() string {
 	fake.userEmailMutex.Lock()
 	ret, specificReturn := fake.userEmailReturnsOnCall[len(fake.userEmailArgsForCall)]
@@ -1685,6 +1967,58 @@ func (fake *FakeReadWriter) PluginReposReturnsOnCall(i int, result1 []models.Plu
 	}{result1}
 }
 
+func (fake *FakeReadWriter) ChangeHeader() string {
+	fake.changeHeaderMutex.Lock()
+	ret, specificReturn := fake.changeHeaderReturnsOnCall[len(fake.changeHeaderArgsForCall)]
+	fake.changeHeaderArgsForCall = append(fake.changeHeaderArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ChangeHeader", []interface{}{})
+	fake.changeHeaderMutex.Unlock()
+	if fake.ChangeHeaderStub != nil {
+		return fake.ChangeHeaderStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.changeHeaderReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReadWriter) ChangeHeaderCallCount() int {
+	fake.changeHeaderMutex.RLock()
+	defer fake.changeHeaderMutex.RUnlock()
+	return len(fake.changeHeaderArgsForCall)
+}
+
+func (fake *FakeReadWriter) ChangeHeaderCalls(stub func() string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = stub
+}
+
+func (fake *FakeReadWriter) ChangeHeaderReturns(result1 string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = nil
+	fake.changeHeaderReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeReadWriter) ChangeHeaderReturnsOnCall(i int, result1 string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = nil
+	if fake.changeHeaderReturnsOnCall == nil {
+		fake.changeHeaderReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.changeHeaderReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeReadWriter) ClearSession() {
 	fake.clearSessionMutex.Lock()
 	fake.clearSessionArgsForCall = append(fake.clearSessionArgsForCall, struct{}{})
@@ -2157,6 +2491,54 @@ func (fake *FakeReadWriter) SetColorEnabledArgsForCall(i int) string {
 	return fake.setColorEnabledArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetDefaultOrganization(arg1 string) {
+	fake.setDefaultOrganizationMutex.Lock()
+	fake.setDefaultOrganizationArgsForCall = append(fake.setDefaultOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetDefaultOrganization", []interface{}{arg1})
+	fake.setDefaultOrganizationMutex.Unlock()
+	if fake.SetDefaultOrganizationStub != nil {
+		fake.SetDefaultOrganizationStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetDefaultOrganizationCallCount() int {
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	return len(fake.setDefaultOrganizationArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetDefaultOrganizationArgsForCall(i int) string {
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	return fake.setDefaultOrganizationArgsForCall[i].arg1
+}
+
+func (fake *FakeReadWriter) SetDefaultSpace(arg1 string) {
+	fake.setDefaultSpaceMutex.Lock()
+	fake.setDefaultSpaceArgsForCall = append(fake.setDefaultSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetDefaultSpace", []interface{}{arg1})
+	fake.setDefaultSpaceMutex.Unlock()
+	if fake.SetDefaultSpaceStub != nil {
+		fake.SetDefaultSpaceStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetDefaultSpaceCallCount() int {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	return len(fake.setDefaultSpaceArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetDefaultSpaceArgsForCall(i int) string {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	return fake.setDefaultSpaceArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetLocale(arg1 string) {
 	fake.setLocaleMutex.Lock()
 	fake.setLocaleArgsForCall = append(fake.setLocaleArgsForCall, struct {
@@ -2332,6 +2714,10 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.dopplerEndpointMutex.RUnlock()
 	fake.uaaEndpointMutex.RLock()
 	defer fake.uaaEndpointMutex.RUnlock()
+	fake.routerCNAMEMutex.RLock()
+	defer fake.routerCNAMEMutex.RUnlock()
+	fake.routerIPsMutex.RLock()
+	defer fake.routerIPsMutex.RUnlock()
 	fake.routingAPIEndpointMutex.RLock()
 	defer fake.routingAPIEndpointMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
@@ -2356,6 +2742,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.usernameMutex.RUnlock()
 	fake.userGUIDMutex.RLock()
 	defer fake.userGUIDMutex.RUnlock()
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
 	fake.userEmailMutex.RLock()
 	defer fake.userEmailMutex.RUnlock()
 	fake.isLoggedInMutex.RLock()
@@ -2382,6 +2770,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
 	defer fake.pluginReposMutex.RUnlock()
+	fake.changeHeaderMutex.RLock()
+	defer fake.changeHeaderMutex.RUnlock()
 	fake.clearSessionMutex.RLock()
 	defer fake.clearSessionMutex.RUnlock()
 	fake.setAPIEndpointMutex.RLock()
@@ -2422,6 +2812,10 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setTraceMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
 	defer fake.setLocaleMutex.RUnlock()
 	fake.setPluginRepoMutex.RLock()
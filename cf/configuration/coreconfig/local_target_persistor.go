@@ -0,0 +1,69 @@
+package coreconfig
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/cf/configuration"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// LocalTargetEnvVar holds the org and space that the running command targets
+// from a project directory's local target file, in the format of the config
+// file.
+const LocalTargetEnvVar = "CF_LOCAL_TARGET"
+
+// LocalTarget is the org and space targeted from a local target file.
+type LocalTarget struct {
+	OrganizationFields models.OrganizationFields
+	SpaceFields        models.SpaceFields
+}
+
+type localTargetPersistor struct {
+	configuration.Persistor
+	localTarget  LocalTarget
+	globalTarget LocalTarget
+}
+
+// NewLocalTargetPersistor returns a persistor that targets localTarget,
+// encoded as in $CF_LOCAL_TARGET, for the running command only. The org and
+// space loaded from persistor are saved back, unless the command targets
+// another org or space.
+func NewLocalTargetPersistor(persistor configuration.Persistor, localTarget string) (configuration.Persistor, error) {
+	localTargetPersistor := &localTargetPersistor{Persistor: persistor}
+	err := json.Unmarshal([]byte(localTarget), &localTargetPersistor.localTarget)
+	if err != nil {
+		return nil, err
+	}
+	return localTargetPersistor, nil
+}
+
+func (persistor *localTargetPersistor) Load(data configuration.DataInterface) error {
+	err := persistor.Persistor.Load(data)
+	if err != nil {
+		return err
+	}
+
+	if configData, ok := data.(*Data); ok {
+		persistor.globalTarget = LocalTarget{
+			OrganizationFields: configData.OrganizationFields,
+			SpaceFields:        configData.SpaceFields,
+		}
+		configData.OrganizationFields = persistor.localTarget.OrganizationFields
+		configData.SpaceFields = persistor.localTarget.SpaceFields
+	}
+	return nil
+}
+
+func (persistor *localTargetPersistor) Save(data configuration.DataInterface) error {
+	configData, ok := data.(*Data)
+	if !ok ||
+		configData.OrganizationFields.GUID != persistor.localTarget.OrganizationFields.GUID ||
+		configData.SpaceFields.GUID != persistor.localTarget.SpaceFields.GUID {
+		return persistor.Persistor.Save(data)
+	}
+
+	savedData := *configData
+	savedData.OrganizationFields = persistor.globalTarget.OrganizationFields
+	savedData.SpaceFields = persistor.globalTarget.SpaceFields
+	return persistor.Persistor.Save(&savedData)
+}
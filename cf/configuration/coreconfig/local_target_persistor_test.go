@@ -0,0 +1,67 @@
+package coreconfig_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration"
+	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewLocalTargetPersistor", func() {
+	var (
+		diskPersistor *configurationfakes.FakePersistor
+		config        coreconfig.Repository
+		saved         coreconfig.Data
+	)
+
+	BeforeEach(func() {
+		diskPersistor = new(configurationfakes.FakePersistor)
+		diskPersistor.ExistsReturns(true)
+		diskPersistor.LoadStub = func(data configuration.DataInterface) error {
+			configData := data.(*coreconfig.Data)
+			configData.OrganizationFields = models.OrganizationFields{GUID: "global-org-guid", Name: "global-org"}
+			configData.SpaceFields = models.SpaceFields{GUID: "global-space-guid", Name: "global-space"}
+			return nil
+		}
+		diskPersistor.SaveStub = func(data configuration.DataInterface) error {
+			saved = *data.(*coreconfig.Data)
+			return nil
+		}
+
+		persistor, err := coreconfig.NewLocalTargetPersistor(diskPersistor, `{"OrganizationFields":{"GUID":"local-org-guid","Name":"local-org"},"SpaceFields":{"GUID":"local-space-guid","Name":"local-space","AllowSSH":true}}`)
+		Expect(err).ToNot(HaveOccurred())
+		config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+	})
+
+	It("targets the local org and space", func() {
+		Expect(config.OrganizationFields().Name).To(Equal("local-org"))
+		Expect(config.SpaceFields()).To(Equal(models.SpaceFields{GUID: "local-space-guid", Name: "local-space", AllowSSH: true}))
+	})
+
+	It("saves the global org and space", func() {
+		config.SetAccessToken("new-access-token")
+
+		Expect(saved.AccessToken).To(Equal("new-access-token"))
+		Expect(saved.OrganizationFields.Name).To(Equal("global-org"))
+		Expect(saved.SpaceFields.Name).To(Equal("global-space"))
+		Expect(config.OrganizationFields().Name).To(Equal("local-org"))
+	})
+
+	When("the command targets another org and space", func() {
+		It("saves the org and space the command targeted", func() {
+			config.SetOrganizationFields(models.OrganizationFields{GUID: "other-org-guid", Name: "other-org"})
+			config.SetSpaceFields(models.SpaceFields{GUID: "other-space-guid", Name: "other-space"})
+
+			Expect(saved.OrganizationFields.Name).To(Equal("other-org"))
+			Expect(saved.SpaceFields.Name).To(Equal("other-space"))
+		})
+	})
+
+	It("returns an error when the local target is not valid", func() {
+		_, err := coreconfig.NewLocalTargetPersistor(diskPersistor, "not-json")
+		Expect(err).To(HaveOccurred())
+	})
+})
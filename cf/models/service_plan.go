@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 type ServicePlanFields struct {
 	GUID                string
 	Name                string
@@ -9,6 +11,10 @@ type ServicePlanFields struct {
 	Active              bool
 	ServiceOfferingGUID string
 	OrgNames            []string
+
+	// UpdateParametersSchema is the JSON schema the broker publishes for the
+	// parameters of service instance updates, or nil when there is none.
+	UpdateParametersSchema json.RawMessage
 }
 
 type ServicePlan struct {
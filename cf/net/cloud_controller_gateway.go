@@ -48,14 +48,16 @@ func cloudControllerErrorHandler(statusCode int, body []byte) error {
 
 func NewCloudControllerGateway(config coreconfig.Reader, clock func() time.Time, ui terminal.UI, logger trace.Printer, envDialTimeout string) Gateway {
 	return Gateway{
-		errHandler:      cloudControllerErrorHandler,
-		config:          config,
-		PollingThrottle: DefaultPollingThrottle,
-		warnings:        &[]string{},
-		Clock:           clock,
-		ui:              ui,
-		logger:          logger,
-		PollingEnabled:  true,
-		DialTimeout:     dialTimeout(envDialTimeout),
+		errHandler:        cloudControllerErrorHandler,
+		config:            config,
+		PollingThrottle:   DefaultPollingThrottle,
+		warnings:          &[]string{},
+		Clock:             clock,
+		ui:                ui,
+		logger:            logger,
+		PollingEnabled:    true,
+		DialTimeout:       dialTimeout(envDialTimeout),
+		sendsChangeReason: true,
+		honorsDryRun:      true,
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -21,6 +22,7 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/dryrun"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -46,6 +48,10 @@ type AsyncResource struct {
 	}
 }
 
+// warningsMutex guards the warnings collected by every gateway, since
+// repositories may issue requests from more than one goroutine.
+var warningsMutex sync.Mutex
+
 type apiErrorHandler func(statusCode int, body []byte) error
 
 type tokenRefresher interface {
@@ -70,6 +76,9 @@ type Gateway struct {
 	ui              terminal.UI
 	logger          trace.Printer
 	DialTimeout     time.Duration
+
+	sendsChangeReason bool
+	honorsDryRun      bool
 }
 
 func (gateway *Gateway) AsyncTimeout() time.Duration {
@@ -199,11 +208,91 @@ func (gateway Gateway) newRequest(request *http.Request, accessToken string, bod
 
 	request.Header.Set("accept", "application/json")
 	request.Header.Set("content-type", "application/json")
-	request.Header.Set("User-Agent", "go-cli "+version.VersionString()+" / "+runtime.GOOS)
+	request.Header.Set("User-Agent", gateway.userAgent())
 
 	return &Request{HTTPReq: request, SeekableBody: body}
 }
 
+// userAgent returns the User-Agent of the CLI, followed by the identifier of
+// the automation running it from $CF_USER_AGENT_SUFFIX or the
+// user-agent-suffix config setting.
+func (gateway Gateway) userAgent() string {
+	userAgent := "go-cli " + version.VersionString() + " / " + runtime.GOOS
+
+	suffix := os.Getenv("CF_USER_AGENT_SUFFIX")
+	if suffix == "" {
+		suffix = gateway.config.UserAgentSuffix()
+	}
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+	return userAgent
+}
+
+// setChangeReason adds the change reason to requests that modify resources
+// when a change header is configured. The reason is asked for when $CF_REASON
+// is not set, and kept there for the rest of the command.
+func (gateway Gateway) setChangeReason(request *http.Request) error {
+	header := gateway.config.ChangeHeader()
+	if !gateway.sendsChangeReason || header == "" || gateway.dryRun(request) {
+		return nil
+	}
+
+	switch request.Method {
+	case "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return nil
+	}
+
+	reason := os.Getenv("CF_REASON")
+	if reason == "" {
+		reason = strings.TrimSpace(gateway.ui.Ask(T("Change reason for {{.Header}}", map[string]interface{}{"Header": header})))
+		if reason == "" {
+			return errors.New(T("A change reason is required to send in the {{.Header}} header. Provide it with --reason or CF_REASON.", map[string]interface{}{"Header": header}))
+		}
+		_ = os.Setenv("CF_REASON", reason)
+	}
+
+	request.Header.Set(header, reason)
+	return nil
+}
+
+// dryRun returns whether the request modifies resources and should be
+// printed instead of sent, because $CF_DRY_RUN is set. UAA token requests are
+// always sent, as they only authenticate the session.
+func (gateway Gateway) dryRun(request *http.Request) bool {
+	if !gateway.honorsDryRun || !dryrun.ModifiesResources(request.Method) || dryrun.IsTokenRequest(request.URL.Path) {
+		return false
+	}
+
+	dryRun, _ := strconv.ParseBool(os.Getenv("CF_DRY_RUN"))
+	return dryRun
+}
+
+// printDryRunRequest prints the request with a summary of its body, and
+// returns an empty response in place of sending it.
+func (gateway Gateway) printDryRunRequest(request *http.Request) (*http.Response, error) {
+	gateway.ui.Say(T("Dry run: {{.Method}} {{.URL}}", map[string]interface{}{
+		"Method": request.Method,
+		"URL":    request.URL.String(),
+	}))
+
+	if request.Body != nil {
+		summary, err := dryrun.SummarizeBody(request.URL.Path, request.Header.Get("content-type"), request.Body)
+		if err != nil {
+			return nil, err
+		}
+		gateway.ui.Say("  " + summary)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusNoContent,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    request,
+	}, nil
+}
+
 func (gateway Gateway) NewRequestForFile(method, fullURL, accessToken string, body *os.File) (*Request, error) {
 	progressReader := NewProgressReader(body, gateway.ui, 5*time.Second)
 	_, _ = progressReader.Seek(0, 0)
@@ -226,6 +315,11 @@ func (gateway Gateway) NewRequestForFile(method, fullURL, accessToken string, bo
 		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
 	}
 
+	err = gateway.setChangeReason(request)
+	if err != nil {
+		return nil, err
+	}
+
 	return gateway.newRequest(request, accessToken, progressReader), nil
 }
 
@@ -234,6 +328,12 @@ func (gateway Gateway) NewRequest(method, path, accessToken string, body io.Read
 	if err != nil {
 		return nil, fmt.Errorf("%s: %s", T("Error building request"), err.Error())
 	}
+
+	err = gateway.setChangeReason(request)
+	if err != nil {
+		return nil, err
+	}
+
 	return gateway.newRequest(request, accessToken, body), nil
 }
 
@@ -324,6 +424,8 @@ func (gateway Gateway) PerformPollingRequestForJSONResponse(endpoint string, req
 }
 
 func (gateway Gateway) Warnings() []string {
+	warningsMutex.Lock()
+	defer warningsMutex.Unlock()
 	return *gateway.warnings
 }
 
@@ -397,6 +499,10 @@ func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Respons
 }
 
 func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error) {
+	if gateway.dryRun(request) {
+		return gateway.printDryRunRequest(request)
+	}
+
 	var response *http.Response
 	var err error
 
@@ -429,7 +535,9 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 			continue
 		}
 		warning, _ := url.QueryUnescape(rawWarning)
+		warningsMutex.Lock()
 		*gateway.warnings = append(*gateway.warnings, warning)
+		warningsMutex.Unlock()
 	}
 
 	return response, err
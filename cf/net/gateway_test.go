@@ -17,6 +17,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/api/authentication"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig/coreconfigfakes"
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/net/netfakes"
@@ -150,6 +151,190 @@ var _ = Describe("Gateway", func() {
 
 	})
 
+	Describe("user agent suffix", func() {
+		var (
+			fakeConfig *coreconfigfakes.FakeReadWriter
+			oldSuffix  string
+		)
+
+		BeforeEach(func() {
+			oldSuffix = os.Getenv("CF_USER_AGENT_SUFFIX")
+			Expect(os.Unsetenv("CF_USER_AGENT_SUFFIX")).To(Succeed())
+
+			fakeConfig = new(coreconfigfakes.FakeReadWriter)
+			fakeConfig.UserAgentSuffixReturns("team-payments/deploy")
+			ccGateway = NewCloudControllerGateway(fakeConfig, clock, new(terminalfakes.FakeUI), new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			Expect(os.Setenv("CF_USER_AGENT_SUFFIX", oldSuffix)).To(Succeed())
+		})
+
+		It("appends the configured suffix to the user agent header", func() {
+			request, err := ccGateway.NewRequest("GET", "https://example.com/v2/apps", initialAccessToken, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request.HTTPReq.Header.Get("User-Agent")).To(Equal("go-cli " + version.VersionString() + " / " + runtime.GOOS + " team-payments/deploy"))
+		})
+
+		Context("when $CF_USER_AGENT_SUFFIX is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_USER_AGENT_SUFFIX", "pipeline-nightly")).To(Succeed())
+			})
+
+			It("appends it instead of the configured suffix", func() {
+				request, err := ccGateway.NewRequest("GET", "https://example.com/v2/apps", initialAccessToken, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("User-Agent")).To(Equal("go-cli " + version.VersionString() + " / " + runtime.GOOS + " pipeline-nightly"))
+			})
+		})
+	})
+
+	Describe("change reason", func() {
+		var (
+			fakeConfig *coreconfigfakes.FakeReadWriter
+			fakeUI     *terminalfakes.FakeUI
+			oldReason  string
+		)
+
+		BeforeEach(func() {
+			oldReason = os.Getenv("CF_REASON")
+			Expect(os.Unsetenv("CF_REASON")).To(Succeed())
+
+			fakeConfig = new(coreconfigfakes.FakeReadWriter)
+			fakeConfig.ChangeHeaderReturns("X-Change-Ticket")
+			fakeUI = new(terminalfakes.FakeUI)
+			ccGateway = NewCloudControllerGateway(fakeConfig, clock, fakeUI, new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			Expect(os.Setenv("CF_REASON", oldReason)).To(Succeed())
+		})
+
+		Context("when $CF_REASON is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_REASON", "CHG12345")).To(Succeed())
+			})
+
+			It("sends the reason in the change header of requests that modify resources", func() {
+				request, err := ccGateway.NewRequest("POST", "https://example.com/v2/organizations", initialAccessToken, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("X-Change-Ticket")).To(Equal("CHG12345"))
+
+				request, err = ccGateway.NewRequest("GET", "https://example.com/v2/organizations", initialAccessToken, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("X-Change-Ticket")).To(BeEmpty())
+
+				Expect(fakeUI.AskCallCount()).To(Equal(0))
+			})
+
+			It("does not send the reason to the UAA", func() {
+				uaaGateway = NewUAAGateway(fakeConfig, fakeUI, new(tracefakes.FakePrinter), "")
+
+				request, err := uaaGateway.NewRequest("POST", "https://uaa.example.com/oauth/token", "", nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("X-Change-Ticket")).To(BeEmpty())
+			})
+		})
+
+		Context("when $CF_REASON is not set", func() {
+			It("asks for the reason once", func() {
+				fakeUI.AskReturns("CHG67890\n")
+
+				request, err := ccGateway.NewRequest("DELETE", "https://example.com/v2/organizations/some-guid", initialAccessToken, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("X-Change-Ticket")).To(Equal("CHG67890"))
+
+				request, err = ccGateway.NewRequest("PUT", "https://example.com/v2/organizations/some-guid", initialAccessToken, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(request.HTTPReq.Header.Get("X-Change-Ticket")).To(Equal("CHG67890"))
+
+				Expect(fakeUI.AskCallCount()).To(Equal(1))
+				Expect(fakeUI.AskArgsForCall(0)).To(Equal("Change reason for X-Change-Ticket"))
+			})
+
+			It("returns an error when no reason is given", func() {
+				_, err := ccGateway.NewRequest("POST", "https://example.com/v2/organizations", initialAccessToken, nil)
+				Expect(err).To(MatchError("A change reason is required to send in the X-Change-Ticket header. Provide it with --reason or CF_REASON."))
+			})
+		})
+	})
+
+	Describe("dry run", func() {
+		var (
+			fakeUI    *terminalfakes.FakeUI
+			oldDryRun string
+		)
+
+		BeforeEach(func() {
+			oldDryRun = os.Getenv("CF_DRY_RUN")
+			Expect(os.Setenv("CF_DRY_RUN", "true")).To(Succeed())
+
+			ccServer = ghttp.NewServer()
+			ccServer.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+			config.SetAPIEndpoint(ccServer.URL())
+
+			fakeUI = new(terminalfakes.FakeUI)
+			ccGateway = NewCloudControllerGateway(config, clock, fakeUI, new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+			Expect(os.Setenv("CF_DRY_RUN", oldDryRun)).To(Succeed())
+		})
+
+		It("prints requests that modify resources instead of sending them", func() {
+			err := ccGateway.UpdateResource(config.APIEndpoint(), "/v2/apps/some-app-guid", strings.NewReader("{\n  \"instances\": 3\n}"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ccServer.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeUI.SayCallCount()).To(Equal(2))
+			message, _ := fakeUI.SayArgsForCall(0)
+			Expect(message).To(Equal("Dry run: PUT " + config.APIEndpoint() + "/v2/apps/some-app-guid"))
+			message, _ = fakeUI.SayArgsForCall(1)
+			Expect(message).To(Equal(`  {"instances":3}`))
+		})
+
+		It("sends requests that only read resources", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v2/apps/some-app-guid"),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+
+			err := ccGateway.GetResource(config.APIEndpoint()+"/v2/apps/some-app-guid", &struct{}{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(fakeUI.SayCallCount()).To(Equal(0))
+		})
+
+		It("sends token requests to the UAA", func() {
+			uaaGateway = NewUAAGateway(config, fakeUI, new(tracefakes.FakePrinter), "")
+			ccServer.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{}`))
+
+			request, err := uaaGateway.NewRequest("POST", config.APIEndpoint()+"/oauth/token", "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = uaaGateway.PerformRequest(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("prints other UAA requests that modify resources instead of sending them", func() {
+			uaaGateway = NewUAAGateway(config, fakeUI, new(tracefakes.FakePrinter), "")
+
+			request, err := uaaGateway.NewRequest("DELETE", config.APIEndpoint()+"/Users/some-user-guid", "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = uaaGateway.PerformRequest(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ccServer.ReceivedRequests()).To(BeEmpty())
+			message, _ := fakeUI.SayArgsForCall(0)
+			Expect(message).To(Equal("Dry run: DELETE " + config.APIEndpoint() + "/Users/some-user-guid"))
+		})
+	})
+
 	Describe("PerformRequestForJSONResponse()", func() {
 		BeforeEach(func() {
 			ccServer = ghttp.NewServer()
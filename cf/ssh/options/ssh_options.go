@@ -1,6 +1,7 @@
 package options
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -25,6 +26,8 @@ type SSHOptions struct {
 	AppName             string
 	Command             []string
 	Index               uint
+	IdentityFile        string
+	RecordFile          string
 	SkipHostValidation  bool
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
@@ -34,11 +37,19 @@ type SSHOptions struct {
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 	sshOptions := &SSHOptions{}
 
-	sshOptions.AppName = fc.Args()[0]
+	if len(fc.Args()) > 0 {
+		sshOptions.AppName = fc.Args()[0]
+	}
 	sshOptions.Index = uint(fc.Int("i"))
 	sshOptions.SkipHostValidation = fc.Bool("k")
+	sshOptions.IdentityFile = fc.String("identity-file")
 	sshOptions.SkipRemoteExecution = fc.Bool("N")
 	sshOptions.Command = fc.StringSlice("c")
+	sshOptions.RecordFile = fc.String("record")
+
+	if sshOptions.RecordFile != "" && sshOptions.SkipRemoteExecution {
+		return sshOptions, errors.New("--record cannot be used with --skip-remote-execution")
+	}
 
 	if fc.IsSet("L") {
 		for _, arg := range fc.StringSlice("L") {
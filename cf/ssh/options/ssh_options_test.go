@@ -27,6 +27,7 @@ var _ = Describe("SSHOptions", func() {
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
 			fc.NewBoolFlag("disable-pseudo-tty", "T", "")
+			fc.NewStringFlag("record", "", "")
 
 			args = []string{}
 			parseError = nil
@@ -292,6 +293,27 @@ var _ = Describe("SSHOptions", func() {
 				Expect(opts.AppName).To(Equal("app-name"))
 			})
 		})
+
+		Context("when --record is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--record", "session.cast")
+			})
+
+			It("records the session to the file", func() {
+				Expect(parseError).ToNot(HaveOccurred())
+				Expect(opts.RecordFile).To(Equal("session.cast"))
+			})
+
+			Context("together with -N", func() {
+				BeforeEach(func() {
+					args = append(args, "-N")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--record cannot be used with --skip-remote-execution"))
+				})
+			})
+		})
 	})
 
 })
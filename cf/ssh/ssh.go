@@ -23,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/clissh"
 	"github.com/moby/moby/pkg/term"
 )
 
@@ -89,6 +90,7 @@ type secureShell struct {
 	token                  string
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
+	recorder               *clissh.SessionRecorder
 
 	localListeners []net.Listener
 }
@@ -122,11 +124,18 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 		return err
 	}
 
+	authMethod := ssh.Password(c.token)
+	if opts.IdentityFile != "" {
+		signer, signerErr := clissh.LoadSigner(opts.IdentityFile)
+		if signerErr != nil {
+			return signerErr
+		}
+		authMethod = ssh.PublicKeys(signer)
+	}
+
 	clientConfig := &ssh.ClientConfig{
-		User: fmt.Sprintf("cf:%s/%d", c.app.GUID, opts.Index),
-		Auth: []ssh.AuthMethod{
-			ssh.Password(c.token),
-		},
+		User:            fmt.Sprintf("cf:%s/%d", c.app.GUID, opts.Index),
+		Auth:            []ssh.AuthMethod{authMethod},
 		HostKeyCallback: fingerprintCallback(opts, c.sshEndpointFingerprint),
 	}
 
@@ -241,6 +250,22 @@ func (c *secureShell) InteractiveSession() error {
 	stdinFd, stdinIsTerminal := c.terminalHelper.GetFdInfo(stdin)
 	stdoutFd, stdoutIsTerminal := c.terminalHelper.GetFdInfo(stdout)
 
+	if opts.RecordFile != "" {
+		recording, createErr := os.Create(opts.RecordFile)
+		if createErr != nil {
+			return fmt.Errorf("Unable to record session: %s", createErr.Error())
+		}
+		defer recording.Close()
+
+		c.recorder = clissh.NewSessionRecorder(recording)
+		err = c.recorder.Start(c.getWindowDimensions(stdoutFd))
+		if err != nil {
+			return fmt.Errorf("Unable to record session: %s", err.Error())
+		}
+		stdout = io.MultiWriter(stdout, c.recorder)
+		stderr = io.MultiWriter(stderr, c.recorder)
+	}
+
 	if c.shouldAllocateTerminal(opts, stdinIsTerminal) {
 		modes := ssh.TerminalModes{
 			ssh.ECHO:          1,
@@ -413,6 +438,10 @@ func (c *secureShell) resize(resized <-chan os.Signal, session SecureSession, te
 
 		_, _ = session.SendRequest("window-change", false, ssh.Marshal(message))
 
+		if c.recorder != nil {
+			c.recorder.Resize(width, height)
+		}
+
 		previousWidth = width
 		previousHeight = height
 	}
@@ -10,8 +10,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
@@ -679,6 +682,38 @@ var _ = Describe("SSH", func() {
 				Expect(sessionError).To(MatchError("error result"))
 			})
 
+			Context("when the session is recorded", func() {
+				var tempDir string
+
+				BeforeEach(func() {
+					var err error
+					tempDir, err = ioutil.TempDir("", "ssh-record")
+					Expect(err).NotTo(HaveOccurred())
+
+					opts.RecordFile = filepath.Join(tempDir, "session.cast")
+					fakeTerminalHelper.GetWinsizeReturns(&term.Winsize{Width: 100, Height: 40}, nil)
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(tempDir)).To(Succeed())
+				})
+
+				It("writes the session output to the record file", func() {
+					Expect(sessionError).To(MatchError("error result"))
+
+					recording, err := ioutil.ReadFile(opts.RecordFile)
+					Expect(err).NotTo(HaveOccurred())
+
+					lines := strings.Split(strings.TrimSpace(string(recording)), "\n")
+					Expect(lines).To(HaveLen(3))
+					Expect(lines[0]).To(MatchRegexp(`^\{"version":2,"width":100,"height":40,`))
+					Expect(lines[1:]).To(ConsistOf(
+						MatchRegexp(`,"o","\\u0001"\]$`),
+						MatchRegexp(`,"o","\\u0002"\]$`),
+					))
+				})
+			})
+
 			Context("when the session terminates before stream copies complete", func() {
 				var sessionErrorCh chan error
 
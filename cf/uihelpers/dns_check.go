@@ -0,0 +1,29 @@
+package uihelpers
+
+import (
+	"strings"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/dnscheck"
+)
+
+// ReportDNSCheck displays whether the checked host name resolves to the
+// routers. A failed check is displayed as a warning with the DNS record to
+// create, since the route or domain was created regardless.
+func ReportDNSCheck(ui terminal.UI, result dnscheck.Result) {
+	if result.OK() {
+		ui.Say(T("DNS check passed: {{.Host}} resolves to {{.Addresses}}", map[string]interface{}{
+			"Host":      terminal.EntityNameColor(result.Host),
+			"Addresses": strings.Join(result.Addresses, ", "),
+		}))
+		return
+	}
+
+	ui.Warn(T("DNS check failed: {{.Problem}}", map[string]interface{}{
+		"Problem": result.Problem,
+	}))
+	if result.Remediation != "" {
+		ui.Warn(result.Remediation)
+	}
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// APIVersionGate checks the Cloud Controller API version of the target
+// against the versions required by a command and its options. The version is
+// looked up once, the first time a check needs it.
+type APIVersionGate struct {
+	currentVersion func() string
+	current        string
+	resolved       bool
+}
+
+// NewAPIVersionGate returns a gate that looks up the target's API version
+// with currentVersion, typically an actor's CloudControllerAPIVersion method.
+func NewAPIVersionGate(currentVersion func() string) *APIVersionGate {
+	return &APIVersionGate{currentVersion: currentVersion}
+}
+
+// Require returns a MinimumCFAPIVersionNotMetError naming feature, such as
+// "Option '-l'", when the target is older than minimum. An empty feature
+// stands for the whole command.
+func (gate *APIVersionGate) Require(feature string, minimum string) error {
+	return MinimumCCAPIVersionCheck(gate.version(), minimum, feature)
+}
+
+// Supports returns whether the target meets minimum. Commands use it to omit
+// output or behavior the target cannot provide instead of failing. A version
+// that cannot be parsed is treated as unsupported.
+func (gate *APIVersionGate) Supports(minimum string) bool {
+	return gate.Require("", minimum) == nil
+}
+
+// ExplainNotFound replaces the error returned when the Cloud Controller does
+// not know an endpoint with a MinimumCFAPIVersionNotMetError naming feature,
+// when the target is older than minimum. Other errors are returned as is.
+func (gate *APIVersionGate) ExplainNotFound(err error, feature string, minimum string) error {
+	if _, ok := err.(ccerror.APINotFoundError); !ok {
+		return err
+	}
+
+	if versionErr := gate.Require(feature, minimum); versionErr != nil {
+		if _, ok := versionErr.(translatableerror.MinimumCFAPIVersionNotMetError); ok {
+			return versionErr
+		}
+	}
+	return err
+}
+
+func (gate *APIVersionGate) version() string {
+	if !gate.resolved {
+		gate.current = gate.currentVersion()
+		gate.resolved = true
+	}
+	return gate.current
+}
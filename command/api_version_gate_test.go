@@ -0,0 +1,87 @@
+package command_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APIVersionGate", func() {
+	var (
+		currentVersion string
+		lookups        int
+		gate           *APIVersionGate
+	)
+
+	BeforeEach(func() {
+		currentVersion = "3.80.0"
+		lookups = 0
+		gate = NewAPIVersionGate(func() string {
+			lookups++
+			return currentVersion
+		})
+	})
+
+	Describe("Require", func() {
+		It("returns nil when the target meets the minimum", func() {
+			Expect(gate.Require("", "3.80.0")).To(Succeed())
+		})
+
+		It("returns an error naming the feature and exact minimum when the target is older", func() {
+			Expect(gate.Require("Option '-l'", "3.124.0")).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				Command:        "Option '-l'",
+				CurrentVersion: "3.80.0",
+				MinimumVersion: "3.124.0",
+			}))
+		})
+
+		It("looks up the target version once", func() {
+			Expect(gate.Require("", "3.1.0")).To(Succeed())
+			Expect(gate.Require("", "3.2.0")).To(Succeed())
+			Expect(gate.Supports("3.3.0")).To(BeTrue())
+			Expect(lookups).To(Equal(1))
+		})
+	})
+
+	Describe("Supports", func() {
+		It("returns whether the target meets the minimum", func() {
+			Expect(gate.Supports("3.80.0")).To(BeTrue())
+			Expect(gate.Supports("3.124.0")).To(BeFalse())
+		})
+
+		When("the target version cannot be parsed", func() {
+			BeforeEach(func() {
+				currentVersion = "not-a-version"
+			})
+
+			It("returns false", func() {
+				Expect(gate.Supports("3.1.0")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ExplainNotFound", func() {
+		It("explains an unknown endpoint on an older target with the minimum version", func() {
+			err := gate.ExplainNotFound(ccerror.APINotFoundError{URL: "https://api.example.com/v3/sidecars"}, "Sidecars", "3.124.0")
+			Expect(err).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				Command:        "Sidecars",
+				CurrentVersion: "3.80.0",
+				MinimumVersion: "3.124.0",
+			}))
+		})
+
+		It("returns the error as is when the target meets the minimum", func() {
+			notFoundErr := ccerror.APINotFoundError{URL: "https://api.example.com/v3/sidecars"}
+			Expect(gate.ExplainNotFound(notFoundErr, "Sidecars", "3.80.0")).To(MatchError(notFoundErr))
+		})
+
+		It("returns other errors as is", func() {
+			Expect(gate.ExplainNotFound(errors.New("some-error"), "Sidecars", "3.124.0")).To(MatchError("some-error"))
+		})
+	})
+})
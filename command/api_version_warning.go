@@ -2,6 +2,7 @@ package command
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -34,6 +35,29 @@ func WarnIfCLIVersionBelowAPIDefinedMinimum(config Config, apiVersion string, ui
 	return nil
 }
 
+// FailIfCLIVersionBelowAPIDefinedMinimum returns a
+// MinimumCLIVersionNotMetError when the CLI is older than the minimum version
+// required by the Cloud Controller.
+func FailIfCLIVersionBelowAPIDefinedMinimum(config Config, apiVersion string) error {
+	minVer := config.MinCLIVersion()
+	currentVer := config.BinaryVersion()
+
+	isOutdated, err := checkVersionOutdated(currentVer, minVer)
+	if err != nil {
+		return err
+	}
+
+	if isOutdated {
+		return translatableerror.MinimumCLIVersionNotMetError{
+			APIVersion:    apiVersion,
+			MinCLIVersion: minVer,
+			BinaryVersion: currentVer,
+		}
+	}
+
+	return nil
+}
+
 func WarnIfAPIVersionBelowSupportedMinimum(apiVersion string, ui UI) error {
 	isOutdated, err := checkVersionOutdated(apiVersion, ccversion.MinSupportedV2ClientVersion)
 	if err != nil {
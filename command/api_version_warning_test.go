@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	. "code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
@@ -126,6 +127,46 @@ var _ = Describe("version checks", func() {
 		})
 	})
 
+	Describe("FailIfCLIVersionBelowAPIDefinedMinimum", func() {
+		var (
+			fakeConfig *commandfakes.FakeConfig
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(commandfakes.FakeConfig)
+			fakeConfig.MinCLIVersionReturns("1.0.0")
+		})
+
+		JustBeforeEach(func() {
+			executeErr = FailIfCLIVersionBelowAPIDefinedMinimum(fakeConfig, "100.200.3")
+		})
+
+		When("the CLI version is less than the minimum", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryVersionReturns("0.0.0")
+			})
+
+			It("returns a MinimumCLIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumCLIVersionNotMetError{
+					APIVersion:    "100.200.3",
+					MinCLIVersion: "1.0.0",
+					BinaryVersion: "0.0.0",
+				}))
+			})
+		})
+
+		When("the CLI version is greater or equal to the minimum", func() {
+			BeforeEach(func() {
+				fakeConfig.BinaryVersionReturns("1.0.0")
+			})
+
+			It("does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("WarnIfAPIVersionBelowSupportedMinimum", func() {
 		var (
 			testUI *ui.UI
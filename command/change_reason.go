@@ -0,0 +1,38 @@
+package command
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// ChangeReasonPrompt returns a function that gets the change reason of the
+// running command. When none was given with --reason or CF_REASON it is asked
+// for once, on a terminal, and kept for the rest of the command.
+func ChangeReasonPrompt(config Config, ui UI) func() (string, error) {
+	return func() (string, error) {
+		if reason := config.ChangeReason(); reason != "" {
+			return reason, nil
+		}
+
+		requiredErr := translatableerror.ChangeReasonRequiredError{Header: config.ChangeHeader()}
+		if !config.IsTTY() {
+			return "", requiredErr
+		}
+
+		reason, err := ui.DisplayOptionalTextPrompt("", "Change reason for {{.Header}}", map[string]interface{}{
+			"Header": config.ChangeHeader(),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		reason = strings.TrimSpace(reason)
+		if reason == "" {
+			return "", requiredErr
+		}
+
+		config.SetChangeReason(reason)
+		return reason, nil
+	}
+}
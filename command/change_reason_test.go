@@ -0,0 +1,87 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ChangeReasonPrompt", func() {
+	var (
+		input      *Buffer
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+
+		reason    string
+		reasonErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.ChangeHeaderReturns("X-Change-Ticket")
+	})
+
+	JustBeforeEach(func() {
+		reason, reasonErr = ChangeReasonPrompt(fakeConfig, testUI)()
+	})
+
+	When("a reason was given", func() {
+		BeforeEach(func() {
+			fakeConfig.ChangeReasonReturns("CHG12345")
+		})
+
+		It("returns it without prompting", func() {
+			Expect(reasonErr).ToNot(HaveOccurred())
+			Expect(reason).To(Equal("CHG12345"))
+			Expect(testUI.Out).ToNot(Say("Change reason"))
+		})
+	})
+
+	When("no reason was given", func() {
+		When("the output is not a terminal", func() {
+			It("returns a ChangeReasonRequiredError", func() {
+				Expect(reasonErr).To(MatchError(translatableerror.ChangeReasonRequiredError{Header: "X-Change-Ticket"}))
+			})
+		})
+
+		When("the output is a terminal", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(true)
+			})
+
+			When("a reason is entered", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("CHG67890\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("prompts for the reason and keeps it for the rest of the command", func() {
+					Expect(reasonErr).ToNot(HaveOccurred())
+					Expect(reason).To(Equal("CHG67890"))
+					Expect(testUI.Out).To(Say("Change reason for X-Change-Ticket"))
+
+					Expect(fakeConfig.SetChangeReasonCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetChangeReasonArgsForCall(0)).To(Equal("CHG67890"))
+				})
+			})
+
+			When("no reason is entered", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns a ChangeReasonRequiredError", func() {
+					Expect(reasonErr).To(MatchError(translatableerror.ChangeReasonRequiredError{Header: "X-Change-Ticket"}))
+					Expect(fakeConfig.SetChangeReasonCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
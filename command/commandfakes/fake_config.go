@@ -30,6 +30,40 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AccessTokenExpiryStub        func() (time.Time, error)
+	accessTokenExpiryMutex       sync.RWMutex
+	accessTokenExpiryArgsForCall []struct {
+	}
+	accessTokenExpiryReturns struct {
+		result1 time.Time
+		result2 error
+	}
+	accessTokenExpiryReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
+	AccessTokenScopesStub        func() ([]string, error)
+	accessTokenScopesMutex       sync.RWMutex
+	accessTokenScopesArgsForCall []struct {
+	}
+	accessTokenScopesReturns struct {
+		result1 []string
+		result2 error
+	}
+	accessTokenScopesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	AccessibleStub        func() bool
+	accessibleMutex       sync.RWMutex
+	accessibleArgsForCall []struct {
+	}
+	accessibleReturns struct {
+		result1 bool
+	}
+	accessibleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	AddPluginStub        func(configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
@@ -41,6 +75,11 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	AddRecentJobStub        func(configv3.RecentJob)
+	addRecentJobMutex       sync.RWMutex
+	addRecentJobArgsForCall []struct {
+		arg1 configv3.RecentJob
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct {
@@ -81,6 +120,26 @@ type FakeConfig struct {
 	cFUsernameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ChangeHeaderStub        func() string
+	changeHeaderMutex       sync.RWMutex
+	changeHeaderArgsForCall []struct {
+	}
+	changeHeaderReturns struct {
+		result1 string
+	}
+	changeHeaderReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ChangeReasonStub        func() string
+	changeReasonMutex       sync.RWMutex
+	changeReasonArgsForCall []struct {
+	}
+	changeReasonReturns struct {
+		result1 string
+	}
+	changeReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -91,6 +150,16 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CommandDeadlineStub        func() time.Time
+	commandDeadlineMutex       sync.RWMutex
+	commandDeadlineArgsForCall []struct {
+	}
+	commandDeadlineReturns struct {
+		result1 time.Time
+	}
+	commandDeadlineReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -115,6 +184,26 @@ type FakeConfig struct {
 		result1 string
 		result2 error
 	}
+	DefaultOrganizationStub        func() string
+	defaultOrganizationMutex       sync.RWMutex
+	defaultOrganizationArgsForCall []struct {
+	}
+	defaultOrganizationReturns struct {
+		result1 string
+	}
+	defaultOrganizationReturnsOnCall map[int]struct {
+		result1 string
+	}
+	DefaultSpaceStub        func() string
+	defaultSpaceMutex       sync.RWMutex
+	defaultSpaceArgsForCall []struct {
+	}
+	defaultSpaceReturns struct {
+		result1 string
+	}
+	defaultSpaceReturnsOnCall map[int]struct {
+		result1 string
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct {
@@ -135,6 +224,16 @@ type FakeConfig struct {
 	dockerPasswordReturnsOnCall map[int]struct {
 		result1 string
 	}
+	DryRunStub        func() bool
+	dryRunMutex       sync.RWMutex
+	dryRunArgsForCall []struct {
+	}
+	dryRunReturns struct {
+		result1 bool
+	}
+	dryRunReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ExperimentalStub        func() bool
 	experimentalMutex       sync.RWMutex
 	experimentalArgsForCall []struct {
@@ -145,16 +244,30 @@ type FakeConfig struct {
 	experimentalReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	ExperimentalLoginStub        func() bool
-	experimentalLoginMutex       sync.RWMutex
-	experimentalLoginArgsForCall []struct {
+	FeatureEnabledStub        func(string) bool
+	featureEnabledMutex       sync.RWMutex
+	featureEnabledArgsForCall []struct {
+		arg1 string
 	}
-	experimentalLoginReturns struct {
+	featureEnabledReturns struct {
 		result1 bool
 	}
-	experimentalLoginReturnsOnCall map[int]struct {
+	featureEnabledReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	FoundationConfigStub        func(string) (*configv3.Config, error)
+	foundationConfigMutex       sync.RWMutex
+	foundationConfigArgsForCall []struct {
+		arg1 string
+	}
+	foundationConfigReturns struct {
+		result1 *configv3.Config
+		result2 error
+	}
+	foundationConfigReturnsOnCall map[int]struct {
+		result1 *configv3.Config
+		result2 error
+	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -181,6 +294,16 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	HTTPSProxyStub        func() string
+	hTTPSProxyMutex       sync.RWMutex
+	hTTPSProxyArgsForCall []struct {
+	}
+	hTTPSProxyReturns struct {
+		result1 string
+	}
+	hTTPSProxyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -201,6 +324,26 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	InsecureAllowedStub        func() bool
+	insecureAllowedMutex       sync.RWMutex
+	insecureAllowedArgsForCall []struct {
+	}
+	insecureAllowedReturns struct {
+		result1 bool
+	}
+	insecureAllowedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct {
+	}
+	isTTYReturns struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct {
@@ -211,6 +354,16 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogSourceStub        func() string
+	logSourceMutex       sync.RWMutex
+	logSourceArgsForCall []struct {
+	}
+	logSourceReturns struct {
+		result1 string
+	}
+	logSourceReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct {
@@ -221,6 +374,18 @@ type FakeConfig struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MinCLIVersionCheckStub        func() (configv3.MinCLIVersionCheck, bool)
+	minCLIVersionCheckMutex       sync.RWMutex
+	minCLIVersionCheckArgsForCall []struct {
+	}
+	minCLIVersionCheckReturns struct {
+		result1 configv3.MinCLIVersionCheck
+		result2 bool
+	}
+	minCLIVersionCheckReturnsOnCall map[int]struct {
+		result1 configv3.MinCLIVersionCheck
+		result2 bool
+	}
 	NOAARequestRetryCountStub        func() int
 	nOAARequestRetryCountMutex       sync.RWMutex
 	nOAARequestRetryCountArgsForCall []struct {
@@ -251,6 +416,16 @@ type FakeConfig struct {
 	pluginHomeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PluginPrecedenceStub        func() []string
+	pluginPrecedenceMutex       sync.RWMutex
+	pluginPrecedenceArgsForCall []struct {
+	}
+	pluginPrecedenceReturns struct {
+		result1 []string
+	}
+	pluginPrecedenceReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	PluginRepositoriesStub        func() []configv3.PluginRepository
 	pluginRepositoriesMutex       sync.RWMutex
 	pluginRepositoriesArgsForCall []struct {
@@ -281,6 +456,36 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PushScanHookStub        func() string
+	pushScanHookMutex       sync.RWMutex
+	pushScanHookArgsForCall []struct {
+	}
+	pushScanHookReturns struct {
+		result1 string
+	}
+	pushScanHookReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PushScanSkipAllowedStub        func() bool
+	pushScanSkipAllowedMutex       sync.RWMutex
+	pushScanSkipAllowedArgsForCall []struct {
+	}
+	pushScanSkipAllowedReturns struct {
+		result1 bool
+	}
+	pushScanSkipAllowedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RecentJobsStub        func() []configv3.RecentJob
+	recentJobsMutex       sync.RWMutex
+	recentJobsArgsForCall []struct {
+	}
+	recentJobsReturns struct {
+		result1 []configv3.RecentJob
+	}
+	recentJobsReturnsOnCall map[int]struct {
+		result1 []configv3.RecentJob
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -291,6 +496,16 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ReleaseCheckEnabledStub        func() bool
+	releaseCheckEnabledMutex       sync.RWMutex
+	releaseCheckEnabledArgsForCall []struct {
+	}
+	releaseCheckEnabledReturns struct {
+		result1 bool
+	}
+	releaseCheckEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
@@ -306,6 +521,26 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RouterCNAMEStub        func() string
+	routerCNAMEMutex       sync.RWMutex
+	routerCNAMEArgsForCall []struct {
+	}
+	routerCNAMEReturns struct {
+		result1 string
+	}
+	routerCNAMEReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RouterIPsStub        func() []string
+	routerIPsMutex       sync.RWMutex
+	routerIPsArgsForCall []struct {
+	}
+	routerIPsReturns struct {
+		result1 []string
+	}
+	routerIPsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -331,22 +566,113 @@ type FakeConfig struct {
 	setAccessTokenArgsForCall []struct {
 		arg1 string
 	}
+	SetAccessibleStub        func(bool)
+	setAccessibleMutex       sync.RWMutex
+	setAccessibleArgsForCall []struct {
+		arg1 bool
+	}
+	SetChangeHeaderStub        func(string)
+	setChangeHeaderMutex       sync.RWMutex
+	setChangeHeaderArgsForCall []struct {
+		arg1 string
+	}
+	SetChangeReasonStub        func(string)
+	setChangeReasonMutex       sync.RWMutex
+	setChangeReasonArgsForCall []struct {
+		arg1 string
+	}
+	SetCommandTimeoutStub        func(time.Duration)
+	setCommandTimeoutMutex       sync.RWMutex
+	setCommandTimeoutArgsForCall []struct {
+		arg1 time.Duration
+	}
+	SetDefaultOrganizationStub        func(string)
+	setDefaultOrganizationMutex       sync.RWMutex
+	setDefaultOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	SetDefaultSpaceStub        func(string)
+	setDefaultSpaceMutex       sync.RWMutex
+	setDefaultSpaceArgsForCall []struct {
+		arg1 string
+	}
+	SetFeatureEnabledStub        func(string, bool)
+	setFeatureEnabledMutex       sync.RWMutex
+	setFeatureEnabledArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	SetInsecureAllowedStub        func(bool)
+	setInsecureAllowedMutex       sync.RWMutex
+	setInsecureAllowedArgsForCall []struct {
+		arg1 bool
+	}
+	SetLogSourceStub        func(string)
+	setLogSourceMutex       sync.RWMutex
+	setLogSourceArgsForCall []struct {
+		arg1 string
+	}
 	SetMinCLIVersionStub        func(string)
 	setMinCLIVersionMutex       sync.RWMutex
 	setMinCLIVersionArgsForCall []struct {
 		arg1 string
 	}
+	SetMinCLIVersionCheckStub        func(configv3.MinCLIVersionCheck)
+	setMinCLIVersionCheckMutex       sync.RWMutex
+	setMinCLIVersionCheckArgsForCall []struct {
+		arg1 configv3.MinCLIVersionCheck
+	}
 	SetOrganizationInformationStub        func(string, string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
 		arg1 string
 		arg2 string
 	}
+	SetPluginMaxOutputStub        func(uint64)
+	setPluginMaxOutputMutex       sync.RWMutex
+	setPluginMaxOutputArgsForCall []struct {
+		arg1 uint64
+	}
+	SetPluginPrecedenceStub        func([]string)
+	setPluginPrecedenceMutex       sync.RWMutex
+	setPluginPrecedenceArgsForCall []struct {
+		arg1 []string
+	}
+	SetPluginTimeoutStub        func(time.Duration)
+	setPluginTimeoutMutex       sync.RWMutex
+	setPluginTimeoutArgsForCall []struct {
+		arg1 time.Duration
+	}
+	SetPushScanHookStub        func(string)
+	setPushScanHookMutex       sync.RWMutex
+	setPushScanHookArgsForCall []struct {
+		arg1 string
+	}
+	SetPushScanSkipAllowedStub        func(bool)
+	setPushScanSkipAllowedMutex       sync.RWMutex
+	setPushScanSkipAllowedArgsForCall []struct {
+		arg1 bool
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
 		arg1 string
 	}
+	SetReleaseCheckEnabledStub        func(bool)
+	setReleaseCheckEnabledMutex       sync.RWMutex
+	setReleaseCheckEnabledArgsForCall []struct {
+		arg1 bool
+	}
+	SetRouterCNAMEStub        func(string)
+	setRouterCNAMEMutex       sync.RWMutex
+	setRouterCNAMEArgsForCall []struct {
+		arg1 string
+	}
+	SetRouterIPsStub        func([]string)
+	setRouterIPsMutex       sync.RWMutex
+	setRouterIPsArgsForCall []struct {
+		arg1 []string
+	}
 	SetSpaceInformationStub        func(string, string, bool)
 	setSpaceInformationMutex       sync.RWMutex
 	setSpaceInformationArgsForCall []struct {
@@ -388,6 +714,21 @@ type FakeConfig struct {
 	setUAAGrantTypeArgsForCall []struct {
 		arg1 string
 	}
+	SetUsageStatsEnabledStub        func(bool)
+	setUsageStatsEnabledMutex       sync.RWMutex
+	setUsageStatsEnabledArgsForCall []struct {
+		arg1 bool
+	}
+	SetUsageStatsEndpointStub        func(string)
+	setUsageStatsEndpointMutex       sync.RWMutex
+	setUsageStatsEndpointArgsForCall []struct {
+		arg1 string
+	}
+	SetUserAgentSuffixStub        func(string)
+	setUserAgentSuffixMutex       sync.RWMutex
+	setUserAgentSuffixArgsForCall []struct {
+		arg1 string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct {
@@ -458,6 +799,16 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TimingsStub        func() bool
+	timingsMutex       sync.RWMutex
+	timingsArgsForCall []struct {
+	}
+	timingsReturns struct {
+		result1 bool
+	}
+	timingsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -510,6 +861,36 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UsageStatsEnabledStub        func() bool
+	usageStatsEnabledMutex       sync.RWMutex
+	usageStatsEnabledArgsForCall []struct {
+	}
+	usageStatsEnabledReturns struct {
+		result1 bool
+	}
+	usageStatsEnabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	UsageStatsEndpointStub        func() string
+	usageStatsEndpointMutex       sync.RWMutex
+	usageStatsEndpointArgsForCall []struct {
+	}
+	usageStatsEndpointReturns struct {
+		result1 string
+	}
+	usageStatsEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UserAgentSuffixStub        func() string
+	userAgentSuffixMutex       sync.RWMutex
+	userAgentSuffixArgsForCall []struct {
+	}
+	userAgentSuffixReturns struct {
+		result1 string
+	}
+	userAgentSuffixReturnsOnCall map[int]struct {
+		result1 string
+	}
 	V7SetSpaceInformationStub        func(string, string)
 	v7SetSpaceInformationMutex       sync.RWMutex
 	v7SetSpaceInformationArgsForCall []struct {
@@ -646,6 +1027,168 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AccessTokenExpiry() (time.Time, error) {
+	fake.accessTokenExpiryMutex.Lock()
+	ret, specificReturn := fake.accessTokenExpiryReturnsOnCall[len(fake.accessTokenExpiryArgsForCall)]
+	fake.accessTokenExpiryArgsForCall = append(fake.accessTokenExpiryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AccessTokenExpiry", []interface{}{})
+	fake.accessTokenExpiryMutex.Unlock()
+	if fake.AccessTokenExpiryStub != nil {
+		return fake.AccessTokenExpiryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.accessTokenExpiryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) AccessTokenExpiryCallCount() int {
+	fake.accessTokenExpiryMutex.RLock()
+	defer fake.accessTokenExpiryMutex.RUnlock()
+	return len(fake.accessTokenExpiryArgsForCall)
+}
+
+func (fake *FakeConfig) AccessTokenExpiryCalls(stub func() (time.Time, error)) {
+	fake.accessTokenExpiryMutex.Lock()
+	defer fake.accessTokenExpiryMutex.Unlock()
+	fake.AccessTokenExpiryStub = stub
+}
+
+func (fake *FakeConfig) AccessTokenExpiryReturns(result1 time.Time, result2 error) {
+	fake.accessTokenExpiryMutex.Lock()
+	defer fake.accessTokenExpiryMutex.Unlock()
+	fake.AccessTokenExpiryStub = nil
+	fake.accessTokenExpiryReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) AccessTokenExpiryReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.accessTokenExpiryMutex.Lock()
+	defer fake.accessTokenExpiryMutex.Unlock()
+	fake.AccessTokenExpiryStub = nil
+	if fake.accessTokenExpiryReturnsOnCall == nil {
+		fake.accessTokenExpiryReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.accessTokenExpiryReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) AccessTokenScopes() ([]string, error) {
+	fake.accessTokenScopesMutex.Lock()
+	ret, specificReturn := fake.accessTokenScopesReturnsOnCall[len(fake.accessTokenScopesArgsForCall)]
+	fake.accessTokenScopesArgsForCall = append(fake.accessTokenScopesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AccessTokenScopes", []interface{}{})
+	fake.accessTokenScopesMutex.Unlock()
+	if fake.AccessTokenScopesStub != nil {
+		return fake.AccessTokenScopesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.accessTokenScopesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) AccessTokenScopesCallCount() int {
+	fake.accessTokenScopesMutex.RLock()
+	defer fake.accessTokenScopesMutex.RUnlock()
+	return len(fake.accessTokenScopesArgsForCall)
+}
+
+func (fake *FakeConfig) AccessTokenScopesCalls(stub func() ([]string, error)) {
+	fake.accessTokenScopesMutex.Lock()
+	defer fake.accessTokenScopesMutex.Unlock()
+	fake.AccessTokenScopesStub = stub
+}
+
+func (fake *FakeConfig) AccessTokenScopesReturns(result1 []string, result2 error) {
+	fake.accessTokenScopesMutex.Lock()
+	defer fake.accessTokenScopesMutex.Unlock()
+	fake.AccessTokenScopesStub = nil
+	fake.accessTokenScopesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) AccessTokenScopesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.accessTokenScopesMutex.Lock()
+	defer fake.accessTokenScopesMutex.Unlock()
+	fake.AccessTokenScopesStub = nil
+	if fake.accessTokenScopesReturnsOnCall == nil {
+		fake.accessTokenScopesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.accessTokenScopesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) Accessible() bool {
+	fake.accessibleMutex.Lock()
+	ret, specificReturn := fake.accessibleReturnsOnCall[len(fake.accessibleArgsForCall)]
+	fake.accessibleArgsForCall = append(fake.accessibleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Accessible", []interface{}{})
+	fake.accessibleMutex.Unlock()
+	if fake.AccessibleStub != nil {
+		return fake.AccessibleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.accessibleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) AccessibleCallCount() int {
+	fake.accessibleMutex.RLock()
+	defer fake.accessibleMutex.RUnlock()
+	return len(fake.accessibleArgsForCall)
+}
+
+func (fake *FakeConfig) AccessibleCalls(stub func() bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = stub
+}
+
+func (fake *FakeConfig) AccessibleReturns(result1 bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = nil
+	fake.accessibleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) AccessibleReturnsOnCall(i int, result1 bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = nil
+	if fake.accessibleReturnsOnCall == nil {
+		fake.accessibleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.accessibleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginMutex.Lock()
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
@@ -709,6 +1252,37 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) AddRecentJob(arg1 configv3.RecentJob) {
+	fake.addRecentJobMutex.Lock()
+	fake.addRecentJobArgsForCall = append(fake.addRecentJobArgsForCall, struct {
+		arg1 configv3.RecentJob
+	}{arg1})
+	fake.recordInvocation("AddRecentJob", []interface{}{arg1})
+	fake.addRecentJobMutex.Unlock()
+	if fake.AddRecentJobStub != nil {
+		fake.AddRecentJobStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) AddRecentJobCallCount() int {
+	fake.addRecentJobMutex.RLock()
+	defer fake.addRecentJobMutex.RUnlock()
+	return len(fake.addRecentJobArgsForCall)
+}
+
+func (fake *FakeConfig) AddRecentJobCalls(stub func(configv3.RecentJob)) {
+	fake.addRecentJobMutex.Lock()
+	defer fake.addRecentJobMutex.Unlock()
+	fake.AddRecentJobStub = stub
+}
+
+func (fake *FakeConfig) AddRecentJobArgsForCall(i int) configv3.RecentJob {
+	fake.addRecentJobMutex.RLock()
+	defer fake.addRecentJobMutex.RUnlock()
+	argsForCall := fake.addRecentJobArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
@@ -917,38 +1491,142 @@ func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
-	fake.colorEnabledMutex.Lock()
-	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
-	fake.colorEnabledArgsForCall = append(fake.colorEnabledArgsForCall, struct {
+func (fake *FakeConfig) ChangeHeader() string {
+	fake.changeHeaderMutex.Lock()
+	ret, specificReturn := fake.changeHeaderReturnsOnCall[len(fake.changeHeaderArgsForCall)]
+	fake.changeHeaderArgsForCall = append(fake.changeHeaderArgsForCall, struct {
 	}{})
-	fake.recordInvocation("ColorEnabled", []interface{}{})
-	fake.colorEnabledMutex.Unlock()
-	if fake.ColorEnabledStub != nil {
-		return fake.ColorEnabledStub()
+	fake.recordInvocation("ChangeHeader", []interface{}{})
+	fake.changeHeaderMutex.Unlock()
+	if fake.ChangeHeaderStub != nil {
+		return fake.ChangeHeaderStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.colorEnabledReturns
+	fakeReturns := fake.changeHeaderReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) ColorEnabledCallCount() int {
-	fake.colorEnabledMutex.RLock()
-	defer fake.colorEnabledMutex.RUnlock()
-	return len(fake.colorEnabledArgsForCall)
+func (fake *FakeConfig) ChangeHeaderCallCount() int {
+	fake.changeHeaderMutex.RLock()
+	defer fake.changeHeaderMutex.RUnlock()
+	return len(fake.changeHeaderArgsForCall)
 }
 
-func (fake *FakeConfig) ColorEnabledCalls(stub func() configv3.ColorSetting) {
-	fake.colorEnabledMutex.Lock()
-	defer fake.colorEnabledMutex.Unlock()
-	fake.ColorEnabledStub = stub
+func (fake *FakeConfig) ChangeHeaderCalls(stub func() string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = stub
 }
 
-func (fake *FakeConfig) ColorEnabledReturns(result1 configv3.ColorSetting) {
-	fake.colorEnabledMutex.Lock()
-	defer fake.colorEnabledMutex.Unlock()
+func (fake *FakeConfig) ChangeHeaderReturns(result1 string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = nil
+	fake.changeHeaderReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ChangeHeaderReturnsOnCall(i int, result1 string) {
+	fake.changeHeaderMutex.Lock()
+	defer fake.changeHeaderMutex.Unlock()
+	fake.ChangeHeaderStub = nil
+	if fake.changeHeaderReturnsOnCall == nil {
+		fake.changeHeaderReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.changeHeaderReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ChangeReason() string {
+	fake.changeReasonMutex.Lock()
+	ret, specificReturn := fake.changeReasonReturnsOnCall[len(fake.changeReasonArgsForCall)]
+	fake.changeReasonArgsForCall = append(fake.changeReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ChangeReason", []interface{}{})
+	fake.changeReasonMutex.Unlock()
+	if fake.ChangeReasonStub != nil {
+		return fake.ChangeReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.changeReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ChangeReasonCallCount() int {
+	fake.changeReasonMutex.RLock()
+	defer fake.changeReasonMutex.RUnlock()
+	return len(fake.changeReasonArgsForCall)
+}
+
+func (fake *FakeConfig) ChangeReasonCalls(stub func() string) {
+	fake.changeReasonMutex.Lock()
+	defer fake.changeReasonMutex.Unlock()
+	fake.ChangeReasonStub = stub
+}
+
+func (fake *FakeConfig) ChangeReasonReturns(result1 string) {
+	fake.changeReasonMutex.Lock()
+	defer fake.changeReasonMutex.Unlock()
+	fake.ChangeReasonStub = nil
+	fake.changeReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ChangeReasonReturnsOnCall(i int, result1 string) {
+	fake.changeReasonMutex.Lock()
+	defer fake.changeReasonMutex.Unlock()
+	fake.ChangeReasonStub = nil
+	if fake.changeReasonReturnsOnCall == nil {
+		fake.changeReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.changeReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
+	fake.colorEnabledMutex.Lock()
+	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
+	fake.colorEnabledArgsForCall = append(fake.colorEnabledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ColorEnabled", []interface{}{})
+	fake.colorEnabledMutex.Unlock()
+	if fake.ColorEnabledStub != nil {
+		return fake.ColorEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.colorEnabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ColorEnabledCallCount() int {
+	fake.colorEnabledMutex.RLock()
+	defer fake.colorEnabledMutex.RUnlock()
+	return len(fake.colorEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) ColorEnabledCalls(stub func() configv3.ColorSetting) {
+	fake.colorEnabledMutex.Lock()
+	defer fake.colorEnabledMutex.Unlock()
+	fake.ColorEnabledStub = stub
+}
+
+func (fake *FakeConfig) ColorEnabledReturns(result1 configv3.ColorSetting) {
+	fake.colorEnabledMutex.Lock()
+	defer fake.colorEnabledMutex.Unlock()
 	fake.ColorEnabledStub = nil
 	fake.colorEnabledReturns = struct {
 		result1 configv3.ColorSetting
@@ -969,6 +1647,58 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CommandDeadline() time.Time {
+	fake.commandDeadlineMutex.Lock()
+	ret, specificReturn := fake.commandDeadlineReturnsOnCall[len(fake.commandDeadlineArgsForCall)]
+	fake.commandDeadlineArgsForCall = append(fake.commandDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CommandDeadline", []interface{}{})
+	fake.commandDeadlineMutex.Unlock()
+	if fake.CommandDeadlineStub != nil {
+		return fake.CommandDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.commandDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CommandDeadlineCallCount() int {
+	fake.commandDeadlineMutex.RLock()
+	defer fake.commandDeadlineMutex.RUnlock()
+	return len(fake.commandDeadlineArgsForCall)
+}
+
+func (fake *FakeConfig) CommandDeadlineCalls(stub func() time.Time) {
+	fake.commandDeadlineMutex.Lock()
+	defer fake.commandDeadlineMutex.Unlock()
+	fake.CommandDeadlineStub = stub
+}
+
+func (fake *FakeConfig) CommandDeadlineReturns(result1 time.Time) {
+	fake.commandDeadlineMutex.Lock()
+	defer fake.commandDeadlineMutex.Unlock()
+	fake.CommandDeadlineStub = nil
+	fake.commandDeadlineReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeConfig) CommandDeadlineReturnsOnCall(i int, result1 time.Time) {
+	fake.commandDeadlineMutex.Lock()
+	defer fake.commandDeadlineMutex.Unlock()
+	fake.CommandDeadlineStub = nil
+	if fake.commandDeadlineReturnsOnCall == nil {
+		fake.commandDeadlineReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.commandDeadlineReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1079,6 +1809,110 @@ func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DefaultOrganization() string {
+	fake.defaultOrganizationMutex.Lock()
+	ret, specificReturn := fake.defaultOrganizationReturnsOnCall[len(fake.defaultOrganizationArgsForCall)]
+	fake.defaultOrganizationArgsForCall = append(fake.defaultOrganizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DefaultOrganization", []interface{}{})
+	fake.defaultOrganizationMutex.Unlock()
+	if fake.DefaultOrganizationStub != nil {
+		return fake.DefaultOrganizationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.defaultOrganizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) DefaultOrganizationCallCount() int {
+	fake.defaultOrganizationMutex.RLock()
+	defer fake.defaultOrganizationMutex.RUnlock()
+	return len(fake.defaultOrganizationArgsForCall)
+}
+
+func (fake *FakeConfig) DefaultOrganizationCalls(stub func() string) {
+	fake.defaultOrganizationMutex.Lock()
+	defer fake.defaultOrganizationMutex.Unlock()
+	fake.DefaultOrganizationStub = stub
+}
+
+func (fake *FakeConfig) DefaultOrganizationReturns(result1 string) {
+	fake.defaultOrganizationMutex.Lock()
+	defer fake.defaultOrganizationMutex.Unlock()
+	fake.DefaultOrganizationStub = nil
+	fake.defaultOrganizationReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DefaultOrganizationReturnsOnCall(i int, result1 string) {
+	fake.defaultOrganizationMutex.Lock()
+	defer fake.defaultOrganizationMutex.Unlock()
+	fake.DefaultOrganizationStub = nil
+	if fake.defaultOrganizationReturnsOnCall == nil {
+		fake.defaultOrganizationReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.defaultOrganizationReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DefaultSpace() string {
+	fake.defaultSpaceMutex.Lock()
+	ret, specificReturn := fake.defaultSpaceReturnsOnCall[len(fake.defaultSpaceArgsForCall)]
+	fake.defaultSpaceArgsForCall = append(fake.defaultSpaceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DefaultSpace", []interface{}{})
+	fake.defaultSpaceMutex.Unlock()
+	if fake.DefaultSpaceStub != nil {
+		return fake.DefaultSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.defaultSpaceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) DefaultSpaceCallCount() int {
+	fake.defaultSpaceMutex.RLock()
+	defer fake.defaultSpaceMutex.RUnlock()
+	return len(fake.defaultSpaceArgsForCall)
+}
+
+func (fake *FakeConfig) DefaultSpaceCalls(stub func() string) {
+	fake.defaultSpaceMutex.Lock()
+	defer fake.defaultSpaceMutex.Unlock()
+	fake.DefaultSpaceStub = stub
+}
+
+func (fake *FakeConfig) DefaultSpaceReturns(result1 string) {
+	fake.defaultSpaceMutex.Lock()
+	defer fake.defaultSpaceMutex.Unlock()
+	fake.DefaultSpaceStub = nil
+	fake.defaultSpaceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DefaultSpaceReturnsOnCall(i int, result1 string) {
+	fake.defaultSpaceMutex.Lock()
+	defer fake.defaultSpaceMutex.Unlock()
+	fake.DefaultSpaceStub = nil
+	if fake.defaultSpaceReturnsOnCall == nil {
+		fake.defaultSpaceReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.defaultSpaceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1183,6 +2017,58 @@ func (fake *FakeConfig) DockerPasswordReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) DryRun() bool {
+	fake.dryRunMutex.Lock()
+	ret, specificReturn := fake.dryRunReturnsOnCall[len(fake.dryRunArgsForCall)]
+	fake.dryRunArgsForCall = append(fake.dryRunArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DryRun", []interface{}{})
+	fake.dryRunMutex.Unlock()
+	if fake.DryRunStub != nil {
+		return fake.DryRunStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.dryRunReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) DryRunCallCount() int {
+	fake.dryRunMutex.RLock()
+	defer fake.dryRunMutex.RUnlock()
+	return len(fake.dryRunArgsForCall)
+}
+
+func (fake *FakeConfig) DryRunCalls(stub func() bool) {
+	fake.dryRunMutex.Lock()
+	defer fake.dryRunMutex.Unlock()
+	fake.DryRunStub = stub
+}
+
+func (fake *FakeConfig) DryRunReturns(result1 bool) {
+	fake.dryRunMutex.Lock()
+	defer fake.dryRunMutex.Unlock()
+	fake.DryRunStub = nil
+	fake.dryRunReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) DryRunReturnsOnCall(i int, result1 bool) {
+	fake.dryRunMutex.Lock()
+	defer fake.dryRunMutex.Unlock()
+	fake.DryRunStub = nil
+	if fake.dryRunReturnsOnCall == nil {
+		fake.dryRunReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.dryRunReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Experimental() bool {
 	fake.experimentalMutex.Lock()
 	ret, specificReturn := fake.experimentalReturnsOnCall[len(fake.experimentalArgsForCall)]
@@ -1235,58 +2121,129 @@ func (fake *FakeConfig) ExperimentalReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeConfig) ExperimentalLogin() bool {
-	fake.experimentalLoginMutex.Lock()
-	ret, specificReturn := fake.experimentalLoginReturnsOnCall[len(fake.experimentalLoginArgsForCall)]
-	fake.experimentalLoginArgsForCall = append(fake.experimentalLoginArgsForCall, struct {
-	}{})
-	fake.recordInvocation("ExperimentalLogin", []interface{}{})
-	fake.experimentalLoginMutex.Unlock()
-	if fake.ExperimentalLoginStub != nil {
-		return fake.ExperimentalLoginStub()
+func (fake *FakeConfig) FeatureEnabled(arg1 string) bool {
+	fake.featureEnabledMutex.Lock()
+	ret, specificReturn := fake.featureEnabledReturnsOnCall[len(fake.featureEnabledArgsForCall)]
+	fake.featureEnabledArgsForCall = append(fake.featureEnabledArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FeatureEnabled", []interface{}{arg1})
+	fake.featureEnabledMutex.Unlock()
+	if fake.FeatureEnabledStub != nil {
+		return fake.FeatureEnabledStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.experimentalLoginReturns
+	fakeReturns := fake.featureEnabledReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) ExperimentalLoginCallCount() int {
-	fake.experimentalLoginMutex.RLock()
-	defer fake.experimentalLoginMutex.RUnlock()
-	return len(fake.experimentalLoginArgsForCall)
+func (fake *FakeConfig) FeatureEnabledCallCount() int {
+	fake.featureEnabledMutex.RLock()
+	defer fake.featureEnabledMutex.RUnlock()
+	return len(fake.featureEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) FeatureEnabledCalls(stub func(string) bool) {
+	fake.featureEnabledMutex.Lock()
+	defer fake.featureEnabledMutex.Unlock()
+	fake.FeatureEnabledStub = stub
 }
 
-func (fake *FakeConfig) ExperimentalLoginCalls(stub func() bool) {
-	fake.experimentalLoginMutex.Lock()
-	defer fake.experimentalLoginMutex.Unlock()
-	fake.ExperimentalLoginStub = stub
+func (fake *FakeConfig) FeatureEnabledArgsForCall(i int) string {
+	fake.featureEnabledMutex.RLock()
+	defer fake.featureEnabledMutex.RUnlock()
+	argsForCall := fake.featureEnabledArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeConfig) ExperimentalLoginReturns(result1 bool) {
-	fake.experimentalLoginMutex.Lock()
-	defer fake.experimentalLoginMutex.Unlock()
-	fake.ExperimentalLoginStub = nil
-	fake.experimentalLoginReturns = struct {
+func (fake *FakeConfig) FeatureEnabledReturns(result1 bool) {
+	fake.featureEnabledMutex.Lock()
+	defer fake.featureEnabledMutex.Unlock()
+	fake.FeatureEnabledStub = nil
+	fake.featureEnabledReturns = struct {
 		result1 bool
 	}{result1}
 }
 
-func (fake *FakeConfig) ExperimentalLoginReturnsOnCall(i int, result1 bool) {
-	fake.experimentalLoginMutex.Lock()
-	defer fake.experimentalLoginMutex.Unlock()
-	fake.ExperimentalLoginStub = nil
-	if fake.experimentalLoginReturnsOnCall == nil {
-		fake.experimentalLoginReturnsOnCall = make(map[int]struct {
+func (fake *FakeConfig) FeatureEnabledReturnsOnCall(i int, result1 bool) {
+	fake.featureEnabledMutex.Lock()
+	defer fake.featureEnabledMutex.Unlock()
+	fake.FeatureEnabledStub = nil
+	if fake.featureEnabledReturnsOnCall == nil {
+		fake.featureEnabledReturnsOnCall = make(map[int]struct {
 			result1 bool
 		})
 	}
-	fake.experimentalLoginReturnsOnCall[i] = struct {
+	fake.featureEnabledReturnsOnCall[i] = struct {
 		result1 bool
 	}{result1}
 }
 
+func (fake *FakeConfig) FoundationConfig(arg1 string) (*configv3.Config, error) {
+	fake.foundationConfigMutex.Lock()
+	ret, specificReturn := fake.foundationConfigReturnsOnCall[len(fake.foundationConfigArgsForCall)]
+	fake.foundationConfigArgsForCall = append(fake.foundationConfigArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FoundationConfig", []interface{}{arg1})
+	fake.foundationConfigMutex.Unlock()
+	if fake.FoundationConfigStub != nil {
+		return fake.FoundationConfigStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.foundationConfigReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) FoundationConfigCallCount() int {
+	fake.foundationConfigMutex.RLock()
+	defer fake.foundationConfigMutex.RUnlock()
+	return len(fake.foundationConfigArgsForCall)
+}
+
+func (fake *FakeConfig) FoundationConfigCalls(stub func(string) (*configv3.Config, error)) {
+	fake.foundationConfigMutex.Lock()
+	defer fake.foundationConfigMutex.Unlock()
+	fake.FoundationConfigStub = stub
+}
+
+func (fake *FakeConfig) FoundationConfigArgsForCall(i int) string {
+	fake.foundationConfigMutex.RLock()
+	defer fake.foundationConfigMutex.RUnlock()
+	argsForCall := fake.foundationConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) FoundationConfigReturns(result1 *configv3.Config, result2 error) {
+	fake.foundationConfigMutex.Lock()
+	defer fake.foundationConfigMutex.Unlock()
+	fake.FoundationConfigStub = nil
+	fake.foundationConfigReturns = struct {
+		result1 *configv3.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) FoundationConfigReturnsOnCall(i int, result1 *configv3.Config, result2 error) {
+	fake.foundationConfigMutex.Lock()
+	defer fake.foundationConfigMutex.Unlock()
+	fake.FoundationConfigStub = nil
+	if fake.foundationConfigReturnsOnCall == nil {
+		fake.foundationConfigReturnsOnCall = make(map[int]struct {
+			result1 *configv3.Config
+			result2 error
+		})
+	}
+	fake.foundationConfigReturnsOnCall[i] = struct {
+		result1 *configv3.Config
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -1413,6 +2370,58 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) HTTPSProxy() string {
+	fake.hTTPSProxyMutex.Lock()
+	ret, specificReturn := fake.hTTPSProxyReturnsOnCall[len(fake.hTTPSProxyArgsForCall)]
+	fake.hTTPSProxyArgsForCall = append(fake.hTTPSProxyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HTTPSProxy", []interface{}{})
+	fake.hTTPSProxyMutex.Unlock()
+	if fake.HTTPSProxyStub != nil {
+		return fake.HTTPSProxyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hTTPSProxyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HTTPSProxyCallCount() int {
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
+	return len(fake.hTTPSProxyArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPSProxyCalls(stub func() string) {
+	fake.hTTPSProxyMutex.Lock()
+	defer fake.hTTPSProxyMutex.Unlock()
+	fake.HTTPSProxyStub = stub
+}
+
+func (fake *FakeConfig) HTTPSProxyReturns(result1 string) {
+	fake.hTTPSProxyMutex.Lock()
+	defer fake.hTTPSProxyMutex.Unlock()
+	fake.HTTPSProxyStub = nil
+	fake.hTTPSProxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPSProxyReturnsOnCall(i int, result1 string) {
+	fake.hTTPSProxyMutex.Lock()
+	defer fake.hTTPSProxyMutex.Unlock()
+	fake.HTTPSProxyStub = nil
+	if fake.hTTPSProxyReturnsOnCall == nil {
+		fake.hTTPSProxyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hTTPSProxyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -1517,76 +2526,232 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeConfig) Locale() string {
-	fake.localeMutex.Lock()
-	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
-	fake.localeArgsForCall = append(fake.localeArgsForCall, struct {
+func (fake *FakeConfig) InsecureAllowed() bool {
+	fake.insecureAllowedMutex.Lock()
+	ret, specificReturn := fake.insecureAllowedReturnsOnCall[len(fake.insecureAllowedArgsForCall)]
+	fake.insecureAllowedArgsForCall = append(fake.insecureAllowedArgsForCall, struct {
 	}{})
-	fake.recordInvocation("Locale", []interface{}{})
-	fake.localeMutex.Unlock()
-	if fake.LocaleStub != nil {
-		return fake.LocaleStub()
+	fake.recordInvocation("InsecureAllowed", []interface{}{})
+	fake.insecureAllowedMutex.Unlock()
+	if fake.InsecureAllowedStub != nil {
+		return fake.InsecureAllowedStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.localeReturns
+	fakeReturns := fake.insecureAllowedReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) LocaleCallCount() int {
-	fake.localeMutex.RLock()
-	defer fake.localeMutex.RUnlock()
-	return len(fake.localeArgsForCall)
+func (fake *FakeConfig) InsecureAllowedCallCount() int {
+	fake.insecureAllowedMutex.RLock()
+	defer fake.insecureAllowedMutex.RUnlock()
+	return len(fake.insecureAllowedArgsForCall)
 }
 
-func (fake *FakeConfig) LocaleCalls(stub func() string) {
-	fake.localeMutex.Lock()
-	defer fake.localeMutex.Unlock()
-	fake.LocaleStub = stub
+func (fake *FakeConfig) InsecureAllowedCalls(stub func() bool) {
+	fake.insecureAllowedMutex.Lock()
+	defer fake.insecureAllowedMutex.Unlock()
+	fake.InsecureAllowedStub = stub
 }
 
-func (fake *FakeConfig) LocaleReturns(result1 string) {
-	fake.localeMutex.Lock()
-	defer fake.localeMutex.Unlock()
-	fake.LocaleStub = nil
-	fake.localeReturns = struct {
-		result1 string
+func (fake *FakeConfig) InsecureAllowedReturns(result1 bool) {
+	fake.insecureAllowedMutex.Lock()
+	defer fake.insecureAllowedMutex.Unlock()
+	fake.InsecureAllowedStub = nil
+	fake.insecureAllowedReturns = struct {
+		result1 bool
 	}{result1}
 }
 
-func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
-	fake.localeMutex.Lock()
-	defer fake.localeMutex.Unlock()
-	fake.LocaleStub = nil
-	if fake.localeReturnsOnCall == nil {
-		fake.localeReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeConfig) InsecureAllowedReturnsOnCall(i int, result1 bool) {
+	fake.insecureAllowedMutex.Lock()
+	defer fake.insecureAllowedMutex.Unlock()
+	fake.InsecureAllowedStub = nil
+	if fake.insecureAllowedReturnsOnCall == nil {
+		fake.insecureAllowedReturnsOnCall = make(map[int]struct {
+			result1 bool
 		})
 	}
-	fake.localeReturnsOnCall[i] = struct {
-		result1 string
+	fake.insecureAllowedReturnsOnCall[i] = struct {
+		result1 bool
 	}{result1}
 }
 
-func (fake *FakeConfig) MinCLIVersion() string {
-	fake.minCLIVersionMutex.Lock()
-	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
-	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct {
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct {
 	}{})
-	fake.recordInvocation("MinCLIVersion", []interface{}{})
-	fake.minCLIVersionMutex.Unlock()
-	if fake.MinCLIVersionStub != nil {
-		return fake.MinCLIVersionStub()
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.minCLIVersionReturns
+	fakeReturns := fake.isTTYReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) MinCLIVersionCallCount() int {
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYCalls(stub func() bool) {
+	fake.isTTYMutex.Lock()
+	defer fake.isTTYMutex.Unlock()
+	fake.IsTTYStub = stub
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.isTTYMutex.Lock()
+	defer fake.isTTYMutex.Unlock()
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.isTTYMutex.Lock()
+	defer fake.isTTYMutex.Unlock()
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) Locale() string {
+	fake.localeMutex.Lock()
+	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
+	fake.localeArgsForCall = append(fake.localeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Locale", []interface{}{})
+	fake.localeMutex.Unlock()
+	if fake.LocaleStub != nil {
+		return fake.LocaleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.localeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) LocaleCallCount() int {
+	fake.localeMutex.RLock()
+	defer fake.localeMutex.RUnlock()
+	return len(fake.localeArgsForCall)
+}
+
+func (fake *FakeConfig) LocaleCalls(stub func() string) {
+	fake.localeMutex.Lock()
+	defer fake.localeMutex.Unlock()
+	fake.LocaleStub = stub
+}
+
+func (fake *FakeConfig) LocaleReturns(result1 string) {
+	fake.localeMutex.Lock()
+	defer fake.localeMutex.Unlock()
+	fake.LocaleStub = nil
+	fake.localeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
+	fake.localeMutex.Lock()
+	defer fake.localeMutex.Unlock()
+	fake.LocaleStub = nil
+	if fake.localeReturnsOnCall == nil {
+		fake.localeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.localeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LogSource() string {
+	fake.logSourceMutex.Lock()
+	ret, specificReturn := fake.logSourceReturnsOnCall[len(fake.logSourceArgsForCall)]
+	fake.logSourceArgsForCall = append(fake.logSourceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LogSource", []interface{}{})
+	fake.logSourceMutex.Unlock()
+	if fake.LogSourceStub != nil {
+		return fake.LogSourceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.logSourceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) LogSourceCallCount() int {
+	fake.logSourceMutex.RLock()
+	defer fake.logSourceMutex.RUnlock()
+	return len(fake.logSourceArgsForCall)
+}
+
+func (fake *FakeConfig) LogSourceCalls(stub func() string) {
+	fake.logSourceMutex.Lock()
+	defer fake.logSourceMutex.Unlock()
+	fake.LogSourceStub = stub
+}
+
+func (fake *FakeConfig) LogSourceReturns(result1 string) {
+	fake.logSourceMutex.Lock()
+	defer fake.logSourceMutex.Unlock()
+	fake.LogSourceStub = nil
+	fake.logSourceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) LogSourceReturnsOnCall(i int, result1 string) {
+	fake.logSourceMutex.Lock()
+	defer fake.logSourceMutex.Unlock()
+	fake.LogSourceStub = nil
+	if fake.logSourceReturnsOnCall == nil {
+		fake.logSourceReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logSourceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) MinCLIVersion() string {
+	fake.minCLIVersionMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
+	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MinCLIVersion", []interface{}{})
+	fake.minCLIVersionMutex.Unlock()
+	if fake.MinCLIVersionStub != nil {
+		return fake.MinCLIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.minCLIVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) MinCLIVersionCallCount() int {
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	return len(fake.minCLIVersionArgsForCall)
@@ -1621,6 +2786,61 @@ func (fake *FakeConfig) MinCLIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) MinCLIVersionCheck() (configv3.MinCLIVersionCheck, bool) {
+	fake.minCLIVersionCheckMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionCheckReturnsOnCall[len(fake.minCLIVersionCheckArgsForCall)]
+	fake.minCLIVersionCheckArgsForCall = append(fake.minCLIVersionCheckArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MinCLIVersionCheck", []interface{}{})
+	fake.minCLIVersionCheckMutex.Unlock()
+	if fake.MinCLIVersionCheckStub != nil {
+		return fake.MinCLIVersionCheckStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.minCLIVersionCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) MinCLIVersionCheckCallCount() int {
+	fake.minCLIVersionCheckMutex.RLock()
+	defer fake.minCLIVersionCheckMutex.RUnlock()
+	return len(fake.minCLIVersionCheckArgsForCall)
+}
+
+func (fake *FakeConfig) MinCLIVersionCheckCalls(stub func() (configv3.MinCLIVersionCheck, bool)) {
+	fake.minCLIVersionCheckMutex.Lock()
+	defer fake.minCLIVersionCheckMutex.Unlock()
+	fake.MinCLIVersionCheckStub = stub
+}
+
+func (fake *FakeConfig) MinCLIVersionCheckReturns(result1 configv3.MinCLIVersionCheck, result2 bool) {
+	fake.minCLIVersionCheckMutex.Lock()
+	defer fake.minCLIVersionCheckMutex.Unlock()
+	fake.MinCLIVersionCheckStub = nil
+	fake.minCLIVersionCheckReturns = struct {
+		result1 configv3.MinCLIVersionCheck
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) MinCLIVersionCheckReturnsOnCall(i int, result1 configv3.MinCLIVersionCheck, result2 bool) {
+	fake.minCLIVersionCheckMutex.Lock()
+	defer fake.minCLIVersionCheckMutex.Unlock()
+	fake.MinCLIVersionCheckStub = nil
+	if fake.minCLIVersionCheckReturnsOnCall == nil {
+		fake.minCLIVersionCheckReturnsOnCall = make(map[int]struct {
+			result1 configv3.MinCLIVersionCheck
+			result2 bool
+		})
+	}
+	fake.minCLIVersionCheckReturnsOnCall[i] = struct {
+		result1 configv3.MinCLIVersionCheck
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) NOAARequestRetryCount() int {
 	fake.nOAARequestRetryCountMutex.Lock()
 	ret, specificReturn := fake.nOAARequestRetryCountReturnsOnCall[len(fake.nOAARequestRetryCountArgsForCall)]
@@ -1777,6 +2997,58 @@ func (fake *FakeConfig) PluginHomeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginPrecedence() []string {
+	fake.pluginPrecedenceMutex.Lock()
+	ret, specificReturn := fake.pluginPrecedenceReturnsOnCall[len(fake.pluginPrecedenceArgsForCall)]
+	fake.pluginPrecedenceArgsForCall = append(fake.pluginPrecedenceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PluginPrecedence", []interface{}{})
+	fake.pluginPrecedenceMutex.Unlock()
+	if fake.PluginPrecedenceStub != nil {
+		return fake.PluginPrecedenceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pluginPrecedenceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginPrecedenceCallCount() int {
+	fake.pluginPrecedenceMutex.RLock()
+	defer fake.pluginPrecedenceMutex.RUnlock()
+	return len(fake.pluginPrecedenceArgsForCall)
+}
+
+func (fake *FakeConfig) PluginPrecedenceCalls(stub func() []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = stub
+}
+
+func (fake *FakeConfig) PluginPrecedenceReturns(result1 []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = nil
+	fake.pluginPrecedenceReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginPrecedenceReturnsOnCall(i int, result1 []string) {
+	fake.pluginPrecedenceMutex.Lock()
+	defer fake.pluginPrecedenceMutex.Unlock()
+	fake.PluginPrecedenceStub = nil
+	if fake.pluginPrecedenceReturnsOnCall == nil {
+		fake.pluginPrecedenceReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.pluginPrecedenceReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginRepositories() []configv3.PluginRepository {
 	fake.pluginRepositoriesMutex.Lock()
 	ret, specificReturn := fake.pluginRepositoriesReturnsOnCall[len(fake.pluginRepositoriesArgsForCall)]
@@ -1933,6 +3205,162 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) PushScanHook() string {
+	fake.pushScanHookMutex.Lock()
+	ret, specificReturn := fake.pushScanHookReturnsOnCall[len(fake.pushScanHookArgsForCall)]
+	fake.pushScanHookArgsForCall = append(fake.pushScanHookArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PushScanHook", []interface{}{})
+	fake.pushScanHookMutex.Unlock()
+	if fake.PushScanHookStub != nil {
+		return fake.PushScanHookStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pushScanHookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PushScanHookCallCount() int {
+	fake.pushScanHookMutex.RLock()
+	defer fake.pushScanHookMutex.RUnlock()
+	return len(fake.pushScanHookArgsForCall)
+}
+
+func (fake *FakeConfig) PushScanHookCalls(stub func() string) {
+	fake.pushScanHookMutex.Lock()
+	defer fake.pushScanHookMutex.Unlock()
+	fake.PushScanHookStub = stub
+}
+
+func (fake *FakeConfig) PushScanHookReturns(result1 string) {
+	fake.pushScanHookMutex.Lock()
+	defer fake.pushScanHookMutex.Unlock()
+	fake.PushScanHookStub = nil
+	fake.pushScanHookReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PushScanHookReturnsOnCall(i int, result1 string) {
+	fake.pushScanHookMutex.Lock()
+	defer fake.pushScanHookMutex.Unlock()
+	fake.PushScanHookStub = nil
+	if fake.pushScanHookReturnsOnCall == nil {
+		fake.pushScanHookReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pushScanHookReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PushScanSkipAllowed() bool {
+	fake.pushScanSkipAllowedMutex.Lock()
+	ret, specificReturn := fake.pushScanSkipAllowedReturnsOnCall[len(fake.pushScanSkipAllowedArgsForCall)]
+	fake.pushScanSkipAllowedArgsForCall = append(fake.pushScanSkipAllowedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PushScanSkipAllowed", []interface{}{})
+	fake.pushScanSkipAllowedMutex.Unlock()
+	if fake.PushScanSkipAllowedStub != nil {
+		return fake.PushScanSkipAllowedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pushScanSkipAllowedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PushScanSkipAllowedCallCount() int {
+	fake.pushScanSkipAllowedMutex.RLock()
+	defer fake.pushScanSkipAllowedMutex.RUnlock()
+	return len(fake.pushScanSkipAllowedArgsForCall)
+}
+
+func (fake *FakeConfig) PushScanSkipAllowedCalls(stub func() bool) {
+	fake.pushScanSkipAllowedMutex.Lock()
+	defer fake.pushScanSkipAllowedMutex.Unlock()
+	fake.PushScanSkipAllowedStub = stub
+}
+
+func (fake *FakeConfig) PushScanSkipAllowedReturns(result1 bool) {
+	fake.pushScanSkipAllowedMutex.Lock()
+	defer fake.pushScanSkipAllowedMutex.Unlock()
+	fake.PushScanSkipAllowedStub = nil
+	fake.pushScanSkipAllowedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) PushScanSkipAllowedReturnsOnCall(i int, result1 bool) {
+	fake.pushScanSkipAllowedMutex.Lock()
+	defer fake.pushScanSkipAllowedMutex.Unlock()
+	fake.PushScanSkipAllowedStub = nil
+	if fake.pushScanSkipAllowedReturnsOnCall == nil {
+		fake.pushScanSkipAllowedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pushScanSkipAllowedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RecentJobs() []configv3.RecentJob {
+	fake.recentJobsMutex.Lock()
+	ret, specificReturn := fake.recentJobsReturnsOnCall[len(fake.recentJobsArgsForCall)]
+	fake.recentJobsArgsForCall = append(fake.recentJobsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RecentJobs", []interface{}{})
+	fake.recentJobsMutex.Unlock()
+	if fake.RecentJobsStub != nil {
+		return fake.RecentJobsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recentJobsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RecentJobsCallCount() int {
+	fake.recentJobsMutex.RLock()
+	defer fake.recentJobsMutex.RUnlock()
+	return len(fake.recentJobsArgsForCall)
+}
+
+func (fake *FakeConfig) RecentJobsCalls(stub func() []configv3.RecentJob) {
+	fake.recentJobsMutex.Lock()
+	defer fake.recentJobsMutex.Unlock()
+	fake.RecentJobsStub = stub
+}
+
+func (fake *FakeConfig) RecentJobsReturns(result1 []configv3.RecentJob) {
+	fake.recentJobsMutex.Lock()
+	defer fake.recentJobsMutex.Unlock()
+	fake.RecentJobsStub = nil
+	fake.recentJobsReturns = struct {
+		result1 []configv3.RecentJob
+	}{result1}
+}
+
+func (fake *FakeConfig) RecentJobsReturnsOnCall(i int, result1 []configv3.RecentJob) {
+	fake.recentJobsMutex.Lock()
+	defer fake.recentJobsMutex.Unlock()
+	fake.RecentJobsStub = nil
+	if fake.recentJobsReturnsOnCall == nil {
+		fake.recentJobsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.RecentJob
+		})
+	}
+	fake.recentJobsReturnsOnCall[i] = struct {
+		result1 []configv3.RecentJob
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1985,6 +3413,58 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ReleaseCheckEnabled() bool {
+	fake.releaseCheckEnabledMutex.Lock()
+	ret, specificReturn := fake.releaseCheckEnabledReturnsOnCall[len(fake.releaseCheckEnabledArgsForCall)]
+	fake.releaseCheckEnabledArgsForCall = append(fake.releaseCheckEnabledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReleaseCheckEnabled", []interface{}{})
+	fake.releaseCheckEnabledMutex.Unlock()
+	if fake.ReleaseCheckEnabledStub != nil {
+		return fake.ReleaseCheckEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseCheckEnabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ReleaseCheckEnabledCallCount() int {
+	fake.releaseCheckEnabledMutex.RLock()
+	defer fake.releaseCheckEnabledMutex.RUnlock()
+	return len(fake.releaseCheckEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) ReleaseCheckEnabledCalls(stub func() bool) {
+	fake.releaseCheckEnabledMutex.Lock()
+	defer fake.releaseCheckEnabledMutex.Unlock()
+	fake.ReleaseCheckEnabledStub = stub
+}
+
+func (fake *FakeConfig) ReleaseCheckEnabledReturns(result1 bool) {
+	fake.releaseCheckEnabledMutex.Lock()
+	defer fake.releaseCheckEnabledMutex.Unlock()
+	fake.ReleaseCheckEnabledStub = nil
+	fake.releaseCheckEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ReleaseCheckEnabledReturnsOnCall(i int, result1 bool) {
+	fake.releaseCheckEnabledMutex.Lock()
+	defer fake.releaseCheckEnabledMutex.Unlock()
+	fake.ReleaseCheckEnabledStub = nil
+	if fake.releaseCheckEnabledReturnsOnCall == nil {
+		fake.releaseCheckEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.releaseCheckEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -2068,6 +3548,110 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RouterCNAME() string {
+	fake.routerCNAMEMutex.Lock()
+	ret, specificReturn := fake.routerCNAMEReturnsOnCall[len(fake.routerCNAMEArgsForCall)]
+	fake.routerCNAMEArgsForCall = append(fake.routerCNAMEArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RouterCNAME", []interface{}{})
+	fake.routerCNAMEMutex.Unlock()
+	if fake.RouterCNAMEStub != nil {
+		return fake.RouterCNAMEStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.routerCNAMEReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RouterCNAMECallCount() int {
+	fake.routerCNAMEMutex.RLock()
+	defer fake.routerCNAMEMutex.RUnlock()
+	return len(fake.routerCNAMEArgsForCall)
+}
+
+func (fake *FakeConfig) RouterCNAMECalls(stub func() string) {
+	fake.routerCNAMEMutex.Lock()
+	defer fake.routerCNAMEMutex.Unlock()
+	fake.RouterCNAMEStub = stub
+}
+
+func (fake *FakeConfig) RouterCNAMEReturns(result1 string) {
+	fake.routerCNAMEMutex.Lock()
+	defer fake.routerCNAMEMutex.Unlock()
+	fake.RouterCNAMEStub = nil
+	fake.routerCNAMEReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RouterCNAMEReturnsOnCall(i int, result1 string) {
+	fake.routerCNAMEMutex.Lock()
+	defer fake.routerCNAMEMutex.Unlock()
+	fake.RouterCNAMEStub = nil
+	if fake.routerCNAMEReturnsOnCall == nil {
+		fake.routerCNAMEReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.routerCNAMEReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RouterIPs() []string {
+	fake.routerIPsMutex.Lock()
+	ret, specificReturn := fake.routerIPsReturnsOnCall[len(fake.routerIPsArgsForCall)]
+	fake.routerIPsArgsForCall = append(fake.routerIPsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RouterIPs", []interface{}{})
+	fake.routerIPsMutex.Unlock()
+	if fake.RouterIPsStub != nil {
+		return fake.RouterIPsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.routerIPsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RouterIPsCallCount() int {
+	fake.routerIPsMutex.RLock()
+	defer fake.routerIPsMutex.RUnlock()
+	return len(fake.routerIPsArgsForCall)
+}
+
+func (fake *FakeConfig) RouterIPsCalls(stub func() []string) {
+	fake.routerIPsMutex.Lock()
+	defer fake.routerIPsMutex.Unlock()
+	fake.RouterIPsStub = stub
+}
+
+func (fake *FakeConfig) RouterIPsReturns(result1 []string) {
+	fake.routerIPsMutex.Lock()
+	defer fake.routerIPsMutex.Unlock()
+	fake.RouterIPsStub = nil
+	fake.routerIPsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) RouterIPsReturnsOnCall(i int, result1 []string) {
+	fake.routerIPsMutex.Lock()
+	defer fake.routerIPsMutex.Unlock()
+	fake.RouterIPsStub = nil
+	if fake.routerIPsReturnsOnCall == nil {
+		fake.routerIPsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.routerIPsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -2172,34 +3756,314 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeConfig) SetAccessToken(arg1 string) {
-	fake.setAccessTokenMutex.Lock()
-	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
+func (fake *FakeConfig) SetAccessToken(arg1 string) {
+	fake.setAccessTokenMutex.Lock()
+	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
+	fake.setAccessTokenMutex.Unlock()
+	if fake.SetAccessTokenStub != nil {
+		fake.SetAccessTokenStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetAccessTokenCallCount() int {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	return len(fake.setAccessTokenArgsForCall)
+}
+
+func (fake *FakeConfig) SetAccessTokenCalls(stub func(string)) {
+	fake.setAccessTokenMutex.Lock()
+	defer fake.setAccessTokenMutex.Unlock()
+	fake.SetAccessTokenStub = stub
+}
+
+func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	argsForCall := fake.setAccessTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetAccessible(arg1 bool) {
+	fake.setAccessibleMutex.Lock()
+	fake.setAccessibleArgsForCall = append(fake.setAccessibleArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetAccessible", []interface{}{arg1})
+	fake.setAccessibleMutex.Unlock()
+	if fake.SetAccessibleStub != nil {
+		fake.SetAccessibleStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetAccessibleCallCount() int {
+	fake.setAccessibleMutex.RLock()
+	defer fake.setAccessibleMutex.RUnlock()
+	return len(fake.setAccessibleArgsForCall)
+}
+
+func (fake *FakeConfig) SetAccessibleCalls(stub func(bool)) {
+	fake.setAccessibleMutex.Lock()
+	defer fake.setAccessibleMutex.Unlock()
+	fake.SetAccessibleStub = stub
+}
+
+func (fake *FakeConfig) SetAccessibleArgsForCall(i int) bool {
+	fake.setAccessibleMutex.RLock()
+	defer fake.setAccessibleMutex.RUnlock()
+	argsForCall := fake.setAccessibleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetChangeHeader(arg1 string) {
+	fake.setChangeHeaderMutex.Lock()
+	fake.setChangeHeaderArgsForCall = append(fake.setChangeHeaderArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetChangeHeader", []interface{}{arg1})
+	fake.setChangeHeaderMutex.Unlock()
+	if fake.SetChangeHeaderStub != nil {
+		fake.SetChangeHeaderStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetChangeHeaderCallCount() int {
+	fake.setChangeHeaderMutex.RLock()
+	defer fake.setChangeHeaderMutex.RUnlock()
+	return len(fake.setChangeHeaderArgsForCall)
+}
+
+func (fake *FakeConfig) SetChangeHeaderCalls(stub func(string)) {
+	fake.setChangeHeaderMutex.Lock()
+	defer fake.setChangeHeaderMutex.Unlock()
+	fake.SetChangeHeaderStub = stub
+}
+
+func (fake *FakeConfig) SetChangeHeaderArgsForCall(i int) string {
+	fake.setChangeHeaderMutex.RLock()
+	defer fake.setChangeHeaderMutex.RUnlock()
+	argsForCall := fake.setChangeHeaderArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetChangeReason(arg1 string) {
+	fake.setChangeReasonMutex.Lock()
+	fake.setChangeReasonArgsForCall = append(fake.setChangeReasonArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetChangeReason", []interface{}{arg1})
+	fake.setChangeReasonMutex.Unlock()
+	if fake.SetChangeReasonStub != nil {
+		fake.SetChangeReasonStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetChangeReasonCallCount() int {
+	fake.setChangeReasonMutex.RLock()
+	defer fake.setChangeReasonMutex.RUnlock()
+	return len(fake.setChangeReasonArgsForCall)
+}
+
+func (fake *FakeConfig) SetChangeReasonCalls(stub func(string)) {
+	fake.setChangeReasonMutex.Lock()
+	defer fake.setChangeReasonMutex.Unlock()
+	fake.SetChangeReasonStub = stub
+}
+
+func (fake *FakeConfig) SetChangeReasonArgsForCall(i int) string {
+	fake.setChangeReasonMutex.RLock()
+	defer fake.setChangeReasonMutex.RUnlock()
+	argsForCall := fake.setChangeReasonArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetCommandTimeout(arg1 time.Duration) {
+	fake.setCommandTimeoutMutex.Lock()
+	fake.setCommandTimeoutArgsForCall = append(fake.setCommandTimeoutArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("SetCommandTimeout", []interface{}{arg1})
+	fake.setCommandTimeoutMutex.Unlock()
+	if fake.SetCommandTimeoutStub != nil {
+		fake.SetCommandTimeoutStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetCommandTimeoutCallCount() int {
+	fake.setCommandTimeoutMutex.RLock()
+	defer fake.setCommandTimeoutMutex.RUnlock()
+	return len(fake.setCommandTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) SetCommandTimeoutCalls(stub func(time.Duration)) {
+	fake.setCommandTimeoutMutex.Lock()
+	defer fake.setCommandTimeoutMutex.Unlock()
+	fake.SetCommandTimeoutStub = stub
+}
+
+func (fake *FakeConfig) SetCommandTimeoutArgsForCall(i int) time.Duration {
+	fake.setCommandTimeoutMutex.RLock()
+	defer fake.setCommandTimeoutMutex.RUnlock()
+	argsForCall := fake.setCommandTimeoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetDefaultOrganization(arg1 string) {
+	fake.setDefaultOrganizationMutex.Lock()
+	fake.setDefaultOrganizationArgsForCall = append(fake.setDefaultOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetDefaultOrganization", []interface{}{arg1})
+	fake.setDefaultOrganizationMutex.Unlock()
+	if fake.SetDefaultOrganizationStub != nil {
+		fake.SetDefaultOrganizationStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetDefaultOrganizationCallCount() int {
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	return len(fake.setDefaultOrganizationArgsForCall)
+}
+
+func (fake *FakeConfig) SetDefaultOrganizationCalls(stub func(string)) {
+	fake.setDefaultOrganizationMutex.Lock()
+	defer fake.setDefaultOrganizationMutex.Unlock()
+	fake.SetDefaultOrganizationStub = stub
+}
+
+func (fake *FakeConfig) SetDefaultOrganizationArgsForCall(i int) string {
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	argsForCall := fake.setDefaultOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetDefaultSpace(arg1 string) {
+	fake.setDefaultSpaceMutex.Lock()
+	fake.setDefaultSpaceArgsForCall = append(fake.setDefaultSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetDefaultSpace", []interface{}{arg1})
+	fake.setDefaultSpaceMutex.Unlock()
+	if fake.SetDefaultSpaceStub != nil {
+		fake.SetDefaultSpaceStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetDefaultSpaceCallCount() int {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	return len(fake.setDefaultSpaceArgsForCall)
+}
+
+func (fake *FakeConfig) SetDefaultSpaceCalls(stub func(string)) {
+	fake.setDefaultSpaceMutex.Lock()
+	defer fake.setDefaultSpaceMutex.Unlock()
+	fake.SetDefaultSpaceStub = stub
+}
+
+func (fake *FakeConfig) SetDefaultSpaceArgsForCall(i int) string {
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	argsForCall := fake.setDefaultSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetFeatureEnabled(arg1 string, arg2 bool) {
+	fake.setFeatureEnabledMutex.Lock()
+	fake.setFeatureEnabledArgsForCall = append(fake.setFeatureEnabledArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("SetFeatureEnabled", []interface{}{arg1, arg2})
+	fake.setFeatureEnabledMutex.Unlock()
+	if fake.SetFeatureEnabledStub != nil {
+		fake.SetFeatureEnabledStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetFeatureEnabledCallCount() int {
+	fake.setFeatureEnabledMutex.RLock()
+	defer fake.setFeatureEnabledMutex.RUnlock()
+	return len(fake.setFeatureEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetFeatureEnabledCalls(stub func(string, bool)) {
+	fake.setFeatureEnabledMutex.Lock()
+	defer fake.setFeatureEnabledMutex.Unlock()
+	fake.SetFeatureEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetFeatureEnabledArgsForCall(i int) (string, bool) {
+	fake.setFeatureEnabledMutex.RLock()
+	defer fake.setFeatureEnabledMutex.RUnlock()
+	argsForCall := fake.setFeatureEnabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfig) SetInsecureAllowed(arg1 bool) {
+	fake.setInsecureAllowedMutex.Lock()
+	fake.setInsecureAllowedArgsForCall = append(fake.setInsecureAllowedArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetInsecureAllowed", []interface{}{arg1})
+	fake.setInsecureAllowedMutex.Unlock()
+	if fake.SetInsecureAllowedStub != nil {
+		fake.SetInsecureAllowedStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetInsecureAllowedCallCount() int {
+	fake.setInsecureAllowedMutex.RLock()
+	defer fake.setInsecureAllowedMutex.RUnlock()
+	return len(fake.setInsecureAllowedArgsForCall)
+}
+
+func (fake *FakeConfig) SetInsecureAllowedCalls(stub func(bool)) {
+	fake.setInsecureAllowedMutex.Lock()
+	defer fake.setInsecureAllowedMutex.Unlock()
+	fake.SetInsecureAllowedStub = stub
+}
+
+func (fake *FakeConfig) SetInsecureAllowedArgsForCall(i int) bool {
+	fake.setInsecureAllowedMutex.RLock()
+	defer fake.setInsecureAllowedMutex.RUnlock()
+	argsForCall := fake.setInsecureAllowedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetLogSource(arg1 string) {
+	fake.setLogSourceMutex.Lock()
+	fake.setLogSourceArgsForCall = append(fake.setLogSourceArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
-	fake.setAccessTokenMutex.Unlock()
-	if fake.SetAccessTokenStub != nil {
-		fake.SetAccessTokenStub(arg1)
+	fake.recordInvocation("SetLogSource", []interface{}{arg1})
+	fake.setLogSourceMutex.Unlock()
+	if fake.SetLogSourceStub != nil {
+		fake.SetLogSourceStub(arg1)
 	}
 }
 
-func (fake *FakeConfig) SetAccessTokenCallCount() int {
-	fake.setAccessTokenMutex.RLock()
-	defer fake.setAccessTokenMutex.RUnlock()
-	return len(fake.setAccessTokenArgsForCall)
+func (fake *FakeConfig) SetLogSourceCallCount() int {
+	fake.setLogSourceMutex.RLock()
+	defer fake.setLogSourceMutex.RUnlock()
+	return len(fake.setLogSourceArgsForCall)
 }
 
-func (fake *FakeConfig) SetAccessTokenCalls(stub func(string)) {
-	fake.setAccessTokenMutex.Lock()
-	defer fake.setAccessTokenMutex.Unlock()
-	fake.SetAccessTokenStub = stub
+func (fake *FakeConfig) SetLogSourceCalls(stub func(string)) {
+	fake.setLogSourceMutex.Lock()
+	defer fake.setLogSourceMutex.Unlock()
+	fake.SetLogSourceStub = stub
 }
 
-func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
-	fake.setAccessTokenMutex.RLock()
-	defer fake.setAccessTokenMutex.RUnlock()
-	argsForCall := fake.setAccessTokenArgsForCall[i]
+func (fake *FakeConfig) SetLogSourceArgsForCall(i int) string {
+	fake.setLogSourceMutex.RLock()
+	defer fake.setLogSourceMutex.RUnlock()
+	argsForCall := fake.setLogSourceArgsForCall[i]
 	return argsForCall.arg1
 }
 
@@ -2234,6 +4098,37 @@ func (fake *FakeConfig) SetMinCLIVersionArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetMinCLIVersionCheck(arg1 configv3.MinCLIVersionCheck) {
+	fake.setMinCLIVersionCheckMutex.Lock()
+	fake.setMinCLIVersionCheckArgsForCall = append(fake.setMinCLIVersionCheckArgsForCall, struct {
+		arg1 configv3.MinCLIVersionCheck
+	}{arg1})
+	fake.recordInvocation("SetMinCLIVersionCheck", []interface{}{arg1})
+	fake.setMinCLIVersionCheckMutex.Unlock()
+	if fake.SetMinCLIVersionCheckStub != nil {
+		fake.SetMinCLIVersionCheckStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetMinCLIVersionCheckCallCount() int {
+	fake.setMinCLIVersionCheckMutex.RLock()
+	defer fake.setMinCLIVersionCheckMutex.RUnlock()
+	return len(fake.setMinCLIVersionCheckArgsForCall)
+}
+
+func (fake *FakeConfig) SetMinCLIVersionCheckCalls(stub func(configv3.MinCLIVersionCheck)) {
+	fake.setMinCLIVersionCheckMutex.Lock()
+	defer fake.setMinCLIVersionCheckMutex.Unlock()
+	fake.SetMinCLIVersionCheckStub = stub
+}
+
+func (fake *FakeConfig) SetMinCLIVersionCheckArgsForCall(i int) configv3.MinCLIVersionCheck {
+	fake.setMinCLIVersionCheckMutex.RLock()
+	defer fake.setMinCLIVersionCheckMutex.RUnlock()
+	argsForCall := fake.setMinCLIVersionCheckArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(arg1 string, arg2 string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -2266,6 +4161,166 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPluginMaxOutput(arg1 uint64) {
+	fake.setPluginMaxOutputMutex.Lock()
+	fake.setPluginMaxOutputArgsForCall = append(fake.setPluginMaxOutputArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	fake.recordInvocation("SetPluginMaxOutput", []interface{}{arg1})
+	fake.setPluginMaxOutputMutex.Unlock()
+	if fake.SetPluginMaxOutputStub != nil {
+		fake.SetPluginMaxOutputStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPluginMaxOutputCallCount() int {
+	fake.setPluginMaxOutputMutex.RLock()
+	defer fake.setPluginMaxOutputMutex.RUnlock()
+	return len(fake.setPluginMaxOutputArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginMaxOutputCalls(stub func(uint64)) {
+	fake.setPluginMaxOutputMutex.Lock()
+	defer fake.setPluginMaxOutputMutex.Unlock()
+	fake.SetPluginMaxOutputStub = stub
+}
+
+func (fake *FakeConfig) SetPluginMaxOutputArgsForCall(i int) uint64 {
+	fake.setPluginMaxOutputMutex.RLock()
+	defer fake.setPluginMaxOutputMutex.RUnlock()
+	argsForCall := fake.setPluginMaxOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetPluginPrecedence(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setPluginPrecedenceMutex.Lock()
+	fake.setPluginPrecedenceArgsForCall = append(fake.setPluginPrecedenceArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("SetPluginPrecedence", []interface{}{arg1Copy})
+	fake.setPluginPrecedenceMutex.Unlock()
+	if fake.SetPluginPrecedenceStub != nil {
+		fake.SetPluginPrecedenceStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPluginPrecedenceCallCount() int {
+	fake.setPluginPrecedenceMutex.RLock()
+	defer fake.setPluginPrecedenceMutex.RUnlock()
+	return len(fake.setPluginPrecedenceArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginPrecedenceCalls(stub func([]string)) {
+	fake.setPluginPrecedenceMutex.Lock()
+	defer fake.setPluginPrecedenceMutex.Unlock()
+	fake.SetPluginPrecedenceStub = stub
+}
+
+func (fake *FakeConfig) SetPluginPrecedenceArgsForCall(i int) []string {
+	fake.setPluginPrecedenceMutex.RLock()
+	defer fake.setPluginPrecedenceMutex.RUnlock()
+	argsForCall := fake.setPluginPrecedenceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetPluginTimeout(arg1 time.Duration) {
+	fake.setPluginTimeoutMutex.Lock()
+	fake.setPluginTimeoutArgsForCall = append(fake.setPluginTimeoutArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("SetPluginTimeout", []interface{}{arg1})
+	fake.setPluginTimeoutMutex.Unlock()
+	if fake.SetPluginTimeoutStub != nil {
+		fake.SetPluginTimeoutStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPluginTimeoutCallCount() int {
+	fake.setPluginTimeoutMutex.RLock()
+	defer fake.setPluginTimeoutMutex.RUnlock()
+	return len(fake.setPluginTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginTimeoutCalls(stub func(time.Duration)) {
+	fake.setPluginTimeoutMutex.Lock()
+	defer fake.setPluginTimeoutMutex.Unlock()
+	fake.SetPluginTimeoutStub = stub
+}
+
+func (fake *FakeConfig) SetPluginTimeoutArgsForCall(i int) time.Duration {
+	fake.setPluginTimeoutMutex.RLock()
+	defer fake.setPluginTimeoutMutex.RUnlock()
+	argsForCall := fake.setPluginTimeoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetPushScanHook(arg1 string) {
+	fake.setPushScanHookMutex.Lock()
+	fake.setPushScanHookArgsForCall = append(fake.setPushScanHookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetPushScanHook", []interface{}{arg1})
+	fake.setPushScanHookMutex.Unlock()
+	if fake.SetPushScanHookStub != nil {
+		fake.SetPushScanHookStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPushScanHookCallCount() int {
+	fake.setPushScanHookMutex.RLock()
+	defer fake.setPushScanHookMutex.RUnlock()
+	return len(fake.setPushScanHookArgsForCall)
+}
+
+func (fake *FakeConfig) SetPushScanHookCalls(stub func(string)) {
+	fake.setPushScanHookMutex.Lock()
+	defer fake.setPushScanHookMutex.Unlock()
+	fake.SetPushScanHookStub = stub
+}
+
+func (fake *FakeConfig) SetPushScanHookArgsForCall(i int) string {
+	fake.setPushScanHookMutex.RLock()
+	defer fake.setPushScanHookMutex.RUnlock()
+	argsForCall := fake.setPushScanHookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetPushScanSkipAllowed(arg1 bool) {
+	fake.setPushScanSkipAllowedMutex.Lock()
+	fake.setPushScanSkipAllowedArgsForCall = append(fake.setPushScanSkipAllowedArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetPushScanSkipAllowed", []interface{}{arg1})
+	fake.setPushScanSkipAllowedMutex.Unlock()
+	if fake.SetPushScanSkipAllowedStub != nil {
+		fake.SetPushScanSkipAllowedStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPushScanSkipAllowedCallCount() int {
+	fake.setPushScanSkipAllowedMutex.RLock()
+	defer fake.setPushScanSkipAllowedMutex.RUnlock()
+	return len(fake.setPushScanSkipAllowedArgsForCall)
+}
+
+func (fake *FakeConfig) SetPushScanSkipAllowedCalls(stub func(bool)) {
+	fake.setPushScanSkipAllowedMutex.Lock()
+	defer fake.setPushScanSkipAllowedMutex.Unlock()
+	fake.SetPushScanSkipAllowedStub = stub
+}
+
+func (fake *FakeConfig) SetPushScanSkipAllowedArgsForCall(i int) bool {
+	fake.setPushScanSkipAllowedMutex.RLock()
+	defer fake.setPushScanSkipAllowedMutex.RUnlock()
+	argsForCall := fake.setPushScanSkipAllowedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -2297,6 +4352,104 @@ func (fake *FakeConfig) SetRefreshTokenArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetReleaseCheckEnabled(arg1 bool) {
+	fake.setReleaseCheckEnabledMutex.Lock()
+	fake.setReleaseCheckEnabledArgsForCall = append(fake.setReleaseCheckEnabledArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetReleaseCheckEnabled", []interface{}{arg1})
+	fake.setReleaseCheckEnabledMutex.Unlock()
+	if fake.SetReleaseCheckEnabledStub != nil {
+		fake.SetReleaseCheckEnabledStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetReleaseCheckEnabledCallCount() int {
+	fake.setReleaseCheckEnabledMutex.RLock()
+	defer fake.setReleaseCheckEnabledMutex.RUnlock()
+	return len(fake.setReleaseCheckEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetReleaseCheckEnabledCalls(stub func(bool)) {
+	fake.setReleaseCheckEnabledMutex.Lock()
+	defer fake.setReleaseCheckEnabledMutex.Unlock()
+	fake.SetReleaseCheckEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetReleaseCheckEnabledArgsForCall(i int) bool {
+	fake.setReleaseCheckEnabledMutex.RLock()
+	defer fake.setReleaseCheckEnabledMutex.RUnlock()
+	argsForCall := fake.setReleaseCheckEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetRouterCNAME(arg1 string) {
+	fake.setRouterCNAMEMutex.Lock()
+	fake.setRouterCNAMEArgsForCall = append(fake.setRouterCNAMEArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetRouterCNAME", []interface{}{arg1})
+	fake.setRouterCNAMEMutex.Unlock()
+	if fake.SetRouterCNAMEStub != nil {
+		fake.SetRouterCNAMEStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetRouterCNAMECallCount() int {
+	fake.setRouterCNAMEMutex.RLock()
+	defer fake.setRouterCNAMEMutex.RUnlock()
+	return len(fake.setRouterCNAMEArgsForCall)
+}
+
+func (fake *FakeConfig) SetRouterCNAMECalls(stub func(string)) {
+	fake.setRouterCNAMEMutex.Lock()
+	defer fake.setRouterCNAMEMutex.Unlock()
+	fake.SetRouterCNAMEStub = stub
+}
+
+func (fake *FakeConfig) SetRouterCNAMEArgsForCall(i int) string {
+	fake.setRouterCNAMEMutex.RLock()
+	defer fake.setRouterCNAMEMutex.RUnlock()
+	argsForCall := fake.setRouterCNAMEArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetRouterIPs(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setRouterIPsMutex.Lock()
+	fake.setRouterIPsArgsForCall = append(fake.setRouterIPsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("SetRouterIPs", []interface{}{arg1Copy})
+	fake.setRouterIPsMutex.Unlock()
+	if fake.SetRouterIPsStub != nil {
+		fake.SetRouterIPsStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetRouterIPsCallCount() int {
+	fake.setRouterIPsMutex.RLock()
+	defer fake.setRouterIPsMutex.RUnlock()
+	return len(fake.setRouterIPsArgsForCall)
+}
+
+func (fake *FakeConfig) SetRouterIPsCalls(stub func([]string)) {
+	fake.setRouterIPsMutex.Lock()
+	defer fake.setRouterIPsMutex.Unlock()
+	fake.SetRouterIPsStub = stub
+}
+
+func (fake *FakeConfig) SetRouterIPsArgsForCall(i int) []string {
+	fake.setRouterIPsMutex.RLock()
+	defer fake.setRouterIPsMutex.RUnlock()
+	argsForCall := fake.setRouterIPsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetSpaceInformation(arg1 string, arg2 string, arg3 bool) {
 	fake.setSpaceInformationMutex.Lock()
 	fake.setSpaceInformationArgsForCall = append(fake.setSpaceInformationArgsForCall, struct {
@@ -2494,6 +4647,99 @@ func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetUsageStatsEnabled(arg1 bool) {
+	fake.setUsageStatsEnabledMutex.Lock()
+	fake.setUsageStatsEnabledArgsForCall = append(fake.setUsageStatsEnabledArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetUsageStatsEnabled", []interface{}{arg1})
+	fake.setUsageStatsEnabledMutex.Unlock()
+	if fake.SetUsageStatsEnabledStub != nil {
+		fake.SetUsageStatsEnabledStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUsageStatsEnabledCallCount() int {
+	fake.setUsageStatsEnabledMutex.RLock()
+	defer fake.setUsageStatsEnabledMutex.RUnlock()
+	return len(fake.setUsageStatsEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetUsageStatsEnabledCalls(stub func(bool)) {
+	fake.setUsageStatsEnabledMutex.Lock()
+	defer fake.setUsageStatsEnabledMutex.Unlock()
+	fake.SetUsageStatsEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetUsageStatsEnabledArgsForCall(i int) bool {
+	fake.setUsageStatsEnabledMutex.RLock()
+	defer fake.setUsageStatsEnabledMutex.RUnlock()
+	argsForCall := fake.setUsageStatsEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetUsageStatsEndpoint(arg1 string) {
+	fake.setUsageStatsEndpointMutex.Lock()
+	fake.setUsageStatsEndpointArgsForCall = append(fake.setUsageStatsEndpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUsageStatsEndpoint", []interface{}{arg1})
+	fake.setUsageStatsEndpointMutex.Unlock()
+	if fake.SetUsageStatsEndpointStub != nil {
+		fake.SetUsageStatsEndpointStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUsageStatsEndpointCallCount() int {
+	fake.setUsageStatsEndpointMutex.RLock()
+	defer fake.setUsageStatsEndpointMutex.RUnlock()
+	return len(fake.setUsageStatsEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) SetUsageStatsEndpointCalls(stub func(string)) {
+	fake.setUsageStatsEndpointMutex.Lock()
+	defer fake.setUsageStatsEndpointMutex.Unlock()
+	fake.SetUsageStatsEndpointStub = stub
+}
+
+func (fake *FakeConfig) SetUsageStatsEndpointArgsForCall(i int) string {
+	fake.setUsageStatsEndpointMutex.RLock()
+	defer fake.setUsageStatsEndpointMutex.RUnlock()
+	argsForCall := fake.setUsageStatsEndpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetUserAgentSuffix(arg1 string) {
+	fake.setUserAgentSuffixMutex.Lock()
+	fake.setUserAgentSuffixArgsForCall = append(fake.setUserAgentSuffixArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUserAgentSuffix", []interface{}{arg1})
+	fake.setUserAgentSuffixMutex.Unlock()
+	if fake.SetUserAgentSuffixStub != nil {
+		fake.SetUserAgentSuffixStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUserAgentSuffixCallCount() int {
+	fake.setUserAgentSuffixMutex.RLock()
+	defer fake.setUserAgentSuffixMutex.RUnlock()
+	return len(fake.setUserAgentSuffixArgsForCall)
+}
+
+func (fake *FakeConfig) SetUserAgentSuffixCalls(stub func(string)) {
+	fake.setUserAgentSuffixMutex.Lock()
+	defer fake.setUserAgentSuffixMutex.Unlock()
+	fake.SetUserAgentSuffixStub = stub
+}
+
+func (fake *FakeConfig) SetUserAgentSuffixArgsForCall(i int) string {
+	fake.setUserAgentSuffixMutex.RLock()
+	defer fake.setUserAgentSuffixMutex.RUnlock()
+	argsForCall := fake.setUserAgentSuffixArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -2858,6 +5104,58 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) Timings() bool {
+	fake.timingsMutex.Lock()
+	ret, specificReturn := fake.timingsReturnsOnCall[len(fake.timingsArgsForCall)]
+	fake.timingsArgsForCall = append(fake.timingsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Timings", []interface{}{})
+	fake.timingsMutex.Unlock()
+	if fake.TimingsStub != nil {
+		return fake.TimingsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.timingsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TimingsCallCount() int {
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
+	return len(fake.timingsArgsForCall)
+}
+
+func (fake *FakeConfig) TimingsCalls(stub func() bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = stub
+}
+
+func (fake *FakeConfig) TimingsReturns(result1 bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = nil
+	fake.timingsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TimingsReturnsOnCall(i int, result1 bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = nil
+	if fake.timingsReturnsOnCall == nil {
+		fake.timingsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.timingsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -3135,6 +5433,162 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UsageStatsEnabled() bool {
+	fake.usageStatsEnabledMutex.Lock()
+	ret, specificReturn := fake.usageStatsEnabledReturnsOnCall[len(fake.usageStatsEnabledArgsForCall)]
+	fake.usageStatsEnabledArgsForCall = append(fake.usageStatsEnabledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UsageStatsEnabled", []interface{}{})
+	fake.usageStatsEnabledMutex.Unlock()
+	if fake.UsageStatsEnabledStub != nil {
+		return fake.UsageStatsEnabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.usageStatsEnabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UsageStatsEnabledCallCount() int {
+	fake.usageStatsEnabledMutex.RLock()
+	defer fake.usageStatsEnabledMutex.RUnlock()
+	return len(fake.usageStatsEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) UsageStatsEnabledCalls(stub func() bool) {
+	fake.usageStatsEnabledMutex.Lock()
+	defer fake.usageStatsEnabledMutex.Unlock()
+	fake.UsageStatsEnabledStub = stub
+}
+
+func (fake *FakeConfig) UsageStatsEnabledReturns(result1 bool) {
+	fake.usageStatsEnabledMutex.Lock()
+	defer fake.usageStatsEnabledMutex.Unlock()
+	fake.UsageStatsEnabledStub = nil
+	fake.usageStatsEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UsageStatsEnabledReturnsOnCall(i int, result1 bool) {
+	fake.usageStatsEnabledMutex.Lock()
+	defer fake.usageStatsEnabledMutex.Unlock()
+	fake.UsageStatsEnabledStub = nil
+	if fake.usageStatsEnabledReturnsOnCall == nil {
+		fake.usageStatsEnabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.usageStatsEnabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UsageStatsEndpoint() string {
+	fake.usageStatsEndpointMutex.Lock()
+	ret, specificReturn := fake.usageStatsEndpointReturnsOnCall[len(fake.usageStatsEndpointArgsForCall)]
+	fake.usageStatsEndpointArgsForCall = append(fake.usageStatsEndpointArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UsageStatsEndpoint", []interface{}{})
+	fake.usageStatsEndpointMutex.Unlock()
+	if fake.UsageStatsEndpointStub != nil {
+		return fake.UsageStatsEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.usageStatsEndpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UsageStatsEndpointCallCount() int {
+	fake.usageStatsEndpointMutex.RLock()
+	defer fake.usageStatsEndpointMutex.RUnlock()
+	return len(fake.usageStatsEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) UsageStatsEndpointCalls(stub func() string) {
+	fake.usageStatsEndpointMutex.Lock()
+	defer fake.usageStatsEndpointMutex.Unlock()
+	fake.UsageStatsEndpointStub = stub
+}
+
+func (fake *FakeConfig) UsageStatsEndpointReturns(result1 string) {
+	fake.usageStatsEndpointMutex.Lock()
+	defer fake.usageStatsEndpointMutex.Unlock()
+	fake.UsageStatsEndpointStub = nil
+	fake.usageStatsEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UsageStatsEndpointReturnsOnCall(i int, result1 string) {
+	fake.usageStatsEndpointMutex.Lock()
+	defer fake.usageStatsEndpointMutex.Unlock()
+	fake.UsageStatsEndpointStub = nil
+	if fake.usageStatsEndpointReturnsOnCall == nil {
+		fake.usageStatsEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.usageStatsEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UserAgentSuffix() string {
+	fake.userAgentSuffixMutex.Lock()
+	ret, specificReturn := fake.userAgentSuffixReturnsOnCall[len(fake.userAgentSuffixArgsForCall)]
+	fake.userAgentSuffixArgsForCall = append(fake.userAgentSuffixArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UserAgentSuffix", []interface{}{})
+	fake.userAgentSuffixMutex.Unlock()
+	if fake.UserAgentSuffixStub != nil {
+		return fake.UserAgentSuffixStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.userAgentSuffixReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UserAgentSuffixCallCount() int {
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
+	return len(fake.userAgentSuffixArgsForCall)
+}
+
+func (fake *FakeConfig) UserAgentSuffixCalls(stub func() string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = stub
+}
+
+func (fake *FakeConfig) UserAgentSuffixReturns(result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	fake.userAgentSuffixReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UserAgentSuffixReturnsOnCall(i int, result1 string) {
+	fake.userAgentSuffixMutex.Lock()
+	defer fake.userAgentSuffixMutex.Unlock()
+	fake.UserAgentSuffixStub = nil
+	if fake.userAgentSuffixReturnsOnCall == nil {
+		fake.userAgentSuffixReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.userAgentSuffixReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 	fake.v7SetSpaceInformationMutex.Lock()
 	fake.v7SetSpaceInformationArgsForCall = append(fake.v7SetSpaceInformationArgsForCall, struct {
@@ -3281,10 +5735,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.accessTokenExpiryMutex.RLock()
+	defer fake.accessTokenExpiryMutex.RUnlock()
+	fake.accessTokenScopesMutex.RLock()
+	defer fake.accessTokenScopesMutex.RUnlock()
+	fake.accessibleMutex.RLock()
+	defer fake.accessibleMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addRecentJobMutex.RLock()
+	defer fake.addRecentJobMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
@@ -3293,62 +5755,136 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
+	fake.changeHeaderMutex.RLock()
+	defer fake.changeHeaderMutex.RUnlock()
+	fake.changeReasonMutex.RLock()
+	defer fake.changeReasonMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.commandDeadlineMutex.RLock()
+	defer fake.commandDeadlineMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
+	fake.defaultOrganizationMutex.RLock()
+	defer fake.defaultOrganizationMutex.RUnlock()
+	fake.defaultSpaceMutex.RLock()
+	defer fake.defaultSpaceMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
 	defer fake.dockerPasswordMutex.RUnlock()
+	fake.dryRunMutex.RLock()
+	defer fake.dryRunMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
-	fake.experimentalLoginMutex.RLock()
-	defer fake.experimentalLoginMutex.RUnlock()
+	fake.featureEnabledMutex.RLock()
+	defer fake.featureEnabledMutex.RUnlock()
+	fake.foundationConfigMutex.RLock()
+	defer fake.foundationConfigMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.hTTPSProxyMutex.RLock()
+	defer fake.hTTPSProxyMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.insecureAllowedMutex.RLock()
+	defer fake.insecureAllowedMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.logSourceMutex.RLock()
+	defer fake.logSourceMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.minCLIVersionCheckMutex.RLock()
+	defer fake.minCLIVersionCheckMutex.RUnlock()
 	fake.nOAARequestRetryCountMutex.RLock()
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
+	fake.pluginPrecedenceMutex.RLock()
+	defer fake.pluginPrecedenceMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.pushScanHookMutex.RLock()
+	defer fake.pushScanHookMutex.RUnlock()
+	fake.pushScanSkipAllowedMutex.RLock()
+	defer fake.pushScanSkipAllowedMutex.RUnlock()
+	fake.recentJobsMutex.RLock()
+	defer fake.recentJobsMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.releaseCheckEnabledMutex.RLock()
+	defer fake.releaseCheckEnabledMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.routerCNAMEMutex.RLock()
+	defer fake.routerCNAMEMutex.RUnlock()
+	fake.routerIPsMutex.RLock()
+	defer fake.routerIPsMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setAccessibleMutex.RLock()
+	defer fake.setAccessibleMutex.RUnlock()
+	fake.setChangeHeaderMutex.RLock()
+	defer fake.setChangeHeaderMutex.RUnlock()
+	fake.setChangeReasonMutex.RLock()
+	defer fake.setChangeReasonMutex.RUnlock()
+	fake.setCommandTimeoutMutex.RLock()
+	defer fake.setCommandTimeoutMutex.RUnlock()
+	fake.setDefaultOrganizationMutex.RLock()
+	defer fake.setDefaultOrganizationMutex.RUnlock()
+	fake.setDefaultSpaceMutex.RLock()
+	defer fake.setDefaultSpaceMutex.RUnlock()
+	fake.setFeatureEnabledMutex.RLock()
+	defer fake.setFeatureEnabledMutex.RUnlock()
+	fake.setInsecureAllowedMutex.RLock()
+	defer fake.setInsecureAllowedMutex.RUnlock()
+	fake.setLogSourceMutex.RLock()
+	defer fake.setLogSourceMutex.RUnlock()
 	fake.setMinCLIVersionMutex.RLock()
 	defer fake.setMinCLIVersionMutex.RUnlock()
+	fake.setMinCLIVersionCheckMutex.RLock()
+	defer fake.setMinCLIVersionCheckMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPluginMaxOutputMutex.RLock()
+	defer fake.setPluginMaxOutputMutex.RUnlock()
+	fake.setPluginPrecedenceMutex.RLock()
+	defer fake.setPluginPrecedenceMutex.RUnlock()
+	fake.setPluginTimeoutMutex.RLock()
+	defer fake.setPluginTimeoutMutex.RUnlock()
+	fake.setPushScanHookMutex.RLock()
+	defer fake.setPushScanHookMutex.RUnlock()
+	fake.setPushScanSkipAllowedMutex.RLock()
+	defer fake.setPushScanSkipAllowedMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setReleaseCheckEnabledMutex.RLock()
+	defer fake.setReleaseCheckEnabledMutex.RUnlock()
+	fake.setRouterCNAMEMutex.RLock()
+	defer fake.setRouterCNAMEMutex.RUnlock()
+	fake.setRouterIPsMutex.RLock()
+	defer fake.setRouterIPsMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
 	defer fake.setSpaceInformationMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
@@ -3361,6 +5897,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.setUAAGrantTypeMutex.RLock()
 	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.setUsageStatsEnabledMutex.RLock()
+	defer fake.setUsageStatsEnabledMutex.RUnlock()
+	fake.setUsageStatsEndpointMutex.RLock()
+	defer fake.setUsageStatsEndpointMutex.RUnlock()
+	fake.setUserAgentSuffixMutex.RLock()
+	defer fake.setUserAgentSuffixMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
@@ -3375,6 +5917,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationNameMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
@@ -3389,6 +5933,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.usageStatsEnabledMutex.RLock()
+	defer fake.usageStatsEnabledMutex.RUnlock()
+	fake.usageStatsEndpointMutex.RLock()
+	defer fake.usageStatsEndpointMutex.RUnlock()
+	fake.userAgentSuffixMutex.RLock()
+	defer fake.userAgentSuffixMutex.RUnlock()
 	fake.v7SetSpaceInformationMutex.RLock()
 	defer fake.v7SetSpaceInformationMutex.RUnlock()
 	fake.verboseMutex.RLock()
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/timings"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -93,6 +94,21 @@ type FakeUI struct {
 	displayOKMutex       sync.RWMutex
 	displayOKArgsForCall []struct {
 	}
+	DisplayOptionalTextPromptStub        func(string, string, ...map[string]interface{}) (string, error)
+	displayOptionalTextPromptMutex       sync.RWMutex
+	displayOptionalTextPromptArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []map[string]interface{}
+	}
+	displayOptionalTextPromptReturns struct {
+		result1 string
+		result2 error
+	}
+	displayOptionalTextPromptReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DisplayPasswordPromptStub        func(string, ...map[string]interface{}) (string, error)
 	displayPasswordPromptMutex       sync.RWMutex
 	displayPasswordPromptArgsForCall []struct {
@@ -120,6 +136,21 @@ type FakeUI struct {
 		arg1 string
 		arg2 []map[string]interface{}
 	}
+	DisplayTextMenuStub        func([]string, string, ...map[string]interface{}) (string, error)
+	displayTextMenuMutex       sync.RWMutex
+	displayTextMenuArgsForCall []struct {
+		arg1 []string
+		arg2 string
+		arg3 []map[string]interface{}
+	}
+	displayTextMenuReturns struct {
+		result1 string
+		result2 error
+	}
+	displayTextMenuReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DisplayTextPromptStub        func(string, ...map[string]interface{}) (string, error)
 	displayTextPromptMutex       sync.RWMutex
 	displayTextPromptArgsForCall []struct {
@@ -208,6 +239,16 @@ type FakeUI struct {
 	requestLoggerTerminalDisplayReturnsOnCall map[int]struct {
 		result1 *ui.RequestLoggerTerminalDisplay
 	}
+	TimingsRecorderStub        func() *timings.Recorder
+	timingsRecorderMutex       sync.RWMutex
+	timingsRecorderArgsForCall []struct {
+	}
+	timingsRecorderReturns struct {
+		result1 *timings.Recorder
+	}
+	timingsRecorderReturnsOnCall map[int]struct {
+		result1 *timings.Recorder
+	}
 	TranslateTextStub        func(string, ...map[string]interface{}) string
 	translateTextMutex       sync.RWMutex
 	translateTextArgsForCall []struct {
@@ -709,6 +750,71 @@ func (fake *FakeUI) DisplayOKCalls(stub func()) {
 	fake.DisplayOKStub = stub
 }
 
+func (fake *FakeUI) DisplayOptionalTextPrompt(arg1 string, arg2 string, arg3 ...map[string]interface{}) (string, error) {
+	fake.displayOptionalTextPromptMutex.Lock()
+	ret, specificReturn := fake.displayOptionalTextPromptReturnsOnCall[len(fake.displayOptionalTextPromptArgsForCall)]
+	fake.displayOptionalTextPromptArgsForCall = append(fake.displayOptionalTextPromptArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []map[string]interface{}
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DisplayOptionalTextPrompt", []interface{}{arg1, arg2, arg3})
+	fake.displayOptionalTextPromptMutex.Unlock()
+	if fake.DisplayOptionalTextPromptStub != nil {
+		return fake.DisplayOptionalTextPromptStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.displayOptionalTextPromptReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUI) DisplayOptionalTextPromptCallCount() int {
+	fake.displayOptionalTextPromptMutex.RLock()
+	defer fake.displayOptionalTextPromptMutex.RUnlock()
+	return len(fake.displayOptionalTextPromptArgsForCall)
+}
+
+func (fake *FakeUI) DisplayOptionalTextPromptCalls(stub func(string, string, ...map[string]interface{}) (string, error)) {
+	fake.displayOptionalTextPromptMutex.Lock()
+	defer fake.displayOptionalTextPromptMutex.Unlock()
+	fake.DisplayOptionalTextPromptStub = stub
+}
+
+func (fake *FakeUI) DisplayOptionalTextPromptArgsForCall(i int) (string, string, []map[string]interface{}) {
+	fake.displayOptionalTextPromptMutex.RLock()
+	defer fake.displayOptionalTextPromptMutex.RUnlock()
+	argsForCall := fake.displayOptionalTextPromptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUI) DisplayOptionalTextPromptReturns(result1 string, result2 error) {
+	fake.displayOptionalTextPromptMutex.Lock()
+	defer fake.displayOptionalTextPromptMutex.Unlock()
+	fake.DisplayOptionalTextPromptStub = nil
+	fake.displayOptionalTextPromptReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUI) DisplayOptionalTextPromptReturnsOnCall(i int, result1 string, result2 error) {
+	fake.displayOptionalTextPromptMutex.Lock()
+	defer fake.displayOptionalTextPromptMutex.Unlock()
+	fake.DisplayOptionalTextPromptStub = nil
+	if fake.displayOptionalTextPromptReturnsOnCall == nil {
+		fake.displayOptionalTextPromptReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.displayOptionalTextPromptReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUI) DisplayPasswordPrompt(arg1 string, arg2 ...map[string]interface{}) (string, error) {
 	fake.displayPasswordPromptMutex.Lock()
 	ret, specificReturn := fake.displayPasswordPromptReturnsOnCall[len(fake.displayPasswordPromptArgsForCall)]
@@ -843,6 +949,76 @@ func (fake *FakeUI) DisplayTextArgsForCall(i int) (string, []map[string]interfac
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayTextMenu(arg1 []string, arg2 string, arg3 ...map[string]interface{}) (string, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.displayTextMenuMutex.Lock()
+	ret, specificReturn := fake.displayTextMenuReturnsOnCall[len(fake.displayTextMenuArgsForCall)]
+	fake.displayTextMenuArgsForCall = append(fake.displayTextMenuArgsForCall, struct {
+		arg1 []string
+		arg2 string
+		arg3 []map[string]interface{}
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("DisplayTextMenu", []interface{}{arg1Copy, arg2, arg3})
+	fake.displayTextMenuMutex.Unlock()
+	if fake.DisplayTextMenuStub != nil {
+		return fake.DisplayTextMenuStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.displayTextMenuReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUI) DisplayTextMenuCallCount() int {
+	fake.displayTextMenuMutex.RLock()
+	defer fake.displayTextMenuMutex.RUnlock()
+	return len(fake.displayTextMenuArgsForCall)
+}
+
+func (fake *FakeUI) DisplayTextMenuCalls(stub func([]string, string, ...map[string]interface{}) (string, error)) {
+	fake.displayTextMenuMutex.Lock()
+	defer fake.displayTextMenuMutex.Unlock()
+	fake.DisplayTextMenuStub = stub
+}
+
+func (fake *FakeUI) DisplayTextMenuArgsForCall(i int) ([]string, string, []map[string]interface{}) {
+	fake.displayTextMenuMutex.RLock()
+	defer fake.displayTextMenuMutex.RUnlock()
+	argsForCall := fake.displayTextMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUI) DisplayTextMenuReturns(result1 string, result2 error) {
+	fake.displayTextMenuMutex.Lock()
+	defer fake.displayTextMenuMutex.Unlock()
+	fake.DisplayTextMenuStub = nil
+	fake.displayTextMenuReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUI) DisplayTextMenuReturnsOnCall(i int, result1 string, result2 error) {
+	fake.displayTextMenuMutex.Lock()
+	defer fake.displayTextMenuMutex.Unlock()
+	fake.DisplayTextMenuStub = nil
+	if fake.displayTextMenuReturnsOnCall == nil {
+		fake.displayTextMenuReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.displayTextMenuReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUI) DisplayTextPrompt(arg1 string, arg2 ...map[string]interface{}) (string, error) {
 	fake.displayTextPromptMutex.Lock()
 	ret, specificReturn := fake.displayTextPromptReturnsOnCall[len(fake.displayTextPromptArgsForCall)]
@@ -1312,6 +1488,58 @@ func (fake *FakeUI) RequestLoggerTerminalDisplayReturnsOnCall(i int, result1 *ui
 	}{result1}
 }
 
+func (fake *FakeUI) TimingsRecorder() *timings.Recorder {
+	fake.timingsRecorderMutex.Lock()
+	ret, specificReturn := fake.timingsRecorderReturnsOnCall[len(fake.timingsRecorderArgsForCall)]
+	fake.timingsRecorderArgsForCall = append(fake.timingsRecorderArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TimingsRecorder", []interface{}{})
+	fake.timingsRecorderMutex.Unlock()
+	if fake.TimingsRecorderStub != nil {
+		return fake.TimingsRecorderStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.timingsRecorderReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) TimingsRecorderCallCount() int {
+	fake.timingsRecorderMutex.RLock()
+	defer fake.timingsRecorderMutex.RUnlock()
+	return len(fake.timingsRecorderArgsForCall)
+}
+
+func (fake *FakeUI) TimingsRecorderCalls(stub func() *timings.Recorder) {
+	fake.timingsRecorderMutex.Lock()
+	defer fake.timingsRecorderMutex.Unlock()
+	fake.TimingsRecorderStub = stub
+}
+
+func (fake *FakeUI) TimingsRecorderReturns(result1 *timings.Recorder) {
+	fake.timingsRecorderMutex.Lock()
+	defer fake.timingsRecorderMutex.Unlock()
+	fake.TimingsRecorderStub = nil
+	fake.timingsRecorderReturns = struct {
+		result1 *timings.Recorder
+	}{result1}
+}
+
+func (fake *FakeUI) TimingsRecorderReturnsOnCall(i int, result1 *timings.Recorder) {
+	fake.timingsRecorderMutex.Lock()
+	defer fake.timingsRecorderMutex.Unlock()
+	fake.TimingsRecorderStub = nil
+	if fake.timingsRecorderReturnsOnCall == nil {
+		fake.timingsRecorderReturnsOnCall = make(map[int]struct {
+			result1 *timings.Recorder
+		})
+	}
+	fake.timingsRecorderReturnsOnCall[i] = struct {
+		result1 *timings.Recorder
+	}{result1}
+}
+
 func (fake *FakeUI) TranslateText(arg1 string, arg2 ...map[string]interface{}) string {
 	fake.translateTextMutex.Lock()
 	ret, specificReturn := fake.translateTextReturnsOnCall[len(fake.translateTextArgsForCall)]
@@ -1514,12 +1742,16 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayNonWrappingTableMutex.RUnlock()
 	fake.displayOKMutex.RLock()
 	defer fake.displayOKMutex.RUnlock()
+	fake.displayOptionalTextPromptMutex.RLock()
+	defer fake.displayOptionalTextPromptMutex.RUnlock()
 	fake.displayPasswordPromptMutex.RLock()
 	defer fake.displayPasswordPromptMutex.RUnlock()
 	fake.displayTableWithHeaderMutex.RLock()
 	defer fake.displayTableWithHeaderMutex.RUnlock()
 	fake.displayTextMutex.RLock()
 	defer fake.displayTextMutex.RUnlock()
+	fake.displayTextMenuMutex.RLock()
+	defer fake.displayTextMenuMutex.RUnlock()
 	fake.displayTextPromptMutex.RLock()
 	defer fake.displayTextPromptMutex.RUnlock()
 	fake.displayTextWithBoldMutex.RLock()
@@ -1540,6 +1772,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.requestLoggerFileWriterMutex.RUnlock()
 	fake.requestLoggerTerminalDisplayMutex.RLock()
 	defer fake.requestLoggerTerminalDisplayMutex.RUnlock()
+	fake.timingsRecorderMutex.RLock()
+	defer fake.timingsRecorderMutex.RUnlock()
 	fake.translateTextMutex.RLock()
 	defer fake.translateTextMutex.RUnlock()
 	fake.userFriendlyDateMutex.RLock()
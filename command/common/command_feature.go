@@ -0,0 +1,46 @@
+package common
+
+import (
+	"reflect"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+)
+
+// LegacyImplementationRequired returns true when the refactored
+// implementation of the command is gated behind an experimental feature,
+// named in the command's feature tag, that is turned off. Such commands are
+// run by the legacy code base instead, unless one of the flags in the
+// command's refactored-flags tag, which only the refactored implementation
+// supports, is set.
+func LegacyImplementationRequired(config command.Config, commandName string, setFlags []string) bool {
+	field, ok := commandField(commandName)
+	if !ok {
+		return false
+	}
+
+	feature := field.Tag.Get("feature")
+	if feature == "" || config.FeatureEnabled(feature) {
+		return false
+	}
+
+	for _, refactoredFlag := range strings.Split(field.Tag.Get("refactored-flags"), ",") {
+		for _, setFlag := range setFlags {
+			if refactoredFlag != "" && setFlag == refactoredFlag {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func commandField(commandName string) (reflect.StructField, bool) {
+	commandListType := reflect.TypeOf(Commands)
+	for i := 0; i < commandListType.NumField(); i++ {
+		field := commandListType.Field(i)
+		if field.Tag.Get("command") == commandName {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,54 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LegacyImplementationRequired", func() {
+	var fakeConfig *commandfakes.FakeConfig
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+	})
+
+	When("the command's feature is turned off", func() {
+		It("returns true", func() {
+			Expect(LegacyImplementationRequired(fakeConfig, "login", nil)).To(BeTrue())
+			Expect(fakeConfig.FeatureEnabledArgsForCall(0)).To(Equal(configv3.LoginV2Feature))
+		})
+
+		When("a flag only the refactored implementation supports is set", func() {
+			It("returns false", func() {
+				Expect(LegacyImplementationRequired(fakeConfig, "login", []string{"-u", "--device"})).To(BeFalse())
+			})
+		})
+
+		When("only flags the legacy implementation supports are set", func() {
+			It("returns true", func() {
+				Expect(LegacyImplementationRequired(fakeConfig, "login", []string{"-u", "-p"})).To(BeTrue())
+			})
+		})
+	})
+
+	When("the command's feature is turned on", func() {
+		BeforeEach(func() {
+			fakeConfig.FeatureEnabledReturns(true)
+		})
+
+		It("returns false", func() {
+			Expect(LegacyImplementationRequired(fakeConfig, "login", nil)).To(BeFalse())
+		})
+	})
+
+	When("the command is not gated behind a feature", func() {
+		It("returns false", func() {
+			Expect(LegacyImplementationRequired(fakeConfig, "apps", nil)).To(BeFalse())
+			Expect(fakeConfig.FeatureEnabledCallCount()).To(Equal(0))
+		})
+	})
+})
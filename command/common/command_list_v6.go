@@ -16,7 +16,11 @@ type V2CommandList struct {
 }
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	Foundations      string `long:"foundations" description:"Comma separated foundations to run a read-only command against"`
+	Reason           string `long:"reason" description:"Change reason to send in the configured change header"`
+	DryRun           bool   `long:"dry-run" description:"Print the API requests that would change resources instead of sending them"`
+	Timings          bool   `long:"timings" description:"Print the time spent on each kind of API request when the command ends"`
 
 	App                                v6.V3AppCommand                              `command:"app" description:"Display health and status for an app"`
 	V3Apps                             v6.V3AppsCommand                             `command:"v3-apps" description:"List all apps in the target space"`
@@ -47,6 +51,7 @@ type commandList struct {
 	AddNetworkPolicy                   v6.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AllowSpaceSSH                      v6.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v6.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyQuotas                        v6.ApplyQuotasCommand                        `command:"apply-quotas" description:"Create, update and apply quotas declared in a YAML file"`
 	Apps                               v6.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v6.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v6.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -54,8 +59,13 @@ type commandList struct {
 	BindSecurityGroup                  v6.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v6.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v6.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
+	BindingsAudit                      v6.BindingsAuditCommand                      `command:"bindings-audit" description:"List service bindings of a space with their age and credential expiry"`
 	Buildpacks                         v6.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	Cat                                v6.CatCommand                                `command:"cat" description:"Print the contents of a file of an app instance"`
+	CanI                               v6.CanICommand                               `command:"can-i" description:"Check whether the current user is authorized to perform an operation"`
+	Capabilities                       v6.CapabilitiesCommand                       `command:"capabilities" description:"Report which optional APIs the targeted foundation supports"`
 	CheckRoute                         v6.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	ClearBuildCache                    v6.ClearBuildCacheCommand                    `command:"clear-build-cache" description:"Delete the build cache of an app so its next staging starts clean"`
 	Config                             v6.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v6.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v6.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -93,44 +103,60 @@ type commandList struct {
 	DeleteSpace                        v6.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteUser                         v6.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
 	Delete                             v6.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
+	DisableFeature                     v6.DisableFeatureCommand                     `command:"disable-feature" description:"Disable an experimental CLI feature"`
 	DisableFeatureFlag                 v6.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v6.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableServiceAccess               v6.DisableServiceAccessCommand               `command:"disable-service-access" description:"Disable access to a service or service plan for one or all orgs"`
 	DisableSSH                         v6.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisallowSpaceSSH                   v6.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
 	Domains                            v6.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
+	Dump                               v6.DumpCommand                               `command:"dump" description:"Download a heap, goroutine or core dump from an application instance"`
+	EnableFeature                      v6.EnableFeatureCommand                      `command:"enable-feature" description:"Enable an experimental CLI feature"`
 	EnableFeatureFlag                  v6.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v6.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableServiceAccess                v6.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service or service plan for one or all orgs"`
 	EnableSSH                          v6.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v6.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
+	EnvGroups                          v6.EnvGroupsCommand                          `command:"env-groups" description:"Compare the staging and running environment variable groups"`
 	Events                             v6.EventsCommand                             `command:"events" description:"Show recent app events"`
+	Exec                               v6.ExecCommand                               `command:"exec" description:"Run a command on an application container instance without an interactive shell"`
+	Examples                           ExamplesCommand                              `command:"examples" description:"Show runnable examples of a command"`
+	ExportEnv                          v6.ExportEnvCommand                          `command:"export-env" description:"Export the environment variables of the apps in a space"`
+	Features                           v6.FeaturesCommand                           `command:"features" description:"List experimental CLI features and their state"`
 	FeatureFlags                       v6.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v6.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
-	Files                              v6.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
+	Files                              v6.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app instance"`
 	GetHealthCheck                     v6.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportEnv                          v6.ImportEnvCommand                          `command:"import-env" description:"Import environment variables exported with export-env into the apps of a space"`
+	Init                               InitCommand                                  `command:"init" description:"Walk through setting up the CLI for first use"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v6.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Job                                v6.JobCommand                                `command:"job" description:"Show the state of an async job"`
+	Jobs                               v6.JobsCommand                               `command:"jobs" description:"List recent async jobs you started, such as org and space deletions"`
 	NetworkPolicies                    v6.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
-	Login                              v6.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
+	LocalEnv                           v6.LocalEnvCommand                           `command:"local-env" description:"Print the VCAP_APPLICATION and VCAP_SERVICES of an app for local development"`
+	Login                              v6.LoginCommand                              `command:"login" alias:"l" feature:"login-v2" refactored-flags:"--client-id,--client-secret,--device,--origin,--refresh-token,--sso-browser,--timeout" description:"Log user in"`
 	Logout                             v6.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
 	Logs                               v6.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
 	MapRoute                           v6.MapRouteCommand                           `command:"map-route" description:"Add a url route to an app"`
 	Marketplace                        v6.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
 	MigrateServiceInstances            v6.MigrateServiceInstancesCommand            `command:"migrate-service-instances" description:"Migrate service instances from one service plan to another"`
+	MigrateSpace                       v6.MigrateSpaceCommand                       `command:"migrate-space" description:"Migrate the apps and user provided services of a space to another foundation"`
 	OauthToken                         v6.OauthTokenCommand                         `command:"oauth-token" description:"Retrieve and display the OAuth token for the current session"`
 	Orgs                               v6.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
 	OrgUsers                           v6.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v6.OrgCommand                                `command:"org" description:"Show org info"`
+	Owners                             v6.OwnersCommand                             `command:"owners" description:"List the owners of an org and its spaces and apps"`
 	Passwd                             v6.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
-	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Plugins                            PluginsCommand                               `command:"plugins" description:"List commands of installed plugins"`
 	PurgeServiceInstance               v6.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v6.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v6.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
 	Quotas                             v6.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v6.QuotaCommand                              `command:"quota" description:"Show quota info"`
+	RebindService                      v6.RebindServiceCommand                      `command:"rebind-service" description:"Unbind and bind a service instance to an app in one step to pick up rotated credentials"`
 	RemoveNetworkPolicy                v6.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	RenameBuildpack                    v6.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
@@ -140,6 +166,7 @@ type commandList struct {
 	RenameSpace                        v6.RenameSpaceCommand                        `command:"rename-space" description:"Rename a space"`
 	Rename                             v6.RenameCommand                             `command:"rename" description:"Rename an app"`
 	RepoPlugins                        plugin.RepoPluginsCommand                    `command:"repo-plugins" description:"List all available plugins in specified repository or in all added repositories"`
+	Requests                           v6.RequestsCommand                           `command:"requests" description:"Show recent requests to an app with response time percentiles and top paths"`
 	ResetOrgDefaultIsolationSegment    v6.ResetOrgDefaultIsolationSegmentCommand    `command:"reset-org-default-isolation-segment" description:"Reset the default isolation segment used for apps in spaces of an org"`
 	ResetSpaceIsolationSegment         v6.ResetSpaceIsolationSegmentCommand         `command:"reset-space-isolation-segment" description:"Reset the space's isolation segment to the org default"`
 	Restage                            v6.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.). This action will cause app downtime."`
@@ -147,10 +174,14 @@ type commandList struct {
 	Restart                            v6.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
 	RouterGroups                       v6.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v6.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
+	RunDueTasks                        v6.RunDueTasksCommand                        `command:"run-due-tasks" description:"Run the scheduled tasks that are due"`
 	RunningEnvironmentVariableGroup    v6.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v6.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups in the set of security groups for running applications"`
+	RunScript                          RunScriptCommand                             `command:"run-script" description:"Run a sequence of commands from a script in a single session"`
 	RunTask                            v6.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	Scale                              v6.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	ScheduledTasks                     v6.ScheduledTasksCommand                     `command:"scheduled-tasks" description:"List task schedules of an app or space"`
+	ScheduleTask                       v6.ScheduleTaskCommand                       `command:"schedule-task" description:"Schedule a task to run on an app on a recurring basis"`
 	SecurityGroups                     v6.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	SecurityGroup                      v6.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
 	ServiceAccess                      v6.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
@@ -158,12 +189,15 @@ type commandList struct {
 	ServiceBrokers                     v6.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKeys                        v6.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	ServiceKey                         v6.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
+	ServiceEnv                         v6.ServiceEnvCommand                         `command:"service-env" description:"Print the credentials of a service instance as environment variables"`
 	Services                           v6.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
 	Service                            v6.ServiceCommand                            `command:"service" description:"Show service instance info"`
+	Dashboard                          v6.DashboardCommand                          `command:"dashboard" description:"Open the dashboard of a service instance in a browser"`
 	SetEnv                             v6.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v6.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
 	SetOrgDefaultIsolationSegment      v6.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v6.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
+	SetOwner                           v6.SetOwnerCommand                           `command:"set-owner" description:"Set the owner, team and contact of an org, space or app"`
 	SetQuota                           v6.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
 	SetRunningEnvironmentVariableGroup v6.SetRunningEnvironmentVariableGroupCommand `command:"set-running-environment-variable-group" alias:"srevg" description:"Pass parameters as JSON to create a running environment variable group"`
 	SetSpaceIsolationSegment           v6.SetSpaceIsolationSegmentCommand           `command:"set-space-isolation-segment" description:"Assign the isolation segment for a space"`
@@ -183,9 +217,12 @@ type commandList struct {
 	SSH                                v6.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	Stacks                             v6.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	Stack                              v6.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StagingEnv                         v6.StagingEnvCommand                         `command:"staging-env" description:"Show the environment the next staging of an app will see"`
 	StagingEnvironmentVariableGroup    v6.StagingEnvironmentVariableGroupCommand    `command:"staging-environment-variable-group" alias:"sevg" description:"Retrieve the contents of the staging environment variable group"`
 	StagingSecurityGroups              v6.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups in the staging set for applications"`
 	Start                              v6.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
+	Status                             v6.StatusCommand                             `command:"status" description:"Show the API endpoint, user, targeted org and space and other session information"`
+	Stats                              v6.StatsCommand                              `command:"stats" description:"Show or configure the opt-in recording of command usage"`
 	Stop                               v6.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v6.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Tasks                              v6.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
@@ -195,8 +232,10 @@ type commandList struct {
 	UnbindSecurityGroup                v6.UnbindSecurityGroupCommand                `command:"unbind-security-group" description:"Unbind a security group from a space"`
 	UnbindService                      v6.UnbindServiceCommand                      `command:"unbind-service" alias:"us" description:"Unbind a service instance from an app"`
 	UnbindStagingSecurityGroup         v6.UnbindStagingSecurityGroupCommand         `command:"unbind-staging-security-group" description:"Unbind a security group from the set of security groups for staging applications"`
+	UnhealthyApps                      v6.UnhealthyAppsCommand                      `command:"unhealthy-apps" description:"List started apps running fewer instances than desired across spaces"`
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v6.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
+	UnscheduleTask                     v6.UnscheduleTaskCommand                     `command:"unschedule-task" description:"Remove a task schedule from an app"`
 	UnsetEnv                           v6.UnsetEnvCommand                           `command:"unset-env" alias:"ue" description:"Remove an env variable from an app"`
 	UnsetOrgRole                       v6.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	UnsetSpaceQuota                    v6.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
@@ -212,6 +251,7 @@ type commandList struct {
 	UpdateSpaceQuota                   v6.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v6.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
+	WS                                 v6.WSCommand                                 `command:"ws" description:"Open a websocket connection to the targeted API or another platform endpoint and print the frames received"`
 }
 
 // HasCommand returns true if the command name is in the command list.
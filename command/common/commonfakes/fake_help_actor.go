@@ -34,6 +34,18 @@ type FakeHelpActor struct {
 	commandInfosReturnsOnCall map[int]struct {
 		result1 map[string]sharedaction.CommandInfo
 	}
+	SearchCommandInfosStub        func(interface{}, string) []sharedaction.CommandInfo
+	searchCommandInfosMutex       sync.RWMutex
+	searchCommandInfosArgsForCall []struct {
+		arg1 interface{}
+		arg2 string
+	}
+	searchCommandInfosReturns struct {
+		result1 []sharedaction.CommandInfo
+	}
+	searchCommandInfosReturnsOnCall map[int]struct {
+		result1 []sharedaction.CommandInfo
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -162,6 +174,67 @@ func (fake *FakeHelpActor) CommandInfosReturnsOnCall(i int, result1 map[string]s
 	}{result1}
 }
 
+func (fake *FakeHelpActor) SearchCommandInfos(arg1 interface{}, arg2 string) []sharedaction.CommandInfo {
+	fake.searchCommandInfosMutex.Lock()
+	ret, specificReturn := fake.searchCommandInfosReturnsOnCall[len(fake.searchCommandInfosArgsForCall)]
+	fake.searchCommandInfosArgsForCall = append(fake.searchCommandInfosArgsForCall, struct {
+		arg1 interface{}
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SearchCommandInfos", []interface{}{arg1, arg2})
+	fake.searchCommandInfosMutex.Unlock()
+	if fake.SearchCommandInfosStub != nil {
+		return fake.SearchCommandInfosStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.searchCommandInfosReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeHelpActor) SearchCommandInfosCallCount() int {
+	fake.searchCommandInfosMutex.RLock()
+	defer fake.searchCommandInfosMutex.RUnlock()
+	return len(fake.searchCommandInfosArgsForCall)
+}
+
+func (fake *FakeHelpActor) SearchCommandInfosCalls(stub func(interface{}, string) []sharedaction.CommandInfo) {
+	fake.searchCommandInfosMutex.Lock()
+	defer fake.searchCommandInfosMutex.Unlock()
+	fake.SearchCommandInfosStub = stub
+}
+
+func (fake *FakeHelpActor) SearchCommandInfosArgsForCall(i int) (interface{}, string) {
+	fake.searchCommandInfosMutex.RLock()
+	defer fake.searchCommandInfosMutex.RUnlock()
+	argsForCall := fake.searchCommandInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeHelpActor) SearchCommandInfosReturns(result1 []sharedaction.CommandInfo) {
+	fake.searchCommandInfosMutex.Lock()
+	defer fake.searchCommandInfosMutex.Unlock()
+	fake.SearchCommandInfosStub = nil
+	fake.searchCommandInfosReturns = struct {
+		result1 []sharedaction.CommandInfo
+	}{result1}
+}
+
+func (fake *FakeHelpActor) SearchCommandInfosReturnsOnCall(i int, result1 []sharedaction.CommandInfo) {
+	fake.searchCommandInfosMutex.Lock()
+	defer fake.searchCommandInfosMutex.Unlock()
+	fake.SearchCommandInfosStub = nil
+	if fake.searchCommandInfosReturnsOnCall == nil {
+		fake.searchCommandInfosReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.CommandInfo
+		})
+	}
+	fake.searchCommandInfosReturnsOnCall[i] = struct {
+		result1 []sharedaction.CommandInfo
+	}{result1}
+}
+
 func (fake *FakeHelpActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -169,6 +242,8 @@ func (fake *FakeHelpActor) Invocations() map[string][][]interface{} {
 	defer fake.commandInfoByNameMutex.RUnlock()
 	fake.commandInfosMutex.RLock()
 	defer fake.commandInfosMutex.RUnlock()
+	fake.searchCommandInfosMutex.RLock()
+	defer fake.searchCommandInfosMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -51,12 +51,11 @@ type FakeInstallPluginActor struct {
 	fileExistsReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	GetAndValidatePluginStub        func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)
+	GetAndValidatePluginStub        func(pluginaction.PluginMetadata, string) (configv3.Plugin, error)
 	getAndValidatePluginMutex       sync.RWMutex
 	getAndValidatePluginArgsForCall []struct {
 		arg1 pluginaction.PluginMetadata
-		arg2 pluginaction.CommandList
-		arg3 string
+		arg2 string
 	}
 	getAndValidatePluginReturns struct {
 		result1 configv3.Plugin
@@ -66,6 +65,17 @@ type FakeInstallPluginActor struct {
 		result1 configv3.Plugin
 		result2 error
 	}
+	GetCommandConflictsStub        func(pluginaction.CommandList) []pluginaction.CommandConflict
+	getCommandConflictsMutex       sync.RWMutex
+	getCommandConflictsArgsForCall []struct {
+		arg1 pluginaction.CommandList
+	}
+	getCommandConflictsReturns struct {
+		result1 []pluginaction.CommandConflict
+	}
+	getCommandConflictsReturnsOnCall map[int]struct {
+		result1 []pluginaction.CommandConflict
+	}
 	GetPlatformStringStub        func(string, string) string
 	getPlatformStringMutex       sync.RWMutex
 	getPlatformStringArgsForCall []struct {
@@ -337,18 +347,17 @@ func (fake *FakeInstallPluginActor) FileExistsReturnsOnCall(i int, result1 bool)
 	}{result1}
 }
 
-func (fake *FakeInstallPluginActor) GetAndValidatePlugin(arg1 pluginaction.PluginMetadata, arg2 pluginaction.CommandList, arg3 string) (configv3.Plugin, error) {
+func (fake *FakeInstallPluginActor) GetAndValidatePlugin(arg1 pluginaction.PluginMetadata, arg2 string) (configv3.Plugin, error) {
 	fake.getAndValidatePluginMutex.Lock()
 	ret, specificReturn := fake.getAndValidatePluginReturnsOnCall[len(fake.getAndValidatePluginArgsForCall)]
 	fake.getAndValidatePluginArgsForCall = append(fake.getAndValidatePluginArgsForCall, struct {
 		arg1 pluginaction.PluginMetadata
-		arg2 pluginaction.CommandList
-		arg3 string
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("GetAndValidatePlugin", []interface{}{arg1, arg2, arg3})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetAndValidatePlugin", []interface{}{arg1, arg2})
 	fake.getAndValidatePluginMutex.Unlock()
 	if fake.GetAndValidatePluginStub != nil {
-		return fake.GetAndValidatePluginStub(arg1, arg2, arg3)
+		return fake.GetAndValidatePluginStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -363,17 +372,17 @@ func (fake *FakeInstallPluginActor) GetAndValidatePluginCallCount() int {
 	return len(fake.getAndValidatePluginArgsForCall)
 }
 
-func (fake *FakeInstallPluginActor) GetAndValidatePluginCalls(stub func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)) {
+func (fake *FakeInstallPluginActor) GetAndValidatePluginCalls(stub func(pluginaction.PluginMetadata, string) (configv3.Plugin, error)) {
 	fake.getAndValidatePluginMutex.Lock()
 	defer fake.getAndValidatePluginMutex.Unlock()
 	fake.GetAndValidatePluginStub = stub
 }
 
-func (fake *FakeInstallPluginActor) GetAndValidatePluginArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.CommandList, string) {
+func (fake *FakeInstallPluginActor) GetAndValidatePluginArgsForCall(i int) (pluginaction.PluginMetadata, string) {
 	fake.getAndValidatePluginMutex.RLock()
 	defer fake.getAndValidatePluginMutex.RUnlock()
 	argsForCall := fake.getAndValidatePluginArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeInstallPluginActor) GetAndValidatePluginReturns(result1 configv3.Plugin, result2 error) {
@@ -402,6 +411,66 @@ func (fake *FakeInstallPluginActor) GetAndValidatePluginReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) GetCommandConflicts(arg1 pluginaction.CommandList) []pluginaction.CommandConflict {
+	fake.getCommandConflictsMutex.Lock()
+	ret, specificReturn := fake.getCommandConflictsReturnsOnCall[len(fake.getCommandConflictsArgsForCall)]
+	fake.getCommandConflictsArgsForCall = append(fake.getCommandConflictsArgsForCall, struct {
+		arg1 pluginaction.CommandList
+	}{arg1})
+	fake.recordInvocation("GetCommandConflicts", []interface{}{arg1})
+	fake.getCommandConflictsMutex.Unlock()
+	if fake.GetCommandConflictsStub != nil {
+		return fake.GetCommandConflictsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.getCommandConflictsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) GetCommandConflictsCallCount() int {
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
+	return len(fake.getCommandConflictsArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetCommandConflictsCalls(stub func(pluginaction.CommandList) []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = stub
+}
+
+func (fake *FakeInstallPluginActor) GetCommandConflictsArgsForCall(i int) pluginaction.CommandList {
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
+	argsForCall := fake.getCommandConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInstallPluginActor) GetCommandConflictsReturns(result1 []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = nil
+	fake.getCommandConflictsReturns = struct {
+		result1 []pluginaction.CommandConflict
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) GetCommandConflictsReturnsOnCall(i int, result1 []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = nil
+	if fake.getCommandConflictsReturnsOnCall == nil {
+		fake.getCommandConflictsReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.CommandConflict
+		})
+	}
+	fake.getCommandConflictsReturnsOnCall[i] = struct {
+		result1 []pluginaction.CommandConflict
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) GetPlatformString(arg1 string, arg2 string) string {
 	fake.getPlatformStringMutex.Lock()
 	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
@@ -793,6 +862,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.fileExistsMutex.RUnlock()
 	fake.getAndValidatePluginMutex.RLock()
 	defer fake.getAndValidatePluginMutex.RUnlock()
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
 	fake.getPlatformStringMutex.RLock()
 	defer fake.getPlatformStringMutex.RUnlock()
 	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
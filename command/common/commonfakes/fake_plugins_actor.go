@@ -1,14 +1,25 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package pluginfakes
+package commonfakes
 
 import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
-	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/common"
 )
 
 type FakePluginsActor struct {
+	GetCommandConflictsStub        func(pluginaction.CommandList) []pluginaction.CommandConflict
+	getCommandConflictsMutex       sync.RWMutex
+	getCommandConflictsArgsForCall []struct {
+		arg1 pluginaction.CommandList
+	}
+	getCommandConflictsReturns struct {
+		result1 []pluginaction.CommandConflict
+	}
+	getCommandConflictsReturnsOnCall map[int]struct {
+		result1 []pluginaction.CommandConflict
+	}
 	GetOutdatedPluginsStub        func() ([]pluginaction.OutdatedPlugin, error)
 	getOutdatedPluginsMutex       sync.RWMutex
 	getOutdatedPluginsArgsForCall []struct {
@@ -25,6 +36,66 @@ type FakePluginsActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePluginsActor) GetCommandConflicts(arg1 pluginaction.CommandList) []pluginaction.CommandConflict {
+	fake.getCommandConflictsMutex.Lock()
+	ret, specificReturn := fake.getCommandConflictsReturnsOnCall[len(fake.getCommandConflictsArgsForCall)]
+	fake.getCommandConflictsArgsForCall = append(fake.getCommandConflictsArgsForCall, struct {
+		arg1 pluginaction.CommandList
+	}{arg1})
+	fake.recordInvocation("GetCommandConflicts", []interface{}{arg1})
+	fake.getCommandConflictsMutex.Unlock()
+	if fake.GetCommandConflictsStub != nil {
+		return fake.GetCommandConflictsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.getCommandConflictsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePluginsActor) GetCommandConflictsCallCount() int {
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
+	return len(fake.getCommandConflictsArgsForCall)
+}
+
+func (fake *FakePluginsActor) GetCommandConflictsCalls(stub func(pluginaction.CommandList) []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = stub
+}
+
+func (fake *FakePluginsActor) GetCommandConflictsArgsForCall(i int) pluginaction.CommandList {
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
+	argsForCall := fake.getCommandConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePluginsActor) GetCommandConflictsReturns(result1 []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = nil
+	fake.getCommandConflictsReturns = struct {
+		result1 []pluginaction.CommandConflict
+	}{result1}
+}
+
+func (fake *FakePluginsActor) GetCommandConflictsReturnsOnCall(i int, result1 []pluginaction.CommandConflict) {
+	fake.getCommandConflictsMutex.Lock()
+	defer fake.getCommandConflictsMutex.Unlock()
+	fake.GetCommandConflictsStub = nil
+	if fake.getCommandConflictsReturnsOnCall == nil {
+		fake.getCommandConflictsReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.CommandConflict
+		})
+	}
+	fake.getCommandConflictsReturnsOnCall[i] = struct {
+		result1 []pluginaction.CommandConflict
+	}{result1}
+}
+
 func (fake *FakePluginsActor) GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error) {
 	fake.getOutdatedPluginsMutex.Lock()
 	ret, specificReturn := fake.getOutdatedPluginsReturnsOnCall[len(fake.getOutdatedPluginsArgsForCall)]
@@ -83,6 +154,8 @@ func (fake *FakePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []p
 func (fake *FakePluginsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getCommandConflictsMutex.RLock()
+	defer fake.getCommandConflictsMutex.RUnlock()
 	fake.getOutdatedPluginsMutex.RLock()
 	defer fake.getOutdatedPluginsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -104,4 +177,4 @@ func (fake *FakePluginsActor) recordInvocation(key string, args []interface{}) {
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ plugin.PluginsActor = new(FakePluginsActor)
+var _ common.PluginsActor = new(FakePluginsActor)
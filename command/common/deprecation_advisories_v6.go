@@ -0,0 +1,12 @@
+// +build !V7
+
+package common
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/deprecation"
+)
+
+func displayDeprecationAdvisories(ui command.UI, binaryName string, commandName string, setFlags []string) {
+	deprecation.Display(ui, binaryName, deprecation.ForCommand(commandName, setFlags)...)
+}
@@ -0,0 +1,8 @@
+// +build V7
+
+package common
+
+import "code.cloudfoundry.org/cli/command"
+
+func displayDeprecationAdvisories(ui command.UI, binaryName string, commandName string, setFlags []string) {
+}
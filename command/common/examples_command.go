@@ -0,0 +1,86 @@
+package common
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ExamplesCommand struct {
+	RequiredArgs    flag.RequiredCommandName `positional-args:"yes"`
+	usage           interface{}              `usage:"CF_NAME examples COMMAND"`
+	examples        interface{}              `examples:"CF_NAME examples push # Show examples of pushing apps\nCF_NAME examples create-route # Show examples of creating routes"`
+	relatedCommands interface{}              `related_commands:"help"`
+
+	UI     command.UI
+	Actor  HelpActor
+	Config command.Config
+}
+
+func (cmd *ExamplesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Actor = sharedaction.NewActor(config)
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ExamplesCommand) Execute(args []string) error {
+	cmdInfo, err := cmd.Actor.CommandInfoByName(Commands, cmd.RequiredArgs.CommandName)
+	if err != nil {
+		if _, ok := err.(actionerror.InvalidCommandError); !ok || !cmd.isPluginCommand() {
+			return err
+		}
+	}
+
+	if len(cmdInfo.Examples) == 0 {
+		cmd.UI.DisplayText("No examples are available for '{{.CommandName}}'.", map[string]interface{}{
+			"CommandName": cmd.RequiredArgs.CommandName,
+		})
+		cmd.UI.DisplayText("TIP: Use '{{.HelpCommand}}' to see how to use the command.", map[string]interface{}{
+			"HelpCommand": cmd.Config.BinaryName() + " help " + cmd.RequiredArgs.CommandName,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Examples of {{.CommandName}}:", map[string]interface{}{
+		"CommandName": cmdInfo.Name,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayNonWrappingTable(commandIndent, examplesTable(cmdInfo.Examples, cmd.Config.BinaryName()), 1)
+
+	return nil
+}
+
+func (cmd ExamplesCommand) isPluginCommand() bool {
+	for _, pluginConfig := range cmd.Config.Plugins() {
+		for _, command := range pluginConfig.Commands {
+			if command.Name == cmd.RequiredArgs.CommandName ||
+				command.Alias == cmd.RequiredArgs.CommandName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// examplesTable lines up the examples' descriptions as shell comments after
+// the commands.
+func examplesTable(examples []sharedaction.CommandExample, binaryName string) [][]string {
+	table := [][]string{}
+	for _, example := range examples {
+		description := ""
+		if example.Description != "" {
+			description = "# " + example.Description
+		}
+		table = append(table, []string{
+			strings.Replace(example.Command, "CF_NAME", binaryName, -1),
+			description,
+		})
+	}
+	return table
+}
@@ -0,0 +1,108 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("examples Command", func() {
+	var (
+		testUI     *ui.UI
+		fakeActor  *commonfakes.FakeHelpActor
+		fakeConfig *commandfakes.FakeConfig
+		cmd        ExamplesCommand
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(commonfakes.FakeHelpActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = ExamplesCommand{
+			UI:           testUI,
+			Actor:        fakeActor,
+			Config:       fakeConfig,
+			RequiredArgs: flag.RequiredCommandName{CommandName: "create-route"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the command has examples", func() {
+		BeforeEach(func() {
+			fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{
+				Name: "create-route",
+				Examples: []sharedaction.CommandExample{
+					{Command: "CF_NAME create-route my-space example.com", Description: "example.com"},
+					{Command: "CF_NAME create-route my-space example.com --port 5000"},
+				},
+			}, nil)
+		})
+
+		It("displays the examples with the binary name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CommandInfoByNameCallCount()).To(Equal(1))
+			_, commandName := fakeActor.CommandInfoByNameArgsForCall(0)
+			Expect(commandName).To(Equal("create-route"))
+
+			Expect(testUI.Out).To(Say("Examples of create-route:"))
+			Expect(testUI.Out).To(Say(`   faceman create-route my-space example.com\s+# example.com`))
+			Expect(testUI.Out).To(Say(`   faceman create-route my-space example.com --port 5000`))
+		})
+	})
+
+	When("the command has no examples", func() {
+		BeforeEach(func() {
+			fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{Name: "create-route"}, nil)
+		})
+
+		It("points to the command's help", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("No examples are available for 'create-route'."))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman help create-route' to see how to use the command.`))
+		})
+	})
+
+	When("the command does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.CommandInfoByNameReturns(sharedaction.CommandInfo{}, actionerror.InvalidCommandError{CommandName: "create-route"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.InvalidCommandError{CommandName: "create-route"}))
+		})
+
+		When("it is a plugin command", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{
+						Name: "some-plugin",
+						Commands: []configv3.PluginCommand{
+							{Name: "create-route"},
+						},
+					},
+				})
+			})
+
+			It("says that there are no examples", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No examples are available for 'create-route'."))
+			})
+		})
+	})
+})
@@ -0,0 +1,20 @@
+package common
+
+import "github.com/jessevdk/go-flags"
+
+// SetFlagNames returns the flags set on the command line, named by their long
+// form with hyphens or by their short form when they have no long form.
+func SetFlagNames(activeCommand *flags.Command) []string {
+	var names []string
+	for _, option := range activeCommand.Options() {
+		if !option.IsSet() {
+			continue
+		}
+		if option.LongName != "" {
+			names = append(names, "--"+option.LongName)
+		} else {
+			names = append(names, "-"+string(option.ShortName))
+		}
+	}
+	return names
+}
@@ -0,0 +1,365 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const foundationsOption = "--foundations"
+
+// foundationCommands lists the read-only commands that can be run with
+// --foundations. Listing commands have their tables merged into a single
+// table with a foundation column; the output of the other commands is
+// displayed foundation by foundation.
+var foundationCommands = map[string]bool{
+	"app":                false,
+	"apps":               true,
+	"buildpacks":         true,
+	"domains":            true,
+	"env":                false,
+	"events":             true,
+	"feature-flags":      true,
+	"isolation-segments": true,
+	"jobs":               true,
+	"marketplace":        true,
+	"network-policies":   true,
+	"org":                false,
+	"orgs":               true,
+	"quota":              false,
+	"quotas":             true,
+	"requests":           false,
+	"routes":             true,
+	"security-group":     false,
+	"security-groups":    true,
+	"service":            false,
+	"service-brokers":    true,
+	"service-keys":       true,
+	"services":           true,
+	"space":              false,
+	"space-quotas":       true,
+	"spaces":             true,
+	"stack":              false,
+	"stacks":             true,
+	"status":             false,
+	"target":             false,
+	"tasks":              true,
+}
+
+var tableColumnRegexp = regexp.MustCompile(`\S+( \S+)*`)
+
+// FoundationsRunner runs a read-only command against several foundations
+// concurrently. Each foundation is run in a child process using the
+// foundation's home as CF_HOME. When InsecureForbidden is set, the child
+// processes refuse to skip SSL validation even if the config of a foundation
+// allows it.
+type FoundationsRunner struct {
+	UI                command.UI
+	Executable        string
+	InsecureForbidden bool
+}
+
+type foundationResult struct {
+	name   string
+	stdout string
+	stderr string
+	err    error
+}
+
+// NewFoundationsRunner returns a FoundationsRunner that runs the current
+// executable with the insecure-allowed setting of config.
+func NewFoundationsRunner(config command.Config, ui command.UI) (FoundationsRunner, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return FoundationsRunner{}, err
+	}
+	return FoundationsRunner{
+		UI:                ui,
+		Executable:        executable,
+		InsecureForbidden: !config.InsecureAllowed(),
+	}, nil
+}
+
+// Run runs args, with the --foundations option removed, on each of the comma
+// separated foundations and displays the combined output.
+func (runner FoundationsRunner) Run(foundations string, args []string) error {
+	args = removeFoundationsOption(args)
+
+	commandName := foundationCommandName(args)
+	mergeTables, readOnly := foundationCommands[commandName]
+	if !readOnly {
+		return translatableerror.FoundationsCommandNotSupportedError{Command: commandName}
+	}
+
+	var names []string
+	for _, name := range strings.Split(foundations, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !configv3.FoundationExists(name) {
+			return translatableerror.FoundationNotFoundError{Name: name, Path: configv3.FoundationHome(name)}
+		}
+		names = append(names, name)
+	}
+
+	runner.UI.DisplayText("Running '{{.Command}}' on foundations {{.Foundations}}...", map[string]interface{}{
+		"Command":     strings.Join(args, " "),
+		"Foundations": strings.Join(names, ", "),
+	})
+	runner.UI.DisplayNewline()
+
+	results := runner.runAll(names, args)
+
+	var failed []string
+	for _, result := range results {
+		if result.err != nil {
+			failed = append(failed, result.name)
+		}
+	}
+
+	runner.displayResults(results, mergeTables)
+
+	if len(failed) > 0 {
+		runner.UI.DisplayNewline()
+		return translatableerror.FoundationsFailedError{Foundations: failed}
+	}
+	return nil
+}
+
+func (runner FoundationsRunner) runAll(names []string, args []string) []foundationResult {
+	results := make([]foundationResult, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i] = runner.runOne(name, args)
+		}(i, name)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (runner FoundationsRunner) runOne(name string, args []string) foundationResult {
+	var stdout, stderr bytes.Buffer
+
+	child := exec.Command(runner.Executable, args...)
+	child.Env = append(os.Environ(), "CF_HOME="+configv3.FoundationHome(name), "CF_COLOR=false")
+	if runner.InsecureForbidden {
+		child.Env = append(child.Env, "CF_INSECURE_ALLOWED=false")
+	}
+	child.Stdout = &stdout
+	child.Stderr = &stderr
+	err := child.Run()
+
+	return foundationResult{
+		name:   name,
+		stdout: stdout.String(),
+		stderr: stderr.String(),
+		err:    err,
+	}
+}
+
+func (runner FoundationsRunner) displayResults(results []foundationResult, mergeTables bool) {
+	var (
+		header   []string
+		table    [][]string
+		sections []foundationResult
+	)
+
+	if mergeTables {
+		header = commonTableHeader(results)
+	}
+
+	for _, result := range results {
+		if result.err == nil && header != nil {
+			resultHeader, rows := parseTable(result.stdout)
+			if reflect.DeepEqual(resultHeader, header) {
+				for _, row := range rows {
+					table = append(table, append([]string{result.name}, row...))
+				}
+				continue
+			}
+		}
+		sections = append(sections, result)
+	}
+
+	if len(table) > 0 {
+		runner.UI.DisplayTableWithHeader("", append([][]string{append([]string{runner.UI.TranslateText("foundation")}, header...)}, table...), ui.DefaultTableSpacePadding)
+	}
+
+	for i, result := range sections {
+		if i > 0 || len(table) > 0 {
+			runner.UI.DisplayNewline()
+		}
+		runner.displaySection(result)
+	}
+}
+
+func (runner FoundationsRunner) displaySection(result foundationResult) {
+	if result.err != nil {
+		fmt.Fprintln(runner.UI.GetErr(), runner.UI.TranslateText("{{.Foundation}}: FAILED", map[string]interface{}{
+			"Foundation": result.name,
+		}))
+		for _, line := range outputLines(result.stdout + result.stderr) {
+			if strings.TrimSpace(line) != "" {
+				fmt.Fprintf(runner.UI.GetErr(), "  %s\n", line)
+			}
+		}
+		return
+	}
+
+	runner.UI.DisplayText("{{.Foundation}}:", map[string]interface{}{
+		"Foundation": result.name,
+	})
+	for _, line := range outputLines(result.stdout) {
+		runner.UI.DisplayText("  {{.Line}}", map[string]interface{}{"Line": line})
+	}
+	for _, line := range outputLines(result.stderr) {
+		fmt.Fprintf(runner.UI.GetErr(), "  %s\n", line)
+	}
+}
+
+// commonTableHeader returns the table header shared by most of the
+// successful results, preferring the earliest foundation on a tie.
+func commonTableHeader(results []foundationResult) []string {
+	var (
+		headers [][]string
+		counts  []int
+	)
+
+	for _, result := range results {
+		if result.err != nil {
+			continue
+		}
+		header, rows := parseTable(result.stdout)
+		if header == nil || len(rows) == 0 {
+			continue
+		}
+
+		found := false
+		for i, existing := range headers {
+			if reflect.DeepEqual(existing, header) {
+				counts[i]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			headers = append(headers, header)
+			counts = append(counts, 1)
+		}
+	}
+
+	var best []string
+	bestCount := 0
+	for i, header := range headers {
+		if counts[i] > bestCount {
+			best = header
+			bestCount = counts[i]
+		}
+	}
+	return best
+}
+
+// parseTable parses the table at the end of a command's output. The first
+// line of the last block of output is the header; the rows are split at the
+// header's column offsets.
+func parseTable(output string) ([]string, [][]string) {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+
+	start := len(lines)
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+	if start == len(lines) {
+		return nil, nil
+	}
+	block := lines[start:]
+
+	var (
+		header  []string
+		offsets []int
+	)
+	for _, match := range tableColumnRegexp.FindAllStringIndex(block[0], -1) {
+		header = append(header, block[0][match[0]:match[1]])
+		offsets = append(offsets, len([]rune(block[0][:match[0]])))
+	}
+
+	var rows [][]string
+	for _, line := range block[1:] {
+		rowLine := []rune(line)
+		row := make([]string, len(offsets))
+		for i, offset := range offsets {
+			if offset >= len(rowLine) {
+				continue
+			}
+			end := len(rowLine)
+			if i+1 < len(offsets) && offsets[i+1] < end {
+				end = offsets[i+1]
+			}
+			row[i] = strings.TrimSpace(string(rowLine[offset:end]))
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+func outputLines(output string) []string {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
+
+// removeFoundationsOption returns args without the --foundations option and
+// its value.
+func removeFoundationsOption(args []string) []string {
+	var remaining []string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == foundationsOption:
+			i++
+		case strings.HasPrefix(args[i], foundationsOption+"="):
+		default:
+			remaining = append(remaining, args[i])
+		}
+	}
+	return remaining
+}
+
+// foundationCommandName returns the name of the command in args, resolving
+// aliases.
+func foundationCommandName(args []string) string {
+	var name string
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			name = arg
+			break
+		}
+	}
+
+	commandListType := reflect.TypeOf(Commands)
+	for i := 0; i < commandListType.NumField(); i++ {
+		field := commandListType.Field(i)
+		if field.Tag.Get("alias") == name && name != "" {
+			return field.Tag.Get("command")
+		}
+	}
+	return name
+}
@@ -0,0 +1,156 @@
+// +build !windows
+
+package common_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+const fakeCFScript = `#!/bin/sh
+foundation=$(basename "$CF_HOME")
+echo "$@" > "$CF_HOME/args"
+echo "$CF_INSECURE_ALLOWED" > "$CF_HOME/insecure-allowed"
+case "$foundation" in
+prod-eu)
+	echo "Getting apps in org o / space s as admin..."
+	echo "OK"
+	echo
+	echo "name    requested state   instances"
+	echo "app-1   started           1/1"
+	echo "app-2   stopped           0/1"
+	;;
+prod-us)
+	echo "Getting apps in org o / space s as admin..."
+	echo "OK"
+	echo
+	echo "name             requested state   instances"
+	echo "app-with-a-long  started           2/2"
+	;;
+empty)
+	echo "Getting apps in org o / space s as admin..."
+	echo "OK"
+	echo
+	echo "No apps found"
+	;;
+broken)
+	echo "FAILED"
+	echo "Not logged in." >&2
+	exit 1
+	;;
+esac
+`
+
+var _ = Describe("FoundationsRunner", func() {
+	var (
+		runner  FoundationsRunner
+		testUI  *ui.UI
+		homeDir string
+		err     error
+	)
+
+	saveFoundation := func(name string) {
+		configDir := filepath.Join(configv3.FoundationHome(name), ".cf")
+		Expect(os.MkdirAll(configDir, 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(configDir, "config.json"), []byte("{}"), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+
+		homeDir, err = ioutil.TempDir("", "foundations-runner-test")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+
+		executable := filepath.Join(homeDir, "cf")
+		Expect(ioutil.WriteFile(executable, []byte(fakeCFScript), 0700)).To(Succeed())
+
+		for _, name := range []string{"prod-eu", "prod-us", "empty", "broken"} {
+			saveFoundation(name)
+		}
+
+		runner = FoundationsRunner{UI: testUI, Executable: executable}
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+		Expect(os.RemoveAll(homeDir)).To(Succeed())
+	})
+
+	It("runs the command without the foundations option against each foundation", func() {
+		err = runner.Run("prod-eu,prod-us", []string{"--foundations", "prod-eu,prod-us", "apps"})
+		Expect(err).ToNot(HaveOccurred())
+
+		args, readErr := ioutil.ReadFile(filepath.Join(configv3.FoundationHome("prod-us"), "args"))
+		Expect(readErr).ToNot(HaveOccurred())
+		Expect(string(args)).To(Equal("apps\n"))
+	})
+
+	When("skipping SSL validation is forbidden", func() {
+		BeforeEach(func() {
+			runner.InsecureForbidden = true
+		})
+
+		It("forbids it on each foundation", func() {
+			err = runner.Run("prod-eu", []string{"apps"})
+			Expect(err).ToNot(HaveOccurred())
+
+			insecureAllowed, readErr := ioutil.ReadFile(filepath.Join(configv3.FoundationHome("prod-eu"), "insecure-allowed"))
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(insecureAllowed)).To(Equal("false\n"))
+		})
+	})
+
+	It("merges the tables with a foundation column", func() {
+		err = runner.Run("prod-eu, prod-us", []string{"--foundations=prod-eu, prod-us", "a"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Running 'a' on foundations prod-eu, prod-us\.\.\.`))
+		Expect(testUI.Out).To(Say(`foundation\s+name\s+requested state\s+instances`))
+		Expect(testUI.Out).To(Say(`prod-eu\s+app-1\s+started\s+1/1`))
+		Expect(testUI.Out).To(Say(`prod-eu\s+app-2\s+stopped\s+0/1`))
+		Expect(testUI.Out).To(Say(`prod-us\s+app-with-a-long\s+started\s+2/2`))
+	})
+
+	It("displays output without a table foundation by foundation", func() {
+		err = runner.Run("prod-eu,empty", []string{"apps"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`prod-eu\s+app-1\s+started\s+1/1`))
+		Expect(testUI.Out).To(Say(`empty:`))
+		Expect(testUI.Out).To(Say(`  No apps found`))
+	})
+
+	It("reports the foundations the command failed on", func() {
+		err = runner.Run("broken,prod-eu", []string{"apps"})
+		Expect(err).To(MatchError(translatableerror.FoundationsFailedError{Foundations: []string{"broken"}}))
+
+		Expect(testUI.Out).To(Say(`prod-eu\s+app-1\s+started\s+1/1`))
+		Expect(testUI.Err).To(Say(`broken: FAILED`))
+		Expect(testUI.Err).To(Say(`  FAILED`))
+		Expect(testUI.Err).To(Say(`  Not logged in\.`))
+	})
+
+	It("returns an error for a command that is not read-only", func() {
+		err = runner.Run("prod-eu", []string{"delete", "app-1", "-f"})
+		Expect(err).To(MatchError(translatableerror.FoundationsCommandNotSupportedError{Command: "delete"}))
+	})
+
+	It("returns an error for a foundation that has not been saved", func() {
+		err = runner.Run("prod-eu,staging", []string{"apps"})
+		Expect(err).To(MatchError(translatableerror.FoundationNotFoundError{
+			Name: "staging",
+			Path: configv3.FoundationHome("staging"),
+		}))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common/internal"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -29,6 +30,10 @@ type HelpActor interface {
 
 	// CommandInfos returns a list of all commands
 	CommandInfos(interface{}) map[string]sharedaction.CommandInfo
+
+	// SearchCommandInfos returns the help information of the commands matching
+	// the given keyword
+	SearchCommandInfos(interface{}, string) []sharedaction.CommandInfo
 }
 
 type HelpCommand struct {
@@ -36,9 +41,12 @@ type HelpCommand struct {
 	Actor  HelpActor
 	Config command.Config
 
-	OptionalArgs flag.CommandName `positional-args:"yes"`
-	AllCommands  bool             `short:"a" description:"All available CLI commands"`
-	usage        interface{}      `usage:"CF_NAME help [COMMAND]"`
+	OptionalArgs    flag.CommandName `positional-args:"yes"`
+	AllCommands     bool             `short:"a" description:"All available CLI commands"`
+	Search          string           `long:"search" description:"List the commands whose name, description or options contain KEYWORD"`
+	usage           interface{}      `usage:"CF_NAME help [COMMAND]\n   CF_NAME help --search KEYWORD"`
+	examples        interface{}      `examples:"CF_NAME help push # Show help for the push command\nCF_NAME help -a # List all commands\nCF_NAME help --search route # Find the commands and options about routes"`
+	relatedCommands interface{}      `related_commands:"examples"`
 }
 
 func (cmd *HelpCommand) Setup(config command.Config, ui command.UI) error {
@@ -50,6 +58,22 @@ func (cmd *HelpCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd HelpCommand) Execute(args []string) error {
+	if cmd.Search != "" {
+		if cmd.OptionalArgs.CommandName != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"COMMAND", "--search"},
+			}
+		}
+		if cmd.AllCommands {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"-a", "--search"},
+			}
+		}
+
+		cmd.displaySearchResults()
+		return nil
+	}
+
 	var err error
 	if cmd.OptionalArgs.CommandName == "" {
 		cmd.displayFullHelp()
@@ -245,6 +269,12 @@ func (cmd HelpCommand) displayCommand() error {
 			"CommandUsage": cmd.UI.TranslateText(usageString),
 		})
 
+	if len(cmdInfo.Examples) > 0 && !strings.Contains(cmdInfo.Usage, "EXAMPLE") {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("EXAMPLES:")
+		cmd.UI.DisplayNonWrappingTable(commandIndent, examplesTable(cmdInfo.Examples, cmd.Config.BinaryName()), 1)
+	}
+
 	if cmdInfo.Alias != "" {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("ALIAS:")
@@ -298,12 +328,55 @@ func (cmd HelpCommand) displayCommand() error {
 	return nil
 }
 
+func (cmd HelpCommand) displaySearchResults() {
+	table := [][]string{}
+	for _, cmdInfo := range cmd.Actor.SearchCommandInfos(Commands, cmd.Search) {
+		table = append(table, []string{cmdInfo.Name, cmd.UI.TranslateText(cmdInfo.Description)})
+		for _, flag := range cmdInfo.Flags {
+			table = append(table, []string{
+				commandIndent + internal.FlagWithHyphens(flag),
+				cmd.UI.TranslateText(flag.Description),
+			})
+		}
+	}
+
+	keyword := strings.ToLower(cmd.Search)
+	for _, pluginCommand := range cmd.getSortedPluginCommands() {
+		if strings.Contains(strings.ToLower(pluginCommand.Name), keyword) ||
+			strings.Contains(strings.ToLower(pluginCommand.HelpText), keyword) {
+			table = append(table, []string{pluginCommand.Name, pluginCommand.HelpText})
+		}
+	}
+
+	if len(table) == 0 {
+		cmd.UI.DisplayText("No commands match '{{.Keyword}}'.", map[string]interface{}{
+			"Keyword": cmd.Search,
+		})
+		cmd.UI.DisplayText("TIP: Use '{{.FullHelpCommand}}' to see all commands.", map[string]interface{}{
+			"FullHelpCommand": cmd.Config.BinaryName() + " help -a",
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Commands matching '{{.Keyword}}':", map[string]interface{}{
+		"Keyword": cmd.Search,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, table, 3)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.HelpCommand}}' for details about a command.", map[string]interface{}{
+		"HelpCommand": cmd.Config.BinaryName() + " help COMMAND",
+	})
+}
+
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
+		{"CF_DRY_RUN=true", cmd.UI.TranslateText("Print the API requests that would change resources instead of sending them")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_REASON=CHG12345", cmd.UI.TranslateText("Change reason to send in the configured change header")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
@@ -315,6 +388,10 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--foundations NAME,...", cmd.UI.TranslateText("Run a read-only command against foundations saved in ~/.cf/foundations")},
+		{"--reason REASON", cmd.UI.TranslateText("Change reason to send in the configured change header")},
+		{"--dry-run", cmd.UI.TranslateText("Print the API requests that would change resources instead of sending them")},
+		{"--timings", cmd.UI.TranslateText("Print the time spent on each kind of API request when the command ends")},
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/command/common/commonfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -107,6 +108,52 @@ var _ = Describe("help Command", func() {
 				})
 			})
 
+			Describe("examples", func() {
+				When("the command has curated examples", func() {
+					BeforeEach(func() {
+						commandInfo := sharedaction.CommandInfo{
+							Name:  "logs",
+							Usage: "CF_NAME logs APP_NAME",
+							Examples: []sharedaction.CommandExample{
+								{Command: "CF_NAME logs my-app", Description: "Stream the logs of an app"},
+							},
+						}
+						fakeActor.CommandInfoByNameReturns(commandInfo, nil)
+					})
+
+					It("displays the examples", func() {
+						err := cmd.Execute(nil)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("USAGE:"))
+						Expect(testUI.Out).To(Say("EXAMPLES:"))
+						Expect(testUI.Out).To(Say(`   faceman logs my-app\s+# Stream the logs of an app`))
+					})
+				})
+
+				When("the examples are part of the usage", func() {
+					BeforeEach(func() {
+						commandInfo := sharedaction.CommandInfo{
+							Name:  "create-route",
+							Usage: "CF_NAME create-route SPACE DOMAIN\n\nEXAMPLES:\n   CF_NAME create-route my-space example.com",
+							Examples: []sharedaction.CommandExample{
+								{Command: "CF_NAME create-route my-space example.com"},
+							},
+						}
+						fakeActor.CommandInfoByNameReturns(commandInfo, nil)
+					})
+
+					It("does not display them twice", func() {
+						err := cmd.Execute(nil)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("EXAMPLES:"))
+						Expect(testUI.Out).To(Say("faceman create-route my-space example.com"))
+						Expect(testUI.Out).ToNot(Say("EXAMPLES:"))
+					})
+				})
+			})
+
 			Describe("aliases", func() {
 				When("the command has an alias", func() {
 					It("displays the alias for help", func() {
@@ -382,7 +429,7 @@ var _ = Describe("help Command", func() {
 
 			Expect(testUI.Out).To(Say("Before getting started:"))
 			Expect(testUI.Out).To(Say(`  config\s+login,l\s+target,t`))
-			Expect(testUI.Out).To(Say(`  help,h\s+logout,lo`))
+			Expect(testUI.Out).To(Say(`  help,h\s+logout,lo\s+init`))
 
 			Expect(testUI.Out).To(Say("Application lifecycle:"))
 			Expect(testUI.Out).To(Say(`  apps,a\s+run-task,rt\s+events`))
@@ -422,6 +469,10 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say(`  --help, -h\s+Show help`))
 			Expect(testUI.Out).To(Say(`  -v\s+Print API request diagnostics to stdout`))
+			Expect(testUI.Out).To(Say(`  --foundations NAME,\.\.\.\s+Run a read-only command against foundations saved in ~/\.cf/foundations`))
+			Expect(testUI.Out).To(Say(`  --reason REASON\s+Change reason to send in the configured change header`))
+			Expect(testUI.Out).To(Say(`  --dry-run\s+Print the API requests that would change resources instead of sending them`))
+			Expect(testUI.Out).To(Say(`  --timings\s+Print the time spent on each kind of API request when the command ends`))
 
 			Expect(testUI.Out).To(Say(`TIP: Use 'cf help -a' to see all commands\.`))
 		})
@@ -608,16 +659,22 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
+				Expect(testUI.Out).To(Say("   CF_DRY_RUN=true                    Print the API requests that would change resources instead of sending them"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_REASON=CHG12345                 Change reason to send in the configured change header"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable proxying for HTTP requests"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
-				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
-				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --help, -h                                     Show help"))
+				Expect(testUI.Out).To(Say("   -v                                             Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --foundations NAME,...                         Run a read-only command against foundations saved in ~/.cf/foundations"))
+				Expect(testUI.Out).To(Say("   --reason REASON                                Change reason to send in the configured change header"))
+				Expect(testUI.Out).To(Say("   --dry-run                                      Print the API requests that would change resources instead of sending them"))
+				Expect(testUI.Out).To(Say("   --timings                                      Print the time spent on each kind of API request when the command ends"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say(`APPS \(experimental\):`))
 				Expect(testUI.Out).To(Say(`   v3-apps\s+List all apps in the target space`))
@@ -698,4 +755,92 @@ var _ = Describe("help Command", func() {
 			})
 		})
 	})
+
+	Describe("searching commands", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			cmd.Search = "route"
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		When("commands match", func() {
+			BeforeEach(func() {
+				fakeActor.SearchCommandInfosReturns([]sharedaction.CommandInfo{
+					{
+						Name:        "create-route",
+						Description: "Create a url route in a space for later use",
+					},
+					{
+						Name:        "push",
+						Description: "Push a new app or sync changes to an existing app",
+						Flags: []sharedaction.CommandFlag{
+							{Long: "no-route", Description: "Do not map a route to this app"},
+						},
+					},
+				})
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{
+						Name: "some-plugin",
+						Commands: []configv3.PluginCommand{
+							{Name: "route-lookup", HelpText: "look up a route"},
+							{Name: "unrelated", HelpText: "does something else"},
+						},
+					},
+				})
+			})
+
+			It("displays the matching commands, flags and plugin commands", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SearchCommandInfosCallCount()).To(Equal(1))
+				_, keyword := fakeActor.SearchCommandInfosArgsForCall(0)
+				Expect(keyword).To(Equal("route"))
+
+				Expect(testUI.Out).To(Say("Commands matching 'route':"))
+				Expect(testUI.Out).To(Say(`   create-route\s+Create a url route in a space for later use`))
+				Expect(testUI.Out).To(Say(`   push\s+Push a new app or sync changes to an existing app`))
+				Expect(testUI.Out).To(Say(`      --no-route\s+Do not map a route to this app`))
+				Expect(testUI.Out).To(Say(`   route-lookup\s+look up a route`))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman help COMMAND' for details about a command.`))
+				Expect(testUI.Out).ToNot(Say("unrelated"))
+			})
+		})
+
+		When("nothing matches", func() {
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("No commands match 'route'."))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman help -a' to see all commands.`))
+			})
+		})
+
+		When("a command name is also provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.CommandName{CommandName: "push"}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"COMMAND", "--search"},
+				}))
+			})
+		})
+
+		When("-a is also provided", func() {
+			BeforeEach(func() {
+				cmd.AllCommands = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"-a", "--search"},
+				}))
+			})
+		})
+	})
 })
@@ -0,0 +1,218 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/completion"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type InitCommand struct {
+	usage           interface{} `usage:"CF_NAME init\n\n   Walks through targeting an API endpoint, logging in, choosing a default org and space, color and locale preferences and installing bash completion. Each step runs the equivalent command, which can be run on its own later to change the setting."`
+	relatedCommands interface{} `related_commands:"api, config, login, target"`
+
+	UI     command.UI
+	Config command.Config
+	Runner ScriptRunner
+}
+
+func (cmd *InitCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Runner = scriptRunner{config: config, ui: ui}
+	return nil
+}
+
+func (cmd InitCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Setting up the {{.BinaryName}} CLI. Each step runs the command shown, so any setting can be changed later.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	steps := []func() error{
+		cmd.setAPI,
+		cmd.login,
+		cmd.setDefaultTarget,
+		cmd.setPreferences,
+		cmd.installCompletion,
+	}
+	for _, step := range steps {
+		cmd.UI.DisplayNewline()
+		err := step()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displaySummary()
+	return nil
+}
+
+func (cmd InitCommand) setAPI() error {
+	if target := cmd.Config.Target(); target != "" {
+		keep, err := cmd.UI.DisplayBoolPrompt(true, "Keep using API endpoint {{.Target}}?", map[string]interface{}{
+			"Target": target,
+		})
+		if err != nil || keep {
+			return err
+		}
+	}
+
+	endpoint, err := cmd.UI.DisplayTextPrompt("API endpoint (e.g. https://api.example.com)")
+	if err != nil {
+		return err
+	}
+	skipSSLValidation, err := cmd.UI.DisplayBoolPrompt(false, "Skip SSL validation? Only do this for endpoints with self-signed certificates.")
+	if err != nil {
+		return err
+	}
+
+	apiArgs := []string{"api", endpoint}
+	if skipSSLValidation {
+		apiArgs = append(apiArgs, "--skip-ssl-validation")
+	}
+	return cmd.run(apiArgs...)
+}
+
+func (cmd InitCommand) login() error {
+	prompt := "Log in now?"
+	defaultResponse := true
+	user, _ := cmd.Config.CurrentUserName()
+	if user != "" {
+		prompt = "Logged in as {{.User}}. Log in again?"
+		defaultResponse = false
+	}
+
+	login, err := cmd.UI.DisplayBoolPrompt(defaultResponse, prompt, map[string]interface{}{
+		"User": user,
+	})
+	if err != nil || !login {
+		return err
+	}
+	return cmd.run("login")
+}
+
+func (cmd InitCommand) setDefaultTarget() error {
+	setDefault, err := cmd.UI.DisplayBoolPrompt(false, "Set a default org and space to target when logging in?")
+	if err != nil || !setDefault {
+		return err
+	}
+
+	org, err := cmd.UI.DisplayTextPrompt("Default org")
+	if err != nil {
+		return err
+	}
+	err = cmd.run("config", "set", "default-org", org)
+	if err != nil {
+		return err
+	}
+
+	space, err := cmd.UI.DisplayTextPrompt("Default space")
+	if err != nil {
+		return err
+	}
+	return cmd.run("config", "set", "default-space", space)
+}
+
+func (cmd InitCommand) setPreferences() error {
+	color, err := cmd.UI.DisplayBoolPrompt(cmd.Config.ColorEnabled() != configv3.ColorDisabled, "Colorize output?")
+	if err != nil {
+		return err
+	}
+	err = cmd.run("config", "--color", strconv.FormatBool(color))
+	if err != nil {
+		return err
+	}
+
+	setLocale, err := cmd.UI.DisplayBoolPrompt(false, "Use a locale other than the system default?")
+	if err != nil || !setLocale {
+		return err
+	}
+	locale, err := cmd.UI.DisplayTextPrompt("Locale (e.g. fr-FR)")
+	if err != nil {
+		return err
+	}
+	return cmd.run("config", "--locale", locale)
+}
+
+func (cmd InitCommand) installCompletion() error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	install, err := cmd.UI.DisplayBoolPrompt(false, "Install bash completion?")
+	if err != nil || !install {
+		return err
+	}
+
+	scriptPath := configv3.BashCompletionFilePath()
+	err = completion.InstallBash(cmd.Config.BinaryName(), scriptPath, filepath.Join(os.Getenv("HOME"), ".bashrc"))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Bash completion installed. Open a new shell or run 'source {{.Path}}' to use it.", map[string]interface{}{
+		"Path": scriptPath,
+	})
+	return nil
+}
+
+func (cmd InitCommand) run(args ...string) error {
+	commandLine := strings.Join(args, " ")
+	cmd.UI.DisplayText("Running '{{.BinaryName}} {{.Command}}'...", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Command":    commandLine,
+	})
+
+	err := cmd.Runner.Run(args)
+	if err != nil {
+		return translatableerror.InitStepFailedError{Command: commandLine}
+	}
+	return nil
+}
+
+func (cmd InitCommand) displaySummary() {
+	cmd.UI.DisplayText("Setup complete:")
+
+	user, _ := cmd.Config.CurrentUserName()
+	completionPath := configv3.BashCompletionFilePath()
+	if _, err := os.Stat(completionPath); err != nil {
+		completionPath = ""
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), cmd.valueOr(cmd.Config.Target(), "none")},
+		{cmd.UI.TranslateText("user:"), cmd.valueOr(user, "not logged in")},
+		{cmd.UI.TranslateText("org:"), cmd.valueOr(cmd.Config.TargetedOrganization().Name, "none")},
+		{cmd.UI.TranslateText("space:"), cmd.valueOr(cmd.Config.TargetedSpace().Name, "none")},
+		{cmd.UI.TranslateText("default org:"), cmd.valueOr(cmd.Config.DefaultOrganization(), "none")},
+		{cmd.UI.TranslateText("default space:"), cmd.valueOr(cmd.Config.DefaultSpace(), "none")},
+		{cmd.UI.TranslateText("color:"), cmd.colorSetting()},
+		{cmd.UI.TranslateText("locale:"), cmd.valueOr(cmd.Config.Locale(), "system default")},
+		{cmd.UI.TranslateText("bash completion:"), cmd.valueOr(completionPath, "not installed")},
+	}, 3)
+}
+
+func (cmd InitCommand) colorSetting() string {
+	switch cmd.Config.ColorEnabled() {
+	case configv3.ColorDisabled:
+		return cmd.UI.TranslateText("disabled")
+	case configv3.ColorEnabled:
+		return cmd.UI.TranslateText("enabled")
+	default:
+		return cmd.UI.TranslateText("auto")
+	}
+}
+
+func (cmd InitCommand) valueOr(value string, fallback string) string {
+	if value == "" {
+		return cmd.UI.TranslateText(fallback)
+	}
+	return value
+}
@@ -0,0 +1,161 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("init Command", func() {
+	var (
+		cmd        InitCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		fakeRunner *commonfakes.FakeScriptRunner
+		homeDir    string
+		oldHome    string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeRunner = new(commonfakes.FakeScriptRunner)
+
+		var err error
+		homeDir, err = ioutil.TempDir("", "init-command-test")
+		Expect(err).ToNot(HaveOccurred())
+		oldHome = os.Getenv("HOME")
+		Expect(os.Setenv("HOME", homeDir)).To(Succeed())
+		Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+
+		cmd = InitCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Runner: fakeRunner,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("HOME", oldHome)).To(Succeed())
+		Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+		Expect(os.RemoveAll(homeDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	runArgs := func() [][]string {
+		var args [][]string
+		for i := 0; i < fakeRunner.RunCallCount(); i++ {
+			args = append(args, fakeRunner.RunArgsForCall(i))
+		}
+		return args
+	}
+
+	When("every step is answered", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("https://api.example.com\ny\ny\ny\nmy-org\nmy-space\nn\ny\nfr-FR\ny\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.CurrentUserNameReturns("", nil)
+			fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+			fakeConfig.DefaultOrganizationReturns("my-org")
+			fakeConfig.LocaleReturns("fr-FR")
+			fakeConfig.TargetReturnsOnCall(0, "")
+		})
+
+		It("runs the command for each step", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(runArgs()).To(Equal([][]string{
+				{"api", "https://api.example.com", "--skip-ssl-validation"},
+				{"login"},
+				{"config", "set", "default-org", "my-org"},
+				{"config", "set", "default-space", "my-space"},
+				{"config", "--color", "false"},
+				{"config", "--locale", "fr-FR"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Running 'faceman api https://api\.example\.com --skip-ssl-validation'\.\.\.`))
+			Expect(testUI.Out).To(Say(`Running 'faceman login'\.\.\.`))
+		})
+
+		It("installs bash completion", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			scriptPath := filepath.Join(homeDir, ".cf", "completion.bash")
+			Expect(scriptPath).To(BeAnExistingFile())
+			rc, err := ioutil.ReadFile(filepath.Join(homeDir, ".bashrc"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rc)).To(ContainSubstring("source " + scriptPath))
+		})
+
+		It("displays a summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setup complete:"))
+			Expect(testUI.Out).To(Say(`api endpoint:\s+https://api\.example\.com`))
+			Expect(testUI.Out).To(Say(`user:\s+not logged in`))
+			Expect(testUI.Out).To(Say(`default org:\s+my-org`))
+			Expect(testUI.Out).To(Say(`default space:\s+none`))
+			Expect(testUI.Out).To(Say(`color:\s+disabled`))
+			Expect(testUI.Out).To(Say(`locale:\s+fr-FR`))
+			Expect(testUI.Out).To(Say(`bash completion:\s+.*completion\.bash`))
+		})
+	})
+
+	When("the API endpoint is kept and the optional steps are skipped", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\nn\nn\ny\nn\nn\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.CurrentUserNameReturns("some-user", nil)
+			fakeConfig.ColorEnabledReturns(configv3.ColorAuto)
+		})
+
+		It("only sets the color preference", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Keep using API endpoint https://api\.example\.com\?`))
+			Expect(testUI.Out).To(Say(`Logged in as some-user\. Log in again\?`))
+			Expect(runArgs()).To(Equal([][]string{
+				{"config", "--color", "true"},
+			}))
+			Expect(testUI.Out).To(Say(`bash completion:\s+not installed`))
+		})
+	})
+
+	When("a step fails", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("https://api.example.com\nn\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeRunner.RunReturns(errors.New("some-error"))
+		})
+
+		It("stops and returns an InitStepFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InitStepFailedError{Command: "api https://api.example.com"}))
+			Expect(fakeRunner.RunCallCount()).To(Equal(1))
+			Expect(testUI.Out).ToNot(Say("Setup complete:"))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// CheckInsecurePolicy enforces the insecure-allowed setting of the config
+// before a command runs. When skipping SSL validation is forbidden, it
+// refuses --skip-ssl-validation and any command against a target that was
+// configured with it, other than those that retarget or change the config.
+// When it is allowed, it warns on every command that the current target is
+// not verified.
+func CheckInsecurePolicy(config command.Config, ui command.UI, commandName string, setFlags []string) error {
+	for _, setFlag := range setFlags {
+		if setFlag == "--skip-ssl-validation" && !config.InsecureAllowed() {
+			return translatableerror.InsecureNotAllowedError{BinaryName: config.BinaryName()}
+		}
+	}
+
+	if config.Target() == "" || !config.SkipSSLValidation() {
+		return nil
+	}
+
+	switch commandName {
+	case "", "api", "config", "help", "logout", "version":
+		return nil
+	}
+
+	if !config.InsecureAllowed() {
+		return translatableerror.InsecureNotAllowedError{
+			API:        config.Target(),
+			BinaryName: config.BinaryName(),
+		}
+	}
+
+	ui.DisplayWarning("WARNING: SSL validation is disabled for {{.API}}. Its certificate is not verified, so the connection may be intercepted.", map[string]interface{}{
+		"API": config.Target(),
+	})
+	return nil
+}
@@ -0,0 +1,113 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("CheckInsecurePolicy", func() {
+	var (
+		fakeConfig  *commandfakes.FakeConfig
+		testUI      *ui.UI
+		commandName string
+		setFlags    []string
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		commandName = "apps"
+		setFlags = nil
+	})
+
+	JustBeforeEach(func() {
+		executeErr = CheckInsecurePolicy(fakeConfig, testUI, commandName, setFlags)
+	})
+
+	When("--skip-ssl-validation is given", func() {
+		BeforeEach(func() {
+			commandName = "api"
+			setFlags = []string{"--skip-ssl-validation"}
+		})
+
+		When("skipping SSL validation is forbidden", func() {
+			BeforeEach(func() {
+				fakeConfig.InsecureAllowedReturns(false)
+			})
+
+			It("returns an InsecureNotAllowedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InsecureNotAllowedError{BinaryName: "faceman"}))
+			})
+		})
+
+		When("skipping SSL validation is allowed", func() {
+			BeforeEach(func() {
+				fakeConfig.InsecureAllowedReturns(true)
+			})
+
+			It("does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	When("the current target skips SSL validation", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.SkipSSLValidationReturns(true)
+		})
+
+		When("skipping SSL validation is allowed", func() {
+			BeforeEach(func() {
+				fakeConfig.InsecureAllowedReturns(true)
+			})
+
+			It("displays a warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say(`WARNING: SSL validation is disabled for https://api\.example\.com\.`))
+			})
+		})
+
+		When("skipping SSL validation is forbidden", func() {
+			BeforeEach(func() {
+				fakeConfig.InsecureAllowedReturns(false)
+			})
+
+			It("returns an InsecureNotAllowedError naming the target", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InsecureNotAllowedError{
+					API:        "https://api.example.com",
+					BinaryName: "faceman",
+				}))
+			})
+
+			When("the command retargets the API", func() {
+				BeforeEach(func() {
+					commandName = "api"
+				})
+
+				It("does not return an error", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	When("the current target validates SSL", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+		})
+
+		It("does not display a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).ToNot(Say("WARNING"))
+		})
+	})
+})
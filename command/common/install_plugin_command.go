@@ -25,7 +25,8 @@ type InstallPluginActor interface {
 	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
 	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
 	FileExists(path string) bool
-	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, path string) (configv3.Plugin, error)
+	GetCommandConflicts(commandList pluginaction.CommandList) []pluginaction.CommandConflict
 	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
 	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
@@ -108,7 +109,7 @@ func (cmd InstallPluginCommand) Execute([]string) error {
 	}
 	log.Info("started RPC server")
 
-	plugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	plugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, executablePath)
 	if err != nil {
 		return err
 	}
@@ -150,9 +151,37 @@ func (cmd InstallPluginCommand) installPlugin(plugin configv3.Plugin, pluginPath
 		"Name":    plugin.Name,
 		"Version": plugin.Version.String(),
 	})
+
+	cmd.displayCommandConflicts(plugin)
 	return nil
 }
 
+// displayCommandConflicts warns about the commands of the installed plugin
+// that built-in commands or other plugins also have, which do not run by
+// their name unless the plugin takes precedence.
+func (cmd InstallPluginCommand) displayCommandConflicts(plugin configv3.Plugin) {
+	var conflictingCommands []string
+	for _, conflict := range cmd.Actor.GetCommandConflicts(Commands) {
+		for _, pluginName := range conflict.Plugins {
+			if pluginName == plugin.Name {
+				conflictingCommands = append(conflictingCommands, conflict.Command)
+			}
+		}
+	}
+	if len(conflictingCommands) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayWarning("Plugin {{.Name}} has command names or aliases that built-in commands or other plugins also have: {{.Commands}}", map[string]interface{}{
+		"Name":     plugin.Name,
+		"Commands": strings.Join(conflictingCommands, ", "),
+	})
+	cmd.UI.DisplayWarning("Run them as '{{.BinaryName}} {{.Name}}:COMMAND'. Use '{{.BinaryName}} plugins --conflicts' to see which commands run by name.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Name":       plugin.Name,
+	})
+}
+
 func (cmd InstallPluginCommand) uninstallPlugin(plugin configv3.Plugin, rpcService pluginaction.PluginUninstaller) error {
 	cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} is already installed. Uninstalling existing plugin...", map[string]interface{}{
 		"Name":    plugin.Name,
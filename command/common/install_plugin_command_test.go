@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -177,7 +178,7 @@ var _ = Describe("install-plugin command", func() {
 							Expect(fakeActor.FileExistsArgsForCall(0)).To(Equal("some-path"))
 
 							Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-							_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+							_, path := fakeActor.GetAndValidatePluginArgsForCall(0)
 							Expect(path).To(Equal("copy-path"))
 
 							Expect(fakeConfig.GetPluginCaseInsensitiveCallCount()).To(Equal(1))
@@ -242,7 +243,7 @@ var _ = Describe("install-plugin command", func() {
 						Expect(pluginDirArg).To(ContainSubstring("temp"))
 
 						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-						_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+						_, path := fakeActor.GetAndValidatePluginArgsForCall(0)
 						Expect(path).To(Equal("copy-path"))
 
 						Expect(fakeConfig.GetPluginCaseInsensitiveCallCount()).To(Equal(1))
@@ -254,6 +255,29 @@ var _ = Describe("install-plugin command", func() {
 						Expect(installedPlugin).To(Equal(plugin))
 
 						Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+
+						Expect(fakeActor.GetCommandConflictsCallCount()).To(Equal(1))
+						Expect(fakeActor.GetCommandConflictsArgsForCall(0)).To(Equal(Commands))
+						Expect(testUI.Err).ToNot(Say("Plugin some-plugin has command names or aliases"))
+					})
+
+					When("the plugin has commands that built-in commands or other plugins also have", func() {
+						BeforeEach(func() {
+							fakeConfig.BinaryNameReturns("faceman")
+							fakeActor.GetCommandConflictsReturns([]pluginaction.CommandConflict{
+								{Command: "deploy", Plugins: []string{"other-plugin", "some-plugin"}},
+								{Command: "other-command", Plugins: []string{"other-plugin", "yet-another-plugin"}},
+								{Command: "version", BuiltIn: true, Plugins: []string{"some-plugin"}},
+							})
+						})
+
+						It("installs the plugin and warns about the conflicts", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.2\.3 successfully installed\.`))
+							Expect(testUI.Err).To(Say("Plugin some-plugin has command names or aliases that built-in commands or other plugins also have: deploy, version"))
+							Expect(testUI.Err).To(Say(`Run them as 'faceman some-plugin:COMMAND'\. Use 'faceman plugins --conflicts' to see which commands run by name\.`))
+						})
 					})
 
 					When("there is an error making an executable copy of the plugin binary", func() {
@@ -361,7 +385,7 @@ var _ = Describe("install-plugin command", func() {
 							Expect(fakeActor.FileExistsArgsForCall(0)).To(Equal("some-path"))
 
 							Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-							_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+							_, path := fakeActor.GetAndValidatePluginArgsForCall(0)
 							Expect(path).To(Equal("copy-path"))
 
 							Expect(fakeConfig.GetPluginCaseInsensitiveCallCount()).To(Equal(1))
@@ -497,7 +521,7 @@ var _ = Describe("install-plugin command", func() {
 
 				It("sets up the progress bar", func() {
 					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-					_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+					_, path := fakeActor.GetAndValidatePluginArgsForCall(0)
 					Expect(path).To(Equal(executablePluginPath))
 
 					Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(1))
@@ -710,7 +734,7 @@ var _ = Describe("install-plugin command", func() {
 						Expect(tempPluginDir).To(ContainSubstring("temp"))
 
 						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-						_, _, path = fakeActor.GetAndValidatePluginArgsForCall(0)
+						_, path = fakeActor.GetAndValidatePluginArgsForCall(0)
 						Expect(path).To(Equal(executablePluginPath))
 
 						Expect(fakeConfig.GetPluginCaseInsensitiveCallCount()).To(Equal(1))
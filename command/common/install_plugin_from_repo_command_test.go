@@ -322,8 +322,7 @@ var _ = Describe("install-plugin command", func() {
 											Expect(testUI.Out).ToNot(Say("Installing plugin"))
 
 											Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
-											_, commandsArg, tempDirArg := fakeActor.GetAndValidatePluginArgsForCall(0)
-											Expect(commandsArg).To(Equal(Commands))
+											_, tempDirArg := fakeActor.GetAndValidatePluginArgsForCall(0)
 											Expect(tempDirArg).To(Equal("copy-path"))
 										})
 									})
@@ -6,31 +6,33 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "GETTING STARTED:",
 		CommandList: [][]string{
-			{"help", "version", "login", "logout", "passwd", "target"},
-			{"api", "auth"},
+			{"help", "examples", "version", "login", "logout", "passwd", "target"},
+			{"api", "auth", "init", "status", "capabilities"},
 		},
 	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app"},
+			{"apps", "app", "unhealthy-apps"},
 			{"push", "scale", "delete", "rename"},
-			{"start", "stop", "restart", "restage", "restart-app-instance"},
+			{"start", "stop", "restart", "restage", "restart-app-instance", "clear-build-cache"},
 			{"run-task", "tasks", "terminate-task"},
-			{"events", "files", "logs"},
-			{"env", "set-env", "unset-env"},
+			{"schedule-task", "scheduled-tasks", "unschedule-task", "run-due-tasks"},
+			{"events", "files", "cat", "logs", "requests"},
+			{"env", "set-env", "unset-env", "local-env", "export-env", "import-env", "staging-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "exec", "dump"},
 		},
 	},
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
-			{"marketplace", "services", "service"},
+			{"marketplace", "services", "service", "dashboard"},
 			{"create-service", "update-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "service-env"},
+			{"bind-service", "unbind-service", "rebind-service"},
+			{"bindings-audit"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 			{"share-service", "unshare-service"},
@@ -41,13 +43,14 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"orgs", "org"},
 			{"create-org", "delete-org", "rename-org"},
+			{"owners", "set-owner"},
 		},
 	},
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
 			{"spaces", "space"},
-			{"create-space", "delete-space", "rename-space"},
+			{"create-space", "delete-space", "rename-space", "migrate-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
 	},
@@ -82,6 +85,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-user", "delete-user"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
+			{"can-i"},
 		},
 	},
 	{
@@ -89,6 +93,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"quotas", "quota", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
+			{"apply-quotas"},
 			{"share-private-domain", "unshare-private-domain"},
 		},
 	},
@@ -121,6 +126,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ENVIRONMENT VARIABLE GROUPS:",
 		CommandList: [][]string{
 			{"running-environment-variable-group", "staging-environment-variable-group", "set-staging-environment-variable-group", "set-running-environment-variable-group"},
+			{"env-groups"},
 		},
 	},
 	{
@@ -138,7 +144,8 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "ws", "config", "stats", "oauth-token", "ssh-code", "run-script", "job", "jobs"},
+			{"features", "enable-feature", "disable-feature"},
 		},
 	},
 	{
@@ -1,4 +1,4 @@
-package plugin
+package common
 
 import (
 	"strings"
@@ -14,13 +14,15 @@ import (
 //go:generate counterfeiter . PluginsActor
 
 type PluginsActor interface {
+	GetCommandConflicts(commandList pluginaction.CommandList) []pluginaction.CommandConflict
 	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
 }
 
 type PluginsCommand struct {
 	Checksum          bool        `long:"checksum" description:"Compute and show the sha1 value of the plugin binary file"`
+	Conflicts         bool        `long:"conflicts" description:"Show plugin command names and aliases that built-in commands or other plugins also have, and which of them runs"`
 	Outdated          bool        `long:"outdated" description:"Search the plugin repositories for new versions of installed plugins"`
-	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated]"`
+	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated | --conflicts]\n\nTIP:\n   Run a plugin command that built-in commands or other plugins also have as 'CF_NAME PLUGIN:COMMAND'. Choose the plugins whose commands run by name with 'CF_NAME config set plugin-precedence PLUGIN[,PLUGIN...]'."`
 	relatedCommands   interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin"`
 	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
@@ -42,6 +44,8 @@ func (cmd PluginsCommand) Execute([]string) error {
 		return cmd.displayOutdatedPlugins()
 	case cmd.Checksum:
 		return cmd.displayPluginChecksums(cmd.Config.Plugins())
+	case cmd.Conflicts:
+		return cmd.displayCommandConflicts()
 	default:
 		return cmd.displayPluginCommands(cmd.Config.Plugins())
 	}
@@ -59,6 +63,47 @@ func (cmd PluginsCommand) displayPluginChecksums(plugins []configv3.Plugin) erro
 	return nil
 }
 
+func (cmd PluginsCommand) displayCommandConflicts() error {
+	cmd.UI.DisplayText("Listing plugin command conflicts...")
+
+	conflicts := cmd.Actor.GetCommandConflicts(Commands)
+	if len(conflicts) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No plugin command conflicts found.")
+		return nil
+	}
+
+	table := [][]string{{"command", "provided by", "runs"}}
+	for _, conflict := range conflicts {
+		providers := conflict.Plugins
+		if conflict.BuiltIn {
+			providers = append([]string{cmd.UI.TranslateText("built-in")}, providers...)
+		}
+
+		var runs string
+		switch {
+		case conflict.RunBy != "":
+			runs = conflict.RunBy
+		case conflict.BuiltIn:
+			runs = cmd.UI.TranslateText("built-in")
+		default:
+			runs = cmd.UI.TranslateText("none, run as PLUGIN:COMMAND")
+		}
+
+		table = append(table, []string{conflict.Command, strings.Join(providers, ", "), runs})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Use '{{.BinaryName}} config set plugin-precedence PLUGIN[,PLUGIN...]' to choose the plugins whose commands run.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
+	return nil
+}
+
 func (cmd PluginsCommand) displayOutdatedPlugins() error {
 	repos := cmd.Config.PluginRepositories()
 	if len(repos) == 0 {
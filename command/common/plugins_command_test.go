@@ -1,4 +1,4 @@
-package plugin_test
+package common_test
 
 import (
 	"io/ioutil"
@@ -7,8 +7,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command/commandfakes"
-	. "code.cloudfoundry.org/cli/command/plugin"
-	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -23,13 +23,13 @@ var _ = Describe("plugins Command", func() {
 		testUI     *ui.UI
 		fakeConfig *commandfakes.FakeConfig
 		executeErr error
-		fakeActor  *pluginfakes.FakePluginsActor
+		fakeActor  *commonfakes.FakePluginsActor
 	)
 
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
 		fakeConfig = new(commandfakes.FakeConfig)
-		fakeActor = new(pluginfakes.FakePluginsActor)
+		fakeActor = new(commonfakes.FakePluginsActor)
 		cmd = PluginsCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
 		cmd.Checksum = false
 
@@ -243,5 +243,46 @@ var _ = Describe("plugins Command", func() {
 				})
 			})
 		})
+
+		When("the --conflicts flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Conflicts = true
+			})
+
+			When("there are no conflicts", func() {
+				It("says so", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.GetCommandConflictsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetCommandConflictsArgsForCall(0)).To(Equal(Commands))
+
+					Expect(testUI.Out).To(Say(`Listing plugin command conflicts\.\.\.`))
+					Expect(testUI.Out).To(Say("No plugin command conflicts found."))
+				})
+			})
+
+			When("there are conflicts", func() {
+				BeforeEach(func() {
+					fakeActor.GetCommandConflictsReturns([]pluginaction.CommandConflict{
+						{Command: "cups", BuiltIn: true, Plugins: []string{"plugin-2"}},
+						{Command: "deploy", Plugins: []string{"plugin-1", "plugin-2"}},
+						{Command: "push", BuiltIn: true, Plugins: []string{"plugin-1"}, RunBy: "plugin-1"},
+					})
+				})
+
+				It("displays which command runs for each conflict", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Listing plugin command conflicts\.\.\.`))
+					Expect(testUI.Out).To(Say(""))
+					Expect(testUI.Out).To(Say(`command\s+provided by\s+runs`))
+					Expect(testUI.Out).To(Say(`cups\s+built-in, plugin-2\s+built-in`))
+					Expect(testUI.Out).To(Say(`deploy\s+plugin-1, plugin-2\s+none, run as PLUGIN:COMMAND`))
+					Expect(testUI.Out).To(Say(`push\s+built-in, plugin-1\s+plugin-1`))
+					Expect(testUI.Out).To(Say(""))
+					Expect(testUI.Out).To(Say(`Use 'faceman config set plugin-precedence PLUGIN\[,PLUGIN\.\.\.\]' to choose the plugins whose commands run\.`))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/usagestats"
+)
+
+// RunCommand runs the refactored command cmd, named commandName, with the
+// checks and follow-ups of every command run: deprecation advisories, the
+// insecure-allowed policy, the local .cf/target, usage stats, the new release
+// check and the explanation of insufficient permissions. Commands typed on
+// the command line and script lines both run through it, so that they behave
+// the same.
+func RunCommand(config command.Config, ui command.UI, commandName string, setFlags []string, cmd command.ExtendedCommander, args []string) error {
+	displayDeprecationAdvisories(ui, config.BinaryName(), commandName, setFlags)
+	err := CheckInsecurePolicy(config, ui, commandName, setFlags)
+	if err != nil {
+		return err
+	}
+	targetLocalOrgAndSpace(config, ui, commandName)
+
+	startTime := time.Now()
+	if LegacyImplementationRequired(config, commandName, setFlags) {
+		err = translatableerror.UnrefactoredCommandError{}
+	} else {
+		err = cmd.Setup(config, ui)
+		if err == nil {
+			err = cmd.Execute(args)
+		}
+	}
+	recordUsage(config, commandName, startTime, err)
+	if err == nil && commandName != "version" {
+		CheckForNewRelease(config, ui)
+	}
+	return explainInsufficientPermission(err, config)
+}
+
+// targetLocalOrgAndSpace targets the org and space named in the nearest
+// .cf/target file above the working directory for this command only. The
+// global target is kept in the config file, and the local target is handed to
+// the legacy code in $CF_LOCAL_TARGET.
+func targetLocalOrgAndSpace(config command.Config, ui command.UI, commandName string) {
+	switch commandName {
+	case "", "api", "auth", "config", "help", "login", "logout", "target", "version":
+		return
+	}
+	cfConfig, isConfig := config.(*configv3.Config)
+	if !isConfig || cfConfig.Target() == "" || cfConfig.AccessToken() == "" {
+		return
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return
+	}
+	localTarget, found, err := configv3.FindLocalTarget(workingDir)
+	if err != nil {
+		ui.DisplayWarning("Ignoring local target file: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+	if !found || !shared.LocalTargetDiffers(cfConfig, localTarget) {
+		return
+	}
+
+	globalOrg, globalSpace := cfConfig.TargetedOrganization(), cfConfig.TargetedSpace()
+	ccClient, uaaClient, err := shared.NewClients(cfConfig, ui, true)
+	if err == nil {
+		err = shared.TargetLocalOrgAndSpace(cfConfig, ui, v2action.NewActor(ccClient, uaaClient, cfConfig), localTarget)
+	}
+	if err != nil {
+		ui.DisplayWarning("Unable to target org and space from {{.Path}}: {{.Error}}", map[string]interface{}{
+			"Path":  localTarget.Path,
+			"Error": err.Error(),
+		})
+		return
+	}
+	cfConfig.SetGlobalTarget(globalOrg, globalSpace)
+
+	org, space := cfConfig.TargetedOrganization(), cfConfig.TargetedSpace()
+	rawLocalTarget, err := json.Marshal(coreconfig.LocalTarget{
+		OrganizationFields: models.OrganizationFields{GUID: org.GUID, Name: org.Name},
+		SpaceFields:        models.SpaceFields{GUID: space.GUID, Name: space.Name, AllowSSH: space.AllowSSH},
+	})
+	if err == nil {
+		_ = os.Setenv(coreconfig.LocalTargetEnvVar, string(rawLocalTarget))
+	}
+}
+
+// recordUsage records the command run in the opt-in usage stats. Commands
+// handed off to the legacy code or to their V2 version are recorded there
+// instead.
+func recordUsage(config command.Config, commandName string, startTime time.Time, commandErr error) {
+	switch commandErr.(type) {
+	case legacyCommand, translatableerror.V3V2SwitchError:
+		return
+	}
+
+	usagestats.RecordCommand(config, configv3.UsageStatsFilePath(), commandName, startTime, commandErr)
+}
+
+// explainInsufficientPermission names the scope or roles that a refused
+// request required, based on the scopes of the current access token.
+func explainInsufficientPermission(err error, config command.Config) error {
+	if err == nil {
+		return nil
+	}
+
+	scopes, scopesErr := config.AccessTokenScopes()
+	if scopesErr != nil {
+		return err
+	}
+	return translatableerror.ConvertToInsufficientPermissionError(err, scopes)
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/script"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . ScriptRunner
+
+// ScriptRunner executes a single command read from a script. The runner is
+// responsible for displaying any error returned by the command.
+type ScriptRunner interface {
+	Run(args []string) error
+}
+
+type RunScriptCommand struct {
+	RequiredArgs     flag.ScriptPath               `positional-args:"yes"`
+	ContinueOnError  bool                          `long:"continue-on-error" description:"Run the remaining commands when a command fails instead of aborting the script"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for the script; can specify multiple times"`
+	usage            interface{}                   `usage:"CF_NAME run-script SCRIPT_PATH [--continue-on-error] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   Each line of the script is a command without the leading 'CF_NAME', e.g. 'target -s ((space))'.\n   Lines starting with '#' are ignored. Use '-' as SCRIPT_PATH (or run 'CF_NAME -') to read the script from standard input."`
+	relatedCommands  interface{}                   `related_commands:"curl"`
+
+	UI     command.UI
+	Config command.Config
+	Runner ScriptRunner
+}
+
+func (cmd *RunScriptCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Runner = scriptRunner{config: config, ui: ui}
+	return nil
+}
+
+func (cmd RunScriptCommand) Execute(args []string) error {
+	vars, err := cmd.variables()
+	if err != nil {
+		return err
+	}
+
+	commands, err := cmd.parseScript(vars)
+	if err != nil {
+		return err
+	}
+
+	var failedLines []int
+	for i, scriptCommand := range commands {
+		if i > 0 {
+			cmd.UI.DisplayNewline()
+		}
+		cmd.UI.DisplayTextWithBold("{{.BinaryName}} {{.Command}}", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+			"Command":    scriptCommand.Text,
+		})
+
+		err = cmd.Runner.Run(scriptCommand.Args)
+		if err == nil {
+			continue
+		}
+
+		if !cmd.ContinueOnError {
+			return translatableerror.ScriptCommandFailedError{Line: scriptCommand.Line, Command: scriptCommand.Text}
+		}
+		failedLines = append(failedLines, scriptCommand.Line)
+	}
+
+	if len(failedLines) > 0 {
+		return translatableerror.ScriptCommandsFailedError{Lines: failedLines, Total: len(commands)}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RunScriptCommand) parseScript(vars map[string]string) ([]script.Command, error) {
+	var reader io.Reader
+	if cmd.RequiredArgs.Path == "-" {
+		reader = cmd.UI.GetIn()
+	} else {
+		file, err := os.Open(cmd.RequiredArgs.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	return script.Parse(reader, vars)
+}
+
+func (cmd RunScriptCommand) variables() (map[string]string, error) {
+	vars := map[string]string{}
+
+	for _, path := range cmd.PathsToVarsFiles {
+		rawVarsFile, err := ioutil.ReadFile(string(path))
+		if err != nil {
+			return nil, err
+		}
+
+		var fileVars map[string]interface{}
+		err = yaml.Unmarshal(rawVarsFile, &fileVars)
+		if err != nil {
+			return nil, translatableerror.InvalidYAMLError{Err: err}
+		}
+
+		for name, value := range fileVars {
+			vars[name] = stringifyVariable(value)
+		}
+	}
+
+	for _, kv := range cmd.Vars {
+		vars[kv.Name] = stringifyVariable(kv.Value)
+	}
+
+	return vars, nil
+}
+
+func stringifyVariable(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	raw, err := yaml.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -0,0 +1,203 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/script"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-script Command", func() {
+	var (
+		cmd        RunScriptCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		fakeRunner *commonfakes.FakeScriptRunner
+		tmpDir     string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeRunner = new(commonfakes.FakeScriptRunner)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "run-script-command-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = RunScriptCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Runner: fakeRunner,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	writeScript := func(contents string) string {
+		path := filepath.Join(tmpDir, "script.cf")
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		return path
+	}
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the script file does not exist", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.ScriptPath{Path: filepath.Join(tmpDir, "missing.cf")}
+		})
+
+		It("returns the error without running anything", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			Expect(fakeRunner.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the script cannot be parsed", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("target -o ((org))\n")}
+		})
+
+		It("returns the parse error without running anything", func() {
+			Expect(executeErr).To(MatchError(script.ParseError{Line: 1, Message: "Expected to find variables: org"}))
+			Expect(fakeRunner.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the script is valid", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("# setup\ntarget -o ((org)) -s ((space))\n\ncreate-app ((app))\nscale ((app)) -i ((instances))\n")}
+			varsFilePath := filepath.Join(tmpDir, "vars.yml")
+			Expect(ioutil.WriteFile(varsFilePath, []byte("org: some-org\nspace: some-space\napp: file-app\ninstances: 2\n"), 0600)).To(Succeed())
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{flag.PathWithExistenceCheck(varsFilePath)}
+			cmd.Vars = []template.VarKV{{Name: "app", Value: "flag-app"}}
+		})
+
+		It("runs every command with the variables substituted, --var taking precedence, and displays the lines as written", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeRunner.RunCallCount()).To(Equal(3))
+			Expect(fakeRunner.RunArgsForCall(0)).To(Equal([]string{"target", "-o", "some-org", "-s", "some-space"}))
+			Expect(fakeRunner.RunArgsForCall(1)).To(Equal([]string{"create-app", "flag-app"}))
+			Expect(fakeRunner.RunArgsForCall(2)).To(Equal([]string{"scale", "flag-app", "-i", "2"}))
+
+			Expect(testUI.Out).ToNot(Say("flag-app"))
+			Expect(testUI.Out).To(Say(`faceman target -o \(\(org\)\) -s \(\(space\)\)`))
+			Expect(testUI.Out).To(Say(`faceman create-app \(\(app\)\)`))
+			Expect(testUI.Out).To(Say(`faceman scale \(\(app\)\) -i \(\(instances\)\)`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("a command fails", func() {
+			BeforeEach(func() {
+				fakeRunner.RunReturnsOnCall(1, errors.New("some-error"))
+			})
+
+			It("aborts the script", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ScriptCommandFailedError{Line: 4, Command: "create-app ((app))"}))
+				Expect(fakeRunner.RunCallCount()).To(Equal(2))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+
+			When("--continue-on-error is provided", func() {
+				BeforeEach(func() {
+					cmd.ContinueOnError = true
+				})
+
+				It("runs the remaining commands and reports the failed lines", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ScriptCommandsFailedError{Lines: []int{4}, Total: 3}))
+					Expect(fakeRunner.RunCallCount()).To(Equal(3))
+				})
+			})
+		})
+	})
+
+	When("the script path is -", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.ScriptPath{Path: "-"}
+			_, err := input.Write([]byte("apps\nstacks\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("reads the script from standard input", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeRunner.RunCallCount()).To(Equal(2))
+			Expect(fakeRunner.RunArgsForCall(0)).To(Equal([]string{"apps"}))
+			Expect(fakeRunner.RunArgsForCall(1)).To(Equal([]string{"stacks"}))
+		})
+	})
+
+	When("the script is run by the script runner", func() {
+		BeforeEach(func() {
+			Expect(cmd.Setup(fakeConfig, testUI)).To(Succeed())
+			cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("help -a\nhelp\n")}
+		})
+
+		It("does not carry options over from one command to the next", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`faceman help -a`))
+			Expect(testUI.Out).To(Say("INSTALLED PLUGIN COMMANDS:"))
+			Expect(testUI.Out).To(Say(`faceman help\n`))
+			Expect(testUI.Out).To(Say("Before getting started:"))
+			Expect(testUI.Out).ToNot(Say("INSTALLED PLUGIN COMMANDS:"))
+		})
+
+		When("a command is deprecated", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("v3-apps\n")}
+			})
+
+			It("displays the deprecation advisory as when the command is typed", func() {
+				Expect(testUI.Err).To(Say("The v3-apps command is removed in V7."))
+			})
+		})
+
+		When("skipping SSL validation is forbidden", func() {
+			BeforeEach(func() {
+				fakeConfig.InsecureAllowedReturns(false)
+				cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("api https://api.example.com --skip-ssl-validation\n")}
+			})
+
+			It("refuses --skip-ssl-validation", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ScriptCommandFailedError{Line: 1, Command: "api https://api.example.com --skip-ssl-validation"}))
+				Expect(testUI.Err).To(Say("--skip-ssl-validation is not permitted by the CLI config"))
+				Expect(fakeConfig.SetTargetInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the vars file is not valid YAML", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.ScriptPath{Path: writeScript("apps\n")}
+			varsFilePath := filepath.Join(tmpDir, "vars.yml")
+			Expect(ioutil.WriteFile(varsFilePath, []byte("- not: [a map"), 0600)).To(Succeed())
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{flag.PathWithExistenceCheck(varsFilePath)}
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidYAMLError{}))
+			Expect(fakeRunner.RunCallCount()).To(Equal(0))
+		})
+	})
+})
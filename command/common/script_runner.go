@@ -0,0 +1,98 @@
+package common
+
+import (
+	"os"
+	"os/exec"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/jessevdk/go-flags"
+)
+
+type legacyCommand interface {
+	LegacyMain()
+}
+
+// scriptRunner runs script commands in the current process so that they share
+// the loaded config, including any token refreshed along the way. Commands
+// that still live in the legacy code base, as well as plugin commands, are run
+// in a child process after the config has been persisted.
+type scriptRunner struct {
+	config command.Config
+	ui     command.UI
+}
+
+func (runner scriptRunner) Run(args []string) error {
+	if len(args) > 0 && args[0] == "run-script" {
+		err := translatableerror.NestedScriptError{}
+		runner.ui.DisplayError(err)
+		return err
+	}
+
+	// Every command is parsed into a zero-valued command list, as go-flags
+	// only resets options with a default, and options given on an earlier
+	// line, such as delete -f, would otherwise apply to later ones.
+	err := runner.parse(args, &commandList{})
+	if _, ok := err.(translatableerror.V3V2SwitchError); ok {
+		err = runner.parse(args, &V2CommandList{})
+	}
+
+	switch typedErr := err.(type) {
+	case nil:
+		return nil
+	case legacyCommand:
+		return runner.runInChildProcess(args)
+	case *flags.Error:
+		if typedErr.Type == flags.ErrUnknownCommand {
+			return runner.runInChildProcess(args)
+		}
+	}
+
+	runner.ui.DisplayError(translatableerror.ConvertToTranslatableError(err))
+	return err
+}
+
+func (runner scriptRunner) parse(args []string, commandList interface{}) error {
+	parser := flags.NewParser(commandList, flags.HelpFlag)
+	parser.CommandHandler = func(cmd flags.Commander, extraArgs []string) error {
+		extendedCmd, ok := cmd.(command.ExtendedCommander)
+		if !ok {
+			return translatableerror.UnrefactoredCommandError{}
+		}
+		return RunCommand(runner.config, runner.ui, parser.Active.Name, SetFlagNames(parser.Active), extendedCmd, extraArgs)
+	}
+
+	_, err := parser.ParseArgs(args)
+	return err
+}
+
+func (runner scriptRunner) runInChildProcess(args []string) error {
+	cfConfig, isConfig := runner.config.(*configv3.Config)
+	if isConfig {
+		err := configv3.WriteConfig(cfConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	child := exec.Command(executable, args...)
+	child.Stdin = runner.ui.GetIn()
+	child.Stdout = runner.ui.GetOut()
+	child.Stderr = runner.ui.GetErr()
+	runErr := child.Run()
+
+	if isConfig {
+		reloadedConfig, err := configv3.LoadConfig(cfConfig.Flags)
+		if err == nil {
+			cfConfig.ReloadConfigFile(reloadedConfig.ConfigFile)
+		}
+	}
+
+	return runErr
+}
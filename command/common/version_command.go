@@ -1,25 +1,119 @@
 package common
 
-import "code.cloudfoundry.org/cli/command"
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/releasecheck"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . ReleaseChecker
+
+// ReleaseChecker looks up the latest CLI release.
+type ReleaseChecker interface {
+	LatestRelease(force bool) (releasecheck.Release, bool, error)
+}
 
 type VersionCommand struct {
-	usage  interface{} `usage:"CF_NAME version\n\n   'cf -v' and 'cf --version' are also accepted."`
-	UI     command.UI
-	Config command.Config
+	Check        bool        `long:"check" description:"Check for a newer CLI release now"`
+	EnableCheck  bool        `long:"enable-check" description:"Check for a newer CLI release once a day and show how to upgrade"`
+	DisableCheck bool        `long:"disable-check" description:"Stop checking for newer CLI releases, e.g. in air-gapped environments"`
+	usage        interface{} `usage:"CF_NAME version [--check] [--enable-check | --disable-check]\n\n   'cf -v' and 'cf --version' are also accepted."`
+
+	UI         command.UI
+	Config     command.Config
+	Checker    ReleaseChecker
+	Executable string
 }
 
 func (cmd *VersionCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	cmd.Checker = newReleaseChecker()
+	cmd.Executable, _ = os.Executable()
 	return nil
 }
 
 func (cmd VersionCommand) Execute(args []string) error {
+	if cmd.EnableCheck && cmd.DisableCheck {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--enable-check", "--disable-check"},
+		}
+	}
+
 	cmd.UI.DisplayText("{{.BinaryName}} version {{.VersionString}}",
 		map[string]interface{}{
 			"BinaryName":    cmd.Config.BinaryName(),
 			"VersionString": cmd.Config.BinaryVersion(),
 		})
 
+	if cmd.EnableCheck {
+		cmd.Config.SetReleaseCheckEnabled(true)
+		cmd.UI.DisplayText("The CLI will check for a newer release once a day.")
+	}
+
+	if cmd.DisableCheck {
+		cmd.Config.SetReleaseCheckEnabled(false)
+		cmd.UI.DisplayText("The CLI will no longer check for newer releases.")
+	}
+
+	if cmd.Check {
+		return cmd.check()
+	}
+
 	return nil
 }
+
+func (cmd VersionCommand) check() error {
+	release, _, err := cmd.Checker.LatestRelease(true)
+	if err != nil {
+		return err
+	}
+
+	if releasecheck.IsNewer(cmd.Config.BinaryVersion(), release.Version) {
+		cmd.UI.DisplayText(newReleaseTemplate, newReleaseValues(cmd.UI, cmd.Config, release, cmd.Executable))
+		return nil
+	}
+
+	cmd.UI.DisplayText("You are using the latest release ({{.LatestVersion}}).", map[string]interface{}{
+		"LatestVersion": release.Version,
+	})
+	return nil
+}
+
+const newReleaseTemplate = "A newer version of the CLI is available: {{.LatestVersion}} (you have {{.CurrentVersion}}). {{.UpgradeHint}}"
+
+// CheckForNewRelease warns about a newer CLI release when the user has opted
+// in to the daily check and the check is due. Failures are logged and
+// otherwise ignored so that the check never affects the command itself.
+func CheckForNewRelease(config command.Config, ui command.UI) {
+	if !config.ReleaseCheckEnabled() {
+		return
+	}
+
+	release, checked, err := newReleaseChecker().LatestRelease(false)
+	if err != nil {
+		log.WithField("error", err).Debug("unable to check for a newer CLI release")
+		return
+	}
+
+	if checked && releasecheck.IsNewer(config.BinaryVersion(), release.Version) {
+		executable, _ := os.Executable()
+		ui.DisplayWarning(newReleaseTemplate, newReleaseValues(ui, config, release, executable))
+	}
+}
+
+func newReleaseChecker() ReleaseChecker {
+	return releasecheck.NewChecker(releasecheck.DefaultEndpoint, configv3.ReleaseCheckFilePath(), releasecheck.CheckTimeout)
+}
+
+func newReleaseValues(ui command.UI, config command.Config, release releasecheck.Release, executable string) map[string]interface{} {
+	return map[string]interface{}{
+		"LatestVersion":  release.Version,
+		"CurrentVersion": config.BinaryVersion(),
+		"UpgradeHint":    ui.TranslateText(releasecheck.DetectInstall(executable).UpgradeHint()),
+	}
+}
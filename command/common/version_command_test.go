@@ -1,8 +1,13 @@
 package common_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/releasecheck"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -12,10 +17,11 @@ import (
 
 var _ = Describe("Version Command", func() {
 	var (
-		cmd        VersionCommand
-		testUI     *ui.UI
-		fakeConfig *commandfakes.FakeConfig
-		err        error
+		cmd         VersionCommand
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		fakeChecker *commonfakes.FakeReleaseChecker
+		err         error
 	)
 
 	BeforeEach(func() {
@@ -23,16 +29,101 @@ var _ = Describe("Version Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeConfig.BinaryNameReturns("faceman")
 		fakeConfig.BinaryVersionReturns("0.0.0-invalid-version")
+		fakeChecker = new(commonfakes.FakeReleaseChecker)
 
 		cmd = VersionCommand{
-			UI:     testUI,
-			Config: fakeConfig,
+			UI:         testUI,
+			Config:     fakeConfig,
+			Checker:    fakeChecker,
+			Executable: "/home/user/bin/cf",
 		}
 	})
 
-	It("displays correct version", func() {
+	JustBeforeEach(func() {
 		err = cmd.Execute(nil)
+	})
+
+	It("displays correct version", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(testUI.Out).To(Say("faceman version 0.0.0-invalid-version"))
+		Expect(fakeChecker.LatestReleaseCallCount()).To(Equal(0))
+		Expect(fakeConfig.SetReleaseCheckEnabledCallCount()).To(Equal(0))
+	})
+
+	When("--check is provided", func() {
+		BeforeEach(func() {
+			cmd.Check = true
+			fakeConfig.BinaryVersionReturns("6.46.0+a1b2c3d.2019-06-01")
+		})
+
+		When("a newer release is available", func() {
+			BeforeEach(func() {
+				fakeChecker.LatestReleaseReturns(releasecheck.Release{Version: "6.47.0"}, true, nil)
+			})
+
+			It("forces a check and displays how to upgrade", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeChecker.LatestReleaseArgsForCall(0)).To(BeTrue())
+				Expect(testUI.Out).To(Say(`A newer version of the CLI is available: 6\.47\.0 \(you have 6\.46\.0\+a1b2c3d\.2019-06-01\)\. Download it from https://github\.com/cloudfoundry/cli#downloads`))
+			})
+		})
+
+		When("the latest release is in use", func() {
+			BeforeEach(func() {
+				fakeChecker.LatestReleaseReturns(releasecheck.Release{Version: "6.46.0"}, true, nil)
+			})
+
+			It("says so", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`You are using the latest release \(6\.46\.0\)\.`))
+			})
+		})
+
+		When("the check fails", func() {
+			BeforeEach(func() {
+				fakeChecker.LatestReleaseReturns(releasecheck.Release{}, false, errors.New("no network"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("no network"))
+			})
+		})
+	})
+
+	When("--enable-check is provided", func() {
+		BeforeEach(func() {
+			cmd.EnableCheck = true
+		})
+
+		It("opts in to the daily check", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetReleaseCheckEnabledArgsForCall(0)).To(BeTrue())
+			Expect(testUI.Out).To(Say("The CLI will check for a newer release once a day."))
+		})
+	})
+
+	When("--disable-check is provided", func() {
+		BeforeEach(func() {
+			cmd.DisableCheck = true
+		})
+
+		It("opts out of the daily check", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetReleaseCheckEnabledArgsForCall(0)).To(BeFalse())
+			Expect(testUI.Out).To(Say("The CLI will no longer check for newer releases."))
+		})
+	})
+
+	When("--enable-check and --disable-check are both provided", func() {
+		BeforeEach(func() {
+			cmd.EnableCheck = true
+			cmd.DisableCheck = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--enable-check", "--disable-check"},
+			}))
+		})
 	})
 })
@@ -11,40 +11,81 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	AccessTokenExpiry() (time.Time, error)
+	AccessTokenScopes() ([]string, error)
+	Accessible() bool
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddRecentJob(job configv3.RecentJob)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
 	CFPassword() string
 	CFUsername() string
+	ChangeHeader() string
+	ChangeReason() string
 	ColorEnabled() configv3.ColorSetting
+	CommandDeadline() time.Time
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
+	DefaultOrganization() string
+	DefaultSpace() string
 	DialTimeout() time.Duration
+	DryRun() bool
 	DockerPassword() string
 	Experimental() bool
-	ExperimentalLogin() bool
+	FeatureEnabled(name string) bool
+	FoundationConfig(name string) (*configv3.Config, error)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HTTPSProxy() string
+	IsTTY() bool
+	InsecureAllowed() bool
 	Locale() string
+	LogSource() string
 	MinCLIVersion() string
+	MinCLIVersionCheck() (configv3.MinCLIVersionCheck, bool)
 	NOAARequestRetryCount() int
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
+	PluginPrecedence() []string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	PushScanHook() string
+	PushScanSkipAllowed() bool
+	RecentJobs() []configv3.RecentJob
 	RefreshToken() string
+	ReleaseCheckEnabled() bool
 	RemovePlugin(string)
 	RequestRetryCount() int
+	RouterCNAME() string
+	RouterIPs() []string
 	RoutingEndpoint() string
 	SetAccessToken(token string)
+	SetAccessible(accessible bool)
+	SetChangeHeader(header string)
+	SetChangeReason(reason string)
+	SetCommandTimeout(timeout time.Duration)
+	SetDefaultOrganization(name string)
+	SetDefaultSpace(name string)
+	SetFeatureEnabled(name string, enabled bool)
+	SetInsecureAllowed(allowed bool)
+	SetLogSource(source string)
 	SetMinCLIVersion(version string)
+	SetMinCLIVersionCheck(check configv3.MinCLIVersionCheck)
 	SetOrganizationInformation(guid string, name string)
+	SetPluginMaxOutput(maxOutput uint64)
+	SetPluginPrecedence(plugins []string)
+	SetPluginTimeout(timeout time.Duration)
+	SetPushScanHook(hook string)
+	SetPushScanSkipAllowed(allowed bool)
 	SetRefreshToken(token string)
+	SetReleaseCheckEnabled(enabled bool)
+	SetRouterCNAME(cname string)
+	SetRouterIPs(ips []string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	V7SetSpaceInformation(guid string, name string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
@@ -52,6 +93,9 @@ type Config interface {
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SetUAAGrantType(uaaGrantType string)
+	SetUsageStatsEnabled(enabled bool)
+	SetUsageStatsEndpoint(endpoint string)
+	SetUserAgentSuffix(suffix string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
@@ -60,6 +104,7 @@ type Config interface {
 	TargetedOrganization() configv3.Organization
 	TargetedOrganizationName() string
 	TargetedSpace() configv3.Space
+	Timings() bool
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
@@ -67,6 +112,9 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UsageStatsEnabled() bool
+	UsageStatsEndpoint() string
+	UserAgentSuffix() string
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 }
@@ -0,0 +1,172 @@
+// Package deprecation contains the table of V6 behavior that changes in V7
+// and displays an advisory with the replacement when a user relies on it.
+package deprecation
+
+import "strings"
+
+// ID identifies an advisory in the table.
+type ID string
+
+const (
+	ManifestGlobalFields    ID = "manifest-global-fields"
+	ManifestInheritance     ID = "manifest-inheritance"
+	ManifestRouteComponents ID = "manifest-route-components"
+	PushRouteFlags          ID = "push-route-flags"
+	V3AppCommand            ID = "v3-app-command"
+	V3AppsCommand           ID = "v3-apps-command"
+	V3PushCommand           ID = "v3-push-command"
+	V3ScaleCommand          ID = "v3-scale-command"
+	V3SSHCommand            ID = "v3-ssh-command"
+)
+
+// Advisory describes a V6 behavior that changes in V7 and how to replace it.
+type Advisory struct {
+	// ID identifies the advisory.
+	ID ID
+
+	// Command is the name of the command the advisory applies to.
+	Command string
+
+	// Flags lists the flags that trigger the advisory when set. Flags are
+	// named by their long form with hyphens, or by their short form when they
+	// have no long form. When empty, the advisory is displayed on every run unless
+	// Reported is set.
+	Flags []string
+
+	// Reported is set when the command itself decides when the advisory
+	// applies, for example after reading a manifest.
+	Reported bool
+
+	// Change explains what changes in V7.
+	Change string
+
+	// Replacement is the command line to use instead. CF_NAME is replaced by
+	// the binary name.
+	Replacement string
+
+	// DocsURL points to further documentation, may be empty.
+	DocsURL string
+
+	// Details is set by callers with specifics about the current run, such as
+	// the deprecated fields that were found.
+	Details string
+}
+
+// Advisories is the table of V6 behavior that changes in V7.
+var Advisories = []Advisory{
+	{
+		ID:          ManifestGlobalFields,
+		Command:     "push",
+		Reported:    true,
+		Change:      "Specifying app manifest attributes at the top level is deprecated and is not supported in V7.",
+		Replacement: "CF_NAME push -f MANIFEST_PATH, with the attributes repeated under each app in 'applications'",
+		DocsURL:     "http://docs.cloudfoundry.org/devguide/deploy-apps/manifest.html#deprecated",
+	},
+	{
+		ID:          ManifestInheritance,
+		Command:     "push",
+		Reported:    true,
+		Change:      "App manifest inheritance is deprecated and is not supported in V7.",
+		Replacement: "CF_NAME push -f MANIFEST_PATH --vars-file VARS_FILE_PATH, with the shared values moved to the vars file",
+		DocsURL:     "http://docs.cloudfoundry.org/devguide/deploy-apps/manifest.html#deprecated",
+	},
+	{
+		ID:          ManifestRouteComponents,
+		Command:     "push",
+		Reported:    true,
+		Change:      "Route component attributes 'domain', 'domains', 'host', 'hosts' and 'no-hostname' are deprecated and are not supported in V7.",
+		Replacement: "CF_NAME push -f MANIFEST_PATH, with the full URLs listed under 'routes'",
+		DocsURL:     "http://docs.cloudfoundry.org/devguide/deploy-apps/manifest.html#deprecated",
+	},
+	{
+		ID:          PushRouteFlags,
+		Command:     "push",
+		Flags:       []string{"-d", "--hostname", "--no-hostname", "--random-route", "--route-path"},
+		Change:      "The route flags of push are removed in V7.",
+		Replacement: "CF_NAME push APP_NAME --no-route, then CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]",
+	},
+	{
+		ID:          V3AppCommand,
+		Command:     "v3-app",
+		Change:      "The v3-app command is removed in V7.",
+		Replacement: "CF_NAME app APP_NAME",
+	},
+	{
+		ID:          V3AppsCommand,
+		Command:     "v3-apps",
+		Change:      "The v3-apps command is removed in V7.",
+		Replacement: "CF_NAME apps",
+	},
+	{
+		ID:          V3PushCommand,
+		Command:     "v3-push",
+		Change:      "The v3-push command is removed in V7.",
+		Replacement: "CF_NAME push APP_NAME",
+	},
+	{
+		ID:          V3ScaleCommand,
+		Command:     "v3-scale",
+		Change:      "The v3-scale command is removed in V7.",
+		Replacement: "CF_NAME scale APP_NAME [--process PROCESS]",
+	},
+	{
+		ID:          V3SSHCommand,
+		Command:     "v3-ssh",
+		Change:      "The v3-ssh command is removed in V7.",
+		Replacement: "CF_NAME ssh APP_NAME [--process PROCESS]",
+	},
+}
+
+// Lookup returns the advisory with the given ID. The zero Advisory is
+// returned for unknown IDs.
+func Lookup(id ID) Advisory {
+	for _, advisory := range Advisories {
+		if advisory.ID == id {
+			return advisory
+		}
+	}
+	return Advisory{}
+}
+
+// ForCommand returns the advisories that apply to running commandName with
+// the given flags set. The details of flag advisories list the flags found.
+// Advisories reported by the command itself are not included.
+func ForCommand(commandName string, setFlags []string) []Advisory {
+	var advisories []Advisory
+	for _, advisory := range Advisories {
+		if advisory.Command != commandName || advisory.Reported {
+			continue
+		}
+
+		if len(advisory.Flags) == 0 {
+			advisories = append(advisories, advisory)
+			continue
+		}
+
+		var usedFlags []string
+		for _, flag := range advisory.Flags {
+			if contains(setFlags, flag) {
+				usedFlags = append(usedFlags, flag)
+			}
+		}
+		if len(usedFlags) > 0 {
+			advisories = append(advisories, advisory.WithDetails("Found: "+strings.Join(usedFlags, ", ")+"."))
+		}
+	}
+	return advisories
+}
+
+// WithDetails returns a copy of the advisory with its details set.
+func (advisory Advisory) WithDetails(details string) Advisory {
+	advisory.Details = details
+	return advisory
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
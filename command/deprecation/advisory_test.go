@@ -0,0 +1,61 @@
+package deprecation_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/deprecation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Advisories", func() {
+	It("has a unique ID, a command, a change and a replacement for every advisory", func() {
+		ids := map[ID]bool{}
+		for _, advisory := range Advisories {
+			Expect(ids).ToNot(HaveKey(advisory.ID))
+			ids[advisory.ID] = true
+
+			Expect(advisory.Command).ToNot(BeEmpty(), string(advisory.ID))
+			Expect(advisory.Change).ToNot(BeEmpty(), string(advisory.ID))
+			Expect(advisory.Replacement).To(HavePrefix("CF_NAME "), string(advisory.ID))
+		}
+	})
+
+	Describe("Lookup", func() {
+		It("returns the advisory with the ID", func() {
+			Expect(Lookup(ManifestInheritance).Command).To(Equal("push"))
+		})
+
+		It("returns the zero advisory for unknown IDs", func() {
+			Expect(Lookup("unknown")).To(Equal(Advisory{}))
+		})
+	})
+
+	Describe("ForCommand", func() {
+		It("returns the advisories of the command that apply on every run", func() {
+			advisories := ForCommand("v3-push", nil)
+			Expect(advisories).To(HaveLen(1))
+			Expect(advisories[0].ID).To(Equal(V3PushCommand))
+		})
+
+		It("returns the flag advisories when their flags are set, listing the flags found", func() {
+			advisories := ForCommand("push", []string{"--hostname", "-d", "--memory"})
+			Expect(advisories).To(HaveLen(1))
+			Expect(advisories[0].ID).To(Equal(PushRouteFlags))
+			Expect(advisories[0].Details).To(Equal("Found: -d, --hostname."))
+		})
+
+		It("does not return flag advisories when their flags are not set", func() {
+			Expect(ForCommand("push", []string{"--memory"})).To(BeEmpty())
+		})
+
+		It("does not return advisories reported by the command", func() {
+			for _, advisory := range ForCommand("push", []string{"--hostname"}) {
+				Expect(advisory.Reported).To(BeFalse())
+			}
+		})
+
+		It("returns nothing for other commands", func() {
+			Expect(ForCommand("apps", []string{"--hostname"})).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package deprecation
+
+import "strings"
+
+// UI is the subset of the command UI used to display advisories.
+type UI interface {
+	DisplayWarning(template string, templateValues ...map[string]interface{})
+}
+
+// Display writes the advisories to the UI's error stream.
+func Display(ui UI, binaryName string, advisories ...Advisory) {
+	for _, advisory := range advisories {
+		change := advisory.Change
+		if advisory.Details != "" {
+			change += " " + advisory.Details
+		}
+
+		ui.DisplayWarning("Deprecation advisory: {{.Change}}", map[string]interface{}{
+			"Change": change,
+		})
+		ui.DisplayWarning("   Instead use: {{.Replacement}}", map[string]interface{}{
+			"Replacement": strings.Replace(advisory.Replacement, "CF_NAME", binaryName, -1),
+		})
+		if advisory.DocsURL != "" {
+			ui.DisplayWarning("   More info:   {{.DocsURL}}", map[string]interface{}{
+				"DocsURL": advisory.DocsURL,
+			})
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package deprecation_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/deprecation"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Display", func() {
+	var testUI *ui.UI
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	It("displays the change, replacement and docs on stderr", func() {
+		Display(testUI, "faceman", Lookup(ManifestInheritance).WithDetails("Found: inherit."))
+
+		Expect(testUI.Err).To(Say(`Deprecation advisory: App manifest inheritance is deprecated and is not supported in V7\. Found: inherit\.`))
+		Expect(testUI.Err).To(Say(`   Instead use: faceman push -f MANIFEST_PATH --vars-file VARS_FILE_PATH`))
+		Expect(testUI.Err).To(Say(`   More info:   http://docs.cloudfoundry.org/devguide/deploy-apps/manifest.html#deprecated`))
+		Expect(testUI.Out).ToNot(Say("."))
+	})
+
+	It("omits the docs when the advisory has none", func() {
+		Display(testUI, "faceman", Lookup(V3AppsCommand))
+
+		Expect(testUI.Err).To(Say(`Deprecation advisory: The v3-apps command is removed in V7\.`))
+		Expect(testUI.Err).To(Say(`   Instead use: faceman apps`))
+		Expect(testUI.Err).ToNot(Say("More info"))
+	})
+})
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"github.com/jmespath/go-jmespath"
+)
+
+// DisplayDocument displays the structured result of a command. The JMESPath
+// query, when given, first narrows the JSON document down. Without a template
+// the result is displayed as an indented JSON document. Otherwise the Go
+// template is executed against the JSON document, so that queries and
+// templates use the same field names as the JSON output.
+func DisplayDocument(ui UI, document interface{}, query string, outputTemplate string) error {
+	raw, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if query != "" {
+		raw, err = queryDocument(raw, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	if outputTemplate == "" {
+		ui.DisplayText("{{.Document}}", map[string]interface{}{
+			"Document": string(raw),
+		})
+		return nil
+	}
+
+	parsedTemplate, err := template.New("output").Parse(outputTemplate)
+	if err != nil {
+		return translatableerror.InvalidTemplateError{Message: err.Error()}
+	}
+
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	err = decoder.Decode(&data)
+	if err != nil {
+		return err
+	}
+
+	var output bytes.Buffer
+	err = parsedTemplate.Execute(&output, data)
+	if err != nil {
+		return translatableerror.InvalidTemplateError{Message: err.Error()}
+	}
+
+	_, err = ui.GetOut().Write(output.Bytes())
+	return err
+}
+
+func queryDocument(raw []byte, query string) ([]byte, error) {
+	var data interface{}
+	err := json.Unmarshal(raw, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := jmespath.Search(query, data)
+	if err != nil {
+		return nil, translatableerror.InvalidQueryError{Message: err.Error()}
+	}
+
+	return json.MarshalIndent(result, "", "  ")
+}
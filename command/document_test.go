@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayDocument", func() {
+	type app struct {
+		Name      string `json:"name"`
+		Instances int    `json:"instances"`
+	}
+
+	var (
+		testUI   *ui.UI
+		document interface{}
+		err      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		document = map[string]interface{}{
+			"apps": []app{
+				{Name: "some-app", Instances: 2},
+				{Name: "other-app", Instances: 1},
+			},
+		}
+	})
+
+	When("no template is given", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "", "")
+		})
+
+		It("displays the document as indented JSON", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`\{\n  "apps": \[\n    \{\n      "name": "some-app",\n      "instances": 2\n    \},`))
+		})
+	})
+
+	When("a template is given", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "", `{{range .apps}}{{.name}} {{.instances}}{{"\n"}}{{end}}`)
+		})
+
+		It("executes the template against the JSON field names", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("^some-app 2\nother-app 1\n$"))
+		})
+	})
+
+	When("a query is given", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "apps[?instances > `1`].name", "")
+		})
+
+		It("displays the result of the query as indented JSON", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`^\[\n  "some-app"\n\]\n$`))
+		})
+	})
+
+	When("a query and a template are given", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "apps[0]", `{{.name}} {{.instances}}`)
+		})
+
+		It("executes the template against the result of the query", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("^some-app 2$"))
+		})
+	})
+
+	When("the query cannot be parsed", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "apps[?", "")
+		})
+
+		It("returns an InvalidQueryError", func() {
+			Expect(err).To(BeAssignableToTypeOf(translatableerror.InvalidQueryError{}))
+			Expect(testUI.Out).ToNot(Say("."))
+		})
+	})
+
+	When("the template cannot be parsed", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "", `{{range .apps}}`)
+		})
+
+		It("returns an InvalidTemplateError", func() {
+			Expect(err).To(BeAssignableToTypeOf(translatableerror.InvalidTemplateError{}))
+			Expect(testUI.Out).ToNot(Say("."))
+		})
+	})
+
+	When("the template cannot be executed", func() {
+		BeforeEach(func() {
+			err = DisplayDocument(testUI, document, "", `{{index .apps 5}}`)
+		})
+
+		It("returns an InvalidTemplateError without partial output", func() {
+			Expect(err).To(BeAssignableToTypeOf(translatableerror.InvalidTemplateError{}))
+			Expect(testUI.Out).ToNot(Say("."))
+		})
+	})
+})
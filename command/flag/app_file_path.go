@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppFilePath is a file in an app instance given as APP_NAME:PATH.
+type AppFilePath struct {
+	AppName string
+	Path    string
+}
+
+func (a *AppFilePath) UnmarshalFlag(val string) error {
+	separator := strings.Index(val, ":")
+	if separator <= 0 || separator == len(val)-1 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "App file must be given as APP_NAME:PATH.",
+		}
+	}
+
+	a.AppName = val[:separator]
+	a.Path = val[separator+1:]
+	return nil
+}
@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppFilePath", func() {
+	var appFile AppFilePath
+
+	BeforeEach(func() {
+		appFile = AppFilePath{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expectedAppName string, expectedPath string) {
+			err := appFile.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(appFile.AppName).To(Equal(expectedAppName))
+			Expect(appFile.Path).To(Equal(expectedPath))
+		},
+		Entry("relative path", "some-app:app/config.yml", "some-app", "app/config.yml"),
+		Entry("absolute path", "some-app:/etc/hosts", "some-app", "/etc/hosts"),
+		Entry("path containing a colon", "some-app:logs/a:b.log", "some-app", "logs/a:b.log"),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			err := appFile.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: "App file must be given as APP_NAME:PATH.",
+			}))
+		},
+		Entry("empty string", ""),
+		Entry("missing path", "some-app:"),
+		Entry("missing app name", ":some-path"),
+		Entry("missing separator", "some-app"),
+	)
+})
@@ -0,0 +1,45 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppInstanceName is an app instance given as APP_NAME/INDEX. The index
+// defaults to 0 when it is omitted.
+type AppInstanceName struct {
+	AppName string
+	Index   uint
+}
+
+func (a *AppInstanceName) UnmarshalFlag(val string) error {
+	appName := val
+	var index uint64
+
+	if separator := strings.LastIndex(val, "/"); separator != -1 {
+		appName = val[:separator]
+
+		var err error
+		index, err = strconv.ParseUint(val[separator+1:], 10, 0)
+		if err != nil {
+			return invalidAppInstanceNameError()
+		}
+	}
+
+	if appName == "" {
+		return invalidAppInstanceNameError()
+	}
+
+	a.AppName = appName
+	a.Index = uint(index)
+	return nil
+}
+
+func invalidAppInstanceNameError() error {
+	return &flags.Error{
+		Type:    flags.ErrMarshal,
+		Message: "App instance must be given as APP_NAME/INDEX, where INDEX is a non-negative integer.",
+	}
+}
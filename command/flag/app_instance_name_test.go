@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppInstanceName", func() {
+	var appInstance AppInstanceName
+
+	BeforeEach(func() {
+		appInstance = AppInstanceName{}
+	})
+
+	DescribeTable("UnmarshalFlag with valid values",
+		func(input string, expectedAppName string, expectedIndex uint) {
+			err := appInstance.UnmarshalFlag(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(appInstance.AppName).To(Equal(expectedAppName))
+			Expect(appInstance.Index).To(Equal(expectedIndex))
+		},
+		Entry("app name and index", "some-app/3", "some-app", uint(3)),
+		Entry("app name without an index", "some-app", "some-app", uint(0)),
+		Entry("app name containing a slash", "some/app/1", "some/app", uint(1)),
+	)
+
+	DescribeTable("UnmarshalFlag with invalid values",
+		func(input string) {
+			err := appInstance.UnmarshalFlag(input)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: "App instance must be given as APP_NAME/INDEX, where INDEX is a non-negative integer.",
+			}))
+		},
+		Entry("empty string", ""),
+		Entry("missing app name", "/0"),
+		Entry("missing index", "some-app/"),
+		Entry("negative index", "some-app/-1"),
+		Entry("non-numeric index", "some-app/banana"),
+	)
+})
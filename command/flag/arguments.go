@@ -4,6 +4,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type ProjectAppName struct {
+	AppName string `positional-arg-name:"APP_NAME" description:"The application name (Default: the app in cfproject.yml)"`
+}
+
 type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
@@ -16,6 +20,16 @@ type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
 
+type RequiredCommandName struct {
+	CommandName string `positional-arg-name:"COMMAND_NAME" required:"true" description:"The command name"`
+}
+
+type ConfigArgs struct {
+	Action  string `positional-arg-name:"ACTION" description:"Either set or unset"`
+	Setting string `positional-arg-name:"SETTING" description:"The setting: default-org, default-space, push-scan-hook, push-scan-skip-allowed, insecure-allowed, log-source, router-cname, router-ips, change-header, user-agent-suffix, plugin-precedence, plugin-timeout, plugin-max-output or accessible"`
+	Value   string `positional-arg-name:"VALUE" description:"The value of the setting"`
+}
+
 type Domain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
@@ -44,6 +58,10 @@ type APIPath struct {
 	Path string `positional-arg-name:"PATH" required:"true" description:"The API endpoint"`
 }
 
+type WebSocketTarget struct {
+	Target string `positional-arg-name:"PATH_OR_URL" required:"true" description:"A path on the API endpoint, or a ws, wss, http or https URL"`
+}
+
 type PluginRepoName struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 }
@@ -94,6 +112,11 @@ type CreateUser struct {
 	Password *string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
+type ExecArgs struct {
+	AppName string   `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Command []string `positional-arg-name:"COMMAND" required:"true" description:"The command to run and its arguments, given after --"`
+}
+
 type AppInstance struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
@@ -146,6 +169,10 @@ type FilesArgs struct {
 	Path    string `positional-arg-name:"PATH" description:"The file path"`
 }
 
+type CatArgs struct {
+	File AppFilePath `positional-arg-name:"APP_NAME:PATH" required:"true" description:"The application name and the path of the file"`
+}
+
 type EnvironmentArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
@@ -167,9 +194,9 @@ type CopySourceArgs struct {
 }
 
 type CreateServiceArgs struct {
-	Service         string `positional-arg-name:"SERVICE" required:"true" description:"The service offering"`
-	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" required:"true" description:"The service plan that the service instance will use"`
-	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance"`
+	Service         string `positional-arg-name:"SERVICE" description:"The service offering"`
+	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" description:"The service plan that the service instance will use"`
+	ServiceInstance string `positional-arg-name:"SERVICE_INSTANCE" description:"The service instance"`
 }
 
 type RenameServiceArgs struct {
@@ -249,6 +276,11 @@ type DeleteLabelArgs struct {
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
 	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to delete on the resource"`
 }
+
+type SetOwnerArgs struct {
+	ResourceType OwnedResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource: org, space or app"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
 type SetOrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
@@ -346,3 +378,44 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type ScriptPath struct {
+	Path string `positional-arg-name:"SCRIPT_PATH" required:"true" description:"Path to the script, or - to read it from standard input"`
+}
+
+type ManifestPathArg struct {
+	Path PathWithExistenceCheck `positional-arg-name:"MANIFEST_PATH" required:"true" description:"Path to the manifest file"`
+}
+
+type AppInstanceNameArg struct {
+	Instance AppInstanceName `positional-arg-name:"APP_NAME/INDEX" required:"true" description:"The application name and instance index"`
+}
+
+type JobGUID struct {
+	GUID string `positional-arg-name:"JOB_GUID" required:"true" description:"The job GUID"`
+}
+
+type CLIFeature struct {
+	Feature string `positional-arg-name:"FEATURE" required:"true" description:"The experimental CLI feature name"`
+}
+
+type RouteURL struct {
+	Route string `positional-arg-name:"ROUTE" required:"true" description:"The route, e.g. myapp.example.com or myapp.example.com/path"`
+}
+
+type CanIArgs struct {
+	Operation string `positional-arg-name:"OPERATION" required:"true" description:"The operation, such as push or create-space"`
+}
+
+type EnvironmentsFileArg struct {
+	Path PathWithExistenceCheck `positional-arg-name:"ENVIRONMENTS_FILE" required:"true" description:"Path to the JSON file written by export-env"`
+}
+
+type QuotasFileArg struct {
+	Path PathWithExistenceCheck `positional-arg-name:"QUOTAS_FILE" required:"true" description:"Path to the YAML file declaring the quotas"`
+}
+
+type UnscheduleTaskArgs struct {
+	AppName      string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	ScheduleName string `positional-arg-name:"SCHEDULE_NAME" required:"true" description:"The task schedule name"`
+}
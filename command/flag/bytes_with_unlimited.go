@@ -0,0 +1,39 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// BytesWithUnlimited is an amount of bytes, or -1 for an unlimited amount.
+type BytesWithUnlimited struct {
+	types.NullInt
+}
+
+func (b *BytesWithUnlimited) UnmarshalFlag(val string) error {
+	switch val {
+	case "-1":
+		b.Value = -1
+		b.IsSet = true
+		return nil
+	case "0":
+		b.Value = 0
+		b.IsSet = true
+		return nil
+	}
+
+	size, err := bytefmt.ToBytes(val)
+	if err != nil || strings.Contains(val, ".") {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Byte quantity must be an integer with a unit of measurement like B, K, KB, M, MB, G, or GB`,
+		}
+	}
+
+	b.Value = int(size)
+	b.IsSet = true
+	return nil
+}
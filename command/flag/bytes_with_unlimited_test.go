@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BytesWithUnlimited", func() {
+	var bytes BytesWithUnlimited
+
+	BeforeEach(func() {
+		bytes = BytesWithUnlimited{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("converts valid values to bytes",
+			func(input string, expected int) {
+				Expect(bytes.UnmarshalFlag(input)).To(Succeed())
+				Expect(bytes).To(Equal(BytesWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: expected}}))
+			},
+			Entry("kilobytes", "1K", 1024),
+			Entry("megabytes", "2MB", 2*1024*1024),
+			Entry("zero", "0", 0),
+			Entry("unlimited", "-1", -1),
+		)
+
+		When("the value is invalid", func() {
+			It("returns an error", func() {
+				err := bytes.UnmarshalFlag("lots")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Byte quantity must be an integer with a unit of measurement like B, K, KB, M, MB, G, or GB`,
+				}))
+				Expect(bytes.IsSet).To(BeFalse())
+			})
+		})
+	})
+})
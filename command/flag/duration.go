@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a positive duration such as 90s, 10m or 1h30m.
+type Duration struct {
+	Value time.Duration
+}
+
+func (d *Duration) UnmarshalFlag(rawValue string) error {
+	value, err := time.ParseDuration(rawValue)
+	if err != nil || value <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Duration must be a positive duration like 90s, 10m or 1h30m.`,
+		}
+	}
+
+	d.Value = value
+	return nil
+}
@@ -0,0 +1,41 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			duration = Duration{}
+		})
+
+		It("sets the value", func() {
+			err := duration.UnmarshalFlag("1h30m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(duration.Value).To(Equal(90 * time.Minute))
+		})
+
+		DescribeTable("returns an error",
+			func(rawValue string) {
+				err := duration.UnmarshalFlag(rawValue)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Duration must be a positive duration like 90s, 10m or 1h30m.`,
+				}))
+			},
+			Entry("when passed something that is not a duration", "soon"),
+			Entry("when passed a number without a unit", "5"),
+			Entry("when passed a duration that is not positive", "0s"),
+		)
+	})
+})
@@ -0,0 +1,47 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// HTTPStatus is either a status code such as 404 or a status class such as
+// 5xx. The zero value matches every status code.
+type HTTPStatus struct {
+	Code  int
+	Class int
+}
+
+func (h *HTTPStatus) UnmarshalFlag(rawValue string) error {
+	lower := strings.ToLower(rawValue)
+	if len(lower) == 3 && strings.HasSuffix(lower, "xx") && lower[0] >= '1' && lower[0] <= '5' {
+		h.Class = int(lower[0] - '0')
+		return nil
+	}
+
+	code, err := strconv.Atoi(rawValue)
+	if err != nil || code < 100 || code > 599 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Status must be an HTTP status code like 404 or a status class like 5xx.`,
+		}
+	}
+
+	h.Code = code
+	return nil
+}
+
+// Matches returns true when statusCode is the status code or belongs to the
+// status class.
+func (h HTTPStatus) Matches(statusCode int) bool {
+	switch {
+	case h.Code != 0:
+		return statusCode == h.Code
+	case h.Class != 0:
+		return statusCode/100 == h.Class
+	default:
+		return true
+	}
+}
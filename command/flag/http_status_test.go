@@ -0,0 +1,60 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("HTTPStatus", func() {
+	var status HTTPStatus
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			status = HTTPStatus{}
+		})
+
+		DescribeTable("sets the code or class",
+			func(rawValue string, expected HTTPStatus) {
+				err := status.UnmarshalFlag(rawValue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(status).To(Equal(expected))
+			},
+			Entry("a status code", "404", HTTPStatus{Code: 404}),
+			Entry("a status class", "5xx", HTTPStatus{Class: 5}),
+			Entry("an upper case status class", "4XX", HTTPStatus{Class: 4}),
+		)
+
+		DescribeTable("returns an error",
+			func(rawValue string) {
+				err := status.UnmarshalFlag(rawValue)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Status must be an HTTP status code like 404 or a status class like 5xx.`,
+				}))
+			},
+			Entry("when passed something that is not a status", "error"),
+			Entry("when passed a code out of range", "600"),
+			Entry("when passed a class out of range", "6xx"),
+		)
+	})
+
+	Describe("Matches", func() {
+		It("matches the status code", func() {
+			Expect(HTTPStatus{Code: 404}.Matches(404)).To(BeTrue())
+			Expect(HTTPStatus{Code: 404}.Matches(403)).To(BeFalse())
+		})
+
+		It("matches the status class", func() {
+			Expect(HTTPStatus{Class: 5}.Matches(503)).To(BeTrue())
+			Expect(HTTPStatus{Class: 5}.Matches(404)).To(BeFalse())
+		})
+
+		It("matches every status code when unset", func() {
+			Expect(HTTPStatus{}.Matches(200)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// IntegerLimit is a non-negative integer limit, or -1 for no limit.
+type IntegerLimit struct {
+	types.NullInt
+}
+
+func (i *IntegerLimit) UnmarshalFlag(val string) error {
+	err := i.ParseStringValue(val)
+	if err != nil || i.Value < -1 {
+		i.NullInt = types.NullInt{}
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument (expected int >= -1)",
+		}
+	}
+	return nil
+}
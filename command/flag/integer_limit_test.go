@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IntegerLimit", func() {
+	var limit IntegerLimit
+
+	BeforeEach(func() {
+		limit = IntegerLimit{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts integers of -1 or more",
+			func(input string, expected int) {
+				Expect(limit.UnmarshalFlag(input)).To(Succeed())
+				Expect(limit).To(Equal(IntegerLimit{NullInt: types.NullInt{IsSet: true, Value: expected}}))
+			},
+			Entry("a positive integer", "10", 10),
+			Entry("zero", "0", 0),
+			Entry("unlimited", "-1", -1),
+		)
+
+		DescribeTable("returns an error for invalid values",
+			func(input string) {
+				err := limit.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument (expected int >= -1)",
+				}))
+				Expect(limit.IsSet).To(BeFalse())
+			},
+			Entry("less than -1", "-2"),
+			Entry("not an integer", "abc"),
+		)
+	})
+})
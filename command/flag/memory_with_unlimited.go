@@ -1,8 +1,37 @@
 package flag
 
-type MemoryWithUnlimited int64
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// MemoryWithUnlimited is an amount of memory in megabytes, or -1 for an
+// unlimited amount.
+type MemoryWithUnlimited struct {
+	types.NullInt
+}
 
-//TODO:Code for this flag exists in cf/formatters/bytes.go, move tests from there to here
 func (m *MemoryWithUnlimited) UnmarshalFlag(val string) error {
+	if val == "-1" {
+		m.Value = -1
+		m.IsSet = true
+		return nil
+	}
+
+	size, err := bytefmt.ToMegabytes(val)
+	if err != nil ||
+		!strings.ContainsAny(strings.ToLower(val), ALLOWED_UNITS) ||
+		strings.Contains(val, ".") {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB`,
+		}
+	}
+
+	m.Value = int(size)
+	m.IsSet = true
 	return nil
 }
@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryWithUnlimited", func() {
+	var memory MemoryWithUnlimited
+
+	BeforeEach(func() {
+		memory = MemoryWithUnlimited{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("the value has a unit", func() {
+			It("converts the value to megabytes", func() {
+				Expect(memory.UnmarshalFlag("2G")).To(Succeed())
+				Expect(memory).To(Equal(MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: 2048}}))
+			})
+		})
+
+		When("the value is -1", func() {
+			It("sets the value to -1", func() {
+				Expect(memory.UnmarshalFlag("-1")).To(Succeed())
+				Expect(memory).To(Equal(MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: -1}}))
+			})
+		})
+
+		DescribeTable("returns an error for invalid values",
+			func(input string) {
+				err := memory.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB`,
+				}))
+				Expect(memory.IsSet).To(BeFalse())
+			},
+			Entry("no unit", "1024"),
+			Entry("a decimal", "1.5G"),
+			Entry("an unsupported unit", "100T"),
+			Entry("not a number", "lots"),
+		)
+	})
+})
@@ -0,0 +1,14 @@
+package flag
+
+// OptionalString is a string flag that records whether it was provided, so
+// that an explicitly empty value can be told apart from a missing one.
+type OptionalString struct {
+	IsSet bool
+	Value string
+}
+
+func (o *OptionalString) UnmarshalFlag(val string) error {
+	o.IsSet = true
+	o.Value = val
+	return nil
+}
@@ -0,0 +1,27 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OptionalString", func() {
+	var optionalString OptionalString
+
+	BeforeEach(func() {
+		optionalString = OptionalString{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("records the value as set", func() {
+			Expect(optionalString.UnmarshalFlag("some-value")).To(Succeed())
+			Expect(optionalString).To(Equal(OptionalString{IsSet: true, Value: "some-value"}))
+		})
+
+		It("records an empty value as set", func() {
+			Expect(optionalString.UnmarshalFlag("")).To(Succeed())
+			Expect(optionalString).To(Equal(OptionalString{IsSet: true}))
+		})
+	})
+})
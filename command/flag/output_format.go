@@ -0,0 +1,9 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type OutputFormat string
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"table", "json"}, prefix, false)
+}
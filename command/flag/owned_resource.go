@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OwnedResource struct {
+	Type string
+}
+
+func (OwnedResource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"org", "space", "app"}, prefix, false)
+}
+
+func (o *OwnedResource) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "org", "space", "app":
+		o.Type = strings.ToLower(val)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `RESOURCE must be "org", "space" or "app"`,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OwnedResource", func() {
+	var ownedResource OwnedResource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := ownedResource.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'org' when passed 'o'", "o",
+				[]flags.Completion{{Item: "org"}}),
+			Entry("returns 'space' when passed 'S'", "S",
+				[]flags.Completion{{Item: "space"}}),
+			Entry("returns all resources when passed nothing", "",
+				[]flags.Completion{{Item: "org"}, {Item: "space"}, {Item: "app"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			ownedResource = OwnedResource{}
+		})
+
+		DescribeTable("accepts org, space and app in any case",
+			func(val string, expected string) {
+				Expect(ownedResource.UnmarshalFlag(val)).To(Succeed())
+				Expect(ownedResource.Type).To(Equal(expected))
+			},
+			Entry("org", "org", "org"),
+			Entry("Space", "Space", "space"),
+			Entry("APP", "APP", "app"),
+		)
+
+		It("errors on anything else", func() {
+			err := ownedResource.UnmarshalFlag("route")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `RESOURCE must be "org", "space" or "app"`,
+			}))
+			Expect(ownedResource.Type).To(BeEmpty())
+		})
+	})
+})
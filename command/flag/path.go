@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"code.cloudfoundry.org/cli/util"
 	flags "github.com/jessevdk/go-flags"
 )
 
@@ -101,6 +102,30 @@ func (p *PathWithExistenceCheckOrURL) UnmarshalFlag(path string) error {
 	return nil
 }
 
+type PathWithExistenceCheckOrRemoteSource string
+
+func (PathWithExistenceCheckOrRemoteSource) Complete(prefix string) []flags.Completion {
+	return completeWithTilde(prefix)
+}
+
+func (p *PathWithExistenceCheckOrRemoteSource) UnmarshalFlag(path string) error {
+	if !util.IsHTTPScheme(path) && !util.IsGitURL(path) {
+		_, err := os.Stat(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return &flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("The specified path '%s' does not exist.", path),
+				}
+			}
+			return err
+		}
+	}
+
+	*p = PathWithExistenceCheckOrRemoteSource(path)
+	return nil
+}
+
 type PathWithAt string
 
 func (PathWithAt) Complete(prefix string) []flags.Completion {
@@ -343,6 +343,53 @@ var _ = Describe("path types", func() {
 		})
 	})
 
+	Describe("PathWithExistenceCheckOrRemoteSource", func() {
+		var pathOrRemoteSource PathWithExistenceCheckOrRemoteSource
+
+		BeforeEach(func() {
+			pathOrRemoteSource = PathWithExistenceCheckOrRemoteSource("")
+		})
+
+		// The Complete method is not tested because it shares the same code as
+		// Path.Complete().
+
+		Describe("UnmarshalFlag", func() {
+			When("the path is an archive URL", func() {
+				It("sets the path", func() {
+					err := pathOrRemoteSource.UnmarshalFlag("https://example.com/org/repo/archive/main.tar.gz")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathOrRemoteSource).To(BeEquivalentTo("https://example.com/org/repo/archive/main.tar.gz"))
+				})
+			})
+
+			When("the path is a git URL", func() {
+				It("sets the path", func() {
+					err := pathOrRemoteSource.UnmarshalFlag("git@example.com:org/repo.git#v1.0.0")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathOrRemoteSource).To(BeEquivalentTo("git@example.com:org/repo.git#v1.0.0"))
+				})
+			})
+
+			When("the path does not exist", func() {
+				It("returns a path does not exist error", func() {
+					err := pathOrRemoteSource.UnmarshalFlag("./some-dir/some-file")
+					Expect(err).To(MatchError(&flags.Error{
+						Type:    flags.ErrRequired,
+						Message: "The specified path './some-dir/some-file' does not exist.",
+					}))
+				})
+			})
+
+			When("the path exists", func() {
+				It("sets the path", func() {
+					err := pathOrRemoteSource.UnmarshalFlag("abc")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pathOrRemoteSource).To(BeEquivalentTo("abc"))
+				})
+			})
+		})
+	})
+
 	Describe("PathWithAt", func() {
 		var pathWithAt PathWithAt
 
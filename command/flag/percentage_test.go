@@ -0,0 +1,39 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Percentage", func() {
+	var percentage Percentage
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			percentage = Percentage{}
+		})
+
+		When("passed a whole number between 1 and 100", func() {
+			It("sets the value", func() {
+				Expect(percentage.UnmarshalFlag("25")).To(Succeed())
+				Expect(percentage.Value).To(Equal(25))
+			})
+		})
+
+		DescribeTable("returns an error for other values",
+			func(rawValue string) {
+				Expect(percentage.UnmarshalFlag(rawValue)).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be a whole number between 1 and 100.`,
+				}))
+			},
+			Entry("zero", "0"),
+			Entry("more than 100", "101"),
+			Entry("not a number", "half"),
+		)
+	})
+})
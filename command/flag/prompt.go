@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Prompt is the value of a UAA login prompt given as KEY=VALUE.
+type Prompt struct {
+	Key   string
+	Value string
+}
+
+func (p *Prompt) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad prompt value '%s', expected KEY=VALUE", val),
+		}
+	}
+
+	p.Key = parts[0]
+	p.Value = parts[1]
+	return nil
+}
@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prompt", func() {
+	var prompt Prompt
+
+	BeforeEach(func() {
+		prompt = Prompt{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("splits the value into a key and a value",
+			func(input string, expected Prompt) {
+				Expect(prompt.UnmarshalFlag(input)).To(Succeed())
+				Expect(prompt).To(Equal(expected))
+			},
+			Entry("key and value", "pin=1234", Prompt{Key: "pin", Value: "1234"}),
+			Entry("value containing '='", "token=a=b", Prompt{Key: "token", Value: "a=b"}),
+			Entry("empty value", "pin=", Prompt{Key: "pin"}),
+		)
+
+		DescribeTable("errors on a value that is not KEY=VALUE",
+			func(input string) {
+				err := prompt.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Bad prompt value '" + input + "', expected KEY=VALUE",
+				}))
+			},
+			Entry("no '='", "pin"),
+			Entry("empty key", "=1234"),
+			Entry("empty", ""),
+		)
+	})
+})
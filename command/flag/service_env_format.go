@@ -0,0 +1,9 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type ServiceEnvFormat string
+
+func (ServiceEnvFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"shell", "dotenv", "json"}, prefix, false)
+}
@@ -0,0 +1,38 @@
+package flag
+
+import (
+	"strconv"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timeout is a positive duration such as 90s, 10m or 1h30m. Values without a
+// unit are minutes, matching CF_STAGING_TIMEOUT and CF_STARTUP_TIMEOUT.
+type Timeout struct {
+	Value time.Duration
+}
+
+func (t *Timeout) UnmarshalFlag(rawValue string) error {
+	value, err := time.ParseDuration(rawValue)
+	if err != nil {
+		minutes, parseErr := strconv.ParseFloat(rawValue, 64)
+		if parseErr != nil {
+			return &flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: `Timeout must be a duration like 90s, 10m or 1h30m.`,
+			}
+		}
+		value = time.Duration(minutes * float64(time.Minute))
+	}
+
+	if value <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Timeout must be greater than 0.`,
+		}
+	}
+
+	t.Value = value
+	return nil
+}
@@ -0,0 +1,54 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Timeout", func() {
+	var timeout Timeout
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			timeout = Timeout{}
+		})
+
+		DescribeTable("sets the value",
+			func(rawValue string, expected time.Duration) {
+				err := timeout.UnmarshalFlag(rawValue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timeout.Value).To(Equal(expected))
+			},
+			Entry("seconds", "90s", 90*time.Second),
+			Entry("minutes and hours", "1h30m", 90*time.Minute),
+			Entry("a number without a unit as minutes", "5", 5*time.Minute),
+			Entry("a fractional number without a unit as minutes", "1.5", 90*time.Second),
+		)
+
+		When("passed something that is not a duration", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("soon")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Timeout must be a duration like 90s, 10m or 1h30m.`,
+				}))
+			})
+		})
+
+		When("passed a duration that is not positive", func() {
+			It("returns an error", func() {
+				err := timeout.UnmarshalFlag("0s")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Timeout must be greater than 0.`,
+				}))
+			})
+		})
+	})
+})
@@ -1,13 +1,31 @@
 package translatableerror
 
+import "time"
+
+// CredentialPolicyExitCode is the exit status used when the login server
+// refuses a login because of its credential policy, such as a locked account
+// or an expired password.
+const CredentialPolicyExitCode = 3
+
 type AccountLockedError struct {
-	Message string
+	Message       string
+	LockoutPeriod time.Duration
 }
 
 func (e AccountLockedError) Error() string {
-	return e.Message
+	if e.LockoutPeriod > 0 {
+		return "{{.Message}}\nWait {{.LockoutPeriod}} before trying again, or ask your administrator to unlock your account."
+	}
+	return "{{.Message}}\nWait for the lockout to expire before trying again, or ask your administrator to unlock your account."
 }
 
 func (e AccountLockedError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error())
+	return translate(e.Error(), map[string]interface{}{
+		"Message":       e.Message,
+		"LockoutPeriod": e.LockoutPeriod,
+	})
+}
+
+func (AccountLockedError) ExitCode() int {
+	return CredentialPolicyExitCode
 }
@@ -0,0 +1,21 @@
+package translatableerror
+
+import "strings"
+
+// APIDiscoveryFailedError is returned when no Cloud Controller API could be
+// located from an app URL.
+type APIDiscoveryFailedError struct {
+	AppURL string
+	Tried  []string
+}
+
+func (APIDiscoveryFailedError) Error() string {
+	return "Unable to discover the api endpoint for {{.AppURL}}. Tried:\n{{.Tried}}\nUse 'cf api URL' to set the endpoint directly."
+}
+
+func (e APIDiscoveryFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppURL": e.AppURL,
+		"Tried":  strings.Join(e.Tried, "\n"),
+	})
+}
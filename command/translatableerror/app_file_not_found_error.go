@@ -0,0 +1,15 @@
+package translatableerror
+
+type AppFileNotFoundError struct {
+	Path string
+}
+
+func (AppFileNotFoundError) Error() string {
+	return "File or directory '{{.Path}}' not found in the app instance."
+}
+
+func (e AppFileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
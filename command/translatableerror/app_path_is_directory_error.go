@@ -0,0 +1,15 @@
+package translatableerror
+
+type AppPathIsDirectoryError struct {
+	Path string
+}
+
+func (AppPathIsDirectoryError) Error() string {
+	return "'{{.Path}}' is a directory. Use 'files' to list its contents."
+}
+
+func (e AppPathIsDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
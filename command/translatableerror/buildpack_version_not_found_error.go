@@ -0,0 +1,15 @@
+package translatableerror
+
+type BuildpackVersionNotFoundError struct {
+	BuildpackName string
+}
+
+func (BuildpackVersionNotFoundError) Error() string {
+	return "Cannot determine the installed version of buildpack {{.BuildpackName}}. Its filename does not contain a version."
+}
+
+func (e BuildpackVersionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BuildpackName": e.BuildpackName,
+	})
+}
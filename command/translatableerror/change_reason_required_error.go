@@ -0,0 +1,17 @@
+package translatableerror
+
+// ChangeReasonRequiredError is returned when a change header is configured and
+// a request that modifies resources is made without a change reason.
+type ChangeReasonRequiredError struct {
+	Header string
+}
+
+func (ChangeReasonRequiredError) Error() string {
+	return "A change reason is required to send in the {{.Header}} header. Provide it with --reason or CF_REASON."
+}
+
+func (e ChangeReasonRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Header": e.Header,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// CLIFeatureNotFoundError is returned when an experimental CLI feature does
+// not exist.
+type CLIFeatureNotFoundError struct {
+	Name string
+}
+
+func (CLIFeatureNotFoundError) Error() string {
+	return "Feature '{{.Name}}' does not exist. Run 'cf features' to list the experimental features."
+}
+
+func (e CLIFeatureNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,31 @@
+package translatableerror
+
+import (
+	"strings"
+	"time"
+)
+
+// CommandTimeoutError is returned when a command run with --timeout reaches
+// its deadline. It reports how far the command got instead of the timeout of
+// the individual step that was running.
+type CommandTimeoutError struct {
+	Timeout    time.Duration
+	Completed  []string
+	InProgress string
+}
+
+func (e CommandTimeoutError) Error() string {
+	message := "Timed out after {{.Timeout}} while {{.InProgress}}."
+	if len(e.Completed) > 0 {
+		message += "\nCompleted before the timeout: {{.Completed}}."
+	}
+	return message + "\nThe operation may still be running on the CF instance."
+}
+
+func (e CommandTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout":    e.Timeout,
+		"Completed":  strings.Join(e.Completed, ", "),
+		"InProgress": e.InProgress,
+	})
+}
@@ -0,0 +1,51 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CommandTimeoutError", func() {
+	Describe("Translate()", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				err := t.Execute(buffer, subs[0])
+				Expect(err).NotTo(HaveOccurred())
+				return buffer.String()
+			}
+		})
+
+		When("steps were completed before the timeout", func() {
+			It("lists the completed steps and the step in progress", func() {
+				err := CommandTimeoutError{
+					Timeout:    90 * time.Second,
+					Completed:  []string{"stopping app", "staging app"},
+					InProgress: "waiting for app to start",
+				}
+
+				Expect(err.Translate(translateFunc)).To(Equal("Timed out after 1m30s while waiting for app to start.\nCompleted before the timeout: stopping app, staging app.\nThe operation may still be running on the CF instance."))
+			})
+		})
+
+		When("no steps were completed before the timeout", func() {
+			It("only reports the step in progress", func() {
+				err := CommandTimeoutError{
+					Timeout:    time.Minute,
+					InProgress: "deleting org some-org",
+				}
+
+				Expect(err.Translate(translateFunc)).To(Equal("Timed out after 1m0s while deleting org some-org.\nThe operation may still be running on the CF instance."))
+			})
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package translatableerror
+
+import (
+	"net/http"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+const (
+	adminScope = "cloud_controller.admin"
+	readScope  = "cloud_controller.read"
+	writeScope = "cloud_controller.write"
+)
+
+var (
+	spaceDeveloper = []string{"SpaceDeveloper"}
+	spaceReaders   = []string{"SpaceDeveloper", "SpaceManager", "SpaceAuditor", "OrgManager"}
+	spaceManagers  = []string{"SpaceManager", "OrgManager"}
+	orgManager     = []string{"OrgManager"}
+)
+
+// endpointPermission is the scope and roles the Cloud Controller requires
+// for requests to matching endpoints.
+type endpointPermission struct {
+	// methods are the HTTP methods the permission applies to.
+	methods []string
+	path    *regexp.Regexp
+	scope   string
+	roles   []string
+}
+
+var writeMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// endpointPermissions are checked in order; the first match applies.
+var endpointPermissions = []endpointPermission{
+	{methods: writeMethods, path: regexp.MustCompile(`^/v2/organizations/[^/]+/(users|managers|billing_managers|auditors)(/|$)`), scope: writeScope, roles: orgManager},
+	{methods: writeMethods, path: regexp.MustCompile(`^/v2/spaces/[^/]+/(developers|managers|auditors)(/|$)`), scope: writeScope, roles: spaceManagers},
+	{methods: writeMethods, path: regexp.MustCompile(`^/v3/roles(/|$)`), scope: writeScope, roles: spaceManagers},
+	{methods: []string{http.MethodPost}, path: regexp.MustCompile(`^/v[23]/spaces/?$`), scope: writeScope, roles: orgManager},
+	{methods: writeMethods, path: regexp.MustCompile(`^/v[23]/organizations/?$`), scope: adminScope},
+	{methods: writeMethods, path: regexp.MustCompile(`^/v[23]/(buildpacks|stacks|quota_definitions|space_quota_definitions|security_groups|service_brokers|service_plan_visibilities|isolation_segments|feature_flags|config/feature_flags|shared_domains)(/|$)`), scope: adminScope},
+	{methods: []string{http.MethodGet}, path: regexp.MustCompile(`^/v[23]/apps/[^/]+/env(/|$)`), scope: readScope, roles: spaceDeveloper},
+	{methods: []string{http.MethodGet}, path: regexp.MustCompile(`^/v[23]/`), scope: readScope, roles: spaceReaders},
+	{methods: writeMethods, path: regexp.MustCompile(`^/v[23]/`), scope: writeScope, roles: spaceDeveloper},
+}
+
+// ConvertToInsufficientPermissionError turns Cloud Controller and UAA
+// authorization failures into an InsufficientPermissionError that names the
+// scope the access token lacks or the roles the request required. Other
+// errors, and failures of requests without a known permission, are returned
+// unchanged.
+func ConvertToInsufficientPermissionError(err error, tokenScopes []string) error {
+	switch e := err.(type) {
+	case ccerror.ForbiddenError:
+		permission, found := findEndpointPermission(e.Method, e.Path)
+		if !found || hasScope(tokenScopes, adminScope) {
+			return err
+		}
+
+		permissionErr := InsufficientPermissionError{
+			Request:     e.Method + " " + e.Path,
+			TokenScopes: tokenScopes,
+		}
+		if !hasScope(tokenScopes, permission.scope) {
+			permissionErr.MissingScopes = []string{permission.scope}
+			return permissionErr
+		}
+		if len(permission.roles) == 0 {
+			return err
+		}
+		permissionErr.RequiredRoles = permission.roles
+		return permissionErr
+	case uaa.InsufficientScopeError:
+		if len(e.RequiredScopes) == 0 {
+			return err
+		}
+		return InsufficientPermissionError{
+			MissingScopes: e.RequiredScopes,
+			TokenScopes:   tokenScopes,
+		}
+	}
+
+	return err
+}
+
+func findEndpointPermission(method string, path string) (endpointPermission, bool) {
+	for _, permission := range endpointPermissions {
+		if permission.path.MatchString(path) && matchesMethod(permission.methods, method) {
+			return permission, true
+		}
+	}
+	return endpointPermission{}, false
+}
+
+func matchesMethod(methods []string, method string) bool {
+	for _, candidate := range methods {
+		if candidate == method {
+			return true
+		}
+	}
+	return false
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, candidate := range scopes {
+		if candidate == scope {
+			return true
+		}
+	}
+	return false
+}
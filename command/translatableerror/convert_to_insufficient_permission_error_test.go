@@ -0,0 +1,136 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"text/template"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvertToInsufficientPermissionError", func() {
+	developerScopes := []string{"openid", "cloud_controller.read", "cloud_controller.write"}
+	readOnlyScopes := []string{"openid", "cloud_controller.read"}
+
+	DescribeTable("converting errors",
+		func(err error, tokenScopes []string, expectedErr error) {
+			Expect(ConvertToInsufficientPermissionError(err, tokenScopes)).To(Equal(expectedErr))
+		},
+
+		Entry("a write without the write scope",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPost, Path: "/v2/apps"},
+			readOnlyScopes,
+			InsufficientPermissionError{
+				Request:       "POST /v2/apps",
+				MissingScopes: []string{"cloud_controller.write"},
+				TokenScopes:   readOnlyScopes,
+			}),
+
+		Entry("a write to a space the user is not a developer of",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPost, Path: "/v3/apps/some-guid/actions/start"},
+			developerScopes,
+			InsufficientPermissionError{
+				Request:       "POST /v3/apps/some-guid/actions/start",
+				TokenScopes:   developerScopes,
+				RequiredRoles: []string{"SpaceDeveloper"},
+			}),
+
+		Entry("reading app environment variables",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodGet, Path: "/v3/apps/some-guid/env"},
+			developerScopes,
+			InsufficientPermissionError{
+				Request:       "GET /v3/apps/some-guid/env",
+				TokenScopes:   developerScopes,
+				RequiredRoles: []string{"SpaceDeveloper"},
+			}),
+
+		Entry("assigning a space role",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPut, Path: "/v2/spaces/some-guid/developers/some-user-guid"},
+			developerScopes,
+			InsufficientPermissionError{
+				Request:       "PUT /v2/spaces/some-guid/developers/some-user-guid",
+				TokenScopes:   developerScopes,
+				RequiredRoles: []string{"SpaceManager", "OrgManager"},
+			}),
+
+		Entry("an admin only endpoint",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPost, Path: "/v2/buildpacks"},
+			developerScopes,
+			InsufficientPermissionError{
+				Request:       "POST /v2/buildpacks",
+				MissingScopes: []string{"cloud_controller.admin"},
+				TokenScopes:   developerScopes,
+			}),
+
+		Entry("an admin token",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPost, Path: "/v2/apps"},
+			[]string{"cloud_controller.admin"},
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodPost, Path: "/v2/apps"}),
+
+		Entry("an unknown endpoint",
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodGet, Path: "/some/other/path"},
+			developerScopes,
+			ccerror.ForbiddenError{Message: "some-message", Method: http.MethodGet, Path: "/some/other/path"}),
+
+		Entry("a UAA insufficient scope error",
+			uaa.InsufficientScopeError{Message: "some-message", RequiredScopes: []string{"scim.write", "uaa.admin"}},
+			developerScopes,
+			InsufficientPermissionError{
+				MissingScopes: []string{"scim.write", "uaa.admin"},
+				TokenScopes:   developerScopes,
+			}),
+
+		Entry("a UAA insufficient scope error without the required scopes",
+			uaa.InsufficientScopeError{Message: "some-message"},
+			developerScopes,
+			uaa.InsufficientScopeError{Message: "some-message"}),
+
+		Entry("any other error",
+			errors.New("some-error"),
+			developerScopes,
+			errors.New("some-error")),
+	)
+
+	Describe("InsufficientPermissionError", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				Expect(t.Execute(buffer, subs[0])).To(Succeed())
+				return buffer.String()
+			}
+		})
+
+		It("names the missing scope and the token scopes", func() {
+			err := InsufficientPermissionError{
+				Request:       "POST /v2/apps",
+				MissingScopes: []string{"cloud_controller.write"},
+				TokenScopes:   readOnlyScopes,
+			}
+			Expect(err.Translate(translateFunc)).To(Equal("You are not authorized to perform the requested action: POST /v2/apps requires the cloud_controller.write scope, but the access token has scopes: openid, cloud_controller.read."))
+		})
+
+		It("names the required roles", func() {
+			err := InsufficientPermissionError{
+				Request:       "POST /v2/apps",
+				TokenScopes:   developerScopes,
+				RequiredRoles: []string{"SpaceDeveloper"},
+			}
+			Expect(err.Translate(translateFunc)).To(Equal("You are not authorized to perform the requested action: POST /v2/apps requires one of the roles SpaceDeveloper in the org or space."))
+		})
+
+		It("names the scopes UAA required", func() {
+			err := InsufficientPermissionError{MissingScopes: []string{"scim.write", "uaa.admin"}}
+			Expect(err.Translate(translateFunc)).To(Equal("You are not authorized to perform the requested action: it requires one of the scopes scim.write, uaa.admin, but the access token has scopes: none."))
+		})
+	})
+})
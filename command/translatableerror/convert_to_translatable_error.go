@@ -9,10 +9,12 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/apidiscovery"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/script"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -27,8 +29,12 @@ func ConvertToTranslatableError(err error) error {
 		return ApplicationNotFoundError(e)
 	case actionerror.ApplicationNotStartedError:
 		return ApplicationNotStartedError(e)
+	case actionerror.AppFileNotFoundError:
+		return AppFileNotFoundError(e)
 	case actionerror.AppNotFoundInManifestError:
 		return AppNotFoundInManifestError(e)
+	case actionerror.AppPathIsDirectoryError:
+		return AppPathIsDirectoryError(e)
 	case manifestparser.AppNotInManifestError:
 		return AppNotFoundInManifestError(e)
 	case actionerror.AssignDropletError:
@@ -37,8 +43,14 @@ func ConvertToTranslatableError(err error) error {
 		return BuildpackNotFoundError(e)
 	case actionerror.BuildpackStackChangeError:
 		return BuildpackStackChangeError(e)
+	case actionerror.BuildpackVersionNotFoundError:
+		return BuildpackVersionNotFoundError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
+	case actionerror.DiagnosticDumpFailedError:
+		return DiagnosticDumpFailedError(e)
+	case actionerror.DiagnosticDumpTooLargeError:
+		return DiagnosticDumpTooLargeError(e)
 	case actionerror.DockerPasswordNotSetError:
 		return DockerPasswordNotSetError{}
 	case actionerror.DomainNotFoundError:
@@ -55,20 +67,40 @@ func ConvertToTranslatableError(err error) error {
 		return FileChangedError(e)
 	case actionerror.GettingPluginRepositoryError:
 		return GettingPluginRepositoryError(e)
+	case actionerror.GitCloneError:
+		return GitCloneError(e)
+	case actionerror.GitNotInstalledError:
+		return GitNotInstalledError{}
+	case actionerror.HealthCheckEndpointFailedError:
+		return HealthCheckEndpointFailedError(e)
 	case actionerror.HostnameWithTCPDomainError:
 		return HostnameWithTCPDomainError(e)
 	case actionerror.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case actionerror.InstanceIdentityCertificateNotFoundError:
+		return InstanceIdentityCertificateNotFoundError{}
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidInstanceIdentityCertificateError:
+		return InvalidInstanceIdentityCertificateError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.InvalidQuotaLimitError:
+		return InvalidQuotaLimitError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
+	case actionerror.InvalidServiceParametersError:
+		errors := []string{}
+		for _, validationError := range e.Errors {
+			errors = append(errors, validationError.Error())
+		}
+		return InvalidServiceParametersError{PlanName: e.PlanName, Errors: errors}
 	case actionerror.InvalidTCPRouteSettings:
 		return HostAndPathNotAllowedWithTCPDomainError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
+	case actionerror.JobNotFoundError:
+		return JobNotFoundError(e)
 	case actionerror.MissingNameError:
 		return AppNameOrManifestRequiredError{}
 	case actionerror.MultipleBuildpacksFoundError:
@@ -95,10 +127,10 @@ func ConvertToTranslatableError(err error) error {
 		return OrganizationNotFoundError(e)
 	case actionerror.OrganizationQuotaNotFoundForNameError:
 		return OrganizationQuotaNotFoundForNameError(e)
+	case actionerror.PackageScanRejectedError:
+		return PackageScanRejectedError(e)
 	case actionerror.PasswordGrantTypeLogoutRequiredError:
 		return PasswordGrantTypeLogoutRequiredError(e)
-	case actionerror.PluginCommandsConflictError:
-		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
@@ -109,20 +141,44 @@ func ConvertToTranslatableError(err error) error {
 		return ProcessInstanceNotRunningError(e)
 	case actionerror.ProcessNotFoundError:
 		return ProcessNotFoundError(e)
+	case actionerror.ProjectHookFailedError:
+		return ProjectHookFailedError(e)
 	case actionerror.PropertyCombinationError:
 		return PropertyCombinationError(e)
+	case actionerror.QuotaReservationExceededError:
+		return QuotaReservationExceededError(e)
 	case actionerror.RepositoryNameTakenError:
 		return RepositoryNameTakenError(e)
 	case actionerror.RepositoryNotRegisteredError:
 		return RepositoryNotRegisteredError(e)
+	case actionerror.RevisionNotFoundError:
+		return RevisionNotFoundError(e)
+	case actionerror.RouteConflictsError:
+		conflicts := make([]RouteConflict, 0, len(e.Conflicts))
+		for _, conflict := range e.Conflicts {
+			conflicts = append(conflicts, RouteConflict(conflict))
+		}
+		return RouteConflictsError{Conflicts: conflicts}
+	case actionerror.RouteDestinationNotFoundError:
+		return RouteDestinationNotFoundError(e)
+	case actionerror.RouteHasOtherDestinationsError:
+		return RouteHasOtherDestinationsError(e)
 	case actionerror.RouteInDifferentSpaceError:
 		return RouteInDifferentSpaceError(e)
+	case actionerror.RouteNotFoundError:
+		return RouteNotFoundError{Host: e.Host, DomainName: e.DomainName, Path: e.Path}
 	case actionerror.RoutePathWithTCPDomainError:
 		return RoutePathWithTCPDomainError(e)
 	case actionerror.RouterGroupNotFoundError:
 		return RouterGroupNotFoundError(e)
+	case actionerror.RouteUnregistrationTimeoutError:
+		return RouteUnregistrationTimeoutError(e)
 	case actionerror.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError(e)
+	case actionerror.ServiceBindingReferenceNotFoundError:
+		return ServiceBindingReferenceNotFoundError(e)
+	case actionerror.ServiceInstanceDashboardUnavailableError:
+		return ServiceInstanceDashboardUnavailableError(e)
 	case actionerror.ServiceInstanceNotFoundError:
 		return ServiceInstanceNotFoundError(e)
 	case actionerror.ServiceInstanceNotShareableError:
@@ -132,6 +188,10 @@ func ConvertToTranslatableError(err error) error {
 		}
 	case actionerror.ServiceInstanceNotSharedToSpaceError:
 		return ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: e.ServiceInstanceName}
+	case actionerror.ServiceInstanceOperationFailedError:
+		return ServiceInstanceOperationFailedError(e)
+	case actionerror.ServiceInstanceOperationTimeoutError:
+		return ServiceInstanceOperationTimeoutError(e)
 	case actionerror.ServicePlanNotFoundError:
 		return ServicePlanNotFoundError(e)
 	case actionerror.SharedServiceInstanceNotFoundError:
@@ -150,6 +210,8 @@ func ConvertToTranslatableError(err error) error {
 		return TCPRouteOptionsNotProvidedError{}
 	case actionerror.TriggerLegacyPushError:
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
+	case actionerror.UnsupportedAppArchiveError:
+		return UnsupportedAppArchiveError(e)
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
 	case actionerror.CommandLineOptionsAndManifestConflictError:
@@ -207,6 +269,10 @@ func ConvertToTranslatableError(err error) error {
 	case manifestparser.InvalidYAMLError:
 		return InvalidYAMLError(e)
 
+	// Script Errors
+	case script.ParseError:
+		return ScriptParseError(e)
+
 	// Plugin Execution Errors
 	case pluginerror.RawHTTPStatusError:
 		return DownloadPluginHTTPError{Message: e.Status}
@@ -224,12 +290,28 @@ func ConvertToTranslatableError(err error) error {
 		return UnauthorizedError(e)
 	case uaa.AccountLockedError:
 		return AccountLockedError(e)
+	case uaa.InvalidRequestError:
+		return UAAInvalidRequestError(e)
+	case uaa.PasswordExpiredError:
+		return PasswordExpiredError(e)
 	case uaa.InsufficientScopeError:
 		return UnauthorizedToPerformActionError{}
 	case uaa.InvalidAuthTokenError:
 		return InvalidRefreshTokenError{}
+	case uaa.DeviceAuthorizationDeniedError:
+		return DeviceAuthorizationDeniedError{}
+	case uaa.DeviceAuthorizationNotSupportedError:
+		return DeviceAuthorizationNotSupportedError{}
+	case uaa.DeviceAuthorizationExpiredError:
+		return DeviceAuthorizationExpiredError{}
+	case uaa.DeviceAuthorizationTimeoutError:
+		return DeviceAuthorizationTimeoutError{Timeout: e.Timeout}
+	case uaa.RedirectURINotRegisteredError:
+		return SSOBrowserRedirectNotRegisteredError{ClientID: e.ClientID}
 
 	// Other Errors
+	case apidiscovery.NoAPIFoundError:
+		return APIDiscoveryFailedError(e)
 	case download.RawHTTPStatusError:
 		return HTTPStatusError{Status: e.Status}
 	}
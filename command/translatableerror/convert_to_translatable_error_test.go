@@ -10,10 +10,13 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/apidiscovery"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/jsonschema"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/script"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -44,6 +47,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.ApplicationNotStartedError{Name: "some-app"},
 			ApplicationNotStartedError{Name: "some-app"}),
 
+		Entry("actionerror.AppFileNotFoundError -> AppFileNotFoundError",
+			actionerror.AppFileNotFoundError{Path: "some-path"},
+			AppFileNotFoundError{Path: "some-path"}),
+
 		Entry("actionerror.AppNotFoundInManifestError -> AppNotFoundInManifestError",
 			actionerror.AppNotFoundInManifestError{Name: "some-app"},
 			AppNotFoundInManifestError{Name: "some-app"}),
@@ -52,10 +59,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			manifestparser.AppNotInManifestError{Name: "some-app"},
 			AppNotFoundInManifestError{Name: "some-app"}),
 
+		Entry("actionerror.AppPathIsDirectoryError -> AppPathIsDirectoryError",
+			actionerror.AppPathIsDirectoryError{Path: "some-path"},
+			AppPathIsDirectoryError{Path: "some-path"}),
+
 		Entry("actionerror.AssignDropletError -> AssignDropletError",
 			actionerror.AssignDropletError{Message: "some-message"},
 			AssignDropletError{Message: "some-message"}),
 
+		Entry("actionerror.InvalidServiceParametersError -> InvalidServiceParametersError",
+			actionerror.InvalidServiceParametersError{PlanName: "some-plan", Errors: []jsonschema.ValidationError{{Pointer: "/size", Message: "is required"}}},
+			InvalidServiceParametersError{PlanName: "some-plan", Errors: []string{"/size: is required"}}),
+
 		Entry("actionerror.ServicePlanNotFoundError -> ServicePlanNotFoundError",
 			actionerror.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
 			ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}),
@@ -68,10 +83,22 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.BuildpackStackChangeError{},
 			BuildpackStackChangeError{}),
 
+		Entry("actionerror.BuildpackVersionNotFoundError -> BuildpackVersionNotFoundError",
+			actionerror.BuildpackVersionNotFoundError{BuildpackName: "some-buildpack"},
+			BuildpackVersionNotFoundError{BuildpackName: "some-buildpack"}),
+
 		Entry("actionerror.CommandLineOptionsWithMultipleAppsError -> CommandLineArgsWithMultipleAppsError",
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
 
+		Entry("actionerror.DiagnosticDumpFailedError -> DiagnosticDumpFailedError",
+			actionerror.DiagnosticDumpFailedError{Type: "core", Reason: "some-reason"},
+			DiagnosticDumpFailedError{Type: "core", Reason: "some-reason"}),
+
+		Entry("actionerror.DiagnosticDumpTooLargeError -> DiagnosticDumpTooLargeError",
+			actionerror.DiagnosticDumpTooLargeError{Type: "core", Size: 2048, MaxSize: 1024},
+			DiagnosticDumpTooLargeError{Type: "core", Size: 2048, MaxSize: 1024}),
+
 		Entry("actionerror.DockerPasswordNotSetError -> DockerPasswordNotSetError",
 			actionerror.DockerPasswordNotSetError{},
 			DockerPasswordNotSetError{}),
@@ -105,6 +132,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
 
+		Entry("actionerror.GitCloneError -> GitCloneError",
+			actionerror.GitCloneError{URL: "some-url", Ref: "some-ref", Output: "some-output"},
+			GitCloneError{URL: "some-url", Ref: "some-ref", Output: "some-output"}),
+
+		Entry("actionerror.GitNotInstalledError -> GitNotInstalledError",
+			actionerror.GitNotInstalledError{},
+			GitNotInstalledError{}),
+
+		Entry("actionerror.HealthCheckEndpointFailedError -> HealthCheckEndpointFailedError",
+			actionerror.HealthCheckEndpointFailedError{AppName: "some-app", URL: "https://some-route/health", StatusCode: 404},
+			HealthCheckEndpointFailedError{AppName: "some-app", URL: "https://some-route/health", StatusCode: 404}),
+
 		Entry("actionerror.HostnameWithTCPDomainError -> HostnameWithTCPDomainError",
 			actionerror.HostnameWithTCPDomainError{},
 			HostnameWithTCPDomainError{}),
@@ -113,14 +152,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.HTTPHealthCheckInvalidError{},
 			HTTPHealthCheckInvalidError{}),
 
+		Entry("actionerror.InstanceIdentityCertificateNotFoundError -> InstanceIdentityCertificateNotFoundError",
+			actionerror.InstanceIdentityCertificateNotFoundError{},
+			InstanceIdentityCertificateNotFoundError{}),
+
 		Entry("actionerror.InvalidBuildpacksError -> InvalidBuildpacksError",
 			actionerror.InvalidBuildpacksError{},
 			InvalidBuildpacksError{}),
 
+		Entry("actionerror.InvalidInstanceIdentityCertificateError -> InvalidInstanceIdentityCertificateError",
+			actionerror.InvalidInstanceIdentityCertificateError{Reason: "some-reason"},
+			InvalidInstanceIdentityCertificateError{Reason: "some-reason"}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.InvalidQuotaLimitError -> InvalidQuotaLimitError",
+			actionerror.InvalidQuotaLimitError{QuotaName: "some-quota", Limit: "total_memory", Value: "lots"},
+			InvalidQuotaLimitError{QuotaName: "some-quota", Limit: "total_memory", Value: "lots"}),
+
 		Entry("actionerror.InvalidRouteError -> InvalidRouteError",
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
@@ -129,6 +180,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.JobNotFoundError -> JobNotFoundError",
+			actionerror.JobNotFoundError{GUID: "some-job-guid"},
+			JobNotFoundError{GUID: "some-job-guid"}),
+
 		Entry("actionerror.MissingNameError -> AppNameOrManifestRequiredError",
 			actionerror.MissingNameError{},
 			AppNameOrManifestRequiredError{}),
@@ -181,24 +236,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.OrganizationQuotaNotFoundForNameError{Name: "some-quota"},
 			OrganizationQuotaNotFoundForNameError{Name: "some-quota"}),
 
+		Entry("actionerror.PackageScanRejectedError -> PackageScanRejectedError",
+			actionerror.PackageScanRejectedError{AppName: "some-app", Hook: "some-hook", Reason: "some-reason"},
+			PackageScanRejectedError{AppName: "some-app", Hook: "some-hook", Reason: "some-reason"}),
+
 		Entry("actionerror.PasswordGrantTypeLogoutRequiredError -> PasswordGrantTypeLogoutRequiredError",
 			actionerror.PasswordGrantTypeLogoutRequiredError{},
 			PasswordGrantTypeLogoutRequiredError{}),
 
-		Entry("actionerror.PluginCommandConflictError -> PluginCommandConflictError",
-			actionerror.PluginCommandsConflictError{
-				PluginName:     "some-plugin",
-				PluginVersion:  "1.1.1",
-				CommandNames:   []string{"some-command", "some-other-command"},
-				CommandAliases: []string{"sc", "soc"},
-			},
-			PluginCommandsConflictError{
-				PluginName:     "some-plugin",
-				PluginVersion:  "1.1.1",
-				CommandNames:   []string{"some-command", "some-other-command"},
-				CommandAliases: []string{"sc", "soc"},
-			}),
-
 		Entry("actionerror.PluginInvalidError -> PluginInvalidError",
 			actionerror.PluginInvalidError{},
 			PluginInvalidError{}),
@@ -223,10 +268,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.ProcessNotFoundError{ProcessType: "some-process-type"},
 			ProcessNotFoundError{ProcessType: "some-process-type"}),
 
+		Entry("actionerror.ProjectHookFailedError -> ProjectHookFailedError",
+			actionerror.ProjectHookFailedError{Command: "some-command", Err: errors.New("exit status 1")},
+			ProjectHookFailedError{Command: "some-command", Err: errors.New("exit status 1")}),
+
 		Entry("actionerror.PropertyCombinationError -> PropertyCombinationError",
 			actionerror.PropertyCombinationError{Properties: []string{"property-1", "property-2"}},
 			PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 
+		Entry("actionerror.QuotaReservationExceededError -> QuotaReservationExceededError",
+			actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1.5G"},
+			QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1.5G"}),
+
 		Entry("actionerror.RepositoryNameTakenError -> RepositoryNameTakenError",
 			actionerror.RepositoryNameTakenError{Name: "some-repo"},
 			RepositoryNameTakenError{Name: "some-repo"}),
@@ -235,10 +288,34 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.RepositoryNotRegisteredError{Name: "some-repo"},
 			RepositoryNotRegisteredError{Name: "some-repo"}),
 
+		Entry("actionerror.RevisionNotFoundError -> RevisionNotFoundError",
+			actionerror.RevisionNotFoundError{AppName: "some-app", Version: 3},
+			RevisionNotFoundError{AppName: "some-app", Version: 3}),
+
+		Entry("actionerror.RouteConflictsError -> RouteConflictsError",
+			actionerror.RouteConflictsError{Conflicts: []actionerror.RouteConflict{
+				{AppName: "some-app", Route: "some-route", Alternatives: []string{"some-alternative"}},
+			}},
+			RouteConflictsError{Conflicts: []RouteConflict{
+				{AppName: "some-app", Route: "some-route", Alternatives: []string{"some-alternative"}},
+			}}),
+
+		Entry("actionerror.RouteDestinationNotFoundError -> RouteDestinationNotFoundError",
+			actionerror.RouteDestinationNotFoundError{AppName: "some-app", Route: "some-route"},
+			RouteDestinationNotFoundError{AppName: "some-app", Route: "some-route"}),
+
+		Entry("actionerror.RouteHasOtherDestinationsError -> RouteHasOtherDestinationsError",
+			actionerror.RouteHasOtherDestinationsError{Route: "some-route"},
+			RouteHasOtherDestinationsError{Route: "some-route"}),
+
 		Entry("actionerror.RouteInDifferentSpaceError -> RouteInDifferentSpaceError",
 			actionerror.RouteInDifferentSpaceError{Route: "some-route"},
 			RouteInDifferentSpaceError{Route: "some-route"}),
 
+		Entry("actionerror.RouteNotFoundError -> RouteNotFoundError",
+			actionerror.RouteNotFoundError{Host: "some-host", DomainGUID: "some-domain-guid", DomainName: "some-domain", Path: "/some-path"},
+			RouteNotFoundError{Host: "some-host", DomainName: "some-domain", Path: "/some-path"}),
+
 		Entry("actionerror.RoutePathWithTCPDomainError -> RoutePathWithTCPDomainError",
 			actionerror.RoutePathWithTCPDomainError{},
 			RoutePathWithTCPDomainError{}),
@@ -248,10 +325,22 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			RouterGroupNotFoundError{Name: "some-group"},
 		),
 
+		Entry("actionerror.RouteUnregistrationTimeoutError -> RouteUnregistrationTimeoutError",
+			actionerror.RouteUnregistrationTimeoutError{AppName: "some-app", Routes: []string{"some-route"}},
+			RouteUnregistrationTimeoutError{AppName: "some-app", Routes: []string{"some-route"}}),
+
 		Entry("actionerror.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			actionerror.SecurityGroupNotFoundError{Name: "some-security-group"},
 			SecurityGroupNotFoundError{Name: "some-security-group"}),
 
+		Entry("actionerror.ServiceBindingReferenceNotFoundError -> ServiceBindingReferenceNotFoundError",
+			actionerror.ServiceBindingReferenceNotFoundError{Reference: "services.mydb.credentials.uri"},
+			ServiceBindingReferenceNotFoundError{Reference: "services.mydb.credentials.uri"}),
+
+		Entry("actionerror.ServiceInstanceDashboardUnavailableError -> ServiceInstanceDashboardUnavailableError",
+			actionerror.ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"},
+			ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"}),
+
 		Entry("actionerror.ServiceInstanceNotFoundError -> ServiceInstanceNotFoundError",
 			actionerror.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			ServiceInstanceNotFoundError{Name: "some-service-instance"}),
@@ -292,6 +381,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}},
 			TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}),
 
+		Entry("actionerror.UnsupportedAppArchiveError -> UnsupportedAppArchiveError",
+			actionerror.UnsupportedAppArchiveError{URL: "some-url"},
+			UnsupportedAppArchiveError{URL: "some-url"}),
+
 		Entry("actionerror.UploadFailedError -> UploadFailedError",
 			actionerror.UploadFailedError{Err: actionerror.NoDomainsFoundError{}},
 			UploadFailedError{Err: NoDomainsFoundError{}}),
@@ -310,6 +403,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 				CommandLineOptions: []string{"option-1", "option-2"},
 			}),
 
+		Entry("actionerror.ServiceInstanceOperationFailedError -> ServiceInstanceOperationFailedError",
+			actionerror.ServiceInstanceOperationFailedError{Name: "some-service-instance", Description: "some-description"},
+			ServiceInstanceOperationFailedError{Name: "some-service-instance", Description: "some-description"}),
+
+		Entry("actionerror.ServiceInstanceOperationTimeoutError -> ServiceInstanceOperationTimeoutError",
+			actionerror.ServiceInstanceOperationTimeoutError{Name: "some-service-instance"},
+			ServiceInstanceOperationTimeoutError{Name: "some-service-instance"}),
+
 		Entry("actionerror.ServiceInstanceNotSharedToSpaceError -> ServiceInstanceNotSharedToSpaceError",
 			actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-service-instance-name"},
 			ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-service-instance-name"}),
@@ -376,6 +477,11 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			unprocessableEntityError,
 			unprocessableEntityError),
 
+		Entry("apidiscovery.NoAPIFoundError -> APIDiscoveryFailedError",
+			apidiscovery.NoAPIFoundError{AppURL: "myapp.example.com", Tried: []string{"https://api.example.com"}},
+			APIDiscoveryFailedError{AppURL: "myapp.example.com", Tried: []string{"https://api.example.com"}},
+		),
+
 		Entry("download.RawHTTPStatusError -> HTTPStatusError",
 			download.RawHTTPStatusError{Status: "some status"},
 			HTTPStatusError{Status: "some status"},
@@ -411,6 +517,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			manifestparser.InvalidYAMLError{Err: errors.New("an-error")},
 			InvalidYAMLError{Err: errors.New("an-error")}),
 
+		Entry("script.ParseError -> ScriptParseError",
+			script.ParseError{Line: 3, Message: "some-message"},
+			ScriptParseError{Line: 3, Message: "some-message"}),
+
 		// Plugin Errors
 		Entry("pluginerror.RawHTTPStatusError -> DownloadPluginHTTPError",
 			pluginerror.RawHTTPStatusError{Status: "some status"},
@@ -439,6 +549,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			uaa.AccountLockedError{Message: "locked out"},
 			AccountLockedError{Message: "locked out"}),
 
+		Entry("uaa.PasswordExpiredError -> PasswordExpiredError",
+			uaa.PasswordExpiredError{Message: "expired", ResetURL: "https://login.example.com/forgot_password"},
+			PasswordExpiredError{Message: "expired", ResetURL: "https://login.example.com/forgot_password"}),
+
+		Entry("uaa.InvalidRequestError -> UAAInvalidRequestError",
+			uaa.InvalidRequestError{Message: "Missing mfa code"},
+			UAAInvalidRequestError{Message: "Missing mfa code"}),
+
 		Entry("uaa.InsufficientScopeError -> UnauthorizedToPerformActionError",
 			uaa.InsufficientScopeError{},
 			UnauthorizedToPerformActionError{}),
@@ -447,6 +565,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			uaa.InvalidAuthTokenError{},
 			InvalidRefreshTokenError{}),
 
+		Entry("uaa.DeviceAuthorizationDeniedError -> DeviceAuthorizationDeniedError",
+			uaa.DeviceAuthorizationDeniedError{Message: "denied"},
+			DeviceAuthorizationDeniedError{}),
+
+		Entry("uaa.DeviceAuthorizationNotSupportedError -> DeviceAuthorizationNotSupportedError",
+			uaa.DeviceAuthorizationNotSupportedError{},
+			DeviceAuthorizationNotSupportedError{}),
+
+		Entry("uaa.DeviceAuthorizationExpiredError -> DeviceAuthorizationExpiredError",
+			uaa.DeviceAuthorizationExpiredError{Message: "expired"},
+			DeviceAuthorizationExpiredError{}),
+
+		Entry("uaa.DeviceAuthorizationTimeoutError -> DeviceAuthorizationTimeoutError",
+			uaa.DeviceAuthorizationTimeoutError{Timeout: time.Minute},
+			DeviceAuthorizationTimeoutError{Timeout: time.Minute}),
+
+		Entry("uaa.RedirectURINotRegisteredError -> SSOBrowserRedirectNotRegisteredError",
+			uaa.RedirectURINotRegisteredError{ClientID: "cf", RedirectURI: "http://127.0.0.1:1234/callback"},
+			SSOBrowserRedirectNotRegisteredError{ClientID: "cf"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
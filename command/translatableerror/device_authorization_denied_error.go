@@ -0,0 +1,13 @@
+package translatableerror
+
+// DeviceAuthorizationDeniedError is returned when the user denied logging in
+// from another device.
+type DeviceAuthorizationDeniedError struct{}
+
+func (DeviceAuthorizationDeniedError) Error() string {
+	return "The login request was denied."
+}
+
+func (e DeviceAuthorizationDeniedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// DeviceAuthorizationExpiredError is returned when the code for logging in
+// from another device expired before the user approved the login request.
+type DeviceAuthorizationExpiredError struct{}
+
+func (DeviceAuthorizationExpiredError) Error() string {
+	return "The code expired before the login request was approved. Run the command again to get a new code."
+}
+
+func (e DeviceAuthorizationExpiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
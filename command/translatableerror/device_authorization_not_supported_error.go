@@ -0,0 +1,13 @@
+package translatableerror
+
+// DeviceAuthorizationNotSupportedError is returned when the UAA does not
+// support logging in from another device.
+type DeviceAuthorizationNotSupportedError struct{}
+
+func (DeviceAuthorizationNotSupportedError) Error() string {
+	return "This Cloud Foundry's UAA does not support logging in from another device. Use --sso instead to log in with a one-time passcode."
+}
+
+func (e DeviceAuthorizationNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
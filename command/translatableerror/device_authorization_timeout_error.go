@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+// DeviceAuthorizationTimeoutError is returned when the user did not approve
+// logging in from another device before the --timeout passed.
+type DeviceAuthorizationTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (DeviceAuthorizationTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for the login request to be approved. Run the command again to get a new code."
+}
+
+func (e DeviceAuthorizationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout": e.Timeout,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type DiagnosticDumpFailedError struct {
+	Type   string
+	Reason string
+}
+
+func (DiagnosticDumpFailedError) Error() string {
+	return "Creating the {{.Type}} dump failed: {{.Reason}}"
+}
+
+func (e DiagnosticDumpFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Type":   e.Type,
+		"Reason": e.Reason,
+	})
+}
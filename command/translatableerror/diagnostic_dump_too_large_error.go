@@ -0,0 +1,20 @@
+package translatableerror
+
+import "code.cloudfoundry.org/bytefmt"
+
+type DiagnosticDumpTooLargeError struct {
+	Type    string
+	Size    int64
+	MaxSize int64
+}
+
+func (DiagnosticDumpTooLargeError) Error() string {
+	return "The {{.Type}} dump is larger than the maximum size of {{.MaxSize}} and was not downloaded.\nUse --max-size to allow larger dumps."
+}
+
+func (e DiagnosticDumpTooLargeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Type":    e.Type,
+		"MaxSize": bytefmt.ByteSize(uint64(e.MaxSize)),
+	})
+}
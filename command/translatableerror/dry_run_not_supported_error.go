@@ -0,0 +1,17 @@
+package translatableerror
+
+// DryRunNotSupportedError is returned when --dry-run is given to a command
+// that sends requests to an API whose requests cannot be printed instead.
+type DryRunNotSupportedError struct {
+	API string
+}
+
+func (e DryRunNotSupportedError) Error() string {
+	return "--dry-run is not supported for commands that use the {{.API}} API."
+}
+
+func (e DryRunNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"API": e.API,
+	})
+}
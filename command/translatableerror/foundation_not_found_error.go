@@ -0,0 +1,19 @@
+package translatableerror
+
+// FoundationNotFoundError is returned when a foundation passed to
+// --foundations has no saved config.
+type FoundationNotFoundError struct {
+	Name string
+	Path string
+}
+
+func (FoundationNotFoundError) Error() string {
+	return "Foundation '{{.Name}}' not found. Log in to it with 'CF_HOME={{.Path}} cf login' to save it."
+}
+
+func (e FoundationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+		"Path": e.Path,
+	})
+}
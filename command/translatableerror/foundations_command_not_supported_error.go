@@ -0,0 +1,17 @@
+package translatableerror
+
+// FoundationsCommandNotSupportedError is returned when --foundations is used
+// with a command that is not read-only.
+type FoundationsCommandNotSupportedError struct {
+	Command string
+}
+
+func (FoundationsCommandNotSupportedError) Error() string {
+	return "Command '{{.Command}}' cannot be run with --foundations. Only read-only commands can be run against several foundations."
+}
+
+func (e FoundationsCommandNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+	})
+}
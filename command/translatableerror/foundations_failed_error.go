@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// FoundationsFailedError is returned when a command run with --foundations
+// fails on one or more of the foundations.
+type FoundationsFailedError struct {
+	Foundations []string
+}
+
+func (FoundationsFailedError) Error() string {
+	return "Command failed on foundations: {{.Foundations}}"
+}
+
+func (e FoundationsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Foundations": strings.Join(e.Foundations, ", "),
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+type GitCloneError struct {
+	URL    string
+	Ref    string
+	Output string
+}
+
+func (e GitCloneError) Error() string {
+	if e.Ref != "" {
+		return "Failed to check out '{{.Ref}}' from {{.URL}}:\n{{.Output}}"
+	}
+	return "Failed to clone {{.URL}}:\n{{.Output}}"
+}
+
+func (e GitCloneError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL":    e.URL,
+		"Ref":    e.Ref,
+		"Output": e.Output,
+	})
+}
@@ -0,0 +1,11 @@
+package translatableerror
+
+type GitNotInstalledError struct{}
+
+func (GitNotInstalledError) Error() string {
+	return "git must be installed to push an app from a git repository."
+}
+
+func (e GitNotInstalledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,28 @@
+package translatableerror
+
+import "net/http"
+
+type HealthCheckEndpointFailedError struct {
+	AppName    string
+	URL        string
+	StatusCode int
+}
+
+func (e HealthCheckEndpointFailedError) Error() string {
+	switch e.StatusCode {
+	case 0:
+		return "Health check endpoint {{.URL}} of app {{.AppName}} could not be reached before the app start timeout."
+	case http.StatusNotFound:
+		return "Health check endpoint {{.URL}} of app {{.AppName}} returned 404 Not Found.\nServe a health endpoint at this path, set 'health-check-http-endpoint' in the manifest, or push without --detect-health-check."
+	default:
+		return "Health check endpoint {{.URL}} of app {{.AppName}} responded with status {{.StatusCode}}."
+	}
+}
+
+func (e HealthCheckEndpointFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"URL":        e.URL,
+		"StatusCode": e.StatusCode,
+	})
+}
@@ -0,0 +1,39 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HealthCheckEndpointFailedError", func() {
+	DescribeTable("Translate()",
+		func(statusCode int, expectedMessage string) {
+			translateFunc := func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				Expect(t.Execute(buffer, subs[0])).To(Succeed())
+				return buffer.String()
+			}
+
+			err := HealthCheckEndpointFailedError{
+				AppName:    "some-app",
+				URL:        "https://some-app.example.com/up",
+				StatusCode: statusCode,
+			}
+			Expect(err.Translate(translateFunc)).To(HavePrefix(expectedMessage))
+		},
+
+		Entry("when the endpoint was never reached", 0,
+			"Health check endpoint https://some-app.example.com/up of app some-app could not be reached before the app start timeout."),
+		Entry("when the endpoint is not found", 404,
+			"Health check endpoint https://some-app.example.com/up of app some-app returned 404 Not Found.\nServe a health endpoint at this path"),
+		Entry("when the endpoint responds with another status", 503,
+			"Health check endpoint https://some-app.example.com/up of app some-app responded with status 503."),
+	)
+})
@@ -0,0 +1,16 @@
+package translatableerror
+
+// InitStepFailedError is returned when a command run by 'cf init' fails.
+type InitStepFailedError struct {
+	Command string
+}
+
+func (InitStepFailedError) Error() string {
+	return "Setup stopped because '{{.Command}}' failed. Run 'cf init' to start again."
+}
+
+func (e InitStepFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+	})
+}
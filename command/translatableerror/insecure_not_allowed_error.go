@@ -0,0 +1,23 @@
+package translatableerror
+
+// InsecureNotAllowedError is returned when SSL validation would be skipped
+// although the CLI config forbids it. API is set when the current target was
+// already configured with --skip-ssl-validation.
+type InsecureNotAllowedError struct {
+	API        string
+	BinaryName string
+}
+
+func (e InsecureNotAllowedError) Error() string {
+	if e.API != "" {
+		return "The current target {{.API}} skips SSL validation, which is not permitted by the CLI config. Use '{{.BinaryName}} api {{.API}}' to target it with SSL validation."
+	}
+	return "--skip-ssl-validation is not permitted by the CLI config. Add the certificate authority of the API endpoint to the trusted certificates of your system instead."
+}
+
+func (e InsecureNotAllowedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"API":        e.API,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,11 @@
+package translatableerror
+
+type InstanceIdentityCertificateNotFoundError struct{}
+
+func (InstanceIdentityCertificateNotFoundError) Error() string {
+	return "The app instance does not have an instance identity certificate. Instance identity credentials may not be enabled on this CF instance."
+}
+
+func (e InstanceIdentityCertificateNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
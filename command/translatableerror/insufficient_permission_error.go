@@ -0,0 +1,42 @@
+package translatableerror
+
+import "strings"
+
+// InsufficientPermissionError explains a request that the Cloud Controller or
+// UAA refused with the scope or roles it required.
+type InsufficientPermissionError struct {
+	// Request is the method and path of the refused request, when known.
+	Request string
+	// MissingScopes are the scopes, any of which the access token needed.
+	MissingScopes []string
+	// TokenScopes are the scopes the access token has.
+	TokenScopes []string
+	// RequiredRoles are the roles, any of which would have permitted the
+	// request.
+	RequiredRoles []string
+}
+
+func (e InsufficientPermissionError) Error() string {
+	switch {
+	case len(e.MissingScopes) > 0 && e.Request != "":
+		return "You are not authorized to perform the requested action: {{.Request}} requires the {{.MissingScopes}} scope, but the access token has scopes: {{.TokenScopes}}."
+	case len(e.MissingScopes) > 0:
+		return "You are not authorized to perform the requested action: it requires one of the scopes {{.MissingScopes}}, but the access token has scopes: {{.TokenScopes}}."
+	default:
+		return "You are not authorized to perform the requested action: {{.Request}} requires one of the roles {{.RequiredRoles}} in the org or space."
+	}
+}
+
+func (e InsufficientPermissionError) Translate(translate func(string, ...interface{}) string) string {
+	tokenScopes := strings.Join(e.TokenScopes, ", ")
+	if tokenScopes == "" {
+		tokenScopes = "none"
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Request":       e.Request,
+		"MissingScopes": strings.Join(e.MissingScopes, ", "),
+		"TokenScopes":   tokenScopes,
+		"RequiredRoles": strings.Join(e.RequiredRoles, ", "),
+	})
+}
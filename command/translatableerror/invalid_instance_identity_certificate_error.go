@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidInstanceIdentityCertificateError struct {
+	Reason string
+}
+
+func (InvalidInstanceIdentityCertificateError) Error() string {
+	return "Unable to parse the instance identity certificate: {{.Reason}}"
+}
+
+func (e InvalidInstanceIdentityCertificateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason": e.Reason,
+	})
+}
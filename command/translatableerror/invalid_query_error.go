@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidQueryError is returned when the JMESPath expression given with
+// --query cannot be parsed or evaluated.
+type InvalidQueryError struct {
+	Message string
+}
+
+func (InvalidQueryError) Error() string {
+	return "Invalid query: {{.Message}}"
+}
+
+func (e InvalidQueryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// InvalidQuotaLimitError is returned when a limit of a quota in a quotas file
+// cannot be parsed.
+type InvalidQuotaLimitError struct {
+	QuotaName string
+	Limit     string
+	Value     string
+}
+
+func (InvalidQuotaLimitError) Error() string {
+	return "Invalid value '{{.Value}}' for {{.Limit}} of quota {{.QuotaName}}."
+}
+
+func (e InvalidQuotaLimitError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"QuotaName": e.QuotaName,
+		"Limit":     e.Limit,
+		"Value":     e.Value,
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+import "strings"
+
+// InvalidServiceParametersError is returned when the -c parameters do not
+// match the schema the broker publishes for the service plan. Each error
+// names the JSON pointer of the offending value.
+type InvalidServiceParametersError struct {
+	PlanName string
+	Errors   []string
+}
+
+func (InvalidServiceParametersError) Error() string {
+	return "The parameters provided with -c do not match the schema of service plan {{.PlanName}}:\n   {{.Errors}}"
+}
+
+func (e InvalidServiceParametersError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName": e.PlanName,
+		"Errors":   strings.Join(e.Errors, "\n   "),
+	})
+}
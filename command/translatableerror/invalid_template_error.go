@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidTemplateError is returned when the Go template given with --template
+// cannot be parsed or executed.
+type InvalidTemplateError struct {
+	Message string
+}
+
+func (InvalidTemplateError) Error() string {
+	return "Invalid template: {{.Message}}"
+}
+
+func (e InvalidTemplateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
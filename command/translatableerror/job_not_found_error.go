@@ -0,0 +1,15 @@
+package translatableerror
+
+type JobNotFoundError struct {
+	GUID string
+}
+
+func (JobNotFoundError) Error() string {
+	return "Job '{{.GUID}}' not found. Completed jobs are only kept for a limited time."
+}
+
+func (e JobNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"GUID": e.GUID,
+	})
+}
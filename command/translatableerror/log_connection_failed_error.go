@@ -0,0 +1,21 @@
+package translatableerror
+
+// LogConnectionFailedError is returned when the connection to the logging
+// endpoint fails and diagnostics identify the layer responsible.
+type LogConnectionFailedError struct {
+	Endpoint string
+	Layer    string
+	Reason   string
+}
+
+func (LogConnectionFailedError) Error() string {
+	return "Unable to connect to the logging endpoint {{.Endpoint}}: the {{.Layer}} check failed: {{.Reason}}"
+}
+
+func (e LogConnectionFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Endpoint": e.Endpoint,
+		"Layer":    e.Layer,
+		"Reason":   e.Reason,
+	})
+}
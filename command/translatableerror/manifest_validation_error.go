@@ -0,0 +1,15 @@
+package translatableerror
+
+type ManifestValidationError struct {
+	ErrorCount int
+}
+
+func (ManifestValidationError) Error() string {
+	return "Manifest validation failed with {{.ErrorCount}} error(s)."
+}
+
+func (e ManifestValidationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ErrorCount": e.ErrorCount,
+	})
+}
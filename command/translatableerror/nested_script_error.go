@@ -0,0 +1,11 @@
+package translatableerror
+
+type NestedScriptError struct{}
+
+func (NestedScriptError) Error() string {
+	return "run-script cannot be called from within a script"
+}
+
+func (e NestedScriptError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
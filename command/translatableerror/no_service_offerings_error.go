@@ -0,0 +1,13 @@
+package translatableerror
+
+// NoServiceOfferingsError is returned when there are no service offerings to
+// choose from in the targeted space.
+type NoServiceOfferingsError struct{}
+
+func (NoServiceOfferingsError) Error() string {
+	return "No service offerings found in the targeted space."
+}
+
+func (e NoServiceOfferingsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
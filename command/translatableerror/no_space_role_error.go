@@ -0,0 +1,19 @@
+package translatableerror
+
+type NoSpaceRoleError struct {
+	Username  string
+	SpaceName string
+	OrgName   string
+}
+
+func (NoSpaceRoleError) Error() string {
+	return "User '{{.Username}}' has no role in space '{{.SpaceName}}' in org '{{.OrgName}}'. Ask a space manager or org manager for a role before targeting it."
+}
+
+func (e NoSpaceRoleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username":  e.Username,
+		"SpaceName": e.SpaceName,
+		"OrgName":   e.OrgName,
+	})
+}
@@ -0,0 +1,28 @@
+package translatableerror
+
+import "strings"
+
+// OperationNotAuthorizedError is returned when a permission preflight finds
+// that the Cloud Controller would not authorize the operation.
+type OperationNotAuthorizedError struct {
+	Operation     string
+	Username      string
+	MissingScope  string
+	RequiredRoles []string
+}
+
+func (e OperationNotAuthorizedError) Error() string {
+	if e.MissingScope != "" {
+		return "{{.Username}} is not authorized to {{.Operation}}: the access token lacks the {{.Scope}} scope."
+	}
+	return "{{.Username}} is not authorized to {{.Operation}}: requires one of the roles {{.Roles}}."
+}
+
+func (e OperationNotAuthorizedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Operation": e.Operation,
+		"Username":  e.Username,
+		"Scope":     e.MissingScope,
+		"Roles":     strings.Join(e.RequiredRoles, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type PackageScanRejectedError struct {
+	AppName string
+	Hook    string
+	Reason  string
+}
+
+func (PackageScanRejectedError) Error() string {
+	return "The push scan hook '{{.Hook}}' did not approve the files of app {{.AppName}}; nothing was uploaded.\n{{.Reason}}"
+}
+
+func (e PackageScanRejectedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Hook":    e.Hook,
+		"AppName": e.AppName,
+		"Reason":  e.Reason,
+	})
+}
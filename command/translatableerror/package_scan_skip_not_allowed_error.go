@@ -0,0 +1,15 @@
+package translatableerror
+
+type PackageScanSkipNotAllowedError struct {
+	BinaryName string
+}
+
+func (PackageScanSkipNotAllowedError) Error() string {
+	return "--skip-scan is not permitted by the CLI config. Skipping the push scan hook requires '{{.BinaryName}} config set push-scan-skip-allowed true'."
+}
+
+func (e PackageScanSkipNotAllowedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,24 @@
+package translatableerror
+
+type PasswordExpiredError struct {
+	Message  string
+	ResetURL string
+}
+
+func (e PasswordExpiredError) Error() string {
+	if e.ResetURL != "" {
+		return "{{.Message}}\nReset your password at {{.ResetURL}}, then log in again."
+	}
+	return "{{.Message}}\nReset your password with your identity provider, then log in again."
+}
+
+func (e PasswordExpiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message":  e.Message,
+		"ResetURL": e.ResetURL,
+	})
+}
+
+func (PasswordExpiredError) ExitCode() int {
+	return CredentialPolicyExitCode
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ProjectHookFailedError struct {
+	Command string
+	Err     error
+}
+
+func (ProjectHookFailedError) Error() string {
+	return "Project hook '{{.Command}}' failed: {{.Error}}"
+}
+
+func (e ProjectHookFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+		"Error":   e.Err.Error(),
+	})
+}
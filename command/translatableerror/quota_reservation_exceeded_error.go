@@ -0,0 +1,27 @@
+package translatableerror
+
+// QuotaReservationExceededError is returned when an operation would use more
+// of a resource than a space or organization quota has remaining.
+type QuotaReservationExceededError struct {
+	QuotaName   string
+	Resource    string
+	Needed      string
+	Available   string
+	PerInstance bool
+}
+
+func (e QuotaReservationExceededError) Error() string {
+	if e.PerInstance {
+		return "{{.Resource}} needs {{.Needed}} per instance, only {{.Available}} allowed by quota {{.QuotaName}}."
+	}
+	return "{{.Resource}} needs {{.Needed}}, only {{.Available}} remaining in quota {{.QuotaName}}."
+}
+
+func (e QuotaReservationExceededError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Resource":  e.Resource,
+		"Needed":    e.Needed,
+		"Available": e.Available,
+		"QuotaName": e.QuotaName,
+	})
+}
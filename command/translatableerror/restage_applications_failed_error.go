@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// RestageApplicationsFailedError is returned when outdated-apps --restage
+// fails to restage one or more apps.
+type RestageApplicationsFailedError struct {
+	AppNames []string
+}
+
+func (RestageApplicationsFailedError) Error() string {
+	return "Failed to restage apps: {{.AppNames}}"
+}
+
+func (e RestageApplicationsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type RevisionNotFoundError struct {
+	AppName string
+	Version int
+}
+
+func (RevisionNotFoundError) Error() string {
+	return "Revision {{.Version}} of app '{{.AppName}}' not found."
+}
+
+func (e RevisionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Version": e.Version,
+	})
+}
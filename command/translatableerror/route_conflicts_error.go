@@ -0,0 +1,43 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RouteConflict is a route that an app being pushed cannot be mapped to
+// because the route is in use in another space.
+type RouteConflict struct {
+	AppName      string
+	Route        string
+	Alternatives []string
+}
+
+// RouteConflictsError is returned when push finds routes that are in use in
+// other spaces before creating any of them.
+type RouteConflictsError struct {
+	Conflicts []RouteConflict
+}
+
+func (RouteConflictsError) Error() string {
+	return "Push cannot continue because these routes are in use in other spaces:\n{{.Conflicts}}\nChoose different hostnames with --hostname or routes in the manifest, or use --random-route."
+}
+
+func (e RouteConflictsError) Translate(translate func(string, ...interface{}) string) string {
+	var conflicts []string
+	for _, conflict := range e.Conflicts {
+		line := translate("- {{.Route}} (app {{.AppName}})", map[string]interface{}{
+			"Route":   conflict.Route,
+			"AppName": conflict.AppName,
+		})
+		if len(conflict.Alternatives) > 0 {
+			line = fmt.Sprintf("%s; %s", line, translate("available alternatives: {{.Alternatives}}", map[string]interface{}{
+				"Alternatives": strings.Join(conflict.Alternatives, ", "),
+			}))
+		}
+		conflicts = append(conflicts, line)
+	}
+	return translate(e.Error(), map[string]interface{}{
+		"Conflicts": strings.Join(conflicts, "\n"),
+	})
+}
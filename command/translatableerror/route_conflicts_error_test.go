@@ -0,0 +1,33 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouteConflictsError", func() {
+	Describe("Translate", func() {
+		It("lists each conflicting route with its alternatives", func() {
+			translateFunc := func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				Expect(t.Execute(buffer, subs[0])).To(Succeed())
+				return buffer.String()
+			}
+
+			err := RouteConflictsError{Conflicts: []RouteConflict{
+				{AppName: "app-1", Route: "app-1.example.com", Alternatives: []string{"app-1-brave-fox.example.com", "app-1-quiet-owl.example.com"}},
+				{AppName: "app-2", Route: "tcp.example.com:1024"},
+			}}
+			Expect(err.Translate(translateFunc)).To(Equal("Push cannot continue because these routes are in use in other spaces:\n" +
+				"- app-1.example.com (app app-1); available alternatives: app-1-brave-fox.example.com, app-1-quiet-owl.example.com\n" +
+				"- tcp.example.com:1024 (app app-2)\n" +
+				"Choose different hostnames with --hostname or routes in the manifest, or use --random-route."))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package translatableerror
+
+// RouteCutoverFailedError is returned when the app that traffic is being
+// shifted to is not healthy. A Weight of 0 means the app was unhealthy before
+// any traffic was shifted.
+type RouteCutoverFailedError struct {
+	AppName string
+	Route   string
+	Weight  int
+}
+
+func (e RouteCutoverFailedError) Error() string {
+	if e.Weight == 0 {
+		return "App {{.AppName}} is not healthy, so route {{.Route}} was not changed. Make sure all of its instances are running before cutting over."
+	}
+	return "App {{.AppName}} was not healthy with {{.Weight}}% of the traffic of route {{.Route}}. The route's original destinations were restored."
+}
+
+func (e RouteCutoverFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Route":   e.Route,
+		"Weight":  e.Weight,
+	})
+}
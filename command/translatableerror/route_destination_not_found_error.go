@@ -0,0 +1,17 @@
+package translatableerror
+
+type RouteDestinationNotFoundError struct {
+	AppName string
+	Route   string
+}
+
+func (RouteDestinationNotFoundError) Error() string {
+	return "App {{.AppName}} is not mapped to route {{.Route}}."
+}
+
+func (e RouteDestinationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Route":   e.Route,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type RouteHasOtherDestinationsError struct {
+	Route string
+}
+
+func (RouteHasOtherDestinationsError) Error() string {
+	return "Route {{.Route}} sends traffic to apps other than the two being cut over. Unmap the other apps from the route first."
+}
+
+func (e RouteHasOtherDestinationsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Route": e.Route,
+	})
+}
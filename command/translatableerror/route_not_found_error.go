@@ -0,0 +1,22 @@
+package translatableerror
+
+type RouteNotFoundError struct {
+	Host       string
+	DomainName string
+	Path       string
+}
+
+func (e RouteNotFoundError) Error() string {
+	if e.Path != "" {
+		return "Route with host '{{.Host}}', domain '{{.Domain}}', and path '{{.Path}}' not found."
+	}
+	return "Route with host '{{.Host}}' and domain '{{.Domain}}' not found."
+}
+
+func (e RouteNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Host":   e.Host,
+		"Domain": e.DomainName,
+		"Path":   e.Path,
+	})
+}
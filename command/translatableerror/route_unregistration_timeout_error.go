@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+type RouteUnregistrationTimeoutError struct {
+	AppName string
+	Routes  []string
+}
+
+func (RouteUnregistrationTimeoutError) Error() string {
+	return "Timed out waiting for the router to stop sending traffic for {{.Routes}} to app {{.AppName}}."
+}
+
+func (e RouteUnregistrationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Routes":  strings.Join(e.Routes, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ScheduledTasksFailedError is returned when run-due-tasks fails to run one
+// or more due tasks.
+type ScheduledTasksFailedError struct {
+	Schedules []string
+}
+
+func (ScheduledTasksFailedError) Error() string {
+	return "Failed to run scheduled tasks: {{.Schedules}}"
+}
+
+func (e ScheduledTasksFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Schedules": strings.Join(e.Schedules, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// ScriptCommandFailedError is returned when a command in a script fails and
+// the script is aborted.
+type ScriptCommandFailedError struct {
+	Line    int
+	Command string
+}
+
+func (ScriptCommandFailedError) Error() string {
+	return "Script aborted: command on line {{.Line}} failed: {{.Command}}"
+}
+
+func (e ScriptCommandFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Line":    e.Line,
+		"Command": e.Command,
+	})
+}
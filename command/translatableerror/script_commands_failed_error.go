@@ -0,0 +1,30 @@
+package translatableerror
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ScriptCommandsFailedError is returned when one or more commands in a script
+// run with --continue-on-error failed.
+type ScriptCommandsFailedError struct {
+	Lines []int
+	Total int
+}
+
+func (ScriptCommandsFailedError) Error() string {
+	return "{{.Count}} of {{.Total}} commands in the script failed (lines: {{.Lines}})"
+}
+
+func (e ScriptCommandsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	lines := make([]string, 0, len(e.Lines))
+	for _, line := range e.Lines {
+		lines = append(lines, strconv.Itoa(line))
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Count": len(e.Lines),
+		"Total": e.Total,
+		"Lines": strings.Join(lines, ", "),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ScriptParseError struct {
+	Line    int
+	Message string
+}
+
+func (ScriptParseError) Error() string {
+	return "Error parsing script on line {{.Line}}: {{.Message}}"
+}
+
+func (e ScriptParseError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Line":    e.Line,
+		"Message": e.Message,
+	})
+}
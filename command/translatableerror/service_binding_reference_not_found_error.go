@@ -0,0 +1,15 @@
+package translatableerror
+
+type ServiceBindingReferenceNotFoundError struct {
+	Reference string
+}
+
+func (ServiceBindingReferenceNotFoundError) Error() string {
+	return "Unable to resolve '{{.Reference}}': no service bound to the app provides it."
+}
+
+func (e ServiceBindingReferenceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reference": e.Reference,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ServiceInstanceDashboardUnavailableError struct {
+	Name string
+}
+
+func (ServiceInstanceDashboardUnavailableError) Error() string {
+	return "Service instance {{.ServiceInstance}} does not provide a dashboard."
+}
+
+func (e ServiceInstanceDashboardUnavailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstance": e.Name,
+	})
+}
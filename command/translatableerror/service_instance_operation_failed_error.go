@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Description string
+}
+
+func (ServiceInstanceOperationFailedError) Error() string {
+	return "Operation on service instance {{.Name}} failed: {{.Description}}"
+}
+
+func (e ServiceInstanceOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":        e.Name,
+		"Description": e.Description,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ServiceInstanceOperationTimeoutError struct {
+	Name string
+}
+
+func (ServiceInstanceOperationTimeoutError) Error() string {
+	return "Timed out waiting for service instance {{.Name}}. The operation may still be running on the CF instance."
+}
+
+func (e ServiceInstanceOperationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
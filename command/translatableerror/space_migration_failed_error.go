@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// SpaceMigrationFailedError is returned when migrate-space fails to migrate
+// one or more resources.
+type SpaceMigrationFailedError struct {
+	Resources []string
+}
+
+func (SpaceMigrationFailedError) Error() string {
+	return "Failed to migrate: {{.Resources}}"
+}
+
+func (e SpaceMigrationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Resources": strings.Join(e.Resources, ", "),
+	})
+}
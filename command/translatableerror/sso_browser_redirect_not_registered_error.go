@@ -0,0 +1,18 @@
+package translatableerror
+
+// SSOBrowserRedirectNotRegisteredError is returned when UAA will not redirect
+// the browser back to the CLI after logging in with --sso-browser, as the
+// OAuth client is not registered for a local redirect URI.
+type SSOBrowserRedirectNotRegisteredError struct {
+	ClientID string
+}
+
+func (SSOBrowserRedirectNotRegisteredError) Error() string {
+	return "The UAA client '{{.ClientID}}' is not registered to redirect the browser back to the CLI. Log in with --sso instead, or ask an administrator to allow the redirect URI http://127.0.0.1:*/callback for the client."
+}
+
+func (e SSOBrowserRedirectNotRegisteredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ClientID": e.ClientID,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"text/template"
+	"time"
 
 	. "code.cloudfoundry.org/cli/command/translatableerror"
 
@@ -37,6 +38,7 @@ var _ = Describe("Translatable Errors", func() {
 		},
 
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
+		Entry("AccountLockedError", AccountLockedError{LockoutPeriod: time.Minute}),
 		Entry("APINotFoundError", APINotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
@@ -46,10 +48,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("BadCredentialsError", UnauthorizedError{}),
 		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
 		Entry("BuildpackStackChangeError", BuildpackStackChangeError{}),
+		Entry("BuildpackVersionNotFoundError", BuildpackVersionNotFoundError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
+		Entry("DryRunNotSupportedError", DryRunNotSupportedError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("EmptyBuildpacksError", EmptyBuildpacksError{}),
@@ -64,6 +68,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
+		Entry("InvalidServiceParametersError", InvalidServiceParametersError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
@@ -83,16 +88,17 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoServiceOfferingsError", NoServiceOfferingsError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("OrganizationQuotaNotFoundForNameError", OrganizationQuotaNotFoundForNameError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
+		Entry("PasswordExpiredError", PasswordExpiredError{}),
 		Entry("PasswordGrantTypeLogoutRequiredError", PasswordGrantTypeLogoutRequiredError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
-		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
@@ -105,6 +111,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
+		Entry("RestageApplicationsFailedError", RestageApplicationsFailedError{}),
+		Entry("RevisionNotFoundError", RevisionNotFoundError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RoutePathWithTCPDomainError", RoutePathWithTCPDomainError{}),
 		Entry("RunTaskError", RunTaskError{}),
@@ -124,6 +132,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
+		Entry("UAAInvalidRequestError", UAAInvalidRequestError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
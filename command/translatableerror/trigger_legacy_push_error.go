@@ -3,6 +3,8 @@ package translatableerror
 import (
 	"fmt"
 	"strings"
+
+	"code.cloudfoundry.org/cli/command/deprecation"
 )
 
 type TriggerLegacyPushError struct {
@@ -35,6 +37,21 @@ Please see http://docs.cloudfoundry.org/devguide/deploy-apps/manifest.html#depre
 	}
 }
 
+// DeprecationAdvisory returns the advisory for the deprecated manifest syntax
+// that triggered the legacy push, if any.
+func (e TriggerLegacyPushError) DeprecationAdvisory() (deprecation.Advisory, bool) {
+	switch {
+	case len(e.DomainHostRelated) > 0:
+		return deprecation.Lookup(deprecation.ManifestRouteComponents).WithDetails(fmt.Sprintf("Found: %s.", strings.Join(e.DomainHostRelated, ", "))), true
+	case len(e.GlobalRelated) > 0:
+		return deprecation.Lookup(deprecation.ManifestGlobalFields).WithDetails(fmt.Sprintf("Found: %s.", strings.Join(e.GlobalRelated, ", "))), true
+	case e.InheritanceRelated:
+		return deprecation.Lookup(deprecation.ManifestInheritance), true
+	default:
+		return deprecation.Advisory{}, false
+	}
+}
+
 func (e TriggerLegacyPushError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error())
 }
@@ -0,0 +1,38 @@
+package translatableerror_test
+
+import (
+	"code.cloudfoundry.org/cli/command/deprecation"
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TriggerLegacyPushError", func() {
+	Describe("DeprecationAdvisory", func() {
+		It("returns the route components advisory with the fields found", func() {
+			advisory, found := TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}.DeprecationAdvisory()
+			Expect(found).To(BeTrue())
+			Expect(advisory.ID).To(Equal(deprecation.ManifestRouteComponents))
+			Expect(advisory.Details).To(Equal("Found: domain, host."))
+		})
+
+		It("returns the global fields advisory with the fields found", func() {
+			advisory, found := TriggerLegacyPushError{GlobalRelated: []string{"memory"}}.DeprecationAdvisory()
+			Expect(found).To(BeTrue())
+			Expect(advisory.ID).To(Equal(deprecation.ManifestGlobalFields))
+			Expect(advisory.Details).To(Equal("Found: memory."))
+		})
+
+		It("returns the inheritance advisory", func() {
+			advisory, found := TriggerLegacyPushError{InheritanceRelated: true}.DeprecationAdvisory()
+			Expect(found).To(BeTrue())
+			Expect(advisory.ID).To(Equal(deprecation.ManifestInheritance))
+		})
+
+		It("returns no advisory otherwise", func() {
+			_, found := TriggerLegacyPushError{RandomRouteRelated: true}.DeprecationAdvisory()
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,15 @@
+package translatableerror
+
+// UAAInvalidRequestError is returned when UAA rejects a request as invalid.
+// Its message is UAA's description of what was wrong with the request.
+type UAAInvalidRequestError struct {
+	Message string
+}
+
+func (e UAAInvalidRequestError) Error() string {
+	return e.Message
+}
+
+func (e UAAInvalidRequestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
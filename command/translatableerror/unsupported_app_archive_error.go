@@ -0,0 +1,15 @@
+package translatableerror
+
+type UnsupportedAppArchiveError struct {
+	URL string
+}
+
+func (e UnsupportedAppArchiveError) Error() string {
+	return "The app source downloaded from {{.URL}} is not a zip, tar or tar.gz archive."
+}
+
+func (e UnsupportedAppArchiveError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/apidiscovery"
 )
 
 //go:generate counterfeiter . ApiActor
@@ -17,16 +19,24 @@ type ApiActor interface {
 	SetTarget(settings v2action.TargetSettings) (v2action.Warnings, error)
 }
 
+//go:generate counterfeiter . APIDiscoverer
+
+type APIDiscoverer interface {
+	Discover(appURL string) (string, error)
+}
+
 type ApiCommand struct {
 	OptionalArgs      flag.APITarget `positional-args:"yes"`
+	Discover          string         `long:"discover" description:"Locate the api endpoint from the URL of an app on the foundation"`
 	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
+	usage             interface{}    `usage:"CF_NAME api [URL]\n   CF_NAME api --discover APP_URL\n\nEXAMPLES:\n   CF_NAME api api.example.com\n   CF_NAME api --discover myapp.apps.example.com"`
 	relatedCommands   interface{}    `related_commands:"auth, login, target"`
 
-	UI     command.UI
-	Actor  ApiActor
-	Config command.Config
+	UI         command.UI
+	Actor      ApiActor
+	Discoverer APIDiscoverer
+	Config     command.Config
 }
 
 func (cmd *ApiCommand) Setup(config command.Config, ui command.UI) error {
@@ -36,16 +46,28 @@ func (cmd *ApiCommand) Setup(config command.Config, ui command.UI) error {
 	}
 
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.Discoverer = apidiscovery.NewDiscoverer(cmd.SkipSSLValidation, config.DialTimeout())
 	cmd.UI = ui
 	cmd.Config = config
 	return nil
 }
 
 func (cmd *ApiCommand) Execute(args []string) error {
+	if cmd.Discover != "" && (cmd.OptionalArgs.URL != "" || cmd.Unset) {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--discover", "URL", "--unset"}}
+	}
+
 	if cmd.Unset {
 		return cmd.ClearTarget()
 	}
 
+	if cmd.Discover != "" {
+		err := cmd.discoverAPI()
+		if err != nil {
+			return err
+		}
+	}
+
 	if cmd.OptionalArgs.URL != "" {
 		err := cmd.setAPI()
 		if err != nil {
@@ -88,6 +110,25 @@ func (cmd *ApiCommand) ClearTarget() error {
 	return nil
 }
 
+func (cmd *ApiCommand) discoverAPI() error {
+	cmd.UI.DisplayTextWithFlavor("Discovering api endpoint for {{.AppURL}}...", map[string]interface{}{
+		"AppURL": cmd.Discover,
+	})
+
+	api, err := cmd.Discoverer.Discover(cmd.Discover)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Found api endpoint {{.Endpoint}}", map[string]interface{}{
+		"Endpoint": api,
+	})
+	cmd.UI.DisplayNewline()
+
+	cmd.OptionalArgs.URL = api
+	return nil
+}
+
 func (cmd *ApiCommand) setAPI() error {
 	cmd.UI.DisplayTextWithFlavor("Setting api endpoint to {{.Endpoint}}...", map[string]interface{}{
 		"Endpoint": cmd.OptionalArgs.URL,
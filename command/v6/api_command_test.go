@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -16,22 +17,25 @@ import (
 
 var _ = Describe("api Command", func() {
 	var (
-		cmd        ApiCommand
-		testUI     *ui.UI
-		fakeActor  *v6fakes.FakeApiActor
-		fakeConfig *commandfakes.FakeConfig
-		err        error
+		cmd            ApiCommand
+		testUI         *ui.UI
+		fakeActor      *v6fakes.FakeApiActor
+		fakeDiscoverer *v6fakes.FakeAPIDiscoverer
+		fakeConfig     *commandfakes.FakeConfig
+		err            error
 	)
 
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
 		fakeActor = new(v6fakes.FakeApiActor)
+		fakeDiscoverer = new(v6fakes.FakeAPIDiscoverer)
 		fakeConfig = new(commandfakes.FakeConfig)
 
 		cmd = ApiCommand{
-			UI:     testUI,
-			Actor:  fakeActor,
-			Config: fakeConfig,
+			UI:         testUI,
+			Actor:      fakeActor,
+			Discoverer: fakeDiscoverer,
+			Config:     fakeConfig,
 		}
 
 		fakeConfig.BinaryNameReturns("faceman")
@@ -238,4 +242,54 @@ api version:    100.200.300`,
 			})
 		})
 	})
+
+	When("--discover is provided", func() {
+		BeforeEach(func() {
+			cmd.Discover = "myapp.apps.example.com"
+		})
+
+		When("the api endpoint is discovered", func() {
+			BeforeEach(func() {
+				fakeDiscoverer.DiscoverReturns("https://api.system.example.com", nil)
+				fakeConfig.TargetReturns("https://api.system.example.com")
+			})
+
+			It("targets the discovered api endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Discovering api endpoint for myapp\.apps\.example\.com\.\.\.`))
+				Expect(testUI.Out).To(Say(`Found api endpoint https://api\.system\.example\.com`))
+				Expect(testUI.Out).To(Say(`Setting api endpoint to https://api\.system\.example\.com\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeDiscoverer.DiscoverCallCount()).To(Equal(1))
+				Expect(fakeDiscoverer.DiscoverArgsForCall(0)).To(Equal("myapp.apps.example.com"))
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.URL).To(Equal("https://api.system.example.com"))
+			})
+		})
+
+		When("no api endpoint is discovered", func() {
+			BeforeEach(func() {
+				fakeDiscoverer.DiscoverReturns("", errors.New("no API found"))
+			})
+
+			It("returns the error without targeting", func() {
+				Expect(err).To(MatchError("no API found"))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a URL is also provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.URL = "api.example.com"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--discover", "URL", "--unset"}}))
+				Expect(fakeDiscoverer.DiscoverCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
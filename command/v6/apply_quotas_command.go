@@ -0,0 +1,113 @@
+package v6
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyQuotasActor
+
+type ApplyQuotasActor interface {
+	CloudControllerAPIVersion() string
+	ApplyQuotasManifest(rawManifest []byte) ([]v3action.QuotaChange, v3action.Warnings, error)
+}
+
+type ApplyQuotasCommand struct {
+	RequiredArgs    flag.QuotasFileArg `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME apply-quotas QUOTAS_FILE\n\nEXAMPLES:\n   organization_quotas:\n   - name: small\n     total_memory: 10G\n     instance_memory: 1G\n     log_rate_limit: 16K\n     paid_service_plans: false\n     orgs: [my-org]\n   space_quotas:\n   - name: dev\n     org: my-org\n     per_app_tasks: 5\n     spaces: [dev]"`
+	relatedCommands interface{}        `related_commands:"quotas, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyQuotasActor
+}
+
+func (cmd *ApplyQuotasCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
+	return nil
+}
+
+func (cmd ApplyQuotasCommand) Execute(args []string) error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rawManifest, err := ioutil.ReadFile(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying quotas from {{.Path}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Path":        cmd.RequiredArgs.Path,
+		"CurrentUser": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.ApplyQuotasManifest(rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("quota"),
+			cmd.UI.TranslateText("kind"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("change"),
+			cmd.UI.TranslateText("target"),
+		},
+	}
+	for _, change := range changes {
+		kind := cmd.UI.TranslateText("org quota")
+		if change.IsSpaceQuota {
+			kind = cmd.UI.TranslateText("space quota")
+		}
+		table = append(table, []string{
+			change.QuotaName,
+			kind,
+			change.Org,
+			cmd.UI.TranslateText(string(change.Type)),
+			change.Target,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
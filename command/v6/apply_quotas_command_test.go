@@ -0,0 +1,101 @@
+package v6_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-quotas Command", func() {
+	var (
+		cmd             ApplyQuotasCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeApplyQuotasActor
+		quotasFile      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeApplyQuotasActor)
+
+		file, err := ioutil.TempFile("", "quotas")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteString("organization_quotas:\n- name: small\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		quotasFile = file.Name()
+
+		cmd = ApplyQuotasCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Path = flag.PathWithExistenceCheck(quotasFile)
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionQuotasV3)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(quotasFile)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("applying the quotas succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyQuotasManifestReturns([]v3action.QuotaChange{
+				{QuotaName: "small", Type: v3action.QuotaCreated},
+				{QuotaName: "small", Type: v3action.QuotaAppliedToOrg, Target: "some-org"},
+				{QuotaName: "dev", IsSpaceQuota: true, Org: "some-org", Type: v3action.QuotaUnchanged},
+			}, v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("passes the file contents to the actor and displays each change", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ApplyQuotasManifestCallCount()).To(Equal(1))
+			Expect(string(fakeActor.ApplyQuotasManifestArgsForCall(0))).To(Equal("organization_quotas:\n- name: small\n"))
+
+			Expect(testUI.Out).To(Say("Applying quotas from %s as banana...", quotasFile))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`quota\s+kind\s+org\s+change\s+target`))
+			Expect(testUI.Out).To(Say(`small\s+org quota\s+created`))
+			Expect(testUI.Out).To(Say(`small\s+org quota\s+applied to org\s+some-org`))
+			Expect(testUI.Out).To(Say(`dev\s+space quota\s+some-org\s+unchanged`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("applying the quotas fails", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyQuotasManifestReturns(nil, v3action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
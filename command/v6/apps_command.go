@@ -6,7 +6,9 @@ import (
 )
 
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
+	AllSpaces       bool        `long:"all-spaces" description:"List the apps in all spaces of the targeted org"`
+	Sort            string      `long:"sort" choice:"memory" choice:"instances" choice:"name" choice:"state" description:"Sort apps by memory, instances, name or state"`
+	usage           interface{} `usage:"CF_NAME apps [--sort memory | instances | name | state] [--all-spaces]"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
 }
 
@@ -2,6 +2,7 @@ package v6
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -16,15 +17,22 @@ import (
 
 type AuthActor interface {
 	Authenticate(ID string, secret string, origin string, grantType constant.GrantType) error
+	AuthenticateWithCredentials(credentials map[string]string, origin string, grantType constant.GrantType) error
+	AuthenticateWithDeviceAuthorization(deviceAuthorization v2action.DeviceAuthorization, timeout time.Duration) error
 	CloudControllerAPIVersion() string
+	StartDeviceAuthorization() (v2action.DeviceAuthorization, error)
 	UAAAPIVersion() string
 }
 
 type AuthCommand struct {
 	RequiredArgs      flag.Authentication `positional-args:"yes"`
 	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	Device            bool                `long:"device" description:"Log in by approving a code from another device, such as a phone or a computer with a browser"`
 	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	Prompts           []flag.Prompt       `long:"prompt" description:"Value for a login prompt configured in UAA, as KEY=VALUE. May be repeated"`
+	SSOPasscode       string              `long:"sso-passcode" description:"One-time passcode"`
+	Timeout           flag.Timeout        `long:"timeout" description:"Maximum time to wait for the login request to be approved (e.g. 90s, 10m); numbers without a unit are minutes. Requires --device"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD [--prompt KEY=VALUE]...\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth --sso-passcode PASSCODE [--prompt KEY=VALUE]...\n   CF_NAME auth --device [--timeout TIMEOUT]\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth --sso-passcode PASSCODE (use a one-time passcode instead of a username and password)\n   CF_NAME auth name@example.com \"my password\" --prompt pin=1234 (also answer the UAA login prompt named pin)\n   CF_NAME auth --device (approve the login from a browser on another device, such as when logged in over SSH)"`
 	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
@@ -53,17 +61,19 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
-	if cmd.ClientCredentials && cmd.Origin != "" {
-		return translatableerror.ArgumentCombinationError{
-			Args: []string{"--client-credentials", "--origin"},
-		}
-	}
-
-	username, password, err := cmd.checkEnvVariables()
+	err := cmd.checkFlagCombinations()
 	if err != nil {
 		return err
 	}
 
+	var username, password string
+	if cmd.SSOPasscode == "" && !cmd.Device {
+		username, password, err = cmd.checkEnvVariables()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, cmd.Actor.CloudControllerAPIVersion(), cmd.UI)
 	if err != nil {
 		return err
@@ -76,12 +86,11 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	grantType := constant.GrantTypePassword
-	if cmd.ClientCredentials {
-		grantType = constant.GrantTypeClientCredentials
+	if cmd.Device {
+		err = cmd.authenticateWithDevice()
+	} else {
+		err = cmd.authenticate(username, password)
 	}
-
-	err = cmd.Actor.Authenticate(username, password, cmd.Origin, grantType)
 	if err != nil {
 		return err
 	}
@@ -96,6 +105,108 @@ func (cmd AuthCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AuthCommand) checkFlagCombinations() error {
+	if cmd.Timeout.Value != 0 && !cmd.Device {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--timeout",
+			Arg2: "--device",
+		}
+	}
+
+	if cmd.Device {
+		conflicts := []struct {
+			flag  string
+			isSet bool
+		}{
+			{"--client-credentials", cmd.ClientCredentials},
+			{"--origin", cmd.Origin != ""},
+			{"--prompt", len(cmd.Prompts) > 0},
+			{"--sso-passcode", cmd.SSOPasscode != ""},
+			{"USERNAME", cmd.RequiredArgs.Username != ""},
+		}
+		for _, conflict := range conflicts {
+			if conflict.isSet {
+				return translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", conflict.flag},
+				}
+			}
+		}
+	}
+
+	if cmd.ClientCredentials {
+		conflicts := []struct {
+			flag  string
+			isSet bool
+		}{
+			{"--origin", cmd.Origin != ""},
+			{"--sso-passcode", cmd.SSOPasscode != ""},
+			{"--prompt", len(cmd.Prompts) > 0},
+		}
+		for _, conflict := range conflicts {
+			if conflict.isSet {
+				return translatableerror.ArgumentCombinationError{
+					Args: []string{"--client-credentials", conflict.flag},
+				}
+			}
+		}
+	}
+
+	if cmd.SSOPasscode != "" {
+		if cmd.Origin != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--sso-passcode", "--origin"},
+			}
+		}
+
+		if cmd.RequiredArgs.Username != "" || cmd.RequiredArgs.Password != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--sso-passcode", "USERNAME", "PASSWORD"},
+			}
+		}
+	}
+
+	return nil
+}
+
+// authenticate authenticates with the username and password, or with the
+// passcode when one is given, along with the values of any --prompt flags.
+func (cmd AuthCommand) authenticate(username string, password string) error {
+	if cmd.ClientCredentials {
+		return cmd.Actor.Authenticate(username, password, "", constant.GrantTypeClientCredentials)
+	}
+
+	if cmd.SSOPasscode == "" && len(cmd.Prompts) == 0 {
+		return cmd.Actor.Authenticate(username, password, cmd.Origin, constant.GrantTypePassword)
+	}
+
+	credentials := make(map[string]string, len(cmd.Prompts)+2)
+	for _, prompt := range cmd.Prompts {
+		credentials[prompt.Key] = prompt.Value
+	}
+
+	if cmd.SSOPasscode != "" {
+		credentials["passcode"] = cmd.SSOPasscode
+	} else {
+		credentials["username"] = username
+		credentials["password"] = password
+	}
+
+	return cmd.Actor.AuthenticateWithCredentials(credentials, cmd.Origin, constant.GrantTypePassword)
+}
+
+// authenticateWithDevice displays a code for the user to approve from another
+// device and waits until they have.
+func (cmd AuthCommand) authenticateWithDevice() error {
+	deviceAuthorization, err := cmd.Actor.StartDeviceAuthorization()
+	if err != nil {
+		return err
+	}
+
+	shared.DisplayDeviceAuthorization(cmd.UI, deviceAuthorization.VerificationURI, deviceAuthorization.UserCode, deviceAuthorization.VerificationURIComplete)
+
+	return cmd.Actor.AuthenticateWithDeviceAuthorization(deviceAuthorization, cmd.Timeout.Value)
+}
+
 func (cmd AuthCommand) checkEnvVariables() (string, string, error) {
 	var (
 		userMissing     bool
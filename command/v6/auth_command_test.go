@@ -2,11 +2,14 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
@@ -93,6 +96,273 @@ var _ = Describe("auth Command", func() {
 		})
 	})
 
+	When("--sso-passcode is set", func() {
+		BeforeEach(func() {
+			cmd.SSOPasscode = "some-passcode"
+		})
+
+		It("authenticates with the passcode instead of a username and password", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateWithCredentialsCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeActor.AuthenticateWithCredentialsArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypePassword))
+
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the username and password are provided in env variables", func() {
+			BeforeEach(func() {
+				fakeConfig.CFUsernameReturns("banana")
+				fakeConfig.CFPasswordReturns("potato")
+			})
+
+			It("ignores them", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				credentials, _, _ := fakeActor.AuthenticateWithCredentialsArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			})
+		})
+
+		When("--prompt is also set", func() {
+			BeforeEach(func() {
+				cmd.Prompts = []flag.Prompt{
+					{Key: "pin", Value: "1234"},
+					{Key: "passcode", Value: "overridden"},
+				}
+			})
+
+			It("sends the prompt values along with the passcode", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				credentials, _, _ := fakeActor.AuthenticateWithCredentialsArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"passcode": "some-passcode",
+					"pin":      "1234",
+				}))
+			})
+		})
+
+		When("the username and password are provided as arguments", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "some-user"
+				cmd.RequiredArgs.Password = "some-password"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--sso-passcode", "USERNAME", "PASSWORD"},
+				}))
+				Expect(fakeActor.AuthenticateWithCredentialsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--origin is also set", func() {
+			BeforeEach(func() {
+				cmd.Origin = "some-origin"
+				fakeActor.UAAAPIVersionReturns(uaaversion.MinVersionOrigin)
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--sso-passcode", "--origin"},
+				}))
+			})
+		})
+
+		When("--client-credentials is also set", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--client-credentials", "--sso-passcode"},
+				}))
+			})
+		})
+	})
+
+	When("--prompt is set", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "some-user"
+			cmd.RequiredArgs.Password = "some-password"
+			cmd.Prompts = []flag.Prompt{
+				{Key: "pin", Value: "1234"},
+				{Key: "mfaCode", Value: "567890"},
+			}
+		})
+
+		It("authenticates with the username, password and prompt values", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(fakeActor.AuthenticateWithCredentialsCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeActor.AuthenticateWithCredentialsArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{
+				"username": "some-user",
+				"password": "some-password",
+				"pin":      "1234",
+				"mfaCode":  "567890",
+			}))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypePassword))
+		})
+
+		When("--origin is also set", func() {
+			BeforeEach(func() {
+				cmd.Origin = "some-origin"
+				fakeActor.UAAAPIVersionReturns(uaaversion.MinVersionOrigin)
+			})
+
+			It("authenticates against that identity provider", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, origin, _ := fakeActor.AuthenticateWithCredentialsArgsForCall(0)
+				Expect(origin).To(Equal("some-origin"))
+			})
+		})
+
+		When("the username and password are missing", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = ""
+				cmd.RequiredArgs.Password = ""
+			})
+
+			It("raises an error", func() {
+				Expect(err).To(MatchError(translatableerror.MissingCredentialsError{
+					MissingUsername: true,
+					MissingPassword: true,
+				}))
+			})
+		})
+
+		When("--client-credentials is also set", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--client-credentials", "--prompt"},
+				}))
+			})
+		})
+
+		When("authentication fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithCredentialsReturns(errors.New("some error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("some error"))
+			})
+		})
+	})
+
+	When("--device is set", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			fakeConfig.TargetReturns("some-api-target")
+			fakeActor.StartDeviceAuthorizationReturns(v2action.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+			}, nil)
+		})
+
+		It("displays the code to approve and waits for it to be approved", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+			Expect(testUI.Out).To(Say("To log in, visit https://login.example.com/device on another device and enter the code ABCD-EFGH"))
+			Expect(testUI.Out).To(Say(`Waiting for the login request to be approved\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(1))
+			deviceAuthorization, timeout := fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0)
+			Expect(deviceAuthorization.DeviceCode).To(Equal("some-device-code"))
+			Expect(timeout).To(BeZero())
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+
+		When("--timeout is set", func() {
+			BeforeEach(func() {
+				cmd.Timeout = flag.Timeout{Value: 2 * time.Minute}
+			})
+
+			It("waits no longer than the timeout", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, timeout := fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0)
+				Expect(timeout).To(Equal(2 * time.Minute))
+			})
+		})
+
+		When("starting the device authorization fails", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceAuthorizationReturns(v2action.DeviceAuthorization{}, errors.New("some error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("some error"))
+				Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the login request is not approved", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceAuthorizationReturns(uaa.DeviceAuthorizationDeniedError{})
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(uaa.DeviceAuthorizationDeniedError{}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("a username is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "some-user"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "USERNAME"},
+				}))
+			})
+		})
+
+		When("--sso-passcode is also set", func() {
+			BeforeEach(func() {
+				cmd.SSOPasscode = "some-passcode"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--sso-passcode"},
+				}))
+			})
+		})
+	})
+
+	When("--timeout is set without --device", func() {
+		BeforeEach(func() {
+			cmd.Timeout = flag.Timeout{Value: time.Minute}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--timeout",
+				Arg2: "--device",
+			}))
+		})
+	})
+
 	When("credentials are missing", func() {
 		When("username and password are both missing", func() {
 			It("raises an error", func() {
@@ -0,0 +1,147 @@
+package v6
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . BindingsAuditActor
+
+type BindingsAuditActor interface {
+	GetServiceBindingSummariesBySpace(spaceGUID string) ([]v2action.ServiceBindingSummary, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type BindingsAuditCommand struct {
+	Space           string               `short:"s" long:"space" description:"Space to audit (Default: targeted space)"`
+	MaxAge          flag.PositiveInteger `long:"max-age" default:"90" description:"Flag bindings created more than this many days ago"`
+	usage           interface{}          `usage:"CF_NAME bindings-audit [-s SPACE] [--max-age DAYS]\n\n   Lists the service bindings of a space with their age and, where the service broker reports one, the expiry of their credentials. Bindings older than the maximum age or with expired credentials are flagged for rotation."`
+	examples        interface{}          `examples:"CF_NAME bindings-audit\nCF_NAME bindings-audit -s production --max-age 30"`
+	relatedCommands interface{}          `related_commands:"rebind-service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BindingsAuditActor
+}
+
+func (cmd *BindingsAuditCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd BindingsAuditCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaceName, spaceGUID := cmd.Config.TargetedSpace().Name, cmd.Config.TargetedSpace().GUID
+	if cmd.Space != "" {
+		space, warnings, spaceErr := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.Space)
+		cmd.UI.DisplayWarnings(warnings)
+		if spaceErr != nil {
+			return spaceErr
+		}
+		spaceName, spaceGUID = space.Name, space.GUID
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Auditing service bindings in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   spaceName,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	summaries, warnings, err := cmd.Actor.GetServiceBindingSummariesBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No service bindings found")
+		return nil
+	}
+
+	now := time.Now()
+	maxAge := time.Duration(cmd.MaxAge.Value) * 24 * time.Hour
+
+	table := [][]string{{
+		cmd.UI.TranslateText("app"),
+		cmd.UI.TranslateText("service instance"),
+		cmd.UI.TranslateText("binding name"),
+		cmd.UI.TranslateText("age (days)"),
+		cmd.UI.TranslateText("credentials expire"),
+		cmd.UI.TranslateText("rotation"),
+	}}
+
+	var flagged int
+	for _, summary := range summaries {
+		age := cmd.UI.TranslateText("unknown")
+		if !summary.CreatedAt.IsZero() {
+			age = strconv.Itoa(int(now.Sub(summary.CreatedAt).Hours() / 24))
+		}
+
+		expiry := cmd.UI.TranslateText("not reported")
+		if !summary.ExpiresAt.IsZero() {
+			expiry = summary.ExpiresAt.Local().Format(time.RFC1123)
+		}
+
+		var rotation string
+		switch {
+		case !summary.ExpiresAt.IsZero() && summary.ExpiresAt.Before(now):
+			rotation = cmd.UI.TranslateText("credentials expired")
+		case !summary.CreatedAt.IsZero() && now.Sub(summary.CreatedAt) > maxAge:
+			rotation = cmd.UI.TranslateText("older than {{.MaxAge}} days", map[string]interface{}{
+				"MaxAge": cmd.MaxAge.Value,
+			})
+		}
+		if rotation != "" {
+			flagged++
+		}
+
+		table = append(table, []string{
+			summary.AppName,
+			summary.ServiceInstanceName,
+			summary.Name,
+			age,
+			expiry,
+			rotation,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.Flagged}} of {{.Total}} bindings need credential rotation.", map[string]interface{}{
+		"Flagged": flagged,
+		"Total":   len(summaries),
+	})
+	if flagged > 0 {
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to issue new credentials to an app.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " rebind-service APP_NAME SERVICE_INSTANCE",
+		})
+	}
+
+	return nil
+}
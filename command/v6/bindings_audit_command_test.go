@@ -0,0 +1,156 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("bindings-audit Command", func() {
+	var (
+		cmd             BindingsAuditCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeBindingsAuditActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeBindingsAuditActor)
+
+		cmd = BindingsAuditCommand{
+			MaxAge:      flag.PositiveInteger{Value: 90},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("there are no bindings", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBindingSummariesBySpaceReturns(nil, v2action.Warnings{"bindings-warning"}, nil)
+		})
+
+		It("displays that no bindings were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Auditing service bindings in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("No service bindings found"))
+			Expect(testUI.Err).To(Say("bindings-warning"))
+
+			Expect(fakeActor.GetServiceBindingSummariesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("there are bindings", func() {
+		BeforeEach(func() {
+			now := time.Now()
+			fakeActor.GetServiceBindingSummariesBySpaceReturns(
+				[]v2action.ServiceBindingSummary{
+					{
+						ServiceBinding:      v2action.ServiceBinding{Name: "fresh-binding", CreatedAt: now.Add(-24 * time.Hour)},
+						AppName:             "app-1",
+						ServiceInstanceName: "instance-1",
+					},
+					{
+						ServiceBinding:      v2action.ServiceBinding{CreatedAt: now.Add(-100 * 24 * time.Hour)},
+						AppName:             "app-2",
+						ServiceInstanceName: "instance-2",
+					},
+					{
+						ServiceBinding:      v2action.ServiceBinding{CreatedAt: now.Add(-24 * time.Hour), ExpiresAt: now.Add(-time.Hour)},
+						AppName:             "app-3",
+						ServiceInstanceName: "instance-3",
+					},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the bindings and flags the ones needing rotation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`app\s+service instance\s+binding name\s+age \(days\)\s+credentials expire\s+rotation`))
+			Expect(testUI.Out).To(Say(`app-1\s+instance-1\s+fresh-binding\s+1\s+not reported\s*\n`))
+			Expect(testUI.Out).To(Say(`app-2\s+instance-2\s+100\s+not reported\s+older than 90 days`))
+			Expect(testUI.Out).To(Say(`app-3\s+instance-3\s+1\s+.+\s+credentials expired`))
+			Expect(testUI.Out).To(Say(`2 of 3 bindings need credential rotation\.`))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman rebind-service APP_NAME SERVICE_INSTANCE' to issue new credentials to an app\.`))
+		})
+	})
+
+	When("a space is provided", func() {
+		BeforeEach(func() {
+			cmd.Space = "other-space"
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "other-space-guid", Name: "other-space"}, v2action.Warnings{"space-warning"}, nil)
+		})
+
+		It("audits the provided space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("other-space"))
+
+			Expect(testUI.Out).To(Say(`Auditing service bindings in org some-org / space other-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("space-warning"))
+			Expect(fakeActor.GetServiceBindingSummariesBySpaceArgsForCall(0)).To(Equal("other-space-guid"))
+		})
+	})
+
+	When("getting the bindings fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceBindingSummariesBySpaceReturns(nil, v2action.Warnings{"bindings-warning"}, errors.New("bindings error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("bindings error"))
+			Expect(testUI.Err).To(Say("bindings-warning"))
+		})
+	})
+})
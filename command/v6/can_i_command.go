@@ -0,0 +1,154 @@
+package v6
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . CanIActor
+
+type CanIActor interface {
+	CheckPermission(operation v2action.PermissionOperation, userGUID string, scopes []string, orgGUID string, spaceGUID string) (v2action.PermissionCheck, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type CanICommand struct {
+	RequiredArgs    flag.CanIArgs `positional-args:"yes"`
+	Organization    string        `short:"o" long:"org" description:"Org to check the operation in (Default: targeted org)"`
+	Space           string        `short:"s" long:"space" description:"Space to check the operation in (Default: targeted space)"`
+	usage           interface{}   `usage:"CF_NAME can-i OPERATION [-o ORG] [-s SPACE]\n\n   Checks, without changing anything, whether the current user is authorized to perform the operation, based on the scopes of the access token and the user's org and space roles. Fails when the operation would be rejected.\n\nOPERATIONS:\n   bind-service, create-route, create-service, create-space, delete, map-route, push, read,\n   restage, restart, scale, set-env, set-space-role, start, stop"`
+	examples        interface{}   `examples:"CF_NAME can-i push\nCF_NAME can-i push -o my-org -s production\nCF_NAME can-i create-space -o my-org"`
+	relatedCommands interface{}   `related_commands:"org-users, space-users, target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CanIActor
+}
+
+func (cmd *CanICommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd CanICommand) Execute(args []string) error {
+	operation, found := v2action.GetPermissionOperation(cmd.RequiredArgs.Operation)
+	if !found {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "OPERATION",
+			ExpectedType: strings.Join(v2action.PermissionOperationNames(), ", "),
+		}
+	}
+
+	if operation.RequiresSpace() && cmd.Organization != "" && cmd.Space == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--org, -o", Arg2: "--space, -s"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Organization == "", operation.RequiresSpace() && cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	scopes, err := cmd.Config.AccessTokenScopes()
+	if err != nil {
+		return err
+	}
+
+	orgName, orgGUID, spaceName, spaceGUID, err := cmd.resolveTarget(operation)
+	if err != nil {
+		return err
+	}
+
+	textMap := map[string]interface{}{
+		"Username":  user.Name,
+		"Operation": operation.Name,
+		"OrgName":   orgName,
+		"SpaceName": spaceName,
+	}
+	if operation.RequiresSpace() {
+		cmd.UI.DisplayTextWithFlavor("Checking whether {{.Username}} can {{.Operation}} in org {{.OrgName}} / space {{.SpaceName}}...", textMap)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Checking whether {{.Username}} can {{.Operation}} in org {{.OrgName}}...", textMap)
+	}
+
+	check, warnings, err := cmd.Actor.CheckPermission(operation, user.GUID, scopes, orgGUID, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !check.Allowed {
+		return translatableerror.OperationNotAuthorizedError{
+			Operation:     operation.Name,
+			Username:      user.Name,
+			MissingScope:  check.MissingScope,
+			RequiredRoles: check.RequiredRoles,
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	if check.GrantedByScope != "" {
+		cmd.UI.DisplayText("yes, the access token has the {{.Scope}} scope", map[string]interface{}{
+			"Scope": check.GrantedByScope,
+		})
+	} else {
+		cmd.UI.DisplayText("yes, {{.Username}} has the {{.Role}} role", map[string]interface{}{
+			"Username": user.Name,
+			"Role":     check.GrantedByRole,
+		})
+	}
+
+	return nil
+}
+
+// resolveTarget returns the org and space given by the flags, falling back
+// to the targeted org and space.
+func (cmd CanICommand) resolveTarget(operation v2action.PermissionOperation) (string, string, string, string, error) {
+	orgName, orgGUID := cmd.Config.TargetedOrganization().Name, cmd.Config.TargetedOrganization().GUID
+	spaceName, spaceGUID := cmd.Config.TargetedSpace().Name, cmd.Config.TargetedSpace().GUID
+
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", "", "", "", err
+		}
+		orgName, orgGUID = org.Name, org.GUID
+	}
+
+	if !operation.RequiresSpace() {
+		return orgName, orgGUID, "", "", nil
+	}
+
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(orgGUID, cmd.Space)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", "", "", "", err
+		}
+		spaceName, spaceGUID = space.Name, space.GUID
+	}
+
+	return orgName, orgGUID, spaceName, spaceGUID, nil
+}
@@ -0,0 +1,226 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("can-i Command", func() {
+	var (
+		cmd             CanICommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeCanIActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeCanIActor)
+
+		cmd = CanICommand{
+			RequiredArgs: flag.CanIArgs{Operation: "push"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve", GUID: "steve-guid"}, nil)
+		fakeConfig.AccessTokenScopesReturns([]string{"cloud_controller.read", "cloud_controller.write"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the operation is unknown", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Operation = "launch-rockets"
+		})
+
+		It("returns a ParseArgumentError listing the operations", func() {
+			Expect(executeErr).To(HaveOccurred())
+			parseErr, ok := executeErr.(translatableerror.ParseArgumentError)
+			Expect(ok).To(BeTrue())
+			Expect(parseErr.ArgumentName).To(Equal("OPERATION"))
+			Expect(parseErr.ExpectedType).To(ContainSubstring("create-space, delete"))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the operation is authorized by a role", func() {
+		BeforeEach(func() {
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{Allowed: true, GrantedByRole: "SpaceDeveloper"}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("checks the targeted space and displays the role", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Checking whether steve can push in org some-org / space some-space\.\.\.`))
+			Expect(testUI.Out).To(Say(`yes, steve has the SpaceDeveloper role`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.CheckPermissionCallCount()).To(Equal(1))
+			operation, userGUID, scopes, orgGUID, spaceGUID := fakeActor.CheckPermissionArgsForCall(0)
+			Expect(operation.Name).To(Equal("push"))
+			Expect(userGUID).To(Equal("steve-guid"))
+			Expect(scopes).To(ConsistOf("cloud_controller.read", "cloud_controller.write"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the operation is authorized by an admin scope", func() {
+		BeforeEach(func() {
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{Allowed: true, GrantedByScope: "cloud_controller.admin"}, nil, nil)
+		})
+
+		It("displays the scope", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`yes, the access token has the cloud_controller\.admin scope`))
+		})
+	})
+
+	When("the token lacks the required scope", func() {
+		BeforeEach(func() {
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{MissingScope: "cloud_controller.write"}, nil, nil)
+		})
+
+		It("returns an OperationNotAuthorizedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OperationNotAuthorizedError{
+				Operation:    "push",
+				Username:     "steve",
+				MissingScope: "cloud_controller.write",
+			}))
+		})
+	})
+
+	When("the user lacks the required roles", func() {
+		BeforeEach(func() {
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{RequiredRoles: []string{"SpaceDeveloper"}}, nil, nil)
+		})
+
+		It("returns an OperationNotAuthorizedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OperationNotAuthorizedError{
+				Operation:     "push",
+				Username:      "steve",
+				RequiredRoles: []string{"SpaceDeveloper"},
+			}))
+		})
+	})
+
+	When("checking the permission fails", func() {
+		BeforeEach(func() {
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{}, v2action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("the org and space are provided", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+			cmd.Space = "other-space"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{Name: "other-org", GUID: "other-org-guid"}, v2action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{Name: "other-space", GUID: "other-space-guid"}, v2action.Warnings{"space-warning"}, nil)
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{Allowed: true, GrantedByRole: "SpaceDeveloper"}, nil, nil)
+		})
+
+		It("checks the operation in that space without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Checking whether steve can push in org other-org / space other-space\.\.\.`))
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+			Expect(spaceName).To(Equal("other-space"))
+
+			_, _, _, orgGUID, spaceGUID := fakeActor.CheckPermissionArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, actionerror.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "other-space"}))
+				Expect(fakeActor.CheckPermissionCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("only the org is provided for a space operation", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--org, -o", Arg2: "--space, -s"}))
+		})
+	})
+
+	When("the operation applies to the org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Operation = "create-space"
+			fakeActor.CheckPermissionReturns(v2action.PermissionCheck{Allowed: true, GrantedByRole: "OrgManager"}, nil, nil)
+		})
+
+		It("does not require a targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Checking whether steve can create-space in org some-org\.\.\.`))
+			_, _, _, _, spaceGUID := fakeActor.CheckPermissionArgsForCall(0)
+			Expect(spaceGUID).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CapabilitiesActor
+
+type CapabilitiesActor interface {
+	GetCapabilities() ([]v3action.Capability, v3action.Warnings, error)
+}
+
+type CapabilitiesCommand struct {
+	Output          flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Template        string            `long:"template" description:"Format the output with a Go template applied to the JSON output"`
+	Query           string            `long:"query" description:"Narrow down the JSON output with a JMESPath expression"`
+	usage           interface{}       `usage:"CF_NAME capabilities [--output json | --template TEMPLATE] [--query EXPRESSION]\n\n   Reports which optional APIs the targeted foundation supports: routing-api, network-policy, log-cache, revisions, sidecars, cnb-lifecycle and service-instance-sharing. Separately deployed APIs are detected from the links advertised by the API root, the others from the Cloud Controller API version. Logging in is not required."`
+	examples        interface{}       `examples:"CF_NAME capabilities\nCF_NAME capabilities --output json"`
+	relatedCommands interface{}       `related_commands:"api, curl, status"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  CapabilitiesActor
+}
+
+func (cmd *CapabilitiesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedaction.NewActor(config), nil)
+
+	return nil
+}
+
+func (cmd CapabilitiesCommand) Execute(args []string) error {
+	if cmd.Output != "json" && cmd.Template == "" && cmd.Query == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting capabilities of {{.APIEndpoint}}...", map[string]interface{}{
+			"APIEndpoint": cmd.Config.Target(),
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	capabilities, warnings, err := cmd.Actor.GetCapabilities()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "json" || cmd.Template != "" || cmd.Query != "" {
+		return command.DisplayDocument(cmd.UI, capabilities, cmd.Query, cmd.Template)
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("capability"),
+			cmd.UI.TranslateText("supported"),
+			cmd.UI.TranslateText("reason"),
+		},
+	}
+	for _, capability := range capabilities {
+		supported := cmd.UI.TranslateText("no")
+		if capability.Supported {
+			supported = cmd.UI.TranslateText("yes")
+		}
+		table = append(table, []string{capability.Name, supported, capability.Reason})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,91 @@
+package v6_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("capabilities Command", func() {
+	var (
+		cmd        CapabilitiesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v6fakes.FakeCapabilitiesActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v6fakes.FakeCapabilitiesActor)
+
+		cmd = CapabilitiesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeActor.GetCapabilitiesReturns(
+			[]v3action.Capability{
+				{Name: "routing-api", Supported: true, Reason: "routing link advertised"},
+				{Name: "sidecars", Supported: false, Reason: "requires API 3.77.0, target is 3.70.0"},
+			},
+			v3action.Warnings{"some-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays a table of capabilities and warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Getting capabilities of https://api\.example\.com\.\.\.`))
+		Expect(testUI.Out).To(Say(`capability\s+supported\s+reason`))
+		Expect(testUI.Out).To(Say(`routing-api\s+yes\s+routing link advertised`))
+		Expect(testUI.Out).To(Say(`sidecars\s+no\s+requires API 3\.77\.0, target is 3\.70\.0`))
+		Expect(testUI.Err).To(Say("some-warning"))
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			cmd.Output = "json"
+		})
+
+		It("displays the capabilities as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting capabilities"))
+
+			var capabilities []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &capabilities)).To(Succeed())
+			Expect(capabilities).To(HaveLen(2))
+			Expect(capabilities[0]).To(Equal(map[string]interface{}{
+				"name":      "routing-api",
+				"supported": true,
+				"reason":    "routing link advertised",
+			}))
+		})
+	})
+
+	When("getting the capabilities fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCapabilitiesReturns(nil, v3action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+type CatCommand struct {
+	RequiredArgs       flag.CatArgs `positional-args:"yes"`
+	Instance           uint         `short:"i" description:"Instance"`
+	ProcessType        string       `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}  `usage:"CF_NAME cat APP_NAME:PATH [-i INSTANCE] [--process PROCESS] [--skip-host-validation]\n\n   Prints the file at PATH in an app instance to stdout. Relative paths are relative to /home/vcap. SSH must be enabled for the app.\n\nEXAMPLES:\n   CF_NAME cat my-app:app/config.yml\n   CF_NAME cat my-app:logs/app.log -i 2 > app.log"`
+	relatedCommands    interface{}  `related_commands:"enable-ssh, files, ssh"`
+
+	UI            command.UI
+	Config        command.Config
+	SharedActor   command.SharedActor
+	Actor         FilesActor
+	AppFilesActor AppFilesActor
+	SSHClient     *clissh.SecureShell
+}
+
+func (cmd *CatCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.AppFilesActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd CatCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.File.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.Instance,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.AppFilesActor.CatAppFile(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		cmd.RequiredArgs.File.Path,
+		cmd.UI.GetOut(),
+	)
+}
@@ -0,0 +1,104 @@
+package v6_test
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cat Command", func() {
+	var (
+		cmd               CatCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v6fakes.FakeFilesActor
+		fakeAppFilesActor *v6fakes.FakeAppFilesActor
+		executeErr        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeFilesActor)
+		fakeAppFilesActor = new(v6fakes.FakeAppFilesActor)
+
+		cmd = CatCommand{
+			RequiredArgs: flag.CatArgs{File: flag.AppFilePath{AppName: "some-app", Path: "app/config.yml"}},
+			ProcessType:  "web",
+
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			AppFilesActor: fakeAppFilesActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v3action.SSHAuthentication{Endpoint: "some-endpoint", Passcode: "some-passcode", Username: "some-username"},
+			v3action.Warnings{"ssh-warning"},
+			nil,
+		)
+		fakeAppFilesActor.CatAppFileStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, _ string, out io.Writer) error {
+			_, err := out.Write([]byte("some-contents\n"))
+			return err
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+			Expect(fakeAppFilesActor.CatAppFileCallCount()).To(Equal(0))
+		})
+	})
+
+	It("prints only the contents of the file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("^some-contents\n$"))
+		Expect(testUI.Err).To(Say("ssh-warning"))
+
+		appName, spaceGUID, processType, index := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(processType).To(Equal("web"))
+		Expect(index).To(Equal(uint(0)))
+
+		_, sshOptions, path, _ := fakeAppFilesActor.CatAppFileArgsForCall(0)
+		Expect(sshOptions.Endpoint).To(Equal("some-endpoint"))
+		Expect(sshOptions.Passcode).To(Equal("some-passcode"))
+		Expect(path).To(Equal("app/config.yml"))
+	})
+
+	When("the path is a directory", func() {
+		BeforeEach(func() {
+			fakeAppFilesActor.CatAppFileStub = nil
+			fakeAppFilesActor.CatAppFileReturns(actionerror.AppPathIsDirectoryError{Path: "app/config.yml"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.AppPathIsDirectoryError{Path: "app/config.yml"}))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package v6
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . ClearBuildCacheActor
+
+type ClearBuildCacheActor interface {
+	CloudControllerAPIVersion() string
+	ClearApplicationBuildCacheByNameAndSpace(appName string, spaceGUID string) (v3action.Warnings, error)
+}
+
+type ClearBuildCacheCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME clear-build-cache APP_NAME\n\n   Deletes the files buildpacks cached while staging the app. The app keeps running; the next staging starts from a clean cache."`
+	relatedCommands interface{}  `related_commands:"push, restage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ClearBuildCacheActor
+}
+
+func (cmd *ClearBuildCacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd ClearBuildCacheCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Clearing build cache of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	warnings, err := cmd.Actor.ClearApplicationBuildCacheByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		return gate.ExplainNotFound(err, "", ccversion.MinVersionClearBuildpackCacheV3)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}} {{.AppName}}' to rebuild the app with a clean cache.", map[string]interface{}{
+		"Command": fmt.Sprintf("%s restage", cmd.Config.BinaryName()),
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clear-build-cache Command", func() {
+	var (
+		cmd             ClearBuildCacheCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeClearBuildCacheActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeClearBuildCacheActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = ClearBuildCacheCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		})
+
+		When("clearing the build cache succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.ClearApplicationBuildCacheByNameAndSpaceReturns(v3action.Warnings{"clear-warning"}, nil)
+			})
+
+			It("clears the build cache of the app and displays a tip to restage", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Clearing build cache of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Err).To(Say("clear-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman restage some-app' to rebuild the app with a clean cache\.`))
+
+				Expect(fakeActor.ClearApplicationBuildCacheByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.ClearApplicationBuildCacheByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.ClearApplicationBuildCacheByNameAndSpaceReturns(v3action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("the API does not know the endpoint", func() {
+			BeforeEach(func() {
+				fakeActor.ClearApplicationBuildCacheByNameAndSpaceReturns(nil, ccerror.APINotFoundError{})
+			})
+
+			When("the API is older than the minimum version", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("3.99.0")
+				})
+
+				It("returns a MinimumCFAPIVersionNotMetError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+						CurrentVersion: "3.99.0",
+						MinimumVersion: ccversion.MinVersionClearBuildpackCacheV3,
+					}))
+				})
+			})
+
+			When("the API meets the minimum version", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionClearBuildpackCacheV3)
+				})
+
+				It("returns the original error", func() {
+					Expect(executeErr).To(MatchError(ccerror.APINotFoundError{}))
+				})
+			})
+		})
+	})
+})
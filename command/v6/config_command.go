@@ -1,23 +1,229 @@
 package v6
 
 import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+var (
+	headerNamePattern      = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+	userAgentSuffixPattern = regexp.MustCompile(`^[[:print:]]+$`)
 )
 
 type ConfigCommand struct {
+	OptionalArgs flag.ConfigArgs   `positional-args:"yes"`
 	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
 	Color        flag.Color        `long:"color" description:"Enable or disable color"`
 	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
 	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]\n   CF_NAME config set (default-org ORG | default-space SPACE | push-scan-hook (COMMAND | URL) | push-scan-skip-allowed (true | false) | insecure-allowed (true | false) | log-source (auto | log-cache | doppler) | router-cname HOST | router-ips IP[,IP...] | change-header HEADER | user-agent-suffix IDENTIFIER | plugin-precedence PLUGIN[,PLUGIN...] | plugin-timeout DURATION | plugin-max-output SIZE | accessible (true | false))\n   CF_NAME config unset (default-org | default-space | push-scan-hook | push-scan-skip-allowed | insecure-allowed | log-source | router-cname | router-ips | change-header | user-agent-suffix | plugin-precedence | plugin-timeout | plugin-max-output | accessible)\n\nEXAMPLES:\n   CF_NAME config set default-org my-org\n   CF_NAME config unset default-space\n   CF_NAME config set push-scan-hook 'clamscan --no-summary \"$CF_SCAN_PACKAGE_PATH\"'\n   CF_NAME config set push-scan-hook https://scanner.example.com/scan\n   CF_NAME config set router-ips 10.0.16.4,10.0.16.5\n   CF_NAME config set change-header X-Change-Ticket\n   CF_NAME config set user-agent-suffix team-payments/deploy-pipeline\n   CF_NAME config set plugin-precedence blue-green-deploy,autopilot\n   CF_NAME config set plugin-timeout 30m\n   CF_NAME config set plugin-max-output 10M\n   CF_NAME config set accessible true\n\nTIP:\n   The default org and space are targeted after logging in when -o and -s are not given. A .cf/target file in a project directory, containing 'org: ORG' and optionally 'space: SPACE', targets that org and space when running commands from within the directory.\n\n   The push scan hook must approve the app files or droplet before push uploads them. A command receives the paths in CF_SCAN_APP_NAME, CF_SCAN_PACKAGE_PATH and CF_SCAN_MANIFEST_PATH and approves by exiting with 0. A URL receives a multipart POST with the app_name, package and manifest fields and approves with a 2xx response. Push --skip-scan is refused unless push-scan-skip-allowed is true. Both settings are user preferences that guard against uploading unscanned files by mistake; anyone who can run the CLI can change them, so they do not replace scanning enforced by the platform.\n\n   When insecure-allowed is false, --skip-ssl-validation is refused and commands against a target configured with it fail. The CF_INSECURE_ALLOWED environment variable takes precedence over this setting. It is a user preference that guards against connecting without SSL validation by mistake; anyone who can run the CLI can change it, so it does not replace a policy enforced by the platform.\n\n   The log-source setting chooses where cf logs reads logs from. With auto, the default, logs are read from Log Cache when the API advertises it and from the Doppler websocket endpoint otherwise, or when Log Cache cannot be reached.\n\n   The router-cname and router-ips settings describe the DNS records of the routers of the foundation. create-domain and map-route with --verify-dns check that the domain or route resolves to them, as a CNAME of or to the addresses of router-cname, or to router-ips.\n\n   When change-header is set, every request that creates, updates or deletes resources carries the change reason in that header, so that it is recorded in the audit events. Give the reason with the --reason global flag or the CF_REASON environment variable; otherwise it is prompted for on a terminal.\n\n   The user-agent-suffix setting is appended to the User-Agent of every request, so that platform operators can attribute API load in the router and Cloud Controller logs to a team or pipeline. The CF_USER_AGENT_SUFFIX environment variable takes precedence over this setting.\n\n   When several plugins, or a plugin and a built-in command, have the same command name or alias, the command of the first of them in plugin-precedence runs. Otherwise the built-in command runs, and plugin commands have to be run as PLUGIN:COMMAND.\n\n   The plugin-timeout and plugin-max-output settings stop a plugin command that runs longer than the duration, such as 90s or 30m, or that writes more than the size, such as 512K or 10M, to stdout and stderr together. Ctrl-C is passed on to the plugin command, which is stopped when it does not exit within 10 seconds. Output written to a terminal is not limited, so that plugin commands keep their colours and prompts. When stdin is a terminal, stopping a plugin command does not stop the processes it started.\n\n   When accessible is true, output is adapted for screen readers: prompts read whole lines without redrawing them, upload progress bars are not drawn and tables announce how many rows they have."`
+
+	UI     command.UI
+	Config command.Config
 }
 
-func (ConfigCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd ConfigCommand) Execute(args []string) error {
+	switch cmd.OptionalArgs.Action {
+	case "":
+		return translatableerror.UnrefactoredCommandError{}
+	case "set":
+		return cmd.set()
+	case "unset":
+		return cmd.unset()
+	default:
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "ACTION",
+			ExpectedType: "set or unset",
+		}
+	}
+}
+
+func (cmd ConfigCommand) set() error {
+	if cmd.OptionalArgs.Value == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "VALUE"}
+	}
+
+	switch cmd.OptionalArgs.Setting {
+	case "default-org":
+		cmd.Config.SetDefaultOrganization(cmd.OptionalArgs.Value)
+	case "default-space":
+		cmd.Config.SetDefaultSpace(cmd.OptionalArgs.Value)
+	case "push-scan-hook":
+		cmd.Config.SetPushScanHook(cmd.OptionalArgs.Value)
+	case "push-scan-skip-allowed":
+		allowed, err := strconv.ParseBool(cmd.OptionalArgs.Value)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "true or false",
+			}
+		}
+		cmd.Config.SetPushScanSkipAllowed(allowed)
+	case "insecure-allowed":
+		allowed, err := strconv.ParseBool(cmd.OptionalArgs.Value)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "true or false",
+			}
+		}
+		cmd.Config.SetInsecureAllowed(allowed)
+	case "log-source":
+		switch cmd.OptionalArgs.Value {
+		case configv3.LogSourceAuto, configv3.LogSourceLogCache, configv3.LogSourceDoppler:
+			cmd.Config.SetLogSource(cmd.OptionalArgs.Value)
+		default:
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "auto, log-cache or doppler",
+			}
+		}
+	case "router-cname":
+		cmd.Config.SetRouterCNAME(cmd.OptionalArgs.Value)
+	case "router-ips":
+		var ips []string
+		for _, ip := range strings.Split(cmd.OptionalArgs.Value, ",") {
+			ip = strings.TrimSpace(ip)
+			if net.ParseIP(ip) == nil {
+				return translatableerror.ParseArgumentError{
+					ArgumentName: "VALUE",
+					ExpectedType: "comma-separated IP addresses",
+				}
+			}
+			ips = append(ips, ip)
+		}
+		cmd.Config.SetRouterIPs(ips)
+	case "change-header":
+		if !headerNamePattern.MatchString(cmd.OptionalArgs.Value) || configv3.IsReservedHeader(cmd.OptionalArgs.Value) {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "an HTTP header name the CLI does not set itself",
+			}
+		}
+		cmd.Config.SetChangeHeader(cmd.OptionalArgs.Value)
+	case "user-agent-suffix":
+		if !userAgentSuffixPattern.MatchString(cmd.OptionalArgs.Value) {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "printable ASCII text",
+			}
+		}
+		cmd.Config.SetUserAgentSuffix(cmd.OptionalArgs.Value)
+	case "plugin-precedence":
+		var plugins []string
+		for _, plugin := range strings.Split(cmd.OptionalArgs.Value, ",") {
+			if plugin = strings.TrimSpace(plugin); plugin != "" {
+				plugins = append(plugins, plugin)
+			}
+		}
+		if len(plugins) == 0 {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "comma-separated plugin names",
+			}
+		}
+		cmd.Config.SetPluginPrecedence(plugins)
+	case "plugin-timeout":
+		timeout, err := time.ParseDuration(cmd.OptionalArgs.Value)
+		if err != nil || timeout < time.Second {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "a duration of at least 1s, such as 90s or 30m",
+			}
+		}
+		cmd.Config.SetPluginTimeout(timeout)
+	case "plugin-max-output":
+		maxOutput, err := bytefmt.ToBytes(cmd.OptionalArgs.Value)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "a size, such as 512K or 10M",
+			}
+		}
+		cmd.Config.SetPluginMaxOutput(maxOutput)
+	case "accessible":
+		accessible, err := strconv.ParseBool(cmd.OptionalArgs.Value)
+		if err != nil {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: "VALUE",
+				ExpectedType: "true or false",
+			}
+		}
+		cmd.Config.SetAccessible(accessible)
+	default:
+		return cmd.invalidSettingError()
+	}
+
+	cmd.UI.DisplayText("Setting {{.Setting}} to {{.Value}}...", map[string]interface{}{
+		"Setting": cmd.OptionalArgs.Setting,
+		"Value":   cmd.OptionalArgs.Value,
+	})
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ConfigCommand) unset() error {
+	switch cmd.OptionalArgs.Setting {
+	case "default-org":
+		cmd.Config.SetDefaultOrganization("")
+	case "default-space":
+		cmd.Config.SetDefaultSpace("")
+	case "push-scan-hook":
+		cmd.Config.SetPushScanHook("")
+	case "push-scan-skip-allowed":
+		cmd.Config.SetPushScanSkipAllowed(false)
+	case "insecure-allowed":
+		cmd.Config.SetInsecureAllowed(true)
+	case "log-source":
+		cmd.Config.SetLogSource("")
+	case "router-cname":
+		cmd.Config.SetRouterCNAME("")
+	case "router-ips":
+		cmd.Config.SetRouterIPs(nil)
+	case "change-header":
+		cmd.Config.SetChangeHeader("")
+	case "user-agent-suffix":
+		cmd.Config.SetUserAgentSuffix("")
+	case "plugin-precedence":
+		cmd.Config.SetPluginPrecedence(nil)
+	case "plugin-timeout":
+		cmd.Config.SetPluginTimeout(0)
+	case "plugin-max-output":
+		cmd.Config.SetPluginMaxOutput(0)
+	case "accessible":
+		cmd.Config.SetAccessible(false)
+	default:
+		return cmd.invalidSettingError()
+	}
+
+	cmd.UI.DisplayText("Unsetting {{.Setting}}...", map[string]interface{}{
+		"Setting": cmd.OptionalArgs.Setting,
+	})
+	cmd.UI.DisplayOK()
 	return nil
 }
 
-func (ConfigCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd ConfigCommand) invalidSettingError() error {
+	if cmd.OptionalArgs.Setting == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "SETTING"}
+	}
+	return translatableerror.ParseArgumentError{
+		ArgumentName: "SETTING",
+		ExpectedType: "default-org, default-space, push-scan-hook, push-scan-skip-allowed, insecure-allowed, log-source, router-cname, router-ips, change-header, user-agent-suffix, plugin-precedence, plugin-timeout, plugin-max-output or accessible",
+	}
 }
@@ -0,0 +1,563 @@
+package v6_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("config Command", func() {
+	var (
+		cmd        ConfigCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ConfigCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no action is provided", func() {
+		It("hands off to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+	})
+
+	When("the action is not set or unset", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.ConfigArgs{Action: "get", Setting: "default-org"}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "ACTION",
+				ExpectedType: "set or unset",
+			}))
+		})
+	})
+
+	Describe("set", func() {
+		When("setting the default org", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "default-org", Value: "some-org"}
+			})
+
+			It("stores the default org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetDefaultOrganizationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetDefaultOrganizationArgsForCall(0)).To(Equal("some-org"))
+				Expect(testUI.Out).To(Say("Setting default-org to some-org..."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("setting the default space", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "default-space", Value: "some-space"}
+			})
+
+			It("stores the default space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetDefaultSpaceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetDefaultSpaceArgsForCall(0)).To(Equal("some-space"))
+				Expect(testUI.Out).To(Say("Setting default-space to some-space..."))
+			})
+		})
+
+		When("setting the push scan hook", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "push-scan-hook", Value: "https://scanner.example.com"}
+			})
+
+			It("stores the push scan hook", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPushScanHookCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPushScanHookArgsForCall(0)).To(Equal("https://scanner.example.com"))
+				Expect(testUI.Out).To(Say("Setting push-scan-hook to https://scanner.example.com..."))
+			})
+		})
+
+		When("allowing push to skip the scan", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "push-scan-skip-allowed", Value: "true"}
+			})
+
+			It("stores the permission", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPushScanSkipAllowedCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPushScanSkipAllowedArgsForCall(0)).To(BeTrue())
+			})
+
+			When("the value is not a boolean", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "sometimes"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "true or false",
+					}))
+					Expect(fakeConfig.SetPushScanSkipAllowedCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("forbidding skipping SSL validation", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "insecure-allowed", Value: "false"}
+			})
+
+			It("stores the policy", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetInsecureAllowedCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetInsecureAllowedArgsForCall(0)).To(BeFalse())
+				Expect(testUI.Out).To(Say("Setting insecure-allowed to false..."))
+			})
+		})
+
+		When("setting the log source", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "log-source", Value: "doppler"}
+			})
+
+			It("stores the log source", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetLogSourceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetLogSourceArgsForCall(0)).To(Equal("doppler"))
+				Expect(testUI.Out).To(Say("Setting log-source to doppler..."))
+			})
+
+			When("the log source is unknown", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "firehose"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "auto, log-cache or doppler",
+					}))
+					Expect(fakeConfig.SetLogSourceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the router CNAME", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "router-cname", Value: "router.example.com"}
+			})
+
+			It("stores the router CNAME", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetRouterCNAMECallCount()).To(Equal(1))
+				Expect(fakeConfig.SetRouterCNAMEArgsForCall(0)).To(Equal("router.example.com"))
+				Expect(testUI.Out).To(Say("Setting router-cname to router.example.com..."))
+			})
+		})
+
+		When("setting the router IPs", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "router-ips", Value: "10.0.16.4, 10.0.16.5"}
+			})
+
+			It("stores the router IPs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetRouterIPsCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetRouterIPsArgsForCall(0)).To(Equal([]string{"10.0.16.4", "10.0.16.5"}))
+			})
+
+			When("an IP address is invalid", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "10.0.16.4,router.example.com"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "comma-separated IP addresses",
+					}))
+					Expect(fakeConfig.SetRouterIPsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the change header", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "change-header", Value: "X-Change-Ticket"}
+			})
+
+			It("stores the change header", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetChangeHeaderCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetChangeHeaderArgsForCall(0)).To(Equal("X-Change-Ticket"))
+				Expect(testUI.Out).To(Say("Setting change-header to X-Change-Ticket..."))
+			})
+
+			When("the header name is invalid", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "X-Change-Ticket: CHG12345"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "an HTTP header name the CLI does not set itself",
+					}))
+					Expect(fakeConfig.SetChangeHeaderCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the header name is reserved", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "authorization"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "an HTTP header name the CLI does not set itself",
+					}))
+					Expect(fakeConfig.SetChangeHeaderCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the user agent suffix", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "user-agent-suffix", Value: "team-payments/deploy"}
+			})
+
+			It("stores the user agent suffix", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetUserAgentSuffixCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUserAgentSuffixArgsForCall(0)).To(Equal("team-payments/deploy"))
+				Expect(testUI.Out).To(Say("Setting user-agent-suffix to team-payments/deploy..."))
+			})
+
+			When("the identifier has control characters", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "team-payments\r\nX-Injected: true"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "printable ASCII text",
+					}))
+					Expect(fakeConfig.SetUserAgentSuffixCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the plugin precedence", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "plugin-precedence", Value: "plugin-b, plugin-a"}
+			})
+
+			It("stores the plugins in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginPrecedenceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginPrecedenceArgsForCall(0)).To(Equal([]string{"plugin-b", "plugin-a"}))
+				Expect(testUI.Out).To(Say("Setting plugin-precedence to plugin-b, plugin-a..."))
+			})
+
+			When("no plugin is given", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = " , "
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "comma-separated plugin names",
+					}))
+					Expect(fakeConfig.SetPluginPrecedenceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the plugin timeout", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "plugin-timeout", Value: "30m"}
+			})
+
+			It("stores the timeout", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginTimeoutCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginTimeoutArgsForCall(0)).To(Equal(30 * time.Minute))
+				Expect(testUI.Out).To(Say("Setting plugin-timeout to 30m..."))
+			})
+
+			When("the value is shorter than a second", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "500ms"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "a duration of at least 1s, such as 90s or 30m",
+					}))
+					Expect(fakeConfig.SetPluginTimeoutCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the value is not a duration", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "forever"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "a duration of at least 1s, such as 90s or 30m",
+					}))
+					Expect(fakeConfig.SetPluginTimeoutCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting the plugin max output", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "plugin-max-output", Value: "10M"}
+			})
+
+			It("stores the size in bytes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginMaxOutputCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginMaxOutputArgsForCall(0)).To(Equal(uint64(10 * 1024 * 1024)))
+				Expect(testUI.Out).To(Say("Setting plugin-max-output to 10M..."))
+			})
+
+			When("the value is not a size", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "lots"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "a size, such as 512K or 10M",
+					}))
+					Expect(fakeConfig.SetPluginMaxOutputCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("setting accessible mode", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "accessible", Value: "true"}
+			})
+
+			It("stores the setting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetAccessibleCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetAccessibleArgsForCall(0)).To(BeTrue())
+				Expect(testUI.Out).To(Say("Setting accessible to true..."))
+			})
+
+			When("the value is not a boolean", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.Value = "yes please"
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+						ArgumentName: "VALUE",
+						ExpectedType: "true or false",
+					}))
+					Expect(fakeConfig.SetAccessibleCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("no value is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "default-org"}
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "VALUE"}))
+				Expect(fakeConfig.SetDefaultOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the setting is unknown", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "set", Setting: "default-app", Value: "some-app"}
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "SETTING",
+					ExpectedType: "default-org, default-space, push-scan-hook, push-scan-skip-allowed, insecure-allowed, log-source, router-cname, router-ips, change-header, user-agent-suffix, plugin-precedence, plugin-timeout, plugin-max-output or accessible",
+				}))
+			})
+		})
+	})
+
+	Describe("unset", func() {
+		When("unsetting the default space", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "default-space"}
+			})
+
+			It("clears the default space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetDefaultSpaceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetDefaultSpaceArgsForCall(0)).To(Equal(""))
+				Expect(testUI.Out).To(Say("Unsetting default-space..."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("unsetting the push scan hook", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "push-scan-hook"}
+			})
+
+			It("clears the push scan hook", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPushScanHookCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPushScanHookArgsForCall(0)).To(Equal(""))
+			})
+		})
+
+		When("unsetting the insecure-allowed policy", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "insecure-allowed"}
+			})
+
+			It("allows skipping SSL validation again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetInsecureAllowedCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetInsecureAllowedArgsForCall(0)).To(BeTrue())
+			})
+		})
+
+		When("unsetting the log source", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "log-source"}
+			})
+
+			It("restores the default log source", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetLogSourceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetLogSourceArgsForCall(0)).To(Equal(""))
+			})
+		})
+
+		When("unsetting the router IPs", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "router-ips"}
+			})
+
+			It("clears the router IPs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetRouterIPsCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetRouterIPsArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		When("unsetting the change header", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "change-header"}
+			})
+
+			It("stops sending the change reason", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetChangeHeaderCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetChangeHeaderArgsForCall(0)).To(Equal(""))
+			})
+		})
+
+		When("unsetting the user agent suffix", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "user-agent-suffix"}
+			})
+
+			It("stops appending the identifier", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetUserAgentSuffixCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUserAgentSuffixArgsForCall(0)).To(Equal(""))
+			})
+		})
+
+		When("unsetting the plugin precedence", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "plugin-precedence"}
+			})
+
+			It("removes the plugin precedence", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginPrecedenceCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginPrecedenceArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		When("unsetting the plugin timeout", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "plugin-timeout"}
+			})
+
+			It("removes the plugin timeout", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginTimeoutCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginTimeoutArgsForCall(0)).To(BeZero())
+			})
+		})
+
+		When("unsetting the plugin max output", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "plugin-max-output"}
+			})
+
+			It("removes the plugin max output", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetPluginMaxOutputCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetPluginMaxOutputArgsForCall(0)).To(BeZero())
+			})
+		})
+
+		When("unsetting accessible mode", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset", Setting: "accessible"}
+			})
+
+			It("turns accessible mode off", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetAccessibleCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetAccessibleArgsForCall(0)).To(BeFalse())
+			})
+		})
+
+		When("no setting is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.ConfigArgs{Action: "unset"}
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SETTING"}))
+			})
+		})
+	})
+})
@@ -53,7 +53,9 @@ func (cmd *CreateBuildpackCommand) Setup(config command.Config, ui command.UI) e
 		return err
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
-	cmd.ProgressBar = v2action.NewProgressBar()
+	progressBar := v2action.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
 
 	return nil
 }
@@ -8,7 +8,8 @@ import (
 
 type CreateDomainCommand struct {
 	RequiredArgs    flag.OrgDomain `positional-args:"yes"`
-	usage           interface{}    `usage:"CF_NAME create-domain ORG DOMAIN"`
+	VerifyDNS       bool           `long:"verify-dns" description:"Check that DNS resolves host names on the domain to the routers of the foundation"`
+	usage           interface{}    `usage:"CF_NAME create-domain ORG DOMAIN [--verify-dns]\n\nTIP:\n   Set the router-cname or router-ips config setting to check that DNS resolves to the routers, rather than only that it resolves."`
 	relatedCommands interface{}    `related_commands:"create-shared-domain, domains, router-groups, share-private-domain"`
 }
 
@@ -14,12 +14,14 @@ import (
 type CreateOrgActor interface {
 	CreateOrganization(orgName string, quotaName string) (v2action.Organization, v2action.Warnings, error)
 	GrantOrgManagerByUsername(guid string, username string) (v2action.Warnings, error)
+	PollOrganizationVisible(org v2action.Organization) (v2action.Warnings, error)
 }
 
 type CreateOrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	Quota           string            `short:"q" description:"Quota to assign to the newly created org (excluding this option results in assignment of default quota)"`
-	usage           interface{}       `usage:"CF_NAME create-org ORG"`
+	Consistent      bool              `long:"consistent" description:"Wait until the new org is returned by the API before returning"`
+	usage           interface{}       `usage:"CF_NAME create-org ORG [--consistent]"`
 	relatedCommands interface{}       `related_commands:"create-space, orgs, quotas, set-org-role"`
 
 	UI          command.UI
@@ -92,6 +94,18 @@ func (cmd CreateOrgCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if cmd.Consistent {
+		cmd.UI.DisplayText("Waiting for org {{.OrgName}} to be visible...", map[string]interface{}{
+			"OrgName": orgName,
+		})
+		warnings, err = cmd.Actor.PollOrganizationVisible(v2action.Organization{GUID: org.GUID, Name: orgName})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+	}
+
 	cmd.UI.DisplayText(`TIP: Use 'cf target -o "{{.OrgName}}"' to target new org`,
 		map[string]interface{}{
 			"OrgName": orgName,
@@ -139,6 +139,45 @@ var _ = Describe("CreateOrgCommand", func() {
 					})
 				})
 
+				When("--consistent is passed", func() {
+					BeforeEach(func() {
+						cmd.Consistent = true
+					})
+
+					When("the org becomes visible", func() {
+						BeforeEach(func() {
+							fakeActor.PollOrganizationVisibleReturns(v2action.Warnings{"warn-poll"}, nil)
+						})
+
+						It("waits for the org to be visible before displaying the tip", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`Assigning role OrgManager to user %s in org %s\.\.\.`, username, orgName))
+							Expect(testUI.Out).To(Say(`Waiting for org %s to be visible\.\.\.`, orgName))
+							Expect(testUI.Err).To(Say("warn-poll\n"))
+							Expect(testUI.Out).To(Say("OK\n\n"))
+							Expect(testUI.Out).To(Say(`TIP: Use 'cf target -o "%s"' to target new org`, orgName))
+
+							Expect(fakeActor.PollOrganizationVisibleCallCount()).To(Equal(1))
+							Expect(fakeActor.PollOrganizationVisibleArgsForCall(0)).To(Equal(v2action.Organization{GUID: "fake-org-id", Name: orgName}))
+						})
+					})
+
+					When("the org does not become visible", func() {
+						BeforeEach(func() {
+							fakeActor.PollOrganizationVisibleReturns(nil, actionerror.ResourceVisibilityTimeoutError{Type: "org", Name: orgName})
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "org", Name: orgName}))
+							Expect(testUI.Out).ToNot(Say("TIP"))
+						})
+					})
+				})
+
+				It("does not wait for the org to be visible", func() {
+					Expect(fakeActor.PollOrganizationVisibleCallCount()).To(Equal(0))
+				})
+
 				When("making the user an org manager fails", func() {
 					BeforeEach(func() {
 						fakeActor.GrantOrgManagerByUsernameReturns(
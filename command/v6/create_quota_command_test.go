@@ -0,0 +1,112 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-quota Command", func() {
+	var (
+		cmd             CreateQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeCreateQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeCreateQuotaActor)
+
+		cmd = CreateQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Quota = "small"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLogRateLimitV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the log rate limit is set", func() {
+		BeforeEach(func() {
+			cmd.LogRateLimit = flag.BytesWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: 1024}}
+		})
+
+		When("the API version is below the log rate limit minimum", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionQuotasV3)
+			})
+
+			It("returns a MinimumCFAPIVersionNotMetError for the option", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+					Command:        "Option '-l'",
+					CurrentVersion: ccversion.MinVersionQuotasV3,
+					MinimumVersion: ccversion.MinVersionLogRateLimitV3,
+				}))
+				Expect(fakeActor.CreateOrganizationQuotaCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("creating the quota succeeds", func() {
+		BeforeEach(func() {
+			cmd.TotalMemory = flag.MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: 2048}}
+			cmd.IndividualAppInstanceMemory = flag.MemoryWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: -1}}
+			cmd.PerAppTasks = flag.IntegerLimit{NullInt: types.NullInt{IsSet: true, Value: 4}}
+			cmd.AllowPaidServicePlans = true
+			fakeActor.CreateOrganizationQuotaReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("creates the quota with only the given limits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating quota small as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.CreateOrganizationQuotaCallCount()).To(Equal(1))
+			name, limits := fakeActor.CreateOrganizationQuotaArgsForCall(0)
+			Expect(name).To(Equal("small"))
+			Expect(limits.Apps.TotalMemory).To(Equal(&types.NullInt{IsSet: true, Value: 2048}))
+			Expect(limits.Apps.InstanceMemory).To(Equal(&types.NullInt{}))
+			Expect(limits.Apps.PerAppTasks).To(Equal(&types.NullInt{IsSet: true, Value: 4}))
+			Expect(limits.Apps.LogRateLimit).To(BeNil())
+			Expect(limits.Routes.TotalRoutes).To(BeNil())
+			Expect(*limits.Services.PaidServicePlans).To(BeTrue())
+		})
+	})
+
+	When("creating the quota fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateOrganizationQuotaReturns(v3action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 type CreateRouteActor interface {
 	CloudControllerAPIVersion() string
 	CreateRouteWithExistenceCheck(orgGUID string, spaceName string, route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	PollRouteVisible(route v2action.Route) (v2action.Warnings, error)
 }
 
 type CreateRouteCommand struct {
@@ -23,7 +24,8 @@ type CreateRouteCommand struct {
 	Path            string           `long:"path" description:"Path for the HTTP route"`
 	Port            flag.Port        `long:"port" description:"Port for the TCP route"`
 	RandomPort      bool             `long:"random-port" description:"Create a random port for the TCP route"`
-	usage           interface{}      `usage:"Create an HTTP route:\n      CF_NAME create-route SPACE DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Create a TCP route:\n      CF_NAME create-route SPACE DOMAIN (--port PORT | --random-port)\n\nEXAMPLES:\n   CF_NAME create-route my-space example.com                             # example.com\n   CF_NAME create-route my-space example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route my-space example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route my-space example.com --port 5000                 # example.com:5000"`
+	Consistent      bool             `long:"consistent" description:"Wait until the new route is returned by the API before returning"`
+	usage           interface{}      `usage:"Create an HTTP route:\n      CF_NAME create-route SPACE DOMAIN [--hostname HOSTNAME] [--path PATH] [--consistent]\n\n   Create a TCP route:\n      CF_NAME create-route SPACE DOMAIN (--port PORT | --random-port) [--consistent]\n\nEXAMPLES:\n   CF_NAME create-route my-space example.com                             # example.com\n   CF_NAME create-route my-space example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route my-space example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route my-space example.com --port 5000                 # example.com:5000"`
 	relatedCommands interface{}      `related_commands:"check-route, domains, map-route"`
 
 	UI          command.UI
@@ -96,6 +98,18 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayOK()
 
+	if cmd.Consistent {
+		cmd.UI.DisplayText("Waiting for route {{.Route}} to be visible...", map[string]interface{}{
+			"Route": createdRoute,
+		})
+		warnings, err = cmd.Actor.PollRouteVisible(createdRoute)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+	}
+
 	return nil
 }
 
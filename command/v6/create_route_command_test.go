@@ -150,6 +150,58 @@ var _ = Describe("Create Route Command", func() {
 				})
 			})
 
+			When("--consistent is provided", func() {
+				var createdRoute v2action.Route
+
+				BeforeEach(func() {
+					cmd.Consistent = true
+					createdRoute = v2action.Route{
+						GUID:   "some-route-guid",
+						Domain: v2action.Domain{Name: "some-domain"},
+					}
+					fakeActor.CreateRouteWithExistenceCheckReturns(createdRoute, nil, nil)
+				})
+
+				When("the route becomes visible", func() {
+					BeforeEach(func() {
+						fakeActor.PollRouteVisibleReturns(v2action.Warnings{"poll-warning"}, nil)
+					})
+
+					It("waits for the created route to be visible", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`Route some-domain has been created\.`))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).To(Say(`Waiting for route some-domain to be visible\.\.\.`))
+						Expect(testUI.Err).To(Say("poll-warning"))
+						Expect(testUI.Out).To(Say("OK"))
+
+						Expect(fakeActor.PollRouteVisibleCallCount()).To(Equal(1))
+						Expect(fakeActor.PollRouteVisibleArgsForCall(0)).To(Equal(createdRoute))
+					})
+				})
+
+				When("the route does not become visible", func() {
+					BeforeEach(func() {
+						fakeActor.PollRouteVisibleReturns(nil, actionerror.ResourceVisibilityTimeoutError{Type: "route", Name: "some-domain"})
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "route", Name: "some-domain"}))
+					})
+				})
+
+				When("the route already exists", func() {
+					BeforeEach(func() {
+						fakeActor.CreateRouteWithExistenceCheckReturns(v2action.Route{}, nil, actionerror.RouteAlreadyExistsError{})
+					})
+
+					It("does not wait for the route", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.PollRouteVisibleCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("host and path flags are provided", func() {
 				BeforeEach(func() {
 					cmd.Hostname = "some-host"
@@ -1,6 +1,10 @@
 package v6
 
 import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -15,6 +19,8 @@ import (
 
 type CreateServiceActor interface {
 	CreateServiceInstance(spaceGUID, serviceName, servicePlanName, serviceInstanceName, brokerName string, params map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServicesSummariesForSpace(spaceGUID string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	PollServiceInstanceOperation(instance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
 }
 
 type CreateServiceCommand struct {
@@ -22,7 +28,10 @@ type CreateServiceCommand struct {
 	ServiceBroker    string                        `short:"b" description:"Create a service instance from a particular broker. Required when service name is ambiguous"`
 	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
 	Tags             flag.Tags                     `short:"t" description:"User provided tags"`
-	usage            interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\""`
+	Wait             bool                          `long:"wait" description:"Wait for the service instance to be created before returning"`
+	Timeout          flag.Timeout                  `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes. Requires --wait"`
+	Interactive      bool                          `long:"interactive" description:"Choose the service offering and plan, and enter the parameters described by the plan's schema, interactively"`
+	usage            interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--timeout TIMEOUT]]\n   CF_NAME create-service --interactive [SERVICE [PLAN [SERVICE_INSTANCE]]] [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--timeout TIMEOUT]]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\""`
 	relatedCommands  interface{}                   `related_commands:"bind-service, create-user-provided-service, marketplace, services"`
 
 	UI          command.UI
@@ -34,6 +43,9 @@ type CreateServiceCommand struct {
 func (cmd *CreateServiceCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 
 	cmd.SharedActor = sharedaction.NewActor(config)
 
@@ -54,6 +66,19 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Timeout.Value != 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--timeout",
+			Arg2: "--wait",
+		}
+	}
+
+	if !cmd.Interactive {
+		if err := cmd.checkRequiredArgs(); err != nil {
+			return err
+		}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
@@ -63,6 +88,12 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Interactive {
+		if err := cmd.promptForServiceInstance(); err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Creating service instance {{.ServiceInstance}} in org {{.Org}} / space {{.Space}} as {{.User}}...",
 		map[string]interface{}{
 			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
@@ -71,6 +102,7 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 			"User":            user.Name,
 		})
 
+	progress := shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "creating service instance "+cmd.RequiredArgs.ServiceInstance)
 	instance, warnings, err := cmd.Actor.CreateServiceInstance(
 		cmd.Config.TargetedSpace().GUID,
 		cmd.RequiredArgs.Service,
@@ -95,6 +127,17 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if instance.LastOperation.State == constant.LastOperationInProgress && cmd.Wait {
+		progress.Step("waiting for service instance " + cmd.RequiredArgs.ServiceInstance + " to be created")
+		cmd.UI.DisplayText("Waiting for the operation to complete...")
+
+		instance, warnings, err = cmd.Actor.PollServiceInstanceOperation(instance)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return progress.Wrap(err)
+		}
+	}
+
 	if instance.LastOperation.State == constant.LastOperationInProgress {
 		cmd.UI.DisplayOK()
 		cmd.UI.DisplayTextWithFlavor("Create in progress. Use 'cf services' or 'cf service {{.ServiceInstance}}' to check operation status.",
@@ -107,3 +150,197 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	return nil
 }
+
+func (cmd CreateServiceCommand) checkRequiredArgs() error {
+	switch {
+	case cmd.RequiredArgs.Service == "":
+		return translatableerror.ThreeRequiredArgumentsError{
+			ArgumentName1: "SERVICE",
+			ArgumentName2: "SERVICE_PLAN",
+			ArgumentName3: "SERVICE_INSTANCE",
+		}
+	case cmd.RequiredArgs.ServicePlan == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_PLAN"}
+	case cmd.RequiredArgs.ServiceInstance == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "SERVICE_INSTANCE"}
+	}
+	return nil
+}
+
+// promptForServiceInstance asks for the offering, plan and instance name that
+// were not given as arguments and, unless -c was given, for the parameters
+// described by the plan's schema. Invalid parameter values are rejected
+// before anything is sent to the broker.
+func (cmd *CreateServiceCommand) promptForServiceInstance() error {
+	summaries, warnings, err := cmd.Actor.GetServicesSummariesForSpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.ServiceBroker != "" {
+		fromBroker := []v2action.ServiceSummary{}
+		for _, summary := range summaries {
+			if summary.ServiceBrokerName == cmd.ServiceBroker {
+				fromBroker = append(fromBroker, summary)
+			}
+		}
+		summaries = fromBroker
+	}
+	if len(summaries) == 0 {
+		return translatableerror.NoServiceOfferingsError{}
+	}
+
+	offeringNames := []string{}
+	for _, summary := range summaries {
+		offeringNames = append(offeringNames, summary.Label+" ("+summary.ServiceBrokerName+"): "+summary.Description)
+	}
+	offering, err := cmd.choose("Service offering", cmd.RequiredArgs.Service, offeringNames, func(i int) string { return summaries[i].Label })
+	if err != nil {
+		return err
+	}
+	if offering < 0 {
+		return actionerror.ServiceNotFoundError{Name: cmd.RequiredArgs.Service}
+	}
+	summary := summaries[offering]
+	cmd.RequiredArgs.Service = summary.Label
+	cmd.ServiceBroker = summary.ServiceBrokerName
+
+	planNames := []string{}
+	for _, plan := range summary.Plans {
+		planNames = append(planNames, plan.Name+" ("+formatFreeOrPaid(plan.Free)+"): "+plan.Description)
+	}
+	planIndex, err := cmd.choose("Service plan", cmd.RequiredArgs.ServicePlan, planNames, func(i int) string { return summary.Plans[i].Name })
+	if err != nil {
+		return err
+	}
+	if planIndex < 0 {
+		return actionerror.ServicePlanNotFoundError{PlanName: cmd.RequiredArgs.ServicePlan, ServiceName: summary.Label}
+	}
+	plan := summary.Plans[planIndex]
+	cmd.RequiredArgs.ServicePlan = plan.Name
+
+	if cmd.RequiredArgs.ServiceInstance == "" {
+		cmd.RequiredArgs.ServiceInstance, err = cmd.UI.DisplayTextPrompt("Service instance name")
+		if err != nil {
+			return err
+		}
+		cmd.RequiredArgs.ServiceInstance = strings.TrimSpace(cmd.RequiredArgs.ServiceInstance)
+	}
+
+	if cmd.ParametersAsJSON != nil {
+		return nil
+	}
+	parameters, err := v2action.ServicePlan(plan.ServicePlan).CreateParameters()
+	if err != nil {
+		return err
+	}
+	if len(parameters) > 0 {
+		cmd.ParametersAsJSON, err = cmd.promptForParameters(parameters)
+	}
+	return err
+}
+
+// choose returns the index of the option named given. When nothing is given,
+// the options are listed and the user picks one by number or by name. It
+// returns -1 when the given name matches no option.
+func (cmd *CreateServiceCommand) choose(prompt string, given string, options []string, nameOf func(int) string) (int, error) {
+	find := func(name string) int {
+		for i := range options {
+			if nameOf(i) == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	if given != "" {
+		return find(given), nil
+	}
+
+	cmd.UI.DisplayNewline()
+	for i, option := range options {
+		cmd.UI.DisplayText("{{.Number}}. {{.Option}}", map[string]interface{}{
+			"Number": i + 1,
+			"Option": option,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	for {
+		answer, err := cmd.UI.DisplayTextPrompt(prompt + " (enter a number or name)")
+		if err != nil {
+			return -1, err
+		}
+		answer = strings.TrimSpace(answer)
+
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(options) {
+			return number - 1, nil
+		}
+		if i := find(answer); i >= 0 {
+			return i, nil
+		}
+		cmd.UI.DisplayWarning("'{{.Answer}}' is not one of the listed choices.", map[string]interface{}{
+			"Answer": answer,
+		})
+	}
+}
+
+// promptForParameters asks for a value for every parameter until it is valid.
+// Optional parameters left empty are omitted and those with a default use it.
+func (cmd *CreateServiceCommand) promptForParameters(parameters []v2action.ServicePlanParameter) (map[string]interface{}, error) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Enter the parameters of the service instance. Leave optional parameters empty to omit them.")
+
+	params := map[string]interface{}{}
+	for _, parameter := range parameters {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithBold(parameter.Name)
+		if parameter.Description != "" {
+			cmd.UI.DisplayText("  {{.Description}}", map[string]interface{}{"Description": parameter.Description})
+		}
+		if len(parameter.Enum) > 0 {
+			cmd.UI.DisplayText("  One of: {{.Values}}", map[string]interface{}{"Values": strings.Join(parameter.EnumValues(), ", ")})
+		}
+
+		prompt := parameter.Name
+		if parameter.Type != "" {
+			prompt += " (" + parameter.Type + ")"
+		}
+
+		for {
+			var (
+				answer string
+				err    error
+			)
+			switch {
+			case parameter.HasDefault:
+				answer, err = cmd.UI.DisplayOptionalTextPrompt(v2action.FormatServicePlanParameterValue(parameter.Default), prompt)
+			case parameter.Required:
+				answer, err = cmd.UI.DisplayTextPrompt(prompt)
+			default:
+				answer, err = cmd.UI.DisplayOptionalTextPrompt("", prompt)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if answer == "" {
+				if parameter.Required {
+					cmd.UI.DisplayWarning("Parameter '{{.Name}}' is required.", map[string]interface{}{"Name": parameter.Name})
+					continue
+				}
+				break
+			}
+
+			value, err := parameter.Parse(answer)
+			if err != nil {
+				cmd.UI.DisplayWarning(err.Error())
+				continue
+			}
+			params[parameter.Name] = value
+			break
+		}
+	}
+	return params, nil
+}
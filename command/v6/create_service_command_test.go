@@ -2,7 +2,9 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -54,6 +56,20 @@ var _ = Describe("create-service Command", func() {
 		executeErr = cmd.Execute(extraArgs)
 	})
 
+	When("--timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout.Value = time.Minute
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--timeout",
+				Arg2: "--wait",
+			}))
+			Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the user provides extra arguments", func() {
 		BeforeEach(func() {
 			extraArgs = []string{"some-extra-arg"}
@@ -168,6 +184,54 @@ var _ = Describe("create-service Command", func() {
 					Expect(testUI.Out).To(Say("OK"))
 					Expect(testUI.Out).To(Say("Create in progress\\. Use 'cf services' or 'cf service cool-service' to check operation status\\."))
 				})
+
+				When("--wait is provided", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeActor.PollServiceInstanceOperationReturns(
+							v2action.ServiceInstance{Name: "cool-service", LastOperation: ccv2.LastOperation{State: constant.LastOperationSucceeded}},
+							v2action.Warnings{"poll-warning"},
+							nil,
+						)
+					})
+
+					It("waits for the service instance to be created", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`Waiting for the operation to complete\.\.\.`))
+						Expect(testUI.Err).To(Say("poll-warning"))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).NotTo(Say("Create in progress"))
+
+						Expect(fakeActor.PollServiceInstanceOperationCallCount()).To(Equal(1))
+						Expect(fakeActor.PollServiceInstanceOperationArgsForCall(0).LastOperation.State).To(Equal(constant.LastOperationInProgress))
+					})
+
+					When("the operation fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollServiceInstanceOperationReturns(v2action.ServiceInstance{}, nil, actionerror.ServiceInstanceOperationFailedError{Name: "cool-service", Description: "nope"})
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{Name: "cool-service", Description: "nope"}))
+						})
+					})
+
+					When("the --timeout deadline passes while waiting", func() {
+						BeforeEach(func() {
+							cmd.Timeout.Value = 10 * time.Minute
+							fakeConfig.CommandDeadlineReturns(time.Now())
+							fakeActor.PollServiceInstanceOperationReturns(v2action.ServiceInstance{}, nil, actionerror.ServiceInstanceOperationTimeoutError{Name: "cool-service"})
+						})
+
+						It("returns a CommandTimeoutError describing the progress made", func() {
+							Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+								Timeout:    10 * time.Minute,
+								Completed:  []string{"creating service instance cool-service"},
+								InProgress: "waiting for service instance cool-service to be created",
+							}))
+						})
+					})
+				})
 			})
 		})
 
@@ -212,6 +276,165 @@ var _ = Describe("create-service Command", func() {
 		})
 	})
 
+	When("the arguments are not provided and --interactive is not passed", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.CreateServiceArgs{}
+		})
+
+		It("returns a ThreeRequiredArgumentsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ThreeRequiredArgumentsError{
+				ArgumentName1: "SERVICE",
+				ArgumentName2: "SERVICE_PLAN",
+				ArgumentName3: "SERVICE_INSTANCE",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--interactive is passed", func() {
+		var input *Buffer
+
+		BeforeEach(func() {
+			input = NewBuffer()
+			testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+			cmd.UI = testUI
+			cmd.Interactive = true
+			cmd.RequiredArgs = flag.CreateServiceArgs{}
+
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeActor.CreateServiceInstanceReturns(v2action.ServiceInstance{LastOperation: ccv2.LastOperation{State: constant.LastOperationSucceeded}}, nil, nil)
+			fakeActor.GetServicesSummariesForSpaceReturns([]v2action.ServiceSummary{
+				{
+					Service: v2action.Service{Label: "redis", Description: "In-memory store", ServiceBrokerName: "broker-a"},
+					Plans:   []v2action.ServicePlanSummary{{ServicePlan: v2action.ServicePlan{Name: "small"}}},
+				},
+				{
+					Service: v2action.Service{Label: "postgres", Description: "A database", ServiceBrokerName: "broker-b"},
+					Plans: []v2action.ServicePlanSummary{
+						{ServicePlan: v2action.ServicePlan{Name: "shared", Free: true}},
+						{ServicePlan: v2action.ServicePlan{
+							Name: "dedicated",
+							CreateParametersSchema: []byte(`{
+								"properties": {
+									"storage_gb": {"type": "integer", "description": "Disk size"},
+									"version": {"type": "string", "enum": ["10", "11"], "default": "11"},
+									"backups": {"type": "boolean"}
+								},
+								"required": ["storage_gb"]
+							}`),
+						}},
+					},
+				},
+			}, v2action.Warnings{"summary-warning"}, nil)
+		})
+
+		When("the user picks the offering and plan and enters the parameters", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("2\ndedicated\nmy-db\nlots\n20\n\n12\n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("creates the chosen service instance with the validated parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetServicesSummariesForSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+				Expect(testUI.Out).To(Say(`1\. redis \(broker-a\): In-memory store`))
+				Expect(testUI.Out).To(Say(`2\. postgres \(broker-b\): A database`))
+				Expect(testUI.Out).To(Say(`1\. shared \(free\)`))
+				Expect(testUI.Out).To(Say(`2\. dedicated \(paid\)`))
+				Expect(testUI.Err).To(Say("Invalid value 'lots' for parameter 'storage_gb': expected an integer."))
+				Expect(testUI.Out).To(Say("One of: 10, 11"))
+				Expect(testUI.Err).To(Say("Invalid value '12' for parameter 'version': expected one of 10, 11."))
+				Expect(testUI.Out).To(Say("Creating service instance my-db in org"))
+
+				Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(1))
+				spaceGUID, service, plan, instance, broker, params, _ := fakeActor.CreateServiceInstanceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(service).To(Equal("postgres"))
+				Expect(plan).To(Equal("dedicated"))
+				Expect(instance).To(Equal("my-db"))
+				Expect(broker).To(Equal("broker-b"))
+				Expect(params).To(Equal(map[string]interface{}{
+					"storage_gb": int64(20),
+					"version":    "11",
+				}))
+			})
+		})
+
+		When("the offering and plan are given as arguments", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.CreateServiceArgs{Service: "postgres", ServicePlan: "shared"}
+				_, err := input.Write([]byte("my-db\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only prompts for what is missing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, service, plan, instance, _, params, _ := fakeActor.CreateServiceInstanceArgsForCall(0)
+				Expect(service).To(Equal("postgres"))
+				Expect(plan).To(Equal("shared"))
+				Expect(instance).To(Equal("my-db"))
+				Expect(params).To(BeEmpty())
+			})
+		})
+
+		When("the given offering does not exist", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.CreateServiceArgs{Service: "mysql"}
+			})
+
+			It("returns a ServiceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "mysql"}))
+				Expect(fakeActor.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the given plan does not exist", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.CreateServiceArgs{Service: "postgres", ServicePlan: "huge"}
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "huge", ServiceName: "postgres"}))
+			})
+		})
+
+		When("parameters are given with -c", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.CreateServiceArgs{Service: "postgres", ServicePlan: "dedicated", ServiceInstance: "my-db"}
+				cmd.ParametersAsJSON = map[string]interface{}{"storage_gb": 5}
+			})
+
+			It("does not prompt for parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, _, _, params, _ := fakeActor.CreateServiceInstanceArgsForCall(0)
+				Expect(params).To(Equal(map[string]interface{}{"storage_gb": 5}))
+			})
+		})
+
+		When("the broker has no offerings in the space", func() {
+			BeforeEach(func() {
+				cmd.ServiceBroker = "broker-c"
+			})
+
+			It("returns a NoServiceOfferingsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoServiceOfferingsError{}))
+			})
+		})
+
+		When("getting the offerings fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServicesSummariesForSpaceReturns(nil, v2action.Warnings{"summary-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+	})
+
 	When("checking the target returns an error", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(errors.New("explode"))
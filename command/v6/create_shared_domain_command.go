@@ -61,8 +61,8 @@ func (cmd CreateSharedDomainCommand) Execute(args []string) error {
 	}
 
 	if cmd.Internal {
-		currentVersion := cmd.Actor.CloudControllerAPIVersion()
-		err := command.MinimumCCAPIVersionCheck(currentVersion, ccversion.MinVersionInternalDomainV2, "Option '--internal'")
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		err := gate.Require("Option '--internal'", ccversion.MinVersionInternalDomainV2)
 		if err != nil {
 			return err
 		}
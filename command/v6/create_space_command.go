@@ -15,13 +15,15 @@ type CreateSpaceActor interface {
 	CreateSpace(spaceName, orgName, quotaName string) (v2action.Space, v2action.Warnings, error)
 	GrantSpaceManagerByUsername(orgGUID string, spaceGUID string, username string) (v2action.Warnings, error)
 	GrantSpaceDeveloperByUsername(spaceGUID string, username string) (v2action.Warnings, error)
+	PollSpaceVisible(space v2action.Space) (v2action.Warnings, error)
 }
 
 type CreateSpaceCommand struct {
 	RequiredArgs    flag.Space  `positional-args:"yes"`
 	Organization    string      `short:"o" description:"Organization"`
 	Quota           string      `short:"q" description:"Quota to assign to the newly created space"`
-	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA]"`
+	Consistent      bool        `long:"consistent" description:"Wait until the new space is returned by the API before returning"`
+	usage           interface{} `usage:"CF_NAME create-space SPACE [-o ORG] [-q SPACE_QUOTA] [--consistent]"`
 	relatedCommands interface{} `related_commands:"set-space-isolation-segment, space-quotas, spaces, target"`
 
 	UI          command.UI
@@ -112,6 +114,19 @@ func (cmd CreateSpaceCommand) Execute(args []string) error {
 	}
 
 	cmd.UI.DisplayOK()
+
+	if cmd.Consistent {
+		cmd.UI.DisplayText("Waiting for space {{.Space}} to be visible...", map[string]interface{}{
+			"Space": spaceName,
+		})
+		warnings, err = cmd.Actor.PollSpaceVisible(space)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayOK()
+	}
+
 	cmd.UI.DisplayText(`TIP: Use 'cf target -o "{{.Org}}" -s "{{.Space}}"' to target new space`, map[string]interface{}{
 		"Org":   orgName,
 		"Space": spaceName,
@@ -202,6 +202,44 @@ var _ = Describe("CreateSpaceCommand", func() {
 								Expect(testUI.Err).To(Say("space-developer-warning"))
 								Expect(testUI.Err).To(Say("other-warning"))
 							})
+
+							It("does not wait for the space to be visible", func() {
+								Expect(fakeActor.PollSpaceVisibleCallCount()).To(Equal(0))
+							})
+
+							When("--consistent is passed", func() {
+								BeforeEach(func() {
+									cmd.Consistent = true
+								})
+
+								When("the space becomes visible", func() {
+									BeforeEach(func() {
+										fakeActor.PollSpaceVisibleReturns(v2action.Warnings{"poll-warning"}, nil)
+									})
+
+									It("waits for the space to be visible before displaying the tip", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Out).To(Say(`Waiting for space %s to be visible\.\.\.`, spaceName))
+										Expect(testUI.Err).To(Say("poll-warning"))
+										Expect(testUI.Out).To(Say("OK"))
+										Expect(testUI.Out).To(Say("TIP: Use 'cf target"))
+
+										Expect(fakeActor.PollSpaceVisibleCallCount()).To(Equal(1))
+										Expect(fakeActor.PollSpaceVisibleArgsForCall(0)).To(Equal(v2action.Space{GUID: "some-space-guid", OrganizationGUID: "some-org-guid"}))
+									})
+								})
+
+								When("the space does not become visible", func() {
+									BeforeEach(func() {
+										fakeActor.PollSpaceVisibleReturns(nil, actionerror.ResourceVisibilityTimeoutError{Type: "space", Name: spaceName})
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError(actionerror.ResourceVisibilityTimeoutError{Type: "space", Name: spaceName}))
+										Expect(testUI.Out).ToNot(Say("TIP"))
+									})
+								})
+							})
 						})
 
 						When("making the user a space developer fails", func() {
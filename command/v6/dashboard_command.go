@@ -0,0 +1,94 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/browser"
+)
+
+//go:generate counterfeiter . DashboardActor
+
+type DashboardActor interface {
+	GetServiceInstanceDashboardURLByNameAndSpace(name string, spaceGUID string) (string, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . Browser
+
+type Browser interface {
+	Open(url string) error
+}
+
+type DashboardCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	URLOnly         bool                 `long:"url" description:"Display the dashboard URL without opening a browser"`
+	usage           interface{}          `usage:"CF_NAME dashboard SERVICE_INSTANCE [--url]\n\nTIP:\n   Service brokers that support single sign-on ask you to log in with your CF_NAME credentials the first time the dashboard opens."`
+	relatedCommands interface{}          `related_commands:"service, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DashboardActor
+	Browser     Browser
+}
+
+func (cmd *DashboardCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.Browser = browser.Browser{}
+
+	return nil
+}
+
+func (cmd DashboardCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	dashboardURL, warnings, err := cmd.Actor.GetServiceInstanceDashboardURLByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.URLOnly {
+		cmd.UI.DisplayText(dashboardURL)
+		return nil
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Opening dashboard of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             cmd.Config.TargetedOrganization().Name,
+		"SpaceName":           cmd.Config.TargetedSpace().Name,
+		"UserName":            user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	err = cmd.Browser.Open(dashboardURL)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to open a browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("dashboard:"), dashboardURL},
+	}, 3)
+
+	return nil
+}
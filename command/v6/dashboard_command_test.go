@@ -0,0 +1,128 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("dashboard Command", func() {
+	var (
+		cmd             DashboardCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeDashboardActor
+		fakeBrowser     *v6fakes.FakeBrowser
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeDashboardActor)
+		fakeBrowser = new(v6fakes.FakeBrowser)
+
+		cmd = DashboardCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Browser:     fakeBrowser,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the service instance has no dashboard", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceDashboardURLByNameAndSpaceReturns("", v2action.Warnings{"get-warning"}, actionerror.ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"})
+		})
+
+		It("returns the error and does not open a browser", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceInstanceDashboardUnavailableError{Name: "some-service-instance"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeBrowser.OpenCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the service instance has a dashboard", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceDashboardURLByNameAndSpaceReturns("https://dashboard.example.com", v2action.Warnings{"get-warning"}, nil)
+		})
+
+		It("opens the dashboard in a browser", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			name, spaceGUID := fakeActor.GetServiceInstanceDashboardURLByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Opening dashboard of service some-service-instance in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`dashboard:\s+https://dashboard\.example\.com`))
+			Expect(testUI.Err).To(Say("get-warning"))
+
+			Expect(fakeBrowser.OpenCallCount()).To(Equal(1))
+			Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://dashboard.example.com"))
+		})
+
+		When("the browser cannot be opened", func() {
+			BeforeEach(func() {
+				fakeBrowser.OpenReturns(errors.New("no browser"))
+			})
+
+			It("warns and still displays the dashboard URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser: no browser"))
+				Expect(testUI.Out).To(Say(`dashboard:\s+https://dashboard\.example\.com`))
+			})
+		})
+
+		When("the --url flag is provided", func() {
+			BeforeEach(func() {
+				cmd.URLOnly = true
+			})
+
+			It("displays only the dashboard URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("https://dashboard.example.com"))
+				Expect(testUI.Out).ToNot(Say("Opening dashboard"))
+				Expect(fakeBrowser.OpenCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
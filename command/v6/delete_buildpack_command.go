@@ -41,11 +41,8 @@ func (cmd *DeleteBuildpackCommand) Setup(config command.Config, ui command.UI) e
 
 func (cmd DeleteBuildpackCommand) Execute(args []string) error {
 	if cmd.stackSpecified() {
-		err := command.MinimumCCAPIVersionCheck(
-			cmd.Actor.CloudControllerAPIVersion(),
-			ccversion.MinVersionBuildpackStackAssociationV2,
-			"Option '-s'",
-		)
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		err := gate.Require("Option '-s'", ccversion.MinVersionBuildpackStackAssociationV2)
 		if err != nil {
 			return err
 		}
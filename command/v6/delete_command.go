@@ -1,23 +1,240 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+//go:generate counterfeiter . DeleteActor
+
+type DeleteActor interface {
+	DeleteApplication(appGUID string) (v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (v2action.Warnings, error)
+	GetApplicationDeletion(appName string, spaceGUID string) (v2action.ApplicationDeletion, v2action.Warnings, error)
+}
+
 type DeleteCommand struct {
-	RequiredArgs       flag.AppName `positional-args:"yes"`
-	ForceDelete        bool         `short:"f" description:"Force deletion without confirmation"`
-	DeleteMappedRoutes bool         `short:"r" description:"Also delete any mapped routes"`
-	usage              interface{}  `usage:"CF_NAME delete APP_NAME [-r] [-f]"`
-	relatedCommands    interface{}  `related_commands:"apps, scale, stop"`
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	ForceDelete         bool         `short:"f" description:"Force deletion without confirmation"`
+	DeleteMappedRoutes  bool         `short:"r" description:"Also delete any mapped routes"`
+	AndRoutes           bool         `long:"and-routes" description:"Also delete mapped routes that are not mapped to any other app"`
+	AndOrphanedServices bool         `long:"and-orphaned-services" description:"Also offer to delete service instances that are not bound to any other app"`
+	usage               interface{}  `usage:"CF_NAME delete APP_NAME [-r] [-f]\n   CF_NAME delete APP_NAME [--and-routes] [--and-orphaned-services] [-f]\n\n   --and-routes and --and-orphaned-services only remove resources that no other app uses, and\n   print a report of everything that was deleted. Without -f, each service instance is\n   confirmed separately. With the --dry-run global option, the report shows what would be\n   deleted.\n\nEXAMPLES:\n   CF_NAME delete my-app --and-routes --and-orphaned-services --dry-run"`
+	relatedCommands     interface{}  `related_commands:"apps, delete-orphaned-routes, scale, stop"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteActor
 }
 
-func (DeleteCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.cascading() {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd DeleteCommand) Execute(args []string) error {
+	if !cmd.cascading() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	if cmd.DeleteMappedRoutes {
+		return translatableerror.ArgumentCombinationError{
+			Args: append([]string{"-r"}, cmd.cascadingFlags()...),
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+
+	if !cmd.ForceDelete && !cmd.Config.DryRun() {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the app {{.AppName}}?", map[string]interface{}{
+			"AppName": appName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	template := "Deleting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	if cmd.Config.DryRun() {
+		template = "Checking what deleting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} would remove as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"AppName":   appName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	deletion, warnings, err := cmd.Actor.GetApplicationDeletion(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+			cmd.UI.DisplayWarning("App {{.AppName}} does not exist.", map[string]interface{}{
+				"AppName": appName,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	report := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("action"),
+		},
+	}
+
+	if cmd.Config.DryRun() {
+		cmd.displayDryRunReport(report, deletion)
+		return nil
+	}
+
+	report, err = cmd.deleteApplication(report, deletion)
+	cmd.displayReport(report)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
 	return nil
 }
 
-func (DeleteCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd DeleteCommand) deleteApplication(report [][]string, deletion v2action.ApplicationDeletion) ([][]string, error) {
+	warnings, err := cmd.Actor.DeleteApplication(deletion.Application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return report, err
+	}
+	report = append(report, cmd.reportRow("app", deletion.Application.Name, "deleted"))
+
+	if cmd.AndRoutes {
+		for _, route := range deletion.OrphanedRoutes {
+			warnings, err = cmd.Actor.DeleteRoute(route.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return report, err
+			}
+			report = append(report, cmd.reportRow("route", route.String(), "deleted"))
+		}
+	}
+
+	if cmd.AndOrphanedServices {
+		for _, instance := range deletion.OrphanedServiceInstances {
+			if !cmd.ForceDelete {
+				response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Service instance {{.ServiceInstanceName}} is no longer bound to any app. Delete it?", map[string]interface{}{
+					"ServiceInstanceName": instance.Name,
+				})
+				if promptErr != nil {
+					return report, promptErr
+				}
+
+				if !response {
+					report = append(report, cmd.reportRow("service instance", instance.Name, "kept"))
+					continue
+				}
+			}
+
+			warnings, err = cmd.Actor.DeleteServiceInstance(instance.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return report, err
+			}
+			report = append(report, cmd.reportRow("service instance", instance.Name, "deleted"))
+		}
+	}
+
+	return report, nil
+}
+
+func (cmd DeleteCommand) displayDryRunReport(report [][]string, deletion v2action.ApplicationDeletion) {
+	report = append(report, cmd.reportRow("app", deletion.Application.Name, "would delete"))
+
+	if cmd.AndRoutes {
+		for _, route := range deletion.OrphanedRoutes {
+			report = append(report, cmd.reportRow("route", route.String(), "would delete"))
+		}
+	}
+
+	if cmd.AndOrphanedServices {
+		action := "would ask to delete"
+		if cmd.ForceDelete {
+			action = "would delete"
+		}
+		for _, instance := range deletion.OrphanedServiceInstances {
+			report = append(report, cmd.reportRow("service instance", instance.Name, action))
+		}
+	}
+
+	cmd.displayReport(report)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Dry run: nothing was deleted.")
+}
+
+func (cmd DeleteCommand) displayReport(report [][]string) {
+	if len(report) == 1 {
+		return
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", report, ui.DefaultTableSpacePadding)
+}
+
+func (cmd DeleteCommand) reportRow(resource string, name string, action string) []string {
+	return []string{cmd.UI.TranslateText(resource), name, cmd.UI.TranslateText(action)}
+}
+
+// cascading returns true when any of the flags handled by the refactored
+// command are provided.
+func (cmd DeleteCommand) cascading() bool {
+	return len(cmd.cascadingFlags()) > 0
+}
+
+func (cmd DeleteCommand) cascadingFlags() []string {
+	var flags []string
+	if cmd.AndRoutes {
+		flags = append(flags, "--and-routes")
+	}
+	if cmd.AndOrphanedServices {
+		flags = append(flags, "--and-orphaned-services")
+	}
+	return flags
 }
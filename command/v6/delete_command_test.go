@@ -0,0 +1,228 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete Command", func() {
+	var (
+		cmd             DeleteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeDeleteActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeDeleteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DeleteCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationDeletionReturns(
+			v2action.ApplicationDeletion{
+				Application: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+				OrphanedRoutes: []v2action.Route{
+					{GUID: "some-route-guid", Host: "some-app", Domain: v2action.Domain{Name: "example.com"}},
+				},
+				OrphanedServiceInstances: []v2action.ServiceInstance{
+					{GUID: "some-instance-guid", Name: "some-db"},
+				},
+			},
+			v2action.Warnings{"get-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("none of the cascading flags are provided", func() {
+		It("returns an UnrefactoredCommandError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+	})
+
+	When("-r is combined with a cascading flag", func() {
+		BeforeEach(func() {
+			cmd.DeleteMappedRoutes = true
+			cmd.AndRoutes = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"-r", "--and-routes"},
+			}))
+		})
+	})
+
+	When("cascading flags are provided", func() {
+		BeforeEach(func() {
+			cmd.AndRoutes = true
+			cmd.AndOrphanedServices = true
+		})
+
+		When("checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("the user declines to delete the app", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.GetApplicationDeletionCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user confirms the app deletion", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+				fakeActor.DeleteApplicationReturns(v2action.Warnings{"delete-app-warning"}, nil)
+				fakeActor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+				fakeActor.DeleteServiceInstanceReturns(v2action.Warnings{"delete-instance-warning"}, nil)
+			})
+
+			When("the user confirms the service instance deletion", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("deletes the app, its orphaned routes and service instance, and reports them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`Deleting app some-app in org some-org / space some-space as steve\.\.\.`))
+					Expect(testUI.Out).To(Say(`Service instance some-db is no longer bound to any app\. Delete it\?`))
+					Expect(testUI.Out).To(Say(`resource\s+name\s+action`))
+					Expect(testUI.Out).To(Say(`app\s+some-app\s+deleted`))
+					Expect(testUI.Out).To(Say(`route\s+some-app\.example\.com\s+deleted`))
+					Expect(testUI.Out).To(Say(`service instance\s+some-db\s+deleted`))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(testUI.Err).To(Say("get-warning"))
+					Expect(testUI.Err).To(Say("delete-app-warning"))
+					Expect(testUI.Err).To(Say("delete-route-warning"))
+					Expect(testUI.Err).To(Say("delete-instance-warning"))
+
+					appName, spaceGUID := fakeActor.GetApplicationDeletionArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(fakeActor.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+					Expect(fakeActor.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-instance-guid"))
+				})
+			})
+
+			When("the user declines the service instance deletion", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps the service instance and reports it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`service instance\s+some-db\s+kept`))
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("deleting a route fails", func() {
+				BeforeEach(func() {
+					fakeActor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, errors.New("route error"))
+				})
+
+				It("reports what was deleted and returns the error", func() {
+					Expect(executeErr).To(MatchError("route error"))
+					Expect(testUI.Out).To(Say(`app\s+some-app\s+deleted`))
+					Expect(testUI.Out).ToNot(Say("route"))
+					Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				cmd.ForceDelete = true
+				fakeActor.GetApplicationDeletionReturns(v2action.ApplicationDeletion{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("displays that the app does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("App some-app does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --dry-run global option is provided", func() {
+			BeforeEach(func() {
+				fakeConfig.DryRunReturns(true)
+			})
+
+			It("reports what would be deleted without prompting or deleting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Checking what deleting app some-app in org some-org / space some-space would remove as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`app\s+some-app\s+would delete`))
+				Expect(testUI.Out).To(Say(`route\s+some-app\.example\.com\s+would delete`))
+				Expect(testUI.Out).To(Say(`service instance\s+some-db\s+would ask to delete`))
+				Expect(testUI.Out).To(Say("Dry run: nothing was deleted."))
+
+				Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -12,13 +12,17 @@ import (
 //go:generate counterfeiter . DeleteOrganizationActor
 
 type DeleteOrganizationActor interface {
-	DeleteOrganization(orgName string) (v2action.Warnings, error)
+	GetOrganizationDeletionReport(orgName string) (v2action.DeletionReport, v2action.Warnings, error)
+	StartOrganizationDeletion(orgName string) (v2action.Job, v2action.Warnings, error)
+	PollJobWithProgress(job v2action.Job, progress func(v2action.JobProgress)) (v2action.Warnings, error)
 }
 
 type DeleteOrgCommand struct {
 	RequiredArgs flag.Organization `positional-args:"yes"`
 	Force        bool              `short:"f" description:"Force deletion without confirmation"`
-	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
+	Report       bool              `long:"report" description:"List the apps, service instances with their bound apps and service keys, and routes that will be deleted before confirming"`
+	Timeout      flag.Timeout      `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes"`
+	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f] [--report] [--timeout TIMEOUT]"`
 
 	Config      command.Config
 	UI          command.UI
@@ -29,6 +33,9 @@ type DeleteOrgCommand struct {
 func (cmd *DeleteOrgCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.UI = ui
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 	cmd.SharedActor = sharedaction.NewActor(config)
 
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
@@ -51,6 +58,13 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Report {
+		err = cmd.displayReport()
+		if err != nil {
+			return err
+		}
+	}
+
 	if !cmd.Force {
 		promptMessage := "Really delete the org {{.OrgName}}, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers?"
 		deleteOrg, promptErr := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{"OrgName": cmd.RequiredArgs.Organization})
@@ -70,8 +84,8 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
-	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
-	cmd.UI.DisplayWarnings(warnings)
+	progress := shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "deleting org "+cmd.RequiredArgs.Organization)
+	err = cmd.deleteOrganization(user.Name)
 	if err != nil {
 		switch err.(type) {
 		case actionerror.OrganizationNotFoundError:
@@ -79,7 +93,7 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 				"OrgName": cmd.RequiredArgs.Organization,
 			})
 		default:
-			return err
+			return progress.Wrap(err)
 		}
 	}
 
@@ -91,3 +105,40 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd *DeleteOrgCommand) displayReport() error {
+	report, warnings, err := cmd.Actor.GetOrganizationDeletionReport(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.OrganizationNotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+
+	if len(report.Spaces) == 0 {
+		cmd.UI.DisplayText("Org {{.OrgName}} has no spaces.", map[string]interface{}{
+			"OrgName": cmd.RequiredArgs.Organization,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Deleting org {{.OrgName}} will also delete:", map[string]interface{}{
+		"OrgName": cmd.RequiredArgs.Organization,
+	})
+	shared.DisplayDeletionReport(cmd.UI, report)
+	return nil
+}
+
+func (cmd *DeleteOrgCommand) deleteOrganization(username string) error {
+	job, warnings, err := cmd.Actor.StartOrganizationDeletion(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	recordRecentJob(cmd.Config, username, job.GUID, "delete org "+cmd.RequiredArgs.Organization)
+
+	warnings, err = cmd.Actor.PollJobWithProgress(job, shared.NewJobProgressDisplay(cmd.UI))
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
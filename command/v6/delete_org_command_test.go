@@ -2,10 +2,14 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -98,7 +102,13 @@ var _ = Describe("delete-org Command", func() {
 
 					When("no errors are encountered", func() {
 						BeforeEach(func() {
-							fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.StartOrganizationDeletionReturns(v2action.Job{GUID: "some-job-guid"}, v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.PollJobWithProgressStub = func(job v2action.Job, progress func(v2action.JobProgress)) (v2action.Warnings, error) {
+								progress(v2action.JobProgress{Status: constant.JobStatusQueued, Elapsed: time.Second})
+								progress(v2action.JobProgress{Status: constant.JobStatusQueued, Elapsed: 2 * time.Second})
+								progress(v2action.JobProgress{Status: constant.JobStatusRunning, Elapsed: 3 * time.Second})
+								return v2action.Warnings{"warning-3"}, nil
+							}
 						})
 
 						It("does not prompt for user confirmation, displays warnings, and deletes the org", func() {
@@ -107,20 +117,127 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).ToNot(Say(`Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\? \[yN\]:`))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(1))
+							orgName := fakeActor.StartOrganizationDeletionArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
+							Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(1))
+							job, _ := fakeActor.PollJobWithProgressArgsForCall(0)
+							Expect(job).To(Equal(v2action.Job{GUID: "some-job-guid"}))
+
 							Expect(testUI.Err).To(Say("warning-1"))
 							Expect(testUI.Err).To(Say("warning-2"))
+							Expect(testUI.Err).To(Say("warning-3"))
+							Expect(fakeActor.GetOrganizationDeletionReportCallCount()).To(Equal(0))
+						})
+
+						It("remembers the deletion job", func() {
+							Expect(fakeConfig.AddRecentJobCallCount()).To(Equal(1))
+							recentJob := fakeConfig.AddRecentJobArgsForCall(0)
+							Expect(recentJob.GUID).To(Equal("some-job-guid"))
+							Expect(recentJob.Description).To(Equal("delete org some-org"))
+							Expect(recentJob.User).To(Equal("some-user"))
+							Expect(recentJob.Target).To(Equal("some-url"))
+							Expect(recentJob.CreatedAt).ToNot(BeZero())
+						})
+
+						It("displays the progress of the deletion job when its status changes", func() {
+							Expect(testUI.Out).To(Say(`Job queued \(1s elapsed\)\.\.\.`))
+							Expect(testUI.Out).To(Say(`Job running \(3s elapsed\)\.\.\.`))
 							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).ToNot(Say(`2s elapsed`))
+						})
+					})
+
+					When("the '--report' flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Report = true
+						})
+
+						When("the org has spaces", func() {
+							BeforeEach(func() {
+								fakeActor.GetOrganizationDeletionReportReturns(
+									v2action.DeletionReport{
+										Spaces: []v2action.SpaceDeletionReport{
+											{
+												Name:         "space-1",
+												Applications: []string{"app-1", "app-2"},
+												ServiceInstances: []v2action.ServiceInstanceDeletionReport{
+													{Name: "instance-1", BoundApplications: []string{"app-1"}, ServiceKeys: []string{"key-1", "key-2"}},
+													{Name: "instance-2"},
+												},
+												Routes: []string{"host.example.com"},
+											},
+											{Name: "space-2"},
+										},
+									},
+									v2action.Warnings{"report-warning"},
+									nil)
+							})
+
+							It("displays what will be deleted before deleting the org", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.GetOrganizationDeletionReportCallCount()).To(Equal(1))
+								Expect(fakeActor.GetOrganizationDeletionReportArgsForCall(0)).To(Equal("some-org"))
+
+								Expect(testUI.Err).To(Say("report-warning"))
+								Expect(testUI.Out).To(Say("Deleting org some-org will also delete:"))
+								Expect(testUI.Out).To(Say("space space-1:"))
+								Expect(testUI.Out).To(Say(`apps:\s+app-1, app-2`))
+								Expect(testUI.Out).To(Say(`service instances:\s+instance-1 \(bound apps: app-1; service keys: key-1, key-2\)`))
+								Expect(testUI.Out).To(Say(`\s+instance-2 \(bound apps: none\)`))
+								Expect(testUI.Out).To(Say(`routes:\s+host.example.com`))
+								Expect(testUI.Out).To(Say("space space-2:"))
+								Expect(testUI.Out).To(Say(`apps:\s+none`))
+								Expect(testUI.Out).To(Say(`service instances:\s+none`))
+								Expect(testUI.Out).To(Say(`routes:\s+none`))
+								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
+
+								Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(1))
+							})
+						})
+
+						When("the org has no spaces", func() {
+							It("says so", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Org some-org has no spaces."))
+								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
+							})
+						})
+
+						When("the org does not exist", func() {
+							BeforeEach(func() {
+								fakeActor.GetOrganizationDeletionReportReturns(v2action.DeletionReport{}, nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+								fakeActor.StartOrganizationDeletionReturns(v2action.Job{}, nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+							})
+
+							It("skips the report and says the org does not exist", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).ToNot(Say("will also delete"))
+								Expect(testUI.Out).To(Say("Org some-org does not exist."))
+								Expect(testUI.Out).To(Say("OK"))
+							})
+						})
+
+						When("getting the report returns an error", func() {
+							BeforeEach(func() {
+								fakeActor.GetOrganizationDeletionReportReturns(v2action.DeletionReport{}, v2action.Warnings{"report-warning"}, errors.New("report error"))
+							})
+
+							It("returns the error and does not delete the org", func() {
+								Expect(executeErr).To(MatchError("report error"))
+								Expect(testUI.Err).To(Say("report-warning"))
+								Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(0))
+							})
 						})
 					})
 
 					When("an error is encountered deleting the org", func() {
 						When("the organization does not exist", func() {
 							BeforeEach(func() {
-								fakeActor.DeleteOrganizationReturns(
+								fakeActor.StartOrganizationDeletionReturns(
+									v2action.Job{},
 									v2action.Warnings{"warning-1", "warning-2"},
 									actionerror.OrganizationNotFoundError{
 										Name: "some-org",
@@ -133,8 +250,8 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(1))
+								orgName := fakeActor.StartOrganizationDeletionArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
@@ -150,7 +267,7 @@ var _ = Describe("delete-org Command", func() {
 
 							BeforeEach(func() {
 								returnedErr = errors.New("some error")
-								fakeActor.DeleteOrganizationReturns(v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
+								fakeActor.StartOrganizationDeletionReturns(v2action.Job{}, v2action.Warnings{"warning-1", "warning-2"}, returnedErr)
 							})
 
 							It("returns the error, displays all warnings, and does not delete the org", func() {
@@ -158,12 +275,29 @@ var _ = Describe("delete-org Command", func() {
 
 								Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-								Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-								orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+								Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(1))
+								orgName := fakeActor.StartOrganizationDeletionArgsForCall(0)
 								Expect(orgName).To(Equal("some-org"))
 
 								Expect(testUI.Err).To(Say("warning-1"))
 								Expect(testUI.Err).To(Say("warning-2"))
+								Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(0))
+							})
+						})
+
+						When("polling the delete job passes the --timeout deadline", func() {
+							BeforeEach(func() {
+								cmd.Timeout.Value = 2 * time.Minute
+								fakeConfig.CommandDeadlineReturns(time.Now())
+								fakeActor.PollJobWithProgressReturns(v2action.Warnings{"warning-1"}, ccerror.JobTimeoutError{JobGUID: "some-job-guid"})
+							})
+
+							It("returns a CommandTimeoutError describing the progress made", func() {
+								Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+									Timeout:    2 * time.Minute,
+									InProgress: "deleting org some-org",
+								}))
+								Expect(testUI.Err).To(Say("warning-1"))
 							})
 						})
 					})
@@ -181,7 +315,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(0))
 						})
 					})
 
@@ -195,7 +329,7 @@ var _ = Describe("delete-org Command", func() {
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(0))
 						})
 					})
 
@@ -210,8 +344,8 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say(`Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\? \[yN\]:`))
 							Expect(testUI.Out).To(Say("Deleting org some-org as some-user..."))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
-							orgName := fakeActor.DeleteOrganizationArgsForCall(0)
+							Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(1))
+							orgName := fakeActor.StartOrganizationDeletionArgsForCall(0)
 							Expect(orgName).To(Equal("some-org"))
 
 							Expect(testUI.Out).To(Say("OK"))
@@ -230,7 +364,7 @@ var _ = Describe("delete-org Command", func() {
 							Expect(testUI.Out).To(Say(`invalid input \(not y, n, yes, or no\)`))
 							Expect(testUI.Out).To(Say(`Really delete the org some-org, including its spaces, apps, service instances, routes, private domains and space-scoped service brokers\? \[yN\]:`))
 
-							Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+							Expect(fakeActor.StartOrganizationDeletionCallCount()).To(Equal(0))
 						})
 					})
 
@@ -1,22 +1,97 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . DeleteQuotaActor
+
+type DeleteQuotaActor interface {
+	CloudControllerAPIVersion() string
+	DeleteOrganizationQuota(name string) (v3action.Warnings, error)
+}
+
 type DeleteQuotaCommand struct {
 	RequiredArgs    flag.Quota  `positional-args:"yes"`
 	Force           bool        `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{} `usage:"CF_NAME delete-quota QUOTA [-f]"`
 	relatedCommands interface{} `related_commands:"quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteQuotaActor
 }
 
-func (DeleteQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
 	return nil
 }
 
-func (DeleteQuotaCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd DeleteQuotaCommand) Execute(args []string) error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteQuota, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the quota {{.QuotaName}}?", map[string]interface{}{
+			"QuotaName": cmd.RequiredArgs.Quota,
+		})
+
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteQuota {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting quota {{.QuotaName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"QuotaName":   cmd.RequiredArgs.Quota,
+		"CurrentUser": user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteOrganizationQuota(cmd.RequiredArgs.Quota)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.OrganizationQuotaNotFoundForNameError); ok {
+		cmd.UI.DisplayWarning("Quota {{.QuotaName}} does not exist", map[string]interface{}{
+			"QuotaName": cmd.RequiredArgs.Quota,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
 }
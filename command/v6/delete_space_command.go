@@ -11,14 +11,17 @@ import (
 //go:generate counterfeiter . DeleteSpaceActor
 
 type DeleteSpaceActor interface {
-	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Warnings, error)
+	GetSpaceDeletionReport(spaceName string, orgName string) (v2action.DeletionReport, v2action.Warnings, error)
+	StartSpaceDeletion(spaceName string, orgName string) (v2action.Job, v2action.Warnings, error)
+	PollJobWithProgress(job v2action.Job, progress func(v2action.JobProgress)) (v2action.Warnings, error)
 }
 
 type DeleteSpaceCommand struct {
 	RequiredArgs flag.Space  `positional-args:"yes"`
 	Force        bool        `short:"f" description:"Force deletion without confirmation"`
 	Org          string      `short:"o" description:"Delete space within specified org"`
-	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f]"`
+	Report       bool        `long:"report" description:"List the apps, service instances with their bound apps and service keys, and routes that will be deleted before confirming"`
+	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f] [--report]"`
 
 	Config      command.Config
 	UI          command.UI
@@ -63,6 +66,19 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Report {
+		report, warnings, reportErr := cmd.Actor.GetSpaceDeletionReport(cmd.RequiredArgs.Space, orgName)
+		cmd.UI.DisplayWarnings(warnings)
+		if reportErr != nil {
+			return reportErr
+		}
+
+		cmd.UI.DisplayText("Deleting space {{.SpaceName}} will also delete:", map[string]interface{}{
+			"SpaceName": cmd.RequiredArgs.Space,
+		})
+		shared.DisplayDeletionReport(cmd.UI, report)
+	}
+
 	if !cmd.Force {
 		promptMessage := "Really delete the space {{.SpaceName}}?"
 		deleteSpace, promptErr := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{"SpaceName": cmd.RequiredArgs.Space})
@@ -84,7 +100,14 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 			"CurrentUser": user.Name,
 		})
 
-	warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	job, warnings, err := cmd.Actor.StartSpaceDeletion(cmd.RequiredArgs.Space, orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	recordRecentJob(cmd.Config, user.Name, job.GUID, "delete space "+cmd.RequiredArgs.Space+" in org "+orgName)
+
+	warnings, err = cmd.Actor.PollJobWithProgress(job, shared.NewJobProgressDisplay(cmd.UI))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
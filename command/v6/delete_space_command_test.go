@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
@@ -114,7 +115,7 @@ var _ = Describe("delete-space Command", func() {
 
 					When("the deleting the space errors", func() {
 						BeforeEach(func() {
-							fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v2action.Warnings{"warning-1", "warning-2"}, actionerror.SpaceNotFoundError{Name: "some-space"})
+							fakeActor.StartSpaceDeletionReturns(v2action.Job{}, v2action.Warnings{"warning-1", "warning-2"}, actionerror.SpaceNotFoundError{Name: "some-space"})
 						})
 
 						It("returns the translatable error", func() {
@@ -123,12 +124,73 @@ var _ = Describe("delete-space Command", func() {
 
 							Expect(testUI.Err).To(Say("warning-1"))
 							Expect(testUI.Err).To(Say("warning-2"))
+							Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(0))
+						})
+					})
+
+					When("the deletion job fails", func() {
+						BeforeEach(func() {
+							fakeActor.StartSpaceDeletionReturns(v2action.Job{GUID: "some-job-guid"}, nil, nil)
+							fakeActor.PollJobWithProgressReturns(v2action.Warnings{"poll-warning"}, ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "it broke"})
+						})
+
+						It("returns the error and does not display OK", func() {
+							Expect(executeErr).To(MatchError(ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "it broke"}))
+							Expect(testUI.Err).To(Say("poll-warning"))
+							Expect(testUI.Out).ToNot(Say("OK"))
+						})
+					})
+
+					When("the --report flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Report = true
+							fakeActor.GetSpaceDeletionReportReturns(
+								v2action.DeletionReport{
+									Spaces: []v2action.SpaceDeletionReport{
+										{
+											Name:         "some-space",
+											Applications: []string{"app-1"},
+											ServiceInstances: []v2action.ServiceInstanceDeletionReport{
+												{Name: "instance-1", BoundApplications: []string{"app-1", "other-app-guid"}},
+											},
+										},
+									},
+								},
+								v2action.Warnings{"report-warning"},
+								nil)
+						})
+
+						It("displays what will be deleted before deleting the space", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							spaceArg, orgArg := fakeActor.GetSpaceDeletionReportArgsForCall(0)
+							Expect(spaceArg).To(Equal("some-space"))
+							Expect(orgArg).To(Equal("some-org"))
+
+							Expect(testUI.Err).To(Say("report-warning"))
+							Expect(testUI.Out).To(Say("Deleting space some-space will also delete:"))
+							Expect(testUI.Out).To(Say(`apps:\s+app-1`))
+							Expect(testUI.Out).To(Say(`service instances:\s+instance-1 \(bound apps: app-1, other-app-guid\)`))
+							Expect(testUI.Out).To(Say(`routes:\s+none`))
+							Expect(testUI.Out).To(Say(`Deleting space some-space in org some-org as some-user\.\.\.`))
+						})
+
+						When("getting the report returns an error", func() {
+							BeforeEach(func() {
+								fakeActor.GetSpaceDeletionReportReturns(v2action.DeletionReport{}, nil, actionerror.SpaceNotFoundError{Name: "some-space"})
+							})
+
+							It("returns the error and does not delete the space", func() {
+								Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+								Expect(fakeActor.StartSpaceDeletionCallCount()).To(Equal(0))
+							})
 						})
 					})
 
 					When("the deleting the space succeeds", func() {
 						BeforeEach(func() {
-							fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.StartSpaceDeletionReturns(v2action.Job{GUID: "some-job-guid"}, v2action.Warnings{"warning-1"}, nil)
+							fakeActor.PollJobWithProgressReturns(v2action.Warnings{"warning-2"}, nil)
 						})
 
 						When("the user was targeted to the space", func() {
@@ -149,9 +211,14 @@ var _ = Describe("delete-space Command", func() {
 
 								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 
-								spaceArg, orgArg := fakeActor.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+								spaceArg, orgArg := fakeActor.StartSpaceDeletionArgsForCall(0)
 								Expect(spaceArg).To(Equal("some-space"))
 								Expect(orgArg).To(Equal("some-org"))
+
+								Expect(fakeConfig.AddRecentJobCallCount()).To(Equal(1))
+								recentJob := fakeConfig.AddRecentJobArgsForCall(0)
+								Expect(recentJob.GUID).To(Equal("some-job-guid"))
+								Expect(recentJob.Description).To(Equal("delete space some-space in org some-org"))
 							})
 						})
 
@@ -187,7 +254,8 @@ var _ = Describe("delete-space Command", func() {
 							_, err := input.Write([]byte("y\n"))
 							Expect(err).ToNot(HaveOccurred())
 
-							fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+							fakeActor.StartSpaceDeletionReturns(v2action.Job{GUID: "some-job-guid"}, v2action.Warnings{"warning-1"}, nil)
+							fakeActor.PollJobWithProgressReturns(v2action.Warnings{"warning-2"}, nil)
 						})
 
 						It("deletes the space", func() {
@@ -213,7 +281,7 @@ var _ = Describe("delete-space Command", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
-							Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+							Expect(fakeActor.StartSpaceDeletionCallCount()).To(Equal(0))
 						})
 					})
 
@@ -227,7 +295,7 @@ var _ = Describe("delete-space Command", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
 							Expect(testUI.Out).To(Say("Delete cancelled"))
-							Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+							Expect(fakeActor.StartSpaceDeletionCallCount()).To(Equal(0))
 						})
 					})
 
@@ -244,7 +312,7 @@ var _ = Describe("delete-space Command", func() {
 							Expect(testUI.Out).To(Say(`invalid input \(not y, n, yes, or no\)`))
 							Expect(testUI.Out).To(Say(`Really delete the space some-space\? \[yN\]`))
 
-							Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+							Expect(fakeActor.StartSpaceDeletionCallCount()).To(Equal(0))
 						})
 					})
 				})
@@ -255,7 +323,8 @@ var _ = Describe("delete-space Command", func() {
 					cmd.Org = ""
 					cmd.Force = true
 					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-targeted-org"})
-					fakeActor.DeleteSpaceByNameAndOrganizationNameReturns(v2action.Warnings{"warning-1", "warning-2"}, nil)
+					fakeActor.StartSpaceDeletionReturns(v2action.Job{GUID: "some-job-guid"}, v2action.Warnings{"warning-1"}, nil)
+					fakeActor.PollJobWithProgressReturns(v2action.Warnings{"warning-2"}, nil)
 				})
 
 				It("deletes the space in the targeted org", func() {
@@ -268,7 +337,7 @@ var _ = Describe("delete-space Command", func() {
 					Expect(testUI.Err).To(Say("warning-1"))
 					Expect(testUI.Err).To(Say("warning-2"))
 
-					spaceArg, orgArg := fakeActor.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+					spaceArg, orgArg := fakeActor.StartSpaceDeletionArgsForCall(0)
 					Expect(spaceArg).To(Equal("some-space"))
 					Expect(orgArg).To(Equal("some-targeted-org"))
 				})
@@ -1,22 +1,97 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . DeleteSpaceQuotaActor
+
+type DeleteSpaceQuotaActor interface {
+	CloudControllerAPIVersion() string
+	DeleteSpaceQuota(name string, orgGUID string) (v3action.Warnings, error)
+}
+
 type DeleteSpaceQuotaCommand struct {
 	RequiredArgs    flag.SpaceQuota `positional-args:"yes"`
 	Force           bool            `short:"f" description:"Force deletion without confirmation"`
 	usage           interface{}     `usage:"CF_NAME delete-space-quota SPACE_QUOTA_NAME [-f]"`
 	relatedCommands interface{}     `related_commands:"space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteSpaceQuotaActor
 }
 
-func (DeleteSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
 	return nil
 }
 
-func (DeleteSpaceQuotaCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd DeleteSpaceQuotaCommand) Execute(args []string) error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteQuota, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the space quota {{.QuotaName}}?", map[string]interface{}{
+			"QuotaName": cmd.RequiredArgs.SpaceQuota,
+		})
+
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteQuota {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting space quota {{.QuotaName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"QuotaName":   cmd.RequiredArgs.SpaceQuota,
+		"CurrentUser": user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteSpaceQuota(cmd.RequiredArgs.SpaceQuota, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.SpaceQuotaNotFoundByNameError); ok {
+		cmd.UI.DisplayWarning("Space quota {{.QuotaName}} does not exist", map[string]interface{}{
+			"QuotaName": cmd.RequiredArgs.SpaceQuota,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
 }
@@ -0,0 +1,25 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DisableFeatureCommand struct {
+	RequiredArgs    flag.CLIFeature `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME disable-feature FEATURE\n\nEXAMPLES:\n   CF_NAME disable-feature login-v2"`
+	relatedCommands interface{}     `related_commands:"enable-feature, features"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DisableFeatureCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd DisableFeatureCommand) Execute(args []string) error {
+	return setFeatureEnabled(cmd.Config, cmd.UI, cmd.RequiredArgs.Feature, false)
+}
@@ -0,0 +1,47 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("disable-feature Command", func() {
+	var (
+		cmd        DisableFeatureCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = DisableFeatureCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Feature = configv3.LoginV2Feature
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("disables the feature in the config", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeConfig.SetFeatureEnabledCallCount()).To(Equal(1))
+		name, enabled := fakeConfig.SetFeatureEnabledArgsForCall(0)
+		Expect(name).To(Equal(configv3.LoginV2Feature))
+		Expect(enabled).To(BeFalse())
+
+		Expect(testUI.Out).To(Say(`Disabling feature login-v2\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+})
@@ -0,0 +1,159 @@
+package v6
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+//go:generate counterfeiter . DumpActor
+
+type DumpActor interface {
+	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . DiagnosticDumpActor
+
+type DiagnosticDumpActor interface {
+	DownloadDiagnosticDump(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, dumpOptions sharedaction.DiagnosticDumpOptions) (sharedaction.DiagnosticDump, error)
+}
+
+type DumpCommand struct {
+	RequiredArgs       flag.AppInstanceNameArg `positional-args:"yes"`
+	JVMHeap            bool                    `long:"jvm-heap" description:"Download a heap dump of the Java process"`
+	Goroutine          bool                    `long:"goroutine" description:"Download the goroutine stacks from the app's pprof endpoint"`
+	Core               bool                    `long:"core" description:"Download a core dump of the app process"`
+	MaxSize            flag.Megabytes          `long:"max-size" default:"1G" description:"Largest dump to download, with a unit of measurement like M, MB, G, or GB"`
+	OutputPath         flag.Path               `short:"o" description:"File or directory to write the dump to"`
+	ProcessType        string                  `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool                    `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}             `usage:"CF_NAME dump APP_NAME[/INDEX] [--jvm-heap | --goroutine | --core] [--max-size SIZE] [-o PATH] [--process PROCESS] [--skip-host-validation]\n\n   Without a dump type, apps staged by the Java buildpack get a heap dump, apps staged by the Go buildpack get a goroutine dump and all other apps get a core dump. Goroutine dumps require the app to serve net/http/pprof on $PORT.\n\n   The dump is written to the current directory as APP_NAME-INDEX-TYPE with an extension matching the dump type unless -o is provided, and is removed from the app instance afterwards."`
+	examples           interface{}             `examples:"CF_NAME dump my-app # Download the default dump of the first instance\nCF_NAME dump my-app/2 --jvm-heap -o /tmp # Download a heap dump of the instance at index 2 to /tmp\nCF_NAME dump my-app --core --max-size 4G # Allow core dumps of up to 4G"`
+	relatedCommands    interface{}             `related_commands:"enable-ssh, exec, ssh"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DumpActor
+	DumpActor   DiagnosticDumpActor
+	SSHClient   *clissh.SecureShell
+}
+
+func (cmd *DumpCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.DumpActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd DumpCommand) Execute(args []string) error {
+	dumpType, err := cmd.dumpType()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	instance := cmd.RequiredArgs.Instance
+	cmd.UI.DisplayTextWithFlavor("Downloading diagnostic dump of app {{.AppName}} instance {{.Index}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   instance.AppName,
+		"Index":     instance.Index,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		instance.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		instance.Index,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	dump, err := cmd.DumpActor.DownloadDiagnosticDump(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		sharedaction.DiagnosticDumpOptions{
+			Type:     dumpType,
+			Path:     string(cmd.OutputPath),
+			BaseName: fmt.Sprintf("%s-%d", instance.AppName, instance.Index),
+			MaxSize:  int64(cmd.MaxSize.Value) * bytefmt.MEGABYTE,
+		})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Wrote {{.Type}} dump ({{.Size}}) to {{.Path}}", map[string]interface{}{
+		"Type": dump.Type,
+		"Size": bytefmt.ByteSize(uint64(dump.Size)),
+		"Path": dump.Path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd DumpCommand) dumpType() (sharedaction.DiagnosticDumpType, error) {
+	var (
+		dumpTypes []sharedaction.DiagnosticDumpType
+		flags     []string
+	)
+	if cmd.JVMHeap {
+		dumpTypes = append(dumpTypes, sharedaction.JVMHeapDump)
+		flags = append(flags, "--jvm-heap")
+	}
+	if cmd.Goroutine {
+		dumpTypes = append(dumpTypes, sharedaction.GoroutineDump)
+		flags = append(flags, "--goroutine")
+	}
+	if cmd.Core {
+		dumpTypes = append(dumpTypes, sharedaction.CoreDump)
+		flags = append(flags, "--core")
+	}
+
+	switch len(dumpTypes) {
+	case 0:
+		return sharedaction.DiagnosticDumpDefault, nil
+	case 1:
+		return dumpTypes[0], nil
+	default:
+		return "", translatableerror.ArgumentCombinationError{Args: flags}
+	}
+}
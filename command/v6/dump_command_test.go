@@ -0,0 +1,180 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("dump Command", func() {
+	var (
+		cmd             DumpCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeDumpActor
+		fakeDumpActor   *v6fakes.FakeDiagnosticDumpActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeDumpActor)
+		fakeDumpActor = new(v6fakes.FakeDiagnosticDumpActor)
+
+		cmd = DumpCommand{
+			RequiredArgs: flag.AppInstanceNameArg{Instance: flag.AppInstanceName{AppName: "some-app", Index: 2}},
+			MaxSize:      flag.Megabytes{NullUint64: types.NullUint64{Value: 1024, IsSet: true}},
+			ProcessType:  "web",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			DumpActor:   fakeDumpActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("more than one dump type is provided", func() {
+		BeforeEach(func() {
+			cmd.JVMHeap = true
+			cmd.Core = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--jvm-heap", "--core"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the secure shell authentication information succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHAuthentication{
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "some-passcode",
+					Username:           "some-username",
+				},
+				v3action.Warnings{"some-warnings"},
+				nil,
+			)
+		})
+
+		When("downloading the dump succeeds", func() {
+			BeforeEach(func() {
+				cmd.Goroutine = true
+				cmd.OutputPath = "some-dir"
+				cmd.SkipHostValidation = true
+				fakeDumpActor.DownloadDiagnosticDumpReturns(sharedaction.DiagnosticDump{
+					Type: sharedaction.GoroutineDump,
+					Path: "some-dir/some-app-2-goroutine.txt",
+					Size: 2048,
+				}, nil)
+			})
+
+			It("downloads the dump of the instance and displays where it was written", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Downloading diagnostic dump of app some-app instance 2 in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(testUI.Out).To(Say(`Wrote goroutine dump \(2K\) to some-dir/some-app-2-goroutine\.txt`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+				appName, spaceGUID, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(processIndex).To(Equal(uint(2)))
+
+				Expect(fakeDumpActor.DownloadDiagnosticDumpCallCount()).To(Equal(1))
+				_, sshOptions, dumpOptions := fakeDumpActor.DownloadDiagnosticDumpArgsForCall(0)
+				Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "some-passcode",
+					SkipHostValidation: true,
+					Username:           "some-username",
+				}))
+				Expect(dumpOptions).To(Equal(sharedaction.DiagnosticDumpOptions{
+					Type:     sharedaction.GoroutineDump,
+					Path:     "some-dir",
+					BaseName: "some-app-2",
+					MaxSize:  1024 * 1024 * 1024,
+				}))
+			})
+		})
+
+		When("no dump type is provided", func() {
+			It("lets the actor pick the dump type", func() {
+				_, _, dumpOptions := fakeDumpActor.DownloadDiagnosticDumpArgsForCall(0)
+				Expect(dumpOptions.Type).To(Equal(sharedaction.DiagnosticDumpDefault))
+			})
+		})
+
+		When("downloading the dump fails", func() {
+			BeforeEach(func() {
+				fakeDumpActor.DownloadDiagnosticDumpReturns(sharedaction.DiagnosticDump{}, actionerror.DiagnosticDumpTooLargeError{Type: "core", Size: 2048, MaxSize: 1024})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.DiagnosticDumpTooLargeError{Type: "core", Size: 2048, MaxSize: 1024}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	When("getting the secure shell authentication information fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHAuthentication{},
+				v3action.Warnings{"some-warnings"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeDumpActor.DownloadDiagnosticDumpCallCount()).To(Equal(0))
+		})
+	})
+})
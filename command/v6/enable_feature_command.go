@@ -0,0 +1,53 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type EnableFeatureCommand struct {
+	RequiredArgs    flag.CLIFeature `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME enable-feature FEATURE\n\nEXAMPLES:\n   CF_NAME enable-feature login-v2"`
+	relatedCommands interface{}     `related_commands:"disable-feature, features"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *EnableFeatureCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd EnableFeatureCommand) Execute(args []string) error {
+	return setFeatureEnabled(cmd.Config, cmd.UI, cmd.RequiredArgs.Feature, true)
+}
+
+func setFeatureEnabled(config command.Config, ui command.UI, name string, enabled bool) error {
+	feature, found := configv3.LookupFeature(name)
+	if !found {
+		return translatableerror.CLIFeatureNotFoundError{Name: name}
+	}
+
+	template := "Disabling feature {{.Feature}}..."
+	if enabled {
+		template = "Enabling feature {{.Feature}}..."
+	}
+	ui.DisplayText(template, map[string]interface{}{
+		"Feature": feature.Name,
+	})
+
+	config.SetFeatureEnabled(feature.Name, enabled)
+
+	if config.FeatureEnabled(feature.Name) != enabled {
+		ui.DisplayWarning("{{.EnvVar}} is set and overrides this setting.", map[string]interface{}{
+			"EnvVar": feature.EnvVar,
+		})
+	}
+
+	ui.DisplayOK()
+	return nil
+}
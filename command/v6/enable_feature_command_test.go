@@ -0,0 +1,78 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("enable-feature Command", func() {
+	var (
+		cmd        EnableFeatureCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = EnableFeatureCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Feature = configv3.LoginV2Feature
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the feature exists", func() {
+		BeforeEach(func() {
+			fakeConfig.FeatureEnabledReturns(true)
+		})
+
+		It("enables the feature in the config", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetFeatureEnabledCallCount()).To(Equal(1))
+			name, enabled := fakeConfig.SetFeatureEnabledArgsForCall(0)
+			Expect(name).To(Equal(configv3.LoginV2Feature))
+			Expect(enabled).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Enabling feature login-v2\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).ToNot(Say("overrides"))
+		})
+	})
+
+	When("the feature's environment variable overrides the config", func() {
+		BeforeEach(func() {
+			fakeConfig.FeatureEnabledReturns(false)
+		})
+
+		It("warns that the setting has no effect", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("CF_EXPERIMENTAL_LOGIN is set and overrides this setting."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the feature does not exist", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Feature = "some-feature"
+		})
+
+		It("returns a CLIFeatureNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.CLIFeatureNotFoundError{Name: "some-feature"}))
+			Expect(fakeConfig.SetFeatureEnabledCallCount()).To(Equal(0))
+		})
+	})
+})
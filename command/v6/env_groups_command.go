@@ -0,0 +1,139 @@
+package v6
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . EnvGroupsActor
+
+type EnvGroupsActor interface {
+	GetEnvironmentVariableGroups() ([]v2action.EnvironmentVariableGroupVariable, v2action.Warnings, error)
+}
+
+type EnvGroupsCommand struct {
+	Diff            bool        `long:"diff" description:"Only show variables that are set in one group but not the other, or set to different values"`
+	ShowValues      bool        `long:"show-values" description:"Show the values of the variables instead of redacting them"`
+	usage           interface{} `usage:"CF_NAME env-groups [--diff] [--show-values]\n\n   Shows the staging and running environment variable groups side by side. Values are redacted unless --show-values is given.\n\nEXAMPLES:\n   CF_NAME env-groups --diff"`
+	relatedCommands interface{} `related_commands:"running-environment-variable-group, set-running-environment-variable-group, set-staging-environment-variable-group, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnvGroupsActor
+}
+
+func (cmd *EnvGroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd EnvGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting environment variable groups as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+
+	variables, warnings, err := cmd.Actor.GetEnvironmentVariableGroups()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("variable"),
+			cmd.UI.TranslateText("staging"),
+			cmd.UI.TranslateText("running"),
+			cmd.UI.TranslateText("difference"),
+		},
+	}
+	for _, variable := range variables {
+		if cmd.Diff && !variable.Differs() {
+			continue
+		}
+
+		stagingValue, err := cmd.formatValue(variable.StagingValue, variable.InStaging)
+		if err != nil {
+			return err
+		}
+		runningValue, err := cmd.formatValue(variable.RunningValue, variable.InRunning)
+		if err != nil {
+			return err
+		}
+
+		table = append(table, []string{variable.Name, stagingValue, runningValue, cmd.difference(variable)})
+	}
+
+	if len(table) == 1 {
+		if cmd.Diff {
+			cmd.UI.DisplayText("The staging and running environment variable groups are identical.")
+		} else {
+			cmd.UI.DisplayText("No environment variable groups have been set")
+		}
+		return nil
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd EnvGroupsCommand) formatValue(value interface{}, isSet bool) (string, error) {
+	if !isSet {
+		return "-", nil
+	}
+
+	if !cmd.ShowValues {
+		return RedactedCredential, nil
+	}
+
+	if text, ok := value.(string); ok {
+		return text, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func (cmd EnvGroupsCommand) difference(variable v2action.EnvironmentVariableGroupVariable) string {
+	switch {
+	case !variable.InRunning:
+		return cmd.UI.TranslateText("only in staging")
+	case !variable.InStaging:
+		return cmd.UI.TranslateText("only in running")
+	case variable.Differs():
+		return cmd.UI.TranslateText("value differs")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,138 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("env-groups Command", func() {
+	var (
+		cmd             EnvGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeEnvGroupsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeEnvGroupsActor)
+
+		cmd = EnvGroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetEnvironmentVariableGroupsReturns(
+			[]v2action.EnvironmentVariableGroupVariable{
+				{Name: "DB_POOL", StagingValue: float64(5), InStaging: true, RunningValue: float64(10), InRunning: true},
+				{Name: "RUNNING_ONLY", RunningValue: "b", InRunning: true},
+				{Name: "SHARED", StagingValue: "same", InStaging: true, RunningValue: "same", InRunning: true},
+				{Name: "STAGING_ONLY", StagingValue: "a", InStaging: true},
+			},
+			v2action.Warnings{"some-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the groups fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariableGroupsReturns(nil, v2action.Warnings{"some-warning"}, errors.New("groups error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("groups error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	It("displays both groups with redacted values", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting environment variable groups as steve\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`variable\s+staging\s+running\s+difference`))
+		Expect(testUI.Out).To(Say(`DB_POOL\s+\[REDACTED\]\s+\[REDACTED\]\s+value differs`))
+		Expect(testUI.Out).To(Say(`RUNNING_ONLY\s+-\s+\[REDACTED\]\s+only in running`))
+		Expect(testUI.Out).To(Say(`SHARED\s+\[REDACTED\]\s+\[REDACTED\]\s*\n`))
+		Expect(testUI.Out).To(Say(`STAGING_ONLY\s+\[REDACTED\]\s+-\s+only in staging`))
+		Expect(testUI.Err).To(Say("some-warning"))
+	})
+
+	When("--diff is provided", func() {
+		BeforeEach(func() {
+			cmd.Diff = true
+		})
+
+		It("only displays the variables that differ", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("DB_POOL"))
+			Expect(testUI.Out).To(Say("RUNNING_ONLY"))
+			Expect(testUI.Out).To(Say("STAGING_ONLY"))
+			Expect(testUI.Out).ToNot(Say("SHARED"))
+		})
+
+		When("--show-values is provided", func() {
+			BeforeEach(func() {
+				cmd.ShowValues = true
+			})
+
+			It("displays the values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`DB_POOL\s+5\s+10\s+value differs`))
+				Expect(testUI.Out).To(Say(`RUNNING_ONLY\s+-\s+b\s+only in running`))
+			})
+		})
+
+		When("the groups are identical", func() {
+			BeforeEach(func() {
+				fakeActor.GetEnvironmentVariableGroupsReturns(
+					[]v2action.EnvironmentVariableGroupVariable{
+						{Name: "SHARED", StagingValue: "same", InStaging: true, RunningValue: "same", InRunning: true},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("The staging and running environment variable groups are identical."))
+			})
+		})
+	})
+})
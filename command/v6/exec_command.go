@@ -0,0 +1,94 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+//go:generate counterfeiter . ExecActor
+
+type ExecActor interface {
+	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+}
+
+type ExecCommand struct {
+	RequiredArgs       flag.ExecArgs `positional-args:"yes"`
+	ProcessIndex       uint          `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string        `long:"process" default:"web" description:"App process name"`
+	RequestPseudoTTY   bool          `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation bool          `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME exec APP_NAME [--process PROCESS] [-i INDEX] [-t] [--skip-host-validation] -- COMMAND [ARGS]...\n\n   The command runs without a pseudo-tty unless -t is given, and CF_NAME exits with the exit status of the command."`
+	examples        interface{} `examples:"CF_NAME exec my-app -- env # Print the environment of the first instance\nCF_NAME exec my-app -i 2 -- ls -la /home/vcap/app # Run a command on the instance at index 2\nCF_NAME exec my-app -- 'env | sort' # Run a pipeline on the instance"`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExecActor
+	SSHActor    SSHActor
+	SSHClient   *clissh.SecureShell
+}
+
+func (cmd *ExecCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+// Execute runs the command on the app instance. Nothing but the command's own
+// output is written on success so that the output can be scripted against; a
+// non-zero exit status is returned as an *ssh.ExitError, which becomes the
+// CLI's exit status.
+func (cmd ExecCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	ttyOption := sharedaction.RequestTTYNo
+	if cmd.RequestPseudoTTY {
+		ttyOption = sharedaction.RequestTTYYes
+	}
+
+	return cmd.SSHActor.ExecuteSecureShell(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Commands:           cmd.RequiredArgs.Command,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			TTYOption:          ttyOption,
+			Username:           sshAuth.Username,
+		})
+}
@@ -0,0 +1,147 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("exec Command", func() {
+	var (
+		cmd             ExecCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeExecActor
+		fakeSSHActor    *v6fakes.FakeSSHActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeExecActor)
+		fakeSSHActor = new(v6fakes.FakeSSHActor)
+
+		cmd = ExecCommand{
+			RequiredArgs: flag.ExecArgs{AppName: "some-app", Command: []string{"env", "|", "sort"}},
+			ProcessType:  "web",
+			ProcessIndex: 2,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHActor:    fakeSSHActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the secure shell authentication information succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHAuthentication{
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					Passcode:           "some-passcode",
+					Username:           "some-username",
+				},
+				v3action.Warnings{"some-warnings"},
+				nil,
+			)
+		})
+
+		It("runs the command on the instance without a pseudo-tty and without extra output", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(processIndex).To(Equal(uint(2)))
+
+			Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+			_, sshOptions := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Commands:           []string{"env", "|", "sort"},
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				TTYOption:          sharedaction.RequestTTYNo,
+				Username:           "some-username",
+			}))
+
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		})
+
+		When("-t is provided", func() {
+			BeforeEach(func() {
+				cmd.RequestPseudoTTY = true
+			})
+
+			It("requests a pseudo-tty", func() {
+				_, sshOptions := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+				Expect(sshOptions.TTYOption).To(Equal(sharedaction.RequestTTYYes))
+			})
+		})
+
+		When("the command exits unsuccessfully", func() {
+			var exitErr *ssh.ExitError
+
+			BeforeEach(func() {
+				exitErr = &ssh.ExitError{}
+				fakeSSHActor.ExecuteSecureShellReturns(exitErr)
+			})
+
+			It("returns the exit error so that its status becomes the exit status", func() {
+				Expect(executeErr).To(BeIdenticalTo(exitErr))
+			})
+		})
+	})
+
+	When("getting the secure shell authentication information fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHAuthentication{}, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+		})
+
+		It("returns the error without connecting", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package v6
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+// EnvironmentExport is the document written by export-env and read by
+// import-env.
+type EnvironmentExport struct {
+	Space string                    `json:"space"`
+	Apps  []v3action.AppEnvironment `json:"apps"`
+}
+
+//go:generate counterfeiter . ExportEnvActor
+
+type ExportEnvActor interface {
+	ExportEnvironmentVariablesBySpace(spaceGUID string, filter v3action.AppFilter, redact []string) ([]v3action.AppEnvironment, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+}
+
+type ExportEnvCommand struct {
+	Space           string      `long:"space" description:"Space to export from, instead of the targeted space"`
+	OutputFile      flag.Path   `short:"o" description:"Write the environments to FILE instead of stdout"`
+	Include         []string    `long:"include" description:"Only export apps whose name matches the pattern. May be repeated"`
+	Exclude         []string    `long:"exclude" description:"Do not export apps whose name matches the pattern. May be repeated"`
+	Redact          []string    `long:"redact" description:"Leave out the value of variables whose name matches the pattern, ignoring case. May be repeated"`
+	usage           interface{} `usage:"CF_NAME export-env [--space SPACE] [-o FILE] [--include APP_PATTERN]... [--exclude APP_PATTERN]... [--redact VAR_PATTERN]...\n\n   Writes a JSON document with the user provided environment variables of each app in the space. Patterns may use * and ? wildcards. Redacted variables are listed by name only and are left unchanged by import-env."`
+	examples        interface{} `examples:"CF_NAME export-env --space staging -o envs.json\nCF_NAME export-env --exclude 'test-*' --redact '*PASSWORD*' --redact '*TOKEN*' -o envs.json"`
+	relatedCommands interface{} `related_commands:"env, import-env, local-env, set-env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportEnvActor
+}
+
+func (cmd *ExportEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, nil)
+
+	return nil
+}
+
+func (cmd ExportEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+	if cmd.Space != "" {
+		found, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		space.GUID = found.GUID
+		space.Name = found.Name
+	}
+
+	environments, warnings, err := cmd.Actor.ExportEnvironmentVariablesBySpace(
+		space.GUID,
+		v3action.AppFilter{Include: cmd.Include, Exclude: cmd.Exclude},
+		cmd.Redact,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(EnvironmentExport{Space: space.Name, Apps: environments}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile == "" {
+		cmd.UI.DisplayText("{{.Document}}", map[string]interface{}{
+			"Document": string(raw),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Writing environments of {{.AppCount}} apps in space {{.SpaceName}} to {{.Path}}...", map[string]interface{}{
+		"AppCount":  len(environments),
+		"SpaceName": space.Name,
+		"Path":      cmd.OutputFile,
+	})
+	err = ioutil.WriteFile(string(cmd.OutputFile), append(raw, '\n'), 0600)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+	return nil
+}
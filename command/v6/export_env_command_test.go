@@ -0,0 +1,185 @@
+package v6_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-env Command", func() {
+	var (
+		cmd             ExportEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeExportEnvActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeExportEnvActor)
+
+		cmd = ExportEnvCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.ExportEnvironmentVariablesBySpaceReturns(
+			[]v3action.AppEnvironment{
+				{
+					Name:                 "web",
+					EnvironmentVariables: map[string]string{"PORT": "8080"},
+					RedactedVariables:    []string{"DB_PASSWORD"},
+				},
+			},
+			v3action.Warnings{"export-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("prints the environments of the targeted space as JSON", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Err).To(Say("export-warning"))
+
+		var document EnvironmentExport
+		Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &document)).To(Succeed())
+		Expect(document).To(Equal(EnvironmentExport{
+			Space: "some-space",
+			Apps: []v3action.AppEnvironment{
+				{
+					Name:                 "web",
+					EnvironmentVariables: map[string]string{"PORT": "8080"},
+					RedactedVariables:    []string{"DB_PASSWORD"},
+				},
+			},
+		}))
+
+		spaceGUID, _, _ := fakeActor.ExportEnvironmentVariablesBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+	})
+
+	When("filters and redaction patterns are provided", func() {
+		BeforeEach(func() {
+			cmd.Include = []string{"web*"}
+			cmd.Exclude = []string{"web-test"}
+			cmd.Redact = []string{"*PASSWORD*"}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, filter, redact := fakeActor.ExportEnvironmentVariablesBySpaceArgsForCall(0)
+			Expect(filter).To(Equal(v3action.AppFilter{Include: []string{"web*"}, Exclude: []string{"web-test"}}))
+			Expect(redact).To(Equal([]string{"*PASSWORD*"}))
+		})
+	})
+
+	When("--space is provided", func() {
+		BeforeEach(func() {
+			cmd.Space = "other-space"
+			fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "other-space-guid", Name: "other-space"}, v3action.Warnings{"space-warning"}, nil)
+		})
+
+		It("exports the apps of that space without requiring a targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			spaceGUID, _, _ := fakeActor.ExportEnvironmentVariablesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+			Expect(testUI.Out).To(Say(`"space": "other-space"`))
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, nil, actionerror.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "other-space"}))
+				Expect(fakeActor.ExportEnvironmentVariablesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("-o is provided", func() {
+		var outputDir string
+
+		BeforeEach(func() {
+			var err error
+			outputDir, err = ioutil.TempDir("", "export-env")
+			Expect(err).ToNot(HaveOccurred())
+			cmd.OutputFile = flag.Path(filepath.Join(outputDir, "envs.json"))
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(outputDir)).To(Succeed())
+		})
+
+		It("writes the environments to the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Writing environments of 1 apps in space some-space to .*envs\.json\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			raw, err := ioutil.ReadFile(string(cmd.OutputFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(ContainSubstring(`"PORT": "8080"`))
+			Expect(string(raw)).ToNot(ContainSubstring("secret"))
+		})
+	})
+
+	When("exporting fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportEnvironmentVariablesBySpaceReturns(nil, v3action.Warnings{"export-warning"}, errors.New("export-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("export-error"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+	})
+})
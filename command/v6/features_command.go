@@ -0,0 +1,50 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type FeaturesCommand struct {
+	usage           interface{} `usage:"CF_NAME features\n\nTIP:\n   Setting a feature's environment variable to true or false overrides the state set with enable-feature and disable-feature."`
+	relatedCommands interface{} `related_commands:"enable-feature, disable-feature"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *FeaturesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd FeaturesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting experimental features...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("environment variable"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, feature := range configv3.Features() {
+		state := cmd.UI.TranslateText("disabled")
+		if cmd.Config.FeatureEnabled(feature.Name) {
+			state = cmd.UI.TranslateText("enabled")
+		}
+		table = append(table, []string{
+			feature.Name,
+			state,
+			feature.EnvVar,
+			cmd.UI.TranslateText(feature.Description),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
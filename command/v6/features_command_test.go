@@ -0,0 +1,55 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("features Command", func() {
+	var (
+		cmd        FeaturesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = FeaturesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the experimental features as disabled by default", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting experimental features\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+state\s+environment variable\s+description`))
+		Expect(testUI.Out).To(Say(`login-v2\s+disabled\s+CF_EXPERIMENTAL_LOGIN\s+Use the refactored login command`))
+	})
+
+	When("a feature is enabled", func() {
+		BeforeEach(func() {
+			fakeConfig.FeatureEnabledStub = func(name string) bool {
+				return name == configv3.LoginV2Feature
+			}
+		})
+
+		It("lists the feature as enabled", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`login-v2\s+enabled`))
+		})
+	})
+})
@@ -1,25 +1,142 @@
 package v6
 
 import (
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+//go:generate counterfeiter . FilesActor
+
+type FilesActor interface {
+	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . AppFilesActor
+
+type AppFilesActor interface {
+	BrowseAppFiles(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, path string, fileContents io.Writer) (sharedaction.AppFileListing, error)
+	CatAppFile(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, path string, out io.Writer) error
+}
+
 type FilesCommand struct {
-	RequiredArgs    flag.FilesArgs `positional-args:"yes"`
-	Instance        int            `short:"i" description:"Instance"`
-	usage           interface{}    `usage:"CF_NAME files APP_NAME [PATH] [-i INSTANCE]\n\nTIP:\n   To list and inspect files of an app running on the Diego backend, use 'CF_NAME ssh'"`
-	relatedCommands interface{}    `related_commands:"ssh"`
-	UI              command.UI
+	RequiredArgs       flag.FilesArgs `positional-args:"yes"`
+	Instance           uint           `short:"i" description:"Instance"`
+	ProcessType        string         `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool           `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}    `usage:"CF_NAME files APP_NAME [PATH] [-i INSTANCE] [--process PROCESS] [--skip-host-validation]\n\n   Lists the directory at PATH in an app instance, or prints the file at PATH. Relative paths are relative to /home/vcap. SSH must be enabled for the app.\n\nEXAMPLES:\n   CF_NAME files my-app app/\n   CF_NAME files my-app logs/staging_task.log -i 1"`
+	relatedCommands    interface{}    `related_commands:"cat, enable-ssh, ssh"`
+
+	UI            command.UI
+	Config        command.Config
+	SharedActor   command.SharedActor
+	Actor         FilesActor
+	AppFilesActor AppFilesActor
+	SSHClient     *clissh.SecureShell
 }
 
 func (cmd *FilesCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.AppFilesActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
 	return nil
 }
 
-func (cmd *FilesCommand) Execute(args []string) error {
-	cmd.UI.DisplayFileDeprecationWarning()
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd FilesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	cmd.UI.DisplayTextWithFlavor("Getting files for app {{.AppName}} instance {{.Index}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"Index":     cmd.Instance,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.Instance,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	listing, err := cmd.AppFilesActor.BrowseAppFiles(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		cmd.RequiredArgs.Path,
+		cmd.UI.GetOut(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if listing.IsFile {
+		return nil
+	}
+
+	if len(listing.Entries) == 0 {
+		cmd.UI.DisplayText("No files found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("size"),
+			cmd.UI.TranslateText("modified"),
+		},
+	}
+	for _, entry := range listing.Entries {
+		name := entry.Name
+		size := bytefmt.ByteSize(uint64(entry.Size))
+		if entry.IsDir {
+			name += "/"
+			size = "-"
+		}
+		table = append(table, []string{name, size, entry.ModTime.Local().Format(time.RFC3339)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
 }
@@ -1,37 +1,174 @@
 package v6_test
 
 import (
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"errors"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
 )
 
-var _ = Describe("Files Command", func() {
+var _ = Describe("files Command", func() {
 	var (
-		cmd    FilesCommand
-		testUI *ui.UI
+		cmd               FilesCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v6fakes.FakeFilesActor
+		fakeAppFilesActor *v6fakes.FakeAppFilesActor
+		executeErr        error
 	)
 
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeFilesActor)
+		fakeAppFilesActor = new(v6fakes.FakeAppFilesActor)
 
 		cmd = FilesCommand{
-			UI: testUI,
+			RequiredArgs: flag.FilesArgs{AppName: "some-app", Path: "app"},
+			Instance:     1,
+			ProcessType:  "web",
+
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			AppFilesActor: fakeAppFilesActor,
 		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v3action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v3action.Warnings{"ssh-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the SSH configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v3action.SSHAuthentication{},
+				v3action.Warnings{"ssh-warning"},
+				actionerror.ApplicationNotFoundError{Name: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("ssh-warning"))
+			Expect(fakeAppFilesActor.BrowseAppFilesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the path is a directory", func() {
+		BeforeEach(func() {
+			fakeAppFilesActor.BrowseAppFilesReturns(sharedaction.AppFileListing{
+				Path: "app",
+				Entries: []sharedaction.AppFile{
+					{Name: "config", Size: 4096, ModTime: time.Unix(1571234000, 0), IsDir: true},
+					{Name: "server.js", Size: 2048, ModTime: time.Unix(1571234567, 0)},
+				},
+			}, nil)
+		})
+
+		It("lists the directory over SSH", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting files for app some-app instance 1 in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+size\s+modified`))
+			Expect(testUI.Out).To(Say(`config/\s+-\s+%s`, time.Unix(1571234000, 0).Format(time.RFC3339)))
+			Expect(testUI.Out).To(Say(`server\.js\s+2K\s+%s`, time.Unix(1571234567, 0).Format(time.RFC3339)))
+			Expect(testUI.Err).To(Say("ssh-warning"))
+
+			appName, spaceGUID, processType, index := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(index).To(Equal(uint(1)))
+
+			_, sshOptions, path, _ := fakeAppFilesActor.BrowseAppFilesArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}))
+			Expect(path).To(Equal("app"))
+		})
+	})
+
+	When("the directory is empty", func() {
+		BeforeEach(func() {
+			fakeAppFilesActor.BrowseAppFilesReturns(sharedaction.AppFileListing{Path: "app"}, nil)
+		})
+
+		It("says no files were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No files found."))
+		})
+	})
+
+	When("the path is a file", func() {
+		BeforeEach(func() {
+			fakeAppFilesActor.BrowseAppFilesStub = func(_ sharedaction.SecureShellClient, _ sharedaction.SSHOptions, path string, fileContents io.Writer) (sharedaction.AppFileListing, error) {
+				_, err := fileContents.Write([]byte("some-contents\n"))
+				return sharedaction.AppFileListing{Path: path, IsFile: true}, err
+			}
+		})
+
+		It("displays the contents of the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("some-contents"))
+			Expect(testUI.Out).ToNot(Say("name"))
+		})
 	})
 
-	When("command is called in v6", func() {
-		It("returns an unrefactored command error", func() {
-			err := cmd.Execute(nil)
-			Expect(err).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+	When("browsing the files fails", func() {
+		BeforeEach(func() {
+			fakeAppFilesActor.BrowseAppFilesReturns(sharedaction.AppFileListing{}, errors.New("browse error"))
 		})
 
-		It("displays a deprecation warning", func() {
-			_ = cmd.Execute(nil)
-			Expect(testUI.Err).To(Say("Deprecation warning: This command has been deprecated and will be removed in the future. For similar functionality, please use the `cf ssh` command instead."))
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("browse error"))
 		})
 	})
 })
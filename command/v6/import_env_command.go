@@ -0,0 +1,115 @@
+package v6
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . ImportEnvActor
+
+type ImportEnvActor interface {
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+	ImportEnvironmentVariablesBySpace(spaceGUID string, environments []v3action.AppEnvironment, filter v3action.AppFilter) (v3action.EnvironmentImport, v3action.Warnings, error)
+}
+
+type ImportEnvCommand struct {
+	RequiredArgs    flag.EnvironmentsFileArg `positional-args:"yes"`
+	Space           string                   `long:"space" description:"Space to import into, instead of the targeted space"`
+	Include         []string                 `long:"include" description:"Only import apps whose name matches the pattern. May be repeated"`
+	Exclude         []string                 `long:"exclude" description:"Do not import apps whose name matches the pattern. May be repeated"`
+	usage           interface{}              `usage:"CF_NAME import-env ENVIRONMENTS_FILE [--space SPACE] [--include APP_PATTERN]... [--exclude APP_PATTERN]...\n\n   Sets the environment variables written by export-env on the apps of the same name in the space. Variables missing from the file, including redacted ones, are left unchanged. Apps that are not in the space are skipped. Restart the apps for the changes to take effect."`
+	examples        interface{}              `examples:"CF_NAME import-env envs.json --space production\nCF_NAME import-env envs.json --include 'worker-*'"`
+	relatedCommands interface{}              `related_commands:"env, export-env, restart, set-env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ImportEnvActor
+}
+
+func (cmd *ImportEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, nil)
+
+	return nil
+}
+
+func (cmd ImportEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	raw, err := ioutil.ReadFile(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	var document EnvironmentExport
+	err = json.Unmarshal(raw, &document)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+	if cmd.Space != "" {
+		found, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		space.GUID = found.GUID
+		space.Name = found.Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Importing environments from {{.Path}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      cmd.RequiredArgs.Path,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": space.Name,
+		"Username":  user.Name,
+	})
+
+	result, warnings, err := cmd.Actor.ImportEnvironmentVariablesBySpace(
+		space.GUID,
+		document.Apps,
+		v3action.AppFilter{Include: cmd.Include, Exclude: cmd.Exclude},
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, appName := range result.NotFound {
+		cmd.UI.DisplayWarning("App {{.AppName}} not found in space {{.SpaceName}}, skipping.", map[string]interface{}{
+			"AppName":   appName,
+			"SpaceName": space.Name,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	if len(result.Imported) > 0 {
+		cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restart APP_NAME' to ensure your env variable changes take effect.", map[string]interface{}{
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package v6_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-env Command", func() {
+	var (
+		cmd             ImportEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeImportEnvActor
+		inputDir        string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeImportEnvActor)
+
+		var err error
+		inputDir, err = ioutil.TempDir("", "import-env")
+		Expect(err).ToNot(HaveOccurred())
+		path := filepath.Join(inputDir, "envs.json")
+		Expect(ioutil.WriteFile(path, []byte(`{
+			"space": "staging",
+			"apps": [
+				{"name": "web", "environment_variables": {"PORT": "8080"}, "redacted_variables": ["DB_PASSWORD"]}
+			]
+		}`), 0600)).To(Succeed())
+
+		cmd = ImportEnvCommand{
+			RequiredArgs: flag.EnvironmentsFileArg{Path: flag.PathWithExistenceCheck(path)},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.ImportEnvironmentVariablesBySpaceReturns(
+			v3action.EnvironmentImport{Imported: []string{"web"}, NotFound: []string{"worker"}},
+			v3action.Warnings{"import-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(inputDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.ImportEnvironmentVariablesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("imports the environments into the targeted space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Importing environments from .*envs\.json into org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("import-warning"))
+		Expect(testUI.Err).To(Say("App worker not found in space some-space, skipping."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Use 'faceman restart APP_NAME' to ensure your env variable changes take effect."))
+
+		spaceGUID, environments, filter := fakeActor.ImportEnvironmentVariablesBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(environments).To(Equal([]v3action.AppEnvironment{
+			{
+				Name:                 "web",
+				EnvironmentVariables: map[string]string{"PORT": "8080"},
+				RedactedVariables:    []string{"DB_PASSWORD"},
+			},
+		}))
+		Expect(filter).To(Equal(v3action.AppFilter{}))
+	})
+
+	When("--space and filters are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = "other-space"
+			cmd.Include = []string{"web"}
+			fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "other-space-guid", Name: "other-space"}, nil, nil)
+		})
+
+		It("imports the selected apps into that space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("into org some-org / space other-space"))
+
+			spaceGUID, _, filter := fakeActor.ImportEnvironmentVariablesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+			Expect(filter).To(Equal(v3action.AppFilter{Include: []string{"web"}}))
+		})
+	})
+
+	When("the file is not valid JSON", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(string(cmd.RequiredArgs.Path), []byte("not json"), 0600)).To(Succeed())
+		})
+
+		It("returns the error without importing", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeActor.ImportEnvironmentVariablesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("importing fails", func() {
+		BeforeEach(func() {
+			fakeActor.ImportEnvironmentVariablesBySpaceReturns(v3action.EnvironmentImport{}, v3action.Warnings{"import-warning"}, errors.New("import-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("import-error"))
+			Expect(testUI.Err).To(Say("import-warning"))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+package v6
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . JobActor
+
+type JobActor interface {
+	GetJob(jobGUID string) (v2action.Job, v2action.Warnings, error)
+	PollJobWithProgress(job v2action.Job, progress func(v2action.JobProgress)) (v2action.Warnings, error)
+}
+
+type JobCommand struct {
+	RequiredArgs    flag.JobGUID `positional-args:"yes"`
+	Wait            bool         `long:"wait" description:"Wait for the job to finish, and fail if the job fails"`
+	usage           interface{}  `usage:"CF_NAME job JOB_GUID [--wait]"`
+	relatedCommands interface{}  `related_commands:"jobs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       JobActor
+}
+
+func (cmd *JobCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd JobCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting job {{.JobGUID}} as {{.CurrentUser}}...", map[string]interface{}{
+		"JobGUID":     cmd.RequiredArgs.GUID,
+		"CurrentUser": user.Name,
+	})
+
+	job, warnings, err := cmd.Actor.GetJob(cmd.RequiredArgs.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Wait && !jobDone(job) {
+		warnings, err = cmd.Actor.PollJobWithProgress(job, shared.NewJobProgressDisplay(cmd.UI))
+		cmd.UI.DisplayWarnings(warnings)
+		if _, failed := err.(ccerror.V2JobFailedError); err != nil && !failed {
+			return err
+		}
+
+		job, warnings, err = cmd.Actor.GetJob(cmd.RequiredArgs.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("guid:"), job.GUID},
+	}
+	for _, recentJob := range recentJobsFor(cmd.Config, user.Name) {
+		if recentJob.GUID == job.GUID {
+			table = append(table,
+				[]string{cmd.UI.TranslateText("description:"), recentJob.Description},
+				[]string{cmd.UI.TranslateText("start time:"), recentJob.CreatedAt.Format(time.RFC1123)},
+			)
+			break
+		}
+	}
+	table = append(table, []string{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(string(job.Status))})
+	if job.Status == constant.JobStatusFailed {
+		table = append(table, []string{cmd.UI.TranslateText("error:"), jobErrorMessage(job)})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	if cmd.Wait && job.Status == constant.JobStatusFailed {
+		return ccerror.V2JobFailedError{JobGUID: job.GUID, Message: jobErrorMessage(job)}
+	}
+
+	return nil
+}
+
+func jobDone(job v2action.Job) bool {
+	return job.Status == constant.JobStatusFinished || job.Status == constant.JobStatusFailed
+}
+
+func jobErrorMessage(job v2action.Job) string {
+	if job.ErrorDetails.Description != "" {
+		return job.ErrorDetails.Description
+	}
+	return job.Error
+}
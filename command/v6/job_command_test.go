@@ -0,0 +1,190 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("job Command", func() {
+	var (
+		cmd             JobCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeJobActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeJobActor)
+
+		cmd = JobCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.GUID = "some-job-guid"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetReturns("https://api.some-target.com")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the job does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v2action.Job{}, v2action.Warnings{"warning-1"}, actionerror.JobNotFoundError{GUID: "some-job-guid"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.JobNotFoundError{GUID: "some-job-guid"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("the job is running", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v2action.Job{GUID: "some-job-guid", Status: constant.JobStatusRunning}, v2action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the state of the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting job some-job-guid as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`guid:\s+some-job-guid`))
+			Expect(testUI.Out).To(Say(`state:\s+running`))
+			Expect(testUI.Out).ToNot(Say("description:"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetJobArgsForCall(0)).To(Equal("some-job-guid"))
+			Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(0))
+		})
+
+		When("the user started the job from this CLI", func() {
+			BeforeEach(func() {
+				fakeConfig.RecentJobsReturns([]configv3.RecentJob{
+					{GUID: "some-job-guid", Description: "delete org some-org", Target: "https://api.some-target.com", User: "some-user", CreatedAt: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)},
+				})
+			})
+
+			It("displays what the job does and when it started", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`guid:\s+some-job-guid`))
+				Expect(testUI.Out).To(Say(`description:\s+delete org some-org`))
+				Expect(testUI.Out).To(Say(`start time:\s+Wed, 02 Jan 2019 03:04:05 UTC`))
+				Expect(testUI.Out).To(Say(`state:\s+running`))
+			})
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+				fakeActor.GetJobReturnsOnCall(1, v2action.Job{GUID: "some-job-guid", Status: constant.JobStatusFinished}, v2action.Warnings{"warning-3"}, nil)
+				fakeActor.PollJobWithProgressStub = func(job v2action.Job, progress func(v2action.JobProgress)) (v2action.Warnings, error) {
+					progress(v2action.JobProgress{Status: constant.JobStatusRunning, Elapsed: 4 * time.Second})
+					return v2action.Warnings{"warning-2"}, nil
+				}
+			})
+
+			It("waits for the job to finish and displays its final state", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(1))
+				job, _ := fakeActor.PollJobWithProgressArgsForCall(0)
+				Expect(job.GUID).To(Equal("some-job-guid"))
+
+				Expect(testUI.Out).To(Say(`Job running \(4s elapsed\)\.\.\.`))
+				Expect(testUI.Out).To(Say(`state:\s+finished`))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+				Expect(testUI.Err).To(Say("warning-3"))
+			})
+
+			When("the job fails", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobWithProgressStub = nil
+					fakeActor.PollJobWithProgressReturns(nil, ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "it broke"})
+					failedJob := v2action.Job{GUID: "some-job-guid", Status: constant.JobStatusFailed}
+					failedJob.ErrorDetails.Description = "it broke"
+					fakeActor.GetJobReturnsOnCall(1, failedJob, nil, nil)
+				})
+
+				It("displays the job error and returns a job failed error", func() {
+					Expect(executeErr).To(MatchError(ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "it broke"}))
+
+					Expect(testUI.Out).To(Say(`state:\s+failed`))
+					Expect(testUI.Out).To(Say(`error:\s+it broke`))
+				})
+			})
+
+			When("polling the job returns another error", func() {
+				BeforeEach(func() {
+					fakeActor.PollJobWithProgressStub = nil
+					fakeActor.PollJobWithProgressReturns(nil, errors.New("poll error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("poll error"))
+					Expect(fakeActor.GetJobCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	When("the job has failed", func() {
+		BeforeEach(func() {
+			fakeActor.GetJobReturns(v2action.Job{GUID: "some-job-guid", Status: constant.JobStatusFailed, Error: "some error"}, nil, nil)
+		})
+
+		It("displays the job error without failing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`state:\s+failed`))
+			Expect(testUI.Out).To(Say(`error:\s+some error`))
+		})
+
+		When("--wait is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = true
+			})
+
+			It("does not poll and returns a job failed error", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2JobFailedError{JobGUID: "some-job-guid", Message: "some error"}))
+				Expect(fakeActor.PollJobWithProgressCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package v6
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . JobsActor
+
+type JobsActor interface {
+	GetJob(jobGUID string) (v2action.Job, v2action.Warnings, error)
+}
+
+type JobsCommand struct {
+	usage           interface{} `usage:"CF_NAME jobs"`
+	relatedCommands interface{} `related_commands:"job, delete-org, delete-space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       JobsActor
+}
+
+func (cmd *JobsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd JobsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting recent jobs as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": user.Name,
+	})
+
+	recentJobs := recentJobsFor(cmd.Config, user.Name)
+	if len(recentJobs) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No recent jobs found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("start time"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, recentJob := range recentJobs {
+		job, warnings, err := cmd.Actor.GetJob(recentJob.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		state := string(job.Status)
+		if err != nil {
+			if _, ok := err.(actionerror.JobNotFoundError); !ok {
+				return err
+			}
+			state = "expired"
+		}
+
+		table = append(table, []string{
+			recentJob.GUID,
+			cmd.UI.TranslateText(state),
+			recentJob.CreatedAt.Format(time.RFC1123),
+			recentJob.Description,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// recentJobsFor returns the jobs the user started against the current API
+// endpoint, most recent first.
+func recentJobsFor(config command.Config, username string) []configv3.RecentJob {
+	var jobs []configv3.RecentJob
+	for _, job := range config.RecentJobs() {
+		if job.Target == config.Target() && job.User == username {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// recordRecentJob remembers a job started by the current user so that it can
+// be followed up on with the jobs and job commands.
+func recordRecentJob(config command.Config, username string, jobGUID string, description string) {
+	if jobGUID == "" {
+		return
+	}
+	config.AddRecentJob(configv3.RecentJob{
+		GUID:        jobGUID,
+		Description: description,
+		Target:      config.Target(),
+		User:        username,
+		CreatedAt:   time.Now(),
+	})
+}
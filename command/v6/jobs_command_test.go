@@ -0,0 +1,140 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("jobs Command", func() {
+	var (
+		cmd             JobsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeJobsActor
+		binaryName      string
+		executeErr      error
+		createdAt       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeJobsActor)
+
+		cmd = JobsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetReturns("https://api.some-target.com")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		createdAt = time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the current user returns an error", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("get current user error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get current user error"))
+		})
+	})
+
+	When("no jobs were started by the user against the current target", func() {
+		BeforeEach(func() {
+			fakeConfig.RecentJobsReturns([]configv3.RecentJob{
+				{GUID: "other-user-job", Target: "https://api.some-target.com", User: "other-user"},
+				{GUID: "other-target-job", Target: "https://api.other-target.com", User: "some-user"},
+			})
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting recent jobs as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("No recent jobs found."))
+			Expect(fakeActor.GetJobCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user started jobs against the current target", func() {
+		BeforeEach(func() {
+			fakeConfig.RecentJobsReturns([]configv3.RecentJob{
+				{GUID: "job-2", Description: "delete space some-space in org some-org", Target: "https://api.some-target.com", User: "some-user", CreatedAt: createdAt},
+				{GUID: "other-user-job", Target: "https://api.some-target.com", User: "other-user"},
+				{GUID: "job-1", Description: "delete org some-org", Target: "https://api.some-target.com", User: "some-user", CreatedAt: createdAt},
+			})
+		})
+
+		When("the jobs can be retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.GetJobReturnsOnCall(0, v2action.Job{GUID: "job-2", Status: constant.JobStatusRunning}, v2action.Warnings{"warning-1"}, nil)
+				fakeActor.GetJobReturnsOnCall(1, v2action.Job{}, v2action.Warnings{"warning-2"}, actionerror.JobNotFoundError{GUID: "job-1"})
+			})
+
+			It("lists the jobs with their current state, most recent first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting recent jobs as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`guid\s+state\s+start time\s+description`))
+				Expect(testUI.Out).To(Say(`job-2\s+running\s+Wed, 02 Jan 2019 03:04:05 UTC\s+delete space some-space in org some-org`))
+				Expect(testUI.Out).To(Say(`job-1\s+expired\s+Wed, 02 Jan 2019 03:04:05 UTC\s+delete org some-org`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+
+				Expect(fakeActor.GetJobCallCount()).To(Equal(2))
+				Expect(fakeActor.GetJobArgsForCall(0)).To(Equal("job-2"))
+				Expect(fakeActor.GetJobArgsForCall(1)).To(Equal("job-1"))
+			})
+		})
+
+		When("getting a job returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetJobReturns(v2action.Job{}, v2action.Warnings{"warning-1"}, errors.New("get job error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get job error"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
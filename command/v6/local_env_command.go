@@ -0,0 +1,159 @@
+package v6
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+// RedactedCredential replaces each credential value in the VCAP_SERVICES
+// written by local-env unless --show-credentials is provided.
+const RedactedCredential = "[REDACTED]"
+
+//go:generate counterfeiter . LocalEnvActor
+
+type LocalEnvActor interface {
+	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v3action.EnvironmentVariableGroups, v3action.Warnings, error)
+}
+
+type LocalEnvCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	OutputFile      flag.Path    `short:"o" description:"Write the environment to FILE instead of stdout"`
+	ShowCredentials bool         `long:"show-credentials" description:"Include the service credentials instead of redacting them"`
+	usage           interface{}  `usage:"CF_NAME local-env APP_NAME [-o FILE] [--show-credentials]\n\n   Prints a JSON document with the VCAP_APPLICATION and VCAP_SERVICES environment variables the app receives from its bindings, for running the app locally. Credential values are redacted unless --show-credentials is provided."`
+	examples        interface{}  `examples:"CF_NAME local-env my-app -o vcap.json # Write the environment with redacted credentials\nCF_NAME local-env my-app --show-credentials -o vcap.json # Write the environment including credentials"`
+	relatedCommands interface{}  `related_commands:"env, service-env, services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LocalEnvActor
+}
+
+func (cmd *LocalEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd LocalEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	services := envGroups.System["VCAP_SERVICES"]
+	if services == nil {
+		services = map[string]interface{}{}
+	}
+	if !cmd.ShowCredentials {
+		services = redactServiceCredentials(services)
+	}
+
+	document := map[string]interface{}{
+		"VCAP_APPLICATION": envGroups.Application["VCAP_APPLICATION"],
+		"VCAP_SERVICES":    services,
+	}
+	raw, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutputFile == "" {
+		cmd.UI.DisplayText("{{.Document}}", map[string]interface{}{
+			"Document": string(raw),
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("Writing environment of app {{.AppName}} to {{.Path}}...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+		"Path":    cmd.OutputFile,
+	})
+	err = ioutil.WriteFile(string(cmd.OutputFile), append(raw, '\n'), 0600)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// redactServiceCredentials returns a copy of VCAP_SERVICES with every
+// credential value replaced, keeping the credential names so that the shape
+// of each binding is preserved.
+func redactServiceCredentials(services interface{}) interface{} {
+	offerings, ok := services.(map[string]interface{})
+	if !ok {
+		return services
+	}
+
+	redacted := map[string]interface{}{}
+	for label, instances := range offerings {
+		bindings, ok := instances.([]interface{})
+		if !ok {
+			redacted[label] = instances
+			continue
+		}
+
+		var redactedBindings []interface{}
+		for _, binding := range bindings {
+			fields, ok := binding.(map[string]interface{})
+			if !ok {
+				redactedBindings = append(redactedBindings, binding)
+				continue
+			}
+
+			redactedFields := map[string]interface{}{}
+			for name, value := range fields {
+				if name == "credentials" {
+					value = redactValues(value)
+				}
+				redactedFields[name] = value
+			}
+			redactedBindings = append(redactedBindings, redactedFields)
+		}
+		redacted[label] = redactedBindings
+	}
+	return redacted
+}
+
+func redactValues(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		redacted := map[string]interface{}{}
+		for key, nested := range typedValue {
+			redacted[key] = redactValues(nested)
+		}
+		return redacted
+	case []interface{}:
+		var redacted []interface{}
+		for _, nested := range typedValue {
+			redacted = append(redacted, redactValues(nested))
+		}
+		return redacted
+	default:
+		return RedactedCredential
+	}
+}
@@ -0,0 +1,179 @@
+package v6_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("local-env Command", func() {
+	var (
+		cmd             LocalEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeLocalEnvActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeLocalEnvActor)
+
+		cmd = LocalEnvCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the environment fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+				v3action.EnvironmentVariableGroups{},
+				v3action.Warnings{"some-warning"},
+				errors.New("some-error"),
+			)
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("getting the environment succeeds", func() {
+		var document map[string]interface{}
+
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+				v3action.EnvironmentVariableGroups{
+					Application: map[string]interface{}{
+						"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+					},
+					System: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{
+							"p-mysql": []interface{}{
+								map[string]interface{}{
+									"name": "mydb",
+									"credentials": map[string]interface{}{
+										"uri":   "mysql://user:pass@db:3306/app",
+										"hosts": []interface{}{"db-1", "db-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("gets the environment of the app in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("no output file is provided", func() {
+			JustBeforeEach(func() {
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &document)).To(Succeed())
+			})
+
+			It("prints the environment with redacted credentials", func() {
+				Expect(document).To(Equal(map[string]interface{}{
+					"VCAP_APPLICATION": map[string]interface{}{"application_name": "some-app"},
+					"VCAP_SERVICES": map[string]interface{}{
+						"p-mysql": []interface{}{
+							map[string]interface{}{
+								"name": "mydb",
+								"credentials": map[string]interface{}{
+									"uri":   "[REDACTED]",
+									"hosts": []interface{}{"[REDACTED]", "[REDACTED]"},
+								},
+							},
+						},
+					},
+				}))
+			})
+
+			When("--show-credentials is provided", func() {
+				BeforeEach(func() {
+					cmd.ShowCredentials = true
+				})
+
+				It("prints the credentials", func() {
+					services := document["VCAP_SERVICES"].(map[string]interface{})
+					binding := services["p-mysql"].([]interface{})[0].(map[string]interface{})
+					Expect(binding["credentials"]).To(HaveKeyWithValue("uri", "mysql://user:pass@db:3306/app"))
+				})
+			})
+		})
+
+		When("an output file is provided", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "local-env")
+				Expect(err).ToNot(HaveOccurred())
+				cmd.OutputFile = flag.Path(filepath.Join(tmpDir, "vcap.json"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("writes the environment to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Writing environment of app some-app to .*vcap\.json\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				raw, err := ioutil.ReadFile(filepath.Join(tmpDir, "vcap.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(json.Unmarshal(raw, &document)).To(Succeed())
+				Expect(document).To(HaveKey("VCAP_SERVICES"))
+				Expect(string(raw)).ToNot(ContainSubstring("mysql://"))
+			})
+		})
+	})
+})
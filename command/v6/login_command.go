@@ -1,20 +1,34 @@
 package v6
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	ccv2constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/browser"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LoginActor
@@ -23,15 +37,41 @@ const maxLoginTries = 3
 
 type LoginActor interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error
+	AuthenticateWithDeviceAuthorization(deviceAuthorization v3action.DeviceAuthorization, timeout time.Duration) error
+	AuthenticateWithRefreshToken(refreshToken string) error
+	AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error)
 	GetLoginPrompts() map[string]coreconfig.AuthPrompt
+	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
+	GetOrganizations() ([]v3action.Organization, v3action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v3action.Space, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+	RefreshLoginPrompts() (map[string]coreconfig.AuthPrompt, error)
 	SetTarget(settings v3action.TargetSettings) (v3action.Warnings, error)
+	StartDeviceAuthorization() (v3action.DeviceAuthorization, error)
+	VerifyAuthorizationCodeRedirect(redirectURI string) error
 }
 
 //go:generate counterfeiter . VersionChecker
 
+// VersionChecker is the V2 actor used once the user has authenticated, to
+// check the minimum CLI version and to summarize what the user can do.
 type VersionChecker interface {
 	MinCLIVersion() string
+	MinCLIVersionEnforcement() ccv2constant.MinCLIVersionEnforcement
 	CloudControllerAPIVersion() string
+	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetUserOrganizationRoles(userGUID string) (v2action.UserRoles, v2action.Warnings, error)
+	GetUserSpaceRoles(userGUID string, orgGUID string) (v2action.UserRoles, v2action.Warnings, error)
+}
+
+// notableFeatureFlags are the platform feature flags shown after logging in,
+// as they decide what users can push and run.
+var notableFeatureFlags = []string{
+	"diego_docker",
+	"task_creation",
+	"service_instance_sharing",
+	"app_bits_upload",
 }
 
 //go:generate counterfeiter . ActorMaker
@@ -78,20 +118,28 @@ var checkerMaker CheckerMakerFunc = func(config command.Config, ui command.UI, t
 }
 
 type LoginCommand struct {
-	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
-	Organization      string      `short:"o" description:"Org"`
-	Password          string      `short:"p" description:"Password"`
-	Space             string      `short:"s" description:"Space"`
-	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
-	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
-	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
-	relatedCommands   interface{} `related_commands:"api, auth, target"`
+	APIEndpoint       string                      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	ClientID          string                      `long:"client-id" description:"Client ID of a (non-user) service account to log in with"`
+	ClientSecret      string                      `long:"client-secret" description:"Client secret of the service account; prompted for if not provided. Requires --client-id"`
+	Device            bool                        `long:"device" description:"Log in by approving a code from another device, such as a phone or a computer with a browser"`
+	Organization      string                      `short:"o" description:"Org"`
+	Origin            string                      `long:"origin" description:"Indicates the identity provider to be used for login"`
+	Password          string                      `short:"p" description:"Password"`
+	RefreshToken      flag.PathWithExistenceCheck `long:"refresh-token" description:"Path to a file containing a refresh token to start the session with, instead of logging in"`
+	Space             string                      `short:"s" description:"Space"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	SSO               bool                        `long:"sso" description:"Prompt for a one-time passcode to login"`
+	SSOBrowser        bool                        `long:"sso-browser" description:"Log in through the browser and return to the CLI automatically"`
+	SSOPasscode       string                      `long:"sso-passcode" description:"One-time passcode"`
+	Timeout           flag.Timeout                `long:"timeout" description:"Maximum time to wait for the login request to be approved (e.g. 90s, 10m); numbers without a unit are minutes. Requires --device"`
+	Username          string                      `short:"u" description:"Username"`
+	usage             interface{}                 `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --sso-browser | --device [--timeout TIMEOUT] | --refresh-token FILE | --origin ORIGIN]\n   CF_NAME login [-a API_URL] --client-id CLIENT_ID [--client-secret CLIENT_SECRET] [-o ORG] [-s SPACE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --sso-browser (CF_NAME will open a browser to log in, then finish logging in once you have)\n   CF_NAME login --device (approve the login from a browser on another device, such as when logged in over SSH)\n   CF_NAME login -a https://api.example.com --refresh-token ~/refresh-token (start a session from a stored refresh token, such as in CI)\n   CF_NAME login --origin ldap (authenticate against the ldap identity provider)\n   CF_NAME login --client-id my-service-account -o my-org -s my-space (log in as a service account; CF_NAME will prompt for the client secret)"`
+	relatedCommands   interface{}                 `related_commands:"api, auth, target"`
 
 	UI           command.UI
 	Actor        LoginActor
 	ActorMaker   ActorMaker
+	Browser      Browser
 	Checker      VersionChecker
 	CheckerMaker CheckerMaker
 	Config       command.Config
@@ -105,17 +153,20 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.CheckerMaker = checkerMaker
 	cmd.Actor = actor
+	cmd.Browser = browser.Browser{}
 	cmd.UI = ui
 	cmd.Config = config
 	return nil
 }
 
 func (cmd *LoginCommand) Execute(args []string) error {
-	if !cmd.Config.ExperimentalLogin() {
-		return translatableerror.UnrefactoredCommandError{}
-	}
 	cmd.UI.DisplayWarning("Using experimental login command, some behavior may be different")
 
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
 	if cmd.APIEndpoint != "" {
 		cmd.UI.DisplayTextWithFlavor("API endpoint: {{.APIEndpoint}}", map[string]interface{}{
 			"APIEndpoint": cmd.APIEndpoint,
@@ -145,7 +196,7 @@ func (cmd *LoginCommand) Execute(args []string) error {
 		URL:               endpoint.String(),
 		SkipSSLValidation: cmd.Config.SkipSSLValidation() || cmd.SkipSSLValidation,
 	}
-	_, err := cmd.Actor.SetTarget(settings)
+	_, err = cmd.Actor.SetTarget(settings)
 	if err != nil {
 		return err
 	}
@@ -157,21 +208,41 @@ func (cmd *LoginCommand) Execute(args []string) error {
 
 	defer cmd.showStatus()
 
-	if cmd.Config.UAAGrantType() == "client_credentials" {
+	if cmd.Config.UAAGrantType() == "client_credentials" && cmd.ClientID == "" {
 		return errors.New("Service account currently logged in. Use 'cf logout' to log out service account and try again.")
 	}
 
 	var authErr error
-	if cmd.SSO == true || cmd.SSOPasscode != "" {
-		if cmd.SSO && cmd.SSOPasscode != "" {
-			return translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--sso"}}
+	if cmd.ClientID != "" {
+		err = cmd.authenticateClientCredentials()
+		if err != nil {
+			return err
+		}
+	} else if cmd.RefreshToken != "" {
+		err = cmd.authenticateWithRefreshToken()
+		if err != nil {
+			return err
+		}
+	} else if cmd.Device {
+		err = cmd.authenticateWithDevice()
+		if err != nil {
+			return err
+		}
+	} else if cmd.SSOBrowser {
+		authErr = cmd.authenticateSSOBrowser()
+		if _, ok := authErr.(uaa.RedirectURINotRegisteredError); ok {
+			return authErr
 		}
+	} else if cmd.SSO == true || cmd.SSOPasscode != "" {
 		authErr = cmd.authenticateSSO()
 	} else {
 		authErr = cmd.authenticate()
 	}
 
 	if authErr != nil {
+		if isCredentialPolicyError(authErr) {
+			return authErr
+		}
 		return errors.New("Unable to authenticate.")
 	}
 
@@ -180,9 +251,159 @@ func (cmd *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	org, targeted, err := cmd.targetOrganization()
+	if err != nil || !targeted {
+		return err
+	}
+
+	return cmd.targetSpace(org)
+}
+
+// targetOrganization targets the org given with -o, the only org the user
+// can see, or the one they choose from a menu. It returns false when there
+// is no org to target or the user skipped choosing one.
+func (cmd *LoginCommand) targetOrganization() (v3action.Organization, bool, error) {
+	var org v3action.Organization
+
+	if cmd.Organization != "" {
+		var warnings v3action.Warnings
+		var err error
+		org, warnings, err = cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v3action.Organization{}, false, err
+		}
+	} else {
+		orgs, warnings, err := cmd.Actor.GetOrganizations()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v3action.Organization{}, false, err
+		}
+
+		switch len(orgs) {
+		case 0:
+			return v3action.Organization{}, false, nil
+		case 1:
+			org = orgs[0]
+		default:
+			names := make([]string, len(orgs))
+			for i, o := range orgs {
+				names[i] = o.Name
+			}
+
+			name, err := cmd.promptForChoice(names, "Select an org (or press enter to skip):")
+			if err != nil || name == "" {
+				return v3action.Organization{}, false, err
+			}
+
+			org, warnings, err = cmd.Actor.GetOrganizationByName(name)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return v3action.Organization{}, false, err
+			}
+		}
+	}
+
+	cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+	cmd.Config.UnsetSpaceInformation()
+	cmd.UI.DisplayTextWithFlavor("Targeted org {{.Organization}}", map[string]interface{}{
+		"Organization": org.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	return org, true, nil
+}
+
+// targetSpace targets the space given with -s, the only space in the org, or
+// the one the user chooses from a menu.
+func (cmd *LoginCommand) targetSpace(org v3action.Organization) error {
+	var space v3action.Space
+
+	if cmd.Space != "" {
+		var warnings v3action.Warnings
+		var err error
+		space, warnings, err = cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	} else {
+		spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		switch len(spaces) {
+		case 0:
+			return nil
+		case 1:
+			space = spaces[0]
+		default:
+			names := make([]string, len(spaces))
+			for i, s := range spaces {
+				names[i] = s.Name
+			}
+
+			name, err := cmd.promptForChoice(names, "Select a space (or press enter to skip):")
+			if err != nil || name == "" {
+				return err
+			}
+
+			space, warnings, err = cmd.Actor.GetSpaceByNameAndOrganization(name, org.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cmd.Config.SetSpaceInformation(space.GUID, space.Name, cmd.spaceAllowsSSH(org.GUID, space.Name))
+	cmd.UI.DisplayTextWithFlavor("Targeted space {{.Space}}", map[string]interface{}{
+		"Space": space.Name,
+	})
+	cmd.UI.DisplayNewline()
+
 	return nil
 }
 
+// promptForChoice shows the names as a menu until the user picks one of them,
+// types a name, or skips by pressing enter.
+func (cmd *LoginCommand) promptForChoice(names []string, prompt string) (string, error) {
+	for {
+		name, err := cmd.UI.DisplayTextMenu(names, prompt)
+		if err != ui.ErrInvalidIndex {
+			return name, err
+		}
+		cmd.UI.DisplayNewline()
+	}
+}
+
+// spaceAllowsSSH looks up whether SSH is allowed in the space, which only the
+// V2 API reports. SSH is taken to be disabled when the lookup fails.
+func (cmd *LoginCommand) spaceAllowsSSH(orgGUID string, spaceName string) bool {
+	checker, err := cmd.checker()
+	if err != nil {
+		return false
+	}
+
+	space, warnings, err := checker.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	cmd.UI.DisplayWarnings(warnings)
+	return err == nil && space.AllowSSH
+}
+
+// checker returns the V2 actor, building it the first time it is needed.
+func (cmd *LoginCommand) checker() (VersionChecker, error) {
+	if cmd.Checker == nil {
+		checker, err := cmd.CheckerMaker.NewVersionChecker(cmd.Config, cmd.UI, true)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Checker = checker
+	}
+	return cmd.Checker, nil
+}
+
 func (cmd *LoginCommand) authenticate() error {
 	prompts := cmd.Actor.GetLoginPrompts()
 	credentials := make(map[string]string)
@@ -230,15 +451,33 @@ func (cmd *LoginCommand) authenticate() error {
 
 		cmd.UI.DisplayText("Authenticating...")
 
-		err = cmd.Actor.Authenticate(promptedCredentials, "", constant.GrantTypePassword)
+		err = cmd.Actor.Authenticate(promptedCredentials, cmd.Origin, constant.GrantTypePassword)
+
+		if err != nil && !isCredentialPolicyError(err) {
+			newKeys, promptErr := cmd.promptForNewLoginPrompts(prompts, credentials, promptedCredentials)
+			if promptErr != nil {
+				return promptErr
+			}
+
+			if len(newKeys) > 0 {
+				for _, key := range newKeys {
+					if prompts[key].Type == coreconfig.AuthPromptTypePassword {
+						passwordKeys = append(passwordKeys, key)
+					}
+				}
+
+				cmd.UI.DisplayText("Authenticating...")
+				err = cmd.Actor.Authenticate(promptedCredentials, cmd.Origin, constant.GrantTypePassword)
+			}
+		}
+
+		if isCredentialPolicyError(err) {
+			break
+		}
 
 		if err != nil {
 			cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
 			cmd.UI.DisplayNewline()
-
-			if _, ok := err.(uaa.AccountLockedError); ok {
-				break
-			}
 		}
 
 		if err == nil {
@@ -253,6 +492,364 @@ func (cmd *LoginCommand) authenticate() error {
 	return nil
 }
 
+// promptForNewLoginPrompts re-fetches the login prompts after a failed
+// authentication, in case the server has started asking for more than it did
+// when the prompts were first listed (for example, a newly required MFA
+// code). Only the new prompts are asked for; their answers are added to
+// promptedCredentials, and text answers are also kept in credentials for
+// later attempts. It returns the keys of the new prompts, which are added to
+// prompts; none are returned if the prompts cannot be fetched again.
+func (cmd *LoginCommand) promptForNewLoginPrompts(prompts map[string]coreconfig.AuthPrompt, credentials map[string]string, promptedCredentials map[string]string) ([]string, error) {
+	refreshedPrompts, err := cmd.Actor.RefreshLoginPrompts()
+	if err != nil {
+		return nil, nil
+	}
+
+	var newKeys []string
+	for key, prompt := range refreshedPrompts {
+		if _, ok := prompts[key]; ok || key == "passcode" {
+			continue
+		}
+		prompts[key] = prompt
+		newKeys = append(newKeys, key)
+	}
+
+	if len(newKeys) == 0 {
+		return nil, nil
+	}
+	sort.Strings(newKeys)
+
+	cmd.UI.DisplayText("The login server is asking for more information.")
+	cmd.UI.DisplayNewline()
+
+	for _, key := range newKeys {
+		var (
+			value string
+			err   error
+		)
+		isPassword := prompts[key].Type == coreconfig.AuthPromptTypePassword
+		if isPassword {
+			value, err = cmd.UI.DisplayPasswordPrompt(prompts[key].DisplayName)
+		} else {
+			value, err = cmd.UI.DisplayTextPrompt(prompts[key].DisplayName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmd.UI.DisplayNewline()
+
+		if !isPassword {
+			credentials[key] = value
+		}
+		promptedCredentials[key] = value
+	}
+
+	return newKeys, nil
+}
+
+// isCredentialPolicyError reports whether the login server refused to
+// authenticate the user because of its credential policy. Trying again
+// cannot succeed, so these errors end the login with their own guidance.
+func isCredentialPolicyError(err error) bool {
+	switch err.(type) {
+	case uaa.AccountLockedError, uaa.PasswordExpiredError:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateFlags returns an error for flags that are missing the flag they
+// require or that cannot be combined, before the API is contacted.
+func (cmd *LoginCommand) validateFlags() error {
+	if cmd.Timeout.Value != 0 && !cmd.Device {
+		return translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--device"}
+	}
+
+	if cmd.ClientSecret != "" && cmd.ClientID == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--client-secret", Arg2: "--client-id"}
+	}
+
+	var loginFlag string
+	switch {
+	case cmd.ClientID != "":
+		loginFlag = "--client-id"
+	case cmd.RefreshToken != "":
+		loginFlag = "--refresh-token"
+	case cmd.Device:
+		loginFlag = "--device"
+	case cmd.SSOBrowser:
+		if cmd.SSO || cmd.SSOPasscode != "" || cmd.Origin != "" {
+			otherFlag := "--sso"
+			if cmd.SSOPasscode != "" {
+				otherFlag = "--sso-passcode"
+			} else if cmd.Origin != "" {
+				otherFlag = "--origin"
+			}
+			return translatableerror.ArgumentCombinationError{Args: []string{"--sso-browser", otherFlag}}
+		}
+		return nil
+	case cmd.SSO || cmd.SSOPasscode != "":
+		if cmd.SSO && cmd.SSOPasscode != "" {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--sso"}}
+		}
+		if cmd.Origin != "" {
+			ssoFlag := "--sso"
+			if cmd.SSOPasscode != "" {
+				ssoFlag = "--sso-passcode"
+			}
+			return translatableerror.ArgumentCombinationError{Args: []string{ssoFlag, "--origin"}}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	if otherFlag := cmd.conflictingFlag(loginFlag); otherFlag != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{loginFlag, otherFlag}}
+	}
+	return nil
+}
+
+// conflictingFlag returns the first flag given, other than loginFlag, that
+// chooses a different way to log in, or "" if there is none.
+func (cmd *LoginCommand) conflictingFlag(loginFlag string) string {
+	loginFlags := []struct {
+		flag  string
+		isSet bool
+	}{
+		{"--client-id", cmd.ClientID != ""},
+		{"--refresh-token", cmd.RefreshToken != ""},
+		{"--device", cmd.Device},
+		{"--sso", cmd.SSO},
+		{"--sso-passcode", cmd.SSOPasscode != ""},
+		{"--sso-browser", cmd.SSOBrowser},
+		{"--origin", cmd.Origin != ""},
+		{"-u", cmd.Username != ""},
+		{"-p", cmd.Password != ""},
+	}
+	for _, f := range loginFlags {
+		if f.isSet && f.flag != loginFlag {
+			return f.flag
+		}
+	}
+	return ""
+}
+
+// authenticateClientCredentials logs in as the service account given with
+// --client-id, prompting for its secret unless --client-secret is given.
+func (cmd *LoginCommand) authenticateClientCredentials() error {
+	secret := cmd.ClientSecret
+	if secret == "" {
+		var err error
+		secret, err = cmd.UI.DisplayPasswordPrompt("Client secret")
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	err := cmd.Actor.Authenticate(map[string]string{
+		"client_id":     cmd.ClientID,
+		"client_secret": secret,
+	}, "", constant.GrantTypeClientCredentials)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// authenticateWithRefreshToken starts the session from the refresh token in
+// the --refresh-token file.
+func (cmd *LoginCommand) authenticateWithRefreshToken() error {
+	rawRefreshToken, err := ioutil.ReadFile(string(cmd.RefreshToken))
+	if err != nil {
+		return err
+	}
+
+	refreshToken := strings.TrimSpace(string(rawRefreshToken))
+	if refreshToken == "" {
+		return translatableerror.InvalidRefreshTokenError{}
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	err = cmd.Actor.AuthenticateWithRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// authenticateWithDevice displays a code for the user to approve from another
+// device and waits until they have.
+func (cmd *LoginCommand) authenticateWithDevice() error {
+	deviceAuthorization, err := cmd.Actor.StartDeviceAuthorization()
+	if err != nil {
+		return err
+	}
+
+	shared.DisplayDeviceAuthorization(cmd.UI, deviceAuthorization.VerificationURI, deviceAuthorization.UserCode, deviceAuthorization.VerificationURIComplete)
+
+	err = cmd.Actor.AuthenticateWithDeviceAuthorization(deviceAuthorization, cmd.Timeout.Value)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// authenticateSSOBrowser logs the user in through the browser. It listens on
+// a local port for UAA to redirect the browser back with an authorization
+// code once the user has logged in, and exchanges the code for tokens.
+func (cmd *LoginCommand) authenticateSSOBrowser() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr())
+	state, err := newAuthorizationState()
+	if err != nil {
+		return err
+	}
+
+	codeVerifier, codeChallenge, err := newCodeVerifier()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.VerifyAuthorizationCodeRedirect(redirectURI)
+	if err != nil {
+		return err
+	}
+
+	authorizeURL, err := cmd.Actor.AuthorizationCodeURL(redirectURI, state, codeChallenge)
+	if err != nil {
+		return err
+	}
+
+	results := make(chan authorizationCallbackResult, 1)
+	server := &http.Server{Handler: authorizationCallbackHandler(state, results)}
+	go server.Serve(listener)
+	defer server.Close()
+
+	cmd.UI.DisplayText("Opening the browser to log in. If it does not open, visit:")
+	cmd.UI.DisplayText(authorizeURL)
+	cmd.UI.DisplayNewline()
+	if err = cmd.Browser.Open(authorizeURL); err != nil {
+		cmd.UI.DisplayWarning("Unable to open the browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	var result authorizationCallbackResult
+	select {
+	case result = <-results:
+		if result.err != nil {
+			cmd.UI.DisplayWarning(result.err.Error())
+			return result.err
+		}
+	case <-time.After(ssoBrowserTimeout):
+		cmd.UI.DisplayWarning("Timed out waiting to log in through the browser. If the browser showed an error about the redirect URI, log in with --sso instead.")
+		return errors.New("timed out waiting for the browser login")
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	err = cmd.Actor.Authenticate(map[string]string{
+		"code":          result.code,
+		"code_verifier": codeVerifier,
+		"redirect_uri":  redirectURI,
+	}, "", constant.GrantTypeAuthorizationCode)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// ssoBrowserTimeout is how long to wait for the user to log in through the
+// browser.
+var ssoBrowserTimeout = 5 * time.Minute
+
+// authorizationCallbackResult is either the authorization code UAA redirected
+// the browser back with, or the reason the user could not log in.
+type authorizationCallbackResult struct {
+	code string
+	err  error
+}
+
+// authorizationCallbackHandler handles UAA redirecting the browser back to
+// the CLI, passing on the authorization code when the state matches the one
+// the login was started with. Only the first result is passed on, in case the
+// browser makes the request again.
+func authorizationCallbackHandler(state string, results chan<- authorizationCallbackResult) http.Handler {
+	send := func(result authorizationCallbackResult) {
+		select {
+		case results <- result:
+		default:
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		switch {
+		case query.Get("state") != state:
+			http.Error(w, "The login could not be verified. Return to the CLI and try again.", http.StatusBadRequest)
+		case query.Get("error") != "":
+			description := query.Get("error_description")
+			if description == "" {
+				description = query.Get("error")
+			}
+			http.Error(w, "Login failed. Return to the CLI and try again.", http.StatusUnauthorized)
+			send(authorizationCallbackResult{err: errors.New(description)})
+		case query.Get("code") == "":
+			http.Error(w, "No authorization code was received. Return to the CLI and try again.", http.StatusBadRequest)
+		default:
+			fmt.Fprintln(w, "Login complete. You can close this window and return to the CLI.")
+			send(authorizationCallbackResult{code: query.Get("code")})
+		}
+	})
+}
+
+// newAuthorizationState returns a random value which UAA passes back with the
+// authorization code, so that callbacks from logins the CLI did not start are
+// ignored.
+func newAuthorizationState() (string, error) {
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(state), nil
+}
+
+// newCodeVerifier returns a random PKCE code verifier along with its S256
+// code challenge, so that only the CLI can exchange the authorization code
+// UAA redirects the browser back with.
+func newCodeVerifier() (string, string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", err
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(random)
+	challenge := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(challenge[:]), nil
+}
+
 func (cmd *LoginCommand) authenticateSSO() error {
 	prompts := cmd.Actor.GetLoginPrompts()
 	credentials := make(map[string]string)
@@ -277,6 +874,10 @@ func (cmd *LoginCommand) authenticateSSO() error {
 		cmd.UI.DisplayText("Authenticating...")
 		err = cmd.Actor.Authenticate(credentialsCopy, "", constant.GrantTypePassword)
 
+		if isCredentialPolicyError(err) {
+			break
+		}
+
 		if err != nil {
 			cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
 			cmd.UI.DisplayNewline()
@@ -294,15 +895,41 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return nil
 }
 
+// checkMinCLIVersion warns, or fails when the Cloud Controller asks for it to
+// be enforced, if the CLI is older than the Cloud Controller's minimum CLI
+// version. The Cloud Controller is only asked once a day per target.
 func (cmd *LoginCommand) checkMinCLIVersion() error {
-	newChecker, err := cmd.CheckerMaker.NewVersionChecker(cmd.Config, cmd.UI, true)
-	if err != nil {
-		return err
+	check, cached := cmd.Config.MinCLIVersionCheck()
+	if !cached {
+		checker, err := cmd.checker()
+		if err != nil {
+			return err
+		}
+
+		check = configv3.MinCLIVersionCheck{
+			Target:        cmd.Config.Target(),
+			APIVersion:    checker.CloudControllerAPIVersion(),
+			MinCLIVersion: checker.MinCLIVersion(),
+			Enforcement:   string(checker.MinCLIVersionEnforcement()),
+			CheckedAt:     time.Now(),
+		}
+		cmd.Config.SetMinCLIVersionCheck(check)
 	}
 
-	cmd.Checker = newChecker
-	cmd.Config.SetMinCLIVersion(cmd.Checker.MinCLIVersion())
-	return command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, cmd.Checker.CloudControllerAPIVersion(), cmd.UI)
+	cmd.Config.SetMinCLIVersion(check.MinCLIVersion)
+
+	switch ccv2constant.MinCLIVersionEnforcement(check.Enforcement) {
+	case ccv2constant.MinCLIVersionOff:
+		return nil
+	case ccv2constant.MinCLIVersionBlock:
+		err := command.FailIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, check.APIVersion)
+		if err != nil {
+			cmd.Config.SetTokenInformation("", "", "")
+		}
+		return err
+	default:
+		return command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, check.APIVersion, cmd.UI)
+	}
 }
 
 func (cmd *LoginCommand) passwordPrompts(prompts map[string]coreconfig.AuthPrompt, credentials map[string]string, passwordKeys []string) (map[string]string, error) {
@@ -369,7 +996,99 @@ func (cmd *LoginCommand) showStatus() {
 		return
 	}
 	tableContent = append(tableContent, []string{cmd.UI.TranslateText("User:"), user})
+	tableContent = append(tableContent, cmd.targetStatus()...)
 
 	cmd.UI.DisplayKeyValueTable("", tableContent, 3)
 	cmd.UI.DisplayNewline()
 }
+
+// targetStatus returns the summary rows for the targeted org and space, the
+// user's roles in them and the notable feature flags. Rows whose details
+// cannot be fetched are left out rather than failing the login.
+func (cmd *LoginCommand) targetStatus() [][]string {
+	var rows [][]string
+
+	org := cmd.Config.TargetedOrganization()
+	if cmd.Config.HasTargetedOrganization() {
+		rows = append(rows, []string{cmd.UI.TranslateText("Org:"), org.Name})
+	}
+
+	space := cmd.Config.TargetedSpace()
+	if cmd.Config.HasTargetedSpace() {
+		sshStatus := cmd.UI.TranslateText("disabled")
+		if space.AllowSSH {
+			sshStatus = cmd.UI.TranslateText("allowed")
+		}
+		rows = append(rows,
+			[]string{cmd.UI.TranslateText("Space:"), space.Name},
+			[]string{cmd.UI.TranslateText("SSH:"), sshStatus},
+		)
+	}
+
+	if _, err := cmd.checker(); err != nil {
+		return rows
+	}
+
+	if cmd.Config.HasTargetedOrganization() {
+		if roles := cmd.userRoles(org.GUID, space.GUID); len(roles) > 0 {
+			rows = append(rows, []string{cmd.UI.TranslateText("Roles:"), strings.Join(roles, ", ")})
+		}
+	}
+
+	featureFlags, warnings, err := cmd.Checker.GetFeatureFlags()
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		if flags := notableFeatureFlagStates(featureFlags); len(flags) > 0 {
+			rows = append(rows, []string{cmd.UI.TranslateText("Feature flags:"), strings.Join(flags, ", ")})
+		}
+	}
+
+	return rows
+}
+
+// userRoles lists the current user's roles in the given org and, when
+// spaceGUID is set, in that space.
+func (cmd *LoginCommand) userRoles(orgGUID string, spaceGUID string) []string {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return nil
+	}
+
+	var roles []string
+	orgRoles, warnings, err := cmd.Checker.GetUserOrganizationRoles(user.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		for _, role := range orgRoles[orgGUID] {
+			roles = append(roles, cmd.UI.TranslateText("org {{.Role}}", map[string]interface{}{"Role": role}))
+		}
+	}
+
+	if spaceGUID == "" {
+		return roles
+	}
+
+	spaceRoles, warnings, err := cmd.Checker.GetUserSpaceRoles(user.GUID, orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		for _, role := range spaceRoles[spaceGUID] {
+			roles = append(roles, cmd.UI.TranslateText("space {{.Role}}", map[string]interface{}{"Role": role}))
+		}
+	}
+
+	return roles
+}
+
+func notableFeatureFlagStates(featureFlags []v2action.FeatureFlag) []string {
+	states := map[string]v2action.FeatureFlagState{}
+	for _, flag := range featureFlags {
+		states[flag.Name] = flag.State()
+	}
+
+	var flags []string
+	for _, name := range notableFeatureFlags {
+		if state, ok := states[name]; ok {
+			flags = append(flags, fmt.Sprintf("%s %s", name, state))
+		}
+	}
+	return flags
+}
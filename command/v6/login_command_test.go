@@ -1,15 +1,27 @@
 package v6_test
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
-
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	ccv2constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -56,632 +68,1574 @@ var _ = Describe("login Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
-	When("the experimental login flag is not set", func() {
-		It("returns an UnrefactoredCommandError", func() {
-			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
-		})
+	It("displays a helpful warning", func() {
+		Expect(testUI.Err).To(Say("Using experimental login command, some behavior may be different"))
 	})
 
-	When("the experimental login flag is set", func() {
+	Describe("API Endpoint", func() {
 		BeforeEach(func() {
-			fakeConfig.ExperimentalLoginReturns(true)
-		})
-
-		It("displays a helpful warning", func() {
-			Expect(testUI.Err).To(Say("Using experimental login command, some behavior may be different"))
+			fakeConfig.APIVersionReturns("3.4.5")
 		})
 
-		Describe("API Endpoint", func() {
+		When("user provides the api endpoint using the -a flag", func() {
 			BeforeEach(func() {
-				fakeConfig.APIVersionReturns("3.4.5")
+				cmd.APIEndpoint = "api.boshlite.com"
 			})
 
-			When("user provides the api endpoint using the -a flag", func() {
+			It("target the provided api endpoint", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("API endpoint: api.boshlite.com\n\n"))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				actualSettings := fakeActor.SetTargetArgsForCall(0)
+				Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
+			})
+		})
+
+		When("user does not provide the api endpoint using the -a flag", func() {
+			When("config has API endpoint already set", func() {
 				BeforeEach(func() {
-					cmd.APIEndpoint = "api.boshlite.com"
+					fakeConfig.TargetReturns("api.fake.com")
 				})
 
-				It("target the provided api endpoint", func() {
+				It("does not prompt the user for an API endpoint", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(testUI.Out).To(Say("API endpoint: api.boshlite.com\n\n"))
+					Expect(testUI.Out).To(Say(`API endpoint:\s+api\.fake\.com \(API version: 3\.4\.5\)`))
 					Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
-					actualSettings := fakeActor.SetTargetArgsForCall(0)
-					Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
 				})
 			})
 
-			When("user does not provide the api endpoint using the -a flag", func() {
-				When("config has API endpoint already set", func() {
-					BeforeEach(func() {
-						fakeConfig.TargetReturns("api.fake.com")
-					})
-
-					It("does not prompt the user for an API endpoint", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(testUI.Out).To(Say(`API endpoint:\s+api\.fake\.com \(API version: 3\.4\.5\)`))
-						Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
-					})
-				})
-
-				When("the user enters something at the prompt", func() {
-					BeforeEach(func() {
-						input.Write([]byte("api.boshlite.com\n"))
-						cmd.APIEndpoint = ""
-					})
-
-					It("targets the API that the user inputted", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(testUI.Out).To(Say("API endpoint:"))
-						Expect(testUI.Out).To(Say("api.boshlite.com\n"))
-						Expect(testUI.Out).To(Say(`API endpoint:\s+api\.boshlite\.com \(API version: 3\.4\.5\)`))
-
-						Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
-						actualSettings := fakeActor.SetTargetArgsForCall(0)
-						Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
-					})
+			When("the user enters something at the prompt", func() {
+				BeforeEach(func() {
+					input.Write([]byte("api.boshlite.com\n"))
+					cmd.APIEndpoint = ""
 				})
 
-				When("the user inputs an empty API", func() {
-					BeforeEach(func() {
-						cmd.APIEndpoint = ""
-						input.Write([]byte("\n\napi.boshlite.com\n"))
-					})
+				It("targets the API that the user inputted", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("API endpoint:"))
+					Expect(testUI.Out).To(Say("api.boshlite.com\n"))
+					Expect(testUI.Out).To(Say(`API endpoint:\s+api\.boshlite\.com \(API version: 3\.4\.5\)`))
 
-					It("reprompts for the API", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(testUI.Out).To(Say("API endpoint:"))
-						Expect(testUI.Out).To(Say("API endpoint:"))
-						Expect(testUI.Out).To(Say("API endpoint:"))
-						Expect(testUI.Out).To(Say("api.boshlite.com\n"))
-						Expect(testUI.Out).To(Say(`API endpoint:\s+api\.boshlite\.com \(API version: 3\.4\.5\)`))
-					})
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+					actualSettings := fakeActor.SetTargetArgsForCall(0)
+					Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
 				})
 			})
 
-			When("the endpoint has trailing slashes", func() {
+			When("the user inputs an empty API", func() {
 				BeforeEach(func() {
-					cmd.APIEndpoint = "api.boshlite.com////"
+					cmd.APIEndpoint = ""
+					input.Write([]byte("\n\napi.boshlite.com\n"))
 				})
 
-				It("strips the backslashes before using the endpoint", func() {
+				It("reprompts for the API", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
-					actualSettings := fakeActor.SetTargetArgsForCall(0)
-					Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
-
+					Expect(testUI.Out).To(Say("API endpoint:"))
+					Expect(testUI.Out).To(Say("API endpoint:"))
+					Expect(testUI.Out).To(Say("API endpoint:"))
+					Expect(testUI.Out).To(Say("api.boshlite.com\n"))
 					Expect(testUI.Out).To(Say(`API endpoint:\s+api\.boshlite\.com \(API version: 3\.4\.5\)`))
 				})
 			})
 		})
 
-		Describe("username and password", func() {
+		When("the endpoint has trailing slashes", func() {
 			BeforeEach(func() {
-				fakeConfig.TargetReturns("https://some.random.endpoint")
+				cmd.APIEndpoint = "api.boshlite.com////"
 			})
 
-			When("the current grant type is client credentials", func() {
-				BeforeEach(func() {
-					fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
-				})
+			It("strips the backslashes before using the endpoint", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				actualSettings := fakeActor.SetTargetArgsForCall(0)
+				Expect(actualSettings.URL).To(Equal("https://api.boshlite.com"))
 
-				It("returns an error", func() {
-					Expect(executeErr).To(MatchError("Service account currently logged in. Use 'cf logout' to log out service account and try again."))
-				})
+				Expect(testUI.Out).To(Say(`API endpoint:\s+api\.boshlite\.com \(API version: 3\.4\.5\)`))
 			})
+		})
+	})
 
-			When("the current grant type is password", func() {
-				BeforeEach(func() {
-					fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypePassword))
-				})
+	Describe("username and password", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://some.random.endpoint")
+		})
 
-				It("fetches prompts from the UAA", func() {
-					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(1))
-				})
+		When("the current grant type is client credentials", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
+			})
 
-				When("fetching prompts succeeds", func() {
-					When("one of the prompts has a username key and is text type", func() {
-						BeforeEach(func() {
-							fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
-								"username": {
-									DisplayName: "Username",
-									Type:        coreconfig.AuthPromptTypeText,
-								},
-							})
-						})
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("Service account currently logged in. Use 'cf logout' to log out service account and try again."))
+			})
+		})
 
-						When("the username flag is set", func() {
-							BeforeEach(func() {
-								cmd.Username = "potatoface"
-							})
+		When("the current grant type is password", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypePassword))
+			})
 
-							It("uses the provided value and does not prompt for the username", func() {
-								Expect(executeErr).ToNot(HaveOccurred())
-								Expect(testUI.Out).NotTo(Say("Username:"))
-								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-								credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
-								Expect(credentials["username"]).To(Equal("potatoface"))
-							})
+			It("fetches prompts from the UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(1))
+			})
+
+			When("fetching prompts succeeds", func() {
+				When("one of the prompts has a username key and is text type", func() {
+					BeforeEach(func() {
+						fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+							"username": {
+								DisplayName: "Username",
+								Type:        coreconfig.AuthPromptTypeText,
+							},
 						})
 					})
 
-					When("one of the prompts has password key and is password type", func() {
+					When("the username flag is set", func() {
 						BeforeEach(func() {
-							fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
-								"password": {
-									DisplayName: "Your Password",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-							})
+							cmd.Username = "potatoface"
 						})
 
-						When("the password flag is set", func() {
-							BeforeEach(func() {
-								cmd.Password = "noprompto"
-							})
-
-							It("uses the provided value and does not prompt for the password", func() {
-								Expect(executeErr).ToNot(HaveOccurred())
-								Expect(testUI.Out).NotTo(Say("Your Password:"))
-								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-								credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
-								Expect(credentials["password"]).To(Equal("noprompto"))
-							})
-
-							When("the password is incorrect", func() {
-								BeforeEach(func() {
-									input.Write([]byte("other-password\n"))
-									fakeActor.AuthenticateReturns(errors.New("bad creds"))
-								})
+						It("uses the provided value and does not prompt for the username", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).NotTo(Say("Username:"))
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+							credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+							Expect(credentials["username"]).To(Equal("potatoface"))
+						})
+					})
 
-								It("does not reuse the flag value for subsequent attempts", func() {
-									credentials, _, _ := fakeActor.AuthenticateArgsForCall(1)
-									Expect(credentials["password"]).To(Equal("other-password"))
-								})
-							})
+					When("the origin flag is set", func() {
+						BeforeEach(func() {
+							cmd.Username = "potatoface"
+							cmd.Origin = "some-origin"
+						})
 
-							When("there have been too many failed login attempts", func() {
-								BeforeEach(func() {
-									input.Write([]byte("other-password\n"))
-									fakeActor.AuthenticateReturns(
-										uaa.AccountLockedError{
-											Message: "Your account has been locked because of too many failed attempts to login.",
-										},
-									)
-								})
-
-								It("does not reuse the flag value for subsequent attempts", func() {
-									Expect(fakeActor.AuthenticateCallCount()).To(Equal(1), "called Authenticate again after lockout")
-									Expect(testUI.Err).To(Say("Your account has been locked because of too many failed attempts to login."))
-								})
-							})
+						It("authenticates against that identity provider", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+							_, origin, _ := fakeActor.AuthenticateArgsForCall(0)
+							Expect(origin).To(Equal("some-origin"))
 						})
 					})
 
-					When("UAA prompts for the SSO passcode during non-SSO flow", func() {
+					When("the origin flag is not set", func() {
 						BeforeEach(func() {
-							cmd.SSO = false
-							cmd.Password = "some-password"
-							fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
-								"password": {
-									DisplayName: "Your Password",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-								"passcode": {
-									DisplayName: "gimme your passcode",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-							})
+							cmd.Username = "potatoface"
 						})
 
-						It("does not prompt for the passcode", func() {
+						It("authenticates without an origin", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
-							Expect(testUI.Out).NotTo(Say("gimme your passcode"))
+							_, origin, _ := fakeActor.AuthenticateArgsForCall(0)
+							Expect(origin).To(BeEmpty())
 						})
+					})
+				})
 
-						It("does not send the passcode", func() {
-							Expect(executeErr).ToNot(HaveOccurred())
-							credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
-							Expect(credentials).To(HaveKeyWithValue("password", "some-password"))
-							Expect(credentials).NotTo(HaveKey("passcode"))
+				When("one of the prompts has password key and is password type", func() {
+					BeforeEach(func() {
+						fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+							"password": {
+								DisplayName: "Your Password",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
 						})
 					})
 
-					When("multiple prompts of text and password type are returned", func() {
+					When("the password flag is set", func() {
 						BeforeEach(func() {
-							fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
-								"account_number": {
-									DisplayName: "Account Number",
-									Type:        coreconfig.AuthPromptTypeText,
-								},
-								"username": {
-									DisplayName: "Username",
-									Type:        coreconfig.AuthPromptTypeText,
-								},
-								"passcode": {
-									DisplayName: "It's a passcode, what you want it to be???",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-								"password": {
-									DisplayName: "Your Password",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-								"supersecret": {
-									DisplayName: "MFA Code",
-									Type:        coreconfig.AuthPromptTypePassword,
-								},
-							})
+							cmd.Password = "noprompto"
 						})
 
-						When("no authentication flags are set", func() {
-							BeforeEach(func() {
-								input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\n"))
-							})
-
-							It("displays text prompts, starting with username, then password prompts, starting with password", func() {
-								Expect(executeErr).ToNot(HaveOccurred())
-
-								Expect(testUI.Out).To(Say("\n\n"))
-								Expect(testUI.Out).To(Say("Username:"))
-								Expect(testUI.Out).To(Say("faker"))
-
-								Expect(testUI.Out).To(Say("\n\n"))
-								Expect(testUI.Out).To(Say("Account Number:"))
-								Expect(testUI.Out).To(Say("someaccount"))
-
-								Expect(testUI.Out).To(Say("\n\n"))
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).NotTo(Say("somepassword"))
+						It("uses the provided value and does not prompt for the password", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).NotTo(Say("Your Password:"))
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+							credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+							Expect(credentials["password"]).To(Equal("noprompto"))
+						})
 
-								Expect(testUI.Out).To(Say("\n\n"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Out).NotTo(Say("garbage"))
+						When("the password is incorrect", func() {
+							BeforeEach(func() {
+								input.Write([]byte("other-password\n"))
+								fakeActor.AuthenticateReturns(errors.New("bad creds"))
 							})
 
-							It("authenticates with the responses", func() {
-								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-								credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
-								Expect(credentials["username"]).To(Equal("faker"))
-								Expect(credentials["password"]).To(Equal("somepassword"))
-								Expect(credentials["supersecret"]).To(Equal("garbage"))
-								Expect(grantType).To(Equal(constant.GrantTypePassword))
+							It("does not reuse the flag value for subsequent attempts", func() {
+								credentials, _, _ := fakeActor.AuthenticateArgsForCall(1)
+								Expect(credentials["password"]).To(Equal("other-password"))
 							})
 						})
 
-						When("an error occurs prompting for the username", func() {
-							var fakeUI *commandfakes.FakeUI
-
+						When("there have been too many failed login attempts", func() {
 							BeforeEach(func() {
-								fakeUI = new(commandfakes.FakeUI)
-								fakeUI.DisplayTextPromptReturns("", errors.New("some-error"))
-								cmd = LoginCommand{
-									UI:           fakeUI,
-									Actor:        fakeActor,
-									ActorMaker:   fakeActorMaker,
-									Config:       fakeConfig,
-									CheckerMaker: fakeCheckerMaker,
-								}
+								input.Write([]byte("other-password\n"))
+								fakeActor.AuthenticateReturns(
+									uaa.AccountLockedError{
+										Message: "Your account has been locked because of too many failed attempts to login.",
+									},
+								)
 							})
 
-							It("stops prompting after the first prompt", func() {
-								Expect(fakeUI.DisplayTextPromptCallCount()).To(Equal(1))
+							It("does not reuse the flag value for subsequent attempts", func() {
+								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1), "called Authenticate again after lockout")
 							})
 
-							It("errors", func() {
-								Expect(executeErr).To(MatchError("Unable to authenticate."))
+							It("returns the lockout error instead of a generic failure", func() {
+								Expect(executeErr).To(MatchError(uaa.AccountLockedError{
+									Message: "Your account has been locked because of too many failed attempts to login.",
+								}))
 							})
 						})
 
-						When("an error occurs in an additional text prompt after username", func() {
-							var fakeUI *commandfakes.FakeUI
-
+						When("the password has expired", func() {
 							BeforeEach(func() {
-								fakeUI = new(commandfakes.FakeUI)
-								fakeUI.DisplayTextPromptReturnsOnCall(0, "some-name", nil)
-								fakeUI.DisplayTextPromptReturnsOnCall(1, "", errors.New("some-error"))
-								cmd = LoginCommand{
-									UI:           fakeUI,
-									Actor:        fakeActor,
-									ActorMaker:   fakeActorMaker,
-									Config:       fakeConfig,
-									CheckerMaker: fakeCheckerMaker,
-								}
+								fakeActor.AuthenticateReturns(
+									uaa.PasswordExpiredError{
+										Message:  "Your current password has expired. Please reset your password.",
+										ResetURL: "https://login.example.com/forgot_password",
+									},
+								)
 							})
 
-							It("returns the error", func() {
-								Expect(executeErr).To(MatchError("Unable to authenticate."))
+							It("stops trying and returns the expiry error", func() {
+								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+								Expect(testUI.Err).NotTo(Say("password has expired"))
+								Expect(executeErr).To(MatchError(uaa.PasswordExpiredError{
+									Message:  "Your current password has expired. Please reset your password.",
+									ResetURL: "https://login.example.com/forgot_password",
+								}))
 							})
 						})
+					})
+				})
 
-						When("an error occurs prompting for the password", func() {
-							var fakeUI *commandfakes.FakeUI
-
-							BeforeEach(func() {
-								fakeUI = new(commandfakes.FakeUI)
-								fakeUI.DisplayPasswordPromptReturns("", errors.New("some-error"))
-								cmd = LoginCommand{
-									UI:           fakeUI,
-									Actor:        fakeActor,
-									ActorMaker:   fakeActorMaker,
-									Config:       fakeConfig,
-									CheckerMaker: fakeCheckerMaker,
-								}
-							})
-
-							It("stops prompting after the first prompt", func() {
-								Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(1))
-							})
-
-							It("errors", func() {
-								Expect(executeErr).To(MatchError("Unable to authenticate."))
-							})
+				When("UAA prompts for the SSO passcode during non-SSO flow", func() {
+					BeforeEach(func() {
+						cmd.SSO = false
+						cmd.Password = "some-password"
+						fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+							"password": {
+								DisplayName: "Your Password",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
+							"passcode": {
+								DisplayName: "gimme your passcode",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
 						})
+					})
 
-						When("an error occurs prompting for prompts of type password that are not the 'password'", func() {
-							var fakeUI *commandfakes.FakeUI
-
-							BeforeEach(func() {
-								fakeUI = new(commandfakes.FakeUI)
-								fakeUI.DisplayPasswordPromptReturnsOnCall(0, "some-password", nil)
-								fakeUI.DisplayPasswordPromptReturnsOnCall(1, "", errors.New("some-error"))
-
-								cmd = LoginCommand{
-									UI:           fakeUI,
-									Actor:        fakeActor,
-									ActorMaker:   fakeActorMaker,
-									Config:       fakeConfig,
-									CheckerMaker: fakeCheckerMaker,
-								}
-							})
+					It("does not prompt for the passcode", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("gimme your passcode"))
+					})
 
-							It("stops prompting after the second prompt", func() {
-								Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(2))
-							})
+					It("does not send the passcode", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+						Expect(credentials).To(HaveKeyWithValue("password", "some-password"))
+						Expect(credentials).NotTo(HaveKey("passcode"))
+					})
+				})
 
-							It("errors", func() {
-								Expect(executeErr).To(MatchError("Unable to authenticate."))
-							})
+				When("multiple prompts of text and password type are returned", func() {
+					BeforeEach(func() {
+						fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+							"account_number": {
+								DisplayName: "Account Number",
+								Type:        coreconfig.AuthPromptTypeText,
+							},
+							"username": {
+								DisplayName: "Username",
+								Type:        coreconfig.AuthPromptTypeText,
+							},
+							"passcode": {
+								DisplayName: "It's a passcode, what you want it to be???",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
+							"password": {
+								DisplayName: "Your Password",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
+							"supersecret": {
+								DisplayName: "MFA Code",
+								Type:        coreconfig.AuthPromptTypePassword,
+							},
 						})
+					})
 
-						When("authenticating succeeds", func() {
-							BeforeEach(func() {
-								fakeConfig.CurrentUserNameReturns("potatoface", nil)
-								input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\n"))
-							})
-
-							It("displays OK and a status summary", func() {
-								Expect(executeErr).ToNot(HaveOccurred())
-								Expect(testUI.Out).To(Say("OK"))
-								Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-								Expect(testUI.Out).To(Say(`User:\s+potatoface`))
-
-								Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-							})
+					When("no authentication flags are set", func() {
+						BeforeEach(func() {
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\n"))
 						})
 
-						When("authenticating fails", func() {
-							BeforeEach(func() {
-								fakeActor.AuthenticateReturns(errors.New("something died"))
-								input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\n"))
-							})
+						It("displays text prompts, starting with username, then password prompts, starting with password", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
 
-							It("prints the error message three times", func() {
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("something died"))
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("something died"))
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("something died"))
-							})
+							Expect(testUI.Out).To(Say("\n\n"))
+							Expect(testUI.Out).To(Say("Username:"))
+							Expect(testUI.Out).To(Say("faker"))
 
-							It("returns an error indicating that it could not authenticate", func() {
-								Expect(executeErr).To(MatchError("Unable to authenticate."))
-							})
+							Expect(testUI.Out).To(Say("\n\n"))
+							Expect(testUI.Out).To(Say("Account Number:"))
+							Expect(testUI.Out).To(Say("someaccount"))
 
-							It("displays a status summary", func() {
-								Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-								Expect(testUI.Out).To(Say(`Not logged in. Use '%s login' to log in.`, cmd.Config.BinaryName()))
-							})
+							Expect(testUI.Out).To(Say("\n\n"))
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).NotTo(Say("somepassword"))
 
+							Expect(testUI.Out).To(Say("\n\n"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Out).NotTo(Say("garbage"))
 						})
 
-						When("authenticating fails with a bad credentials error", func() {
-							BeforeEach(func() {
-								fakeActor.AuthenticateReturns(uaa.UnauthorizedError{Message: "Bad credentials"})
-								input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\n"))
-							})
-
-							It("converts the error before printing it", func() {
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
-								Expect(testUI.Out).To(Say("Your Password:"))
-								Expect(testUI.Out).To(Say("MFA Code:"))
-								Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
-							})
+						It("authenticates with the responses", func() {
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+							credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+							Expect(credentials["username"]).To(Equal("faker"))
+							Expect(credentials["password"]).To(Equal("somepassword"))
+							Expect(credentials["supersecret"]).To(Equal("garbage"))
+							Expect(grantType).To(Equal(constant.GrantTypePassword))
 						})
 					})
-				})
-			})
-		})
 
-		Describe("SSO Passcode", func() {
-			BeforeEach(func() {
-				fakeConfig.TargetReturns("whatever.com")
+					When("an error occurs prompting for the username", func() {
+						var fakeUI *commandfakes.FakeUI
 
-				input.Write([]byte("some-passcode\n"))
-				fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
-					"passcode": {
-						DisplayName: "some-sso-prompt",
-						Type:        coreconfig.AuthPromptTypePassword,
-					},
-				})
+						BeforeEach(func() {
+							fakeUI = new(commandfakes.FakeUI)
+							fakeUI.DisplayTextPromptReturns("", errors.New("some-error"))
+							cmd = LoginCommand{
+								UI:           fakeUI,
+								Actor:        fakeActor,
+								ActorMaker:   fakeActorMaker,
+								Config:       fakeConfig,
+								CheckerMaker: fakeCheckerMaker,
+							}
+						})
 
-				fakeConfig.CurrentUserNameReturns("potatoface", nil)
-			})
+						It("stops prompting after the first prompt", func() {
+							Expect(fakeUI.DisplayTextPromptCallCount()).To(Equal(1))
+						})
 
-			When("--sso flag is set", func() {
-				BeforeEach(func() {
-					cmd.SSO = true
-				})
+						It("errors", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+						})
+					})
 
-				It("prompts the user for SSO passcode", func() {
-					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(1))
-					Expect(testUI.Out).To(Say("some-sso-prompt:"))
-				})
+					When("an error occurs in an additional text prompt after username", func() {
+						var fakeUI *commandfakes.FakeUI
 
-				It("authenticates with the inputted code", func() {
-					Expect(testUI.Out).To(Say("OK"))
-					Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-					Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+						BeforeEach(func() {
+							fakeUI = new(commandfakes.FakeUI)
+							fakeUI.DisplayTextPromptReturnsOnCall(0, "some-name", nil)
+							fakeUI.DisplayTextPromptReturnsOnCall(1, "", errors.New("some-error"))
+							cmd = LoginCommand{
+								UI:           fakeUI,
+								Actor:        fakeActor,
+								ActorMaker:   fakeActorMaker,
+								Config:       fakeConfig,
+								CheckerMaker: fakeCheckerMaker,
+							}
+						})
 
-					Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-					credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
-					Expect(credentials["passcode"]).To(Equal("some-passcode"))
-					Expect(origin).To(BeEmpty())
-					Expect(grantType).To(Equal(constant.GrantTypePassword))
-				})
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+						})
+					})
 
-				When("an error occurs prompting for the code", func() {
-					var fakeUI *commandfakes.FakeUI
+					When("an error occurs prompting for the password", func() {
+						var fakeUI *commandfakes.FakeUI
 
-					BeforeEach(func() {
-						fakeUI = new(commandfakes.FakeUI)
-						fakeUI.DisplayPasswordPromptReturns("", errors.New("some-error"))
-						cmd = LoginCommand{
-							UI:           fakeUI,
-							Actor:        fakeActor,
-							ActorMaker:   fakeActorMaker,
-							Config:       fakeConfig,
-							CheckerMaker: fakeCheckerMaker,
-							SSO:          true,
-						}
-					})
+						BeforeEach(func() {
+							fakeUI = new(commandfakes.FakeUI)
+							fakeUI.DisplayPasswordPromptReturns("", errors.New("some-error"))
+							cmd = LoginCommand{
+								UI:           fakeUI,
+								Actor:        fakeActor,
+								ActorMaker:   fakeActorMaker,
+								Config:       fakeConfig,
+								CheckerMaker: fakeCheckerMaker,
+							}
+						})
 
-					It("errors", func() {
-						Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(1))
-						Expect(executeErr).To(MatchError("Unable to authenticate."))
-					})
-				})
-			})
+						It("stops prompting after the first prompt", func() {
+							Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(1))
+						})
 
-			When("the --sso-passcode flag is set", func() {
-				BeforeEach(func() {
-					cmd.SSOPasscode = "a-passcode"
-				})
+						It("errors", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+						})
+					})
 
-				It("does not prompt the user for SSO passcode", func() {
-					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Out).ToNot(Say("some-sso-prompt:"))
-				})
+					When("an error occurs prompting for prompts of type password that are not the 'password'", func() {
+						var fakeUI *commandfakes.FakeUI
 
-				It("uses the flag value to authenticate", func() {
-					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-					credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
-					Expect(credentials["passcode"]).To(Equal("a-passcode"))
-					Expect(origin).To(BeEmpty())
-					Expect(grantType).To(Equal(constant.GrantTypePassword))
-				})
+						BeforeEach(func() {
+							fakeUI = new(commandfakes.FakeUI)
+							fakeUI.DisplayPasswordPromptReturnsOnCall(0, "some-password", nil)
+							fakeUI.DisplayPasswordPromptReturnsOnCall(1, "", errors.New("some-error"))
+
+							cmd = LoginCommand{
+								UI:           fakeUI,
+								Actor:        fakeActor,
+								ActorMaker:   fakeActorMaker,
+								Config:       fakeConfig,
+								CheckerMaker: fakeCheckerMaker,
+							}
+						})
 
-				It("displays a summary with user information", func() {
-					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Out).To(Say("OK"))
-					Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-					Expect(testUI.Out).To(Say(`User:\s+potatoface`))
-				})
+						It("stops prompting after the second prompt", func() {
+							Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(2))
+						})
 
-				When("an incorrect passcode is inputted", func() {
-					BeforeEach(func() {
-						cmd.SSOPasscode = "some-garbage"
-						fakeActor.AuthenticateReturns(uaa.UnauthorizedError{
-							Message: "Bad credentials",
+						It("errors", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
 						})
-						fakeConfig.CurrentUserNameReturns("", nil)
-						input.Write([]byte("some-passcode\n"))
 					})
 
-					It("re-prompts two more times", func() {
-						Expect(testUI.Out).To(Say("some-sso-prompt:"))
-						Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
-						Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
-						Expect(testUI.Out).To(Say("some-sso-prompt:"))
-						Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
-						Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
-					})
+					When("authenticating succeeds", func() {
+						BeforeEach(func() {
+							fakeConfig.CurrentUserNameReturns("potatoface", nil)
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\n"))
+						})
 
-					It("returns an error message", func() {
-						Expect(executeErr).To(MatchError("Unable to authenticate."))
-					})
+						It("displays OK and a status summary", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+							Expect(testUI.Out).To(Say(`User:\s+potatoface`))
 
-					It("does not include user information in the summary", func() {
-						Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-						Expect(testUI.Out).To(Say(`Not logged in. Use '%s login' to log in.`, cmd.Config.BinaryName()))
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+						})
 					})
-				})
-			})
 
-			When("both --sso and --sso-passcode flags are set", func() {
-				BeforeEach(func() {
-					cmd.SSO = true
-					cmd.SSOPasscode = "a-passcode"
-				})
+					When("authenticating fails", func() {
+						BeforeEach(func() {
+							fakeActor.AuthenticateReturns(errors.New("something died"))
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\n"))
+						})
 
-				It("returns an error message", func() {
-					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
-					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--sso"}}))
-				})
-			})
-		})
+						It("prints the error message three times", func() {
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("something died"))
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("something died"))
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("something died"))
+						})
 
-		Describe("Minimum CLI version ", func() {
-			BeforeEach(func() {
-				fakeConfig.TargetReturns("whatever.com")
+						It("returns an error indicating that it could not authenticate", func() {
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+						})
 
-				fakeChecker.MinCLIVersionReturns("9000.0.0")
-			})
+						It("displays a status summary", func() {
+							Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+							Expect(testUI.Out).To(Say(`Not logged in. Use '%s login' to log in.`, cmd.Config.BinaryName()))
+						})
 
-			It("sets the minimum CLI version in the config", func() {
-				Expect(executeErr).NotTo(HaveOccurred())
-				Expect(fakeConfig.SetMinCLIVersionCallCount()).To(Equal(1))
-				Expect(fakeConfig.SetMinCLIVersionArgsForCall(0)).To(Equal("9000.0.0"))
-			})
+					})
 
-			When("The current version is below the minimum supported", func() {
-				BeforeEach(func() {
-					fakeChecker.CloudControllerAPIVersionReturns("2.123.0")
-					fakeConfig.BinaryVersionReturns("1.2.3")
-					fakeConfig.MinCLIVersionReturns("9000.0.0")
-				})
+					When("authenticating fails with a bad credentials error", func() {
+						BeforeEach(func() {
+							fakeActor.AuthenticateReturns(uaa.UnauthorizedError{Message: "Bad credentials"})
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\nfaker\nsomeaccount\nsomepassword\ngarbage\n"))
+						})
 
-				It("displays a warning", func() {
-					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Err).To(Say("Cloud Foundry API version 2.123.0 requires CLI version 9000.0.0. You are currently on version 1.2.3. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads"))
-				})
+						It("converts the error before printing it", func() {
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+							Expect(testUI.Out).To(Say("Your Password:"))
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+						})
+					})
 
-				Context("ordering of output", func() {
+					When("the login server asks for more information once the prompts have been answered", func() {
+						BeforeEach(func() {
+							fakeActor.AuthenticateReturnsOnCall(0, uaa.InvalidRequestError{Message: "Missing region"})
+							fakeActor.RefreshLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+								"account_number": {
+									DisplayName: "Account Number",
+									Type:        coreconfig.AuthPromptTypeText,
+								},
+								"username": {
+									DisplayName: "Username",
+									Type:        coreconfig.AuthPromptTypeText,
+								},
+								"passcode": {
+									DisplayName: "It's a passcode, what you want it to be???",
+									Type:        coreconfig.AuthPromptTypePassword,
+								},
+								"password": {
+									DisplayName: "Your Password",
+									Type:        coreconfig.AuthPromptTypePassword,
+								},
+								"supersecret": {
+									DisplayName: "MFA Code",
+									Type:        coreconfig.AuthPromptTypePassword,
+								},
+								"region": {
+									DisplayName: "Region",
+									Type:        coreconfig.AuthPromptTypeText,
+								},
+								"pin": {
+									DisplayName: "PIN",
+									Type:        coreconfig.AuthPromptTypePassword,
+								},
+							}, nil)
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\n1234\nus-east\n"))
+						})
+
+						It("prompts only for the new prompts and authenticates again", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("MFA Code:"))
+							Expect(testUI.Out).To(Say("The login server is asking for more information."))
+							Expect(testUI.Out).To(Say("PIN:"))
+							Expect(testUI.Out).NotTo(Say("1234"))
+							Expect(testUI.Out).To(Say("Region:"))
+							Expect(testUI.Out).To(Say("us-east"))
+							Expect(testUI.Out).NotTo(Say("Your Password:"))
+							Expect(testUI.Err).NotTo(Say("Missing region"))
+
+							Expect(fakeActor.AuthenticateCallCount()).To(Equal(2))
+							credentials, _, _ := fakeActor.AuthenticateArgsForCall(1)
+							Expect(credentials).To(Equal(map[string]string{
+								"username":       "faker",
+								"account_number": "someaccount",
+								"password":       "somepassword",
+								"supersecret":    "garbage",
+								"pin":            "1234",
+								"region":         "us-east",
+							}))
+						})
+					})
+
+					When("authenticating fails with an invalid request error", func() {
+						BeforeEach(func() {
+							fakeActor.AuthenticateReturns(uaa.InvalidRequestError{Message: "Missing region"})
+							input.Write([]byte("faker\nsomeaccount\nsomepassword\ngarbage\nsomepassword\ngarbage\nsomepassword\ngarbage\n"))
+						})
+
+						It("displays UAA's description of the error", func() {
+							Expect(testUI.Err).To(Say("Missing region"))
+							Expect(executeErr).To(MatchError("Unable to authenticate."))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("SSO Passcode", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("whatever.com")
+
+			input.Write([]byte("some-passcode\n"))
+			fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+				"passcode": {
+					DisplayName: "some-sso-prompt",
+					Type:        coreconfig.AuthPromptTypePassword,
+				},
+			})
+
+			fakeConfig.CurrentUserNameReturns("potatoface", nil)
+		})
+
+		When("--sso flag is set", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+			})
+
+			It("prompts the user for SSO passcode", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(1))
+				Expect(testUI.Out).To(Say("some-sso-prompt:"))
+			})
+
+			It("authenticates with the inputted code", func() {
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+				Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials["passcode"]).To(Equal("some-passcode"))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypePassword))
+			})
+
+			When("an error occurs prompting for the code", func() {
+				var fakeUI *commandfakes.FakeUI
+
+				BeforeEach(func() {
+					fakeUI = new(commandfakes.FakeUI)
+					fakeUI.DisplayPasswordPromptReturns("", errors.New("some-error"))
+					cmd = LoginCommand{
+						UI:           fakeUI,
+						Actor:        fakeActor,
+						ActorMaker:   fakeActorMaker,
+						Config:       fakeConfig,
+						CheckerMaker: fakeCheckerMaker,
+						SSO:          true,
+					}
+				})
+
+				It("errors", func() {
+					Expect(fakeUI.DisplayPasswordPromptCallCount()).To(Equal(1))
+					Expect(executeErr).To(MatchError("Unable to authenticate."))
+				})
+			})
+		})
+
+		When("the --sso-passcode flag is set", func() {
+			BeforeEach(func() {
+				cmd.SSOPasscode = "a-passcode"
+			})
+
+			It("does not prompt the user for SSO passcode", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("some-sso-prompt:"))
+			})
+
+			It("uses the flag value to authenticate", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials["passcode"]).To(Equal("a-passcode"))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypePassword))
+			})
+
+			It("displays a summary with user information", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+				Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+			})
+
+			When("an incorrect passcode is inputted", func() {
+				BeforeEach(func() {
+					cmd.SSOPasscode = "some-garbage"
+					fakeActor.AuthenticateReturns(uaa.UnauthorizedError{
+						Message: "Bad credentials",
+					})
+					fakeConfig.CurrentUserNameReturns("", nil)
+					input.Write([]byte("some-passcode\n"))
+				})
+
+				It("re-prompts two more times", func() {
+					Expect(testUI.Out).To(Say("some-sso-prompt:"))
+					Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+					Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+					Expect(testUI.Out).To(Say("some-sso-prompt:"))
+					Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+					Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+				})
+
+				It("returns an error message", func() {
+					Expect(executeErr).To(MatchError("Unable to authenticate."))
+				})
+
+				It("does not include user information in the summary", func() {
+					Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+					Expect(testUI.Out).To(Say(`Not logged in. Use '%s login' to log in.`, cmd.Config.BinaryName()))
+				})
+			})
+		})
+
+		When("both --sso and --sso-passcode flags are set", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+				cmd.SSOPasscode = "a-passcode"
+			})
+
+			It("returns an error message", func() {
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--sso"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --sso and --origin flags are both set", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+				cmd.Origin = "some-origin"
+			})
+
+			It("returns an error message", func() {
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso", "--origin"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --sso-passcode and --origin flags are both set", func() {
+			BeforeEach(func() {
+				cmd.SSOPasscode = "a-passcode"
+				cmd.Origin = "some-origin"
+			})
+
+			It("returns an error message", func() {
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso-passcode", "--origin"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("SSO browser", func() {
+		var (
+			fakeBrowser   *v6fakes.FakeBrowser
+			callbackQuery func(state string) string
+			callbackCodes []int
+		)
+
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("whatever.com")
+			cmd.SSOBrowser = true
+
+			callbackQuery = func(state string) string {
+				return "code=some-code&state=" + state
+			}
+			callbackCodes = nil
+
+			var redirectURI, state string
+			fakeActor.AuthorizationCodeURLStub = func(uri string, s string, _ string) (string, error) {
+				redirectURI, state = uri, s
+				return "https://login.example.com/oauth/authorize", nil
+			}
+
+			fakeBrowser = new(v6fakes.FakeBrowser)
+			fakeBrowser.OpenStub = func(string) error {
+				response, err := http.Get(redirectURI + "?" + callbackQuery(state))
+				Expect(err).ToNot(HaveOccurred())
+				response.Body.Close()
+				callbackCodes = append(callbackCodes, response.StatusCode)
+				return nil
+			}
+			cmd.Browser = fakeBrowser
+		})
+
+		It("opens the browser on the authorize URL with a local redirect URI", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Opening the browser to log in. If it does not open, visit:"))
+			Expect(testUI.Out).To(Say("https://login.example.com/oauth/authorize"))
+			Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://login.example.com/oauth/authorize"))
+
+			redirectURI, state, codeChallenge := fakeActor.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			Expect(state).ToNot(BeEmpty())
+			Expect(codeChallenge).ToNot(BeEmpty())
+		})
+
+		It("checks that UAA will redirect back to the local redirect URI first", func() {
+			Expect(fakeActor.VerifyAuthorizationCodeRedirectCallCount()).To(Equal(1))
+			redirectURI, _, _ := fakeActor.AuthorizationCodeURLArgsForCall(0)
+			Expect(fakeActor.VerifyAuthorizationCodeRedirectArgsForCall(0)).To(Equal(redirectURI))
+		})
+
+		It("authenticates with the authorization code from the callback", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(callbackCodes).To(Equal([]int{http.StatusOK}))
+			Expect(testUI.Out).To(Say("Authenticating..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+			redirectURI, _, codeChallenge := fakeActor.AuthorizationCodeURLArgsForCall(0)
+			Expect(credentials).To(HaveKeyWithValue("code", "some-code"))
+			Expect(credentials).To(HaveKeyWithValue("redirect_uri", redirectURI))
+			Expect(credentials).To(HaveLen(3))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+
+			verifierHash := sha256.Sum256([]byte(credentials["code_verifier"]))
+			Expect(codeChallenge).To(Equal(base64.RawURLEncoding.EncodeToString(verifierHash[:])))
+		})
+
+		When("the callback does not carry the state the login started with", func() {
+			BeforeEach(func() {
+				validQuery := callbackQuery
+				callbacks := 0
+				callbackQuery = func(state string) string {
+					callbacks++
+					if callbacks == 1 {
+						return validQuery("some-other-state")
+					}
+					return validQuery(state)
+				}
+
+				realOpen := fakeBrowser.OpenStub
+				fakeBrowser.OpenStub = func(url string) error {
+					Expect(realOpen(url)).To(Succeed())
+					return realOpen(url)
+				}
+			})
+
+			It("rejects it and waits for a callback that does", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(callbackCodes).To(Equal([]int{http.StatusBadRequest, http.StatusOK}))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("UAA will not redirect back to the local redirect URI", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyAuthorizationCodeRedirectReturns(uaa.RedirectURINotRegisteredError{ClientID: "cf"})
+			})
+
+			It("returns the error and does not open the browser", func() {
+				Expect(executeErr).To(MatchError(uaa.RedirectURINotRegisteredError{ClientID: "cf"}))
+				Expect(fakeActor.AuthorizationCodeURLCallCount()).To(Equal(0))
+				Expect(fakeBrowser.OpenCallCount()).To(Equal(0))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user does not allow the login", func() {
+			BeforeEach(func() {
+				callbackQuery = func(state string) string {
+					return "error=access_denied&error_description=User+denied+access&state=" + state
+				}
+			})
+
+			It("displays the reason and does not authenticate", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("User denied access"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the browser cannot be opened", func() {
+			BeforeEach(func() {
+				realOpen := fakeBrowser.OpenStub
+				fakeBrowser.OpenStub = func(url string) error {
+					Expect(realOpen(url)).To(Succeed())
+					return errors.New("no browser")
+				}
+			})
+
+			It("warns and waits for the user to visit the URL themselves", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open the browser: no browser"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("exchanging the code fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateReturns(errors.New("some-error"))
+			})
+
+			It("displays the error", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("some-error"))
+			})
+		})
+
+		When("the --sso flag is also set", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso-browser", "--sso"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				Expect(fakeBrowser.OpenCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --origin flag is also set", func() {
+			BeforeEach(func() {
+				cmd.Origin = "some-origin"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--sso-browser", "--origin"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("device authorization", func() {
+		BeforeEach(func() {
+			cmd.APIEndpoint = "example.com"
+			cmd.Device = true
+			fakeActor.StartDeviceAuthorizationReturns(v3action.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+			}, nil)
+		})
+
+		It("displays the code to approve and waits for it to be approved", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("To log in, visit https://login.example.com/device on another device and enter the code ABCD-EFGH"))
+			Expect(testUI.Out).To(Say(`Waiting for the login request to be approved\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(1))
+			deviceAuthorization, timeout := fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0)
+			Expect(deviceAuthorization.DeviceCode).To(Equal("some-device-code"))
+			Expect(timeout).To(BeZero())
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+
+		When("--timeout is set", func() {
+			BeforeEach(func() {
+				cmd.Timeout = flag.Timeout{Value: 2 * time.Minute}
+			})
+
+			It("waits no longer than the timeout", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, timeout := fakeActor.AuthenticateWithDeviceAuthorizationArgsForCall(0)
+				Expect(timeout).To(Equal(2 * time.Minute))
+			})
+		})
+
+		When("UAA provides a URL with the code filled in", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceAuthorizationReturns(v3action.DeviceAuthorization{
+					DeviceCode:              "some-device-code",
+					UserCode:                "ABCD-EFGH",
+					VerificationURI:         "https://login.example.com/device",
+					VerificationURIComplete: "https://login.example.com/device?user_code=ABCD-EFGH",
+				}, nil)
+			})
+
+			It("displays that URL too", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Or visit https://login.example.com/device\?user_code=ABCD-EFGH, which has the code filled in`))
+			})
+		})
+
+		When("UAA does not support the device authorization grant", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceAuthorizationReturns(v3action.DeviceAuthorization{}, uaa.DeviceAuthorizationNotSupportedError{})
+			})
+
+			It("returns the error without waiting", func() {
+				Expect(executeErr).To(MatchError(uaa.DeviceAuthorizationNotSupportedError{}))
+				Expect(fakeActor.AuthenticateWithDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the login request is not approved in time", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceAuthorizationReturns(uaa.DeviceAuthorizationTimeoutError{Timeout: time.Minute})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(uaa.DeviceAuthorizationTimeoutError{Timeout: time.Minute}))
+				Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the --sso-browser flag is also set", func() {
+			BeforeEach(func() {
+				cmd.SSOBrowser = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--device", "--sso-browser"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				Expect(fakeActor.StartDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a username is also given", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--device", "-u"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--timeout is set without --device", func() {
+			BeforeEach(func() {
+				cmd.Device = false
+				cmd.Timeout = flag.Timeout{Value: time.Minute}
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--device"}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("client credentials", func() {
+		BeforeEach(func() {
+			cmd.APIEndpoint = "example.com"
+			cmd.ClientID = "some-client"
+		})
+
+		When("--client-secret is given", func() {
+			BeforeEach(func() {
+				cmd.ClientSecret = "some-secret"
+			})
+
+			It("logs in as the service account without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Client secret"))
+				Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"client_id":     "some-client",
+					"client_secret": "some-secret",
+				}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeClientCredentials))
+				Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+			})
+
+			It("goes on to target an org", func() {
+				Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
+			})
+
+			When("a service account is already logged in", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
+				})
+
+				It("logs in again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				})
+			})
+
+			When("authenticating fails", func() {
+				BeforeEach(func() {
+					fakeActor.AuthenticateReturns(uaa.UnauthorizedError{Message: "Bad credentials"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(uaa.UnauthorizedError{Message: "Bad credentials"}))
+					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("--client-secret is not given", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("prompted-secret\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("prompts for the client secret", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Client secret"))
+				credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials["client_secret"]).To(Equal("prompted-secret"))
+			})
+		})
+
+		When("a username is also given", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--client-id", "-u"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--client-secret is given without --client-id", func() {
+			BeforeEach(func() {
+				cmd.ClientID = ""
+				cmd.ClientSecret = "some-secret"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--client-secret", Arg2: "--client-id"}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("refresh token", func() {
+		var refreshTokenPath string
+
+		BeforeEach(func() {
+			cmd.APIEndpoint = "example.com"
+
+			refreshTokenFile, err := ioutil.TempFile("", "refresh-token")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = refreshTokenFile.WriteString("some-refresh-token\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(refreshTokenFile.Close()).To(Succeed())
+
+			refreshTokenPath = refreshTokenFile.Name()
+			cmd.RefreshToken = flag.PathWithExistenceCheck(refreshTokenPath)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(refreshTokenPath)).To(Succeed())
+		})
+
+		It("starts the session from the refresh token in the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateWithRefreshTokenCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithRefreshTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+
+		When("the file is empty", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(refreshTokenPath, []byte("\n"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidRefreshTokenError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidRefreshTokenError{}))
+				Expect(fakeActor.AuthenticateWithRefreshTokenCallCount()).To(Equal(0))
+			})
+		})
+
+		When("UAA rejects the refresh token", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithRefreshTokenReturns(uaa.InvalidAuthTokenError{Message: "invalid"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(uaa.InvalidAuthTokenError{Message: "invalid"}))
+				Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a password is also given", func() {
+			BeforeEach(func() {
+				cmd.Password = "some-password"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--refresh-token", "-p"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				Expect(fakeActor.AuthenticateWithRefreshTokenCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--device is also set", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--refresh-token", "--device"}}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Minimum CLI version ", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("whatever.com")
+
+			fakeChecker.MinCLIVersionReturns("9000.0.0")
+		})
+
+		It("sets the minimum CLI version in the config", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeConfig.SetMinCLIVersionCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetMinCLIVersionArgsForCall(0)).To(Equal("9000.0.0"))
+		})
+
+		It("remembers the check for the target", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeConfig.SetMinCLIVersionCheckCallCount()).To(Equal(1))
+			check := fakeConfig.SetMinCLIVersionCheckArgsForCall(0)
+			Expect(check.Target).To(Equal("whatever.com"))
+			Expect(check.MinCLIVersion).To(Equal("9000.0.0"))
+			Expect(check.CheckedAt).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		When("the target was checked earlier today", func() {
+			BeforeEach(func() {
+				fakeConfig.MinCLIVersionCheckReturns(configv3.MinCLIVersionCheck{
+					Target:        "whatever.com",
+					APIVersion:    "2.123.0",
+					MinCLIVersion: "8000.0.0",
+					Enforcement:   "warn",
+					CheckedAt:     time.Now(),
+				}, true)
+			})
+
+			It("uses the remembered check instead of asking the Cloud Controller", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCheckerMaker.NewVersionCheckerCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetMinCLIVersionCheckCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetMinCLIVersionArgsForCall(0)).To(Equal("8000.0.0"))
+			})
+		})
+
+		When("the current version is below the minimum and the Cloud Controller blocks it", func() {
+			BeforeEach(func() {
+				fakeChecker.CloudControllerAPIVersionReturns("2.123.0")
+				fakeChecker.MinCLIVersionEnforcementReturns(ccv2constant.MinCLIVersionBlock)
+				fakeConfig.BinaryVersionReturns("1.2.3")
+				fakeConfig.MinCLIVersionReturns("9000.0.0")
+			})
+
+			It("returns a MinimumCLIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumCLIVersionNotMetError{
+					APIVersion:    "2.123.0",
+					MinCLIVersion: "9000.0.0",
+					BinaryVersion: "1.2.3",
+				}))
+			})
+
+			It("logs the user back out", func() {
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+			})
+		})
+
+		When("the current version is below the minimum and the Cloud Controller turns the check off", func() {
+			BeforeEach(func() {
+				fakeChecker.CloudControllerAPIVersionReturns("2.123.0")
+				fakeChecker.MinCLIVersionEnforcementReturns(ccv2constant.MinCLIVersionOff)
+				fakeConfig.BinaryVersionReturns("1.2.3")
+				fakeConfig.MinCLIVersionReturns("9000.0.0")
+			})
+
+			It("does not warn", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).NotTo(Say("requires CLI version"))
+			})
+		})
+
+		When("The current version is below the minimum supported", func() {
+			BeforeEach(func() {
+				fakeChecker.CloudControllerAPIVersionReturns("2.123.0")
+				fakeConfig.BinaryVersionReturns("1.2.3")
+				fakeConfig.MinCLIVersionReturns("9000.0.0")
+			})
+
+			It("displays a warning", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("Cloud Foundry API version 2.123.0 requires CLI version 9000.0.0. You are currently on version 1.2.3. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads"))
+			})
+
+			Context("ordering of output", func() {
+				BeforeEach(func() {
+					outAndErr := NewBuffer()
+					testUI.Out = outAndErr
+					testUI.Err = outAndErr
+				})
+
+				It("displays the warning after all prompts but before the summary ", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Authenticating...`))
+					Expect(testUI.Err).To(Say("Cloud Foundry API version 2.123.0 requires CLI version 9000.0.0. You are currently on version 1.2.3. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads"))
+					Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
+					Expect(testUI.Out).To(Say(`Not logged in. Use 'faceman login' to log in.`))
+				})
+			})
+		})
+	})
+
+	Describe("status summary", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("whatever.com")
+			fakeConfig.CurrentUserNameReturns("potatoface", nil)
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "potatoface", GUID: "some-user-guid"}, nil)
+		})
+
+		When("an org and space are targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.HasTargetedOrganizationReturns(true)
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+				fakeConfig.HasTargetedSpaceReturns(true)
+				fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid", AllowSSH: true})
+
+				fakeChecker.GetUserOrganizationRolesReturns(v2action.UserRoles{
+					"some-org-guid":  {"manager"},
+					"other-org-guid": {"auditor"},
+				}, v2action.Warnings{"org-roles-warning"}, nil)
+				fakeChecker.GetUserSpaceRolesReturns(v2action.UserRoles{
+					"some-space-guid": {"developer"},
+				}, v2action.Warnings{"space-roles-warning"}, nil)
+				fakeChecker.GetFeatureFlagsReturns([]v2action.FeatureFlag{
+					{Name: "diego_docker", Enabled: true},
+					{Name: "task_creation", Enabled: false},
+					{Name: "user_org_creation", Enabled: true},
+				}, v2action.Warnings{"feature-flags-warning"}, nil)
+			})
+
+			It("displays the target, SSH access, roles and notable feature flags", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`User:\s+potatoface`))
+				Expect(testUI.Out).To(Say(`Org:\s+some-org`))
+				Expect(testUI.Out).To(Say(`Space:\s+some-space`))
+				Expect(testUI.Out).To(Say(`SSH:\s+allowed`))
+				Expect(testUI.Out).To(Say(`Roles:\s+org manager, space developer`))
+				Expect(testUI.Out).To(Say(`Feature flags:\s+diego_docker enabled, task_creation disabled\n`))
+
+				Expect(testUI.Err).To(Say("org-roles-warning"))
+				Expect(testUI.Err).To(Say("space-roles-warning"))
+				Expect(testUI.Err).To(Say("feature-flags-warning"))
+
+				Expect(fakeChecker.GetUserOrganizationRolesArgsForCall(0)).To(Equal("some-user-guid"))
+				userGUID, orgGUID := fakeChecker.GetUserSpaceRolesArgsForCall(0)
+				Expect(userGUID).To(Equal("some-user-guid"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+
+			When("SSH is disabled in the space", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+				})
+
+				It("displays SSH as disabled", func() {
+					Expect(testUI.Out).To(Say(`SSH:\s+disabled`))
+				})
+			})
+
+			When("looking up the roles and feature flags fails", func() {
+				BeforeEach(func() {
+					fakeChecker.GetUserOrganizationRolesReturns(nil, nil, errors.New("org-roles-error"))
+					fakeChecker.GetUserSpaceRolesReturns(nil, nil, errors.New("space-roles-error"))
+					fakeChecker.GetFeatureFlagsReturns(nil, nil, errors.New("feature-flags-error"))
+				})
+
+				It("leaves them out of the summary without failing the login", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`SSH:\s+allowed`))
+					Expect(testUI.Out).ToNot(Say("Roles:"))
+					Expect(testUI.Out).ToNot(Say("Feature flags:"))
+				})
+			})
+		})
+
+		When("no org is targeted", func() {
+			It("does not display the target or look up roles", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Org:"))
+				Expect(testUI.Out).ToNot(Say("Space:"))
+				Expect(fakeChecker.GetUserOrganizationRolesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("targeting an org and space", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("whatever.com")
+		})
+
+		When("the user can see no orgs", func() {
+			It("does not target an org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+				Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user can see one org", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns(
+					[]v3action.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					v3action.Warnings{"orgs-warning"}, nil)
+			})
+
+			It("targets it without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("orgs-warning"))
+				Expect(testUI.Out).To(Say("Targeted org some-org"))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(orgName).To(Equal("some-org"))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+			})
+
+			When("the org has one space", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationSpacesReturns(
+						[]v3action.Space{{GUID: "some-space-guid", Name: "some-space"}},
+						v3action.Warnings{"spaces-warning"}, nil)
+					fakeChecker.GetSpaceByOrganizationAndNameReturns(v2action.Space{AllowSSH: true}, nil, nil)
+				})
+
+				It("targets it without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("spaces-warning"))
+					Expect(testUI.Out).To(Say("Targeted space some-space"))
+					Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+
+					Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+					spaceGUID, spaceName, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(spaceName).To(Equal("some-space"))
+					Expect(allowSSH).To(BeTrue())
+
+					orgGUID, name := fakeChecker.GetSpaceByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(name).To(Equal("some-space"))
+				})
+			})
+
+			When("the org has more than one space", func() {
+				var fakeUI *commandfakes.FakeUI
+
+				BeforeEach(func() {
+					fakeActor.GetOrganizationSpacesReturns(
+						[]v3action.Space{{GUID: "space-guid-1", Name: "space-1"}, {GUID: "space-guid-2", Name: "space-2"}},
+						nil, nil)
+					fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "space-guid-2", Name: "space-2"}, nil, nil)
+
+					fakeUI = new(commandfakes.FakeUI)
+					fakeUI.DisplayTextMenuReturns("space-2", nil)
+					cmd.UI = fakeUI
+				})
+
+				It("prompts for the space with a menu", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeUI.DisplayTextMenuCallCount()).To(Equal(1))
+					choices, prompt, _ := fakeUI.DisplayTextMenuArgsForCall(0)
+					Expect(choices).To(Equal([]string{"space-1", "space-2"}))
+					Expect(prompt).To(Equal("Select a space (or press enter to skip):"))
+
+					spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+					Expect(spaceName).To(Equal("space-2"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+
+					spaceGUID, _, _ := fakeConfig.SetSpaceInformationArgsForCall(0)
+					Expect(spaceGUID).To(Equal("space-guid-2"))
+				})
+
+				When("the user picks a number that is not listed", func() {
 					BeforeEach(func() {
-						outAndErr := NewBuffer()
-						testUI.Out = outAndErr
-						testUI.Err = outAndErr
+						fakeUI.DisplayTextMenuReturnsOnCall(0, "", ui.ErrInvalidIndex)
+						fakeUI.DisplayTextMenuReturnsOnCall(1, "space-1", nil)
 					})
 
-					It("displays the warning after all prompts but before the summary ", func() {
-						Expect(executeErr).NotTo(HaveOccurred())
-						Expect(testUI.Out).To(Say(`Authenticating...`))
-						Expect(testUI.Err).To(Say("Cloud Foundry API version 2.123.0 requires CLI version 9000.0.0. You are currently on version 1.2.3. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads"))
-						Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, cmd.APIEndpoint))
-						Expect(testUI.Out).To(Say(`Not logged in. Use 'faceman login' to log in.`))
+					It("prompts again", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeUI.DisplayTextMenuCallCount()).To(Equal(2))
+						spaceName, _ := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+						Expect(spaceName).To(Equal("space-1"))
+					})
+				})
+
+				When("the user skips choosing a space", func() {
+					BeforeEach(func() {
+						fakeUI.DisplayTextMenuReturns("", nil)
+					})
+
+					It("does not target a space", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 					})
 				})
 			})
+
+			When("the space flag is set", func() {
+				BeforeEach(func() {
+					cmd.Space = "some-space"
+					fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "some-space-guid", Name: "some-space"}, v3action.Warnings{"space-warning"}, nil)
+				})
+
+				It("looks the space up by name instead of listing spaces", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("space-warning"))
+					Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+					spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+					Expect(spaceName).To(Equal("some-space"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(testUI.Out).To(Say("Targeted space some-space"))
+				})
+
+				When("the space does not exist", func() {
+					BeforeEach(func() {
+						fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, nil, actionerror.SpaceNotFoundError{Name: "some-space"})
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+						Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		When("the user can see more than one org", func() {
+			var fakeUI *commandfakes.FakeUI
+
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns(
+					[]v3action.Organization{{GUID: "org-guid-1", Name: "org-1"}, {GUID: "org-guid-2", Name: "org-2"}},
+					nil, nil)
+				fakeActor.GetOrganizationByNameReturns(v3action.Organization{GUID: "org-guid-2", Name: "org-2"}, nil, nil)
+
+				fakeUI = new(commandfakes.FakeUI)
+				fakeUI.DisplayTextMenuReturns("org-2", nil)
+				cmd.UI = fakeUI
+			})
+
+			It("prompts for the org with a menu", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				choices, prompt, _ := fakeUI.DisplayTextMenuArgsForCall(0)
+				Expect(choices).To(Equal([]string{"org-1", "org-2"}))
+				Expect(prompt).To(Equal("Select an org (or press enter to skip):"))
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("org-2"))
+
+				orgGUID, _ := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(orgGUID).To(Equal("org-guid-2"))
+			})
+		})
+
+		When("the org flag is set", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+				fakeActor.GetOrganizationByNameReturns(v3action.Organization{GUID: "some-org-guid", Name: "some-org"}, v3action.Warnings{"org-warning"}, nil)
+			})
+
+			It("looks the org up by name instead of listing orgs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				Expect(testUI.Out).To(Say("Targeted org some-org"))
+			})
+
+			When("the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(v3action.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+					Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 })
@@ -3,31 +3,45 @@ package v6
 import (
 	"github.com/cloudfoundry/noaa/consumer"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
+	DiagnoseLogConnection() v2action.LogConnectionDiagnosis
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsFromLogCacheForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsFromLogCacheForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.ProjectAppName `positional-args:"yes"`
+	Diagnose        bool                `long:"diagnose" description:"Check each layer of the connection to the logging endpoint (DNS, TCP, TLS and auth token) before retrieving logs"`
+	Recent          bool                `long:"recent" description:"Dump recent logs instead of tailing"`
+	usage           interface{}         `usage:"CF_NAME logs [APP_NAME]"`
+	examples        interface{}         `examples:"CF_NAME logs my-app # Stream the logs of an app\nCF_NAME logs my-app --recent # Show recent logs and exit\nCF_NAME logs my-app --diagnose # Check the connection to the logging endpoint first"`
+	relatedCommands interface{}         `related_commands:"app, apps, ssh"`
 
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       LogsActor
-	NOAAClient  *consumer.Consumer
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          LogsActor
+	NOAAClient     *consumer.Consumer
+	LogCacheClient v2action.LogCacheClient
+	// LogSources are the sources logs are read from, in the order they are
+	// tried.
+	LogSources []string
+	Project    configv3.Project
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,10 +57,35 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
-	return nil
+	var logCacheEndpoint string
+	if config.LogSource() != configv3.LogSourceDoppler {
+		// The Log Cache endpoint is only advertised in the root links; an API
+		// that cannot provide them is treated as not offering Log Cache.
+		ccClientV3, _, v3Err := shared.NewV3BasedClients(config, ui, true, "")
+		if v3Err == nil {
+			logCacheEndpoint = ccClientV3.LogCache()
+		}
+	}
+	cmd.LogSources = shared.LogSources(config.LogSource(), logCacheEndpoint, ccClient.DopplerEndpoint())
+	if logCacheEndpoint != "" {
+		cmd.LogCacheClient = shared.NewLogCacheClientForEndpoint(logCacheEndpoint, config, uaaClient)
+	} else {
+		cmd.LogCacheClient = shared.NewLogCacheClient(config, uaaClient)
+	}
+
+	cmd.Project, err = shared.LoadProject()
+	return err
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	appName := cmd.OptionalArgs.AppName
+	if appName == "" {
+		appName = cmd.Project.AppName
+	}
+	if appName == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -59,48 +98,90 @@ func (cmd LogsCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
+			"AppName":   appName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
 			"SpaceName": cmd.Config.TargetedSpace().Name,
 			"Username":  user.Name,
 		})
 	cmd.UI.DisplayNewline()
 
+	if cmd.Diagnose {
+		diagnosis := cmd.Actor.DiagnoseLogConnection()
+		cmd.displayLogConnectionDiagnosis(diagnosis)
+		if failedCheck, failed := diagnosis.FailedCheck(); failed {
+			return logConnectionFailedError(diagnosis.Endpoint, failedCheck)
+		}
+	}
+
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		return cmd.displayRecentLogs(appName)
 	}
 
-	return cmd.streamLogs()
+	return cmd.streamLogs(appName)
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
-	)
+func (cmd LogsCommand) displayRecentLogs(appName string) error {
+	for i, source := range cmd.LogSources {
+		messages, warnings, err := cmd.getRecentLogs(source, appName)
+		if cmd.canFallBack(i, err) {
+			cmd.UI.DisplayWarnings(warnings)
+			cmd.displayLogSourceFallback(source, cmd.LogSources[i+1], err)
+			continue
+		}
+
+		var logRateLimitExceeded bool
+		for _, message := range messages {
+			cmd.UI.DisplayLogMessage(message, true)
+			logRateLimitExceeded = logRateLimitExceeded || message.LogRateLimitExceeded()
+		}
 
-	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		cmd.UI.DisplayWarnings(warnings)
+		if logRateLimitExceeded {
+			cmd.displayLogRateLimitWarning(appName)
+		}
+		return cmd.logSourceError(source, err)
 	}
 
-	cmd.UI.DisplayWarnings(warnings)
-	return err
+	return nil
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
-	)
+func (cmd LogsCommand) getRecentLogs(source string, appName string) ([]v2action.LogMessage, v2action.Warnings, error) {
+	if source == configv3.LogSourceLogCache {
+		return cmd.Actor.GetRecentLogsFromLogCacheForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
+	}
+	return cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+}
 
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
+func (cmd LogsCommand) streamLogs(appName string) error {
+	for i, source := range cmd.LogSources {
+		messages, logErrs, warnings, err := cmd.getStreamingLogs(source, appName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		received, err := cmd.displayStreamingLogs(source, appName, messages, logErrs)
+		if !received && cmd.canFallBack(i, err) {
+			cmd.displayLogSourceFallback(source, cmd.LogSources[i+1], err)
+			continue
+		}
+		return cmd.logSourceError(source, err)
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) getStreamingLogs(source string, appName string) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	if source == configv3.LogSourceLogCache {
+		return cmd.Actor.GetStreamingLogsFromLogCacheForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
 	}
+	return cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+}
 
-	var messagesClosed, errLogsClosed bool
+// displayStreamingLogs displays log messages until the stream ends or fails.
+// It returns whether any message was received along with the stream error.
+func (cmd LogsCommand) displayStreamingLogs(source string, appName string, messages <-chan *v2action.LogMessage, logErrs <-chan error) (bool, error) {
+	var received, messagesClosed, errLogsClosed, logRateLimitWarned bool
 	for {
 		select {
 		case message, ok := <-messages:
@@ -109,15 +190,22 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
+			received = true
 			cmd.UI.DisplayLogMessage(message, true)
+			if !logRateLimitWarned && message.LogRateLimitExceeded() {
+				cmd.displayLogRateLimitWarning(appName)
+				logRateLimitWarned = true
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
 				break
 			}
 
-			cmd.NOAAClient.Close()
-			return logErr
+			if source == configv3.LogSourceDoppler {
+				cmd.NOAAClient.Close()
+			}
+			return received, logErr
 		}
 
 		if messagesClosed && errLogsClosed {
@@ -125,5 +213,83 @@ func (cmd LogsCommand) streamLogs() error {
 		}
 	}
 
-	return nil
+	return received, nil
+}
+
+// canFallBack returns whether err from the log source at index i warrants
+// trying the next log source.
+func (cmd LogsCommand) canFallBack(i int, err error) bool {
+	if _, isAppNotFound := err.(actionerror.ApplicationNotFoundError); err == nil || isAppNotFound {
+		return false
+	}
+	return i < len(cmd.LogSources)-1
+}
+
+func (cmd LogsCommand) displayLogSourceFallback(source string, fallback string, err error) {
+	cmd.UI.DisplayWarning("Unable to read logs from {{.Source}}: {{.Error}}", map[string]interface{}{
+		"Source": source,
+		"Error":  err.Error(),
+	})
+	cmd.UI.DisplayWarning("Falling back to {{.Fallback}}.", map[string]interface{}{
+		"Fallback": fallback,
+	})
+}
+
+// logSourceError returns the error to report when the log source fails. The
+// connection to Doppler is diagnosed to name the layer that failed.
+func (cmd LogsCommand) logSourceError(source string, err error) error {
+	if _, isAppNotFound := err.(actionerror.ApplicationNotFoundError); err == nil || isAppNotFound {
+		return err
+	}
+	if source == configv3.LogSourceDoppler {
+		return cmd.diagnoseLogError(err)
+	}
+	return err
+}
+
+func (cmd LogsCommand) displayLogRateLimitWarning(appName string) {
+	cmd.UI.DisplayWarning("Some log lines of app {{.AppName}} are being dropped because an instance exceeded its log rate limit. Use '{{.BinaryName}} app {{.AppName}}' to view the limit.", map[string]interface{}{
+		"AppName":    appName,
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+}
+
+// diagnoseLogError checks the connection to the logging endpoint after logs
+// could not be retrieved. It returns an error naming the layer that failed,
+// or logErr when every layer checks out.
+func (cmd LogsCommand) diagnoseLogError(logErr error) error {
+	diagnosis := cmd.Actor.DiagnoseLogConnection()
+	if failedCheck, failed := diagnosis.FailedCheck(); failed {
+		return logConnectionFailedError(diagnosis.Endpoint, failedCheck)
+	}
+	return logErr
+}
+
+func (cmd LogsCommand) displayLogConnectionDiagnosis(diagnosis v2action.LogConnectionDiagnosis) {
+	cmd.UI.DisplayText("Diagnosing connection to logging endpoint {{.Endpoint}}...", map[string]interface{}{
+		"Endpoint": diagnosis.Endpoint,
+	})
+
+	table := [][]string{{
+		cmd.UI.TranslateText("layer"),
+		cmd.UI.TranslateText("result"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, check := range diagnosis.Checks {
+		result := cmd.UI.TranslateText("ok")
+		if !check.Passed {
+			result = cmd.UI.TranslateText("failed")
+		}
+		table = append(table, []string{check.Layer, result, check.Detail})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
+
+func logConnectionFailedError(endpoint string, failedCheck v2action.LogConnectionCheck) error {
+	return translatableerror.LogConnectionFailedError{
+		Endpoint: endpoint,
+		Layer:    failedCheck.Layer,
+		Reason:   failedCheck.Detail,
+	}
 }
@@ -6,7 +6,9 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -25,6 +27,7 @@ var _ = Describe("logs command", func() {
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v6fakes.FakeLogsActor
 		noaaClient      *consumer.Consumer
+		logCacheClient  *v2actionfakes.FakeLogCacheClient
 		binaryName      string
 		executeErr      error
 	)
@@ -35,18 +38,21 @@ var _ = Describe("logs command", func() {
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v6fakes.FakeLogsActor)
 		noaaClient = new(consumer.Consumer)
+		logCacheClient = new(v2actionfakes.FakeLogCacheClient)
 
 		cmd = LogsCommand{
-			UI:          testUI,
-			Config:      fakeConfig,
-			SharedActor: fakeSharedActor,
-			Actor:       fakeActor,
-			NOAAClient:  noaaClient,
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			NOAAClient:     noaaClient,
+			LogCacheClient: logCacheClient,
+			LogSources:     []string{configv3.LogSourceDoppler},
 		}
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppName = "some-app"
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -54,6 +60,34 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("no app name is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = ""
+		})
+
+		When("the project file names an app", func() {
+			BeforeEach(func() {
+				cmd.Project = configv3.Project{AppName: "project-app"}
+				cmd.Recent = true
+			})
+
+			It("shows the logs of the project's app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Retrieving logs for app project-app"))
+
+				appName, _, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("project-app"))
+			})
+		})
+
+		When("there is no project app", func() {
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -80,6 +114,59 @@ var _ = Describe("logs command", func() {
 			})
 		})
 
+		When("the --diagnose flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Diagnose = true
+				cmd.Recent = true
+			})
+
+			When("every layer checks out", func() {
+				BeforeEach(func() {
+					fakeActor.DiagnoseLogConnectionReturns(v2action.LogConnectionDiagnosis{
+						Endpoint: "wss://doppler.example.com:443",
+						Checks: []v2action.LogConnectionCheck{
+							{Layer: "DNS", Passed: true, Detail: "doppler.example.com resolves to 10.0.0.1"},
+							{Layer: "TCP", Passed: true, Detail: "connected to doppler.example.com:443"},
+							{Layer: "TLS", Passed: true, Detail: "handshake succeeded"},
+							{Layer: "auth token", Passed: true, Detail: "issued for cloud_controller"},
+						},
+					})
+				})
+
+				It("displays the diagnosis and retrieves the logs", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Diagnosing connection to logging endpoint wss://doppler\.example\.com:443\.\.\.`))
+					Expect(testUI.Out).To(Say(`layer\s+result\s+details`))
+					Expect(testUI.Out).To(Say(`DNS\s+ok\s+doppler\.example\.com resolves to 10\.0\.0\.1`))
+					Expect(testUI.Out).To(Say(`TCP\s+ok\s+connected to doppler\.example\.com:443`))
+					Expect(testUI.Out).To(Say(`TLS\s+ok\s+handshake succeeded`))
+					Expect(testUI.Out).To(Say(`auth token\s+ok\s+issued for cloud_controller`))
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				})
+			})
+
+			When("a layer fails", func() {
+				BeforeEach(func() {
+					fakeActor.DiagnoseLogConnectionReturns(v2action.LogConnectionDiagnosis{
+						Endpoint: "wss://doppler.example.com:443",
+						Checks: []v2action.LogConnectionCheck{
+							{Layer: "DNS", Passed: false, Detail: "no such host"},
+						},
+					})
+				})
+
+				It("displays the diagnosis and returns an error naming the failed layer", func() {
+					Expect(executeErr).To(MatchError(translatableerror.LogConnectionFailedError{
+						Endpoint: "wss://doppler.example.com:443",
+						Layer:    "DNS",
+						Reason:   "no such host",
+					}))
+					Expect(testUI.Out).To(Say(`DNS\s+failed\s+no such host`))
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		When("the --recent flag is provided", func() {
 			BeforeEach(func() {
 				cmd.Recent = true
@@ -104,6 +191,41 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Err).To(Say("some-warning-1"))
 					Expect(testUI.Err).To(Say("some-warning-2"))
 				})
+
+				It("diagnoses the connection to the logging endpoint", func() {
+					Expect(fakeActor.DiagnoseLogConnectionCallCount()).To(Equal(1))
+				})
+
+				When("the diagnosis finds a failed layer", func() {
+					BeforeEach(func() {
+						fakeActor.DiagnoseLogConnectionReturns(v2action.LogConnectionDiagnosis{
+							Endpoint: "wss://doppler.example.com:443",
+							Checks: []v2action.LogConnectionCheck{
+								{Layer: "DNS", Passed: true},
+								{Layer: "TCP", Passed: false, Detail: "connection refused"},
+							},
+						})
+					})
+
+					It("returns an error naming the failed layer", func() {
+						Expect(executeErr).To(MatchError(translatableerror.LogConnectionFailedError{
+							Endpoint: "wss://doppler.example.com:443",
+							Layer:    "TCP",
+							Reason:   "connection refused",
+						}))
+					})
+				})
+			})
+
+			When("the app does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+				})
+
+				It("returns the error without diagnosing the connection", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(fakeActor.DiagnoseLogConnectionCallCount()).To(Equal(0))
+				})
 			})
 
 			When("the logs actor returns logs", func() {
@@ -145,6 +267,107 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 				})
 			})
+
+			When("the logs show the app exceeded its log rate limit", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage(
+								"app instance exceeded log rate limit (16384 bytes/sec)",
+								1,
+								time.Unix(0, 0),
+								"APP/PROC/WEB",
+								"0",
+							),
+						},
+						nil,
+						nil)
+				})
+
+				It("warns that log lines are being dropped", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("app instance exceeded log rate limit"))
+					Expect(testUI.Err).To(Say(`Some log lines of app some-app are being dropped because an instance exceeded its log rate limit\. Use 'faceman app some-app' to view the limit\.`))
+				})
+			})
+
+			When("Log Cache is tried before Doppler", func() {
+				BeforeEach(func() {
+					cmd.LogSources = []string{configv3.LogSourceLogCache, configv3.LogSourceDoppler}
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am a doppler message", 1, time.Unix(0, 0), "app", "1"),
+						},
+						v2action.Warnings{"doppler-warning"},
+						nil)
+				})
+
+				When("Log Cache returns logs", func() {
+					BeforeEach(func() {
+						fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns(
+							[]v2action.LogMessage{
+								*v2action.NewLogMessage("i am a log cache message", 1, time.Unix(0, 0), "app", "1"),
+							},
+							v2action.Warnings{"log-cache-warning"},
+							nil)
+					})
+
+					It("displays the logs from Log Cache without reading from Doppler", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say("i am a log cache message"))
+						Expect(testUI.Err).To(Say("log-cache-warning"))
+
+						Expect(fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID, client := fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(client).To(Equal(logCacheClient))
+						Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+
+				When("Log Cache cannot be reached", func() {
+					BeforeEach(func() {
+						fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns(
+							nil,
+							v2action.Warnings{"log-cache-warning"},
+							errors.New("connection refused"))
+					})
+
+					It("falls back to Doppler", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Err).To(Say("log-cache-warning"))
+						Expect(testUI.Err).To(Say("Unable to read logs from log-cache: connection refused"))
+						Expect(testUI.Err).To(Say("Falling back to doppler."))
+						Expect(testUI.Out).To(Say("i am a doppler message"))
+						Expect(testUI.Err).To(Say("doppler-warning"))
+					})
+				})
+
+				When("the app does not exist", func() {
+					BeforeEach(func() {
+						fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns(nil, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+					})
+
+					It("returns the error without falling back", func() {
+						Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+						Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+
+				When("Log Cache is the only source and cannot be reached", func() {
+					BeforeEach(func() {
+						cmd.LogSources = []string{configv3.LogSourceLogCache}
+						fakeActor.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns(nil, nil, errors.New("connection refused"))
+					})
+
+					It("returns the error without diagnosing the Doppler connection", func() {
+						Expect(executeErr).To(MatchError("connection refused"))
+						Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.DiagnoseLogConnectionCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 
 		When("the --recent flag is not provided", func() {
@@ -192,6 +415,25 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Err).To(Say("some-warning-1"))
 					Expect(testUI.Err).To(Say("some-warning-2"))
 				})
+
+				When("the diagnosis finds a failed layer", func() {
+					BeforeEach(func() {
+						fakeActor.DiagnoseLogConnectionReturns(v2action.LogConnectionDiagnosis{
+							Endpoint: "wss://doppler.example.com:443",
+							Checks: []v2action.LogConnectionCheck{
+								{Layer: "TLS", Passed: false, Detail: "x509: certificate signed by unknown authority"},
+							},
+						})
+					})
+
+					It("returns an error naming the failed layer", func() {
+						Expect(executeErr).To(MatchError(translatableerror.LogConnectionFailedError{
+							Endpoint: "wss://doppler.example.com:443",
+							Layer:    "TLS",
+							Reason:   "x509: certificate signed by unknown authority",
+						}))
+					})
+				})
 			})
 
 			When("the logs actor returns logs", func() {
@@ -245,6 +487,72 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 				})
 			})
+
+			When("Log Cache is tried before Doppler", func() {
+				BeforeEach(func() {
+					cmd.LogSources = []string{configv3.LogSourceLogCache, configv3.LogSourceDoppler}
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewLogMessage("i am a doppler message", 1, time.Unix(0, 0), "app", "1")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				When("the Log Cache stream fails before any logs are received", func() {
+					BeforeEach(func() {
+						fakeActor.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error, 1)
+							logErrs <- errors.New("connection refused")
+							close(messages)
+							close(logErrs)
+
+							return messages, logErrs, nil, nil
+						}
+					})
+
+					It("falls back to Doppler", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Err).To(Say("Unable to read logs from log-cache: connection refused"))
+						Expect(testUI.Err).To(Say("Falling back to doppler."))
+						Expect(testUI.Out).To(Say("i am a doppler message"))
+
+						_, _, client := fakeActor.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall(0)
+						Expect(client).To(Equal(logCacheClient))
+					})
+				})
+
+				When("the Log Cache stream fails after logs are received", func() {
+					BeforeEach(func() {
+						fakeActor.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error)
+
+							go func() {
+								messages <- v2action.NewLogMessage("i am a log cache message", 1, time.Unix(0, 0), "app", "1")
+								logErrs <- errors.New("connection reset")
+								close(messages)
+								close(logErrs)
+							}()
+
+							return messages, logErrs, nil, nil
+						}
+					})
+
+					It("returns the error without falling back", func() {
+						Expect(executeErr).To(MatchError("connection reset"))
+						Expect(testUI.Out).To(Say("i am a log cache message"))
+						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 	})
 })
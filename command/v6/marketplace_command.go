@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -26,13 +28,17 @@ type ServicesSummariesActor interface {
 type MarketplaceCommand struct {
 	ServiceName     string      `short:"s" description:"Show plan details for a particular service offering"`
 	NoPlans         bool        `long:"no-plans" description:"Hide plan information for service offerings"`
-	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE]"`
+	Search          string      `long:"search" description:"Only show service offerings whose name, description, broker or plans contain every word of the search"`
+	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE | --search TEXT]"`
 	relatedCommands interface{} `related_commands:"create-service, services"`
 
 	UI          command.UI
 	SharedActor command.SharedActor
 	Actor       ServicesSummariesActor
 	Config      command.Config
+	// Cache holds the catalogs listed by the command. A nil Cache always
+	// fetches the catalog.
+	Cache *v2action.MarketplaceCache
 }
 
 func (cmd *MarketplaceCommand) Setup(config command.Config, ui command.UI) error {
@@ -47,6 +53,9 @@ func (cmd *MarketplaceCommand) Setup(config command.Config, ui command.UI) error
 
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	cache := v2action.NewMarketplaceCache(configv3.MarketplaceCacheDirectory())
+	cmd.Cache = &cache
+
 	return nil
 }
 
@@ -57,6 +66,12 @@ func (cmd *MarketplaceCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.ServiceName != "" && cmd.Search != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"-s", "--search"},
+		}
+	}
+
 	if !cmd.SharedActor.IsLoggedIn() {
 		return cmd.publicMarketplace()
 	}
@@ -80,8 +95,10 @@ func (cmd *MarketplaceCommand) marketplace() error {
 			"SpaceName": cmd.Config.TargetedSpace().Name,
 			"Username":  user.Name,
 		})
-		serviceSummaries, warnings, err := cmd.Actor.GetServicesSummariesForSpace(cmd.Config.TargetedSpace().GUID)
-		cmd.UI.DisplayWarnings(warnings)
+		spaceGUID := cmd.Config.TargetedSpace().GUID
+		serviceSummaries, err := cmd.cachedServiceSummaries(spaceGUID+"/"+user.Name, func() ([]v2action.ServiceSummary, v2action.Warnings, error) {
+			return cmd.Actor.GetServicesSummariesForSpace(spaceGUID)
+		})
 		if err != nil {
 			return err
 		}
@@ -116,8 +133,7 @@ func (cmd *MarketplaceCommand) publicMarketplace() error {
 	if cmd.ServiceName == "" {
 		cmd.UI.DisplayText("Getting all services from marketplace...")
 
-		serviceSummaries, warnings, err := cmd.Actor.GetServicesSummaries()
-		cmd.UI.DisplayWarnings(warnings)
+		serviceSummaries, err := cmd.cachedServiceSummaries("public", cmd.Actor.GetServicesSummaries)
 		if err != nil {
 			return err
 		}
@@ -143,6 +159,51 @@ func (cmd *MarketplaceCommand) publicMarketplace() error {
 	return nil
 }
 
+// cachedServiceSummaries returns the catalog cached under key, fetching and
+// caching it when there is none. When a search was given, only the matching
+// summaries are returned.
+func (cmd *MarketplaceCommand) cachedServiceSummaries(key string, fetch func() ([]v2action.ServiceSummary, v2action.Warnings, error)) ([]v2action.ServiceSummary, error) {
+	key = cmd.Config.Target() + "/" + key
+
+	var serviceSummaries []v2action.ServiceSummary
+	cached := false
+	if cmd.Cache != nil {
+		var cachedAt time.Time
+		serviceSummaries, cachedAt, cached = cmd.Cache.Load(key)
+		if cached {
+			cmd.UI.DisplayText("Using marketplace catalog cached at {{.CachedAt}}", map[string]interface{}{
+				"CachedAt": cmd.UI.UserFriendlyDate(cachedAt),
+			})
+		}
+	}
+
+	if !cached {
+		var (
+			warnings v2action.Warnings
+			err      error
+		)
+		serviceSummaries, warnings, err = fetch()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+
+		if cmd.Cache != nil {
+			err = cmd.Cache.Save(key, serviceSummaries)
+			if err != nil {
+				cmd.UI.DisplayWarning("Unable to cache the marketplace catalog: {{.Error}}", map[string]interface{}{
+					"Error": err.Error(),
+				})
+			}
+		}
+	}
+
+	if cmd.Search != "" {
+		serviceSummaries = v2action.SearchServiceSummaries(serviceSummaries, cmd.Search)
+	}
+	return serviceSummaries, nil
+}
+
 func (cmd *MarketplaceCommand) displayServiceSummaries(serviceSummaries []v2action.ServiceSummary) {
 	if len(serviceSummaries) == 0 {
 		cmd.UI.DisplayText("No service offerings found")
@@ -2,6 +2,9 @@ package v6_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -378,6 +381,91 @@ var _ = Describe("marketplace Command", func() {
 		})
 	})
 
+	When("-s and --search are both passed", func() {
+		BeforeEach(func() {
+			cmd.ServiceName = "service-a"
+			cmd.Search = "postgres"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"-s", "--search"},
+			}))
+		})
+	})
+
+	When("--search is passed", func() {
+		BeforeEach(func() {
+			cmd.Search = "POSTGRES"
+			fakeSharedActor.IsLoggedInReturns(false)
+			fakeActor.GetServicesSummariesReturns([]v2action.ServiceSummary{
+				{Service: v2action.Service{Label: "elephantsql", Description: "Hosted PostgreSQL", ServiceBrokerName: "broker-a"}},
+				{Service: v2action.Service{Label: "redis", Description: "In-memory store", ServiceBrokerName: "broker-b"}},
+			}, v2action.Warnings{"warning"}, nil)
+		})
+
+		It("only outputs the matching services", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("elephantsql\\s+Hosted PostgreSQL\\s+broker-a"))
+			Expect(testUI.Out).ToNot(Say("redis"))
+		})
+
+		When("nothing matches", func() {
+			BeforeEach(func() {
+				cmd.Search = "mysql"
+			})
+
+			It("outputs that none are available", func() {
+				Expect(testUI.Out).To(Say("No service offerings found"))
+			})
+		})
+	})
+
+	When("a cache is configured", func() {
+		var (
+			cacheDir string
+			cache    v2action.MarketplaceCache
+		)
+
+		BeforeEach(func() {
+			var err error
+			cacheDir, err = ioutil.TempDir("", "marketplace-command-cache")
+			Expect(err).ToNot(HaveOccurred())
+
+			cache = v2action.NewMarketplaceCache(cacheDir)
+			cmd.Cache = &cache
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeSharedActor.IsLoggedInReturns(false)
+			fakeActor.GetServicesSummariesReturns([]v2action.ServiceSummary{
+				{Service: v2action.Service{Label: "service-a", Description: "fake service-a", ServiceBrokerName: "broker-a"}},
+			}, v2action.Warnings{"warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(cacheDir)).To(Succeed())
+		})
+
+		It("fetches the catalog once and reuses it until the TTL passes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetServicesSummariesCallCount()).To(Equal(1))
+
+			Expect(cmd.Execute(nil)).To(Succeed())
+			Expect(fakeActor.GetServicesSummariesCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("Using marketplace catalog cached at"))
+			Expect(testUI.Out).To(Say("service-a\\s+fake service-a\\s+broker-a"))
+
+			cache.Now = func() time.Time { return time.Now().Add(v2action.MarketplaceCacheTTL) }
+			Expect(cmd.Execute(nil)).To(Succeed())
+			Expect(fakeActor.GetServicesSummariesCallCount()).To(Equal(2))
+		})
+
+		It("keeps catalogs of different targets apart", func() {
+			fakeConfig.TargetReturns("https://api.other.com")
+			Expect(cmd.Execute(nil)).To(Succeed())
+			Expect(fakeActor.GetServicesSummariesCallCount()).To(Equal(2))
+		})
+	})
+
 	When("the user is logged in but not targeting an org", func() {
 		BeforeEach(func() {
 			fakeSharedActor.IsLoggedInReturns(true)
@@ -0,0 +1,446 @@
+package v6
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . MigrateSpaceActor
+
+type MigrateSpaceActor interface {
+	ApplySpaceManifest(spaceGUID string, rawManifest []byte) (v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
+	CreateUserProvidedServiceInstance(spaceGUID string, instance v3action.UserProvidedServiceInstance) (v3action.Warnings, error)
+	DownloadApplicationPackage(appGUID string, path string) (v3action.Warnings, error)
+	GetApplicationManifest(appGUID string) ([]byte, v3action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetDomainNames() ([]string, v3action.Warnings, error)
+	GetServiceInstanceNamesBySpace(spaceGUID string) ([]string, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+	GetUserProvidedServiceInstancesBySpace(spaceGUID string) ([]v3action.UserProvidedServiceInstance, v3action.Warnings, error)
+	PollBuild(buildGUID string, appName string) (v3action.Droplet, v3action.Warnings, error)
+	SetApplicationDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
+	StageApplicationPackage(packageGUID string) (v3action.Build, v3action.Warnings, error)
+}
+
+type MigrateSpaceCommand struct {
+	RequiredArgs       flag.Space  `positional-args:"yes"`
+	SourceProfile      string      `long:"source-profile" required:"true" description:"Saved foundation to migrate the space from"`
+	DestinationProfile string      `long:"dest-profile" required:"true" description:"Saved foundation to migrate the space to"`
+	usage              interface{} `usage:"CF_NAME migrate-space SPACE --source-profile SOURCE --dest-profile DESTINATION\n\n   Copies the apps of SPACE, in the org targeted by the source profile, to the space of the same name in the org targeted by the destination profile. Profiles are foundations saved with 'CF_HOME=~/.cf/foundations/NAME cf login'.\n\n   Each app is recreated from its manifest, including its environment variables and the routes whose domain exists in the destination, and its package is copied and staged. User provided service instances are recreated. Bindings to other service instances are skipped. New apps are left stopped.\n\n   With the --dry-run global option, only the migration plan is displayed."`
+	examples           interface{} `examples:"CF_NAME migrate-space my-space --source-profile old --dest-profile new --dry-run\nCF_NAME migrate-space my-space --source-profile old --dest-profile new"`
+	relatedCommands    interface{} `related_commands:"create-app-manifest, export-env, import-env, push"`
+
+	UI                command.UI
+	Config            command.Config
+	SourceConfig      command.Config
+	DestinationConfig command.Config
+	Source            MigrateSpaceActor
+	Destination       MigrateSpaceActor
+}
+
+type serviceMigrationStep struct {
+	instance v3action.UserProvidedServiceInstance
+	exists   bool
+}
+
+type appMigrationStep struct {
+	guid      string
+	migration v3action.AppMigration
+	exists    bool
+}
+
+type spaceMigrationPlan struct {
+	services           []serviceMigrationStep
+	servicesSkipReason string
+	apps               []appMigrationStep
+}
+
+func (cmd *MigrateSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	var err error
+	cmd.SourceConfig, cmd.Source, err = newMigrateSpaceActor(config, ui, cmd.SourceProfile)
+	if err != nil {
+		return err
+	}
+	cmd.DestinationConfig, cmd.Destination, err = newMigrateSpaceActor(config, ui, cmd.DestinationProfile)
+	return err
+}
+
+func newMigrateSpaceActor(config command.Config, ui command.UI, profile string) (command.Config, MigrateSpaceActor, error) {
+	if !configv3.FoundationExists(profile) {
+		return nil, nil, translatableerror.FoundationNotFoundError{Name: profile, Path: configv3.FoundationHome(profile)}
+	}
+
+	profileConfig, err := config.FoundationConfig(profile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sharedActor := sharedaction.NewActor(profileConfig)
+	err = sharedActor.CheckTarget(true, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, _, err := shared.NewV3BasedClients(profileConfig, ui, true, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return profileConfig, v3action.NewActor(client, profileConfig, sharedActor, nil), nil
+}
+
+func (cmd MigrateSpaceCommand) Execute(args []string) error {
+	sourceOrg := cmd.SourceConfig.TargetedOrganization()
+	destinationOrg := cmd.DestinationConfig.TargetedOrganization()
+
+	cmd.UI.DisplayTextWithFlavor("Planning migration of space {{.SpaceName}} from org {{.SourceOrg}} ({{.SourceProfile}}) to org {{.DestinationOrg}} ({{.DestinationProfile}})...", map[string]interface{}{
+		"SpaceName":          cmd.RequiredArgs.Space,
+		"SourceOrg":          sourceOrg.Name,
+		"SourceProfile":      cmd.SourceProfile,
+		"DestinationOrg":     destinationOrg.Name,
+		"DestinationProfile": cmd.DestinationProfile,
+	})
+
+	sourceSpace, warnings, err := cmd.Source.GetSpaceByNameAndOrganization(cmd.RequiredArgs.Space, sourceOrg.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	destinationSpace, warnings, err := cmd.Destination.GetSpaceByNameAndOrganization(cmd.RequiredArgs.Space, destinationOrg.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	plan, err := cmd.plan(sourceSpace.GUID, destinationSpace.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayPlan(plan)
+
+	if cmd.Config.DryRun() {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run: no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	report, failed := cmd.migrate(plan, destinationSpace.GUID)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", report, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if len(failed) > 0 {
+		return translatableerror.SpaceMigrationFailedError{Resources: failed}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: New apps are stopped. Use '{{.BinaryName}} start APP_NAME' with the {{.DestinationProfile}} profile to start them.", map[string]interface{}{
+		"BinaryName":         cmd.Config.BinaryName(),
+		"DestinationProfile": cmd.DestinationProfile,
+	})
+	return nil
+}
+
+func (cmd MigrateSpaceCommand) plan(sourceSpaceGUID string, destinationSpaceGUID string) (spaceMigrationPlan, error) {
+	var plan spaceMigrationPlan
+
+	serviceInstances, warnings, err := cmd.Destination.GetServiceInstanceNamesBySpace(destinationSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return plan, err
+	}
+
+	sourceGate := command.NewAPIVersionGate(cmd.Source.CloudControllerAPIVersion)
+	destinationGate := command.NewAPIVersionGate(cmd.Destination.CloudControllerAPIVersion)
+	if sourceGate.Supports(ccversion.MinVersionUserProvidedServicesV3) && destinationGate.Supports(ccversion.MinVersionUserProvidedServicesV3) {
+		instances, warnings, err := cmd.Source.GetUserProvidedServiceInstancesBySpace(sourceSpaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return plan, err
+		}
+
+		for _, instance := range instances {
+			exists := containsName(serviceInstances, instance.Name)
+			plan.services = append(plan.services, serviceMigrationStep{instance: instance, exists: exists})
+			if !exists {
+				serviceInstances = append(serviceInstances, instance.Name)
+			}
+		}
+	} else {
+		plan.servicesSkipReason = cmd.UI.TranslateText("requires CC API version {{.APIVersion}} or higher on both foundations", map[string]interface{}{
+			"APIVersion": ccversion.MinVersionUserProvidedServicesV3,
+		})
+	}
+
+	domains, warnings, err := cmd.Destination.GetDomainNames()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return plan, err
+	}
+
+	destinationApps, warnings, err := cmd.Destination.GetApplicationsBySpace(destinationSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return plan, err
+	}
+	var destinationAppNames []string
+	for _, app := range destinationApps {
+		destinationAppNames = append(destinationAppNames, app.Name)
+	}
+
+	sourceApps, warnings, err := cmd.Source.GetApplicationsBySpace(sourceSpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return plan, err
+	}
+
+	for _, app := range sourceApps {
+		rawManifest, warnings, err := cmd.Source.GetApplicationManifest(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return plan, err
+		}
+
+		migration, err := v3action.PlanAppMigration(rawManifest, domains, serviceInstances)
+		if err != nil {
+			return plan, err
+		}
+		if migration.Name == "" {
+			migration.Name = app.Name
+		}
+
+		plan.apps = append(plan.apps, appMigrationStep{
+			guid:      app.GUID,
+			migration: migration,
+			exists:    containsName(destinationAppNames, app.Name),
+		})
+	}
+
+	return plan, nil
+}
+
+func (cmd MigrateSpaceCommand) displayPlan(plan spaceMigrationPlan) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("action"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+
+	if plan.servicesSkipReason != "" {
+		table = append(table, []string{cmd.UI.TranslateText("user-provided services"), cmd.UI.TranslateText("skip"), plan.servicesSkipReason})
+	}
+	for _, step := range plan.services {
+		if step.exists {
+			table = append(table, []string{cmd.serviceResource(step), cmd.UI.TranslateText("skip"), cmd.UI.TranslateText("already exists")})
+		} else {
+			table = append(table, []string{cmd.serviceResource(step), cmd.UI.TranslateText("create"), ""})
+		}
+	}
+
+	for _, step := range plan.apps {
+		action := cmd.UI.TranslateText("create")
+		if step.exists {
+			action = cmd.UI.TranslateText("update")
+		}
+		table = append(table, []string{cmd.appResource(step), action, cmd.appDetails(step.migration)})
+
+		for _, route := range step.migration.SkippedRoutes {
+			table = append(table, []string{
+				cmd.UI.TranslateText("  route {{.Route}}", map[string]interface{}{"Route": route}),
+				cmd.UI.TranslateText("skip"),
+				cmd.UI.TranslateText("domain not found"),
+			})
+		}
+		for _, service := range step.migration.SkippedServices {
+			table = append(table, []string{
+				cmd.UI.TranslateText("  service binding {{.ServiceInstance}}", map[string]interface{}{"ServiceInstance": service}),
+				cmd.UI.TranslateText("skip"),
+				cmd.UI.TranslateText("service instance not found"),
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd MigrateSpaceCommand) migrate(plan spaceMigrationPlan, destinationSpaceGUID string) ([][]string, []string) {
+	report := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+	var failed []string
+
+	record := func(resource string, err error, details string) {
+		switch err.(type) {
+		case nil:
+			report = append(report, []string{resource, cmd.UI.TranslateText("migrated"), details})
+		case skippedResource:
+			report = append(report, []string{resource, cmd.UI.TranslateText("skipped"), err.Error()})
+		default:
+			report = append(report, []string{resource, cmd.UI.TranslateText("failed"), err.Error()})
+			failed = append(failed, resource)
+		}
+	}
+
+	for _, step := range plan.services {
+		resource := cmd.serviceResource(step)
+		if step.exists {
+			record(resource, skippedResource(cmd.UI.TranslateText("already exists")), "")
+			continue
+		}
+
+		cmd.UI.DisplayText("Creating {{.Resource}}...", map[string]interface{}{"Resource": resource})
+		warnings, err := cmd.Destination.CreateUserProvidedServiceInstance(destinationSpaceGUID, step.instance)
+		cmd.UI.DisplayWarnings(warnings)
+		record(resource, err, "")
+	}
+
+	for _, step := range plan.apps {
+		resource := cmd.appResource(step)
+		cmd.UI.DisplayText("Applying manifest of {{.Resource}}...", map[string]interface{}{"Resource": resource})
+		warnings, err := cmd.Destination.ApplySpaceManifest(destinationSpaceGUID, step.migration.Manifest)
+		cmd.UI.DisplayWarnings(warnings)
+		record(resource, err, cmd.appDetails(step.migration))
+		if err != nil {
+			continue
+		}
+
+		dropletResource := cmd.UI.TranslateText("droplet {{.AppName}}", map[string]interface{}{"AppName": step.migration.Name})
+		cmd.UI.DisplayText("Copying and staging package of {{.Resource}}...", map[string]interface{}{"Resource": resource})
+		dropletGUID, err := cmd.copyDroplet(step, destinationSpaceGUID)
+		record(dropletResource, err, dropletGUID)
+	}
+
+	return report, failed
+}
+
+func (cmd MigrateSpaceCommand) copyDroplet(step appMigrationStep, destinationSpaceGUID string) (string, error) {
+	appName := step.migration.Name
+
+	var (
+		pkg      v3action.Package
+		warnings v3action.Warnings
+		err      error
+	)
+	if step.migration.DockerImage != "" {
+		pkg, warnings, err = cmd.Destination.CreateDockerPackageByApplicationNameAndSpace(appName, destinationSpaceGUID, v3action.DockerImageCredentials{Path: step.migration.DockerImage})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		dir, err := ioutil.TempDir("", "cf-migrate-space")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(dir)
+
+		bitsPath := filepath.Join(dir, fmt.Sprintf("%s.zip", step.guid))
+		warnings, err = cmd.Source.DownloadApplicationPackage(step.guid, bitsPath)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(actionerror.PackageNotFoundError); ok {
+			return "", skippedResource(cmd.UI.TranslateText("no package to copy"))
+		}
+		if err != nil {
+			return "", err
+		}
+
+		pkg, warnings, err = cmd.Destination.CreateAndUploadBitsPackageByApplicationNameAndSpace(appName, destinationSpaceGUID, bitsPath)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	build, warnings, err := cmd.Destination.StageApplicationPackage(pkg.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	droplet, warnings, err := cmd.Destination.PollBuild(build.GUID, appName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+
+	warnings, err = cmd.Destination.SetApplicationDropletByApplicationNameAndSpace(appName, destinationSpaceGUID, droplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+	return droplet.GUID, nil
+}
+
+func (cmd MigrateSpaceCommand) serviceResource(step serviceMigrationStep) string {
+	return cmd.UI.TranslateText("user-provided service {{.ServiceInstance}}", map[string]interface{}{
+		"ServiceInstance": step.instance.Name,
+	})
+}
+
+func (cmd MigrateSpaceCommand) appResource(step appMigrationStep) string {
+	return cmd.UI.TranslateText("app {{.AppName}}", map[string]interface{}{
+		"AppName": step.migration.Name,
+	})
+}
+
+func (cmd MigrateSpaceCommand) appDetails(migration v3action.AppMigration) string {
+	if migration.DockerImage != "" {
+		return cmd.UI.TranslateText("{{.Routes}} routes, {{.Services}} services, {{.EnvironmentVariables}} env variables, docker image {{.DockerImage}}", map[string]interface{}{
+			"Routes":               len(migration.Routes),
+			"Services":             len(migration.Services),
+			"EnvironmentVariables": migration.EnvironmentVariables,
+			"DockerImage":          migration.DockerImage,
+		})
+	}
+	return cmd.UI.TranslateText("{{.Routes}} routes, {{.Services}} services, {{.EnvironmentVariables}} env variables", map[string]interface{}{
+		"Routes":               len(migration.Routes),
+		"Services":             len(migration.Services),
+		"EnvironmentVariables": migration.EnvironmentVariables,
+	})
+}
+
+// skippedResource is the reason a resource was not migrated, reported without
+// failing the migration.
+type skippedResource string
+
+func (reason skippedResource) Error() string {
+	return string(reason)
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package v6_test
+
+import (
+	"errors"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("migrate-space Command", func() {
+	var (
+		cmd                   MigrateSpaceCommand
+		testUI                *ui.UI
+		fakeConfig            *commandfakes.FakeConfig
+		fakeSourceConfig      *commandfakes.FakeConfig
+		fakeDestinationConfig *commandfakes.FakeConfig
+		fakeSource            *v6fakes.FakeMigrateSpaceActor
+		fakeDestination       *v6fakes.FakeMigrateSpaceActor
+		executeErr            error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSourceConfig = new(commandfakes.FakeConfig)
+		fakeDestinationConfig = new(commandfakes.FakeConfig)
+		fakeSource = new(v6fakes.FakeMigrateSpaceActor)
+		fakeDestination = new(v6fakes.FakeMigrateSpaceActor)
+
+		cmd = MigrateSpaceCommand{
+			RequiredArgs:       flag.Space{Space: "some-space"},
+			SourceProfile:      "old",
+			DestinationProfile: "new",
+			UI:                 testUI,
+			Config:             fakeConfig,
+			SourceConfig:       fakeSourceConfig,
+			DestinationConfig:  fakeDestinationConfig,
+			Source:             fakeSource,
+			Destination:        fakeDestination,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeSourceConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "old-org-guid", Name: "old-org"})
+		fakeDestinationConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "new-org-guid", Name: "new-org"})
+
+		fakeSource.CloudControllerAPIVersionReturns(ccversion.MinVersionUserProvidedServicesV3)
+		fakeDestination.CloudControllerAPIVersionReturns(ccversion.MinVersionUserProvidedServicesV3)
+		fakeSource.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "old-space-guid"}, v3action.Warnings{"source-space-warning"}, nil)
+		fakeDestination.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "new-space-guid"}, v3action.Warnings{"destination-space-warning"}, nil)
+
+		fakeDestination.GetServiceInstanceNamesBySpaceReturns([]string{"cache"}, nil, nil)
+		fakeSource.GetUserProvidedServiceInstancesBySpaceReturns(
+			[]v3action.UserProvidedServiceInstance{
+				{Name: "cache"},
+				{Name: "db", Credentials: map[string]interface{}{"user": "admin"}},
+			},
+			nil,
+			nil,
+		)
+		fakeDestination.GetDomainNamesReturns([]string{"apps.new.com"}, nil, nil)
+		fakeDestination.GetApplicationsBySpaceReturns([]v3action.Application{{Name: "worker"}}, nil, nil)
+		fakeSource.GetApplicationsBySpaceReturns(
+			[]v3action.Application{
+				{Name: "web", GUID: "web-guid"},
+				{Name: "worker", GUID: "worker-guid"},
+			},
+			nil,
+			nil,
+		)
+		fakeSource.GetApplicationManifestStub = func(appGUID string) ([]byte, v3action.Warnings, error) {
+			if appGUID == "web-guid" {
+				return []byte(`applications:
+- name: web
+  env:
+    MODE: production
+  routes:
+  - route: web.apps.new.com
+  - route: web.internal.old.com
+  services:
+  - db
+  - metrics
+`), nil, nil
+			}
+			return []byte(`applications:
+- name: worker
+  docker:
+    image: some/worker
+  no-route: true
+`), nil, nil
+		}
+
+		fakeSource.DownloadApplicationPackageStub = func(appGUID string, path string) (v3action.Warnings, error) {
+			return v3action.Warnings{"download-warning"}, ioutil.WriteFile(path, []byte("some-zip"), 0600)
+		}
+		fakeDestination.CreateAndUploadBitsPackageByApplicationNameAndSpaceReturns(v3action.Package{GUID: "web-package-guid"}, nil, nil)
+		fakeDestination.CreateDockerPackageByApplicationNameAndSpaceReturns(v3action.Package{GUID: "worker-package-guid"}, nil, nil)
+		fakeDestination.StageApplicationPackageStub = func(packageGUID string) (v3action.Build, v3action.Warnings, error) {
+			return v3action.Build{GUID: packageGUID + "-build"}, nil, nil
+		}
+		fakeDestination.PollBuildStub = func(buildGUID string, appName string) (v3action.Droplet, v3action.Warnings, error) {
+			return v3action.Droplet{GUID: appName + "-droplet-guid"}, nil, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("looks up the space in the orgs targeted by both profiles", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Planning migration of space some-space from org old-org \(old\) to org new-org \(new\)\.\.\.`))
+		Expect(testUI.Err).To(Say("source-space-warning"))
+		Expect(testUI.Err).To(Say("destination-space-warning"))
+
+		spaceName, orgGUID := fakeSource.GetSpaceByNameAndOrganizationArgsForCall(0)
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(orgGUID).To(Equal("old-org-guid"))
+		spaceName, orgGUID = fakeDestination.GetSpaceByNameAndOrganizationArgsForCall(0)
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(orgGUID).To(Equal("new-org-guid"))
+	})
+
+	When("the space does not exist in the destination", func() {
+		BeforeEach(func() {
+			fakeDestination.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, nil, actionerror.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns the error without planning", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(fakeSource.GetApplicationsBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the --dry-run global option is provided", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+		})
+
+		It("displays the plan without making changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`resource\s+action\s+details`))
+			Expect(testUI.Out).To(Say(`user-provided service cache\s+skip\s+already exists`))
+			Expect(testUI.Out).To(Say(`user-provided service db\s+create`))
+			Expect(testUI.Out).To(Say(`app web\s+create\s+1 routes, 1 services, 1 env variables`))
+			Expect(testUI.Out).To(Say(`route web.internal.old.com\s+skip\s+domain not found`))
+			Expect(testUI.Out).To(Say(`service binding metrics\s+skip\s+service instance not found`))
+			Expect(testUI.Out).To(Say(`app worker\s+update\s+0 routes, 0 services, 0 env variables, docker image some/worker`))
+			Expect(testUI.Out).To(Say("Dry run: no changes were made."))
+
+			Expect(fakeDestination.CreateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			Expect(fakeDestination.ApplySpaceManifestCallCount()).To(Equal(0))
+			Expect(fakeSource.DownloadApplicationPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a foundation does not support user provided services", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+			fakeDestination.CloudControllerAPIVersionReturns("3.80.0")
+		})
+
+		It("skips them in the plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`user-provided services\s+skip\s+requires CC API version 3.99.0 or higher on both foundations`))
+			Expect(fakeSource.GetUserProvidedServiceInstancesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("migrates the services and apps and reports their status", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeDestination.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+		spaceGUID, instance := fakeDestination.CreateUserProvidedServiceInstanceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("new-space-guid"))
+		Expect(instance.Name).To(Equal("db"))
+
+		Expect(fakeDestination.ApplySpaceManifestCallCount()).To(Equal(2))
+		spaceGUID, rawManifest := fakeDestination.ApplySpaceManifestArgsForCall(0)
+		Expect(spaceGUID).To(Equal("new-space-guid"))
+		Expect(string(rawManifest)).To(ContainSubstring("web.apps.new.com"))
+		Expect(string(rawManifest)).ToNot(ContainSubstring("web.internal.old.com"))
+		Expect(string(rawManifest)).ToNot(ContainSubstring("metrics"))
+
+		appGUID, _ := fakeSource.DownloadApplicationPackageArgsForCall(0)
+		Expect(appGUID).To(Equal("web-guid"))
+		appName, spaceGUID, _ := fakeDestination.CreateAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("web"))
+		Expect(spaceGUID).To(Equal("new-space-guid"))
+
+		appName, _, dockerCredentials := fakeDestination.CreateDockerPackageByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("worker"))
+		Expect(dockerCredentials).To(Equal(v3action.DockerImageCredentials{Path: "some/worker"}))
+
+		Expect(fakeDestination.StageApplicationPackageArgsForCall(0)).To(Equal("web-package-guid"))
+		appName, spaceGUID, dropletGUID := fakeDestination.SetApplicationDropletByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("web"))
+		Expect(spaceGUID).To(Equal("new-space-guid"))
+		Expect(dropletGUID).To(Equal("web-droplet-guid"))
+
+		Expect(testUI.Err).To(Say("download-warning"))
+		Expect(testUI.Out).To(Say(`resource\s+status\s+details`))
+		Expect(testUI.Out).To(Say(`user-provided service cache\s+skipped\s+already exists`))
+		Expect(testUI.Out).To(Say(`user-provided service db\s+migrated`))
+		Expect(testUI.Out).To(Say(`app web\s+migrated\s+1 routes, 1 services, 1 env variables`))
+		Expect(testUI.Out).To(Say(`droplet web\s+migrated\s+web-droplet-guid`))
+		Expect(testUI.Out).To(Say(`app worker\s+migrated`))
+		Expect(testUI.Out).To(Say(`droplet worker\s+migrated\s+worker-droplet-guid`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: New apps are stopped. Use 'faceman start APP_NAME' with the new profile to start them."))
+	})
+
+	When("an app has no package", func() {
+		BeforeEach(func() {
+			fakeSource.DownloadApplicationPackageStub = nil
+			fakeSource.DownloadApplicationPackageReturns(nil, actionerror.PackageNotFoundError{})
+		})
+
+		It("skips its droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`droplet web\s+skipped\s+no package to copy`))
+			Expect(fakeDestination.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("migrating a resource fails", func() {
+		BeforeEach(func() {
+			fakeDestination.ApplySpaceManifestStub = func(spaceGUID string, rawManifest []byte) (v3action.Warnings, error) {
+				if fakeDestination.ApplySpaceManifestCallCount() == 1 {
+					return nil, errors.New("route is taken")
+				}
+				return nil, nil
+			}
+		})
+
+		It("continues with the other resources and returns a SpaceMigrationFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceMigrationFailedError{Resources: []string{"app web"}}))
+			Expect(testUI.Out).To(Say(`app web\s+failed\s+route is taken`))
+			Expect(testUI.Out).To(Say(`app worker\s+migrated`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(fakeSource.DownloadApplicationPackageCallCount()).To(Equal(0))
+			Expect(fakeDestination.CreateDockerPackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v6
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
@@ -12,6 +14,7 @@ import (
 
 type OrgsActor interface {
 	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetUserOrganizationRoles(userGUID string) (v2action.UserRoles, v2action.Warnings, error)
 }
 
 type OrgsCommand struct {
@@ -61,17 +64,24 @@ func (cmd OrgsCommand) Execute(args []string) error {
 
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
-	} else {
-		cmd.displayOrgs(orgs)
+		return nil
 	}
 
+	roles, warnings, err := cmd.Actor.GetUserOrganizationRoles(user.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayOrgs(orgs, roles)
+
 	return nil
 }
 
-func (cmd OrgsCommand) displayOrgs(orgs []v2action.Organization) {
-	table := [][]string{{cmd.UI.TranslateText("name")}}
+func (cmd OrgsCommand) displayOrgs(orgs []v2action.Organization, roles v2action.UserRoles) {
+	table := [][]string{{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("roles")}}
 	for _, org := range orgs {
-		table = append(table, []string{org.Name})
+		table = append(table, []string{org.Name, strings.Join(roles[org.GUID], ", ")})
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
@@ -76,7 +76,7 @@ var _ = Describe("orgs Command", func() {
 		When("getting the current user succeeds", func() {
 			BeforeEach(func() {
 				fakeConfig.CurrentUserReturns(
-					configv3.User{Name: "some-user"},
+					configv3.User{Name: "some-user", GUID: "some-user-guid"},
 					nil)
 			})
 
@@ -98,6 +98,7 @@ var _ = Describe("orgs Command", func() {
 					Expect(testUI.Err).To(Say("get-orgs-warning"))
 
 					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetUserOrganizationRolesCallCount()).To(Equal(0))
 				})
 			})
 
@@ -105,25 +106,45 @@ var _ = Describe("orgs Command", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationsReturns(
 						[]v2action.Organization{
-							{Name: "org-1"},
-							{Name: "org-2"},
+							{GUID: "org-guid-1", Name: "org-1"},
+							{GUID: "org-guid-2", Name: "org-2"},
 						},
 						v2action.Warnings{"get-orgs-warning"},
 						nil)
+					fakeActor.GetUserOrganizationRolesReturns(
+						v2action.UserRoles{"org-guid-1": {"manager", "auditor"}},
+						v2action.Warnings{"get-roles-warning"},
+						nil)
 				})
 
-				It("displays all the orgs in the org", func() {
+				It("displays all the orgs with the user's roles in each", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 
 					Expect(testUI.Out).To(Say(`Getting orgs as some-user\.\.\.`))
 					Expect(testUI.Out).To(Say(""))
-					Expect(testUI.Out).To(Say("name"))
-					Expect(testUI.Out).To(Say("org-1"))
-					Expect(testUI.Out).To(Say("org-2"))
+					Expect(testUI.Out).To(Say(`name\s+roles`))
+					Expect(testUI.Out).To(Say(`org-1\s+manager, auditor`))
+					Expect(testUI.Out).To(Say(`org-2\s*\n`))
 
 					Expect(testUI.Err).To(Say("get-orgs-warning"))
+					Expect(testUI.Err).To(Say("get-roles-warning"))
 
 					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetUserOrganizationRolesCallCount()).To(Equal(1))
+					Expect(fakeActor.GetUserOrganizationRolesArgsForCall(0)).To(Equal("some-user-guid"))
+				})
+
+				When("getting the user's roles fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetUserOrganizationRolesReturns(nil, v2action.Warnings{"get-roles-warning"}, errors.New("get-roles-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("get-roles-error"))
+
+						Expect(testUI.Err).To(Say("get-orgs-warning"))
+						Expect(testUI.Err).To(Say("get-roles-warning"))
+					})
 				})
 			})
 
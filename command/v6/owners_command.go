@@ -0,0 +1,105 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . OwnersActor
+
+type OwnersActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganizationOwners(orgName string) ([]v3action.ResourceOwner, v3action.Warnings, error)
+}
+
+type OwnersCommand struct {
+	Org             string      `short:"o" long:"org" description:"Org to list owners in (defaults to the targeted org)"`
+	usage           interface{} `usage:"CF_NAME owners [-o ORG]\n\n   Lists the owner, team and contact recorded on an org and on its spaces and apps."`
+	relatedCommands interface{} `related_commands:"set-owner, spaces, v3-apps"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OwnersActor
+}
+
+func (cmd *OwnersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd OwnersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Org == "", false)
+	if err != nil {
+		return err
+	}
+
+	err = command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion).Require("", ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Org
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting owners in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	owners, warnings, err := cmd.Actor.GetOrganizationOwners(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) == 0 {
+		cmd.UI.DisplayText("No owners found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("owner"),
+			cmd.UI.TranslateText("team"),
+			cmd.UI.TranslateText("contact"),
+		},
+	}
+	for _, owner := range owners {
+		table = append(table, []string{
+			string(owner.Type),
+			owner.Name,
+			owner.SpaceName,
+			owner.Owner.Owner,
+			owner.Team,
+			owner.Contact,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
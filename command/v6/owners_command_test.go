@@ -0,0 +1,140 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("owners Command", func() {
+	var (
+		cmd             OwnersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeOwnersActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeOwnersActor)
+
+		cmd = OwnersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org", GUID: "targeted-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			targetedOrg, targetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeTrue())
+			Expect(targetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the API does not support metadata", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.65.0")
+		})
+
+		It("returns a MinimumCFAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: "3.65.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	When("there are owners", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationOwnersReturns(
+				[]v3action.ResourceOwner{
+					{Type: v3action.OwnedOrganization, Name: "targeted-org", Owner: v3action.Owner{Owner: "platform", Contact: "#platform"}},
+					{Type: v3action.OwnedSpace, Name: "production", Owner: v3action.Owner{Team: "payments"}},
+					{Type: v3action.OwnedApplication, Name: "web", SpaceName: "production", Owner: v3action.Owner{Owner: "jane", Team: "payments", Contact: "payments-oncall"}},
+				},
+				v3action.Warnings{"owners-warning"},
+				nil,
+			)
+		})
+
+		It("lists the owners of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting owners in org targeted-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`type\s+name\s+space\s+owner\s+team\s+contact`))
+			Expect(testUI.Out).To(Say(`org\s+targeted-org\s+platform\s+#platform`))
+			Expect(testUI.Out).To(Say(`space\s+production\s+payments`))
+			Expect(testUI.Out).To(Say(`app\s+web\s+production\s+jane\s+payments\s+payments-oncall`))
+			Expect(testUI.Err).To(Say("owners-warning"))
+
+			Expect(fakeActor.GetOrganizationOwnersArgsForCall(0)).To(Equal("targeted-org"))
+		})
+
+		When("--org is passed", func() {
+			BeforeEach(func() {
+				cmd.Org = "other-org"
+			})
+
+			It("lists the owners of that org without requiring a targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				targetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(targetedOrg).To(BeFalse())
+				Expect(testUI.Out).To(Say(`Getting owners in org other-org as steve\.\.\.`))
+				Expect(fakeActor.GetOrganizationOwnersArgsForCall(0)).To(Equal("other-org"))
+			})
+		})
+	})
+
+	When("there are no owners", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationOwnersReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No owners found."))
+		})
+	})
+
+	When("getting the owners fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationOwnersReturns(nil, v3action.Warnings{"owners-warning"}, actionerror.OrganizationNotFoundError{Name: "targeted-org"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "targeted-org"}))
+			Expect(testUI.Err).To(Say("owners-warning"))
+		})
+	})
+})
@@ -1,22 +1,113 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . PurgeServiceInstanceActor
+
+type PurgeServiceInstanceActor interface {
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancePurgeImpact(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstancePurgeImpact, v2action.Warnings, error)
+	PurgeServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.Warnings, error)
+}
+
 type PurgeServiceInstanceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}          `usage:"CF_NAME purge-service-instance SERVICE_INSTANCE\n\nWARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys."`
+	Output          flag.OutputFormat    `long:"output" choice:"table" choice:"json" default:"table" description:"Output format of the cleanup checklist; json requires -f"`
+	usage           interface{}          `usage:"CF_NAME purge-service-instance SERVICE_INSTANCE [-f] [--output json]\n\nWARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys."`
 	relatedCommands interface{}          `related_commands:"delete-service, services, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PurgeServiceInstanceActor
 }
 
-func (PurgeServiceInstanceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *PurgeServiceInstanceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (PurgeServiceInstanceCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd *PurgeServiceInstanceCommand) Execute(args []string) error {
+	if cmd.Output == "json" && !cmd.Force {
+		return translatableerror.RequiredFlagsError{Arg1: "--output json", Arg2: "-f"}
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	serviceInstance, warnings, err := cmd.Actor.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceInstanceNotFoundError); ok {
+			cmd.UI.DisplayText("Service instance {{.ServiceInstance}} not found", map[string]interface{}{
+				"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	impact, warnings, err := cmd.Actor.GetServiceInstancePurgeImpact(serviceInstance)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	report := shared.NewPurgeReport(cmd.UI, "", "", []v2action.ServiceInstancePurgeImpact{impact})
+
+	if cmd.Output != "json" {
+		cmd.UI.DisplayText("WARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys.\n")
+		shared.DisplayPurgeImpact(cmd.UI, report)
+	}
+
+	if !cmd.Force {
+		confirmed, promptErr := shared.ConfirmPurge(cmd.UI, cmd.RequiredArgs.ServiceInstance)
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !confirmed {
+			cmd.UI.DisplayText("Purge service instance cancelled")
+			return nil
+		}
+	}
+
+	if cmd.Output != "json" {
+		cmd.UI.DisplayText("Purging service {{.ServiceInstance}}...", map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+	}
+
+	warnings, err = cmd.Actor.PurgeServiceInstance(serviceInstance)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "json" {
+		return shared.DisplayPurgeReportJSON(cmd.UI, report)
+	}
+
+	cmd.UI.DisplayOK()
+	shared.DisplayPurgeChecklist(cmd.UI, report)
+	return nil
 }
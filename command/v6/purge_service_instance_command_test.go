@@ -0,0 +1,211 @@
+package v6_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("purge-service-instance command", func() {
+	var (
+		cmd             PurgeServiceInstanceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakePurgeServiceInstanceActor
+		input           *Buffer
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakePurgeServiceInstanceActor)
+
+		cmd = PurgeServiceInstanceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.ServiceInstance{
+				ServiceInstance: "some-instance",
+			},
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetServiceInstanceByNameAndSpaceReturns(
+			v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"},
+			v2action.Warnings{"get-instance-warning"},
+			nil)
+		fakeActor.GetServiceInstancePurgeImpactReturns(
+			v2action.ServiceInstancePurgeImpact{
+				ServiceInstance: v2action.ServiceInstance{GUID: "some-instance-guid", Name: "some-instance"},
+				BoundApps:       []string{"some-app"},
+				ServiceKeys:     []string{"some-key"},
+				SharedTo:        []v2action.ServiceInstanceSharedTo{},
+			},
+			v2action.Warnings{"impact-warning"},
+			nil)
+		fakeActor.PurgeServiceInstanceReturns(v2action.Warnings{"purge-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the service instance does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"get-instance-warning"}, actionerror.ServiceInstanceNotFoundError{Name: "some-instance"})
+		})
+
+		It("displays that it was not found and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Service instance some-instance not found"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-instance-warning"))
+			Expect(fakeActor.PurgeServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the purge impact fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstancePurgeImpactReturns(v2action.ServiceInstancePurgeImpact{}, v2action.Warnings{"impact-warning"}, errors.New("impact-error"))
+		})
+
+		It("returns the error without purging", func() {
+			Expect(executeErr).To(MatchError("impact-error"))
+			Expect(testUI.Err).To(Say("impact-warning"))
+			Expect(fakeActor.PurgeServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user types the service instance name", func() {
+		BeforeEach(func() {
+			input.Write([]byte("some-instance\n"))
+		})
+
+		It("reports what will be orphaned, purges the instance and displays a cleanup checklist", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			name, spaceGUID := fakeActor.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("WARNING: This operation assumes that the service broker responsible for this service instance is no longer available"))
+			Expect(testUI.Out).To(Say("The following will be orphaned:"))
+			Expect(testUI.Out).To(Say(`bound apps:\s+some-app`))
+			Expect(testUI.Out).To(Say(`service keys:\s+some-key`))
+			Expect(testUI.Out).To(Say(`shared to:\s+none`))
+			Expect(testUI.Out).To(Say("Type 'some-instance' to confirm the purge"))
+			Expect(testUI.Out).To(Say(`Purging service some-instance\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Cleanup checklist:"))
+			Expect(testUI.Out).To(Say(`- Run 'cf restage some-app'`))
+			Expect(testUI.Out).To(Say("- Revoke the credentials of service key some-key"))
+			Expect(testUI.Out).To(Say("- Deprovision any resources the service provider still holds for service instance some-instance"))
+
+			Expect(fakeActor.PurgeServiceInstanceArgsForCall(0).GUID).To(Equal("some-instance-guid"))
+			Expect(testUI.Err).To(Say("get-instance-warning"))
+			Expect(testUI.Err).To(Say("impact-warning"))
+			Expect(testUI.Err).To(Say("purge-warning"))
+		})
+
+		When("purging fails", func() {
+			BeforeEach(func() {
+				fakeActor.PurgeServiceInstanceReturns(v2action.Warnings{"purge-warning"}, errors.New("purge-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("purge-error"))
+				Expect(testUI.Err).To(Say("purge-warning"))
+				Expect(testUI.Out).NotTo(Say("Cleanup checklist:"))
+			})
+		})
+	})
+
+	When("the user types something other than the service instance name", func() {
+		BeforeEach(func() {
+			input.Write([]byte("y\n"))
+		})
+
+		It("does not purge the service instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Purge service instance cancelled"))
+			Expect(fakeActor.PurgeServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is passed", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("purges without asking for confirmation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("to confirm the purge"))
+			Expect(fakeActor.PurgeServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		When("--output json is passed", func() {
+			BeforeEach(func() {
+				cmd.Output = "json"
+			})
+
+			It("displays only the report and checklist as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var report map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+				Expect(report).ToNot(HaveKey("service_offering"))
+				Expect(report["service_instances"]).To(Equal([]interface{}{
+					map[string]interface{}{
+						"name":         "some-instance",
+						"bound_apps":   []interface{}{"some-app"},
+						"service_keys": []interface{}{"some-key"},
+						"shared_to":    []interface{}{},
+					},
+				}))
+				Expect(report["cleanup_checklist"]).To(HaveLen(3))
+			})
+		})
+	})
+
+	When("--output json is passed without -f", func() {
+		BeforeEach(func() {
+			cmd.Output = "json"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--output json", Arg2: "-f"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -15,15 +15,17 @@ import (
 type PurgeServiceOfferingActor interface {
 	PurgeServiceOffering(service v2action.Service) (v2action.Warnings, error)
 	GetServiceByNameAndBrokerName(serviceName, brokerName string) (v2action.Service, v2action.Warnings, error)
+	GetServiceOfferingPurgeImpact(service v2action.Service) ([]v2action.ServiceInstancePurgeImpact, v2action.Warnings, error)
 }
 
 type PurgeServiceOfferingCommand struct {
-	RequiredArgs    flag.Service `positional-args:"yes"`
-	ServiceBroker   string       `short:"b" description:"Purge a service from a particular service broker. Required when service name is ambiguous"`
-	Force           bool         `short:"f" description:"Force deletion without confirmation"`
-	Provider        string       `short:"p" description:"Provider"`
-	usage           interface{}  `usage:"CF_NAME purge-service-offering SERVICE [-b BROKER] [-p PROVIDER] [-f]\n\nWARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup."`
-	relatedCommands interface{}  `related_commands:"marketplace, purge-service-instance, service-brokers"`
+	RequiredArgs    flag.Service      `positional-args:"yes"`
+	ServiceBroker   string            `short:"b" description:"Purge a service from a particular service broker. Required when service name is ambiguous"`
+	Force           bool              `short:"f" description:"Force deletion without confirmation"`
+	Provider        string            `short:"p" description:"Provider"`
+	Output          flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format of the cleanup checklist; json requires -f"`
+	usage           interface{}       `usage:"CF_NAME purge-service-offering SERVICE [-b BROKER] [-p PROVIDER] [-f] [--output json]\n\nWARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup."`
+	relatedCommands interface{}       `related_commands:"marketplace, purge-service-instance, service-brokers"`
 
 	UI          command.UI
 	SharedActor command.SharedActor
@@ -61,6 +63,10 @@ func (cmd *PurgeServiceOfferingCommand) Execute(args []string) error {
 		return translatableerror.FlagNoLongerSupportedError{Flag: "-p"}
 	}
 
+	if cmd.Output == "json" && !cmd.Force {
+		return translatableerror.RequiredFlagsError{Arg1: "--output json", Arg2: "-f"}
+	}
+
 	service, warnings, err := cmd.Actor.GetServiceByNameAndBrokerName(cmd.RequiredArgs.Service, cmd.ServiceBroker)
 	if err != nil {
 		cmd.UI.DisplayWarnings(warnings)
@@ -77,43 +83,69 @@ func (cmd *PurgeServiceOfferingCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.UI.DisplayText("WARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.\n")
+	impacts, impactWarnings, err := cmd.Actor.GetServiceOfferingPurgeImpact(service)
+	allWarnings := append(warnings, impactWarnings...)
+	if err != nil {
+		cmd.UI.DisplayWarnings(allWarnings)
+		return err
+	}
+
+	brokerName := cmd.ServiceBroker
+	if brokerName == "" {
+		brokerName = service.ServiceBrokerName
+	}
+	report := shared.NewPurgeReport(cmd.UI, cmd.RequiredArgs.Service, brokerName, impacts)
+
+	if cmd.Output != "json" {
+		cmd.UI.DisplayText("WARNING: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.\n")
+		shared.DisplayPurgeImpact(cmd.UI, report)
+	}
 
 	if !cmd.Force {
-		var promptMessage string
 		if cmd.ServiceBroker != "" {
-			promptMessage = "Really purge service offering {{.ServiceOffering}} from broker {{.ServiceBroker}} from Cloud Foundry?"
+			cmd.UI.DisplayText("Really purge service offering {{.ServiceOffering}} from broker {{.ServiceBroker}} from Cloud Foundry?", map[string]interface{}{
+				"ServiceOffering": cmd.RequiredArgs.Service,
+				"ServiceBroker":   cmd.ServiceBroker,
+			})
 		} else {
-			promptMessage = "Really purge service offering {{.ServiceOffering}} from Cloud Foundry?"
+			cmd.UI.DisplayText("Really purge service offering {{.ServiceOffering}} from Cloud Foundry?", map[string]interface{}{
+				"ServiceOffering": cmd.RequiredArgs.Service,
+			})
 		}
 
-		purgeServiceOffering, promptErr := cmd.UI.DisplayBoolPrompt(false, promptMessage, map[string]interface{}{
-			"ServiceOffering": cmd.RequiredArgs.Service,
-			"ServiceBroker":   cmd.ServiceBroker,
-		})
+		confirmed, promptErr := shared.ConfirmPurge(cmd.UI, cmd.RequiredArgs.Service)
 		if promptErr != nil {
+			cmd.UI.DisplayWarnings(allWarnings)
 			return promptErr
 		}
 
-		if !purgeServiceOffering {
+		if !confirmed {
+			cmd.UI.DisplayWarnings(allWarnings)
 			cmd.UI.DisplayText("Purge service offering cancelled")
 			return nil
 		}
 	}
 
-	cmd.UI.DisplayText("Purging service {{.ServiceOffering}}...", map[string]interface{}{
-		"ServiceOffering": cmd.RequiredArgs.Service,
-	})
+	if cmd.Output != "json" {
+		cmd.UI.DisplayText("Purging service {{.ServiceOffering}}...", map[string]interface{}{
+			"ServiceOffering": cmd.RequiredArgs.Service,
+		})
+	}
 
 	purgeWarnings, err := cmd.Actor.PurgeServiceOffering(service)
-	allWarnings := append(warnings, purgeWarnings...)
+	allWarnings = append(allWarnings, purgeWarnings...)
 	cmd.UI.DisplayWarnings(allWarnings)
 
 	if err != nil {
 		return err
 	}
 
+	if cmd.Output == "json" {
+		return shared.DisplayPurgeReportJSON(cmd.UI, report)
+	}
+
 	cmd.UI.DisplayOK()
+	shared.DisplayPurgeChecklist(cmd.UI, report)
 
 	return nil
 }
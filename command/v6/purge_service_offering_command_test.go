@@ -1,6 +1,8 @@
 package v6_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -94,23 +96,9 @@ var _ = Describe("purge-service-offering command", func() {
 					Expect(testUI.Out).To(Say("Really purge service offering some-service from Cloud Foundry?"))
 				})
 
-				When("the user chooses the default", func() {
+				When("the user types something other than the service offering name", func() {
 					BeforeEach(func() {
-						input.Write([]byte("\n"))
-					})
-
-					It("does not purge the service offering", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
-
-						Expect(testUI.Out).To(Say("Purge service offering cancelled"))
-
-						Expect(fakePurgeServiceActor.PurgeServiceOfferingCallCount()).To(Equal(0))
-					})
-				})
-
-				When("the user inputs no", func() {
-					BeforeEach(func() {
-						input.Write([]byte("n\n"))
+						input.Write([]byte("y\n"))
 					})
 
 					It("does not purge the service offering", func() {
@@ -122,9 +110,9 @@ var _ = Describe("purge-service-offering command", func() {
 					})
 				})
 
-				When("the user inputs yes", func() {
+				When("the user types the service offering name", func() {
 					BeforeEach(func() {
-						input.Write([]byte("y\n"))
+						input.Write([]byte("some-service\n"))
 						fakePurgeServiceActor.GetServiceByNameAndBrokerNameReturns(v2action.Service{
 							Label: "some-service",
 							GUID:  "some-service-guid",
@@ -180,22 +168,6 @@ var _ = Describe("purge-service-offering command", func() {
 						})
 					})
 				})
-
-				When("the user input is invalid", func() {
-					BeforeEach(func() {
-						input.Write([]byte("e\n\n"))
-					})
-
-					It("asks the user again", func() {
-						Expect(executeErr).NotTo(HaveOccurred())
-
-						Expect(testUI.Out).To(Say(`Really purge service offering some-service from Cloud Foundry\? \[yN\]:`))
-						Expect(testUI.Out).To(Say(`invalid input \(not y, n, yes, or no\)`))
-						Expect(testUI.Out).To(Say(`Really purge service offering some-service from Cloud Foundry\? \[yN\]:`))
-
-						Expect(fakePurgeServiceActor.PurgeServiceOfferingCallCount()).To(Equal(0))
-					})
-				})
 			})
 
 			When("the -f flag is passed", func() {
@@ -211,7 +183,7 @@ var _ = Describe("purge-service-offering command", func() {
 
 				It("purges the service offering without asking for confirmation", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Out).NotTo(Say(`Really purge service offering some-service from Cloud Foundry\? \[yN\]:`))
+					Expect(testUI.Out).NotTo(Say(`Type 'some-service' to confirm the purge`))
 
 					Expect(testUI.Out).To(Say(`Purging service some-service\.\.\.`))
 
@@ -225,6 +197,95 @@ var _ = Describe("purge-service-offering command", func() {
 				})
 			})
 
+			When("the offering has service instances", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.ServiceBroker = "some-broker"
+
+					fakePurgeServiceActor.GetServiceByNameAndBrokerNameReturns(v2action.Service{
+						Label: "some-service",
+						GUID:  "some-service-guid",
+					}, v2action.Warnings{"get-service-warning"}, nil)
+					fakePurgeServiceActor.GetServiceOfferingPurgeImpactReturns([]v2action.ServiceInstancePurgeImpact{
+						{
+							ServiceInstance: v2action.ServiceInstance{Name: "some-instance"},
+							BoundApps:       []string{"some-app"},
+							ServiceKeys:     []string{"some-key"},
+							SharedTo:        []v2action.ServiceInstanceSharedTo{{OrganizationName: "other-org", SpaceName: "other-space"}},
+						},
+					}, v2action.Warnings{"impact-warning"}, nil)
+				})
+
+				It("reports what will be orphaned and displays a cleanup checklist", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					service := fakePurgeServiceActor.GetServiceOfferingPurgeImpactArgsForCall(0)
+					Expect(service.GUID).To(Equal("some-service-guid"))
+
+					Expect(testUI.Out).To(Say("The following will be orphaned:"))
+					Expect(testUI.Out).To(Say("service instance some-instance:"))
+					Expect(testUI.Out).To(Say(`bound apps:\s+some-app`))
+					Expect(testUI.Out).To(Say(`service keys:\s+some-key`))
+					Expect(testUI.Out).To(Say(`shared to:\s+other-org/other-space`))
+					Expect(testUI.Out).To(Say(`Purging service some-service\.\.\.`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Cleanup checklist:"))
+					Expect(testUI.Out).To(Say(`- Run 'cf restage some-app'`))
+					Expect(testUI.Out).To(Say("- Revoke the credentials of service key some-key"))
+					Expect(testUI.Out).To(Say("- Tell the developers of org other-org space other-space"))
+					Expect(testUI.Out).To(Say("- Deprovision any resources the service provider still holds for service instance some-instance"))
+					Expect(testUI.Out).To(Say(`- Run 'cf delete-service-broker some-broker'`))
+					Expect(testUI.Err).To(Say("impact-warning"))
+				})
+
+				When("getting the purge impact fails", func() {
+					BeforeEach(func() {
+						fakePurgeServiceActor.GetServiceOfferingPurgeImpactReturns(nil, v2action.Warnings{"impact-warning"}, errors.New("impact-error"))
+					})
+
+					It("returns the error without purging", func() {
+						Expect(executeErr).To(MatchError("impact-error"))
+						Expect(testUI.Err).To(Say("impact-warning"))
+						Expect(fakePurgeServiceActor.PurgeServiceOfferingCallCount()).To(Equal(0))
+					})
+				})
+
+				When("--output json is passed", func() {
+					BeforeEach(func() {
+						cmd.Output = "json"
+					})
+
+					It("displays only the report and checklist as JSON", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(fakePurgeServiceActor.PurgeServiceOfferingCallCount()).To(Equal(1))
+
+						Expect(testUI.Out).NotTo(Say("WARNING"))
+						var report map[string]interface{}
+						Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+						Expect(report["service_offering"]).To(Equal("some-service"))
+						Expect(report["service_broker"]).To(Equal("some-broker"))
+						Expect(report["service_instances"]).To(HaveLen(1))
+
+						actions := []interface{}{}
+						for _, item := range report["cleanup_checklist"].([]interface{}) {
+							actions = append(actions, item.(map[string]interface{})["action"])
+						}
+						Expect(actions).To(Equal([]interface{}{"restage-app", "revoke-service-key", "notify-shared-space", "deprovision-service-instance", "delete-service-broker"}))
+					})
+				})
+			})
+
+			When("--output json is passed without -f", func() {
+				BeforeEach(func() {
+					cmd.Output = "json"
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--output json", Arg2: "-f"}))
+					Expect(fakePurgeServiceActor.GetServiceByNameAndBrokerNameCallCount()).To(Equal(0))
+				})
+			})
+
 			When("the -p flag is passed", func() {
 				BeforeEach(func() {
 					cmd.Provider = "dave"
@@ -245,12 +306,13 @@ var _ = Describe("purge-service-offering command", func() {
 						GUID:  "some-service-guid",
 					}, v2action.Warnings{"get-service-warning"}, nil)
 					fakePurgeServiceActor.PurgeServiceOfferingReturns(v2action.Warnings{"warning-1"}, nil)
-					input.Write([]byte("y\n"))
+					input.Write([]byte("some-service\n"))
 				})
 
 				It("purges the service offering for the specified broker", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Out).To(Say(`Really purge service offering some-service from broker some-broker from Cloud Foundry\? \[yN\]:`))
+					Expect(testUI.Out).To(Say(`Really purge service offering some-service from broker some-broker from Cloud Foundry\?`))
+					Expect(testUI.Out).To(Say(`Type 'some-service' to confirm the purge`))
 					Expect(testUI.Out).To(Say(`Purging service some-service\.\.\.`))
 
 					serviceName, brokerName := fakePurgeServiceActor.GetServiceByNameAndBrokerNameArgsForCall(0)
@@ -1,14 +1,19 @@
 package v6
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2v3action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -17,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/routeprobe"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
@@ -37,40 +43,68 @@ type V2PushActor interface {
 	CloudControllerV2APIVersion() string
 	CloudControllerV3APIVersion() string
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	DetectHealthCheck(appPath string) (pushaction.DetectedHealthCheck, bool)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 }
 
+//go:generate counterfeiter . HealthCheckActor
+
+type HealthCheckActor interface {
+	SetApplicationHealthCheckTypeByNameAndSpace(name string, spaceGUID string, healthCheckType constant.ApplicationHealthCheckType, httpEndpoint string) (v2action.Application, v2action.Warnings, error)
+	VerifyHealthCheckEndpoint(app v2action.Application, endpoint string, prober v2action.HealthCheckEndpointProber, timeout time.Duration) (v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . ProvenanceActor
+
+type ProvenanceActor interface {
+	GetApplicationArtifactProvenance(appGUID string) (v3action.Provenance, v3action.Warnings, error)
+	SetApplicationProvenance(provenance v3action.Provenance) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . GitSourceActor
+
+type GitSourceActor interface {
+	GetGitSource(appPath string) (sharedaction.GitSource, bool)
+}
+
 type PushCommand struct {
-	OptionalArgs        flag.OptionalAppName                    `positional-args:"yes"`
-	Buildpacks          []string                                `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Command             flag.Command                            `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain              string                                  `short:"d" description:"Specify a custom domain (e.g. private-domain.example.com, apps.internal.com) to use instead of the default domain"`
-	DockerImage         flag.DockerImage                        `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	DockerUsername      string                                  `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	DropletPath         flag.PathWithExistenceCheck             `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
-	PathToManifest      flag.PathWithExistenceCheck             `short:"f" description:"Path to manifest"`
-	HealthCheckType     flag.HealthCheckTypeWithDeprecatedValue `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	Hostname            string                                  `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	Instances           flag.Instances                          `short:"i" description:"Number of instances"`
-	DiskQuota           flag.Megabytes                          `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory              flag.Megabytes                          `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoHostname          bool                                    `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest          bool                                    `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute             bool                                    `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart             bool                                    `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath             flag.PathWithExistenceCheck             `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute         bool                                    `long:"random-route" description:"Create a random route for this app"`
-	RoutePath           flag.RoutePath                          `long:"route-path" description:"Path for the route"`
-	StackName           string                                  `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	VarsFilePaths       []flag.PathWithExistenceCheck           `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
-	Vars                []template.VarKV                        `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
-	HealthCheckTimeout  uint64                                  `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{}                             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{}                             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{}                             `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-
-	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	OptionalArgs        flag.OptionalAppName                      `positional-args:"yes"`
+	Attest              bool                                      `long:"attest" description:"Record the droplet checksum, package checksum, git commit and pusher of each app as app annotations"`
+	AttestFile          flag.Path                                 `long:"attest-file" description:"Also write an in-toto SLSA provenance statement for the pushed apps to this file; implies --attest"`
+	Buildpacks          []string                                  `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Command             flag.Command                              `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain              string                                    `short:"d" description:"Specify a custom domain (e.g. private-domain.example.com, apps.internal.com) to use instead of the default domain"`
+	DockerImage         flag.DockerImage                          `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername      string                                    `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DropletPath         flag.PathWithExistenceCheck               `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DetectHealthCheck   bool                                      `long:"detect-health-check" description:"Detect the health endpoint of Spring Boot Actuator, Rails and Node.js apps, verify it responds once the app starts and switch the app to an http health check on it"`
+	PathToManifest      flag.PathWithExistenceCheck               `short:"f" description:"Path to manifest"`
+	HealthCheckType     flag.HealthCheckTypeWithDeprecatedValue   `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname            string                                    `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	Instances           flag.Instances                            `short:"i" description:"Number of instances"`
+	DiskQuota           flag.Megabytes                            `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory              flag.Megabytes                            `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname          bool                                      `long:"no-hostname" description:"Map the root domain to this app"`
+	NoManifest          bool                                      `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute             bool                                      `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoFingerprintCache  bool                                      `long:"no-fingerprint-cache" description:"Recalculate the fingerprints of all app files instead of reusing those cached by previous pushes"`
+	NoStart             bool                                      `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath             flag.PathWithExistenceCheckOrRemoteSource `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory, or URL of a zip or tar.gz archive or git repository (append #REF to select a branch, tag or commit)"`
+	RandomRoute         bool                                      `long:"random-route" description:"Create a random route for this app"`
+	RoutePath           flag.RoutePath                            `long:"route-path" description:"Path for the route"`
+	ShowIgnored         bool                                      `long:"show-ignored" description:"List the files excluded from the upload by .cfignore files and the default ignore rules"`
+	SkipScan            bool                                      `long:"skip-scan" description:"Upload without running the push scan hook; only permitted when the push-scan-skip-allowed config setting is true, a safeguard against mistakes rather than an enforced policy"`
+	StackName           string                                    `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	Timeout             flag.Timeout                              `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes"`
+	VarsFilePaths       []flag.PathWithExistenceCheck             `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Vars                []template.VarKV                          `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	HealthCheckTimeout  uint64                                    `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingTimeout interface{}                               `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}                               `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword      interface{}                               `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+
+	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]... [--show-ignored] [--no-fingerprint-cache] [--timeout TIMEOUT] [--detect-health-check] [--skip-scan]\n   [--attest] [--attest-file PROVENANCE_PATH]\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI                      command.UI
@@ -78,17 +112,38 @@ type PushCommand struct {
 	SharedActor             command.SharedActor
 	Actor                   V2PushActor
 	ApplicationSummaryActor shared.ApplicationSummaryActor
+	IgnoredResourcesActor   shared.IgnoredResourcesActor
+	RemoteAppSourceActor    shared.RemoteAppSourceActor
 	ProgressBar             ProgressBar
 
 	RestartActor RestartActor
 	NOAAClient   *consumer.Consumer
+
+	HealthCheckActor  HealthCheckActor
+	HealthCheckProber v2action.HealthCheckEndpointProber
+
+	Project          configv3.Project
+	ProjectHookActor shared.ProjectHookActor
+
+	ProvenanceActor ProvenanceActor
+	GitSourceActor  GitSourceActor
 }
 
 func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
+	cmd.IgnoredResourcesActor = sharedActor
+	cmd.RemoteAppSourceActor = sharedActor
+	cmd.ProjectHookActor = sharedActor
+	cmd.GitSourceActor = sharedActor
+	if !cmd.NoFingerprintCache {
+		sharedActor.FingerprintCacheDirectory = configv3.FingerprintCacheDirectory()
+	}
 
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
@@ -104,14 +159,21 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	v3Actor := v3action.NewActor(ccClientV3, config, sharedActor, nil)
 
 	cmd.RestartActor = v2Actor
+	cmd.HealthCheckActor = v2Actor
+	cmd.ProvenanceActor = v3Actor
+	cmd.HealthCheckProber = routeprobe.NewProber(config.SkipSSLValidation(), config.DialTimeout())
 	cmd.Actor = pushaction.NewActor(v2Actor, v3Actor, sharedActor)
 
 	cmd.ApplicationSummaryActor = v2v3action.NewActor(v2Actor, v3Actor)
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
-	return nil
+	progressBar := progressbar.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
+
+	cmd.Project, err = shared.LoadProject()
+	return err
 }
 
 func (cmd PushCommand) Execute(args []string) error {
@@ -125,6 +187,12 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Project.Path != "" {
+		cmd.UI.DisplayText("Using project file {{.Path}}", map[string]interface{}{
+			"Path": cmd.Project.Path,
+		})
+	}
+
 	log.Info("collating flags")
 	cliSettings, err := cmd.GetCommandLineSettings()
 	if err != nil {
@@ -132,8 +200,21 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	scanHook, err := cmd.scanHook()
+	if err != nil {
+		return err
+	}
+
+	appPath, cleanupAppPath, err := shared.FetchRemoteAppSource(cmd.UI, cmd.RemoteAppSourceActor, cliSettings.ProvidedAppPath)
+	if err != nil {
+		log.Errorln("fetching remote app source:", err)
+		return err
+	}
+	defer cleanupAppPath()
+	cliSettings.ProvidedAppPath = appPath
+
 	log.Info("checking manifest")
-	rawApps, err := cmd.findAndReadManifestWithFlavorText(cliSettings)
+	rawApps, manifestPath, err := cmd.findAndReadManifestWithFlavorText(cliSettings)
 	if err != nil {
 		log.Errorln("reading manifest:", err)
 		return err
@@ -161,6 +242,22 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	healthChecks := cmd.detectHealthChecks(manifestApplications, appConfigs)
+
+	if cmd.ShowIgnored {
+		for _, appConfig := range appConfigs {
+			if appConfig.DesiredApplication.DockerImage != "" || appConfig.DropletPath != "" {
+				continue
+			}
+
+			err = shared.DisplayIgnoredResources(cmd.UI, cmd.IgnoredResourcesActor, appConfig.DesiredApplication.Name, appConfig.Path, appConfig.Archive)
+			if err != nil {
+				log.Errorln("gathering ignored files:", err)
+				return err
+			}
+		}
+	}
+
 	for _, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayText("Creating app with these attributes...")
@@ -177,30 +274,51 @@ func (cmd PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	var provenances []v3action.Provenance
+	progress := shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "")
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
+			progress.Step("creating app " + appConfig.DesiredApplication.Name)
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
 				"AppName": appConfig.DesiredApplication.Name,
 			})
 		} else {
+			progress.Step("updating app " + appConfig.DesiredApplication.Name)
 			cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}}...", map[string]interface{}{
 				"AppName": appConfig.DesiredApplication.Name,
 			})
 		}
 
+		appConfig.ScanHook = scanHook
+		appConfig.ManifestPath = manifestPath
 		configStream, eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig, cmd.ProgressBar)
 		updatedConfig, err := cmd.processApplyStreams(user, appConfig, configStream, eventStream, warningsStream, errorStream)
 		if err != nil {
 			log.Errorln("process apply stream:", err)
-			return err
+			return progress.Wrap(err)
 		}
 
 		if !cmd.NoStart {
 			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient)
-			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+			err = shared.PollStart(cmd.UI, cmd.Config, progress, messages, logErrs, appState, apiWarnings, errs)
+			if err != nil {
+				return err
+			}
+
+			if healthCheck, ok := healthChecks[appConfig.DesiredApplication.Name]; ok {
+				err = cmd.applyDetectedHealthCheck(updatedConfig.CurrentApplication.Application, healthCheck)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if cmd.Attest || cmd.AttestFile != "" {
+			provenance, err := cmd.recordProvenance(user, appConfig.Path, updatedConfig.CurrentApplication.Application)
 			if err != nil {
 				return err
 			}
+			provenances = append(provenances, provenance)
 		}
 
 		cmd.UI.DisplayNewline()
@@ -218,6 +336,167 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.AttestFile != "" {
+		err = cmd.writeProvenanceStatement(provenances)
+		if err != nil {
+			return err
+		}
+	}
+
+	return shared.RunPostPushHooks(cmd.UI, cmd.ProjectHookActor, cmd.Project)
+}
+
+// recordProvenance annotates the pushed app with the checksums of its
+// droplet and package, the git commit it was pushed from and the pushing
+// user.
+func (cmd PushCommand) recordProvenance(user configv3.User, appPath string, app v2action.Application) (v3action.Provenance, error) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Recording provenance of app {{.AppName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":  app.Name,
+		"Username": user.Name,
+	})
+
+	provenance, warnings, err := cmd.ProvenanceActor.GetApplicationArtifactProvenance(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v3action.Provenance{}, err
+	}
+	provenance.AppName = app.Name
+	provenance.PushedBy = user.Name
+	provenance.PushedAt = time.Now()
+
+	if appPath != "" {
+		if source, found := cmd.GitSourceActor.GetGitSource(appPath); found {
+			provenance.GitCommit = source.Commit
+			provenance.GitRemoteURL = source.RemoteURL
+		}
+	}
+
+	warnings, err = cmd.ProvenanceActor.SetApplicationProvenance(provenance)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v3action.Provenance{}, err
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("droplet checksum:"), provenance.DropletChecksum.String()},
+		{cmd.UI.TranslateText("package checksum:"), provenance.PackageChecksum.String()},
+		{cmd.UI.TranslateText("git commit:"), provenance.GitCommit},
+	}, 3)
+	return provenance, nil
+}
+
+// writeProvenanceStatement writes the in-toto provenance statement for the
+// pushed apps to the --attest-file path.
+func (cmd PushCommand) writeProvenanceStatement(provenances []v3action.Provenance) error {
+	statement := v3action.NewProvenanceStatement(cmd.Config.Target(), provenances)
+	output, err := json.MarshalIndent(statement, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(string(cmd.AttestFile), append(output, '\n'), 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Provenance statement written to {{.Path}}", map[string]interface{}{
+		"Path": string(cmd.AttestFile),
+	})
+	return nil
+}
+
+// scanHook returns the push scan hook that must approve the files of each
+// app, or an empty hook when there is none or --skip-scan is permitted.
+func (cmd PushCommand) scanHook() (string, error) {
+	hook := cmd.Config.PushScanHook()
+	if hook == "" || !cmd.SkipScan {
+		return hook, nil
+	}
+
+	if !cmd.Config.PushScanSkipAllowed() {
+		return "", translatableerror.PackageScanSkipNotAllowedError{BinaryName: cmd.Config.BinaryName()}
+	}
+	cmd.UI.DisplayWarning("Skipping the push scan hook.")
+	return "", nil
+}
+
+// detectHealthChecks looks for a framework health endpoint in each app
+// without an explicit health check type. With --detect-health-check the
+// detected endpoints are returned by app name to be verified once the apps
+// start; otherwise they are only suggested.
+func (cmd PushCommand) detectHealthChecks(manifestApps []manifest.Application, appConfigs []pushaction.ApplicationConfig) map[string]pushaction.DetectedHealthCheck {
+	explicitHealthCheck := map[string]bool{}
+	for _, app := range manifestApps {
+		if app.HealthCheckType != "" {
+			explicitHealthCheck[app.Name] = true
+		}
+	}
+
+	healthChecks := map[string]pushaction.DetectedHealthCheck{}
+	for _, appConfig := range appConfigs {
+		app := appConfig.DesiredApplication
+		if explicitHealthCheck[app.Name] ||
+			app.HealthCheckType == constant.ApplicationHealthCheckHTTP ||
+			app.HealthCheckType == constant.ApplicationHealthCheckProcess ||
+			app.DockerImage != "" ||
+			appConfig.DropletPath != "" {
+			continue
+		}
+
+		healthCheck, found := cmd.Actor.DetectHealthCheck(appConfig.Path)
+		if !found {
+			continue
+		}
+		log.WithField("framework", healthCheck.Framework).Debugln("detected health check endpoint:", healthCheck.Endpoint)
+
+		textMap := map[string]interface{}{
+			"AppName":   app.Name,
+			"Framework": healthCheck.Framework,
+			"Endpoint":  healthCheck.Endpoint,
+		}
+		if !cmd.DetectHealthCheck {
+			cmd.UI.DisplayText("TIP: App {{.AppName}} looks like a {{.Framework}} app. Use --detect-health-check to verify {{.Endpoint}} and use it for an http health check.", textMap)
+			continue
+		}
+
+		cmd.UI.DisplayText("Detected {{.Framework}} app {{.AppName}}, health check endpoint {{.Endpoint}} will be verified once the app starts.", textMap)
+		healthChecks[app.Name] = healthCheck
+	}
+
+	return healthChecks
+}
+
+// applyDetectedHealthCheck verifies that the started app serves the detected
+// health endpoint before switching it to an http health check, so that a
+// wrong guess fails the push with a diagnostic instead of crashing the app.
+func (cmd PushCommand) applyDetectedHealthCheck(app v2action.Application, healthCheck pushaction.DetectedHealthCheck) error {
+	textMap := map[string]interface{}{
+		"AppName":  app.Name,
+		"Endpoint": healthCheck.Endpoint,
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Verifying health check endpoint {{.Endpoint}} of app {{.AppName}}...", textMap)
+	warnings, err := cmd.HealthCheckActor.VerifyHealthCheckEndpoint(app, healthCheck.Endpoint, cmd.HealthCheckProber, cmd.Config.StartupTimeout())
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationWithoutHTTPRouteError); ok {
+			cmd.UI.DisplayWarning("App {{.AppName}} has no HTTP route to verify {{.Endpoint}} through; keeping its current health check.", textMap)
+			return nil
+		}
+		return err
+	}
+
+	_, warnings, err = cmd.HealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpace(app.Name, cmd.Config.TargetedSpace().GUID, constant.ApplicationHealthCheckHTTP, healthCheck.Endpoint)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Health check type of app {{.AppName}} set to http with endpoint {{.Endpoint}}.", textMap)
+	cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
 	return nil
 }
 
@@ -246,6 +525,11 @@ func (cmd PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings,
 		}
 	}
 
+	appName := cmd.OptionalArgs.AppName
+	if appName == "" {
+		appName = cmd.Project.AppName
+	}
+
 	config := pushaction.CommandLineSettings{
 		Buildpacks:           cmd.Buildpacks,             // -b
 		Command:              cmd.Command.FilteredString, // -c
@@ -261,7 +545,7 @@ func (cmd PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings,
 		HealthCheckType:      cmd.HealthCheckType.Type, // -u/--health-check-type
 		Instances:            cmd.Instances.NullInt,    // -i
 		Memory:               cmd.Memory.Value,         // -m
-		Name:                 cmd.OptionalArgs.AppName, // arg
+		Name:                 appName,                  // arg or project file
 		NoHostname:           cmd.NoHostname,           // --no-hostname
 		NoRoute:              cmd.NoRoute,              // --no-route
 		ProvidedAppPath:      string(cmd.AppPath),      // -p
@@ -274,20 +558,23 @@ func (cmd PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings,
 	return config, nil
 }
 
-func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
+func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.CommandLineSettings) ([]manifest.Application, string, error) {
 	var (
 		pathToManifest string
 	)
 	switch {
 	case cmd.NoManifest:
 		log.Debug("skipping reading of manifest")
-	case cmd.PathToManifest != "":
-		log.WithField("file", cmd.PathToManifest).Debug("using specified manifest file")
+	case cmd.PathToManifest != "" || cmd.Project.Manifest != "":
 		pathToManifest = string(cmd.PathToManifest)
+		if pathToManifest == "" {
+			pathToManifest = cmd.Project.Manifest
+		}
+		log.WithField("file", pathToManifest).Debug("using specified manifest file")
 
 		fileInfo, err := os.Stat(pathToManifest)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		if fileInfo.IsDir() {
@@ -304,7 +591,7 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 		}
 
 		if err != nil {
-			return nil, translatableerror.ManifestFileNotFoundInDirectoryError{
+			return nil, "", translatableerror.ManifestFileNotFoundInDirectoryError{
 				PathToManifest: pathToManifest,
 			}
 		}
@@ -326,7 +613,7 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if pathToManifest == "" {
@@ -336,10 +623,11 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 			"SpaceName": cmd.Config.TargetedSpace().Name,
 			"Username":  user.Name,
 		})
-		return nil, nil
+		return nil, "", nil
 	}
 
 	var pathsToVarsFiles []string
+	pathsToVarsFiles = append(pathsToVarsFiles, cmd.Project.VarsFiles...)
 	for _, path := range cmd.VarsFilePaths {
 		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
 	}
@@ -357,7 +645,7 @@ func (cmd PushCommand) findAndReadManifestWithFlavorText(settings pushaction.Com
 	apps, warnings, err := cmd.Actor.ReadManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
 	cmd.UI.DisplayWarnings(warnings)
 
-	return apps, err
+	return apps, pathToManifest, err
 }
 
 func (cmd PushCommand) processApplyStreams(
@@ -427,6 +715,8 @@ func (cmd PushCommand) processEvent(user configv3.User, appConfig pushaction.App
 		cmd.UI.DisplayText("Comparing local files to remote cache...")
 	case pushaction.CreatingArchive:
 		cmd.UI.DisplayText("Packaging files to upload...")
+	case pushaction.ScanningPackage:
+		cmd.UI.DisplayText("Running push scan hook...")
 	case pushaction.UploadingApplication:
 		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
@@ -525,6 +815,18 @@ func (cmd PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"--route-path", "--no-route"},
 		}
+	case cmd.DetectHealthCheck && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--detect-health-check", "--no-start"},
+		}
+	case cmd.DetectHealthCheck && cmd.HealthCheckType.Type != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--detect-health-check", "--health-check-type, -u"},
+		}
+	case cmd.DetectHealthCheck && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--detect-health-check", "--docker-image, -o"},
+		}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package v6_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -10,9 +11,12 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/actor/v2v3action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	v3constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -22,6 +26,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/cfignore"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -41,7 +46,14 @@ var _ = Describe("push Command", func() {
 		fakeActor                   *v6fakes.FakeV2PushActor
 		fakeRestartActor            *v6fakes.FakeRestartActor
 		fakeApplicationSummaryActor *sharedfakes.FakeApplicationSummaryActor
+		fakeIgnoredResourcesActor   *sharedfakes.FakeIgnoredResourcesActor
+		fakeRemoteAppSourceActor    *sharedfakes.FakeRemoteAppSourceActor
+		fakeProjectHookActor        *sharedfakes.FakeProjectHookActor
+		fakeHealthCheckActor        *v6fakes.FakeHealthCheckActor
+		fakeHealthCheckProber       *v2actionfakes.FakeHealthCheckEndpointProber
 		fakeProgressBar             *v6fakes.FakeProgressBar
+		fakeProvenanceActor         *v6fakes.FakeProvenanceActor
+		fakeGitSourceActor          *v6fakes.FakeGitSourceActor
 		input                       *Buffer
 		binaryName                  string
 
@@ -58,7 +70,13 @@ var _ = Describe("push Command", func() {
 		fakeActor = new(v6fakes.FakeV2PushActor)
 		fakeRestartActor = new(v6fakes.FakeRestartActor)
 		fakeApplicationSummaryActor = new(sharedfakes.FakeApplicationSummaryActor)
+		fakeIgnoredResourcesActor = new(sharedfakes.FakeIgnoredResourcesActor)
+		fakeRemoteAppSourceActor = new(sharedfakes.FakeRemoteAppSourceActor)
 		fakeProgressBar = new(v6fakes.FakeProgressBar)
+		fakeHealthCheckActor = new(v6fakes.FakeHealthCheckActor)
+		fakeHealthCheckProber = new(v2actionfakes.FakeHealthCheckEndpointProber)
+		fakeProvenanceActor = new(v6fakes.FakeProvenanceActor)
+		fakeGitSourceActor = new(v6fakes.FakeGitSourceActor)
 
 		cmd = PushCommand{
 			UI:                      testUI,
@@ -67,7 +85,13 @@ var _ = Describe("push Command", func() {
 			Actor:                   fakeActor,
 			RestartActor:            fakeRestartActor,
 			ApplicationSummaryActor: fakeApplicationSummaryActor,
+			IgnoredResourcesActor:   fakeIgnoredResourcesActor,
+			RemoteAppSourceActor:    fakeRemoteAppSourceActor,
 			ProgressBar:             fakeProgressBar,
+			HealthCheckActor:        fakeHealthCheckActor,
+			HealthCheckProber:       fakeHealthCheckProber,
+			ProvenanceActor:         fakeProvenanceActor,
+			GitSourceActor:          fakeGitSourceActor,
 		}
 
 		appName = "some-app"
@@ -175,6 +199,7 @@ var _ = Describe("push Command", func() {
 									Eventually(eventStream).Should(BeSent(pushaction.ResourceMatching))
 									Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
 									Eventually(eventStream).Should(BeSent(pushaction.CreatingArchive))
+									Eventually(eventStream).Should(BeSent(pushaction.ScanningPackage))
 									Eventually(eventStream).Should(BeSent(pushaction.UploadingApplicationWithArchive))
 									Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
 									Eventually(eventStream).Should(BeSent(pushaction.RetryUpload))
@@ -265,6 +290,71 @@ var _ = Describe("push Command", func() {
 							})
 						})
 
+						When("the app path is a remote URL", func() {
+							BeforeEach(func() {
+								cmd.AppPath = "https://example.com/org/repo/archive/main.tar.gz"
+								fakeRemoteAppSourceActor.FetchRemoteAppSourceReturns("/some/fetched/app", nil)
+							})
+
+							It("fetches the app source and pushes from the fetched path", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`Downloading app source from https://example\.com/org/repo/archive/main\.tar\.gz\.\.\.`))
+
+								Expect(fakeRemoteAppSourceActor.FetchRemoteAppSourceCallCount()).To(Equal(1))
+								source, _, _ := fakeRemoteAppSourceActor.FetchRemoteAppSourceArgsForCall(0)
+								Expect(source).To(Equal("https://example.com/org/repo/archive/main.tar.gz"))
+
+								cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+								Expect(cmdSettings.ProvidedAppPath).To(Equal("/some/fetched/app"))
+							})
+
+							When("fetching the app source fails", func() {
+								BeforeEach(func() {
+									fakeRemoteAppSourceActor.FetchRemoteAppSourceReturns("", actionerror.GitNotInstalledError{})
+								})
+
+								It("returns the error without pushing", func() {
+									Expect(executeErr).To(MatchError(actionerror.GitNotInstalledError{}))
+									Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+								})
+							})
+						})
+
+						When("--show-ignored is provided", func() {
+							BeforeEach(func() {
+								cmd.ShowIgnored = true
+								fakeIgnoredResourcesActor.GatherIgnoredDirectoryResourcesReturns(
+									[]sharedaction.IgnoredResource{
+										{Filename: "node_modules/", Rule: cfignore.Rule{Source: "/some/.cfignore", Line: 2, Pattern: "node_modules/"}},
+									},
+									nil,
+								)
+							})
+
+							It("lists the ignored files before pushing", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeIgnoredResourcesActor.GatherIgnoredDirectoryResourcesCallCount()).To(Equal(1))
+								Expect(fakeIgnoredResourcesActor.GatherIgnoredDirectoryResourcesArgsForCall(0)).To(Equal(pwd))
+
+								Expect(testUI.Out).To(Say("Files ignored when uploading app %s from %s:", appName, regexp.QuoteMeta(pwd)))
+								Expect(testUI.Out).To(Say(`file\s+ignored by`))
+								Expect(testUI.Out).To(Say(`node_modules/\s+/some/\.cfignore:2: node_modules/`))
+								Expect(testUI.Out).To(Say("Creating app with these attributes..."))
+							})
+
+							When("gathering the ignored files fails", func() {
+								BeforeEach(func() {
+									fakeIgnoredResourcesActor.GatherIgnoredDirectoryResourcesReturns(nil, errors.New("some-ignore-error"))
+								})
+
+								It("returns the error", func() {
+									Expect(executeErr).To(MatchError("some-ignore-error"))
+									Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+								})
+							})
+						})
+
 						When("a manifest is provided", func() {
 							var (
 								tmpDir       string
@@ -589,6 +679,110 @@ var _ = Describe("push Command", func() {
 							})
 						})
 
+						When("a project file is provided", func() {
+							var (
+								tmpDir       string
+								manifestPath string
+								varsPath     string
+							)
+
+							BeforeEach(func() {
+								var err error
+								tmpDir, err = ioutil.TempDir("", "push-command-test")
+								Expect(err).ToNot(HaveOccurred())
+
+								// OS X uses weird symlinks that causes problems for some tests
+								tmpDir, err = filepath.EvalSymlinks(tmpDir)
+								Expect(err).ToNot(HaveOccurred())
+
+								manifestPath = filepath.Join(tmpDir, "deploy", "manifest.yml")
+								Expect(os.MkdirAll(filepath.Dir(manifestPath), 0755)).To(Succeed())
+								Expect(ioutil.WriteFile(manifestPath, []byte("some manifest file"), 0666)).To(Succeed())
+								varsPath = filepath.Join(tmpDir, "deploy", "vars.yml")
+
+								cmd.OptionalArgs.AppName = ""
+								cmd.Project = configv3.Project{
+									Path:      filepath.Join(tmpDir, "cfproject.yml"),
+									AppName:   "project-app",
+									Manifest:  manifestPath,
+									VarsFiles: []string{varsPath},
+									PostPush:  []string{"./smoke-test.sh"},
+								}
+								fakeProjectHookActor = new(sharedfakes.FakeProjectHookActor)
+								cmd.ProjectHookActor = fakeProjectHookActor
+							})
+
+							AfterEach(func() {
+								Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+							})
+
+							It("uses the project's app name, manifest and vars files and runs its post-push hooks", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Using project file %s", regexp.QuoteMeta(cmd.Project.Path)))
+								Expect(testUI.Out).To(Say("Using manifest file %s", regexp.QuoteMeta(manifestPath)))
+
+								cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+								Expect(cmdSettings.Name).To(Equal("project-app"))
+
+								Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+								manifest, varsFiles, _ := fakeActor.ReadManifestArgsForCall(0)
+								Expect(manifest).To(Equal(manifestPath))
+								Expect(varsFiles).To(Equal([]string{varsPath}))
+
+								Expect(fakeProjectHookActor.RunProjectHookCallCount()).To(Equal(1))
+								hook, dir, _, _ := fakeProjectHookActor.RunProjectHookArgsForCall(0)
+								Expect(hook).To(Equal("./smoke-test.sh"))
+								Expect(dir).To(Equal(tmpDir))
+								Expect(testUI.Out).To(Say(`Running post-push hook \./smoke-test\.sh\.\.\.`))
+							})
+
+							When("flags are also provided", func() {
+								var flagVarsPath string
+
+								BeforeEach(func() {
+									cmd.OptionalArgs.AppName = "flag-app"
+									otherManifestPath := filepath.Join(tmpDir, "manifest.yml")
+									Expect(ioutil.WriteFile(otherManifestPath, []byte("some manifest file"), 0666)).To(Succeed())
+									cmd.PathToManifest = flag.PathWithExistenceCheck(otherManifestPath)
+									flagVarsPath = filepath.Join(tmpDir, "other-vars.yml")
+									cmd.VarsFilePaths = []flag.PathWithExistenceCheck{flag.PathWithExistenceCheck(flagVarsPath)}
+								})
+
+								It("prefers the flags and applies the flag vars files after the project's", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+									Expect(cmdSettings.Name).To(Equal("flag-app"))
+
+									manifest, varsFiles, _ := fakeActor.ReadManifestArgsForCall(0)
+									Expect(manifest).To(Equal(filepath.Join(tmpDir, "manifest.yml")))
+									Expect(varsFiles).To(Equal([]string{varsPath, flagVarsPath}))
+								})
+							})
+
+							When("--no-manifest is provided", func() {
+								BeforeEach(func() {
+									cmd.NoManifest = true
+								})
+
+								It("ignores the project's manifest", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(fakeActor.ReadManifestCallCount()).To(Equal(0))
+								})
+							})
+
+							When("a post-push hook fails", func() {
+								BeforeEach(func() {
+									fakeProjectHookActor.RunProjectHookReturns(actionerror.ProjectHookFailedError{Command: "./smoke-test.sh", Err: errors.New("exit status 1")})
+								})
+
+								It("returns the error after pushing", func() {
+									Expect(executeErr).To(MatchError(actionerror.ProjectHookFailedError{Command: "./smoke-test.sh", Err: errors.New("exit status 1")}))
+									Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+								})
+							})
+						})
+
 						When("an app name and manifest are provided", func() {
 							var (
 								tmpDir         string
@@ -658,6 +852,46 @@ var _ = Describe("push Command", func() {
 							Expect(progressBar).To(Equal(fakeProgressBar))
 						})
 
+						When("a push scan hook is configured", func() {
+							BeforeEach(func() {
+								fakeConfig.PushScanHookReturns("some-scan-hook")
+							})
+
+							It("has the hook approve the files of each app", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								config, _ := fakeActor.ApplyArgsForCall(0)
+								Expect(config.ScanHook).To(Equal("some-scan-hook"))
+							})
+
+							When("--skip-scan is provided", func() {
+								BeforeEach(func() {
+									cmd.SkipScan = true
+								})
+
+								When("the config permits skipping the scan", func() {
+									BeforeEach(func() {
+										fakeConfig.PushScanSkipAllowedReturns(true)
+									})
+
+									It("pushes without the hook", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Err).To(Say("Skipping the push scan hook."))
+
+										config, _ := fakeActor.ApplyArgsForCall(0)
+										Expect(config.ScanHook).To(BeEmpty())
+									})
+								})
+
+								When("the config does not permit skipping the scan", func() {
+									It("returns a PackageScanSkipNotAllowedError without pushing", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PackageScanSkipNotAllowedError{BinaryName: binaryName}))
+										Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+									})
+								})
+							})
+						})
+
 						It("display diff of changes", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
@@ -684,6 +918,7 @@ var _ = Describe("push Command", func() {
 								Expect(testUI.Out).To(Say("All files found in remote cache; nothing to upload."))
 								Expect(testUI.Out).To(Say(`Waiting for API to complete processing files\.\.\.`))
 								Expect(testUI.Out).To(Say(`Packaging files to upload\.\.\.`))
+								Expect(testUI.Out).To(Say(`Running push scan hook\.\.\.`))
 								Expect(testUI.Out).To(Say(`Uploading files\.\.\.`))
 								Expect(testUI.Out).To(Say(`Retrying upload due to an error\.\.\.`))
 								Expect(testUI.Out).To(Say(`Waiting for API to complete processing files\.\.\.`))
@@ -799,6 +1034,188 @@ var _ = Describe("push Command", func() {
 							})
 						})
 
+						When("a framework health endpoint is detected", func() {
+							BeforeEach(func() {
+								fakeConfig.StartupTimeoutReturns(time.Minute)
+								fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+								fakeActor.DetectHealthCheckReturns(pushaction.DetectedHealthCheck{
+									Framework: "Spring Boot Actuator",
+									Endpoint:  "/actuator/health",
+								}, true)
+							})
+
+							When("--detect-health-check is not provided", func() {
+								It("suggests the health check without verifying it", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(fakeActor.DetectHealthCheckCallCount()).To(Equal(1))
+									Expect(fakeActor.DetectHealthCheckArgsForCall(0)).To(Equal(pwd))
+									Expect(testUI.Out).To(Say(`TIP: App some-app looks like a Spring Boot Actuator app\. Use --detect-health-check to verify /actuator/health and use it for an http health check\.`))
+									Expect(fakeHealthCheckActor.VerifyHealthCheckEndpointCallCount()).To(Equal(0))
+								})
+							})
+
+							When("the manifest sets the health check type", func() {
+								BeforeEach(func() {
+									appManifests[0].HealthCheckType = "port"
+								})
+
+								It("does not detect the health check", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(fakeActor.DetectHealthCheckCallCount()).To(Equal(0))
+								})
+							})
+
+							When("--detect-health-check is provided", func() {
+								BeforeEach(func() {
+									cmd.DetectHealthCheck = true
+								})
+
+								When("the endpoint responds", func() {
+									BeforeEach(func() {
+										fakeHealthCheckActor.VerifyHealthCheckEndpointReturns(v2action.Warnings{"verify-warning"}, nil)
+										fakeHealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"set-health-check-warning"}, nil)
+									})
+
+									It("verifies the endpoint once the app starts and sets an http health check", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+
+										Expect(testUI.Out).To(Say(`Detected Spring Boot Actuator app some-app, health check endpoint /actuator/health will be verified once the app starts\.`))
+										Expect(testUI.Out).To(Say(`Verifying health check endpoint /actuator/health of app some-app\.\.\.`))
+										Expect(testUI.Out).To(Say(`Health check type of app some-app set to http with endpoint /actuator/health\.`))
+										Expect(testUI.Out).To(Say("TIP: An app restart is required for the change to take effect."))
+										Expect(testUI.Err).To(Say("verify-warning"))
+										Expect(testUI.Err).To(Say("set-health-check-warning"))
+
+										Expect(fakeHealthCheckActor.VerifyHealthCheckEndpointCallCount()).To(Equal(1))
+										app, endpoint, prober, timeout := fakeHealthCheckActor.VerifyHealthCheckEndpointArgsForCall(0)
+										Expect(app).To(Equal(updatedConfig.CurrentApplication.Application))
+										Expect(endpoint).To(Equal("/actuator/health"))
+										Expect(prober).To(Equal(fakeHealthCheckProber))
+										Expect(timeout).To(Equal(time.Minute))
+
+										Expect(fakeHealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(1))
+										name, spaceGUID, healthCheckType, httpEndpoint := fakeHealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpaceArgsForCall(0)
+										Expect(name).To(Equal(appName))
+										Expect(spaceGUID).To(Equal("some-space-guid"))
+										Expect(healthCheckType).To(Equal(constant.ApplicationHealthCheckHTTP))
+										Expect(httpEndpoint).To(Equal("/actuator/health"))
+									})
+								})
+
+								When("the endpoint returns a 404", func() {
+									var expectedErr error
+
+									BeforeEach(func() {
+										expectedErr = actionerror.HealthCheckEndpointFailedError{AppName: appName, URL: "https://some-route/actuator/health", StatusCode: 404}
+										fakeHealthCheckActor.VerifyHealthCheckEndpointReturns(nil, expectedErr)
+									})
+
+									It("fails the push without changing the health check", func() {
+										Expect(executeErr).To(MatchError(expectedErr))
+										Expect(fakeHealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+									})
+								})
+
+								When("the app has no HTTP route", func() {
+									BeforeEach(func() {
+										fakeHealthCheckActor.VerifyHealthCheckEndpointReturns(nil, actionerror.ApplicationWithoutHTTPRouteError{AppName: appName})
+									})
+
+									It("warns and keeps the current health check", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Err).To(Say(`App some-app has no HTTP route to verify /actuator/health through; keeping its current health check\.`))
+										Expect(fakeHealthCheckActor.SetApplicationHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+									})
+								})
+							})
+						})
+
+						When("--attest is provided", func() {
+							BeforeEach(func() {
+								cmd.Attest = true
+								fakeProvenanceActor.GetApplicationArtifactProvenanceReturns(v3action.Provenance{
+									AppGUID:         "some-app-guid",
+									DropletGUID:     "some-droplet-guid",
+									DropletChecksum: v3action.Checksum{Type: "sha256", Value: "some-droplet-sha"},
+									PackageGUID:     "some-package-guid",
+									PackageChecksum: v3action.Checksum{Type: "sha256", Value: "some-package-sha"},
+								}, v3action.Warnings{"get-provenance-warning"}, nil)
+								fakeProvenanceActor.SetApplicationProvenanceReturns(v3action.Warnings{"set-provenance-warning"}, nil)
+								fakeGitSourceActor.GetGitSourceReturns(sharedaction.GitSource{
+									Commit:    "some-commit",
+									RemoteURL: "https://example.com/org/repo.git",
+								}, true)
+							})
+
+							It("records the provenance of the started app", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say(`Recording provenance of app some-app as some-user\.\.\.`))
+								Expect(testUI.Out).To(Say(`droplet checksum:\s+sha256:some-droplet-sha`))
+								Expect(testUI.Out).To(Say(`package checksum:\s+sha256:some-package-sha`))
+								Expect(testUI.Out).To(Say(`git commit:\s+some-commit`))
+								Expect(testUI.Err).To(Say("get-provenance-warning"))
+								Expect(testUI.Err).To(Say("set-provenance-warning"))
+
+								Expect(fakeProvenanceActor.GetApplicationArtifactProvenanceCallCount()).To(Equal(1))
+								Expect(fakeProvenanceActor.GetApplicationArtifactProvenanceArgsForCall(0)).To(Equal("some-app-guid"))
+								Expect(fakeGitSourceActor.GetGitSourceArgsForCall(0)).To(Equal(pwd))
+
+								Expect(fakeProvenanceActor.SetApplicationProvenanceCallCount()).To(Equal(1))
+								provenance := fakeProvenanceActor.SetApplicationProvenanceArgsForCall(0)
+								Expect(provenance.AppName).To(Equal(appName))
+								Expect(provenance.DropletChecksum.Value).To(Equal("some-droplet-sha"))
+								Expect(provenance.GitCommit).To(Equal("some-commit"))
+								Expect(provenance.GitRemoteURL).To(Equal("https://example.com/org/repo.git"))
+								Expect(provenance.PushedBy).To(Equal("some-user"))
+								Expect(provenance.PushedAt).To(BeTemporally("~", time.Now(), time.Minute))
+							})
+
+							When("recording the provenance fails", func() {
+								BeforeEach(func() {
+									fakeProvenanceActor.SetApplicationProvenanceReturns(nil, errors.New("set-provenance-error"))
+								})
+
+								It("returns the error", func() {
+									Expect(executeErr).To(MatchError("set-provenance-error"))
+								})
+							})
+
+							When("--attest-file is provided", func() {
+								var statementPath string
+
+								BeforeEach(func() {
+									cmd.Attest = false
+									dir, err := ioutil.TempDir("", "push-attest")
+									Expect(err).ToNot(HaveOccurred())
+									statementPath = filepath.Join(dir, "provenance.json")
+									cmd.AttestFile = flag.Path(statementPath)
+									fakeConfig.TargetReturns("https://api.example.com")
+								})
+
+								AfterEach(func() {
+									Expect(os.RemoveAll(filepath.Dir(statementPath))).To(Succeed())
+								})
+
+								It("records the provenance and writes the provenance statement", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(fakeProvenanceActor.SetApplicationProvenanceCallCount()).To(Equal(1))
+									Expect(testUI.Out).To(Say(`Provenance statement written to %s`, regexp.QuoteMeta(statementPath)))
+
+									contents, err := ioutil.ReadFile(statementPath)
+									Expect(err).ToNot(HaveOccurred())
+									var statement v3action.ProvenanceStatement
+									Expect(json.Unmarshal(contents, &statement)).To(Succeed())
+									Expect(statement.Predicate.Builder.ID).To(Equal("https://api.example.com"))
+									Expect(statement.Subject).To(ConsistOf(v3action.ProvenanceSubject{
+										Name:   "droplet/some-app",
+										Digest: map[string]string{"sha256": "some-droplet-sha"},
+									}))
+								})
+							})
+						})
+
 						When("no-start is set", func() {
 							BeforeEach(func() {
 								cmd.NoStart = true
@@ -874,6 +1291,21 @@ var _ = Describe("push Command", func() {
 							Expect(testUI.Err).To(Say("apply-1"))
 							Expect(testUI.Err).To(Say("apply-2"))
 						})
+
+						When("the error is a timeout after the --timeout deadline", func() {
+							BeforeEach(func() {
+								expectedErr = ccerror.JobTimeoutError{JobGUID: "some-job-guid"}
+								cmd.Timeout.Value = time.Minute
+								fakeConfig.CommandDeadlineReturns(time.Now())
+							})
+
+							It("returns a CommandTimeoutError describing the progress made", func() {
+								Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+									Timeout:    time.Minute,
+									InProgress: "creating app some-app",
+								}))
+							})
+						})
 					})
 				})
 
@@ -1186,6 +1618,27 @@ var _ = Describe("push Command", func() {
 					cmd.NoRoute = true
 				},
 				translatableerror.ArgumentCombinationError{Args: []string{"--route-path", "--no-route"}}),
+
+			Entry("--detect-health-check and --no-start",
+				func() {
+					cmd.DetectHealthCheck = true
+					cmd.NoStart = true
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--detect-health-check", "--no-start"}}),
+
+			Entry("--detect-health-check and --health-check-type",
+				func() {
+					cmd.DetectHealthCheck = true
+					cmd.HealthCheckType = flag.HealthCheckTypeWithDeprecatedValue{Type: "port"}
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--detect-health-check", "--health-check-type, -u"}}),
+
+			Entry("--detect-health-check and --docker-image",
+				func() {
+					cmd.DetectHealthCheck = true
+					cmd.DockerImage = flag.DockerImage{Path: "some-image"}
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--detect-health-check", "--docker-image, -o"}}),
 		)
 	})
 })
@@ -1,18 +1,90 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . QuotasActor
+
+type QuotasActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganizationQuotas() ([]v3action.OrganizationQuota, v3action.Warnings, error)
+}
+
 type QuotasCommand struct {
-	usage interface{} `usage:"CF_NAME quotas"`
+	Output          flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Template        string            `long:"template" description:"Format the output with a Go template applied to the JSON output"`
+	Query           string            `long:"query" description:"Narrow down the JSON output with a JMESPath expression"`
+	usage           interface{}       `usage:"CF_NAME quotas [--output json | --template TEMPLATE] [--query EXPRESSION]"`
+	relatedCommands interface{}       `related_commands:"apply-quotas, quota, set-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       QuotasActor
 }
 
-func (QuotasCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *QuotasCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
 	return nil
 }
 
-func (QuotasCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd QuotasCommand) Execute(args []string) error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output != "json" && cmd.Template == "" && cmd.Query == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting quotas as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+	}
+
+	quotas, warnings, err := cmd.Actor.GetOrganizationQuotas()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	displayQuotas := []shared.Quota{}
+	for _, quota := range quotas {
+		displayQuotas = append(displayQuotas, shared.NewQuota(quota.Name, quota.GUID, v3action.QuotaLimits(quota.QuotaLimits)))
+	}
+
+	displayer := shared.NewQuotaDisplayer(cmd.UI, gate.Supports(ccversion.MinVersionLogRateLimitV3))
+	if cmd.Output == "json" || cmd.Template != "" || cmd.Query != "" {
+		return command.DisplayDocument(cmd.UI, displayQuotas, cmd.Query, cmd.Template)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	displayer.DisplayQuotasTable(displayQuotas)
+	return nil
 }
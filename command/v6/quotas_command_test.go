@@ -0,0 +1,194 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("quotas Command", func() {
+	var (
+		cmd             QuotasCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeQuotasActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeQuotasActor)
+
+		cmd = QuotasCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLogRateLimitV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinSupportedV3ClientVersion)
+		})
+
+		It("returns a MinimumCFAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinSupportedV3ClientVersion,
+				MinimumVersion: ccversion.MinVersionQuotasV3,
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the quotas fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationQuotasReturns(nil, v3action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("getting the quotas succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationQuotasReturns([]v3action.OrganizationQuota{
+				{
+					GUID: "small-guid",
+					Name: "small",
+					QuotaLimits: ccv3.QuotaLimits{
+						Apps: ccv3.AppLimits{
+							TotalMemory:  &types.NullInt{IsSet: true, Value: 2048},
+							PerAppTasks:  &types.NullInt{IsSet: true, Value: 5},
+							LogRateLimit: &types.NullInt{IsSet: true, Value: 1024},
+						},
+						Routes: ccv3.RouteLimits{
+							TotalRoutes: &types.NullInt{IsSet: true, Value: 10},
+						},
+					},
+				},
+			}, v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the quotas in a table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting quotas as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`name\s+total memory\s+instance memory\s+routes\s+service instances\s+paid plans\s+app instances\s+route ports\s+app tasks\s+log rate limit`))
+			Expect(testUI.Out).To(Say(`small\s+2G\s+unlimited\s+10\s+unlimited\s+disallowed\s+unlimited\s+unlimited\s+5\s+1K/s`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("the target does not support log rate limits", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionQuotasV3)
+			})
+
+			It("leaves the log rate limit column out", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`name\s+total memory\s+instance memory\s+routes\s+service instances\s+paid plans\s+app instances\s+route ports\s+app tasks\n`))
+				Expect(testUI.Out).To(Say(`small\s+2G\s+unlimited\s+10\s+unlimited\s+disallowed\s+unlimited\s+unlimited\s+5\n`))
+				Expect(fakeActor.CloudControllerAPIVersionCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the output is json", func() {
+			BeforeEach(func() {
+				cmd.Output = "json"
+			})
+
+			It("displays only the quotas as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting quotas"))
+				Expect(testUI.Out).To(Say(`"name": "small"`))
+				Expect(testUI.Out).To(Say(`"guid": "small-guid"`))
+				Expect(testUI.Out).To(Say(`"total_memory_in_mb": 2048`))
+				Expect(testUI.Out).To(Say(`"instance_memory_in_mb": null`))
+				Expect(testUI.Out).To(Say(`"per_app_tasks": 5`))
+				Expect(testUI.Out).To(Say(`"log_rate_limit_in_bytes_per_second": 1024`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("a template is provided", func() {
+			BeforeEach(func() {
+				cmd.Template = `{{range .}}{{.name}}={{.total_memory_in_mb}}{{"\n"}}{{end}}`
+			})
+
+			It("formats the JSON document with the template", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting quotas"))
+				Expect(testUI.Out).To(Say(`small=2048\n`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("a query is provided", func() {
+			BeforeEach(func() {
+				cmd.Query = "[].name"
+			})
+
+			It("displays the result of the query as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting quotas"))
+				Expect(testUI.Out).To(Say(`\[\n  "small"\n\]`))
+			})
+		})
+
+		When("the template is invalid", func() {
+			BeforeEach(func() {
+				cmd.Template = "{{.name"
+			})
+
+			It("returns an InvalidTemplateError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidTemplateError{}))
+			})
+		})
+	})
+})
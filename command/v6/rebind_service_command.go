@@ -0,0 +1,194 @@
+package v6
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . RebindServiceActor
+
+type RebindServiceActor interface {
+	RebindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, bindingName string, parameters map[string]interface{}) (v2action.ServiceBinding, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . RebindServiceRestartActor
+
+type RebindServiceRestartActor interface {
+	CloudControllerAPIVersion() string
+	CreateDeployment(appGUID, dropletGUID string) (string, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	PollStart(appGUID string, warningsChannel chan<- v3action.Warnings) error
+	RestartApplication(appGUID string) (v3action.Warnings, error)
+	ZeroDowntimePollStart(appGUID string, warningsChannel chan<- v3action.Warnings) error
+}
+
+type RebindServiceCommand struct {
+	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
+	BindingName      flag.BindingName              `long:"binding-name" description:"Name to expose service instance to app process with (Default: name of the existing binding)"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	RestartStrategy  string                        `long:"restart-strategy" choice:"restart" choice:"rolling" description:"Restart the app once it is rebound so it picks up the new credentials; 'rolling' replaces instances one at a time without downtime"`
+	usage            interface{}                   `usage:"CF_NAME rebind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--restart-strategy (restart | rolling)]\n\n   Unbind and bind the service instance in one step so the app receives newly issued credentials."`
+	examples         interface{}                   `examples:"CF_NAME rebind-service myapp mydb\nCF_NAME rebind-service myapp mydb --restart-strategy rolling"`
+	relatedCommands  interface{}                   `related_commands:"bind-service, unbind-service, restart"`
+
+	UI           command.UI
+	Config       command.Config
+	SharedActor  command.SharedActor
+	Actor        RebindServiceActor
+	RestartActor RebindServiceRestartActor
+}
+
+func (cmd *RebindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.RestartActor = v3action.NewActor(ccClientV3, config, sharedActor, nil)
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) Execute(args []string) error {
+	if cmd.RestartStrategy == "rolling" {
+		gate := command.NewAPIVersionGate(cmd.RestartActor.CloudControllerAPIVersion)
+		err := gate.Require("Option '--restart-strategy rolling'", ccversion.MinVersionZeroDowntimePushV3)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rebinding service {{.ServiceName}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	serviceBinding, warnings, err := cmd.Actor.RebindServiceBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, cmd.Config.TargetedSpace().GUID, cmd.BindingName.Value, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if serviceBinding.IsInProgress() {
+		cmd.UI.DisplayText("Binding in progress. Use '{{.CFCommand}} {{.ServiceName}}' to check operation status.", map[string]interface{}{
+			"CFCommand":   fmt.Sprintf("%s service", cmd.Config.BinaryName()),
+			"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		})
+		cmd.UI.DisplayText("TIP: Once this operation succeeds, use '{{.CFCommand}} {{.AppName}}' to ensure your env variable changes take effect.", map[string]interface{}{
+			"CFCommand": fmt.Sprintf("%s restart", cmd.Config.BinaryName()),
+			"AppName":   cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	if cmd.RestartStrategy == "" {
+		cmd.UI.DisplayText("TIP: Use '{{.CFCommand}} {{.AppName}}' to ensure your env variable changes take effect", map[string]interface{}{
+			"CFCommand": fmt.Sprintf("%s restart", cmd.Config.BinaryName()),
+			"AppName":   cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	return cmd.restartApp(user.Name)
+}
+
+func (cmd RebindServiceCommand) restartApp(username string) error {
+	app, warnings, err := cmd.RestartActor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.Stopped() {
+		cmd.UI.DisplayText("App {{.AppName}} is stopped; it will use the new credentials once started.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+
+	var pollStart func(string, chan<- v3action.Warnings) error
+	if cmd.RestartStrategy == "rolling" {
+		cmd.UI.DisplayTextWithFlavor("Starting deployment for app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":      cmd.RequiredArgs.AppName,
+			"CurrentSpace": cmd.Config.TargetedSpace().Name,
+			"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+			"CurrentUser":  username,
+		})
+
+		_, warnings, err = cmd.RestartActor.CreateDeployment(app.GUID, "")
+		pollStart = cmd.RestartActor.ZeroDowntimePollStart
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":      cmd.RequiredArgs.AppName,
+			"CurrentSpace": cmd.Config.TargetedSpace().Name,
+			"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+			"CurrentUser":  username,
+		})
+
+		warnings, err = cmd.RestartActor.RestartApplication(app.GUID)
+		pollStart = cmd.RestartActor.PollStart
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	warningsChannel := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-warningsChannel:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = pollStart(app.GUID, warningsChannel)
+	done <- true
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
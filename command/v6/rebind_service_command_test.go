@@ -0,0 +1,236 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	ccv2constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rebind-service Command", func() {
+	var (
+		cmd              RebindServiceCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v6fakes.FakeRebindServiceActor
+		fakeRestartActor *v6fakes.FakeRebindServiceRestartActor
+		binaryName       string
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRebindServiceActor)
+		fakeRestartActor = new(v6fakes.FakeRebindServiceRestartActor)
+
+		cmd = RebindServiceCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			RestartActor: fakeRestartActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ServiceInstanceName = "some-service"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeRestartActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("rebinding the service fails", func() {
+		BeforeEach(func() {
+			fakeActor.RebindServiceBySpaceReturns(v2action.ServiceBinding{}, v2action.Warnings{"rebind-warning"}, errors.New("rebind error"))
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("rebind error"))
+			Expect(testUI.Err).To(Say("rebind-warning"))
+			Expect(fakeRestartActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no restart strategy is provided", func() {
+		BeforeEach(func() {
+			cmd.BindingName.Value = "some-binding-name"
+			cmd.ParametersAsJSON = map[string]interface{}{"some-parameter": "some-value"}
+			fakeActor.RebindServiceBySpaceReturns(v2action.ServiceBinding{}, v2action.Warnings{"rebind-warning"}, nil)
+		})
+
+		It("rebinds the service and displays a restart tip", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Rebinding service some-service to app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'faceman restart some-app' to ensure your env variable changes take effect`))
+			Expect(testUI.Err).To(Say("rebind-warning"))
+
+			Expect(fakeActor.RebindServiceBySpaceCallCount()).To(Equal(1))
+			appName, serviceInstanceName, spaceGUID, bindingName, parameters := fakeActor.RebindServiceBySpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(serviceInstanceName).To(Equal("some-service"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(bindingName).To(Equal("some-binding-name"))
+			Expect(parameters).To(Equal(map[string]interface{}{"some-parameter": "some-value"}))
+
+			Expect(fakeRestartActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the new binding is still in progress", func() {
+		BeforeEach(func() {
+			cmd.RestartStrategy = "rolling"
+			fakeActor.RebindServiceBySpaceReturns(
+				v2action.ServiceBinding{LastOperation: ccv2.LastOperation{State: ccv2constant.LastOperationInProgress}},
+				nil,
+				nil,
+			)
+		})
+
+		It("does not restart the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Binding in progress\. Use 'faceman service some-service' to check operation status\.`))
+			Expect(fakeRestartActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the restart strategy is 'rolling'", func() {
+		BeforeEach(func() {
+			cmd.RestartStrategy = "rolling"
+			fakeActor.RebindServiceBySpaceReturns(v2action.ServiceBinding{}, nil, nil)
+			fakeRestartActor.GetApplicationByNameAndSpaceReturns(
+				v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted},
+				v3action.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeRestartActor.CreateDeploymentReturns("some-deployment-guid", v3action.Warnings{"deployment-warning"}, nil)
+		})
+
+		It("creates a deployment and waits for it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Starting deployment for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`Waiting for app to start\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("deployment-warning"))
+
+			Expect(fakeRestartActor.CreateDeploymentCallCount()).To(Equal(1))
+			appGUID, dropletGUID := fakeRestartActor.CreateDeploymentArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(BeEmpty())
+
+			Expect(fakeRestartActor.ZeroDowntimePollStartCallCount()).To(Equal(1))
+			Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+		})
+
+		When("the API version is below the minimum", func() {
+			BeforeEach(func() {
+				fakeRestartActor.CloudControllerAPIVersionReturns(ccversion.MinSupportedV3ClientVersion)
+			})
+
+			It("returns a MinimumCFAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+					Command:        "Option '--restart-strategy rolling'",
+					CurrentVersion: ccversion.MinSupportedV3ClientVersion,
+					MinimumVersion: ccversion.MinVersionZeroDowntimePushV3,
+				}))
+				Expect(fakeActor.RebindServiceBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app is stopped", func() {
+			BeforeEach(func() {
+				fakeRestartActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStopped},
+					nil,
+					nil,
+				)
+			})
+
+			It("does not start the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`App some-app is stopped; it will use the new credentials once started\.`))
+				Expect(fakeRestartActor.CreateDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		When("polling the deployment fails", func() {
+			BeforeEach(func() {
+				fakeRestartActor.ZeroDowntimePollStartReturns(actionerror.StartupTimeoutError{})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+			})
+		})
+	})
+
+	When("the restart strategy is 'restart'", func() {
+		BeforeEach(func() {
+			cmd.RestartStrategy = "restart"
+			fakeActor.RebindServiceBySpaceReturns(v2action.ServiceBinding{}, nil, nil)
+			fakeRestartActor.GetApplicationByNameAndSpaceReturns(
+				v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted},
+				nil,
+				nil,
+			)
+			fakeRestartActor.RestartApplicationReturns(v3action.Warnings{"restart-warning"}, nil)
+		})
+
+		It("restarts the app and waits for it to start", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Restarting app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`Waiting for app to start\.\.\.`))
+			Expect(testUI.Err).To(Say("restart-warning"))
+
+			Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(1))
+			Expect(fakeRestartActor.RestartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeRestartActor.PollStartCallCount()).To(Equal(1))
+			Expect(fakeRestartActor.CreateDeploymentCallCount()).To(Equal(0))
+			Expect(fakeRestartActor.CloudControllerAPIVersionCallCount()).To(Equal(0))
+		})
+	})
+})
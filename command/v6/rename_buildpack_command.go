@@ -53,7 +53,8 @@ func (cmd RenameBuildpackCommand) Execute(args []string) error {
 	}
 
 	if cmd.stackSpecified() {
-		err = command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionBuildpackStackAssociationV2, "Option '-s'")
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		err = gate.Require("Option '-s'", ccversion.MinVersionBuildpackStackAssociationV2)
 		if err != nil {
 			return err
 		}
@@ -1,21 +1,156 @@
 package v6
 
 import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+// DefaultRouteOverlap is how long rename --migrate-route keeps the old routes
+// mapped alongside the new ones when --route-overlap is not provided.
+const DefaultRouteOverlap = 5 * time.Minute
+
+//go:generate counterfeiter . RenameActor
+
+type RenameActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	CreateRenamedApplicationRoutes(appGUID string, oldName string, newName string) (v2action.Routes, v2action.Routes, v2action.Warnings, error)
+	RemoveApplicationRoutes(routes v2action.Routes, appGUID string) (v2action.Warnings, error)
+}
+
 type RenameCommand struct {
 	RequiredArgs    flag.AppRenameArgs `positional-args:"yes"`
-	usage           interface{}        `usage:"CF_NAME rename APP_NAME NEW_APP_NAME"`
-	relatedCommands interface{}        `related_commands:"apps, delete"`
+	MigrateRoute    bool               `long:"migrate-route" description:"Map a route with the new app name as hostname next to each route with the old app name as hostname, and remove the old routes after the overlap window"`
+	RouteOverlap    flag.Timeout       `long:"route-overlap" description:"How long to keep the old routes mapped next to the new ones (e.g. 90s, 10m); numbers without a unit are minutes. Defaults to 5m. Requires --migrate-route"`
+	usage           interface{}        `usage:"CF_NAME rename APP_NAME NEW_APP_NAME [--migrate-route [--route-overlap DURATION]]"`
+	examples        interface{}        `examples:"CF_NAME rename my-app my-new-app --migrate-route # Serve my-new-app.example.com alongside my-app.example.com for 5 minutes, then remove my-app.example.com"`
+	relatedCommands interface{}        `related_commands:"apps, delete, map-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RenameActor
 }
 
-func (RenameCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RenameCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.MigrateRoute {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RenameCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd RenameCommand) Execute(args []string) error {
+	if !cmd.MigrateRoute {
+		if cmd.RouteOverlap.Value != 0 {
+			return translatableerror.RequiredFlagsError{
+				Arg1: "--route-overlap",
+				Arg2: "--migrate-route",
+			}
+		}
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	oldName, newName := cmd.RequiredArgs.OldAppName, cmd.RequiredArgs.NewAppName
+	cmd.UI.DisplayTextWithFlavor("Renaming app {{.AppName}} to {{.NewName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     oldName,
+			"NewName":     newName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(oldName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err = cmd.Actor.UpdateApplication(v2action.Application{
+		GUID: app.GUID,
+		Name: newName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Mapping routes with hostname {{.NewName}} to app {{.NewName}}...",
+		map[string]interface{}{
+			"NewName": newName,
+		})
+	oldRoutes, newRoutes, warnings, err := cmd.Actor.CreateRenamedApplicationRoutes(app.GUID, oldName, newName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	if len(oldRoutes) == 0 {
+		cmd.UI.DisplayText("App {{.NewName}} has no routes with hostname {{.OldName}}.",
+			map[string]interface{}{
+				"NewName": newName,
+				"OldName": oldName,
+			})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+	cmd.UI.DisplayText("Mapped {{.Routes}}",
+		map[string]interface{}{
+			"Routes": newRoutes.Summary(),
+		})
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	overlap := cmd.RouteOverlap.Value
+	if overlap == 0 {
+		overlap = DefaultRouteOverlap
+	}
+	cmd.UI.DisplayText("Keeping {{.Routes}} mapped for {{.Overlap}} so that clients can move to the new routes...",
+		map[string]interface{}{
+			"Routes":  oldRoutes.Summary(),
+			"Overlap": overlap,
+		})
+	time.Sleep(overlap)
+
+	cmd.UI.DisplayText("Removing {{.Routes}} from app {{.NewName}}...",
+		map[string]interface{}{
+			"Routes":  oldRoutes.Summary(),
+			"NewName": newName,
+		})
+	warnings, err = cmd.Actor.RemoveApplicationRoutes(oldRoutes, app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
 }
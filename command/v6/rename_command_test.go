@@ -0,0 +1,175 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename Command", func() {
+	var (
+		cmd             RenameCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRenameActor
+		oldRoutes       v2action.Routes
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRenameActor)
+
+		cmd = RenameCommand{
+			RequiredArgs: flag.AppRenameArgs{OldAppName: "old-app", NewAppName: "new-app"},
+			MigrateRoute: true,
+			RouteOverlap: flag.Timeout{Value: time.Millisecond},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "old-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-app-warning"}, nil)
+
+		oldRoutes = v2action.Routes{{GUID: "old-route-guid", Host: "old-app", Domain: v2action.Domain{Name: "example.com"}}}
+		fakeActor.CreateRenamedApplicationRoutesReturns(
+			oldRoutes,
+			v2action.Routes{{GUID: "new-route-guid", Host: "new-app", Domain: v2action.Domain{Name: "example.com"}}},
+			v2action.Warnings{"create-routes-warning"},
+			nil,
+		)
+		fakeActor.RemoveApplicationRoutesReturns(v2action.Warnings{"remove-routes-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--migrate-route is not provided", func() {
+		BeforeEach(func() {
+			cmd.MigrateRoute = false
+			cmd.RouteOverlap = flag.Timeout{}
+		})
+
+		It("hands off to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+
+		When("--route-overlap is provided", func() {
+			BeforeEach(func() {
+				cmd.RouteOverlap = flag.Timeout{Value: time.Minute}
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+					Arg1: "--route-overlap",
+					Arg2: "--migrate-route",
+				}))
+			})
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	It("renames the app, maps the new routes and removes the old ones after the overlap", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Renaming app old-app to new-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`Mapping routes with hostname new-app to app new-app\.\.\.`))
+		Expect(testUI.Out).To(Say(`Mapped new-app\.example\.com`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`Keeping old-app\.example\.com mapped for 1ms so that clients can move to the new routes\.\.\.`))
+		Expect(testUI.Out).To(Say(`Removing old-app\.example\.com from app new-app\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("update-app-warning"))
+		Expect(testUI.Err).To(Say("create-routes-warning"))
+		Expect(testUI.Err).To(Say("remove-routes-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("old-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{GUID: "some-app-guid", Name: "new-app"}))
+
+		appGUID, oldName, newName := fakeActor.CreateRenamedApplicationRoutesArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(oldName).To(Equal("old-app"))
+		Expect(newName).To(Equal("new-app"))
+
+		routes, appGUID := fakeActor.RemoveApplicationRoutesArgsForCall(0)
+		Expect(routes).To(Equal(oldRoutes))
+		Expect(appGUID).To(Equal("some-app-guid"))
+	})
+
+	When("the app has no routes with the old name", func() {
+		BeforeEach(func() {
+			fakeActor.CreateRenamedApplicationRoutesReturns(nil, nil, nil, nil)
+		})
+
+		It("does not remove any routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App new-app has no routes with hostname old-app."))
+			Expect(fakeActor.RemoveApplicationRoutesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("renaming the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationReturns(v2action.Application{}, nil, errors.New("some-error"))
+		})
+
+		It("returns the error without touching routes", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(fakeActor.CreateRenamedApplicationRoutesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("mapping the new routes fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateRenamedApplicationRoutesReturns(nil, nil, nil, actionerror.RouteInDifferentSpaceError{Route: "new-app.example.com"})
+		})
+
+		It("returns the error and keeps the old routes", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "new-app.example.com"}))
+			Expect(fakeActor.RemoveApplicationRoutesCallCount()).To(Equal(0))
+		})
+	})
+})
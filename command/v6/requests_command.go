@@ -0,0 +1,166 @@
+package v6
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	// recentRequests is the number of requests listed before the summary.
+	recentRequests = 20
+	// topRequestPaths is the number of paths listed in the summary.
+	topRequestPaths = 5
+)
+
+//go:generate counterfeiter . RequestsActor
+
+type RequestsActor interface {
+	GetRequestLogsForApplicationByNameAndSpace(appName string, spaceGUID string, since time.Time, client v2action.LogCacheClient) ([]v2action.RequestLog, v2action.Warnings, error)
+}
+
+type RequestsCommand struct {
+	RequiredArgs    flag.AppName    `positional-args:"yes"`
+	Since           flag.Duration   `long:"since" default:"10m" description:"Only show requests received within this duration, such as 90s or 1h"`
+	Status          flag.HTTPStatus `long:"status" description:"Only show requests with this status code, such as 404, or status class, such as 5xx"`
+	usage           interface{}     `usage:"CF_NAME requests APP_NAME [--since DURATION] [--status STATUS]\n\n   Lists the most recent requests routed to the app and summarizes their response times and most requested paths."`
+	examples        interface{}     `examples:"CF_NAME requests my-app\nCF_NAME requests my-app --since 1h --status 5xx"`
+	relatedCommands interface{}     `related_commands:"app, logs, routes"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          RequestsActor
+	LogCacheClient v2action.LogCacheClient
+}
+
+func (cmd *RequestsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.LogCacheClient = shared.NewLogCacheClient(config, uaaClient)
+
+	return nil
+}
+
+func (cmd RequestsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting requests for app {{.AppName}} from the last {{.Since}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"Since":     cmd.Since.Value,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	requestLogs, warnings, err := cmd.Actor.GetRequestLogsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		time.Now().Add(-cmd.Since.Value),
+		cmd.LogCacheClient,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var matching []v2action.RequestLog
+	for _, requestLog := range requestLogs {
+		if cmd.Status.Matches(requestLog.StatusCode) {
+			matching = append(matching, requestLog)
+		}
+	}
+
+	if len(matching) == 0 {
+		cmd.UI.DisplayText("No requests found.")
+		return nil
+	}
+
+	cmd.displayRecentRequests(matching)
+	cmd.UI.DisplayNewline()
+	cmd.displaySummary(v2action.SummarizeRequestLogs(matching, topRequestPaths))
+
+	return nil
+}
+
+func (cmd RequestsCommand) displayRecentRequests(requestLogs []v2action.RequestLog) {
+	if len(requestLogs) > recentRequests {
+		requestLogs = requestLogs[len(requestLogs)-recentRequests:]
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("method"),
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("response time"),
+		},
+	}
+	for _, requestLog := range requestLogs {
+		table = append(table, []string{
+			requestLog.Timestamp.Local().Format(time.RFC3339),
+			requestLog.InstanceIndex,
+			requestLog.Method,
+			requestLog.Path,
+			strconv.Itoa(requestLog.StatusCode),
+			formatResponseTime(requestLog.ResponseTime),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RequestsCommand) displaySummary(summary v2action.RequestLogSummary) {
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("requests:"), strconv.Itoa(summary.Count)},
+		{cmd.UI.TranslateText("p50 response time:"), formatResponseTime(summary.P50)},
+		{cmd.UI.TranslateText("p90 response time:"), formatResponseTime(summary.P90)},
+		{cmd.UI.TranslateText("p99 response time:"), formatResponseTime(summary.P99)},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Top paths:")
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("requests"),
+			cmd.UI.TranslateText("p90 response time"),
+		},
+	}
+	for _, path := range summary.TopPaths {
+		table = append(table, []string{
+			path.Path,
+			strconv.Itoa(path.Count),
+			formatResponseTime(path.P90),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func formatResponseTime(responseTime time.Duration) string {
+	return responseTime.Round(time.Millisecond).String()
+}
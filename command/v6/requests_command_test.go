@@ -0,0 +1,143 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("requests Command", func() {
+	var (
+		cmd             RequestsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRequestsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRequestsActor)
+
+		cmd = RequestsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Since:        flag.Duration{Value: 10 * time.Minute},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has requests", func() {
+		var timestamp time.Time
+
+		BeforeEach(func() {
+			timestamp = time.Unix(1500000000, 0)
+			fakeActor.GetRequestLogsForApplicationByNameAndSpaceReturns([]v2action.RequestLog{
+				{Timestamp: timestamp, InstanceIndex: "0", Method: "GET", Path: "/", StatusCode: 200, ResponseTime: 5 * time.Millisecond},
+				{Timestamp: timestamp, InstanceIndex: "1", Method: "POST", Path: "/orders", StatusCode: 503, ResponseTime: 1250 * time.Millisecond},
+				{Timestamp: timestamp, InstanceIndex: "0", Method: "POST", Path: "/orders", StatusCode: 502, ResponseTime: 30 * time.Millisecond},
+			}, v2action.Warnings{"some-warning"}, nil)
+		})
+
+		It("displays the requests and their summary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting requests for app some-app from the last 10m0s in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+instance\s+method\s+path\s+status\s+response time`))
+			Expect(testUI.Out).To(Say(`%s\s+0\s+GET\s+/\s+200\s+5ms`, timestamp.Local().Format(time.RFC3339)))
+			Expect(testUI.Out).To(Say(`1\s+POST\s+/orders\s+503\s+1.25s`))
+			Expect(testUI.Out).To(Say(`0\s+POST\s+/orders\s+502\s+30ms`))
+			Expect(testUI.Out).To(Say(`requests:\s+3`))
+			Expect(testUI.Out).To(Say(`p50 response time:\s+30ms`))
+			Expect(testUI.Out).To(Say(`p90 response time:\s+1.25s`))
+			Expect(testUI.Out).To(Say(`p99 response time:\s+1.25s`))
+			Expect(testUI.Out).To(Say(`Top paths:`))
+			Expect(testUI.Out).To(Say(`path\s+requests\s+p90 response time`))
+			Expect(testUI.Out).To(Say(`/orders\s+2\s+1.25s`))
+			Expect(testUI.Out).To(Say(`/\s+1\s+5ms`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.GetRequestLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, since, _ := fakeActor.GetRequestLogsForApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(since).To(BeTemporally("~", time.Now().Add(-10*time.Minute), time.Minute))
+		})
+
+		When("filtering by status", func() {
+			BeforeEach(func() {
+				cmd.Status = flag.HTTPStatus{Class: 5}
+			})
+
+			It("only displays the matching requests", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say(`GET`))
+				Expect(testUI.Out).To(Say(`requests:\s+2`))
+			})
+		})
+
+		When("no requests match the status", func() {
+			BeforeEach(func() {
+				cmd.Status = flag.HTTPStatus{Code: 404}
+			})
+
+			It("displays that there are no requests", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No requests found."))
+				Expect(testUI.Out).ToNot(Say("requests:"))
+			})
+		})
+	})
+
+	When("getting the requests fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRequestLogsForApplicationByNameAndSpaceReturns(nil, v2action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
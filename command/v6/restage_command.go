@@ -24,7 +24,8 @@ type RestageActor interface {
 
 type RestageCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restage APP_NAME"`
+	Timeout             flag.Timeout `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes"`
+	usage               interface{}  `usage:"CF_NAME restage APP_NAME [--timeout TIMEOUT]"`
 	relatedCommands     interface{}  `related_commands:"restart"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -40,6 +41,9 @@ type RestageCommand struct {
 func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 	cmd.SharedActor = sharedaction.NewActor(config)
 	sharedActor := sharedaction.NewActor(config)
 
@@ -90,8 +94,9 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return err
 	}
 
+	progress := shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "restaging app")
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(app, cmd.NOAAClient)
-	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	err = shared.PollStart(cmd.UI, cmd.Config, progress, messages, logErrs, appState, apiWarnings, errs)
 	if err != nil {
 		return err
 	}
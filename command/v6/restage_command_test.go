@@ -480,6 +480,20 @@ var _ = Describe("Restage Command", func() {
 					It("stops logging and returns StartupTimeoutError", func() {
 						Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: "faceman"}))
 					})
+
+					When("the --timeout deadline has passed", func() {
+						BeforeEach(func() {
+							cmd.Timeout.Value = time.Minute
+							fakeConfig.CommandDeadlineReturns(time.Now())
+						})
+
+						It("returns a CommandTimeoutError describing the progress made", func() {
+							Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+								Timeout:    time.Minute,
+								InProgress: "restaging app",
+							}))
+						})
+					})
 				})
 			})
 
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 	sharedV3 "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/routeprobe"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
@@ -23,7 +24,9 @@ type RestartActor interface {
 
 type RestartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restart APP_NAME"`
+	Graceful            bool         `long:"graceful" description:"Unmap the app's routes and wait for in-flight requests to drain before stopping it, then map them back once it is running"`
+	usage               interface{}  `usage:"CF_NAME restart APP_NAME [--graceful]"`
+	examples            interface{}  `examples:"CF_NAME restart my-app # Stop and start an app\nCF_NAME restart my-app --graceful # Drain routes before stopping the app"`
 	relatedCommands     interface{}  `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -33,6 +36,8 @@ type RestartCommand struct {
 	SharedActor             command.SharedActor
 	Actor                   RestartActor
 	ApplicationSummaryActor shared.ApplicationSummaryActor
+	RouteDrainActor         shared.RouteDrainActor
+	RouteProber             v2action.RouteProber
 	NOAAClient              *consumer.Consumer
 }
 
@@ -60,6 +65,8 @@ func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 	cmd.ApplicationSummaryActor = v2v3action.NewActor(v2Actor, v3Actor)
+	cmd.RouteDrainActor = v2Actor
+	cmd.RouteProber = routeprobe.NewProber(config.SkipSSLValidation(), config.DialTimeout())
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
 	return nil
@@ -90,11 +97,23 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return err
 	}
 
+	var drainedRoutes v2action.Routes
+	if cmd.Graceful && app.Started() {
+		drainedRoutes, err = shared.DrainApplicationRoutes(cmd.UI, cmd.RouteDrainActor, cmd.RouteProber, app)
+		if err != nil {
+			return err
+		}
+	}
+
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestartApplication(app, cmd.NOAAClient)
-	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	err = shared.PollStart(cmd.UI, cmd.Config, nil, messages, logErrs, appState, apiWarnings, errs)
+	restoreErr := shared.RestoreApplicationRoutes(cmd.UI, cmd.RouteDrainActor, app, drainedRoutes)
 	if err != nil {
 		return err
 	}
+	if restoreErr != nil {
+		return restoreErr
+	}
 
 	cmd.UI.DisplayNewline()
 	log.WithField("v3_api_version", cmd.ApplicationSummaryActor.CloudControllerV3APIVersion()).Debug("using v3 for app display")
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/actor/v2v3action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -152,6 +153,105 @@ var _ = Describe("Restart Command", func() {
 
 					Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
 				})
+
+				When("the --graceful flag is provided", func() {
+					var (
+						fakeRouteDrainActor *sharedfakes.FakeRouteDrainActor
+						fakeRouteProber     *v2actionfakes.FakeRouteProber
+						routes              v2action.Routes
+					)
+
+					BeforeEach(func() {
+						fakeRouteDrainActor = new(sharedfakes.FakeRouteDrainActor)
+						fakeRouteProber = new(v2actionfakes.FakeRouteProber)
+						cmd.RouteDrainActor = fakeRouteDrainActor
+						cmd.RouteProber = fakeRouteProber
+						cmd.Graceful = true
+
+						fakeActor.GetApplicationByNameAndSpaceReturns(
+							v2action.Application{GUID: "app-guid", Name: "some-app", State: constant.ApplicationStarted},
+							nil,
+							nil,
+						)
+
+						routes = v2action.Routes{{GUID: "route-guid"}}
+						fakeRouteDrainActor.DrainApplicationRoutesReturns(routes, v2action.Warnings{"drain-warning"}, nil)
+						fakeRouteDrainActor.MapRoutesToApplicationReturns(v2action.Warnings{"map-warning"}, nil)
+					})
+
+					It("drains the routes before restarting and maps them back afterwards", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Unmapping routes and waiting for the router to stop sending traffic to app some-app..."))
+						Expect(testUI.Out).To(Say("Stopping app..."))
+						Expect(testUI.Out).To(Say("Waiting for app to start..."))
+						Expect(testUI.Out).To(Say("Mapping routes back to app some-app..."))
+						Expect(testUI.Err).To(Say("drain-warning"))
+						Expect(testUI.Err).To(Say("map-warning"))
+
+						Expect(fakeRouteDrainActor.DrainApplicationRoutesCallCount()).To(Equal(1))
+						app, prober, _, _ := fakeRouteDrainActor.DrainApplicationRoutesArgsForCall(0)
+						Expect(app.GUID).To(Equal("app-guid"))
+						Expect(prober).To(Equal(fakeRouteProber))
+
+						Expect(fakeActor.RestartApplicationCallCount()).To(Equal(1))
+
+						Expect(fakeRouteDrainActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+						mappedRoutes, appGUID := fakeRouteDrainActor.MapRoutesToApplicationArgsForCall(0)
+						Expect(mappedRoutes).To(Equal(routes))
+						Expect(appGUID).To(Equal("app-guid"))
+					})
+
+					When("draining fails", func() {
+						BeforeEach(func() {
+							fakeRouteDrainActor.DrainApplicationRoutesReturns(routes, nil, actionerror.RouteUnregistrationTimeoutError{AppName: "some-app"})
+						})
+
+						It("maps the routes back and does not restart the app", func() {
+							Expect(executeErr).To(MatchError(actionerror.RouteUnregistrationTimeoutError{AppName: "some-app"}))
+							Expect(fakeRouteDrainActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+							Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					When("the restart fails", func() {
+						BeforeEach(func() {
+							fakeActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+								messages := make(chan *v2action.LogMessage)
+								logErrs := make(chan error)
+								appState := make(chan v2action.ApplicationStateChange)
+								warnings := make(chan string)
+								errs := make(chan error)
+
+								go func() {
+									errs <- errors.New("restart-error")
+									close(messages)
+									close(logErrs)
+									close(appState)
+									close(warnings)
+									close(errs)
+								}()
+
+								return messages, logErrs, appState, warnings, errs
+							}
+						})
+
+						It("still maps the routes back and returns the restart error", func() {
+							Expect(executeErr).To(MatchError("restart-error"))
+							Expect(fakeRouteDrainActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+						})
+					})
+
+					When("mapping the routes back fails", func() {
+						BeforeEach(func() {
+							fakeRouteDrainActor.MapRoutesToApplicationReturns(nil, errors.New("map-error"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("map-error"))
+						})
+					})
+				})
 			})
 
 			When("the app is not already started", func() {
@@ -0,0 +1,126 @@
+package v6
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . RunDueTasksActor
+
+type RunDueTasksActor interface {
+	GetTaskSchedulesByApplicationNameAndSpace(appName string, spaceGUID string) ([]v3action.TaskSchedule, v3action.Warnings, error)
+	GetTaskSchedulesBySpace(spaceGUID string) ([]v3action.TaskSchedule, v3action.Warnings, error)
+	RunTaskSchedule(schedule v3action.TaskSchedule, now time.Time) (v3action.Task, v3action.Warnings, error)
+}
+
+type RunDueTasksCommand struct {
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME run-due-tasks [APP_NAME]\n\n   With the --dry-run global option, lists the due tasks without running them.\n\nTIP:\n   Invoke this command regularly, for example from a CI job every few minutes, to run the tasks scheduled with 'CF_NAME schedule-task'. A task that became due more than once since the last invocation runs once."`
+	relatedCommands interface{}          `related_commands:"run-task, schedule-task, scheduled-tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RunDueTasksActor
+}
+
+func (cmd *RunDueTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd RunDueTasksCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running due tasks in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	var (
+		schedules []v3action.TaskSchedule
+		warnings  v3action.Warnings
+	)
+	if cmd.OptionalArgs.AppName != "" {
+		schedules, warnings, err = cmd.Actor.GetTaskSchedulesByApplicationNameAndSpace(cmd.OptionalArgs.AppName, space.GUID)
+	} else {
+		schedules, warnings, err = cmd.Actor.GetTaskSchedulesBySpace(space.GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var (
+		due    int
+		failed []string
+	)
+	for _, schedule := range schedules {
+		if !schedule.Due(now) {
+			continue
+		}
+		due++
+
+		templateValues := map[string]interface{}{
+			"ScheduleName": schedule.Name,
+			"AppName":      schedule.AppName,
+		}
+		if cmd.Config.DryRun() {
+			cmd.UI.DisplayText("Task {{.ScheduleName}} for app {{.AppName}} is due.", templateValues)
+			continue
+		}
+
+		cmd.UI.DisplayText("Running task {{.ScheduleName}} for app {{.AppName}}...", templateValues)
+		task, warnings, err := cmd.Actor.RunTaskSchedule(schedule, now)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning(err.Error())
+			failed = append(failed, fmt.Sprintf("%s/%s", schedule.AppName, schedule.Name))
+			continue
+		}
+		cmd.UI.DisplayText("Task {{.ScheduleName}} has been submitted with task id {{.SequenceID}}.", map[string]interface{}{
+			"ScheduleName": schedule.Name,
+			"SequenceID":   task.SequenceID,
+		})
+	}
+
+	if due == 0 {
+		cmd.UI.DisplayText("No tasks are due.")
+	}
+
+	if len(failed) > 0 {
+		return translatableerror.ScheduledTasksFailedError{Schedules: failed}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-due-tasks Command", func() {
+	var (
+		cmd             RunDueTasksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRunDueTasksActor
+		binaryName      string
+		executeErr      error
+
+		dueSchedule    v3action.TaskSchedule
+		notDueSchedule v3action.TaskSchedule
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRunDueTasksActor)
+
+		cmd = RunDueTasksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		dueSchedule = v3action.TaskSchedule{
+			Name:      "cleanup",
+			AppName:   "app-a",
+			Cron:      "* * * * *",
+			Command:   "rake cleanup",
+			CreatedAt: time.Now().Add(-time.Hour),
+		}
+		notDueSchedule = v3action.TaskSchedule{
+			Name:      "report",
+			AppName:   "app-b",
+			Cron:      "0 0 30 2 *",
+			Command:   "bin/report",
+			CreatedAt: time.Now().Add(-time.Hour),
+		}
+		fakeActor.GetTaskSchedulesBySpaceReturns(
+			[]v3action.TaskSchedule{dueSchedule, notDueSchedule},
+			v3action.Warnings{"get-schedules-warning"},
+			nil,
+		)
+		fakeActor.RunTaskScheduleReturns(v3action.Task{Name: "cleanup", SequenceID: 7}, v3action.Warnings{"run-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	It("runs the due tasks of every app in the space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetTaskSchedulesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(1))
+		schedule, now := fakeActor.RunTaskScheduleArgsForCall(0)
+		Expect(schedule).To(Equal(dueSchedule))
+		Expect(now).To(BeTemporally("~", time.Now(), 5*time.Second))
+
+		Expect(testUI.Out).To(Say("Running due tasks in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("Running task cleanup for app app-a..."))
+		Expect(testUI.Out).To(Say("Task cleanup has been submitted with task id 7."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("get-schedules-warning"))
+		Expect(testUI.Err).To(Say("run-warning"))
+	})
+
+	When("an app is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = "app-b"
+			fakeActor.GetTaskSchedulesByApplicationNameAndSpaceReturns([]v3action.TaskSchedule{notDueSchedule}, nil, nil)
+		})
+
+		It("only considers the schedules of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetTaskSchedulesByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("app-b"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetTaskSchedulesBySpaceCallCount()).To(Equal(0))
+
+			Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("No tasks are due."))
+		})
+	})
+
+	When("the --dry-run global option is provided", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+		})
+
+		It("lists the due tasks without running them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("Task cleanup for app app-a is due."))
+		})
+	})
+
+	When("running a task fails", func() {
+		BeforeEach(func() {
+			secondDue := dueSchedule
+			secondDue.Name = "vacuum"
+			fakeActor.GetTaskSchedulesBySpaceReturns([]v3action.TaskSchedule{dueSchedule, secondDue}, nil, nil)
+			fakeActor.RunTaskScheduleReturnsOnCall(0, v3action.Task{}, v3action.Warnings{"run-warning"}, errors.New("run-error"))
+			fakeActor.RunTaskScheduleReturnsOnCall(1, v3action.Task{Name: "vacuum", SequenceID: 8}, nil, nil)
+		})
+
+		It("runs the other due tasks and returns a ScheduledTasksFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ScheduledTasksFailedError{Schedules: []string{"app-a/cleanup"}}))
+
+			Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(2))
+			Expect(testUI.Err).To(Say("run-error"))
+			Expect(testUI.Out).To(Say("Task vacuum has been submitted with task id 8."))
+		})
+	})
+})
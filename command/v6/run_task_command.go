@@ -2,33 +2,48 @@ package v6
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+// taskOutputTimeout is how long run-task keeps reading the logs for the output
+// file after the task has finished.
+const taskOutputTimeout = 30 * time.Second
+
 //go:generate counterfeiter . RunTaskActor
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	PollTask(appGUID string, sequenceID int) (v3action.Task, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 }
 
 type RunTaskCommand struct {
-	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
-	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
-	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
+	RequiredArgs    flag.RunTaskArgs            `positional-args:"yes"`
+	Disk            flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	InputFile       flag.PathWithExistenceCheck `long:"input-file" description:"Local file to make available to the task; its path in the task container is in $TASK_INPUT_FILE"`
+	Memory          flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string                      `long:"name" description:"Name to give the task (generated if omitted)"`
+	OutputFile      flag.Path                   `long:"output-file" description:"Local path to save the output file to (default: the file name of --output-path)"`
+	OutputPath      string                      `long:"output-path" description:"File the task creates in its container; waits for the task to finish and downloads the file"`
+	usage           interface{}                 `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n   [--input-file LOCAL_PATH] [--output-path TASK_PATH [--output-file LOCAL_PATH]]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\n   Input files can be at most 64K. Output files are transferred through the task's logs, so keep them small.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"psql \\$DATABASE_URL -f \\$TASK_INPUT_FILE\" --input-file migration.sql\n   CF_NAME run-task my-app \"bin/report > /tmp/report.csv\" --output-path /tmp/report.csv"`
+	relatedCommands interface{}                 `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+	NOAAClient  v3action.NOAAClient
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -36,11 +51,12 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor(config)
 
-	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	client, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
 	if err != nil {
 		return err
 	}
 	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+	cmd.NOAAClient = shared.NewNOAAClient(client.Info.Logging(), config, uaaClient, ui)
 
 	return nil
 }
@@ -58,6 +74,11 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return err
 	}
 
+	files, err := cmd.taskFiles()
+	if err != nil {
+		return err
+	}
+
 	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -74,6 +95,12 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 	inputTask := v3action.Task{
 		Command: cmd.RequiredArgs.Command,
 	}
+	if files.InputName != "" || files.OutputPath != "" {
+		inputTask.Command, err = files.WrapCommand(cmd.RequiredArgs.Command)
+		if err != nil {
+			return err
+		}
+	}
 
 	if cmd.Name != "" {
 		inputTask.Name = cmd.Name
@@ -85,6 +112,15 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		inputTask.MemoryInMB = cmd.Memory.Value
 	}
 
+	var (
+		logStream <-chan *v3action.LogMessage
+		errStream <-chan error
+	)
+	if files.OutputPath != "" {
+		logStream, errStream = cmd.Actor.GetStreamingLogs(application.GUID, cmd.NOAAClient)
+		defer cmd.NOAAClient.Close()
+	}
+
 	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -98,5 +134,115 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if files.OutputPath == "" {
+		return nil
+	}
+
+	return cmd.waitForOutputFile(application.GUID, task, files, logStream, errStream)
+}
+
+func (cmd RunTaskCommand) taskFiles() (v3action.TaskFiles, error) {
+	var (
+		inputName    string
+		inputContent []byte
+	)
+	if cmd.InputFile != "" {
+		inputName = string(cmd.InputFile)
+
+		var err error
+		inputContent, err = ioutil.ReadFile(inputName)
+		if err != nil {
+			return v3action.TaskFiles{}, err
+		}
+	}
+
+	return v3action.NewTaskFiles(inputName, inputContent, cmd.OutputPath)
+}
+
+type polledTask struct {
+	task     v3action.Task
+	warnings v3action.Warnings
+	err      error
+}
+
+func (cmd RunTaskCommand) waitForOutputFile(appGUID string, task v3action.Task, files v3action.TaskFiles, logStream <-chan *v3action.LogMessage, errStream <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to complete...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+
+	polled := make(chan polledTask, 1)
+	go func() {
+		finishedTask, warnings, err := cmd.Actor.PollTask(appGUID, int(task.SequenceID))
+		polled <- polledTask{task: finishedTask, warnings: warnings, err: err}
+	}()
+
+	var (
+		output     = files.NewOutputFile()
+		sourceType = "APP/TASK/" + task.Name
+		result     *polledTask
+		timeout    <-chan time.Time
+	)
+	for result == nil || (!output.Complete() && logStream != nil) {
+		select {
+		case logMessage, open := <-logStream:
+			if !open {
+				logStream = nil
+				continue
+			}
+			if logMessage.SourceType() != sourceType || output.Consume(logMessage.Message()) {
+				continue
+			}
+			cmd.UI.DisplayLogMessage(logMessage, true)
+		case err, open := <-errStream:
+			if !open {
+				errStream = nil
+				continue
+			}
+			if _, ok := err.(actionerror.NOAATimeoutError); ok {
+				cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+			}
+			cmd.UI.DisplayWarning(err.Error())
+		case finished := <-polled:
+			cmd.UI.DisplayWarnings(finished.warnings)
+			if finished.err != nil {
+				return finished.err
+			}
+			result = &finished
+			timeout = time.After(taskOutputTimeout)
+		case <-timeout:
+			logStream = nil
+		}
+	}
+
+	taskFailed := actionerror.TaskFailedError{Name: task.Name}
+
+	content, err := output.Content()
+	if err != nil {
+		if result.task.State == constant.TaskFailed {
+			return taskFailed
+		}
+		return err
+	}
+
+	outputFile := string(cmd.OutputFile)
+	if outputFile == "" {
+		outputFile = path.Base(files.OutputPath)
+	}
+	err = ioutil.WriteFile(outputFile, content, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Saved {{.OutputPath}} to {{.OutputFile}}.", map[string]interface{}{
+		"OutputPath": files.OutputPath,
+		"OutputFile": outputFile,
+	})
+
+	if result.task.State == constant.TaskFailed {
+		return taskFailed
+	}
+
+	cmd.UI.DisplayOK()
 	return nil
 }
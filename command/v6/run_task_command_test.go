@@ -2,10 +2,18 @@ package v6_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v6"
@@ -193,6 +201,157 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Err).To(Say("get-application-warning-3"))
 					})
 				})
+
+				When("an input file is provided", func() {
+					var tmpDir string
+
+					BeforeEach(func() {
+						var err error
+						tmpDir, err = ioutil.TempDir("", "run-task-input")
+						Expect(err).ToNot(HaveOccurred())
+
+						inputFile := filepath.Join(tmpDir, "migration.sql")
+						Expect(ioutil.WriteFile(inputFile, []byte("select 1;"), 0600)).To(Succeed())
+						cmd.InputFile = flag.PathWithExistenceCheck(inputFile)
+
+						fakeActor.RunTaskReturns(v3action.Task{Name: "some-task-name", SequenceID: 3}, nil, nil)
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(tmpDir)).To(Succeed())
+					})
+
+					It("writes the input file in the task container before running the command", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+						_, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(task.Command).To(HavePrefix("mkdir -p '/home/vcap/tmp/task-input' && "))
+						Expect(task.Command).To(ContainSubstring("echo 'c2VsZWN0IDE7' | base64 -d > '/home/vcap/tmp/task-input/migration.sql'"))
+						Expect(task.Command).To(HaveSuffix("export TASK_INPUT_FILE='/home/vcap/tmp/task-input/migration.sql' && (\nsome command\n)"))
+
+						Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(0))
+						Expect(fakeActor.PollTaskCallCount()).To(Equal(0))
+					})
+				})
+
+				When("an output path is provided", func() {
+					var (
+						tmpDir         string
+						fakeNOAAClient *v3actionfakes.FakeNOAAClient
+						logStream      chan *v3action.LogMessage
+						taskLogs       []string
+					)
+
+					BeforeEach(func() {
+						var err error
+						tmpDir, err = ioutil.TempDir("", "run-task-output")
+						Expect(err).ToNot(HaveOccurred())
+
+						fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+						cmd.OutputPath = "/tmp/report.csv"
+						cmd.OutputFile = flag.Path(filepath.Join(tmpDir, "report.csv"))
+
+						logStream = make(chan *v3action.LogMessage, 10)
+						fakeActor.GetStreamingLogsReturns(logStream, make(chan error))
+
+						taskLogs = []string{"generating report", "MARKER-begin", "aWQsbmFtZQox", "LHNvbWUtbmFtZQo=", "MARKER-end"}
+						fakeActor.RunTaskStub = func(_ string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
+							marker := regexp.MustCompile(`cf-task-output-[0-9a-f]+`).FindString(task.Command)
+							logStream <- v3action.NewLogMessage("staging an app", 1, time.Now(), "STG", "0")
+							for _, line := range taskLogs {
+								logStream <- v3action.NewLogMessage(strings.Replace(line, "MARKER", marker, 1), 1, time.Now(), "APP/TASK/some-task-name", "0")
+							}
+							return v3action.Task{Name: "some-task-name", SequenceID: 3}, v3action.Warnings{"run-task-warning"}, nil
+						}
+						fakeActor.PollTaskReturns(v3action.Task{Name: "some-task-name", State: constant.TaskSucceeded}, v3action.Warnings{"poll-task-warning"}, nil)
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(tmpDir)).To(Succeed())
+					})
+
+					It("waits for the task and saves the output file", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+						appGUID, noaaClient := fakeActor.GetStreamingLogsArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+						_, task := fakeActor.RunTaskArgsForCall(0)
+						Expect(task.Command).To(HavePrefix("(\nsome command\n); status=$?; if [ -f '/tmp/report.csv' ]"))
+
+						Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+						appGUID, sequenceID := fakeActor.PollTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(sequenceID).To(Equal(3))
+
+						Expect(testUI.Out).To(Say("Task has been submitted successfully for execution."))
+						Expect(testUI.Out).To(Say("Waiting for task some-task-name to complete..."))
+						Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT generating report`))
+						Expect(testUI.Out).To(Say(`Saved /tmp/report.csv to .*report.csv\.`))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).ToNot(Say("staging an app"))
+						Expect(testUI.Out).ToNot(Say("cf-task-output"))
+
+						Expect(testUI.Err).To(Say("run-task-warning"))
+						Expect(testUI.Err).To(Say("poll-task-warning"))
+
+						content, err := ioutil.ReadFile(filepath.Join(tmpDir, "report.csv"))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(content)).To(Equal("id,name\n1,some-name\n"))
+
+						Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+					})
+
+					When("the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(v3action.Task{Name: "some-task-name", State: constant.TaskFailed}, nil, nil)
+						})
+
+						It("saves the output file and returns a TaskFailedError", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Name: "some-task-name"}))
+
+							Expect(testUI.Out).To(Say(`Saved /tmp/report.csv to .*report.csv\.`))
+							Expect(filepath.Join(tmpDir, "report.csv")).To(BeAnExistingFile())
+						})
+
+						When("the task did not create the output file", func() {
+							BeforeEach(func() {
+								taskLogs = []string{"MARKER-missing"}
+							})
+
+							It("returns a TaskFailedError", func() {
+								Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Name: "some-task-name"}))
+							})
+						})
+					})
+
+					When("the task did not create the output file", func() {
+						BeforeEach(func() {
+							taskLogs = []string{"MARKER-missing"}
+						})
+
+						It("returns a TaskOutputFileNotFoundError", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskOutputFileNotFoundError{Path: "/tmp/report.csv"}))
+							Expect(filepath.Join(tmpDir, "report.csv")).ToNot(BeAnExistingFile())
+						})
+					})
+
+					When("polling the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(v3action.Task{}, v3action.Warnings{"poll-task-warning"}, errors.New("poll-error"))
+						})
+
+						It("returns the error and displays all warnings", func() {
+							Expect(executeErr).To(MatchError("poll-error"))
+							Expect(testUI.Err).To(Say("poll-task-warning"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 
 			When("there are errors", func() {
@@ -13,6 +13,7 @@ type ScaleCommand struct {
 	DiskLimit       string       `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	MemoryLimit     string       `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	usage           interface{}  `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
+	examples        interface{}  `examples:"CF_NAME scale my-app # Show the current instances, memory and disk\nCF_NAME scale my-app -i 3 # Run three instances\nCF_NAME scale my-app -m 1G -k 2G -f # Change the memory and disk limits without confirmation"`
 	relatedCommands interface{}  `related_commands:"push"`
 }
 
@@ -0,0 +1,98 @@
+package v6
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . ScheduleTaskActor
+
+type ScheduleTaskActor interface {
+	SetTaskSchedule(appName string, spaceGUID string, schedule v3action.TaskSchedule) (v3action.TaskSchedule, v3action.Warnings, error)
+}
+
+type ScheduleTaskCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Command         string       `long:"command" required:"true" description:"The command the task runs"`
+	Cron            string       `long:"cron" required:"true" description:"When to run the task, as a cron expression evaluated in UTC (e.g. \"0 3 * * *\")"`
+	Name            string       `long:"name" description:"Name of the schedule and the tasks it runs (generated if omitted)"`
+	usage           interface{}  `usage:"CF_NAME schedule-task APP_NAME --cron CRON_EXPRESSION --command COMMAND [--name SCHEDULE_NAME]\n\nTIP:\n   Schedules are stored on the app and only run when 'CF_NAME run-due-tasks' is invoked, for example from a CI job every few minutes. Scheduling a task with an existing name replaces that schedule.\n\nEXAMPLES:\n   CF_NAME schedule-task my-app --cron \"0 3 * * *\" --command \"rake cleanup\" --name cleanup"`
+	relatedCommands interface{}  `related_commands:"run-due-tasks, run-task, scheduled-tasks, unschedule-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduleTaskActor
+}
+
+func (cmd *ScheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd ScheduleTaskCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scheduling task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	schedule, warnings, err := cmd.Actor.SetTaskSchedule(cmd.RequiredArgs.AppName, space.GUID, v3action.TaskSchedule{
+		Name:    cmd.Name,
+		Cron:    cmd.Cron,
+		Command: cmd.Command,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	nextRun, err := schedule.NextRun()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("schedule name:"), schedule.Name},
+		{cmd.UI.TranslateText("next run:"), formatScheduleTime(cmd.UI, nextRun)},
+	}, 3)
+
+	return nil
+}
+
+// formatScheduleTime formats the times task schedules ran or are due, which
+// are always in UTC.
+func formatScheduleTime(ui command.UI, t time.Time) string {
+	if t.IsZero() {
+		return ui.TranslateText("never")
+	}
+	return t.UTC().Format(time.RFC1123)
+}
@@ -0,0 +1,115 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("schedule-task Command", func() {
+	var (
+		cmd             ScheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeScheduleTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeScheduleTaskActor)
+
+		cmd = ScheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Cron = "0 3 * * *"
+		cmd.Command = "rake cleanup"
+		cmd.Name = "cleanup"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the schedule is stored", func() {
+		BeforeEach(func() {
+			fakeActor.SetTaskScheduleReturns(
+				v3action.TaskSchedule{
+					Name:      "cleanup",
+					Cron:      "0 3 * * *",
+					Command:   "rake cleanup",
+					CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+				},
+				v3action.Warnings{"set-schedule-warning"},
+				nil,
+			)
+		})
+
+		It("displays the schedule name and when it runs next", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetTaskScheduleCallCount()).To(Equal(1))
+			appName, spaceGUID, schedule := fakeActor.SetTaskScheduleArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(schedule).To(Equal(v3action.TaskSchedule{Name: "cleanup", Cron: "0 3 * * *", Command: "rake cleanup"}))
+
+			Expect(testUI.Out).To(Say("Scheduling task for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`schedule name:\s+cleanup`))
+			Expect(testUI.Out).To(Say(`next run:\s+Tue, 05 Mar 2019 03:00:00 UTC`))
+			Expect(testUI.Err).To(Say("set-schedule-warning"))
+		})
+	})
+
+	When("storing the schedule fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetTaskScheduleReturns(v3action.TaskSchedule{}, v3action.Warnings{"set-schedule-warning"}, errors.New("set-schedule-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("set-schedule-error"))
+			Expect(testUI.Err).To(Say("set-schedule-warning"))
+		})
+	})
+})
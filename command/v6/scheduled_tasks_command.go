@@ -0,0 +1,119 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ScheduledTasksActor
+
+type ScheduledTasksActor interface {
+	GetTaskSchedulesByApplicationNameAndSpace(appName string, spaceGUID string) ([]v3action.TaskSchedule, v3action.Warnings, error)
+	GetTaskSchedulesBySpace(spaceGUID string) ([]v3action.TaskSchedule, v3action.Warnings, error)
+}
+
+type ScheduledTasksCommand struct {
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME scheduled-tasks [APP_NAME]"`
+	relatedCommands interface{}          `related_commands:"run-due-tasks, schedule-task, tasks, unschedule-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduledTasksActor
+}
+
+func (cmd *ScheduledTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd ScheduledTasksCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		schedules []v3action.TaskSchedule
+		warnings  v3action.Warnings
+	)
+	if cmd.OptionalArgs.AppName != "" {
+		cmd.UI.DisplayTextWithFlavor("Getting task schedules for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":     cmd.OptionalArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+		schedules, warnings, err = cmd.Actor.GetTaskSchedulesByApplicationNameAndSpace(cmd.OptionalArgs.AppName, space.GUID)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting task schedules in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+		schedules, warnings, err = cmd.Actor.GetTaskSchedulesBySpace(space.GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(schedules) == 0 {
+		cmd.UI.DisplayText("No task schedules found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("cron"),
+			cmd.UI.TranslateText("last run"),
+			cmd.UI.TranslateText("next run"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+	for _, schedule := range schedules {
+		nextRun := cmd.UI.TranslateText("invalid schedule")
+		if next, err := schedule.NextRun(); err == nil {
+			nextRun = formatScheduleTime(cmd.UI, next)
+		}
+
+		table = append(table, []string{
+			schedule.AppName,
+			schedule.Name,
+			schedule.Cron,
+			formatScheduleTime(cmd.UI, schedule.LastRunAt),
+			nextRun,
+			schedule.Command,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
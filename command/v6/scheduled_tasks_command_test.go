@@ -0,0 +1,132 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scheduled-tasks Command", func() {
+	var (
+		cmd             ScheduledTasksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeScheduledTasksActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeScheduledTasksActor)
+
+		cmd = ScheduledTasksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("no app is provided", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskSchedulesBySpaceReturns(
+				[]v3action.TaskSchedule{
+					{
+						Name:      "cleanup",
+						AppName:   "app-a",
+						Cron:      "0 3 * * *",
+						Command:   "rake cleanup",
+						CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+						LastRunAt: time.Date(2019, 3, 5, 3, 1, 0, 0, time.UTC),
+					},
+					{
+						Name:      "report",
+						AppName:   "app-b",
+						Cron:      "not a cron expression",
+						Command:   "bin/report",
+						CreatedAt: time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				v3action.Warnings{"get-schedules-warning"},
+				nil,
+			)
+		})
+
+		It("lists the task schedules of every app in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetTaskSchedulesBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetTaskSchedulesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("Getting task schedules in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`app\s+name\s+cron\s+last run\s+next run\s+command`))
+			Expect(testUI.Out).To(Say(`app-a\s+cleanup\s+0 3 \* \* \*\s+Tue, 05 Mar 2019 03:01:00 UTC\s+Wed, 06 Mar 2019 03:00:00 UTC\s+rake cleanup`))
+			Expect(testUI.Out).To(Say(`app-b\s+report\s+not a cron expression\s+never\s+invalid schedule\s+bin/report`))
+			Expect(testUI.Err).To(Say("get-schedules-warning"))
+		})
+	})
+
+	When("an app is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = "some-app"
+		})
+
+		It("lists the task schedules of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetTaskSchedulesByApplicationNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetTaskSchedulesByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say("Getting task schedules for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("No task schedules found."))
+		})
+
+		When("getting the schedules fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskSchedulesByApplicationNameAndSpaceReturns(nil, v3action.Warnings{"get-schedules-warning"}, errors.New("get-schedules-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("get-schedules-error"))
+				Expect(testUI.Err).To(Say("get-schedules-warning"))
+			})
+		})
+	})
+})
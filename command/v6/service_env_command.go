@@ -0,0 +1,241 @@
+package v6
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/shellquote"
+)
+
+// serviceEnvKeyName is the name of the service key holding the credentials
+// that service-env prints.
+const serviceEnvKeyName = "cf-service-env"
+
+//go:generate counterfeiter . ServiceEnvActor
+
+type ServiceEnvActor interface {
+	GetServiceInstanceCredentials(serviceInstanceName, keyName, spaceGUID string) (map[string]interface{}, v2action.Warnings, error)
+	DeleteServiceInstanceKey(serviceInstanceName, keyName, spaceGUID string) (v2action.Warnings, error)
+}
+
+type ServiceEnvCommand struct {
+	RequiredArgs    flag.ServiceInstance  `positional-args:"yes"`
+	Format          flag.ServiceEnvFormat `long:"format" choice:"shell" choice:"dotenv" choice:"json" default:"shell" description:"Output format"`
+	Cleanup         bool                  `long:"cleanup" description:"Delete the service key holding the credentials, which revokes them"`
+	usage           interface{}           `usage:"CF_NAME service-env SERVICE_INSTANCE [--format (shell | dotenv | json)]\n   CF_NAME service-env SERVICE_INSTANCE --cleanup\n\n   Retrieves the credentials of a service instance through the service key cf-service-env, which is created on first use and reused afterwards. The credentials stay valid until the key is deleted with --cleanup. Nested credentials are flattened into upper case variable names joined with underscores, e.g. {\"db\": {\"host\": \"...\"}} becomes DB_HOST, and names starting with a digit are prefixed with an underscore. Credentials whose names flatten to the same variable are left out with a warning. The json format prints the credentials as returned by the service broker."`
+	examples        interface{}           `examples:"eval $(CF_NAME service-env mydb) # Export the credentials into the current shell\nCF_NAME service-env mydb --format dotenv > .env # Write the credentials to a .env file\nCF_NAME service-env mydb --format json # Print the credentials as JSON\nCF_NAME service-env mydb --cleanup # Delete the service key, revoking the credentials"`
+	relatedCommands interface{}           `related_commands:"create-service-key, service, service-key"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceEnvActor
+}
+
+func (cmd *ServiceEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+// Execute prints only the credentials to stdout so that the output can be
+// evaluated or redirected; warnings go to stderr.
+func (cmd ServiceEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Cleanup {
+		return cmd.cleanup()
+	}
+
+	credentials, warnings, err := cmd.Actor.GetServiceInstanceCredentials(cmd.RequiredArgs.ServiceInstance, serviceEnvKeyName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		output     string
+		collisions []serviceEnvCollision
+	)
+	switch cmd.Format {
+	case "json":
+		raw, err := json.MarshalIndent(credentials, "", "  ")
+		if err != nil {
+			return err
+		}
+		output = string(raw)
+	case "dotenv":
+		output, collisions = formatServiceEnv(credentials, func(name string, value string) string {
+			return fmt.Sprintf("%s=%s", name, dotenvQuote(value))
+		})
+	default:
+		output, collisions = formatServiceEnv(credentials, func(name string, value string) string {
+			return fmt.Sprintf("export %s=%s", name, shellquote.Quote(value))
+		})
+	}
+
+	for _, collision := range collisions {
+		cmd.UI.DisplayWarning("Credentials {{.Credentials}} all map to {{.Name}} and are left out. Use --format json to read them.", map[string]interface{}{
+			"Credentials": strings.Join(collision.paths, ", "),
+			"Name":        collision.name,
+		})
+	}
+
+	if output != "" {
+		cmd.UI.DisplayText("{{.Output}}", map[string]interface{}{
+			"Output": output,
+		})
+	}
+	return nil
+}
+
+func (cmd ServiceEnvCommand) cleanup() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting key {{.ServiceKey}} for service instance {{.ServiceInstance}} as {{.User}}...", map[string]interface{}{
+		"ServiceKey":      serviceEnvKeyName,
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"User":            user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteServiceInstanceKey(cmd.RequiredArgs.ServiceInstance, serviceEnvKeyName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.ServiceKeyNotFoundError); ok {
+		cmd.UI.DisplayText("Service key {{.ServiceKey}} does not exist for service instance {{.ServiceInstance}}.", map[string]interface{}{
+			"ServiceKey":      serviceEnvKeyName,
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+var (
+	invalidEnvNameCharacters = regexp.MustCompile(`[^A-Z0-9_]`)
+	digitPrefix              = regexp.MustCompile(`^[0-9]`)
+)
+
+// serviceEnvVariable is a flattened credential and the paths of the
+// credentials that flatten to its name.
+type serviceEnvVariable struct {
+	value string
+	paths []string
+}
+
+// serviceEnvCollision is a variable name that several credentials flatten to.
+type serviceEnvCollision struct {
+	name  string
+	paths []string
+}
+
+// formatServiceEnv flattens the credentials into variables sorted by name and
+// formats each with formatLine. Variables that several credentials flatten to
+// are left out and returned as collisions.
+func formatServiceEnv(credentials map[string]interface{}, formatLine func(name string, value string) string) (string, []serviceEnvCollision) {
+	variables := map[string]*serviceEnvVariable{}
+	flattenCredentials("", "", credentials, variables)
+
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		lines      []string
+		collisions []serviceEnvCollision
+	)
+	for _, name := range names {
+		variable := variables[name]
+		if len(variable.paths) > 1 {
+			sort.Strings(variable.paths)
+			collisions = append(collisions, serviceEnvCollision{name: name, paths: variable.paths})
+			continue
+		}
+		lines = append(lines, formatLine(name, variable.value))
+	}
+	return strings.Join(lines, "\n"), collisions
+}
+
+func flattenCredentials(prefix string, path string, value interface{}, variables map[string]*serviceEnvVariable) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typedValue {
+			flattenCredentials(joinEnvName(prefix, key), joinPath(path, key), nested, variables)
+		}
+	case []interface{}:
+		for i, nested := range typedValue {
+			flattenCredentials(joinEnvName(prefix, fmt.Sprint(i)), joinPath(path, fmt.Sprint(i)), nested, variables)
+		}
+	case nil:
+		addServiceEnvVariable(variables, prefix, path, "")
+	case string:
+		addServiceEnvVariable(variables, prefix, path, typedValue)
+	default:
+		raw, _ := json.Marshal(typedValue)
+		addServiceEnvVariable(variables, prefix, path, string(raw))
+	}
+}
+
+func addServiceEnvVariable(variables map[string]*serviceEnvVariable, name string, path string, value string) {
+	if variable, found := variables[name]; found {
+		variable.paths = append(variable.paths, path)
+		return
+	}
+	variables[name] = &serviceEnvVariable{value: value, paths: []string{path}}
+}
+
+// joinEnvName appends key to the variable name prefix. A variable name that
+// would start with a digit, or be empty, is prefixed with an underscore.
+func joinEnvName(prefix string, key string) string {
+	name := invalidEnvNameCharacters.ReplaceAllString(strings.ToUpper(key), "_")
+	if prefix != "" {
+		return prefix + "_" + name
+	}
+	if name == "" || digitPrefix.MatchString(name) {
+		return "_" + name
+	}
+	return name
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// dotenvQuote double quotes value for a .env file, escaping $ so that dotenv
+// loaders which expand ${VAR} in double quoted values leave it as is.
+func dotenvQuote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, `$`, `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
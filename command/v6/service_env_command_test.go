@@ -0,0 +1,215 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-env Command", func() {
+	var (
+		cmd             ServiceEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeServiceEnvActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeServiceEnvActor)
+
+		cmd = ServiceEnvCommand{
+			RequiredArgs: flag.ServiceInstance{ServiceInstance: "mydb"},
+			Format:       "shell",
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetServiceInstanceCredentialsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the credentials are retrieved", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceCredentialsReturns(
+				map[string]interface{}{
+					"uri":      "postgres://user:it's@db:5432/app",
+					"password": "pa$$word${HOME}",
+					"port":     float64(5432),
+					"tls":      true,
+					"replicas": []interface{}{"db-1", "db-2"},
+					"admin":    map[string]interface{}{"user-name": "admin", "note": "say \"hi\"\nbye"},
+				},
+				[]string{"some-warning"},
+				nil,
+			)
+		})
+
+		It("gets the credentials of the service-env key of the service instance in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			serviceInstanceName, keyName, spaceGUID := fakeActor.GetServiceInstanceCredentialsArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("mydb"))
+			Expect(keyName).To(Equal("cf-service-env"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		It("prints sorted shell exports of the flattened credentials", func() {
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`export ADMIN_NOTE='say "hi"
+bye'
+export ADMIN_USER_NAME='admin'
+export PASSWORD='pa$$word${HOME}'
+export PORT='5432'
+export REPLICAS_0='db-1'
+export REPLICAS_1='db-2'
+export TLS='true'
+export URI='postgres://user:it'"'"'s@db:5432/app'
+`))
+		})
+
+		When("the format is dotenv", func() {
+			BeforeEach(func() {
+				cmd.Format = "dotenv"
+			})
+
+			It("prints double quoted assignments", func() {
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`ADMIN_NOTE="say \"hi\"\nbye"
+ADMIN_USER_NAME="admin"
+PASSWORD="pa\$\$word\${HOME}"
+PORT="5432"
+REPLICAS_0="db-1"
+REPLICAS_1="db-2"
+TLS="true"
+URI="postgres://user:it's@db:5432/app"
+`))
+			})
+		})
+
+		When("the format is json", func() {
+			BeforeEach(func() {
+				cmd.Format = "json"
+			})
+
+			It("prints the credentials as returned", func() {
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+					"uri": "postgres://user:it's@db:5432/app",
+					"password": "pa$$word${HOME}",
+					"port": 5432,
+					"tls": true,
+					"replicas": ["db-1", "db-2"],
+					"admin": {"user-name": "admin", "note": "say \"hi\"\nbye"}
+				}`))
+			})
+		})
+	})
+
+	When("credential names are not valid variable names or collide", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceCredentialsReturns(
+				map[string]interface{}{
+					"2fa-secret": "some-secret",
+					"a-b":        "dash",
+					"a_b":        "underscore",
+					"host":       "db",
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("prefixes names starting with a digit and leaves out colliding credentials with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`export HOST='db'
+export _2FA_SECRET='some-secret'
+`))
+			Expect(testUI.Err).To(Say(`Credentials a-b, a_b all map to A_B and are left out\. Use --format json to read them\.`))
+		})
+	})
+
+	When("--cleanup is given", func() {
+		BeforeEach(func() {
+			cmd.Cleanup = true
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.DeleteServiceInstanceKeyReturns([]string{"some-warning"}, nil)
+		})
+
+		It("deletes the service-env key instead of printing credentials", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetServiceInstanceCredentialsCallCount()).To(Equal(0))
+
+			serviceInstanceName, keyName, spaceGUID := fakeActor.DeleteServiceInstanceKeyArgsForCall(0)
+			Expect(serviceInstanceName).To(Equal("mydb"))
+			Expect(keyName).To(Equal("cf-service-env"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Deleting key cf-service-env for service instance mydb as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("the key does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceKeyReturns(nil, actionerror.ServiceKeyNotFoundError{Name: "cf-service-env", ServiceInstanceName: "mydb"})
+			})
+
+			It("says so and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Service key cf-service-env does not exist for service instance mydb\.`))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("deleting the key fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteServiceInstanceKeyReturns(nil, errors.New("delete-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+			})
+		})
+	})
+
+	When("retrieving the credentials fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceInstanceCredentialsReturns(nil, []string{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+		})
+	})
+})
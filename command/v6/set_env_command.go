@@ -1,22 +1,118 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . SetEnvActor
+
+type SetEnvActor interface {
+	ResolveEnvironmentVariableTemplate(appName string, spaceGUID string, template string) (string, v3action.Warnings, error)
+	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+	SetEnvironmentVariableTemplateByApplicationNameAndSpace(appName string, spaceGUID string, envPair v3action.EnvironmentVariablePair) (v3action.Warnings, error)
+}
+
 type SetEnvCommand struct {
 	RequiredArgs    flag.SetEnvironmentArgs `positional-args:"yes"`
-	usage           interface{}             `usage:"CF_NAME set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE"`
+	Resolve         bool                    `long:"resolve" description:"Resolve service binding references in ENV_VAR_VALUE and set the resulting value"`
+	Dynamic         bool                    `long:"dynamic" description:"Same as --resolve, and also record ENV_VAR_VALUE as a template in an annotation on the app"`
+	usage           interface{}             `usage:"CF_NAME set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE [--resolve | --dynamic]\n\n   ENV_VAR_VALUE may refer to the credentials of a service instance bound to the app as {{\"{{\"}}services.INSTANCE_NAME.credentials.KEY}}. These references are only resolved when --resolve or --dynamic is provided.\n\nEXAMPLES:\n   CF_NAME set-env my-app DB_URL '{{\"{{\"}}services.mydb.credentials.uri}}' --resolve"`
 	relatedCommands interface{}             `related_commands:"apps, env, restart, set-staging-environment-variable-group, set-running-environment-variable-group, unset-env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvActor
 }
 
-func (SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.Resolve && !cmd.Dynamic {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
 	return nil
 }
 
-func (SetEnvCommand) Execute(args []string) error {
-	//TODO: Be sure to sanitize the WorkAroundPrefix
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd SetEnvCommand) Execute(args []string) error {
+	if !cmd.Resolve && !cmd.Dynamic {
+		//TODO: Be sure to sanitize the WorkAroundPrefix
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	if cmd.Resolve && cmd.Dynamic {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--resolve", "--dynamic"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	envVarName := cmd.RequiredArgs.EnvironmentVariableName
+	template := string(cmd.RequiredArgs.EnvironmentVariableValue)
+
+	cmd.UI.DisplayTextWithFlavor("Setting env variable {{.EnvVarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":    appName,
+		"EnvVarName": envVarName,
+		"OrgName":    cmd.Config.TargetedOrganization().Name,
+		"SpaceName":  cmd.Config.TargetedSpace().Name,
+		"Username":   user.Name,
+	})
+
+	value, warnings, err := cmd.Actor.ResolveEnvironmentVariableTemplate(appName, spaceGUID, template)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.SetEnvironmentVariableByApplicationNameAndSpace(appName, spaceGUID, v3action.EnvironmentVariablePair{
+		Key:   envVarName,
+		Value: value,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Dynamic {
+		warnings, err = cmd.Actor.SetEnvironmentVariableTemplateByApplicationNameAndSpace(appName, spaceGUID, v3action.EnvironmentVariablePair{
+			Key:   envVarName,
+			Value: template,
+		})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restage {{.AppName}}' to ensure your env variable changes take effect.", map[string]interface{}{
+		"AppName": appName,
+	})
+
+	return nil
 }
@@ -0,0 +1,172 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-env Command", func() {
+	var (
+		cmd             SetEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeSetEnvActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeSetEnvActor)
+
+		cmd = SetEnvCommand{
+			RequiredArgs: flag.SetEnvironmentArgs{
+				AppName:                  "some-app",
+				EnvironmentVariableName:  "DB_URL",
+				EnvironmentVariableValue: "{{services.mydb.credentials.uri}}",
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither --resolve nor --dynamic is provided", func() {
+		It("returns an UnrefactoredCommandError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+	})
+
+	When("both --resolve and --dynamic are provided", func() {
+		BeforeEach(func() {
+			cmd.Resolve = true
+			cmd.Dynamic = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--resolve", "--dynamic"},
+			}))
+		})
+	})
+
+	When("--resolve is provided", func() {
+		BeforeEach(func() {
+			cmd.Resolve = true
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+				Expect(fakeActor.ResolveEnvironmentVariableTemplateCallCount()).To(Equal(0))
+			})
+		})
+
+		When("resolving the template fails", func() {
+			BeforeEach(func() {
+				fakeActor.ResolveEnvironmentVariableTemplateReturns("", v3action.Warnings{"resolve-warning"}, actionerror.ServiceBindingReferenceNotFoundError{Reference: "services.mydb.credentials.uri"})
+			})
+
+			It("returns the error and does not set the env variable", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceBindingReferenceNotFoundError{Reference: "services.mydb.credentials.uri"}))
+				Expect(testUI.Err).To(Say("resolve-warning"))
+				Expect(fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("resolving the template succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.ResolveEnvironmentVariableTemplateReturns("mysql://db:3306/app", v3action.Warnings{"resolve-warning"}, nil)
+				fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceReturns(v3action.Warnings{"set-warning"}, nil)
+			})
+
+			It("sets the resolved value without recording the template", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				appName, spaceGUID, template := fakeActor.ResolveEnvironmentVariableTemplateArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(template).To(Equal("{{services.mydb.credentials.uri}}"))
+
+				appName, spaceGUID, envPair := fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(envPair).To(Equal(v3action.EnvironmentVariablePair{Key: "DB_URL", Value: "mysql://db:3306/app"}))
+				Expect(fakeActor.SetEnvironmentVariableTemplateByApplicationNameAndSpaceCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say("Setting env variable DB_URL for app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("TIP: Use 'cf restage some-app' to ensure your env variable changes take effect."))
+				Expect(testUI.Out).ToNot(Say("mysql://"))
+				Expect(testUI.Err).To(Say("resolve-warning"))
+				Expect(testUI.Err).To(Say("set-warning"))
+			})
+		})
+	})
+
+	When("--dynamic is provided", func() {
+		BeforeEach(func() {
+			cmd.Dynamic = true
+			fakeActor.ResolveEnvironmentVariableTemplateReturns("mysql://db:3306/app", nil, nil)
+		})
+
+		When("recording the template succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.SetEnvironmentVariableTemplateByApplicationNameAndSpaceReturns(v3action.Warnings{"annotate-warning"}, nil)
+			})
+
+			It("sets the resolved value and records the template on the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, envPair := fakeActor.SetEnvironmentVariableByApplicationNameAndSpaceArgsForCall(0)
+				Expect(envPair.Value).To(Equal("mysql://db:3306/app"))
+
+				appName, spaceGUID, templatePair := fakeActor.SetEnvironmentVariableTemplateByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(templatePair).To(Equal(v3action.EnvironmentVariablePair{Key: "DB_URL", Value: "{{services.mydb.credentials.uri}}"}))
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("annotate-warning"))
+			})
+		})
+
+		When("recording the template fails", func() {
+			BeforeEach(func() {
+				fakeActor.SetEnvironmentVariableTemplateByApplicationNameAndSpaceReturns(nil, errors.New("annotate-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("annotate-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,117 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . SetOwnerActor
+
+type SetOwnerActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationOwnershipByName(appName string, spaceGUID string, annotations map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationOwnershipByName(orgName string, annotations map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceOwnershipByName(spaceName string, orgGUID string, annotations map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type SetOwnerCommand struct {
+	RequiredArgs    flag.SetOwnerArgs   `positional-args:"yes"`
+	Owner           flag.OptionalString `long:"owner" description:"Person accountable for the resource"`
+	Team            flag.OptionalString `long:"team" description:"Team that runs the resource"`
+	Contact         flag.OptionalString `long:"contact" description:"Where to reach the owners during an incident, such as an email address, pager or chat channel"`
+	usage           interface{}         `usage:"CF_NAME set-owner RESOURCE RESOURCE_NAME [--owner OWNER] [--team TEAM] [--contact CONTACT]\n\n   Records ownership in the owner, team and contact annotations of the resource. Pass an empty value to remove an annotation.\n\nRESOURCES:\n   org\n   space\n   app"`
+	examples        interface{}         `examples:"CF_NAME set-owner app dora --owner jane --team payments --contact payments-oncall@example.com\nCF_NAME set-owner space production --team payments\nCF_NAME set-owner org acme --contact \"\""`
+	relatedCommands interface{}         `related_commands:"owners, spaces, v3-apps"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetOwnerActor
+}
+
+func (cmd *SetOwnerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd SetOwnerCommand) Execute(args []string) error {
+	annotations := cmd.annotations()
+	if len(annotations) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "--owner, --team or --contact"}
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err := cmd.SharedActor.CheckTarget(resourceType != "org", resourceType == "app")
+	if err != nil {
+		return err
+	}
+
+	err = command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion).Require("", ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting owner of {{.ResourceType}} {{.ResourceName}} as {{.Username}}...", map[string]interface{}{
+		"ResourceType": resourceType,
+		"ResourceName": cmd.RequiredArgs.ResourceName,
+		"Username":     user.Name,
+	})
+
+	var warnings v3action.Warnings
+	switch resourceType {
+	case "org":
+		warnings, err = cmd.Actor.UpdateOrganizationOwnershipByName(cmd.RequiredArgs.ResourceName, annotations)
+	case "space":
+		warnings, err = cmd.Actor.UpdateSpaceOwnershipByName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, annotations)
+	case "app":
+		warnings, err = cmd.Actor.UpdateApplicationOwnershipByName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, annotations)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// annotations returns the ownership annotations to update. Flags passed
+// with an empty value remove their annotation.
+func (cmd SetOwnerCommand) annotations() map[string]types.NullString {
+	annotations := map[string]types.NullString{}
+	for key, value := range map[string]flag.OptionalString{
+		v3action.OwnerAnnotation:   cmd.Owner,
+		v3action.TeamAnnotation:    cmd.Team,
+		v3action.ContactAnnotation: cmd.Contact,
+	} {
+		if !value.IsSet {
+			continue
+		}
+		if value.Value == "" {
+			annotations[key] = types.NewNullString()
+		} else {
+			annotations[key] = types.NewNullString(value.Value)
+		}
+	}
+	return annotations
+}
@@ -0,0 +1,168 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-owner Command", func() {
+	var (
+		cmd             SetOwnerCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeSetOwnerActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeSetOwnerActor)
+
+		cmd = SetOwnerCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ResourceType = flag.OwnedResource{Type: "app"}
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.Owner = flag.OptionalString{IsSet: true, Value: "jane"}
+		cmd.Contact = flag.OptionalString{IsSet: true}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no ownership flags are passed", func() {
+		BeforeEach(func() {
+			cmd.Owner = flag.OptionalString{}
+			cmd.Contact = flag.OptionalString{}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "--owner, --team or --contact"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the API does not support metadata", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.65.0")
+		})
+
+		It("returns a MinimumCFAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: "3.65.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	When("the resource is an app", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationOwnershipByNameReturns(v3action.Warnings{"update-warning"}, nil)
+		})
+
+		It("sets the passed annotations and removes the empty ones", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			targetedOrg, targetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeTrue())
+			Expect(targetedSpace).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Setting owner of app some-app as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			appName, spaceGUID, annotations := fakeActor.UpdateApplicationOwnershipByNameArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"owner":   types.NewNullString("jane"),
+				"contact": types.NewNullString(),
+			}))
+		})
+
+		When("updating the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationOwnershipByNameReturns(v3action.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-warning"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+	})
+
+	When("the resource is a space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.OwnedResource{Type: "space"}
+			cmd.RequiredArgs.ResourceName = "some-space"
+		})
+
+		It("updates the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			targetedOrg, targetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeTrue())
+			Expect(targetedSpace).To(BeFalse())
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceOwnershipByNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	When("the resource is an org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.OwnedResource{Type: "org"}
+			cmd.RequiredArgs.ResourceName = "some-org"
+		})
+
+		It("updates the org without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			targetedOrg, targetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeFalse())
+			Expect(targetedSpace).To(BeFalse())
+
+			orgName, _ := fakeActor.UpdateOrganizationOwnershipByNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-space-quota Command", func() {
+	var (
+		cmd             SetSpaceQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeSetSpaceQuotaActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeSetSpaceQuotaActor)
+
+		cmd = SetSpaceQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Space = "some-space"
+		cmd.RequiredArgs.SpaceQuota = "dev"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionQuotasV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the space cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, v3action.Warnings{"space-warning"}, actionerror.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns the error and does not apply the quota", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("space-warning"))
+			Expect(fakeActor.ApplySpaceQuotaByNameCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the space exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "some-space-guid"}, v3action.Warnings{"space-warning"}, nil)
+		})
+
+		When("applying the quota succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.ApplySpaceQuotaByNameReturns(v3action.Warnings{"apply-warning"}, nil)
+			})
+
+			It("applies the quota in the targeted organization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Assigning space quota dev to space some-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+
+				spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				quotaName, spaceGUID, orgGUID := fakeActor.ApplySpaceQuotaByNameArgsForCall(0)
+				Expect(quotaName).To(Equal("dev"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("applying the quota fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplySpaceQuotaByNameReturns(v3action.Warnings{"apply-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+})
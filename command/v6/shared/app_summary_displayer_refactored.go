@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,10 +11,19 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type AppSummaryDisplayer2 struct {
 	UI command.UI
+
+	// DisplayPlacement adds the cell and availability zone of each instance
+	// to the instances table.
+	DisplayPlacement bool
+	// GroupByPlacement displays, after each instances table, the number of
+	// instances and the cells in each availability zone.
+	GroupByPlacement bool
 }
 
 func NewAppSummaryDisplayer2(ui command.UI) *AppSummaryDisplayer2 {
@@ -51,20 +61,21 @@ func (display AppSummaryDisplayer2) AppDisplay(summary v2v3action.ApplicationSum
 }
 
 func (display AppSummaryDisplayer2) displayAppInstancesTable(processSummary v3action.ProcessSummary) {
-	table := [][]string{
-		{
-			"",
-			display.UI.TranslateText("state"),
-			display.UI.TranslateText("since"),
-			display.UI.TranslateText("cpu"),
-			display.UI.TranslateText("memory"),
-			display.UI.TranslateText("disk"),
-			display.UI.TranslateText("details"),
-		},
+	header := []string{
+		"",
+		display.UI.TranslateText("state"),
+		display.UI.TranslateText("since"),
+		display.UI.TranslateText("cpu"),
+		display.UI.TranslateText("memory"),
+		display.UI.TranslateText("disk"),
+	}
+	if display.DisplayPlacement {
+		header = append(header, display.UI.TranslateText("cell"), display.UI.TranslateText("zone"))
 	}
+	table := [][]string{append(header, display.UI.TranslateText("details"))}
 
 	for _, instance := range processSummary.InstanceDetails {
-		table = append(table, []string{
+		row := []string{
 			fmt.Sprintf("#%d", instance.Index),
 			display.UI.TranslateText(strings.ToLower(string(instance.State))),
 			display.appInstanceDate(instance.StartTime()),
@@ -77,13 +88,69 @@ func (display AppSummaryDisplayer2) displayAppInstancesTable(processSummary v3ac
 				"DiskUsage": bytefmt.ByteSize(instance.DiskUsage),
 				"DiskQuota": bytefmt.ByteSize(instance.DiskQuota),
 			}),
-			instance.Details,
-		})
+		}
+		if display.DisplayPlacement {
+			row = append(row, instance.Host, instance.Zone)
+		}
+		table = append(table, append(row, instance.Details))
 	}
 
 	display.UI.DisplayInstancesTableForApp(table)
 }
 
+// displayPlacementTable displays the number of instances and the cells they
+// are running on for each availability zone, so that uneven placement across
+// zones is easy to spot.
+func (display AppSummaryDisplayer2) displayPlacementTable(processSummary v3action.ProcessSummary) {
+	var zones []string
+	instanceCounts := map[string]int{}
+	cells := map[string][]string{}
+
+	for _, instance := range processSummary.InstanceDetails {
+		if instance.Host == "" {
+			continue
+		}
+
+		zone := instance.Zone
+		if zone == "" {
+			zone = display.UI.TranslateText("unknown")
+		}
+		if _, found := instanceCounts[zone]; !found {
+			zones = append(zones, zone)
+		}
+		instanceCounts[zone]++
+
+		if !containsString(cells[zone], instance.Host) {
+			cells[zone] = append(cells[zone], instance.Host)
+		}
+	}
+
+	display.UI.DisplayNewline()
+	if len(zones) == 0 {
+		display.UI.DisplayText("No instances of this process have been placed on a cell.")
+		return
+	}
+
+	sort.Strings(zones)
+	table := [][]string{
+		{
+			display.UI.TranslateText("zone"),
+			display.UI.TranslateText("instances"),
+			display.UI.TranslateText("cells"),
+		},
+	}
+	for _, zone := range zones {
+		sort.Strings(cells[zone])
+		table = append(table, []string{
+			zone,
+			fmt.Sprint(instanceCounts[zone]),
+			strings.Join(cells[zone], ", "),
+		})
+	}
+
+	display.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
 func (display AppSummaryDisplayer2) displayProcessTable(summary v3action.ApplicationSummary, displayStartCommand bool) {
 	for _, process := range summary.ProcessSummaries {
 		display.UI.DisplayNewline()
@@ -93,10 +160,16 @@ func (display AppSummaryDisplayer2) displayProcessTable(summary v3action.Applica
 			startCommandRow = append(startCommandRow, display.UI.TranslateText("start command:"), process.Command.Value)
 		}
 
+		var logRateLimitRow []string
+		if process.LogRateLimitInBPS.IsSet {
+			logRateLimitRow = append(logRateLimitRow, display.UI.TranslateText("log rate limit:"), display.logRateLimit(process.LogRateLimitInBPS))
+		}
+
 		keyValueTable := [][]string{
 			{display.UI.TranslateText("type:"), process.Type},
 			{display.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
 			{display.UI.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB.Value)},
+			logRateLimitRow,
 			startCommandRow,
 		}
 
@@ -108,7 +181,18 @@ func (display AppSummaryDisplayer2) displayProcessTable(summary v3action.Applica
 			continue
 		}
 		display.displayAppInstancesTable(process)
+
+		if display.GroupByPlacement {
+			display.displayPlacementTable(process)
+		}
+	}
+}
+
+func (display AppSummaryDisplayer2) logRateLimit(limit types.NullInt) string {
+	if limit.Value < 0 {
+		return display.UI.TranslateText("unlimited")
 	}
+	return bytefmt.ByteSize(uint64(limit.Value)) + "/s"
 }
 
 func (display AppSummaryDisplayer2) getCreatedTime(summary v2v3action.ApplicationSummary) string {
@@ -148,6 +232,15 @@ func (AppSummaryDisplayer2) appInstanceDate(input time.Time) string {
 	return input.UTC().Format(time.RFC3339)
 }
 
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
+
 func (AppSummaryDisplayer2) processHasAnInstanceUp(processSummary *v3action.ProcessSummary) bool {
 	for _, processInstance := range processSummary.InstanceDetails {
 		if processInstance.State != constant.ProcessInstanceDown {
@@ -285,7 +285,7 @@ var _ = Describe("app summary displayer", func() {
 			})
 		})
 
-		When("the app has no instances", func() {
+		When("the instances have been placed on cells", func() {
 			BeforeEach(func() {
 				summary = v2v3action.ApplicationSummary{
 					ApplicationSummary: v3action.ApplicationSummary{
@@ -298,14 +298,104 @@ var _ = Describe("app summary displayer", func() {
 								Process: v3action.Process{
 									Type:       constant.ProcessTypeWeb,
 									MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
-									DiskInMB:   types.NullUint64{Value: 1024, IsSet: true},
+								},
+								InstanceDetails: []v3action.ProcessInstance{
+									{Index: 0, State: constant.ProcessInstanceRunning, Host: "10.0.16.5", Zone: "z1", Details: "some-details"},
+									{Index: 1, State: constant.ProcessInstanceRunning, Host: "10.0.32.7", Zone: "z2"},
+									{Index: 2, State: constant.ProcessInstanceRunning, Host: "10.0.16.5", Zone: "z1"},
+									{Index: 3, State: constant.ProcessInstanceRunning, Host: "10.0.16.6", Zone: "z1"},
+									{Index: 4, State: constant.ProcessInstanceStarting},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("does not display the cell and zone by default", func() {
+				Expect(testUI.Out).NotTo(Say(`cell\s+zone`))
+			})
+
+			When("DisplayPlacement is set", func() {
+				BeforeEach(func() {
+					appSummaryDisplayer.DisplayPlacement = true
+				})
+
+				It("displays the cell and zone of each instance", func() {
+					processTable := helpers.ParseV3AppProcessTable(output.Contents())
+					instances := processTable.Processes[0].Instances
+					Expect(instances[0].Cell).To(Equal("10.0.16.5"))
+					Expect(instances[0].Zone).To(Equal("z1"))
+					Expect(instances[0].Details).To(Equal("some-details"))
+					Expect(instances[1].Cell).To(Equal("10.0.32.7"))
+					Expect(instances[1].Zone).To(Equal("z2"))
+				})
+
+				It("does not group the instances by zone", func() {
+					Expect(testUI.Out).NotTo(Say(`zone\s+instances\s+cells`))
+				})
+			})
+
+			When("GroupByPlacement is set", func() {
+				BeforeEach(func() {
+					appSummaryDisplayer.GroupByPlacement = true
+				})
+
+				It("displays the number of instances and the cells in each zone", func() {
+					Expect(testUI.Out).To(Say(`zone\s+instances\s+cells`))
+					Expect(testUI.Out).To(Say(`z1\s+3\s+10\.0\.16\.5, 10\.0\.16\.6`))
+					Expect(testUI.Out).To(Say(`z2\s+1\s+10\.0\.32\.7`))
+				})
+
+				When("the Cloud Controller does not report zones", func() {
+					BeforeEach(func() {
+						for i := range summary.ProcessSummaries[0].InstanceDetails {
+							summary.ProcessSummaries[0].InstanceDetails[i].Zone = ""
+						}
+					})
+
+					It("groups the instances under an unknown zone", func() {
+						Expect(testUI.Out).To(Say(`unknown\s+4\s+10\.0\.16\.5, 10\.0\.16\.6, 10\.0\.32\.7`))
+					})
+				})
+
+				When("no instances have been placed", func() {
+					BeforeEach(func() {
+						summary.ProcessSummaries[0].InstanceDetails = []v3action.ProcessInstance{
+							{Index: 0, State: constant.ProcessInstanceStarting},
+						}
+					})
+
+					It("says so", func() {
+						Expect(testUI.Out).To(Say("No instances of this process have been placed on a cell."))
+					})
+				})
+			})
+		})
+
+		When("the app has no instances", func() {
+			BeforeEach(func() {
+				summary = v2v3action.ApplicationSummary{
+					ApplicationSummary: v3action.ApplicationSummary{
+						Application: v3action.Application{
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: v3action.ProcessSummaries{
+							{
+								Process: v3action.Process{
+									Type:              constant.ProcessTypeWeb,
+									MemoryInMB:        types.NullUint64{Value: 32, IsSet: true},
+									DiskInMB:          types.NullUint64{Value: 1024, IsSet: true},
+									LogRateLimitInBPS: types.NullInt{Value: 16384, IsSet: true},
 								},
 							},
 							{
 								Process: v3action.Process{
-									Type:       "console",
-									MemoryInMB: types.NullUint64{Value: 16, IsSet: true},
-									DiskInMB:   types.NullUint64{Value: 512, IsSet: true},
+									Type:              "console",
+									MemoryInMB:        types.NullUint64{Value: 16, IsSet: true},
+									DiskInMB:          types.NullUint64{Value: 512, IsSet: true},
+									LogRateLimitInBPS: types.NullInt{Value: -1, IsSet: true},
 								},
 							},
 						},
@@ -317,11 +407,13 @@ var _ = Describe("app summary displayer", func() {
 				Expect(testUI.Out).To(Say(`type:\s+web`))
 				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
 				Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
+				Expect(testUI.Out).To(Say(`log rate limit:\s+16K/s`))
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
 
 				Expect(testUI.Out).To(Say(`type:\s+console`))
 				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
 				Expect(testUI.Out).To(Say(`memory usage:\s+16M`))
+				Expect(testUI.Out).To(Say(`log rate limit:\s+unlimited`))
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
 			})
 
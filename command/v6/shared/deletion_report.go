@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// JobProgressInterval is how often an unchanged job status is displayed again
+// while waiting for a deletion job.
+const JobProgressInterval = 30 * time.Second
+
+// DisplayDeletionReport displays, space by space, the apps, service instances
+// and routes listed in the report.
+func DisplayDeletionReport(ui command.UI, report v2action.DeletionReport) {
+	for _, space := range report.Spaces {
+		ui.DisplayNewline()
+		ui.DisplayText("space {{.SpaceName}}:", map[string]interface{}{
+			"SpaceName": space.Name,
+		})
+
+		table := [][]string{
+			{ui.TranslateText("apps:"), listOrNone(ui, space.Applications)},
+		}
+
+		if len(space.ServiceInstances) == 0 {
+			table = append(table, []string{ui.TranslateText("service instances:"), ui.TranslateText("none")})
+		}
+		for i, serviceInstance := range space.ServiceInstances {
+			key := ""
+			if i == 0 {
+				key = ui.TranslateText("service instances:")
+			}
+			table = append(table, []string{key, serviceInstanceSummary(ui, serviceInstance)})
+		}
+
+		table = append(table, []string{ui.TranslateText("routes:"), listOrNone(ui, space.Routes)})
+
+		ui.DisplayKeyValueTable("  ", table, 3)
+	}
+	ui.DisplayNewline()
+}
+
+// NewJobProgressDisplay returns a callback for PollJobWithProgress that
+// displays the job status whenever it changes, and again every
+// JobProgressInterval while it stays the same.
+func NewJobProgressDisplay(ui command.UI) func(v2action.JobProgress) {
+	var (
+		lastStatus    constant.JobStatus
+		lastDisplayed time.Duration
+	)
+
+	return func(progress v2action.JobProgress) {
+		if progress.Status == lastStatus && progress.Elapsed-lastDisplayed < JobProgressInterval {
+			return
+		}
+		lastStatus = progress.Status
+		lastDisplayed = progress.Elapsed
+
+		ui.DisplayText("Job {{.Status}} ({{.Elapsed}} elapsed)...", map[string]interface{}{
+			"Status":  string(progress.Status),
+			"Elapsed": progress.Elapsed.Round(time.Second).String(),
+		})
+	}
+}
+
+func serviceInstanceSummary(ui command.UI, serviceInstance v2action.ServiceInstanceDeletionReport) string {
+	details := []string{
+		ui.TranslateText("bound apps: {{.Apps}}", map[string]interface{}{
+			"Apps": listOrNone(ui, serviceInstance.BoundApplications),
+		}),
+	}
+	if len(serviceInstance.ServiceKeys) > 0 {
+		details = append(details, ui.TranslateText("service keys: {{.Keys}}", map[string]interface{}{
+			"Keys": strings.Join(serviceInstance.ServiceKeys, ", "),
+		}))
+	}
+
+	return serviceInstance.Name + " (" + strings.Join(details, "; ") + ")"
+}
+
+func listOrNone(ui command.UI, names []string) string {
+	if len(names) == 0 {
+		return ui.TranslateText("none")
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,20 @@
+package shared
+
+import "code.cloudfoundry.org/cli/command"
+
+// DisplayDeviceAuthorization tells the user where to approve logging in from
+// another device and which code to enter there. When UAA provides a URL with
+// the code already filled in, that URL is shown too so the code does not have
+// to be typed.
+func DisplayDeviceAuthorization(ui command.UI, verificationURI string, userCode string, verificationURIComplete string) {
+	ui.DisplayText("To log in, visit {{.URL}} on another device and enter the code {{.Code}}", map[string]interface{}{
+		"URL":  verificationURI,
+		"Code": userCode,
+	})
+	if verificationURIComplete != "" {
+		ui.DisplayText("Or visit {{.URL}}, which has the code filled in", map[string]interface{}{
+			"URL": verificationURIComplete,
+		})
+	}
+	ui.DisplayText("Waiting for the login request to be approved...")
+}
@@ -0,0 +1,33 @@
+package shared_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayDeviceAuthorization", func() {
+	var testUI *ui.UI
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	It("displays where to enter the code", func() {
+		DisplayDeviceAuthorization(testUI, "https://login.example.com/device", "ABCD-EFGH", "")
+
+		Expect(testUI.Out).To(Say("To log in, visit https://login.example.com/device on another device and enter the code ABCD-EFGH"))
+		Expect(testUI.Out).ToNot(Say("Or visit"))
+	})
+
+	It("also displays the URL with the code filled in, when there is one", func() {
+		DisplayDeviceAuthorization(testUI, "https://login.example.com/device", "ABCD-EFGH", "https://login.example.com/device?user_code=ABCD-EFGH")
+
+		Expect(testUI.Out).To(Say("To log in, visit https://login.example.com/device on another device and enter the code ABCD-EFGH"))
+		Expect(testUI.Out).To(Say(`Or visit https://login.example.com/device\?user_code=ABCD-EFGH, which has the code filled in`))
+		Expect(testUI.Out).To(Say(`Waiting for the login request to be approved\.\.\.`))
+	})
+})
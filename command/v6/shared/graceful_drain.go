@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+const (
+	// RouteUnregistrationTimeout is how long to wait for the router to stop
+	// sending traffic to an app after its routes have been unmapped.
+	RouteUnregistrationTimeout = 2 * time.Minute
+
+	// DrainPeriod is how long to wait for in-flight requests to complete once
+	// the router has stopped sending new ones.
+	DrainPeriod = 10 * time.Second
+)
+
+//go:generate counterfeiter . RouteDrainActor
+
+type RouteDrainActor interface {
+	DrainApplicationRoutes(app v2action.Application, prober v2action.RouteProber, unregistrationTimeout time.Duration, drainPeriod time.Duration) (v2action.Routes, v2action.Warnings, error)
+	MapRoutesToApplication(routes v2action.Routes, appGUID string) (v2action.Warnings, error)
+}
+
+// DrainApplicationRoutes takes the app out of the router before it gets
+// stopped. The unmapped routes are returned so that they can be restored
+// with RestoreApplicationRoutes; if draining fails they are restored before
+// the error is returned.
+func DrainApplicationRoutes(ui command.UI, actor RouteDrainActor, prober v2action.RouteProber, app v2action.Application) (v2action.Routes, error) {
+	ui.DisplayText("Unmapping routes and waiting for the router to stop sending traffic to app {{.AppName}}...",
+		map[string]interface{}{
+			"AppName": app.Name,
+		})
+
+	routes, warnings, err := actor.DrainApplicationRoutes(app, prober, RouteUnregistrationTimeout, DrainPeriod)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		if restoreErr := RestoreApplicationRoutes(ui, actor, app, routes); restoreErr != nil {
+			return nil, restoreErr
+		}
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+// RestoreApplicationRoutes maps the routes removed by DrainApplicationRoutes
+// back to the app.
+func RestoreApplicationRoutes(ui command.UI, actor RouteDrainActor, app v2action.Application, routes v2action.Routes) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	ui.DisplayText("Mapping routes back to app {{.AppName}}...",
+		map[string]interface{}{
+			"AppName": app.Name,
+		})
+
+	warnings, err := actor.MapRoutesToApplication(routes, app.GUID)
+	ui.DisplayWarnings(warnings)
+	return err
+}
@@ -0,0 +1,126 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("graceful drain", func() {
+	var (
+		testUI     *ui.UI
+		fakeActor  *sharedfakes.FakeRouteDrainActor
+		fakeProber *v2actionfakes.FakeRouteProber
+		app        v2action.Application
+		routes     v2action.Routes
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeRouteDrainActor)
+		fakeProber = new(v2actionfakes.FakeRouteProber)
+		app = v2action.Application{GUID: "some-app-guid", Name: "some-app"}
+		routes = v2action.Routes{{GUID: "some-route-guid"}}
+	})
+
+	Describe("DrainApplicationRoutes", func() {
+		var (
+			drainedRoutes v2action.Routes
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			drainedRoutes, executeErr = DrainApplicationRoutes(testUI, fakeActor, fakeProber, app)
+		})
+
+		When("draining succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.DrainApplicationRoutesReturns(routes, v2action.Warnings{"drain-warning"}, nil)
+			})
+
+			It("drains the app with the default timeouts and returns the routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(drainedRoutes).To(Equal(routes))
+
+				Expect(fakeActor.DrainApplicationRoutesCallCount()).To(Equal(1))
+				appArg, proberArg, unregistrationTimeout, drainPeriod := fakeActor.DrainApplicationRoutesArgsForCall(0)
+				Expect(appArg).To(Equal(app))
+				Expect(proberArg).To(Equal(fakeProber))
+				Expect(unregistrationTimeout).To(Equal(RouteUnregistrationTimeout))
+				Expect(drainPeriod).To(Equal(DrainPeriod))
+
+				Expect(testUI.Out).To(Say("Unmapping routes and waiting for the router to stop sending traffic to app some-app..."))
+				Expect(testUI.Err).To(Say("drain-warning"))
+				Expect(fakeActor.MapRoutesToApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("draining fails after unmapping routes", func() {
+			BeforeEach(func() {
+				fakeActor.DrainApplicationRoutesReturns(routes, nil, errors.New("drain-error"))
+				fakeActor.MapRoutesToApplicationReturns(v2action.Warnings{"map-warning"}, nil)
+			})
+
+			It("maps the routes back and returns the error", func() {
+				Expect(executeErr).To(MatchError("drain-error"))
+				Expect(drainedRoutes).To(BeNil())
+
+				Expect(fakeActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+				routesArg, appGUID := fakeActor.MapRoutesToApplicationArgsForCall(0)
+				Expect(routesArg).To(Equal(routes))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(testUI.Out).To(Say("Mapping routes back to app some-app..."))
+				Expect(testUI.Err).To(Say("map-warning"))
+			})
+
+			When("mapping the routes back fails", func() {
+				BeforeEach(func() {
+					fakeActor.MapRoutesToApplicationReturns(nil, errors.New("map-error"))
+				})
+
+				It("returns the mapping error", func() {
+					Expect(executeErr).To(MatchError("map-error"))
+				})
+			})
+		})
+	})
+
+	Describe("RestoreApplicationRoutes", func() {
+		var executeErr error
+
+		JustBeforeEach(func() {
+			executeErr = RestoreApplicationRoutes(testUI, fakeActor, app, routes)
+		})
+
+		When("there are no routes", func() {
+			BeforeEach(func() {
+				routes = nil
+			})
+
+			It("does nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.MapRoutesToApplicationCallCount()).To(Equal(0))
+				Expect(testUI.Out).ToNot(Say("Mapping routes"))
+			})
+		})
+
+		When("mapping fails", func() {
+			BeforeEach(func() {
+				fakeActor.MapRoutesToApplicationReturns(v2action.Warnings{"map-warning"}, errors.New("map-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("map-error"))
+				Expect(testUI.Err).To(Say("map-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . IgnoredResourcesActor
+
+type IgnoredResourcesActor interface {
+	GatherIgnoredArchiveResources(archivePath string) ([]sharedaction.IgnoredResource, error)
+	GatherIgnoredDirectoryResources(sourceDir string) ([]sharedaction.IgnoredResource, error)
+}
+
+// DisplayIgnoredResources lists the files that will not be uploaded for an
+// app, along with the ignore rule that excluded each of them.
+func DisplayIgnoredResources(commandUI command.UI, actor IgnoredResourcesActor, appName string, path string, archive bool) error {
+	var (
+		ignoredResources []sharedaction.IgnoredResource
+		err              error
+	)
+	if archive {
+		ignoredResources, err = actor.GatherIgnoredArchiveResources(path)
+	} else {
+		ignoredResources, err = actor.GatherIgnoredDirectoryResources(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	commandUI.DisplayTextWithFlavor("Files ignored when uploading app {{.AppName}} from {{.Path}}:", map[string]interface{}{
+		"AppName": appName,
+		"Path":    path,
+	})
+
+	if len(ignoredResources) == 0 {
+		commandUI.DisplayText("No files are ignored.")
+		commandUI.DisplayNewline()
+		return nil
+	}
+
+	table := [][]string{
+		{
+			commandUI.TranslateText("file"),
+			commandUI.TranslateText("ignored by"),
+		},
+	}
+	for _, ignoredResource := range ignoredResources {
+		rule := ignoredResource.Rule
+		table = append(table, []string{
+			ignoredResource.Filename,
+			fmt.Sprintf("%s:%d: %s", rule.Source, rule.Line, rule.Pattern),
+		})
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	commandUI.DisplayNewline()
+	return nil
+}
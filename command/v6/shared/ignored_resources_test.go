@@ -0,0 +1,64 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/cfignore"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplayIgnoredResources", func() {
+	var (
+		testUI    *ui.UI
+		fakeActor *sharedfakes.FakeIgnoredResourcesActor
+		archive   bool
+		err       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeIgnoredResourcesActor)
+		archive = false
+	})
+
+	JustBeforeEach(func() {
+		err = DisplayIgnoredResources(testUI, fakeActor, "some-app", "/some/path", archive)
+	})
+
+	When("files are ignored", func() {
+		BeforeEach(func() {
+			fakeActor.GatherIgnoredDirectoryResourcesReturns([]sharedaction.IgnoredResource{
+				{Filename: ".cfignore", Rule: cfignore.Rule{Source: "default ignore rules", Line: 1, Pattern: ".cfignore"}},
+				{Filename: "tmp/", Rule: cfignore.Rule{Source: "/some/path/.cfignore", Line: 4, Pattern: "/tmp/"}},
+			}, nil)
+		})
+
+		It("displays each ignored file and the rule that ignored it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeActor.GatherIgnoredDirectoryResourcesArgsForCall(0)).To(Equal("/some/path"))
+
+			Expect(testUI.Out).To(Say("Files ignored when uploading app some-app from /some/path:"))
+			Expect(testUI.Out).To(Say(`file\s+ignored by`))
+			Expect(testUI.Out).To(Say(`\.cfignore\s+default ignore rules:1: \.cfignore`))
+			Expect(testUI.Out).To(Say(`tmp/\s+/some/path/\.cfignore:4: /tmp/`))
+		})
+	})
+
+	When("the path is an archive and nothing is ignored", func() {
+		BeforeEach(func() {
+			archive = true
+		})
+
+		It("says so", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeActor.GatherIgnoredArchiveResourcesCallCount()).To(Equal(1))
+			Expect(fakeActor.GatherIgnoredDirectoryResourcesCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("No files are ignored."))
+		})
+	})
+})
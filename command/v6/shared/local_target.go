@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . LocalTargetActor
+
+type LocalTargetActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+// LocalTargetDiffers returns whether the org or space named in a project
+// directory's local target file differ from the currently targeted ones.
+func LocalTargetDiffers(config command.Config, target configv3.LocalTarget) bool {
+	if config.TargetedOrganizationName() != target.Organization {
+		return true
+	}
+	return target.Space != "" && config.TargetedSpace().Name != target.Space
+}
+
+// TargetLocalOrgAndSpace targets the org and space named in a project
+// directory's local target file. The space is untargeted when the file only
+// names an org.
+func TargetLocalOrgAndSpace(config command.Config, ui command.UI, actor LocalTargetActor, target configv3.LocalTarget) error {
+	org, warnings, err := actor.GetOrganizationByName(target.Organization)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if target.Space == "" {
+		config.SetOrganizationInformation(org.GUID, org.Name)
+		config.UnsetSpaceInformation()
+		ui.DisplayWarning("Targeting org {{.Org}} from {{.Path}}", map[string]interface{}{
+			"Org":  org.Name,
+			"Path": target.Path,
+		})
+		return nil
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(org.GUID, target.Space)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	config.SetOrganizationInformation(org.GUID, org.Name)
+	config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+	ui.DisplayWarning("Targeting org {{.Org}} and space {{.Space}} from {{.Path}}", map[string]interface{}{
+		"Org":   org.Name,
+		"Space": space.Name,
+		"Path":  target.Path,
+	})
+	return nil
+}
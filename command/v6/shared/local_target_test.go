@@ -0,0 +1,139 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("local target", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *sharedfakes.FakeLocalTargetActor
+		target     configv3.LocalTarget
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(sharedfakes.FakeLocalTargetActor)
+		target = configv3.LocalTarget{
+			Path:         "/some/project/.cf/target",
+			Organization: "some-org",
+			Space:        "some-space",
+		}
+	})
+
+	Describe("LocalTargetDiffers", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationNameReturns("some-org")
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		})
+
+		It("returns false when the org and space are already targeted", func() {
+			Expect(LocalTargetDiffers(fakeConfig, target)).To(BeFalse())
+		})
+
+		It("returns true when the org differs", func() {
+			target.Organization = "other-org"
+			Expect(LocalTargetDiffers(fakeConfig, target)).To(BeTrue())
+		})
+
+		It("returns true when the space differs", func() {
+			target.Space = "other-space"
+			Expect(LocalTargetDiffers(fakeConfig, target)).To(BeTrue())
+		})
+
+		It("ignores the space when the file only names an org", func() {
+			target.Space = ""
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "other-space"})
+			Expect(LocalTargetDiffers(fakeConfig, target)).To(BeFalse())
+		})
+	})
+
+	Describe("TargetLocalOrgAndSpace", func() {
+		var executeErr error
+
+		JustBeforeEach(func() {
+			executeErr = TargetLocalOrgAndSpace(fakeConfig, testUI, fakeActor, target)
+		})
+
+		When("the org and space exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+					v2action.Warnings{"org-warning"}, nil)
+				fakeActor.GetSpaceByOrganizationAndNameReturns(
+					v2action.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true},
+					v2action.Warnings{"space-warning"}, nil)
+			})
+
+			It("targets the org and space and notes the target file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("some-space"))
+
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-org-guid"))
+				Expect(name).To(Equal("some-org"))
+
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+				guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-space-guid"))
+				Expect(name).To(Equal("some-space"))
+				Expect(allowSSH).To(BeTrue())
+
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say(`Targeting org some-org and space some-space from /some/project/\.cf/target`))
+			})
+		})
+
+		When("the file only names an org", func() {
+			BeforeEach(func() {
+				target.Space = ""
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, nil, nil)
+			})
+
+			It("targets the org and untargets the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+				Expect(testUI.Err).To(Say("Targeting org some-org from /some/project/\\.cf/target"))
+			})
+		})
+
+		When("the space cannot be found", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("space not found")
+				fakeActor.GetOrganizationByNameReturns(
+					v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, nil, nil)
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, expectedErr)
+			})
+
+			It("returns the error without changing the target", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package shared
+
+import "code.cloudfoundry.org/cli/util/configv3"
+
+// LogSources returns the sources logs are read from, in the order they are
+// tried, given the configured log source and the Log Cache and Doppler
+// endpoints advertised by the targeted API. In auto mode Log Cache is
+// preferred, with Doppler as the fallback; an endpoint that is not advertised
+// is skipped.
+func LogSources(logSource string, logCacheEndpoint string, dopplerEndpoint string) []string {
+	switch logSource {
+	case configv3.LogSourceLogCache, configv3.LogSourceDoppler:
+		return []string{logSource}
+	}
+
+	var sources []string
+	if logCacheEndpoint != "" {
+		sources = append(sources, configv3.LogSourceLogCache)
+	}
+	if dopplerEndpoint != "" || len(sources) == 0 {
+		sources = append(sources, configv3.LogSourceDoppler)
+	}
+	return sources
+}
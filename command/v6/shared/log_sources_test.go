@@ -0,0 +1,23 @@
+package shared_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSources", func() {
+	DescribeTable("orders the log sources to try",
+		func(logSource string, logCacheEndpoint string, dopplerEndpoint string, expected []string) {
+			Expect(LogSources(logSource, logCacheEndpoint, dopplerEndpoint)).To(Equal(expected))
+		},
+		Entry("auto with both endpoints prefers log cache", configv3.LogSourceAuto, "https://log-cache.example.com", "wss://doppler.example.com", []string{"log-cache", "doppler"}),
+		Entry("auto without log cache uses doppler", configv3.LogSourceAuto, "", "wss://doppler.example.com", []string{"doppler"}),
+		Entry("auto without doppler uses log cache", configv3.LogSourceAuto, "https://log-cache.example.com", "", []string{"log-cache"}),
+		Entry("auto without either endpoint tries doppler", configv3.LogSourceAuto, "", "", []string{"doppler"}),
+		Entry("log-cache is forced", configv3.LogSourceLogCache, "", "wss://doppler.example.com", []string{"log-cache"}),
+		Entry("doppler is forced", configv3.LogSourceDoppler, "https://log-cache.example.com", "wss://doppler.example.com", []string{"doppler"}),
+	)
+})
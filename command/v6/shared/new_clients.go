@@ -6,16 +6,20 @@ import (
 	"code.cloudfoundry.org/cli/api/router"
 	routerWrapper "code.cloudfoundry.org/cli/api/router/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
-	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
-// passed in config.
+// passed in config. The target's endpoints are only discovered once per
+// process.
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
+	if config.Timings() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewTimings(ui.TimingsRecorder()))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -25,14 +29,23 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
+	if header := config.ChangeHeader(); header != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewChangeReason(header, command.ChangeReasonPrompt(config, ui)))
+	}
+
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
 
+	if config.DryRun() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewDryRun(ui.GetOut()))
+	}
+
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
+		UserAgentSuffix:    config.UserAgentSuffix(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 		Wrappers:           ccWrappers,
@@ -48,16 +61,23 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv2.TargetSettings{
+	settings := ccv2.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
-	})
-	if err != nil {
-		return nil, nil, err
+	}
+	key := newTargetKey(config.Target(), config)
+	if info, ok := targets.getV2(key); ok {
+		ccClient.TargetCFWithInfo(settings, info)
+	} else {
+		_, err := ccClient.TargetCF(settings)
+		if err != nil {
+			return nil, nil, err
+		}
+		targets.setV2(key, ccClient.TargetInfo())
 	}
 
-	if err = command.WarnIfAPIVersionBelowSupportedMinimum(ccClient.APIVersion(), ui); err != nil {
+	if err := command.WarnIfAPIVersionBelowSupportedMinimum(ccClient.APIVersion(), ui); err != nil {
 		return nil, nil, err
 	}
 
@@ -65,34 +85,21 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 		return nil, nil, translatableerror.AuthorizationEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
-
-	if verbose {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
-	}
-	if location != nil {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
-	}
-
-	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
-	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
-
-	err = uaaClient.SetupResources(ccClient.AuthorizationEndpoint())
+	uaaClient, err := newUAAClient(config, ui, ccClient.AuthorizationEndpoint())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	uaaAuthWrapper.SetClient(uaaClient)
 	authWrapper.SetClient(uaaClient)
 
-	return ccClient, uaaClient, err
+	return ccClient, uaaClient, nil
 }
 
 func NewRouterClient(config command.Config, ui command.UI, uaaClient *uaa.Client) (*router.Client, error) {
 	routerConfig := router.Config{
-		AppName:    config.BinaryName(),
-		AppVersion: config.BinaryVersion(),
+		AppName:         config.BinaryName(),
+		AppVersion:      config.BinaryVersion(),
+		UserAgentSuffix: config.UserAgentSuffix(),
 		ConnectionConfig: router.ConnectionConfig{
 			DialTimeout:       config.DialTimeout(),
 			SkipSSLValidation: config.SkipSSLValidation(),
@@ -116,6 +123,9 @@ func NewRouterClient(config command.Config, ui command.UI, uaaClient *uaa.Client
 	errorWrapper := routerWrapper.NewErrorWrapper()
 
 	routerWrappers = append(routerWrappers, authWrapper, errorWrapper)
+	if config.DryRun() {
+		routerWrappers = append(routerWrappers, routerWrapper.NewDryRun(ui.GetOut()))
+	}
 	routerConfig.Wrappers = routerWrappers
 
 	routerClient := router.NewClient(routerConfig)
@@ -1,6 +1,7 @@
 package shared_test
 
 import (
+	"fmt"
 	"net/http"
 	"runtime"
 	"time"
@@ -97,6 +98,63 @@ var _ = Describe("New Clients", func() {
 			})
 		})
 
+		When("clients have already been built for the target", func() {
+			var server *Server
+
+			BeforeEach(func() {
+				server = NewTLSServer()
+
+				fakeConfig.TargetReturns(server.URL())
+				fakeConfig.SkipSSLValidationReturns(true)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/info"),
+						RespondWith(http.StatusOK, fmt.Sprintf(`{ "api_version": "2.128.0", "authorization_endpoint": "%s" }`, server.URL())),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/login"),
+						RespondWith(http.StatusOK, fmt.Sprintf(`{ "links": { "uaa": "%s" } }`, server.URL())),
+					),
+				)
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("builds new clients without discovering the endpoints again", func() {
+				ccClient, uaaClient, err := NewClients(fakeConfig, testUI, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				newCCClient, newUAAClient, err := NewClients(fakeConfig, testUI, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newCCClient).ToNot(BeIdenticalTo(ccClient))
+				Expect(newUAAClient).ToNot(BeIdenticalTo(uaaClient))
+				Expect(newCCClient.AuthorizationEndpoint()).To(Equal(server.URL()))
+				Expect(newUAAClient.UAALink()).To(Equal(server.URL()))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+
+			It("wraps the new clients for the config they are built with", func() {
+				_, _, err := NewClients(fakeConfig, testUI, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeConfig.VerboseReturns(true, nil)
+				ccClient, _, err := NewClients(fakeConfig, testUI, true)
+				Expect(err).ToNot(HaveOccurred())
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/info"),
+						RespondWith(http.StatusOK, `{}`),
+					),
+				)
+				_, _, err = ccClient.Info()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("REQUEST:"))
+			})
+		})
+
 		When("not targetting", func() {
 			It("does not target and returns no UAA client", func() {
 				ccClient, uaaClient, err := NewClients(fakeConfig, testUI, false)
@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewLogCacheClient returns back a Log Cache client for the targeted API. Log
+// Cache is expected to be served next to the API, at log-cache.<system
+// domain>.
+func NewLogCacheClient(config command.Config, uaaClient *uaa.Client) *logcache.Client {
+	return NewLogCacheClientForEndpoint(strings.Replace(config.Target(), "://api.", "://log-cache.", 1), config, uaaClient)
+}
+
+// NewLogCacheClientForEndpoint returns back a Log Cache client for the given
+// Log Cache endpoint, such as the one advertised in the API root links.
+func NewLogCacheClientForEndpoint(endpoint string, config command.Config, uaaClient *uaa.Client) *logcache.Client {
+	return logcache.NewClient(logcache.Config{
+		AppName:         config.BinaryName(),
+		AppVersion:      config.BinaryVersion(),
+		UserAgentSuffix: config.UserAgentSuffix(),
+		ConnectionConfig: logcache.ConnectionConfig{
+			DialTimeout:       config.DialTimeout(),
+			SkipSSLValidation: config.SkipSSLValidation(),
+		},
+		Endpoint:       endpoint,
+		TokenCache:     config,
+		TokenRefresher: noaabridge.NewTokenRefresher(uaaClient, config),
+	})
+}
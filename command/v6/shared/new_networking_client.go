@@ -8,12 +8,18 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
-// NewNetworkingClient creates a new cfnetworking client.
+// NewNetworkingClient creates a new cfnetworking client. The client cannot
+// print its requests instead of sending them, so it is not created for a dry
+// run.
 func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*cfnetv1.Client, error) {
 	if apiURL == "" {
 		return nil, translatableerror.CFNetworkingEndpointNotFoundError{}
 	}
 
+	if config.DryRun() {
+		return nil, translatableerror.DryRunNotSupportedError{API: "Network Policy"}
+	}
+
 	wrappers := []cfnetv1.ConnectionWrapper{}
 
 	verbose, location := config.Verbose()
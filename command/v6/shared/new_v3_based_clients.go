@@ -4,16 +4,20 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
-	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
 // NewV3BasedClients creates a new V3 Cloud Controller client and UAA client using the
-// passed in config.
+// passed in config. The target's endpoints are only discovered once per
+// process.
 func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minVersionV3 string) (*ccv3.Client, *uaa.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
 
+	if config.Timings() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewTimings(ui.TimingsRecorder()))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -22,14 +26,23 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minV
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
+	if header := config.ChangeHeader(); header != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewChangeReason(header, command.ChangeReasonPrompt(config, ui)))
+	}
+
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
 	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
 
+	if config.DryRun() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewDryRun(ui.GetOut()))
+	}
+
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
+		UserAgentSuffix:    config.UserAgentSuffix(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 		Wrappers:           ccWrappers,
@@ -45,17 +58,24 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minV
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv3.TargetSettings{
+	settings := ccv3.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
-	})
-	if err != nil {
-		return nil, nil, err
+	}
+	key := newTargetKey(config.Target(), config)
+	if target, ok := targets.getV3(key); ok {
+		ccClient.TargetCFWithInfo(settings, target.info, target.resources)
+	} else {
+		_, err := ccClient.TargetCF(settings)
+		if err != nil {
+			return nil, nil, err
+		}
+		targets.setV3(key, v3Target{info: ccClient.Info, resources: ccClient.Resources()})
 	}
 
 	if minVersionV3 != "" {
-		err = command.MinimumCCAPIVersionCheck(ccClient.CloudControllerAPIVersion(), minVersionV3)
+		err := command.MinimumCCAPIVersionCheck(ccClient.CloudControllerAPIVersion(), minVersionV3)
 		if err != nil {
 			if _, ok := err.(translatableerror.MinimumCFAPIVersionNotMetError); ok {
 				return nil, nil, translatableerror.V3V2SwitchError{}
@@ -68,25 +88,11 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minV
 		return nil, nil, translatableerror.UAAEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
-
-	if verbose {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
-	}
-	if location != nil {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
-	}
-
-	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
-	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
-
-	err = uaaClient.SetupResources(ccClient.UAA())
+	uaaClient, err := newUAAClient(config, ui, ccClient.UAA())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	uaaAuthWrapper.SetClient(uaaClient)
 	authWrapper.SetClient(uaaClient)
 
 	return ccClient, uaaClient, nil
@@ -7,7 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
-func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.LogMessage, logErrs <-chan error, appState <-chan v2action.ApplicationStateChange, apiWarnings <-chan string, apiErrs <-chan error) error {
+func PollStart(ui command.UI, config command.Config, progress *TimeoutProgress, messages <-chan *v2action.LogMessage, logErrs <-chan error, appState <-chan v2action.ApplicationStateChange, apiWarnings <-chan string, apiErrs <-chan error) error {
 	var breakAppState, breakWarnings, breakAPIErrs bool
 	for {
 		select {
@@ -27,14 +27,17 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 
 			switch state {
 			case v2action.ApplicationStateStopping:
+				progress.Step("stopping app")
 				ui.DisplayNewline()
 				ui.DisplayText("Stopping app...")
 
 			case v2action.ApplicationStateStaging:
+				progress.Step("staging app")
 				ui.DisplayNewline()
 				ui.DisplayText("Staging app and tracing logs...")
 
 			case v2action.ApplicationStateStarting:
+				progress.Step("waiting for app to start")
 				ui.DisplayNewline()
 				ui.DisplayText("Waiting for app to start...")
 			}
@@ -68,13 +71,13 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 			case actionerror.StagingFailedNoAppDetectedError:
 				return translatableerror.StagingFailedNoAppDetectedError{BinaryName: config.BinaryName(), Message: err.Error()}
 			case actionerror.StagingTimeoutError:
-				return translatableerror.StagingTimeoutError{AppName: err.AppName, Timeout: err.Timeout}
+				return progress.Wrap(translatableerror.StagingTimeoutError{AppName: err.AppName, Timeout: err.Timeout})
 			case actionerror.ApplicationInstanceCrashedError:
 				return translatableerror.ApplicationUnableToStartError{AppName: err.Name, BinaryName: config.BinaryName()}
 			case actionerror.ApplicationInstanceFlappingError:
 				return translatableerror.ApplicationUnableToStartError{AppName: err.Name, BinaryName: config.BinaryName()}
 			case actionerror.StartupTimeoutError:
-				return translatableerror.StartupTimeoutError{AppName: err.Name, BinaryName: config.BinaryName()}
+				return progress.Wrap(translatableerror.StartupTimeoutError{AppName: err.Name, BinaryName: config.BinaryName()})
 			default:
 				return apiErr
 			}
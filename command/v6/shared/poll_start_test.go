@@ -29,6 +29,7 @@ var _ = Describe("Poll Start", func() {
 		apiErrs     chan error
 		err         error
 		block       chan bool
+		progress    *TimeoutProgress
 	)
 
 	BeforeEach(func() {
@@ -43,12 +44,14 @@ var _ = Describe("Poll Start", func() {
 		apiErrs = make(chan error)
 		block = make(chan bool)
 
+		progress = nil
+
 		err = errors.New("This should never occur.")
 	})
 
 	JustBeforeEach(func() {
 		go func() {
-			err = PollStart(testUI, fakeConfig, messages, logErrs, appState, apiWarnings, apiErrs)
+			err = PollStart(testUI, fakeConfig, progress, messages, logErrs, appState, apiWarnings, apiErrs)
 			close(block)
 		}()
 	})
@@ -106,6 +109,25 @@ var _ = Describe("Poll Start", func() {
 		})
 	})
 
+	When("the command deadline expires", func() {
+		BeforeEach(func() {
+			progress = NewTimeoutProgress(time.Minute, time.Now(), "restarting app")
+		})
+
+		It("reports the progress made before the timeout", func() {
+			appState <- v2action.ApplicationStateStaging
+			appState <- v2action.ApplicationStateStarting
+			apiErrs <- actionerror.StartupTimeoutError{Name: "some-app"}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(translatableerror.CommandTimeoutError{
+				Timeout:    time.Minute,
+				Completed:  []string{"restarting app", "staging app"},
+				InProgress: "waiting for app to start",
+			}))
+		})
+	})
+
 	DescribeTable("API Errors",
 		func(apiErr error, expectedErr error) {
 			apiErrs <- apiErr
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+//go:generate counterfeiter . ProjectHookActor
+
+type ProjectHookActor interface {
+	RunProjectHook(command string, dir string, stdout io.Writer, stderr io.Writer) error
+}
+
+// LoadProject reads the project file in the working directory, returning an
+// empty project when there is none.
+func LoadProject() (configv3.Project, error) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return configv3.Project{}, err
+	}
+
+	project, _, err := configv3.LoadProject(pwd)
+	return project, err
+}
+
+// RunPostPushHooks runs the post-push hooks declared in the project file from
+// the project directory, stopping at the first one that fails.
+func RunPostPushHooks(ui command.UI, actor ProjectHookActor, project configv3.Project) error {
+	for _, hook := range project.PostPush {
+		ui.DisplayNewline()
+		ui.DisplayText("Running post-push hook {{.Command}}...", map[string]interface{}{
+			"Command": hook,
+		})
+
+		err := actor.RunProjectHook(hook, filepath.Dir(project.Path), ui.GetOut(), ui.GetErr())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
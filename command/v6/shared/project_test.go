@@ -0,0 +1,67 @@
+package shared_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RunPostPushHooks", func() {
+	var (
+		testUI    *ui.UI
+		fakeActor *sharedfakes.FakeProjectHookActor
+		project   configv3.Project
+		err       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeProjectHookActor)
+		project = configv3.Project{
+			Path:     "/some/project/cfproject.yml",
+			PostPush: []string{"./smoke-test.sh", "echo done"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		err = RunPostPushHooks(testUI, fakeActor, project)
+	})
+
+	It("runs each hook from the project directory", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeActor.RunProjectHookCallCount()).To(Equal(2))
+
+		command, dir, stdout, stderr := fakeActor.RunProjectHookArgsForCall(0)
+		Expect(command).To(Equal("./smoke-test.sh"))
+		Expect(dir).To(Equal("/some/project"))
+		Expect(stdout).To(Equal(testUI.Out))
+		Expect(stderr).To(Equal(testUI.Err))
+
+		command, _, _, _ = fakeActor.RunProjectHookArgsForCall(1)
+		Expect(command).To(Equal("echo done"))
+
+		Expect(testUI.Out).To(Say(`Running post-push hook \./smoke-test\.sh\.\.\.`))
+		Expect(testUI.Out).To(Say(`Running post-push hook echo done\.\.\.`))
+	})
+
+	When("a hook fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("hook failed")
+			fakeActor.RunProjectHookReturns(expectedErr)
+		})
+
+		It("stops and returns the error", func() {
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeActor.RunProjectHookCallCount()).To(Equal(1))
+		})
+	})
+})
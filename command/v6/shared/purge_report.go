@@ -0,0 +1,206 @@
+package shared
+
+import (
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// PurgeReport is the structured result of purge-service-instance and
+// purge-service-offering: what the purge orphaned and what operators still
+// have to clean up by hand.
+type PurgeReport struct {
+	ServiceOffering  string               `json:"service_offering,omitempty"`
+	ServiceBroker    string               `json:"service_broker,omitempty"`
+	ServiceInstances []PurgedInstance     `json:"service_instances"`
+	Checklist        []PurgeChecklistItem `json:"cleanup_checklist"`
+}
+
+// PurgedInstance lists the bindings, keys and shares removed with a service
+// instance.
+type PurgedInstance struct {
+	Name        string        `json:"name"`
+	BoundApps   []string      `json:"bound_apps"`
+	ServiceKeys []string      `json:"service_keys"`
+	SharedTo    []PurgedShare `json:"shared_to"`
+}
+
+// PurgedShare is a space a purged service instance was shared to.
+type PurgedShare struct {
+	Organization string `json:"org"`
+	Space        string `json:"space"`
+}
+
+// PurgeChecklistItem is a cleanup step left after a purge.
+type PurgeChecklistItem struct {
+	Action      string `json:"action"`
+	Target      string `json:"target"`
+	Description string `json:"description"`
+}
+
+// NewPurgeReport builds the report and cleanup checklist for purging the
+// given service instances. serviceOffering and serviceBroker are empty when
+// a single service instance is purged.
+func NewPurgeReport(ui command.UI, serviceOffering string, serviceBroker string, impacts []v2action.ServiceInstancePurgeImpact) PurgeReport {
+	report := PurgeReport{
+		ServiceOffering:  serviceOffering,
+		ServiceBroker:    serviceBroker,
+		ServiceInstances: []PurgedInstance{},
+		Checklist:        []PurgeChecklistItem{},
+	}
+
+	for _, impact := range impacts {
+		instance := PurgedInstance{
+			Name:        impact.ServiceInstance.Name,
+			BoundApps:   impact.BoundApps,
+			ServiceKeys: impact.ServiceKeys,
+			SharedTo:    []PurgedShare{},
+		}
+		for _, sharedTo := range impact.SharedTo {
+			instance.SharedTo = append(instance.SharedTo, PurgedShare{
+				Organization: sharedTo.OrganizationName,
+				Space:        sharedTo.SpaceName,
+			})
+		}
+		report.ServiceInstances = append(report.ServiceInstances, instance)
+		report.Checklist = append(report.Checklist, instanceChecklist(ui, instance)...)
+	}
+
+	if serviceOffering != "" {
+		target := serviceBroker
+		description := ui.TranslateText("Run 'cf delete-service-broker' or 'cf delete-service-auth-token' for the broker of service offering {{.ServiceOffering}} so the offering is not registered again", map[string]interface{}{
+			"ServiceOffering": serviceOffering,
+		})
+		if serviceBroker != "" {
+			description = ui.TranslateText("Run 'cf delete-service-broker {{.ServiceBroker}}' so service offering {{.ServiceOffering}} is not registered again", map[string]interface{}{
+				"ServiceBroker":   serviceBroker,
+				"ServiceOffering": serviceOffering,
+			})
+		}
+		report.Checklist = append(report.Checklist, PurgeChecklistItem{
+			Action:      "delete-service-broker",
+			Target:      target,
+			Description: description,
+		})
+	}
+
+	return report
+}
+
+// DisplayPurgeImpact displays the bindings, keys and shares of each service
+// instance in the report that the purge will orphan.
+func DisplayPurgeImpact(ui command.UI, report PurgeReport) {
+	if len(report.ServiceInstances) == 0 {
+		ui.DisplayText("No service instances will be orphaned.")
+		return
+	}
+
+	ui.DisplayText("The following will be orphaned:")
+	for _, instance := range report.ServiceInstances {
+		shares := []string{}
+		for _, share := range instance.SharedTo {
+			shares = append(shares, share.Organization+"/"+share.Space)
+		}
+
+		ui.DisplayNewline()
+		ui.DisplayText("service instance {{.ServiceInstance}}:", map[string]interface{}{
+			"ServiceInstance": instance.Name,
+		})
+		ui.DisplayKeyValueTable("  ", [][]string{
+			{ui.TranslateText("bound apps:"), listOrNone(ui, instance.BoundApps)},
+			{ui.TranslateText("service keys:"), listOrNone(ui, instance.ServiceKeys)},
+			{ui.TranslateText("shared to:"), listOrNone(ui, shares)},
+		}, 3)
+	}
+	ui.DisplayNewline()
+}
+
+// DisplayPurgeChecklist displays the cleanup steps left after a purge.
+func DisplayPurgeChecklist(ui command.UI, report PurgeReport) {
+	if len(report.Checklist) == 0 {
+		return
+	}
+
+	ui.DisplayNewline()
+	ui.DisplayText("Cleanup checklist:")
+	for _, item := range report.Checklist {
+		ui.DisplayText("  - {{.Description}}", map[string]interface{}{
+			"Description": item.Description,
+		})
+	}
+}
+
+// DisplayPurgeReportJSON displays the report as a JSON document.
+func DisplayPurgeReportJSON(ui command.UI, report PurgeReport) error {
+	raw, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayText("{{.Document}}", map[string]interface{}{
+		"Document": string(raw),
+	})
+	return nil
+}
+
+// ConfirmPurge asks the user to type name to confirm a purge, and reports
+// whether they typed it exactly.
+func ConfirmPurge(ui command.UI, name string) (bool, error) {
+	typed, err := ui.DisplayTextPrompt("Type '{{.Name}}' to confirm the purge", map[string]interface{}{
+		"Name": name,
+	})
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(typed) == name, nil
+}
+
+func instanceChecklist(ui command.UI, instance PurgedInstance) []PurgeChecklistItem {
+	checklist := []PurgeChecklistItem{}
+
+	for _, app := range instance.BoundApps {
+		checklist = append(checklist, PurgeChecklistItem{
+			Action: "restage-app",
+			Target: app,
+			Description: ui.TranslateText("Run 'cf restage {{.AppName}}' and check it no longer depends on service instance {{.ServiceInstance}}", map[string]interface{}{
+				"AppName":         app,
+				"ServiceInstance": instance.Name,
+			}),
+		})
+	}
+
+	for _, key := range instance.ServiceKeys {
+		checklist = append(checklist, PurgeChecklistItem{
+			Action: "revoke-service-key",
+			Target: key,
+			Description: ui.TranslateText("Revoke the credentials of service key {{.ServiceKey}} of service instance {{.ServiceInstance}} with the service provider", map[string]interface{}{
+				"ServiceKey":      key,
+				"ServiceInstance": instance.Name,
+			}),
+		})
+	}
+
+	for _, share := range instance.SharedTo {
+		checklist = append(checklist, PurgeChecklistItem{
+			Action: "notify-shared-space",
+			Target: share.Organization + "/" + share.Space,
+			Description: ui.TranslateText("Tell the developers of org {{.OrgName}} space {{.SpaceName}} that shared service instance {{.ServiceInstance}} was removed", map[string]interface{}{
+				"OrgName":         share.Organization,
+				"SpaceName":       share.Space,
+				"ServiceInstance": instance.Name,
+			}),
+		})
+	}
+
+	checklist = append(checklist, PurgeChecklistItem{
+		Action: "deprovision-service-instance",
+		Target: instance.Name,
+		Description: ui.TranslateText("Deprovision any resources the service provider still holds for service instance {{.ServiceInstance}}", map[string]interface{}{
+			"ServiceInstance": instance.Name,
+		}),
+	})
+
+	return checklist
+}
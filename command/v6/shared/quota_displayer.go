@@ -0,0 +1,159 @@
+package shared
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// Quota is the displayed form of an organization or space quota. Nil limits
+// are unlimited.
+type Quota struct {
+	Name                         string `json:"name"`
+	GUID                         string `json:"guid"`
+	TotalMemoryInMB              *int   `json:"total_memory_in_mb"`
+	InstanceMemoryInMB           *int   `json:"instance_memory_in_mb"`
+	TotalInstances               *int   `json:"total_instances"`
+	PerAppTasks                  *int   `json:"per_app_tasks"`
+	LogRateLimitInBytesPerSecond *int   `json:"log_rate_limit_in_bytes_per_second"`
+	PaidServicePlans             bool   `json:"paid_service_plans"`
+	TotalServiceInstances        *int   `json:"total_service_instances"`
+	TotalRoutes                  *int   `json:"total_routes"`
+	TotalReservedPorts           *int   `json:"total_reserved_ports"`
+}
+
+// NewQuota converts the name, GUID and limits of a quota into a Quota.
+func NewQuota(name string, guid string, limits v3action.QuotaLimits) Quota {
+	return Quota{
+		Name:                         name,
+		GUID:                         guid,
+		TotalMemoryInMB:              limitValue(limits.Apps.TotalMemory),
+		InstanceMemoryInMB:           limitValue(limits.Apps.InstanceMemory),
+		TotalInstances:               limitValue(limits.Apps.TotalInstances),
+		PerAppTasks:                  limitValue(limits.Apps.PerAppTasks),
+		LogRateLimitInBytesPerSecond: limitValue(limits.Apps.LogRateLimit),
+		PaidServicePlans:             limits.Services.PaidServicePlans != nil && *limits.Services.PaidServicePlans,
+		TotalServiceInstances:        limitValue(limits.Services.TotalServiceInstances),
+		TotalRoutes:                  limitValue(limits.Routes.TotalRoutes),
+		TotalReservedPorts:           limitValue(limits.Routes.TotalReservedPorts),
+	}
+}
+
+// QuotaDisplayer displays quotas as tables or JSON documents.
+type QuotaDisplayer struct {
+	ui               command.UI
+	showLogRateLimit bool
+}
+
+// NewQuotaDisplayer returns a QuotaDisplayer. The log rate limit is left out
+// of tables unless showLogRateLimit is set, for targets that do not support
+// it.
+func NewQuotaDisplayer(ui command.UI, showLogRateLimit bool) QuotaDisplayer {
+	return QuotaDisplayer{ui: ui, showLogRateLimit: showLogRateLimit}
+}
+
+// DisplayQuotasTable displays one row per quota.
+func (display QuotaDisplayer) DisplayQuotasTable(quotas []Quota) {
+	table := [][]string{
+		{
+			display.ui.TranslateText("name"),
+			display.ui.TranslateText("total memory"),
+			display.ui.TranslateText("instance memory"),
+			display.ui.TranslateText("routes"),
+			display.ui.TranslateText("service instances"),
+			display.ui.TranslateText("paid plans"),
+			display.ui.TranslateText("app instances"),
+			display.ui.TranslateText("route ports"),
+			display.ui.TranslateText("app tasks"),
+		},
+	}
+	if display.showLogRateLimit {
+		table[0] = append(table[0], display.ui.TranslateText("log rate limit"))
+	}
+
+	for _, quota := range quotas {
+		row := []string{
+			quota.Name,
+			display.megabytes(quota.TotalMemoryInMB),
+			display.megabytes(quota.InstanceMemoryInMB),
+			display.count(quota.TotalRoutes),
+			display.count(quota.TotalServiceInstances),
+			display.allowed(quota.PaidServicePlans),
+			display.count(quota.TotalInstances),
+			display.count(quota.TotalReservedPorts),
+			display.count(quota.PerAppTasks),
+		}
+		if display.showLogRateLimit {
+			row = append(row, display.bytesPerSecond(quota.LogRateLimitInBytesPerSecond))
+		}
+		table = append(table, row)
+	}
+
+	display.ui.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// DisplayQuotaTable displays the limits of a single quota.
+func (display QuotaDisplayer) DisplayQuotaTable(quota Quota) {
+	table := [][]string{
+		{display.ui.TranslateText("Total Memory"), display.megabytes(quota.TotalMemoryInMB)},
+		{display.ui.TranslateText("Instance Memory"), display.megabytes(quota.InstanceMemoryInMB)},
+		{display.ui.TranslateText("Routes"), display.count(quota.TotalRoutes)},
+		{display.ui.TranslateText("Services"), display.count(quota.TotalServiceInstances)},
+		{display.ui.TranslateText("Paid service plans"), display.allowed(quota.PaidServicePlans)},
+		{display.ui.TranslateText("App instance limit"), display.count(quota.TotalInstances)},
+		{display.ui.TranslateText("Reserved Route Ports"), display.count(quota.TotalReservedPorts)},
+		{display.ui.TranslateText("App task limit"), display.count(quota.PerAppTasks)},
+	}
+	if display.showLogRateLimit {
+		table = append(table, []string{display.ui.TranslateText("Log rate limit"), display.bytesPerSecond(quota.LogRateLimitInBytesPerSecond)})
+	}
+
+	display.ui.DisplayKeyValueTable("", table, 3)
+}
+
+func (display QuotaDisplayer) allowed(allowed bool) string {
+	if allowed {
+		return display.ui.TranslateText("allowed")
+	}
+	return display.ui.TranslateText("disallowed")
+}
+
+func (display QuotaDisplayer) bytesPerSecond(value *int) string {
+	if value == nil {
+		return display.ui.TranslateText("unlimited")
+	}
+	if *value == 0 {
+		return "0"
+	}
+	return bytefmt.ByteSize(uint64(*value)) + "/s"
+}
+
+func (display QuotaDisplayer) count(value *int) string {
+	if value == nil {
+		return display.ui.TranslateText("unlimited")
+	}
+	return strconv.Itoa(*value)
+}
+
+func (display QuotaDisplayer) megabytes(value *int) string {
+	if value == nil {
+		return display.ui.TranslateText("unlimited")
+	}
+	if *value == 0 {
+		return "0"
+	}
+	return bytefmt.ByteSize(uint64(*value) * bytefmt.MEGABYTE)
+}
+
+func limitValue(limit *types.NullInt) *int {
+	if limit == nil || !limit.IsSet {
+		return nil
+	}
+
+	value := limit.Value
+	return &value
+}
@@ -0,0 +1,24 @@
+package shared
+
+import "code.cloudfoundry.org/cli/types"
+
+// QuotaLimit converts a quota limit flag into a quota limit. A flag that was
+// not provided leaves the limit unchanged, and -1 removes the limit.
+func QuotaLimit(flagLimit types.NullInt) *types.NullInt {
+	if !flagLimit.IsSet {
+		return nil
+	}
+	if flagLimit.Value == -1 {
+		return &types.NullInt{}
+	}
+	return &types.NullInt{IsSet: true, Value: flagLimit.Value}
+}
+
+// PaidServicePlansLimit converts the paid service plan flags into a quota
+// limit. Neither flag being provided leaves the limit unchanged.
+func PaidServicePlansLimit(allow bool, disallow bool) *bool {
+	if !allow && !disallow {
+		return nil
+	}
+	return &allow
+}
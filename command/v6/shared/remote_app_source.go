@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/download"
+)
+
+//go:generate counterfeiter . RemoteAppSourceActor
+
+type RemoteAppSourceActor interface {
+	FetchRemoteAppSource(source string, destDir string, downloader sharedaction.Downloader) (string, error)
+}
+
+// FetchRemoteAppSource downloads the app source when the provided path is an
+// archive or git URL and returns the local path to push from. The returned
+// cleanup function removes the downloaded files and is always safe to call.
+// Local paths are returned unchanged.
+func FetchRemoteAppSource(commandUI command.UI, actor RemoteAppSourceActor, source string) (string, func(), error) {
+	noCleanup := func() {}
+	if !sharedaction.IsRemoteAppSource(source) {
+		return source, noCleanup, nil
+	}
+
+	tmpDirPath, err := ioutil.TempDir("", "app-source-")
+	if err != nil {
+		return "", noCleanup, err
+	}
+	cleanup := func() { _ = os.RemoveAll(tmpDirPath) }
+
+	commandUI.DisplayTextWithFlavor("Downloading app source from {{.Source}}...", map[string]interface{}{
+		"Source": source,
+	})
+
+	path, err := actor.FetchRemoteAppSource(source, tmpDirPath, download.NewDownloader(time.Second*30))
+	if err != nil {
+		cleanup()
+		return "", noCleanup, err
+	}
+
+	commandUI.DisplayNewline()
+	return path, cleanup, nil
+}
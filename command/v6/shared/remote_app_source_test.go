@@ -0,0 +1,99 @@
+package shared_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("FetchRemoteAppSource", func() {
+	var (
+		testUI    *ui.UI
+		fakeActor *sharedfakes.FakeRemoteAppSourceActor
+		source    string
+		path      string
+		cleanup   func()
+		err       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(sharedfakes.FakeRemoteAppSourceActor)
+	})
+
+	JustBeforeEach(func() {
+		path, cleanup, err = FetchRemoteAppSource(testUI, fakeActor, source)
+	})
+
+	AfterEach(func() {
+		cleanup()
+	})
+
+	When("the source is a local path", func() {
+		BeforeEach(func() {
+			source = "some/local/path"
+		})
+
+		It("returns the path without fetching anything", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal("some/local/path"))
+			Expect(fakeActor.FetchRemoteAppSourceCallCount()).To(Equal(0))
+			Expect(testUI.Out).ToNot(Say("Downloading"))
+		})
+	})
+
+	When("the source is a URL", func() {
+		var destDir string
+
+		BeforeEach(func() {
+			source = "https://example.com/org/repo/archive/main.tar.gz"
+			fakeActor.FetchRemoteAppSourceStub = func(_ string, dir string, _ sharedaction.Downloader) (string, error) {
+				destDir = dir
+				return filepath.Join(dir, "app"), nil
+			}
+		})
+
+		It("fetches the source into a temporary directory", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Downloading app source from https://example\.com/org/repo/archive/main\.tar\.gz\.\.\.`))
+
+			Expect(fakeActor.FetchRemoteAppSourceCallCount()).To(Equal(1))
+			sourceArg, _, downloader := fakeActor.FetchRemoteAppSourceArgsForCall(0)
+			Expect(sourceArg).To(Equal(source))
+			Expect(downloader).ToNot(BeNil())
+
+			Expect(path).To(Equal(filepath.Join(destDir, "app")))
+			Expect(destDir).To(BeADirectory())
+		})
+
+		It("returns a cleanup function that removes the temporary directory", func() {
+			cleanup()
+			_, statErr := os.Stat(destDir)
+			Expect(os.IsNotExist(statErr)).To(BeTrue())
+		})
+
+		When("fetching the source fails", func() {
+			BeforeEach(func() {
+				fakeActor.FetchRemoteAppSourceStub = func(_ string, dir string, _ sharedaction.Downloader) (string, error) {
+					destDir = dir
+					return "", errors.New("some-error")
+				}
+			})
+
+			It("returns the error and removes the temporary directory", func() {
+				Expect(err).To(MatchError("some-error"))
+				_, statErr := os.Stat(destDir)
+				Expect(os.IsNotExist(statErr)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/uaa"
+	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// targetKey identifies the endpoint that was discovered.
+type targetKey struct {
+	url               string
+	skipSSLValidation bool
+}
+
+// v3Target is what a V3 client discovers from the targeted Cloud Controller.
+type v3Target struct {
+	info      ccv3.Info
+	resources map[string]string
+}
+
+// targetCache holds what was discovered from the targeted endpoints during the
+// life of the process, so that a command which needs more than one client, or
+// rebuilds one, only discovers them once. Clients themselves are built anew
+// every time, with the wrappers of the config and UI they are built with.
+type targetCache struct {
+	mutex sync.Mutex
+	ccV2  map[targetKey]ccv2.APIInformation
+	ccV3  map[targetKey]v3Target
+	uaa   map[targetKey]uaa.Info
+}
+
+var targets = &targetCache{
+	ccV2: map[targetKey]ccv2.APIInformation{},
+	ccV3: map[targetKey]v3Target{},
+	uaa:  map[targetKey]uaa.Info{},
+}
+
+func newTargetKey(url string, config command.Config) targetKey {
+	return targetKey{
+		url:               url,
+		skipSSLValidation: config.SkipSSLValidation(),
+	}
+}
+
+func (cache *targetCache) getV2(key targetKey) (ccv2.APIInformation, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	info, ok := cache.ccV2[key]
+	return info, ok
+}
+
+func (cache *targetCache) setV2(key targetKey, info ccv2.APIInformation) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.ccV2[key] = info
+}
+
+func (cache *targetCache) getV3(key targetKey) (v3Target, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	target, ok := cache.ccV3[key]
+	return target, ok
+}
+
+func (cache *targetCache) setV3(key targetKey, target v3Target) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.ccV3[key] = target
+}
+
+func (cache *targetCache) getUAA(key targetKey) (uaa.Info, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	info, ok := cache.uaa[key]
+	return info, ok
+}
+
+func (cache *targetCache) setUAA(key targetKey, info uaa.Info) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.uaa[key] = info
+}
+
+// newUAAClient returns a UAA client set up against the given bootstrap URL,
+// reusing what was already discovered from that URL if anything was.
+func newUAAClient(config command.Config, ui command.UI, bootstrapURL string) (*uaa.Client, error) {
+	uaaClient := uaa.NewClient(config)
+
+	if config.Timings() {
+		uaaClient.WrapConnection(uaaWrapper.NewTimings(ui.TimingsRecorder()))
+	}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
+	uaaClient.WrapConnection(uaaAuthWrapper)
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+
+	if config.DryRun() {
+		uaaClient.WrapConnection(uaaWrapper.NewDryRun(ui.GetOut()))
+	}
+
+	key := newTargetKey(bootstrapURL, config)
+	if info, ok := targets.getUAA(key); ok {
+		uaaClient.SetupResourcesWithInfo(bootstrapURL, info)
+	} else {
+		err := uaaClient.SetupResources(bootstrapURL)
+		if err != nil {
+			return nil, err
+		}
+		targets.setUAA(key, uaaClient.Info)
+	}
+
+	uaaAuthWrapper.SetClient(uaaClient)
+
+	return uaaClient, nil
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// TimeoutProgress records the steps of a command run with --timeout so that,
+// when the command deadline expires, the user is told how far the command got
+// instead of seeing the timeout of whichever step happened to be running. A
+// nil *TimeoutProgress records nothing and returns errors unchanged.
+type TimeoutProgress struct {
+	timeout   time.Duration
+	deadline  time.Time
+	completed []string
+	current   string
+}
+
+// NewTimeoutProgress returns a TimeoutProgress for a command that must finish
+// by deadline. It returns nil when no timeout was requested.
+func NewTimeoutProgress(timeout time.Duration, deadline time.Time, firstStep string) *TimeoutProgress {
+	if timeout == 0 {
+		return nil
+	}
+	return &TimeoutProgress{timeout: timeout, deadline: deadline, current: firstStep}
+}
+
+// Step marks the current step as completed and begins the next one.
+func (progress *TimeoutProgress) Step(description string) {
+	if progress == nil {
+		return
+	}
+	if progress.current != "" {
+		progress.completed = append(progress.completed, progress.current)
+	}
+	progress.current = description
+}
+
+// Wrap converts timeout errors returned after the deadline into a
+// CommandTimeoutError describing the progress made. Other errors, and timeouts
+// of individual steps that expired before the deadline, are returned as is.
+func (progress *TimeoutProgress) Wrap(err error) error {
+	if progress == nil || err == nil || !isTimeoutError(err) || time.Now().Before(progress.deadline) {
+		return err
+	}
+
+	return translatableerror.CommandTimeoutError{
+		Timeout:    progress.timeout,
+		Completed:  progress.completed,
+		InProgress: progress.current,
+	}
+}
+
+func isTimeoutError(err error) bool {
+	switch err.(type) {
+	case actionerror.StagingTimeoutError,
+		actionerror.StartupTimeoutError,
+		actionerror.ServiceInstanceOperationTimeoutError,
+		ccerror.JobTimeoutError,
+		translatableerror.StagingTimeoutError,
+		translatableerror.StartupTimeoutError,
+		translatableerror.JobTimeoutError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TimeoutProgress", func() {
+	var (
+		progress *TimeoutProgress
+		deadline time.Time
+	)
+
+	BeforeEach(func() {
+		deadline = time.Now().Add(-time.Second)
+	})
+
+	JustBeforeEach(func() {
+		progress = NewTimeoutProgress(time.Minute, deadline, "stopping app")
+		progress.Step("staging app")
+		progress.Step("waiting for app to start")
+	})
+
+	When("a timeout error is returned after the deadline", func() {
+		It("reports the progress made", func() {
+			Expect(progress.Wrap(actionerror.StartupTimeoutError{Name: "some-app"})).To(MatchError(translatableerror.CommandTimeoutError{
+				Timeout:    time.Minute,
+				Completed:  []string{"stopping app", "staging app"},
+				InProgress: "waiting for app to start",
+			}))
+			Expect(progress.Wrap(ccerror.JobTimeoutError{JobGUID: "some-job"})).To(BeAssignableToTypeOf(translatableerror.CommandTimeoutError{}))
+		})
+	})
+
+	When("a timeout error is returned before the deadline", func() {
+		BeforeEach(func() {
+			deadline = time.Now().Add(time.Hour)
+		})
+
+		It("returns the error", func() {
+			Expect(progress.Wrap(actionerror.StartupTimeoutError{Name: "some-app"})).To(MatchError(actionerror.StartupTimeoutError{Name: "some-app"}))
+		})
+	})
+
+	When("another error is returned", func() {
+		It("returns the error", func() {
+			Expect(progress.Wrap(errors.New("some-error"))).To(MatchError("some-error"))
+			Expect(progress.Wrap(nil)).ToNot(HaveOccurred())
+		})
+	})
+
+	When("no timeout was requested", func() {
+		It("records nothing and returns errors as is", func() {
+			noProgress := NewTimeoutProgress(0, time.Time{}, "stopping app")
+			Expect(noProgress).To(BeNil())
+			noProgress.Step("staging app")
+			Expect(noProgress.Wrap(actionerror.StartupTimeoutError{Name: "some-app"})).To(MatchError(actionerror.StartupTimeoutError{Name: "some-app"}))
+		})
+	})
+})
@@ -1,20 +1,87 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . SpaceQuotaActor
+
+type SpaceQuotaActor interface {
+	CloudControllerAPIVersion() string
+	GetSpaceQuotaByName(name string, orgGUID string) (v3action.SpaceQuota, v3action.Warnings, error)
+}
+
 type SpaceQuotaCommand struct {
-	RequiredArgs flag.SpaceQuota `positional-args:"yes"`
-	usage        interface{}     `usage:"CF_NAME space-quota SPACE_QUOTA_NAME"`
+	RequiredArgs flag.SpaceQuota   `positional-args:"yes"`
+	Output       flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Template     string            `long:"template" description:"Format the output with a Go template applied to the JSON output"`
+	Query        string            `long:"query" description:"Narrow down the JSON output with a JMESPath expression"`
+	usage        interface{}       `usage:"CF_NAME space-quota SPACE_QUOTA_NAME [--output json | --template TEMPLATE] [--query EXPRESSION]"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpaceQuotaActor
 }
 
-func (SpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
 	return nil
 }
 
-func (SpaceQuotaCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd SpaceQuotaCommand) Execute(args []string) error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output != "json" && cmd.Template == "" && cmd.Query == "" {
+		cmd.UI.DisplayTextWithFlavor("Getting space quota {{.QuotaName}} info as {{.CurrentUser}}...", map[string]interface{}{
+			"QuotaName":   cmd.RequiredArgs.SpaceQuota,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	quota, warnings, err := cmd.Actor.GetSpaceQuotaByName(cmd.RequiredArgs.SpaceQuota, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	displayQuota := shared.NewQuota(quota.Name, quota.GUID, v3action.QuotaLimits(quota.QuotaLimits))
+	displayer := shared.NewQuotaDisplayer(cmd.UI, gate.Supports(ccversion.MinVersionLogRateLimitV3))
+	if cmd.Output == "json" || cmd.Template != "" || cmd.Query != "" {
+		return command.DisplayDocument(cmd.UI, displayQuota, cmd.Query, cmd.Template)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	displayer.DisplayQuotaTable(displayQuota)
+	return nil
 }
@@ -1,9 +1,14 @@
 package v6
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -12,6 +17,14 @@ import (
 
 type SpacesActor interface {
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetUserSpaceRoles(userGUID string, orgGUID string) (v2action.UserRoles, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . SpacesActorV3
+
+type SpacesActorV3 interface {
+	CloudControllerAPIVersion() string
+	GetSpaceOwnersByOrganization(orgGUID string) (map[string]v3action.Owner, v3action.Warnings, error)
 }
 
 type SpacesCommand struct {
@@ -22,6 +35,7 @@ type SpacesCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       SpacesActor
+	ActorV3     SpacesActorV3
 }
 
 func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
@@ -35,6 +49,15 @@ func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, nil, config)
 
+	ccClientV3, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
 	return nil
 }
 
@@ -63,17 +86,51 @@ func (cmd SpacesCommand) Execute([]string) error {
 
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
-	} else {
-		cmd.displaySpaces(spaces)
+		return nil
+	}
+
+	roles, warnings, err := cmd.Actor.GetUserSpaceRoles(user.GUID, cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
 	}
 
+	owners, err := cmd.spaceOwners()
+	if err != nil {
+		return err
+	}
+
+	cmd.displaySpaces(spaces, roles, owners)
+
 	return nil
 }
 
-func (cmd SpacesCommand) displaySpaces(spaces []v2action.Space) {
-	table := [][]string{{cmd.UI.TranslateText("name")}}
+// spaceOwners returns the ownership of the spaces in the targeted org, or
+// nil when the API cannot provide it.
+func (cmd SpacesCommand) spaceOwners() (map[string]v3action.Owner, error) {
+	if cmd.ActorV3 == nil || !command.NewAPIVersionGate(cmd.ActorV3.CloudControllerAPIVersion).Supports(ccversion.MinVersionMetadataV3) {
+		return nil, nil
+	}
+
+	owners, warnings, err := cmd.ActorV3.GetSpaceOwnersByOrganization(cmd.Config.TargetedOrganization().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	return owners, err
+}
+
+func (cmd SpacesCommand) displaySpaces(spaces []v2action.Space, roles v2action.UserRoles, owners map[string]v3action.Owner) {
+	header := []string{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("roles")}
+	if len(owners) > 0 {
+		header = append(header, cmd.UI.TranslateText("owner"), cmd.UI.TranslateText("team"), cmd.UI.TranslateText("contact"))
+	}
+
+	table := [][]string{header}
 	for _, space := range spaces {
-		table = append(table, []string{space.Name})
+		row := []string{space.Name, strings.Join(roles[space.GUID], ", ")}
+		if len(owners) > 0 {
+			owner := owners[space.GUID]
+			row = append(row, owner.Owner, owner.Team, owner.Contact)
+		}
+		table = append(table, row)
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
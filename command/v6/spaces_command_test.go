@@ -5,6 +5,8 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
@@ -83,7 +85,7 @@ var _ = Describe("spaces Command", func() {
 		When("getting the current user succeeds", func() {
 			BeforeEach(func() {
 				fakeConfig.CurrentUserReturns(
-					configv3.User{Name: "some-user"},
+					configv3.User{Name: "some-user", GUID: "some-user-guid"},
 					nil)
 			})
 
@@ -113,26 +115,86 @@ var _ = Describe("spaces Command", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationSpacesReturns(
 						[]v2action.Space{
-							{Name: "space-1"},
-							{Name: "space-2"},
+							{GUID: "space-guid-1", Name: "space-1"},
+							{GUID: "space-guid-2", Name: "space-2"},
 						},
 						v2action.Warnings{"get-spaces-warning"},
 						nil)
+					fakeActor.GetUserSpaceRolesReturns(
+						v2action.UserRoles{"space-guid-2": {"developer"}},
+						v2action.Warnings{"get-roles-warning"},
+						nil)
 				})
 
-				It("displays all the spaces in the org", func() {
+				It("displays all the spaces in the org with the user's roles in each", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 
 					Expect(testUI.Out).To(Say(`Getting spaces in org some-org as some-user\.\.\.`))
 					Expect(testUI.Out).To(Say(""))
-					Expect(testUI.Out).To(Say("name"))
-					Expect(testUI.Out).To(Say("space-1"))
-					Expect(testUI.Out).To(Say("space-2"))
+					Expect(testUI.Out).To(Say(`name\s+roles`))
+					Expect(testUI.Out).To(Say(`space-1\s*\n`))
+					Expect(testUI.Out).To(Say(`space-2\s+developer`))
 
 					Expect(testUI.Err).To(Say("get-spaces-warning"))
+					Expect(testUI.Err).To(Say("get-roles-warning"))
 
 					Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
 					Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+
+					Expect(fakeActor.GetUserSpaceRolesCallCount()).To(Equal(1))
+					userGUID, orgGUID := fakeActor.GetUserSpaceRolesArgsForCall(0)
+					Expect(userGUID).To(Equal("some-user-guid"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+				})
+
+				When("the v3 API supports metadata and the spaces have owners", func() {
+					BeforeEach(func() {
+						fakeActorV3 := new(v6fakes.FakeSpacesActorV3)
+						fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+						fakeActorV3.GetSpaceOwnersByOrganizationReturns(
+							map[string]v3action.Owner{"space-guid-1": {Owner: "jane", Team: "payments", Contact: "payments-oncall"}},
+							v3action.Warnings{"get-owners-warning"},
+							nil)
+						cmd.ActorV3 = fakeActorV3
+					})
+
+					It("displays the owner columns", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`name\s+roles\s+owner\s+team\s+contact`))
+						Expect(testUI.Out).To(Say(`space-1\s+jane\s+payments\s+payments-oncall`))
+						Expect(testUI.Out).To(Say(`space-2\s+developer\s*\n`))
+						Expect(testUI.Err).To(Say("get-owners-warning"))
+					})
+				})
+
+				When("the v3 API does not support metadata", func() {
+					var fakeActorV3 *v6fakes.FakeSpacesActorV3
+
+					BeforeEach(func() {
+						fakeActorV3 = new(v6fakes.FakeSpacesActorV3)
+						fakeActorV3.CloudControllerAPIVersionReturns("3.65.0")
+						cmd.ActorV3 = fakeActorV3
+					})
+
+					It("does not look up or display owners", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("owner"))
+						Expect(fakeActorV3.GetSpaceOwnersByOrganizationCallCount()).To(Equal(0))
+					})
+				})
+
+				When("getting the user's roles fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetUserSpaceRolesReturns(nil, v2action.Warnings{"get-roles-warning"}, errors.New("get-roles-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("get-roles-error"))
+
+						Expect(testUI.Err).To(Say("get-spaces-warning"))
+						Expect(testUI.Err).To(Say("get-roles-warning"))
+					})
 				})
 			})
 
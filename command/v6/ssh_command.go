@@ -7,17 +7,20 @@ import (
 )
 
 type SSHCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
-	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
-	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
-	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
-	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]"`
-	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+	OptionalArgs        flag.ProjectAppName `positional-args:"yes"`
+	AppInstanceIndex    int                 `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	Command             string              `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	DisablePseudoTTY    bool                `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY      bool                `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	IdentityFile        flag.Path           `long:"identity-file" description:"Authenticate with the private key in this file, registered with the platform, instead of a one-time passcode. A certificate in the file with a '-cert.pub' suffix is used if present"`
+	LocalPort           string              `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	Record              flag.Path           `long:"record" description:"Record the terminal session to this file in asciicast format, with timing, for replay with asciinema"`
+	RemotePseudoTTY     bool                `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation  bool                `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	SkipRemoteExecution bool                `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+	usage               interface{}         `usage:"CF_NAME ssh [APP_NAME] [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--identity-file PATH] [--record FILE]\n\n   A key registered with the platform also lets standard ssh tooling, for example with ProxyJump, reach the instance as user cf:APP_GUID/INDEX on the app SSH endpoint."`
+	examples            interface{}         `examples:"CF_NAME ssh my-app # Open a shell in the first instance\nCF_NAME ssh my-app -i 1 # Open a shell in the instance at index 1\nCF_NAME ssh my-app -c \"ls -la\" # Run a command and exit\nCF_NAME ssh my-app -N -L 9999:localhost:8080 # Forward local port 9999 to port 8080 in the instance\nCF_NAME ssh my-app --identity-file ~/.ssh/id_ed25519 # Authenticate with a registered key\nCF_NAME ssh my-app --record session.cast # Record the session for auditing"`
+	relatedCommands     interface{}         `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 }
 
 func (SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -0,0 +1,130 @@
+package v6
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . StagingEnvActor
+
+type StagingEnvActor interface {
+	GetStagingEnvironmentByApplicationNameAndSpace(appName string, spaceGUID string) ([]v3action.StagingEnvironmentVariable, v3action.Warnings, error)
+}
+
+type StagingEnvCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	ShowCredentials bool         `long:"show-credentials" description:"Include the service credentials in VCAP_SERVICES instead of redacting them"`
+	usage           interface{}  `usage:"CF_NAME staging-env APP_NAME [--show-credentials]\n\n   Shows the environment the next staging of the app will see. App variables override the staging environment variable group, and lifecycle variables set by the platform override both."`
+	relatedCommands interface{}  `related_commands:"env, restage, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       StagingEnvActor
+}
+
+func (cmd *StagingEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd StagingEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting staging environment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	variables, warnings, err := cmd.Actor.GetStagingEnvironmentByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(variables) == 0 {
+		cmd.UI.DisplayText("No staging env variables have been set")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("value"),
+			cmd.UI.TranslateText("source"),
+		},
+	}
+	for _, variable := range variables {
+		value, err := cmd.formatValue(variable)
+		if err != nil {
+			return err
+		}
+		table = append(table, []string{variable.Name, value, cmd.formatSource(variable)})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd StagingEnvCommand) formatValue(variable v3action.StagingEnvironmentVariable) (string, error) {
+	value := variable.Value
+	if variable.Name == "VCAP_SERVICES" && !cmd.ShowCredentials {
+		value = redactServiceCredentials(value)
+	}
+
+	if text, ok := value.(string); ok {
+		return text, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func (cmd StagingEnvCommand) formatSource(variable v3action.StagingEnvironmentVariable) string {
+	source := cmd.UI.TranslateText(string(variable.Source))
+	if len(variable.Overrides) == 0 {
+		return source
+	}
+
+	var overridden []string
+	for _, override := range variable.Overrides {
+		overridden = append(overridden, cmd.UI.TranslateText(string(override)))
+	}
+	return fmt.Sprintf("%s (%s)", source, cmd.UI.TranslateText("overrides {{.Sources}}", map[string]interface{}{
+		"Sources": strings.Join(overridden, ", "),
+	}))
+}
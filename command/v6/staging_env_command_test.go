@@ -0,0 +1,147 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("staging-env Command", func() {
+	var (
+		cmd             StagingEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeStagingEnvActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeStagingEnvActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = StagingEnvCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		})
+
+		When("getting the staging environment fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetStagingEnvironmentByApplicationNameAndSpaceReturns(nil, v3action.Warnings{"env-warning"}, errors.New("env-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("env-error"))
+				Expect(testUI.Err).To(Say("env-warning"))
+			})
+		})
+
+		When("the staging environment is empty", func() {
+			BeforeEach(func() {
+				fakeActor.GetStagingEnvironmentByApplicationNameAndSpaceReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No staging env variables have been set"))
+			})
+		})
+
+		When("the staging environment has variables", func() {
+			BeforeEach(func() {
+				fakeActor.GetStagingEnvironmentByApplicationNameAndSpaceReturns(
+					[]v3action.StagingEnvironmentVariable{
+						{Name: "CF_STACK", Value: "cflinuxfs3", Source: v3action.StagingEnvironmentLifecycleSource},
+						{Name: "GROUP_ONLY", Value: "group-value", Source: v3action.StagingEnvironmentGroupSource},
+						{Name: "SHADOWED", Value: "app-value", Source: v3action.StagingEnvironmentAppSource, Overrides: []v3action.StagingEnvironmentSource{v3action.StagingEnvironmentGroupSource}},
+						{Name: "VCAP_SERVICES", Value: map[string]interface{}{
+							"p-mysql": []interface{}{
+								map[string]interface{}{
+									"name":        "some-db",
+									"credentials": map[string]interface{}{"password": "hunter2"},
+								},
+							},
+						}, Source: v3action.StagingEnvironmentLifecycleSource},
+					},
+					v3action.Warnings{"env-warning"},
+					nil,
+				)
+			})
+
+			It("displays the resolved variables and where they come from", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting staging environment for app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Err).To(Say("env-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`name\s+value\s+source`))
+				Expect(testUI.Out).To(Say(`CF_STACK\s+cflinuxfs3\s+lifecycle`))
+				Expect(testUI.Out).To(Say(`GROUP_ONLY\s+group-value\s+staging group`))
+				Expect(testUI.Out).To(Say(`SHADOWED\s+app-value\s+app \(overrides staging group\)`))
+				Expect(testUI.Out).To(Say(`VCAP_SERVICES\s+.*"password":"\[REDACTED\]"`))
+
+				appName, spaceGUID := fakeActor.GetStagingEnvironmentByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			When("--show-credentials is provided", func() {
+				BeforeEach(func() {
+					cmd.ShowCredentials = true
+				})
+
+				It("displays the service credentials", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`VCAP_SERVICES\s+.*"password":"hunter2"`))
+				})
+			})
+		})
+	})
+})
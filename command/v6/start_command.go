@@ -24,7 +24,8 @@ type StartActor interface {
 
 type StartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME start APP_NAME"`
+	Timeout             flag.Timeout `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes"`
+	usage               interface{}  `usage:"CF_NAME start APP_NAME [--timeout TIMEOUT]"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{}  `related_commands:"apps, logs, scale, ssh, stop, restart, run-task"`
@@ -40,6 +41,9 @@ type StartCommand struct {
 func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
 
@@ -97,8 +101,9 @@ func (cmd StartCommand) Execute(args []string) error {
 		return nil
 	}
 
+	progress := shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "starting app")
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.StartApplication(app, cmd.NOAAClient)
-	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	err = shared.PollStart(cmd.UI, cmd.Config, progress, messages, logErrs, appState, apiWarnings, errs)
 	if err != nil {
 		return err
 	}
@@ -485,6 +485,20 @@ var _ = Describe("Start Command", func() {
 						It("stops logging and returns StartupTimeoutError", func() {
 							Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: appName, BinaryName: "faceman"}))
 						})
+
+						When("the --timeout deadline has passed", func() {
+							BeforeEach(func() {
+								cmd.Timeout.Value = time.Minute
+								fakeConfig.CommandDeadlineReturns(time.Now())
+							})
+
+							It("returns a CommandTimeoutError describing the progress made", func() {
+								Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+									Timeout:    time.Minute,
+									InProgress: "starting app",
+								}))
+							})
+						})
 					})
 				})
 
@@ -0,0 +1,140 @@
+package v6
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/usagestats"
+)
+
+// clearUsageStatsEndpoint is the --export-endpoint value that removes the
+// saved endpoint.
+const clearUsageStatsEndpoint = "CLEAR"
+
+//go:generate counterfeiter . UsageStatsStore
+
+type UsageStatsStore interface {
+	Clear() error
+	Records() ([]usagestats.Record, error)
+}
+
+type StatsCommand struct {
+	Enable          bool        `long:"enable" description:"Start recording the name, duration and error type of each command run on this machine"`
+	Disable         bool        `long:"disable" description:"Stop recording command usage"`
+	Clear           bool        `long:"clear" description:"Delete all recorded command usage"`
+	ExportEndpoint  string      `long:"export-endpoint" description:"Periodically send batches of recorded usage to this URL. If URL is 'CLEAR', the endpoint is removed."`
+	usage           interface{} `usage:"CF_NAME stats [--enable | --disable] [--clear] [--export-endpoint (URL | CLEAR)]\n\n   Command usage is only recorded after running 'CF_NAME stats --enable'. Arguments, flag values and error messages are never recorded."`
+	relatedCommands interface{} `related_commands:"config"`
+	envCFEndpoint   interface{} `environmentName:"CF_USAGE_STATS_ENDPOINT" environmentDescription:"Send batches of recorded usage to this URL instead of the endpoint set with --export-endpoint"`
+
+	UI     command.UI
+	Config command.Config
+	Store  UsageStatsStore
+}
+
+func (cmd *StatsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Store = usagestats.NewStore(configv3.UsageStatsFilePath())
+	return nil
+}
+
+func (cmd StatsCommand) Execute(args []string) error {
+	if cmd.Enable && cmd.Disable {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--enable", "--disable"},
+		}
+	}
+
+	if !cmd.Enable && !cmd.Disable && !cmd.Clear && cmd.ExportEndpoint == "" {
+		return cmd.displayStats()
+	}
+
+	if cmd.Enable {
+		cmd.Config.SetUsageStatsEnabled(true)
+		cmd.UI.DisplayText("Command usage will be recorded on this machine.")
+	}
+
+	if cmd.Disable {
+		cmd.Config.SetUsageStatsEnabled(false)
+		cmd.UI.DisplayText("Command usage will no longer be recorded.")
+	}
+
+	if cmd.ExportEndpoint == clearUsageStatsEndpoint {
+		cmd.Config.SetUsageStatsEndpoint("")
+		cmd.UI.DisplayText("Recorded usage will only be kept on this machine.")
+	} else if cmd.ExportEndpoint != "" {
+		cmd.Config.SetUsageStatsEndpoint(cmd.ExportEndpoint)
+		cmd.UI.DisplayText("Recorded usage will be sent to {{.Endpoint}} in batches.", map[string]interface{}{
+			"Endpoint": cmd.ExportEndpoint,
+		})
+	}
+
+	if cmd.Clear {
+		err := cmd.Store.Clear()
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText("Recorded command usage deleted.")
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd StatsCommand) displayStats() error {
+	status := cmd.UI.TranslateText("disabled")
+	if cmd.Config.UsageStatsEnabled() {
+		status = cmd.UI.TranslateText("enabled")
+	}
+	endpoint := cmd.Config.UsageStatsEndpoint()
+	if endpoint == "" {
+		endpoint = cmd.UI.TranslateText("none")
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("recording:"), status},
+		{cmd.UI.TranslateText("export endpoint:"), endpoint},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	records, err := cmd.Store.Records()
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		cmd.UI.DisplayText("No command usage has been recorded.")
+		if !cmd.Config.UsageStatsEnabled() {
+			cmd.UI.DisplayText("TIP: Use '{{.Command}}' to start recording command usage.", map[string]interface{}{
+				"Command": cmd.Config.BinaryName() + " stats --enable",
+			})
+		}
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("command"),
+		cmd.UI.TranslateText("runs"),
+		cmd.UI.TranslateText("errors"),
+		cmd.UI.TranslateText("average duration"),
+		cmd.UI.TranslateText("last run"),
+	}}
+
+	for _, summary := range usagestats.Summarize(records) {
+		table = append(table, []string{
+			summary.Command,
+			strconv.Itoa(summary.Runs),
+			strconv.Itoa(summary.Failures),
+			summary.AverageDuration.Round(time.Millisecond).String(),
+			cmd.UI.UserFriendlyDate(summary.LastRun),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
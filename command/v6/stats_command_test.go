@@ -0,0 +1,176 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/usagestats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stats Command", func() {
+	var (
+		cmd        StatsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeStore  *v6fakes.FakeUsageStatsStore
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeStore = new(v6fakes.FakeUsageStatsStore)
+
+		cmd = StatsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Store:  fakeStore,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no flags are provided", func() {
+		When("nothing has been recorded", func() {
+			It("displays the settings and a tip to enable recording", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`recording:\s+disabled`))
+				Expect(testUI.Out).To(Say(`export endpoint:\s+none`))
+				Expect(testUI.Out).To(Say("No command usage has been recorded."))
+				Expect(testUI.Out).To(Say(`TIP: Use 'faceman stats --enable' to start recording command usage.`))
+			})
+		})
+
+		When("commands have been recorded", func() {
+			BeforeEach(func() {
+				fakeConfig.UsageStatsEnabledReturns(true)
+				fakeConfig.UsageStatsEndpointReturns("https://stats.example.com")
+				fakeStore.RecordsReturns([]usagestats.Record{
+					{Command: "push", Timestamp: time.Now(), DurationMS: 1500},
+					{Command: "apps", Timestamp: time.Now(), DurationMS: 200},
+					{Command: "push", Timestamp: time.Now(), DurationMS: 2500, ErrorClass: "actionerror.StagingTimeoutError"},
+				}, nil)
+			})
+
+			It("displays the settings and a summary per command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`recording:\s+enabled`))
+				Expect(testUI.Out).To(Say(`export endpoint:\s+https://stats.example.com`))
+				Expect(testUI.Out).To(Say(`command\s+runs\s+errors\s+average duration\s+last run`))
+				Expect(testUI.Out).To(Say(`push\s+2\s+1\s+2s\s+`))
+				Expect(testUI.Out).To(Say(`apps\s+1\s+0\s+200ms\s+`))
+			})
+		})
+
+		When("the records cannot be read", func() {
+			BeforeEach(func() {
+				fakeStore.RecordsReturns(nil, errors.New("permission denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("permission denied"))
+			})
+		})
+	})
+
+	When("--enable and --disable are both provided", func() {
+		BeforeEach(func() {
+			cmd.Enable = true
+			cmd.Disable = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--enable", "--disable"},
+			}))
+			Expect(fakeConfig.SetUsageStatsEnabledCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--enable is provided", func() {
+		BeforeEach(func() {
+			cmd.Enable = true
+		})
+
+		It("turns recording on", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetUsageStatsEnabledCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUsageStatsEnabledArgsForCall(0)).To(BeTrue())
+			Expect(testUI.Out).To(Say("Command usage will be recorded on this machine."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeStore.RecordsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--disable and --clear are provided", func() {
+		BeforeEach(func() {
+			cmd.Disable = true
+			cmd.Clear = true
+		})
+
+		It("turns recording off and deletes the records", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetUsageStatsEnabledCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUsageStatsEnabledArgsForCall(0)).To(BeFalse())
+			Expect(fakeStore.ClearCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("Command usage will no longer be recorded."))
+			Expect(testUI.Out).To(Say("Recorded command usage deleted."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("clearing fails", func() {
+			BeforeEach(func() {
+				fakeStore.ClearReturns(errors.New("permission denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("permission denied"))
+			})
+		})
+	})
+
+	When("--export-endpoint is provided", func() {
+		BeforeEach(func() {
+			cmd.ExportEndpoint = "https://stats.example.com"
+		})
+
+		It("saves the endpoint", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetUsageStatsEndpointCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUsageStatsEndpointArgsForCall(0)).To(Equal("https://stats.example.com"))
+			Expect(testUI.Out).To(Say("Recorded usage will be sent to https://stats.example.com in batches."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("the endpoint is CLEAR", func() {
+			BeforeEach(func() {
+				cmd.ExportEndpoint = "CLEAR"
+			})
+
+			It("removes the endpoint", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.SetUsageStatsEndpointCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUsageStatsEndpointArgsForCall(0)).To(BeEmpty())
+				Expect(testUI.Out).To(Say("Recorded usage will only be kept on this machine."))
+			})
+		})
+	})
+})
@@ -0,0 +1,120 @@
+package v6
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+// Status is the target and session information displayed by status.
+type Status struct {
+	APIEndpoint    string     `json:"api_endpoint"`
+	APIVersion     string     `json:"api_version"`
+	User           string     `json:"user"`
+	TokenExpiresAt *time.Time `json:"token_expires_at"`
+	Org            string     `json:"org"`
+	Space          string     `json:"space"`
+	Proxy          string     `json:"proxy"`
+	PluginCount    int        `json:"plugin_count"`
+}
+
+type StatusCommand struct {
+	Output          flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Template        string            `long:"template" description:"Format the output with a Go template applied to the JSON output"`
+	Query           string            `long:"query" description:"Narrow down the JSON output with a JMESPath expression"`
+	usage           interface{}       `usage:"CF_NAME status [--output json | --template TEMPLATE] [--query EXPRESSION]\n\n   Displays the API endpoint, user, access token expiry, targeted org and space, proxy and number of installed plugins from the local configuration, without contacting the API."`
+	examples        interface{}       `examples:"CF_NAME status\nCF_NAME status --output json"`
+	relatedCommands interface{}       `related_commands:"api, login, plugins, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *StatusCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd StatusCommand) Execute(args []string) error {
+	status, err := cmd.status()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "json" || cmd.Template != "" || cmd.Query != "" {
+		return command.DisplayDocument(cmd.UI, status, cmd.Query, cmd.Template)
+	}
+
+	cmd.displayStatusTable(status)
+	return nil
+}
+
+func (cmd StatusCommand) status() (Status, error) {
+	user, err := cmd.Config.CurrentUserName()
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{
+		APIEndpoint: strings.TrimRight(cmd.Config.Target(), "/"),
+		APIVersion:  cmd.Config.APIVersion(),
+		User:        user,
+		Org:         cmd.Config.TargetedOrganization().Name,
+		Space:       cmd.Config.TargetedSpace().Name,
+		Proxy:       cmd.Config.HTTPSProxy(),
+		PluginCount: len(cmd.Config.Plugins()),
+	}
+
+	expiry, err := cmd.Config.AccessTokenExpiry()
+	if err != nil {
+		return Status{}, err
+	}
+	if !expiry.IsZero() {
+		expiry = expiry.UTC()
+		status.TokenExpiresAt = &expiry
+	}
+
+	return status, nil
+}
+
+func (cmd StatusCommand) displayStatusTable(status Status) {
+	apiEndpoint := cmd.UI.TranslateText("none")
+	if status.APIEndpoint != "" {
+		apiEndpoint = cmd.UI.TranslateText("{{.APIEndpoint}} (API version: {{.APIVersion}})", map[string]interface{}{
+			"APIEndpoint": status.APIEndpoint,
+			"APIVersion":  status.APIVersion,
+		})
+	}
+
+	tokenExpiry := cmd.UI.TranslateText("none")
+	if status.TokenExpiresAt != nil {
+		tokenExpiry = status.TokenExpiresAt.Local().Format(time.RFC1123)
+		if status.TokenExpiresAt.Before(time.Now()) {
+			tokenExpiry = cmd.UI.TranslateText("{{.Expiry}} (expired)", map[string]interface{}{
+				"Expiry": tokenExpiry,
+			})
+		}
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), apiEndpoint},
+		{cmd.UI.TranslateText("User:"), cmd.valueOr(status.User, "not logged in")},
+		{cmd.UI.TranslateText("Token expires:"), tokenExpiry},
+		{cmd.UI.TranslateText("Org:"), cmd.valueOr(status.Org, "none")},
+		{cmd.UI.TranslateText("Space:"), cmd.valueOr(status.Space, "none")},
+		{cmd.UI.TranslateText("Proxy:"), cmd.valueOr(status.Proxy, "none")},
+		{cmd.UI.TranslateText("Plugins:"), cmd.UI.TranslateText("{{.Count}} installed", map[string]interface{}{
+			"Count": status.PluginCount,
+		})},
+	}, 3)
+}
+
+func (cmd StatusCommand) valueOr(value string, fallback string) string {
+	if value == "" {
+		return cmd.UI.TranslateText(fallback)
+	}
+	return value
+}
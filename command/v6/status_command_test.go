@@ -0,0 +1,137 @@
+package v6_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("status Command", func() {
+	var (
+		cmd        StatusCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		expiry     time.Time
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = StatusCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Output: "table",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the CLI is targeted and logged in", func() {
+		BeforeEach(func() {
+			expiry = time.Now().Add(time.Hour)
+
+			fakeConfig.TargetReturns("https://api.example.com/")
+			fakeConfig.APIVersionReturns("2.128.0")
+			fakeConfig.CurrentUserNameReturns("some-user", nil)
+			fakeConfig.AccessTokenExpiryReturns(expiry, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+			fakeConfig.HTTPSProxyReturns("http://proxy.example.com:8080")
+			fakeConfig.PluginsReturns([]configv3.Plugin{{Name: "plugin-1"}, {Name: "plugin-2"}})
+		})
+
+		It("displays the status table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api\.example\.com \(API version: 2\.128\.0\)`))
+			Expect(testUI.Out).To(Say(`User:\s+some-user`))
+			Expect(testUI.Out).To(Say(`Token expires:\s+%s`, expiry.Local().Format(time.RFC1123)))
+			Expect(testUI.Out).To(Say(`Org:\s+some-org`))
+			Expect(testUI.Out).To(Say(`Space:\s+some-space`))
+			Expect(testUI.Out).To(Say(`Proxy:\s+http://proxy\.example\.com:8080`))
+			Expect(testUI.Out).To(Say(`Plugins:\s+2 installed`))
+		})
+
+		When("the output is json", func() {
+			BeforeEach(func() {
+				cmd.Output = "json"
+			})
+
+			It("displays the status as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var status map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &status)).To(Succeed())
+				Expect(status).To(Equal(map[string]interface{}{
+					"api_endpoint":     "https://api.example.com",
+					"api_version":      "2.128.0",
+					"user":             "some-user",
+					"token_expires_at": expiry.UTC().Format(time.RFC3339Nano),
+					"org":              "some-org",
+					"space":            "some-space",
+					"proxy":            "http://proxy.example.com:8080",
+					"plugin_count":     float64(2),
+				}))
+			})
+		})
+
+		When("the access token has expired", func() {
+			BeforeEach(func() {
+				expiry = time.Now().Add(-time.Hour)
+				fakeConfig.AccessTokenExpiryReturns(expiry, nil)
+			})
+
+			It("displays the token as expired", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Token expires:\s+%s \(expired\)`, expiry.Local().Format(time.RFC1123)))
+			})
+		})
+	})
+
+	When("the CLI is not targeted or logged in", func() {
+		It("displays none for the missing information", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`API endpoint:\s+none`))
+			Expect(testUI.Out).To(Say(`User:\s+not logged in`))
+			Expect(testUI.Out).To(Say(`Token expires:\s+none`))
+			Expect(testUI.Out).To(Say(`Org:\s+none`))
+			Expect(testUI.Out).To(Say(`Space:\s+none`))
+			Expect(testUI.Out).To(Say(`Proxy:\s+none`))
+			Expect(testUI.Out).To(Say(`Plugins:\s+0 installed`))
+		})
+
+		When("the output is json", func() {
+			BeforeEach(func() {
+				cmd.Output = "json"
+			})
+
+			It("displays the token expiry as null", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"token_expires_at": null`))
+			})
+		})
+	})
+
+	When("the access token cannot be decoded", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenExpiryReturns(time.Time{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
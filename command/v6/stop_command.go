@@ -1,21 +1,113 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/routeprobe"
 )
 
+//go:generate counterfeiter . StopActor
+
+type StopActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+}
+
 type StopCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME stop APP_NAME"`
+	Graceful        bool         `long:"graceful" description:"Unmap the app's routes and wait for in-flight requests to drain before stopping it, then map them back"`
+	usage           interface{}  `usage:"CF_NAME stop APP_NAME [--graceful]"`
 	relatedCommands interface{}  `related_commands:"restart, scale, start"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           StopActor
+	RouteDrainActor shared.RouteDrainActor
+	RouteProber     v2action.RouteProber
 }
 
-func (StopCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *StopCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.Graceful {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v2Actor := v2action.NewActor(ccClient, uaaClient, config)
+	cmd.Actor = v2Actor
+	cmd.RouteDrainActor = v2Actor
+	cmd.RouteProber = routeprobe.NewProber(config.SkipSSLValidation(), config.DialTimeout())
+
 	return nil
 }
 
-func (StopCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd StopCommand) Execute(args []string) error {
+	if !cmd.Graceful {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if app.Stopped() {
+		cmd.UI.DisplayText("App {{.AppName}} is already stopped",
+			map[string]interface{}{
+				"AppName": cmd.RequiredArgs.AppName,
+			})
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	drainedRoutes, err := shared.DrainApplicationRoutes(cmd.UI, cmd.RouteDrainActor, cmd.RouteProber, app)
+	if err != nil {
+		return err
+	}
+
+	_, warnings, err = cmd.Actor.UpdateApplication(v2action.Application{
+		GUID:  app.GUID,
+		State: constant.ApplicationStopped,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	restoreErr := shared.RestoreApplicationRoutes(cmd.UI, cmd.RouteDrainActor, app, drainedRoutes)
+	if err != nil {
+		return err
+	}
+	if restoreErr != nil {
+		return restoreErr
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
 }
@@ -0,0 +1,176 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stop Command", func() {
+	var (
+		cmd                 StopCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v6fakes.FakeStopActor
+		fakeRouteDrainActor *sharedfakes.FakeRouteDrainActor
+		fakeRouteProber     *v2actionfakes.FakeRouteProber
+		routes              v2action.Routes
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeStopActor)
+		fakeRouteDrainActor = new(sharedfakes.FakeRouteDrainActor)
+		fakeRouteProber = new(v2actionfakes.FakeRouteProber)
+
+		cmd = StopCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Graceful:     true,
+
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			RouteDrainActor: fakeRouteDrainActor,
+			RouteProber:     fakeRouteProber,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", State: constant.ApplicationStarted},
+			v2action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-app-warning"}, nil)
+
+		routes = v2action.Routes{{GUID: "some-route-guid"}}
+		fakeRouteDrainActor.DrainApplicationRoutesReturns(routes, nil, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the --graceful flag is not provided", func() {
+		BeforeEach(func() {
+			cmd.Graceful = false
+		})
+
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the app is already stopped", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", State: constant.ApplicationStopped},
+				nil,
+				nil,
+			)
+		})
+
+		It("does not drain or stop the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is already stopped"))
+			Expect(fakeRouteDrainActor.DrainApplicationRoutesCallCount()).To(Equal(0))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	It("drains the routes, stops the app and maps the routes back", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Stopping app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`Unmapping routes and waiting for the router to stop sending traffic to app some-app\.\.\.`))
+		Expect(testUI.Out).To(Say(`Mapping routes back to app some-app\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("update-app-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeRouteDrainActor.DrainApplicationRoutesCallCount()).To(Equal(1))
+		app, prober, _, _ := fakeRouteDrainActor.DrainApplicationRoutesArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(prober).To(Equal(fakeRouteProber))
+
+		Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+		Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+			GUID:  "some-app-guid",
+			State: constant.ApplicationStopped,
+		}))
+
+		Expect(fakeRouteDrainActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+		mappedRoutes, appGUID := fakeRouteDrainActor.MapRoutesToApplicationArgsForCall(0)
+		Expect(mappedRoutes).To(Equal(routes))
+		Expect(appGUID).To(Equal("some-app-guid"))
+	})
+
+	When("draining fails", func() {
+		BeforeEach(func() {
+			fakeRouteDrainActor.DrainApplicationRoutesReturns(nil, nil, errors.New("drain-error"))
+		})
+
+		It("does not stop the app", func() {
+			Expect(executeErr).To(MatchError("drain-error"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("stopping the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationReturns(v2action.Application{}, nil, errors.New("update-error"))
+		})
+
+		It("maps the routes back and returns the error", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(fakeRouteDrainActor.MapRoutesToApplicationCallCount()).To(Equal(1))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	When("mapping the routes back fails", func() {
+		BeforeEach(func() {
+			fakeRouteDrainActor.MapRoutesToApplicationReturns(nil, errors.New("map-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("map-error"))
+		})
+	})
+})
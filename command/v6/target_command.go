@@ -2,7 +2,10 @@ package v6
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
@@ -16,6 +19,9 @@ type TargetActor interface {
 	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetOrganizationNameSuggestions(orgName string) ([]string, v2action.Warnings, error)
+	GetSpaceNameSuggestions(orgGUID string, spaceName string) ([]string, v2action.Warnings, error)
+	UserHasSpaceRole(userGUID string, orgGUID string, spaceGUID string) (bool, v2action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
@@ -23,6 +29,7 @@ type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
 	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+	examples        interface{} `examples:"CF_NAME target # Show the targeted API, org and space\nCF_NAME target -o my-org -s my-space # Target an org and space\nCF_NAME target -s other-space # Switch spaces within the targeted org"`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
 	UI          command.UI
@@ -64,7 +71,7 @@ func (cmd *TargetCommand) Execute(args []string) error {
 
 	switch {
 	case cmd.Organization != "" && cmd.Space != "":
-		err = cmd.setOrgAndSpace()
+		err = cmd.setOrgAndSpace(user)
 		if err != nil {
 			cmd.clearTargets()
 			return err
@@ -75,13 +82,13 @@ func (cmd *TargetCommand) Execute(args []string) error {
 			cmd.clearTargets()
 			return err
 		}
-		err = cmd.autoTargetSpace(cmd.Config.TargetedOrganization().GUID)
+		err = cmd.autoTargetSpace(user, cmd.Config.TargetedOrganization().GUID)
 		if err != nil {
 			cmd.clearTargets()
 			return err
 		}
 	case cmd.Space != "":
-		err = cmd.setSpace()
+		err = cmd.setSpace(user)
 		if err != nil {
 			cmd.clearTargets()
 			return err
@@ -117,15 +124,18 @@ func (cmd TargetCommand) clearTargets() {
 }
 
 // setOrgAndSpace sets organization and space
-func (cmd *TargetCommand) setOrgAndSpace() error {
-	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
-	cmd.UI.DisplayWarnings(warnings)
+func (cmd *TargetCommand) setOrgAndSpace(user configv3.User) error {
+	org, err := cmd.getOrganization()
 	if err != nil {
 		return err
 	}
 
-	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
-	cmd.UI.DisplayWarnings(warnings)
+	space, err := cmd.getSpace(org.GUID)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.checkSpaceRole(user, org.GUID, cmd.Organization, space)
 	if err != nil {
 		return err
 	}
@@ -138,8 +148,7 @@ func (cmd *TargetCommand) setOrgAndSpace() error {
 
 // setOrg sets organization
 func (cmd *TargetCommand) setOrg() error {
-	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
-	cmd.UI.DisplayWarnings(warnings)
+	org, err := cmd.getOrganization()
 	if err != nil {
 		return err
 	}
@@ -150,9 +159,9 @@ func (cmd *TargetCommand) setOrg() error {
 	return nil
 }
 
-// autoTargetSpace targets the space if there is only one space in the org
-// and no space arg was provided.
-func (cmd *TargetCommand) autoTargetSpace(orgGUID string) error {
+// autoTargetSpace targets the space if there is only one space in the org,
+// the user has a role in it, and no space arg was provided.
+func (cmd *TargetCommand) autoTargetSpace(user configv3.User, orgGUID string) error {
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(orgGUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -161,20 +170,32 @@ func (cmd *TargetCommand) autoTargetSpace(orgGUID string) error {
 
 	if len(spaces) == 1 {
 		space := spaces[0]
-		cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+		err = cmd.checkSpaceRole(user, orgGUID, cmd.Organization, space)
+		switch err.(type) {
+		case nil:
+			cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+		case translatableerror.NoSpaceRoleError:
+		default:
+			return err
+		}
 	}
 
 	return nil
 }
 
 // setSpace sets space
-func (cmd *TargetCommand) setSpace() error {
+func (cmd *TargetCommand) setSpace(user configv3.User) error {
 	if !cmd.Config.HasTargetedOrganization() {
 		return translatableerror.NoOrganizationTargetedError{BinaryName: cmd.Config.BinaryName()}
 	}
 
-	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.Space)
-	cmd.UI.DisplayWarnings(warnings)
+	org := cmd.Config.TargetedOrganization()
+	space, err := cmd.getSpace(org.GUID)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.checkSpaceRole(user, org.GUID, org.Name, space)
 	if err != nil {
 		return err
 	}
@@ -184,6 +205,124 @@ func (cmd *TargetCommand) setSpace() error {
 	return nil
 }
 
+// getOrganization returns the org named by the -o flag. When it does not
+// exist, close matches are suggested and, on a terminal, the user can pick
+// one instead.
+func (cmd *TargetCommand) getOrganization() (v2action.Organization, error) {
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.OrganizationNotFoundError); !ok {
+		return org, err
+	}
+
+	suggestions, warnings, suggestErr := cmd.Actor.GetOrganizationNameSuggestions(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if suggestErr != nil {
+		return org, err
+	}
+
+	name, picked, pickErr := cmd.pickSuggestion(suggestions)
+	if pickErr != nil {
+		return org, pickErr
+	}
+	if !picked {
+		return org, err
+	}
+
+	cmd.Organization = name
+	org, warnings, err = cmd.Actor.GetOrganizationByName(name)
+	cmd.UI.DisplayWarnings(warnings)
+	return org, err
+}
+
+// getSpace returns the space named by the -s flag in the given org. When it
+// does not exist, close matches are suggested as for orgs.
+func (cmd *TargetCommand) getSpace(orgGUID string) (v2action.Space, error) {
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(orgGUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.SpaceNotFoundError); !ok {
+		return space, err
+	}
+
+	suggestions, warnings, suggestErr := cmd.Actor.GetSpaceNameSuggestions(orgGUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if suggestErr != nil {
+		return space, err
+	}
+
+	name, picked, pickErr := cmd.pickSuggestion(suggestions)
+	if pickErr != nil {
+		return space, pickErr
+	}
+	if !picked {
+		return space, err
+	}
+
+	cmd.Space = name
+	space, warnings, err = cmd.Actor.GetSpaceByOrganizationAndName(orgGUID, name)
+	cmd.UI.DisplayWarnings(warnings)
+	return space, err
+}
+
+// pickSuggestion displays the suggested names. On a terminal the user is
+// asked to pick one; it returns false when there is nothing to pick from, the
+// output is not a terminal, or the user cancels.
+func (cmd *TargetCommand) pickSuggestion(suggestions []string) (string, bool, error) {
+	if len(suggestions) == 0 {
+		return "", false, nil
+	}
+
+	cmd.UI.DisplayText("Did you mean?")
+	if !cmd.Config.IsTTY() {
+		for _, suggestion := range suggestions {
+			cmd.UI.DisplayText("  {{.Name}}", map[string]interface{}{"Name": suggestion})
+		}
+		return "", false, nil
+	}
+
+	for i, suggestion := range suggestions {
+		cmd.UI.DisplayText("{{.Number}}. {{.Name}}", map[string]interface{}{
+			"Number": i + 1,
+			"Name":   suggestion,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	answer, err := cmd.UI.DisplayTextPrompt("Select a number, or 0 to cancel")
+	if err != nil {
+		return "", false, err
+	}
+
+	choice, err := strconv.Atoi(strings.TrimSpace(answer))
+	if err != nil || choice < 1 || choice > len(suggestions) {
+		return "", false, nil
+	}
+	return suggestions[choice-1], true, nil
+}
+
+// checkSpaceRole returns a NoSpaceRoleError when the user has no role in the
+// space. Admins, who can see every space, are not checked.
+func (cmd *TargetCommand) checkSpaceRole(user configv3.User, orgGUID string, orgName string, space v2action.Space) error {
+	if user.Admin || user.GUID == "" {
+		return nil
+	}
+
+	hasRole, warnings, err := cmd.Actor.UserHasSpaceRole(user.GUID, orgGUID, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !hasRole {
+		return translatableerror.NoSpaceRoleError{
+			Username:  user.Name,
+			SpaceName: space.Name,
+			OrgName:   orgName,
+		}
+	}
+	return nil
+}
+
 // displayTargetTable neatly displays target information.
 func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 	table := [][]string{
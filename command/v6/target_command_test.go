@@ -506,6 +506,76 @@ var _ = Describe("target Command", func() {
 								Expect(testUI.Err).To(Say("warning-1"))
 								Expect(testUI.Err).To(Say("warning-2"))
 							})
+
+							It("does not check the roles of a user without a GUID", func() {
+								Expect(fakeActor.UserHasSpaceRoleCallCount()).To(Equal(0))
+							})
+
+							When("the user is an admin", func() {
+								BeforeEach(func() {
+									fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user", GUID: "some-user-guid", Admin: true}, nil)
+								})
+
+								It("does not check the user's roles", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(fakeActor.UserHasSpaceRoleCallCount()).To(Equal(0))
+									Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+								})
+							})
+
+							When("the user is not an admin", func() {
+								BeforeEach(func() {
+									fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user", GUID: "some-user-guid"}, nil)
+								})
+
+								When("the user has a role in the space", func() {
+									BeforeEach(func() {
+										fakeActor.UserHasSpaceRoleReturns(true, v2action.Warnings{"role-warning"}, nil)
+									})
+
+									It("targets the org and space", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Err).To(Say("role-warning"))
+
+										Expect(fakeActor.UserHasSpaceRoleCallCount()).To(Equal(1))
+										userGUID, orgGUID, spaceGUID := fakeActor.UserHasSpaceRoleArgsForCall(0)
+										Expect(userGUID).To(Equal("some-user-guid"))
+										Expect(orgGUID).To(Equal("some-org-guid"))
+										Expect(spaceGUID).To(Equal("some-space-guid"))
+
+										Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+										Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+									})
+								})
+
+								When("the user has no role in the space", func() {
+									BeforeEach(func() {
+										fakeActor.UserHasSpaceRoleReturns(false, nil, nil)
+									})
+
+									It("returns a NoSpaceRoleError and clears existing targets", func() {
+										Expect(executeErr).To(MatchError(translatableerror.NoSpaceRoleError{
+											Username:  "some-user",
+											SpaceName: "some-space",
+											OrgName:   "some-org",
+										}))
+
+										Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+										Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+										Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+									})
+								})
+
+								When("checking the user's roles fails", func() {
+									BeforeEach(func() {
+										fakeActor.UserHasSpaceRoleReturns(false, nil, errors.New("role error"))
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError("role error"))
+									})
+								})
+							})
 						})
 
 						When("the space does not exist", func() {
@@ -524,6 +594,26 @@ var _ = Describe("target Command", func() {
 
 								Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
 							})
+
+							When("there are spaces with similar names", func() {
+								BeforeEach(func() {
+									fakeActor.GetSpaceNameSuggestionsReturns([]string{"some-spaces", "some-place"}, v2action.Warnings{"suggestion-warning"}, nil)
+								})
+
+								It("suggests them and returns the error", func() {
+									Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+
+									orgGUID, spaceName := fakeActor.GetSpaceNameSuggestionsArgsForCall(0)
+									Expect(orgGUID).To(Equal("some-org-guid"))
+									Expect(spaceName).To(Equal("some-space"))
+
+									Expect(testUI.Err).To(Say("suggestion-warning"))
+									Expect(testUI.Out).To(Say(`Did you mean\?`))
+									Expect(testUI.Out).To(Say("  some-spaces"))
+									Expect(testUI.Out).To(Say("  some-place"))
+									Expect(fakeActor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(1))
+								})
+							})
 						})
 					})
 
@@ -543,6 +633,59 @@ var _ = Describe("target Command", func() {
 
 							Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
 						})
+
+						When("there are orgs with similar names and the output is a terminal", func() {
+							var input *Buffer
+
+							BeforeEach(func() {
+								input = NewBuffer()
+								testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+								cmd.UI = testUI
+								fakeConfig.IsTTYReturns(true)
+								fakeActor.GetOrganizationNameSuggestionsReturns([]string{"some-orgs", "some-org-2"}, nil, nil)
+							})
+
+							When("the user picks a suggestion", func() {
+								BeforeEach(func() {
+									_, err := input.Write([]byte("2\n"))
+									Expect(err).ToNot(HaveOccurred())
+									fakeActor.GetOrganizationByNameReturnsOnCall(1, v2action.Organization{GUID: "some-org-2-guid", Name: "some-org-2"}, nil, nil)
+									fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid", Name: "some-space"}, nil, nil)
+								})
+
+								It("targets the picked org", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say(`Did you mean\?`))
+									Expect(testUI.Out).To(Say(`1\. some-orgs`))
+									Expect(testUI.Out).To(Say(`2\. some-org-2`))
+									Expect(testUI.Out).To(Say("Select a number, or 0 to cancel"))
+
+									Expect(fakeActor.GetOrganizationNameSuggestionsArgsForCall(0)).To(Equal("some-org"))
+									Expect(fakeActor.GetOrganizationByNameArgsForCall(1)).To(Equal("some-org-2"))
+
+									orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
+									Expect(orgGUID).To(Equal("some-org-2-guid"))
+									Expect(orgName).To(Equal("some-org-2"))
+
+									orgGUID, _ = fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+									Expect(orgGUID).To(Equal("some-org-2-guid"))
+								})
+							})
+
+							When("the user cancels", func() {
+								BeforeEach(func() {
+									_, err := input.Write([]byte("0\n"))
+									Expect(err).ToNot(HaveOccurred())
+								})
+
+								It("returns the original error", func() {
+									Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+									Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
+									Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+								})
+							})
+						})
 					})
 				})
 			})
@@ -0,0 +1,145 @@
+package v6
+
+import (
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UnhealthyAppsActor
+
+type UnhealthyAppsActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetUnhealthyApplications() ([]v2action.UnhealthyApplication, v2action.Warnings, error)
+	GetUnhealthyApplicationsByOrganization(orgGUID string) ([]v2action.UnhealthyApplication, v2action.Warnings, error)
+}
+
+type UnhealthyAppsCommand struct {
+	Org             string      `short:"o" long:"org" description:"Org to check (defaults to the targeted org)"`
+	All             bool        `long:"all" description:"Check every org visible to the user; run as an admin to check the whole foundation"`
+	usage           interface{} `usage:"CF_NAME unhealthy-apps [-o ORG | --all]\n\n   Lists the started apps that are running fewer instances than desired, with their crashed instances and the reason for their last crash in the past 24 hours."`
+	relatedCommands interface{} `related_commands:"app, apps, events, logs, restart-app-instance"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnhealthyAppsActor
+}
+
+func (cmd *UnhealthyAppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd UnhealthyAppsCommand) Execute(args []string) error {
+	if cmd.Org != "" && cmd.All {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all"}}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Org == "" && !cmd.All, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		apps     []v2action.UnhealthyApplication
+		warnings v2action.Warnings
+	)
+	if cmd.All {
+		cmd.UI.DisplayTextWithFlavor("Getting unhealthy apps in all orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		apps, warnings, err = cmd.Actor.GetUnhealthyApplications()
+	} else {
+		apps, warnings, err = cmd.getUnhealthyApplicationsByOrganization(user.Name)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No unhealthy apps found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("crashed"),
+			cmd.UI.TranslateText("last crash"),
+			cmd.UI.TranslateText("last crash reason"),
+		},
+	}
+	for _, app := range apps {
+		lastCrash := ""
+		if !app.LastCrashTime.IsZero() {
+			lastCrash = cmd.UI.UserFriendlyDate(app.LastCrashTime)
+		}
+
+		table = append(table, []string{
+			app.OrganizationName,
+			app.SpaceName,
+			app.Name,
+			fmt.Sprintf("%d/%d", app.RunningInstances, app.DesiredInstances),
+			strconv.Itoa(app.CrashedInstances),
+			lastCrash,
+			app.LastCrashReason,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd UnhealthyAppsCommand) getUnhealthyApplicationsByOrganization(username string) ([]v2action.UnhealthyApplication, v2action.Warnings, error) {
+	orgName := cmd.Org
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting unhealthy apps in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": username,
+	})
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	var allWarnings v2action.Warnings
+	if cmd.Org != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		orgGUID = org.GUID
+	}
+
+	apps, warnings, err := cmd.Actor.GetUnhealthyApplicationsByOrganization(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return apps, allWarnings, err
+}
@@ -0,0 +1,180 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unhealthy-apps Command", func() {
+	var (
+		cmd             UnhealthyAppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeUnhealthyAppsActor
+		executeErr      error
+		unhealthyApps   []v2action.UnhealthyApplication
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeUnhealthyAppsActor)
+
+		cmd = UnhealthyAppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org", GUID: "targeted-org-guid"})
+
+		unhealthyApps = []v2action.UnhealthyApplication{
+			{
+				Application:      v2action.Application{Name: "crashing"},
+				OrganizationName: "targeted-org",
+				SpaceName:        "production",
+				RunningInstances: 1,
+				DesiredInstances: 3,
+				CrashedInstances: 2,
+				LastCrashReason:  "out of memory",
+				LastCrashTime:    time.Now().Add(-time.Hour),
+			},
+			{
+				Application:      v2action.Application{Name: "unstaged"},
+				OrganizationName: "targeted-org",
+				SpaceName:        "staging",
+				DesiredInstances: 1,
+			},
+		}
+		fakeActor.GetUnhealthyApplicationsByOrganizationReturns(unhealthyApps, v2action.Warnings{"unhealthy-warning"}, nil)
+		fakeActor.GetUnhealthyApplicationsReturns(unhealthyApps, v2action.Warnings{"unhealthy-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--org and --all are both passed", func() {
+		BeforeEach(func() {
+			cmd.Org = "some-org"
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--org", "--all"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			targetedOrg, targetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeTrue())
+			Expect(targetedSpace).To(BeFalse())
+		})
+	})
+
+	It("lists the unhealthy apps in the targeted org", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting unhealthy apps in org targeted-org as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`org\s+space\s+name\s+instances\s+crashed\s+last crash\s+last crash reason`))
+		Expect(testUI.Out).To(Say(`targeted-org\s+production\s+crashing\s+1/3\s+2\s+.+\s+out of memory`))
+		Expect(testUI.Out).To(Say(`targeted-org\s+staging\s+unstaged\s+0/1\s+0`))
+		Expect(testUI.Err).To(Say("unhealthy-warning"))
+
+		Expect(fakeActor.GetUnhealthyApplicationsByOrganizationArgsForCall(0)).To(Equal("targeted-org-guid"))
+		Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+	})
+
+	When("--org is passed", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "other-org-guid"}, v2action.Warnings{"org-warning"}, nil)
+		})
+
+		It("checks that org without requiring a targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			targetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeFalse())
+			Expect(testUI.Out).To(Say(`Getting unhealthy apps in org other-org as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			Expect(fakeActor.GetUnhealthyApplicationsByOrganizationArgsForCall(0)).To(Equal("other-org-guid"))
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, actionerror.OrganizationNotFoundError{Name: "other-org"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "other-org"}))
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(fakeActor.GetUnhealthyApplicationsByOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--all is passed", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		It("checks every org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			targetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrg).To(BeFalse())
+			Expect(testUI.Out).To(Say(`Getting unhealthy apps in all orgs as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`crashing`))
+			Expect(fakeActor.GetUnhealthyApplicationsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetUnhealthyApplicationsByOrganizationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no unhealthy apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetUnhealthyApplicationsByOrganizationReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No unhealthy apps found."))
+		})
+	})
+
+	When("getting the unhealthy apps fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUnhealthyApplicationsByOrganizationReturns(nil, v2action.Warnings{"unhealthy-warning"}, errors.New("apps-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("apps-error"))
+			Expect(testUI.Err).To(Say("unhealthy-warning"))
+		})
+	})
+})
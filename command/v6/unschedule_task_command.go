@@ -0,0 +1,78 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . UnscheduleTaskActor
+
+type UnscheduleTaskActor interface {
+	DeleteTaskSchedule(appName string, spaceGUID string, name string) (v3action.Warnings, error)
+}
+
+type UnscheduleTaskCommand struct {
+	RequiredArgs    flag.UnscheduleTaskArgs `positional-args:"yes"`
+	usage           interface{}             `usage:"CF_NAME unschedule-task APP_NAME SCHEDULE_NAME"`
+	relatedCommands interface{}             `related_commands:"schedule-task, scheduled-tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnscheduleTaskActor
+}
+
+func (cmd *UnscheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd UnscheduleTaskCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing task schedule {{.ScheduleName}} from app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ScheduleName": cmd.RequiredArgs.ScheduleName,
+		"AppName":      cmd.RequiredArgs.AppName,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    space.Name,
+		"CurrentUser":  user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteTaskSchedule(cmd.RequiredArgs.AppName, space.GUID, cmd.RequiredArgs.ScheduleName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.TaskScheduleNotFoundError); !ok {
+			return err
+		}
+		cmd.UI.DisplayWarning("Task schedule {{.ScheduleName}} does not exist.", map[string]interface{}{
+			"ScheduleName": cmd.RequiredArgs.ScheduleName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unschedule-task Command", func() {
+	var (
+		cmd             UnscheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeUnscheduleTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeUnscheduleTaskActor)
+
+		cmd = UnscheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ScheduleName = "cleanup"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	It("removes the schedule", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.DeleteTaskScheduleCallCount()).To(Equal(1))
+		appName, spaceGUID, name := fakeActor.DeleteTaskScheduleArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(name).To(Equal("cleanup"))
+
+		Expect(testUI.Out).To(Say("Removing task schedule cleanup from app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("the schedule does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteTaskScheduleReturns(v3action.Warnings{"delete-warning"}, actionerror.TaskScheduleNotFoundError{AppName: "some-app", Name: "cleanup"})
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("delete-warning"))
+			Expect(testUI.Err).To(Say("Task schedule cleanup does not exist."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("removing the schedule fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteTaskScheduleReturns(v3action.Warnings{"delete-warning"}, errors.New("delete-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("delete-error"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+})
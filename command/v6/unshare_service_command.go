@@ -59,7 +59,8 @@ func (cmd *UnshareServiceCommand) Setup(config command.Config, ui command.UI) er
 }
 
 func (cmd UnshareServiceCommand) Execute(args []string) error {
-	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionShareServiceV3)
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerV3APIVersion)
+	err := gate.Require("", ccversion.MinVersionShareServiceV3)
 	if err != nil {
 		return err
 	}
@@ -57,7 +57,9 @@ func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) e
 		return err
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
-	cmd.ProgressBar = v2action.NewProgressBar()
+	progressBar := v2action.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
 
 	return nil
 }
@@ -142,20 +144,13 @@ func (cmd UpdateBuildpackCommand) Execute(args []string) error {
 }
 
 func (cmd UpdateBuildpackCommand) minAPIVersionCheck() error {
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
 	if cmd.CurrentStack != "" {
-		return command.MinimumCCAPIVersionCheck(
-			cmd.Actor.CloudControllerAPIVersion(),
-			ccversion.MinVersionBuildpackStackAssociationV2,
-			"Option '-s'",
-		)
+		return gate.Require("Option '-s'", ccversion.MinVersionBuildpackStackAssociationV2)
 	}
 
 	if cmd.NewStack != "" {
-		return command.MinimumCCAPIVersionCheck(
-			cmd.Actor.CloudControllerAPIVersion(),
-			ccversion.MinVersionBuildpackStackAssociationV2,
-			"Option '--assign-stack'",
-		)
+		return gate.Require("Option '--assign-stack'", ccversion.MinVersionBuildpackStackAssociationV2)
 	}
 	return nil
 }
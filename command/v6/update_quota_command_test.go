@@ -0,0 +1,90 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-quota Command", func() {
+	var (
+		cmd             UpdateQuotaCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeUpdateQuotaActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeUpdateQuotaActor)
+
+		cmd = UpdateQuotaCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Quota = "small"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLogRateLimitV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("both paid service plan flags are provided", func() {
+		BeforeEach(func() {
+			cmd.AllowPaidServicePlans = true
+			cmd.DisallowPaidServicePlans = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--allow-paid-service-plans", "--disallow-paid-service-plans"},
+			}))
+			Expect(fakeActor.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+		})
+	})
+
+	When("updating the quota succeeds", func() {
+		BeforeEach(func() {
+			cmd.NewName = "medium"
+			cmd.NumRoutes = flag.IntegerLimit{NullInt: types.NullInt{IsSet: true, Value: -1}}
+			cmd.LogRateLimit = flag.BytesWithUnlimited{NullInt: types.NullInt{IsSet: true, Value: 16384}}
+			cmd.DisallowPaidServicePlans = true
+			fakeActor.UpdateOrganizationQuotaReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("updates only the given limits", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Updating quota small as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			name, newName, limits := fakeActor.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(name).To(Equal("small"))
+			Expect(newName).To(Equal("medium"))
+			Expect(limits.Routes.TotalRoutes).To(Equal(&types.NullInt{}))
+			Expect(limits.Apps.LogRateLimit).To(Equal(&types.NullInt{IsSet: true, Value: 16384}))
+			Expect(limits.Apps.TotalMemory).To(BeNil())
+			Expect(*limits.Services.PaidServicePlans).To(BeFalse())
+		})
+	})
+})
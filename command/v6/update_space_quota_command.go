@@ -1,30 +1,111 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . UpdateSpaceQuotaActor
+
+type UpdateSpaceQuotaActor interface {
+	CloudControllerAPIVersion() string
+	UpdateSpaceQuota(name string, orgGUID string, newName string, limits v3action.QuotaLimits) (v3action.Warnings, error)
+}
+
 type UpdateSpaceQuotaCommand struct {
 	RequiredArgs             flag.SpaceQuota          `positional-args:"yes"`
-	NumAppInstances          int                      `short:"a" description:"Total number of application instances. -1 represents an unlimited amount."`
+	NumAppInstances          flag.IntegerLimit        `short:"a" description:"Total number of application instances. -1 represents an unlimited amount."`
 	AllowPaidServicePlans    bool                     `long:"allow-paid-service-plans" description:"Can provision instances of paid service plans"`
 	DisallowPaidServicePlans bool                     `long:"disallow-paid-service-plans" description:"Can not provision instances of paid service plans"`
 	AppInstanceMemory        flag.MemoryWithUnlimited `short:"i" description:"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G). -1 represents an unlimited amount."`
-	TotalMemory              string                   `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G)"`
+	LogRateLimit             flag.BytesWithUnlimited  `short:"l" long:"log-rate-limit" description:"Maximum log rate of an application instance in bytes per second (e.g. 1K, 16K, 1M). -1 represents an unlimited amount."`
+	TotalMemory              flag.MemoryWithUnlimited `short:"m" description:"Total amount of memory a space can have (e.g. 1024M, 1G, 10G). -1 represents an unlimited amount."`
 	Name                     string                   `short:"n" description:"New name"`
-	NumRoutes                int                      `short:"r" description:"Total number of routes"`
-	ReservedRoutePorts       int                      `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports"`
-	NumServiceInstances      int                      `short:"s" description:"Total number of service instances"`
-	usage                    interface{}              `usage:"CF_NAME update-space-quota SPACE_QUOTA [-i INSTANCE_MEMORY] [-m MEMORY] [-n NAME] [-r ROUTES] [-s SERVICE_INSTANCES] [-a APP_INSTANCES] [--allow-paid-service-plans | --disallow-paid-service-plans] [--reserved-route-ports RESERVED_ROUTE_PORTS]"`
-	relatedCommands          interface{}              `related_commands:"space-quota, space-quotas"`
+	PerAppTasks              flag.IntegerLimit        `long:"per-app-tasks" description:"Maximum number of running tasks per application. -1 represents an unlimited amount."`
+	NumRoutes                flag.IntegerLimit        `short:"r" description:"Total number of routes. -1 represents an unlimited amount."`
+	ReservedRoutePorts       flag.IntegerLimit        `long:"reserved-route-ports" description:"Maximum number of routes that may be created with reserved ports"`
+	NumServiceInstances      flag.IntegerLimit        `short:"s" description:"Total number of service instances. -1 represents an unlimited amount."`
+	usage                    interface{}              `usage:"CF_NAME update-space-quota SPACE_QUOTA [-i INSTANCE_MEMORY] [-m MEMORY] [-n NAME] [-r ROUTES] [-s SERVICE_INSTANCES] [-a APP_INSTANCES] [--allow-paid-service-plans | --disallow-paid-service-plans] [--reserved-route-ports RESERVED_ROUTE_PORTS] [--per-app-tasks PER_APP_TASKS] [-l LOG_RATE_LIMIT]"`
+	relatedCommands          interface{}              `related_commands:"apply-quotas, space-quota, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateSpaceQuotaActor
 }
 
-func (UpdateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateSpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, sharedActor, nil)
+
 	return nil
 }
 
-func (UpdateSpaceQuotaCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd UpdateSpaceQuotaCommand) Execute(args []string) error {
+	if cmd.AllowPaidServicePlans && cmd.DisallowPaidServicePlans {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--allow-paid-service-plans", "--disallow-paid-service-plans"},
+		}
+	}
+
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionQuotasV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.LogRateLimit.IsSet {
+		err = gate.Require("Option '-l'", ccversion.MinVersionLogRateLimitV3)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating space quota {{.QuotaName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"QuotaName":   cmd.RequiredArgs.SpaceQuota,
+		"CurrentUser": user.Name,
+	})
+
+	var limits v3action.QuotaLimits
+	limits.Apps.TotalMemory = shared.QuotaLimit(cmd.TotalMemory.NullInt)
+	limits.Apps.InstanceMemory = shared.QuotaLimit(cmd.AppInstanceMemory.NullInt)
+	limits.Apps.TotalInstances = shared.QuotaLimit(cmd.NumAppInstances.NullInt)
+	limits.Apps.PerAppTasks = shared.QuotaLimit(cmd.PerAppTasks.NullInt)
+	limits.Apps.LogRateLimit = shared.QuotaLimit(cmd.LogRateLimit.NullInt)
+	limits.Services.PaidServicePlans = shared.PaidServicePlansLimit(cmd.AllowPaidServicePlans, cmd.DisallowPaidServicePlans)
+	limits.Services.TotalServiceInstances = shared.QuotaLimit(cmd.NumServiceInstances.NullInt)
+	limits.Routes.TotalRoutes = shared.QuotaLimit(cmd.NumRoutes.NullInt)
+	limits.Routes.TotalReservedPorts = shared.QuotaLimit(cmd.ReservedRoutePorts.NullInt)
+
+	warnings, err := cmd.Actor.UpdateSpaceQuota(cmd.RequiredArgs.SpaceQuota, cmd.Config.TargetedOrganization().GUID, cmd.Name, limits)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
 }
@@ -26,7 +26,8 @@ type V3AppActor interface {
 type V3AppCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
 	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid]"`
+	Placement       bool         `long:"placement" description:"Show the number of instances and the cells in each availability zone"`
+	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid] [--placement]"`
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI              command.UI
@@ -83,6 +84,8 @@ func (cmd V3AppCommand) Execute(args []string) error {
 	cmd.UI.DisplayNewline()
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer2(cmd.UI)
+	appSummaryDisplayer.DisplayPlacement = true
+	appSummaryDisplayer.GroupByPlacement = cmd.Placement
 	summary, warnings, err := cmd.AppSummaryActor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -240,5 +240,43 @@ var _ = Describe("app Command", func() {
 				Expect(withObfuscatedValues).To(BeFalse())
 			})
 		})
+
+		When("the --placement flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Placement = true
+
+				summary := v2v3action.ApplicationSummary{
+					ApplicationSummary: v3action.ApplicationSummary{
+						Application: v3action.Application{
+							Name:  "some-app",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: v3action.ProcessSummaries{
+							{
+								Process: v3action.Process{
+									Type: constant.ProcessTypeWeb,
+								},
+								InstanceDetails: []v3action.ProcessInstance{
+									{Index: 0, State: constant.ProcessInstanceRunning, Host: "10.0.16.5", Zone: "z1"},
+									{Index: 1, State: constant.ProcessInstanceRunning, Host: "10.0.32.7", Zone: "z2"},
+								},
+							},
+						},
+					},
+				}
+				fakeAppSummaryActor.GetApplicationSummaryByNameAndSpaceReturns(summary, nil, nil)
+			})
+
+			It("displays the cell and zone of each instance and groups them by zone", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`state\s+since\s+cpu\s+memory\s+disk\s+cell\s+zone\s+details`))
+				Expect(testUI.Out).To(Say(`#0\s+running.*10\.0\.16\.5\s+z1`))
+				Expect(testUI.Out).To(Say(`#1\s+running.*10\.0\.32\.7\s+z2`))
+				Expect(testUI.Out).To(Say(`zone\s+instances\s+cells`))
+				Expect(testUI.Out).To(Say(`z1\s+1\s+10\.0\.16\.5`))
+				Expect(testUI.Out).To(Say(`z2\s+1\s+10\.0\.32\.7`))
+			})
+		})
 	})
 })
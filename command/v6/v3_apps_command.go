@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -14,6 +15,8 @@ import (
 //go:generate counterfeiter . V3AppsActor
 
 type V3AppsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationOwnersBySpace(spaceGUID string) (map[string]v3action.Owner, v3action.Warnings, error)
 	GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
 }
 
@@ -79,14 +82,25 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return nil
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("name"),
-			cmd.UI.TranslateText("requested state"),
-			cmd.UI.TranslateText("processes"),
-			cmd.UI.TranslateText("routes"),
-		},
+	var owners map[string]v3action.Owner
+	if command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion).Supports(ccversion.MinVersionMetadataV3) {
+		owners, warnings, err = cmd.Actor.GetApplicationOwnersBySpace(cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	header := []string{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("requested state"),
+		cmd.UI.TranslateText("processes"),
+		cmd.UI.TranslateText("routes"),
 	}
+	if len(owners) > 0 {
+		header = append(header, cmd.UI.TranslateText("owner"), cmd.UI.TranslateText("team"), cmd.UI.TranslateText("contact"))
+	}
+	table := [][]string{header}
 
 	for _, summary := range summaries {
 		var routesList string
@@ -99,12 +113,17 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 			routesList = routes.Summary()
 		}
 
-		table = append(table, []string{
+		row := []string{
 			summary.Name,
 			cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
 			summary.ProcessSummaries.String(),
 			routesList,
-		})
+		}
+		if len(owners) > 0 {
+			owner := owners[summary.GUID]
+			row = append(row, owner.Owner, owner.Team, owner.Contact)
+		}
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
@@ -277,6 +278,44 @@ var _ = Describe("v3-apps Command", func() {
 				appGUID = fakeV2Actor.GetApplicationRoutesArgsForCall(1)
 				Expect(appGUID).To(Equal("app-guid-2"))
 			})
+
+			It("does not display owner columns when no app has an owner", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("owner"))
+			})
+
+			When("the API supports metadata and the apps have owners", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+					fakeActor.GetApplicationOwnersBySpaceReturns(
+						map[string]v3action.Owner{"app-guid-2": {Owner: "jane", Team: "payments", Contact: "payments-oncall"}},
+						v3action.Warnings{"owners-warning"},
+						nil,
+					)
+				})
+
+				It("displays the owner columns", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`name\s+requested state\s+processes\s+routes\s+owner\s+team\s+contact`))
+					Expect(testUI.Out).To(Say(`some-app-1\s+started\s+web:2/2, console:0/0, worker:0/1\s+some-app-1.some-other-domain, some-app-1.some-domain\s*\n`))
+					Expect(testUI.Out).To(Say(`some-app-2\s+stopped\s+web:0/2\s+some-app-2.some-domain\s+jane\s+payments\s+payments-oncall`))
+					Expect(testUI.Err).To(Say("owners-warning"))
+
+					Expect(fakeActor.GetApplicationOwnersBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				})
+
+				When("getting the owners fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationOwnersBySpaceReturns(nil, v3action.Warnings{"owners-warning"}, errors.New("owners-error"))
+					})
+
+					It("returns the error and displays warnings", func() {
+						Expect(executeErr).To(MatchError("owners-error"))
+						Expect(testUI.Err).To(Say("owners-warning"))
+					})
+				})
+			})
 		})
 
 		When("app does not have processes", func() {
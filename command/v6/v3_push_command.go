@@ -83,7 +83,9 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.Config = config
 	cmd.UI = ui
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	progressBar := progressbar.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
 
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
@@ -112,7 +112,8 @@ func (cmd V3ZeroDowntimePushCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = command.MinimumCCAPIVersionCheck(cmd.ZdtActor.CloudControllerAPIVersion(), ccversion.MinVersionZeroDowntimePushV3)
+	gate := command.NewAPIVersionGate(cmd.ZdtActor.CloudControllerAPIVersion)
+	err = gate.Require("", ccversion.MinVersionZeroDowntimePushV3)
 	if err != nil {
 		return err
 	}
@@ -47,7 +47,8 @@ func (cmd *V3ZeroDowntimeRestartCommand) Setup(config command.Config, ui command
 func (cmd V3ZeroDowntimeRestartCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
-	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionZeroDowntimePushV3)
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err := gate.Require("", ccversion.MinVersionZeroDowntimePushV3)
 	if err != nil {
 		return err
 	}
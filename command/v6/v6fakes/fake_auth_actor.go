@@ -3,7 +3,9 @@ package v6fakes
 
 import (
 	"sync"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
@@ -23,6 +25,31 @@ type FakeAuthActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithCredentialsStub        func(map[string]string, string, constant.GrantType) error
+	authenticateWithCredentialsMutex       sync.RWMutex
+	authenticateWithCredentialsArgsForCall []struct {
+		arg1 map[string]string
+		arg2 string
+		arg3 constant.GrantType
+	}
+	authenticateWithCredentialsReturns struct {
+		result1 error
+	}
+	authenticateWithCredentialsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateWithDeviceAuthorizationStub        func(v2action.DeviceAuthorization, time.Duration) error
+	authenticateWithDeviceAuthorizationMutex       sync.RWMutex
+	authenticateWithDeviceAuthorizationArgsForCall []struct {
+		arg1 v2action.DeviceAuthorization
+		arg2 time.Duration
+	}
+	authenticateWithDeviceAuthorizationReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct {
@@ -33,6 +60,18 @@ type FakeAuthActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	StartDeviceAuthorizationStub        func() (v2action.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}
 	UAAAPIVersionStub        func() string
 	uAAAPIVersionMutex       sync.RWMutex
 	uAAAPIVersionArgsForCall []struct {
@@ -110,6 +149,129 @@ func (fake *FakeAuthActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAuthActor) AuthenticateWithCredentials(arg1 map[string]string, arg2 string, arg3 constant.GrantType) error {
+	fake.authenticateWithCredentialsMutex.Lock()
+	ret, specificReturn := fake.authenticateWithCredentialsReturnsOnCall[len(fake.authenticateWithCredentialsArgsForCall)]
+	fake.authenticateWithCredentialsArgsForCall = append(fake.authenticateWithCredentialsArgsForCall, struct {
+		arg1 map[string]string
+		arg2 string
+		arg3 constant.GrantType
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthenticateWithCredentials", []interface{}{arg1, arg2, arg3})
+	fake.authenticateWithCredentialsMutex.Unlock()
+	if fake.AuthenticateWithCredentialsStub != nil {
+		return fake.AuthenticateWithCredentialsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithCredentialsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuthActor) AuthenticateWithCredentialsCallCount() int {
+	fake.authenticateWithCredentialsMutex.RLock()
+	defer fake.authenticateWithCredentialsMutex.RUnlock()
+	return len(fake.authenticateWithCredentialsArgsForCall)
+}
+
+func (fake *FakeAuthActor) AuthenticateWithCredentialsCalls(stub func(map[string]string, string, constant.GrantType) error) {
+	fake.authenticateWithCredentialsMutex.Lock()
+	defer fake.authenticateWithCredentialsMutex.Unlock()
+	fake.AuthenticateWithCredentialsStub = stub
+}
+
+func (fake *FakeAuthActor) AuthenticateWithCredentialsArgsForCall(i int) (map[string]string, string, constant.GrantType) {
+	fake.authenticateWithCredentialsMutex.RLock()
+	defer fake.authenticateWithCredentialsMutex.RUnlock()
+	argsForCall := fake.authenticateWithCredentialsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuthActor) AuthenticateWithCredentialsReturns(result1 error) {
+	fake.authenticateWithCredentialsMutex.Lock()
+	defer fake.authenticateWithCredentialsMutex.Unlock()
+	fake.AuthenticateWithCredentialsStub = nil
+	fake.authenticateWithCredentialsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithCredentialsReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithCredentialsMutex.Lock()
+	defer fake.authenticateWithCredentialsMutex.Unlock()
+	fake.AuthenticateWithCredentialsStub = nil
+	if fake.authenticateWithCredentialsReturnsOnCall == nil {
+		fake.authenticateWithCredentialsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithCredentialsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorization(arg1 v2action.DeviceAuthorization, arg2 time.Duration) error {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceAuthorizationReturnsOnCall[len(fake.authenticateWithDeviceAuthorizationArgsForCall)]
+	fake.authenticateWithDeviceAuthorizationArgsForCall = append(fake.authenticateWithDeviceAuthorizationArgsForCall, struct {
+		arg1 v2action.DeviceAuthorization
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("AuthenticateWithDeviceAuthorization", []interface{}{arg1, arg2})
+	fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	if fake.AuthenticateWithDeviceAuthorizationStub != nil {
+		return fake.AuthenticateWithDeviceAuthorizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceAuthorizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorizationCallCount() int {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	return len(fake.authenticateWithDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorizationCalls(stub func(v2action.DeviceAuthorization, time.Duration) error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorizationArgsForCall(i int) (v2action.DeviceAuthorization, time.Duration) {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorizationReturns(result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	fake.authenticateWithDeviceAuthorizationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceAuthorizationReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	if fake.authenticateWithDeviceAuthorizationReturnsOnCall == nil {
+		fake.authenticateWithDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAuthActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -162,6 +324,61 @@ func (fake *FakeAuthActor) CloudControllerAPIVersionReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeAuthActor) StartDeviceAuthorization() (v2action.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if fake.StartDeviceAuthorizationStub != nil {
+		return fake.StartDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthActor) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeAuthActor) StartDeviceAuthorizationCalls(stub func() (v2action.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeAuthActor) StartDeviceAuthorizationReturns(result1 v2action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthActor) StartDeviceAuthorizationReturnsOnCall(i int, result1 v2action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthActor) UAAAPIVersion() string {
 	fake.uAAAPIVersionMutex.Lock()
 	ret, specificReturn := fake.uAAAPIVersionReturnsOnCall[len(fake.uAAAPIVersionArgsForCall)]
@@ -219,8 +436,14 @@ func (fake *FakeAuthActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithCredentialsMutex.RLock()
+	defer fake.authenticateWithCredentialsMutex.RUnlock()
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
 	fake.uAAAPIVersionMutex.RLock()
 	defer fake.uAAAPIVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
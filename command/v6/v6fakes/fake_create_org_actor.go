@@ -39,6 +39,19 @@ type FakeCreateOrgActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	PollOrganizationVisibleStub        func(v2action.Organization) (v2action.Warnings, error)
+	pollOrganizationVisibleMutex       sync.RWMutex
+	pollOrganizationVisibleArgsForCall []struct {
+		arg1 v2action.Organization
+	}
+	pollOrganizationVisibleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	pollOrganizationVisibleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -174,6 +187,69 @@ func (fake *FakeCreateOrgActor) GrantOrgManagerByUsernameReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeCreateOrgActor) PollOrganizationVisible(arg1 v2action.Organization) (v2action.Warnings, error) {
+	fake.pollOrganizationVisibleMutex.Lock()
+	ret, specificReturn := fake.pollOrganizationVisibleReturnsOnCall[len(fake.pollOrganizationVisibleArgsForCall)]
+	fake.pollOrganizationVisibleArgsForCall = append(fake.pollOrganizationVisibleArgsForCall, struct {
+		arg1 v2action.Organization
+	}{arg1})
+	fake.recordInvocation("PollOrganizationVisible", []interface{}{arg1})
+	fake.pollOrganizationVisibleMutex.Unlock()
+	if fake.PollOrganizationVisibleStub != nil {
+		return fake.PollOrganizationVisibleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollOrganizationVisibleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreateOrgActor) PollOrganizationVisibleCallCount() int {
+	fake.pollOrganizationVisibleMutex.RLock()
+	defer fake.pollOrganizationVisibleMutex.RUnlock()
+	return len(fake.pollOrganizationVisibleArgsForCall)
+}
+
+func (fake *FakeCreateOrgActor) PollOrganizationVisibleCalls(stub func(v2action.Organization) (v2action.Warnings, error)) {
+	fake.pollOrganizationVisibleMutex.Lock()
+	defer fake.pollOrganizationVisibleMutex.Unlock()
+	fake.PollOrganizationVisibleStub = stub
+}
+
+func (fake *FakeCreateOrgActor) PollOrganizationVisibleArgsForCall(i int) v2action.Organization {
+	fake.pollOrganizationVisibleMutex.RLock()
+	defer fake.pollOrganizationVisibleMutex.RUnlock()
+	argsForCall := fake.pollOrganizationVisibleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateOrgActor) PollOrganizationVisibleReturns(result1 v2action.Warnings, result2 error) {
+	fake.pollOrganizationVisibleMutex.Lock()
+	defer fake.pollOrganizationVisibleMutex.Unlock()
+	fake.PollOrganizationVisibleStub = nil
+	fake.pollOrganizationVisibleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateOrgActor) PollOrganizationVisibleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.pollOrganizationVisibleMutex.Lock()
+	defer fake.pollOrganizationVisibleMutex.Unlock()
+	fake.PollOrganizationVisibleStub = nil
+	if fake.pollOrganizationVisibleReturnsOnCall == nil {
+		fake.pollOrganizationVisibleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.pollOrganizationVisibleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreateOrgActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -181,6 +257,8 @@ func (fake *FakeCreateOrgActor) Invocations() map[string][][]interface{} {
 	defer fake.createOrganizationMutex.RUnlock()
 	fake.grantOrgManagerByUsernameMutex.RLock()
 	defer fake.grantOrgManagerByUsernameMutex.RUnlock()
+	fake.pollOrganizationVisibleMutex.RLock()
+	defer fake.pollOrganizationVisibleMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -37,6 +37,19 @@ type FakeCreateRouteActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	PollRouteVisibleStub        func(v2action.Route) (v2action.Warnings, error)
+	pollRouteVisibleMutex       sync.RWMutex
+	pollRouteVisibleArgsForCall []struct {
+		arg1 v2action.Route
+	}
+	pollRouteVisibleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	pollRouteVisibleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -162,6 +175,69 @@ func (fake *FakeCreateRouteActor) CreateRouteWithExistenceCheckReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCreateRouteActor) PollRouteVisible(arg1 v2action.Route) (v2action.Warnings, error) {
+	fake.pollRouteVisibleMutex.Lock()
+	ret, specificReturn := fake.pollRouteVisibleReturnsOnCall[len(fake.pollRouteVisibleArgsForCall)]
+	fake.pollRouteVisibleArgsForCall = append(fake.pollRouteVisibleArgsForCall, struct {
+		arg1 v2action.Route
+	}{arg1})
+	fake.recordInvocation("PollRouteVisible", []interface{}{arg1})
+	fake.pollRouteVisibleMutex.Unlock()
+	if fake.PollRouteVisibleStub != nil {
+		return fake.PollRouteVisibleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollRouteVisibleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreateRouteActor) PollRouteVisibleCallCount() int {
+	fake.pollRouteVisibleMutex.RLock()
+	defer fake.pollRouteVisibleMutex.RUnlock()
+	return len(fake.pollRouteVisibleArgsForCall)
+}
+
+func (fake *FakeCreateRouteActor) PollRouteVisibleCalls(stub func(v2action.Route) (v2action.Warnings, error)) {
+	fake.pollRouteVisibleMutex.Lock()
+	defer fake.pollRouteVisibleMutex.Unlock()
+	fake.PollRouteVisibleStub = stub
+}
+
+func (fake *FakeCreateRouteActor) PollRouteVisibleArgsForCall(i int) v2action.Route {
+	fake.pollRouteVisibleMutex.RLock()
+	defer fake.pollRouteVisibleMutex.RUnlock()
+	argsForCall := fake.pollRouteVisibleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateRouteActor) PollRouteVisibleReturns(result1 v2action.Warnings, result2 error) {
+	fake.pollRouteVisibleMutex.Lock()
+	defer fake.pollRouteVisibleMutex.Unlock()
+	fake.PollRouteVisibleStub = nil
+	fake.pollRouteVisibleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateRouteActor) PollRouteVisibleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.pollRouteVisibleMutex.Lock()
+	defer fake.pollRouteVisibleMutex.Unlock()
+	fake.PollRouteVisibleStub = nil
+	if fake.pollRouteVisibleReturnsOnCall == nil {
+		fake.pollRouteVisibleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.pollRouteVisibleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreateRouteActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -169,6 +245,8 @@ func (fake *FakeCreateRouteActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createRouteWithExistenceCheckMutex.RLock()
 	defer fake.createRouteWithExistenceCheckMutex.RUnlock()
+	fake.pollRouteVisibleMutex.RLock()
+	defer fake.pollRouteVisibleMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -30,6 +30,36 @@ type FakeCreateServiceActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServicesSummariesForSpaceStub        func(string) ([]v2action.ServiceSummary, v2action.Warnings, error)
+	getServicesSummariesForSpaceMutex       sync.RWMutex
+	getServicesSummariesForSpaceArgsForCall []struct {
+		arg1 string
+	}
+	getServicesSummariesForSpaceReturns struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServicesSummariesForSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollServiceInstanceOperationStub        func(v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	pollServiceInstanceOperationMutex       sync.RWMutex
+	pollServiceInstanceOperationArgsForCall []struct {
+		arg1 v2action.ServiceInstance
+	}
+	pollServiceInstanceOperationReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	pollServiceInstanceOperationReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -111,11 +141,129 @@ func (fake *FakeCreateServiceActor) CreateServiceInstanceReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCreateServiceActor) GetServicesSummariesForSpace(arg1 string) ([]v2action.ServiceSummary, v2action.Warnings, error) {
+	fake.getServicesSummariesForSpaceMutex.Lock()
+	ret, specificReturn := fake.getServicesSummariesForSpaceReturnsOnCall[len(fake.getServicesSummariesForSpaceArgsForCall)]
+	fake.getServicesSummariesForSpaceArgsForCall = append(fake.getServicesSummariesForSpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServicesSummariesForSpace", []interface{}{arg1})
+	fake.getServicesSummariesForSpaceMutex.Unlock()
+	if fake.GetServicesSummariesForSpaceStub != nil {
+		return fake.GetServicesSummariesForSpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServicesSummariesForSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreateServiceActor) GetServicesSummariesForSpaceCallCount() int {
+	fake.getServicesSummariesForSpaceMutex.RLock()
+	defer fake.getServicesSummariesForSpaceMutex.RUnlock()
+	return len(fake.getServicesSummariesForSpaceArgsForCall)
+}
+
+func (fake *FakeCreateServiceActor) GetServicesSummariesForSpaceCalls(stub func(string) ([]v2action.ServiceSummary, v2action.Warnings, error)) {
+	fake.getServicesSummariesForSpaceMutex.Lock()
+	defer fake.getServicesSummariesForSpaceMutex.Unlock()
+	fake.GetServicesSummariesForSpaceStub = stub
+}
+
+func (fake *FakeCreateServiceActor) GetServicesSummariesForSpaceArgsForCall(i int) string {
+	fake.getServicesSummariesForSpaceMutex.RLock()
+	defer fake.getServicesSummariesForSpaceMutex.RUnlock()
+	argsForCall := fake.getServicesSummariesForSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateServiceActor) GetServicesSummariesForSpaceReturns(result1 []v2action.ServiceSummary, result2 v2action.Warnings, result3 error) {
+	fake.getServicesSummariesForSpaceMutex.Lock()
+	defer fake.getServicesSummariesForSpaceMutex.Unlock()
+	fake.GetServicesSummariesForSpaceStub = nil
+	fake.getServicesSummariesForSpaceReturns = struct {
+		result1 []v2action.ServiceSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperation(arg1 v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceInstanceOperationReturnsOnCall[len(fake.pollServiceInstanceOperationArgsForCall)]
+	fake.pollServiceInstanceOperationArgsForCall = append(fake.pollServiceInstanceOperationArgsForCall, struct {
+		arg1 v2action.ServiceInstance
+	}{arg1})
+	fake.recordInvocation("PollServiceInstanceOperation", []interface{}{arg1})
+	fake.pollServiceInstanceOperationMutex.Unlock()
+	if fake.PollServiceInstanceOperationStub != nil {
+		return fake.PollServiceInstanceOperationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollServiceInstanceOperationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationCallCount() int {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return len(fake.pollServiceInstanceOperationArgsForCall)
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationCalls(stub func(v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = stub
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationArgsForCall(i int) v2action.ServiceInstance {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	argsForCall := fake.pollServiceInstanceOperationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = nil
+	fake.pollServiceInstanceOperationReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = nil
+	if fake.pollServiceInstanceOperationReturnsOnCall == nil {
+		fake.pollServiceInstanceOperationReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.pollServiceInstanceOperationReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCreateServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createServiceInstanceMutex.RLock()
 	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.getServicesSummariesForSpaceMutex.RLock()
+	defer fake.getServicesSummariesForSpaceMutex.RUnlock()
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -55,6 +55,19 @@ type FakeCreateSpaceActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	PollSpaceVisibleStub        func(v2action.Space) (v2action.Warnings, error)
+	pollSpaceVisibleMutex       sync.RWMutex
+	pollSpaceVisibleArgsForCall []struct {
+		arg1 v2action.Space
+	}
+	pollSpaceVisibleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	pollSpaceVisibleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -256,6 +269,69 @@ func (fake *FakeCreateSpaceActor) GrantSpaceManagerByUsernameReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCreateSpaceActor) PollSpaceVisible(arg1 v2action.Space) (v2action.Warnings, error) {
+	fake.pollSpaceVisibleMutex.Lock()
+	ret, specificReturn := fake.pollSpaceVisibleReturnsOnCall[len(fake.pollSpaceVisibleArgsForCall)]
+	fake.pollSpaceVisibleArgsForCall = append(fake.pollSpaceVisibleArgsForCall, struct {
+		arg1 v2action.Space
+	}{arg1})
+	fake.recordInvocation("PollSpaceVisible", []interface{}{arg1})
+	fake.pollSpaceVisibleMutex.Unlock()
+	if fake.PollSpaceVisibleStub != nil {
+		return fake.PollSpaceVisibleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollSpaceVisibleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreateSpaceActor) PollSpaceVisibleCallCount() int {
+	fake.pollSpaceVisibleMutex.RLock()
+	defer fake.pollSpaceVisibleMutex.RUnlock()
+	return len(fake.pollSpaceVisibleArgsForCall)
+}
+
+func (fake *FakeCreateSpaceActor) PollSpaceVisibleCalls(stub func(v2action.Space) (v2action.Warnings, error)) {
+	fake.pollSpaceVisibleMutex.Lock()
+	defer fake.pollSpaceVisibleMutex.Unlock()
+	fake.PollSpaceVisibleStub = stub
+}
+
+func (fake *FakeCreateSpaceActor) PollSpaceVisibleArgsForCall(i int) v2action.Space {
+	fake.pollSpaceVisibleMutex.RLock()
+	defer fake.pollSpaceVisibleMutex.RUnlock()
+	argsForCall := fake.pollSpaceVisibleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreateSpaceActor) PollSpaceVisibleReturns(result1 v2action.Warnings, result2 error) {
+	fake.pollSpaceVisibleMutex.Lock()
+	defer fake.pollSpaceVisibleMutex.Unlock()
+	fake.PollSpaceVisibleStub = nil
+	fake.pollSpaceVisibleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateSpaceActor) PollSpaceVisibleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.pollSpaceVisibleMutex.Lock()
+	defer fake.pollSpaceVisibleMutex.Unlock()
+	fake.PollSpaceVisibleStub = nil
+	if fake.pollSpaceVisibleReturnsOnCall == nil {
+		fake.pollSpaceVisibleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.pollSpaceVisibleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreateSpaceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -265,6 +341,8 @@ func (fake *FakeCreateSpaceActor) Invocations() map[string][][]interface{} {
 	defer fake.grantSpaceDeveloperByUsernameMutex.RUnlock()
 	fake.grantSpaceManagerByUsernameMutex.RLock()
 	defer fake.grantSpaceManagerByUsernameMutex.RUnlock()
+	fake.pollSpaceVisibleMutex.RLock()
+	defer fake.pollSpaceVisibleMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,91 +9,259 @@ import (
 )
 
 type FakeDeleteOrganizationActor struct {
-	DeleteOrganizationStub        func(string) (v2action.Warnings, error)
-	deleteOrganizationMutex       sync.RWMutex
-	deleteOrganizationArgsForCall []struct {
+	GetOrganizationDeletionReportStub        func(string) (v2action.DeletionReport, v2action.Warnings, error)
+	getOrganizationDeletionReportMutex       sync.RWMutex
+	getOrganizationDeletionReportArgsForCall []struct {
 		arg1 string
 	}
-	deleteOrganizationReturns struct {
+	getOrganizationDeletionReportReturns struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationDeletionReportReturnsOnCall map[int]struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollJobWithProgressStub        func(v2action.Job, func(v2action.JobProgress)) (v2action.Warnings, error)
+	pollJobWithProgressMutex       sync.RWMutex
+	pollJobWithProgressArgsForCall []struct {
+		arg1 v2action.Job
+		arg2 func(v2action.JobProgress)
+	}
+	pollJobWithProgressReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	deleteOrganizationReturnsOnCall map[int]struct {
+	pollJobWithProgressReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	StartOrganizationDeletionStub        func(string) (v2action.Job, v2action.Warnings, error)
+	startOrganizationDeletionMutex       sync.RWMutex
+	startOrganizationDeletionArgsForCall []struct {
+		arg1 string
+	}
+	startOrganizationDeletionReturns struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	startOrganizationDeletionReturnsOnCall map[int]struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganization(arg1 string) (v2action.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReport(arg1 string) (v2action.DeletionReport, v2action.Warnings, error) {
+	fake.getOrganizationDeletionReportMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDeletionReportReturnsOnCall[len(fake.getOrganizationDeletionReportArgsForCall)]
+	fake.getOrganizationDeletionReportArgsForCall = append(fake.getOrganizationDeletionReportArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("DeleteOrganization", []interface{}{arg1})
-	fake.deleteOrganizationMutex.Unlock()
-	if fake.DeleteOrganizationStub != nil {
-		return fake.DeleteOrganizationStub(arg1)
+	fake.recordInvocation("GetOrganizationDeletionReport", []interface{}{arg1})
+	fake.getOrganizationDeletionReportMutex.Unlock()
+	if fake.GetOrganizationDeletionReportStub != nil {
+		return fake.GetOrganizationDeletionReportStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationDeletionReportReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReportCallCount() int {
+	fake.getOrganizationDeletionReportMutex.RLock()
+	defer fake.getOrganizationDeletionReportMutex.RUnlock()
+	return len(fake.getOrganizationDeletionReportArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReportCalls(stub func(string) (v2action.DeletionReport, v2action.Warnings, error)) {
+	fake.getOrganizationDeletionReportMutex.Lock()
+	defer fake.getOrganizationDeletionReportMutex.Unlock()
+	fake.GetOrganizationDeletionReportStub = stub
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReportArgsForCall(i int) string {
+	fake.getOrganizationDeletionReportMutex.RLock()
+	defer fake.getOrganizationDeletionReportMutex.RUnlock()
+	argsForCall := fake.getOrganizationDeletionReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReportReturns(result1 v2action.DeletionReport, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationDeletionReportMutex.Lock()
+	defer fake.getOrganizationDeletionReportMutex.Unlock()
+	fake.GetOrganizationDeletionReportStub = nil
+	fake.getOrganizationDeletionReportReturns = struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) GetOrganizationDeletionReportReturnsOnCall(i int, result1 v2action.DeletionReport, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationDeletionReportMutex.Lock()
+	defer fake.getOrganizationDeletionReportMutex.Unlock()
+	fake.GetOrganizationDeletionReportStub = nil
+	if fake.getOrganizationDeletionReportReturnsOnCall == nil {
+		fake.getOrganizationDeletionReportReturnsOnCall = make(map[int]struct {
+			result1 v2action.DeletionReport
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDeletionReportReturnsOnCall[i] = struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgress(arg1 v2action.Job, arg2 func(v2action.JobProgress)) (v2action.Warnings, error) {
+	fake.pollJobWithProgressMutex.Lock()
+	ret, specificReturn := fake.pollJobWithProgressReturnsOnCall[len(fake.pollJobWithProgressArgsForCall)]
+	fake.pollJobWithProgressArgsForCall = append(fake.pollJobWithProgressArgsForCall, struct {
+		arg1 v2action.Job
+		arg2 func(v2action.JobProgress)
+	}{arg1, arg2})
+	fake.recordInvocation("PollJobWithProgress", []interface{}{arg1, arg2})
+	fake.pollJobWithProgressMutex.Unlock()
+	if fake.PollJobWithProgressStub != nil {
+		return fake.PollJobWithProgressStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteOrganizationReturns
+	fakeReturns := fake.pollJobWithProgressReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationCallCount() int {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	return len(fake.deleteOrganizationArgsForCall)
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgressCallCount() int {
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	return len(fake.pollJobWithProgressArgsForCall)
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationCalls(stub func(string) (v2action.Warnings, error)) {
-	fake.deleteOrganizationMutex.Lock()
-	defer fake.deleteOrganizationMutex.Unlock()
-	fake.DeleteOrganizationStub = stub
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgressCalls(stub func(v2action.Job, func(v2action.JobProgress)) (v2action.Warnings, error)) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = stub
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationArgsForCall(i int) string {
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
-	argsForCall := fake.deleteOrganizationArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgressArgsForCall(i int) (v2action.Job, func(v2action.JobProgress)) {
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	argsForCall := fake.pollJobWithProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturns(result1 v2action.Warnings, result2 error) {
-	fake.deleteOrganizationMutex.Lock()
-	defer fake.deleteOrganizationMutex.Unlock()
-	fake.DeleteOrganizationStub = nil
-	fake.deleteOrganizationReturns = struct {
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgressReturns(result1 v2action.Warnings, result2 error) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = nil
+	fake.pollJobWithProgressReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDeleteOrganizationActor) DeleteOrganizationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
-	fake.deleteOrganizationMutex.Lock()
-	defer fake.deleteOrganizationMutex.Unlock()
-	fake.DeleteOrganizationStub = nil
-	if fake.deleteOrganizationReturnsOnCall == nil {
-		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+func (fake *FakeDeleteOrganizationActor) PollJobWithProgressReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = nil
+	if fake.pollJobWithProgressReturnsOnCall == nil {
+		fake.pollJobWithProgressReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.deleteOrganizationReturnsOnCall[i] = struct {
+	fake.pollJobWithProgressReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletion(arg1 string) (v2action.Job, v2action.Warnings, error) {
+	fake.startOrganizationDeletionMutex.Lock()
+	ret, specificReturn := fake.startOrganizationDeletionReturnsOnCall[len(fake.startOrganizationDeletionArgsForCall)]
+	fake.startOrganizationDeletionArgsForCall = append(fake.startOrganizationDeletionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("StartOrganizationDeletion", []interface{}{arg1})
+	fake.startOrganizationDeletionMutex.Unlock()
+	if fake.StartOrganizationDeletionStub != nil {
+		return fake.StartOrganizationDeletionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.startOrganizationDeletionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletionCallCount() int {
+	fake.startOrganizationDeletionMutex.RLock()
+	defer fake.startOrganizationDeletionMutex.RUnlock()
+	return len(fake.startOrganizationDeletionArgsForCall)
+}
+
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletionCalls(stub func(string) (v2action.Job, v2action.Warnings, error)) {
+	fake.startOrganizationDeletionMutex.Lock()
+	defer fake.startOrganizationDeletionMutex.Unlock()
+	fake.StartOrganizationDeletionStub = stub
+}
+
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletionArgsForCall(i int) string {
+	fake.startOrganizationDeletionMutex.RLock()
+	defer fake.startOrganizationDeletionMutex.RUnlock()
+	argsForCall := fake.startOrganizationDeletionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletionReturns(result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.startOrganizationDeletionMutex.Lock()
+	defer fake.startOrganizationDeletionMutex.Unlock()
+	fake.StartOrganizationDeletionStub = nil
+	fake.startOrganizationDeletionReturns = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteOrganizationActor) StartOrganizationDeletionReturnsOnCall(i int, result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.startOrganizationDeletionMutex.Lock()
+	defer fake.startOrganizationDeletionMutex.Unlock()
+	fake.StartOrganizationDeletionStub = nil
+	if fake.startOrganizationDeletionReturnsOnCall == nil {
+		fake.startOrganizationDeletionReturnsOnCall = make(map[int]struct {
+			result1 v2action.Job
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.startOrganizationDeletionReturnsOnCall[i] = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeleteOrganizationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.deleteOrganizationMutex.RLock()
-	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.getOrganizationDeletionReportMutex.RLock()
+	defer fake.getOrganizationDeletionReportMutex.RUnlock()
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	fake.startOrganizationDeletionMutex.RLock()
+	defer fake.startOrganizationDeletionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
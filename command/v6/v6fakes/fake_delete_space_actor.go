@@ -9,93 +9,263 @@ import (
 )
 
 type FakeDeleteSpaceActor struct {
-	DeleteSpaceByNameAndOrganizationNameStub        func(string, string) (v2action.Warnings, error)
-	deleteSpaceByNameAndOrganizationNameMutex       sync.RWMutex
-	deleteSpaceByNameAndOrganizationNameArgsForCall []struct {
+	GetSpaceDeletionReportStub        func(string, string) (v2action.DeletionReport, v2action.Warnings, error)
+	getSpaceDeletionReportMutex       sync.RWMutex
+	getSpaceDeletionReportArgsForCall []struct {
 		arg1 string
 		arg2 string
 	}
-	deleteSpaceByNameAndOrganizationNameReturns struct {
+	getSpaceDeletionReportReturns struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceDeletionReportReturnsOnCall map[int]struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollJobWithProgressStub        func(v2action.Job, func(v2action.JobProgress)) (v2action.Warnings, error)
+	pollJobWithProgressMutex       sync.RWMutex
+	pollJobWithProgressArgsForCall []struct {
+		arg1 v2action.Job
+		arg2 func(v2action.JobProgress)
+	}
+	pollJobWithProgressReturns struct {
 		result1 v2action.Warnings
 		result2 error
 	}
-	deleteSpaceByNameAndOrganizationNameReturnsOnCall map[int]struct {
+	pollJobWithProgressReturnsOnCall map[int]struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	StartSpaceDeletionStub        func(string, string) (v2action.Job, v2action.Warnings, error)
+	startSpaceDeletionMutex       sync.RWMutex
+	startSpaceDeletionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	startSpaceDeletionReturns struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
+	startSpaceDeletionReturnsOnCall map[int]struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationName(arg1 string, arg2 string) (v2action.Warnings, error) {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.Lock()
-	ret, specificReturn := fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall[len(fake.deleteSpaceByNameAndOrganizationNameArgsForCall)]
-	fake.deleteSpaceByNameAndOrganizationNameArgsForCall = append(fake.deleteSpaceByNameAndOrganizationNameArgsForCall, struct {
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReport(arg1 string, arg2 string) (v2action.DeletionReport, v2action.Warnings, error) {
+	fake.getSpaceDeletionReportMutex.Lock()
+	ret, specificReturn := fake.getSpaceDeletionReportReturnsOnCall[len(fake.getSpaceDeletionReportArgsForCall)]
+	fake.getSpaceDeletionReportArgsForCall = append(fake.getSpaceDeletionReportArgsForCall, struct {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
-	fake.recordInvocation("DeleteSpaceByNameAndOrganizationName", []interface{}{arg1, arg2})
-	fake.deleteSpaceByNameAndOrganizationNameMutex.Unlock()
-	if fake.DeleteSpaceByNameAndOrganizationNameStub != nil {
-		return fake.DeleteSpaceByNameAndOrganizationNameStub(arg1, arg2)
+	fake.recordInvocation("GetSpaceDeletionReport", []interface{}{arg1, arg2})
+	fake.getSpaceDeletionReportMutex.Unlock()
+	if fake.GetSpaceDeletionReportStub != nil {
+		return fake.GetSpaceDeletionReportStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceDeletionReportReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReportCallCount() int {
+	fake.getSpaceDeletionReportMutex.RLock()
+	defer fake.getSpaceDeletionReportMutex.RUnlock()
+	return len(fake.getSpaceDeletionReportArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReportCalls(stub func(string, string) (v2action.DeletionReport, v2action.Warnings, error)) {
+	fake.getSpaceDeletionReportMutex.Lock()
+	defer fake.getSpaceDeletionReportMutex.Unlock()
+	fake.GetSpaceDeletionReportStub = stub
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReportArgsForCall(i int) (string, string) {
+	fake.getSpaceDeletionReportMutex.RLock()
+	defer fake.getSpaceDeletionReportMutex.RUnlock()
+	argsForCall := fake.getSpaceDeletionReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReportReturns(result1 v2action.DeletionReport, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceDeletionReportMutex.Lock()
+	defer fake.getSpaceDeletionReportMutex.Unlock()
+	fake.GetSpaceDeletionReportStub = nil
+	fake.getSpaceDeletionReportReturns = struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) GetSpaceDeletionReportReturnsOnCall(i int, result1 v2action.DeletionReport, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceDeletionReportMutex.Lock()
+	defer fake.getSpaceDeletionReportMutex.Unlock()
+	fake.GetSpaceDeletionReportStub = nil
+	if fake.getSpaceDeletionReportReturnsOnCall == nil {
+		fake.getSpaceDeletionReportReturnsOnCall = make(map[int]struct {
+			result1 v2action.DeletionReport
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceDeletionReportReturnsOnCall[i] = struct {
+		result1 v2action.DeletionReport
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) PollJobWithProgress(arg1 v2action.Job, arg2 func(v2action.JobProgress)) (v2action.Warnings, error) {
+	fake.pollJobWithProgressMutex.Lock()
+	ret, specificReturn := fake.pollJobWithProgressReturnsOnCall[len(fake.pollJobWithProgressArgsForCall)]
+	fake.pollJobWithProgressArgsForCall = append(fake.pollJobWithProgressArgsForCall, struct {
+		arg1 v2action.Job
+		arg2 func(v2action.JobProgress)
+	}{arg1, arg2})
+	fake.recordInvocation("PollJobWithProgress", []interface{}{arg1, arg2})
+	fake.pollJobWithProgressMutex.Unlock()
+	if fake.PollJobWithProgressStub != nil {
+		return fake.PollJobWithProgressStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteSpaceByNameAndOrganizationNameReturns
+	fakeReturns := fake.pollJobWithProgressReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameCallCount() int {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
-	return len(fake.deleteSpaceByNameAndOrganizationNameArgsForCall)
+func (fake *FakeDeleteSpaceActor) PollJobWithProgressCallCount() int {
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	return len(fake.pollJobWithProgressArgsForCall)
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameCalls(stub func(string, string) (v2action.Warnings, error)) {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.Lock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.Unlock()
-	fake.DeleteSpaceByNameAndOrganizationNameStub = stub
+func (fake *FakeDeleteSpaceActor) PollJobWithProgressCalls(stub func(v2action.Job, func(v2action.JobProgress)) (v2action.Warnings, error)) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = stub
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameArgsForCall(i int) (string, string) {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
-	argsForCall := fake.deleteSpaceByNameAndOrganizationNameArgsForCall[i]
+func (fake *FakeDeleteSpaceActor) PollJobWithProgressArgsForCall(i int) (v2action.Job, func(v2action.JobProgress)) {
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	argsForCall := fake.pollJobWithProgressArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameReturns(result1 v2action.Warnings, result2 error) {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.Lock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.Unlock()
-	fake.DeleteSpaceByNameAndOrganizationNameStub = nil
-	fake.deleteSpaceByNameAndOrganizationNameReturns = struct {
+func (fake *FakeDeleteSpaceActor) PollJobWithProgressReturns(result1 v2action.Warnings, result2 error) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = nil
+	fake.pollJobWithProgressReturns = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDeleteSpaceActor) DeleteSpaceByNameAndOrganizationNameReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
-	fake.deleteSpaceByNameAndOrganizationNameMutex.Lock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.Unlock()
-	fake.DeleteSpaceByNameAndOrganizationNameStub = nil
-	if fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall == nil {
-		fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall = make(map[int]struct {
+func (fake *FakeDeleteSpaceActor) PollJobWithProgressReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.pollJobWithProgressMutex.Lock()
+	defer fake.pollJobWithProgressMutex.Unlock()
+	fake.PollJobWithProgressStub = nil
+	if fake.pollJobWithProgressReturnsOnCall == nil {
+		fake.pollJobWithProgressReturnsOnCall = make(map[int]struct {
 			result1 v2action.Warnings
 			result2 error
 		})
 	}
-	fake.deleteSpaceByNameAndOrganizationNameReturnsOnCall[i] = struct {
+	fake.pollJobWithProgressReturnsOnCall[i] = struct {
 		result1 v2action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletion(arg1 string, arg2 string) (v2action.Job, v2action.Warnings, error) {
+	fake.startSpaceDeletionMutex.Lock()
+	ret, specificReturn := fake.startSpaceDeletionReturnsOnCall[len(fake.startSpaceDeletionArgsForCall)]
+	fake.startSpaceDeletionArgsForCall = append(fake.startSpaceDeletionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("StartSpaceDeletion", []interface{}{arg1, arg2})
+	fake.startSpaceDeletionMutex.Unlock()
+	if fake.StartSpaceDeletionStub != nil {
+		return fake.StartSpaceDeletionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.startSpaceDeletionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletionCallCount() int {
+	fake.startSpaceDeletionMutex.RLock()
+	defer fake.startSpaceDeletionMutex.RUnlock()
+	return len(fake.startSpaceDeletionArgsForCall)
+}
+
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletionCalls(stub func(string, string) (v2action.Job, v2action.Warnings, error)) {
+	fake.startSpaceDeletionMutex.Lock()
+	defer fake.startSpaceDeletionMutex.Unlock()
+	fake.StartSpaceDeletionStub = stub
+}
+
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletionArgsForCall(i int) (string, string) {
+	fake.startSpaceDeletionMutex.RLock()
+	defer fake.startSpaceDeletionMutex.RUnlock()
+	argsForCall := fake.startSpaceDeletionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletionReturns(result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.startSpaceDeletionMutex.Lock()
+	defer fake.startSpaceDeletionMutex.Unlock()
+	fake.StartSpaceDeletionStub = nil
+	fake.startSpaceDeletionReturns = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeleteSpaceActor) StartSpaceDeletionReturnsOnCall(i int, result1 v2action.Job, result2 v2action.Warnings, result3 error) {
+	fake.startSpaceDeletionMutex.Lock()
+	defer fake.startSpaceDeletionMutex.Unlock()
+	fake.StartSpaceDeletionStub = nil
+	if fake.startSpaceDeletionReturnsOnCall == nil {
+		fake.startSpaceDeletionReturnsOnCall = make(map[int]struct {
+			result1 v2action.Job
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.startSpaceDeletionReturnsOnCall[i] = struct {
+		result1 v2action.Job
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDeleteSpaceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.deleteSpaceByNameAndOrganizationNameMutex.RLock()
-	defer fake.deleteSpaceByNameAndOrganizationNameMutex.RUnlock()
+	fake.getSpaceDeletionReportMutex.RLock()
+	defer fake.getSpaceDeletionReportMutex.RUnlock()
+	fake.pollJobWithProgressMutex.RLock()
+	defer fake.pollJobWithProgressMutex.RUnlock()
+	fake.startSpaceDeletionMutex.RLock()
+	defer fake.startSpaceDeletionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
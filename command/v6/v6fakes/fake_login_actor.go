@@ -3,6 +3,7 @@ package v6fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -24,6 +25,44 @@ type FakeLoginActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithDeviceAuthorizationStub        func(v3action.DeviceAuthorization, time.Duration) error
+	authenticateWithDeviceAuthorizationMutex       sync.RWMutex
+	authenticateWithDeviceAuthorizationArgsForCall []struct {
+		arg1 v3action.DeviceAuthorization
+		arg2 time.Duration
+	}
+	authenticateWithDeviceAuthorizationReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateWithRefreshTokenStub        func(string) error
+	authenticateWithRefreshTokenMutex       sync.RWMutex
+	authenticateWithRefreshTokenArgsForCall []struct {
+		arg1 string
+	}
+	authenticateWithRefreshTokenReturns struct {
+		result1 error
+	}
+	authenticateWithRefreshTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthorizationCodeURLStub        func(string, string, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetLoginPromptsStub        func() map[string]coreconfig.AuthPrompt
 	getLoginPromptsMutex       sync.RWMutex
 	getLoginPromptsArgsForCall []struct {
@@ -34,6 +73,78 @@ type FakeLoginActor struct {
 	getLoginPromptsReturnsOnCall map[int]struct {
 		result1 map[string]coreconfig.AuthPrompt
 	}
+	GetOrganizationByNameStub        func(string) (v3action.Organization, v3action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetOrganizationSpacesStub        func(string) ([]v3action.Space, v3action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetOrganizationsStub        func() ([]v3action.Organization, v3action.Warnings, error)
+	getOrganizationsMutex       sync.RWMutex
+	getOrganizationsArgsForCall []struct {
+	}
+	getOrganizationsReturns struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationsReturnsOnCall map[int]struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetSpaceByNameAndOrganizationStub        func(string, string) (v3action.Space, v3action.Warnings, error)
+	getSpaceByNameAndOrganizationMutex       sync.RWMutex
+	getSpaceByNameAndOrganizationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceByNameAndOrganizationReturns struct {
+		result1 v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}
+	getSpaceByNameAndOrganizationReturnsOnCall map[int]struct {
+		result1 v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}
+	RefreshLoginPromptsStub        func() (map[string]coreconfig.AuthPrompt, error)
+	refreshLoginPromptsMutex       sync.RWMutex
+	refreshLoginPromptsArgsForCall []struct {
+	}
+	refreshLoginPromptsReturns struct {
+		result1 map[string]coreconfig.AuthPrompt
+		result2 error
+	}
+	refreshLoginPromptsReturnsOnCall map[int]struct {
+		result1 map[string]coreconfig.AuthPrompt
+		result2 error
+	}
 	SetTargetStub        func(v3action.TargetSettings) (v3action.Warnings, error)
 	setTargetMutex       sync.RWMutex
 	setTargetArgsForCall []struct {
@@ -47,6 +158,29 @@ type FakeLoginActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	StartDeviceAuthorizationStub        func() (v3action.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}
+	VerifyAuthorizationCodeRedirectStub        func(string) error
+	verifyAuthorizationCodeRedirectMutex       sync.RWMutex
+	verifyAuthorizationCodeRedirectArgsForCall []struct {
+		arg1 string
+	}
+	verifyAuthorizationCodeRedirectReturns struct {
+		result1 error
+	}
+	verifyAuthorizationCodeRedirectReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -113,6 +247,192 @@ func (fake *FakeLoginActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorization(arg1 v3action.DeviceAuthorization, arg2 time.Duration) error {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceAuthorizationReturnsOnCall[len(fake.authenticateWithDeviceAuthorizationArgsForCall)]
+	fake.authenticateWithDeviceAuthorizationArgsForCall = append(fake.authenticateWithDeviceAuthorizationArgsForCall, struct {
+		arg1 v3action.DeviceAuthorization
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("AuthenticateWithDeviceAuthorization", []interface{}{arg1, arg2})
+	fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	if fake.AuthenticateWithDeviceAuthorizationStub != nil {
+		return fake.AuthenticateWithDeviceAuthorizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceAuthorizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationCallCount() int {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	return len(fake.authenticateWithDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationCalls(stub func(v3action.DeviceAuthorization, time.Duration) error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationArgsForCall(i int) (v3action.DeviceAuthorization, time.Duration) {
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceAuthorizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationReturns(result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	fake.authenticateWithDeviceAuthorizationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceAuthorizationReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceAuthorizationMutex.Lock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.Unlock()
+	fake.AuthenticateWithDeviceAuthorizationStub = nil
+	if fake.authenticateWithDeviceAuthorizationReturnsOnCall == nil {
+		fake.authenticateWithDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshToken(arg1 string) error {
+	fake.authenticateWithRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.authenticateWithRefreshTokenReturnsOnCall[len(fake.authenticateWithRefreshTokenArgsForCall)]
+	fake.authenticateWithRefreshTokenArgsForCall = append(fake.authenticateWithRefreshTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithRefreshToken", []interface{}{arg1})
+	fake.authenticateWithRefreshTokenMutex.Unlock()
+	if fake.AuthenticateWithRefreshTokenStub != nil {
+		return fake.AuthenticateWithRefreshTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithRefreshTokenReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshTokenCallCount() int {
+	fake.authenticateWithRefreshTokenMutex.RLock()
+	defer fake.authenticateWithRefreshTokenMutex.RUnlock()
+	return len(fake.authenticateWithRefreshTokenArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshTokenCalls(stub func(string) error) {
+	fake.authenticateWithRefreshTokenMutex.Lock()
+	defer fake.authenticateWithRefreshTokenMutex.Unlock()
+	fake.AuthenticateWithRefreshTokenStub = stub
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshTokenArgsForCall(i int) string {
+	fake.authenticateWithRefreshTokenMutex.RLock()
+	defer fake.authenticateWithRefreshTokenMutex.RUnlock()
+	argsForCall := fake.authenticateWithRefreshTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshTokenReturns(result1 error) {
+	fake.authenticateWithRefreshTokenMutex.Lock()
+	defer fake.authenticateWithRefreshTokenMutex.Unlock()
+	fake.AuthenticateWithRefreshTokenStub = nil
+	fake.authenticateWithRefreshTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithRefreshTokenReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithRefreshTokenMutex.Lock()
+	defer fake.authenticateWithRefreshTokenMutex.Unlock()
+	fake.AuthenticateWithRefreshTokenStub = nil
+	if fake.authenticateWithRefreshTokenReturnsOnCall == nil {
+		fake.authenticateWithRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithRefreshTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.authorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLoginActor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	fake.getLoginPromptsMutex.Lock()
 	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
@@ -165,6 +485,318 @@ func (fake *FakeLoginActor) GetLoginPromptsReturnsOnCall(i int, result1 map[stri
 	}{result1}
 }
 
+func (fake *FakeLoginActor) GetOrganizationByName(arg1 string) (v3action.Organization, v3action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{arg1})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLoginActor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetOrganizationByNameCalls(stub func(string) (v3action.Organization, v3action.Warnings, error)) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = stub
+}
+
+func (fake *FakeLoginActor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	argsForCall := fake.getOrganizationByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) GetOrganizationByNameReturns(result1 v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetOrganizationByNameReturnsOnCall(i int, result1 v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.Organization
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpaces(arg1 string) ([]v3action.Space, v3action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{arg1})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpacesCalls(stub func(string) ([]v3action.Space, v3action.Warnings, error)) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = stub
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	argsForCall := fake.getOrganizationSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpacesReturns(result1 []v3action.Space, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v3action.Space, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Space
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetOrganizations() ([]v3action.Organization, v3action.Warnings, error) {
+	fake.getOrganizationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
+	fake.getOrganizationsArgsForCall = append(fake.getOrganizationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetOrganizations", []interface{}{})
+	fake.getOrganizationsMutex.Unlock()
+	if fake.GetOrganizationsStub != nil {
+		return fake.GetOrganizationsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLoginActor) GetOrganizationsCallCount() int {
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	return len(fake.getOrganizationsArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetOrganizationsCalls(stub func() ([]v3action.Organization, v3action.Warnings, error)) {
+	fake.getOrganizationsMutex.Lock()
+	defer fake.getOrganizationsMutex.Unlock()
+	fake.GetOrganizationsStub = stub
+}
+
+func (fake *FakeLoginActor) GetOrganizationsReturns(result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationsMutex.Lock()
+	defer fake.getOrganizationsMutex.Unlock()
+	fake.GetOrganizationsStub = nil
+	fake.getOrganizationsReturns = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetOrganizationsReturnsOnCall(i int, result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.getOrganizationsMutex.Lock()
+	defer fake.getOrganizationsMutex.Unlock()
+	fake.GetOrganizationsStub = nil
+	if fake.getOrganizationsReturnsOnCall == nil {
+		fake.getOrganizationsReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Organization
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsReturnsOnCall[i] = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (v3action.Space, v3action.Warnings, error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
+	fake.getSpaceByNameAndOrganizationArgsForCall = append(fake.getSpaceByNameAndOrganizationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceByNameAndOrganization", []interface{}{arg1, arg2})
+	fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	if fake.GetSpaceByNameAndOrganizationStub != nil {
+		return fake.GetSpaceByNameAndOrganizationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceByNameAndOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganizationCallCount() int {
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	return len(fake.getSpaceByNameAndOrganizationArgsForCall)
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganizationCalls(stub func(string, string) (v3action.Space, v3action.Warnings, error)) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = stub
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganizationArgsForCall(i int) (string, string) {
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	argsForCall := fake.getSpaceByNameAndOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganizationReturns(result1 v3action.Space, result2 v3action.Warnings, result3 error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = nil
+	fake.getSpaceByNameAndOrganizationReturns = struct {
+		result1 v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) GetSpaceByNameAndOrganizationReturnsOnCall(i int, result1 v3action.Space, result2 v3action.Warnings, result3 error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	defer fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	fake.GetSpaceByNameAndOrganizationStub = nil
+	if fake.getSpaceByNameAndOrganizationReturnsOnCall == nil {
+		fake.getSpaceByNameAndOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Space
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByNameAndOrganizationReturnsOnCall[i] = struct {
+		result1 v3action.Space
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLoginActor) RefreshLoginPrompts() (map[string]coreconfig.AuthPrompt, error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	ret, specificReturn := fake.refreshLoginPromptsReturnsOnCall[len(fake.refreshLoginPromptsArgsForCall)]
+	fake.refreshLoginPromptsArgsForCall = append(fake.refreshLoginPromptsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RefreshLoginPrompts", []interface{}{})
+	fake.refreshLoginPromptsMutex.Unlock()
+	if fake.RefreshLoginPromptsStub != nil {
+		return fake.RefreshLoginPromptsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.refreshLoginPromptsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) RefreshLoginPromptsCallCount() int {
+	fake.refreshLoginPromptsMutex.RLock()
+	defer fake.refreshLoginPromptsMutex.RUnlock()
+	return len(fake.refreshLoginPromptsArgsForCall)
+}
+
+func (fake *FakeLoginActor) RefreshLoginPromptsCalls(stub func() (map[string]coreconfig.AuthPrompt, error)) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = stub
+}
+
+func (fake *FakeLoginActor) RefreshLoginPromptsReturns(result1 map[string]coreconfig.AuthPrompt, result2 error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = nil
+	fake.refreshLoginPromptsReturns = struct {
+		result1 map[string]coreconfig.AuthPrompt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) RefreshLoginPromptsReturnsOnCall(i int, result1 map[string]coreconfig.AuthPrompt, result2 error) {
+	fake.refreshLoginPromptsMutex.Lock()
+	defer fake.refreshLoginPromptsMutex.Unlock()
+	fake.RefreshLoginPromptsStub = nil
+	if fake.refreshLoginPromptsReturnsOnCall == nil {
+		fake.refreshLoginPromptsReturnsOnCall = make(map[int]struct {
+			result1 map[string]coreconfig.AuthPrompt
+			result2 error
+		})
+	}
+	fake.refreshLoginPromptsReturnsOnCall[i] = struct {
+		result1 map[string]coreconfig.AuthPrompt
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLoginActor) SetTarget(arg1 v3action.TargetSettings) (v3action.Warnings, error) {
 	fake.setTargetMutex.Lock()
 	ret, specificReturn := fake.setTargetReturnsOnCall[len(fake.setTargetArgsForCall)]
@@ -228,15 +860,150 @@ func (fake *FakeLoginActor) SetTargetReturnsOnCall(i int, result1 v3action.Warni
 	}{result1, result2}
 }
 
+func (fake *FakeLoginActor) StartDeviceAuthorization() (v3action.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if fake.StartDeviceAuthorizationStub != nil {
+		return fake.StartDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeLoginActor) StartDeviceAuthorizationCalls(stub func() (v3action.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeLoginActor) StartDeviceAuthorizationReturns(result1 v3action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) StartDeviceAuthorizationReturnsOnCall(i int, result1 v3action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v3action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v3action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirect(arg1 string) error {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	ret, specificReturn := fake.verifyAuthorizationCodeRedirectReturnsOnCall[len(fake.verifyAuthorizationCodeRedirectArgsForCall)]
+	fake.verifyAuthorizationCodeRedirectArgsForCall = append(fake.verifyAuthorizationCodeRedirectArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("VerifyAuthorizationCodeRedirect", []interface{}{arg1})
+	fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	if fake.VerifyAuthorizationCodeRedirectStub != nil {
+		return fake.VerifyAuthorizationCodeRedirectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.verifyAuthorizationCodeRedirectReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirectCallCount() int {
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
+	return len(fake.verifyAuthorizationCodeRedirectArgsForCall)
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirectCalls(stub func(string) error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = stub
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirectArgsForCall(i int) string {
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
+	argsForCall := fake.verifyAuthorizationCodeRedirectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirectReturns(result1 error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = nil
+	fake.verifyAuthorizationCodeRedirectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) VerifyAuthorizationCodeRedirectReturnsOnCall(i int, result1 error) {
+	fake.verifyAuthorizationCodeRedirectMutex.Lock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.Unlock()
+	fake.VerifyAuthorizationCodeRedirectStub = nil
+	if fake.verifyAuthorizationCodeRedirectReturnsOnCall == nil {
+		fake.verifyAuthorizationCodeRedirectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyAuthorizationCodeRedirectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeLoginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithDeviceAuthorizationMutex.RLock()
+	defer fake.authenticateWithDeviceAuthorizationMutex.RUnlock()
+	fake.authenticateWithRefreshTokenMutex.RLock()
+	defer fake.authenticateWithRefreshTokenMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.getLoginPromptsMutex.RLock()
 	defer fake.getLoginPromptsMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getOrganizationsMutex.RLock()
+	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getSpaceByNameAndOrganizationMutex.RLock()
+	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
+	fake.refreshLoginPromptsMutex.RLock()
+	defer fake.refreshLoginPromptsMutex.RUnlock()
 	fake.setTargetMutex.RLock()
 	defer fake.setTargetMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	fake.verifyAuthorizationCodeRedirectMutex.RLock()
+	defer fake.verifyAuthorizationCodeRedirectMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
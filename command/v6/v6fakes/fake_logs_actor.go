@@ -9,6 +9,16 @@ import (
 )
 
 type FakeLogsActor struct {
+	DiagnoseLogConnectionStub        func() v2action.LogConnectionDiagnosis
+	diagnoseLogConnectionMutex       sync.RWMutex
+	diagnoseLogConnectionArgsForCall []struct {
+	}
+	diagnoseLogConnectionReturns struct {
+		result1 v2action.LogConnectionDiagnosis
+	}
+	diagnoseLogConnectionReturnsOnCall map[int]struct {
+		result1 v2action.LogConnectionDiagnosis
+	}
 	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -26,6 +36,23 @@ type FakeLogsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub        func(string, string, v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogCacheClient
+	}
+	getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -45,10 +72,81 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub        func(string, string, v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogCacheClient
+	}
+	getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeLogsActor) DiagnoseLogConnection() v2action.LogConnectionDiagnosis {
+	fake.diagnoseLogConnectionMutex.Lock()
+	ret, specificReturn := fake.diagnoseLogConnectionReturnsOnCall[len(fake.diagnoseLogConnectionArgsForCall)]
+	fake.diagnoseLogConnectionArgsForCall = append(fake.diagnoseLogConnectionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DiagnoseLogConnection", []interface{}{})
+	fake.diagnoseLogConnectionMutex.Unlock()
+	if fake.DiagnoseLogConnectionStub != nil {
+		return fake.DiagnoseLogConnectionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.diagnoseLogConnectionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLogsActor) DiagnoseLogConnectionCallCount() int {
+	fake.diagnoseLogConnectionMutex.RLock()
+	defer fake.diagnoseLogConnectionMutex.RUnlock()
+	return len(fake.diagnoseLogConnectionArgsForCall)
+}
+
+func (fake *FakeLogsActor) DiagnoseLogConnectionCalls(stub func() v2action.LogConnectionDiagnosis) {
+	fake.diagnoseLogConnectionMutex.Lock()
+	defer fake.diagnoseLogConnectionMutex.Unlock()
+	fake.DiagnoseLogConnectionStub = stub
+}
+
+func (fake *FakeLogsActor) DiagnoseLogConnectionReturns(result1 v2action.LogConnectionDiagnosis) {
+	fake.diagnoseLogConnectionMutex.Lock()
+	defer fake.diagnoseLogConnectionMutex.Unlock()
+	fake.DiagnoseLogConnectionStub = nil
+	fake.diagnoseLogConnectionReturns = struct {
+		result1 v2action.LogConnectionDiagnosis
+	}{result1}
+}
+
+func (fake *FakeLogsActor) DiagnoseLogConnectionReturnsOnCall(i int, result1 v2action.LogConnectionDiagnosis) {
+	fake.diagnoseLogConnectionMutex.Lock()
+	defer fake.diagnoseLogConnectionMutex.Unlock()
+	fake.DiagnoseLogConnectionStub = nil
+	if fake.diagnoseLogConnectionReturnsOnCall == nil {
+		fake.diagnoseLogConnectionReturnsOnCall = make(map[int]struct {
+			result1 v2action.LogConnectionDiagnosis
+		})
+	}
+	fake.diagnoseLogConnectionReturnsOnCall[i] = struct {
+		result1 v2action.LogConnectionDiagnosis
+	}{result1}
+}
+
 func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -117,6 +215,74 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error) {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall)]
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogCacheClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRecentLogsFromLogCacheForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub != nil {
+		return fake.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpaceCallCount() int {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)) {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogCacheClient) {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub = nil
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsFromLogCacheForApplicationByNameAndSpaceStub = nil
+	if fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -188,13 +354,90 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogCacheClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsFromLogCacheForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.LogCacheClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)) {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogCacheClient) {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub = nil
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsFromLogCacheForApplicationByNameAndSpaceStub = nil
+	if fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.diagnoseLogConnectionMutex.RLock()
+	defer fake.diagnoseLogConnectionMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsFromLogCacheForApplicationByNameAndSpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
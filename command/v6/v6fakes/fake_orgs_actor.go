@@ -23,6 +23,21 @@ type FakeOrgsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetUserOrganizationRolesStub        func(string) (v2action.UserRoles, v2action.Warnings, error)
+	getUserOrganizationRolesMutex       sync.RWMutex
+	getUserOrganizationRolesArgsForCall []struct {
+		arg1 string
+	}
+	getUserOrganizationRolesReturns struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUserOrganizationRolesReturnsOnCall map[int]struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -85,11 +100,79 @@ func (fake *FakeOrgsActor) GetOrganizationsReturnsOnCall(i int, result1 []v2acti
 	}{result1, result2, result3}
 }
 
+func (fake *FakeOrgsActor) GetUserOrganizationRoles(arg1 string) (v2action.UserRoles, v2action.Warnings, error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	ret, specificReturn := fake.getUserOrganizationRolesReturnsOnCall[len(fake.getUserOrganizationRolesArgsForCall)]
+	fake.getUserOrganizationRolesArgsForCall = append(fake.getUserOrganizationRolesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetUserOrganizationRoles", []interface{}{arg1})
+	fake.getUserOrganizationRolesMutex.Unlock()
+	if fake.GetUserOrganizationRolesStub != nil {
+		return fake.GetUserOrganizationRolesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getUserOrganizationRolesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeOrgsActor) GetUserOrganizationRolesCallCount() int {
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
+	return len(fake.getUserOrganizationRolesArgsForCall)
+}
+
+func (fake *FakeOrgsActor) GetUserOrganizationRolesCalls(stub func(string) (v2action.UserRoles, v2action.Warnings, error)) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = stub
+}
+
+func (fake *FakeOrgsActor) GetUserOrganizationRolesArgsForCall(i int) string {
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
+	argsForCall := fake.getUserOrganizationRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOrgsActor) GetUserOrganizationRolesReturns(result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = nil
+	fake.getUserOrganizationRolesReturns = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgsActor) GetUserOrganizationRolesReturnsOnCall(i int, result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = nil
+	if fake.getUserOrganizationRolesReturnsOnCall == nil {
+		fake.getUserOrganizationRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.UserRoles
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUserOrganizationRolesReturnsOnCall[i] = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeOrgsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
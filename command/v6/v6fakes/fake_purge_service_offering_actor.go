@@ -25,6 +25,21 @@ type FakePurgeServiceOfferingActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceOfferingPurgeImpactStub        func(v2action.Service) ([]v2action.ServiceInstancePurgeImpact, v2action.Warnings, error)
+	getServiceOfferingPurgeImpactMutex       sync.RWMutex
+	getServiceOfferingPurgeImpactArgsForCall []struct {
+		arg1 v2action.Service
+	}
+	getServiceOfferingPurgeImpactReturns struct {
+		result1 []v2action.ServiceInstancePurgeImpact
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceOfferingPurgeImpactReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstancePurgeImpact
+		result2 v2action.Warnings
+		result3 error
+	}
 	PurgeServiceOfferingStub        func(v2action.Service) (v2action.Warnings, error)
 	purgeServiceOfferingMutex       sync.RWMutex
 	purgeServiceOfferingArgsForCall []struct {
@@ -109,6 +124,72 @@ func (fake *FakePurgeServiceOfferingActor) GetServiceByNameAndBrokerNameReturnsO
 	}{result1, result2, result3}
 }
 
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpact(arg1 v2action.Service) ([]v2action.ServiceInstancePurgeImpact, v2action.Warnings, error) {
+	fake.getServiceOfferingPurgeImpactMutex.Lock()
+	ret, specificReturn := fake.getServiceOfferingPurgeImpactReturnsOnCall[len(fake.getServiceOfferingPurgeImpactArgsForCall)]
+	fake.getServiceOfferingPurgeImpactArgsForCall = append(fake.getServiceOfferingPurgeImpactArgsForCall, struct {
+		arg1 v2action.Service
+	}{arg1})
+	fake.recordInvocation("GetServiceOfferingPurgeImpact", []interface{}{arg1})
+	fake.getServiceOfferingPurgeImpactMutex.Unlock()
+	if fake.GetServiceOfferingPurgeImpactStub != nil {
+		return fake.GetServiceOfferingPurgeImpactStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceOfferingPurgeImpactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpactCallCount() int {
+	fake.getServiceOfferingPurgeImpactMutex.RLock()
+	defer fake.getServiceOfferingPurgeImpactMutex.RUnlock()
+	return len(fake.getServiceOfferingPurgeImpactArgsForCall)
+}
+
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpactCalls(stub func(v2action.Service) ([]v2action.ServiceInstancePurgeImpact, v2action.Warnings, error)) {
+	fake.getServiceOfferingPurgeImpactMutex.Lock()
+	defer fake.getServiceOfferingPurgeImpactMutex.Unlock()
+	fake.GetServiceOfferingPurgeImpactStub = stub
+}
+
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpactArgsForCall(i int) v2action.Service {
+	fake.getServiceOfferingPurgeImpactMutex.RLock()
+	defer fake.getServiceOfferingPurgeImpactMutex.RUnlock()
+	argsForCall := fake.getServiceOfferingPurgeImpactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpactReturns(result1 []v2action.ServiceInstancePurgeImpact, result2 v2action.Warnings, result3 error) {
+	fake.getServiceOfferingPurgeImpactMutex.Lock()
+	defer fake.getServiceOfferingPurgeImpactMutex.Unlock()
+	fake.GetServiceOfferingPurgeImpactStub = nil
+	fake.getServiceOfferingPurgeImpactReturns = struct {
+		result1 []v2action.ServiceInstancePurgeImpact
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePurgeServiceOfferingActor) GetServiceOfferingPurgeImpactReturnsOnCall(i int, result1 []v2action.ServiceInstancePurgeImpact, result2 v2action.Warnings, result3 error) {
+	fake.getServiceOfferingPurgeImpactMutex.Lock()
+	defer fake.getServiceOfferingPurgeImpactMutex.Unlock()
+	fake.GetServiceOfferingPurgeImpactStub = nil
+	if fake.getServiceOfferingPurgeImpactReturnsOnCall == nil {
+		fake.getServiceOfferingPurgeImpactReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstancePurgeImpact
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceOfferingPurgeImpactReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstancePurgeImpact
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePurgeServiceOfferingActor) PurgeServiceOffering(arg1 v2action.Service) (v2action.Warnings, error) {
 	fake.purgeServiceOfferingMutex.Lock()
 	ret, specificReturn := fake.purgeServiceOfferingReturnsOnCall[len(fake.purgeServiceOfferingArgsForCall)]
@@ -177,6 +258,8 @@ func (fake *FakePurgeServiceOfferingActor) Invocations() map[string][][]interfac
 	defer fake.invocationsMutex.RUnlock()
 	fake.getServiceByNameAndBrokerNameMutex.RLock()
 	defer fake.getServiceByNameAndBrokerNameMutex.RUnlock()
+	fake.getServiceOfferingPurgeImpactMutex.RLock()
+	defer fake.getServiceOfferingPurgeImpactMutex.RUnlock()
 	fake.purgeServiceOfferingMutex.RLock()
 	defer fake.purgeServiceOfferingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
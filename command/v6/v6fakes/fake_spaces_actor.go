@@ -24,6 +24,22 @@ type FakeSpacesActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetUserSpaceRolesStub        func(string, string) (v2action.UserRoles, v2action.Warnings, error)
+	getUserSpaceRolesMutex       sync.RWMutex
+	getUserSpaceRolesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getUserSpaceRolesReturns struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUserSpaceRolesReturnsOnCall map[int]struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -94,11 +110,80 @@ func (fake *FakeSpacesActor) GetOrganizationSpacesReturnsOnCall(i int, result1 [
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSpacesActor) GetUserSpaceRoles(arg1 string, arg2 string) (v2action.UserRoles, v2action.Warnings, error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	ret, specificReturn := fake.getUserSpaceRolesReturnsOnCall[len(fake.getUserSpaceRolesArgsForCall)]
+	fake.getUserSpaceRolesArgsForCall = append(fake.getUserSpaceRolesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetUserSpaceRoles", []interface{}{arg1, arg2})
+	fake.getUserSpaceRolesMutex.Unlock()
+	if fake.GetUserSpaceRolesStub != nil {
+		return fake.GetUserSpaceRolesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getUserSpaceRolesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSpacesActor) GetUserSpaceRolesCallCount() int {
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
+	return len(fake.getUserSpaceRolesArgsForCall)
+}
+
+func (fake *FakeSpacesActor) GetUserSpaceRolesCalls(stub func(string, string) (v2action.UserRoles, v2action.Warnings, error)) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = stub
+}
+
+func (fake *FakeSpacesActor) GetUserSpaceRolesArgsForCall(i int) (string, string) {
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
+	argsForCall := fake.getUserSpaceRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSpacesActor) GetUserSpaceRolesReturns(result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = nil
+	fake.getUserSpaceRolesReturns = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpacesActor) GetUserSpaceRolesReturnsOnCall(i int, result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = nil
+	if fake.getUserSpaceRolesReturnsOnCall == nil {
+		fake.getUserSpaceRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.UserRoles
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUserSpaceRolesReturnsOnCall[i] = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSpacesActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getOrganizationSpacesMutex.RLock()
 	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
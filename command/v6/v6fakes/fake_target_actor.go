@@ -34,6 +34,21 @@ type FakeTargetActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetOrganizationNameSuggestionsStub        func(string) ([]string, v2action.Warnings, error)
+	getOrganizationNameSuggestionsMutex       sync.RWMutex
+	getOrganizationNameSuggestionsArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationNameSuggestionsReturns struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationNameSuggestionsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetOrganizationSpacesStub        func(string) ([]v2action.Space, v2action.Warnings, error)
 	getOrganizationSpacesMutex       sync.RWMutex
 	getOrganizationSpacesArgsForCall []struct {
@@ -65,6 +80,39 @@ type FakeTargetActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetSpaceNameSuggestionsStub        func(string, string) ([]string, v2action.Warnings, error)
+	getSpaceNameSuggestionsMutex       sync.RWMutex
+	getSpaceNameSuggestionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceNameSuggestionsReturns struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceNameSuggestionsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}
+	UserHasSpaceRoleStub        func(string, string, string) (bool, v2action.Warnings, error)
+	userHasSpaceRoleMutex       sync.RWMutex
+	userHasSpaceRoleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	userHasSpaceRoleReturns struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
+	userHasSpaceRoleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -187,6 +235,72 @@ func (fake *FakeTargetActor) GetOrganizationByNameReturnsOnCall(i int, result1 v
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTargetActor) GetOrganizationNameSuggestions(arg1 string) ([]string, v2action.Warnings, error) {
+	fake.getOrganizationNameSuggestionsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationNameSuggestionsReturnsOnCall[len(fake.getOrganizationNameSuggestionsArgsForCall)]
+	fake.getOrganizationNameSuggestionsArgsForCall = append(fake.getOrganizationNameSuggestionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationNameSuggestions", []interface{}{arg1})
+	fake.getOrganizationNameSuggestionsMutex.Unlock()
+	if fake.GetOrganizationNameSuggestionsStub != nil {
+		return fake.GetOrganizationNameSuggestionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationNameSuggestionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTargetActor) GetOrganizationNameSuggestionsCallCount() int {
+	fake.getOrganizationNameSuggestionsMutex.RLock()
+	defer fake.getOrganizationNameSuggestionsMutex.RUnlock()
+	return len(fake.getOrganizationNameSuggestionsArgsForCall)
+}
+
+func (fake *FakeTargetActor) GetOrganizationNameSuggestionsCalls(stub func(string) ([]string, v2action.Warnings, error)) {
+	fake.getOrganizationNameSuggestionsMutex.Lock()
+	defer fake.getOrganizationNameSuggestionsMutex.Unlock()
+	fake.GetOrganizationNameSuggestionsStub = stub
+}
+
+func (fake *FakeTargetActor) GetOrganizationNameSuggestionsArgsForCall(i int) string {
+	fake.getOrganizationNameSuggestionsMutex.RLock()
+	defer fake.getOrganizationNameSuggestionsMutex.RUnlock()
+	argsForCall := fake.getOrganizationNameSuggestionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTargetActor) GetOrganizationNameSuggestionsReturns(result1 []string, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationNameSuggestionsMutex.Lock()
+	defer fake.getOrganizationNameSuggestionsMutex.Unlock()
+	fake.GetOrganizationNameSuggestionsStub = nil
+	fake.getOrganizationNameSuggestionsReturns = struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTargetActor) GetOrganizationNameSuggestionsReturnsOnCall(i int, result1 []string, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationNameSuggestionsMutex.Lock()
+	defer fake.getOrganizationNameSuggestionsMutex.Unlock()
+	fake.GetOrganizationNameSuggestionsStub = nil
+	if fake.getOrganizationNameSuggestionsReturnsOnCall == nil {
+		fake.getOrganizationNameSuggestionsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationNameSuggestionsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTargetActor) GetOrganizationSpaces(arg1 string) ([]v2action.Space, v2action.Warnings, error) {
 	fake.getOrganizationSpacesMutex.Lock()
 	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
@@ -320,6 +434,141 @@ func (fake *FakeTargetActor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTargetActor) GetSpaceNameSuggestions(arg1 string, arg2 string) ([]string, v2action.Warnings, error) {
+	fake.getSpaceNameSuggestionsMutex.Lock()
+	ret, specificReturn := fake.getSpaceNameSuggestionsReturnsOnCall[len(fake.getSpaceNameSuggestionsArgsForCall)]
+	fake.getSpaceNameSuggestionsArgsForCall = append(fake.getSpaceNameSuggestionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceNameSuggestions", []interface{}{arg1, arg2})
+	fake.getSpaceNameSuggestionsMutex.Unlock()
+	if fake.GetSpaceNameSuggestionsStub != nil {
+		return fake.GetSpaceNameSuggestionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceNameSuggestionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTargetActor) GetSpaceNameSuggestionsCallCount() int {
+	fake.getSpaceNameSuggestionsMutex.RLock()
+	defer fake.getSpaceNameSuggestionsMutex.RUnlock()
+	return len(fake.getSpaceNameSuggestionsArgsForCall)
+}
+
+func (fake *FakeTargetActor) GetSpaceNameSuggestionsCalls(stub func(string, string) ([]string, v2action.Warnings, error)) {
+	fake.getSpaceNameSuggestionsMutex.Lock()
+	defer fake.getSpaceNameSuggestionsMutex.Unlock()
+	fake.GetSpaceNameSuggestionsStub = stub
+}
+
+func (fake *FakeTargetActor) GetSpaceNameSuggestionsArgsForCall(i int) (string, string) {
+	fake.getSpaceNameSuggestionsMutex.RLock()
+	defer fake.getSpaceNameSuggestionsMutex.RUnlock()
+	argsForCall := fake.getSpaceNameSuggestionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTargetActor) GetSpaceNameSuggestionsReturns(result1 []string, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceNameSuggestionsMutex.Lock()
+	defer fake.getSpaceNameSuggestionsMutex.Unlock()
+	fake.GetSpaceNameSuggestionsStub = nil
+	fake.getSpaceNameSuggestionsReturns = struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTargetActor) GetSpaceNameSuggestionsReturnsOnCall(i int, result1 []string, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceNameSuggestionsMutex.Lock()
+	defer fake.getSpaceNameSuggestionsMutex.Unlock()
+	fake.GetSpaceNameSuggestionsStub = nil
+	if fake.getSpaceNameSuggestionsReturnsOnCall == nil {
+		fake.getSpaceNameSuggestionsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceNameSuggestionsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRole(arg1 string, arg2 string, arg3 string) (bool, v2action.Warnings, error) {
+	fake.userHasSpaceRoleMutex.Lock()
+	ret, specificReturn := fake.userHasSpaceRoleReturnsOnCall[len(fake.userHasSpaceRoleArgsForCall)]
+	fake.userHasSpaceRoleArgsForCall = append(fake.userHasSpaceRoleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UserHasSpaceRole", []interface{}{arg1, arg2, arg3})
+	fake.userHasSpaceRoleMutex.Unlock()
+	if fake.UserHasSpaceRoleStub != nil {
+		return fake.UserHasSpaceRoleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.userHasSpaceRoleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRoleCallCount() int {
+	fake.userHasSpaceRoleMutex.RLock()
+	defer fake.userHasSpaceRoleMutex.RUnlock()
+	return len(fake.userHasSpaceRoleArgsForCall)
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRoleCalls(stub func(string, string, string) (bool, v2action.Warnings, error)) {
+	fake.userHasSpaceRoleMutex.Lock()
+	defer fake.userHasSpaceRoleMutex.Unlock()
+	fake.UserHasSpaceRoleStub = stub
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRoleArgsForCall(i int) (string, string, string) {
+	fake.userHasSpaceRoleMutex.RLock()
+	defer fake.userHasSpaceRoleMutex.RUnlock()
+	argsForCall := fake.userHasSpaceRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRoleReturns(result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.userHasSpaceRoleMutex.Lock()
+	defer fake.userHasSpaceRoleMutex.Unlock()
+	fake.UserHasSpaceRoleStub = nil
+	fake.userHasSpaceRoleReturns = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTargetActor) UserHasSpaceRoleReturnsOnCall(i int, result1 bool, result2 v2action.Warnings, result3 error) {
+	fake.userHasSpaceRoleMutex.Lock()
+	defer fake.userHasSpaceRoleMutex.Unlock()
+	fake.UserHasSpaceRoleStub = nil
+	if fake.userHasSpaceRoleReturnsOnCall == nil {
+		fake.userHasSpaceRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.userHasSpaceRoleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTargetActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -327,10 +576,16 @@ func (fake *FakeTargetActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getOrganizationNameSuggestionsMutex.RLock()
+	defer fake.getOrganizationNameSuggestionsMutex.RUnlock()
 	fake.getOrganizationSpacesMutex.RLock()
 	defer fake.getOrganizationSpacesMutex.RUnlock()
 	fake.getSpaceByOrganizationAndNameMutex.RLock()
 	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.getSpaceNameSuggestionsMutex.RLock()
+	defer fake.getSpaceNameSuggestionsMutex.RUnlock()
+	fake.userHasSpaceRoleMutex.RLock()
+	defer fake.userHasSpaceRoleMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -67,6 +67,19 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	DetectHealthCheckStub        func(string) (pushaction.DetectedHealthCheck, bool)
+	detectHealthCheckMutex       sync.RWMutex
+	detectHealthCheckArgsForCall []struct {
+		arg1 string
+	}
+	detectHealthCheckReturns struct {
+		result1 pushaction.DetectedHealthCheck
+		result2 bool
+	}
+	detectHealthCheckReturnsOnCall map[int]struct {
+		result1 pushaction.DetectedHealthCheck
+		result2 bool
+	}
 	MergeAndValidateSettingsAndManifestsStub        func(pushaction.CommandLineSettings, []manifest.Application) ([]manifest.Application, error)
 	mergeAndValidateSettingsAndManifestsMutex       sync.RWMutex
 	mergeAndValidateSettingsAndManifestsArgsForCall []struct {
@@ -350,6 +363,69 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) DetectHealthCheck(arg1 string) (pushaction.DetectedHealthCheck, bool) {
+	fake.detectHealthCheckMutex.Lock()
+	ret, specificReturn := fake.detectHealthCheckReturnsOnCall[len(fake.detectHealthCheckArgsForCall)]
+	fake.detectHealthCheckArgsForCall = append(fake.detectHealthCheckArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DetectHealthCheck", []interface{}{arg1})
+	fake.detectHealthCheckMutex.Unlock()
+	if fake.DetectHealthCheckStub != nil {
+		return fake.DetectHealthCheckStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.detectHealthCheckReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV2PushActor) DetectHealthCheckCallCount() int {
+	fake.detectHealthCheckMutex.RLock()
+	defer fake.detectHealthCheckMutex.RUnlock()
+	return len(fake.detectHealthCheckArgsForCall)
+}
+
+func (fake *FakeV2PushActor) DetectHealthCheckCalls(stub func(string) (pushaction.DetectedHealthCheck, bool)) {
+	fake.detectHealthCheckMutex.Lock()
+	defer fake.detectHealthCheckMutex.Unlock()
+	fake.DetectHealthCheckStub = stub
+}
+
+func (fake *FakeV2PushActor) DetectHealthCheckArgsForCall(i int) string {
+	fake.detectHealthCheckMutex.RLock()
+	defer fake.detectHealthCheckMutex.RUnlock()
+	argsForCall := fake.detectHealthCheckArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV2PushActor) DetectHealthCheckReturns(result1 pushaction.DetectedHealthCheck, result2 bool) {
+	fake.detectHealthCheckMutex.Lock()
+	defer fake.detectHealthCheckMutex.Unlock()
+	fake.DetectHealthCheckStub = nil
+	fake.detectHealthCheckReturns = struct {
+		result1 pushaction.DetectedHealthCheck
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) DetectHealthCheckReturnsOnCall(i int, result1 pushaction.DetectedHealthCheck, result2 bool) {
+	fake.detectHealthCheckMutex.Lock()
+	defer fake.detectHealthCheckMutex.Unlock()
+	fake.DetectHealthCheckStub = nil
+	if fake.detectHealthCheckReturnsOnCall == nil {
+		fake.detectHealthCheckReturnsOnCall = make(map[int]struct {
+			result1 pushaction.DetectedHealthCheck
+			result2 bool
+		})
+	}
+	fake.detectHealthCheckReturnsOnCall[i] = struct {
+		result1 pushaction.DetectedHealthCheck
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifests(arg1 pushaction.CommandLineSettings, arg2 []manifest.Application) ([]manifest.Application, error) {
 	var arg2Copy []manifest.Application
 	if arg2 != nil {
@@ -508,6 +584,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.cloudControllerV3APIVersionMutex.RUnlock()
 	fake.convertToApplicationConfigsMutex.RLock()
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.detectHealthCheckMutex.RLock()
+	defer fake.detectHealthCheckMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
 	fake.readManifestMutex.RLock()
@@ -9,6 +9,31 @@ import (
 )
 
 type FakeV3AppsActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct {
+	}
+	cloudControllerAPIVersionReturns struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetApplicationOwnersBySpaceStub        func(string) (map[string]v3action.Owner, v3action.Warnings, error)
+	getApplicationOwnersBySpaceMutex       sync.RWMutex
+	getApplicationOwnersBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationOwnersBySpaceReturns struct {
+		result1 map[string]v3action.Owner
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationOwnersBySpaceReturnsOnCall map[int]struct {
+		result1 map[string]v3action.Owner
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetApplicationsWithProcessesBySpaceStub        func(string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
 	getApplicationsWithProcessesBySpaceMutex       sync.RWMutex
 	getApplicationsWithProcessesBySpaceArgsForCall []struct {
@@ -28,6 +53,124 @@ type FakeV3AppsActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3AppsActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cloudControllerAPIVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3AppsActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeV3AppsActor) CloudControllerAPIVersionCalls(stub func() string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = stub
+}
+
+func (fake *FakeV3AppsActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV3AppsActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpace(arg1 string) (map[string]v3action.Owner, v3action.Warnings, error) {
+	fake.getApplicationOwnersBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationOwnersBySpaceReturnsOnCall[len(fake.getApplicationOwnersBySpaceArgsForCall)]
+	fake.getApplicationOwnersBySpaceArgsForCall = append(fake.getApplicationOwnersBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationOwnersBySpace", []interface{}{arg1})
+	fake.getApplicationOwnersBySpaceMutex.Unlock()
+	if fake.GetApplicationOwnersBySpaceStub != nil {
+		return fake.GetApplicationOwnersBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationOwnersBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpaceCallCount() int {
+	fake.getApplicationOwnersBySpaceMutex.RLock()
+	defer fake.getApplicationOwnersBySpaceMutex.RUnlock()
+	return len(fake.getApplicationOwnersBySpaceArgsForCall)
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpaceCalls(stub func(string) (map[string]v3action.Owner, v3action.Warnings, error)) {
+	fake.getApplicationOwnersBySpaceMutex.Lock()
+	defer fake.getApplicationOwnersBySpaceMutex.Unlock()
+	fake.GetApplicationOwnersBySpaceStub = stub
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpaceArgsForCall(i int) string {
+	fake.getApplicationOwnersBySpaceMutex.RLock()
+	defer fake.getApplicationOwnersBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationOwnersBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpaceReturns(result1 map[string]v3action.Owner, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationOwnersBySpaceMutex.Lock()
+	defer fake.getApplicationOwnersBySpaceMutex.Unlock()
+	fake.GetApplicationOwnersBySpaceStub = nil
+	fake.getApplicationOwnersBySpaceReturns = struct {
+		result1 map[string]v3action.Owner
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3AppsActor) GetApplicationOwnersBySpaceReturnsOnCall(i int, result1 map[string]v3action.Owner, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationOwnersBySpaceMutex.Lock()
+	defer fake.getApplicationOwnersBySpaceMutex.Unlock()
+	fake.GetApplicationOwnersBySpaceStub = nil
+	if fake.getApplicationOwnersBySpaceReturnsOnCall == nil {
+		fake.getApplicationOwnersBySpaceReturnsOnCall = make(map[int]struct {
+			result1 map[string]v3action.Owner
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationOwnersBySpaceReturnsOnCall[i] = struct {
+		result1 map[string]v3action.Owner
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpace(arg1 string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
 	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationsWithProcessesBySpaceReturnsOnCall[len(fake.getApplicationsWithProcessesBySpaceArgsForCall)]
@@ -97,6 +240,10 @@ func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceReturnsOnCall(i
 func (fake *FakeV3AppsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getApplicationOwnersBySpaceMutex.RLock()
+	defer fake.getApplicationOwnersBySpaceMutex.RUnlock()
 	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
 	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,6 +4,9 @@ package v6fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
 
@@ -18,6 +21,67 @@ type FakeVersionChecker struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetFeatureFlagsStub        func() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	getFeatureFlagsMutex       sync.RWMutex
+	getFeatureFlagsArgsForCall []struct {
+	}
+	getFeatureFlagsReturns struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	getFeatureFlagsReturnsOnCall map[int]struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceByOrganizationAndNameStub        func(string, string) (v2action.Space, v2action.Warnings, error)
+	getSpaceByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceByOrganizationAndNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetUserOrganizationRolesStub        func(string) (v2action.UserRoles, v2action.Warnings, error)
+	getUserOrganizationRolesMutex       sync.RWMutex
+	getUserOrganizationRolesArgsForCall []struct {
+		arg1 string
+	}
+	getUserOrganizationRolesReturns struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUserOrganizationRolesReturnsOnCall map[int]struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetUserSpaceRolesStub        func(string, string) (v2action.UserRoles, v2action.Warnings, error)
+	getUserSpaceRolesMutex       sync.RWMutex
+	getUserSpaceRolesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getUserSpaceRolesReturns struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUserSpaceRolesReturnsOnCall map[int]struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct {
@@ -28,6 +92,16 @@ type FakeVersionChecker struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MinCLIVersionEnforcementStub        func() constant.MinCLIVersionEnforcement
+	minCLIVersionEnforcementMutex       sync.RWMutex
+	minCLIVersionEnforcementArgsForCall []struct {
+	}
+	minCLIVersionEnforcementReturns struct {
+		result1 constant.MinCLIVersionEnforcement
+	}
+	minCLIVersionEnforcementReturnsOnCall map[int]struct {
+		result1 constant.MinCLIVersionEnforcement
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -84,6 +158,264 @@ func (fake *FakeVersionChecker) CloudControllerAPIVersionReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeVersionChecker) GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error) {
+	fake.getFeatureFlagsMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
+	fake.getFeatureFlagsArgsForCall = append(fake.getFeatureFlagsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetFeatureFlags", []interface{}{})
+	fake.getFeatureFlagsMutex.Unlock()
+	if fake.GetFeatureFlagsStub != nil {
+		return fake.GetFeatureFlagsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getFeatureFlagsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVersionChecker) GetFeatureFlagsCallCount() int {
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	return len(fake.getFeatureFlagsArgsForCall)
+}
+
+func (fake *FakeVersionChecker) GetFeatureFlagsCalls(stub func() ([]v2action.FeatureFlag, v2action.Warnings, error)) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = stub
+}
+
+func (fake *FakeVersionChecker) GetFeatureFlagsReturns(result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = nil
+	fake.getFeatureFlagsReturns = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetFeatureFlagsReturnsOnCall(i int, result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = nil
+	if fake.getFeatureFlagsReturnsOnCall == nil {
+		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.FeatureFlag
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagsReturnsOnCall[i] = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndName(arg1 string, arg2 string) (v2action.Space, v2action.Warnings, error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
+	fake.getSpaceByOrganizationAndNameArgsForCall = append(fake.getSpaceByOrganizationAndNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceByOrganizationAndName", []interface{}{arg1, arg2})
+	fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceByOrganizationAndNameStub != nil {
+		return fake.GetSpaceByOrganizationAndNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceByOrganizationAndNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndNameCallCount() int {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndNameCalls(stub func(string, string) (v2action.Space, v2action.Warnings, error)) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	defer fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	fake.GetSpaceByOrganizationAndNameStub = stub
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	argsForCall := fake.getSpaceByOrganizationAndNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	defer fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	fake.getSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	defer fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	if fake.getSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRoles(arg1 string) (v2action.UserRoles, v2action.Warnings, error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	ret, specificReturn := fake.getUserOrganizationRolesReturnsOnCall[len(fake.getUserOrganizationRolesArgsForCall)]
+	fake.getUserOrganizationRolesArgsForCall = append(fake.getUserOrganizationRolesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetUserOrganizationRoles", []interface{}{arg1})
+	fake.getUserOrganizationRolesMutex.Unlock()
+	if fake.GetUserOrganizationRolesStub != nil {
+		return fake.GetUserOrganizationRolesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getUserOrganizationRolesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRolesCallCount() int {
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
+	return len(fake.getUserOrganizationRolesArgsForCall)
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRolesCalls(stub func(string) (v2action.UserRoles, v2action.Warnings, error)) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = stub
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRolesArgsForCall(i int) string {
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
+	argsForCall := fake.getUserOrganizationRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRolesReturns(result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = nil
+	fake.getUserOrganizationRolesReturns = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetUserOrganizationRolesReturnsOnCall(i int, result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserOrganizationRolesMutex.Lock()
+	defer fake.getUserOrganizationRolesMutex.Unlock()
+	fake.GetUserOrganizationRolesStub = nil
+	if fake.getUserOrganizationRolesReturnsOnCall == nil {
+		fake.getUserOrganizationRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.UserRoles
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUserOrganizationRolesReturnsOnCall[i] = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRoles(arg1 string, arg2 string) (v2action.UserRoles, v2action.Warnings, error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	ret, specificReturn := fake.getUserSpaceRolesReturnsOnCall[len(fake.getUserSpaceRolesArgsForCall)]
+	fake.getUserSpaceRolesArgsForCall = append(fake.getUserSpaceRolesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetUserSpaceRoles", []interface{}{arg1, arg2})
+	fake.getUserSpaceRolesMutex.Unlock()
+	if fake.GetUserSpaceRolesStub != nil {
+		return fake.GetUserSpaceRolesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getUserSpaceRolesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRolesCallCount() int {
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
+	return len(fake.getUserSpaceRolesArgsForCall)
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRolesCalls(stub func(string, string) (v2action.UserRoles, v2action.Warnings, error)) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = stub
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRolesArgsForCall(i int) (string, string) {
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
+	argsForCall := fake.getUserSpaceRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRolesReturns(result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = nil
+	fake.getUserSpaceRolesReturns = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVersionChecker) GetUserSpaceRolesReturnsOnCall(i int, result1 v2action.UserRoles, result2 v2action.Warnings, result3 error) {
+	fake.getUserSpaceRolesMutex.Lock()
+	defer fake.getUserSpaceRolesMutex.Unlock()
+	fake.GetUserSpaceRolesStub = nil
+	if fake.getUserSpaceRolesReturnsOnCall == nil {
+		fake.getUserSpaceRolesReturnsOnCall = make(map[int]struct {
+			result1 v2action.UserRoles
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUserSpaceRolesReturnsOnCall[i] = struct {
+		result1 v2action.UserRoles
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVersionChecker) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
@@ -136,13 +468,75 @@ func (fake *FakeVersionChecker) MinCLIVersionReturnsOnCall(i int, result1 string
 	}{result1}
 }
 
+func (fake *FakeVersionChecker) MinCLIVersionEnforcement() constant.MinCLIVersionEnforcement {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	ret, specificReturn := fake.minCLIVersionEnforcementReturnsOnCall[len(fake.minCLIVersionEnforcementArgsForCall)]
+	fake.minCLIVersionEnforcementArgsForCall = append(fake.minCLIVersionEnforcementArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MinCLIVersionEnforcement", []interface{}{})
+	fake.minCLIVersionEnforcementMutex.Unlock()
+	if fake.MinCLIVersionEnforcementStub != nil {
+		return fake.MinCLIVersionEnforcementStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.minCLIVersionEnforcementReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVersionChecker) MinCLIVersionEnforcementCallCount() int {
+	fake.minCLIVersionEnforcementMutex.RLock()
+	defer fake.minCLIVersionEnforcementMutex.RUnlock()
+	return len(fake.minCLIVersionEnforcementArgsForCall)
+}
+
+func (fake *FakeVersionChecker) MinCLIVersionEnforcementCalls(stub func() constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = stub
+}
+
+func (fake *FakeVersionChecker) MinCLIVersionEnforcementReturns(result1 constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = nil
+	fake.minCLIVersionEnforcementReturns = struct {
+		result1 constant.MinCLIVersionEnforcement
+	}{result1}
+}
+
+func (fake *FakeVersionChecker) MinCLIVersionEnforcementReturnsOnCall(i int, result1 constant.MinCLIVersionEnforcement) {
+	fake.minCLIVersionEnforcementMutex.Lock()
+	defer fake.minCLIVersionEnforcementMutex.Unlock()
+	fake.MinCLIVersionEnforcementStub = nil
+	if fake.minCLIVersionEnforcementReturnsOnCall == nil {
+		fake.minCLIVersionEnforcementReturnsOnCall = make(map[int]struct {
+			result1 constant.MinCLIVersionEnforcement
+		})
+	}
+	fake.minCLIVersionEnforcementReturnsOnCall[i] = struct {
+		result1 constant.MinCLIVersionEnforcement
+	}{result1}
+}
+
 func (fake *FakeVersionChecker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.getUserOrganizationRolesMutex.RLock()
+	defer fake.getUserOrganizationRolesMutex.RUnlock()
+	fake.getUserSpaceRolesMutex.RLock()
+	defer fake.getUserSpaceRolesMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.minCLIVersionEnforcementMutex.RLock()
+	defer fake.minCLIVersionEnforcementMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
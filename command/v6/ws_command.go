@@ -0,0 +1,107 @@
+package v6
+
+import (
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . WSActor
+
+type WSActor interface {
+	RefreshAccessToken(refreshToken string) (string, error)
+	StreamWebSocket(wsURL string, headers http.Header, messages []string) (<-chan v2action.WebSocketFrame, <-chan error, error)
+	WebSocketURL(target string) (string, error)
+}
+
+type WSCommand struct {
+	RequiredArgs    flag.WebSocketTarget `positional-args:"yes"`
+	CustomHeaders   []string             `short:"H" description:"Custom headers to include in the handshake request, flag can be specified multiple times"`
+	Messages        []string             `short:"m" long:"message" description:"Text message to send once connected, flag can be specified multiple times"`
+	usage           interface{}          `usage:"CF_NAME ws PATH_OR_URL [-H HEADER]... [-m MESSAGE]...\n\n   Opens a websocket connection and prints the frames it receives to stdout until\n   the server closes the connection. A PATH is relative to the targeted API\n   endpoint; http and https URLs are dialed as ws and wss. Connections to the API\n   and Doppler endpoints are authenticated with the current session; give\n   -H \"Authorization: TOKEN\" to authenticate to other hosts."`
+	examples        interface{}          `examples:"CF_NAME ws wss://doppler.example.com/apps/APP_GUID/stream\nCF_NAME ws /v3/some/stream -H \"X-Request-Id: debug-1\" -m '{\"subscribe\":true}'"`
+	relatedCommands interface{}          `related_commands:"curl, logs, oauth-token"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       WSActor
+}
+
+func (cmd *WSCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd WSCommand) Execute(args []string) error {
+	headers, err := cmd.parseHeaders()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	wsURL, err := cmd.Actor.WebSocketURL(cmd.RequiredArgs.Target)
+	if err != nil {
+		return err
+	}
+
+	if headers.Get("Authorization") == "" {
+		_, err = cmd.Actor.RefreshAccessToken(cmd.Config.RefreshToken())
+		if err != nil {
+			return err
+		}
+	}
+
+	frames, errs, err := cmd.Actor.StreamWebSocket(wsURL, headers, cmd.Messages)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.UI.GetOut()
+	for frame := range frames {
+		data := frame.Data
+		if !frame.Binary && !strings.HasSuffix(string(data), "\n") {
+			data = append(data, '\n')
+		}
+		_, err = out.Write(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return <-errs
+}
+
+func (cmd WSCommand) parseHeaders() (http.Header, error) {
+	headers := http.Header{}
+	for _, header := range cmd.CustomHeaders {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, translatableerror.ParseArgumentError{
+				ArgumentName: "-H",
+				ExpectedType: "a header in the form 'Name: value'",
+			}
+		}
+		headers.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return headers, nil
+}
@@ -0,0 +1,176 @@
+package v6_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ws command", func() {
+	var (
+		cmd             WSCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeWSActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeWSActor)
+
+		cmd = WSCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Target = "/v3/some-stream"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.RefreshTokenReturns("some-refresh-token")
+		fakeActor.WebSocketURLReturns("wss://api.some-domain.com/v3/some-stream", nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.StreamWebSocketCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a header is malformed", func() {
+		BeforeEach(func() {
+			cmd.CustomHeaders = []string{"no-colon"}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "-H",
+				ExpectedType: "a header in the form 'Name: value'",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("refreshing the access token fails", func() {
+		BeforeEach(func() {
+			fakeActor.RefreshAccessTokenReturns("", errors.New("refresh error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("refresh error"))
+			Expect(fakeActor.StreamWebSocketCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the connection cannot be opened", func() {
+		BeforeEach(func() {
+			fakeActor.StreamWebSocketReturns(nil, nil, actionerror.WebSocketHandshakeError{
+				URL:        "wss://api.some-domain.com/v3/some-stream",
+				StatusCode: http.StatusNotFound,
+			})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.WebSocketHandshakeError{
+				URL:        "wss://api.some-domain.com/v3/some-stream",
+				StatusCode: http.StatusNotFound,
+			}))
+		})
+	})
+
+	When("the connection is opened", func() {
+		var (
+			frames chan v2action.WebSocketFrame
+			errs   chan error
+		)
+
+		BeforeEach(func() {
+			cmd.CustomHeaders = []string{"X-Some-Header: some-value"}
+			cmd.Messages = []string{"hello"}
+
+			frames = make(chan v2action.WebSocketFrame, 3)
+			errs = make(chan error, 1)
+			frames <- v2action.WebSocketFrame{Data: []byte("frame-1")}
+			frames <- v2action.WebSocketFrame{Data: []byte("frame-2\n")}
+			frames <- v2action.WebSocketFrame{Binary: true, Data: []byte("raw")}
+			close(frames)
+			fakeActor.StreamWebSocketReturns(frames, errs, nil)
+		})
+
+		When("the server closes the connection normally", func() {
+			BeforeEach(func() {
+				close(errs)
+			})
+
+			It("refreshes the token, sends the headers and messages, and prints the frames", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				Expect(fakeActor.WebSocketURLCallCount()).To(Equal(1))
+				Expect(fakeActor.WebSocketURLArgsForCall(0)).To(Equal("/v3/some-stream"))
+
+				Expect(fakeActor.StreamWebSocketCallCount()).To(Equal(1))
+				wsURL, headers, messages := fakeActor.StreamWebSocketArgsForCall(0)
+				Expect(wsURL).To(Equal("wss://api.some-domain.com/v3/some-stream"))
+				Expect(headers.Get("X-Some-Header")).To(Equal("some-value"))
+				Expect(messages).To(Equal([]string{"hello"}))
+
+				Expect(testUI.Out).To(Say("frame-1\nframe-2\nraw"))
+			})
+		})
+
+		When("an Authorization header is given", func() {
+			BeforeEach(func() {
+				cmd.CustomHeaders = []string{"Authorization: bearer other-token"}
+				close(errs)
+			})
+
+			It("does not refresh the access token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(0))
+
+				_, headers, _ := fakeActor.StreamWebSocketArgsForCall(0)
+				Expect(headers.Get("Authorization")).To(Equal("bearer other-token"))
+			})
+		})
+
+		When("the connection fails while streaming", func() {
+			BeforeEach(func() {
+				errs <- errors.New("connection reset")
+				close(errs)
+			})
+
+			It("prints the frames received and returns the error", func() {
+				Expect(executeErr).To(MatchError("connection reset"))
+				Expect(testUI.Out).To(Say("frame-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+//go:generate counterfeiter . InstanceIdentityCertificateActor
+
+type InstanceIdentityCertificateActor interface {
+	GetInstanceIdentityCertificate(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) (sharedaction.InstanceIdentityCertificate, error)
+}
+
+type AppInstanceCertsCommand struct {
+	RequiredArgs       flag.AppInstanceNameArg `positional-args:"yes"`
+	ProcessType        string                  `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool                    `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME app-instance-certs APP_NAME/INDEX [--process PROCESS] [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME app-instance-certs my-app/0\n   CF_NAME app-instance-certs my-app/2 --process worker"`
+	relatedCommands interface{} `related_commands:"add-network-policy, enable-ssh, ssh"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SSHActor
+	CertActor   InstanceIdentityCertificateActor
+	SSHClient   *clissh.SecureShell
+}
+
+func (cmd *AppInstanceCertsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.CertActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd AppInstanceCertsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.Instance.AppName
+	index := cmd.RequiredArgs.Instance.Index
+
+	cmd.UI.DisplayTextWithFlavor("Getting instance identity certificate for app {{.AppName}} instance {{.Index}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"Index":     index,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		index,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cert, err := cmd.CertActor.GetInstanceIdentityCertificate(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("common name:"), cert.CommonName},
+		{cmd.UI.TranslateText("issuer:"), cert.Issuer},
+		{cmd.UI.TranslateText("organization guid:"), cert.OrganizationGUID},
+		{cmd.UI.TranslateText("space guid:"), cert.SpaceGUID},
+		{cmd.UI.TranslateText("app guid:"), cert.AppGUID},
+		{cmd.UI.TranslateText("organizational units:"), strings.Join(cert.OrganizationalUnits, ", ")},
+		{cmd.UI.TranslateText("dns names:"), strings.Join(cert.DNSNames, ", ")},
+		{cmd.UI.TranslateText("ip addresses:"), strings.Join(cert.IPAddresses, ", ")},
+		{cmd.UI.TranslateText("valid from:"), cert.NotBefore.UTC().Format(time.RFC3339)},
+		{cmd.UI.TranslateText("valid until:"), cert.NotAfter.UTC().Format(time.RFC3339)},
+	}, 3)
+
+	if cert.Expired(time.Now()) {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("The instance identity certificate has expired. Clients validating it will reject connections from this instance.")
+	}
+
+	return nil
+}
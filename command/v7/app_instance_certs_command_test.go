@@ -0,0 +1,182 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-instance-certs Command", func() {
+	var (
+		cmd             AppInstanceCertsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeSSHActor
+		fakeCertActor   *v7fakes.FakeInstanceIdentityCertificateActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeSSHActor)
+		fakeCertActor = new(v7fakes.FakeInstanceIdentityCertificateActor)
+
+		cmd = AppInstanceCertsCommand{
+			RequiredArgs: flag.AppInstanceNameArg{
+				Instance: flag.AppInstanceName{AppName: "some-app", Index: 2},
+			},
+			ProcessType:        "web",
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			CertActor:   fakeCertActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the secure shell authentication fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{}, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(fakeCertActor.GetInstanceIdentityCertificateCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the secure shell authentication succeeds", func() {
+		var cert sharedaction.InstanceIdentityCertificate
+
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}, v7action.Warnings{"some-warning"}, nil)
+
+			cert = sharedaction.InstanceIdentityCertificate{
+				CommonName:       "some-instance-guid",
+				Issuer:           "instanceIdentityCA",
+				OrganizationGUID: "some-org-guid",
+				SpaceGUID:        "some-space-guid",
+				AppGUID:          "some-app-guid",
+				OrganizationalUnits: []string{
+					"organization:some-org-guid",
+					"space:some-space-guid",
+					"app:some-app-guid",
+				},
+				DNSNames:    []string{"some-instance-guid"},
+				IPAddresses: []string{"10.255.0.4"},
+				NotBefore:   time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				NotAfter:    time.Now().Add(time.Hour),
+			}
+			fakeCertActor.GetInstanceIdentityCertificateReturns(cert, nil)
+		})
+
+		It("fetches the certificate from the requested instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+			appName, spaceGUID, processType, index := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("web"))
+			Expect(index).To(Equal(uint(2)))
+
+			Expect(fakeCertActor.GetInstanceIdentityCertificateCallCount()).To(Equal(1))
+			_, sshOptions := fakeCertActor.GetInstanceIdentityCertificateArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				SkipHostValidation: true,
+				Username:           "some-username",
+			}))
+		})
+
+		It("displays the certificate details and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting instance identity certificate for app some-app instance 2 in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`common name:\s+some-instance-guid`))
+			Expect(testUI.Out).To(Say(`issuer:\s+instanceIdentityCA`))
+			Expect(testUI.Out).To(Say(`organization guid:\s+some-org-guid`))
+			Expect(testUI.Out).To(Say(`space guid:\s+some-space-guid`))
+			Expect(testUI.Out).To(Say(`app guid:\s+some-app-guid`))
+			Expect(testUI.Out).To(Say(`organizational units:\s+organization:some-org-guid, space:some-space-guid, app:some-app-guid`))
+			Expect(testUI.Out).To(Say(`dns names:\s+some-instance-guid`))
+			Expect(testUI.Out).To(Say(`ip addresses:\s+10\.255\.0\.4`))
+			Expect(testUI.Out).To(Say(`valid from:\s+2019-01-01T00:00:00Z`))
+			Expect(testUI.Out).To(Say(`valid until:`))
+
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Err).ToNot(Say("has expired"))
+		})
+
+		When("the certificate has expired", func() {
+			BeforeEach(func() {
+				cert.NotAfter = time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+				fakeCertActor.GetInstanceIdentityCertificateReturns(cert, nil)
+			})
+
+			It("warns the user", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`valid until:\s+2019-01-02T00:00:00Z`))
+				Expect(testUI.Err).To(Say("The instance identity certificate has expired."))
+			})
+		})
+
+		When("getting the certificate fails", func() {
+			BeforeEach(func() {
+				fakeCertActor.GetInstanceIdentityCertificateReturns(sharedaction.InstanceIdentityCertificate{}, actionerror.InstanceIdentityCertificateNotFoundError{})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InstanceIdentityCertificateNotFoundError{}))
+			})
+		})
+	})
+})
@@ -0,0 +1,187 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . CutoverRouteActor
+
+type CutoverRouteActor interface {
+	CutoverRouteDestinations(route v7action.Route, current []v7action.RouteDestination, fromApp v7action.Application, toApp v7action.Application, toWeight int) ([]v7action.RouteDestination, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetRouteByURL(routeURL string) (v7action.Route, v7action.Warnings, error)
+	GetRouteDestinations(routeGUID string) ([]v7action.RouteDestination, v7action.Warnings, error)
+	ProcessInstancesRunning(appGUID string, processType string) (bool, v7action.Warnings, error)
+	UpdateRouteDestinations(routeGUID string, destinations []v7action.RouteDestination) (v7action.Warnings, error)
+}
+
+type CutoverRouteCommand struct {
+	RequiredArgs    flag.RouteURL   `positional-args:"yes"`
+	From            string          `long:"from" required:"true" description:"App currently receiving the route's traffic"`
+	To              string          `long:"to" required:"true" description:"App to shift the route's traffic to"`
+	Step            flag.Percentage `long:"step" default:"25" description:"Percentage of the traffic to shift at each step"`
+	usage           interface{}     `usage:"CF_NAME cutover-route ROUTE --from APP_A --to APP_B [--step PERCENTAGE]\n\n   Shifts the route's traffic from APP_A to APP_B step by step. After each step the web instances of APP_B must all be running, otherwise the route's original destinations are restored."`
+	examples        interface{}     `examples:"CF_NAME cutover-route my-app.example.com --from my-app-blue --to my-app-green\nCF_NAME cutover-route my-app.example.com/api --from my-app-blue --to my-app-green --step 10"`
+	relatedCommands interface{}     `related_commands:"map-route, routes, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       CutoverRouteActor
+	SharedActor command.SharedActor
+}
+
+func (cmd *CutoverRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+	return nil
+}
+
+func (cmd CutoverRouteCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Cutting over route {{.Route}} from app {{.FromApp}} to app {{.ToApp}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     cmd.RequiredArgs.Route,
+		"FromApp":   cmd.From,
+		"ToApp":     cmd.To,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	fromApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.From, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	toApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.To, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	route, warnings, err := cmd.Actor.GetRouteByURL(cmd.RequiredArgs.Route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	original, warnings, err := cmd.Actor.GetRouteDestinations(route.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	healthy, warnings, err := cmd.Actor.ProcessInstancesRunning(toApp.GUID, constant.ProcessTypeWeb)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	if !healthy {
+		return translatableerror.RouteCutoverFailedError{AppName: toApp.Name, Route: route.URL}
+	}
+
+	for weight := cmd.Step.Value; ; weight += cmd.Step.Value {
+		if weight > 100 {
+			weight = 100
+		}
+
+		err = cmd.shiftTraffic(route, original, fromApp, toApp, weight)
+		if err != nil {
+			return err
+		}
+
+		if weight == 100 {
+			break
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// shiftTraffic sends weight percent of the route's traffic to toApp and
+// verifies that toApp stays healthy, restoring the original destinations
+// when it does not.
+func (cmd CutoverRouteCommand) shiftTraffic(route v7action.Route, original []v7action.RouteDestination, fromApp v7action.Application, toApp v7action.Application, weight int) error {
+	destinations, err := cmd.Actor.CutoverRouteDestinations(route, original, fromApp, toApp, weight)
+	if err != nil {
+		return err
+	}
+
+	if weight == 100 {
+		cmd.UI.DisplayText("Sending all traffic to {{.ToApp}}...", map[string]interface{}{
+			"ToApp": toApp.Name,
+		})
+	} else {
+		cmd.UI.DisplayText("Sending {{.ToWeight}}% of traffic to {{.ToApp}} and {{.FromWeight}}% to {{.FromApp}}...", map[string]interface{}{
+			"ToWeight":   weight,
+			"ToApp":      toApp.Name,
+			"FromWeight": 100 - weight,
+			"FromApp":    fromApp.Name,
+		})
+	}
+
+	warnings, err := cmd.Actor.UpdateRouteDestinations(route.GUID, destinations)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return cmd.restore(route, original, err)
+	}
+
+	time.Sleep(cmd.Config.PollingInterval())
+
+	healthy, warnings, err := cmd.Actor.ProcessInstancesRunning(toApp.GUID, constant.ProcessTypeWeb)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return cmd.restore(route, original, err)
+	}
+	if !healthy {
+		return cmd.restore(route, original, translatableerror.RouteCutoverFailedError{
+			AppName: toApp.Name,
+			Route:   route.URL,
+			Weight:  weight,
+		})
+	}
+
+	return nil
+}
+
+// restore puts back the route's original destinations and returns cause, or
+// the error restoring them.
+func (cmd CutoverRouteCommand) restore(route v7action.Route, original []v7action.RouteDestination, cause error) error {
+	cmd.UI.DisplayWarning("Restoring the original destinations of route {{.Route}}...", map[string]interface{}{
+		"Route": route.URL,
+	})
+
+	warnings, err := cmd.Actor.UpdateRouteDestinations(route.GUID, original)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	return cause
+}
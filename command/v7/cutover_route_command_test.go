@@ -0,0 +1,202 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cutover-route Command", func() {
+	var (
+		cmd             CutoverRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeCutoverRouteActor
+		binaryName      string
+		executeErr      error
+
+		route    v7action.Route
+		original []v7action.RouteDestination
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeCutoverRouteActor)
+
+		cmd = CutoverRouteCommand{
+			RequiredArgs: flag.RouteURL{Route: "app.example.com"},
+			From:         "blue",
+			To:           "green",
+			Step:         flag.Percentage{Value: 40},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error) {
+			return v7action.Application{Name: appName, GUID: appName + "-guid"}, v7action.Warnings{"get-app-warning"}, nil
+		}
+
+		route = v7action.Route{GUID: "route-guid", URL: "app.example.com"}
+		fakeActor.GetRouteByURLReturns(route, v7action.Warnings{"get-route-warning"}, nil)
+
+		original = []v7action.RouteDestination{{GUID: "destination-guid", AppGUID: "blue-guid", ProcessType: "web"}}
+		fakeActor.GetRouteDestinationsReturns(original, v7action.Warnings{"get-destinations-warning"}, nil)
+
+		fakeActor.CutoverRouteDestinationsStub = func(_ v7action.Route, _ []v7action.RouteDestination, _ v7action.Application, _ v7action.Application, toWeight int) ([]v7action.RouteDestination, error) {
+			return []v7action.RouteDestination{{AppGUID: "green-guid", Weight: types.NullInt{IsSet: true, Value: toWeight}}}, nil
+		}
+		fakeActor.ProcessInstancesRunningReturns(true, v7action.Warnings{"instances-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("shifts the traffic step by step and checks the new app after each step", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Cutting over route app\.example\.com from app blue to app green in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Sending 40% of traffic to green and 60% to blue\.\.\.`))
+		Expect(testUI.Out).To(Say(`Sending 80% of traffic to green and 20% to blue\.\.\.`))
+		Expect(testUI.Out).To(Say(`Sending all traffic to green\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("get-route-warning"))
+		Expect(testUI.Err).To(Say("get-destinations-warning"))
+
+		Expect(fakeActor.GetRouteByURLArgsForCall(0)).To(Equal("app.example.com"))
+		Expect(fakeActor.GetRouteDestinationsArgsForCall(0)).To(Equal("route-guid"))
+
+		Expect(fakeActor.CutoverRouteDestinationsCallCount()).To(Equal(3))
+		passedRoute, passedDestinations, fromApp, toApp, toWeight := fakeActor.CutoverRouteDestinationsArgsForCall(0)
+		Expect(passedRoute).To(Equal(route))
+		Expect(passedDestinations).To(Equal(original))
+		Expect(fromApp).To(Equal(v7action.Application{Name: "blue", GUID: "blue-guid"}))
+		Expect(toApp).To(Equal(v7action.Application{Name: "green", GUID: "green-guid"}))
+		Expect(toWeight).To(Equal(40))
+		_, _, _, _, toWeight = fakeActor.CutoverRouteDestinationsArgsForCall(2)
+		Expect(toWeight).To(Equal(100))
+
+		Expect(fakeActor.UpdateRouteDestinationsCallCount()).To(Equal(3))
+		routeGUID, destinations := fakeActor.UpdateRouteDestinationsArgsForCall(1)
+		Expect(routeGUID).To(Equal("route-guid"))
+		Expect(destinations).To(Equal([]v7action.RouteDestination{{AppGUID: "green-guid", Weight: types.NullInt{IsSet: true, Value: 80}}}))
+
+		Expect(fakeActor.ProcessInstancesRunningCallCount()).To(Equal(4))
+		appGUID, processType := fakeActor.ProcessInstancesRunningArgsForCall(0)
+		Expect(appGUID).To(Equal("green-guid"))
+		Expect(processType).To(Equal("web"))
+	})
+
+	When("the new app is not healthy before the cutover", func() {
+		BeforeEach(func() {
+			fakeActor.ProcessInstancesRunningReturns(false, nil, nil)
+		})
+
+		It("does not change the route", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RouteCutoverFailedError{AppName: "green", Route: "app.example.com"}))
+			Expect(fakeActor.UpdateRouteDestinationsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the new app becomes unhealthy during the cutover", func() {
+		BeforeEach(func() {
+			fakeActor.ProcessInstancesRunningReturnsOnCall(2, false, nil, nil)
+		})
+
+		It("restores the original destinations", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RouteCutoverFailedError{AppName: "green", Route: "app.example.com", Weight: 80}))
+			Expect(testUI.Err).To(Say(`Restoring the original destinations of route app\.example\.com\.\.\.`))
+
+			Expect(fakeActor.UpdateRouteDestinationsCallCount()).To(Equal(3))
+			routeGUID, destinations := fakeActor.UpdateRouteDestinationsArgsForCall(2)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinations).To(Equal(original))
+		})
+
+		When("restoring the original destinations fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateRouteDestinationsReturnsOnCall(2, v7action.Warnings{"restore-warning"}, errors.New("restore-error"))
+			})
+
+			It("returns the error restoring them", func() {
+				Expect(executeErr).To(MatchError("restore-error"))
+				Expect(testUI.Err).To(Say("restore-warning"))
+			})
+		})
+	})
+
+	When("updating the destinations fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateRouteDestinationsReturnsOnCall(1, v7action.Warnings{"update-warning"}, errors.New("update-error"))
+		})
+
+		It("restores the original destinations and returns the error", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			Expect(fakeActor.UpdateRouteDestinationsCallCount()).To(Equal(3))
+			_, destinations := fakeActor.UpdateRouteDestinationsArgsForCall(2)
+			Expect(destinations).To(Equal(original))
+		})
+	})
+
+	When("the route cannot be cut over", func() {
+		BeforeEach(func() {
+			fakeActor.CutoverRouteDestinationsReturns(nil, actionerror.RouteHasOtherDestinationsError{Route: "app.example.com"})
+			fakeActor.CutoverRouteDestinationsStub = nil
+		})
+
+		It("returns the error without changing the route", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteHasOtherDestinationsError{Route: "app.example.com"}))
+			Expect(fakeActor.UpdateRouteDestinationsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByURLReturns(v7action.Route{}, v7action.Warnings{"get-route-warning"}, actionerror.RouteNotFoundError{Host: "app", DomainName: "example.com"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "app", DomainName: "example.com"}))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+		})
+	})
+})
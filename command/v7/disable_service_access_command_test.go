@@ -0,0 +1,130 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("disable-service-access Command", func() {
+	var (
+		cmd             DisableServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDisableServiceAccessActor
+		binaryName      string
+		executeErr      error
+		changes         []v7action.ServiceAccessChange
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDisableServiceAccessActor)
+
+		cmd = DisableServiceAccessCommand{
+			RequiredArgs: flag.Service{Service: "some-service"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		changes = []v7action.ServiceAccessChange{
+			{
+				ServicePlanAccess: v7action.ServicePlanAccess{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "plan-1", VisibilityType: constant.ServicePlanVisibilityPublic},
+				NewVisibilityType: constant.ServicePlanVisibilityAdmin,
+			},
+			{
+				ServicePlanAccess: v7action.ServicePlanAccess{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "plan-2", VisibilityType: constant.ServicePlanVisibilityOrganization, Organizations: []string{"org-1", "org-2"}},
+				NewVisibilityType: constant.ServicePlanVisibilityOrganization,
+				LostOrganizations: []string{"org-1"},
+			},
+		}
+		fakeActor.GetDisableServiceAccessChangesReturns(changes, v7action.Warnings{"changes-warning"}, nil)
+		fakeActor.ApplyServiceAccessChangesReturns(v7action.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.GetDisableServiceAccessChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the org is given", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+		})
+
+		It("applies the access changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Disabling access to all plans of service some-service for the org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			serviceName, planName, orgName, brokerName := fakeActor.GetDisableServiceAccessChangesArgsForCall(0)
+			Expect(serviceName).To(Equal("some-service"))
+			Expect(planName).To(BeEmpty())
+			Expect(orgName).To(Equal("some-org"))
+			Expect(brokerName).To(BeEmpty())
+
+			Expect(fakeActor.ApplyServiceAccessChangesArgsForCall(0)).To(Equal(changes))
+		})
+	})
+
+	When("it is a dry run", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+		})
+
+		It("displays the changes without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`some-broker\s+some-service\s+plan-1\s+all -> none\s+all orgs`))
+			Expect(testUI.Out).To(Say(`some-broker\s+some-service\s+plan-2\s+limited -> limited\s+org-1`))
+			Expect(testUI.Out).To(Say(`Dry run: service access was not changed\.`))
+
+			Expect(fakeActor.ApplyServiceAccessChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyServiceAccessChangesReturns(v7action.Warnings{"apply-warning"}, errors.New("apply-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("apply-error"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . EnableServiceAccessActor
+
+type EnableServiceAccessActor interface {
+	GetEnableServiceAccessChanges(serviceName string, planName string, orgName string, brokerName string) ([]v7action.ServiceAccessChange, v7action.Warnings, error)
+	ApplyServiceAccessChanges(changes []v7action.ServiceAccessChange) (v7action.Warnings, error)
+}
+
+type EnableServiceAccessCommand struct {
+	RequiredArgs    flag.Service `positional-args:"yes"`
+	ServiceBroker   string       `short:"b" description:"Enable access to a service from a particular service broker. Required when service name is ambiguous"`
+	Organization    string       `short:"o" description:"Enable access for a specified organization"`
+	ServicePlan     string       `short:"p" description:"Enable access to a specified service plan"`
+	usage           interface{}  `usage:"CF_NAME enable-service-access SERVICE [-b BROKER] [-p PLAN] [-o ORG]\n\n   With the --dry-run global option, shows which orgs would gain or lose access to each\n   plan, without changing access."`
+	relatedCommands interface{}  `related_commands:"marketplace, service-access, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnableServiceAccessActor
+}
+
+func (cmd *EnableServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+	return nil
+}
+
+func (cmd EnableServiceAccessCommand) Execute(args []string) error {
+	if len(args) > 0 {
+		return translatableerror.TooManyArgumentsError{
+			ExtraArgument: args[0],
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	serviceName := cmd.RequiredArgs.Service
+	servicePlanName := cmd.ServicePlan
+	orgName := cmd.Organization
+	serviceBrokerName := cmd.ServiceBroker
+
+	cmd.UI.DisplayTextWithFlavor(enableServiceAccessMessages[enableServiceAccessOptions{servicePlanName != "", orgName != "", serviceBrokerName != ""}],
+		map[string]interface{}{
+			"ServicePlan":   servicePlanName,
+			"Service":       serviceName,
+			"ServiceBroker": serviceBrokerName,
+			"Organization":  orgName,
+			"User":          user.Name,
+		})
+
+	changes, warnings, err := cmd.Actor.GetEnableServiceAccessChanges(serviceName, servicePlanName, orgName, serviceBrokerName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Config.DryRun() {
+		cmd.UI.DisplayNewline()
+		displayServiceAccessChanges(cmd.UI, changes)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run: service access was not changed.")
+		return nil
+	}
+
+	warnings, err = cmd.Actor.ApplyServiceAccessChanges(changes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+type enableServiceAccessOptions struct {
+	Plan   bool
+	Org    bool
+	Broker bool
+}
+
+var enableServiceAccessMessages = map[enableServiceAccessOptions]string{
+	{Plan: true, Org: true, Broker: false}:   "Enabling access to plan {{.ServicePlan}} of service {{.Service}} for org {{.Organization}} as {{.User}}...",
+	{Plan: false, Org: true, Broker: false}:  "Enabling access to all plans of service {{.Service}} for the org {{.Organization}} as {{.User}}...",
+	{Plan: true, Org: false, Broker: false}:  "Enabling access of plan {{.ServicePlan}} for service {{.Service}} as {{.User}}...",
+	{Plan: false, Org: false, Broker: false}: "Enabling access to all plans of service {{.Service}} for all orgs as {{.User}}...",
+	{Plan: true, Org: true, Broker: true}:    "Enabling access to plan {{.ServicePlan}} of service {{.Service}} from broker {{.ServiceBroker}} for org {{.Organization}} as {{.User}}...",
+	{Plan: false, Org: true, Broker: true}:   "Enabling access to all plans of service {{.Service}} from broker {{.ServiceBroker}} for the org {{.Organization}} as {{.User}}...",
+	{Plan: true, Org: false, Broker: true}:   "Enabling access to plan {{.ServicePlan}} for service {{.Service}} from broker {{.ServiceBroker}} for all orgs as {{.User}}...",
+	{Plan: false, Org: false, Broker: true}:  "Enabling access to all plans of service {{.Service}} from broker {{.ServiceBroker}} for all orgs as {{.User}}...",
+}
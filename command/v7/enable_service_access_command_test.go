@@ -0,0 +1,172 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("enable-service-access Command", func() {
+	var (
+		cmd             EnableServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeEnableServiceAccessActor
+		binaryName      string
+		extraArgs       []string
+		executeErr      error
+		changes         []v7action.ServiceAccessChange
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeEnableServiceAccessActor)
+		extraArgs = nil
+
+		cmd = EnableServiceAccessCommand{
+			RequiredArgs: flag.Service{Service: "some-service"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		changes = []v7action.ServiceAccessChange{
+			{
+				ServicePlanAccess:   v7action.ServicePlanAccess{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "plan-1", VisibilityType: constant.ServicePlanVisibilityAdmin},
+				NewVisibilityType:   constant.ServicePlanVisibilityOrganization,
+				GainedOrganizations: []string{"some-org"},
+			},
+			{
+				ServicePlanAccess: v7action.ServicePlanAccess{BrokerName: "some-broker", ServiceName: "some-service", PlanName: "plan-2", VisibilityType: constant.ServicePlanVisibilityOrganization, Organizations: []string{"other-org"}},
+				NewVisibilityType: constant.ServicePlanVisibilityPublic,
+			},
+		}
+		fakeActor.GetEnableServiceAccessChangesReturns(changes, v7action.Warnings{"changes-warning"}, nil)
+		fakeActor.ApplyServiceAccessChangesReturns(v7action.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(extraArgs)
+	})
+
+	When("there are extra arguments", func() {
+		BeforeEach(func() {
+			extraArgs = []string{"extra"}
+		})
+
+		It("returns a TooManyArgumentsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TooManyArgumentsError{ExtraArgument: "extra"}))
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.GetEnableServiceAccessChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the plan, org and broker are given", func() {
+		BeforeEach(func() {
+			cmd.ServicePlan = "some-plan"
+			cmd.Organization = "some-org"
+			cmd.ServiceBroker = "some-broker"
+		})
+
+		It("applies the access changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Enabling access to plan some-plan of service some-service from broker some-broker for org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			serviceName, planName, orgName, brokerName := fakeActor.GetEnableServiceAccessChangesArgsForCall(0)
+			Expect(serviceName).To(Equal("some-service"))
+			Expect(planName).To(Equal("some-plan"))
+			Expect(orgName).To(Equal("some-org"))
+			Expect(brokerName).To(Equal("some-broker"))
+
+			Expect(fakeActor.ApplyServiceAccessChangesCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplyServiceAccessChangesArgsForCall(0)).To(Equal(changes))
+		})
+	})
+
+	When("it is a dry run", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+		})
+
+		It("displays the changes without applying them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Enabling access to all plans of service some-service for all orgs as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`broker\s+service\s+plan\s+access\s+orgs gaining access\s+orgs losing access`))
+			Expect(testUI.Out).To(Say(`some-broker\s+some-service\s+plan-1\s+none -> limited\s+some-org`))
+			Expect(testUI.Out).To(Say(`some-broker\s+some-service\s+plan-2\s+limited -> all\s+all orgs`))
+			Expect(testUI.Out).To(Say(`Dry run: service access was not changed\.`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+
+			Expect(fakeActor.ApplyServiceAccessChangesCallCount()).To(Equal(0))
+		})
+
+		When("nothing would change", func() {
+			BeforeEach(func() {
+				fakeActor.GetEnableServiceAccessChangesReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No service access changes\.`))
+			})
+		})
+	})
+
+	When("getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnableServiceAccessChangesReturns(nil, v7action.Warnings{"changes-warning"}, actionerror.ServiceNotFoundError{Name: "some-service"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceNotFoundError{Name: "some-service"}))
+			Expect(testUI.Err).To(Say("changes-warning"))
+			Expect(fakeActor.ApplyServiceAccessChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyServiceAccessChangesReturns(v7action.Warnings{"apply-warning"}, errors.New("apply-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("apply-error"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+		})
+	})
+})
@@ -57,7 +57,8 @@ var _ = Describe("labels command", func() {
 			fakeActor.GetApplicationByNameAndSpaceReturns(
 				v7action.Application{
 					Metadata: struct {
-						Labels map[string]types.NullString `json:"labels,omitempty"`
+						Labels      map[string]types.NullString `json:"labels,omitempty"`
+						Annotations map[string]types.NullString `json:"annotations,omitempty"`
 					}{
 						Labels: map[string]types.NullString{
 							"some-other-label": types.NewNullString("some-other-value"),
@@ -104,7 +105,8 @@ var _ = Describe("labels command", func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(
 					v7action.Application{
 						Metadata: struct {
-							Labels map[string]types.NullString `json:"labels,omitempty"`
+							Labels      map[string]types.NullString `json:"labels,omitempty"`
+							Annotations map[string]types.NullString `json:"annotations,omitempty"`
 						}{
 							Labels: map[string]types.NullString{
 								"some-other-label": types.NewNullString("some-other-value"),
@@ -0,0 +1,144 @@
+package v7
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/sorting"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . OutdatedAppsActor
+
+type OutdatedAppsActor interface {
+	CloudControllerAPIVersion() string
+	GetOutdatedApplicationsByBuildpack(buildpackName string, spaceGUID string) ([]v7action.OutdatedApplication, v7action.Warnings, error)
+	RestageApplication(app v7action.Application, rolling bool) (v7action.Warnings, error)
+}
+
+type OutdatedAppsCommand struct {
+	Buildpack       string      `long:"buildpack" required:"true" description:"Name of the buildpack to check apps against"`
+	Restage         bool        `long:"restage" description:"Restage every outdated app"`
+	Strategy        string      `long:"strategy" choice:"rolling" description:"Restage with a rolling deployment that replaces instances one at a time without downtime (requires --restage)"`
+	usage           interface{} `usage:"CF_NAME outdated-apps --buildpack BUILDPACK [--restage [--strategy rolling]]\n\n   Lists the apps in the targeted space whose droplets were staged with an older version of the buildpack than the one installed for their stack. The installed version is read from the buildpack's filename."`
+	examples        interface{} `examples:"CF_NAME outdated-apps --buildpack java_buildpack\nCF_NAME outdated-apps --buildpack java_buildpack --restage --strategy rolling"`
+	relatedCommands interface{} `related_commands:"buildpacks, restage, update-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OutdatedAppsActor
+}
+
+func (cmd *OutdatedAppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd OutdatedAppsCommand) Execute(args []string) error {
+	if cmd.Strategy != "" && !cmd.Restage {
+		return translatableerror.RequiredFlagsError{Arg1: "--strategy", Arg2: "--restage"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	rolling := cmd.Strategy == "rolling"
+	if rolling {
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		err = gate.Require("Option '--strategy rolling'", ccversion.MinVersionZeroDowntimePushV3)
+		if err != nil {
+			return err
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps staged with an outdated {{.BuildpackName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"BuildpackName": cmd.Buildpack,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	outdatedApps, warnings, err := cmd.Actor.GetOutdatedApplicationsByBuildpack(cmd.Buildpack, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(outdatedApps) == 0 {
+		cmd.UI.DisplayText("No apps found staged with an outdated {{.BuildpackName}}.", map[string]interface{}{
+			"BuildpackName": cmd.Buildpack,
+		})
+		return nil
+	}
+
+	sort.Slice(outdatedApps, func(i, j int) bool {
+		return sorting.LessIgnoreCase(outdatedApps[i].Name, outdatedApps[j].Name)
+	})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("stack"),
+			cmd.UI.TranslateText("staged version"),
+			cmd.UI.TranslateText("installed version"),
+		},
+	}
+	for _, app := range outdatedApps {
+		table = append(table, []string{app.Name, app.Stack, app.StagedVersion, app.InstalledVersion})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if !cmd.Restage {
+		return nil
+	}
+
+	return cmd.restage(outdatedApps, rolling)
+}
+
+func (cmd OutdatedAppsCommand) restage(outdatedApps []v7action.OutdatedApplication, rolling bool) error {
+	var failed []string
+	for _, app := range outdatedApps {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Restaging app {{.AppName}}...", map[string]interface{}{
+			"AppName": app.Name,
+		})
+
+		warnings, err := cmd.Actor.RestageApplication(app.Application, rolling)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning(err.Error())
+			failed = append(failed, app.Name)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(failed) > 0 {
+		return translatableerror.RestageApplicationsFailedError{AppNames: failed}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
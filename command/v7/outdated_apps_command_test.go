@@ -0,0 +1,197 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("outdated-apps Command", func() {
+	var (
+		cmd             OutdatedAppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeOutdatedAppsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeOutdatedAppsActor)
+
+		cmd = OutdatedAppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Buildpack:   "java_buildpack",
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetOutdatedApplicationsByBuildpackReturns(
+			[]v7action.OutdatedApplication{
+				{
+					Application:      v7action.Application{Name: "zebra-app", GUID: "zebra-app-guid"},
+					Stack:            "cflinuxfs3",
+					StagedVersion:    "v4.17",
+					InstalledVersion: "4.19.1",
+				},
+				{
+					Application:      v7action.Application{Name: "aardvark-app", GUID: "aardvark-app-guid"},
+					Stack:            "cflinuxfs3",
+					StagedVersion:    "v4.18",
+					InstalledVersion: "4.19.1",
+				},
+			},
+			v7action.Warnings{"get-outdated-warning"},
+			nil,
+		)
+		fakeActor.RestageApplicationReturns(v7action.Warnings{"restage-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("lists the outdated apps sorted by name without restaging them", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting apps staged with an outdated java_buildpack in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+stack\s+staged version\s+installed version`))
+		Expect(testUI.Out).To(Say(`aardvark-app\s+cflinuxfs3\s+v4\.18\s+4\.19\.1`))
+		Expect(testUI.Out).To(Say(`zebra-app\s+cflinuxfs3\s+v4\.17\s+4\.19\.1`))
+		Expect(testUI.Err).To(Say("get-outdated-warning"))
+
+		buildpackName, spaceGUID := fakeActor.GetOutdatedApplicationsByBuildpackArgsForCall(0)
+		Expect(buildpackName).To(Equal("java_buildpack"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+	})
+
+	When("no apps are outdated", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedApplicationsByBuildpackReturns([]v7action.OutdatedApplication{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No apps found staged with an outdated java_buildpack\.`))
+			Expect(testUI.Out).NotTo(Say("name"))
+		})
+	})
+
+	When("getting the outdated apps fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedApplicationsByBuildpackReturns(nil, v7action.Warnings{"get-outdated-warning"}, actionerror.BuildpackNotFoundError{BuildpackName: "java_buildpack"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.BuildpackNotFoundError{BuildpackName: "java_buildpack"}))
+			Expect(testUI.Err).To(Say("get-outdated-warning"))
+		})
+	})
+
+	When("--restage is passed", func() {
+		BeforeEach(func() {
+			cmd.Restage = true
+		})
+
+		It("restages every outdated app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Restaging app aardvark-app\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restaging app zebra-app\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("restage-warning"))
+
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+			app, rolling := fakeActor.RestageApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("aardvark-app-guid"))
+			Expect(rolling).To(BeFalse())
+			Expect(fakeActor.CloudControllerAPIVersionCallCount()).To(Equal(0))
+		})
+
+		When("restaging an app fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestageApplicationReturnsOnCall(0, v7action.Warnings{"restage-warning"}, errors.New("staging failed"))
+			})
+
+			It("restages the remaining apps and returns a RestageApplicationsFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RestageApplicationsFailedError{AppNames: []string{"aardvark-app"}}))
+				Expect(testUI.Err).To(Say("staging failed"))
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+
+		When("--strategy rolling is passed", func() {
+			BeforeEach(func() {
+				cmd.Strategy = "rolling"
+			})
+
+			It("restages with a rolling deployment", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, rolling := fakeActor.RestageApplicationArgsForCall(0)
+				Expect(rolling).To(BeTrue())
+			})
+
+			When("the API does not support rolling deployments", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("3.56.0")
+				})
+
+				It("returns a MinimumCFAPIVersionNotMetError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+						Command:        "Option '--strategy rolling'",
+						CurrentVersion: "3.56.0",
+						MinimumVersion: ccversion.MinVersionZeroDowntimePushV3,
+					}))
+					Expect(fakeActor.GetOutdatedApplicationsByBuildpackCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	When("--strategy is passed without --restage", func() {
+		BeforeEach(func() {
+			cmd.Strategy = "rolling"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--strategy", Arg2: "--restage"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -42,8 +42,12 @@ type PushActor interface {
 	PrepareSpace(pushPlans []v7pushaction.PushPlan, parser v7pushaction.ManifestParser) (<-chan []v7pushaction.PushPlan, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error)
 	// UpdateApplicationSettings figures out the state of the world.
 	UpdateApplicationSettings(pushPlans []v7pushaction.PushPlan) ([]v7pushaction.PushPlan, v7pushaction.Warnings, error)
+	// CheckPushPlanQuotas fails early when the apps do not fit in the quotas.
+	CheckPushPlanQuotas(pushPlans []v7pushaction.PushPlan) (v7pushaction.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) (<-chan v7pushaction.PushPlan, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error)
+	// DeletePushCheckpoint forgets the completed phases of a finished push.
+	DeletePushCheckpoint(plan v7pushaction.PushPlan)
 }
 
 //go:generate counterfeiter . V7ActorForPush
@@ -70,50 +74,67 @@ type ManifestLocator interface {
 }
 
 type PushCommand struct {
-	OptionalArgs            flag.OptionalAppName          `positional-args:"yes"`
-	HealthCheckTimeout      flag.PositiveInteger          `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	Buildpacks              []string                      `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Disk                    flag.Megabytes                `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	DockerImage             flag.DockerImage              `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
-	DockerUsername          string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	HealthCheckHTTPEndpoint string                        `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
-	HealthCheckType         flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
-	Instances               flag.Instances                `long:"instances" short:"i" description:"Number of instances"`
-	PathToManifest          flag.PathWithExistenceCheck   `long:"manifest" short:"f" description:"Path to manifest"`
-	Memory                  flag.Megabytes                `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoManifest              bool                          `long:"no-manifest" description:""`
-	NoRoute                 bool                          `long:"no-route" description:"Do not map a route to this app"`
-	NoStart                 bool                          `long:"no-start" description:"Do not stage and start the app after pushing"`
-	AppPath                 flag.PathWithExistenceCheck   `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	Stack                   string                        `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	StartCommand            flag.Command                  `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Vars                    []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
-	PathsToVarsFiles        []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
-	dockerPassword          interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                   `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
-	envCFStagingTimeout     interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout     interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-
-	Config          command.Config
-	UI              command.UI
-	NOAAClient      v3action.NOAAClient
-	Actor           PushActor
-	VersionActor    V7ActorForPush
-	SharedActor     command.SharedActor
-	RouteActor      v7action.RouteActor
-	ProgressBar     ProgressBar
-	PWD             string
-	ManifestLocator ManifestLocator
-	ManifestParser  ManifestParser
+	OptionalArgs            flag.OptionalAppName                      `positional-args:"yes"`
+	HealthCheckTimeout      flag.PositiveInteger                      `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	Buildpacks              []string                                  `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Disk                    flag.Megabytes                            `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	DockerImage             flag.DockerImage                          `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
+	DockerUsername          string                                    `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	HealthCheckHTTPEndpoint string                                    `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
+	HealthCheckType         flag.HealthCheckType                      `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
+	Instances               flag.Instances                            `long:"instances" short:"i" description:"Number of instances"`
+	PathToManifest          flag.PathWithExistenceCheck               `long:"manifest" short:"f" description:"Path to manifest"`
+	Memory                  flag.Megabytes                            `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoManifest              bool                                      `long:"no-manifest" description:""`
+	NoRoute                 bool                                      `long:"no-route" description:"Do not map a route to this app"`
+	NoBuildCache            bool                                      `long:"no-build-cache" description:"Clear the app's build cache before staging, so buildpacks start from a clean cache"`
+	NoFingerprintCache      bool                                      `long:"no-fingerprint-cache" description:"Recalculate the fingerprints of all app files instead of reusing those cached by previous pushes"`
+	NoStart                 bool                                      `long:"no-start" description:"Do not stage and start the app after pushing"`
+	Resume                  bool                                      `long:"resume" description:"Continue a failed push from its last completed phase, reusing the package, build or droplet it created"`
+	AppPath                 flag.PathWithExistenceCheckOrRemoteSource `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory, or URL of a zip or tar.gz archive or git repository (append #REF to select a branch, tag or commit)"`
+	Stack                   string                                    `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StartCommand            flag.Command                              `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
+	Timeout                 flag.Timeout                              `long:"timeout" description:"Maximum time to wait for the command to finish (e.g. 90s, 10m); numbers without a unit are minutes"`
+	Vars                    []template.VarKV                          `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles        []flag.PathWithExistenceCheck             `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	ShowIgnored             bool                                      `long:"show-ignored" description:"List the files excluded from the upload by .cfignore files and the default ignore rules"`
+	dockerPassword          interface{}                               `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	usage                   interface{}                               `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]... [--show-ignored] [--no-fingerprint-cache] [--no-build-cache] [--resume] [--timeout TIMEOUT]\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	envCFStagingTimeout     interface{}                               `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout     interface{}                               `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Config                command.Config
+	UI                    command.UI
+	NOAAClient            v3action.NOAAClient
+	Actor                 PushActor
+	VersionActor          V7ActorForPush
+	SharedActor           command.SharedActor
+	IgnoredResourcesActor v6shared.IgnoredResourcesActor
+	RemoteAppSourceActor  v6shared.RemoteAppSourceActor
+	RouteActor            v7action.RouteActor
+	ProgressBar           ProgressBar
+	PWD                   string
+	ManifestLocator       ManifestLocator
+	ManifestParser        ManifestParser
 }
 
 func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.UI = ui
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	progressBar := progressbar.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
+	if cmd.Timeout.Value != 0 {
+		config.SetCommandTimeout(cmd.Timeout.Value)
+	}
 
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
+	cmd.IgnoredResourcesActor = sharedActor
+	cmd.RemoteAppSourceActor = sharedActor
+	if !cmd.NoFingerprintCache {
+		sharedActor.FingerprintCacheDirectory = configv3.FingerprintCacheDirectory()
+	}
 
 	ccClient, uaaClient, err := v6shared.NewV3BasedClients(config, ui, true, "")
 	if err != nil {
@@ -129,7 +150,9 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 	cmd.RouteActor = v2Actor
-	cmd.Actor = v7pushaction.NewActor(v2Actor, v7actor, sharedActor)
+	pushActor := v7pushaction.NewActor(v2Actor, v7actor, sharedActor)
+	pushActor.CheckpointDirectory = configv3.PushCheckpointDirectory()
+	cmd.Actor = pushActor
 
 	cmd.NOAAClient = v6shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
 
@@ -176,6 +199,13 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	appPath, cleanupAppPath, err := v6shared.FetchRemoteAppSource(cmd.UI, cmd.RemoteAppSourceActor, flagOverrides.ProvidedAppPath)
+	if err != nil {
+		return err
+	}
+	defer cleanupAppPath()
+	flagOverrides.ProvidedAppPath = appPath
+
 	pushPlans, err := cmd.Actor.CreatePushPlans(
 		cmd.OptionalArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -187,6 +217,13 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.ShowIgnored {
+		err = cmd.displayIgnoredResources(pushPlans)
+		if err != nil {
+			return err
+		}
+	}
+
 	pushPlansStream, eventStream, warningsStream, errorStream := cmd.Actor.PrepareSpace(pushPlans, cmd.ManifestParser)
 	appNames, err := cmd.processStreamsFromPrepareSpace(pushPlansStream, eventStream, warningsStream, errorStream)
 
@@ -215,18 +252,36 @@ func (cmd PushCommand) Execute(args []string) error {
 	}
 	log.WithField("number of plans", len(pushPlans)).Debug("completed generating plan")
 
+	warnings, err = cmd.Actor.CheckPushPlanQuotas(pushPlans)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.QuotaReservationExceededError:
+		return err
+	default:
+		cmd.UI.DisplayWarning("Unable to check quota: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	progress := v6shared.NewTimeoutProgress(cmd.Timeout.Value, cmd.Config.CommandDeadline(), "")
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
+		progress.Step("pushing app " + plan.Application.Name)
 		planStream, eventStream, warningsStream, errorStream := cmd.Actor.Actualize(plan, cmd.ProgressBar)
 		updatedPlan, err := cmd.processApplyStreams(plan.Application.Name, planStream, eventStream, warningsStream, errorStream)
 		if err != nil {
-			return err
+			return progress.Wrap(err)
 		}
 
+		if !cmd.NoStart {
+			progress.Step("waiting for app " + plan.Application.Name + " to start")
+		}
 		anyProcessCrashed, err := cmd.appRestarter(plan.Application.Name, updatedPlan.Application.GUID)
 		if err != nil {
-			return err
+			return progress.Wrap(err)
 		}
+		cmd.Actor.DeletePushCheckpoint(updatedPlan)
 		err = cmd.displayAppSummary(plan)
 		if err != nil {
 			return err
@@ -242,6 +297,20 @@ func (cmd PushCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd PushCommand) displayIgnoredResources(pushPlans []v7pushaction.PushPlan) error {
+	for _, plan := range pushPlans {
+		if plan.Application.LifecycleType == constant.AppLifecycleTypeDocker || plan.BitsPath == "" {
+			continue
+		}
+
+		err := v6shared.DisplayIgnoredResources(cmd.UI, cmd.IgnoredResourcesActor, plan.Application.Name, plan.BitsPath, plan.Archive)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (cmd PushCommand) announcePushing(appNames []string, user configv3.User) {
 	tokens := map[string]interface{}{
 		"AppName":   strings.Join(appNames, ", "),
@@ -473,8 +542,17 @@ func (cmd PushCommand) processEvent(event v7pushaction.Event, appName string) (b
 			return false, err
 		}
 		go cmd.getLogs(logStream, errStream)
+	case v7pushaction.ClearingBuildCache:
+		cmd.UI.DisplayText("Clearing build cache...")
 	case v7pushaction.StagingComplete:
 		cmd.NOAAClient.Close()
+	case v7pushaction.ResumingFromPackage:
+		cmd.UI.DisplayText("Resuming from previously uploaded package...")
+	case v7pushaction.ResumingFromBuild:
+		cmd.UI.DisplayText("Resuming previous staging...")
+	case v7pushaction.ResumingFromDroplet:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Resuming from previously staged droplet...")
 	case v7pushaction.Complete:
 		return true, nil
 	default:
@@ -549,8 +627,10 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		HealthCheckType:     cmd.HealthCheckType.Type,
 		HealthCheckTimeout:  cmd.HealthCheckTimeout.Value, Instances: cmd.Instances.NullInt,
 		Memory:            cmd.Memory.NullUint64,
+		NoBuildCache:      cmd.NoBuildCache,
 		NoStart:           cmd.NoStart,
 		ProvidedAppPath:   string(cmd.AppPath),
+		Resume:            cmd.Resume,
 		SkipRouteCreation: cmd.NoRoute,
 		StartCommand:      cmd.StartCommand.FilteredString,
 	}, nil
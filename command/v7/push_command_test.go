@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
@@ -14,10 +15,12 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/cfignore"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -138,6 +141,9 @@ var _ = Describe("push Command", func() {
 		orgName            string
 		pwd                string
 		fakeManifestParser *v7fakes.FakeManifestParser
+
+		fakeIgnoredResourcesActor *sharedfakes.FakeIgnoredResourcesActor
+		fakeRemoteAppSourceActor  *sharedfakes.FakeRemoteAppSourceActor
 	)
 
 	BeforeEach(func() {
@@ -158,6 +164,8 @@ var _ = Describe("push Command", func() {
 		pwd = "/push/cmd/test"
 		fakeManifestLocator = new(v7fakes.FakeManifestLocator)
 		fakeManifestParser = new(v7fakes.FakeManifestParser)
+		fakeIgnoredResourcesActor = new(sharedfakes.FakeIgnoredResourcesActor)
+		fakeRemoteAppSourceActor = new(sharedfakes.FakeRemoteAppSourceActor)
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -174,6 +182,9 @@ var _ = Describe("push Command", func() {
 			PWD:             pwd,
 			ManifestLocator: fakeManifestLocator,
 			ManifestParser:  fakeManifestParser,
+
+			IgnoredResourcesActor: fakeIgnoredResourcesActor,
+			RemoteAppSourceActor:  fakeRemoteAppSourceActor,
 		}
 	})
 
@@ -329,6 +340,35 @@ var _ = Describe("push Command", func() {
 					Expect(actualOrgGUID).To(Equal("some-org-guid"))
 				})
 
+				When("the app path is a remote URL", func() {
+					BeforeEach(func() {
+						cmd.AppPath = "git@example.com:org/repo.git#v1.0.0"
+						fakeRemoteAppSourceActor.FetchRemoteAppSourceReturns("/some/fetched/app", nil)
+					})
+
+					It("fetches the app source and plans the push from the fetched path", func() {
+						Expect(testUI.Out).To(Say(`Downloading app source from git@example\.com:org/repo\.git#v1\.0\.0\.\.\.`))
+
+						Expect(fakeRemoteAppSourceActor.FetchRemoteAppSourceCallCount()).To(Equal(1))
+						source, _, _ := fakeRemoteAppSourceActor.FetchRemoteAppSourceArgsForCall(0)
+						Expect(source).To(Equal("git@example.com:org/repo.git#v1.0.0"))
+
+						_, _, _, _, overrides := fakeActor.CreatePushPlansArgsForCall(0)
+						Expect(overrides.ProvidedAppPath).To(Equal("/some/fetched/app"))
+					})
+
+					When("fetching the app source fails", func() {
+						BeforeEach(func() {
+							fakeRemoteAppSourceActor.FetchRemoteAppSourceReturns("", actionerror.GitNotInstalledError{})
+						})
+
+						It("returns the error without creating push plans", func() {
+							Expect(executeErr).To(MatchError(actionerror.GitNotInstalledError{}))
+							Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				When("Creating the pushPlans errors", func() {
 					BeforeEach(func() {
 						fakeActor.CreatePushPlansReturns(nil, errors.New("panic"))
@@ -350,6 +390,46 @@ var _ = Describe("push Command", func() {
 						)
 					})
 
+					When("--show-ignored is provided", func() {
+						BeforeEach(func() {
+							cmd.ShowIgnored = true
+							fakeActor.CreatePushPlansReturns(
+								[]v7pushaction.PushPlan{
+									{Application: v7action.Application{Name: appName1}, BitsPath: "/some/archive.zip", Archive: true},
+									{Application: v7action.Application{Name: appName2, LifecycleType: constant.AppLifecycleTypeDocker}},
+								}, nil,
+							)
+							fakeIgnoredResourcesActor.GatherIgnoredArchiveResourcesReturns(
+								[]sharedaction.IgnoredResource{
+									{Filename: "/.git/", Rule: cfignore.Rule{Source: "default ignore rules", Line: 3, Pattern: ".git"}},
+								},
+								nil,
+							)
+						})
+
+						It("lists the ignored files for apps with bits", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeIgnoredResourcesActor.GatherIgnoredArchiveResourcesCallCount()).To(Equal(1))
+							Expect(fakeIgnoredResourcesActor.GatherIgnoredArchiveResourcesArgsForCall(0)).To(Equal("/some/archive.zip"))
+							Expect(fakeIgnoredResourcesActor.GatherIgnoredDirectoryResourcesCallCount()).To(Equal(0))
+
+							Expect(testUI.Out).To(Say(`Files ignored when uploading app %s from /some/archive\.zip:`, appName1))
+							Expect(testUI.Out).To(Say(`/\.git/\s+default ignore rules:3: \.git`))
+						})
+
+						When("gathering the ignored files fails", func() {
+							BeforeEach(func() {
+								fakeIgnoredResourcesActor.GatherIgnoredArchiveResourcesReturns(nil, errors.New("some-ignore-error"))
+							})
+
+							It("returns the error before preparing the space", func() {
+								Expect(executeErr).To(MatchError("some-ignore-error"))
+								Expect(fakeActor.PrepareSpaceCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Describe("delegating to Actor.PrepareSpace", func() {
 						It("delegates to PrepareSpace", func() {
 							actualPushPlans, actualParser := fakeActor.PrepareSpaceArgsForCall(0)
@@ -390,6 +470,32 @@ var _ = Describe("push Command", func() {
 										))
 									})
 
+									When("the push plans exceed a quota", func() {
+										BeforeEach(func() {
+											fakeActor.CheckPushPlanQuotasReturns(
+												v7pushaction.Warnings{"quota-warning"},
+												actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1G"})
+										})
+
+										It("returns the error without actualizing", func() {
+											Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2G", Available: "1G"}))
+											Expect(testUI.Err).To(Say("quota-warning"))
+											Expect(fakeActor.CheckPushPlanQuotasCallCount()).To(Equal(1))
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+										})
+									})
+
+									When("the quotas cannot be checked", func() {
+										BeforeEach(func() {
+											fakeActor.CheckPushPlanQuotasReturns(nil, errors.New("quota-lookup-failed"))
+										})
+
+										It("warns and continues pushing", func() {
+											Expect(testUI.Err).To(Say("Unable to check quota: quota-lookup-failed"))
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+										})
+									})
+
 									Describe("delegating to Actor.Actualize", func() {
 										When("Actualize returns success", func() {
 											BeforeEach(func() {
@@ -483,6 +589,32 @@ var _ = Describe("push Command", func() {
 												})
 											})
 
+											Describe("resume and build cache events", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = FillInValues([]Step{
+														{
+															Event: v7pushaction.ResumingFromPackage,
+														},
+														{
+															Event: v7pushaction.ResumingFromDroplet,
+														},
+														{
+															Event:    v7pushaction.ClearingBuildCache,
+															Warnings: v7pushaction.Warnings{"clear build cache warning"},
+														},
+													}, v7pushaction.PushPlan{})
+												})
+
+												It("displays the phases the push resumes from and clearing the build cache", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+
+													Expect(testUI.Out).To(Say("Resuming from previously uploaded package..."))
+													Expect(testUI.Out).To(Say("Resuming from previously staged droplet..."))
+													Expect(testUI.Out).To(Say("Clearing build cache..."))
+													Expect(testUI.Err).To(Say("clear build cache warning"))
+												})
+											})
+
 											Describe("staging logs", func() {
 												BeforeEach(func() {
 													fakeActor.ActualizeStub = FillInValues([]Step{
@@ -572,6 +704,13 @@ var _ = Describe("push Command", func() {
 														Expect(fakeVersionActor.RestartApplicationArgsForCall(1)).To(Equal("potato"))
 													})
 
+													It("deletes the push checkpoint of each app", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+
+														Expect(fakeActor.DeletePushCheckpointCallCount()).To(Equal(2))
+														Expect(fakeActor.DeletePushCheckpointArgsForCall(0).Application.GUID).To(Equal("potato"))
+													})
+
 													When("when getting the application summary succeeds", func() {
 														BeforeEach(func() {
 															summary := v7action.ApplicationSummary{
@@ -626,6 +765,10 @@ var _ = Describe("push Command", func() {
 															Expect(executeErr).To(MatchError("restart failure"))
 															Expect(testUI.Err).To(Say("some-restart-warning"))
 														})
+
+														It("keeps the push checkpoint so the push can be resumed", func() {
+															Expect(fakeActor.DeletePushCheckpointCallCount()).To(Equal(0))
+														})
 													})
 
 													When("the error is an AllInstancesCrashedError", func() {
@@ -655,6 +798,21 @@ var _ = Describe("push Command", func() {
 
 															Expect(testUI.Err).To(Say("some-restart-warning"))
 														})
+
+														When("the --timeout deadline has passed", func() {
+															BeforeEach(func() {
+																cmd.Timeout.Value = 5 * time.Minute
+																fakeConfig.CommandDeadlineReturns(time.Now())
+															})
+
+															It("returns a CommandTimeoutError describing the progress made", func() {
+																Expect(executeErr).To(MatchError(translatableerror.CommandTimeoutError{
+																	Timeout:    5 * time.Minute,
+																	Completed:  []string{"pushing app first-app"},
+																	InProgress: "waiting for app first-app to start",
+																}))
+															})
+														})
 													})
 												})
 											})
@@ -906,6 +1064,8 @@ var _ = Describe("push Command", func() {
 			cmd.StartCommand = flag.Command{FilteredString: types.FilteredString{IsSet: true, Value: "some-start-command"}}
 			cmd.NoRoute = true
 			cmd.NoStart = true
+			cmd.NoBuildCache = true
+			cmd.Resume = true
 			cmd.Instances = flag.Instances{NullInt: types.NullInt{Value: 10, IsSet: true}}
 		})
 
@@ -926,6 +1086,8 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.StartCommand).To(Equal(types.FilteredString{IsSet: true, Value: "some-start-command"}))
 			Expect(overrides.SkipRouteCreation).To(BeTrue())
 			Expect(overrides.NoStart).To(BeTrue())
+			Expect(overrides.NoBuildCache).To(BeTrue())
+			Expect(overrides.Resume).To(BeTrue())
 			Expect(overrides.Instances).To(Equal(types.NullInt{Value: 10, IsSet: true}))
 		})
 
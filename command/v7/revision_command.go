@@ -0,0 +1,190 @@
+package v7
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . RevisionActor
+
+type RevisionActor interface {
+	CloudControllerAPIVersion() string
+	GetRevisionByApplicationNameAndVersion(appName string, spaceGUID string, version int) (v7action.Revision, v7action.Warnings, error)
+	GetRevisionDiffByApplicationNameAndVersions(appName string, spaceGUID string, version int, otherVersion int) (v7action.RevisionDiff, v7action.Warnings, error)
+}
+
+type RevisionCommand struct {
+	RequiredArgs    flag.AppName         `positional-args:"yes"`
+	Version         flag.PositiveInteger `long:"version" required:"true" description:"Version of the revision to display"`
+	DiffWith        flag.PositiveInteger `long:"diff-with" description:"Display what changes when the app moves from --version to this revision instead"`
+	usage           interface{}          `usage:"CF_NAME revision APP_NAME --version VERSION [--diff-with OTHER_VERSION]\n\n   With --diff-with, displays the droplet, environment variable names and process commands that differ between the two revisions. Environment variable values are never displayed."`
+	examples        interface{}          `examples:"CF_NAME revision my-app --version 3\nCF_NAME revision my-app --version 5 --diff-with 3"`
+	relatedCommands interface{}          `related_commands:"app, env, events"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RevisionActor
+}
+
+func (cmd *RevisionCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd RevisionCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+	err = gate.Require("", ccversion.MinVersionRevisionsV3)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.DiffWith.Value != 0 {
+		return cmd.displayDiff(user.Name)
+	}
+	return cmd.displayRevision(user.Name)
+}
+
+func (cmd RevisionCommand) displayRevision(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Getting revision {{.Version}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Version":   cmd.Version.Value,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+	cmd.UI.DisplayNewline()
+
+	revision, warnings, err := cmd.Actor.GetRevisionByApplicationNameAndVersion(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, int(cmd.Version.Value))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	envVarNames := cmd.UI.TranslateText("none")
+	if len(revision.EnvironmentVariableNames) > 0 {
+		envVarNames = strings.Join(revision.EnvironmentVariableNames, ", ")
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("version:"), strconv.Itoa(revision.Version)},
+		{cmd.UI.TranslateText("guid:"), revision.GUID},
+		{cmd.UI.TranslateText("description:"), revision.Description},
+		{cmd.UI.TranslateText("deployable:"), strconv.FormatBool(revision.Deployable)},
+		{cmd.UI.TranslateText("droplet:"), revision.DropletGUID},
+		{cmd.UI.TranslateText("created:"), revision.CreatedAt},
+		{cmd.UI.TranslateText("env variables:"), envVarNames},
+	}
+	for i, processType := range sortedProcessTypes(revision.ProcessCommands) {
+		key := ""
+		if i == 0 {
+			key = cmd.UI.TranslateText("processes:")
+		}
+		table = append(table, []string{key, processType + ": " + revision.ProcessCommands[processType]})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	return nil
+}
+
+func (cmd RevisionCommand) displayDiff(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Comparing revision {{.Version}} with revision {{.OtherVersion}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Version":      cmd.Version.Value,
+		"OtherVersion": cmd.DiffWith.Value,
+		"AppName":      cmd.RequiredArgs.AppName,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     username,
+	})
+	cmd.UI.DisplayNewline()
+
+	diff, warnings, err := cmd.Actor.GetRevisionDiffByApplicationNameAndVersions(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, int(cmd.Version.Value), int(cmd.DiffWith.Value))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !diff.HasChanges() {
+		cmd.UI.DisplayText("Revisions {{.Version}} and {{.OtherVersion}} run the same droplet, environment variables and process commands.", map[string]interface{}{
+			"Version":      diff.From.Version,
+			"OtherVersion": diff.To.Version,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayText("droplet:")
+	if diff.DropletChanged() {
+		cmd.UI.DisplayText("  - {{.DropletGUID}}", map[string]interface{}{"DropletGUID": diff.From.DropletGUID})
+		cmd.UI.DisplayText("  + {{.DropletGUID}}", map[string]interface{}{"DropletGUID": diff.To.DropletGUID})
+	} else {
+		cmd.UI.DisplayText("  unchanged ({{.DropletGUID}})", map[string]interface{}{"DropletGUID": diff.From.DropletGUID})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("env variables:")
+	if len(diff.AddedEnvironmentVariables)+len(diff.RemovedEnvironmentVariables)+len(diff.ChangedEnvironmentVariables) == 0 {
+		cmd.UI.DisplayText("  unchanged")
+	}
+	for _, name := range diff.AddedEnvironmentVariables {
+		cmd.UI.DisplayText("  + {{.Name}}", map[string]interface{}{"Name": name})
+	}
+	for _, name := range diff.RemovedEnvironmentVariables {
+		cmd.UI.DisplayText("  - {{.Name}}", map[string]interface{}{"Name": name})
+	}
+	for _, name := range diff.ChangedEnvironmentVariables {
+		cmd.UI.DisplayText("  ~ {{.Name}} (value changed)", map[string]interface{}{"Name": name})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("process commands:")
+	if len(diff.ProcessCommandChanges) == 0 {
+		cmd.UI.DisplayText("  unchanged")
+	}
+	for _, change := range diff.ProcessCommandChanges {
+		cmd.UI.DisplayText("  {{.ProcessType}}:", map[string]interface{}{"ProcessType": change.ProcessType})
+		if change.From != "" {
+			cmd.UI.DisplayText("    - {{.Command}}", map[string]interface{}{"Command": change.From})
+		}
+		if change.To != "" {
+			cmd.UI.DisplayText("    + {{.Command}}", map[string]interface{}{"Command": change.To})
+		}
+	}
+
+	return nil
+}
+
+func sortedProcessTypes(commands map[string]string) []string {
+	processTypes := make([]string, 0, len(commands))
+	for processType := range commands {
+		processTypes = append(processTypes, processType)
+	}
+	sort.Strings(processTypes)
+	return processTypes
+}
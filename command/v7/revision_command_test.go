@@ -0,0 +1,240 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("revision Command", func() {
+	var (
+		cmd             RevisionCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRevisionActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRevisionActor)
+
+		cmd = RevisionCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Version.Value = 1
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRevisionsV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the API does not support revisions", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.63.0")
+		})
+
+		It("returns a MinimumCFAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: "3.63.0",
+				MinimumVersion: ccversion.MinVersionRevisionsV3,
+			}))
+		})
+	})
+
+	When("--diff-with is not passed", func() {
+		When("getting the revision succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionByApplicationNameAndVersionReturns(
+					v7action.Revision{
+						GUID:                     "revision-guid",
+						Version:                  1,
+						Description:              "Initial revision.",
+						Deployable:               true,
+						DropletGUID:              "droplet-guid",
+						ProcessCommands:          map[string]string{"web": "web-command", "worker": "worker-command"},
+						CreatedAt:                "2019-01-01T00:00:00Z",
+						EnvironmentVariableNames: []string{"FOO", "SECRET"},
+					},
+					v7action.Warnings{"revision-warning"},
+					nil,
+				)
+			})
+
+			It("displays the revision without environment variable values", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Getting revision 1 of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`version:\s+1`))
+				Expect(testUI.Out).To(Say(`guid:\s+revision-guid`))
+				Expect(testUI.Out).To(Say(`description:\s+Initial revision\.`))
+				Expect(testUI.Out).To(Say(`deployable:\s+true`))
+				Expect(testUI.Out).To(Say(`droplet:\s+droplet-guid`))
+				Expect(testUI.Out).To(Say(`created:\s+2019-01-01T00:00:00Z`))
+				Expect(testUI.Out).To(Say(`env variables:\s+FOO, SECRET`))
+				Expect(testUI.Out).To(Say(`processes:\s+web: web-command`))
+				Expect(testUI.Out).To(Say(`worker: worker-command`))
+				Expect(testUI.Err).To(Say("revision-warning"))
+
+				appName, spaceGUID, version := fakeActor.GetRevisionByApplicationNameAndVersionArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(version).To(Equal(1))
+				Expect(fakeActor.GetRevisionDiffByApplicationNameAndVersionsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the revision fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionByApplicationNameAndVersionReturns(
+					v7action.Revision{},
+					v7action.Warnings{"revision-warning"},
+					actionerror.RevisionNotFoundError{AppName: "some-app", Version: 1},
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.RevisionNotFoundError{AppName: "some-app", Version: 1}))
+				Expect(testUI.Err).To(Say("revision-warning"))
+			})
+		})
+	})
+
+	When("--diff-with is passed", func() {
+		BeforeEach(func() {
+			cmd.DiffWith.Value = 3
+		})
+
+		When("the revisions differ", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionDiffByApplicationNameAndVersionsReturns(
+					v7action.RevisionDiff{
+						From:                        v7action.Revision{Version: 1, DropletGUID: "droplet-guid-1"},
+						To:                          v7action.Revision{Version: 3, DropletGUID: "droplet-guid-2"},
+						AddedEnvironmentVariables:   []string{"ADDED"},
+						RemovedEnvironmentVariables: []string{"REMOVED"},
+						ChangedEnvironmentVariables: []string{"CHANGED"},
+						ProcessCommandChanges: []v7action.ProcessCommandChange{
+							{ProcessType: "web", From: "old-command", To: "new-command"},
+						},
+					},
+					v7action.Warnings{"diff-warning"},
+					nil,
+				)
+			})
+
+			It("displays the droplet, environment variable and process command changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Comparing revision 1 with revision 3 of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("droplet:"))
+				Expect(testUI.Out).To(Say(`- droplet-guid-1`))
+				Expect(testUI.Out).To(Say(`\+ droplet-guid-2`))
+				Expect(testUI.Out).To(Say("env variables:"))
+				Expect(testUI.Out).To(Say(`\+ ADDED`))
+				Expect(testUI.Out).To(Say(`- REMOVED`))
+				Expect(testUI.Out).To(Say(`~ CHANGED \(value changed\)`))
+				Expect(testUI.Out).To(Say("process commands:"))
+				Expect(testUI.Out).To(Say("web:"))
+				Expect(testUI.Out).To(Say(`- old-command`))
+				Expect(testUI.Out).To(Say(`\+ new-command`))
+				Expect(testUI.Err).To(Say("diff-warning"))
+
+				appName, spaceGUID, version, otherVersion := fakeActor.GetRevisionDiffByApplicationNameAndVersionsArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(version).To(Equal(1))
+				Expect(otherVersion).To(Equal(3))
+			})
+		})
+
+		When("only the environment variables differ", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionDiffByApplicationNameAndVersionsReturns(
+					v7action.RevisionDiff{
+						From:                        v7action.Revision{Version: 1, DropletGUID: "droplet-guid"},
+						To:                          v7action.Revision{Version: 3, DropletGUID: "droplet-guid"},
+						ChangedEnvironmentVariables: []string{"CHANGED"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("reports the droplet and process commands as unchanged", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`unchanged \(droplet-guid\)`))
+				Expect(testUI.Out).To(Say(`~ CHANGED`))
+				Expect(testUI.Out).To(Say("process commands:"))
+				Expect(testUI.Out).To(Say("unchanged"))
+			})
+		})
+
+		When("the revisions do not differ", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionDiffByApplicationNameAndVersionsReturns(
+					v7action.RevisionDiff{
+						From: v7action.Revision{Version: 1, DropletGUID: "droplet-guid"},
+						To:   v7action.Revision{Version: 3, DropletGUID: "droplet-guid"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Revisions 1 and 3 run the same droplet, environment variables and process commands."))
+				Expect(testUI.Out).NotTo(Say("droplet:"))
+			})
+		})
+
+		When("getting the diff fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRevisionDiffByApplicationNameAndVersionsReturns(v7action.RevisionDiff{}, v7action.Warnings{"diff-warning"}, errors.New("diff-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("diff-error"))
+				Expect(testUI.Err).To(Say("diff-warning"))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -17,6 +18,9 @@ import (
 type ScaleActor interface {
 	AppActor
 
+	CheckQuotaReservation(orgGUID string, spaceGUID string, reservation v7action.QuotaReservation) (v7action.Warnings, error)
+	CloudControllerAPIVersion() string
+	GetProcessByTypeAndApplication(processType string, appGUID string) (v7action.Process, v7action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process v7action.Process) (v7action.Warnings, error)
 	StopApplication(appGUID string) (v7action.Warnings, error)
 	StartApplication(appGUID string) (v7action.Application, v7action.Warnings, error)
@@ -24,15 +28,17 @@ type ScaleActor interface {
 }
 
 type ScaleCommand struct {
-	RequiredArgs        flag.AppName   `positional-args:"yes"`
-	Force               bool           `short:"f" description:"Force restart of app without prompt"`
-	Instances           flag.Instances `short:"i" required:"false" description:"Number of instances"`
-	DiskLimit           flag.Megabytes `short:"k" required:"false" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit         flag.Megabytes `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	ProcessType         string         `long:"process" default:"web" description:"App process to scale"`
-	usage               interface{}    `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
-	relatedCommands     interface{}    `related_commands:"push"`
-	envCFStartupTimeout interface{}    `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName            `positional-args:"yes"`
+	Force               bool                    `short:"f" description:"Force restart of app without prompt"`
+	Instances           flag.Instances          `short:"i" required:"false" description:"Number of instances"`
+	DiskLimit           flag.Megabytes          `short:"k" required:"false" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	LogRateLimit        flag.BytesWithUnlimited `short:"l" long:"log-rate-limit" required:"false" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -1 represents an unlimited amount."`
+	MemoryLimit         flag.Megabytes          `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	ProcessType         string                  `long:"process" default:"web" description:"App process to scale"`
+	usage               interface{}             `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-f]"`
+	examples            interface{}             `examples:"CF_NAME scale my-app # Show the current instances, memory and disk\nCF_NAME scale my-app -i 3 # Run three web instances\nCF_NAME scale my-app --process worker -i 2 # Run two worker instances\nCF_NAME scale my-app -m 1G -k 2G -f # Change the memory and disk limits without confirmation\nCF_NAME scale my-app --log-rate-limit 16K -f # Limit each instance to 16K of logs per second without confirmation"`
+	relatedCommands     interface{}             `related_commands:"push"`
+	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
@@ -63,6 +69,14 @@ func (cmd *ScaleCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ScaleCommand) Execute(args []string) error {
+	if cmd.LogRateLimit.IsSet {
+		gate := command.NewAPIVersionGate(cmd.Actor.CloudControllerAPIVersion)
+		err := gate.Require("Option '--log-rate-limit'", ccversion.MinVersionLogRateLimitV3)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -79,11 +93,11 @@ func (cmd ScaleCommand) Execute(args []string) error {
 		return err
 	}
 
-	if !cmd.Instances.IsSet && !cmd.DiskLimit.IsSet && !cmd.MemoryLimit.IsSet {
+	if !cmd.Instances.IsSet && !cmd.DiskLimit.IsSet && !cmd.MemoryLimit.IsSet && !cmd.LogRateLimit.IsSet {
 		return cmd.showCurrentScale(user.Name, err)
 	}
 
-	scaled, err := cmd.scaleProcess(app.GUID, user.Name)
+	scaled, err := cmd.scaleProcess(app, user.Name)
 	if err != nil {
 		return err
 	}
@@ -118,7 +132,7 @@ func (cmd ScaleCommand) translateErrors(err error) error {
 	return err
 }
 
-func (cmd ScaleCommand) scaleProcess(appGUID string, username string) (bool, error) {
+func (cmd ScaleCommand) scaleProcess(app v7action.Application, username string) (bool, error) {
 	cmd.UI.DisplayTextWithFlavor("Scaling app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -127,7 +141,20 @@ func (cmd ScaleCommand) scaleProcess(appGUID string, username string) (bool, err
 	})
 	cmd.UI.DisplayNewline()
 
-	shouldRestart := cmd.DiskLimit.IsSet || cmd.MemoryLimit.IsSet
+	process := v7action.Process{
+		Type:              cmd.ProcessType,
+		Instances:         cmd.Instances.NullInt,
+		MemoryInMB:        cmd.MemoryLimit.NullUint64,
+		DiskInMB:          cmd.DiskLimit.NullUint64,
+		LogRateLimitInBPS: cmd.LogRateLimit.NullInt,
+	}
+
+	err := cmd.checkQuota(app, process)
+	if err != nil {
+		return false, err
+	}
+
+	shouldRestart := cmd.DiskLimit.IsSet || cmd.MemoryLimit.IsSet || cmd.LogRateLimit.IsSet
 	if shouldRestart && !cmd.Force {
 		shouldScale, err := cmd.UI.DisplayBoolPrompt(
 			false,
@@ -144,19 +171,14 @@ func (cmd ScaleCommand) scaleProcess(appGUID string, username string) (bool, err
 		cmd.UI.DisplayNewline()
 	}
 
-	warnings, err := cmd.Actor.ScaleProcessByApplication(appGUID, v7action.Process{
-		Type:       cmd.ProcessType,
-		Instances:  cmd.Instances.NullInt,
-		MemoryInMB: cmd.MemoryLimit.NullUint64,
-		DiskInMB:   cmd.DiskLimit.NullUint64,
-	})
+	warnings, err := cmd.Actor.ScaleProcessByApplication(app.GUID, process)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return false, err
 	}
 
 	if shouldRestart {
-		err := cmd.restartApplication(appGUID, username)
+		err := cmd.restartApplication(app.GUID, username)
 		if err != nil {
 			return false, err
 		}
@@ -165,6 +187,32 @@ func (cmd ScaleCommand) scaleProcess(appGUID string, username string) (bool, err
 	return true, nil
 }
 
+// checkQuota fails before the app is scaled or restarted when the scaled
+// process does not fit in the space or org quota. Errors checking the quota
+// are displayed as warnings and leave the decision to the Cloud Controller.
+func (cmd ScaleCommand) checkQuota(app v7action.Application, process v7action.Process) error {
+	current, warnings, err := cmd.Actor.GetProcessByTypeAndApplication(process.Type, app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	reservation := v7action.NewScaleQuotaReservation(app, current, process)
+	warnings, err = cmd.Actor.CheckQuotaReservation(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, reservation)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.QuotaReservationExceededError:
+		return err
+	default:
+		cmd.UI.DisplayWarning("Unable to check quota: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	return nil
+}
+
 func (cmd ScaleCommand) restartApplication(appGUID string, username string) error {
 	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
@@ -672,6 +673,55 @@ var _ = Describe("scale Command", func() {
 				})
 			})
 
+			When("only the log rate limit flag option is provided", func() {
+				BeforeEach(func() {
+					cmd.LogRateLimit.Value = 16384
+					cmd.LogRateLimit.IsSet = true
+					cmd.Force = true
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLogRateLimitV3)
+					fakeActor.ScaleProcessByApplicationReturns(
+						v7action.Warnings{"scale-warning"},
+						nil)
+					fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+						appSummary,
+						v7action.Warnings{"get-instances-warning"},
+						nil)
+				})
+
+				It("scales the log rate limit and restarts the application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Scaling"))
+					Expect(testUI.Out).To(Say("Stopping"))
+					Expect(testUI.Out).To(Say("Starting"))
+
+					Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+					_, scaleProcess := fakeActor.ScaleProcessByApplicationArgsForCall(0)
+					Expect(scaleProcess).To(Equal(v7action.Process{
+						Type:              constant.ProcessTypeWeb,
+						LogRateLimitInBPS: types.NullInt{Value: 16384, IsSet: true},
+					}))
+
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+				})
+
+				When("the API does not support log rate limits", func() {
+					BeforeEach(func() {
+						fakeActor.CloudControllerAPIVersionReturns(ccversion.MinSupportedV3ClientVersion)
+					})
+
+					It("returns a MinimumCFAPIVersionNotMetError without scaling", func() {
+						Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+							Command:        "Option '--log-rate-limit'",
+							CurrentVersion: ccversion.MinSupportedV3ClientVersion,
+							MinimumVersion: ccversion.MinVersionLogRateLimitV3,
+						}))
+						Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("process flag is provided", func() {
 				BeforeEach(func() {
 					cmd.ProcessType = "some-process-type"
@@ -712,6 +762,75 @@ var _ = Describe("scale Command", func() {
 				})
 			})
 
+			When("the scaled process is checked against the quotas", func() {
+				BeforeEach(func() {
+					cmd.Instances.Value = 3
+					cmd.Instances.IsSet = true
+					cmd.MemoryLimit.Value = 1024
+					cmd.MemoryLimit.IsSet = true
+					cmd.Force = true
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v7action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted},
+						nil,
+						nil)
+					fakeActor.GetProcessByTypeAndApplicationReturns(
+						v7action.Process{
+							Instances:  types.NullInt{Value: 1, IsSet: true},
+							MemoryInMB: types.NullUint64{Value: 512, IsSet: true},
+						},
+						v7action.Warnings{"get-process-warning"},
+						nil)
+				})
+
+				It("reserves the additional resources of the scaled process", func() {
+					Expect(fakeActor.GetProcessByTypeAndApplicationCallCount()).To(Equal(1))
+					processType, appGUID := fakeActor.GetProcessByTypeAndApplicationArgsForCall(0)
+					Expect(processType).To(Equal(constant.ProcessTypeWeb))
+					Expect(appGUID).To(Equal("some-app-guid"))
+
+					Expect(fakeActor.CheckQuotaReservationCallCount()).To(Equal(1))
+					orgGUID, spaceGUID, reservation := fakeActor.CheckQuotaReservationArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(reservation).To(Equal(v7action.QuotaReservation{
+						MemoryInMB:         2560,
+						Instances:          2,
+						InstanceMemoryInMB: 1024,
+					}))
+
+					Expect(testUI.Err).To(Say("get-process-warning"))
+				})
+
+				When("the scaled process does not fit in the quota", func() {
+					BeforeEach(func() {
+						fakeActor.CheckQuotaReservationReturns(
+							v7action.Warnings{"quota-warning"},
+							actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2.5G", Available: "1.5G"})
+					})
+
+					It("returns the error without scaling or restarting the app", func() {
+						Expect(executeErr).To(MatchError(actionerror.QuotaReservationExceededError{QuotaName: "some-quota", Resource: "Memory", Needed: "2.5G", Available: "1.5G"}))
+						Expect(testUI.Err).To(Say("quota-warning"))
+
+						Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+						Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				When("the quotas cannot be checked", func() {
+					BeforeEach(func() {
+						fakeActor.CheckQuotaReservationReturns(nil, errors.New("quota-error"))
+					})
+
+					It("displays a warning and scales the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("Unable to check quota: quota-error"))
+						Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+					})
+				})
+			})
+
 			When("an error is encountered scaling the application", func() {
 				var expectedErr error
 
@@ -0,0 +1,215 @@
+package v7
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+//go:generate counterfeiter . ServiceAccessActor
+
+type ServiceAccessActor interface {
+	GetServiceAccess(brokerName string, serviceName string, orgName string) ([]v7action.ServicePlanAccess, v7action.Warnings, error)
+}
+
+type ServiceAccessCommand struct {
+	Broker          string            `short:"b" description:"Access for plans of a particular broker"`
+	Service         string            `short:"e" description:"Access for service name of a particular service offering"`
+	Organization    string            `short:"o" description:"Plans accessible by a particular organization"`
+	Output          flag.OutputFormat `long:"output" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Template        string            `long:"template" description:"Format the output with a Go template applied to the JSON output"`
+	Query           string            `long:"query" description:"Narrow down the JSON output with a JMESPath expression"`
+	usage           interface{}       `usage:"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG] [--output json | --template TEMPLATE] [--query EXPRESSION]"`
+	relatedCommands interface{}       `related_commands:"marketplace, disable-service-access, enable-service-access, service-brokers"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceAccessActor
+}
+
+func (cmd *ServiceAccessCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+	return nil
+}
+
+func (cmd ServiceAccessCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output != "json" && cmd.Template == "" && cmd.Query == "" {
+		template := serviceAccessMessages[serviceAccessOptions{Broker: cmd.Broker != "", Service: cmd.Service != "", Org: cmd.Organization != ""}]
+		cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+			"Broker":      cmd.Broker,
+			"Service":     cmd.Service,
+			"Org":         cmd.Organization,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	plans, warnings, err := cmd.Actor.GetServiceAccess(cmd.Broker, cmd.Service, cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sortServicePlanAccess(plans)
+
+	if cmd.Output == "json" || cmd.Template != "" || cmd.Query != "" {
+		if plans == nil {
+			plans = []v7action.ServicePlanAccess{}
+		}
+		return command.DisplayDocument(cmd.UI, plans, cmd.Query, cmd.Template)
+	}
+
+	tableHeaders := []string{"service", "plan", "access", "orgs"}
+	for i := 0; i < len(plans); {
+		brokerName := plans[i].BrokerName
+		cmd.UI.DisplayText("broker: {{.BrokerName}}", map[string]interface{}{
+			"BrokerName": brokerName,
+		})
+
+		data := [][]string{tableHeaders}
+		for ; i < len(plans) && plans[i].BrokerName == brokerName; i++ {
+			data = append(data, []string{
+				plans[i].ServiceName,
+				plans[i].PlanName,
+				cmd.UI.TranslateText(formatServicePlanAccess(plans[i].VisibilityType, plans[i].Organizations)),
+				strings.Join(plans[i].Organizations, ","),
+			})
+		}
+
+		cmd.UI.DisplayTableWithHeader("   ", data, 3)
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+// displayServiceAccessChanges displays the plans whose access changes, from
+// and to which access, and the orgs that gain or lose it.
+func displayServiceAccessChanges(ui command.UI, changes []v7action.ServiceAccessChange) {
+	if len(changes) == 0 {
+		ui.DisplayText("No service access changes.")
+		return
+	}
+
+	data := [][]string{{
+		ui.TranslateText("broker"),
+		ui.TranslateText("service"),
+		ui.TranslateText("plan"),
+		ui.TranslateText("access"),
+		ui.TranslateText("orgs gaining access"),
+		ui.TranslateText("orgs losing access"),
+	}}
+	for _, change := range changes {
+		newOrgs := append(append([]string{}, change.Organizations...), change.GainedOrganizations...)
+		newOrgs = removeStrings(newOrgs, change.LostOrganizations)
+
+		gained := strings.Join(change.GainedOrganizations, ",")
+		if change.NewVisibilityType == constant.ServicePlanVisibilityPublic {
+			gained = ui.TranslateText("all orgs")
+		}
+		lost := strings.Join(change.LostOrganizations, ",")
+		if change.VisibilityType == constant.ServicePlanVisibilityPublic {
+			lost = ui.TranslateText("all orgs")
+		}
+
+		data = append(data, []string{
+			change.BrokerName,
+			change.ServiceName,
+			change.PlanName,
+			ui.TranslateText("{{.From}} -> {{.To}}", map[string]interface{}{
+				"From": ui.TranslateText(formatServicePlanAccess(change.VisibilityType, change.Organizations)),
+				"To":   ui.TranslateText(formatServicePlanAccess(change.NewVisibilityType, newOrgs)),
+			}),
+			gained,
+			lost,
+		})
+	}
+	ui.DisplayTableWithHeader("", data, 3)
+}
+
+func formatServicePlanAccess(visibilityType constant.ServicePlanVisibilityType, orgs []string) string {
+	switch {
+	case visibilityType == constant.ServicePlanVisibilityPublic:
+		return "all"
+	case visibilityType == constant.ServicePlanVisibilityOrganization && len(orgs) > 0:
+		return "limited"
+	default:
+		return "none"
+	}
+}
+
+func removeStrings(values []string, remove []string) []string {
+	var kept []string
+	for _, value := range values {
+		removed := false
+		for _, r := range remove {
+			if value == r {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+func sortServicePlanAccess(plans []v7action.ServicePlanAccess) {
+	for _, plan := range plans {
+		sort.Strings(plan.Organizations)
+	}
+
+	sort.SliceStable(plans, func(i, j int) bool {
+		if plans[i].BrokerName != plans[j].BrokerName {
+			return sorting.LessIgnoreCase(plans[i].BrokerName, plans[j].BrokerName)
+		}
+		if plans[i].ServiceName != plans[j].ServiceName {
+			return sorting.LessIgnoreCase(plans[i].ServiceName, plans[j].ServiceName)
+		}
+		return sorting.LessIgnoreCase(plans[i].PlanName, plans[j].PlanName)
+	})
+}
+
+type serviceAccessOptions struct {
+	Broker  bool
+	Service bool
+	Org     bool
+}
+
+var serviceAccessMessages = map[serviceAccessOptions]string{
+	{Broker: false, Service: false, Org: false}: "Getting service access as {{.CurrentUser}}...",
+	{Broker: true, Service: false, Org: false}:  "Getting service access for broker {{.Broker}} as {{.CurrentUser}}...",
+	{Broker: true, Service: true, Org: false}:   "Getting service access for broker {{.Broker}} and service {{.Service}} as {{.CurrentUser}}...",
+	{Broker: true, Service: true, Org: true}:    "Getting service access for broker {{.Broker}} and service {{.Service}} and organization {{.Org}} as {{.CurrentUser}}...",
+	{Broker: true, Service: false, Org: true}:   "Getting service access for broker {{.Broker}} and organization {{.Org}} as {{.CurrentUser}}...",
+	{Broker: false, Service: true, Org: false}:  "Getting service access for service {{.Service}} as {{.CurrentUser}}...",
+	{Broker: false, Service: true, Org: true}:   "Getting service access for service {{.Service}} and organization {{.Org}} as {{.CurrentUser}}...",
+	{Broker: false, Service: false, Org: true}:  "Getting service access for organization {{.Org}} as {{.CurrentUser}}...",
+}
@@ -0,0 +1,143 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-access Command", func() {
+	var (
+		cmd             ServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeServiceAccessActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeServiceAccessActor)
+
+		cmd = ServiceAccessCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the service access succeeds", func() {
+		BeforeEach(func() {
+			cmd.Broker = "some-broker"
+			cmd.Service = "some-service"
+			cmd.Organization = "some-org"
+
+			fakeActor.GetServiceAccessReturns(
+				[]v7action.ServicePlanAccess{
+					{BrokerName: "broker-b", ServiceName: "service-1", PlanName: "plan-2", VisibilityType: constant.ServicePlanVisibilityAdmin},
+					{BrokerName: "broker-a", ServiceName: "service-2", PlanName: "plan-1", VisibilityType: constant.ServicePlanVisibilityOrganization, Organizations: []string{"org-2", "org-1"}},
+					{BrokerName: "broker-b", ServiceName: "service-1", PlanName: "plan-1", VisibilityType: constant.ServicePlanVisibilityPublic},
+				},
+				v7action.Warnings{"access-warning"},
+				nil,
+			)
+		})
+
+		It("displays the access of each plan grouped by broker", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetServiceAccessCallCount()).To(Equal(1))
+			brokerName, serviceName, orgName := fakeActor.GetServiceAccessArgsForCall(0)
+			Expect(brokerName).To(Equal("some-broker"))
+			Expect(serviceName).To(Equal("some-service"))
+			Expect(orgName).To(Equal("some-org"))
+
+			Expect(testUI.Out).To(Say(`Getting service access for broker some-broker and service some-service and organization some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`broker: broker-a`))
+			Expect(testUI.Out).To(Say(`service\s+plan\s+access\s+orgs`))
+			Expect(testUI.Out).To(Say(`service-2\s+plan-1\s+limited\s+org-1,org-2`))
+			Expect(testUI.Out).To(Say(`broker: broker-b`))
+			Expect(testUI.Out).To(Say(`service-1\s+plan-1\s+all`))
+			Expect(testUI.Out).To(Say(`service-1\s+plan-2\s+none`))
+			Expect(testUI.Err).To(Say("access-warning"))
+		})
+
+		When("the output is json", func() {
+			BeforeEach(func() {
+				cmd.Output = "json"
+			})
+
+			It("displays the access of each plan as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting service access"))
+				Expect(testUI.Out).To(Say(`"broker": "broker-a"`))
+				Expect(testUI.Out).To(Say(`"visibility_type": "organization"`))
+				Expect(testUI.Out).To(Say(`"org-1",\s+"org-2"`))
+				Expect(testUI.Out).To(Say(`"broker": "broker-b"`))
+				Expect(testUI.Out).To(Say(`"plan": "plan-1"`))
+				Expect(testUI.Out).To(Say(`"plan": "plan-2"`))
+			})
+
+			When("there are no plans", func() {
+				BeforeEach(func() {
+					fakeActor.GetServiceAccessReturns(nil, nil, nil)
+				})
+
+				It("displays an empty list", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`\[\]`))
+				})
+			})
+		})
+	})
+
+	When("getting the service access fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAccessReturns(nil, v7action.Warnings{"access-warning"}, errors.New("access-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("access-error"))
+			Expect(testUI.Err).To(Say("access-warning"))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/types"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -93,10 +94,16 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Applicat
 			startCommandRow = append(startCommandRow, display.UI.TranslateText("start command:"), process.Command.Value)
 		}
 
+		var logRateLimitRow []string
+		if process.LogRateLimitInBPS.IsSet {
+			logRateLimitRow = append(logRateLimitRow, display.UI.TranslateText("log rate limit:"), display.logRateLimit(process.LogRateLimitInBPS))
+		}
+
 		keyValueTable := [][]string{
 			{display.UI.TranslateText("type:"), process.Type},
 			{display.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
 			{display.UI.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB.Value)},
+			logRateLimitRow,
 			startCommandRow,
 		}
 
@@ -111,6 +118,13 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Applicat
 	}
 }
 
+func (display AppSummaryDisplayer) logRateLimit(limit types.NullInt) string {
+	if limit.Value < 0 {
+		return display.UI.TranslateText("unlimited")
+	}
+	return bytefmt.ByteSize(uint64(limit.Value)) + "/s"
+}
+
 func (display AppSummaryDisplayer) getCreatedTime(summary v7action.ApplicationSummary) string {
 	if summary.CurrentDroplet.CreatedAt != "" {
 		timestamp, err := time.Parse(time.RFC3339, summary.CurrentDroplet.CreatedAt)
@@ -285,16 +285,18 @@ var _ = Describe("app summary displayer", func() {
 					ProcessSummaries: v7action.ProcessSummaries{
 						{
 							Process: v7action.Process{
-								Type:       constant.ProcessTypeWeb,
-								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
-								DiskInMB:   types.NullUint64{Value: 1024, IsSet: true},
+								Type:              constant.ProcessTypeWeb,
+								MemoryInMB:        types.NullUint64{Value: 32, IsSet: true},
+								DiskInMB:          types.NullUint64{Value: 1024, IsSet: true},
+								LogRateLimitInBPS: types.NullInt{Value: 16384, IsSet: true},
 							},
 						},
 						{
 							Process: v7action.Process{
-								Type:       "console",
-								MemoryInMB: types.NullUint64{Value: 16, IsSet: true},
-								DiskInMB:   types.NullUint64{Value: 512, IsSet: true},
+								Type:              "console",
+								MemoryInMB:        types.NullUint64{Value: 16, IsSet: true},
+								DiskInMB:          types.NullUint64{Value: 512, IsSet: true},
+								LogRateLimitInBPS: types.NullInt{Value: -1, IsSet: true},
 							},
 						},
 					},
@@ -305,11 +307,13 @@ var _ = Describe("app summary displayer", func() {
 				Expect(testUI.Out).To(Say(`type:\s+web`))
 				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
 				Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
+				Expect(testUI.Out).To(Say(`log rate limit:\s+16K/s`))
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
 
 				Expect(testUI.Out).To(Say(`type:\s+console`))
 				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
 				Expect(testUI.Out).To(Say(`memory usage:\s+16M`))
+				Expect(testUI.Out).To(Say(`log rate limit:\s+unlimited`))
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
 			})
 
@@ -2,6 +2,7 @@ package shared_test
 
 import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -40,4 +41,15 @@ var _ = Describe("New Clients", func() {
 			Expect(err).To(MatchError("This command requires Network Policy API V1. Your targeted endpoint does not expose it."))
 		})
 	})
+
+	When("the command is a dry run", func() {
+		BeforeEach(func() {
+			fakeConfig.DryRunReturns(true)
+		})
+
+		It("returns a DryRunNotSupportedError", func() {
+			_, err := NewNetworkingClient("some-url", fakeConfig, fakeUAAClient, testUI)
+			Expect(err).To(MatchError(translatableerror.DryRunNotSupportedError{API: "Network Policy"}))
+		})
+	})
 })
@@ -20,21 +20,25 @@ type SharedSSHActor interface {
 
 type SSHActor interface {
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 }
 
 type SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs          flag.AppName                `positional-args:"yes"`
+	ProcessIndex          uint                        `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands              []string                    `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY      bool                        `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY        bool                        `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	IdentityFile          flag.PathWithExistenceCheck `long:"identity-file" description:"Authenticate with the private key in this file, registered with the platform, instead of a one-time passcode. A certificate in the file with a '-cert.pub' suffix is used if present"`
+	LocalPortForwardSpecs []flag.SSHPortForwarding    `short:"L" description:"Local port forward specification"`
+	ProcessType           string                      `long:"process" default:"web" description:"App process name"`
+	Record                flag.Path                   `long:"record" description:"Record the terminal session to this file in asciicast format, with timing, for replay with asciinema"`
+	RequestPseudoTTY      bool                        `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation    bool                        `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution   bool                        `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n   [--identity-file PATH] [--record FILE]\n\n   A key registered with the platform also lets standard ssh tooling, for example with ProxyJump, reach the instance as user cf:PROCESS_GUID/INDEX on the app SSH endpoint."`
+	examples        interface{} `examples:"CF_NAME ssh my-app # Open a shell in the first web instance\nCF_NAME ssh my-app -i 1 # Open a shell in the instance at index 1\nCF_NAME ssh my-app -c \"ls -la\" # Run a command and exit\nCF_NAME ssh my-app -N -L 9999:localhost:8080 # Forward local port 9999 to port 8080 in the instance\nCF_NAME ssh my-app --identity-file ~/.ssh/id_ed25519 # Authenticate with a registered key\nCF_NAME ssh my-app --record session.cast # Record the session for auditing"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -67,6 +71,12 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 
 func (cmd SSHCommand) Execute(args []string) error {
 
+	if cmd.Record != "" && cmd.SkipRemoteExecution {
+		return translatableerror.ArgumentCombinationError{Args: []string{
+			"--record", "--skip-remote-execution", "-N",
+		}}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -82,7 +92,12 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
-	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+	getConfiguration := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex
+	if cmd.IdentityFile != "" {
+		getConfiguration = cmd.Actor.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex
+	}
+
+	sshAuth, warnings, err := getConfiguration(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.ProcessType,
@@ -99,8 +114,10 @@ func (cmd SSHCommand) Execute(args []string) error {
 			Commands:              cmd.Commands,
 			Endpoint:              sshAuth.Endpoint,
 			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
+			IdentityFile:          string(cmd.IdentityFile),
 			LocalPortForwardSpecs: forwardSpecs,
 			Passcode:              sshAuth.Passcode,
+			RecordFile:            string(cmd.Record),
 			SkipHostValidation:    cmd.SkipHostValidation,
 			SkipRemoteExecution:   cmd.SkipRemoteExecution,
 			TTYOption:             ttyOption,
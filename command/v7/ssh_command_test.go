@@ -61,6 +61,20 @@ var _ = Describe("ssh Command", func() {
 			executeErr = cmd.Execute(nil)
 		})
 
+		When("--record and --skip-remote-execution are both provided", func() {
+			BeforeEach(func() {
+				cmd.Record = "some-session.cast"
+				cmd.SkipRemoteExecution = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{
+					"--record", "--skip-remote-execution", "-N",
+				}}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		When("checking target fails", func() {
 			BeforeEach(func() {
 				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
@@ -157,6 +171,56 @@ var _ = Describe("ssh Command", func() {
 					})
 				})
 
+				When("an identity file is provided", func() {
+					BeforeEach(func() {
+						cmd.DisablePseudoTTY = true
+						cmd.IdentityFile = "some-identity-file"
+						fakeActor.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+							v7action.SSHAuthentication{
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								Username:           "some-username",
+							},
+							v7action.Warnings{"some-key-warnings"},
+							nil,
+						)
+					})
+
+					It("authenticates with the key instead of fetching a passcode", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("some-key-warnings"))
+
+						Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+						Expect(fakeActor.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg).To(Equal(sharedaction.SSHOptions{
+							Commands:            []string{"some", "commands"},
+							Endpoint:            "some-endpoint",
+							HostKeyFingerprint:  "some-fingerprint",
+							IdentityFile:        "some-identity-file",
+							SkipHostValidation:  true,
+							SkipRemoteExecution: true,
+							TTYOption:           sharedaction.RequestTTYNo,
+							Username:            "some-username",
+						}))
+					})
+				})
+
+				When("a record file is provided", func() {
+					BeforeEach(func() {
+						cmd.Record = "some-session.cast"
+						cmd.SkipRemoteExecution = false
+					})
+
+					It("passes the record file along", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg.RecordFile).To(Equal("some-session.cast"))
+					})
+				})
+
 				When("executing the secure shell fails", func() {
 					BeforeEach(func() {
 						cmd.DisablePseudoTTY = true
@@ -23,6 +23,7 @@ type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
 	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+	examples        interface{} `examples:"CF_NAME target # Show the targeted API, org and space\nCF_NAME target -o my-org -s my-space # Target an org and space\nCF_NAME target -s other-space # Switch spaces within the targeted org"`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
 	UI          command.UI
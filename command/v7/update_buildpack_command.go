@@ -58,7 +58,9 @@ func (cmd *UpdateBuildpackCommand) Setup(config command.Config, ui command.UI) e
 		return err
 	}
 	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
-	cmd.ProgressBar = v7action.NewProgressBar()
+	progressBar := v7action.NewProgressBar()
+	progressBar.Hidden = config.Accessible()
+	cmd.ProgressBar = progressBar
 
 	return nil
 }
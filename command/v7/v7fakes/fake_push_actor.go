@@ -27,6 +27,19 @@ type FakePushActor struct {
 		result3 <-chan v7pushaction.Warnings
 		result4 <-chan error
 	}
+	CheckPushPlanQuotasStub        func([]v7pushaction.PushPlan) (v7pushaction.Warnings, error)
+	checkPushPlanQuotasMutex       sync.RWMutex
+	checkPushPlanQuotasArgsForCall []struct {
+		arg1 []v7pushaction.PushPlan
+	}
+	checkPushPlanQuotasReturns struct {
+		result1 v7pushaction.Warnings
+		result2 error
+	}
+	checkPushPlanQuotasReturnsOnCall map[int]struct {
+		result1 v7pushaction.Warnings
+		result2 error
+	}
 	CreatePushPlansStub        func(string, string, string, v7pushaction.ManifestParser, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -44,6 +57,11 @@ type FakePushActor struct {
 		result1 []v7pushaction.PushPlan
 		result2 error
 	}
+	DeletePushCheckpointStub        func(v7pushaction.PushPlan)
+	deletePushCheckpointMutex       sync.RWMutex
+	deletePushCheckpointArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+	}
 	PrepareSpaceStub        func([]v7pushaction.PushPlan, v7pushaction.ManifestParser) (<-chan []v7pushaction.PushPlan, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error)
 	prepareSpaceMutex       sync.RWMutex
 	prepareSpaceArgsForCall []struct {
@@ -151,6 +169,74 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan v7pushac
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakePushActor) CheckPushPlanQuotas(arg1 []v7pushaction.PushPlan) (v7pushaction.Warnings, error) {
+	var arg1Copy []v7pushaction.PushPlan
+	if arg1 != nil {
+		arg1Copy = make([]v7pushaction.PushPlan, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.checkPushPlanQuotasMutex.Lock()
+	ret, specificReturn := fake.checkPushPlanQuotasReturnsOnCall[len(fake.checkPushPlanQuotasArgsForCall)]
+	fake.checkPushPlanQuotasArgsForCall = append(fake.checkPushPlanQuotasArgsForCall, struct {
+		arg1 []v7pushaction.PushPlan
+	}{arg1Copy})
+	fake.recordInvocation("CheckPushPlanQuotas", []interface{}{arg1Copy})
+	fake.checkPushPlanQuotasMutex.Unlock()
+	if fake.CheckPushPlanQuotasStub != nil {
+		return fake.CheckPushPlanQuotasStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkPushPlanQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePushActor) CheckPushPlanQuotasCallCount() int {
+	fake.checkPushPlanQuotasMutex.RLock()
+	defer fake.checkPushPlanQuotasMutex.RUnlock()
+	return len(fake.checkPushPlanQuotasArgsForCall)
+}
+
+func (fake *FakePushActor) CheckPushPlanQuotasCalls(stub func([]v7pushaction.PushPlan) (v7pushaction.Warnings, error)) {
+	fake.checkPushPlanQuotasMutex.Lock()
+	defer fake.checkPushPlanQuotasMutex.Unlock()
+	fake.CheckPushPlanQuotasStub = stub
+}
+
+func (fake *FakePushActor) CheckPushPlanQuotasArgsForCall(i int) []v7pushaction.PushPlan {
+	fake.checkPushPlanQuotasMutex.RLock()
+	defer fake.checkPushPlanQuotasMutex.RUnlock()
+	argsForCall := fake.checkPushPlanQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) CheckPushPlanQuotasReturns(result1 v7pushaction.Warnings, result2 error) {
+	fake.checkPushPlanQuotasMutex.Lock()
+	defer fake.checkPushPlanQuotasMutex.Unlock()
+	fake.CheckPushPlanQuotasStub = nil
+	fake.checkPushPlanQuotasReturns = struct {
+		result1 v7pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) CheckPushPlanQuotasReturnsOnCall(i int, result1 v7pushaction.Warnings, result2 error) {
+	fake.checkPushPlanQuotasMutex.Lock()
+	defer fake.checkPushPlanQuotasMutex.Unlock()
+	fake.CheckPushPlanQuotasStub = nil
+	if fake.checkPushPlanQuotasReturnsOnCall == nil {
+		fake.checkPushPlanQuotasReturnsOnCall = make(map[int]struct {
+			result1 v7pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.checkPushPlanQuotasReturnsOnCall[i] = struct {
+		result1 v7pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 string, arg4 v7pushaction.ManifestParser, arg5 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -218,6 +304,37 @@ func (fake *FakePushActor) CreatePushPlansReturnsOnCall(i int, result1 []v7pusha
 	}{result1, result2}
 }
 
+func (fake *FakePushActor) DeletePushCheckpoint(arg1 v7pushaction.PushPlan) {
+	fake.deletePushCheckpointMutex.Lock()
+	fake.deletePushCheckpointArgsForCall = append(fake.deletePushCheckpointArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+	}{arg1})
+	fake.recordInvocation("DeletePushCheckpoint", []interface{}{arg1})
+	fake.deletePushCheckpointMutex.Unlock()
+	if fake.DeletePushCheckpointStub != nil {
+		fake.DeletePushCheckpointStub(arg1)
+	}
+}
+
+func (fake *FakePushActor) DeletePushCheckpointCallCount() int {
+	fake.deletePushCheckpointMutex.RLock()
+	defer fake.deletePushCheckpointMutex.RUnlock()
+	return len(fake.deletePushCheckpointArgsForCall)
+}
+
+func (fake *FakePushActor) DeletePushCheckpointCalls(stub func(v7pushaction.PushPlan)) {
+	fake.deletePushCheckpointMutex.Lock()
+	defer fake.deletePushCheckpointMutex.Unlock()
+	fake.DeletePushCheckpointStub = stub
+}
+
+func (fake *FakePushActor) DeletePushCheckpointArgsForCall(i int) v7pushaction.PushPlan {
+	fake.deletePushCheckpointMutex.RLock()
+	defer fake.deletePushCheckpointMutex.RUnlock()
+	argsForCall := fake.deletePushCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakePushActor) PrepareSpace(arg1 []v7pushaction.PushPlan, arg2 v7pushaction.ManifestParser) (<-chan []v7pushaction.PushPlan, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error) {
 	var arg1Copy []v7pushaction.PushPlan
 	if arg1 != nil {
@@ -369,8 +486,12 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.checkPushPlanQuotasMutex.RLock()
+	defer fake.checkPushPlanQuotasMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
+	fake.deletePushCheckpointMutex.RLock()
+	defer fake.deletePushCheckpointMutex.RUnlock()
 	fake.prepareSpaceMutex.RLock()
 	defer fake.prepareSpaceMutex.RUnlock()
 	fake.updateApplicationSettingsMutex.RLock()
@@ -9,6 +9,31 @@ import (
 )
 
 type FakeScaleActor struct {
+	CheckQuotaReservationStub        func(string, string, v7action.QuotaReservation) (v7action.Warnings, error)
+	checkQuotaReservationMutex       sync.RWMutex
+	checkQuotaReservationArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaReservation
+	}
+	checkQuotaReservationReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	checkQuotaReservationReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct {
+	}
+	cloudControllerAPIVersionReturns struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -43,6 +68,22 @@ type FakeScaleActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetProcessByTypeAndApplicationStub        func(string, string) (v7action.Process, v7action.Warnings, error)
+	getProcessByTypeAndApplicationMutex       sync.RWMutex
+	getProcessByTypeAndApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getProcessByTypeAndApplicationReturns struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
+	getProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
 	PollStartStub        func(string) (v7action.Warnings, error)
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -102,6 +143,123 @@ type FakeScaleActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeScaleActor) CheckQuotaReservation(arg1 string, arg2 string, arg3 v7action.QuotaReservation) (v7action.Warnings, error) {
+	fake.checkQuotaReservationMutex.Lock()
+	ret, specificReturn := fake.checkQuotaReservationReturnsOnCall[len(fake.checkQuotaReservationArgsForCall)]
+	fake.checkQuotaReservationArgsForCall = append(fake.checkQuotaReservationArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaReservation
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckQuotaReservation", []interface{}{arg1, arg2, arg3})
+	fake.checkQuotaReservationMutex.Unlock()
+	if fake.CheckQuotaReservationStub != nil {
+		return fake.CheckQuotaReservationStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkQuotaReservationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScaleActor) CheckQuotaReservationCallCount() int {
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	return len(fake.checkQuotaReservationArgsForCall)
+}
+
+func (fake *FakeScaleActor) CheckQuotaReservationCalls(stub func(string, string, v7action.QuotaReservation) (v7action.Warnings, error)) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = stub
+}
+
+func (fake *FakeScaleActor) CheckQuotaReservationArgsForCall(i int) (string, string, v7action.QuotaReservation) {
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	argsForCall := fake.checkQuotaReservationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScaleActor) CheckQuotaReservationReturns(result1 v7action.Warnings, result2 error) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = nil
+	fake.checkQuotaReservationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScaleActor) CheckQuotaReservationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.checkQuotaReservationMutex.Lock()
+	defer fake.checkQuotaReservationMutex.Unlock()
+	fake.CheckQuotaReservationStub = nil
+	if fake.checkQuotaReservationReturnsOnCall == nil {
+		fake.checkQuotaReservationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.checkQuotaReservationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScaleActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cloudControllerAPIVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeScaleActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeScaleActor) CloudControllerAPIVersionCalls(stub func() string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = stub
+}
+
+func (fake *FakeScaleActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeScaleActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeScaleActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -238,6 +396,73 @@ func (fake *FakeScaleActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeScaleActor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (v7action.Process, v7action.Warnings, error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
+	fake.getProcessByTypeAndApplicationArgsForCall = append(fake.getProcessByTypeAndApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetProcessByTypeAndApplication", []interface{}{arg1, arg2})
+	fake.getProcessByTypeAndApplicationMutex.Unlock()
+	if fake.GetProcessByTypeAndApplicationStub != nil {
+		return fake.GetProcessByTypeAndApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProcessByTypeAndApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeScaleActor) GetProcessByTypeAndApplicationCallCount() int {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.getProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeScaleActor) GetProcessByTypeAndApplicationCalls(stub func(string, string) (v7action.Process, v7action.Warnings, error)) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = stub
+}
+
+func (fake *FakeScaleActor) GetProcessByTypeAndApplicationArgsForCall(i int) (string, string) {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	argsForCall := fake.getProcessByTypeAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScaleActor) GetProcessByTypeAndApplicationReturns(result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	fake.getProcessByTypeAndApplicationReturns = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeScaleActor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	if fake.getProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.getProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Process
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeScaleActor) PollStart(arg1 string) (v7action.Warnings, error) {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -497,10 +722,16 @@ func (fake *FakeScaleActor) StopApplicationReturnsOnCall(i int, result1 v7action
 func (fake *FakeScaleActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkQuotaReservationMutex.RLock()
+	defer fake.checkQuotaReservationMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.scaleProcessByApplicationMutex.RLock()
@@ -27,6 +27,24 @@ type FakeSSHActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
+	getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 uint
+	}
+	getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall map[int]struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -100,11 +118,82 @@ func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProce
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	ret, specificReturn := fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall = append(fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 uint
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	if fake.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub != nil {
+		return fake.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount() int {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	return len(fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)
+}
+
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexCalls(stub func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	fake.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub = stub
+}
+
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(i int) (string, string, string, uint) {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	argsForCall := fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(result1 v7action.SSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	fake.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns = struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSSHActor) GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall(i int, result1 v7action.SSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Unlock()
+	fake.GetSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexStub = nil
+	if fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall == nil {
+		fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall = make(map[int]struct {
+			result1 v7action.SSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[i] = struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
+	defer fake.getSecureShellKeyConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
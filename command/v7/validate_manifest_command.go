@@ -0,0 +1,166 @@
+package v7
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	GetMissingManifestReferences(spaceGUID string, references []manifestparser.Reference) ([]manifestparser.Reference, v7action.Warnings, error)
+}
+
+type ValidateManifestCommand struct {
+	RequiredArgs    flag.ManifestPathArg `positional-args:"yes"`
+	AgainstAPI      bool                 `long:"against-api" description:"Check that the buildpacks, stacks, service instances and route domains referenced in the manifest exist in the targeted space"`
+	usage           interface{}          `usage:"CF_NAME validate-manifest MANIFEST_PATH [--against-api]"`
+	relatedCommands interface{}          `related_commands:"create-app-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ValidateManifestActor
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	if !cmd.AgainstAPI {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	if cmd.AgainstAPI {
+		err := cmd.SharedActor.CheckTarget(true, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	manifestPath := string(cmd.RequiredArgs.Path)
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.ManifestPath}}...", map[string]interface{}{
+		"ManifestPath": manifestPath,
+	})
+	cmd.UI.DisplayNewline()
+
+	rawManifest, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	diagnostics, err := manifestparser.Lint(rawManifest)
+	if err != nil {
+		yamlErr, ok := err.(manifestparser.InvalidYAMLError)
+		if !ok {
+			return err
+		}
+		diagnostics = []manifestparser.Diagnostic{{
+			Severity: manifestparser.DiagnosticError,
+			Message:  yamlErr.Err.Error(),
+		}}
+	}
+
+	if cmd.AgainstAPI && err == nil {
+		missingDiagnostics, warnings, err := cmd.missingReferenceDiagnostics(rawManifest)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		diagnostics = append(diagnostics, missingDiagnostics...)
+	}
+
+	var errorCount, warningCount int
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == manifestparser.DiagnosticError {
+			errorCount++
+		} else {
+			warningCount++
+		}
+		cmd.displayDiagnostic(manifestPath, diagnostic)
+	}
+
+	if len(diagnostics) > 0 {
+		cmd.UI.DisplayNewline()
+	}
+	cmd.UI.DisplayText("{{.ErrorCount}} error(s), {{.WarningCount}} warning(s)", map[string]interface{}{
+		"ErrorCount":   errorCount,
+		"WarningCount": warningCount,
+	})
+
+	if errorCount > 0 {
+		return translatableerror.ManifestValidationError{ErrorCount: errorCount}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ValidateManifestCommand) missingReferenceDiagnostics(rawManifest []byte) ([]manifestparser.Diagnostic, v7action.Warnings, error) {
+	references, err := manifestparser.References(rawManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missing, warnings, err := cmd.Actor.GetMissingManifestReferences(cmd.Config.TargetedSpace().GUID, references)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var diagnostics []manifestparser.Diagnostic
+	for _, reference := range missing {
+		var message string
+		switch reference.Type {
+		case manifestparser.BuildpackReference:
+			message = fmt.Sprintf("buildpack '%s' does not exist", reference.Name)
+		case manifestparser.StackReference:
+			message = fmt.Sprintf("stack '%s' does not exist", reference.Name)
+		case manifestparser.ServiceInstanceReference:
+			message = fmt.Sprintf("service instance '%s' does not exist in space '%s'", reference.Name, cmd.Config.TargetedSpace().Name)
+		case manifestparser.RouteReference:
+			message = fmt.Sprintf("route '%s' does not match any available domain", reference.Name)
+		}
+
+		diagnostics = append(diagnostics, manifestparser.Diagnostic{
+			Position: reference.Position,
+			Path:     reference.Path,
+			Severity: manifestparser.DiagnosticError,
+			Message:  message,
+		})
+	}
+
+	return diagnostics, warnings, nil
+}
+
+func (cmd ValidateManifestCommand) displayDiagnostic(manifestPath string, diagnostic manifestparser.Diagnostic) {
+	location := manifestPath
+	if diagnostic.Line > 0 {
+		location = fmt.Sprintf("%s:%d:%d", manifestPath, diagnostic.Line, diagnostic.Column)
+	}
+
+	cmd.UI.DisplayText("{{.Location}}: {{.Severity}}: {{.Message}}", map[string]interface{}{
+		"Location": location,
+		"Severity": diagnostic.Severity,
+		"Message":  diagnostic.Message,
+	})
+}
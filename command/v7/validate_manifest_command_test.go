@@ -0,0 +1,168 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd             ValidateManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeValidateManifestActor
+		binaryName      string
+		executeErr      error
+
+		tmpDir       string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeValidateManifestActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "validate-manifest")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+
+		cmd = ValidateManifestCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Path = flag.PathWithExistenceCheck(manifestPath)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(manifestPath, []byte("applications:\n- name: some-app\n  buildpacks: [go_buildpack]\n"), 0600)).To(Succeed())
+		})
+
+		It("reports no problems without contacting the API", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Validating manifest %s\.\.\.`, manifestPath))
+			Expect(testUI.Out).To(Say(`0 error\(s\), 0 warning\(s\)`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetMissingManifestReferencesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest has problems", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(manifestPath, []byte("applications:\n- name: some-app\n  memory: lots\n  buildpack: ruby_buildpack\n"), 0600)).To(Succeed())
+		})
+
+		It("displays each diagnostic with its position and returns a ManifestValidationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationError{ErrorCount: 1}))
+			Expect(testUI.Out).To(Say(`%s:3:3: error: 'memory' must be a size with a unit, e\.g\. 256M or 1G`, manifestPath))
+			Expect(testUI.Out).To(Say(`%s:4:3: warning: 'buildpack' is deprecated, use 'buildpacks' instead`, manifestPath))
+			Expect(testUI.Out).To(Say(`1 error\(s\), 1 warning\(s\)`))
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(manifestPath, []byte("applications: ["), 0600)).To(Succeed())
+		})
+
+		It("reports the YAML error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationError{ErrorCount: 1}))
+			Expect(testUI.Out).To(Say(`%s: error: yaml:`, manifestPath))
+		})
+	})
+
+	When("--against-api is provided", func() {
+		BeforeEach(func() {
+			cmd.AgainstAPI = true
+			Expect(ioutil.WriteFile(manifestPath, []byte("applications:\n- name: some-app\n  services:\n  - some-service\n"), 0600)).To(Succeed())
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("a referenced resource is missing", func() {
+			BeforeEach(func() {
+				fakeActor.GetMissingManifestReferencesReturns(
+					[]manifestparser.Reference{{
+						Position: manifestparser.Position{Line: 4, Column: 3},
+						Type:     manifestparser.ServiceInstanceReference,
+						Name:     "some-service",
+					}},
+					v7action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("reports the missing resource", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestValidationError{ErrorCount: 1}))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).To(Say(`%s:4:3: error: service instance 'some-service' does not exist in space 'some-space'`, manifestPath))
+
+				Expect(fakeActor.GetMissingManifestReferencesCallCount()).To(Equal(1))
+				spaceGUID, references := fakeActor.GetMissingManifestReferencesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(references).To(HaveLen(1))
+				Expect(references[0].Name).To(Equal("some-service"))
+			})
+		})
+
+		When("looking up the references fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetMissingManifestReferencesReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+})
@@ -12,6 +12,8 @@ type AppInstanceRow struct {
 	CPU     string
 	Memory  string
 	Disk    string
+	Cell    string
+	Zone    string
 	Details string
 }
 
@@ -31,6 +33,7 @@ func ParseV3AppProcessTable(input []byte) AppTable {
 
 	rows := strings.Split(string(input), "\n")
 	foundFirstProcess := false
+	hasPlacement := false
 	for _, row := range rows {
 		if !foundFirstProcess {
 			ok := regexp.MustCompile(`\Atype:([^:]+)\z`).Match([]byte(row))
@@ -49,20 +52,27 @@ func ParseV3AppProcessTable(input []byte) AppTable {
 		case strings.HasPrefix(row, "#"):
 			// instance row
 			columns := splitColumns(row)
-			details := ""
-			if len(columns) >= 7 {
-				details = columns[6]
+			instanceRow := AppInstanceRow{
+				Index:  columns[0],
+				State:  columns[1],
+				Since:  columns[2],
+				CPU:    columns[3],
+				Memory: columns[4],
+				Disk:   columns[5],
 			}
 
-			instanceRow := AppInstanceRow{
-				Index:   columns[0],
-				State:   columns[1],
-				Since:   columns[2],
-				CPU:     columns[3],
-				Memory:  columns[4],
-				Disk:    columns[5],
-				Details: details,
+			detailsColumn := 6
+			if hasPlacement {
+				detailsColumn = 8
+				if len(columns) >= 8 {
+					instanceRow.Cell = columns[6]
+					instanceRow.Zone = columns[7]
+				}
 			}
+			if len(columns) > detailsColumn {
+				instanceRow.Details = columns[detailsColumn]
+			}
+
 			lastProcessIndex := len(appTable.Processes) - 1
 			appTable.Processes[lastProcessIndex].Instances = append(
 				appTable.Processes[lastProcessIndex].Instances,
@@ -86,6 +96,7 @@ func ParseV3AppProcessTable(input []byte) AppTable {
 
 		default:
 			// column headers
+			hasPlacement = strings.Contains(row, " cell ")
 			continue
 		}
 
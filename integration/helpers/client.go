@@ -24,6 +24,7 @@ func CreateCCV2Client() (*ccv2.Client, error) {
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
+		UserAgentSuffix:    config.UserAgentSuffix(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
 		Wrappers:           ccWrappers,
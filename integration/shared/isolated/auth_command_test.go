@@ -17,7 +17,10 @@ var _ = Describe("auth command", func() {
 			Eventually(session).Should(Say("auth - Authenticate non-interactively\n\n"))
 
 			Eventually(session).Should(Say("USAGE:"))
-			Eventually(session).Should(Say("cf auth USERNAME PASSWORD\n"))
+			Eventually(session).Should(Say(`cf auth USERNAME PASSWORD \[--prompt KEY=VALUE\]\.\.\.\n`))
+			Eventually(session).Should(Say("cf auth USERNAME PASSWORD --origin ORIGIN\n"))
+			Eventually(session).Should(Say(`cf auth --sso-passcode PASSCODE \[--prompt KEY=VALUE\]\.\.\.\n`))
+			Eventually(session).Should(Say(`cf auth --device \[--timeout TIMEOUT\]\n`))
 			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n"))
 
 			Eventually(session).Should(Say("ENVIRONMENT VARIABLES:"))
@@ -31,11 +34,18 @@ var _ = Describe("auth command", func() {
 
 			Eventually(session).Should(Say("EXAMPLES:"))
 			Eventually(session).Should(Say("cf auth name@example\\.com \"my password\" \\(use quotes for passwords with a space\\)"))
-			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n\n"))
+			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n"))
+			Eventually(session).Should(Say("cf auth --sso-passcode PASSCODE \\(use a one-time passcode instead of a username and password\\)\n"))
+			Eventually(session).Should(Say("cf auth name@example\\.com \"my password\" --prompt pin=1234 \\(also answer the UAA login prompt named pin\\)\n"))
+			Eventually(session).Should(Say("cf auth --device \\(approve the login from a browser on another device, such as when logged in over SSH\\)\n\n"))
 
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say("--client-credentials\\s+Use \\(non-user\\) service account \\(also called client credentials\\)\n"))
-			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n\n"))
+			Eventually(session).Should(Say("--device\\s+Log in by approving a code from another device, such as a phone or a computer with a browser\n"))
+			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n"))
+			Eventually(session).Should(Say("--prompt\\s+Value for a login prompt configured in UAA, as KEY=VALUE. May be repeated\n"))
+			Eventually(session).Should(Say("--sso-passcode\\s+One-time passcode\n"))
+			Eventually(session).Should(Say(`--timeout\s+Maximum time to wait for the login request to be approved \(e.g. 90s, 10m\); numbers without a unit are minutes. Requires --device\n\n`))
 
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say("api, login, target"))
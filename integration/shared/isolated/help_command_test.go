@@ -44,8 +44,9 @@ var _ = Describe("help command", func() {
 			Eventually(session).Should(Say("CLI plugin management:"))
 			Eventually(session).Should(Say("  install-plugin    list-plugin-repos"))
 			Eventually(session).Should(Say("Global options:"))
-			Eventually(session).Should(Say("  --help, -h                         Show help"))
-			Eventually(session).Should(Say("  -v                                 Print API request diagnostics to stdout"))
+			Eventually(session).Should(Say("  --help, -h                                     Show help"))
+			Eventually(session).Should(Say("  -v                                             Print API request diagnostics to stdout"))
+			Eventually(session).Should(Say("  --foundations NAME,...                         Run a read-only command against foundations saved in ~/.cf/foundations"))
 
 			Eventually(session).Should(Say(`TIP: Use 'cf help -a' to see all commands\.`))
 			Eventually(session).Should(Exit(0))
@@ -292,117 +292,40 @@ var _ = Describe("install-plugin command", func() {
 								})
 						})
 
-						It("tells the user about the conflict and fails", func() {
+						It("installs the plugin and warns about the conflict", func() {
 							session := helpers.CF("install-plugin", "-f", pluginPath)
 
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
+							Eventually(session).Should(Say(`Plugin some-plugin 1\.1\.1 successfully installed\.`))
+							Eventually(session.Err).Should(Say("Plugin some-plugin has command names or aliases that built-in commands or other plugins also have: version"))
+							Eventually(session.Err).Should(Say(`Run them as 'cf some-plugin:COMMAND'\. Use 'cf plugins --conflicts' to see which commands run by name\.`))
 
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin some-plugin v1\.1\.1 could not be installed as it contains commands with names that are already used: version`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-
-					When("the plugin has a command that is the same as a built-in alias", func() {
-						BeforeEach(func() {
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "some-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "cups"},
-								})
-						})
-
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
-
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
-
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin some-plugin v1\.1\.1 could not be installed as it contains commands with names that are already used: cups`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-
-					When("the plugin has a command that is the same as another plugin command", func() {
-						BeforeEach(func() {
-							helpers.InstallConfigurablePlugin("existing-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command"},
-								})
-
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "new-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command"},
-								})
-						})
-
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
-
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
-
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin new-plugin v1\.1\.1 could not be installed as it contains commands with names that are already used: existing-command\.`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-
-					When("the plugin has a command that is the same as another plugin alias", func() {
-						BeforeEach(func() {
-							helpers.InstallConfigurablePlugin("existing-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command"},
-								})
-
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "new-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "new-command", Alias: "existing-command"},
-								})
+							Eventually(session).Should(Exit(0))
 						})
 
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
-
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
-
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin new-plugin v1\.1\.1 could not be installed as it contains commands with aliases that are already used: existing-command\.`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-				})
+						It("runs the built-in command by name and the plugin command by its namespaced name", func() {
+							Eventually(helpers.CF("install-plugin", "-f", pluginPath)).Should(Exit(0))
 
-				Context("alias conflict", func() {
-					When("the plugin has an alias that is the same as a built-in command", func() {
+							session := helpers.CF("version")
+							Eventually(session).Should(Say(`cf version \d+\.\d+`))
+							Eventually(session).Should(Exit(0))
 
-						BeforeEach(func() {
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "some-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "some-command", Alias: "version"},
-								})
+							session = helpers.CF("some-plugin:version")
+							Eventually(session).Should(Say(`some-plugin \d+ version`))
+							Eventually(session).Should(Exit(0))
 						})
 
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
-
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
+						When("the plugin takes precedence", func() {
+							BeforeEach(func() {
+								Eventually(helpers.CF("config", "set", "plugin-precedence", "some-plugin")).Should(Exit(0))
+							})
 
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin some-plugin v1\.1\.1 could not be installed as it contains commands with aliases that are already used: version`))
+							It("runs the plugin command by name", func() {
+								Eventually(helpers.CF("install-plugin", "-f", pluginPath)).Should(Exit(0))
 
-							Eventually(session).Should(Exit(1))
+								session := helpers.CF("version")
+								Eventually(session).Should(Say(`some-plugin \d+ version`))
+								Eventually(session).Should(Exit(0))
+							})
 						})
 					})
 
@@ -415,47 +338,17 @@ var _ = Describe("install-plugin command", func() {
 								})
 						})
 
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
-
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
-
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin some-plugin v1\.1\.1 could not be installed as it contains commands with aliases that are already used: cups`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-
-					When("the plugin has an alias that is the same as another plugin command", func() {
-						BeforeEach(func() {
-							helpers.InstallConfigurablePlugin("existing-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command"},
-								})
-
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "new-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "new-command", Alias: "existing-command"},
-								})
-						})
-
-						It("tells the user about the conflict and fails", func() {
+						It("installs the plugin and warns about the conflict", func() {
 							session := helpers.CF("install-plugin", "-f", pluginPath)
 
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
+							Eventually(session).Should(Say(`Plugin some-plugin 1\.1\.1 successfully installed\.`))
+							Eventually(session.Err).Should(Say("Plugin some-plugin has command names or aliases that built-in commands or other plugins also have: cups"))
 
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin new-plugin v1\.1\.1 could not be installed as it contains commands with aliases that are already used: existing-command\.`))
-
-							Eventually(session).Should(Exit(1))
+							Eventually(session).Should(Exit(0))
 						})
 					})
 
-					When("the plugin has an alias that is the same as another plugin alias", func() {
+					When("the plugin has a command that is the same as another plugin alias", func() {
 						BeforeEach(func() {
 							helpers.InstallConfigurablePlugin("existing-plugin", "1.1.1",
 								[]helpers.PluginCommand{
@@ -465,49 +358,34 @@ var _ = Describe("install-plugin command", func() {
 							pluginPath = helpers.BuildConfigurablePlugin(
 								"configurable_plugin", "new-plugin", "1.1.1",
 								[]helpers.PluginCommand{
-									{Name: "new-command", Alias: "existing-alias"},
+									{Name: "existing-alias"},
 								})
 						})
 
-						It("tells the user about the conflict and fails", func() {
+						It("installs the plugin and warns about the conflict", func() {
 							session := helpers.CF("install-plugin", "-f", pluginPath)
 
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
-
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin new-plugin v1\.1\.1 could not be installed as it contains commands with aliases that are already used: existing-alias\.`))
-
-							Eventually(session).Should(Exit(1))
-						})
-					})
-				})
-
-				Context("alias and command conflicts", func() {
-					When("the plugin has a command and an alias that are both taken by another plugin", func() {
-						BeforeEach(func() {
-							helpers.InstallConfigurablePlugin("existing-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command", Alias: "existing-alias"},
-								})
+							Eventually(session).Should(Say(`Plugin new-plugin 1\.1\.1 successfully installed\.`))
+							Eventually(session.Err).Should(Say("Plugin new-plugin has command names or aliases that built-in commands or other plugins also have: existing-alias"))
 
-							pluginPath = helpers.BuildConfigurablePlugin(
-								"configurable_plugin", "new-plugin", "1.1.1",
-								[]helpers.PluginCommand{
-									{Name: "existing-command", Alias: "existing-alias"},
-								})
+							Eventually(session).Should(Exit(0))
 						})
 
-						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+						It("asks which plugin to run until one takes precedence", func() {
+							Eventually(helpers.CF("install-plugin", "-f", pluginPath)).Should(Exit(0))
 
-							Eventually(session).Should(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
-							Eventually(session).Should(Say(`Install and use plugins at your own risk\.`))
+							session := helpers.CF("existing-alias")
+							Eventually(session).Should(Say(`'existing-alias' is a command of the plugins existing-plugin, new-plugin\. Run it as 'cf PLUGIN:existing-alias', or choose the plugin that runs it with 'cf config set plugin-precedence PLUGIN'\.`))
+							Eventually(session).Should(Exit(1))
 
-							Eventually(session).Should(Say("FAILED"))
-							Eventually(session.Err).Should(Say(`Plugin new-plugin v1\.1\.1 could not be installed as it contains commands with names and aliases that are already used: existing-command, existing-alias\.`))
+							session = helpers.CF("existing-plugin:existing-alias")
+							Eventually(session).Should(Say(`existing-plugin \d+ existing-command`))
+							Eventually(session).Should(Exit(0))
 
-							Eventually(session).Should(Exit(1))
+							Eventually(helpers.CF("config", "set", "plugin-precedence", "new-plugin")).Should(Exit(0))
+							session = helpers.CF("existing-alias")
+							Eventually(session).Should(Say(`new-plugin \d+ existing-alias`))
+							Eventually(session).Should(Exit(0))
 						})
 					})
 				})
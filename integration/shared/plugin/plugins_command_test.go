@@ -21,9 +21,12 @@ var _ = Describe("plugins command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("plugins - List commands of installed plugins"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf plugins [--checksum | --outdated]"))
+				Eventually(session).Should(Say(`cf plugins \[--checksum \| --outdated \| --conflicts\]`))
+				Eventually(session).Should(Say("TIP:"))
+				Eventually(session).Should(Say(`Run a plugin command that built-in commands or other plugins also have as 'cf PLUGIN:COMMAND'\.`))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--checksum\s+Compute and show the sha1 value of the plugin binary file`))
+				Eventually(session).Should(Say(`--conflicts\s+Show plugin command names and aliases that built-in commands or other plugins also have, and which of them runs`))
 				Eventually(session).Should(Say(`--outdated\s+Search the plugin repositories for new versions of installed plugins`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("install-plugin, repo-plugins, uninstall-plugin"))
@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/deprecation"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -35,16 +37,46 @@ type TriggerLegacyMain interface {
 	error
 }
 
+// ExitCoder is an error that ends the CLI with its own exit status instead of
+// the generic failure status.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// exitStatusError carries an ExitCoder's exit status back to parse.
+type exitStatusError int
+
+func (e exitStatusError) Error() string {
+	return fmt.Sprintf("exit status %d", int(e))
+}
+
+type DeprecationAdvisor interface {
+	DeprecationAdvisory() (deprecation.Advisory, bool)
+}
+
 const switchToV2 = -3
 
+// readScriptFromStdin is the shorthand for 'cf run-script -'.
+const readScriptFromStdin = "-"
+
 var ErrFailed = errors.New("command failed")
 var ParseErr = errors.New("incorrect type for arg")
 
 func main() {
 	defer panichandler.HandlePanic()
-	exitStatus := parse(os.Args[1:], &common.Commands)
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == readScriptFromStdin {
+		args = append([]string{"run-script"}, args...)
+	}
+
+	if len(args) > 0 && isCommand(args[0]) && cmd.PluginOverridesCommand(args[0]) {
+		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return
+	}
+
+	exitStatus := parse(args, &common.Commands)
 	if exitStatus == switchToV2 {
-		exitStatus = parse(os.Args[1:], &common.FallbackCommands)
+		exitStatus = parse(args, &common.FallbackCommands)
 	}
 	if exitStatus != 0 {
 		os.Exit(exitStatus)
@@ -53,7 +85,9 @@ func main() {
 
 func parse(args []string, commandList interface{}) int {
 	parser := flags.NewParser(commandList, flags.HelpFlag)
-	parser.CommandHandler = executionWrapper
+	parser.CommandHandler = func(cmd flags.Commander, args []string) error {
+		return executionWrapper(parser.Active, cmd, args)
+	}
 	extraArgs, err := parser.ParseArgs(args)
 	if err == nil {
 		return 0
@@ -69,6 +103,8 @@ func parse(args []string, commandList interface{}) int {
 		return 1
 	} else if exitError, ok := err.(*ssh.ExitError); ok {
 		return exitError.ExitStatus()
+	} else if exitStatus, ok := err.(exitStatusError); ok {
+		return int(exitStatus)
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
@@ -159,9 +195,21 @@ func isOption(s string) bool {
 	return strings.HasPrefix(s, "-")
 }
 
-func executionWrapper(cmd flags.Commander, args []string) error {
+func executionWrapper(activeCommand *flags.Command, cmd flags.Commander, args []string) error {
+	var (
+		commandName string
+		setFlags    []string
+	)
+	if activeCommand != nil {
+		commandName = activeCommand.Name
+		setFlags = common.SetFlagNames(activeCommand)
+	}
+
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
+		Reason:  common.Commands.Reason,
+		DryRun:  common.Commands.DryRun,
+		Timings: common.Commands.Timings,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -173,6 +221,14 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	if err != nil {
 		return err
 	}
+	defer commandUI.RestoreConsole()
+	if cfConfig.Timings() {
+		defer commandUI.DisplayTimings()
+	}
+
+	if common.Commands.Foundations != "" {
+		return handleError(runOnFoundations(cfConfig, commandUI, setFlags), commandUI)
+	}
 
 	err = preventExtraArgs(args)
 	if err != nil {
@@ -219,16 +275,60 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
-		err = extendedCmd.Setup(cfConfig, commandUI)
-		if err != nil {
-			return handleError(err, commandUI)
-		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+		err = common.RunCommand(cfConfig, commandUI, commandName, setFlags, extendedCmd, args)
+		return handleError(err, commandUI)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// handOffGlobalFlags removes the --reason and --dry-run global flags, which
+// the legacy code does not know, from the arguments and hands them to it in
+// $CF_REASON and $CF_DRY_RUN. The --timings global flag is removed as the
+// legacy code does not record timings.
+func handOffGlobalFlags(args []string) []string {
+	var legacyArgs []string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--reason" && i+1 < len(args):
+			_ = os.Setenv("CF_REASON", args[i+1])
+			i++
+		case strings.HasPrefix(args[i], "--reason="):
+			_ = os.Setenv("CF_REASON", strings.TrimPrefix(args[i], "--reason="))
+		case args[i] == "--dry-run" && common.Commands.DryRun:
+			_ = os.Setenv("CF_DRY_RUN", "true")
+		case args[i] == "--timings" && common.Commands.Timings:
+		default:
+			legacyArgs = append(legacyArgs, args[i])
+		}
+	}
+	return legacyArgs
+}
+
+// runOnFoundations runs the command against every foundation given with
+// --foundations. The current target is not used, so only the flags are
+// checked against the insecure-allowed setting here; the foundations are
+// checked by their child processes.
+func runOnFoundations(cfConfig *configv3.Config, commandUI command.UI, setFlags []string) error {
+	err := common.CheckInsecurePolicy(cfConfig, commandUI, "", setFlags)
+	if err != nil {
+		return err
+	}
+
+	runner, err := common.NewFoundationsRunner(cfConfig, commandUI)
+	if err != nil {
+		return err
+	}
+	return runner.Run(common.Commands.Foundations, os.Args[1:])
+}
+
+func deprecationAdvisory(err error) (deprecation.Advisory, bool) {
+	if advisor, ok := err.(DeprecationAdvisor); ok {
+		return advisor.DeprecationAdvisory()
+	}
+	return deprecation.Advisory{}, false
+}
+
 func handleError(passedErr error, commandUI UI) error {
 	if passedErr == nil {
 		return nil
@@ -241,12 +341,15 @@ func handleError(passedErr error, commandUI UI) error {
 		log.Info("Received a V3V2SwitchError - switch to the V2 version of the command")
 		return passedErr
 	case TriggerLegacyMain:
-		if typedErr.Error() != "" {
+		if advisory, found := deprecationAdvisory(typedErr); found {
+			commandUI.DisplayWarning("")
+			deprecation.Display(commandUI, filepath.Base(os.Args[0]), advisory)
+		} else if typedErr.Error() != "" {
 			commandUI.DisplayWarning("")
 			commandUI.DisplayWarning(typedErr.Error())
 		}
 
-		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		cmd.Main(os.Getenv("CF_TRACE"), handOffGlobalFlags(os.Args))
 	case *ssh.ExitError:
 		exitStatus := typedErr.ExitStatus()
 		if sig := typedErr.Signal(); sig != "" {
@@ -264,5 +367,9 @@ func handleError(passedErr error, commandUI UI) error {
 		return ParseErr
 	}
 
+	if exitCoder, ok := translatedErr.(ExitCoder); ok {
+		return exitStatusError(exitCoder.ExitCode())
+	}
+
 	return ErrFailed
 }
@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// InterruptGracePeriod is how long a plugin command has to exit after it is
+// interrupted with Ctrl-C before it is killed.
+const InterruptGracePeriod = 10 * time.Second
+
+// stopWaitPeriod is how long to wait for a killed plugin command to be reaped,
+// in case processes it started still hold its output open.
+const stopWaitPeriod = 5 * time.Second
+
+// PluginLimits bound a plugin command run, so that a plugin that misbehaves
+// cannot hang the terminal. Zero values mean no limit.
+type PluginLimits struct {
+	// Timeout is how long the plugin command may run.
+	Timeout time.Duration
+
+	// MaxOutput is how many bytes the plugin command may write to stdout and
+	// stderr together.
+	MaxOutput uint64
+}
+
+// PluginTimeoutError is returned when a plugin command runs longer than the
+// plugin-timeout setting.
+type PluginTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e PluginTimeoutError) Error() string {
+	return fmt.Sprintf("The plugin command did not finish within %s and was stopped.", e.Timeout)
+}
+
+// PluginOutputLimitError is returned when a plugin command writes more than
+// the plugin-max-output setting.
+type PluginOutputLimitError struct {
+	MaxOutput uint64
+}
+
+func (e PluginOutputLimitError) Error() string {
+	return fmt.Sprintf("The plugin command wrote more than %s of output and was stopped.", bytefmt.ByteSize(e.MaxOutput))
+}
+
+// PluginInterruptedError is returned when a plugin command does not exit
+// within InterruptGracePeriod of being interrupted.
+type PluginInterruptedError struct{}
+
+func (PluginInterruptedError) Error() string {
+	return fmt.Sprintf("The plugin command did not exit within %s of being interrupted and was stopped.", InterruptGracePeriod)
+}
+
+// SupervisePlugin runs the plugin command cmd and waits for it to exit. The
+// command is killed when it runs longer than limits.Timeout, writes more than
+// limits.MaxOutput, or does not exit within InterruptGracePeriod of Ctrl-C.
+//
+// When stdin is not a terminal the command runs in its own process group, so
+// that interrupting or killing it reaches the processes it started as well.
+// Otherwise it stays in the foreground process group, so that it can read
+// from the terminal, and the terminal interrupts it directly; killing it then
+// does not reach the processes it started. MaxOutput is not applied when
+// stdout is a terminal, since limiting output means writing to pipes, which
+// would take the terminal away from interactive and coloured output.
+func SupervisePlugin(cmd *exec.Cmd, limits PluginLimits) error {
+	var limiter *outputLimiter
+	if limits.MaxOutput > 0 && !isTerminal(cmd.Stdout) {
+		limiter = newOutputLimiter(limits.MaxOutput)
+		cmd.Stdout = limiter.writer(cmd.Stdout)
+		cmd.Stderr = limiter.writer(cmd.Stderr)
+	}
+
+	isolated := isolatePlugin(cmd)
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, interruptSignals...)
+	defer signal.Stop(interrupts)
+
+	err := cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	var timeout <-chan time.Time
+	if limits.Timeout > 0 {
+		timer := time.NewTimer(limits.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var exceeded <-chan struct{}
+	if limiter != nil {
+		exceeded = limiter.exceeded
+	}
+
+	var gracePeriod <-chan time.Time
+	for {
+		select {
+		case err = <-exited:
+			return err
+		case sig := <-interrupts:
+			signalPlugin(cmd, sig, isolated)
+			if gracePeriod == nil {
+				gracePeriod = time.After(InterruptGracePeriod)
+			}
+		case <-gracePeriod:
+			return stopPlugin(cmd, isolated, exited, PluginInterruptedError{})
+		case <-timeout:
+			return stopPlugin(cmd, isolated, exited, PluginTimeoutError{Timeout: limits.Timeout})
+		case <-exceeded:
+			return stopPlugin(cmd, isolated, exited, PluginOutputLimitError{MaxOutput: limits.MaxOutput})
+		}
+	}
+}
+
+func isTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	return ok && terminal.IsTerminal(int(file.Fd()))
+}
+
+func stopPlugin(cmd *exec.Cmd, isolated bool, exited <-chan error, err error) error {
+	killPlugin(cmd, isolated)
+	select {
+	case <-exited:
+	case <-time.After(stopWaitPeriod):
+	}
+	return err
+}
+
+var errOutputLimitExceeded = errors.New("plugin output limit exceeded")
+
+// outputLimiter counts the bytes written through its writers, and drops
+// output and closes exceeded once more than its limit is written.
+type outputLimiter struct {
+	mutex     sync.Mutex
+	remaining uint64
+	stopped   bool
+	exceeded  chan struct{}
+}
+
+func newOutputLimiter(limit uint64) *outputLimiter {
+	return &outputLimiter{
+		remaining: limit,
+		exceeded:  make(chan struct{}),
+	}
+}
+
+func (limiter *outputLimiter) writer(w io.Writer) io.Writer {
+	return limitedWriter{limiter: limiter, writer: w}
+}
+
+type limitedWriter struct {
+	limiter *outputLimiter
+	writer  io.Writer
+}
+
+func (w limitedWriter) Write(p []byte) (int, error) {
+	w.limiter.mutex.Lock()
+	defer w.limiter.mutex.Unlock()
+
+	if w.limiter.stopped {
+		return 0, errOutputLimitExceeded
+	}
+
+	if uint64(len(p)) <= w.limiter.remaining {
+		w.limiter.remaining -= uint64(len(p))
+		return w.writer.Write(p)
+	}
+
+	n, err := w.writer.Write(p[:w.limiter.remaining])
+	w.limiter.remaining = 0
+	w.limiter.stopped = true
+	close(w.limiter.exceeded)
+	if err != nil {
+		return n, err
+	}
+	return n, errOutputLimitExceeded
+}
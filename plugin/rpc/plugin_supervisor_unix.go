@@ -0,0 +1,46 @@
+// +build !windows
+
+package rpc
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var interruptSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// isolatePlugin starts the plugin command in its own process group when stdin
+// is not a terminal. A process group in the background of a terminal would be
+// stopped as soon as it read from it.
+func isolatePlugin(cmd *exec.Cmd) bool {
+	if terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return false
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return true
+}
+
+// signalPlugin passes sig on to the plugin command. Ctrl-C on a terminal
+// already reached a plugin command in the foreground process group.
+func signalPlugin(cmd *exec.Cmd, sig os.Signal, isolated bool) {
+	if isolated {
+		_ = syscall.Kill(-cmd.Process.Pid, sig.(syscall.Signal))
+	} else if sig != os.Interrupt {
+		_ = cmd.Process.Signal(sig)
+	}
+}
+
+// killPlugin kills the plugin command, and the processes it started when it
+// runs in its own process group. In the foreground process group of a
+// terminal, those processes cannot be told apart from the CLI's own, so they
+// are left running.
+func killPlugin(cmd *exec.Cmd, isolated bool) {
+	if isolated {
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	} else {
+		_ = cmd.Process.Kill()
+	}
+}
@@ -0,0 +1,82 @@
+// +build !windows
+
+package rpc_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"time"
+
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var _ = Describe("SupervisePlugin", func() {
+	var (
+		cmd    *exec.Cmd
+		stdout *bytes.Buffer
+		limits PluginLimits
+	)
+
+	BeforeEach(func() {
+		if terminal.IsTerminal(int(os.Stdin.Fd())) {
+			Skip("plugin commands only run in their own process group when stdin is not a terminal")
+		}
+
+		stdout = new(bytes.Buffer)
+		limits = PluginLimits{}
+	})
+
+	run := func(script string) (time.Duration, error) {
+		cmd = exec.Command("sh", "-c", script)
+		cmd.Stdout = stdout
+		cmd.Stderr = stdout
+
+		startTime := time.Now()
+		err := SupervisePlugin(cmd, limits)
+		return time.Since(startTime), err
+	}
+
+	It("returns the result of the plugin command", func() {
+		_, err := run("echo hello")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("hello\n"))
+
+		_, err = run("exit 3")
+		Expect(err).To(BeAssignableToTypeOf(&exec.ExitError{}))
+	})
+
+	When("the plugin command runs longer than the timeout", func() {
+		BeforeEach(func() {
+			limits.Timeout = 100 * time.Millisecond
+		})
+
+		It("stops the plugin command and the processes it started", func() {
+			duration, err := run("sleep 10 & wait")
+			Expect(err).To(MatchError(PluginTimeoutError{Timeout: 100 * time.Millisecond}))
+			Expect(duration).To(BeNumerically("<", 3*time.Second))
+		})
+	})
+
+	When("the plugin command writes more than the max output", func() {
+		BeforeEach(func() {
+			limits.MaxOutput = 1024
+		})
+
+		It("stops the plugin command after writing the max output", func() {
+			duration, err := run("yes")
+			Expect(err).To(MatchError(PluginOutputLimitError{MaxOutput: 1024}))
+			Expect(stdout.Len()).To(Equal(1024))
+			Expect(duration).To(BeNumerically("<", 3*time.Second))
+		})
+
+		It("lets the plugin command write up to the max output", func() {
+			_, err := run("echo hello; echo world >&2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(Equal("hello\nworld\n"))
+		})
+	})
+})
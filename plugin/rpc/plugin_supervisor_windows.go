@@ -0,0 +1,22 @@
+// +build windows
+
+package rpc
+
+import (
+	"os"
+	"os/exec"
+)
+
+var interruptSignals = []os.Signal{os.Interrupt}
+
+// isolatePlugin leaves the plugin command attached to the console, which
+// passes Ctrl-C on to it.
+func isolatePlugin(cmd *exec.Cmd) bool {
+	return false
+}
+
+func signalPlugin(cmd *exec.Cmd, sig os.Signal, isolated bool) {}
+
+func killPlugin(cmd *exec.Cmd, isolated bool) {
+	_ = cmd.Process.Kill()
+}
@@ -1,41 +1,115 @@
 package rpc
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
-func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[string]pluginconfig.PluginMetadata) bool {
-	for _, metadata := range pluginList {
-		for _, command := range metadata.Commands {
-			if command.Name == args[0] || command.Alias == args[0] {
-				args[0] = command.Name
+// RunMethodIfExists runs the plugin command, or alias, args[0]. A command of
+// the form PLUGIN:COMMAND runs the command of that plugin. When several
+// plugins have the command, the first of them in precedence runs it, and none
+// does when none of them is in precedence. The plugin command runs
+// supervised, within limits. Along with whether a plugin command ran, it
+// returns the exit code for the CLI to exit with: the plugin's own, or 1 when
+// the plugin could not run to completion.
+func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[string]pluginconfig.PluginMetadata, precedence []string, limits PluginLimits) (bool, int) {
+	metadata, commandName, found := findMethod(args[0], pluginList, precedence)
+	if !found {
+		return false, 0
+	}
+	args[0] = commandName
+
+	rpcService.Start()
+	defer rpcService.Stop()
+
+	pluginArgs := append([]string{rpcService.Port()}, args...)
 
-				rpcService.Start()
-				defer rpcService.Stop()
+	cmd := exec.Command(metadata.Location, pluginArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	err := SupervisePlugin(cmd, limits)
+	if err != nil {
+		return true, pluginExitCode(err)
+	}
+	return true, 0
+}
 
-				pluginArgs := append([]string{rpcService.Port()}, args...)
+// pluginExitCode returns the exit code of a plugin that exited with an error,
+// or 1 when it was stopped, after explaining why.
+func pluginExitCode(err error) int {
+	exitErr, exited := err.(*exec.ExitError)
+	if !exited {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	if code := exitErr.ExitCode(); code > 0 {
+		return code
+	}
+	return 1
+}
+
+// PluginsWithMethod returns the names of the plugins that have the command or
+// alias name, sorted.
+func PluginsWithMethod(name string, pluginList map[string]pluginconfig.PluginMetadata) []string {
+	var pluginNames []string
+	for pluginName, metadata := range pluginList {
+		if _, found := commandOf(metadata, name); found {
+			pluginNames = append(pluginNames, pluginName)
+		}
+	}
+	sort.Strings(pluginNames)
+	return pluginNames
+}
 
-				cmd := exec.Command(metadata.Location, pluginArgs...)
-				cmd.Stdout = os.Stdout
-				cmd.Stdin = os.Stdin
-				cmd.Stderr = os.Stderr
+// PluginOverridesCommand returns whether a plugin in precedence has the
+// command or alias name, so that the plugin command runs instead of the
+// built-in command of the same name.
+func PluginOverridesCommand(name string, pluginList map[string]pluginconfig.PluginMetadata, precedence []string) bool {
+	_, found := configv3.PreferredPlugin(PluginsWithMethod(name, pluginList), precedence)
+	return found
+}
 
-				defer stopPlugin(cmd)
-				err := cmd.Run()
-				if err != nil {
-					os.Exit(1)
-				}
-				return true
+func findMethod(name string, pluginList map[string]pluginconfig.PluginMetadata, precedence []string) (pluginconfig.PluginMetadata, string, bool) {
+	if i := strings.Index(name, ":"); i > 0 {
+		if metadata, found := pluginList[name[:i]]; found {
+			if commandName, found := commandOf(metadata, name[i+1:]); found {
+				return metadata, commandName, true
 			}
 		}
 	}
-	return false
+
+	pluginNames := PluginsWithMethod(name, pluginList)
+	if len(pluginNames) == 0 {
+		return pluginconfig.PluginMetadata{}, "", false
+	}
+
+	pluginName := pluginNames[0]
+	if len(pluginNames) > 1 {
+		var found bool
+		pluginName, found = configv3.PreferredPlugin(pluginNames, precedence)
+		if !found {
+			return pluginconfig.PluginMetadata{}, "", false
+		}
+	}
+
+	metadata := pluginList[pluginName]
+	commandName, _ := commandOf(metadata, name)
+	return metadata, commandName, true
 }
 
-func stopPlugin(plugin *exec.Cmd) {
-	plugin.Process.Kill()
-	plugin.Wait()
+func commandOf(metadata pluginconfig.PluginMetadata, name string) (string, bool) {
+	for _, command := range metadata.Commands {
+		if command.Name == name || command.Alias == name {
+			return command.Name, true
+		}
+	}
+	return "", false
 }
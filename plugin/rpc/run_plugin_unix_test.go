@@ -0,0 +1,83 @@
+// +build !windows
+
+package rpc_test
+
+import (
+	"io/ioutil"
+	"net/rpc"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/plugin"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var _ = Describe("RunMethodIfExists", func() {
+	var (
+		rpcService *CliRpcService
+		pluginDir  string
+		pluginList map[string]pluginconfig.PluginMetadata
+	)
+
+	BeforeEach(func() {
+		if terminal.IsTerminal(int(os.Stdin.Fd())) {
+			Skip("plugin commands only run in their own process group when stdin is not a terminal")
+		}
+
+		var err error
+		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.NewServer())
+		Expect(err).ToNot(HaveOccurred())
+
+		pluginDir, err = ioutil.TempDir("", "run-plugin")
+		Expect(err).ToNot(HaveOccurred())
+
+		pluginList = map[string]pluginconfig.PluginMetadata{}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pluginDir)).To(Succeed())
+	})
+
+	addPlugin := func(name string, command string, script string) {
+		location := filepath.Join(pluginDir, name)
+		Expect(ioutil.WriteFile(location, []byte("#!/bin/sh\n"+script+"\n"), 0700)).To(Succeed())
+		pluginList[name] = pluginconfig.PluginMetadata{
+			Location: location,
+			Commands: []plugin.Command{{Name: command}},
+		}
+	}
+
+	It("does not run anything when no plugin has the command", func() {
+		ran, exitCode := RunMethodIfExists(rpcService, []string{"some-command"}, pluginList, nil, PluginLimits{})
+		Expect(ran).To(BeFalse())
+		Expect(exitCode).To(Equal(0))
+	})
+
+	It("returns exit code 0 when the plugin command succeeds", func() {
+		addPlugin("some-plugin", "some-command", "exit 0")
+
+		ran, exitCode := RunMethodIfExists(rpcService, []string{"some-command"}, pluginList, nil, PluginLimits{})
+		Expect(ran).To(BeTrue())
+		Expect(exitCode).To(Equal(0))
+	})
+
+	When("the plugin command fails", func() {
+		BeforeEach(func() {
+			addPlugin("some-plugin", "some-command", "exit 3")
+		})
+
+		It("returns the exit code of the plugin after stopping the rpc server", func() {
+			ran, exitCode := RunMethodIfExists(rpcService, []string{"some-command"}, pluginList, nil, PluginLimits{})
+			Expect(ran).To(BeTrue())
+			Expect(exitCode).To(Equal(3))
+
+			_, err := rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,155 @@
+// Package apidiscovery locates the Cloud Controller API of a foundation from
+// the URL of an app deployed on it.
+package apidiscovery
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WellKnownPath is the path, relative to a domain, of the optional document
+// naming the API of the foundation serving that domain. The document is a
+// JSON object with an "api" key holding the API URL.
+const WellKnownPath = "/.well-known/cf-api"
+
+// systemDomainPrefixes are the labels commonly used for the system domain
+// when it is a sibling of the app domain.
+var systemDomainPrefixes = []string{"system", "sys"}
+
+// NoAPIFoundError is returned when none of the candidate API URLs answer as a
+// Cloud Controller.
+type NoAPIFoundError struct {
+	AppURL string
+	Tried  []string
+}
+
+func (e NoAPIFoundError) Error() string {
+	return fmt.Sprintf("no API found for %s, tried %s", e.AppURL, strings.Join(e.Tried, ", "))
+}
+
+type Discoverer struct {
+	HTTPClient *http.Client
+}
+
+func NewDiscoverer(skipSSLValidation bool, dialTimeout time.Duration) *Discoverer {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   dialTimeout,
+		}).DialContext,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: skipSSLValidation,
+		},
+	}
+
+	return &Discoverer{
+		HTTPClient: &http.Client{
+			Transport: tr,
+			Timeout:   dialTimeout,
+		},
+	}
+}
+
+// Discover returns the URL of the Cloud Controller API serving the app at
+// appURL. For each parent domain of the app's host name, starting with the
+// closest, the domain's well-known document is consulted first and then
+// api.DOMAIN, api.system.DOMAIN and api.sys.DOMAIN are probed. The first
+// URL that answers as a Cloud Controller is returned.
+func (discoverer Discoverer) Discover(appURL string) (string, error) {
+	domains, err := ParentDomains(appURL)
+	if err != nil {
+		return "", err
+	}
+
+	var tried []string
+	for _, domain := range domains {
+		wellKnownURL := "https://" + domain + WellKnownPath
+		tried = append(tried, wellKnownURL)
+		if api, ok := discoverer.wellKnownAPI(wellKnownURL); ok && discoverer.isCloudController(api) {
+			return api, nil
+		}
+
+		for _, candidate := range conventionalAPIs(domain) {
+			tried = append(tried, candidate)
+			if discoverer.isCloudController(candidate) {
+				return candidate, nil
+			}
+		}
+	}
+
+	return "", NoAPIFoundError{AppURL: appURL, Tried: tried}
+}
+
+// ParentDomains returns the parent domains of the host name in appURL,
+// closest first. Top-level domains are not included.
+func ParentDomains(appURL string) ([]string, error) {
+	if !strings.Contains(appURL, "://") {
+		appURL = "https://" + appURL
+	}
+
+	parsedURL, err := url.Parse(appURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := parsedURL.Hostname()
+	if net.ParseIP(host) != nil {
+		return nil, fmt.Errorf("%s is an IP address, not a host name", host)
+	}
+
+	labels := strings.Split(strings.Trim(host, "."), ".")
+	var domains []string
+	for i := 1; i < len(labels)-1; i++ {
+		domains = append(domains, strings.Join(labels[i:], "."))
+	}
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("%s has no parent domain", host)
+	}
+
+	return domains, nil
+}
+
+func conventionalAPIs(domain string) []string {
+	apis := []string{"https://api." + domain}
+	for _, prefix := range systemDomainPrefixes {
+		apis = append(apis, "https://api."+prefix+"."+domain)
+	}
+	return apis
+}
+
+func (discoverer Discoverer) wellKnownAPI(wellKnownURL string) (string, bool) {
+	var document struct {
+		API string `json:"api"`
+	}
+	if !discoverer.getJSON(wellKnownURL, &document) || document.API == "" {
+		return "", false
+	}
+	return strings.TrimSuffix(document.API, "/"), true
+}
+
+func (discoverer Discoverer) isCloudController(api string) bool {
+	var info struct {
+		APIVersion string `json:"api_version"`
+	}
+	return discoverer.getJSON(api+"/v2/info", &info) && info.APIVersion != ""
+}
+
+func (discoverer Discoverer) getJSON(url string, v interface{}) bool {
+	response, err := discoverer.HTTPClient.Get(url)
+	if err != nil {
+		return false
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return false
+	}
+	return json.NewDecoder(response.Body).Decode(v) == nil
+}
@@ -0,0 +1,123 @@
+package apidiscovery_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/apidiscovery"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeTransport map[string]string
+
+func (transport fakeTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	body, ok := transport[request.URL.String()]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    request,
+	}, nil
+}
+
+var _ = Describe("Discoverer", func() {
+	var (
+		responses  fakeTransport
+		discoverer *Discoverer
+	)
+
+	BeforeEach(func() {
+		responses = fakeTransport{}
+		discoverer = &Discoverer{HTTPClient: &http.Client{Transport: responses}}
+	})
+
+	Describe("Discover", func() {
+		var (
+			api        string
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			api, executeErr = discoverer.Discover("myapp.apps.example.com")
+		})
+
+		When("the app domain publishes a well-known document", func() {
+			BeforeEach(func() {
+				responses["https://apps.example.com/.well-known/cf-api"] = `{"api": "https://cf.example.com/"}`
+				responses["https://cf.example.com/v2/info"] = `{"api_version": "2.100.0"}`
+				responses["https://api.apps.example.com/v2/info"] = `{"api_version": "2.100.0"}`
+			})
+
+			It("returns the API named in the document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(api).To(Equal("https://cf.example.com"))
+			})
+		})
+
+		When("the API follows the api.SYSTEM_DOMAIN convention", func() {
+			BeforeEach(func() {
+				responses["https://api.system.example.com/v2/info"] = `{"api_version": "2.100.0"}`
+			})
+
+			It("returns the first API that answers as a Cloud Controller", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(api).To(Equal("https://api.system.example.com"))
+			})
+		})
+
+		When("a candidate does not answer as a Cloud Controller", func() {
+			BeforeEach(func() {
+				responses["https://api.apps.example.com/v2/info"] = `<html></html>`
+				responses["https://api.example.com/v2/info"] = `{"api_version": "2.100.0"}`
+			})
+
+			It("skips it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(api).To(Equal("https://api.example.com"))
+			})
+		})
+
+		When("no candidate answers", func() {
+			It("returns a NoAPIFoundError listing the URLs tried", func() {
+				Expect(executeErr).To(MatchError(NoAPIFoundError{
+					AppURL: "myapp.apps.example.com",
+					Tried: []string{
+						"https://apps.example.com/.well-known/cf-api",
+						"https://api.apps.example.com",
+						"https://api.system.apps.example.com",
+						"https://api.sys.apps.example.com",
+						"https://example.com/.well-known/cf-api",
+						"https://api.example.com",
+						"https://api.system.example.com",
+						"https://api.sys.example.com",
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("ParentDomains", func() {
+		It("returns the parent domains of the host, closest first", func() {
+			Expect(ParentDomains("https://myapp.apps.example.com:443/some/path")).To(Equal([]string{"apps.example.com", "example.com"}))
+		})
+
+		It("accepts a host name without a scheme", func() {
+			Expect(ParentDomains("myapp.example.com")).To(Equal([]string{"example.com"}))
+		})
+
+		It("errors when the host has no parent domain", func() {
+			_, err := ParentDomains("example.com")
+			Expect(err).To(MatchError("example.com has no parent domain"))
+		})
+
+		It("errors when the host is an IP address", func() {
+			_, err := ParentDomains("10.0.0.1")
+			Expect(err).To(MatchError("10.0.0.1 is an IP address, not a host name"))
+		})
+	})
+})
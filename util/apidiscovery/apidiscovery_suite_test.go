@@ -0,0 +1,13 @@
+package apidiscovery_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAPIDiscovery(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "API Discovery Suite")
+}
@@ -0,0 +1,11 @@
+// Package browser opens URLs in the user's default web browser.
+package browser
+
+// Browser opens URLs with the platform's default browser.
+type Browser struct{}
+
+// Open starts the default browser on the given URL without waiting for it to
+// exit.
+func (Browser) Open(url string) error {
+	return openCommand(url).Start()
+}
@@ -0,0 +1,13 @@
+package cfignore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCfignore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CF Ignore Suite")
+}
@@ -0,0 +1,211 @@
+// Package cfignore implements the gitignore style pattern matching used to
+// exclude files from application uploads.
+//
+// Patterns follow the rules described at https://git-scm.com/docs/gitignore:
+// later patterns take precedence over earlier ones, a leading "!" re-includes
+// a previously excluded path, a trailing "/" only matches directories, a
+// pattern containing a "/" is anchored to the root of the application, and
+// "**" matches any number of directories. As with git, a path cannot be
+// re-included if one of its parent directories is excluded.
+package cfignore
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Rule is a single compiled ignore pattern.
+type Rule struct {
+	// Pattern is the pattern as it was written.
+	Pattern string
+	// Source is the file the pattern was read from, or a description of where
+	// it came from.
+	Source string
+	// Line is the 1-based line number of the pattern in Source.
+	Line int
+	// Negate is true for patterns starting with "!".
+	Negate bool
+
+	directoryOnly bool
+	expression    *regexp.Regexp
+}
+
+func (rule Rule) matches(path string, isDir bool) bool {
+	if rule.directoryOnly && !isDir {
+		return false
+	}
+	return rule.expression.MatchString(path)
+}
+
+// Matcher decides whether paths are ignored based on an ordered list of rules.
+type Matcher struct {
+	rules []Rule
+}
+
+// NewMatcher returns an empty Matcher which ignores nothing.
+func NewMatcher() *Matcher {
+	return new(Matcher)
+}
+
+// AddLines compiles the provided lines as if they were the contents of an
+// ignore file named source. Blank lines and comments are skipped.
+func (matcher *Matcher) AddLines(source string, lines ...string) {
+	for index, line := range lines {
+		rule, ok := parseRule(line)
+		if !ok {
+			continue
+		}
+		rule.Source = source
+		rule.Line = index + 1
+		matcher.rules = append(matcher.rules, rule)
+	}
+}
+
+// AddFile compiles the patterns in the ignore file at path.
+func (matcher *Matcher) AddFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	matcher.AddLines(path, lines...)
+	return nil
+}
+
+// Rules returns the compiled rules in the order they are evaluated.
+func (matcher *Matcher) Rules() []Rule {
+	return matcher.rules
+}
+
+// Match reports whether the provided slash separated path, relative to the
+// application root, is ignored. The returned Rule is the rule that decided the
+// outcome; it is the zero Rule when no rule applies to the path.
+func (matcher *Matcher) Match(path string, isDir bool) (Rule, bool) {
+	path = strings.Trim(path, "/")
+	if path == "" || path == "." {
+		return Rule{}, false
+	}
+
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		if rule, ignored := matcher.matchSelf(strings.Join(segments[:i], "/"), true); ignored {
+			return rule, true
+		}
+	}
+
+	return matcher.matchSelf(path, isDir)
+}
+
+// Ignored is a convenience wrapper around Match.
+func (matcher *Matcher) Ignored(path string, isDir bool) bool {
+	_, ignored := matcher.Match(path, isDir)
+	return ignored
+}
+
+func (matcher *Matcher) matchSelf(path string, isDir bool) (Rule, bool) {
+	for i := len(matcher.rules) - 1; i >= 0; i-- {
+		rule := matcher.rules[i]
+		if rule.matches(path, isDir) {
+			return rule, !rule.Negate
+		}
+	}
+	return Rule{}, false
+}
+
+func parseRule(line string) (Rule, bool) {
+	rule := Rule{Pattern: strings.TrimRight(line, "\r")}
+	pattern := trimTrailingSpaces(rule.Pattern)
+
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return Rule{}, false
+	}
+
+	if strings.HasPrefix(pattern, "!") {
+		rule.Negate = true
+		pattern = pattern[1:]
+	}
+
+	if strings.HasSuffix(pattern, "/") {
+		rule.directoryOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if pattern == "" {
+		return Rule{}, false
+	}
+
+	expression := "^"
+	if !anchored {
+		expression += "(?:.*/)?"
+	}
+	expression += translate(pattern) + "$"
+
+	var err error
+	rule.expression, err = regexp.Compile(expression)
+	if err != nil {
+		return Rule{}, false
+	}
+	return rule, true
+}
+
+// trimTrailingSpaces removes unescaped trailing spaces.
+func trimTrailingSpaces(pattern string) string {
+	for strings.HasSuffix(pattern, " ") && !strings.HasSuffix(pattern, `\ `) {
+		pattern = pattern[:len(pattern)-1]
+	}
+	return pattern
+}
+
+// translate converts a gitignore glob into a regular expression.
+func translate(pattern string) string {
+	var expression strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		char := pattern[i]
+		switch {
+		case char == '\\' && i+1 < len(pattern):
+			i++
+			expression.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		case strings.HasPrefix(pattern[i:], "**/") && (i == 0 || pattern[i-1] == '/'):
+			expression.WriteString("(?:.*/)?")
+			i += 2
+		case pattern[i:] == "**" && (i == 0 || pattern[i-1] == '/'):
+			expression.WriteString(".*")
+			i++
+		case char == '*':
+			expression.WriteString("[^/]*")
+		case char == '?':
+			expression.WriteString("[^/]")
+		case char == '[':
+			end := strings.Index(pattern[i+1:], "]")
+			if end < 0 {
+				expression.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expression.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 1
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	return expression.String()
+}
@@ -0,0 +1,109 @@
+package cfignore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/cfignore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Matcher", func() {
+	DescribeTable("Ignored",
+		func(lines []string, path string, isDir bool, expected bool) {
+			matcher := NewMatcher()
+			matcher.AddLines(".cfignore", lines...)
+			Expect(matcher.Ignored(path, isDir)).To(Equal(expected))
+		},
+
+		Entry("no rules", nil, "a.txt", false, false),
+		Entry("comments and blank lines", []string{"# a.txt", ""}, "a.txt", false, false),
+		Entry("escaped hash", []string{`\#a.txt`}, "#a.txt", false, true),
+		Entry("trailing spaces are trimmed", []string{"a.txt  "}, "a.txt", false, true),
+		Entry("escaped trailing space is kept", []string{`a.txt\ `}, "a.txt ", false, true),
+
+		Entry("basename matches at the root", []string{"*.log"}, "debug.log", false, true),
+		Entry("basename matches in subdirectories", []string{"*.log"}, "logs/debug.log", false, true),
+		Entry("star does not cross directories", []string{"logs/*.log"}, "logs/old/debug.log", false, false),
+		Entry("question mark", []string{"debug?.log"}, "debug1.log", false, true),
+		Entry("character class", []string{"debug[0-9].log"}, "debug7.log", false, true),
+		Entry("negated character class", []string{"debug[!0-9].log"}, "debug7.log", false, false),
+
+		Entry("leading slash anchors to the root", []string{"/build"}, "build", true, true),
+		Entry("leading slash does not match nested", []string{"/build"}, "src/build", true, false),
+		Entry("inner slash anchors to the root", []string{"docs/build"}, "src/docs/build", true, false),
+
+		Entry("directory pattern matches directories", []string{"tmp/"}, "tmp", true, true),
+		Entry("directory pattern does not match files", []string{"tmp/"}, "tmp", false, false),
+		Entry("directory pattern matches nested directories", []string{"tmp/"}, "a/tmp", true, true),
+		Entry("contents of ignored directory", []string{"tmp/"}, "tmp/a/b.txt", false, true),
+
+		Entry("leading double star", []string{"**/foo"}, "a/b/foo", false, true),
+		Entry("trailing double star", []string{"abc/**"}, "abc/d/e", false, true),
+		Entry("trailing double star does not match the directory", []string{"abc/**"}, "abc", true, false),
+		Entry("inner double star with no directories", []string{"a/**/b"}, "a/b", false, true),
+		Entry("inner double star with directories", []string{"a/**/b"}, "a/x/y/b", false, true),
+
+		Entry("negation re-includes a file", []string{"*.log", "!keep.log"}, "keep.log", false, false),
+		Entry("later rules win", []string{"!keep.log", "*.log"}, "keep.log", false, true),
+		Entry("escaped exclamation mark", []string{`\!important`}, "!important", false, true),
+		Entry("negation inside an ignored directory has no effect", []string{"vendor/", "!vendor/keep.go"}, "vendor/keep.go", false, true),
+		Entry("negation of contents of a directory", []string{"vendor/*", "!vendor/keep.go"}, "vendor/keep.go", false, false),
+	)
+
+	Describe("Match", func() {
+		It("returns the rule that decided the outcome", func() {
+			matcher := NewMatcher()
+			matcher.AddLines("defaults", ".git")
+			matcher.AddLines("/app/.cfignore", "# comment", "node_modules/", "*.log", "!keep.log")
+
+			rule, ignored := matcher.Match("node_modules/a/b.js", false)
+			Expect(ignored).To(BeTrue())
+			Expect(rule.Pattern).To(Equal("node_modules/"))
+			Expect(rule.Source).To(Equal("/app/.cfignore"))
+			Expect(rule.Line).To(Equal(2))
+
+			rule, ignored = matcher.Match("keep.log", false)
+			Expect(ignored).To(BeFalse())
+			Expect(rule.Negate).To(BeTrue())
+			Expect(rule.Line).To(Equal(4))
+
+			rule, ignored = matcher.Match("main.go", false)
+			Expect(ignored).To(BeFalse())
+			Expect(rule).To(Equal(Rule{}))
+		})
+	})
+
+	Describe("AddFile", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "cfignore")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("compiles the patterns in the file", func() {
+			path := filepath.Join(tmpDir, ".cfignore")
+			Expect(ioutil.WriteFile(path, []byte("*.log\r\n!keep.log\r\n"), 0600)).To(Succeed())
+
+			matcher := NewMatcher()
+			Expect(matcher.AddFile(path)).To(Succeed())
+			Expect(matcher.Rules()).To(HaveLen(2))
+			Expect(matcher.Ignored("debug.log", false)).To(BeTrue())
+			Expect(matcher.Ignored("keep.log", false)).To(BeFalse())
+		})
+
+		It("returns an error when the file cannot be read", func() {
+			Expect(NewMatcher().AddFile(filepath.Join(tmpDir, "missing"))).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package clissh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// LoadSigner reads the unencrypted private key in identityFile. When an
+// OpenSSH certificate for the key exists next to it, named with a
+// "-cert.pub" suffix like ssh expects, the returned signer presents the
+// certificate instead of the bare key.
+func LoadSigner(identityFile string) (ssh.Signer, error) {
+	privateKey, err := ioutil.ReadFile(identityFile)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse private key %s: %s", identityFile, err)
+	}
+
+	rawCertificate, err := ioutil.ReadFile(identityFile + "-cert.pub")
+	if os.IsNotExist(err) {
+		return signer, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey(rawCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse certificate %s-cert.pub: %s", identityFile, err)
+	}
+	certificate, ok := publicKey.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("%s-cert.pub is not an SSH certificate", identityFile)
+	}
+
+	return ssh.NewCertSigner(certificate, signer)
+}
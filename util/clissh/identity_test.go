@@ -0,0 +1,98 @@
+package clissh_test
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ = Describe("LoadSigner", func() {
+	var (
+		tempDir      string
+		identityFile string
+		signer       ssh.Signer
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "cli-ssh-identity")
+		Expect(err).ToNot(HaveOccurred())
+
+		privateKeyBytes, err := ioutil.ReadFile(filepath.Join("..", "..", "fixtures", "private-key"))
+		Expect(err).ToNot(HaveOccurred())
+
+		identityFile = filepath.Join(tempDir, "id_rsa")
+		Expect(ioutil.WriteFile(identityFile, privateKeyBytes, 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		signer, executeErr = LoadSigner(identityFile)
+	})
+
+	It("returns a signer for the private key", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(signer.PublicKey().Marshal()).To(Equal(TestPrivateKey.PublicKey().Marshal()))
+	})
+
+	When("a certificate exists next to the key", func() {
+		BeforeEach(func() {
+			certificate := &ssh.Certificate{
+				Key:             TestPrivateKey.PublicKey(),
+				CertType:        ssh.UserCert,
+				ValidPrincipals: []string{"some-user"},
+				ValidBefore:     ssh.CertTimeInfinity,
+			}
+			Expect(certificate.SignCert(rand.Reader, TestHostKey)).To(Succeed())
+			Expect(ioutil.WriteFile(identityFile+"-cert.pub", ssh.MarshalAuthorizedKey(certificate), 0600)).To(Succeed())
+		})
+
+		It("returns a signer presenting the certificate", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			certificate, ok := signer.PublicKey().(*ssh.Certificate)
+			Expect(ok).To(BeTrue())
+			Expect(certificate.ValidPrincipals).To(ConsistOf("some-user"))
+		})
+	})
+
+	When("the file next to the key is not a certificate", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(identityFile+"-cert.pub", ssh.MarshalAuthorizedKey(TestPrivateKey.PublicKey()), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(identityFile + "-cert.pub is not an SSH certificate"))
+		})
+	})
+
+	When("the key cannot be parsed", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(identityFile, []byte("not a key"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(executeErr.Error()).To(HavePrefix("Unable to parse private key " + identityFile))
+		})
+	})
+
+	When("the key does not exist", func() {
+		BeforeEach(func() {
+			identityFile = filepath.Join(tempDir, "missing")
+		})
+
+		It("returns an error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+})
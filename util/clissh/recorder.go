@@ -0,0 +1,90 @@
+package clissh
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// SessionRecorder writes the output of an interactive session to a writer in
+// the asciicast v2 format, so that it can be replayed with asciinema.
+type SessionRecorder struct {
+	writer    io.Writer
+	startTime time.Time
+	mutex     sync.Mutex
+}
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// NewSessionRecorder returns a SessionRecorder that writes to writer.
+func NewSessionRecorder(writer io.Writer) *SessionRecorder {
+	return &SessionRecorder{
+		writer: writer,
+	}
+}
+
+// Start writes the asciicast header for a terminal of the given size and
+// begins timing events from now.
+func (r *SessionRecorder) Start(width int, height int) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.startTime = time.Now()
+
+	env := map[string]string{}
+	if term := os.Getenv("TERM"); term != "" {
+		env["TERM"] = term
+	}
+	if shell := os.Getenv("SHELL"); shell != "" {
+		env["SHELL"] = shell
+	}
+
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.startTime.Unix(),
+		Env:       env,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(r.writer, "%s\n", header)
+	return err
+}
+
+// Write records p as an output event. It always reports that all of p was
+// written so that a failing recording never interrupts the session itself.
+func (r *SessionRecorder) Write(p []byte) (int, error) {
+	_ = r.writeEvent("o", string(p))
+	return len(p), nil
+}
+
+// Resize records a change of the terminal size.
+func (r *SessionRecorder) Resize(width int, height int) {
+	_ = r.writeEvent("r", fmt.Sprintf("%dx%d", width, height))
+}
+
+func (r *SessionRecorder) writeEvent(eventType string, data string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	elapsed := time.Since(r.startTime).Seconds()
+	event, err := json.Marshal([]interface{}{elapsed, eventType, data})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(r.writer, "%s\n", event)
+	return err
+}
@@ -0,0 +1,57 @@
+package clissh_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SessionRecorder", func() {
+	var (
+		recording *bytes.Buffer
+		recorder  *SessionRecorder
+	)
+
+	BeforeEach(func() {
+		recording = new(bytes.Buffer)
+		recorder = NewSessionRecorder(recording)
+	})
+
+	recordedLines := func() []string {
+		return strings.Split(strings.TrimSpace(recording.String()), "\n")
+	}
+
+	It("writes an asciicast v2 header followed by timed events", func() {
+		Expect(recorder.Start(120, 30)).To(Succeed())
+
+		n, err := recorder.Write([]byte("hello \"world\"\r\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(15))
+
+		recorder.Resize(80, 24)
+
+		lines := recordedLines()
+		Expect(lines).To(HaveLen(3))
+
+		var header map[string]interface{}
+		Expect(json.Unmarshal([]byte(lines[0]), &header)).To(Succeed())
+		Expect(header).To(HaveKeyWithValue("version", BeNumerically("==", 2)))
+		Expect(header).To(HaveKeyWithValue("width", BeNumerically("==", 120)))
+		Expect(header).To(HaveKeyWithValue("height", BeNumerically("==", 30)))
+		Expect(header).To(HaveKey("timestamp"))
+
+		var output []interface{}
+		Expect(json.Unmarshal([]byte(lines[1]), &output)).To(Succeed())
+		Expect(output).To(HaveLen(3))
+		Expect(output[0]).To(BeNumerically(">=", 0))
+		Expect(output[1:]).To(Equal([]interface{}{"o", "hello \"world\"\r\n"}))
+
+		var resize []interface{}
+		Expect(json.Unmarshal([]byte(lines[2]), &resize)).To(Succeed())
+		Expect(resize[1:]).To(Equal([]interface{}{"r", "80x24"}))
+	})
+})
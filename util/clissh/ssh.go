@@ -1,6 +1,7 @@
 package clissh
 
 import (
+	"bytes"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -8,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
 	"os/signal"
@@ -45,6 +47,7 @@ type SecureShell struct {
 
 	localListeners    []net.Listener
 	keepAliveInterval time.Duration
+	recorder          *SessionRecorder
 }
 
 func NewDefaultSecureShell() *SecureShell {
@@ -83,11 +86,26 @@ func (c *SecureShell) Close() error {
 }
 
 func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint string, appSSHHostKeyFingerprint string, skipHostValidation bool) error {
+	return c.connect(username, ssh.Password(passcode), appSSHEndpoint, appSSHHostKeyFingerprint, skipHostValidation)
+}
+
+// ConnectWithKey authenticates with the private key in identityFile, as
+// loaded by LoadSigner, instead of a one-time passcode.
+func (c *SecureShell) ConnectWithKey(username string, identityFile string, appSSHEndpoint string, appSSHHostKeyFingerprint string, skipHostValidation bool) error {
+	signer, err := LoadSigner(identityFile)
+	if err != nil {
+		return err
+	}
+
+	return c.connect(username, ssh.PublicKeys(signer), appSSHEndpoint, appSSHHostKeyFingerprint, skipHostValidation)
+}
+
+func (c *SecureShell) connect(username string, authMethod ssh.AuthMethod, appSSHEndpoint string, appSSHHostKeyFingerprint string, skipHostValidation bool) error {
 	hostKeyCallbackFunction := fingerprintCallback(skipHostValidation, appSSHHostKeyFingerprint)
 
 	clientConfig := &ssh.ClientConfig{
 		User:            username,
-		Auth:            []ssh.AuthMethod{ssh.Password(passcode)},
+		Auth:            []ssh.AuthMethod{authMethod},
 		HostKeyCallback: hostKeyCallbackFunction,
 	}
 
@@ -103,6 +121,12 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 	return nil
 }
 
+// RecordSession makes subsequent interactive sessions write a recording of
+// their terminal output to writer.
+func (c *SecureShell) RecordSession(writer io.Writer) {
+	c.recorder = NewSessionRecorder(writer)
+}
+
 func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYRequest) error {
 	session, err := c.secureClient.NewSession()
 	if err != nil {
@@ -130,6 +154,15 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	stdinFd, stdinIsTerminal := c.terminalHelper.GetFdInfo(stdin)
 	stdoutFd, stdoutIsTerminal := c.terminalHelper.GetFdInfo(stdout)
 
+	if c.recorder != nil {
+		err = c.recorder.Start(c.getWindowDimensions(stdoutFd))
+		if err != nil {
+			return fmt.Errorf("Unable to record session: %s", err.Error())
+		}
+		stdout = io.MultiWriter(stdout, c.recorder)
+		stderr = io.MultiWriter(stderr, c.recorder)
+	}
+
 	if c.shouldAllocateTerminal(commands, terminalRequest, stdinIsTerminal) {
 		modes := ssh.TerminalModes{
 			ssh.ECHO:          1,
@@ -219,6 +252,63 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+// RunCommand runs the given command on the remote host without a terminal and
+// returns everything it wrote to stdout and stderr.
+func (c *SecureShell) RunCommand(command string) ([]byte, []byte, error) {
+	var stdout bytes.Buffer
+	stderr, err := c.StreamCommand(command, &stdout)
+	return stdout.Bytes(), stderr, err
+}
+
+// StreamCommand runs the given command on the remote host without a terminal,
+// copying its stdout to the given writer as it is produced, and returns
+// everything it wrote to stderr.
+func (c *SecureShell) StreamCommand(command string, stdout io.Writer) ([]byte, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	var writeErr error
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		_, writeErr = io.Copy(stdout, outPipe)
+		if writeErr != nil {
+			// Keep draining so that the remote command does not block on a
+			// full pipe and the session can finish.
+			_, _ = io.Copy(ioutil.Discard, outPipe)
+		}
+	}()
+	go copyAndDone(wg, &stderr, errPipe)
+
+	wg.Wait()
+	err = session.Wait()
+	if err == nil {
+		err = writeErr
+	}
+	return stderr.Bytes(), err
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -302,6 +392,10 @@ func (c *SecureShell) resize(resized <-chan os.Signal, session SecureSession, te
 			log.Errorln("window-change:", err)
 		}
 
+		if c.recorder != nil {
+			c.recorder.Resize(width, height)
+		}
+
 		previousWidth = width
 		previousHeight = height
 	}
@@ -7,11 +7,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -660,6 +662,31 @@ var _ = Describe("CLI SSH", func() {
 				Expect(sessionErr).To(MatchError("error result"))
 			})
 
+			When("the session is being recorded", func() {
+				var recording *bytes.Buffer
+
+				BeforeEach(func() {
+					recording = new(bytes.Buffer)
+					fakeTerminalHelper.GetWinsizeReturns(&term.Winsize{Width: 100, Height: 40}, nil)
+					interactiveSessionInvoker = func(secureShell *SecureShell) {
+						secureShell.RecordSession(recording)
+						sessionErr = secureShell.InteractiveSession(commands, terminalRequest)
+					}
+				})
+
+				It("records the header and the session output", func() {
+					Expect(sessionErr).To(MatchError("error result"))
+
+					lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+					Expect(lines).To(HaveLen(3))
+					Expect(lines[0]).To(MatchRegexp(`^\{"version":2,"width":100,"height":40,"timestamp":\d+`))
+					Expect(lines[1:]).To(ConsistOf(
+						MatchRegexp(`^\[[0-9.e-]+,"o","\\u0001"\]$`),
+						MatchRegexp(`^\[[0-9.e-]+,"o","\\u0002"\]$`),
+					))
+				})
+			})
+
 			When("the session terminates before stream copies complete", func() {
 				var sessionErrorCh chan error
 
@@ -1148,6 +1175,122 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RunCommand", func() {
+		var (
+			stdout, stderr []byte
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-output"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some-error-output"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			stdout, stderr, runErr = secureShell.RunCommand("cat some-file")
+		})
+
+		It("runs the command without a terminal and returns its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal([]byte("some-output")))
+			Expect(stderr).To(Equal([]byte("some-error-output")))
+
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("cat some-file"))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("creating the session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("woops"))
+			})
+
+			It("returns an error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: woops"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("oh well"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("oh well"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the command exits with an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the error along with the output", func() {
+				Expect(runErr).To(MatchError("exit status 1"))
+				Expect(stderr).To(Equal([]byte("some-error-output")))
+			})
+		})
+	})
+
+	Describe("StreamCommand", func() {
+		var (
+			stdout    *bytes.Buffer
+			writer    io.Writer
+			stderr    []byte
+			streamErr error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			writer = stdout
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-output"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some-error-output"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			stderr, streamErr = secureShell.StreamCommand("cat some-file", writer)
+		})
+
+		It("copies the command's stdout to the writer and returns its stderr", func() {
+			Expect(streamErr).NotTo(HaveOccurred())
+			Expect(stdout.String()).To(Equal("some-output"))
+			Expect(stderr).To(Equal([]byte("some-error-output")))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("cat some-file"))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+		})
+
+		When("writing the output fails", func() {
+			BeforeEach(func() {
+				writer = failingWriter{}
+			})
+
+			It("waits for the command and returns the write error", func() {
+				Expect(streamErr).To(MatchError("write failed"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the command exits with an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the command error", func() {
+				Expect(streamErr).To(MatchError("exit status 1"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -1216,3 +1359,9 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 })
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
@@ -0,0 +1,72 @@
+// Package completion installs shell completion for the CLI. Completions are
+// provided by the command parser itself when the GO_FLAGS_COMPLETION
+// environment variable is set; the shell scripts only forward the words being
+// completed to it.
+package completion
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const bashScriptTemplate = `# bash completion for Cloud Foundry CLI
+
+_%[1]s-cli() {
+    # All arguments except the first one
+    args=("${COMP_WORDS[@]:1:$COMP_CWORD}")
+    # Only split on newlines
+    local IFS=$'\n'
+    # Call completion (note that the first element of COMP_WORDS is
+    # the executable itself)
+    COMPREPLY=($(GO_FLAGS_COMPLETION=1 ${COMP_WORDS[0]} "${args[@]}"))
+    return 0
+}
+complete -F _%[1]s-cli %[1]s
+`
+
+// BashScript returns the bash completion script for the named binary.
+func BashScript(binaryName string) string {
+	return fmt.Sprintf(bashScriptTemplate, binaryName)
+}
+
+// InstallBash writes the bash completion script for the named binary to
+// scriptPath and sources it from the shell startup file at rcPath, unless the
+// startup file already does.
+func InstallBash(binaryName string, scriptPath string, rcPath string) error {
+	err := os.MkdirAll(filepath.Dir(scriptPath), 0700)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(scriptPath, []byte(BashScript(binaryName)), 0600)
+	if err != nil {
+		return err
+	}
+
+	sourceLine := fmt.Sprintf("[ -f %[1]s ] && source %[1]s", scriptPath)
+
+	rc, err := ioutil.ReadFile(rcPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, line := range strings.Split(string(rc), "\n") {
+		if strings.TrimSpace(line) == sourceLine {
+			return nil
+		}
+	}
+
+	rcFile, err := os.OpenFile(rcPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer rcFile.Close()
+
+	prefix := ""
+	if len(rc) > 0 && !strings.HasSuffix(string(rc), "\n") {
+		prefix = "\n"
+	}
+	_, err = fmt.Fprintf(rcFile, "%s%s\n", prefix, sourceLine)
+	return err
+}
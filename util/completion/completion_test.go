@@ -0,0 +1,64 @@
+package completion_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/completion"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Completion", func() {
+	Describe("BashScript", func() {
+		It("completes the named binary with the command parser", func() {
+			script := BashScript("cf")
+			Expect(script).To(ContainSubstring(`COMPREPLY=($(GO_FLAGS_COMPLETION=1 ${COMP_WORDS[0]} "${args[@]}"))`))
+			Expect(script).To(ContainSubstring("complete -F _cf-cli cf\n"))
+		})
+	})
+
+	Describe("InstallBash", func() {
+		var (
+			tmpDir     string
+			scriptPath string
+			rcPath     string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "completion")
+			Expect(err).ToNot(HaveOccurred())
+			scriptPath = filepath.Join(tmpDir, ".cf", "completion.bash")
+			rcPath = filepath.Join(tmpDir, ".bashrc")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("writes the script and sources it from the startup file once", func() {
+			Expect(ioutil.WriteFile(rcPath, []byte("export EDITOR=vi"), 0644)).To(Succeed())
+
+			Expect(InstallBash("cf", scriptPath, rcPath)).To(Succeed())
+			Expect(InstallBash("cf", scriptPath, rcPath)).To(Succeed())
+
+			script, err := ioutil.ReadFile(scriptPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(script)).To(Equal(BashScript("cf")))
+
+			rc, err := ioutil.ReadFile(rcPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rc)).To(Equal("export EDITOR=vi\n[ -f " + scriptPath + " ] && source " + scriptPath + "\n"))
+		})
+
+		It("creates the startup file when it does not exist", func() {
+			Expect(InstallBash("cf", scriptPath, rcPath)).To(Succeed())
+
+			rc, err := ioutil.ReadFile(rcPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rc)).To(Equal("[ -f " + scriptPath + " ] && source " + scriptPath + "\n"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package configv3
+
+// Accessible returns true when output is adapted for screen readers: prompts
+// read whole lines, progress bars are not drawn and tables announce how many
+// rows they have.
+func (config *Config) Accessible() bool {
+	return config.ConfigFile.Accessible
+}
+
+// SetAccessible sets whether output is adapted for screen readers.
+func (config *Config) SetAccessible(accessible bool) {
+	config.ConfigFile.Accessible = accessible
+}
@@ -0,0 +1,59 @@
+package configv3
+
+import "net/http"
+
+// reservedHeaders are the headers that the CLI or Go's HTTP client set
+// themselves, which a change reason must not replace.
+var reservedHeaders = map[string]bool{
+	"Accept":              true,
+	"Accept-Encoding":     true,
+	"Authorization":       true,
+	"Connection":          true,
+	"Content-Length":      true,
+	"Content-Type":        true,
+	"Cookie":              true,
+	"Host":                true,
+	"Proxy-Authorization": true,
+	"Transfer-Encoding":   true,
+	"User-Agent":          true,
+	"X-Vcap-Request-Id":   true,
+}
+
+// IsReservedHeader returns true when header is one that the CLI or Go's HTTP
+// client set themselves, in any case.
+func IsReservedHeader(header string) bool {
+	return reservedHeaders[http.CanonicalHeaderKey(header)]
+}
+
+// ChangeHeader returns the name of the header that carries the change reason
+// on requests that modify resources. No header name means no change reason is
+// sent, as does a reserved header name from a hand edited config file.
+func (config *Config) ChangeHeader() string {
+	if IsReservedHeader(config.ConfigFile.ChangeHeader) {
+		return ""
+	}
+	return config.ConfigFile.ChangeHeader
+}
+
+// SetChangeHeader sets the name of the header that carries the change reason
+// on requests that modify resources. An empty name removes it.
+func (config *Config) SetChangeHeader(header string) {
+	config.ConfigFile.ChangeHeader = header
+}
+
+// ChangeReason returns the change reason of the running command. This is
+// based off of:
+//   1. The --reason global flag
+//   2. The $CF_REASON environment variable
+func (config *Config) ChangeReason() string {
+	if config.Flags.Reason != "" {
+		return config.Flags.Reason
+	}
+	return config.ENV.CFReason
+}
+
+// SetChangeReason sets the change reason for the rest of the running command.
+// It is not saved to the config file.
+func (config *Config) SetChangeReason(reason string) {
+	config.Flags.Reason = reason
+}
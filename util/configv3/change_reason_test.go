@@ -0,0 +1,44 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Change Reason", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("stores the change header in the config file", func() {
+		config.SetChangeHeader("X-Change-Ticket")
+
+		Expect(config.ConfigFile.ChangeHeader).To(Equal("X-Change-Ticket"))
+		Expect(config.ChangeHeader()).To(Equal("X-Change-Ticket"))
+	})
+
+	It("does not return a reserved header name from the config file", func() {
+		config.ConfigFile.ChangeHeader = "authorization"
+
+		Expect(config.ChangeHeader()).To(BeEmpty())
+	})
+
+	It("prefers the --reason flag over $CF_REASON", func() {
+		config.ENV.CFReason = "CHG-env"
+		Expect(config.ChangeReason()).To(Equal("CHG-env"))
+
+		config.Flags.Reason = "CHG-flag"
+		Expect(config.ChangeReason()).To(Equal("CHG-flag"))
+	})
+
+	It("keeps a reason set during the command out of the config file", func() {
+		config.SetChangeReason("CHG12345")
+
+		Expect(config.ChangeReason()).To(Equal("CHG12345"))
+		Expect(config.ConfigFile).To(Equal(JSONConfig{}))
+	})
+})
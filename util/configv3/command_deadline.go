@@ -0,0 +1,31 @@
+package configv3
+
+import "time"
+
+// SetCommandTimeout sets a deadline, timeout from now, by which the running
+// command must finish. Once set, the staging, startup and polling timeouts are
+// shortened so that no wait extends past the deadline.
+func (config *Config) SetCommandTimeout(timeout time.Duration) {
+	config.commandDeadline = time.Now().Add(timeout)
+}
+
+// CommandDeadline returns the time by which the running command must finish,
+// or the zero time when no command timeout is set.
+func (config *Config) CommandDeadline() time.Time {
+	return config.commandDeadline
+}
+
+func (config *Config) limitToCommandDeadline(timeout time.Duration) time.Duration {
+	if config.commandDeadline.IsZero() {
+		return timeout
+	}
+
+	remaining := time.Until(config.commandDeadline)
+	if remaining < 0 {
+		return 0
+	}
+	if remaining < timeout {
+		return remaining
+	}
+	return timeout
+}
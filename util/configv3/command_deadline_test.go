@@ -0,0 +1,63 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Command Deadline", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+		config.ENV.CFStagingTimeout = "10"
+	})
+
+	When("no command timeout is set", func() {
+		It("does not limit the timeouts", func() {
+			Expect(config.CommandDeadline().IsZero()).To(BeTrue())
+			Expect(config.StagingTimeout()).To(Equal(10 * time.Minute))
+			Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
+			Expect(config.OverallPollingTimeout()).To(Equal(DefaultOverallPollingTimeout))
+		})
+	})
+
+	When("the command timeout is shorter than the timeouts", func() {
+		BeforeEach(func() {
+			config.SetCommandTimeout(time.Minute)
+		})
+
+		It("limits the timeouts to the time remaining until the deadline", func() {
+			Expect(config.CommandDeadline()).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+			Expect(config.StagingTimeout()).To(BeNumerically("~", time.Minute, time.Second))
+			Expect(config.StartupTimeout()).To(BeNumerically("~", time.Minute, time.Second))
+			Expect(config.OverallPollingTimeout()).To(BeNumerically("~", time.Minute, time.Second))
+		})
+	})
+
+	When("the command timeout is longer than the timeouts", func() {
+		BeforeEach(func() {
+			config.SetCommandTimeout(time.Hour)
+		})
+
+		It("uses the timeouts", func() {
+			Expect(config.StagingTimeout()).To(Equal(10 * time.Minute))
+			Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
+		})
+	})
+
+	When("the deadline has passed", func() {
+		BeforeEach(func() {
+			config.SetCommandTimeout(-time.Second)
+		})
+
+		It("returns no time for the timeouts", func() {
+			Expect(config.StagingTimeout()).To(BeZero())
+			Expect(config.OverallPollingTimeout()).To(BeZero())
+		})
+	})
+})
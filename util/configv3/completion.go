@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// BashCompletionFilePath returns the file the bash completion script is
+// installed to.
+func BashCompletionFilePath() string {
+	return filepath.Join(configDirectory(), "completion.bash")
+}
@@ -4,6 +4,7 @@ package configv3
 import (
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/version"
 )
@@ -23,6 +24,14 @@ type Config struct {
 	// detectedSettings are settings detected when the config is loaded.
 	detectedSettings detectedSettings
 
+	// commandDeadline is the time by which the running command must finish,
+	// zero when the command has no --timeout.
+	commandDeadline time.Time
+
+	// localTarget is set when the running command targets the org and space
+	// of a local target file.
+	localTarget *localTargetOverride
+
 	pluginsConfig PluginsConfig
 }
 
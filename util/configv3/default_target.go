@@ -0,0 +1,25 @@
+package configv3
+
+// DefaultOrganization returns the name of the org targeted after login when
+// no org is provided.
+func (config *Config) DefaultOrganization() string {
+	return config.ConfigFile.DefaultOrganization
+}
+
+// SetDefaultOrganization sets the name of the org targeted after login. An
+// empty name removes the default.
+func (config *Config) SetDefaultOrganization(name string) {
+	config.ConfigFile.DefaultOrganization = name
+}
+
+// DefaultSpace returns the name of the space targeted after login when no
+// space is provided.
+func (config *Config) DefaultSpace() string {
+	return config.ConfigFile.DefaultSpace
+}
+
+// SetDefaultSpace sets the name of the space targeted after login. An empty
+// name removes the default.
+func (config *Config) SetDefaultSpace(name string) {
+	config.ConfigFile.DefaultSpace = name
+}
@@ -0,0 +1,23 @@
+package configv3
+
+import "strconv"
+
+// DryRun returns whether requests that modify resources should be printed
+// instead of sent. This is based off of:
+//   1. The --dry-run global flag
+//   2. The $CF_DRY_RUN environment variable
+//   3. Defaults to false
+func (config *Config) DryRun() bool {
+	if config.Flags.DryRun {
+		return true
+	}
+
+	if config.ENV.CFDryRun != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFDryRun)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry Run", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("defaults to false", func() {
+		Expect(config.DryRun()).To(BeFalse())
+	})
+
+	It("is enabled by the --dry-run flag", func() {
+		config.Flags.DryRun = true
+		Expect(config.DryRun()).To(BeTrue())
+	})
+
+	It("is enabled by $CF_DRY_RUN", func() {
+		config.ENV.CFDryRun = "true"
+		Expect(config.DryRun()).To(BeTrue())
+
+		config.ENV.CFDryRun = "not-a-bool"
+		Expect(config.DryRun()).To(BeFalse())
+	})
+})
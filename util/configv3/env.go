@@ -8,24 +8,29 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName        string
-	CFColor           string
-	CFDialTimeout     string
-	CFHome            string
-	CFLogLevel        string
-	CFPassword        string
-	CFPluginHome      string
-	CFStagingTimeout  string
-	CFStartupTimeout  string
-	CFTrace           string
-	CFUsername        string
-	DockerPassword    string
-	Experimental      string
-	ExperimentalLogin string
-	ForceTTY          string
-	HTTPSProxy        string
-	Lang              string
-	LCAll             string
+	BinaryName           string
+	CFColor              string
+	CFDialTimeout        string
+	CFDryRun             string
+	CFHome               string
+	CFInsecureAllowed    string
+	CFLogLevel           string
+	CFPassword           string
+	CFPluginHome         string
+	CFReason             string
+	CFStagingTimeout     string
+	CFStartupTimeout     string
+	CFTrace              string
+	CFUsageStatsEndpoint string
+	CFUserAgentSuffix    string
+	CFUsername           string
+	DockerPassword       string
+	Experimental         string
+	Features             map[string]string
+	ForceTTY             string
+	HTTPSProxy           string
+	Lang                 string
+	LCAll                string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -77,21 +82,6 @@ func (config *Config) Experimental() bool {
 	return false
 }
 
-// ExperimentalLogin is a temporary function during the rewrite of `cf login` that returns whether or not to run the rewritten login. This
-// is based off of:
-//   1. The $CF_EXPERIMENTAL_LOGIN environment variable if set
-//   2. Defaults to false
-func (config *Config) ExperimentalLogin() bool {
-	if config.ENV.ExperimentalLogin != "" {
-		envVal, err := strconv.ParseBool(config.ENV.ExperimentalLogin)
-		if err == nil {
-			return envVal
-		}
-	}
-
-	return false
-}
-
 // HTTPSProxy returns the proxy url that the CLI should use. The url is based
 // off of:
 //   1. The $https_proxy environment variable if set
@@ -128,8 +118,8 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
-// StagingTimeout returns the max time an application staging should take. The
-// time is based off of:
+// StagingTimeout returns the max time an application staging should take,
+// never extending past the command deadline. The time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
 //   2. Defaults to the DefaultStagingTimeout
 func (config *Config) StagingTimeout() time.Duration {
@@ -137,15 +127,15 @@ func (config *Config) StagingTimeout() time.Duration {
 		timeoutInMin, err := strconv.ParseFloat(config.ENV.CFStagingTimeout, 64)
 		timeoutInSec := int64(timeoutInMin * 60)
 		if err == nil {
-			return time.Duration(timeoutInSec) * time.Second
+			return config.limitToCommandDeadline(time.Duration(timeoutInSec) * time.Second)
 		}
 	}
 
-	return DefaultStagingTimeout
+	return config.limitToCommandDeadline(DefaultStagingTimeout)
 }
 
-// StartupTimeout returns the max time an application should take to start. The
-// time is based off of:
+// StartupTimeout returns the max time an application should take to start,
+// never extending past the command deadline. The time is based off of:
 //   1. The $CF_STARTUP_TIMEOUT environment variable if set
 //   2. Defaults to the DefaultStartupTimeout
 func (config *Config) StartupTimeout() time.Duration {
@@ -153,9 +143,9 @@ func (config *Config) StartupTimeout() time.Duration {
 		timeoutInMin, err := strconv.ParseFloat(config.ENV.CFStartupTimeout, 64)
 		timeoutInSec := int64(timeoutInMin * 60)
 		if err == nil {
-			return time.Duration(timeoutInSec) * time.Second
+			return config.limitToCommandDeadline(time.Duration(timeoutInSec) * time.Second)
 		}
 	}
 
-	return DefaultStartupTimeout
+	return config.limitToCommandDeadline(DefaultStartupTimeout)
 }
@@ -83,15 +83,20 @@ var _ = Describe("Config", func() {
 		Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
 	)
 
-	DescribeTable("ExperimentalLogin",
-		func(envVal string, expected bool) {
-			config.ENV.ExperimentalLogin = envVal
-			Expect(config.ExperimentalLogin()).To(Equal(expected))
+	DescribeTable("InsecureAllowed",
+		func(envVal string, forbidden bool, expected bool) {
+			config := Config{
+				ENV:        EnvOverride{CFInsecureAllowed: envVal},
+				ConfigFile: JSONConfig{InsecureForbidden: forbidden},
+			}
+			Expect(config.InsecureAllowed()).To(Equal(expected))
 		},
 
-		Entry("uses default value of false if environment value is not set", "", false),
-		Entry("uses environment value if a valid environment value is set", "true", true),
-		Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
+		Entry("defaults to true", "", false, true),
+		Entry("uses the config setting if the environment value is not set", "", true, false),
+		Entry("uses the environment value over the config setting", "true", true, true),
+		Entry("uses the environment value if it forbids skipping SSL validation", "false", false, false),
+		Entry("uses the config setting if an invalid environment value is set", "something-invalid", true, false),
 	)
 
 	DescribeTable("LogLevel",
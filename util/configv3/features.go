@@ -0,0 +1,94 @@
+package configv3
+
+import (
+	"sort"
+	"strconv"
+)
+
+// LoginV2Feature runs the refactored login command instead of the legacy one.
+const LoginV2Feature = "login-v2"
+
+// Feature is an experimental CLI feature that can be turned on per user with
+// 'cf enable-feature'. Features typically switch a command from its legacy
+// implementation to its refactored one.
+type Feature struct {
+	Name        string
+	Description string
+	// EnvVar, when set in the environment, overrides the feature's state in
+	// the config file.
+	EnvVar string
+}
+
+var features = []Feature{
+	{
+		Name:        LoginV2Feature,
+		Description: "Use the refactored login command",
+		EnvVar:      "CF_EXPERIMENTAL_LOGIN",
+	},
+}
+
+// Features returns all experimental features, sorted by name.
+func Features() []Feature {
+	sorted := make([]Feature, len(features))
+	copy(sorted, features)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// LookupFeature returns the experimental feature with the given name.
+func LookupFeature(name string) (Feature, bool) {
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature, true
+		}
+	}
+	return Feature{}, false
+}
+
+// FeatureEnabled returns whether or not the experimental feature is turned
+// on. This is based off of:
+//   1. The feature's environment variable if set to a valid boolean
+//   2. Whether the feature was enabled with 'cf enable-feature'
+func (config *Config) FeatureEnabled(name string) bool {
+	if envVal, err := strconv.ParseBool(config.ENV.Features[name]); err == nil {
+		return envVal
+	}
+
+	for _, enabled := range config.ConfigFile.EnabledFeatures {
+		if enabled == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetFeatureEnabled turns the experimental feature on or off in the config
+// file.
+func (config *Config) SetFeatureEnabled(name string, enabled bool) {
+	var enabledFeatures []string
+	for _, existing := range config.ConfigFile.EnabledFeatures {
+		if existing != name {
+			enabledFeatures = append(enabledFeatures, existing)
+		}
+	}
+	if enabled {
+		enabledFeatures = append(enabledFeatures, name)
+		sort.Strings(enabledFeatures)
+	}
+	config.ConfigFile.EnabledFeatures = enabledFeatures
+}
+
+func featuresFromEnv(getenv func(string) string) map[string]string {
+	var env map[string]string
+	for _, feature := range features {
+		if value := getenv(feature.EnvVar); value != "" {
+			if env == nil {
+				env = map[string]string{}
+			}
+			env[feature.Name] = value
+		}
+	}
+	return env
+}
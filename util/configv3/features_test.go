@@ -0,0 +1,57 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Features", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	Describe("LookupFeature", func() {
+		It("returns the feature with the given name", func() {
+			feature, found := LookupFeature(LoginV2Feature)
+			Expect(found).To(BeTrue())
+			Expect(feature.EnvVar).To(Equal("CF_EXPERIMENTAL_LOGIN"))
+		})
+
+		It("returns false for an unknown feature", func() {
+			_, found := LookupFeature("some-feature")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("SetFeatureEnabled", func() {
+		It("turns the feature on and off", func() {
+			config.SetFeatureEnabled(LoginV2Feature, true)
+			config.SetFeatureEnabled(LoginV2Feature, true)
+			Expect(config.FeatureEnabled(LoginV2Feature)).To(BeTrue())
+			Expect(config.ConfigFile.EnabledFeatures).To(Equal([]string{LoginV2Feature}))
+
+			config.SetFeatureEnabled(LoginV2Feature, false)
+			Expect(config.FeatureEnabled(LoginV2Feature)).To(BeFalse())
+			Expect(config.ConfigFile.EnabledFeatures).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("FeatureEnabled",
+		func(envVal string, enabledInConfig bool, expected bool) {
+			config.ENV.Features = map[string]string{LoginV2Feature: envVal}
+			config.SetFeatureEnabled(LoginV2Feature, enabledInConfig)
+			Expect(config.FeatureEnabled(LoginV2Feature)).To(Equal(expected))
+		},
+
+		Entry("defaults to false", "", false, false),
+		Entry("uses the config file value if the environment value is not set", "", true, true),
+		Entry("uses the environment value over the config file value", "false", true, false),
+		Entry("uses the environment value if a valid environment value is set", "true", false, true),
+		Entry("ignores an invalid environment value", "something-invalid", true, true),
+	)
+})
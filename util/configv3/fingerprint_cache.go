@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// FingerprintCacheDirectory returns the directory where file fingerprints
+// calculated during push are cached between invocations.
+func FingerprintCacheDirectory() string {
+	return filepath.Join(configDirectory(), "fingerprints")
+}
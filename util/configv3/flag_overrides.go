@@ -3,4 +3,7 @@ package configv3
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
 	Verbose bool
+	Reason  string
+	DryRun  bool
+	Timings bool
 }
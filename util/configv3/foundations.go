@@ -0,0 +1,56 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FoundationsDirectory returns the directory holding the foundations that can
+// be targeted with the global --foundations option. Each foundation is a
+// subdirectory used as CF_HOME when running commands against it, e.g. one set
+// up with 'CF_HOME=~/.cf/foundations/prod-eu cf login'.
+func FoundationsDirectory() string {
+	return filepath.Join(configDirectory(), "foundations")
+}
+
+// FoundationHome returns the CF_HOME directory of the named foundation.
+func FoundationHome(name string) string {
+	return filepath.Join(FoundationsDirectory(), name)
+}
+
+// FoundationExists returns true if the named foundation has a saved config.
+func FoundationExists(name string) bool {
+	_, err := os.Stat(filepath.Join(FoundationHome(name), ".cf", "config.json"))
+	return err == nil
+}
+
+// FoundationConfig returns a copy of config using the saved config of the named
+// foundation, for commands that talk to more than one foundation. Changes to
+// the copy, such as refreshed tokens, are not saved.
+func (config *Config) FoundationConfig(name string) (*Config, error) {
+	file, err := ioutil.ReadFile(filepath.Join(FoundationHome(name), ".cf", "config.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var configFile JSONConfig
+	err = json.Unmarshal(file, &configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if configFile.SSHOAuthClient == "" {
+		configFile.SSHOAuthClient = DefaultSSHOAuthClient
+	}
+
+	if configFile.UAAOAuthClient == "" {
+		configFile.UAAOAuthClient = DefaultUAAOAuthClient
+		configFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
+	}
+
+	foundationConfig := *config
+	foundationConfig.ConfigFile = configFile
+	return &foundationConfig, nil
+}
@@ -0,0 +1,69 @@
+package configv3_test
+
+import (
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Foundations", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("FoundationHome", func() {
+		It("returns the foundation's directory under the config directory", func() {
+			Expect(FoundationHome("prod-eu")).To(Equal(filepath.Join(homeDir, ".cf", "foundations", "prod-eu")))
+		})
+	})
+
+	Describe("FoundationExists", func() {
+		It("returns true when the foundation has a saved config", func() {
+			setConfig(FoundationHome("prod-eu"), `{}`)
+			Expect(FoundationExists("prod-eu")).To(BeTrue())
+		})
+
+		It("returns false when the foundation has no saved config", func() {
+			Expect(FoundationExists("prod-us")).To(BeFalse())
+		})
+	})
+
+	Describe("FoundationConfig", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			setConfig(homeDir, `{"Target": "https://api.home.com"}`)
+			setConfig(FoundationHome("prod-eu"), `{
+				"Target": "https://api.prod-eu.com",
+				"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"}
+			}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns a copy of the config using the foundation's saved config", func() {
+			foundationConfig, err := config.FoundationConfig("prod-eu")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundationConfig.Target()).To(Equal("https://api.prod-eu.com"))
+			Expect(foundationConfig.TargetedOrganization().Name).To(Equal("prod-org"))
+			Expect(foundationConfig.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+			Expect(config.Target()).To(Equal("https://api.home.com"))
+		})
+
+		It("returns an error when the foundation has no saved config", func() {
+			_, err := config.FoundationConfig("prod-us")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
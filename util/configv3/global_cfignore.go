@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// GlobalCFIgnoreFilePath returns the location of the user wide .cfignore file,
+// whose patterns apply to every application pushed by the user.
+func GlobalCFIgnoreFilePath() string {
+	return filepath.Join(homeDirectory(), ".cfignore")
+}
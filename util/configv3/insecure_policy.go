@@ -0,0 +1,27 @@
+package configv3
+
+import "strconv"
+
+// InsecureAllowed returns whether SSL validation may be skipped with
+// --skip-ssl-validation. This is based off of:
+//   1. The $CF_INSECURE_ALLOWED environment variable if set
+//   2. The insecure-allowed setting of the config
+//   3. Defaults to true
+// It is a user preference rather than a policy, since the user can change
+// both.
+func (config *Config) InsecureAllowed() bool {
+	if config.ENV.CFInsecureAllowed != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFInsecureAllowed)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return !config.ConfigFile.InsecureForbidden
+}
+
+// SetInsecureAllowed sets whether SSL validation may be skipped with
+// --skip-ssl-validation.
+func (config *Config) SetInsecureAllowed(allowed bool) {
+	config.ConfigFile.InsecureForbidden = !allowed
+}
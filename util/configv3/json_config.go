@@ -8,29 +8,49 @@ import (
 
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
-	ConfigVersion            int                `json:"ConfigVersion"`
-	Target                   string             `json:"Target"`
-	APIVersion               string             `json:"APIVersion"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	AccessToken              string             `json:"AccessToken"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
-	UAAGrantType             string             `json:"UAAGrantType"`
-	RefreshToken             string             `json:"RefreshToken"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	Trace                    string             `json:"Trace"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	Locale                   string             `json:"Locale"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	ConfigVersion            int                 `json:"ConfigVersion"`
+	Target                   string              `json:"Target"`
+	APIVersion               string              `json:"APIVersion"`
+	AuthorizationEndpoint    string              `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string              `json:"DopplerEndPoint"`
+	UAAEndpoint              string              `json:"UaaEndpoint"`
+	RoutingEndpoint          string              `json:"RoutingAPIEndpoint"`
+	AccessToken              string              `json:"AccessToken"`
+	SSHOAuthClient           string              `json:"SSHOAuthClient"`
+	UAAOAuthClient           string              `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string              `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string              `json:"UAAGrantType"`
+	RefreshToken             string              `json:"RefreshToken"`
+	TargetedOrganization     Organization        `json:"OrganizationFields"`
+	TargetedSpace            Space               `json:"SpaceFields"`
+	SkipSSLValidation        bool                `json:"SSLDisabled"`
+	AsyncTimeout             int                 `json:"AsyncTimeout"`
+	Trace                    string              `json:"Trace"`
+	ColorEnabled             string              `json:"ColorEnabled"`
+	Locale                   string              `json:"Locale"`
+	PluginRepositories       []PluginRepository  `json:"PluginRepos"`
+	MinCLIVersion            string              `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string              `json:"MinRecommendedCLIVersion"`
+	MinCLIVersionCheck       *MinCLIVersionCheck `json:"MinCLIVersionCheck,omitempty"`
+	UsageStatsEnabled        bool                `json:"UsageStatsEnabled,omitempty"`
+	ReleaseCheckEnabled      bool                `json:"ReleaseCheckEnabled,omitempty"`
+	UsageStatsEndpoint       string              `json:"UsageStatsEndpoint,omitempty"`
+	DefaultOrganization      string              `json:"DefaultOrganization,omitempty"`
+	DefaultSpace             string              `json:"DefaultSpace,omitempty"`
+	RecentJobs               []RecentJob         `json:"RecentJobs,omitempty"`
+	EnabledFeatures          []string            `json:"EnabledFeatures,omitempty"`
+	PushScanHook             string              `json:"PushScanHook,omitempty"`
+	PushScanSkipAllowed      bool                `json:"PushScanSkipAllowed,omitempty"`
+	InsecureForbidden        bool                `json:"InsecureForbidden,omitempty"`
+	LogSource                string              `json:"LogSource,omitempty"`
+	RouterCNAME              string              `json:"RouterCNAME,omitempty"`
+	RouterIPs                []string            `json:"RouterIPs,omitempty"`
+	ChangeHeader             string              `json:"ChangeHeader,omitempty"`
+	Accessible               bool                `json:"Accessible,omitempty"`
+	UserAgentSuffix          string              `json:"UserAgentSuffix,omitempty"`
+	PluginPrecedence         []string            `json:"PluginPrecedence,omitempty"`
+	PluginTimeout            int                 `json:"PluginTimeout,omitempty"`
+	PluginMaxOutput          uint64              `json:"PluginMaxOutput,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -62,7 +82,21 @@ type Space struct {
 
 // User represents the user information provided by the JWT access token.
 type User struct {
+	// Name is the user name, or the client ID for client credentials.
 	Name string
+	// GUID is the user's UAA ID, or the client ID for client credentials.
+	GUID string
+	// Admin is true when the token grants read access to every org and
+	// space, regardless of roles.
+	Admin bool
+}
+
+// adminScopes are the token scopes that grant read access to every org and
+// space.
+var adminScopes = []string{
+	"cloud_controller.admin",
+	"cloud_controller.admin_read_only",
+	"cloud_controller.global_auditor",
 }
 
 // AccessToken returns the access token for making authenticated API calls.
@@ -90,6 +124,46 @@ func (config *Config) CurrentUserName() (string, error) {
 	return user.Name, nil
 }
 
+// AccessTokenExpiry returns the time the access token expires, or the zero
+// time when there is no access token or it has no expiry.
+func (config *Config) AccessTokenExpiry() (time.Time, error) {
+	accessToken := config.ConfigFile.AccessToken
+	if accessToken == "" {
+		return time.Time{}, nil
+	}
+
+	token, err := jws.ParseJWT([]byte(accessToken[7:]))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	expiry, _ := token.Claims().Expiration()
+	return expiry, nil
+}
+
+// AccessTokenScopes returns the scopes granted by the access token, or no
+// scopes when there is no access token.
+func (config *Config) AccessTokenScopes() ([]string, error) {
+	accessToken := config.ConfigFile.AccessToken
+	if accessToken == "" {
+		return nil, nil
+	}
+
+	token, err := jws.ParseJWT([]byte(accessToken[7:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []string
+	rawScopes, _ := token.Claims().Get("scope").([]interface{})
+	for _, scope := range rawScopes {
+		if name, ok := scope.(string); ok {
+			scopes = append(scopes, name)
+		}
+	}
+	return scopes, nil
+}
+
 // HasTargetedOrganization returns true if the organization is set.
 func (config *Config) HasTargetedOrganization() bool {
 	return config.ConfigFile.TargetedOrganization.GUID != ""
@@ -106,14 +180,15 @@ func (config *Config) MinCLIVersion() string {
 }
 
 // OverallPollingTimeout returns the overall polling timeout for async
-// operations. The time is based off of:
-//   1. The config file's AsyncTimeout value (integer) is > 0
-//   2. Defaults to the DefaultOverallPollingTimeout
+// operations, never extending past the command deadline. The time is based
+// off of:
+//  1. The config file's AsyncTimeout value (integer) is > 0
+//  2. Defaults to the DefaultOverallPollingTimeout
 func (config *Config) OverallPollingTimeout() time.Duration {
 	if config.ConfigFile.AsyncTimeout == 0 {
-		return DefaultOverallPollingTimeout
+		return config.limitToCommandDeadline(DefaultOverallPollingTimeout)
 	}
-	return time.Duration(config.ConfigFile.AsyncTimeout) * time.Minute
+	return config.limitToCommandDeadline(time.Duration(config.ConfigFile.AsyncTimeout) * time.Minute)
 }
 
 // RefreshToken returns the refresh token for getting a new access token.
@@ -288,7 +363,24 @@ func decodeUserFromJWT(accessToken string) (User, error) {
 	} else {
 		ID = claims.Get("client_id").(string)
 	}
+	var GUID string
+	if claims.Has("sub") {
+		GUID, _ = claims.Get("sub").(string)
+	}
+
+	var admin bool
+	scopes, _ := claims.Get("scope").([]interface{})
+	for _, scope := range scopes {
+		for _, adminScope := range adminScopes {
+			if scope == adminScope {
+				admin = true
+			}
+		}
+	}
+
 	return User{
-		Name: ID,
+		Name:  ID,
+		GUID:  GUID,
+		Admin: admin,
 	}, nil
 }
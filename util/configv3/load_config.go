@@ -104,23 +104,28 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:        filepath.Base(os.Args[0]),
-		CFColor:           os.Getenv("CF_COLOR"),
-		CFDialTimeout:     os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:        os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:        os.Getenv("CF_PASSWORD"),
-		CFPluginHome:      os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout:  os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout:  os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:           os.Getenv("CF_TRACE"),
-		CFUsername:        os.Getenv("CF_USERNAME"),
-		DockerPassword:    os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:      os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ExperimentalLogin: os.Getenv("CF_EXPERIMENTAL_LOGIN"),
-		ForceTTY:          os.Getenv("FORCE_TTY"),
-		HTTPSProxy:        os.Getenv("https_proxy"),
-		Lang:              os.Getenv("LANG"),
-		LCAll:             os.Getenv("LC_ALL"),
+		BinaryName:           filepath.Base(os.Args[0]),
+		CFColor:              os.Getenv("CF_COLOR"),
+		CFDialTimeout:        os.Getenv("CF_DIAL_TIMEOUT"),
+		CFDryRun:             os.Getenv("CF_DRY_RUN"),
+		CFInsecureAllowed:    os.Getenv("CF_INSECURE_ALLOWED"),
+		CFLogLevel:           os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:           os.Getenv("CF_PASSWORD"),
+		CFPluginHome:         os.Getenv("CF_PLUGIN_HOME"),
+		CFReason:             os.Getenv("CF_REASON"),
+		CFStagingTimeout:     os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:     os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:              os.Getenv("CF_TRACE"),
+		CFUsageStatsEndpoint: os.Getenv("CF_USAGE_STATS_ENDPOINT"),
+		CFUserAgentSuffix:    os.Getenv("CF_USER_AGENT_SUFFIX"),
+		CFUsername:           os.Getenv("CF_USERNAME"),
+		DockerPassword:       os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:         os.Getenv("CF_CLI_EXPERIMENTAL"),
+		Features:             featuresFromEnv(os.Getenv),
+		ForceTTY:             os.Getenv("FORCE_TTY"),
+		HTTPSProxy:           os.Getenv("https_proxy"),
+		Lang:                 os.Getenv("LANG"),
+		LCAll:                os.Getenv("LC_ALL"),
 	}
 
 	err = config.loadPluginConfig()
@@ -210,7 +210,7 @@ var _ = Describe("Config", func() {
 
 			It("stores that value", func() {
 				Expect(loadErr).ToNot(HaveOccurred())
-				Expect(config.ExperimentalLogin()).To(BeTrue())
+				Expect(config.FeatureEnabled(LoginV2Feature)).To(BeTrue())
 			})
 		})
 	})
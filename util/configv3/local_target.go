@@ -0,0 +1,157 @@
+package configv3
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// LocalTargetFileName is the path, relative to a project directory, of the
+// file naming the org and space used for commands run in that directory.
+var LocalTargetFileName = filepath.Join(".cf", "target")
+
+// LocalTarget is the org and space named in a project directory's local
+// target file.
+type LocalTarget struct {
+	// Path is the location of the local target file.
+	Path string `yaml:"-"`
+
+	Organization string `yaml:"org"`
+	Space        string `yaml:"space,omitempty"`
+}
+
+// localTargetOverride is the org and space targeted from a local target file
+// for the running command, and the org and space targeted before.
+type localTargetOverride struct {
+	organization       Organization
+	space              Space
+	globalOrganization Organization
+	globalSpace        Space
+}
+
+// SetGlobalTarget makes the org and space targeted since globalOrganization
+// and globalSpace, from a local target file, apply to the running command
+// only. WriteConfig saves the global org and space instead, unless the command
+// targets another org or space.
+func (config *Config) SetGlobalTarget(globalOrganization Organization, globalSpace Space) {
+	config.localTarget = &localTargetOverride{
+		organization:       config.ConfigFile.TargetedOrganization,
+		space:              config.ConfigFile.TargetedSpace,
+		globalOrganization: globalOrganization,
+		globalSpace:        globalSpace,
+	}
+}
+
+// ReloadConfigFile replaces the config file with configFile, read back after
+// another process ran. The local target stays targeted unless the other
+// process targeted another org or space.
+func (config *Config) ReloadConfigFile(configFile JSONConfig) {
+	config.ConfigFile = configFile
+	if config.localTarget != nil && targets(configFile, config.localTarget.globalOrganization, config.localTarget.globalSpace) {
+		config.ConfigFile.TargetedOrganization = config.localTarget.organization
+		config.ConfigFile.TargetedSpace = config.localTarget.space
+	}
+}
+
+// savedConfigFile returns the config file to write, with the global target in
+// place of the local one.
+func (config *Config) savedConfigFile() JSONConfig {
+	configFile := config.ConfigFile
+	if config.localTarget != nil && targets(configFile, config.localTarget.organization, config.localTarget.space) {
+		configFile.TargetedOrganization = config.localTarget.globalOrganization
+		configFile.TargetedSpace = config.localTarget.globalSpace
+	}
+	return configFile
+}
+
+func targets(configFile JSONConfig, org Organization, space Space) bool {
+	return configFile.TargetedOrganization.GUID == org.GUID && configFile.TargetedSpace.GUID == space.GUID
+}
+
+// FindLocalTarget looks for a local target file in dir and its parents, and
+// returns the closest one. The config directory is skipped since the home
+// directory's .cf holds the CLI config rather than a local target.
+func FindLocalTarget(dir string) (LocalTarget, bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return LocalTarget{}, false, err
+	}
+
+	for {
+		path := filepath.Join(dir, LocalTargetFileName)
+		if filepath.Dir(path) != configDirectory() {
+			target, found, err := readLocalTarget(path)
+			if err != nil || found {
+				return target, found, err
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return LocalTarget{}, false, nil
+		}
+		dir = parent
+	}
+}
+
+func readLocalTarget(path string) (LocalTarget, bool, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return LocalTarget{}, false, nil
+	}
+	if err != nil {
+		return LocalTarget{}, false, err
+	}
+
+	target := LocalTarget{Path: path}
+	err = yaml.Unmarshal(raw, &target)
+	if err != nil {
+		return LocalTarget{}, false, err
+	}
+	if target.Organization == "" {
+		return LocalTarget{}, false, nil
+	}
+
+	return target, true, nil
+}
+
+// RenameLocalTargetOrganization rewrites the closest local target file above
+// dir when it names the org oldName. It returns the rewritten target and
+// whether the file was changed.
+func RenameLocalTargetOrganization(dir string, oldName string, newName string) (LocalTarget, bool, error) {
+	target, found, err := FindLocalTarget(dir)
+	if err != nil || !found || target.Organization != oldName {
+		return LocalTarget{}, false, err
+	}
+
+	target.Organization = newName
+	return target, true, writeLocalTarget(target)
+}
+
+// RenameLocalTargetSpace rewrites the closest local target file above dir
+// when it names the space oldName in the org orgName. It returns the
+// rewritten target and whether the file was changed.
+func RenameLocalTargetSpace(dir string, orgName string, oldName string, newName string) (LocalTarget, bool, error) {
+	target, found, err := FindLocalTarget(dir)
+	if err != nil || !found || target.Organization != orgName || target.Space != oldName {
+		return LocalTarget{}, false, err
+	}
+
+	target.Space = newName
+	return target, true, writeLocalTarget(target)
+}
+
+func writeLocalTarget(target LocalTarget) error {
+	raw, err := yaml.Marshal(target)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(target.Path)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(target.Path, raw, info.Mode())
+}
@@ -0,0 +1,194 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FindLocalTarget", func() {
+	var (
+		projectDir string
+		nestedDir  string
+	)
+
+	BeforeEach(func() {
+		var err error
+		projectDir, err = ioutil.TempDir("", "local-target")
+		Expect(err).ToNot(HaveOccurred())
+		projectDir, err = filepath.EvalSymlinks(projectDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		nestedDir = filepath.Join(projectDir, "src", "app")
+		Expect(os.MkdirAll(nestedDir, 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(projectDir)).To(Succeed())
+	})
+
+	writeTarget := func(dir string, contents string) string {
+		path := filepath.Join(dir, LocalTargetFileName)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		return path
+	}
+
+	When("a parent directory has a local target file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = writeTarget(projectDir, "org: my-org\nspace: my-space\n")
+		})
+
+		It("returns the org and space", func() {
+			target, found, err := FindLocalTarget(nestedDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(target).To(Equal(LocalTarget{
+				Path:         path,
+				Organization: "my-org",
+				Space:        "my-space",
+			}))
+		})
+
+		When("a closer directory also has one", func() {
+			BeforeEach(func() {
+				path = writeTarget(nestedDir, "org: other-org\n")
+			})
+
+			It("returns the closest one", func() {
+				target, found, err := FindLocalTarget(nestedDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(target).To(Equal(LocalTarget{
+					Path:         path,
+					Organization: "other-org",
+				}))
+			})
+		})
+	})
+
+	When("the local target file does not name an org", func() {
+		BeforeEach(func() {
+			writeTarget(projectDir, "space: my-space\n")
+		})
+
+		It("is ignored", func() {
+			_, found, err := FindLocalTarget(nestedDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	When("the local target file is invalid", func() {
+		BeforeEach(func() {
+			writeTarget(projectDir, "org: [")
+		})
+
+		It("returns an error", func() {
+			_, _, err := FindLocalTarget(nestedDir)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("no directory has a local target file", func() {
+		It("returns not found", func() {
+			_, found, err := FindLocalTarget(nestedDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("Renaming local targets", func() {
+	var (
+		projectDir string
+		path       string
+	)
+
+	BeforeEach(func() {
+		var err error
+		projectDir, err = ioutil.TempDir("", "local-target")
+		Expect(err).ToNot(HaveOccurred())
+		projectDir, err = filepath.EvalSymlinks(projectDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(projectDir, LocalTargetFileName)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte("org: my-org\nspace: my-space\n"), 0644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(projectDir)).To(Succeed())
+	})
+
+	readTarget := func() LocalTarget {
+		target, found, err := FindLocalTarget(projectDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return target
+	}
+
+	Describe("RenameLocalTargetOrganization", func() {
+		When("the local target file names the old org", func() {
+			It("rewrites the org", func() {
+				target, renamed, err := RenameLocalTargetOrganization(projectDir, "my-org", "new-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(renamed).To(BeTrue())
+				Expect(target.Path).To(Equal(path))
+				Expect(readTarget()).To(Equal(LocalTarget{Path: path, Organization: "new-org", Space: "my-space"}))
+			})
+		})
+
+		When("the local target file names another org", func() {
+			It("leaves the file alone", func() {
+				_, renamed, err := RenameLocalTargetOrganization(projectDir, "other-org", "new-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(renamed).To(BeFalse())
+				Expect(readTarget().Organization).To(Equal("my-org"))
+			})
+		})
+	})
+
+	Describe("RenameLocalTargetSpace", func() {
+		When("the local target file names the old space in the org", func() {
+			It("rewrites the space", func() {
+				_, renamed, err := RenameLocalTargetSpace(projectDir, "my-org", "my-space", "new-space")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(renamed).To(BeTrue())
+				Expect(readTarget()).To(Equal(LocalTarget{Path: path, Organization: "my-org", Space: "new-space"}))
+			})
+		})
+
+		When("the local target file names a space of the same name in another org", func() {
+			It("leaves the file alone", func() {
+				_, renamed, err := RenameLocalTargetSpace(projectDir, "other-org", "my-space", "new-space")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(renamed).To(BeFalse())
+				Expect(readTarget().Space).To(Equal("my-space"))
+			})
+		})
+	})
+})
+
+var _ = Describe("Default Target", func() {
+	It("stores the default org and space in the config file", func() {
+		config := &Config{}
+		Expect(config.DefaultOrganization()).To(BeEmpty())
+		Expect(config.DefaultSpace()).To(BeEmpty())
+
+		config.SetDefaultOrganization("my-org")
+		config.SetDefaultSpace("my-space")
+
+		Expect(config.ConfigFile.DefaultOrganization).To(Equal("my-org"))
+		Expect(config.ConfigFile.DefaultSpace).To(Equal("my-space"))
+		Expect(config.DefaultOrganization()).To(Equal("my-org"))
+		Expect(config.DefaultSpace()).To(Equal("my-space"))
+	})
+})
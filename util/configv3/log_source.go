@@ -0,0 +1,26 @@
+package configv3
+
+const (
+	// LogSourceAuto reads logs from Log Cache when the Cloud Controller
+	// advertises it, falling back to Doppler.
+	LogSourceAuto = "auto"
+	// LogSourceLogCache always reads logs from Log Cache.
+	LogSourceLogCache = "log-cache"
+	// LogSourceDoppler always reads logs from the Doppler websocket endpoint.
+	LogSourceDoppler = "doppler"
+)
+
+// LogSource returns where logs are read from: LogSourceAuto,
+// LogSourceLogCache or LogSourceDoppler. Defaults to LogSourceAuto.
+func (config *Config) LogSource() string {
+	if config.ConfigFile.LogSource == "" {
+		return LogSourceAuto
+	}
+	return config.ConfigFile.LogSource
+}
+
+// SetLogSource sets where logs are read from. An empty source restores the
+// default.
+func (config *Config) SetLogSource(source string) {
+	config.ConfigFile.LogSource = source
+}
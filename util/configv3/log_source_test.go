@@ -0,0 +1,30 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log Source", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("is auto by default", func() {
+		Expect(config.LogSource()).To(Equal(LogSourceAuto))
+	})
+
+	It("stores the log source in the config file", func() {
+		config.SetLogSource(LogSourceDoppler)
+
+		Expect(config.ConfigFile.LogSource).To(Equal("doppler"))
+		Expect(config.LogSource()).To(Equal(LogSourceDoppler))
+
+		config.SetLogSource("")
+		Expect(config.LogSource()).To(Equal(LogSourceAuto))
+	})
+})
@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// MarketplaceCacheDirectory returns the directory where marketplace catalogs
+// are cached between invocations.
+func MarketplaceCacheDirectory() string {
+	return filepath.Join(configDirectory(), "marketplace")
+}
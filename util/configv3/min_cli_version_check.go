@@ -0,0 +1,35 @@
+package configv3
+
+import "time"
+
+// MinCLIVersionCheck is what the targeted Cloud Controller reported about its
+// minimum CLI version, remembered so that it is only asked once a day.
+type MinCLIVersionCheck struct {
+	Target        string    `json:"Target"`
+	APIVersion    string    `json:"APIVersion"`
+	MinCLIVersion string    `json:"MinCLIVersion"`
+	Enforcement   string    `json:"Enforcement"`
+	CheckedAt     time.Time `json:"CheckedAt"`
+}
+
+// MinCLIVersionCheck returns the remembered check for the current target,
+// provided it was made today.
+func (config *Config) MinCLIVersionCheck() (MinCLIVersionCheck, bool) {
+	check := config.ConfigFile.MinCLIVersionCheck
+	if check == nil || check.Target != config.Target() {
+		return MinCLIVersionCheck{}, false
+	}
+
+	checkedYear, checkedMonth, checkedDay := check.CheckedAt.Local().Date()
+	year, month, day := time.Now().Date()
+	if checkedYear != year || checkedMonth != month || checkedDay != day {
+		return MinCLIVersionCheck{}, false
+	}
+
+	return *check, true
+}
+
+// SetMinCLIVersionCheck remembers the outcome of a minimum CLI version check.
+func (config *Config) SetMinCLIVersionCheck(check MinCLIVersionCheck) {
+	config.ConfigFile.MinCLIVersionCheck = &check
+}
@@ -0,0 +1,67 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MinCLIVersionCheck", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+		config.ConfigFile.Target = "https://api.com"
+	})
+
+	It("returns nothing by default", func() {
+		_, ok := config.MinCLIVersionCheck()
+		Expect(ok).To(BeFalse())
+	})
+
+	When("the check was made today against the current target", func() {
+		var check MinCLIVersionCheck
+
+		BeforeEach(func() {
+			check = MinCLIVersionCheck{
+				Target:        "https://api.com",
+				APIVersion:    "2.100.0",
+				MinCLIVersion: "6.40.0",
+				Enforcement:   "block",
+				CheckedAt:     time.Now(),
+			}
+			config.SetMinCLIVersionCheck(check)
+		})
+
+		It("returns the remembered check", func() {
+			remembered, ok := config.MinCLIVersionCheck()
+			Expect(ok).To(BeTrue())
+			Expect(remembered).To(Equal(check))
+		})
+	})
+
+	When("the check was made against another target", func() {
+		BeforeEach(func() {
+			config.SetMinCLIVersionCheck(MinCLIVersionCheck{Target: "https://other-api.com", CheckedAt: time.Now()})
+		})
+
+		It("returns nothing", func() {
+			_, ok := config.MinCLIVersionCheck()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the check was made on an earlier day", func() {
+		BeforeEach(func() {
+			config.SetMinCLIVersionCheck(MinCLIVersionCheck{Target: "https://api.com", CheckedAt: time.Now().AddDate(0, 0, -1)})
+		})
+
+		It("returns nothing", func() {
+			_, ok := config.MinCLIVersionCheck()
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
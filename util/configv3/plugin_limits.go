@@ -0,0 +1,27 @@
+package configv3
+
+import "time"
+
+// PluginTimeout returns how long a plugin command may run before it is
+// stopped. Zero means plugin commands can run indefinitely.
+func (config *Config) PluginTimeout() time.Duration {
+	return time.Duration(config.ConfigFile.PluginTimeout) * time.Second
+}
+
+// SetPluginTimeout saves how long a plugin command may run before it is
+// stopped, in whole seconds. Zero removes the setting.
+func (config *Config) SetPluginTimeout(timeout time.Duration) {
+	config.ConfigFile.PluginTimeout = int(timeout / time.Second)
+}
+
+// PluginMaxOutput returns how many bytes a plugin command may write to stdout
+// and stderr together before it is stopped. Zero means no limit.
+func (config *Config) PluginMaxOutput() uint64 {
+	return config.ConfigFile.PluginMaxOutput
+}
+
+// SetPluginMaxOutput saves how many bytes a plugin command may write to stdout
+// and stderr together before it is stopped. Zero removes the setting.
+func (config *Config) SetPluginMaxOutput(maxOutput uint64) {
+	config.ConfigFile.PluginMaxOutput = maxOutput
+}
@@ -0,0 +1,28 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Limits", func() {
+	It("stores the plugin timeout in whole seconds in the config file", func() {
+		config := &Config{}
+		config.SetPluginTimeout(90*time.Second + 500*time.Millisecond)
+
+		Expect(config.ConfigFile.PluginTimeout).To(Equal(90))
+		Expect(config.PluginTimeout()).To(Equal(90 * time.Second))
+	})
+
+	It("stores the plugin max output in the config file", func() {
+		config := &Config{}
+		config.SetPluginMaxOutput(10 * 1024 * 1024)
+
+		Expect(config.ConfigFile.PluginMaxOutput).To(Equal(uint64(10 * 1024 * 1024)))
+		Expect(config.PluginMaxOutput()).To(Equal(uint64(10 * 1024 * 1024)))
+	})
+})
@@ -0,0 +1,33 @@
+package configv3
+
+import "strings"
+
+// PluginPrecedence returns the plugins whose commands run when other plugins,
+// or built-in commands, have the same command name or alias. Earlier plugins
+// take precedence over later ones.
+func (config *Config) PluginPrecedence() []string {
+	return config.ConfigFile.PluginPrecedence
+}
+
+// SetPluginPrecedence saves the plugins whose commands run when other plugins,
+// or built-in commands, have the same command name or alias. No plugins
+// removes the setting.
+func (config *Config) SetPluginPrecedence(plugins []string) {
+	config.ConfigFile.PluginPrecedence = plugins
+}
+
+// PreferredPlugin returns which of pluginNames, the plugins that have the same
+// command name or alias, runs that command: the first of them in precedence.
+// It returns false when none of them is in precedence, in which case a
+// built-in command of the same name runs instead, or the command has to be run
+// as PLUGIN:COMMAND.
+func PreferredPlugin(pluginNames []string, precedence []string) (string, bool) {
+	for _, preferred := range precedence {
+		for _, pluginName := range pluginNames {
+			if strings.EqualFold(pluginName, preferred) {
+				return pluginName, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,31 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Precedence", func() {
+	It("stores the plugin precedence in the config file", func() {
+		config := &Config{}
+		config.SetPluginPrecedence([]string{"plugin-b", "plugin-a"})
+
+		Expect(config.ConfigFile.PluginPrecedence).To(Equal([]string{"plugin-b", "plugin-a"}))
+		Expect(config.PluginPrecedence()).To(Equal([]string{"plugin-b", "plugin-a"}))
+	})
+
+	Describe("PreferredPlugin", func() {
+		It("returns the first of the plugins in precedence", func() {
+			pluginName, found := PreferredPlugin([]string{"plugin-a", "plugin-b", "plugin-c"}, []string{"plugin-d", "PLUGIN-C", "plugin-b"})
+			Expect(found).To(BeTrue())
+			Expect(pluginName).To(Equal("plugin-c"))
+		})
+
+		It("returns false when none of the plugins is in precedence", func() {
+			_, found := PreferredPlugin([]string{"plugin-a", "plugin-b"}, []string{"plugin-c"})
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package configv3
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ProjectFileName is the name of the file declaring a project directory's
+// defaults for push, logs and ssh.
+const ProjectFileName = "cfproject.yml"
+
+// Project is the defaults declared in a project directory's project file.
+// Relative manifest and vars file paths are resolved against the directory
+// containing the project file.
+type Project struct {
+	// Path is the location of the project file.
+	Path string `yaml:"-"`
+
+	AppName   string   `yaml:"app"`
+	Manifest  string   `yaml:"manifest"`
+	VarsFiles []string `yaml:"vars-files"`
+	PostPush  []string `yaml:"post-push"`
+}
+
+// LoadProject reads the project file in dir. It returns false when dir has
+// no project file.
+func LoadProject(dir string) (Project, bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return Project{}, false, err
+	}
+
+	path := filepath.Join(dir, ProjectFileName)
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Project{}, false, nil
+	}
+	if err != nil {
+		return Project{}, false, err
+	}
+
+	project := Project{Path: path}
+	err = yaml.Unmarshal(raw, &project)
+	if err != nil {
+		return Project{}, false, InvalidProjectFileError{Path: path, Err: err}
+	}
+
+	if project.Manifest != "" {
+		project.Manifest = resolveProjectPath(dir, project.Manifest)
+	}
+	for i, varsFile := range project.VarsFiles {
+		project.VarsFiles[i] = resolveProjectPath(dir, varsFile)
+	}
+
+	return project, true, nil
+}
+
+// InvalidProjectFileError is returned when the project file is not valid
+// YAML.
+type InvalidProjectFileError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidProjectFileError) Error() string {
+	return "Invalid project file " + e.Path + ": " + e.Err.Error()
+}
+
+func resolveProjectPath(dir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
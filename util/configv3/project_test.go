@@ -0,0 +1,86 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadProject", func() {
+	var projectDir string
+
+	BeforeEach(func() {
+		var err error
+		projectDir, err = ioutil.TempDir("", "project")
+		Expect(err).ToNot(HaveOccurred())
+		projectDir, err = filepath.EvalSymlinks(projectDir)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(projectDir)).To(Succeed())
+	})
+
+	writeProject := func(contents string) string {
+		path := filepath.Join(projectDir, ProjectFileName)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		return path
+	}
+
+	When("the directory has a project file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = writeProject(`---
+app: my-app
+manifest: deploy/manifest.yml
+vars-files:
+- deploy/vars.yml
+- /etc/shared-vars.yml
+post-push:
+- ./smoke-test.sh
+- echo done
+`)
+		})
+
+		It("returns the defaults with paths resolved against the project directory", func() {
+			project, found, err := LoadProject(projectDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(project).To(Equal(Project{
+				Path:      path,
+				AppName:   "my-app",
+				Manifest:  filepath.Join(projectDir, "deploy", "manifest.yml"),
+				VarsFiles: []string{filepath.Join(projectDir, "deploy", "vars.yml"), "/etc/shared-vars.yml"},
+				PostPush:  []string{"./smoke-test.sh", "echo done"},
+			}))
+		})
+	})
+
+	When("the directory has no project file", func() {
+		It("returns not found", func() {
+			_, found, err := LoadProject(projectDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	When("the project file is not valid YAML", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = writeProject("app: [my-app")
+		})
+
+		It("returns an InvalidProjectFileError", func() {
+			_, _, err := LoadProject(projectDir)
+			Expect(err).To(BeAssignableToTypeOf(InvalidProjectFileError{}))
+			Expect(err.Error()).To(ContainSubstring(path))
+		})
+	})
+})
@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// PushCheckpointDirectory returns the directory where the completed phases of
+// each push are recorded so that a failed push can be resumed.
+func PushCheckpointDirectory() string {
+	return filepath.Join(configDirectory(), "push-checkpoints")
+}
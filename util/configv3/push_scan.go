@@ -0,0 +1,25 @@
+package configv3
+
+// PushScanHook returns the command or HTTP endpoint that must approve the app
+// files before push uploads them.
+func (config *Config) PushScanHook() string {
+	return config.ConfigFile.PushScanHook
+}
+
+// SetPushScanHook sets the pre-upload scan hook of push. An empty hook
+// removes it.
+func (config *Config) SetPushScanHook(hook string) {
+	config.ConfigFile.PushScanHook = hook
+}
+
+// PushScanSkipAllowed returns true when push may skip the pre-upload scan
+// with --skip-scan. Like the hook itself, it is a user setting that guards
+// against skipping the scan by mistake, not a policy the user cannot change.
+func (config *Config) PushScanSkipAllowed() bool {
+	return config.ConfigFile.PushScanSkipAllowed
+}
+
+// SetPushScanSkipAllowed sets whether push may skip the pre-upload scan.
+func (config *Config) SetPushScanSkipAllowed(allowed bool) {
+	config.ConfigFile.PushScanSkipAllowed = allowed
+}
@@ -0,0 +1,31 @@
+package configv3
+
+import "time"
+
+// MaxRecentJobs is the number of async jobs remembered in the config.
+const MaxRecentJobs = 20
+
+// RecentJob is an async Cloud Controller job started by the CLI, remembered
+// so that it can be followed up on after the command that started it exited.
+type RecentJob struct {
+	GUID        string    `json:"GUID"`
+	Description string    `json:"Description"`
+	Target      string    `json:"Target"`
+	User        string    `json:"User"`
+	CreatedAt   time.Time `json:"CreatedAt"`
+}
+
+// RecentJobs returns the remembered jobs, most recent first.
+func (config *Config) RecentJobs() []RecentJob {
+	return config.ConfigFile.RecentJobs
+}
+
+// AddRecentJob remembers the job, forgetting the oldest jobs once more than
+// MaxRecentJobs are remembered.
+func (config *Config) AddRecentJob(job RecentJob) {
+	jobs := append([]RecentJob{job}, config.ConfigFile.RecentJobs...)
+	if len(jobs) > MaxRecentJobs {
+		jobs = jobs[:MaxRecentJobs]
+	}
+	config.ConfigFile.RecentJobs = jobs
+}
@@ -0,0 +1,48 @@
+package configv3_test
+
+import (
+	"fmt"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecentJobs", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("returns no jobs by default", func() {
+		Expect(config.RecentJobs()).To(BeEmpty())
+	})
+
+	Describe("AddRecentJob", func() {
+		It("returns the most recently added job first", func() {
+			createdAt := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+			config.AddRecentJob(RecentJob{GUID: "job-1", Description: "delete org some-org", Target: "https://api.com", User: "some-user", CreatedAt: createdAt})
+			config.AddRecentJob(RecentJob{GUID: "job-2"})
+
+			Expect(config.RecentJobs()).To(Equal([]RecentJob{
+				{GUID: "job-2"},
+				{GUID: "job-1", Description: "delete org some-org", Target: "https://api.com", User: "some-user", CreatedAt: createdAt},
+			}))
+			Expect(config.ConfigFile.RecentJobs).To(HaveLen(2))
+		})
+
+		It("forgets the oldest jobs once MaxRecentJobs are remembered", func() {
+			for i := 0; i <= MaxRecentJobs; i++ {
+				config.AddRecentJob(RecentJob{GUID: fmt.Sprintf("job-%d", i)})
+			}
+
+			jobs := config.RecentJobs()
+			Expect(jobs).To(HaveLen(MaxRecentJobs))
+			Expect(jobs[0].GUID).To(Equal(fmt.Sprintf("job-%d", MaxRecentJobs)))
+			Expect(jobs[MaxRecentJobs-1].GUID).To(Equal("job-1"))
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package configv3
+
+import "path/filepath"
+
+// ReleaseCheckFilePath returns the file the latest CLI release is cached in
+// between checks.
+func ReleaseCheckFilePath() string {
+	return filepath.Join(configDirectory(), "release_check.json")
+}
+
+// ReleaseCheckEnabled returns whether the user has opted in to the daily
+// check for a newer CLI release. Checking is off unless explicitly enabled.
+func (config *Config) ReleaseCheckEnabled() bool {
+	return config.ConfigFile.ReleaseCheckEnabled
+}
+
+// SetReleaseCheckEnabled opts the user in to or out of the daily check for a
+// newer CLI release.
+func (config *Config) SetReleaseCheckEnabled(enabled bool) {
+	config.ConfigFile.ReleaseCheckEnabled = enabled
+}
@@ -0,0 +1,25 @@
+package configv3
+
+// RouterCNAME returns the host name that the DNS records of custom domains
+// are expected to point to.
+func (config *Config) RouterCNAME() string {
+	return config.ConfigFile.RouterCNAME
+}
+
+// SetRouterCNAME sets the host name that the DNS records of custom domains
+// are expected to point to. An empty host name removes it.
+func (config *Config) SetRouterCNAME(cname string) {
+	config.ConfigFile.RouterCNAME = cname
+}
+
+// RouterIPs returns the router IP addresses that custom domains are expected
+// to resolve to.
+func (config *Config) RouterIPs() []string {
+	return config.ConfigFile.RouterIPs
+}
+
+// SetRouterIPs sets the router IP addresses that custom domains are expected
+// to resolve to. No addresses removes them.
+func (config *Config) SetRouterIPs(ips []string) {
+	config.ConfigFile.RouterIPs = ips
+}
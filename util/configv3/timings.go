@@ -0,0 +1,7 @@
+package configv3
+
+// Timings returns whether a breakdown of the time spent on API requests is
+// displayed when the command ends. It is set by the --timings global flag.
+func (config *Config) Timings() bool {
+	return config.Flags.Timings
+}
@@ -0,0 +1,36 @@
+package configv3
+
+import "path/filepath"
+
+// UsageStatsFilePath returns the file where command usage is recorded when
+// the user has opted in to usage stats.
+func UsageStatsFilePath() string {
+	return filepath.Join(configDirectory(), "usage_stats.json")
+}
+
+// UsageStatsEnabled returns whether the user has opted in to recording
+// command usage. Recording is off unless explicitly enabled.
+func (config *Config) UsageStatsEnabled() bool {
+	return config.ConfigFile.UsageStatsEnabled
+}
+
+// SetUsageStatsEnabled opts the user in to or out of recording command usage.
+func (config *Config) SetUsageStatsEnabled(enabled bool) {
+	config.ConfigFile.UsageStatsEnabled = enabled
+}
+
+// UsageStatsEndpoint returns the URL recorded usage is exported to. The
+// $CF_USAGE_STATS_ENDPOINT environment variable takes precedence over the
+// endpoint saved in the config file. An empty string means usage is only
+// kept locally.
+func (config *Config) UsageStatsEndpoint() string {
+	if config.ENV.CFUsageStatsEndpoint != "" {
+		return config.ENV.CFUsageStatsEndpoint
+	}
+	return config.ConfigFile.UsageStatsEndpoint
+}
+
+// SetUsageStatsEndpoint saves the URL recorded usage is exported to.
+func (config *Config) SetUsageStatsEndpoint(endpoint string) {
+	config.ConfigFile.UsageStatsEndpoint = endpoint
+}
@@ -0,0 +1,42 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Stats", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("is disabled by default", func() {
+		Expect(config.UsageStatsEnabled()).To(BeFalse())
+		Expect(config.UsageStatsEndpoint()).To(BeEmpty())
+	})
+
+	It("stores the opt-in and endpoint in the config file", func() {
+		config.SetUsageStatsEnabled(true)
+		config.SetUsageStatsEndpoint("https://stats.example.com")
+
+		Expect(config.ConfigFile.UsageStatsEnabled).To(BeTrue())
+		Expect(config.ConfigFile.UsageStatsEndpoint).To(Equal("https://stats.example.com"))
+		Expect(config.UsageStatsEnabled()).To(BeTrue())
+		Expect(config.UsageStatsEndpoint()).To(Equal("https://stats.example.com"))
+	})
+
+	When("$CF_USAGE_STATS_ENDPOINT is set", func() {
+		BeforeEach(func() {
+			config.ENV.CFUsageStatsEndpoint = "https://operator.example.com"
+			config.SetUsageStatsEndpoint("https://stats.example.com")
+		})
+
+		It("takes precedence over the config file", func() {
+			Expect(config.UsageStatsEndpoint()).To(Equal("https://operator.example.com"))
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package configv3
+
+// UserAgentSuffix returns the identifier appended to the User-Agent of every
+// request, such as the name of the team or pipeline running the CLI. This is
+// based off of:
+//   1. The $CF_USER_AGENT_SUFFIX environment variable
+//   2. The user-agent-suffix config setting
+func (config *Config) UserAgentSuffix() string {
+	if config.ENV.CFUserAgentSuffix != "" {
+		return config.ENV.CFUserAgentSuffix
+	}
+	return config.ConfigFile.UserAgentSuffix
+}
+
+// SetUserAgentSuffix saves the identifier appended to the User-Agent of every
+// request. An empty identifier removes it.
+func (config *Config) SetUserAgentSuffix(suffix string) {
+	config.ConfigFile.UserAgentSuffix = suffix
+}
@@ -0,0 +1,30 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Agent", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("stores the user agent suffix in the config file", func() {
+		config.SetUserAgentSuffix("team-payments")
+
+		Expect(config.ConfigFile.UserAgentSuffix).To(Equal("team-payments"))
+		Expect(config.UserAgentSuffix()).To(Equal("team-payments"))
+	})
+
+	It("prefers $CF_USER_AGENT_SUFFIX over the config file", func() {
+		config.SetUserAgentSuffix("team-payments")
+		config.ENV.CFUserAgentSuffix = "pipeline-deploy"
+
+		Expect(config.UserAgentSuffix()).To(Equal("pipeline-deploy"))
+	})
+})
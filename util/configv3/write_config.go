@@ -12,7 +12,7 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	rawConfig, err := json.MarshalIndent(c.savedConfigFile(), "", "  ")
 	if err != nil {
 		return err
 	}
@@ -55,5 +55,75 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
 		})
+
+		When("the org and space are targeted from a local target file", func() {
+			BeforeEach(func() {
+				config.SetOrganizationInformation("global-org-guid", "global-org")
+				config.SetSpaceInformation("global-space-guid", "global-space", false)
+				globalOrg, globalSpace := config.TargetedOrganization(), config.TargetedSpace()
+
+				config.SetOrganizationInformation("local-org-guid", "local-org")
+				config.SetSpaceInformation("local-space-guid", "local-space", true)
+				config.SetGlobalTarget(globalOrg, globalSpace)
+			})
+
+			readWrittenConfig := func() JSONConfig {
+				file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				var writtenCFConfig JSONConfig
+				Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+				return writtenCFConfig
+			}
+
+			It("writes the global org and space and keeps the local ones targeted", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenCFConfig := readWrittenConfig()
+				Expect(writtenCFConfig.TargetedOrganization.Name).To(Equal("global-org"))
+				Expect(writtenCFConfig.TargetedSpace.Name).To(Equal("global-space"))
+				Expect(config.TargetedOrganizationName()).To(Equal("local-org"))
+				Expect(config.TargetedSpace().Name).To(Equal("local-space"))
+			})
+
+			When("the command targets another org and space", func() {
+				BeforeEach(func() {
+					config.SetOrganizationInformation("other-org-guid", "other-org")
+					config.SetSpaceInformation("other-space-guid", "other-space", false)
+				})
+
+				It("writes the org and space the command targeted", func() {
+					Expect(WriteConfig(config)).To(Succeed())
+
+					writtenCFConfig := readWrittenConfig()
+					Expect(writtenCFConfig.TargetedOrganization.Name).To(Equal("other-org"))
+					Expect(writtenCFConfig.TargetedSpace.Name).To(Equal("other-space"))
+				})
+			})
+
+			Describe("ReloadConfigFile", func() {
+				It("keeps the local org and space targeted when the reloaded config has the global ones", func() {
+					reloaded := config.ConfigFile
+					reloaded.TargetedOrganization = Organization{GUID: "global-org-guid", Name: "global-org"}
+					reloaded.TargetedSpace = Space{GUID: "global-space-guid", Name: "global-space"}
+					reloaded.RefreshToken = "new-refresh-token"
+
+					config.ReloadConfigFile(reloaded)
+					Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
+					Expect(config.TargetedOrganizationName()).To(Equal("local-org"))
+					Expect(config.TargetedSpace().Name).To(Equal("local-space"))
+				})
+
+				It("targets the org and space of the reloaded config when another process targeted them", func() {
+					reloaded := config.ConfigFile
+					reloaded.TargetedOrganization = Organization{GUID: "other-org-guid", Name: "other-org"}
+					reloaded.TargetedSpace = Space{GUID: "other-space-guid", Name: "other-space"}
+
+					config.ReloadConfigFile(reloaded)
+					Expect(config.TargetedOrganizationName()).To(Equal("other-org"))
+					Expect(config.TargetedSpace().Name).To(Equal("other-space"))
+				})
+			})
+		})
 	})
 })
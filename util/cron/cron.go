@@ -0,0 +1,189 @@
+// Package cron parses the five-field cron expressions used by
+// `cf schedule-task` and computes when they are next due.
+//
+// An expression has the fields minute, hour, day of month, month and day of
+// week. Each field is '*', a number, a range such as 1-5, or a comma
+// separated list of these, optionally followed by a /STEP. Day of week 0 and 7
+// are both Sunday. As in crontab, when both day of month and day of week are
+// restricted an expression is due on days matching either. The macros
+// @yearly, @monthly, @weekly, @daily and @hourly are also accepted.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearch bounds the search for the next time an expression is due, so
+// that expressions which can never match, such as "0 0 30 2 *", terminate.
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// Parse parses the provided cron expression.
+func Parse(expression string) (Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	if macro, ok := macros[spec]; ok {
+		spec = macro
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return Schedule{}, ParseError{Expression: expression, Message: "expected 5 fields: minute, hour, day of month, month and day of week"}
+	}
+
+	values := make([]map[int]bool, len(fields))
+	for i, part := range parts {
+		var err error
+		values[i], err = parseField(part, fields[i])
+		if err != nil {
+			return Schedule{}, ParseError{Expression: expression, Message: err.Error()}
+		}
+	}
+
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	return Schedule{
+		minutes:       values[0],
+		hours:         values[1],
+		daysOfMonth:   values[2],
+		months:        values[3],
+		daysOfWeek:    values[4],
+		anyDayOfMonth: strings.HasPrefix(parts[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+// Matches returns whether the schedule is due in the minute containing t.
+func (schedule Schedule) Matches(t time.Time) bool {
+	return schedule.minutes[t.Minute()] &&
+		schedule.hours[t.Hour()] &&
+		schedule.months[int(t.Month())] &&
+		schedule.matchesDay(t)
+}
+
+// Next returns the first minute strictly after t at which the schedule is
+// due, in t's location. It returns the zero time if the schedule is not due
+// in the next five years.
+func (schedule Schedule) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.Add(maxSearch)
+
+	for next.Before(limit) {
+		switch {
+		case !schedule.months[int(next.Month())]:
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !schedule.matchesDay(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case !schedule.hours[next.Hour()]:
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+		case !schedule.minutes[next.Minute()]:
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+func (schedule Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := schedule.daysOfMonth[t.Day()]
+	dayOfWeek := schedule.daysOfWeek[int(t.Weekday())]
+
+	if schedule.anyDayOfMonth || schedule.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+func parseField(spec string, f field) (map[int]bool, error) {
+	values := map[int]bool{}
+
+	for _, item := range strings.Split(spec, ",") {
+		rangeSpec, step := item, 1
+		if i := strings.Index(item, "/"); i != -1 {
+			var err error
+			rangeSpec = item[:i]
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step < 1 {
+				return nil, fieldError{field: f, value: item}
+			}
+		}
+
+		low, high := f.min, f.max
+		switch {
+		case rangeSpec == "*":
+		case strings.Contains(rangeSpec, "-"):
+			bounds := strings.SplitN(rangeSpec, "-", 2)
+			var err error
+			if low, err = f.parseValue(bounds[0]); err != nil {
+				return nil, err
+			}
+			if high, err = f.parseValue(bounds[1]); err != nil {
+				return nil, err
+			}
+			if low > high {
+				return nil, fieldError{field: f, value: item}
+			}
+		default:
+			var err error
+			if low, err = f.parseValue(rangeSpec); err != nil {
+				return nil, err
+			}
+			if step == 1 {
+				high = low
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			values[value] = true
+		}
+	}
+
+	return values, nil
+}
+
+func (f field) parseValue(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < f.min || number > f.max {
+		return 0, fieldError{field: f, value: value}
+	}
+	return number, nil
+}
@@ -0,0 +1,68 @@
+package cron_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	at := func(value string) time.Time {
+		t, err := time.Parse("2006-01-02 15:04", value)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("Next",
+		func(expression string, after string, expected string) {
+			schedule, err := Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(at(after))).To(Equal(at(expected)))
+		},
+
+		Entry("every minute", "* * * * *", "2019-03-04 10:15", "2019-03-04 10:16"),
+		Entry("daily at a fixed time, later today", "0 3 * * *", "2019-03-04 01:59", "2019-03-04 03:00"),
+		Entry("daily at a fixed time, tomorrow", "0 3 * * *", "2019-03-04 03:00", "2019-03-05 03:00"),
+		Entry("steps", "*/15 * * * *", "2019-03-04 10:31", "2019-03-04 10:45"),
+		Entry("steps from a start value", "5/20 * * * *", "2019-03-04 10:26", "2019-03-04 10:45"),
+		Entry("ranges and lists", "0 9-17/4,22 * * *", "2019-03-04 17:01", "2019-03-04 22:00"),
+		Entry("day of week", "30 6 * * 1-5", "2019-03-08 07:00", "2019-03-11 06:30"),
+		Entry("Sunday as 7", "0 0 * * 7", "2019-03-04 00:00", "2019-03-10 00:00"),
+		Entry("day of month or day of week", "0 0 15 * 1", "2019-03-12 00:00", "2019-03-15 00:00"),
+		Entry("month rollover", "0 0 1 * *", "2019-12-31 23:59", "2020-01-01 00:00"),
+		Entry("leap day", "0 12 29 2 *", "2019-03-01 00:00", "2020-02-29 12:00"),
+		Entry("macros", "@weekly", "2019-03-04 00:00", "2019-03-10 00:00"),
+	)
+
+	It("returns the zero time when the expression never matches", func() {
+		schedule, err := Parse("0 0 30 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(at("2019-03-04 00:00"))).To(BeZero())
+	})
+
+	Describe("Matches", func() {
+		It("returns whether the schedule is due in the minute of the provided time", func() {
+			schedule, err := Parse("0 3 * * *")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(schedule.Matches(at("2019-03-04 03:00").Add(42 * time.Second))).To(BeTrue())
+			Expect(schedule.Matches(at("2019-03-04 03:01"))).To(BeFalse())
+		})
+	})
+
+	DescribeTable("invalid expressions",
+		func(expression string, message string) {
+			_, err := Parse(expression)
+			Expect(err).To(MatchError(ParseError{Expression: expression, Message: message}))
+		},
+
+		Entry("too few fields", "0 3 * *", "expected 5 fields: minute, hour, day of month, month and day of week"),
+		Entry("out of range", "60 * * * *", "minute '60' must be within 0-59"),
+		Entry("not a number", "* * * jan *", "month 'jan' must be within 1-12"),
+		Entry("inverted range", "* 5-2 * * *", "hour '5-2' must be within 0-23"),
+		Entry("invalid step", "*/0 * * * *", "minute '*/0' must be within 0-59"),
+	)
+})
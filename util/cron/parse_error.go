@@ -0,0 +1,22 @@
+package cron
+
+import "fmt"
+
+// ParseError is returned when a cron expression is not valid.
+type ParseError struct {
+	Expression string
+	Message    string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("invalid cron expression '%s': %s", e.Expression, e.Message)
+}
+
+type fieldError struct {
+	field field
+	value string
+}
+
+func (e fieldError) Error() string {
+	return fmt.Sprintf("%s '%s' must be within %d-%d", e.field.name, e.value, e.field.min, e.field.max)
+}
@@ -0,0 +1,187 @@
+// Package dnscheck checks that the host names of custom domains resolve to
+// the routers of a Cloud Foundry foundation, so that routes created on them
+// can be reached.
+package dnscheck
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ProbeLabel is prepended to a domain to check the wildcard record serving
+// the routes on the domain, since a *.DOMAIN name cannot be looked up
+// directly.
+const ProbeLabel = "cf-dns-check"
+
+// Resolver looks up host names. It is satisfied by the net package resolver.
+type Resolver interface {
+	LookupCNAME(host string) (string, error)
+	LookupHost(host string) ([]string, error)
+}
+
+type netResolver struct{}
+
+func (netResolver) LookupCNAME(host string) (string, error) {
+	return net.LookupCNAME(host)
+}
+
+func (netResolver) LookupHost(host string) ([]string, error) {
+	return net.LookupHost(host)
+}
+
+//go:generate counterfeiter . DNSChecker
+
+// DNSChecker checks that host names resolve to the routers. It is satisfied
+// by Checker.
+type DNSChecker interface {
+	CheckDomain(domain string, expected Expectation) Result
+	CheckHost(host string, expected Expectation) Result
+}
+
+// RouterConfig holds the router-cname and router-ips config settings.
+type RouterConfig interface {
+	RouterCNAME() string
+	RouterIPs() []string
+}
+
+// Expectation describes the DNS records of the routers of the foundation.
+// Host names are expected to be a CNAME of, or resolve to the same addresses
+// as, CNAME when it is set, and to resolve to IPs otherwise. When neither is
+// set, host names are only expected to resolve.
+type Expectation struct {
+	CNAME string
+	IPs   []string
+}
+
+// RouterExpectation returns the router DNS records set with the router-cname
+// and router-ips config settings.
+func RouterExpectation(config RouterConfig) Expectation {
+	return Expectation{
+		CNAME: config.RouterCNAME(),
+		IPs:   config.RouterIPs(),
+	}
+}
+
+// Result is the outcome of checking a host name.
+type Result struct {
+	Host      string
+	Addresses []string
+	CNAME     string
+
+	// Problem describes why the host name does not resolve to the routers. It
+	// is empty when it does.
+	Problem string
+
+	// Remediation describes the DNS record to create to fix the problem.
+	Remediation string
+}
+
+// OK returns true when the host name resolves to the routers.
+func (result Result) OK() bool {
+	return result.Problem == ""
+}
+
+type Checker struct {
+	Resolver Resolver
+}
+
+func NewChecker() *Checker {
+	return &Checker{Resolver: netResolver{}}
+}
+
+// CheckDomain checks that any host name on the domain resolves to the
+// routers, by looking up a host name made of ProbeLabel and the domain.
+func (checker Checker) CheckDomain(domain string, expected Expectation) Result {
+	return checker.check(ProbeLabel+"."+domain, "*."+domain, expected)
+}
+
+// CheckHost checks that the host name resolves to the routers.
+func (checker Checker) CheckHost(host string, expected Expectation) Result {
+	return checker.check(host, host, expected)
+}
+
+func (checker Checker) check(host string, record string, expected Expectation) Result {
+	result := Result{Host: host}
+
+	addresses, err := checker.Resolver.LookupHost(host)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+			result.Problem = fmt.Sprintf("%s does not resolve (NXDOMAIN)", host)
+		} else {
+			result.Problem = fmt.Sprintf("%s could not be resolved: %s", host, err)
+		}
+		result.Remediation = remediation(record, expected)
+		return result
+	}
+	result.Addresses = addresses
+
+	cname, err := checker.Resolver.LookupCNAME(host)
+	if err == nil && normalizeName(cname) != normalizeName(host) {
+		result.CNAME = normalizeName(cname)
+	}
+
+	switch {
+	case expected.CNAME != "":
+		if result.CNAME == normalizeName(expected.CNAME) {
+			return result
+		}
+
+		routerAddresses, err := checker.Resolver.LookupHost(expected.CNAME)
+		if err != nil {
+			result.Problem = fmt.Sprintf("router host name %s could not be resolved: %s", expected.CNAME, err)
+			return result
+		}
+		if containsAll(routerAddresses, addresses) {
+			return result
+		}
+		result.Problem = fmt.Sprintf("%s resolves to %s, not to the routers at %s", host, strings.Join(addresses, ", "), expected.CNAME)
+	case len(expected.IPs) > 0:
+		if containsAll(expected.IPs, addresses) {
+			return result
+		}
+		result.Problem = fmt.Sprintf("%s resolves to %s, not to the router IPs %s", host, strings.Join(addresses, ", "), strings.Join(expected.IPs, ", "))
+	default:
+		return result
+	}
+
+	result.Remediation = remediation(record, expected)
+	return result
+}
+
+func remediation(record string, expected Expectation) string {
+	switch {
+	case expected.CNAME != "":
+		return fmt.Sprintf("Create a CNAME record for %s pointing to %s.", record, expected.CNAME)
+	case len(expected.IPs) > 0:
+		return fmt.Sprintf("Create A records for %s pointing to %s.", record, strings.Join(expected.IPs, ", "))
+	default:
+		return fmt.Sprintf("Create a DNS record for %s pointing to the routers of the foundation.", record)
+	}
+}
+
+// containsAll returns true when every address in addresses is one of
+// allowed.
+func containsAll(allowed []string, addresses []string) bool {
+	allowedIPs := map[string]bool{}
+	for _, address := range allowed {
+		allowedIPs[normalizeIP(address)] = true
+	}
+	for _, address := range addresses {
+		if !allowedIPs[normalizeIP(address)] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeIP(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	return address
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
@@ -0,0 +1,192 @@
+package dnscheck_test
+
+import (
+	"errors"
+	"net"
+
+	. "code.cloudfoundry.org/cli/util/dnscheck"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeResolver struct {
+	hosts  map[string][]string
+	cnames map[string]string
+	errs   map[string]error
+}
+
+func (resolver fakeResolver) LookupCNAME(host string) (string, error) {
+	if cname, ok := resolver.cnames[host]; ok {
+		return cname, nil
+	}
+	return host + ".", nil
+}
+
+func (resolver fakeResolver) LookupHost(host string) ([]string, error) {
+	if err, ok := resolver.errs[host]; ok {
+		return nil, err
+	}
+	if addresses, ok := resolver.hosts[host]; ok {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+var _ = Describe("Checker", func() {
+	var (
+		resolver fakeResolver
+		checker  Checker
+		expected Expectation
+		result   Result
+	)
+
+	BeforeEach(func() {
+		resolver = fakeResolver{
+			hosts: map[string][]string{
+				"router.example.com":            {"10.0.0.1", "10.0.0.2"},
+				"cf-dns-check.apps.example.com": {"10.0.0.1"},
+				"myapp.apps.example.com":        {"10.0.0.2"},
+				"other.example.com":             {"192.168.0.1"},
+			},
+			cnames: map[string]string{
+				"cf-dns-check.apps.example.com": "Router.example.com.",
+			},
+			errs: map[string]error{},
+		}
+		checker = Checker{Resolver: resolver}
+		expected = Expectation{}
+	})
+
+	Describe("CheckDomain", func() {
+		JustBeforeEach(func() {
+			result = checker.CheckDomain("apps.example.com", expected)
+		})
+
+		It("looks up the probe host name on the domain", func() {
+			Expect(result.OK()).To(BeTrue())
+			Expect(result.Host).To(Equal("cf-dns-check.apps.example.com"))
+			Expect(result.Addresses).To(ConsistOf("10.0.0.1"))
+			Expect(result.CNAME).To(Equal("router.example.com"))
+		})
+
+		When("the expected CNAME matches", func() {
+			BeforeEach(func() {
+				expected.CNAME = "router.example.com."
+			})
+
+			It("passes", func() {
+				Expect(result.OK()).To(BeTrue())
+			})
+		})
+
+		When("the domain does not resolve", func() {
+			BeforeEach(func() {
+				delete(resolver.hosts, "cf-dns-check.apps.example.com")
+				expected.CNAME = "router.example.com"
+			})
+
+			It("suggests a wildcard record", func() {
+				Expect(result.OK()).To(BeFalse())
+				Expect(result.Problem).To(Equal("cf-dns-check.apps.example.com does not resolve (NXDOMAIN)"))
+				Expect(result.Remediation).To(Equal("Create a CNAME record for *.apps.example.com pointing to router.example.com."))
+			})
+		})
+	})
+
+	Describe("CheckHost", func() {
+		var host string
+
+		BeforeEach(func() {
+			host = "myapp.apps.example.com"
+		})
+
+		JustBeforeEach(func() {
+			result = checker.CheckHost(host, expected)
+		})
+
+		When("nothing is expected", func() {
+			It("only requires the host name to resolve", func() {
+				Expect(result.OK()).To(BeTrue())
+				Expect(result.CNAME).To(BeEmpty())
+			})
+		})
+
+		When("the host name does not resolve", func() {
+			BeforeEach(func() {
+				host = "missing.apps.example.com"
+			})
+
+			It("reports NXDOMAIN with a generic remediation", func() {
+				Expect(result.OK()).To(BeFalse())
+				Expect(result.Problem).To(Equal("missing.apps.example.com does not resolve (NXDOMAIN)"))
+				Expect(result.Remediation).To(Equal("Create a DNS record for missing.apps.example.com pointing to the routers of the foundation."))
+			})
+		})
+
+		When("the lookup fails", func() {
+			BeforeEach(func() {
+				resolver.errs[host] = errors.New("i/o timeout")
+			})
+
+			It("reports the error", func() {
+				Expect(result.OK()).To(BeFalse())
+				Expect(result.Problem).To(Equal("myapp.apps.example.com could not be resolved: i/o timeout"))
+			})
+		})
+
+		When("a router CNAME is expected", func() {
+			BeforeEach(func() {
+				expected.CNAME = "router.example.com"
+			})
+
+			It("passes when the host name resolves to the router addresses", func() {
+				Expect(result.OK()).To(BeTrue())
+			})
+
+			When("the host name resolves elsewhere", func() {
+				BeforeEach(func() {
+					host = "other.example.com"
+				})
+
+				It("reports the mismatch and remediation", func() {
+					Expect(result.OK()).To(BeFalse())
+					Expect(result.Problem).To(Equal("other.example.com resolves to 192.168.0.1, not to the routers at router.example.com"))
+					Expect(result.Remediation).To(Equal("Create a CNAME record for other.example.com pointing to router.example.com."))
+				})
+			})
+
+			When("the router host name does not resolve", func() {
+				BeforeEach(func() {
+					resolver.errs["router.example.com"] = errors.New("server misbehaving")
+				})
+
+				It("reports the error", func() {
+					Expect(result.OK()).To(BeFalse())
+					Expect(result.Problem).To(Equal("router host name router.example.com could not be resolved: server misbehaving"))
+				})
+			})
+		})
+
+		When("router IPs are expected", func() {
+			BeforeEach(func() {
+				expected.IPs = []string{"10.0.0.1", "10.0.0.2"}
+			})
+
+			It("passes when the host name resolves to them", func() {
+				Expect(result.OK()).To(BeTrue())
+			})
+
+			When("the host name resolves elsewhere", func() {
+				BeforeEach(func() {
+					host = "other.example.com"
+				})
+
+				It("reports the mismatch and remediation", func() {
+					Expect(result.OK()).To(BeFalse())
+					Expect(result.Problem).To(Equal("other.example.com resolves to 192.168.0.1, not to the router IPs 10.0.0.1, 10.0.0.2"))
+					Expect(result.Remediation).To(Equal("Create A records for other.example.com pointing to 10.0.0.1, 10.0.0.2."))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package dnscheck_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDNSCheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DNS Check Suite")
+}
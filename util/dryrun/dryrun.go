@@ -0,0 +1,116 @@
+// Package dryrun describes the API requests that the --dry-run global option
+// prints instead of sending.
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// MaxBodyLength is the number of characters of a request body printed by
+// SummarizeBody.
+const MaxBodyLength = 200
+
+// RedactedValue replaces sensitive values in the request bodies printed by
+// SummarizeBody.
+const RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+var sensitiveKeys = regexp.MustCompile("(?i)password|secret|token|credentials")
+
+// environmentVariableKeys hold environment variables, whose values are
+// redacted while their names are kept. The bodies of requests to the V2
+// environment variable groups are environment variables as a whole.
+var environmentVariableKeys = regexp.MustCompile("^(environment_json|environment_variables|var|env)$")
+
+// ModifiesResources returns whether a request with the method modifies
+// resources, and is printed instead of sent.
+func ModifiesResources(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTokenRequest returns whether the path is a UAA OAuth endpoint. Requests to
+// it only authenticate the session, so they are sent in a dry run.
+func IsTokenRequest(path string) bool {
+	return strings.Contains(path, "/oauth/")
+}
+
+// SummarizeBody returns the body of a request to path of the content type as
+// compact JSON, with passwords, secrets, tokens, credentials and environment
+// variable values redacted, shortened to MaxBodyLength characters. Bodies
+// that are not JSON are only described, without reading them.
+func SummarizeBody(path string, contentType string, body io.Reader) (string, error) {
+	description := fmt.Sprintf("[%s body]", contentType)
+	if !strings.Contains(contentType, "json") {
+		return description, nil
+	}
+
+	var parsedBody interface{}
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	err := decoder.Decode(&parsedBody)
+	if err == io.EOF {
+		return "", nil
+	}
+	if err != nil {
+		return description, nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if strings.Contains(path, "/environment_variable_groups/") {
+		parsedBody = redactEnvironmentVariables(parsedBody)
+	}
+	err = encoder.Encode(redact(parsedBody))
+	if err != nil {
+		return "", err
+	}
+
+	summary := []rune(strings.TrimSpace(buffer.String()))
+	if len(summary) > MaxBodyLength {
+		return string(summary[:MaxBodyLength]) + "...", nil
+	}
+	return string(summary), nil
+}
+
+func redact(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case []interface{}:
+		for i, element := range typedValue {
+			typedValue[i] = redact(element)
+		}
+	case map[string]interface{}:
+		for key, element := range typedValue {
+			switch {
+			case sensitiveKeys.MatchString(key):
+				typedValue[key] = RedactedValue
+			case environmentVariableKeys.MatchString(key):
+				typedValue[key] = redactEnvironmentVariables(element)
+			default:
+				typedValue[key] = redact(element)
+			}
+		}
+	}
+	return value
+}
+
+func redactEnvironmentVariables(value interface{}) interface{} {
+	variables, ok := value.(map[string]interface{})
+	if !ok {
+		return RedactedValue
+	}
+	for name := range variables {
+		variables[name] = RedactedValue
+	}
+	return variables
+}
@@ -0,0 +1,84 @@
+package dryrun_test
+
+import (
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/dryrun"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dry Run", func() {
+	DescribeTable("ModifiesResources",
+		func(method string, modifies bool) {
+			Expect(ModifiesResources(method)).To(Equal(modifies))
+		},
+
+		Entry("GET", http.MethodGet, false),
+		Entry("HEAD", http.MethodHead, false),
+		Entry("POST", http.MethodPost, true),
+		Entry("PUT", http.MethodPut, true),
+		Entry("PATCH", http.MethodPatch, true),
+		Entry("DELETE", http.MethodDelete, true),
+	)
+
+	DescribeTable("IsTokenRequest",
+		func(path string, token bool) {
+			Expect(IsTokenRequest(path)).To(Equal(token))
+		},
+
+		Entry("the token endpoint", "/oauth/token", true),
+		Entry("the token endpoint under a context path", "/uaa/oauth/token", true),
+		Entry("the users endpoint", "/Users", false),
+	)
+
+	Describe("SummarizeBody", func() {
+		It("compacts JSON bodies", func() {
+			summary, err := SummarizeBody("/v2/apps", "application/json", strings.NewReader("{\n  \"instances\": 3\n}"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"instances":3}`))
+		})
+
+		It("shortens long bodies", func() {
+			summary, err := SummarizeBody("/v2/apps", "application/json", strings.NewReader(`{"name":"`+strings.Repeat("a", 300)+`"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"name":"` + strings.Repeat("a", 191) + "..."))
+		})
+
+		It("redacts passwords, secrets, tokens and credentials", func() {
+			summary, err := SummarizeBody("/Users", "application/json", strings.NewReader(`{"userName":"some-user","password":"some-password","client_secret":"some-secret","refresh_token":"some-token","entity":{"credentials":{"uri":"some-uri"}}}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"client_secret":"[PRIVATE DATA HIDDEN]","entity":{"credentials":"[PRIVATE DATA HIDDEN]"},"password":"[PRIVATE DATA HIDDEN]","refresh_token":"[PRIVATE DATA HIDDEN]","userName":"some-user"}`))
+		})
+
+		It("redacts the values of environment variables and keeps their names", func() {
+			summary, err := SummarizeBody("/v2/apps/some-guid", "application/json", strings.NewReader(`{"environment_json":{"SOME_KEY":"some-value"},"instances":3}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"environment_json":{"SOME_KEY":"[PRIVATE DATA HIDDEN]"},"instances":3}`))
+
+			summary, err = SummarizeBody("/v3/apps/some-guid/environment_variables", "application/json", strings.NewReader(`{"var":{"SOME_KEY":"some-value"}}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"var":{"SOME_KEY":"[PRIVATE DATA HIDDEN]"}}`))
+
+			summary, err = SummarizeBody("/v2/config/environment_variable_groups/running", "application/json", strings.NewReader(`{"SOME_KEY":"some-value"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(`{"SOME_KEY":"[PRIVATE DATA HIDDEN]"}`))
+		})
+
+		It("describes JSON bodies that cannot be parsed, since they cannot be redacted", func() {
+			summary, err := SummarizeBody("/v2/apps", "application/json", strings.NewReader(`{"password":`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal("[application/json body]"))
+		})
+
+		It("describes bodies that are not JSON without reading them", func() {
+			body := strings.NewReader("some-bits")
+			summary, err := SummarizeBody("/v3/packages/some-guid/upload", "multipart/form-data", body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal("[multipart/form-data body]"))
+			Expect(body.Len()).To(Equal(9))
+		})
+	})
+})
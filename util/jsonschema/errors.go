@@ -0,0 +1,33 @@
+package jsonschema
+
+import "fmt"
+
+// ValidationError is a violation of a schema by the value at Pointer, a JSON
+// pointer such as /cluster_nodes/count. The pointer of the whole value is
+// empty.
+type ValidationError struct {
+	Pointer string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Location(), e.Message)
+}
+
+// Location returns the pointer of the value, or / for the whole value.
+func (e ValidationError) Location() string {
+	if e.Pointer == "" {
+		return "/"
+	}
+	return e.Pointer
+}
+
+// SchemaError is returned when a schema is not valid or uses a $ref that
+// cannot be resolved.
+type SchemaError struct {
+	Message string
+}
+
+func (e SchemaError) Error() string {
+	return "invalid JSON schema: " + e.Message
+}
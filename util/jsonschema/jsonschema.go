@@ -0,0 +1,526 @@
+// Package jsonschema validates JSON values against the JSON schemas service
+// brokers publish for service instance parameters.
+//
+// The validation keywords of JSON Schema draft 4 to 7 are supported: type,
+// enum, const, the numeric, string, array and object constraints, allOf,
+// anyOf, oneOf and not, and $ref to locations within the same schema. Other
+// keywords, such as format, are ignored. Each violation is reported with the
+// JSON pointer of the offending value.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Schema is a parsed JSON schema.
+type Schema struct {
+	root interface{}
+}
+
+// Parse parses a JSON schema.
+func Parse(raw []byte) (Schema, error) {
+	var root interface{}
+	err := json.Unmarshal(raw, &root)
+	if err != nil {
+		return Schema{}, SchemaError{Message: err.Error()}
+	}
+	switch root.(type) {
+	case map[string]interface{}, bool:
+	default:
+		return Schema{}, SchemaError{Message: "a schema must be an object"}
+	}
+	return Schema{root: root}, nil
+}
+
+// Validate returns every violation of the schema by value, sorted by
+// pointer. value is the result of decoding JSON into an interface{}; Go
+// integers are also accepted as numbers.
+func (schema Schema) Validate(value interface{}) ([]ValidationError, error) {
+	v := validator{root: schema.root}
+	err := v.validate(schema.root, normalize(value), "")
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(v.errors, func(i int, j int) bool {
+		return v.errors[i].Pointer < v.errors[j].Pointer
+	})
+	return v.errors, nil
+}
+
+type validator struct {
+	root   interface{}
+	errors []ValidationError
+	depth  int
+}
+
+// maxRefDepth bounds how many $refs are followed while validating a single
+// value, so that recursive schemas terminate.
+const maxRefDepth = 64
+
+func (v *validator) fail(pointer string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+// matches reports whether value matches schema without recording errors.
+func (v *validator) matches(schema interface{}, value interface{}, pointer string) (bool, error) {
+	nested := validator{root: v.root, depth: v.depth}
+	err := nested.validate(schema, value, pointer)
+	return len(nested.errors) == 0, err
+}
+
+func (v *validator) validate(rawSchema interface{}, value interface{}, pointer string) error {
+	switch schema := rawSchema.(type) {
+	case bool:
+		if !schema {
+			v.fail(pointer, "is not allowed")
+		}
+		return nil
+	case map[string]interface{}:
+		if ref, ok := schema["$ref"].(string); ok {
+			return v.validateRef(ref, value, pointer)
+		}
+		return v.validateObjectSchema(schema, value, pointer)
+	default:
+		return SchemaError{Message: fmt.Sprintf("a schema must be an object, got %s", typeOf(rawSchema))}
+	}
+}
+
+func (v *validator) validateRef(ref string, value interface{}, pointer string) error {
+	if v.depth >= maxRefDepth {
+		return SchemaError{Message: fmt.Sprintf("$ref %s is too deeply nested", ref)}
+	}
+	target, err := resolve(v.root, ref)
+	if err != nil {
+		return err
+	}
+	v.depth++
+	defer func() { v.depth-- }()
+	return v.validate(target, value, pointer)
+}
+
+func (v *validator) validateObjectSchema(schema map[string]interface{}, value interface{}, pointer string) error {
+	if rawType, ok := schema["type"]; ok && !matchesType(rawType, value) {
+		v.fail(pointer, "must be of type %s, not %s", describeType(rawType), typeOf(value))
+		return nil
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if equal(allowed, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.fail(pointer, "must be one of %s", formatList(enum))
+		}
+	}
+
+	if constant, ok := schema["const"]; ok && !equal(constant, value) {
+		v.fail(pointer, "must be %s", format(constant))
+	}
+
+	switch typed := value.(type) {
+	case float64:
+		v.validateNumber(schema, typed, pointer)
+	case string:
+		err := v.validateString(schema, typed, pointer)
+		if err != nil {
+			return err
+		}
+	case []interface{}:
+		err := v.validateArray(schema, typed, pointer)
+		if err != nil {
+			return err
+		}
+	case map[string]interface{}:
+		err := v.validateObject(schema, typed, pointer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return v.validateCombinators(schema, value, pointer)
+}
+
+func (v *validator) validateNumber(schema map[string]interface{}, value float64, pointer string) {
+	if minimum, ok := number(schema["minimum"]); ok {
+		if exclusive, _ := schema["exclusiveMinimum"].(bool); exclusive {
+			if value <= minimum {
+				v.fail(pointer, "must be greater than %s", formatNumber(minimum))
+			}
+		} else if value < minimum {
+			v.fail(pointer, "must be at least %s", formatNumber(minimum))
+		}
+	}
+	if minimum, ok := number(schema["exclusiveMinimum"]); ok && value <= minimum {
+		v.fail(pointer, "must be greater than %s", formatNumber(minimum))
+	}
+
+	if maximum, ok := number(schema["maximum"]); ok {
+		if exclusive, _ := schema["exclusiveMaximum"].(bool); exclusive {
+			if value >= maximum {
+				v.fail(pointer, "must be less than %s", formatNumber(maximum))
+			}
+		} else if value > maximum {
+			v.fail(pointer, "must be at most %s", formatNumber(maximum))
+		}
+	}
+	if maximum, ok := number(schema["exclusiveMaximum"]); ok && value >= maximum {
+		v.fail(pointer, "must be less than %s", formatNumber(maximum))
+	}
+
+	if multipleOf, ok := number(schema["multipleOf"]); ok && multipleOf > 0 {
+		quotient := value / multipleOf
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			v.fail(pointer, "must be a multiple of %s", formatNumber(multipleOf))
+		}
+	}
+}
+
+func (v *validator) validateString(schema map[string]interface{}, value string, pointer string) error {
+	length := len([]rune(value))
+	if minLength, ok := number(schema["minLength"]); ok && float64(length) < minLength {
+		v.fail(pointer, "must be at least %s characters long", formatNumber(minLength))
+	}
+	if maxLength, ok := number(schema["maxLength"]); ok && float64(length) > maxLength {
+		v.fail(pointer, "must be at most %s characters long", formatNumber(maxLength))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		expression, err := regexp.Compile(pattern)
+		if err != nil {
+			return SchemaError{Message: fmt.Sprintf("invalid pattern %q: %s", pattern, err)}
+		}
+		if !expression.MatchString(value) {
+			v.fail(pointer, "must match the pattern %s", pattern)
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateArray(schema map[string]interface{}, value []interface{}, pointer string) error {
+	if minItems, ok := number(schema["minItems"]); ok && float64(len(value)) < minItems {
+		v.fail(pointer, "must have at least %s items", formatNumber(minItems))
+	}
+	if maxItems, ok := number(schema["maxItems"]); ok && float64(len(value)) > maxItems {
+		v.fail(pointer, "must have at most %s items", formatNumber(maxItems))
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range value {
+			for j := 0; j < i; j++ {
+				if equal(value[i], value[j]) {
+					v.fail(pointer+"/"+strconv.Itoa(i), "duplicates item %d", j)
+					break
+				}
+			}
+		}
+	}
+
+	switch items := schema["items"].(type) {
+	case []interface{}:
+		for i, item := range value {
+			var itemSchema interface{}
+			if i < len(items) {
+				itemSchema = items[i]
+			} else if additional, ok := schema["additionalItems"]; ok {
+				itemSchema = additional
+			} else {
+				continue
+			}
+			err := v.validate(itemSchema, item, pointer+"/"+strconv.Itoa(i))
+			if err != nil {
+				return err
+			}
+		}
+	case nil:
+	default:
+		for i, item := range value {
+			err := v.validate(items, item, pointer+"/"+strconv.Itoa(i))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateObject(schema map[string]interface{}, value map[string]interface{}, pointer string) error {
+	if minProperties, ok := number(schema["minProperties"]); ok && float64(len(value)) < minProperties {
+		v.fail(pointer, "must have at least %s properties", formatNumber(minProperties))
+	}
+	if maxProperties, ok := number(schema["maxProperties"]); ok && float64(len(value)) > maxProperties {
+		v.fail(pointer, "must have at most %s properties", formatNumber(maxProperties))
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, rawName := range required {
+			name, _ := rawName.(string)
+			if _, present := value[name]; !present {
+				v.fail(childPointer(pointer, name), "is required")
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	patternProperties, _ := schema["patternProperties"].(map[string]interface{})
+	additionalProperties, hasAdditionalProperties := schema["additionalProperties"]
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		matched := false
+		if propertySchema, ok := properties[name]; ok {
+			matched = true
+			err := v.validate(propertySchema, value[name], childPointer(pointer, name))
+			if err != nil {
+				return err
+			}
+		}
+
+		for pattern, propertySchema := range patternProperties {
+			expression, err := regexp.Compile(pattern)
+			if err != nil {
+				return SchemaError{Message: fmt.Sprintf("invalid pattern %q: %s", pattern, err)}
+			}
+			if expression.MatchString(name) {
+				matched = true
+				err = v.validate(propertySchema, value[name], childPointer(pointer, name))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if !matched && hasAdditionalProperties {
+			err := v.validate(additionalProperties, value[name], childPointer(pointer, name))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateCombinators(schema map[string]interface{}, value interface{}, pointer string) error {
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, subschema := range allOf {
+			err := v.validate(subschema, value, pointer)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		count, err := v.countMatches(anyOf, value, pointer)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			v.fail(pointer, "must match at least one of the allowed schemas")
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		count, err := v.countMatches(oneOf, value, pointer)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			v.fail(pointer, "must match exactly one of the allowed schemas, but matches %d", count)
+		}
+	}
+
+	if not, ok := schema["not"]; ok {
+		matched, err := v.matches(not, value, pointer)
+		if err != nil {
+			return err
+		}
+		if matched {
+			v.fail(pointer, "must not match the disallowed schema")
+		}
+	}
+	return nil
+}
+
+func (v *validator) countMatches(schemas []interface{}, value interface{}, pointer string) (int, error) {
+	count := 0
+	for _, subschema := range schemas {
+		matched, err := v.matches(subschema, value, pointer)
+		if err != nil {
+			return 0, err
+		}
+		if matched {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// resolve returns the part of root that ref points to. Only references within
+// the schema, such as #/definitions/size, are supported.
+func resolve(root interface{}, ref string) (interface{}, error) {
+	if ref != "#" && !strings.HasPrefix(ref, "#/") {
+		return nil, SchemaError{Message: fmt.Sprintf("unsupported $ref %s", ref)}
+	}
+
+	current := root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, ok := node[token]
+			if !ok {
+				return nil, SchemaError{Message: fmt.Sprintf("$ref %s cannot be resolved", ref)}
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, SchemaError{Message: fmt.Sprintf("$ref %s cannot be resolved", ref)}
+			}
+			current = node[index]
+		default:
+			return nil, SchemaError{Message: fmt.Sprintf("$ref %s cannot be resolved", ref)}
+		}
+	}
+	return current, nil
+}
+
+// childPointer returns the JSON pointer of the member name of the value at
+// pointer, escaping '~' and '/' as RFC 6901 requires.
+func childPointer(pointer string, name string) string {
+	return pointer + "/" + strings.Replace(strings.Replace(name, "~", "~0", -1), "/", "~1", -1)
+}
+
+func matchesType(rawType interface{}, value interface{}) bool {
+	switch typ := rawType.(type) {
+	case string:
+		return isType(typ, value)
+	case []interface{}:
+		for _, entry := range typ {
+			if name, ok := entry.(string); ok && isType(name, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func isType(name string, value interface{}) bool {
+	actual := typeOf(value)
+	if name == "number" && actual == "integer" {
+		return true
+	}
+	return name == actual
+}
+
+func describeType(rawType interface{}) string {
+	if types, ok := rawType.([]interface{}); ok {
+		names := []string{}
+		for _, entry := range types {
+			names = append(names, fmt.Sprint(entry))
+		}
+		return strings.Join(names, " or ")
+	}
+	return fmt.Sprint(rawType)
+}
+
+// typeOf returns the JSON schema type of value. Numbers without a fractional
+// part are integers.
+func typeOf(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) && !math.IsInf(typed, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// normalize converts Go numbers and json.Numbers in value to float64, as
+// json.Unmarshal would have decoded them.
+func normalize(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(typed))
+		for key, entry := range typed {
+			normalized[key] = normalize(entry)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(typed))
+		for i, entry := range typed {
+			normalized[i] = normalize(entry)
+		}
+		return normalized
+	case json.Number:
+		if f, err := typed.Float64(); err == nil {
+			return f
+		}
+		return string(typed)
+	}
+
+	if f, ok := number(value); ok {
+		return f
+	}
+	return value
+}
+
+func number(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return reflect.ValueOf(typed).Convert(reflect.TypeOf(float64(0))).Float(), true
+	}
+	return 0, false
+}
+
+func equal(a interface{}, b interface{}) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func format(value interface{}) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(raw)
+}
+
+func formatList(values []interface{}) string {
+	formatted := []string{}
+	for _, value := range values {
+		formatted = append(formatted, format(value))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,127 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/jsonschema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schema", func() {
+	validate := func(schema string, value string) []ValidationError {
+		parsed, err := Parse([]byte(schema))
+		Expect(err).ToNot(HaveOccurred())
+
+		var decoded interface{}
+		Expect(json.Unmarshal([]byte(value), &decoded)).To(Succeed())
+
+		validationErrors, err := parsed.Validate(decoded)
+		Expect(err).ToNot(HaveOccurred())
+		return validationErrors
+	}
+
+	DescribeTable("valid values",
+		func(schema string, value string) {
+			Expect(validate(schema, value)).To(BeEmpty())
+		},
+		Entry("empty schema", `{}`, `{"anything": [1, "two"]}`),
+		Entry("type", `{"type": "integer"}`, `3`),
+		Entry("integer as number", `{"type": "number"}`, `3`),
+		Entry("list of types", `{"type": ["string", "null"]}`, `null`),
+		Entry("enum", `{"enum": ["small", 2]}`, `2`),
+		Entry("const", `{"const": {"a": 1}}`, `{"a": 1}`),
+		Entry("bounds", `{"minimum": 1, "maximum": 3, "exclusiveMinimum": 0, "multipleOf": 0.5}`, `2.5`),
+		Entry("string constraints", `{"minLength": 2, "maxLength": 3, "pattern": "^[a-z]+$"}`, `"abc"`),
+		Entry("array constraints", `{"items": {"type": "integer"}, "minItems": 1, "uniqueItems": true}`, `[1, 2]`),
+		Entry("tuple items", `{"items": [{"type": "string"}, {"type": "integer"}]}`, `["a", 1, true]`),
+		Entry("required and properties", `{"properties": {"a": {"type": "string"}}, "required": ["a"], "additionalProperties": false}`, `{"a": "x"}`),
+		Entry("patternProperties", `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": false}`, `{"x-a": "b"}`),
+		Entry("anyOf", `{"anyOf": [{"type": "string"}, {"type": "integer"}]}`, `1`),
+		Entry("oneOf", `{"oneOf": [{"type": "string"}, {"type": "integer"}]}`, `"a"`),
+		Entry("not", `{"not": {"type": "string"}}`, `1`),
+		Entry("$ref", `{"definitions": {"size": {"type": "integer"}}, "properties": {"size": {"$ref": "#/definitions/size"}}}`, `{"size": 1}`),
+	)
+
+	DescribeTable("invalid values",
+		func(schema string, value string, expected []ValidationError) {
+			Expect(validate(schema, value)).To(Equal(expected))
+		},
+		Entry("type", `{"type": "integer"}`, `1.5`, []ValidationError{{Pointer: "", Message: "must be of type integer, not number"}}),
+		Entry("list of types", `{"type": ["string", "null"]}`, `1`, []ValidationError{{Pointer: "", Message: "must be of type string or null, not integer"}}),
+		Entry("enum", `{"enum": ["small", 2]}`, `"large"`, []ValidationError{{Pointer: "", Message: `must be one of "small", 2`}}),
+		Entry("minimum", `{"minimum": 1}`, `0`, []ValidationError{{Pointer: "", Message: "must be at least 1"}}),
+		Entry("draft 4 exclusiveMaximum", `{"maximum": 1, "exclusiveMaximum": true}`, `1`, []ValidationError{{Pointer: "", Message: "must be less than 1"}}),
+		Entry("multipleOf", `{"multipleOf": 0.5}`, `0.7`, []ValidationError{{Pointer: "", Message: "must be a multiple of 0.5"}}),
+		Entry("pattern", `{"pattern": "^[a-z]+$"}`, `"ABC"`, []ValidationError{{Pointer: "", Message: "must match the pattern ^[a-z]+$"}}),
+		Entry("uniqueItems", `{"uniqueItems": true}`, `[1, 2, 1]`, []ValidationError{{Pointer: "/2", Message: "duplicates item 0"}}),
+		Entry("anyOf", `{"anyOf": [{"type": "string"}, {"type": "integer"}]}`, `true`, []ValidationError{{Pointer: "", Message: "must match at least one of the allowed schemas"}}),
+		Entry("oneOf", `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`, `1`, []ValidationError{{Pointer: "", Message: "must match exactly one of the allowed schemas, but matches 2"}}),
+		Entry("additionalProperties", `{"properties": {"a": {}}, "additionalProperties": false}`, `{"a": 1, "b/c": 2}`, []ValidationError{{Pointer: "/b~1c", Message: "is not allowed"}}),
+	)
+
+	It("reports every violation with the pointer of the offending value", func() {
+		schema := `{
+			"type": "object",
+			"properties": {
+				"cluster_nodes": {
+					"type": "object",
+					"properties": {
+						"count": {"type": "integer", "minimum": 1},
+						"memory_mb": {"type": "integer", "enum": [512, 1024]}
+					},
+					"required": ["count", "memory_mb"]
+				},
+				"zones": {"type": "array", "items": {"type": "string"}}
+			},
+			"required": ["cluster_nodes", "plan_size"]
+		}`
+
+		Expect(validate(schema, `{"cluster_nodes": {"count": 0}, "zones": ["a", 2]}`)).To(Equal([]ValidationError{
+			{Pointer: "/cluster_nodes/count", Message: "must be at least 1"},
+			{Pointer: "/cluster_nodes/memory_mb", Message: "is required"},
+			{Pointer: "/plan_size", Message: "is required"},
+			{Pointer: "/zones/1", Message: "must be of type string, not integer"},
+		}))
+	})
+
+	It("accepts Go integers as numbers", func() {
+		schema, err := Parse([]byte(`{"properties": {"count": {"type": "integer", "maximum": 3}}}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		validationErrors, err := schema.Validate(map[string]interface{}{"count": 5})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validationErrors).To(Equal([]ValidationError{{Pointer: "/count", Message: "must be at most 3"}}))
+	})
+
+	Describe("ValidationError", func() {
+		It("shows where the violation is", func() {
+			Expect(ValidationError{Pointer: "/a/0", Message: "is required"}.Error()).To(Equal("/a/0: is required"))
+			Expect(ValidationError{Message: "must be of type object, not array"}.Error()).To(Equal("/: must be of type object, not array"))
+		})
+	})
+
+	Describe("invalid schemas", func() {
+		It("rejects schemas that are not objects", func() {
+			_, err := Parse([]byte(`[]`))
+			Expect(err).To(BeAssignableToTypeOf(SchemaError{}))
+		})
+
+		It("rejects $refs that cannot be resolved", func() {
+			schema, err := Parse([]byte(`{"$ref": "#/definitions/missing"}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = schema.Validate(1)
+			Expect(err).To(MatchError("invalid JSON schema: $ref #/definitions/missing cannot be resolved"))
+		})
+
+		It("stops following recursive $refs", func() {
+			schema, err := Parse([]byte(`{"$ref": "#"}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = schema.Validate(1)
+			Expect(err).To(BeAssignableToTypeOf(SchemaError{}))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package manifest
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Reference is a line of a manifest that mentions a name.
+type Reference struct {
+	Path string
+	Line int
+}
+
+// FindReferences searches the manifests in the repository containing dir for
+// lines mentioning name as a whole word, such as a space name embedded in a
+// route or service instance name. The repository is the closest directory
+// above dir containing .git, or dir itself when there is none. Hidden,
+// node_modules and vendor directories are skipped.
+func FindReferences(dir string, name string) ([]Reference, error) {
+	root, err := repositoryRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(name) + `($|[^\w-])`)
+
+	var references []Reference
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path != root && (strings.HasPrefix(info.Name(), ".") || info.Name() == "node_modules" || info.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !isManifestFileName(info.Name()) {
+			return nil
+		}
+
+		lines, err := matchingLines(path, pattern)
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			references = append(references, Reference{Path: path, Line: line})
+		}
+		return nil
+	})
+
+	return references, err
+}
+
+func repositoryRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, ".git")); err == nil {
+			return current, nil
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir, nil
+		}
+		current = parent
+	}
+}
+
+func isManifestFileName(name string) bool {
+	ext := filepath.Ext(name)
+	return strings.HasPrefix(name, "manifest") && (ext == ".yml" || ext == ".yaml")
+}
+
+func matchingLines(path string, pattern *regexp.Regexp) ([]int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []int
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(text), "#") {
+			continue
+		}
+		if pattern.MatchString(text) {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
@@ -0,0 +1,91 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FindReferences", func() {
+	var (
+		repoDir    string
+		nestedDir  string
+		references []Reference
+		executeErr error
+	)
+
+	writeFile := func(path string, contents string) string {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		repoDir, err = ioutil.TempDir("", "manifest-references")
+		Expect(err).ToNot(HaveOccurred())
+		repoDir, err = filepath.EvalSymlinks(repoDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		nestedDir = filepath.Join(repoDir, "apps", "web")
+		Expect(os.MkdirAll(nestedDir, 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(repoDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		references, executeErr = FindReferences(nestedDir, "staging")
+	})
+
+	When("the directory is in a repository", func() {
+		var manifestPath string
+
+		BeforeEach(func() {
+			Expect(os.Mkdir(filepath.Join(repoDir, ".git"), 0755)).To(Succeed())
+
+			manifestPath = writeFile(filepath.Join(repoDir, "manifest.yml"), `---
+applications:
+- name: my-app
+  # deployed to staging
+  routes:
+  - route: my-app.staging.example.com
+  services:
+  - staging-db
+  - prestaging
+`)
+			writeFile(filepath.Join(repoDir, "apps", "manifest-staging.yaml"), "applications:\n- name: staging\n")
+			writeFile(filepath.Join(repoDir, "apps", "staging.txt"), "staging\n")
+			writeFile(filepath.Join(repoDir, "vendor", "manifest.yml"), "staging\n")
+			writeFile(filepath.Join(repoDir, ".git", "manifest.yml"), "staging\n")
+		})
+
+		It("returns the manifest lines mentioning the name anywhere in the repository", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(references).To(ConsistOf(
+				Reference{Path: filepath.Join(repoDir, "apps", "manifest-staging.yaml"), Line: 2},
+				Reference{Path: manifestPath, Line: 6},
+			))
+		})
+	})
+
+	When("the directory is not in a repository", func() {
+		BeforeEach(func() {
+			writeFile(filepath.Join(repoDir, "manifest.yml"), "staging\n")
+			writeFile(filepath.Join(nestedDir, "manifest.yml"), "applications:\n- name: staging\n")
+		})
+
+		It("only searches the directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(references).To(ConsistOf(
+				Reference{Path: filepath.Join(nestedDir, "manifest.yml"), Line: 2},
+			))
+		})
+	})
+})
@@ -0,0 +1,477 @@
+package manifestparser
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DiagnosticSeverity indicates whether a Diagnostic prevents the manifest from
+// being applied.
+type DiagnosticSeverity string
+
+const (
+	DiagnosticError   DiagnosticSeverity = "error"
+	DiagnosticWarning DiagnosticSeverity = "warning"
+)
+
+// Diagnostic is a single problem found while linting a manifest.
+type Diagnostic struct {
+	Position
+	Path     string
+	Severity DiagnosticSeverity
+	Message  string
+}
+
+// ReferenceType is the kind of foundation resource referenced by a manifest.
+type ReferenceType string
+
+const (
+	BuildpackReference       ReferenceType = "buildpack"
+	RouteReference           ReferenceType = "route"
+	ServiceInstanceReference ReferenceType = "service"
+	StackReference           ReferenceType = "stack"
+)
+
+// Reference is a foundation resource referenced by name in a manifest.
+type Reference struct {
+	Position
+	Path string
+	Type ReferenceType
+	Name string
+}
+
+type attributeCheck func(value interface{}) string
+
+var (
+	placeholderRegexp = regexp.MustCompile(`^\(\([-\w\.]+\)\)$`)
+	byteSizeRegexp    = regexp.MustCompile(`(?i)^\d+(\.\d+)?\s*(B|K|KB|M|MB|G|GB|T|TB)$`)
+
+	processAttributes = map[string]attributeCheck{
+		"command":                         checkString,
+		"disk_quota":                      checkByteSize,
+		"health-check-http-endpoint":      checkString,
+		"health-check-invocation-timeout": checkNonNegativeInt,
+		"health-check-type":               checkHealthCheckType,
+		"instances":                       checkNonNegativeInt,
+		"memory":                          checkByteSize,
+		"timeout":                         checkNonNegativeInt,
+		"type":                            checkString,
+	}
+
+	applicationAttributes = map[string]attributeCheck{
+		"buildpacks":    checkStringList,
+		"default-route": checkBool,
+		"docker":        checkMap,
+		"env":           checkMap,
+		"metadata":      checkMap,
+		"name":          checkString,
+		"no-route":      checkBool,
+		"path":          checkString,
+		"processes":     checkList,
+		"random-route":  checkBool,
+		"routes":        checkList,
+		"services":      checkList,
+		"sidecars":      checkList,
+		"stack":         checkString,
+	}
+
+	deprecatedApplicationAttributes = map[string]string{
+		"buildpack":   "'buildpack' is deprecated, use 'buildpacks' instead",
+		"domain":      "'domain' is deprecated, use 'routes' instead",
+		"domains":     "'domains' is deprecated, use 'routes' instead",
+		"host":        "'host' is deprecated, use 'routes' instead",
+		"hosts":       "'hosts' is deprecated, use 'routes' instead",
+		"no-hostname": "'no-hostname' is deprecated, use 'routes' instead",
+	}
+)
+
+func init() {
+	for attribute, check := range processAttributes {
+		if attribute != "type" {
+			applicationAttributes[attribute] = check
+		}
+	}
+}
+
+type linter struct {
+	positions   positionIndex
+	diagnostics []Diagnostic
+	references  []Reference
+}
+
+// Lint checks the provided raw manifest for schema violations, unknown and
+// deprecated attributes, and returns every problem found along with the
+// position it was found at. Values that are unresolved ((variables)) are not
+// type checked.
+func Lint(rawManifest []byte) ([]Diagnostic, error) {
+	lint, err := lintManifest(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+	return lint.diagnostics, nil
+}
+
+// References returns every buildpack, stack, service instance and route named
+// in the provided raw manifest.
+func References(rawManifest []byte) ([]Reference, error) {
+	lint, err := lintManifest(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+	return lint.references, nil
+}
+
+func lintManifest(rawManifest []byte) (*linter, error) {
+	var manifest map[string]interface{}
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, InvalidYAMLError{Err: err}
+	}
+
+	lint := &linter{positions: newPositionIndex(rawManifest)}
+	lint.checkManifest(manifest)
+
+	sort.SliceStable(lint.diagnostics, func(i, j int) bool {
+		if lint.diagnostics[i].Line == lint.diagnostics[j].Line {
+			return lint.diagnostics[i].Column < lint.diagnostics[j].Column
+		}
+		return lint.diagnostics[i].Line < lint.diagnostics[j].Line
+	})
+
+	return lint, nil
+}
+
+func (lint *linter) report(path string, severity DiagnosticSeverity, format string, args ...interface{}) {
+	lint.diagnostics = append(lint.diagnostics, Diagnostic{
+		Position: lint.positions.lookup(path),
+		Path:     path,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (lint *linter) reference(path string, referenceType ReferenceType, name string) {
+	if name == "" || isPlaceholder(name) {
+		return
+	}
+
+	lint.references = append(lint.references, Reference{
+		Position: lint.positions.lookup(path),
+		Path:     path,
+		Type:     referenceType,
+		Name:     name,
+	})
+}
+
+func (lint *linter) checkManifest(manifest map[string]interface{}) {
+	for _, key := range sortedKeys(manifest) {
+		switch {
+		case key == "applications":
+		case key == "version":
+			if version, ok := manifest[key].(int); !ok || version != 1 {
+				lint.report(key, DiagnosticError, "unsupported manifest version, only version 1 is supported")
+			}
+		case key == "inherit":
+			lint.report(key, DiagnosticError, "'inherit' is no longer supported")
+		case applicationAttributes[key] != nil || deprecatedApplicationAttributes[key] != "":
+			lint.report(key, DiagnosticWarning, "global attribute '%s' is deprecated, set it on each application instead", key)
+		default:
+			lint.report(key, DiagnosticWarning, "unknown attribute '%s'", key)
+		}
+	}
+
+	applications, ok := manifest["applications"].([]interface{})
+	if !ok || len(applications) == 0 {
+		lint.report("applications", DiagnosticError, "manifest must contain a non-empty 'applications' list")
+		return
+	}
+
+	names := map[string]string{}
+	for i, rawApplication := range applications {
+		path := fmt.Sprintf("applications[%d]", i)
+		application, ok := toStringMap(rawApplication)
+		if !ok {
+			lint.report(path, DiagnosticError, "application must be a map")
+			continue
+		}
+
+		name, _ := application["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			lint.report(path, DiagnosticError, "application must have a 'name'")
+		} else if previous, found := names[name]; found {
+			lint.report(path+".name", DiagnosticError, "application name '%s' is already used by %s", name, previous)
+		} else {
+			names[name] = path
+		}
+
+		lint.checkApplication(path, application)
+	}
+}
+
+func (lint *linter) checkApplication(path string, application map[string]interface{}) {
+	for _, key := range sortedKeys(application) {
+		attributePath := path + "." + key
+		value := application[key]
+
+		if message, deprecated := deprecatedApplicationAttributes[key]; deprecated {
+			lint.report(attributePath, DiagnosticWarning, "%s", message)
+			if key == "buildpack" {
+				if buildpack, ok := value.(string); ok {
+					lint.referenceBuildpack(attributePath, buildpack)
+				}
+			}
+			continue
+		}
+
+		check, known := applicationAttributes[key]
+		if !known {
+			lint.report(attributePath, DiagnosticWarning, "unknown attribute '%s'", key)
+			continue
+		}
+
+		if problem := check(value); problem != "" {
+			lint.report(attributePath, DiagnosticError, "'%s' %s", key, problem)
+			continue
+		}
+
+		switch key {
+		case "buildpacks":
+			for i, buildpack := range value.([]interface{}) {
+				name, _ := buildpack.(string)
+				lint.referenceBuildpack(fmt.Sprintf("%s[%d]", attributePath, i), name)
+			}
+		case "docker":
+			lint.checkDocker(attributePath, value)
+		case "env":
+			lint.checkEnv(attributePath, value)
+		case "processes":
+			lint.checkProcesses(attributePath, value.([]interface{}))
+		case "routes":
+			lint.checkRoutes(attributePath, value.([]interface{}))
+		case "services":
+			lint.checkServices(attributePath, value.([]interface{}))
+		case "stack":
+			stack, _ := value.(string)
+			lint.reference(attributePath, StackReference, stack)
+		}
+	}
+
+	_, hasDocker := application["docker"]
+	_, hasBuildpacks := application["buildpacks"]
+	_, hasBuildpack := application["buildpack"]
+	if hasDocker && (hasBuildpacks || hasBuildpack) {
+		lint.report(path+".docker", DiagnosticError, "'docker' cannot be used together with buildpacks")
+	}
+
+	_, hasRoutes := application["routes"]
+	if noRoute, _ := application["no-route"].(bool); noRoute && hasRoutes {
+		lint.report(path+".no-route", DiagnosticError, "'no-route' cannot be used together with 'routes'")
+	}
+	if randomRoute, _ := application["random-route"].(bool); randomRoute && hasRoutes {
+		lint.report(path+".random-route", DiagnosticWarning, "'random-route' is ignored when 'routes' are provided")
+	}
+}
+
+func (lint *linter) referenceBuildpack(path string, buildpack string) {
+	if buildpack == "default" || buildpack == "null" || strings.Contains(buildpack, "://") {
+		return
+	}
+	lint.reference(path, BuildpackReference, buildpack)
+}
+
+func (lint *linter) checkDocker(path string, value interface{}) {
+	docker, _ := toStringMap(value)
+	for _, key := range sortedKeys(docker) {
+		if key != "image" && key != "username" {
+			lint.report(path+"."+key, DiagnosticWarning, "unknown attribute '%s'", key)
+		}
+	}
+	if image, _ := docker["image"].(string); image == "" {
+		lint.report(path, DiagnosticError, "'docker' must have an 'image'")
+	}
+}
+
+func (lint *linter) checkEnv(path string, value interface{}) {
+	env, _ := toStringMap(value)
+	for _, key := range sortedKeys(env) {
+		switch env[key].(type) {
+		case map[interface{}]interface{}, []interface{}:
+			lint.report(path+"."+key, DiagnosticError, "environment variable '%s' must be a string, number or boolean", key)
+		}
+	}
+}
+
+func (lint *linter) checkProcesses(path string, processes []interface{}) {
+	types := map[string]bool{}
+	for i, rawProcess := range processes {
+		processPath := fmt.Sprintf("%s[%d]", path, i)
+		process, ok := toStringMap(rawProcess)
+		if !ok {
+			lint.report(processPath, DiagnosticError, "process must be a map")
+			continue
+		}
+
+		processType, _ := process["type"].(string)
+		if processType == "" {
+			lint.report(processPath, DiagnosticError, "process must have a 'type'")
+		} else if types[processType] {
+			lint.report(processPath+".type", DiagnosticError, "process type '%s' is declared more than once", processType)
+		}
+		types[processType] = true
+
+		for _, key := range sortedKeys(process) {
+			check, known := processAttributes[key]
+			if !known {
+				lint.report(processPath+"."+key, DiagnosticWarning, "unknown attribute '%s'", key)
+				continue
+			}
+			if problem := check(process[key]); problem != "" {
+				lint.report(processPath+"."+key, DiagnosticError, "'%s' %s", key, problem)
+			}
+		}
+	}
+}
+
+func (lint *linter) checkRoutes(path string, routes []interface{}) {
+	for i, rawRoute := range routes {
+		routePath := fmt.Sprintf("%s[%d]", path, i)
+		route, ok := toStringMap(rawRoute)
+		if !ok {
+			lint.report(routePath, DiagnosticError, "route must be a map with a 'route' attribute")
+			continue
+		}
+
+		for _, key := range sortedKeys(route) {
+			if key != "route" && key != "protocol" {
+				lint.report(routePath+"."+key, DiagnosticWarning, "unknown attribute '%s'", key)
+			}
+		}
+
+		url, _ := route["route"].(string)
+		if url == "" {
+			lint.report(routePath, DiagnosticError, "route must have a 'route' attribute")
+			continue
+		}
+		lint.reference(routePath+".route", RouteReference, url)
+	}
+}
+
+func (lint *linter) checkServices(path string, services []interface{}) {
+	for i, rawService := range services {
+		servicePath := fmt.Sprintf("%s[%d]", path, i)
+
+		var name string
+		switch service := rawService.(type) {
+		case string:
+			name = service
+		case map[interface{}]interface{}:
+			name, _ = service["name"].(string)
+			servicePath += ".name"
+		}
+
+		if name == "" {
+			lint.report(servicePath, DiagnosticError, "service must be a name or a map with a 'name' attribute")
+			continue
+		}
+		lint.reference(servicePath, ServiceInstanceReference, name)
+	}
+}
+
+func toStringMap(value interface{}) (map[string]interface{}, bool) {
+	rawMap, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	stringMap := map[string]interface{}{}
+	for key, value := range rawMap {
+		stringMap[fmt.Sprint(key)] = value
+	}
+	return stringMap, true
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isPlaceholder(value interface{}) bool {
+	str, ok := value.(string)
+	return ok && placeholderRegexp.MatchString(str)
+}
+
+func checkString(value interface{}) string {
+	if _, ok := value.(string); ok {
+		return ""
+	}
+	return "must be a string"
+}
+
+func checkBool(value interface{}) string {
+	if _, ok := value.(bool); ok || isPlaceholder(value) {
+		return ""
+	}
+	return "must be true or false"
+}
+
+func checkNonNegativeInt(value interface{}) string {
+	if number, ok := value.(int); (ok && number >= 0) || isPlaceholder(value) {
+		return ""
+	}
+	return "must be a non-negative integer"
+}
+
+func checkByteSize(value interface{}) string {
+	if str, ok := value.(string); ok && (byteSizeRegexp.MatchString(str) || isPlaceholder(str)) {
+		return ""
+	}
+	return "must be a size with a unit, e.g. 256M or 1G"
+}
+
+func checkHealthCheckType(value interface{}) string {
+	switch value {
+	case "port", "process", "http":
+		return ""
+	case "none":
+		return "'none' is deprecated, use 'process' instead"
+	}
+	if isPlaceholder(value) {
+		return ""
+	}
+	return "must be one of port, process or http"
+}
+
+func checkMap(value interface{}) string {
+	if _, ok := value.(map[interface{}]interface{}); ok {
+		return ""
+	}
+	return "must be a map"
+}
+
+func checkList(value interface{}) string {
+	if _, ok := value.([]interface{}); ok {
+		return ""
+	}
+	return "must be a list"
+}
+
+func checkStringList(value interface{}) string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return "must be a list of strings"
+	}
+	for _, item := range list {
+		if _, ok := item.(string); !ok {
+			return "must be a list of strings"
+		}
+	}
+	return ""
+}
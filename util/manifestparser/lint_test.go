@@ -0,0 +1,137 @@
+package manifestparser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lint", func() {
+	var (
+		rawManifest []byte
+
+		diagnostics []Diagnostic
+		executeErr  error
+	)
+
+	JustBeforeEach(func() {
+		diagnostics, executeErr = Lint(rawManifest)
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			rawManifest = []byte(`---
+version: 1
+applications:
+- name: some-app
+  memory: 256M
+  instances: ((instances))
+  buildpacks:
+  - ruby_buildpack
+  routes:
+  - route: example.com/path
+  services:
+  - some-service
+  - name: some-other-service
+  processes:
+  - type: web
+    health-check-type: http
+`)
+		})
+
+		It("returns no diagnostics", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diagnostics).To(BeEmpty())
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			rawManifest = []byte("applications: [")
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(InvalidYAMLError{}))
+		})
+	})
+
+	When("the manifest has no applications", func() {
+		BeforeEach(func() {
+			rawManifest = []byte("version: 1\n")
+		})
+
+		It("returns an error diagnostic", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diagnostics).To(ConsistOf(Diagnostic{
+				Path:     "applications",
+				Severity: DiagnosticError,
+				Message:  "manifest must contain a non-empty 'applications' list",
+			}))
+		})
+	})
+
+	When("the manifest has problems", func() {
+		BeforeEach(func() {
+			rawManifest = []byte(`---
+version: 2
+inherit: base.yml
+applications:
+- name: some-app
+  memory: lots
+  buildpack: ruby_buildpack
+  no-route: true
+  routes:
+  - route: example.com
+  bogus: value
+- name: some-app
+  docker:
+    image: some-image
+  buildpacks: [go_buildpack]
+  processes:
+  - instances: -1
+`)
+		})
+
+		It("returns a diagnostic for each problem at its position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(diagnostics).To(Equal([]Diagnostic{
+				{Position: Position{Line: 2, Column: 1}, Path: "version", Severity: DiagnosticError, Message: "unsupported manifest version, only version 1 is supported"},
+				{Position: Position{Line: 3, Column: 1}, Path: "inherit", Severity: DiagnosticError, Message: "'inherit' is no longer supported"},
+				{Position: Position{Line: 6, Column: 3}, Path: "applications[0].memory", Severity: DiagnosticError, Message: "'memory' must be a size with a unit, e.g. 256M or 1G"},
+				{Position: Position{Line: 7, Column: 3}, Path: "applications[0].buildpack", Severity: DiagnosticWarning, Message: "'buildpack' is deprecated, use 'buildpacks' instead"},
+				{Position: Position{Line: 8, Column: 3}, Path: "applications[0].no-route", Severity: DiagnosticError, Message: "'no-route' cannot be used together with 'routes'"},
+				{Position: Position{Line: 11, Column: 3}, Path: "applications[0].bogus", Severity: DiagnosticWarning, Message: "unknown attribute 'bogus'"},
+				{Position: Position{Line: 12, Column: 3}, Path: "applications[1].name", Severity: DiagnosticError, Message: "application name 'some-app' is already used by applications[0]"},
+				{Position: Position{Line: 13, Column: 3}, Path: "applications[1].docker", Severity: DiagnosticError, Message: "'docker' cannot be used together with buildpacks"},
+				{Position: Position{Line: 17, Column: 3}, Path: "applications[1].processes[0]", Severity: DiagnosticError, Message: "process must have a 'type'"},
+				{Position: Position{Line: 17, Column: 5}, Path: "applications[1].processes[0].instances", Severity: DiagnosticError, Message: "'instances' must be a non-negative integer"},
+			}))
+		})
+	})
+})
+
+var _ = Describe("References", func() {
+	It("returns the named foundation resources with their positions", func() {
+		references, err := References([]byte(`---
+applications:
+- name: some-app
+  stack: cflinuxfs3
+  buildpacks:
+  - https://github.com/some/buildpack
+  - go_buildpack
+  routes:
+  - route: app.example.com
+  services:
+  - name: some-service
+  - ((service))
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(references).To(ConsistOf(
+			Reference{Position: Position{Line: 7, Column: 3}, Path: "applications[0].buildpacks[1]", Type: BuildpackReference, Name: "go_buildpack"},
+			Reference{Position: Position{Line: 9, Column: 5}, Path: "applications[0].routes[0].route", Type: RouteReference, Name: "app.example.com"},
+			Reference{Position: Position{Line: 11, Column: 5}, Path: "applications[0].services[0].name", Type: ServiceInstanceReference, Name: "some-service"},
+			Reference{Position: Position{Line: 4, Column: 3}, Path: "applications[0].stack", Type: StackReference, Name: "cflinuxfs3"},
+		))
+	})
+})
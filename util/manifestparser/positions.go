@@ -0,0 +1,122 @@
+package manifestparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var keyRegexp = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s#'"\-\[\{][^:#]*|-[^\s:#][^:#]*):(\s|$)`)
+
+// Position is a 1-based line and column in a manifest file.
+type Position struct {
+	Line   int
+	Column int
+}
+
+type positionEntry struct {
+	indent     int
+	path       string
+	isSequence bool
+}
+
+// positionIndex maps manifest paths, such as "applications[0].routes[1].route",
+// to the position where they were declared. Only block style YAML is indexed;
+// values nested in flow style collections resolve to their closest indexed
+// parent.
+type positionIndex map[string]Position
+
+func newPositionIndex(rawManifest []byte) positionIndex {
+	index := positionIndex{}
+	sequenceCounts := map[string]int{}
+	var stack []positionEntry
+	blockScalarIndent := -1
+
+	scanner := bufio.NewScanner(bytes.NewReader(rawManifest))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+
+		if blockScalarIndent >= 0 {
+			if strings.TrimSpace(content) == "" || indent > blockScalarIndent {
+				continue
+			}
+			blockScalarIndent = -1
+		}
+
+		if content == "" || strings.HasPrefix(content, "#") || content == "---" {
+			continue
+		}
+
+		for content == "-" || strings.HasPrefix(content, "- ") {
+			for len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if top.indent > indent || (top.isSequence && top.indent >= indent) {
+					stack = stack[:len(stack)-1]
+					continue
+				}
+				break
+			}
+
+			parent := ""
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1].path
+			}
+			path := fmt.Sprintf("%s[%d]", parent, sequenceCounts[parent])
+			sequenceCounts[parent]++
+			index[path] = Position{Line: lineNumber, Column: indent + 1}
+			stack = append(stack, positionEntry{indent: indent, path: path, isSequence: true})
+
+			rest := strings.TrimLeft(strings.TrimPrefix(content, "-"), " ")
+			indent += len(content) - len(rest)
+			content = rest
+		}
+
+		match := keyRegexp.FindStringSubmatch(content)
+		if match == nil {
+			continue
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		key := strings.Trim(strings.TrimSpace(match[1]), `"'`)
+		path := key
+		if len(stack) > 0 {
+			path = stack[len(stack)-1].path + "." + key
+		}
+		index[path] = Position{Line: lineNumber, Column: indent + 1}
+		stack = append(stack, positionEntry{indent: indent, path: path})
+
+		value := strings.TrimSpace(content[len(match[0]):])
+		if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockScalarIndent = indent
+		}
+	}
+
+	return index
+}
+
+// lookup returns the position of the provided path, falling back to the
+// closest parent that has been indexed.
+func (index positionIndex) lookup(path string) Position {
+	for path != "" {
+		if position, ok := index[path]; ok {
+			return position
+		}
+
+		cut := strings.LastIndexAny(path, ".[")
+		if cut <= 0 {
+			break
+		}
+		path = path[:cut]
+	}
+
+	return index[path]
+}
@@ -9,6 +9,9 @@ import (
 )
 
 type ProgressBar struct {
+	// Hidden uploads without drawing the bar, for accessible mode.
+	Hidden bool
+
 	ready chan bool
 	bar   *pb.ProgressBar
 }
@@ -20,6 +23,10 @@ func NewProgressBar() *ProgressBar {
 }
 
 func (p *ProgressBar) Complete() {
+	if p.bar == nil {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 	p.bar.Finish()
@@ -34,6 +41,10 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 	}
 
 	log.Debug("progress bar ready")
+	if p.Hidden {
+		return reader
+	}
+
 	p.bar = pb.New(int(sizeOfFile)).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
 	p.bar.Start()
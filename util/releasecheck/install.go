@@ -0,0 +1,111 @@
+package releasecheck
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PackageManager is the package manager that installed the CLI.
+type PackageManager string
+
+const (
+	Unmanaged  PackageManager = ""
+	Apt        PackageManager = "apt"
+	Chocolatey PackageManager = "chocolatey"
+	Homebrew   PackageManager = "homebrew"
+	Yum        PackageManager = "yum"
+)
+
+// Install describes how the running CLI binary was installed.
+type Install struct {
+	Executable     string
+	PackageManager PackageManager
+}
+
+// Managed returns true when a package manager owns the binary. A managed
+// binary must be upgraded with its package manager; replacing it in place
+// would leave the package manager's records out of sync.
+func (install Install) Managed() bool {
+	return install.PackageManager != Unmanaged
+}
+
+// UpgradeHint returns how to upgrade the installed CLI.
+func (install Install) UpgradeHint() string {
+	switch install.PackageManager {
+	case Apt:
+		return "Upgrade with 'sudo apt-get update && sudo apt-get install cf-cli'."
+	case Chocolatey:
+		return "Upgrade with 'choco upgrade cloudfoundry-cli'."
+	case Homebrew:
+		return "Upgrade with 'brew upgrade cf-cli'."
+	case Yum:
+		return "Upgrade with 'sudo yum update cf-cli'."
+	default:
+		return "Download it from https://github.com/cloudfoundry/cli#downloads"
+	}
+}
+
+// InstallDetector detects how the CLI was installed from the layout the
+// package managers the CLI is distributed with leave on disk.
+type InstallDetector struct {
+	// Root is the file system root the package manager databases are looked
+	// up under.
+	Root string
+}
+
+// DetectInstall detects how the binary at executable was installed.
+func DetectInstall(executable string) Install {
+	return InstallDetector{Root: "/"}.Detect(executable)
+}
+
+// Detect detects how the binary at executable was installed. Symlinks are
+// resolved first, so that e.g. a Homebrew binary linked into /usr/local/bin
+// is detected from its location in the Cellar.
+func (detector InstallDetector) Detect(executable string) Install {
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+	install := Install{Executable: executable}
+
+	path := filepath.ToSlash(executable)
+	switch {
+	case strings.Contains(path, "/Cellar/") || strings.Contains(path, "/homebrew/") || strings.Contains(path, "/linuxbrew/"):
+		install.PackageManager = Homebrew
+	case strings.Contains(strings.ToLower(path), "/chocolatey/"):
+		install.PackageManager = Chocolatey
+	case detector.listedByDpkg(path):
+		install.PackageManager = Apt
+	case strings.HasPrefix(path, "/usr/bin/") && detector.exists("var/lib/rpm"):
+		install.PackageManager = Yum
+	}
+
+	return install
+}
+
+// listedByDpkg returns true if one of the CLI's deb packages installed path.
+func (detector InstallDetector) listedByDpkg(path string) bool {
+	lists, err := filepath.Glob(filepath.Join(detector.Root, "var", "lib", "dpkg", "info", "cf*-cli*.list"))
+	if err != nil {
+		return false
+	}
+
+	for _, list := range lists {
+		raw, err := ioutil.ReadFile(list)
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(raw), "\n") {
+			if strings.TrimSpace(line) == path {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (detector InstallDetector) exists(path string) bool {
+	_, err := os.Stat(filepath.Join(detector.Root, path))
+	return err == nil
+}
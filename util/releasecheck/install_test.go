@@ -0,0 +1,87 @@
+package releasecheck_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/releasecheck"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstallDetector", func() {
+	var (
+		root     string
+		detector InstallDetector
+	)
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "install-detector")
+		Expect(err).ToNot(HaveOccurred())
+		detector = InstallDetector{Root: root}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(root)).To(Succeed())
+	})
+
+	writeFile := func(path string, contents string) {
+		path = filepath.Join(root, path)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	It("detects Homebrew from the Cellar layout", func() {
+		install := detector.Detect("/usr/local/Cellar/cf-cli/6.46.0/bin/cf")
+		Expect(install.PackageManager).To(Equal(Homebrew))
+		Expect(install.Managed()).To(BeTrue())
+	})
+
+	It("detects Chocolatey from its install directory", func() {
+		install := detector.Detect(`C:/ProgramData/chocolatey/lib/cloudfoundry-cli/tools/cf.exe`)
+		Expect(install.PackageManager).To(Equal(Chocolatey))
+	})
+
+	It("detects apt when a CLI deb package lists the binary", func() {
+		writeFile("var/lib/dpkg/info/cf-cli.list", "/.\n/usr\n/usr/bin\n/usr/bin/cf\n")
+		Expect(detector.Detect("/usr/bin/cf").PackageManager).To(Equal(Apt))
+	})
+
+	It("detects yum for a system binary on an rpm based system", func() {
+		Expect(os.MkdirAll(filepath.Join(root, "var", "lib", "rpm"), 0755)).To(Succeed())
+		Expect(detector.Detect("/usr/bin/cf").PackageManager).To(Equal(Yum))
+	})
+
+	It("treats any other binary as unmanaged", func() {
+		writeFile("var/lib/dpkg/info/cf-cli.list", "/usr/bin/cf\n")
+		install := detector.Detect("/home/user/bin/cf")
+		Expect(install.PackageManager).To(Equal(Unmanaged))
+		Expect(install.Managed()).To(BeFalse())
+	})
+
+	It("resolves symlinks before detecting", func() {
+		cellar := filepath.Join(root, "usr", "local", "Cellar", "cf-cli", "6.46.0", "bin")
+		Expect(os.MkdirAll(cellar, 0755)).To(Succeed())
+		writeFile("usr/local/Cellar/cf-cli/6.46.0/bin/cf", "")
+		Expect(os.MkdirAll(filepath.Join(root, "usr", "local", "bin"), 0755)).To(Succeed())
+		link := filepath.Join(root, "usr", "local", "bin", "cf")
+		Expect(os.Symlink(filepath.Join(cellar, "cf"), link)).To(Succeed())
+
+		Expect(detector.Detect(link).PackageManager).To(Equal(Homebrew))
+	})
+})
+
+var _ = DescribeTable("UpgradeHint",
+	func(packageManager PackageManager, expected string) {
+		Expect(Install{PackageManager: packageManager}.UpgradeHint()).To(Equal(expected))
+	},
+
+	Entry("apt", Apt, "Upgrade with 'sudo apt-get update && sudo apt-get install cf-cli'."),
+	Entry("chocolatey", Chocolatey, "Upgrade with 'choco upgrade cloudfoundry-cli'."),
+	Entry("homebrew", Homebrew, "Upgrade with 'brew upgrade cf-cli'."),
+	Entry("yum", Yum, "Upgrade with 'sudo yum update cf-cli'."),
+	Entry("unmanaged", Unmanaged, "Download it from https://github.com/cloudfoundry/cli#downloads"),
+)
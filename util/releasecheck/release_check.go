@@ -0,0 +1,153 @@
+package releasecheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/version"
+	"github.com/blang/semver"
+)
+
+// DefaultEndpoint is the release metadata endpoint of the latest CLI release.
+const DefaultEndpoint = "https://api.github.com/repos/cloudfoundry/cli/releases/latest"
+
+// CheckInterval is how long the latest release is cached before the endpoint
+// is checked again.
+const CheckInterval = 24 * time.Hour
+
+// CheckTimeout bounds how long checking for a new release may delay the end
+// of a command.
+const CheckTimeout = 2 * time.Second
+
+// Release is the latest CLI release, as cached on disk.
+type Release struct {
+	Version   string    `json:"version"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Checker looks up the latest CLI release, caching the result in a JSON file.
+type Checker struct {
+	Endpoint   string
+	CachePath  string
+	HTTPClient *http.Client
+	Now        func() time.Time
+}
+
+func NewChecker(endpoint string, cachePath string, timeout time.Duration) *Checker {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   timeout,
+		}).DialContext,
+	}
+
+	return &Checker{
+		Endpoint:  endpoint,
+		CachePath: cachePath,
+		HTTPClient: &http.Client{
+			Transport: tr,
+			Timeout:   timeout,
+		},
+		Now: time.Now,
+	}
+}
+
+type releaseResponse struct {
+	TagName string `json:"tag_name"`
+}
+
+// LatestRelease returns the cached release while it is less than
+// CheckInterval old, and otherwise fetches and caches the latest release.
+// force always fetches the latest release. The returned bool is true when the
+// endpoint was checked.
+func (checker Checker) LatestRelease(force bool) (Release, bool, error) {
+	now := checker.Now()
+
+	if !force {
+		cached, err := checker.load()
+		if err == nil && cached.Version != "" && now.Sub(cached.CheckedAt) < CheckInterval {
+			return cached, false, nil
+		}
+	}
+
+	request, err := http.NewRequest(http.MethodGet, checker.Endpoint, nil)
+	if err != nil {
+		return Release{}, false, err
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", "cf-cli")
+
+	response, err := checker.HTTPClient.Do(request)
+	if err != nil {
+		return Release{}, false, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return Release{}, false, fmt.Errorf("release endpoint returned %s", response.Status)
+	}
+
+	var body releaseResponse
+	err = json.NewDecoder(response.Body).Decode(&body)
+	if err != nil {
+		return Release{}, false, err
+	}
+
+	release := Release{
+		Version:   strings.TrimPrefix(body.TagName, "v"),
+		CheckedAt: now,
+	}
+	return release, true, checker.save(release)
+}
+
+// IsNewer returns true if latest is a newer version than current. Versions
+// that cannot be compared, such as development builds, are never outdated.
+func IsNewer(current string, latest string) bool {
+	if current == version.DefaultVersion {
+		return false
+	}
+
+	currentSemver, err := semver.Make(current)
+	if err != nil {
+		return false
+	}
+	latestSemver, err := semver.Make(latest)
+	if err != nil {
+		return false
+	}
+
+	currentSemver.Build = nil
+	return latestSemver.GT(currentSemver)
+}
+
+func (checker Checker) load() (Release, error) {
+	var release Release
+
+	raw, err := ioutil.ReadFile(checker.CachePath)
+	if err != nil {
+		return release, err
+	}
+	err = json.Unmarshal(raw, &release)
+	return release, err
+}
+
+func (checker Checker) save(release Release) error {
+	raw, err := json.Marshal(release)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(checker.CachePath), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(checker.CachePath, raw, 0600)
+}
@@ -0,0 +1,123 @@
+package releasecheck_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/releasecheck"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		tmpDir  string
+		server  *ghttp.Server
+		checker *Checker
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "release-check")
+		Expect(err).ToNot(HaveOccurred())
+
+		server = ghttp.NewServer()
+		now = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		checker = NewChecker(server.URL()+"/releases/latest", filepath.Join(tmpDir, "release_check.json"), time.Second)
+		checker.Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("LatestRelease", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/releases/latest"),
+					ghttp.VerifyHeaderKV("User-Agent", "cf-cli"),
+					ghttp.RespondWith(http.StatusOK, `{"tag_name": "v6.47.0"}`),
+				),
+			)
+		})
+
+		It("fetches and caches the latest release", func() {
+			release, checked, err := checker.LatestRelease(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checked).To(BeTrue())
+			Expect(release).To(Equal(Release{Version: "6.47.0", CheckedAt: now}))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+			now = now.Add(CheckInterval - time.Minute)
+			release, checked, err = checker.LatestRelease(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checked).To(BeFalse())
+			Expect(release.Version).To(Equal("6.47.0"))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		When("the cached release is older than a day", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"tag_name": "v6.48.0"}`))
+			})
+
+			It("checks the endpoint again", func() {
+				_, _, err := checker.LatestRelease(false)
+				Expect(err).ToNot(HaveOccurred())
+
+				now = now.Add(CheckInterval)
+				release, checked, err := checker.LatestRelease(false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checked).To(BeTrue())
+				Expect(release.Version).To(Equal("6.48.0"))
+			})
+		})
+
+		When("forced", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"tag_name": "v6.48.0"}`))
+			})
+
+			It("ignores the cached release", func() {
+				_, _, err := checker.LatestRelease(false)
+				Expect(err).ToNot(HaveOccurred())
+
+				release, checked, err := checker.LatestRelease(true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(checked).To(BeTrue())
+				Expect(release.Version).To(Equal("6.48.0"))
+			})
+		})
+	})
+
+	When("the endpoint returns an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusNotFound, ""))
+		})
+
+		It("returns the error", func() {
+			_, _, err := checker.LatestRelease(false)
+			Expect(err).To(MatchError("release endpoint returned 404 Not Found"))
+		})
+	})
+})
+
+var _ = DescribeTable("IsNewer",
+	func(current string, latest string, expected bool) {
+		Expect(IsNewer(current, latest)).To(Equal(expected))
+	},
+
+	Entry("newer release", "6.46.0+a1b2c3d.2019-06-01", "6.47.0", true),
+	Entry("same release", "6.47.0+a1b2c3d.2019-06-01", "6.47.0", false),
+	Entry("older release", "6.47.1", "6.47.0", false),
+	Entry("development build", "0.0.0-unknown-version", "6.47.0", false),
+	Entry("unparsable release", "6.46.0", "latest", false),
+)
@@ -0,0 +1,13 @@
+package releasecheck_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReleaseCheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Release Check Suite")
+}
@@ -0,0 +1,89 @@
+// Package routeprobe asks the Gorouter whether it still routes traffic for a
+// route to a given app instance, and how an app answers requests made through
+// its routes.
+package routeprobe
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	appInstanceHeader = "X-Cf-App-Instance"
+	routerErrorHeader = "X-Cf-Routererror"
+	unknownRoute      = "unknown_route"
+)
+
+type Prober struct {
+	HTTPClient *http.Client
+}
+
+func NewProber(skipSSLValidation bool, dialTimeout time.Duration) *Prober {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   dialTimeout,
+		}).DialContext,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: skipSSLValidation,
+		},
+	}
+
+	return &Prober{
+		HTTPClient: &http.Client{
+			Transport: tr,
+			Timeout:   dialTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// IsRegistered pins a HEAD request to the given app instance with the
+// X-Cf-App-Instance header. The Gorouter answers with an unknown_route error
+// once the instance is no longer registered for the route; any other
+// response means the instance can still receive traffic.
+func (prober Prober) IsRegistered(routeURL string, appGUID string, index int) (bool, error) {
+	request, err := http.NewRequest(http.MethodHead, routeURL, nil)
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set(appInstanceHeader, fmt.Sprintf("%s:%d", appGUID, index))
+
+	response, err := prober.HTTPClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode == http.StatusNotFound && response.Header.Get(routerErrorHeader) == unknownRoute {
+		return false, nil
+	}
+	return true, nil
+}
+
+// EndpointStatus sends a GET request to the given URL and returns the status
+// code of the response. routed is false when the Gorouter answers with an
+// unknown_route error, meaning no app instance is registered for the route
+// yet.
+func (prober Prober) EndpointStatus(url string) (int, bool, error) {
+	response, err := prober.HTTPClient.Get(url)
+	if err != nil {
+		return 0, false, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	if response.StatusCode == http.StatusNotFound && response.Header.Get(routerErrorHeader) == unknownRoute {
+		return response.StatusCode, false, nil
+	}
+	return response.StatusCode, true, nil
+}
@@ -0,0 +1,156 @@
+package routeprobe_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/routeprobe"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Prober", func() {
+	var (
+		server   *ghttp.Server
+		prober   *Prober
+		routeURL string
+
+		registered bool
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		prober = NewProber(false, time.Second)
+		routeURL = server.URL() + "/some-path"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		registered, executeErr = prober.IsRegistered(routeURL, "some-app-guid", 2)
+	})
+
+	When("the router still routes to the instance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodHead, "/some-path"),
+					ghttp.VerifyHeaderKV("X-Cf-App-Instance", "some-app-guid:2"),
+					ghttp.RespondWith(http.StatusServiceUnavailable, nil),
+				),
+			)
+		})
+
+		It("returns true", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(registered).To(BeTrue())
+		})
+	})
+
+	When("the router reports an unknown route", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, nil, http.Header{"X-Cf-Routererror": {"unknown_route"}}),
+			)
+		})
+
+		It("returns false", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(registered).To(BeFalse())
+		})
+	})
+
+	When("the app itself responds with a 404", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, nil),
+			)
+		})
+
+		It("returns true", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(registered).To(BeTrue())
+		})
+	})
+
+	When("the request fails", func() {
+		BeforeEach(func() {
+			server.Close()
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("Prober EndpointStatus", func() {
+	var (
+		server     *ghttp.Server
+		prober     *Prober
+		statusCode int
+		routed     bool
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		prober = NewProber(false, time.Second)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		statusCode, routed, executeErr = prober.EndpointStatus(server.URL() + "/actuator/health")
+	})
+
+	When("the app responds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/actuator/health"),
+					ghttp.RespondWith(http.StatusOK, `{"status":"UP"}`),
+				),
+			)
+		})
+
+		It("returns the status code", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(statusCode).To(Equal(http.StatusOK))
+			Expect(routed).To(BeTrue())
+		})
+	})
+
+	When("the app itself responds with a 404", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, nil),
+			)
+		})
+
+		It("returns the 404 as routed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(statusCode).To(Equal(http.StatusNotFound))
+			Expect(routed).To(BeTrue())
+		})
+	})
+
+	When("the router reports an unknown route", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, nil, http.Header{"X-Cf-Routererror": {"unknown_route"}}),
+			)
+		})
+
+		It("returns not routed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(routed).To(BeFalse())
+		})
+	})
+})
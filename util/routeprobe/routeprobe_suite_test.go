@@ -0,0 +1,13 @@
+package routeprobe_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouteProbe(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Route Probe Suite")
+}
@@ -0,0 +1,34 @@
+package script
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is returned when a script line cannot be turned into a command.
+type ParseError struct {
+	Line    int
+	Message string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+type undefinedVariablesError []string
+
+func (e undefinedVariablesError) Error() string {
+	return fmt.Sprintf("Expected to find variables: %s", strings.Join(e, ", "))
+}
+
+type unterminatedQuoteError rune
+
+func (e unterminatedQuoteError) Error() string {
+	return fmt.Sprintf("unterminated %c quote", rune(e))
+}
+
+type trailingEscapeError struct{}
+
+func (trailingEscapeError) Error() string {
+	return "unexpected backslash at end of line"
+}
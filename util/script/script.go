@@ -0,0 +1,136 @@
+// Package script parses the batch files executed by `cf run-script`.
+//
+// A script is a sequence of CLI invocations, one per line, written without the
+// leading binary name. Blank lines and lines starting with '#' are ignored.
+// Arguments are separated by whitespace and may be quoted with single or
+// double quotes; a backslash escapes the next character outside of single
+// quotes. Variables are referenced as ((name)) and are substituted within each
+// argument after the line is split, so that a value is never split into
+// several arguments.
+package script
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var variableRegexp = regexp.MustCompile(`\(\(([-\w\.]+)\)\)`)
+
+// Command is a single CLI invocation read from a script.
+type Command struct {
+	// Line is the 1-based line number the command was read from.
+	Line int
+	// Text is the line as written, before variables are substituted, so that
+	// it can be displayed without revealing their values.
+	Text string
+	// Args are the command name followed by its arguments and flags.
+	Args []string
+}
+
+// Parse reads every command from the provided script, substituting the
+// provided variables. It returns an error pointing at the offending line if a
+// variable is not defined or a quote is not terminated.
+func Parse(reader io.Reader, vars map[string]string) ([]Command, error) {
+	var commands []Command
+
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		args, err := split(line)
+		if err != nil {
+			return nil, ParseError{Line: lineNumber, Message: err.Error()}
+		}
+
+		err = interpolate(args, vars)
+		if err != nil {
+			return nil, ParseError{Line: lineNumber, Message: err.Error()}
+		}
+
+		commands = append(commands, Command{Line: lineNumber, Text: line, Args: args})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return commands, nil
+}
+
+func interpolate(args []string, vars map[string]string) error {
+	var missing []string
+	for i, arg := range args {
+		args[i] = variableRegexp.ReplaceAllStringFunc(arg, func(match string) string {
+			name := variableRegexp.FindStringSubmatch(match)[1]
+			value, ok := vars[name]
+			if !ok {
+				missing = append(missing, name)
+				return match
+			}
+			return value
+		})
+	}
+
+	if len(missing) > 0 {
+		return undefinedVariablesError(missing)
+	}
+	return nil
+}
+
+func split(line string) ([]string, error) {
+	var (
+		args       []string
+		current    strings.Builder
+		inArg      bool
+		quote      rune
+		escapeNext bool
+	)
+
+	for _, char := range line {
+		switch {
+		case escapeNext:
+			current.WriteRune(char)
+			escapeNext = false
+		case char == '\\' && quote != '\'':
+			escapeNext = true
+			inArg = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inArg = true
+		case char == ' ' || char == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(char)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, unterminatedQuoteError(quote)
+	}
+	if escapeNext {
+		return nil, trailingEscapeError{}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,104 @@
+package script_test
+
+import (
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/script"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	var (
+		input    string
+		vars     map[string]string
+		commands []Command
+		parseErr error
+	)
+
+	BeforeEach(func() {
+		vars = nil
+	})
+
+	JustBeforeEach(func() {
+		commands, parseErr = Parse(strings.NewReader(input), vars)
+	})
+
+	When("the script contains commands, comments and blank lines", func() {
+		BeforeEach(func() {
+			input = "# create things\n\ncreate-space  some-space\n  target -s some-space  \n"
+		})
+
+		It("returns only the commands with their line numbers", func() {
+			Expect(parseErr).ToNot(HaveOccurred())
+			Expect(commands).To(Equal([]Command{
+				{Line: 3, Text: "create-space  some-space", Args: []string{"create-space", "some-space"}},
+				{Line: 4, Text: "target -s some-space", Args: []string{"target", "-s", "some-space"}},
+			}))
+		})
+	})
+
+	When("arguments are quoted or escaped", func() {
+		BeforeEach(func() {
+			input = `set-env app GREETING "hello world" 'it''s' a\ b "say \"hi\"" ''`
+		})
+
+		It("splits the line the way a shell would", func() {
+			Expect(parseErr).ToNot(HaveOccurred())
+			Expect(commands).To(HaveLen(1))
+			Expect(commands[0].Args).To(Equal([]string{"set-env", "app", "GREETING", "hello world", "its", "a b", `say "hi"`, ""}))
+		})
+	})
+
+	When("a quote is not terminated", func() {
+		BeforeEach(func() {
+			input = "apps\npush 'some-app"
+		})
+
+		It("returns a ParseError with the line number", func() {
+			Expect(parseErr).To(MatchError(ParseError{Line: 2, Message: "unterminated ' quote"}))
+		})
+	})
+
+	When("a line ends with a backslash", func() {
+		BeforeEach(func() {
+			input = `push some-app\`
+		})
+
+		It("returns a ParseError", func() {
+			Expect(parseErr).To(MatchError(ParseError{Line: 1, Message: "unexpected backslash at end of line"}))
+		})
+	})
+
+	Describe("variable substitution", func() {
+		BeforeEach(func() {
+			input = "push ((app-name)) -i ((instances)) --var 'greeting=((greeting))'"
+		})
+
+		When("all variables are provided", func() {
+			BeforeEach(func() {
+				vars = map[string]string{"app-name": "my app", "instances": "3 --no-start", "greeting": `it's "hi"`}
+			})
+
+			It("substitutes them within each argument after splitting the line", func() {
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(commands[0].Args).To(Equal([]string{"push", "my app", "-i", "3 --no-start", "--var", `greeting=it's "hi"`}))
+			})
+
+			It("keeps the line as written", func() {
+				Expect(commands[0].Text).To(Equal("push ((app-name)) -i ((instances)) --var 'greeting=((greeting))'"))
+			})
+		})
+
+		When("variables are missing", func() {
+			BeforeEach(func() {
+				vars = map[string]string{}
+			})
+
+			It("returns a ParseError listing the missing variables", func() {
+				Expect(parseErr).To(MatchError(ParseError{Line: 1, Message: "Expected to find variables: app-name, instances, greeting"}))
+			})
+		})
+	})
+})
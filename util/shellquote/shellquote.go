@@ -0,0 +1,11 @@
+// Package shellquote quotes values for use as single words in POSIX shell
+// commands, such as those run over SSH in an app or task container.
+package shellquote
+
+import "strings"
+
+// Quote returns value wrapped in single quotes, so that the shell does not
+// interpret any of its characters.
+func Quote(value string) string {
+	return "'" + strings.Replace(value, "'", `'"'"'`, -1) + "'"
+}
@@ -0,0 +1,13 @@
+package shellquote_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestShellquote(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shellquote Suite")
+}
@@ -0,0 +1,22 @@
+package shellquote_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/shellquote"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quote", func() {
+	DescribeTable("quotes the value as a single shell word when",
+		func(value string, expected string) {
+			Expect(Quote(value)).To(Equal(expected))
+		},
+
+		Entry("the value is empty", "", `''`),
+		Entry("the value has spaces", "some file.txt", `'some file.txt'`),
+		Entry("the value has shell metacharacters", "$HOME; rm -rf `pwd` \"*\"", "'$HOME; rm -rf `pwd` \"*\"'"),
+		Entry("the value has single quotes", "it's", `'it'"'"'s'`),
+	)
+})
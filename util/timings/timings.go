@@ -0,0 +1,93 @@
+// Package timings records how long the API requests of a command take, so
+// that a breakdown can be displayed when the command ends.
+package timings
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+)
+
+var guidPattern = regexp.MustCompile(`/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(/|$)`)
+
+// Request is the time spent on requests of the same kind.
+type Request struct {
+	Name  string
+	Count int
+	Total time.Duration
+}
+
+// Average returns the mean duration of the requests.
+func (request Request) Average() time.Duration {
+	return request.Total / time.Duration(request.Count)
+}
+
+// Recorder collects request durations. It is safe for concurrent use.
+type Recorder struct {
+	start    time.Time
+	lock     sync.Mutex
+	requests map[string]*Request
+}
+
+// NewRecorder returns a Recorder that measures elapsed time from now.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		start:    time.Now(),
+		requests: map[string]*Request{},
+	}
+}
+
+// Record adds a request of the given kind that took duration.
+func (recorder *Recorder) Record(name string, duration time.Duration) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	request, ok := recorder.requests[name]
+	if !ok {
+		request = &Request{Name: name}
+		recorder.requests[name] = request
+	}
+	request.Count++
+	request.Total += duration
+}
+
+// Requests returns the recorded requests, the most time consuming first.
+func (recorder *Recorder) Requests() []Request {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	requests := make([]Request, 0, len(recorder.requests))
+	for _, request := range recorder.requests {
+		requests = append(requests, *request)
+	}
+	sort.Slice(requests, func(i int, j int) bool {
+		if requests[i].Total == requests[j].Total {
+			return requests[i].Name < requests[j].Name
+		}
+		return requests[i].Total > requests[j].Total
+	})
+	return requests
+}
+
+// RecordRequest adds an API request that took duration. Requests are named by
+// their method and path, with GUIDs replaced by :guid so that requests for
+// different resources of the same kind, and the pages of a list, are counted
+// together.
+func (recorder *Recorder) RecordRequest(request *http.Request, duration time.Duration) {
+	recorder.Record(requestName(request), duration)
+}
+
+// Elapsed returns the time since the recorder was created.
+func (recorder *Recorder) Elapsed() time.Duration {
+	return time.Since(recorder.start)
+}
+
+func requestName(request *http.Request) string {
+	path := request.URL.Path
+	for guidPattern.MatchString(path) {
+		path = guidPattern.ReplaceAllString(path, "/:guid$1")
+	}
+	return request.Method + " " + path
+}
@@ -0,0 +1,47 @@
+package timings_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/timings"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timings", func() {
+	Describe("Recorder", func() {
+		var recorder *Recorder
+
+		BeforeEach(func() {
+			recorder = NewRecorder()
+		})
+
+		It("groups requests by name, the most time consuming first", func() {
+			recorder.Record("GET /v3/apps", 200*time.Millisecond)
+			recorder.Record("PUT /v2/apps/:guid/bits", 2*time.Second)
+			recorder.Record("GET /v3/apps", 400*time.Millisecond)
+
+			requests := recorder.Requests()
+			Expect(requests).To(Equal([]Request{
+				{Name: "PUT /v2/apps/:guid/bits", Count: 1, Total: 2 * time.Second},
+				{Name: "GET /v3/apps", Count: 2, Total: 600 * time.Millisecond},
+			}))
+			Expect(requests[1].Average()).To(Equal(300 * time.Millisecond))
+		})
+	})
+
+	Describe("RecordRequest", func() {
+		It("names the request by its method and path, with GUIDs replaced", func() {
+			recorder := NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "https://api.example.com/v3/apps/0b9e0c4c-1a2b-4c3d-8e9f-0a1b2c3d4e5f/processes/7c1d2e3f-4a5b-4c6d-8e7f-8a9b0c1d2e3f?page=2", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			recorder.RecordRequest(request, time.Second)
+
+			Expect(recorder.Requests()).To(Equal([]Request{
+				{Name: "GET /v3/apps/:guid/processes/:guid", Count: 1, Total: time.Second},
+			}))
+		})
+	})
+})
@@ -6,6 +6,8 @@ import "code.cloudfoundry.org/cli/util/configv3"
 
 // Config is the UI configuration.
 type Config interface {
+	// Accessible adapts the output for screen readers
+	Accessible() bool
 	// ColorEnabled enables or disabled color
 	ColorEnabled() configv3.ColorSetting
 	// Locale is the language to translate the output to
@@ -0,0 +1,17 @@
+// +build !windows
+
+package ui
+
+import "io"
+
+// prepareConsole has nothing to do outside of Windows, where terminals
+// already render ANSI sequences and use UTF-8.
+func prepareConsole() func() {
+	return func() {}
+}
+
+// readConsolePassword is only needed on Windows; elsewhere go-interact reads
+// passwords.
+func readConsolePassword(in io.Reader, out io.Writer, prompt string) (string, bool, error) {
+	return "", false, nil
+}
@@ -0,0 +1,89 @@
+// +build windows
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+const utf8CodePage = 65001
+
+var (
+	kernel32               = windows.NewLazySystemDLL("kernel32.dll")
+	procGetConsoleOutputCP = kernel32.NewProc("GetConsoleOutputCP")
+	procSetConsoleOutputCP = kernel32.NewProc("SetConsoleOutputCP")
+)
+
+// prepareConsole enables virtual terminal processing on STDOUT and STDERR, so
+// that Windows 10 and later render the ANSI sequences written by colored
+// output and go-interact menus instead of printing them, and switches the
+// console output code page to UTF-8. go-runewidth reads the original code page
+// when it is initialized, so CJK code pages keep ambiguous characters double
+// width. The returned function restores the original console settings.
+func prepareConsole() func() {
+	var restores []func()
+	for _, file := range []*os.File{os.Stdout, os.Stderr} {
+		handle := windows.Handle(file.Fd())
+		var mode uint32
+		if windows.GetConsoleMode(handle, &mode) != nil {
+			continue
+		}
+		if windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING) == nil {
+			restores = append(restores, func() { _ = windows.SetConsoleMode(handle, mode) })
+		}
+	}
+
+	if len(restores) > 0 {
+		codePage, _, _ := procGetConsoleOutputCP.Call()
+		if codePage != 0 && codePage != utf8CodePage {
+			if set, _, _ := procSetConsoleOutputCP.Call(utf8CodePage); set != 0 {
+				restores = append(restores, func() { _, _, _ = procSetConsoleOutputCP.Call(codePage) })
+			}
+		}
+	}
+
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// readConsolePassword reads a password from the Windows console with echo
+// turned off through the console API. go-interact puts the console in raw
+// mode instead, which garbles the prompt in some Windows consoles. ok is false
+// when in is not a console.
+func readConsolePassword(in io.Reader, out io.Writer, prompt string) (string, bool, error) {
+	file, isFile := in.(*os.File)
+	if !isFile {
+		return "", false, nil
+	}
+
+	handle := windows.Handle(file.Fd())
+	var mode uint32
+	if windows.GetConsoleMode(handle, &mode) != nil {
+		return "", false, nil
+	}
+
+	err := windows.SetConsoleMode(handle, mode&^windows.ENABLE_ECHO_INPUT|windows.ENABLE_LINE_INPUT|windows.ENABLE_PROCESSED_INPUT)
+	if err != nil {
+		return "", true, err
+	}
+	defer windows.SetConsoleMode(handle, mode)
+
+	var password string
+	for password == "" {
+		fmt.Fprint(out, prompt)
+		password, err = readLine(file)
+		fmt.Fprintln(out)
+		if err != nil {
+			return "", true, err
+		}
+	}
+
+	return password, true, nil
+}
@@ -1,7 +1,11 @@
 package ui
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/vito/go-interact/interact"
 	"github.com/vito/go-interact/interact/terminal"
@@ -9,6 +13,10 @@ import (
 
 const sigIntExitCode = 130
 
+// ErrInvalidIndex is returned by DisplayTextMenu when the number entered is
+// not one of the listed choices.
+var ErrInvalidIndex = errors.New("invalid list index")
+
 //go:generate counterfeiter . Resolver
 
 type Resolver interface {
@@ -24,6 +32,10 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	if ui.Accessible {
+		return ui.displayAccessibleBoolPrompt(defaultResponse, ui.TranslateText(template, templateValues...))
+	}
+
 	response := defaultResponse
 	interactivePrompt := ui.Interactor.NewInteraction(ui.TranslateText(template, templateValues...))
 	interactivePrompt.SetIn(ui.In)
@@ -41,8 +53,13 @@ func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[strin
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	prompt := ui.TranslateText(template, templateValues...)
+	if password, ok, err := readConsolePassword(ui.In, ui.OutForInteration, prompt+": "); ok {
+		return password, err
+	}
+
 	var password interact.Password
-	interactivePrompt := ui.Interactor.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt := ui.Interactor.NewInteraction(prompt)
 	interactivePrompt.SetIn(ui.In)
 	interactivePrompt.SetOut(ui.OutForInteration)
 	err := interactivePrompt.Resolve(interact.Required(&password))
@@ -54,6 +71,15 @@ func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[strin
 
 // DisplayTextPrompt outputs the prompt and waits for user input.
 func (ui *UI) DisplayTextPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
+	if ui.Accessible {
+		var value string
+		var err error
+		for value == "" && err == nil {
+			value, err = ui.readPromptLine(ui.TranslateText(template, templateValues...) + ": ")
+		}
+		return value, err
+	}
+
 	interactivePrompt := ui.Interactor.NewInteraction(ui.TranslateText(template, templateValues...))
 	var value string
 	interactivePrompt.SetIn(ui.In)
@@ -65,6 +91,123 @@ func (ui *UI) DisplayTextPrompt(template string, templateValues ...map[string]in
 	return value, err
 }
 
+// DisplayOptionalTextPrompt outputs the prompt and waits for user input. An
+// empty response returns defaultValue, which is shown in the prompt when it is
+// not empty.
+func (ui *UI) DisplayOptionalTextPrompt(defaultValue string, template string, templateValues ...map[string]interface{}) (string, error) {
+	if ui.Accessible {
+		value, err := ui.readPromptLine(fmt.Sprintf("%s (%s): ", ui.TranslateText(template, templateValues...), defaultValue))
+		if value == "" {
+			value = defaultValue
+		}
+		return value, err
+	}
+
+	interactivePrompt := ui.Interactor.NewInteraction(ui.TranslateText(template, templateValues...))
+	value := defaultValue
+	interactivePrompt.SetIn(ui.In)
+	interactivePrompt.SetOut(ui.OutForInteration)
+	err := interactivePrompt.Resolve(&value)
+	if isInterrupt(err) {
+		ui.Exiter.Exit(sigIntExitCode)
+	}
+	return value, err
+}
+
+// DisplayTextMenu lists the choices numbered from 1 and prompts for one of
+// them. The user can answer with a number, which returns that choice, or with
+// any other text, which is returned as is. An empty answer returns an empty
+// string.
+func (ui *UI) DisplayTextMenu(choices []string, promptTemplate string, templateValues ...map[string]interface{}) (string, error) {
+	for i, choice := range choices {
+		ui.DisplayText(fmt.Sprintf("%d. %s", i+1, choice))
+	}
+	ui.DisplayNewline()
+
+	prompt := ui.TranslateText(promptTemplate, templateValues...)
+
+	var value string
+	var err error
+	if ui.Accessible {
+		value, err = ui.readPromptLine(prompt + ": ")
+	} else {
+		interactivePrompt := ui.Interactor.NewInteraction(prompt)
+		interactivePrompt.SetIn(ui.In)
+		interactivePrompt.SetOut(ui.OutForInteration)
+		err = interactivePrompt.Resolve(&value)
+		if isInterrupt(err) {
+			ui.Exiter.Exit(sigIntExitCode)
+		}
+	}
+	if err != nil || value == "" {
+		return "", err
+	}
+
+	index, err := strconv.Atoi(value)
+	if err != nil {
+		return value, nil
+	}
+	if index < 1 || index > len(choices) {
+		return "", ErrInvalidIndex
+	}
+	return choices[index-1], nil
+}
+
+// displayAccessibleBoolPrompt asks a yes or no question one line at a time,
+// until the answer is y, yes, n, no or empty for the default.
+func (ui *UI) displayAccessibleBoolPrompt(defaultResponse bool, prompt string) (bool, error) {
+	indicator := "yN"
+	if defaultResponse {
+		indicator = "Yn"
+	}
+
+	for {
+		answer, err := ui.readPromptLine(fmt.Sprintf("%s [%s]: ", prompt, indicator))
+		if err != nil {
+			return defaultResponse, err
+		}
+
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultResponse, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+	}
+}
+
+// readPromptLine writes the prompt and reads the answer in the terminal's own
+// line mode, which screen readers follow better than the line editor of
+// go-interact.
+func (ui *UI) readPromptLine(prompt string) (string, error) {
+	fmt.Fprint(ui.OutForInteration, prompt)
+	return readLine(ui.In)
+}
+
+// readLine reads up to the end of the line one byte at a time, so that no
+// input meant for later prompts is buffered away.
+func readLine(in io.Reader) (string, error) {
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n == 1 {
+			if buf[0] == '\n' {
+				return strings.TrimSuffix(string(line), "\r"), nil
+			}
+			line = append(line, buf[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			return strings.TrimSuffix(string(line), "\r"), nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
 func isInterrupt(err error) bool {
 	return err == interact.ErrKeyboardInterrupt || err == terminal.ErrKeyboardInterrupt
 }
@@ -207,6 +207,93 @@ var _ = Describe("Prompts", func() {
 		})
 	})
 
+	Describe("DisplayOptionalTextPrompt", func() {
+		It("returns the user input string", func() {
+			_, err := inBuffer.Write([]byte("some-input\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			userInput, err := ui.DisplayOptionalTextPrompt("some-default", "Size of {{.AppName}}", map[string]interface{}{
+				"AppName": "some-app",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Say(`Size of some-app \(some-default\)`))
+			Expect(userInput).To(Equal("some-input"))
+		})
+
+		When("the user enters nothing", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the default", func() {
+				userInput, err := ui.DisplayOptionalTextPrompt("some-default", "Size")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userInput).To(Equal("some-default"))
+			})
+
+			When("there is no default", func() {
+				It("returns an empty string", func() {
+					userInput, err := ui.DisplayOptionalTextPrompt("", "Size")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(userInput).To(BeEmpty())
+				})
+			})
+		})
+	})
+
+	Describe("DisplayTextMenu", func() {
+		var choices []string
+
+		BeforeEach(func() {
+			choices = []string{"choice-1", "choice-2"}
+		})
+
+		It("lists the choices and returns the one picked by number", func() {
+			_, err := inBuffer.Write([]byte("2\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			choice, err := ui.DisplayTextMenu(choices, "Select an {{.Thing}}", map[string]interface{}{"Thing": "org"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Say("1. choice-1"))
+			Expect(out).To(Say("2. choice-2"))
+			Expect(out).To(Say("Select an org"))
+			Expect(choice).To(Equal("choice-2"))
+		})
+
+		When("the user enters text that is not a number", func() {
+			It("returns the text", func() {
+				_, err := inBuffer.Write([]byte("some-name\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				choice, err := ui.DisplayTextMenu(choices, "Select an org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(choice).To(Equal("some-name"))
+			})
+		})
+
+		When("the user enters a number outside the list", func() {
+			It("returns ErrInvalidIndex", func() {
+				_, err := inBuffer.Write([]byte("3\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = ui.DisplayTextMenu(choices, "Select an org")
+				Expect(err).To(MatchError(ErrInvalidIndex))
+			})
+		})
+
+		When("the user enters nothing", func() {
+			It("returns an empty string", func() {
+				_, err := inBuffer.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+
+				choice, err := ui.DisplayTextMenu(choices, "Select an org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(choice).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("interrupt handling", func() {
 		When("the prompt is canceled by a keyboard interrupt", func() {
 			var (
@@ -252,4 +339,40 @@ var _ = Describe("Prompts", func() {
 		})
 
 	})
+
+	Describe("in accessible mode", func() {
+		BeforeEach(func() {
+			ui.Accessible = true
+		})
+
+		It("asks a yes or no question until the answer is valid", func() {
+			_, err := inBuffer.Write([]byte("maybe\nYes\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := ui.DisplayBoolPrompt(false, "some-prompt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeTrue())
+			Expect(out).To(Say(`some-prompt \[yN\]: some-prompt \[yN\]: `))
+		})
+
+		It("reads a required line without echoing it back", func() {
+			_, err := inBuffer.Write([]byte("\nsome-input\r\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := ui.DisplayTextPrompt("some-prompt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("some-input"))
+			Expect(out).To(Say("some-prompt: some-prompt: $"))
+		})
+
+		It("returns the default value of an optional prompt on an empty line", func() {
+			_, err := inBuffer.Write([]byte("\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			value, err := ui.DisplayOptionalTextPrompt("some-default", "some-prompt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("some-default"))
+			Expect(out).To(Say(`some-prompt \(some-default\): `))
+		})
+	})
 })
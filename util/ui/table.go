@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/fatih/color"
@@ -46,6 +47,10 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	writeNonWrappingTable(ui.Out, prefix, table, padding)
+}
+
+func writeNonWrappingTable(out io.Writer, prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
 	}
@@ -65,25 +70,28 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 	}
 
 	for row := 0; row < rows; row++ {
-		fmt.Fprint(ui.Out, prefix)
+		fmt.Fprint(out, prefix)
 		for col := 0; col < columns; col++ {
 			data := table[row][col]
 			var addedPadding int
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - wordSize(data)
 			}
-			fmt.Fprintf(ui.Out, "%s%s", data, strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(out, "%s%s", data, strings.Repeat(" ", addedPadding))
 		}
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(out, "\n")
 	}
 }
 
 // DisplayTableWithHeader outputs a simple non-wrapping table with bolded
-// headers.
+// headers. In accessible mode the number of rows is announced first.
 func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
 	}
+	if ui.Accessible {
+		ui.announceRowCount(len(table) - 1)
+	}
 	for i, str := range table[0] {
 		table[0][i] = ui.modifyColor(str, color.New(color.Bold))
 	}
@@ -91,6 +99,16 @@ func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding in
 	ui.DisplayNonWrappingTable(prefix, table, padding)
 }
 
+func (ui *UI) announceRowCount(rows int) {
+	if rows == 1 {
+		ui.DisplayText("Table with 1 row:")
+		return
+	}
+	ui.DisplayText("Table with {{.Count}} rows:", map[string]interface{}{
+		"Count": rows,
+	})
+}
+
 func wordSize(str string) int {
 	cleanStr := vtclean.Clean(str, false)
 	return runewidth.StringWidth(cleanStr)
@@ -64,6 +64,17 @@ var _ = Describe("Table", func() {
 				Expect(out).To(Say(" wut4:  %s\n", strings.Repeat("a", 15)))
 				Expect(out).To(Say("        %s\n", strings.Repeat("b", 15)))
 			})
+
+			It("aligns columns by their displayed width, ignoring colors", func() {
+				ui.DisplayKeyValueTable("",
+					[][]string{
+						{"\x1b[1mname:\x1b[0m", "some-app"},
+						{"组织:", "some-org"},
+					},
+					2)
+				Expect(out).To(Say("\x1b\\[1mname:\x1b\\[0m  some-app\n"))
+				Expect(out).To(Say("组织:  some-org\n"))
+			})
 		})
 	})
 
@@ -80,5 +91,23 @@ var _ = Describe("Table", func() {
 			Expect(out).To(Say("\x1b\\[1mheader3\x1b\\[0m"))
 			Expect(out).To(Say("#0  data1    data2    data3"))
 		})
+
+		When("accessible mode is on", func() {
+			BeforeEach(func() {
+				ui.Accessible = true
+			})
+
+			It("announces the number of rows first", func() {
+				ui.DisplayTableWithHeader("",
+					[][]string{
+						{"name", "state"},
+						{"some-app", "started"},
+						{"other-app", "stopped"},
+					},
+					2)
+				Expect(out).To(Say("Table with 2 rows:\n"))
+				Expect(out).To(Say("name"))
+			})
+		})
 	})
 })
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/util/timings"
+)
+
+// TimingsRecorder returns the recorder of the time spent on API requests
+// during the command.
+func (ui *UI) TimingsRecorder() *timings.Recorder {
+	return ui.timings
+}
+
+// DisplayTimings outputs to UI.Err the time spent on each kind of API
+// request, the most time consuming first, followed by the time spent waiting
+// on the API and the time spent in the CLI itself.
+func (ui *UI) DisplayTimings() {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var table [][]string
+	var waiting time.Duration
+	for _, request := range ui.timings.Requests() {
+		waiting += request.Total
+		timing := formatTiming(request.Total)
+		if request.Count > 1 {
+			timing = fmt.Sprintf("%d × %s (%s)", request.Count, formatTiming(request.Average()), formatTiming(request.Total))
+		}
+		table = append(table, []string{request.Name, timing})
+	}
+
+	elapsed := ui.timings.Elapsed()
+	cli := elapsed - waiting
+	if cli < 0 {
+		cli = 0
+	}
+	table = append(table,
+		[]string{ui.TranslateText("waiting on API"), formatTiming(waiting)},
+		[]string{ui.TranslateText("CLI"), formatTiming(cli)},
+		[]string{ui.TranslateText("total"), formatTiming(elapsed)},
+	)
+
+	fmt.Fprintf(ui.Err, "\n%s\n", ui.TranslateText("Timings:"))
+	writeNonWrappingTable(ui.Err, "   ", table, 3)
+}
+
+// formatTiming rounds durations under a second to milliseconds and longer
+// ones to tenths of a second.
+func formatTiming(duration time.Duration) string {
+	if duration < time.Second {
+		return duration.Round(time.Millisecond).String()
+	}
+	return duration.Round(100 * time.Millisecond).String()
+}
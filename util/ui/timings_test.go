@@ -0,0 +1,39 @@
+package ui_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Timings", func() {
+	var ui *UI
+
+	BeforeEach(func() {
+		ui = NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	Describe("DisplayTimings", func() {
+		BeforeEach(func() {
+			recorder := ui.TimingsRecorder()
+			recorder.Record("GET /v3/apps", 200*time.Millisecond)
+			recorder.Record("GET /v3/apps", 220*time.Millisecond)
+			recorder.Record("POST /oauth/token", 120*time.Millisecond)
+		})
+
+		It("displays the time spent on each kind of request to stderr, the slowest first", func() {
+			ui.DisplayTimings()
+
+			Expect(ui.Err).To(Say("Timings:\n"))
+			Expect(ui.Err).To(Say(`   GET /v3/apps\s+2 × 210ms \(420ms\)\n`))
+			Expect(ui.Err).To(Say(`   POST /oauth/token\s+120ms\n`))
+			Expect(ui.Err).To(Say(`   waiting on API\s+540ms\n`))
+			Expect(ui.Err).To(Say(`   CLI\s+\d+(\.\d+)?m?s\n`))
+			Expect(ui.Err).To(Say(`   total\s+\d+(\.\d+)?m?s\n`))
+			Expect(ui.Out).ToNot(Say("."))
+		})
+	})
+})
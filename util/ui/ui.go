@@ -15,8 +15,8 @@ import (
 
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/timings"
 	"github.com/fatih/color"
-	runewidth "github.com/mattn/go-runewidth"
 	"github.com/vito/go-interact/interact"
 )
 
@@ -90,8 +90,13 @@ type UI struct {
 
 	IsTTY         bool
 	TerminalWidth int
+	// Accessible adapts prompts, progress and tables for screen readers
+	Accessible bool
 
 	TimezoneLocation *time.Location
+
+	restoreConsole func()
+	timings        *timings.Recorder
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -117,7 +122,10 @@ func NewUI(config Config) (*UI, error) {
 		Interactor:       realInteract,
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		Accessible:       config.Accessible(),
 		TimezoneLocation: location,
+		restoreConsole:   prepareConsole(),
+		timings:          timings.NewRecorder(),
 	}, nil
 }
 
@@ -141,6 +149,15 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
 		TimezoneLocation: time.UTC,
+		timings:          timings.NewRecorder(),
+	}
+}
+
+// RestoreConsole undoes the changes NewUI made to the console settings on
+// Windows. It is a no-op elsewhere.
+func (ui *UI) RestoreConsole() {
+	if ui.restoreConsole != nil {
+		ui.restoreConsole()
 	}
 }
 
@@ -298,7 +315,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 	for col := 0; col < columns-1; col++ {
 		var max int
 		for row := 0; row < rows; row++ {
-			if strLen := runewidth.StringWidth(table[row][col]); max < strLen {
+			if strLen := wordSize(table[row][col]); max < strLen {
 				max = strLen
 			}
 		}
@@ -315,7 +332,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 		for col := 0; col < columns-1; col++ {
 			var addedPadding int
 			if col+1 != columns {
-				addedPadding = columnPadding[col] - runewidth.StringWidth(table[row][col])
+				addedPadding = columnPadding[col] - wordSize(table[row][col])
 			}
 			fmt.Fprintf(ui.Out, "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
 		}
@@ -325,7 +342,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 		currentWidth := 0
 
 		for _, word := range words {
-			wordWidth := runewidth.StringWidth(word)
+			wordWidth := wordSize(word)
 			switch {
 			case currentWidth == 0:
 				currentWidth = wordWidth
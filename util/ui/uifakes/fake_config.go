@@ -9,6 +9,16 @@ import (
 )
 
 type FakeConfig struct {
+	AccessibleStub        func() bool
+	accessibleMutex       sync.RWMutex
+	accessibleArgsForCall []struct {
+	}
+	accessibleReturns struct {
+		result1 bool
+	}
+	accessibleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -53,6 +63,58 @@ type FakeConfig struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeConfig) Accessible() bool {
+	fake.accessibleMutex.Lock()
+	ret, specificReturn := fake.accessibleReturnsOnCall[len(fake.accessibleArgsForCall)]
+	fake.accessibleArgsForCall = append(fake.accessibleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Accessible", []interface{}{})
+	fake.accessibleMutex.Unlock()
+	if fake.AccessibleStub != nil {
+		return fake.AccessibleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.accessibleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) AccessibleCallCount() int {
+	fake.accessibleMutex.RLock()
+	defer fake.accessibleMutex.RUnlock()
+	return len(fake.accessibleArgsForCall)
+}
+
+func (fake *FakeConfig) AccessibleCalls(stub func() bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = stub
+}
+
+func (fake *FakeConfig) AccessibleReturns(result1 bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = nil
+	fake.accessibleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) AccessibleReturnsOnCall(i int, result1 bool) {
+	fake.accessibleMutex.Lock()
+	defer fake.accessibleMutex.Unlock()
+	fake.AccessibleStub = nil
+	if fake.accessibleReturnsOnCall == nil {
+		fake.accessibleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.accessibleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -264,6 +326,8 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.accessibleMutex.RLock()
+	defer fake.accessibleMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.isTTYMutex.RLock()
@@ -1,6 +1,11 @@
 package util
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
+
+var scpLikeGitURLRegexp = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[^\\]`)
 
 func IsHTTPScheme(path string) bool {
 	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
@@ -9,3 +14,27 @@ func IsHTTPScheme(path string) bool {
 func IsUnsupportedURLScheme(path string) bool {
 	return strings.Contains(path, "://") && !IsHTTPScheme(path)
 }
+
+// IsGitURL returns true for git://, ssh:// and scp-like (user@host:repo)
+// URLs, and for URLs of any scheme whose path ends in ".git". A "#REF" suffix
+// selecting a branch, tag or commit is ignored.
+func IsGitURL(path string) bool {
+	url, _ := SplitGitURLRef(path)
+	switch {
+	case strings.HasPrefix(url, "git://"), strings.HasPrefix(url, "ssh://"):
+		return true
+	case strings.Contains(url, "://"):
+		return strings.HasSuffix(strings.TrimSuffix(url, "/"), ".git")
+	default:
+		return scpLikeGitURLRegexp.MatchString(url)
+	}
+}
+
+// SplitGitURLRef splits a git URL of the form URL#REF into the URL and the
+// ref. The ref is empty when none is provided.
+func SplitGitURLRef(path string) (string, string) {
+	if index := strings.LastIndex(path, "#"); index >= 0 {
+		return path[:index], path[index+1:]
+	}
+	return path, ""
+}
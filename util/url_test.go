@@ -36,4 +36,32 @@ var _ = Describe("util", func() {
 		Entry("UNIX path", "/some/path", false),
 		Entry("Windows path", `C:\some\path`, false),
 	)
+
+	DescribeTable("IsGitURL",
+		func(path string, isGitURL bool) {
+			Expect(IsGitURL(path)).To(Equal(isGitURL))
+		},
+
+		Entry("git scheme", "git://example.com/org/repo", true),
+		Entry("ssh scheme", "ssh://git@example.com/org/repo", true),
+		Entry("scp-like", "git@github.com:org/repo.git", true),
+		Entry("HTTPS URL ending in .git", "https://github.com/org/repo.git", true),
+		Entry("HTTPS URL ending in .git with a ref", "https://github.com/org/repo.git#v1.0.0", true),
+		Entry("file URL ending in .git", "file:///some/repo.git", true),
+		Entry("HTTPS archive URL", "https://github.com/org/repo/archive/main.tar.gz", false),
+		Entry("local file name", "some-path", false),
+		Entry("UNIX path", "/some/path.git", false),
+		Entry("Windows path", `C:\some\path`, false),
+	)
+
+	DescribeTable("SplitGitURLRef",
+		func(path string, expectedURL string, expectedRef string) {
+			url, ref := SplitGitURLRef(path)
+			Expect(url).To(Equal(expectedURL))
+			Expect(ref).To(Equal(expectedRef))
+		},
+
+		Entry("without a ref", "https://github.com/org/repo.git", "https://github.com/org/repo.git", ""),
+		Entry("with a ref", "git@github.com:org/repo.git#main", "git@github.com:org/repo.git", "main"),
+	)
 })
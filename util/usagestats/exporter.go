@@ -0,0 +1,74 @@
+package usagestats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultBatchSize is the number of unexported records that are collected
+// before they are sent to the export endpoint.
+const DefaultBatchSize = 50
+
+// Exporter sends records in batches to an endpoint configured by platform
+// operators.
+type Exporter struct {
+	Endpoint   string
+	BatchSize  int
+	HTTPClient *http.Client
+}
+
+func NewExporter(endpoint string, timeout time.Duration) *Exporter {
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   timeout,
+		}).DialContext,
+	}
+
+	return &Exporter{
+		Endpoint:  endpoint,
+		BatchSize: DefaultBatchSize,
+		HTTPClient: &http.Client{
+			Transport: tr,
+			Timeout:   timeout,
+		},
+	}
+}
+
+type exportRequest struct {
+	Records []Record `json:"records"`
+}
+
+// Export posts the store's unexported records to the endpoint once at least
+// BatchSize of them have been collected.
+func (exporter Exporter) Export(store *Store) error {
+	records, err := store.Unexported()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 || len(records) < exporter.BatchSize {
+		return nil
+	}
+
+	body, err := json.Marshal(exportRequest{Records: records})
+	if err != nil {
+		return err
+	}
+
+	response, err := exporter.HTTPClient.Post(exporter.Endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("usage stats endpoint returned %s", response.Status)
+	}
+
+	return store.MarkExported(len(records))
+}
@@ -0,0 +1,99 @@
+package usagestats_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/usagestats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		tmpDir   string
+		store    *Store
+		server   *ghttp.Server
+		exporter *Exporter
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "usage-stats")
+		Expect(err).ToNot(HaveOccurred())
+		store = NewStore(filepath.Join(tmpDir, "usage_stats.json"))
+
+		server = ghttp.NewServer()
+		exporter = NewExporter(server.URL()+"/stats", time.Second)
+		exporter.BatchSize = 2
+
+		Expect(store.Append(Record{Command: "push", DurationMS: 1000})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	When("fewer records than the batch size are waiting", func() {
+		It("does not send anything", func() {
+			Expect(exporter.Export(store)).To(Succeed())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	When("a full batch is waiting", func() {
+		BeforeEach(func() {
+			Expect(store.Append(Record{Command: "apps", ErrorClass: "ccerror.RequestError"})).To(Succeed())
+		})
+
+		When("the endpoint accepts the batch", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/stats"),
+						ghttp.VerifyContentType("application/json"),
+						ghttp.VerifyJSON(`{
+							"records": [
+								{"command": "push", "timestamp": "0001-01-01T00:00:00Z", "duration_ms": 1000},
+								{"command": "apps", "timestamp": "0001-01-01T00:00:00Z", "duration_ms": 0, "error_class": "ccerror.RequestError"}
+							]
+						}`),
+						ghttp.RespondWith(http.StatusAccepted, nil),
+					),
+				)
+			})
+
+			It("sends the records and marks them exported", func() {
+				Expect(exporter.Export(store)).To(Succeed())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+				unexported, err := store.Unexported()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unexported).To(BeEmpty())
+
+				records, err := store.Records()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(records).To(HaveLen(2))
+			})
+		})
+
+		When("the endpoint rejects the batch", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+			})
+
+			It("returns an error and keeps the records for the next attempt", func() {
+				Expect(exporter.Export(store)).To(MatchError("usage stats endpoint returned 500 Internal Server Error"))
+
+				unexported, err := store.Unexported()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unexported).To(HaveLen(2))
+			})
+		})
+	})
+})
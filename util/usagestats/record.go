@@ -0,0 +1,52 @@
+// Package usagestats records which CLI commands are run, how long they take
+// and what class of error they fail with. Nothing is recorded unless the user
+// opts in, arguments and error messages are never recorded, and records stay
+// on disk unless an export endpoint is configured.
+package usagestats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Record is a single command invocation.
+type Record struct {
+	Command    string    `json:"command"`
+	Timestamp  time.Time `json:"timestamp"`
+	DurationMS int64     `json:"duration_ms"`
+	ErrorClass string    `json:"error_class,omitempty"`
+	CLIVersion string    `json:"cli_version,omitempty"`
+}
+
+// Duration returns how long the command ran for.
+func (record Record) Duration() time.Duration {
+	return time.Duration(record.DurationMS) * time.Millisecond
+}
+
+// Failed returns true if the command returned an error.
+func (record Record) Failed() bool {
+	return record.ErrorClass != ""
+}
+
+// NewRecord builds a record for a command that started at startTime and
+// returned err.
+func NewRecord(command string, startTime time.Time, err error, cliVersion string) Record {
+	return Record{
+		Command:    command,
+		Timestamp:  startTime.UTC(),
+		DurationMS: int64(time.Since(startTime) / time.Millisecond),
+		ErrorClass: ErrorClass(err),
+		CLIVersion: cliVersion,
+	}
+}
+
+// ErrorClass returns the type name of the error, such as
+// "ccerror.ResourceNotFoundError", so that failures can be grouped without
+// recording messages that might contain names or URLs.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", err), "*")
+}
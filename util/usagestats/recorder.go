@@ -0,0 +1,41 @@
+package usagestats
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ExportTimeout bounds how long exporting a batch may delay the end of a
+// command.
+const ExportTimeout = 2 * time.Second
+
+// Config is the subset of the CLI configuration used to record usage.
+type Config interface {
+	BinaryVersion() string
+	UsageStatsEnabled() bool
+	UsageStatsEndpoint() string
+}
+
+// RecordCommand records a command run when the user has opted in and
+// exports a batch when an endpoint is configured. Failures are logged and
+// otherwise ignored so that usage stats never affect the command itself.
+func RecordCommand(config Config, path string, command string, startTime time.Time, commandErr error) {
+	if !config.UsageStatsEnabled() || command == "" {
+		return
+	}
+
+	store := NewStore(path)
+	err := store.Append(NewRecord(command, startTime, commandErr, config.BinaryVersion()))
+	if err != nil {
+		log.WithField("error", err).Debug("unable to record usage stats")
+		return
+	}
+
+	if endpoint := config.UsageStatsEndpoint(); endpoint != "" {
+		err = NewExporter(endpoint, ExportTimeout).Export(store)
+		if err != nil {
+			log.WithField("error", err).Debug("unable to export usage stats")
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package usagestats
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// MaxRecords is the number of records kept on disk. Older records are
+// dropped once it is reached.
+const MaxRecords = 1000
+
+type usageStatsFile struct {
+	Exported int      `json:"exported"`
+	Records  []Record `json:"records"`
+}
+
+// Store keeps records in a JSON file.
+type Store struct {
+	Path string
+}
+
+func NewStore(path string) *Store {
+	return &Store{Path: path}
+}
+
+// Append adds a record, dropping the oldest records when there are more than
+// MaxRecords.
+func (store Store) Append(record Record) error {
+	file, err := store.load()
+	if err != nil {
+		return err
+	}
+
+	file.Records = append(file.Records, record)
+	if dropped := len(file.Records) - MaxRecords; dropped > 0 {
+		file.Records = file.Records[dropped:]
+		file.Exported -= dropped
+		if file.Exported < 0 {
+			file.Exported = 0
+		}
+	}
+
+	return store.save(file)
+}
+
+// Records returns every record on disk, oldest first.
+func (store Store) Records() ([]Record, error) {
+	file, err := store.load()
+	return file.Records, err
+}
+
+// Unexported returns the records that have not been sent to an export
+// endpoint yet.
+func (store Store) Unexported() ([]Record, error) {
+	file, err := store.load()
+	if err != nil {
+		return nil, err
+	}
+	return file.Records[file.Exported:], nil
+}
+
+// MarkExported records that the first count unexported records have been
+// sent to an export endpoint.
+func (store Store) MarkExported(count int) error {
+	file, err := store.load()
+	if err != nil {
+		return err
+	}
+
+	file.Exported += count
+	if file.Exported > len(file.Records) {
+		file.Exported = len(file.Records)
+	}
+	return store.save(file)
+}
+
+// Clear removes all records.
+func (store Store) Clear() error {
+	err := os.Remove(store.Path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (store Store) load() (usageStatsFile, error) {
+	var file usageStatsFile
+
+	raw, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return file, nil
+	}
+	if err != nil {
+		return file, err
+	}
+
+	// A corrupt file is replaced rather than failing every command.
+	if json.Unmarshal(raw, &file) != nil {
+		return usageStatsFile{}, nil
+	}
+	if file.Exported < 0 || file.Exported > len(file.Records) {
+		file.Exported = 0
+	}
+	return file, nil
+}
+
+func (store Store) save(file usageStatsFile) error {
+	raw, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(store.Path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent CLI invocations never
+	// read a partially written file.
+	tmpFile, err := ioutil.TempFile(dir, "usage_stats")
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(raw)
+	closeErr := tmpFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), store.Path)
+}
@@ -0,0 +1,95 @@
+package usagestats_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/usagestats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		tmpDir string
+		store  *Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "usage-stats")
+		Expect(err).ToNot(HaveOccurred())
+		store = NewStore(filepath.Join(tmpDir, "nested", "usage_stats.json"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	When("nothing has been recorded", func() {
+		It("returns no records", func() {
+			records, err := store.Records()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(BeEmpty())
+		})
+	})
+
+	It("appends records and tracks which ones have been exported", func() {
+		Expect(store.Append(Record{Command: "push"})).To(Succeed())
+		Expect(store.Append(Record{Command: "apps"})).To(Succeed())
+
+		records, err := store.Records()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(Equal([]Record{{Command: "push"}, {Command: "apps"}}))
+
+		Expect(store.MarkExported(1)).To(Succeed())
+		Expect(store.Append(Record{Command: "logs"})).To(Succeed())
+
+		unexported, err := store.Unexported()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unexported).To(Equal([]Record{{Command: "apps"}, {Command: "logs"}}))
+	})
+
+	It("keeps at most MaxRecords records", func() {
+		for i := 0; i < MaxRecords; i++ {
+			Expect(store.Append(Record{Command: "old"})).To(Succeed())
+		}
+		Expect(store.MarkExported(MaxRecords)).To(Succeed())
+		Expect(store.Append(Record{Command: "new"})).To(Succeed())
+
+		records, err := store.Records()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(MaxRecords))
+		Expect(records[MaxRecords-1].Command).To(Equal("new"))
+
+		unexported, err := store.Unexported()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unexported).To(Equal([]Record{{Command: "new"}}))
+	})
+
+	It("clears all records", func() {
+		Expect(store.Append(Record{Command: "push"})).To(Succeed())
+		Expect(store.Clear()).To(Succeed())
+		Expect(store.Clear()).To(Succeed())
+
+		records, err := store.Records()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	When("the file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(store.Path), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(store.Path, []byte("not json"), 0600)).To(Succeed())
+		})
+
+		It("starts over", func() {
+			Expect(store.Append(Record{Command: "push"})).To(Succeed())
+
+			records, err := store.Records()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]Record{{Command: "push"}}))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package usagestats
+
+import (
+	"sort"
+	"time"
+)
+
+// CommandSummary aggregates the records of a single command.
+type CommandSummary struct {
+	Command         string
+	Runs            int
+	Failures        int
+	AverageDuration time.Duration
+	LastRun         time.Time
+}
+
+// Summarize groups records by command, ordered by the number of runs and
+// then by command name.
+func Summarize(records []Record) []CommandSummary {
+	byCommand := map[string]*CommandSummary{}
+	totalDurations := map[string]time.Duration{}
+
+	for _, record := range records {
+		summary, ok := byCommand[record.Command]
+		if !ok {
+			summary = &CommandSummary{Command: record.Command}
+			byCommand[record.Command] = summary
+		}
+
+		summary.Runs++
+		if record.Failed() {
+			summary.Failures++
+		}
+		if record.Timestamp.After(summary.LastRun) {
+			summary.LastRun = record.Timestamp
+		}
+		totalDurations[record.Command] += record.Duration()
+	}
+
+	summaries := make([]CommandSummary, 0, len(byCommand))
+	for command, summary := range byCommand {
+		summary.AverageDuration = totalDurations[command] / time.Duration(summary.Runs)
+		summaries = append(summaries, *summary)
+	}
+
+	sort.Slice(summaries, func(i int, j int) bool {
+		if summaries[i].Runs != summaries[j].Runs {
+			return summaries[i].Runs > summaries[j].Runs
+		}
+		return summaries[i].Command < summaries[j].Command
+	})
+
+	return summaries
+}
@@ -0,0 +1,39 @@
+package usagestats_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/util/usagestats"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Summarize", func() {
+	It("aggregates the records per command", func() {
+		first := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		second := first.Add(time.Hour)
+
+		summaries := Summarize([]Record{
+			{Command: "push", Timestamp: first, DurationMS: 1000},
+			{Command: "apps", Timestamp: first, DurationMS: 500},
+			{Command: "push", Timestamp: second, DurationMS: 3000, ErrorClass: "actionerror.StagingTimeoutError"},
+			{Command: "logs", Timestamp: second, DurationMS: 100},
+		})
+
+		Expect(summaries).To(Equal([]CommandSummary{
+			{Command: "push", Runs: 2, Failures: 1, AverageDuration: 2 * time.Second, LastRun: second},
+			{Command: "apps", Runs: 1, AverageDuration: 500 * time.Millisecond, LastRun: first},
+			{Command: "logs", Runs: 1, AverageDuration: 100 * time.Millisecond, LastRun: second},
+		}))
+	})
+})
+
+var _ = Describe("ErrorClass", func() {
+	It("returns the type of the error without its message", func() {
+		Expect(ErrorClass(nil)).To(BeEmpty())
+		Expect(ErrorClass(actionerror.ApplicationNotFoundError{Name: "secret-app"})).To(Equal("actionerror.ApplicationNotFoundError"))
+		Expect(ErrorClass(errors.New("some message"))).To(Equal("errors.errorString"))
+	})
+})
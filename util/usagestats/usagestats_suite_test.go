@@ -0,0 +1,13 @@
+package usagestats_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestUsageStats(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Usage Stats Suite")
+}